@@ -0,0 +1,110 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListApis(t *testing.T) {
+	params := url.Values{
+		"name": {"test-name"},
+	}
+	cs, done := newTestClient(t, "listApis", params, `{"listapisresponse":{"api":[{"description":"test-description","isasync":true,"name":"test-name","params":[{"description":"test-description","length":1,"name":"test-name","related":"test-related","required":true,"since":"test-since","type":"test-type"}],"related":"test-related","response":[{"description":"test-description","name":"test-name","response":["test-response"],"type":"test-type"}],"since":"test-since","type":"test-type"}],"count":1}}`)
+	defer done()
+
+	p := cs.APIDiscovery.NewListApisParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetName("test-name")
+	checkURLValues(t, "listApis", p.URLValues(), params)
+
+	r, err := cs.APIDiscovery.ListApis(p)
+	if err != nil {
+		t.Fatalf("Failed to call listApis: %v", err)
+	}
+	if r.Apis[0].Description != "test-description" {
+		t.Errorf("Expected r.Apis[0].Description to be %v, got %v", "test-description", r.Apis[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listApis" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listApis: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listApis: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listApis: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Api
+	count, err := cs.APIDiscovery.StreamApis(context.Background(), p, func(v *Api) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listApis: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Apis) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Apis)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listApis", params, `<listapisresponse><api><description>test-description</description><isasync>true</isasync><name>test-name</name><params><description>test-description</description><length>1</length><name>test-name</name><related>test-related</related><required>true</required><since>test-since</since><type>test-type</type></params><related>test-related</related><response><description>test-description</description><name>test-name</name><response>test-response</response><type>test-type</type></response><since>test-since</since><type>test-type</type></api><count>1</count></listapisresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.APIDiscovery.ListApis(p)
+	if err != nil {
+		t.Fatalf("Failed to call listApis using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.APIDiscovery.StreamApis(context.Background(), p, func(v *Api) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listApis using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Apis) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Apis)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,99 +17,58 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
-	"strconv"
 	"strings"
-)
-
-type CreateAffinityGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateAffinityGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
-}
-
-func (p *CreateAffinityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
 
-func (p *CreateAffinityGroupParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateAffinityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+type AffinityGroupService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateAffinityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func NewAffinityGroupService(cs *CloudStackClient) *AffinityGroupService {
+	return &AffinityGroupService{cs: cs}
 }
 
-func (p *CreateAffinityGroupParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinityGroupType"] = v
-	return
-}
+type CreateAffinityGroupParams = cloudstackcommon.CreateAffinityGroupParams
+type CreateAffinityGroupResponse = cloudstackcommon.CreateAffinityGroupResponse
 
 // You should always use this function to get a new CreateAffinityGroupParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
+//   - type
 func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams {
 	p := &CreateAffinityGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["affinityGroupType"] = affinityGroupType
+	p.SetName(name)
+	p.SetType(affinityGroupType)
 	return p
 }
 
 // Creates an affinity/anti-affinity group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAffinityGroups, GetAffinityGroupID, GetAffinityGroupByName, GetAffinityGroupByID.
 func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAffinityGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createAffinityGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAffinityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createAffinityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -130,98 +89,41 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createAffinityGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateAffinityGroupResponse struct {
-	JobID             string   `json:"jobid,omitempty"`
-	Account           string   `json:"account,omitempty"`
-	Description       string   `json:"description,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Name              string   `json:"name,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-}
-
-type DeleteAffinityGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteAffinityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *DeleteAffinityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *DeleteAffinityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type DeleteAffinityGroupParams = cloudstackcommon.DeleteAffinityGroupParams
+type DeleteAffinityGroupResponse = cloudstackcommon.DeleteAffinityGroupResponse
 
 // You should always use this function to get a new DeleteAffinityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams {
 	p := &DeleteAffinityGroupParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Deletes affinity group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAffinityGroups, GetAffinityGroupID, GetAffinityGroupByName, GetAffinityGroupByID.
 func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAffinityGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteAffinityGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAffinityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteAffinityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -237,170 +139,38 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteAffinityGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteAffinityGroupResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListAffinityGroupsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAffinityGroupsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
-}
-
-func (p *ListAffinityGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinityGroupType"] = v
-	return
-}
-
-func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
-}
+type ListAffinityGroupsParams = cloudstackcommon.ListAffinityGroupsParams
+type ListAffinityGroupsResponse = cloudstackcommon.ListAffinityGroupsResponse
+type AffinityGroup = cloudstackcommon.AffinityGroup
 
 // You should always use this function to get a new ListAffinityGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *AffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroupsParams {
 	p := &ListAffinityGroupsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AffinityGroupService) GetAffinityGroupID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListAffinityGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListAffinityGroups(p)
 	if err != nil {
@@ -415,24 +185,37 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string) (string, error) {
 		return l.AffinityGroups[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.AffinityGroups {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*AffinityGroup
+	for _, v := range l.AffinityGroups {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "AffinityGroup", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AffinityGroupService) GetAffinityGroupByName(name string) (*AffinityGroup, int, error) {
-	id, err := s.GetAffinityGroupID(name)
+func (s *AffinityGroupService) GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	id, err := s.GetAffinityGroupID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetAffinityGroupByID(id)
+	r, count, err := s.GetAffinityGroupByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -440,11 +223,16 @@ func (s *AffinityGroupService) GetAffinityGroupByName(name string) (*AffinityGro
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AffinityGroupService) GetAffinityGroupByID(id string) (*AffinityGroup, int, error) {
+func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
 	p := &ListAffinityGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListAffinityGroups(p)
 	if err != nil {
@@ -467,100 +255,227 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string) (*AffinityGroup,
 }
 
 // Lists affinity groups
+//
+// See also GetAffinityGroupID, GetAffinityGroupByName, GetAffinityGroupByID.
 func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroups", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listAffinityGroups", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAffinityGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listAffinityGroups", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListAffinityGroupsResponse struct {
-	Count          int              `json:"count"`
-	AffinityGroups []*AffinityGroup `json:"affinitygroup"`
+// Lists the objects like ListAffinityGroups, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AffinityGroupService) StreamAffinityGroups(ctx context.Context, p *ListAffinityGroupsParams, fn func(*AffinityGroup) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listAffinityGroups", p.URLValues(), "affinitygroup", func(b json.RawMessage) error {
+		var v AffinityGroup
+		if err := s.cs.unmarshalElement("listAffinityGroups", "affinitygroup", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListAffinityGroupTypesParams = cloudstackcommon.ListAffinityGroupTypesParams
+type ListAffinityGroupTypesResponse = cloudstackcommon.ListAffinityGroupTypesResponse
+type AffinityGroupType = cloudstackcommon.AffinityGroupType
+
+// You should always use this function to get a new ListAffinityGroupTypesParams instance,
+// as then you are sure you have configured all required params
+func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams {
+	p := &ListAffinityGroupTypesParams{}
+	return p
+}
+
+// Lists affinity group types available
+func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listAffinityGroupTypes", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListAffinityGroupTypesResponse
+	if err := s.cs.unmarshal("listAffinityGroupTypes", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-type AffinityGroup struct {
-	Account           string   `json:"account,omitempty"`
-	Description       string   `json:"description,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Name              string   `json:"name,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
+// Lists the objects like ListAffinityGroupTypes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AffinityGroupService) StreamAffinityGroupTypes(ctx context.Context, p *ListAffinityGroupTypesParams, fn func(*AffinityGroupType) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listAffinityGroupTypes", p.URLValues(), "affinitygrouptype", func(b json.RawMessage) error {
+		var v AffinityGroupType
+		if err := s.cs.unmarshalElement("listAffinityGroupTypes", "affinitygrouptype", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
 type UpdateVMAffinityGroupParams struct {
-	p map[string]interface{}
+	Affinitygroupids   []string `json:"affinitygroupids,omitempty" yaml:"affinitygroupids,omitempty"`
+	Affinitygroupnames []string `json:"affinitygroupnames,omitempty" yaml:"affinitygroupnames,omitempty"`
+	Id                 *string  `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVMAffinityGroupParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["affinitygroupids"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Affinitygroupids; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("affinitygroupids", vv)
 	}
-	if v, found := p.p["affinitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Affinitygroupnames; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("affinitygroupnames", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *UpdateVMAffinityGroupParams) Command() string {
+	return "updateVMAffinityGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateVMAffinityGroupParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVMAffinityGroupParams) ResponseType() interface{} {
+	return &UpdateVMAffinityGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVMAffinityGroupParams) Validate() error {
+	v := &validator{api: "updateVMAffinityGroup"}
+	v.required("id", p.Id != nil)
+	v.exclusive("affinitygroupids", p.Affinitygroupids != nil, "affinitygroupnames", p.Affinitygroupnames != nil)
+	return v.err()
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupids"] = v
+	p.Affinitygroupids = v
 	return
 }
 
+func (p *UpdateVMAffinityGroupParams) GetAffinitygroupids() []string {
+	return p.Affinitygroupids
+}
+
+func (p *UpdateVMAffinityGroupParams) HasAffinitygroupids() bool {
+	return p.Affinitygroupids != nil
+}
+
+func (p *UpdateVMAffinityGroupParams) ResetAffinitygroupids() {
+	p.Affinitygroupids = nil
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupnames"] = v
+	p.Affinitygroupnames = v
 	return
 }
 
+func (p *UpdateVMAffinityGroupParams) GetAffinitygroupnames() []string {
+	return p.Affinitygroupnames
+}
+
+func (p *UpdateVMAffinityGroupParams) HasAffinitygroupnames() bool {
+	return p.Affinitygroupnames != nil
+}
+
+func (p *UpdateVMAffinityGroupParams) ResetAffinitygroupnames() {
+	p.Affinitygroupnames = nil
+}
+
+// This param is required.
 func (p *UpdateVMAffinityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.Id = &v
 	return
 }
 
+func (p *UpdateVMAffinityGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateVMAffinityGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateVMAffinityGroupParams) ResetId() {
+	p.Id = nil
+}
+
 // You should always use this function to get a new UpdateVMAffinityGroupParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams {
 	p := &UpdateVMAffinityGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates the affinity/anti-affinity group associations of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateVMAffinityGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateVMAffinityGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVMAffinityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateVMAffinityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -581,7 +496,7 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateVMAffinityGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -589,247 +504,64 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 }
 
 type UpdateVMAffinityGroupResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ListAffinityGroupTypesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListAffinityGroupTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListAffinityGroupTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams {
-	p := &ListAffinityGroupTypesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Lists affinity group types available
-func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroupTypes", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListAffinityGroupTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListAffinityGroupTypesResponse struct {
-	Count              int                  `json:"count"`
-	AffinityGroupTypes []*AffinityGroupType `json:"affinitygrouptype"`
-}
-
-type AffinityGroupType struct {
-	Type string `json:"type,omitempty"`
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -0,0 +1,419 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateAffinityGroup(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"description": {"test-description"},
+		"domainid":    {"test-domainid"},
+		"name":        {"test-name"},
+		"type":        {"test-type"},
+	}
+	cs, done := newTestClient(t, "createAffinityGroup", params, `{"createaffinitygroupresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}}`)
+	defer done()
+
+	p := cs.AffinityGroup.NewCreateAffinityGroupParams("test-name", "test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDescription("test-description")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetType("test-type")
+	checkURLValues(t, "createAffinityGroup", p.URLValues(), params)
+
+	r, err := cs.AffinityGroup.CreateAffinityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAffinityGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createAffinityGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createAffinityGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createAffinityGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createAffinityGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createAffinityGroup", params, `<createaffinitygroupresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></createaffinitygroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AffinityGroup.CreateAffinityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAffinityGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAffinityGroup(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"id":       {"test-id"},
+		"name":     {"test-name"},
+	}
+	cs, done := newTestClient(t, "deleteAffinityGroup", params, `{"deleteaffinitygroupresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.AffinityGroup.NewDeleteAffinityGroupParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	checkURLValues(t, "deleteAffinityGroup", p.URLValues(), params)
+
+	r, err := cs.AffinityGroup.DeleteAffinityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAffinityGroup: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAffinityGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAffinityGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAffinityGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAffinityGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAffinityGroup", params, `<deleteaffinitygroupresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteaffinitygroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AffinityGroup.DeleteAffinityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAffinityGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAffinityGroups(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"domainid":         {"test-domainid"},
+		"id":               {"test-id"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"name":             {"test-name"},
+		"page":             {"8"},
+		"pagesize":         {"9"},
+		"type":             {"test-type"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "listAffinityGroups", params, `{"listaffinitygroupsresponse":{"affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"count":1}}`)
+	defer done()
+
+	p := cs.AffinityGroup.NewListAffinityGroupsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetType("test-type")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "listAffinityGroups", p.URLValues(), params)
+
+	r, err := cs.AffinityGroup.ListAffinityGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAffinityGroups: %v", err)
+	}
+	if r.AffinityGroups[0].Account != "test-account" {
+		t.Errorf("Expected r.AffinityGroups[0].Account to be %v, got %v", "test-account", r.AffinityGroups[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAffinityGroups" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAffinityGroups: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAffinityGroups: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAffinityGroups: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*AffinityGroup
+	count, err := cs.AffinityGroup.StreamAffinityGroups(context.Background(), p, func(v *AffinityGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAffinityGroups: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.AffinityGroups) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.AffinityGroups)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAffinityGroups", params, `<listaffinitygroupsresponse><affinitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></affinitygroup><count>1</count></listaffinitygroupsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AffinityGroup.ListAffinityGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAffinityGroups using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AffinityGroup.StreamAffinityGroups(context.Background(), p, func(v *AffinityGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAffinityGroups using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.AffinityGroups) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.AffinityGroups)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAffinityGroupTypes(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+	cs, done := newTestClient(t, "listAffinityGroupTypes", params, `{"listaffinitygrouptypesresponse":{"affinitygrouptype":[{"type":"test-type"}],"count":1}}`)
+	defer done()
+
+	p := cs.AffinityGroup.NewListAffinityGroupTypesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	checkURLValues(t, "listAffinityGroupTypes", p.URLValues(), params)
+
+	r, err := cs.AffinityGroup.ListAffinityGroupTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAffinityGroupTypes: %v", err)
+	}
+	if r.AffinityGroupTypes[0].Type != "test-type" {
+		t.Errorf("Expected r.AffinityGroupTypes[0].Type to be %v, got %v", "test-type", r.AffinityGroupTypes[0].Type)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAffinityGroupTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAffinityGroupTypes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAffinityGroupTypes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAffinityGroupTypes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*AffinityGroupType
+	count, err := cs.AffinityGroup.StreamAffinityGroupTypes(context.Background(), p, func(v *AffinityGroupType) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAffinityGroupTypes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.AffinityGroupTypes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.AffinityGroupTypes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAffinityGroupTypes", params, `<listaffinitygrouptypesresponse><affinitygrouptype><type>test-type</type></affinitygrouptype><count>1</count></listaffinitygrouptypesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AffinityGroup.ListAffinityGroupTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAffinityGroupTypes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AffinityGroup.StreamAffinityGroupTypes(context.Background(), p, func(v *AffinityGroupType) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAffinityGroupTypes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.AffinityGroupTypes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.AffinityGroupTypes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVMAffinityGroup(t *testing.T) {
+	params := url.Values{
+		"affinitygroupids":   {"test-affinitygroupids1, test-affinitygroupids2"},
+		"affinitygroupnames": {"test-affinitygroupnames1, test-affinitygroupnames2"},
+		"id":                 {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateVMAffinityGroup", params, `{"updatevmaffinitygroupresponse":{"account":"test-account","affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"diskioread":1,"diskiowrite":1,"diskkbsread":1,"diskkbswrite":1,"diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayname":"test-displayname","displayvm":true,"domain":"test-domain","domainid":"test-domainid","forvirtualnetwork":true,"group":"test-group","groupid":"test-groupid","guestosid":"test-guestosid","haenable":true,"hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","instancename":"test-instancename","isdynamicallyscalable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","keypair":"test-keypair","memory":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"ostypeid":1,"password":"test-password","passwordenabled":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","rootdeviceid":1,"rootdevicetype":"test-rootdevicetype","securitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","servicestate":"test-servicestate","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","vgpu":"test-vgpu","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.AffinityGroup.NewUpdateVMAffinityGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAffinitygroupids([]string{"test-affinitygroupids1", "test-affinitygroupids2"})
+	p.SetAffinitygroupnames([]string{"test-affinitygroupnames1", "test-affinitygroupnames2"})
+	p.SetId("test-id")
+	checkURLValues(t, "updateVMAffinityGroup", p.URLValues(), params)
+
+	r, err := cs.AffinityGroup.UpdateVMAffinityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVMAffinityGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVMAffinityGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVMAffinityGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVMAffinityGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVMAffinityGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVMAffinityGroup", params, `<updatevmaffinitygroupresponse><account>test-account</account><affinitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></affinitygroup><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><diskioread>1</diskioread><diskiowrite>1</diskiowrite><diskkbsread>1</diskkbsread><diskkbswrite>1</diskkbswrite><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayname>test-displayname</displayname><displayvm>true</displayvm><domain>test-domain</domain><domainid>test-domainid</domainid><forvirtualnetwork>true</forvirtualnetwork><group>test-group</group><groupid>test-groupid</groupid><guestosid>test-guestosid</guestosid><haenable>true</haenable><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><instancename>test-instancename</instancename><isdynamicallyscalable>true</isdynamicallyscalable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><keypair>test-keypair</keypair><memory>1</memory><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><ostypeid>1</ostypeid><password>test-password</password><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><rootdeviceid>1</rootdeviceid><rootdevicetype>test-rootdevicetype</rootdevicetype><securitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></securitygroup><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><servicestate>test-servicestate</servicestate><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><vgpu>test-vgpu</vgpu><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatevmaffinitygroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AffinityGroup.UpdateVMAffinityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVMAffinityGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,107 +17,170 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type ListAlertsParams struct {
-	p map[string]interface{}
+type AlertService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListAlertsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+func NewAlertService(cs *CloudStackClient) *AlertService {
+	return &AlertService{cs: cs}
+}
+
+type ArchiveAlertsParams = cloudstackcommon.ArchiveAlertsParams
+type ArchiveAlertsResponse = cloudstackcommon.ArchiveAlertsResponse
+
+// You should always use this function to get a new ArchiveAlertsParams instance,
+// as then you are sure you have configured all required params
+func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
+	p := &ArchiveAlertsParams{}
+	return p
+}
+
+// Archive one or more alerts.
+//
+// See also ListAlerts, GetAlertID, GetAlertByName, GetAlertByID.
+func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+
+	resp, err := s.cs.newRequest("archiveAlerts", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+
+	var r ArchiveAlertsResponse
+	if err := s.cs.unmarshal("archiveAlerts", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	return &r, nil
+}
+
+type DeleteAlertsParams = cloudstackcommon.DeleteAlertsParams
+type DeleteAlertsResponse = cloudstackcommon.DeleteAlertsResponse
+
+// You should always use this function to get a new DeleteAlertsParams instance,
+// as then you are sure you have configured all required params
+func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
+	p := &DeleteAlertsParams{}
+	return p
+}
+
+// Delete one or more alerts.
+//
+// See also ListAlerts, GetAlertID, GetAlertByName, GetAlertByID.
+func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+
+	resp, err := s.cs.newRequest("deleteAlerts", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *ListAlertsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteAlertsResponse
+	if err := s.cs.unmarshal("deleteAlerts", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListAlertsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+type GenerateAlertParams = cloudstackcommon.GenerateAlertParams
+type GenerateAlertResponse = cloudstackcommon.GenerateAlertResponse
+
+// You should always use this function to get a new GenerateAlertParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - description
+//   - name
+//   - type
+func (s *AlertService) NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams {
+	p := &GenerateAlertParams{}
+	p.SetDescription(description)
+	p.SetName(name)
+	p.SetType(alertType)
+	return p
 }
 
-func (p *ListAlertsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Generates an alert
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAlerts, GetAlertID, GetAlertByName, GetAlertByID.
+func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *ListAlertsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("generateAlert", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListAlertsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r GenerateAlertResponse
+	if err := s.cs.unmarshal("generateAlert", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListAlertsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("generateAlert", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["alertType"] = v
-	return
+	return &r, nil
 }
 
+type ListAlertsParams = cloudstackcommon.ListAlertsParams
+type ListAlertsResponse = cloudstackcommon.ListAlertsResponse
+type Alert = cloudstackcommon.Alert
+
 // You should always use this function to get a new ListAlertsParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewListAlertsParams() *ListAlertsParams {
 	p := &ListAlertsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AlertService) GetAlertID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListAlertsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListAlerts(p)
 	if err != nil {
@@ -132,24 +195,36 @@ func (s *AlertService) GetAlertID(name string) (string, error) {
 		return l.Alerts[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Alerts {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Alert
+	for _, v := range l.Alerts {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Alert", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AlertService) GetAlertByName(name string) (*Alert, int, error) {
-	id, err := s.GetAlertID(name)
+func (s *AlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error) {
+	id, err := s.GetAlertID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetAlertByID(id)
+	r, count, err := s.GetAlertByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -157,11 +232,16 @@ func (s *AlertService) GetAlertByName(name string) (*Alert, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AlertService) GetAlertByID(id string) (*Alert, int, error) {
+func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error) {
 	p := &ListAlertsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListAlerts(p)
 	if err != nil {
@@ -184,312 +264,42 @@ func (s *AlertService) GetAlertByID(id string) (*Alert, int, error) {
 }
 
 // Lists all alerts.
+//
+// See also GetAlertID, GetAlertByName, GetAlertByID.
 func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
-	resp, err := s.cs.newRequest("listAlerts", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListAlertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListAlertsResponse struct {
-	Count  int      `json:"count"`
-	Alerts []*Alert `json:"alert"`
-}
-
-type Alert struct {
-	Description string `json:"description,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Sent        string `json:"sent,omitempty"`
-	Type        int    `json:"type,omitempty"`
-}
-
-type ArchiveAlertsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ArchiveAlertsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
-	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("ids", vv)
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
-}
-
-func (p *ArchiveAlertsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
-}
-
-func (p *ArchiveAlertsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
-	return
-}
-
-func (p *ArchiveAlertsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
-	return
-}
-
-func (p *ArchiveAlertsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["alertType"] = v
-	return
-}
-
-// You should always use this function to get a new ArchiveAlertsParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
-	p := &ArchiveAlertsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Archive one or more alerts.
-func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
-	resp, err := s.cs.newRequest("archiveAlerts", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ArchiveAlertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ArchiveAlertsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type DeleteAlertsParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteAlertsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
-	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("ids", vv)
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteAlertsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
-}
-
-func (p *DeleteAlertsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
-	return
-}
-
-func (p *DeleteAlertsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
-	return
-}
-
-func (p *DeleteAlertsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["alertType"] = v
-	return
-}
 
-// You should always use this function to get a new DeleteAlertsParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
-	p := &DeleteAlertsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Delete one or more alerts.
-func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
-	resp, err := s.cs.newRequest("deleteAlerts", p.toURLValues())
+	resp, err := s.cs.newRequest("listAlerts", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteAlertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListAlertsResponse
+	if err := s.cs.unmarshal("listAlerts", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteAlertsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type GenerateAlertParams struct {
-	p map[string]interface{}
-}
-
-func (p *GenerateAlertParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("type", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *GenerateAlertParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
-}
-
-func (p *GenerateAlertParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *GenerateAlertParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *GenerateAlertParams) SetType(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["alertType"] = v
-	return
-}
-
-func (p *GenerateAlertParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
-
-// You should always use this function to get a new GenerateAlertParams instance,
-// as then you are sure you have configured all required params
-func (s *AlertService) NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams {
-	p := &GenerateAlertParams{}
-	p.p = make(map[string]interface{})
-	p.p["description"] = description
-	p.p["name"] = name
-	p.p["alertType"] = alertType
-	return p
-}
-
-// Generates an alert
-func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
-	resp, err := s.cs.newRequest("generateAlert", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r GenerateAlertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+// Lists the objects like ListAlerts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AlertService) StreamAlerts(ctx context.Context, p *ListAlertsParams, fn func(*Alert) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	return &r, nil
-}
 
-type GenerateAlertResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+	return s.cs.streamList(ctx, "listAlerts", p.URLValues(), "alert", func(b json.RawMessage) error {
+		var v Alert
+		if err := s.cs.unmarshalElement("listAlerts", "alert", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -0,0 +1,431 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddBaremetalDhcp(t *testing.T) {
+	params := url.Values{
+		"dhcpservertype":    {"test-dhcpservertype"},
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+	cs, done := newTestClient(t, "addBaremetalDhcp", params, `{"addbaremetaldhcpresponse":{"dhcpservertype":"test-dhcpservertype","id":"test-id","jobid":"test-jobid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","url":"test-url"}}`)
+	defer done()
+
+	p := cs.Baremetal.NewAddBaremetalDhcpParams("test-dhcpservertype", "test-password", "test-physicalnetworkid", "test-url", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDhcpservertype("test-dhcpservertype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addBaremetalDhcp", p.URLValues(), params)
+
+	r, err := cs.Baremetal.AddBaremetalDhcp(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalDhcp: %v", err)
+	}
+	if r.Dhcpservertype != "test-dhcpservertype" {
+		t.Errorf("Expected r.Dhcpservertype to be %v, got %v", "test-dhcpservertype", r.Dhcpservertype)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalDhcp" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addBaremetalDhcp: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addBaremetalDhcp: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addBaremetalDhcp: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addBaremetalDhcp", params, `<addbaremetaldhcpresponse><dhcpservertype>test-dhcpservertype</dhcpservertype><id>test-id</id><jobid>test-jobid</jobid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><url>test-url</url></addbaremetaldhcpresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Baremetal.AddBaremetalDhcp(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalDhcp using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListBaremetalDhcp(t *testing.T) {
+	params := url.Values{
+		"dhcpservertype": {"test-dhcpservertype"},
+		"id":             {"2"},
+		"keyword":        {"test-keyword"},
+		"page":           {"4"},
+		"pagesize":       {"5"},
+	}
+	cs, done := newTestClient(t, "listBaremetalDhcp", params, `{"listbaremetaldhcpresponse":{"baremetaldhcp":[{"dhcpservertype":"test-dhcpservertype","id":"test-id","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","url":"test-url"}],"count":1}}`)
+	defer done()
+
+	p := cs.Baremetal.NewListBaremetalDhcpParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDhcpservertype("test-dhcpservertype")
+	p.SetId(2)
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	checkURLValues(t, "listBaremetalDhcp", p.URLValues(), params)
+
+	r, err := cs.Baremetal.ListBaremetalDhcp(p)
+	if err != nil {
+		t.Fatalf("Failed to call listBaremetalDhcp: %v", err)
+	}
+	if r.BaremetalDhcp[0].Dhcpservertype != "test-dhcpservertype" {
+		t.Errorf("Expected r.BaremetalDhcp[0].Dhcpservertype to be %v, got %v", "test-dhcpservertype", r.BaremetalDhcp[0].Dhcpservertype)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listBaremetalDhcp" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listBaremetalDhcp: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listBaremetalDhcp: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listBaremetalDhcp: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*BaremetalDhcp
+	count, err := cs.Baremetal.StreamBaremetalDhcp(context.Background(), p, func(v *BaremetalDhcp) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listBaremetalDhcp: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.BaremetalDhcp) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.BaremetalDhcp)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listBaremetalDhcp", params, `<listbaremetaldhcpresponse><baremetaldhcp><dhcpservertype>test-dhcpservertype</dhcpservertype><id>test-id</id><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><url>test-url</url></baremetaldhcp><count>1</count></listbaremetaldhcpresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Baremetal.ListBaremetalDhcp(p)
+	if err != nil {
+		t.Fatalf("Failed to call listBaremetalDhcp using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Baremetal.StreamBaremetalDhcp(context.Background(), p, func(v *BaremetalDhcp) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listBaremetalDhcp using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.BaremetalDhcp) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.BaremetalDhcp)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddBaremetalPxeKickStartServer(t *testing.T) {
+	params := url.Values{
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"podid":             {"test-podid"},
+		"pxeservertype":     {"test-pxeservertype"},
+		"tftpdir":           {"test-tftpdir"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+	cs, done := newTestClient(t, "addBaremetalPxeKickStartServer", params, `{"addbaremetalpxekickstartserverresponse":{"jobid":"test-jobid","tftpdir":"test-tftpdir"}}`)
+	defer done()
+
+	p := cs.Baremetal.NewAddBaremetalPxeKickStartServerParams("test-password", "test-physicalnetworkid", "test-pxeservertype", "test-tftpdir", "test-url", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPodid("test-podid")
+	p.SetPxeservertype("test-pxeservertype")
+	p.SetTftpdir("test-tftpdir")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addBaremetalPxeKickStartServer", p.URLValues(), params)
+
+	r, err := cs.Baremetal.AddBaremetalPxeKickStartServer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalPxeKickStartServer: %v", err)
+	}
+	if r.Tftpdir != "test-tftpdir" {
+		t.Errorf("Expected r.Tftpdir to be %v, got %v", "test-tftpdir", r.Tftpdir)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalPxeKickStartServer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addBaremetalPxeKickStartServer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addBaremetalPxeKickStartServer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addBaremetalPxeKickStartServer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addBaremetalPxeKickStartServer", params, `<addbaremetalpxekickstartserverresponse><jobid>test-jobid</jobid><tftpdir>test-tftpdir</tftpdir></addbaremetalpxekickstartserverresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Baremetal.AddBaremetalPxeKickStartServer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalPxeKickStartServer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddBaremetalPxePingServer(t *testing.T) {
+	params := url.Values{
+		"password":            {"test-password"},
+		"physicalnetworkid":   {"test-physicalnetworkid"},
+		"pingcifspassword":    {"test-pingcifspassword"},
+		"pingcifsusername":    {"test-pingcifsusername"},
+		"pingdir":             {"test-pingdir"},
+		"pingstorageserverip": {"test-pingstorageserverip"},
+		"podid":               {"test-podid"},
+		"pxeservertype":       {"test-pxeservertype"},
+		"tftpdir":             {"test-tftpdir"},
+		"url":                 {"test-url"},
+		"username":            {"test-username"},
+	}
+	cs, done := newTestClient(t, "addBaremetalPxePingServer", params, `{"addbaremetalpxepingserverresponse":{"jobid":"test-jobid","pingdir":"test-pingdir","pingstorageserverip":"test-pingstorageserverip","tftpdir":"test-tftpdir"}}`)
+	defer done()
+
+	p := cs.Baremetal.NewAddBaremetalPxePingServerParams("test-password", "test-physicalnetworkid", "test-pingdir", "test-pingstorageserverip", "test-pxeservertype", "test-tftpdir", "test-url", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPingcifspassword("test-pingcifspassword")
+	p.SetPingcifsusername("test-pingcifsusername")
+	p.SetPingdir("test-pingdir")
+	p.SetPingstorageserverip("test-pingstorageserverip")
+	p.SetPodid("test-podid")
+	p.SetPxeservertype("test-pxeservertype")
+	p.SetTftpdir("test-tftpdir")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addBaremetalPxePingServer", p.URLValues(), params)
+
+	r, err := cs.Baremetal.AddBaremetalPxePingServer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalPxePingServer: %v", err)
+	}
+	if r.Pingdir != "test-pingdir" {
+		t.Errorf("Expected r.Pingdir to be %v, got %v", "test-pingdir", r.Pingdir)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalPxePingServer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addBaremetalPxePingServer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addBaremetalPxePingServer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addBaremetalPxePingServer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addBaremetalPxePingServer", params, `<addbaremetalpxepingserverresponse><jobid>test-jobid</jobid><pingdir>test-pingdir</pingdir><pingstorageserverip>test-pingstorageserverip</pingstorageserverip><tftpdir>test-tftpdir</tftpdir></addbaremetalpxepingserverresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Baremetal.AddBaremetalPxePingServer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalPxePingServer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListBaremetalPxeServers(t *testing.T) {
+	params := url.Values{
+		"id":       {"1"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+	}
+	cs, done := newTestClient(t, "listBaremetalPxeServers", params, `{"listbaremetalpxeserversresponse":{"baremetalpxeserver":[{"id":"test-id","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","url":"test-url"}],"count":1}}`)
+	defer done()
+
+	p := cs.Baremetal.NewListBaremetalPxeServersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId(1)
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listBaremetalPxeServers", p.URLValues(), params)
+
+	r, err := cs.Baremetal.ListBaremetalPxeServers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listBaremetalPxeServers: %v", err)
+	}
+	if r.BaremetalPxeServers[0].Id != "test-id" {
+		t.Errorf("Expected r.BaremetalPxeServers[0].Id to be %v, got %v", "test-id", r.BaremetalPxeServers[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listBaremetalPxeServers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listBaremetalPxeServers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listBaremetalPxeServers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listBaremetalPxeServers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*BaremetalPxeServer
+	count, err := cs.Baremetal.StreamBaremetalPxeServers(context.Background(), p, func(v *BaremetalPxeServer) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listBaremetalPxeServers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.BaremetalPxeServers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.BaremetalPxeServers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listBaremetalPxeServers", params, `<listbaremetalpxeserversresponse><baremetalpxeserver><id>test-id</id><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><url>test-url</url></baremetalpxeserver><count>1</count></listbaremetalpxeserversresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Baremetal.ListBaremetalPxeServers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listBaremetalPxeServers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Baremetal.StreamBaremetalPxeServers(context.Background(), p, func(v *BaremetalPxeServer) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listBaremetalPxeServers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.BaremetalPxeServers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.BaremetalPxeServers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
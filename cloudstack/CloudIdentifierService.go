@@ -17,58 +17,48 @@
 package cloudstack
 
 import (
-	"encoding/json"
-	"net/url"
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type GetCloudIdentifierParams struct {
-	p map[string]interface{}
+type CloudIdentifierService struct {
+	cs *CloudStackClient
 }
 
-func (p *GetCloudIdentifierParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
-	}
-	return u
+func NewCloudIdentifierService(cs *CloudStackClient) *CloudIdentifierService {
+	return &CloudIdentifierService{cs: cs}
 }
 
-func (p *GetCloudIdentifierParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userid"] = v
-	return
-}
+type GetCloudIdentifierParams = cloudstackcommon.GetCloudIdentifierParams
+type GetCloudIdentifierResponse = cloudstackcommon.GetCloudIdentifierResponse
 
 // You should always use this function to get a new GetCloudIdentifierParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - userid
 func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams {
 	p := &GetCloudIdentifierParams{}
-	p.p = make(map[string]interface{})
-	p.p["userid"] = userid
+	p.SetUserid(userid)
 	return p
 }
 
 // Retrieves a cloud identifier.
 func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
-	resp, err := s.cs.newRequest("getCloudIdentifier", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("getCloudIdentifier", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetCloudIdentifierResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("getCloudIdentifier", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type GetCloudIdentifierResponse struct {
-	Cloudidentifier string `json:"cloudidentifier,omitempty"`
-	Signature       string `json:"signature,omitempty"`
-	Userid          string `json:"userid,omitempty"`
-}
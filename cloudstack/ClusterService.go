@@ -17,595 +17,191 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type AddClusterParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddClusterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
-	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
-	}
-	if v, found := p.p["guestvswitchname"]; found {
-		u.Set("guestvswitchname", v.(string))
-	}
-	if v, found := p.p["guestvswitchtype"]; found {
-		u.Set("guestvswitchtype", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["publicvswitchname"]; found {
-		u.Set("publicvswitchname", v.(string))
-	}
-	if v, found := p.p["publicvswitchtype"]; found {
-		u.Set("publicvswitchtype", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	if v, found := p.p["vsmipaddress"]; found {
-		u.Set("vsmipaddress", v.(string))
-	}
-	if v, found := p.p["vsmpassword"]; found {
-		u.Set("vsmpassword", v.(string))
-	}
-	if v, found := p.p["vsmusername"]; found {
-		u.Set("vsmusername", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *AddClusterParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
-}
-
-func (p *AddClusterParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustername"] = v
-	return
-}
-
-func (p *AddClusterParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
-	return
-}
-
-func (p *AddClusterParams) SetGuestvswitchname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvswitchname"] = v
-	return
-}
-
-func (p *AddClusterParams) SetGuestvswitchtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvswitchtype"] = v
-	return
-}
-
-func (p *AddClusterParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
-}
-
-func (p *AddClusterParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
-}
-
-func (p *AddClusterParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *AddClusterParams) SetPublicvswitchname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicvswitchname"] = v
-	return
-}
-
-func (p *AddClusterParams) SetPublicvswitchtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicvswitchtype"] = v
-	return
-}
-
-func (p *AddClusterParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
-
-func (p *AddClusterParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
 
-func (p *AddClusterParams) SetVsmipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vsmipaddress"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddClusterParams) SetVsmpassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vsmpassword"] = v
-	return
+type ClusterService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddClusterParams) SetVsmusername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vsmusername"] = v
-	return
+func NewClusterService(cs *CloudStackClient) *ClusterService {
+	return &ClusterService{cs: cs}
 }
 
-func (p *AddClusterParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type AddClusterParams = cloudstackcommon.AddClusterParams
+type AddClusterResponse = cloudstackcommon.AddClusterResponse
 
 // You should always use this function to get a new AddClusterParams instance,
 // as then you are sure you have configured all required params
-func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams {
+//
+// The required params are:
+//
+//   - clustername
+//   - clustertype
+//   - hypervisor: the hypervisor, like KVM, XenServer or VMware
+//   - podid: the ID of the pod
+//   - zoneid: the ID of the zone
+func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor HypervisorType, podid string, zoneid string) *AddClusterParams {
 	p := &AddClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clustername"] = clustername
-	p.p["clustertype"] = clustertype
-	p.p["hypervisor"] = hypervisor
-	p.p["podid"] = podid
-	p.p["zoneid"] = zoneid
+	p.SetClustername(clustername)
+	p.SetClustertype(clustertype)
+	p.SetHypervisor(hypervisor)
+	p.SetPodid(podid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Adds a new cluster
+//
+// See also ListClusters, GetClusterID, GetClusterByName, GetClusterByID.
 func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
-	resp, err := s.cs.newRequest("addCluster", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addCluster", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addCluster", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddClusterResponse struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Clustertype           string `json:"clustertype,omitempty"`
-	Cpuovercommitratio    string `json:"cpuovercommitratio,omitempty"`
-	Hypervisortype        string `json:"hypervisortype,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Managedstate          string `json:"managedstate,omitempty"`
-	Memoryovercommitratio string `json:"memoryovercommitratio,omitempty"`
-	Name                  string `json:"name,omitempty"`
-	Podid                 string `json:"podid,omitempty"`
-	Podname               string `json:"podname,omitempty"`
-	Zoneid                string `json:"zoneid,omitempty"`
-	Zonename              string `json:"zonename,omitempty"`
-}
-
-type DeleteClusterParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteClusterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DedicateClusterParams = cloudstackcommon.DedicateClusterParams
+type DedicateClusterResponse = cloudstackcommon.DedicateClusterResponse
 
-// You should always use this function to get a new DeleteClusterParams instance,
+// You should always use this function to get a new DedicateClusterParams instance,
 // as then you are sure you have configured all required params
-func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams {
-	p := &DeleteClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - clusterid: the ID of the cluster
+//   - domainid: the ID of the domain
+func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams {
+	p := &DedicateClusterParams{}
+	p.SetClusterid(clusterid)
+	p.SetDomainid(domainid)
 	return p
 }
 
-// Deletes a cluster.
-func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCluster", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Dedicate an existing cluster
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListClusters, GetClusterID, GetClusterByName, GetClusterByID.
+func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	var r DeleteClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequest("dedicateCluster", p.URLValues())
+	if err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
-
-type DeleteClusterResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type UpdateClusterParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateClusterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
-	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["managedstate"]; found {
-		u.Set("managedstate", v.(string))
-	}
-	return u
-}
 
-func (p *UpdateClusterParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
-}
-
-func (p *UpdateClusterParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DedicateClusterResponse
+	if err := s.cs.unmarshal("dedicateCluster", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["clustername"] = v
-	return
-}
 
-func (p *UpdateClusterParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *UpdateClusterParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
-}
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-func (p *UpdateClusterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("dedicateCluster", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
-func (p *UpdateClusterParams) SetManagedstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managedstate"] = v
-	return
-}
+type DeleteClusterParams = cloudstackcommon.DeleteClusterParams
+type DeleteClusterResponse = cloudstackcommon.DeleteClusterResponse
 
-// You should always use this function to get a new UpdateClusterParams instance,
+// You should always use this function to get a new DeleteClusterParams instance,
 // as then you are sure you have configured all required params
-func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams {
-	p := &UpdateClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams {
+	p := &DeleteClusterParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates an existing cluster
-func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
-	resp, err := s.cs.newRequest("updateCluster", p.toURLValues())
+// Deletes a cluster.
+//
+// See also ListClusters, GetClusterID, GetClusterByName, GetClusterByID.
+func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteCluster", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteClusterResponse
+	if err := s.cs.unmarshal("deleteCluster", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateClusterResponse struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Clustertype           string `json:"clustertype,omitempty"`
-	Cpuovercommitratio    string `json:"cpuovercommitratio,omitempty"`
-	Hypervisortype        string `json:"hypervisortype,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Managedstate          string `json:"managedstate,omitempty"`
-	Memoryovercommitratio string `json:"memoryovercommitratio,omitempty"`
-	Name                  string `json:"name,omitempty"`
-	Podid                 string `json:"podid,omitempty"`
-	Podname               string `json:"podname,omitempty"`
-	Zoneid                string `json:"zoneid,omitempty"`
-	Zonename              string `json:"zonename,omitempty"`
-}
-
-type ListClustersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListClustersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["clustertype"]; found {
-		u.Set("clustertype", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["managedstate"]; found {
-		u.Set("managedstate", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListClustersParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
-}
-
-func (p *ListClustersParams) SetClustertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustertype"] = v
-	return
-}
-
-func (p *ListClustersParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
-}
-
-func (p *ListClustersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListClustersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListClustersParams) SetManagedstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["managedstate"] = v
-	return
-}
-
-func (p *ListClustersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListClustersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListClustersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListClustersParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *ListClustersParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
-	return
-}
-
-func (p *ListClustersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListClustersParams = cloudstackcommon.ListClustersParams
+type ListClustersResponse = cloudstackcommon.ListClustersResponse
+type Cluster = cloudstackcommon.Cluster
 
 // You should always use this function to get a new ListClustersParams instance,
 // as then you are sure you have configured all required params
 func (s *ClusterService) NewListClustersParams() *ListClustersParams {
 	p := &ListClustersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ClusterService) GetClusterID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListClustersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListClusters(p)
 	if err != nil {
@@ -620,24 +216,37 @@ func (s *ClusterService) GetClusterID(name string) (string, error) {
 		return l.Clusters[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Clusters {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Cluster
+	for _, v := range l.Clusters {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Cluster", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ClusterService) GetClusterByName(name string) (*Cluster, int, error) {
-	id, err := s.GetClusterID(name)
+func (s *ClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error) {
+	id, err := s.GetClusterID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetClusterByID(id)
+	r, count, err := s.GetClusterByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -645,11 +254,16 @@ func (s *ClusterService) GetClusterByName(name string) (*Cluster, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ClusterService) GetClusterByID(id string) (*Cluster, int, error) {
+func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error) {
 	p := &ListClustersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListClusters(p)
 	if err != nil {
@@ -672,193 +286,166 @@ func (s *ClusterService) GetClusterByID(id string) (*Cluster, int, error) {
 }
 
 // Lists clusters.
+//
+// See also GetClusterID, GetClusterByName, GetClusterByID.
 func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
-	resp, err := s.cs.newRequest("listClusters", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listClusters", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListClustersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listClusters", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListClustersResponse struct {
-	Count    int        `json:"count"`
-	Clusters []*Cluster `json:"cluster"`
-}
+// Lists the objects like ListClusters, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ClusterService) StreamClusters(ctx context.Context, p *ListClustersParams, fn func(*Cluster) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type Cluster struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Clustertype           string `json:"clustertype,omitempty"`
-	Cpuovercommitratio    string `json:"cpuovercommitratio,omitempty"`
-	Hypervisortype        string `json:"hypervisortype,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Managedstate          string `json:"managedstate,omitempty"`
-	Memoryovercommitratio string `json:"memoryovercommitratio,omitempty"`
-	Name                  string `json:"name,omitempty"`
-	Podid                 string `json:"podid,omitempty"`
-	Podname               string `json:"podname,omitempty"`
-	Zoneid                string `json:"zoneid,omitempty"`
-	Zonename              string `json:"zonename,omitempty"`
+	return s.cs.streamList(ctx, "listClusters", p.URLValues(), "cluster", func(b json.RawMessage) error {
+		var v Cluster
+		if err := s.cs.unmarshalElement("listClusters", "cluster", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type DedicateClusterParams struct {
-	p map[string]interface{}
-}
+type UpdateClusterParams = cloudstackcommon.UpdateClusterParams
+type UpdateClusterResponse = cloudstackcommon.UpdateClusterResponse
 
-func (p *DedicateClusterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	return u
+// You should always use this function to get a new UpdateClusterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams {
+	p := &UpdateClusterParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *DedicateClusterParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Updates an existing cluster
+//
+// See also ListClusters, GetClusterID, GetClusterByName, GetClusterByID.
+func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["account"] = v
-	return
-}
 
-func (p *DedicateClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("updateCluster", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["clusterid"] = v
-	return
-}
 
-func (p *DedicateClusterParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateClusterResponse
+	if err := s.cs.unmarshal("updateCluster", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["domainid"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new DedicateClusterParams instance,
+type ListDedicatedClustersParams = cloudstackcommon.ListDedicatedClustersParams
+type ListDedicatedClustersResponse = cloudstackcommon.ListDedicatedClustersResponse
+type DedicatedCluster = cloudstackcommon.DedicatedCluster
+
+// You should always use this function to get a new ListDedicatedClustersParams instance,
 // as then you are sure you have configured all required params
-func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams {
-	p := &DedicateClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
-	p.p["domainid"] = domainid
+func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClustersParams {
+	p := &ListDedicatedClustersParams{}
 	return p
 }
 
-// Dedicate an existing cluster
-func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
-	resp, err := s.cs.newRequest("dedicateCluster", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Lists dedicated clusters.
+func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	var r DedicateClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequest("listDedicatedClusters", p.URLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r ListDedicatedClustersResponse
+	if err := s.cs.unmarshal("listDedicatedClusters", resp, &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
-type DedicateClusterResponse struct {
-	JobID           string `json:"jobid,omitempty"`
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Clusterid       string `json:"clusterid,omitempty"`
-	Clustername     string `json:"clustername,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Id              string `json:"id,omitempty"`
-}
-
-type ReleaseDedicatedClusterParams struct {
-	p map[string]interface{}
-}
-
-func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+// Lists the objects like ListDedicatedClusters, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ClusterService) StreamDedicatedClusters(ctx context.Context, p *ListDedicatedClustersParams, fn func(*DedicatedCluster) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
-}
 
-func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
+	return s.cs.streamList(ctx, "listDedicatedClusters", p.URLValues(), "dedicatedcluster", func(b json.RawMessage) error {
+		var v DedicatedCluster
+		if err := s.cs.unmarshalElement("listDedicatedClusters", "dedicatedcluster", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
+type ReleaseDedicatedClusterParams = cloudstackcommon.ReleaseDedicatedClusterParams
+type ReleaseDedicatedClusterResponse = cloudstackcommon.ReleaseDedicatedClusterResponse
+
 // You should always use this function to get a new ReleaseDedicatedClusterParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - clusterid: the ID of the cluster
 func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams {
 	p := &ReleaseDedicatedClusterParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
+	p.SetClusterid(clusterid)
 	return p
 }
 
 // Release the dedication for cluster
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListDedicatedClusters.
 func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedCluster", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("releaseDedicatedCluster", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("releaseDedicatedCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -874,142 +461,9 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("releaseDedicatedCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type ReleaseDedicatedClusterResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListDedicatedClustersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDedicatedClustersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListDedicatedClustersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
-	return
-}
-
-func (p *ListDedicatedClustersParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
-}
-
-func (p *ListDedicatedClustersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListDedicatedClustersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListDedicatedClustersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListDedicatedClustersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListDedicatedClustersParams instance,
-// as then you are sure you have configured all required params
-func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClustersParams {
-	p := &ListDedicatedClustersParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Lists dedicated clusters.
-func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedClusters", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListDedicatedClustersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListDedicatedClustersResponse struct {
-	Count             int                 `json:"count"`
-	DedicatedClusters []*DedicatedCluster `json:"dedicatedcluster"`
-}
-
-type DedicatedCluster struct {
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Clusterid       string `json:"clusterid,omitempty"`
-	Clustername     string `json:"clustername,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Id              string `json:"id,omitempty"`
-}
@@ -0,0 +1,332 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateDiskOffering(t *testing.T) {
+	params := url.Values{
+		"bytesreadrate":             {"1"},
+		"byteswriterate":            {"2"},
+		"customized":                {"true"},
+		"customizediops":            {"true"},
+		"disksize":                  {"5"},
+		"displayoffering":           {"true"},
+		"displaytext":               {"test-displaytext"},
+		"domainid":                  {"test-domainid"},
+		"hypervisorsnapshotreserve": {"9"},
+		"iopsreadrate":              {"10"},
+		"iopswriterate":             {"11"},
+		"maxiops":                   {"12"},
+		"miniops":                   {"13"},
+		"name":                      {"test-name"},
+		"storagetype":               {"test-storagetype"},
+		"tags":                      {"test-tags"},
+	}
+	cs, done := newTestClient(t, "createDiskOffering", params, `{"creatediskofferingresponse":{"cacheMode":"test-cacheMode","created":"2014-01-02T15:04:05+0100","diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"disksize":1,"displayoffering":true,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","hypervisorsnapshotreserve":1,"id":"test-id","iscustomized":true,"iscustomizediops":true,"maxiops":1,"miniops":1,"name":"test-name","storagetype":"test-storagetype","tags":"test-tags"}}`)
+	defer done()
+
+	p := cs.DiskOffering.NewCreateDiskOfferingParams("test-displaytext", "test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBytesreadrate(1)
+	p.SetByteswriterate(2)
+	p.SetCustomized(true)
+	p.SetCustomizediops(true)
+	p.SetDisksize(5)
+	p.SetDisplayoffering(true)
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetHypervisorsnapshotreserve(9)
+	p.SetIopsreadrate(10)
+	p.SetIopswriterate(11)
+	p.SetMaxiops(12)
+	p.SetMiniops(13)
+	p.SetName("test-name")
+	p.SetStoragetype("test-storagetype")
+	p.SetTags("test-tags")
+	checkURLValues(t, "createDiskOffering", p.URLValues(), params)
+
+	r, err := cs.DiskOffering.CreateDiskOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createDiskOffering: %v", err)
+	}
+	if r.CacheMode != "test-cacheMode" {
+		t.Errorf("Expected r.CacheMode to be %v, got %v", "test-cacheMode", r.CacheMode)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createDiskOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createDiskOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createDiskOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createDiskOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createDiskOffering", params, `<creatediskofferingresponse><cacheMode>test-cacheMode</cacheMode><created>2014-01-02T15:04:05+0100</created><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><disksize>1</disksize><displayoffering>true</displayoffering><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisorsnapshotreserve>1</hypervisorsnapshotreserve><id>test-id</id><iscustomized>true</iscustomized><iscustomizediops>true</iscustomizediops><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><storagetype>test-storagetype</storagetype><tags>test-tags</tags></creatediskofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.DiskOffering.CreateDiskOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createDiskOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteDiskOffering(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteDiskOffering", params, `{"deletediskofferingresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.DiskOffering.NewDeleteDiskOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteDiskOffering", p.URLValues(), params)
+
+	r, err := cs.DiskOffering.DeleteDiskOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteDiskOffering: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteDiskOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteDiskOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteDiskOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteDiskOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteDiskOffering", params, `<deletediskofferingresponse><displaytext>test-displaytext</displaytext><success>true</success></deletediskofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.DiskOffering.DeleteDiskOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteDiskOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListDiskOfferings(t *testing.T) {
+	params := url.Values{
+		"domainid": {"test-domainid"},
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"5"},
+		"pagesize": {"6"},
+	}
+	cs, done := newTestClient(t, "listDiskOfferings", params, `{"listdiskofferingsresponse":{"count":1,"diskoffering":[{"cacheMode":"test-cacheMode","created":"2014-01-02T15:04:05+0100","diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"disksize":1,"displayoffering":true,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","hypervisorsnapshotreserve":1,"id":"test-id","iscustomized":true,"iscustomizediops":true,"maxiops":1,"miniops":1,"name":"test-name","storagetype":"test-storagetype","tags":"test-tags"}]}}`)
+	defer done()
+
+	p := cs.DiskOffering.NewListDiskOfferingsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listDiskOfferings", p.URLValues(), params)
+
+	r, err := cs.DiskOffering.ListDiskOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDiskOfferings: %v", err)
+	}
+	if r.DiskOfferings[0].CacheMode != "test-cacheMode" {
+		t.Errorf("Expected r.DiskOfferings[0].CacheMode to be %v, got %v", "test-cacheMode", r.DiskOfferings[0].CacheMode)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDiskOfferings" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDiskOfferings: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDiskOfferings: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDiskOfferings: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DiskOffering
+	count, err := cs.DiskOffering.StreamDiskOfferings(context.Background(), p, func(v *DiskOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDiskOfferings: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DiskOfferings) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DiskOfferings)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDiskOfferings", params, `<listdiskofferingsresponse><count>1</count><diskoffering><cacheMode>test-cacheMode</cacheMode><created>2014-01-02T15:04:05+0100</created><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><disksize>1</disksize><displayoffering>true</displayoffering><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisorsnapshotreserve>1</hypervisorsnapshotreserve><id>test-id</id><iscustomized>true</iscustomized><iscustomizediops>true</iscustomizediops><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><storagetype>test-storagetype</storagetype><tags>test-tags</tags></diskoffering></listdiskofferingsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.DiskOffering.ListDiskOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDiskOfferings using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.DiskOffering.StreamDiskOfferings(context.Background(), p, func(v *DiskOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDiskOfferings using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DiskOfferings) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DiskOfferings)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateDiskOffering(t *testing.T) {
+	params := url.Values{
+		"displayoffering": {"true"},
+		"displaytext":     {"test-displaytext"},
+		"id":              {"test-id"},
+		"name":            {"test-name"},
+		"sortkey":         {"5"},
+	}
+	cs, done := newTestClient(t, "updateDiskOffering", params, `{"updatediskofferingresponse":{"cacheMode":"test-cacheMode","created":"2014-01-02T15:04:05+0100","diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"disksize":1,"displayoffering":true,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","hypervisorsnapshotreserve":1,"id":"test-id","iscustomized":true,"iscustomizediops":true,"maxiops":1,"miniops":1,"name":"test-name","storagetype":"test-storagetype","tags":"test-tags"}}`)
+	defer done()
+
+	p := cs.DiskOffering.NewUpdateDiskOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDisplayoffering(true)
+	p.SetDisplaytext("test-displaytext")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetSortkey(5)
+	checkURLValues(t, "updateDiskOffering", p.URLValues(), params)
+
+	r, err := cs.DiskOffering.UpdateDiskOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateDiskOffering: %v", err)
+	}
+	if r.CacheMode != "test-cacheMode" {
+		t.Errorf("Expected r.CacheMode to be %v, got %v", "test-cacheMode", r.CacheMode)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateDiskOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateDiskOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateDiskOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateDiskOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateDiskOffering", params, `<updatediskofferingresponse><cacheMode>test-cacheMode</cacheMode><created>2014-01-02T15:04:05+0100</created><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><disksize>1</disksize><displayoffering>true</displayoffering><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisorsnapshotreserve>1</hypervisorsnapshotreserve><id>test-id</id><iscustomized>true</iscustomized><iscustomizediops>true</iscustomizediops><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><storagetype>test-storagetype</storagetype><tags>test-tags</tags></updatediskofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.DiskOffering.UpdateDiskOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateDiskOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
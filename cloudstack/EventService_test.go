@@ -0,0 +1,347 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArchiveEvents(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+	cs, done := newTestClient(t, "archiveEvents", params, `{"archiveeventsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Event.NewArchiveEventsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	checkURLValues(t, "archiveEvents", p.URLValues(), params)
+
+	r, err := cs.Event.ArchiveEvents(p)
+	if err != nil {
+		t.Fatalf("Failed to call archiveEvents: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "archiveEvents" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute archiveEvents: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of archiveEvents: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of archiveEvents: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "archiveEvents", params, `<archiveeventsresponse><displaytext>test-displaytext</displaytext><success>true</success></archiveeventsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Event.ArchiveEvents(p)
+	if err != nil {
+		t.Fatalf("Failed to call archiveEvents using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteEvents(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+	cs, done := newTestClient(t, "deleteEvents", params, `{"deleteeventsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Event.NewDeleteEventsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	checkURLValues(t, "deleteEvents", p.URLValues(), params)
+
+	r, err := cs.Event.DeleteEvents(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteEvents: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteEvents" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteEvents: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteEvents: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteEvents: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteEvents", params, `<deleteeventsresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteeventsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Event.DeleteEvents(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteEvents using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"duration":    {"3"},
+		"enddate":     {"test-enddate"},
+		"entrytime":   {"5"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"level":       {"test-level"},
+		"listall":     {"true"},
+		"page":        {"11"},
+		"pagesize":    {"12"},
+		"projectid":   {"test-projectid"},
+		"startdate":   {"test-startdate"},
+		"type":        {"test-type"},
+	}
+	cs, done := newTestClient(t, "listEvents", params, `{"listeventsresponse":{"count":1,"event":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","level":"test-level","parentid":"test-parentid","project":"test-project","projectid":"test-projectid","state":"test-state","type":"test-type","username":"test-username"}]}}`)
+	defer done()
+
+	p := cs.Event.NewListEventsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetDuration(3)
+	p.SetEnddate("test-enddate")
+	p.SetEntrytime(5)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetLevel("test-level")
+	p.SetListall(true)
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetProjectid("test-projectid")
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	checkURLValues(t, "listEvents", p.URLValues(), params)
+
+	r, err := cs.Event.ListEvents(p)
+	if err != nil {
+		t.Fatalf("Failed to call listEvents: %v", err)
+	}
+	if r.Events[0].Account != "test-account" {
+		t.Errorf("Expected r.Events[0].Account to be %v, got %v", "test-account", r.Events[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listEvents" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listEvents: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listEvents: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listEvents: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Event
+	count, err := cs.Event.StreamEvents(context.Background(), p, func(v *Event) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listEvents: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Events) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Events)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listEvents", params, `<listeventsresponse><count>1</count><event><account>test-account</account><created>2014-01-02T15:04:05+0100</created><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><level>test-level</level><parentid>test-parentid</parentid><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><type>test-type</type><username>test-username</username></event></listeventsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Event.ListEvents(p)
+	if err != nil {
+		t.Fatalf("Failed to call listEvents using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Event.StreamEvents(context.Background(), p, func(v *Event) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listEvents using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Events) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Events)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListEventTypes(t *testing.T) {
+	params := url.Values{}
+	cs, done := newTestClient(t, "listEventTypes", params, `{"listeventtypesresponse":{"count":1,"eventtype":[{"name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.Event.NewListEventTypesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listEventTypes", p.URLValues(), params)
+
+	r, err := cs.Event.ListEventTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listEventTypes: %v", err)
+	}
+	if r.EventTypes[0].Name != "test-name" {
+		t.Errorf("Expected r.EventTypes[0].Name to be %v, got %v", "test-name", r.EventTypes[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listEventTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listEventTypes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listEventTypes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listEventTypes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*EventType
+	count, err := cs.Event.StreamEventTypes(context.Background(), p, func(v *EventType) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listEventTypes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.EventTypes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.EventTypes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listEventTypes", params, `<listeventtypesresponse><count>1</count><eventtype><name>test-name</name></eventtype></listeventtypesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Event.ListEventTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listEventTypes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Event.StreamEventTypes(context.Background(), p, func(v *EventType) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listEventTypes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.EventTypes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.EventTypes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
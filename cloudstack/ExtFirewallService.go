@@ -0,0 +1,529 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ExtFirewallService struct {
+	cs *CloudStackClient
+}
+
+func NewExtFirewallService(cs *CloudStackClient) *ExtFirewallService {
+	return &ExtFirewallService{cs: cs}
+}
+
+type AddExternalFirewallParams struct {
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Url      *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddExternalFirewallParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddExternalFirewallParams) Command() string {
+	return "addExternalFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddExternalFirewallParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddExternalFirewallParams) ResponseType() interface{} {
+	return &AddExternalFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddExternalFirewallParams) Validate() error {
+	v := &validator{api: "addExternalFirewall"}
+	v.required("password", p.Password != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddExternalFirewallParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddExternalFirewallParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddExternalFirewallParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddExternalFirewallParams) ResetPassword() {
+	p.Password = nil
+}
+
+// This param is required.
+func (p *AddExternalFirewallParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddExternalFirewallParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddExternalFirewallParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddExternalFirewallParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddExternalFirewallParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddExternalFirewallParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddExternalFirewallParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddExternalFirewallParams) ResetUsername() {
+	p.Username = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddExternalFirewallParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddExternalFirewallParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddExternalFirewallParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddExternalFirewallParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new AddExternalFirewallParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - password: the password
+//   - url
+//   - username: the name of the user
+//   - zoneid: the ID of the zone
+func (s *ExtFirewallService) NewAddExternalFirewallParams(password string, url string, username string, zoneid string) *AddExternalFirewallParams {
+	p := &AddExternalFirewallParams{}
+	p.SetPassword(password)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Adds an external firewall appliance
+//
+// Only supported by CloudStack 4.3.
+// See also ListExternalFirewalls.
+func (s *ExtFirewallService) AddExternalFirewall(p *AddExternalFirewallParams) (*AddExternalFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addExternalFirewall", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddExternalFirewallResponse
+	if err := s.cs.unmarshal("addExternalFirewall", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type AddExternalFirewallResponse struct {
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries       string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Privateinterface string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone      string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Publicinterface  string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone       string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout          string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface   string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username         string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteExternalFirewallParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteExternalFirewallParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteExternalFirewallParams) Command() string {
+	return "deleteExternalFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteExternalFirewallParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteExternalFirewallParams) ResponseType() interface{} {
+	return &DeleteExternalFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteExternalFirewallParams) Validate() error {
+	v := &validator{api: "deleteExternalFirewall"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteExternalFirewallParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteExternalFirewallParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteExternalFirewallParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteExternalFirewallParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new DeleteExternalFirewallParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ExtFirewallService) NewDeleteExternalFirewallParams(id string) *DeleteExternalFirewallParams {
+	p := &DeleteExternalFirewallParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes an external firewall appliance.
+//
+// Only supported by CloudStack 4.3.
+// See also ListExternalFirewalls.
+func (s *ExtFirewallService) DeleteExternalFirewall(p *DeleteExternalFirewallParams) (*DeleteExternalFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteExternalFirewall", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteExternalFirewallResponse
+	if err := s.cs.unmarshal("deleteExternalFirewall", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type DeleteExternalFirewallResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListExternalFirewallsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListExternalFirewallsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListExternalFirewallsParams) Command() string {
+	return "listExternalFirewalls"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListExternalFirewallsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListExternalFirewallsParams) ResponseType() interface{} {
+	return &ListExternalFirewallsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListExternalFirewallsParams) Validate() error {
+	v := &validator{api: "listExternalFirewalls"}
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListExternalFirewallsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListExternalFirewallsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListExternalFirewallsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListExternalFirewallsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListExternalFirewallsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListExternalFirewallsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListExternalFirewallsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListExternalFirewallsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListExternalFirewallsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListExternalFirewallsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListExternalFirewallsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListExternalFirewallsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *ListExternalFirewallsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListExternalFirewallsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListExternalFirewallsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListExternalFirewallsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListExternalFirewallsParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - zoneid: the ID of the zone
+func (s *ExtFirewallService) NewListExternalFirewallsParams(zoneid string) *ListExternalFirewallsParams {
+	p := &ListExternalFirewallsParams{}
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// List external firewall appliances.
+//
+// Only supported by CloudStack 4.3.
+func (s *ExtFirewallService) ListExternalFirewalls(p *ListExternalFirewallsParams) (*ListExternalFirewallsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listExternalFirewalls", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListExternalFirewallsResponse
+	if err := s.cs.unmarshal("listExternalFirewalls", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListExternalFirewalls, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ExtFirewallService) StreamExternalFirewalls(ctx context.Context, p *ListExternalFirewallsParams, fn func(*ExternalFirewall) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listExternalFirewalls", p.URLValues(), "externalfirewall", func(b json.RawMessage) error {
+		var v ExternalFirewall
+		if err := s.cs.unmarshalElement("listExternalFirewalls", "externalfirewall", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListExternalFirewallsResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	ExternalFirewalls []*ExternalFirewall        `json:"externalfirewall" xml:"externalfirewall"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExternalFirewall struct {
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries       string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Privateinterface string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone      string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Publicinterface  string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone       string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout          string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface   string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username         string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
@@ -0,0 +1,238 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddExternalFirewall(t *testing.T) {
+	params := url.Values{
+		"password": {"test-password"},
+		"url":      {"test-url"},
+		"username": {"test-username"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addExternalFirewall", params, `{"addexternalfirewallresponse":{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","privateinterface":"test-privateinterface","privatezone":"test-privatezone","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.ExtFirewall.NewAddExternalFirewallParams("test-password", "test-url", "test-username", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetPassword("test-password")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addExternalFirewall", p.URLValues(), params)
+
+	r, err := cs.ExtFirewall.AddExternalFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call addExternalFirewall: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addExternalFirewall" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addExternalFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addExternalFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addExternalFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addExternalFirewall", params, `<addexternalfirewallresponse><id>test-id</id><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></addexternalfirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExtFirewall.AddExternalFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call addExternalFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteExternalFirewall(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteExternalFirewall", params, `{"deleteexternalfirewallresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ExtFirewall.NewDeleteExternalFirewallParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteExternalFirewall", p.URLValues(), params)
+
+	r, err := cs.ExtFirewall.DeleteExternalFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteExternalFirewall: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteExternalFirewall" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteExternalFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteExternalFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteExternalFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteExternalFirewall", params, `<deleteexternalfirewallresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteexternalfirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExtFirewall.DeleteExternalFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteExternalFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListExternalFirewalls(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listExternalFirewalls", params, `{"listexternalfirewallsresponse":{"count":1,"externalfirewall":[{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","privateinterface":"test-privateinterface","privatezone":"test-privatezone","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.ExtFirewall.NewListExternalFirewallsParams("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listExternalFirewalls", p.URLValues(), params)
+
+	r, err := cs.ExtFirewall.ListExternalFirewalls(p)
+	if err != nil {
+		t.Fatalf("Failed to call listExternalFirewalls: %v", err)
+	}
+	if r.ExternalFirewalls[0].Id != "test-id" {
+		t.Errorf("Expected r.ExternalFirewalls[0].Id to be %v, got %v", "test-id", r.ExternalFirewalls[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listExternalFirewalls" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listExternalFirewalls: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listExternalFirewalls: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listExternalFirewalls: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ExternalFirewall
+	count, err := cs.ExtFirewall.StreamExternalFirewalls(context.Background(), p, func(v *ExternalFirewall) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listExternalFirewalls: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ExternalFirewalls) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ExternalFirewalls)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listExternalFirewalls", params, `<listexternalfirewallsresponse><count>1</count><externalfirewall><id>test-id</id><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></externalfirewall></listexternalfirewallsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExtFirewall.ListExternalFirewalls(p)
+	if err != nil {
+		t.Fatalf("Failed to call listExternalFirewalls using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ExtFirewall.StreamExternalFirewalls(context.Background(), p, func(v *ExternalFirewall) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listExternalFirewalls using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ExternalFirewalls) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ExternalFirewalls)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
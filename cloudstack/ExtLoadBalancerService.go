@@ -0,0 +1,605 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type ExtLoadBalancerService struct {
+	cs *CloudStackClient
+}
+
+func NewExtLoadBalancerService(cs *CloudStackClient) *ExtLoadBalancerService {
+	return &ExtLoadBalancerService{cs: cs}
+}
+
+type AddExternalLoadBalancerParams struct {
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Url      *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddExternalLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddExternalLoadBalancerParams) Command() string {
+	return "addExternalLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddExternalLoadBalancerParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddExternalLoadBalancerParams) ResponseType() interface{} {
+	return &AddExternalLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddExternalLoadBalancerParams) Validate() error {
+	v := &validator{api: "addExternalLoadBalancer"}
+	v.required("password", p.Password != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddExternalLoadBalancerParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddExternalLoadBalancerParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddExternalLoadBalancerParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddExternalLoadBalancerParams) ResetPassword() {
+	p.Password = nil
+}
+
+// This param is required.
+func (p *AddExternalLoadBalancerParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddExternalLoadBalancerParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddExternalLoadBalancerParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddExternalLoadBalancerParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddExternalLoadBalancerParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddExternalLoadBalancerParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddExternalLoadBalancerParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddExternalLoadBalancerParams) ResetUsername() {
+	p.Username = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddExternalLoadBalancerParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddExternalLoadBalancerParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddExternalLoadBalancerParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddExternalLoadBalancerParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new AddExternalLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - password: the password
+//   - url
+//   - username: the name of the user
+//   - zoneid: the ID of the zone
+func (s *ExtLoadBalancerService) NewAddExternalLoadBalancerParams(password string, url string, username string, zoneid string) *AddExternalLoadBalancerParams {
+	p := &AddExternalLoadBalancerParams{}
+	p.SetPassword(password)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Adds F5 external load balancer appliance.
+//
+// Only supported by CloudStack 4.3.
+// See also ListExternalLoadBalancers, GetExternalLoadBalancerID.
+func (s *ExtLoadBalancerService) AddExternalLoadBalancer(p *AddExternalLoadBalancerParams) (*AddExternalLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addExternalLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddExternalLoadBalancerResponse
+	if err := s.cs.unmarshal("addExternalLoadBalancer", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type AddExternalLoadBalancerResponse struct {
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries       string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Privateinterface string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Publicinterface  string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Username         string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteExternalLoadBalancerParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteExternalLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteExternalLoadBalancerParams) Command() string {
+	return "deleteExternalLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteExternalLoadBalancerParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteExternalLoadBalancerParams) ResponseType() interface{} {
+	return &DeleteExternalLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteExternalLoadBalancerParams) Validate() error {
+	v := &validator{api: "deleteExternalLoadBalancer"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteExternalLoadBalancerParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteExternalLoadBalancerParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteExternalLoadBalancerParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteExternalLoadBalancerParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new DeleteExternalLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ExtLoadBalancerService) NewDeleteExternalLoadBalancerParams(id string) *DeleteExternalLoadBalancerParams {
+	p := &DeleteExternalLoadBalancerParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a F5 external load balancer appliance added in a zone.
+//
+// Only supported by CloudStack 4.3.
+// See also ListExternalLoadBalancers, GetExternalLoadBalancerID.
+func (s *ExtLoadBalancerService) DeleteExternalLoadBalancer(p *DeleteExternalLoadBalancerParams) (*DeleteExternalLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteExternalLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteExternalLoadBalancerResponse
+	if err := s.cs.unmarshal("deleteExternalLoadBalancer", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type DeleteExternalLoadBalancerResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListExternalLoadBalancersParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListExternalLoadBalancersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListExternalLoadBalancersParams) Command() string {
+	return "listExternalLoadBalancers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListExternalLoadBalancersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListExternalLoadBalancersParams) ResponseType() interface{} {
+	return &ListExternalLoadBalancersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListExternalLoadBalancersParams) Validate() error {
+	v := &validator{api: "listExternalLoadBalancers"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListExternalLoadBalancersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListExternalLoadBalancersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListExternalLoadBalancersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListExternalLoadBalancersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListExternalLoadBalancersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListExternalLoadBalancersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListExternalLoadBalancersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListExternalLoadBalancersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListExternalLoadBalancersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListExternalLoadBalancersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListExternalLoadBalancersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListExternalLoadBalancersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListExternalLoadBalancersParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListExternalLoadBalancersParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListExternalLoadBalancersParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListExternalLoadBalancersParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListExternalLoadBalancersParams instance,
+// as then you are sure you have configured all required params
+func (s *ExtLoadBalancerService) NewListExternalLoadBalancersParams() *ListExternalLoadBalancersParams {
+	p := &ListExternalLoadBalancersParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ExtLoadBalancerService) GetExternalLoadBalancerID(keyword string, opts ...OptionFunc) (string, error) {
+	p := &ListExternalLoadBalancersParams{}
+
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListExternalLoadBalancers(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.ExternalLoadBalancers[0].Id, nil
+	}
+
+	var matches []*ExternalLoadBalancer
+	for _, v := range l.ExternalLoadBalancers {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "ExternalLoadBalancer", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// Lists F5 external load balancer appliances added in a zone.
+//
+// Only supported by CloudStack 4.3.
+// See also GetExternalLoadBalancerID.
+func (s *ExtLoadBalancerService) ListExternalLoadBalancers(p *ListExternalLoadBalancersParams) (*ListExternalLoadBalancersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listExternalLoadBalancers", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListExternalLoadBalancersResponse
+	if err := s.cs.unmarshal("listExternalLoadBalancers", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListExternalLoadBalancers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ExtLoadBalancerService) StreamExternalLoadBalancers(ctx context.Context, p *ListExternalLoadBalancersParams, fn func(*ExternalLoadBalancer) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listExternalLoadBalancers", p.URLValues(), "externalloadbalancer", func(b json.RawMessage) error {
+		var v ExternalLoadBalancer
+		if err := s.cs.unmarshalElement("listExternalLoadBalancers", "externalloadbalancer", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListExternalLoadBalancersResponse struct {
+	Count                 int                        `json:"count" xml:"count"`
+	ExternalLoadBalancers []*ExternalLoadBalancer    `json:"externalloadbalancer" xml:"externalloadbalancer"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExternalLoadBalancer struct {
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
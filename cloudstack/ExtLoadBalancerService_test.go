@@ -0,0 +1,238 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddExternalLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"password": {"test-password"},
+		"url":      {"test-url"},
+		"username": {"test-username"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addExternalLoadBalancer", params, `{"addexternalloadbalancerresponse":{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","privateinterface":"test-privateinterface","publicinterface":"test-publicinterface","username":"test-username","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.ExtLoadBalancer.NewAddExternalLoadBalancerParams("test-password", "test-url", "test-username", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetPassword("test-password")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addExternalLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.ExtLoadBalancer.AddExternalLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addExternalLoadBalancer: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addExternalLoadBalancer" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addExternalLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addExternalLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addExternalLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addExternalLoadBalancer", params, `<addexternalloadbalancerresponse><id>test-id</id><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><privateinterface>test-privateinterface</privateinterface><publicinterface>test-publicinterface</publicinterface><username>test-username</username><zoneid>test-zoneid</zoneid></addexternalloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExtLoadBalancer.AddExternalLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addExternalLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteExternalLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteExternalLoadBalancer", params, `{"deleteexternalloadbalancerresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ExtLoadBalancer.NewDeleteExternalLoadBalancerParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteExternalLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.ExtLoadBalancer.DeleteExternalLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteExternalLoadBalancer: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteExternalLoadBalancer" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteExternalLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteExternalLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteExternalLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteExternalLoadBalancer", params, `<deleteexternalloadbalancerresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteexternalloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExtLoadBalancer.DeleteExternalLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteExternalLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListExternalLoadBalancers(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listExternalLoadBalancers", params, `{"listexternalloadbalancersresponse":{"count":1,"externalloadbalancer":[{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.ExtLoadBalancer.NewListExternalLoadBalancersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listExternalLoadBalancers", p.URLValues(), params)
+
+	r, err := cs.ExtLoadBalancer.ListExternalLoadBalancers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listExternalLoadBalancers: %v", err)
+	}
+	if r.ExternalLoadBalancers[0].Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.ExternalLoadBalancers[0].Capabilities to be %v, got %v", "test-capabilities", r.ExternalLoadBalancers[0].Capabilities)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listExternalLoadBalancers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listExternalLoadBalancers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listExternalLoadBalancers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listExternalLoadBalancers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ExternalLoadBalancer
+	count, err := cs.ExtLoadBalancer.StreamExternalLoadBalancers(context.Background(), p, func(v *ExternalLoadBalancer) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listExternalLoadBalancers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ExternalLoadBalancers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ExternalLoadBalancers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listExternalLoadBalancers", params, `<listexternalloadbalancersresponse><count>1</count><externalloadbalancer><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></externalloadbalancer></listexternalloadbalancersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExtLoadBalancer.ListExternalLoadBalancers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listExternalLoadBalancers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ExtLoadBalancer.StreamExternalLoadBalancers(context.Background(), p, func(v *ExternalLoadBalancer) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listExternalLoadBalancers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ExternalLoadBalancers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ExternalLoadBalancers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,719 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddCiscoAsa1000vResource(t *testing.T) {
+	params := url.Values{
+		"clusterid":         {"test-clusterid"},
+		"hostname":          {"test-hostname"},
+		"insideportprofile": {"test-insideportprofile"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "addCiscoAsa1000vResource", params, `{"addciscoasa1000vresourceresponse":{}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewAddCiscoAsa1000vResourceParams("test-clusterid", "test-hostname", "test-insideportprofile", "test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	p.SetHostname("test-hostname")
+	p.SetInsideportprofile("test-insideportprofile")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "addCiscoAsa1000vResource", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.AddCiscoAsa1000vResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call addCiscoAsa1000vResource: %v", err)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addCiscoAsa1000vResource" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addCiscoAsa1000vResource: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addCiscoAsa1000vResource: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addCiscoAsa1000vResource: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addCiscoAsa1000vResource", params, `<addciscoasa1000vresourceresponse></addciscoasa1000vresourceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.AddCiscoAsa1000vResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call addCiscoAsa1000vResource using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteCiscoAsa1000vResource(t *testing.T) {
+	params := url.Values{
+		"resourceid": {"test-resourceid"},
+	}
+	cs, done := newTestClient(t, "deleteCiscoAsa1000vResource", params, `{"deleteciscoasa1000vresourceresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewDeleteCiscoAsa1000vResourceParams("test-resourceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetResourceid("test-resourceid")
+	checkURLValues(t, "deleteCiscoAsa1000vResource", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.DeleteCiscoAsa1000vResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCiscoAsa1000vResource: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCiscoAsa1000vResource" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteCiscoAsa1000vResource: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteCiscoAsa1000vResource: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteCiscoAsa1000vResource: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteCiscoAsa1000vResource", params, `<deleteciscoasa1000vresourceresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteciscoasa1000vresourceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.DeleteCiscoAsa1000vResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCiscoAsa1000vResource using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListCiscoAsa1000vResources(t *testing.T) {
+	params := url.Values{
+		"hostname":          {"test-hostname"},
+		"keyword":           {"test-keyword"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"resourceid":        {"test-resourceid"},
+	}
+	cs, done := newTestClient(t, "listCiscoAsa1000vResources", params, `{"listciscoasa1000vresourcesresponse":{"ciscoasa1000vresource":[{}],"count":1}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewListCiscoAsa1000vResourcesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetResourceid("test-resourceid")
+	checkURLValues(t, "listCiscoAsa1000vResources", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.ListCiscoAsa1000vResources(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCiscoAsa1000vResources: %v", err)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listCiscoAsa1000vResources" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listCiscoAsa1000vResources: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listCiscoAsa1000vResources: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listCiscoAsa1000vResources: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*CiscoAsa1000vResource
+	count, err := cs.ExternalDevice.StreamCiscoAsa1000vResources(context.Background(), p, func(v *CiscoAsa1000vResource) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listCiscoAsa1000vResources: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.CiscoAsa1000vResources) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.CiscoAsa1000vResources)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listCiscoAsa1000vResources", params, `<listciscoasa1000vresourcesresponse><ciscoasa1000vresource></ciscoasa1000vresource><count>1</count></listciscoasa1000vresourcesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.ListCiscoAsa1000vResources(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCiscoAsa1000vResources using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ExternalDevice.StreamCiscoAsa1000vResources(context.Background(), p, func(v *CiscoAsa1000vResource) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listCiscoAsa1000vResources using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.CiscoAsa1000vResources) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.CiscoAsa1000vResources)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteCiscoNexusVSM(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteCiscoNexusVSM", params, `{"deletecisconexusvsmresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewDeleteCiscoNexusVSMParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteCiscoNexusVSM", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.DeleteCiscoNexusVSM(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCiscoNexusVSM: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCiscoNexusVSM" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteCiscoNexusVSM: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteCiscoNexusVSM: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteCiscoNexusVSM: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteCiscoNexusVSM", params, `<deletecisconexusvsmresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletecisconexusvsmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.DeleteCiscoNexusVSM(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCiscoNexusVSM using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisableCiscoNexusVSM(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "disableCiscoNexusVSM", params, `{"disablecisconexusvsmresponse":{"ipaddress":"test-ipaddress","jobid":"test-jobid","vsmconfigmode":"test-vsmconfigmode","vsmconfigstate":"test-vsmconfigstate","vsmctrlvlanid":1,"vsmdeviceid":"test-vsmdeviceid","vsmdevicename":"test-vsmdevicename","vsmdevicestate":"test-vsmdevicestate","vsmdomainid":"test-vsmdomainid","vsmmgmtvlanid":"test-vsmmgmtvlanid","vsmpktvlanid":1,"vsmstoragevlanid":1}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewDisableCiscoNexusVSMParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "disableCiscoNexusVSM", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.DisableCiscoNexusVSM(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableCiscoNexusVSM: %v", err)
+	}
+	if r.Ipaddress != "test-ipaddress" {
+		t.Errorf("Expected r.Ipaddress to be %v, got %v", "test-ipaddress", r.Ipaddress)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disableCiscoNexusVSM" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disableCiscoNexusVSM: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disableCiscoNexusVSM: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disableCiscoNexusVSM: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disableCiscoNexusVSM", params, `<disablecisconexusvsmresponse><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><vsmconfigmode>test-vsmconfigmode</vsmconfigmode><vsmconfigstate>test-vsmconfigstate</vsmconfigstate><vsmctrlvlanid>1</vsmctrlvlanid><vsmdeviceid>test-vsmdeviceid</vsmdeviceid><vsmdevicename>test-vsmdevicename</vsmdevicename><vsmdevicestate>test-vsmdevicestate</vsmdevicestate><vsmdomainid>test-vsmdomainid</vsmdomainid><vsmmgmtvlanid>test-vsmmgmtvlanid</vsmmgmtvlanid><vsmpktvlanid>1</vsmpktvlanid><vsmstoragevlanid>1</vsmstoragevlanid></disablecisconexusvsmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.DisableCiscoNexusVSM(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableCiscoNexusVSM using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestEnableCiscoNexusVSM(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "enableCiscoNexusVSM", params, `{"enablecisconexusvsmresponse":{"ipaddress":"test-ipaddress","jobid":"test-jobid","vsmconfigmode":"test-vsmconfigmode","vsmconfigstate":"test-vsmconfigstate","vsmctrlvlanid":1,"vsmdeviceid":"test-vsmdeviceid","vsmdevicename":"test-vsmdevicename","vsmdevicestate":"test-vsmdevicestate","vsmdomainid":"test-vsmdomainid","vsmmgmtvlanid":"test-vsmmgmtvlanid","vsmpktvlanid":1,"vsmstoragevlanid":1}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewEnableCiscoNexusVSMParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "enableCiscoNexusVSM", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.EnableCiscoNexusVSM(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableCiscoNexusVSM: %v", err)
+	}
+	if r.Ipaddress != "test-ipaddress" {
+		t.Errorf("Expected r.Ipaddress to be %v, got %v", "test-ipaddress", r.Ipaddress)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "enableCiscoNexusVSM" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute enableCiscoNexusVSM: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of enableCiscoNexusVSM: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of enableCiscoNexusVSM: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "enableCiscoNexusVSM", params, `<enablecisconexusvsmresponse><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><vsmconfigmode>test-vsmconfigmode</vsmconfigmode><vsmconfigstate>test-vsmconfigstate</vsmconfigstate><vsmctrlvlanid>1</vsmctrlvlanid><vsmdeviceid>test-vsmdeviceid</vsmdeviceid><vsmdevicename>test-vsmdevicename</vsmdevicename><vsmdevicestate>test-vsmdevicestate</vsmdevicestate><vsmdomainid>test-vsmdomainid</vsmdomainid><vsmmgmtvlanid>test-vsmmgmtvlanid</vsmmgmtvlanid><vsmpktvlanid>1</vsmpktvlanid><vsmstoragevlanid>1</vsmstoragevlanid></enablecisconexusvsmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.EnableCiscoNexusVSM(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableCiscoNexusVSM using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListCiscoNexusVSMs(t *testing.T) {
+	params := url.Values{
+		"clusterid": {"test-clusterid"},
+		"keyword":   {"test-keyword"},
+		"page":      {"3"},
+		"pagesize":  {"4"},
+		"zoneid":    {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listCiscoNexusVSMs", params, `{"listcisconexusvsmsresponse":{"cisconexusvsm":[{"ipaddress":"test-ipaddress","vsmconfigmode":"test-vsmconfigmode","vsmconfigstate":"test-vsmconfigstate","vsmctrlvlanid":1,"vsmdeviceid":"test-vsmdeviceid","vsmdevicename":"test-vsmdevicename","vsmdevicestate":"test-vsmdevicestate","vsmdomainid":"test-vsmdomainid","vsmmgmtvlanid":"test-vsmmgmtvlanid","vsmpktvlanid":1,"vsmstoragevlanid":1}],"count":1}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewListCiscoNexusVSMsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listCiscoNexusVSMs", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.ListCiscoNexusVSMs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCiscoNexusVSMs: %v", err)
+	}
+	if r.CiscoNexusVSMs[0].Ipaddress != "test-ipaddress" {
+		t.Errorf("Expected r.CiscoNexusVSMs[0].Ipaddress to be %v, got %v", "test-ipaddress", r.CiscoNexusVSMs[0].Ipaddress)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listCiscoNexusVSMs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listCiscoNexusVSMs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listCiscoNexusVSMs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listCiscoNexusVSMs: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*CiscoNexusVSM
+	count, err := cs.ExternalDevice.StreamCiscoNexusVSMs(context.Background(), p, func(v *CiscoNexusVSM) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listCiscoNexusVSMs: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.CiscoNexusVSMs) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.CiscoNexusVSMs)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listCiscoNexusVSMs", params, `<listcisconexusvsmsresponse><cisconexusvsm><ipaddress>test-ipaddress</ipaddress><vsmconfigmode>test-vsmconfigmode</vsmconfigmode><vsmconfigstate>test-vsmconfigstate</vsmconfigstate><vsmctrlvlanid>1</vsmctrlvlanid><vsmdeviceid>test-vsmdeviceid</vsmdeviceid><vsmdevicename>test-vsmdevicename</vsmdevicename><vsmdevicestate>test-vsmdevicestate</vsmdevicestate><vsmdomainid>test-vsmdomainid</vsmdomainid><vsmmgmtvlanid>test-vsmmgmtvlanid</vsmmgmtvlanid><vsmpktvlanid>1</vsmpktvlanid><vsmstoragevlanid>1</vsmstoragevlanid></cisconexusvsm><count>1</count></listcisconexusvsmsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.ListCiscoNexusVSMs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCiscoNexusVSMs using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ExternalDevice.StreamCiscoNexusVSMs(context.Background(), p, func(v *CiscoNexusVSM) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listCiscoNexusVSMs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.CiscoNexusVSMs) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.CiscoNexusVSMs)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddCiscoVnmcResource(t *testing.T) {
+	params := url.Values{
+		"hostname":          {"test-hostname"},
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"username":          {"test-username"},
+	}
+	cs, done := newTestClient(t, "addCiscoVnmcResource", params, `{"addciscovnmcresourceresponse":{}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewAddCiscoVnmcResourceParams("test-hostname", "test-password", "test-physicalnetworkid", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addCiscoVnmcResource", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.AddCiscoVnmcResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call addCiscoVnmcResource: %v", err)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addCiscoVnmcResource" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addCiscoVnmcResource: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addCiscoVnmcResource: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addCiscoVnmcResource: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addCiscoVnmcResource", params, `<addciscovnmcresourceresponse></addciscovnmcresourceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.AddCiscoVnmcResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call addCiscoVnmcResource using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteCiscoVnmcResource(t *testing.T) {
+	params := url.Values{
+		"resourceid": {"test-resourceid"},
+	}
+	cs, done := newTestClient(t, "deleteCiscoVnmcResource", params, `{"deleteciscovnmcresourceresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewDeleteCiscoVnmcResourceParams("test-resourceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetResourceid("test-resourceid")
+	checkURLValues(t, "deleteCiscoVnmcResource", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.DeleteCiscoVnmcResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCiscoVnmcResource: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCiscoVnmcResource" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteCiscoVnmcResource: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteCiscoVnmcResource: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteCiscoVnmcResource: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteCiscoVnmcResource", params, `<deleteciscovnmcresourceresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteciscovnmcresourceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.DeleteCiscoVnmcResource(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCiscoVnmcResource using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListCiscoVnmcResources(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"page":              {"2"},
+		"pagesize":          {"3"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"resourceid":        {"test-resourceid"},
+	}
+	cs, done := newTestClient(t, "listCiscoVnmcResources", params, `{"listciscovnmcresourcesresponse":{"ciscovnmcresource":[{}],"count":1}}`)
+	defer done()
+
+	p := cs.ExternalDevice.NewListCiscoVnmcResourcesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetResourceid("test-resourceid")
+	checkURLValues(t, "listCiscoVnmcResources", p.URLValues(), params)
+
+	r, err := cs.ExternalDevice.ListCiscoVnmcResources(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCiscoVnmcResources: %v", err)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listCiscoVnmcResources" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listCiscoVnmcResources: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listCiscoVnmcResources: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listCiscoVnmcResources: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*CiscoVnmcResource
+	count, err := cs.ExternalDevice.StreamCiscoVnmcResources(context.Background(), p, func(v *CiscoVnmcResource) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listCiscoVnmcResources: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.CiscoVnmcResources) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.CiscoVnmcResources)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listCiscoVnmcResources", params, `<listciscovnmcresourcesresponse><ciscovnmcresource></ciscovnmcresource><count>1</count></listciscovnmcresourcesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ExternalDevice.ListCiscoVnmcResources(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCiscoVnmcResources using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ExternalDevice.StreamCiscoVnmcResources(context.Background(), p, func(v *CiscoVnmcResource) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listCiscoVnmcResources using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.CiscoVnmcResources) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.CiscoVnmcResources)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
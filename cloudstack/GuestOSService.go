@@ -17,390 +17,163 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
-)
-
-type ListOsTypesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListOsTypesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListOsTypesParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
-}
-
-func (p *ListOsTypesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListOsTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListOsTypesParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["oscategoryid"] = v
-	return
-}
-
-func (p *ListOsTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListOsTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListOsTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
-	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsTypeByID(id string) (*OsType, int, error) {
-	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListOsTypes(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
-
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
-
-	if l.Count == 1 {
-		return l.OsTypes[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for OsType UUID: %s!", id)
-}
-
-// Lists all supported OS types for this cloud.
-func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
-	resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
 
-	var r ListOsTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-type ListOsTypesResponse struct {
-	Count   int       `json:"count"`
-	OsTypes []*OsType `json:"ostype"`
+type GuestOSService struct {
+	cs *CloudStackClient
 }
 
-type OsType struct {
-	Description   string `json:"description,omitempty"`
-	Id            string `json:"id,omitempty"`
-	Isuserdefined string `json:"isuserdefined,omitempty"`
-	Oscategoryid  string `json:"oscategoryid,omitempty"`
+func NewGuestOSService(cs *CloudStackClient) *GuestOSService {
+	return &GuestOSService{cs: cs}
 }
 
-type ListOsCategoriesParams struct {
-	p map[string]interface{}
+type AddGuestOsParams struct {
+	Name          *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Oscategoryid  *string `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Osdisplayname *string `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
 }
 
-func (p *ListOsCategoriesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddGuestOsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Oscategoryid; v != nil {
+		u.Set("oscategoryid", *v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Osdisplayname; v != nil {
+		u.Set("osdisplayname", *v)
 	}
 	return u
 }
 
-func (p *ListOsCategoriesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddGuestOsParams) Command() string {
+	return "addGuestOs"
 }
 
-func (p *ListOsCategoriesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AddGuestOsParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListOsCategoriesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddGuestOsParams) ResponseType() interface{} {
+	return &AddGuestOsResponse{}
 }
 
-func (p *ListOsCategoriesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddGuestOsParams) Validate() error {
+	v := &validator{api: "addGuestOs"}
+	v.required("oscategoryid", p.Oscategoryid != nil)
+	v.required("osdisplayname", p.Osdisplayname != nil)
+	return v.err()
 }
 
-func (p *ListOsCategoriesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *AddGuestOsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-// You should always use this function to get a new ListOsCategoriesParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
-	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsCategoryID(name string) (string, error) {
-	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
-
-	l, err := s.ListOsCategories(p)
-	if err != nil {
-		return "", err
-	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
-
-	if l.Count == 1 {
-		return l.OsCategories[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.OsCategories {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
+func (p *AddGuestOsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return *p.Name
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsCategoryByName(name string) (*OsCategory, int, error) {
-	id, err := s.GetOsCategoryID(name)
-	if err != nil {
-		return nil, -1, err
-	}
-
-	r, count, err := s.GetOsCategoryByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *AddGuestOsParams) HasName() bool {
+	return p.Name != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsCategoryByID(id string) (*OsCategory, int, error) {
-	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListOsCategories(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
-
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
-
-	if l.Count == 1 {
-		return l.OsCategories[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for OsCategory UUID: %s!", id)
+func (p *AddGuestOsParams) ResetName() {
+	p.Name = nil
 }
 
-// Lists all supported OS categories for this cloud.
-func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
-	resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListOsCategoriesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// This param is required.
+func (p *AddGuestOsParams) SetOscategoryid(v string) {
+	p.Oscategoryid = &v
+	return
 }
 
-type ListOsCategoriesResponse struct {
-	Count        int           `json:"count"`
-	OsCategories []*OsCategory `json:"oscategory"`
+func (p *AddGuestOsParams) GetOscategoryid() string {
+	if p.Oscategoryid == nil {
+		return ""
+	}
+	return *p.Oscategoryid
 }
 
-type OsCategory struct {
-	Id   string `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+func (p *AddGuestOsParams) HasOscategoryid() bool {
+	return p.Oscategoryid != nil
 }
 
-type AddGuestOsParams struct {
-	p map[string]interface{}
+func (p *AddGuestOsParams) ResetOscategoryid() {
+	p.Oscategoryid = nil
 }
 
-func (p *AddGuestOsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
-	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
-	}
-	return u
+// This param is required.
+func (p *AddGuestOsParams) SetOsdisplayname(v string) {
+	p.Osdisplayname = &v
+	return
 }
 
-func (p *AddGuestOsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddGuestOsParams) GetOsdisplayname() string {
+	if p.Osdisplayname == nil {
+		return ""
 	}
-	p.p["name"] = v
-	return
+	return *p.Osdisplayname
 }
 
-func (p *AddGuestOsParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["oscategoryid"] = v
-	return
+func (p *AddGuestOsParams) HasOsdisplayname() bool {
+	return p.Osdisplayname != nil
 }
 
-func (p *AddGuestOsParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osdisplayname"] = v
-	return
+func (p *AddGuestOsParams) ResetOsdisplayname() {
+	p.Osdisplayname = nil
 }
 
 // You should always use this function to get a new AddGuestOsParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - oscategoryid
+//   - osdisplayname
 func (s *GuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams {
 	p := &AddGuestOsParams{}
-	p.p = make(map[string]interface{})
-	p.p["oscategoryid"] = oscategoryid
-	p.p["osdisplayname"] = osdisplayname
+	p.SetOscategoryid(oscategoryid)
+	p.SetOsdisplayname(osdisplayname)
 	return p
 }
 
 // Add a new guest OS type
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
 func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOs", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addGuestOs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddGuestOsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addGuestOs", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -421,7 +194,7 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addGuestOs", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -429,66 +202,101 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 }
 
 type AddGuestOsResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Description   string `json:"description,omitempty"`
-	Id            string `json:"id,omitempty"`
-	Isuserdefined string `json:"isuserdefined,omitempty"`
-	Oscategoryid  string `json:"oscategoryid,omitempty"`
+	JobID         string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Description   string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id            string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isuserdefined string                     `json:"isuserdefined,omitempty" xml:"isuserdefined,omitempty"`
+	Oscategoryid  string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type UpdateGuestOsParams struct {
-	p map[string]interface{}
+type RemoveGuestOsParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateGuestOsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveGuestOsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *UpdateGuestOsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *RemoveGuestOsParams) Command() string {
+	return "removeGuestOs"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveGuestOsParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveGuestOsParams) ResponseType() interface{} {
+	return &RemoveGuestOsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveGuestOsParams) Validate() error {
+	v := &validator{api: "removeGuestOs"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveGuestOsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RemoveGuestOsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["osdisplayname"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new UpdateGuestOsParams instance,
+func (p *RemoveGuestOsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RemoveGuestOsParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RemoveGuestOsParams instance,
 // as then you are sure you have configured all required params
-func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams {
-	p := &UpdateGuestOsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["osdisplayname"] = osdisplayname
+//
+// The required params are:
+//
+//   - id
+func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams {
+	p := &RemoveGuestOsParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates the information about Guest OS
-func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOs", p.toURLValues())
+// Removes a Guest OS from listing.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeGuestOs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateGuestOsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RemoveGuestOsResponse
+	if err := s.cs.unmarshal("removeGuestOs", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -504,67 +312,135 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("removeGuestOs", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateGuestOsResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Description   string `json:"description,omitempty"`
-	Id            string `json:"id,omitempty"`
-	Isuserdefined string `json:"isuserdefined,omitempty"`
-	Oscategoryid  string `json:"oscategoryid,omitempty"`
+type RemoveGuestOsResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type RemoveGuestOsParams struct {
-	p map[string]interface{}
+type UpdateGuestOsParams struct {
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Osdisplayname *string `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
 }
 
-func (p *RemoveGuestOsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateGuestOsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Osdisplayname; v != nil {
+		u.Set("osdisplayname", *v)
 	}
 	return u
 }
 
-func (p *RemoveGuestOsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateGuestOsParams) Command() string {
+	return "updateGuestOs"
 }
 
-// You should always use this function to get a new RemoveGuestOsParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams {
-	p := &RemoveGuestOsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+// Returns true if the API command starts an async job
+func (p *UpdateGuestOsParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateGuestOsParams) ResponseType() interface{} {
+	return &UpdateGuestOsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateGuestOsParams) Validate() error {
+	v := &validator{api: "updateGuestOs"}
+	v.required("id", p.Id != nil)
+	v.required("osdisplayname", p.Osdisplayname != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UpdateGuestOsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateGuestOsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateGuestOsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateGuestOsParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *UpdateGuestOsParams) SetOsdisplayname(v string) {
+	p.Osdisplayname = &v
+	return
+}
+
+func (p *UpdateGuestOsParams) GetOsdisplayname() string {
+	if p.Osdisplayname == nil {
+		return ""
+	}
+	return *p.Osdisplayname
+}
+
+func (p *UpdateGuestOsParams) HasOsdisplayname() bool {
+	return p.Osdisplayname != nil
+}
+
+func (p *UpdateGuestOsParams) ResetOsdisplayname() {
+	p.Osdisplayname = nil
+}
+
+// You should always use this function to get a new UpdateGuestOsParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - osdisplayname
+func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams {
+	p := &UpdateGuestOsParams{}
+	p.SetId(id)
+	p.SetOsdisplayname(osdisplayname)
 	return p
 }
 
-// Removes a Guest OS from listing.
-func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOs", p.toURLValues())
+// Updates the information about Guest OS
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateGuestOs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveGuestOsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateGuestOsResponse
+	if err := s.cs.unmarshal("updateGuestOs", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -580,261 +456,223 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateGuestOs", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveGuestOsResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type UpdateGuestOsResponse struct {
+	JobID         string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Description   string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id            string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isuserdefined string                     `json:"isuserdefined,omitempty" xml:"isuserdefined,omitempty"`
+	Oscategoryid  string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListGuestOsMappingParams struct {
-	p map[string]interface{}
+type AddGuestOsMappingParams struct {
+	Hypervisor          *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Hypervisorversion   *string         `json:"hypervisorversion,omitempty" yaml:"hypervisorversion,omitempty"`
+	Osdisplayname       *string         `json:"osdisplayname,omitempty" yaml:"osdisplayname,omitempty"`
+	Osnameforhypervisor *string         `json:"osnameforhypervisor,omitempty" yaml:"osnameforhypervisor,omitempty"`
+	Ostypeid            *string         `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
 }
 
-func (p *ListGuestOsMappingParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddGuestOsMappingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if v := p.Hypervisorversion; v != nil {
+		u.Set("hypervisorversion", *v)
 	}
-	if v, found := p.p["hypervisorversion"]; found {
-		u.Set("hypervisorversion", v.(string))
+	if v := p.Osdisplayname; v != nil {
+		u.Set("osdisplayname", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Osnameforhypervisor; v != nil {
+		u.Set("osnameforhypervisor", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
 	}
 	return u
 }
 
-func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddGuestOsMappingParams) Command() string {
+	return "addGuestOsMapping"
 }
 
-func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisorversion"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AddGuestOsMappingParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListGuestOsMappingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddGuestOsMappingParams) ResponseType() interface{} {
+	return &AddGuestOsMappingResponse{}
 }
 
-func (p *ListGuestOsMappingParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddGuestOsMappingParams) Validate() error {
+	v := &validator{api: "addGuestOsMapping"}
+	v.required("hypervisor", p.Hypervisor != nil)
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
 	}
-	p.p["keyword"] = v
-	return
+	v.required("hypervisorversion", p.Hypervisorversion != nil)
+	v.required("osnameforhypervisor", p.Osnameforhypervisor != nil)
+	return v.err()
 }
 
-func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware. This param is required.
+func (p *AddGuestOsMappingParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
 	return
 }
 
-func (p *ListGuestOsMappingParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddGuestOsMappingParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
 	}
-	p.p["page"] = v
-	return
+	return *p.Hypervisor
 }
 
-func (p *ListGuestOsMappingParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *AddGuestOsMappingParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
 }
 
-// You should always use this function to get a new ListGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams {
-	p := &ListGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *AddGuestOsMappingParams) ResetHypervisor() {
+	p.Hypervisor = nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetGuestOsMappingByID(id string) (*GuestOsMapping, int, error) {
-	p := &ListGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+// This param is required.
+func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
+	p.Hypervisorversion = &v
+	return
+}
 
-	l, err := s.ListGuestOsMapping(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *AddGuestOsMappingParams) GetHypervisorversion() string {
+	if p.Hypervisorversion == nil {
+		return ""
 	}
+	return *p.Hypervisorversion
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *AddGuestOsMappingParams) HasHypervisorversion() bool {
+	return p.Hypervisorversion != nil
+}
 
-	if l.Count == 1 {
-		return l.GuestOsMapping[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for GuestOsMapping UUID: %s!", id)
+func (p *AddGuestOsMappingParams) ResetHypervisorversion() {
+	p.Hypervisorversion = nil
 }
 
-// Lists all available OS mappings for given hypervisor
-func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("listGuestOsMapping", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
+	p.Osdisplayname = &v
+	return
+}
 
-	var r ListGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *AddGuestOsMappingParams) GetOsdisplayname() string {
+	if p.Osdisplayname == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Osdisplayname
 }
 
-type ListGuestOsMappingResponse struct {
-	Count          int               `json:"count"`
-	GuestOsMapping []*GuestOsMapping `json:"guestosmapping"`
+func (p *AddGuestOsMappingParams) HasOsdisplayname() bool {
+	return p.Osdisplayname != nil
 }
 
-type GuestOsMapping struct {
-	Hypervisor          string `json:"hypervisor,omitempty"`
-	Hypervisorversion   string `json:"hypervisorversion,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Isuserdefined       string `json:"isuserdefined,omitempty"`
-	Osdisplayname       string `json:"osdisplayname,omitempty"`
-	Osnameforhypervisor string `json:"osnameforhypervisor,omitempty"`
-	Ostypeid            string `json:"ostypeid,omitempty"`
+func (p *AddGuestOsMappingParams) ResetOsdisplayname() {
+	p.Osdisplayname = nil
 }
 
-type AddGuestOsMappingParams struct {
-	p map[string]interface{}
+// This param is required.
+func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
+	p.Osnameforhypervisor = &v
+	return
 }
 
-func (p *AddGuestOsMappingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["hypervisorversion"]; found {
-		u.Set("hypervisorversion", v.(string))
-	}
-	if v, found := p.p["osdisplayname"]; found {
-		u.Set("osdisplayname", v.(string))
-	}
-	if v, found := p.p["osnameforhypervisor"]; found {
-		u.Set("osnameforhypervisor", v.(string))
-	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+func (p *AddGuestOsMappingParams) GetOsnameforhypervisor() string {
+	if p.Osnameforhypervisor == nil {
+		return ""
 	}
-	return u
+	return *p.Osnameforhypervisor
 }
 
-func (p *AddGuestOsMappingParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
+func (p *AddGuestOsMappingParams) HasOsnameforhypervisor() bool {
+	return p.Osnameforhypervisor != nil
 }
 
-func (p *AddGuestOsMappingParams) SetHypervisorversion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisorversion"] = v
-	return
+func (p *AddGuestOsMappingParams) ResetOsnameforhypervisor() {
+	p.Osnameforhypervisor = nil
 }
 
-func (p *AddGuestOsMappingParams) SetOsdisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["osdisplayname"] = v
+// Sets the 'ostypeid' param: the ID of the OS type.
+func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
 	return
 }
 
-func (p *AddGuestOsMappingParams) SetOsnameforhypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddGuestOsMappingParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
 	}
-	p.p["osnameforhypervisor"] = v
-	return
+	return *p.Ostypeid
 }
 
-func (p *AddGuestOsMappingParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
-	return
+func (p *AddGuestOsMappingParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
+
+func (p *AddGuestOsMappingParams) ResetOstypeid() {
+	p.Ostypeid = nil
 }
 
 // You should always use this function to get a new AddGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
-func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
+//
+// The required params are:
+//
+//   - hypervisor: the hypervisor, like KVM, XenServer or VMware
+//   - hypervisorversion
+//   - osnameforhypervisor
+func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor HypervisorType, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
 	p := &AddGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	p.p["hypervisor"] = hypervisor
-	p.p["hypervisorversion"] = hypervisorversion
-	p.p["osnameforhypervisor"] = osnameforhypervisor
+	p.SetHypervisor(hypervisor)
+	p.SetHypervisorversion(hypervisorversion)
+	p.SetOsnameforhypervisor(osnameforhypervisor)
 	return p
 }
 
 // Adds a guest OS name to hypervisor OS name mapping
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListGuestOsMapping, GetGuestOsMappingByID.
 func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("addGuestOsMapping", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addGuestOsMapping", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addGuestOsMapping", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -855,7 +693,7 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addGuestOsMapping", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -863,172 +701,1014 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 }
 
 type AddGuestOsMappingResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Hypervisor          string `json:"hypervisor,omitempty"`
-	Hypervisorversion   string `json:"hypervisorversion,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Isuserdefined       string `json:"isuserdefined,omitempty"`
-	Osdisplayname       string `json:"osdisplayname,omitempty"`
-	Osnameforhypervisor string `json:"osnameforhypervisor,omitempty"`
-	Ostypeid            string `json:"ostypeid,omitempty"`
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Hypervisor          HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion   string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isuserdefined       string                     `json:"isuserdefined,omitempty" xml:"isuserdefined,omitempty"`
+	Osdisplayname       string                     `json:"osdisplayname,omitempty" xml:"osdisplayname,omitempty"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor,omitempty" xml:"osnameforhypervisor,omitempty"`
+	Ostypeid            string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type UpdateGuestOsMappingParams struct {
-	p map[string]interface{}
+type ListGuestOsMappingParams struct {
+	Hypervisor        *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Hypervisorversion *string         `json:"hypervisorversion,omitempty" yaml:"hypervisorversion,omitempty"`
+	Id                *string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string         `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Ostypeid          *string         `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Page              *int            `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int            `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListGuestOsMappingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Hypervisorversion; v != nil {
+		u.Set("hypervisorversion", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
 	}
-	if v, found := p.p["osnameforhypervisor"]; found {
-		u.Set("osnameforhypervisor", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
 	return u
 }
 
-func (p *UpdateGuestOsMappingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ListGuestOsMappingParams) Command() string {
+	return "listGuestOsMapping"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListGuestOsMappingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListGuestOsMappingParams) ResponseType() interface{} {
+	return &ListGuestOsMappingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListGuestOsMappingParams) Validate() error {
+	v := &validator{api: "listGuestOsMapping"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
 	}
-	p.p["id"] = v
+	return v.err()
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListGuestOsMappingParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
 	return
 }
 
-func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListGuestOsMappingParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
 	}
-	p.p["osnameforhypervisor"] = v
-	return
+	return *p.Hypervisor
 }
 
-// You should always use this function to get a new UpdateGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams {
-	p := &UpdateGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["osnameforhypervisor"] = osnameforhypervisor
-	return p
+func (p *ListGuestOsMappingParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
 }
 
-// Updates the information about Guest OS to Hypervisor specific name mapping
-func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("updateGuestOsMapping", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListGuestOsMappingParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
 
-	var r UpdateGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListGuestOsMappingParams) SetHypervisorversion(v string) {
+	p.Hypervisorversion = &v
+	return
+}
+
+func (p *ListGuestOsMappingParams) GetHypervisorversion() string {
+	if p.Hypervisorversion == nil {
+		return ""
 	}
+	return *p.Hypervisorversion
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListGuestOsMappingParams) HasHypervisorversion() bool {
+	return p.Hypervisorversion != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ListGuestOsMappingParams) ResetHypervisorversion() {
+	p.Hypervisorversion = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *ListGuestOsMappingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListGuestOsMappingParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Id
 }
 
-type UpdateGuestOsMappingResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Hypervisor          string `json:"hypervisor,omitempty"`
-	Hypervisorversion   string `json:"hypervisorversion,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Isuserdefined       string `json:"isuserdefined,omitempty"`
-	Osdisplayname       string `json:"osdisplayname,omitempty"`
-	Osnameforhypervisor string `json:"osnameforhypervisor,omitempty"`
-	Ostypeid            string `json:"ostypeid,omitempty"`
+func (p *ListGuestOsMappingParams) HasId() bool {
+	return p.Id != nil
 }
 
-type RemoveGuestOsMappingParams struct {
-	p map[string]interface{}
+func (p *ListGuestOsMappingParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListGuestOsMappingParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
 }
 
-func (p *RemoveGuestOsMappingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListGuestOsMappingParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Keyword
 }
 
-// You should always use this function to get a new RemoveGuestOsMappingParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams {
-	p := &RemoveGuestOsMappingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListGuestOsMappingParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-// Removes a Guest OS Mapping.
-func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("removeGuestOsMapping", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListGuestOsMappingParams) ResetKeyword() {
+	p.Keyword = nil
+}
 
-	var r RemoveGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'ostypeid' param: the ID of the OS type.
+func (p *ListGuestOsMappingParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
+	return
+}
+
+func (p *ListGuestOsMappingParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
 	}
+	return *p.Ostypeid
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListGuestOsMappingParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+func (p *ListGuestOsMappingParams) ResetOstypeid() {
+	p.Ostypeid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListGuestOsMappingParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListGuestOsMappingParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListGuestOsMappingParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListGuestOsMappingParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListGuestOsMappingParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListGuestOsMappingParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListGuestOsMappingParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListGuestOsMappingParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListGuestOsMappingParams instance,
+// as then you are sure you have configured all required params
+func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams {
+	p := &ListGuestOsMappingParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
+	p := &ListGuestOsMappingParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListGuestOsMapping(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.GuestOsMapping[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for GuestOsMapping UUID: %s!", id)
+}
+
+// Lists all available OS mappings for given hypervisor
+//
+// Only supported by CloudStack 4.4.
+// See also GetGuestOsMappingByID.
+func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listGuestOsMapping", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListGuestOsMappingResponse
+	if err := s.cs.unmarshal("listGuestOsMapping", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListGuestOsMapping, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *GuestOSService) StreamGuestOsMapping(ctx context.Context, p *ListGuestOsMappingParams, fn func(*GuestOsMapping) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listGuestOsMapping", p.URLValues(), "guestosmapping", func(b json.RawMessage) error {
+		var v GuestOsMapping
+		if err := s.cs.unmarshalElement("listGuestOsMapping", "guestosmapping", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListGuestOsMappingResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	GuestOsMapping []*GuestOsMapping          `json:"guestosmapping" xml:"guestosmapping"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type GuestOsMapping struct {
+	Hypervisor          HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion   string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isuserdefined       string                     `json:"isuserdefined,omitempty" xml:"isuserdefined,omitempty"`
+	Osdisplayname       string                     `json:"osdisplayname,omitempty" xml:"osdisplayname,omitempty"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor,omitempty" xml:"osnameforhypervisor,omitempty"`
+	Ostypeid            string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RemoveGuestOsMappingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveGuestOsMappingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveGuestOsMappingParams) Command() string {
+	return "removeGuestOsMapping"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveGuestOsMappingParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveGuestOsMappingParams) ResponseType() interface{} {
+	return &RemoveGuestOsMappingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveGuestOsMappingParams) Validate() error {
+	v := &validator{api: "removeGuestOsMapping"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveGuestOsMappingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RemoveGuestOsMappingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RemoveGuestOsMappingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RemoveGuestOsMappingParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RemoveGuestOsMappingParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams {
+	p := &RemoveGuestOsMappingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Removes a Guest OS Mapping.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListGuestOsMapping, GetGuestOsMappingByID.
+func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeGuestOsMapping", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveGuestOsMappingResponse
+	if err := s.cs.unmarshal("removeGuestOsMapping", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("removeGuestOsMapping", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
 type RemoveGuestOsMappingResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateGuestOsMappingParams struct {
+	Id                  *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Osnameforhypervisor *string `json:"osnameforhypervisor,omitempty" yaml:"osnameforhypervisor,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateGuestOsMappingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Osnameforhypervisor; v != nil {
+		u.Set("osnameforhypervisor", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateGuestOsMappingParams) Command() string {
+	return "updateGuestOsMapping"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateGuestOsMappingParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateGuestOsMappingParams) ResponseType() interface{} {
+	return &UpdateGuestOsMappingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateGuestOsMappingParams) Validate() error {
+	v := &validator{api: "updateGuestOsMapping"}
+	v.required("id", p.Id != nil)
+	v.required("osnameforhypervisor", p.Osnameforhypervisor != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UpdateGuestOsMappingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateGuestOsMappingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateGuestOsMappingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateGuestOsMappingParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *UpdateGuestOsMappingParams) SetOsnameforhypervisor(v string) {
+	p.Osnameforhypervisor = &v
+	return
+}
+
+func (p *UpdateGuestOsMappingParams) GetOsnameforhypervisor() string {
+	if p.Osnameforhypervisor == nil {
+		return ""
+	}
+	return *p.Osnameforhypervisor
+}
+
+func (p *UpdateGuestOsMappingParams) HasOsnameforhypervisor() bool {
+	return p.Osnameforhypervisor != nil
+}
+
+func (p *UpdateGuestOsMappingParams) ResetOsnameforhypervisor() {
+	p.Osnameforhypervisor = nil
+}
+
+// You should always use this function to get a new UpdateGuestOsMappingParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - osnameforhypervisor
+func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams {
+	p := &UpdateGuestOsMappingParams{}
+	p.SetId(id)
+	p.SetOsnameforhypervisor(osnameforhypervisor)
+	return p
+}
+
+// Updates the information about Guest OS to Hypervisor specific name mapping
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListGuestOsMapping, GetGuestOsMappingByID.
+func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateGuestOsMapping", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGuestOsMappingResponse
+	if err := s.cs.unmarshal("updateGuestOsMapping", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateGuestOsMapping", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type UpdateGuestOsMappingResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Hypervisor          HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion   string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isuserdefined       string                     `json:"isuserdefined,omitempty" xml:"isuserdefined,omitempty"`
+	Osdisplayname       string                     `json:"osdisplayname,omitempty" xml:"osdisplayname,omitempty"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor,omitempty" xml:"osnameforhypervisor,omitempty"`
+	Ostypeid            string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListOsCategoriesParams = cloudstackcommon.ListOsCategoriesParams
+type ListOsCategoriesResponse = cloudstackcommon.ListOsCategoriesResponse
+type OsCategory = cloudstackcommon.OsCategory
+
+// You should always use this function to get a new ListOsCategoriesParams instance,
+// as then you are sure you have configured all required params
+func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
+	p := &ListOsCategoriesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListOsCategoriesParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListOsCategories(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.OsCategories[0].Id, nil
+	}
+
+	var matches []*OsCategory
+	for _, v := range l.OsCategories {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "OsCategory", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error) {
+	id, err := s.GetOsCategoryID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	r, count, err := s.GetOsCategoryByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
+	p := &ListOsCategoriesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListOsCategories(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.OsCategories[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for OsCategory UUID: %s!", id)
+}
+
+// Lists all supported OS categories for this cloud.
+//
+// See also GetOsCategoryID, GetOsCategoryByName, GetOsCategoryByID.
+func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listOsCategories", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListOsCategoriesResponse
+	if err := s.cs.unmarshal("listOsCategories", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListOsCategories, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *GuestOSService) StreamOsCategories(ctx context.Context, p *ListOsCategoriesParams, fn func(*OsCategory) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listOsCategories", p.URLValues(), "oscategory", func(b json.RawMessage) error {
+		var v OsCategory
+		if err := s.cs.unmarshalElement("listOsCategories", "oscategory", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListOsTypesParams struct {
+	Description  *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Oscategoryid *string `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListOsTypesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Oscategoryid; v != nil {
+		u.Set("oscategoryid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListOsTypesParams) Command() string {
+	return "listOsTypes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListOsTypesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListOsTypesParams) ResponseType() interface{} {
+	return &ListOsTypesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListOsTypesParams) Validate() error {
+	v := &validator{api: "listOsTypes"}
+	return v.err()
+}
+
+func (p *ListOsTypesParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *ListOsTypesParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *ListOsTypesParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *ListOsTypesParams) ResetDescription() {
+	p.Description = nil
+}
+
+func (p *ListOsTypesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListOsTypesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListOsTypesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListOsTypesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListOsTypesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListOsTypesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListOsTypesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListOsTypesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListOsTypesParams) SetOscategoryid(v string) {
+	p.Oscategoryid = &v
+	return
+}
+
+func (p *ListOsTypesParams) GetOscategoryid() string {
+	if p.Oscategoryid == nil {
+		return ""
+	}
+	return *p.Oscategoryid
+}
+
+func (p *ListOsTypesParams) HasOscategoryid() bool {
+	return p.Oscategoryid != nil
+}
+
+func (p *ListOsTypesParams) ResetOscategoryid() {
+	p.Oscategoryid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListOsTypesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListOsTypesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListOsTypesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListOsTypesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListOsTypesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListOsTypesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListOsTypesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListOsTypesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListOsTypesParams instance,
+// as then you are sure you have configured all required params
+func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
+	p := &ListOsTypesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
+	p := &ListOsTypesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListOsTypes(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.OsTypes[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for OsType UUID: %s!", id)
+}
+
+// Lists all supported OS types for this cloud.
+//
+// See also GetOsTypeByID.
+func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listOsTypes", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListOsTypesResponse
+	if err := s.cs.unmarshal("listOsTypes", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListOsTypes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *GuestOSService) StreamOsTypes(ctx context.Context, p *ListOsTypesParams, fn func(*OsType) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listOsTypes", p.URLValues(), "ostype", func(b json.RawMessage) error {
+		var v OsType
+		if err := s.cs.unmarshalElement("listOsTypes", "ostype", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListOsTypesResponse struct {
+	Count   int                        `json:"count" xml:"count"`
+	OsTypes []*OsType                  `json:"ostype" xml:"ostype"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type OsType struct {
+	Description   string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id            string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isuserdefined string                     `json:"isuserdefined,omitempty" xml:"isuserdefined,omitempty"`
+	Oscategoryid  string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -0,0 +1,1074 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddBaremetalHost(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"clusterid":       {"test-clusterid"},
+		"clustername":     {"test-clustername"},
+		"hosttags":        {"test-hosttags1, test-hosttags2"},
+		"hypervisor":      {"BareMetal"},
+		"ipaddress":       {"test-ipaddress"},
+		"password":        {"test-password"},
+		"podid":           {"test-podid"},
+		"url":             {"test-url"},
+		"username":        {"test-username"},
+		"zoneid":          {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addBaremetalHost", params, `{"addbaremetalhostresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewAddBaremetalHostParams(HypervisorBareMetal, "test-password", "test-podid", "test-url", "test-username", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClusterid("test-clusterid")
+	p.SetClustername("test-clustername")
+	p.SetHosttags([]string{"test-hosttags1", "test-hosttags2"})
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetIpaddress("test-ipaddress")
+	p.SetPassword("test-password")
+	p.SetPodid("test-podid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addBaremetalHost", p.URLValues(), params)
+
+	r, err := cs.Host.AddBaremetalHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalHost: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalHost" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addBaremetalHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addBaremetalHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addBaremetalHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addBaremetalHost", params, `<addbaremetalhostresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></addbaremetalhostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.AddBaremetalHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBaremetalHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListDedicatedHosts(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"domainid":        {"test-domainid"},
+		"hostid":          {"test-hostid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"6"},
+		"pagesize":        {"7"},
+	}
+	cs, done := newTestClient(t, "listDedicatedHosts", params, `{"listdedicatedhostsresponse":{"count":1,"dedicatedhost":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id"}]}}`)
+	defer done()
+
+	p := cs.Host.NewListDedicatedHostsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetDomainid("test-domainid")
+	p.SetHostid("test-hostid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	checkURLValues(t, "listDedicatedHosts", p.URLValues(), params)
+
+	r, err := cs.Host.ListDedicatedHosts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedHosts: %v", err)
+	}
+	if r.DedicatedHosts[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedHosts[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedHosts[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedHosts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDedicatedHosts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDedicatedHosts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDedicatedHosts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DedicatedHost
+	count, err := cs.Host.StreamDedicatedHosts(context.Background(), p, func(v *DedicatedHost) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDedicatedHosts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DedicatedHosts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DedicatedHosts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDedicatedHosts", params, `<listdedicatedhostsresponse><count>1</count><dedicatedhost><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><domainid>test-domainid</domainid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id></dedicatedhost></listdedicatedhostsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.ListDedicatedHosts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedHosts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Host.StreamDedicatedHosts(context.Background(), p, func(v *DedicatedHost) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDedicatedHosts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DedicatedHosts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DedicatedHosts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleaseDedicatedHost(t *testing.T) {
+	params := url.Values{
+		"hostid": {"test-hostid"},
+	}
+	cs, done := newTestClient(t, "releaseDedicatedHost", params, `{"releasededicatedhostresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Host.NewReleaseDedicatedHostParams("test-hostid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostid("test-hostid")
+	checkURLValues(t, "releaseDedicatedHost", p.URLValues(), params)
+
+	r, err := cs.Host.ReleaseDedicatedHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedHost: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedHost" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releaseDedicatedHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releaseDedicatedHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releaseDedicatedHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releaseDedicatedHost", params, `<releasededicatedhostresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></releasededicatedhostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.ReleaseDedicatedHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddHost(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"clusterid":       {"test-clusterid"},
+		"clustername":     {"test-clustername"},
+		"hosttags":        {"test-hosttags1, test-hosttags2"},
+		"hypervisor":      {"BareMetal"},
+		"password":        {"test-password"},
+		"podid":           {"test-podid"},
+		"url":             {"test-url"},
+		"username":        {"test-username"},
+		"zoneid":          {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addHost", params, `{"addhostresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewAddHostParams(HypervisorBareMetal, "test-password", "test-podid", "test-url", "test-username", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClusterid("test-clusterid")
+	p.SetClustername("test-clustername")
+	p.SetHosttags([]string{"test-hosttags1", "test-hosttags2"})
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetPassword("test-password")
+	p.SetPodid("test-podid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addHost", p.URLValues(), params)
+
+	r, err := cs.Host.AddHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call addHost: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addHost" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addHost", params, `<addhostresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></addhostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.AddHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call addHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDedicateHost(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"hostid":   {"test-hostid"},
+	}
+	cs, done := newTestClient(t, "dedicateHost", params, `{"dedicatehostresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid"}}`)
+	defer done()
+
+	p := cs.Host.NewDedicateHostParams("test-domainid", "test-hostid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetHostid("test-hostid")
+	checkURLValues(t, "dedicateHost", p.URLValues(), params)
+
+	r, err := cs.Host.DedicateHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateHost: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateHost" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute dedicateHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of dedicateHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of dedicateHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "dedicateHost", params, `<dedicatehostresponse><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><domainid>test-domainid</domainid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid></dedicatehostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.DedicateHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteHost(t *testing.T) {
+	params := url.Values{
+		"forced":                   {"true"},
+		"forcedestroylocalstorage": {"true"},
+		"id":                       {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteHost", params, `{"deletehostresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Host.NewDeleteHostParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetForced(true)
+	p.SetForcedestroylocalstorage(true)
+	p.SetId("test-id")
+	checkURLValues(t, "deleteHost", p.URLValues(), params)
+
+	r, err := cs.Host.DeleteHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteHost: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteHost" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteHost", params, `<deletehostresponse><displaytext>test-displaytext</displaytext><success>true</success></deletehostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.DeleteHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListHosts(t *testing.T) {
+	params := url.Values{
+		"clusterid":        {"test-clusterid"},
+		"details":          {"test-details1, test-details2"},
+		"hahost":           {"true"},
+		"hypervisor":       {"BareMetal"},
+		"id":               {"test-id"},
+		"keyword":          {"test-keyword"},
+		"name":             {"test-name"},
+		"page":             {"8"},
+		"pagesize":         {"9"},
+		"podid":            {"test-podid"},
+		"resourcestate":    {"test-resourcestate"},
+		"state":            {"test-state"},
+		"type":             {"test-type"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"zoneid":           {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listHosts", params, `{"listhostsresponse":{"count":1,"host":[{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Host.NewListHostsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	p.SetDetails([]string{"test-details1", "test-details2"})
+	p.SetHahost(true)
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetPodid("test-podid")
+	p.SetResourcestate("test-resourcestate")
+	p.SetState("test-state")
+	p.SetType("test-type")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listHosts", p.URLValues(), params)
+
+	r, err := cs.Host.ListHosts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listHosts: %v", err)
+	}
+	if r.Hosts[0].Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Hosts[0].Capabilities to be %v, got %v", "test-capabilities", r.Hosts[0].Capabilities)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listHosts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listHosts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listHosts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listHosts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Host
+	count, err := cs.Host.StreamHosts(context.Background(), p, func(v *Host) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listHosts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Hosts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Hosts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listHosts", params, `<listhostsresponse><count>1</count><host><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></host></listhostsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.ListHosts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listHosts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Host.StreamHosts(context.Background(), p, func(v *Host) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listHosts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Hosts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Hosts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReconnectHost(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "reconnectHost", params, `{"reconnecthostresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"jobid":"test-jobid","lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewReconnectHostParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "reconnectHost", p.URLValues(), params)
+
+	r, err := cs.Host.ReconnectHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call reconnectHost: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "reconnectHost" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute reconnectHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of reconnectHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of reconnectHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "reconnectHost", params, `<reconnecthostresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><jobid>test-jobid</jobid><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></reconnecthostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.ReconnectHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call reconnectHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateHost(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"hosttags":        {"test-hosttags1, test-hosttags2"},
+		"id":              {"test-id"},
+		"oscategoryid":    {"test-oscategoryid"},
+		"url":             {"test-url"},
+	}
+	cs, done := newTestClient(t, "updateHost", params, `{"updatehostresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewUpdateHostParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetHosttags([]string{"test-hosttags1", "test-hosttags2"})
+	p.SetId("test-id")
+	p.SetOscategoryid("test-oscategoryid")
+	p.SetUrl("test-url")
+	checkURLValues(t, "updateHost", p.URLValues(), params)
+
+	r, err := cs.Host.UpdateHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateHost: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateHost" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateHost: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateHost: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateHost: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateHost", params, `<updatehostresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatehostresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.UpdateHost(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateHost using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestPrepareHostForMaintenance(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "prepareHostForMaintenance", params, `{"preparehostformaintenanceresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"jobid":"test-jobid","lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewPrepareHostForMaintenanceParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "prepareHostForMaintenance", p.URLValues(), params)
+
+	r, err := cs.Host.PrepareHostForMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call prepareHostForMaintenance: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "prepareHostForMaintenance" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute prepareHostForMaintenance: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of prepareHostForMaintenance: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of prepareHostForMaintenance: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "prepareHostForMaintenance", params, `<preparehostformaintenanceresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><jobid>test-jobid</jobid><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></preparehostformaintenanceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.PrepareHostForMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call prepareHostForMaintenance using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCancelHostMaintenance(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "cancelHostMaintenance", params, `{"cancelhostmaintenanceresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpusockets":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","gpugroup":[{"gpugroupname":"test-gpugroupname","vgpu":[{"maxcapacity":1,"maxheads":1,"maxresolutionx":1,"maxresolutiony":1,"maxvgpuperpgpu":1,"remainingcapacity":1,"vgputype":"test-vgputype","videoram":1}]}],"hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"jobid":"test-jobid","lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewCancelHostMaintenanceParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "cancelHostMaintenance", p.URLValues(), params)
+
+	r, err := cs.Host.CancelHostMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call cancelHostMaintenance: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "cancelHostMaintenance" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute cancelHostMaintenance: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of cancelHostMaintenance: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of cancelHostMaintenance: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "cancelHostMaintenance", params, `<cancelhostmaintenanceresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpusockets>1</cpusockets><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><gpugroup><gpugroupname>test-gpugroupname</gpugroupname><vgpu><maxcapacity>1</maxcapacity><maxheads>1</maxheads><maxresolutionx>1</maxresolutionx><maxresolutiony>1</maxresolutiony><maxvgpuperpgpu>1</maxvgpuperpgpu><remainingcapacity>1</remainingcapacity><vgputype>test-vgputype</vgputype><videoram>1</videoram></vgpu></gpugroup><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><jobid>test-jobid</jobid><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></cancelhostmaintenanceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.CancelHostMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call cancelHostMaintenance using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateHostPassword(t *testing.T) {
+	params := url.Values{
+		"clusterid": {"test-clusterid"},
+		"hostid":    {"test-hostid"},
+		"password":  {"test-password"},
+		"username":  {"test-username"},
+	}
+	cs, done := newTestClient(t, "updateHostPassword", params, `{"updatehostpasswordresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Host.NewUpdateHostPasswordParams("test-password", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	p.SetHostid("test-hostid")
+	p.SetPassword("test-password")
+	p.SetUsername("test-username")
+	checkURLValues(t, "updateHostPassword", p.URLValues(), params)
+
+	r, err := cs.Host.UpdateHostPassword(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateHostPassword: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateHostPassword" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateHostPassword: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateHostPassword: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateHostPassword: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateHostPassword", params, `<updatehostpasswordresponse><displaytext>test-displaytext</displaytext><success>true</success></updatehostpasswordresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.UpdateHostPassword(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateHostPassword using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleaseHostReservation(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "releaseHostReservation", params, `{"releasehostreservationresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Host.NewReleaseHostReservationParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "releaseHostReservation", p.URLValues(), params)
+
+	r, err := cs.Host.ReleaseHostReservation(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseHostReservation: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releaseHostReservation" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releaseHostReservation: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releaseHostReservation: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releaseHostReservation: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releaseHostReservation", params, `<releasehostreservationresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></releasehostreservationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.ReleaseHostReservation(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseHostReservation using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestFindHostsForMigration(t *testing.T) {
+	params := url.Values{
+		"keyword":          {"test-keyword"},
+		"page":             {"2"},
+		"pagesize":         {"3"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "findHostsForMigration", params, `{"findhostsformigrationresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","requiresStorageMotion":true,"resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewFindHostsForMigrationParams("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "findHostsForMigration", p.URLValues(), params)
+
+	r, err := cs.Host.FindHostsForMigration(p)
+	if err != nil {
+		t.Fatalf("Failed to call findHostsForMigration: %v", err)
+	}
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "findHostsForMigration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute findHostsForMigration: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of findHostsForMigration: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of findHostsForMigration: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "findHostsForMigration", params, `<findhostsformigrationresponse><averageload>1</averageload><capabilities>test-capabilities</capabilities><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><clustertype>test-clustertype</clustertype><cpuallocated>12.5%</cpuallocated><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><cpuwithoverprovisioning>test-cpuwithoverprovisioning</cpuwithoverprovisioning><created>2014-01-02T15:04:05+0100</created><disconnected>test-disconnected</disconnected><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><events>test-events</events><hahost>true</hahost><hasenoughcapacity>true</hasenoughcapacity><hosttags>test-hosttags</hosttags><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><ipaddress>test-ipaddress</ipaddress><islocalstorageactive>true</islocalstorageactive><lastpinged>test-lastpinged</lastpinged><managementserverid>1</managementserverid><memoryallocated>1</memoryallocated><memorytotal>1</memorytotal><memoryused>1</memoryused><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><oscategoryid>test-oscategoryid</oscategoryid><oscategoryname>test-oscategoryname</oscategoryname><podid>test-podid</podid><podname>test-podname</podname><removed>2014-01-02T15:04:05+0100</removed><requiresStorageMotion>true</requiresStorageMotion><resourcestate>test-resourcestate</resourcestate><state>test-state</state><suitableformigration>true</suitableformigration><type>test-type</type><version>test-version</version><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></findhostsformigrationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.FindHostsForMigration(p)
+	if err != nil {
+		t.Fatalf("Failed to call findHostsForMigration using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddSecondaryStorage(t *testing.T) {
+	params := url.Values{
+		"url":    {"test-url"},
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addSecondaryStorage", params, `{"addsecondarystorageresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Host.NewAddSecondaryStorageParams("test-url")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addSecondaryStorage", p.URLValues(), params)
+
+	r, err := cs.Host.AddSecondaryStorage(p)
+	if err != nil {
+		t.Fatalf("Failed to call addSecondaryStorage: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addSecondaryStorage" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addSecondaryStorage: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addSecondaryStorage: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addSecondaryStorage: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addSecondaryStorage", params, `<addsecondarystorageresponse><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></addsecondarystorageresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Host.AddSecondaryStorage(p)
+	if err != nil {
+		t.Fatalf("Failed to call addSecondaryStorage using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
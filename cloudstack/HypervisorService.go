@@ -17,230 +17,94 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type ListHypervisorsParams struct {
-	p map[string]interface{}
+type HypervisorService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListHypervisorsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func NewHypervisorService(cs *CloudStackClient) *HypervisorService {
+	return &HypervisorService{cs: cs}
 }
 
-func (p *ListHypervisorsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListHypervisorsParams = cloudstackcommon.ListHypervisorsParams
+type ListHypervisorsResponse = cloudstackcommon.ListHypervisorsResponse
+type Hypervisor = cloudstackcommon.Hypervisor
 
 // You should always use this function to get a new ListHypervisorsParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
 	p := &ListHypervisorsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // List hypervisors
 func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisors", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListHypervisorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListHypervisorsResponse struct {
-	Count       int           `json:"count"`
-	Hypervisors []*Hypervisor `json:"hypervisor"`
-}
-
-type Hypervisor struct {
-	Name string `json:"name,omitempty"`
-}
-
-type UpdateHypervisorCapabilitiesParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["maxguestslimit"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxguestslimit", vv)
-	}
-	if v, found := p.p["securitygroupenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("securitygroupenabled", vv)
-	}
-	return u
-}
-
-func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxguestslimit"] = v
-	return
-}
-
-func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["securitygroupenabled"] = v
-	return
-}
-
-// You should always use this function to get a new UpdateHypervisorCapabilitiesParams instance,
-// as then you are sure you have configured all required params
-func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams {
-	p := &UpdateHypervisorCapabilitiesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
 
-// Updates a hypervisor capabilities.
-func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("updateHypervisorCapabilities", p.toURLValues())
+	resp, err := s.cs.newRequest("listHypervisors", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateHypervisorCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListHypervisorsResponse
+	if err := s.cs.unmarshal("listHypervisors", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateHypervisorCapabilitiesResponse struct {
-	Hypervisor           string `json:"hypervisor,omitempty"`
-	Hypervisorversion    string `json:"hypervisorversion,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Maxdatavolumeslimit  int    `json:"maxdatavolumeslimit,omitempty"`
-	Maxguestslimit       int64  `json:"maxguestslimit,omitempty"`
-	Maxhostspercluster   int    `json:"maxhostspercluster,omitempty"`
-	Securitygroupenabled bool   `json:"securitygroupenabled,omitempty"`
-	Storagemotionenabled bool   `json:"storagemotionenabled,omitempty"`
-}
-
-type ListHypervisorCapabilitiesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
-}
-
-func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListHypervisors, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *HypervisorService) StreamHypervisors(ctx context.Context, p *ListHypervisorsParams, fn func(*Hypervisor) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+	return s.cs.streamList(ctx, "listHypervisors", p.URLValues(), "hypervisor", func(b json.RawMessage) error {
+		var v Hypervisor
+		if err := s.cs.unmarshalElement("listHypervisors", "hypervisor", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListHypervisorCapabilitiesParams = cloudstackcommon.ListHypervisorCapabilitiesParams
+type ListHypervisorCapabilitiesResponse = cloudstackcommon.ListHypervisorCapabilitiesResponse
+type HypervisorCapability = cloudstackcommon.HypervisorCapability
 
 // You should always use this function to get a new ListHypervisorCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *HypervisorService) NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams {
 	p := &ListHypervisorCapabilitiesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *HypervisorService) GetHypervisorCapabilityByID(id string) (*HypervisorCapability, int, error) {
+func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
 	p := &ListHypervisorCapabilitiesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListHypervisorCapabilities(p)
 	if err != nil {
@@ -263,31 +127,74 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string) (*HypervisorC
 }
 
 // Lists all hypervisor capabilities.
+//
+// See also GetHypervisorCapabilityByID.
 func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisorCapabilities", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listHypervisorCapabilities", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHypervisorCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listHypervisorCapabilities", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListHypervisorCapabilitiesResponse struct {
-	Count                  int                     `json:"count"`
-	HypervisorCapabilities []*HypervisorCapability `json:"hypervisorcapability"`
+// Lists the objects like ListHypervisorCapabilities, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *HypervisorService) StreamHypervisorCapabilities(ctx context.Context, p *ListHypervisorCapabilitiesParams, fn func(*HypervisorCapability) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listHypervisorCapabilities", p.URLValues(), "hypervisorcapability", func(b json.RawMessage) error {
+		var v HypervisorCapability
+		if err := s.cs.unmarshalElement("listHypervisorCapabilities", "hypervisorcapability", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type HypervisorCapability struct {
-	Hypervisor           string `json:"hypervisor,omitempty"`
-	Hypervisorversion    string `json:"hypervisorversion,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Maxdatavolumeslimit  int    `json:"maxdatavolumeslimit,omitempty"`
-	Maxguestslimit       int64  `json:"maxguestslimit,omitempty"`
-	Maxhostspercluster   int    `json:"maxhostspercluster,omitempty"`
-	Securitygroupenabled bool   `json:"securitygroupenabled,omitempty"`
-	Storagemotionenabled bool   `json:"storagemotionenabled,omitempty"`
+type UpdateHypervisorCapabilitiesParams = cloudstackcommon.UpdateHypervisorCapabilitiesParams
+type UpdateHypervisorCapabilitiesResponse = cloudstackcommon.UpdateHypervisorCapabilitiesResponse
+
+// You should always use this function to get a new UpdateHypervisorCapabilitiesParams instance,
+// as then you are sure you have configured all required params
+func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams {
+	p := &UpdateHypervisorCapabilitiesParams{}
+	return p
+}
+
+// Updates a hypervisor capabilities.
+//
+// See also ListHypervisorCapabilities, GetHypervisorCapabilityByID.
+func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateHypervisorCapabilities", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateHypervisorCapabilitiesResponse
+	if err := s.cs.unmarshal("updateHypervisorCapabilities", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
@@ -17,66 +17,139 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type ISOService struct {
+	cs *CloudStackClient
+}
+
+func NewISOService(cs *CloudStackClient) *ISOService {
+	return &ISOService{cs: cs}
+}
+
 type AttachIsoParams struct {
-	p map[string]interface{}
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *AttachIsoParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AttachIsoParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *AttachIsoParams) Command() string {
+	return "attachIso"
+}
+
+// Returns true if the API command starts an async job
+func (p *AttachIsoParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AttachIsoParams) ResponseType() interface{} {
+	return &AttachIsoResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AttachIsoParams) Validate() error {
+	v := &validator{api: "attachIso"}
+	v.required("id", p.Id != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// This param is required.
 func (p *AttachIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+	p.Id = &v
 	return
 }
 
-func (p *AttachIsoParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AttachIsoParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Id
+}
+
+func (p *AttachIsoParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *AttachIsoParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *AttachIsoParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *AttachIsoParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *AttachIsoParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *AttachIsoParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new AttachIsoParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - virtualmachineid: the ID of the virtual machine
 func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams {
 	p := &AttachIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetId(id)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Attaches an ISO to a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
 func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
-	resp, err := s.cs.newRequest("attachIso", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("attachIso", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AttachIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("attachIso", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -97,7 +170,7 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("attachIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -105,214 +178,103 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 }
 
 type AttachIsoResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type DetachIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *DetachIsoParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
-}
-
-func (p *DetachIsoParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
-}
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CopyIsoParams = cloudstackcommon.CopyIsoParams
+type CopyIsoResponse = cloudstackcommon.CopyIsoResponse
 
-// You should always use this function to get a new DetachIsoParams instance,
+// You should always use this function to get a new CopyIsoParams instance,
 // as then you are sure you have configured all required params
-func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParams {
-	p := &DetachIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+//
+// The required params are:
+//
+//   - destzoneid
+//   - id
+func (s *ISOService) NewCopyIsoParams(destzoneid string, id string) *CopyIsoParams {
+	p := &CopyIsoParams{}
+	p.SetDestzoneid(destzoneid)
+	p.SetId(id)
 	return p
 }
 
-// Detaches any ISO file (if any) currently attached to a virtual machine.
-func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
-	resp, err := s.cs.newRequest("detachIso", p.toURLValues())
+// Copies an iso from one zone to another.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
+func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("copyIso", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DetachIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CopyIsoResponse
+	if err := s.cs.unmarshal("copyIso", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -333,424 +295,347 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("copyIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DetachIsoResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ListIsosParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListIsosParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isofilter"]; found {
-		u.Set("isofilter", v.(string))
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["isready"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isready", vv)
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+type DeleteIsoParams = cloudstackcommon.DeleteIsoParams
+type DeleteIsoResponse = cloudstackcommon.DeleteIsoResponse
+
+// You should always use this function to get a new DeleteIsoParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
+	p := &DeleteIsoParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes an ISO file.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
+func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+
+	resp, err := s.cs.newRequest("deleteIso", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["showremoved"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showremoved", vv)
+
+	var r DeleteIsoResponse
+	if err := s.cs.unmarshal("deleteIso", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteIso", b, &r); err != nil {
+			return nil, err
 		}
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	return &r, nil
+}
+
+type DetachIsoParams struct {
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DetachIsoParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *ListIsosParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DetachIsoParams) Command() string {
+	return "detachIso"
 }
 
-func (p *ListIsosParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootable"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *DetachIsoParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListIsosParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DetachIsoParams) ResponseType() interface{} {
+	return &DetachIsoResponse{}
 }
 
-func (p *ListIsosParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DetachIsoParams) Validate() error {
+	v := &validator{api: "detachIso"}
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
 }
 
-func (p *ListIsosParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *DetachIsoParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *ListIsosParams) SetIsofilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DetachIsoParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["isofilter"] = v
-	return
+	return *p.Virtualmachineid
 }
 
-func (p *ListIsosParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
-	return
+func (p *DetachIsoParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
 }
 
-func (p *ListIsosParams) SetIsready(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isready"] = v
-	return
+func (p *DetachIsoParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
 }
 
-func (p *ListIsosParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+// You should always use this function to get a new DetachIsoParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - virtualmachineid: the ID of the virtual machine
+func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParams {
+	p := &DetachIsoParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
 }
 
-func (p *ListIsosParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Detaches any ISO file (if any) currently attached to a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
+func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListIsosParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("detachIso", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["listall"] = v
-	return
-}
 
-func (p *ListIsosParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DetachIsoResponse
+	if err := s.cs.unmarshal("detachIso", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *ListIsosParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("detachIso", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["page"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListIsosParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+type DetachIsoResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExtractIsoParams = cloudstackcommon.ExtractIsoParams
+type ExtractIsoResponse = cloudstackcommon.ExtractIsoResponse
+
+// You should always use this function to get a new ExtractIsoParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - mode
+func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoParams {
+	p := &ExtractIsoParams{}
+	p.SetId(id)
+	p.SetMode(mode)
+	return p
 }
 
-func (p *ListIsosParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Extracts an ISO
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
+func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *ListIsosParams) SetShowremoved(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("extractIso", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["showremoved"] = v
-	return
-}
 
-func (p *ListIsosParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ExtractIsoResponse
+	if err := s.cs.unmarshal("extractIso", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["tags"] = v
-	return
-}
 
-func (p *ListIsosParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("extractIso", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
+	return &r, nil
 }
 
+type ListIsosParams = cloudstackcommon.ListIsosParams
+type ListIsosResponse = cloudstackcommon.ListIsosResponse
+type Iso = cloudstackcommon.Iso
+
 // You should always use this function to get a new ListIsosParams instance,
 // as then you are sure you have configured all required params
 func (s *ISOService) NewListIsosParams() *ListIsosParams {
 	p := &ListIsosParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ISOService) GetIsoID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ISOService) GetIsoID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListIsosParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListIsos(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListIsos(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
@@ -759,24 +644,39 @@ func (s *ISOService) GetIsoID(name string) (string, error) {
 		return l.Isos[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Isos {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Iso
+	for _, v := range l.Isos {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Iso", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ISOService) GetIsoByName(name string) (*Iso, int, error) {
-	id, err := s.GetIsoID(name)
+func (s *ISOService) GetIsoByName(name string, opts ...OptionFunc) (*Iso, int, error) {
+	id, err := s.GetIsoID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetIsoByID(id)
+	r, count, err := s.GetIsoByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -784,11 +684,16 @@ func (s *ISOService) GetIsoByName(name string) (*Iso, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ISOService) GetIsoByID(id string) (*Iso, int, error) {
+func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error) {
 	p := &ListIsosParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListIsos(p)
 	if err != nil {
@@ -800,942 +705,551 @@ func (s *ISOService) GetIsoByID(id string) (*Iso, int, error) {
 		return nil, -1, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
-
-	if l.Count == 1 {
-		return l.Isos[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Iso UUID: %s!", id)
-}
-
-// Lists all available ISO files.
-func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
-	resp, err := s.cs.newRequest("listIsos", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListIsosResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListIsosResponse struct {
-	Count int    `json:"count"`
-	Isos  []*Iso `json:"iso"`
-}
-
-type Iso struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type RegisterIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *RegisterIsoParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
-	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
-	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
-	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
-	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *RegisterIsoParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListIsos(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
 	}
-	p.p["account"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	p.p["bootable"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.Isos[0], l.Count, nil
 	}
-	p.p["checksum"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for Iso UUID: %s!", id)
 }
 
-func (p *RegisterIsoParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
-}
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *ISOService) GetIsosByTags(tags map[string]string, opts ...OptionFunc) ([]*Iso, error) {
+	p := &ListIsosParams{}
 
-func (p *RegisterIsoParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
+	p.SetTags(tags)
 
-func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
-	p.p["imagestoreuuid"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListIsos(p)
+	if err != nil {
+		return nil, err
 	}
-	p.p["isdynamicallyscalable"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListIsos(p)
+		if err != nil {
+			return nil, err
+		}
 	}
-	p.p["isextractable"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
-	return
+	return l.Isos, nil
 }
 
-func (p *RegisterIsoParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists all available ISO files.
+//
+// See also GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
+func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["ispublic"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listIsos", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListIsosResponse
+	if err := s.cs.unmarshal("listIsos", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["ostypeid"] = v
-	return
+	return &r, nil
 }
 
-func (p *RegisterIsoParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListIsos, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ISOService) StreamIsos(ctx context.Context, p *ListIsosParams, fn func(*Iso) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *RegisterIsoParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+	return s.cs.streamList(ctx, "listIsos", p.URLValues(), "iso", func(b json.RawMessage) error {
+		var v Iso
+		if err := s.cs.unmarshalElement("listIsos", "iso", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *RegisterIsoParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type RegisterIsoParams = cloudstackcommon.RegisterIsoParams
+type RegisterIsoResponse = cloudstackcommon.RegisterIsoResponse
 
 // You should always use this function to get a new RegisterIsoParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
+//   - url
+//   - zoneid: the ID of the zone
 func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams {
 	p := &RegisterIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Registers an existing ISO into the CloudStack Cloud.
+//
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
 func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
-	resp, err := s.cs.newRequest("registerIso", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("registerIso", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("registerIso", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type RegisterIsoResponse struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
 type UpdateIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateIsoParams) toURLValues() url.Values {
+	Bootable              *bool             `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Details               map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Format                *string           `json:"format,omitempty" yaml:"format,omitempty"`
+	Id                    *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdynamicallyscalable *bool             `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isrouting             *bool             `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string           `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool             `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Sortkey               *int              `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateIsoParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Bootable; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("bootable", vv)
 	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if v := p.Format; v != nil {
+		u.Set("format", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isdynamicallyscalable; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isdynamicallyscalable", vv)
 	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrouting; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrouting", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
 	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Passwordenabled; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("passwordenabled", vv)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Sortkey; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("sortkey", vv)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *UpdateIsoParams) Command() string {
+	return "updateIso"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateIsoParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateIsoParams) ResponseType() interface{} {
+	return &UpdateIsoResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateIsoParams) Validate() error {
+	v := &validator{api: "updateIso"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
 func (p *UpdateIsoParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootable"] = v
+	p.Bootable = &v
 	return
 }
 
-func (p *UpdateIsoParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateIsoParams) GetBootable() bool {
+	if p.Bootable == nil {
+		return false
 	}
-	p.p["details"] = v
-	return
+	return *p.Bootable
 }
 
-func (p *UpdateIsoParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+func (p *UpdateIsoParams) HasBootable() bool {
+	return p.Bootable != nil
 }
 
-func (p *UpdateIsoParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
-	return
+func (p *UpdateIsoParams) ResetBootable() {
+	p.Bootable = nil
 }
 
-func (p *UpdateIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Only supported by CloudStack 4.4.
+func (p *UpdateIsoParams) SetDetails(v map[string]string) {
+	p.Details = v
 	return
 }
 
-func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
-	return
+func (p *UpdateIsoParams) GetDetails() map[string]string {
+	return p.Details
 }
 
-func (p *UpdateIsoParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrouting"] = v
-	return
+func (p *UpdateIsoParams) HasDetails() bool {
+	return p.Details != nil
 }
 
-func (p *UpdateIsoParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *UpdateIsoParams) ResetDetails() {
+	p.Details = nil
 }
 
-func (p *UpdateIsoParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateIsoParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateIsoParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["passwordenabled"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *UpdateIsoParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
-	return
+func (p *UpdateIsoParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
 }
 
-// You should always use this function to get a new UpdateIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
-	p := &UpdateIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *UpdateIsoParams) ResetDisplaytext() {
+	p.Displaytext = nil
 }
 
-// Updates an ISO file.
-func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
-	resp, err := s.cs.newRequest("updateIso", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdateIsoParams) SetFormat(v string) {
+	p.Format = &v
+	return
+}
 
-	var r UpdateIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdateIsoParams) GetFormat() string {
+	if p.Format == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Format
 }
 
-type UpdateIsoResponse struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type DeleteIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteIsoParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func (p *UpdateIsoParams) HasFormat() bool {
+	return p.Format != nil
 }
 
-func (p *DeleteIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateIsoParams) ResetFormat() {
+	p.Format = nil
 }
 
-func (p *DeleteIsoParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+// This param is required.
+func (p *UpdateIsoParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new DeleteIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
-	p := &DeleteIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *UpdateIsoParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
 }
 
-// Deletes an ISO file.
-func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
-	resp, err := s.cs.newRequest("deleteIso", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdateIsoParams) HasId() bool {
+	return p.Id != nil
+}
 
-	var r DeleteIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *UpdateIsoParams) ResetId() {
+	p.Id = nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UpdateIsoParams) SetIsdynamicallyscalable(v bool) {
+	p.Isdynamicallyscalable = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *UpdateIsoParams) GetIsdynamicallyscalable() bool {
+	if p.Isdynamicallyscalable == nil {
+		return false
 	}
-	return &r, nil
+	return *p.Isdynamicallyscalable
 }
 
-type DeleteIsoResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *UpdateIsoParams) HasIsdynamicallyscalable() bool {
+	return p.Isdynamicallyscalable != nil
 }
 
-type CopyIsoParams struct {
-	p map[string]interface{}
+func (p *UpdateIsoParams) ResetIsdynamicallyscalable() {
+	p.Isdynamicallyscalable = nil
 }
 
-func (p *CopyIsoParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["destzoneid"]; found {
-		u.Set("destzoneid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["sourcezoneid"]; found {
-		u.Set("sourcezoneid", v.(string))
-	}
-	return u
+func (p *UpdateIsoParams) SetIsrouting(v bool) {
+	p.Isrouting = &v
+	return
 }
 
-func (p *CopyIsoParams) SetDestzoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateIsoParams) GetIsrouting() bool {
+	if p.Isrouting == nil {
+		return false
 	}
-	p.p["destzoneid"] = v
-	return
+	return *p.Isrouting
 }
 
-func (p *CopyIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateIsoParams) HasIsrouting() bool {
+	return p.Isrouting != nil
 }
 
-func (p *CopyIsoParams) SetSourcezoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcezoneid"] = v
-	return
+func (p *UpdateIsoParams) ResetIsrouting() {
+	p.Isrouting = nil
 }
 
-// You should always use this function to get a new CopyIsoParams instance,
-// as then you are sure you have configured all required params
-func (s *ISOService) NewCopyIsoParams(destzoneid string, id string) *CopyIsoParams {
-	p := &CopyIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["destzoneid"] = destzoneid
-	p.p["id"] = id
-	return p
+func (p *UpdateIsoParams) SetName(v string) {
+	p.Name = &v
+	return
 }
 
-// Copies an iso from one zone to another.
-func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
-	resp, err := s.cs.newRequest("copyIso", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *UpdateIsoParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
+	return *p.Name
+}
 
-	var r CopyIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *UpdateIsoParams) HasName() bool {
+	return p.Name != nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UpdateIsoParams) ResetName() {
+	p.Name = nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+// Sets the 'ostypeid' param: the ID of the OS type.
+func (p *UpdateIsoParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *UpdateIsoParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Ostypeid
 }
 
-type CopyIsoResponse struct {
-	JobID                 string            `json:"jobid,omitempty"`
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type UpdateIsoPermissionsParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accounts"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("accounts", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
-	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["op"]; found {
-		u.Set("op", v.(string))
-	}
-	if v, found := p.p["projectids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("projectids", vv)
-	}
-	return u
+func (p *UpdateIsoParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
 }
 
-func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounts"] = v
-	return
+func (p *UpdateIsoParams) ResetOstypeid() {
+	p.Ostypeid = nil
 }
 
-func (p *UpdateIsoPermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+func (p *UpdateIsoParams) SetPasswordenabled(v bool) {
+	p.Passwordenabled = &v
 	return
 }
 
-func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateIsoParams) GetPasswordenabled() bool {
+	if p.Passwordenabled == nil {
+		return false
 	}
-	p.p["isextractable"] = v
-	return
+	return *p.Passwordenabled
 }
 
-func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
-	return
+func (p *UpdateIsoParams) HasPasswordenabled() bool {
+	return p.Passwordenabled != nil
 }
 
-func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
-	return
+func (p *UpdateIsoParams) ResetPasswordenabled() {
+	p.Passwordenabled = nil
 }
 
-func (p *UpdateIsoPermissionsParams) SetOp(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["op"] = v
+func (p *UpdateIsoParams) SetSortkey(v int) {
+	p.Sortkey = &v
 	return
 }
 
-func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateIsoParams) GetSortkey() int {
+	if p.Sortkey == nil {
+		return 0
 	}
-	p.p["projectids"] = v
-	return
+	return *p.Sortkey
 }
 
-// You should always use this function to get a new UpdateIsoPermissionsParams instance,
+func (p *UpdateIsoParams) HasSortkey() bool {
+	return p.Sortkey != nil
+}
+
+func (p *UpdateIsoParams) ResetSortkey() {
+	p.Sortkey = nil
+}
+
+// You should always use this function to get a new UpdateIsoParams instance,
 // as then you are sure you have configured all required params
-func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams {
-	p := &UpdateIsoPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
+	p := &UpdateIsoParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates iso permissions
-func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateIsoPermissions", p.toURLValues())
+// Updates an ISO file.
+//
+// See also ListIsos, GetIsoID, GetIsoByName, GetIsoByID, GetIsosByTags.
+func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateIso", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateIsoPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateIsoResponse
+	if err := s.cs.unmarshal("updateIso", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateIsoPermissionsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ListIsoPermissionsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListIsoPermissionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *ListIsoPermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type UpdateIsoResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListIsoPermissionsParams = cloudstackcommon.ListIsoPermissionsParams
+type ListIsoPermissionsResponse = cloudstackcommon.ListIsoPermissionsResponse
+type IsoPermission = cloudstackcommon.IsoPermission
 
 // You should always use this function to get a new ListIsoPermissionsParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams {
 	p := &ListIsoPermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ISOService) GetIsoPermissionByID(id string) (*IsoPermission, int, error) {
+func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
 	p := &ListIsoPermissionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
-	p.p["id"] = id
+	p.SetId(id)
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListIsoPermissions(p)
 	if err != nil {
@@ -1758,148 +1272,79 @@ func (s *ISOService) GetIsoPermissionByID(id string) (*IsoPermission, int, error
 }
 
 // List iso visibility and all accounts that have permissions to view this iso.
+//
+// See also GetIsoPermissionByID.
 func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listIsoPermissions", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listIsoPermissions", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIsoPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listIsoPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListIsoPermissionsResponse struct {
-	Count          int              `json:"count"`
-	IsoPermissions []*IsoPermission `json:"isopermission"`
-}
-
-type IsoPermission struct {
-	Account    []string `json:"account,omitempty"`
-	Domainid   string   `json:"domainid,omitempty"`
-	Id         string   `json:"id,omitempty"`
-	Ispublic   bool     `json:"ispublic,omitempty"`
-	Projectids []string `json:"projectids,omitempty"`
-}
-
-type ExtractIsoParams struct {
-	p map[string]interface{}
-}
-
-func (p *ExtractIsoParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ExtractIsoParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListIsoPermissions, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ISOService) StreamIsoPermissions(ctx context.Context, p *ListIsoPermissionsParams, fn func(*IsoPermission) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ExtractIsoParams) SetMode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["mode"] = v
-	return
-}
-
-func (p *ExtractIsoParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+	return s.cs.streamList(ctx, "listIsoPermissions", p.URLValues(), "isopermission", func(b json.RawMessage) error {
+		var v IsoPermission
+		if err := s.cs.unmarshalElement("listIsoPermissions", "isopermission", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ExtractIsoParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type UpdateIsoPermissionsParams = cloudstackcommon.UpdateIsoPermissionsParams
+type UpdateIsoPermissionsResponse = cloudstackcommon.UpdateIsoPermissionsResponse
 
-// You should always use this function to get a new ExtractIsoParams instance,
+// You should always use this function to get a new UpdateIsoPermissionsParams instance,
 // as then you are sure you have configured all required params
-func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoParams {
-	p := &ExtractIsoParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
+//
+// The required params are:
+//
+//   - id
+func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams {
+	p := &UpdateIsoPermissionsParams{}
+	p.SetId(id)
 	return p
 }
 
-// Extracts an ISO
-func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
-	resp, err := s.cs.newRequest("extractIso", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Updates iso permissions
+//
+// See also ListIsoPermissions, GetIsoPermissionByID.
+func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	var r ExtractIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequest("updateIsoPermissions", p.URLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r UpdateIsoPermissionsResponse
+	if err := s.cs.unmarshal("updateIsoPermissions", resp, &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
-
-type ExtractIsoResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Accountid        string `json:"accountid,omitempty"`
-	Created          string `json:"created,omitempty"`
-	ExtractId        string `json:"extractId,omitempty"`
-	ExtractMode      string `json:"extractMode,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Resultstring     string `json:"resultstring,omitempty"`
-	State            string `json:"state,omitempty"`
-	Status           string `json:"status,omitempty"`
-	Storagetype      string `json:"storagetype,omitempty"`
-	Uploadpercentage int    `json:"uploadpercentage,omitempty"`
-	Url              string `json:"url,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
-}
@@ -17,236 +17,156 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddImageStoreParams struct {
-	p map[string]interface{}
+type ImageStoreService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddImageStoreParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func NewImageStoreService(cs *CloudStackClient) *ImageStoreService {
+	return &ImageStoreService{cs: cs}
 }
 
-func (p *AddImageStoreParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
-}
+type UpdateCloudToUseObjectStoreParams = cloudstackcommon.UpdateCloudToUseObjectStoreParams
+type UpdateCloudToUseObjectStoreResponse = cloudstackcommon.UpdateCloudToUseObjectStoreResponse
 
-func (p *AddImageStoreParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+// You should always use this function to get a new UpdateCloudToUseObjectStoreParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - provider
+func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams {
+	p := &UpdateCloudToUseObjectStoreParams{}
+	p.SetProvider(provider)
+	return p
 }
 
-func (p *AddImageStoreParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Migrate current NFS secondary storages to use object store.
+func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["provider"] = v
-	return
-}
 
-func (p *AddImageStoreParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("updateCloudToUseObjectStore", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["url"] = v
-	return
-}
 
-func (p *AddImageStoreParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateCloudToUseObjectStoreResponse
+	if err := s.cs.unmarshal("updateCloudToUseObjectStore", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["zoneid"] = v
-	return
+	return &r, nil
 }
 
+type AddImageStoreParams = cloudstackcommon.AddImageStoreParams
+type AddImageStoreResponse = cloudstackcommon.AddImageStoreResponse
+
 // You should always use this function to get a new AddImageStoreParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - provider
 func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageStoreParams {
 	p := &AddImageStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["provider"] = provider
+	p.SetProvider(provider)
 	return p
 }
 
 // Adds backup image store.
+//
+// See also ListImageStores, GetImageStoreID, GetImageStoreByName, GetImageStoreByID.
 func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("addImageStore", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addImageStore", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddImageStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addImageStore", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddImageStoreResponse struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
-}
+type DeleteImageStoreParams = cloudstackcommon.DeleteImageStoreParams
+type DeleteImageStoreResponse = cloudstackcommon.DeleteImageStoreResponse
 
-type ListImageStoresParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListImageStoresParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListImageStoresParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListImageStoresParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListImageStoresParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListImageStoresParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// You should always use this function to get a new DeleteImageStoreParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageStoreParams {
+	p := &DeleteImageStoreParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListImageStoresParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Deletes an image store .
+//
+// See also ListImageStores, GetImageStoreID, GetImageStoreByName, GetImageStoreByID.
+func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListImageStoresParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteImageStore", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["protocol"] = v
-	return
-}
 
-func (p *ListImageStoresParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteImageStoreResponse
+	if err := s.cs.unmarshal("deleteImageStore", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["provider"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListImageStoresParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListImageStoresParams = cloudstackcommon.ListImageStoresParams
+type ListImageStoresResponse = cloudstackcommon.ListImageStoresResponse
+type ImageStore = cloudstackcommon.ImageStore
 
 // You should always use this function to get a new ListImageStoresParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
 	p := &ListImageStoresParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ImageStoreService) GetImageStoreID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListImageStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListImageStores(p)
 	if err != nil {
@@ -261,24 +181,37 @@ func (s *ImageStoreService) GetImageStoreID(name string) (string, error) {
 		return l.ImageStores[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.ImageStores {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*ImageStore
+	for _, v := range l.ImageStores {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "ImageStore", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ImageStoreService) GetImageStoreByName(name string) (*ImageStore, int, error) {
-	id, err := s.GetImageStoreID(name)
+func (s *ImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error) {
+	id, err := s.GetImageStoreID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetImageStoreByID(id)
+	r, count, err := s.GetImageStoreByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -286,11 +219,16 @@ func (s *ImageStoreService) GetImageStoreByName(name string) (*ImageStore, int,
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ImageStoreService) GetImageStoreByID(id string) (*ImageStore, int, error) {
+func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error) {
 	p := &ListImageStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListImageStores(p)
 	if err != nil {
@@ -313,310 +251,145 @@ func (s *ImageStoreService) GetImageStoreByID(id string) (*ImageStore, int, erro
 }
 
 // Lists image stores.
+//
+// See also GetImageStoreID, GetImageStoreByName, GetImageStoreByID.
 func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
-	resp, err := s.cs.newRequest("listImageStores", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListImageStoresResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListImageStoresResponse struct {
-	Count       int           `json:"count"`
-	ImageStores []*ImageStore `json:"imagestore"`
-}
-
-type ImageStore struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
-}
-
-type DeleteImageStoreParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteImageStoreParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return u
-}
 
-func (p *DeleteImageStoreParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new DeleteImageStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageStoreParams {
-	p := &DeleteImageStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Deletes an image store .
-func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteImageStore", p.toURLValues())
+	resp, err := s.cs.newRequest("listImageStores", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteImageStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListImageStoresResponse
+	if err := s.cs.unmarshal("listImageStores", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteImageStoreResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type CreateSecondaryStagingStoreParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+// Lists the objects like ListImageStores, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ImageStoreService) StreamImageStores(ctx context.Context, p *ListImageStoresParams, fn func(*ImageStore) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
-}
-
-func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
-	return
-}
-
-func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scope"] = v
-	return
-}
 
-func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+	return s.cs.streamList(ctx, "listImageStores", p.URLValues(), "imagestore", func(b json.RawMessage) error {
+		var v ImageStore
+		if err := s.cs.unmarshalElement("listImageStores", "imagestore", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type CreateSecondaryStagingStoreParams = cloudstackcommon.CreateSecondaryStagingStoreParams
+type CreateSecondaryStagingStoreResponse = cloudstackcommon.CreateSecondaryStagingStoreResponse
 
 // You should always use this function to get a new CreateSecondaryStagingStoreParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - url
 func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams {
 	p := &CreateSecondaryStagingStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
+	p.SetUrl(url)
 	return p
 }
 
 // create secondary staging store.
+//
+// See also ListSecondaryStagingStores, GetSecondaryStagingStoreID, GetSecondaryStagingStoreByName, GetSecondaryStagingStoreByID.
 func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("createSecondaryStagingStore", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createSecondaryStagingStore", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSecondaryStagingStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createSecondaryStagingStore", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type CreateSecondaryStagingStoreResponse struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
-}
-
-type ListSecondaryStagingStoresParams struct {
-	p map[string]interface{}
-}
+type DeleteSecondaryStagingStoreParams = cloudstackcommon.DeleteSecondaryStagingStoreParams
+type DeleteSecondaryStagingStoreResponse = cloudstackcommon.DeleteSecondaryStagingStoreResponse
 
-func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListSecondaryStagingStoresParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListSecondaryStagingStoresParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// You should always use this function to get a new DeleteSecondaryStagingStoreParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams {
+	p := &DeleteSecondaryStagingStoreParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Deletes a secondary staging store .
+//
+// See also ListSecondaryStagingStores, GetSecondaryStagingStoreID, GetSecondaryStagingStoreByName, GetSecondaryStagingStoreByID.
+func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["protocol"] = v
-	return
-}
 
-func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteSecondaryStagingStoreResponse
+	if err := s.cs.unmarshal("deleteSecondaryStagingStore", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["provider"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListSecondaryStagingStoresParams = cloudstackcommon.ListSecondaryStagingStoresParams
+type ListSecondaryStagingStoresResponse = cloudstackcommon.ListSecondaryStagingStoresResponse
+type SecondaryStagingStore = cloudstackcommon.SecondaryStagingStore
 
 // You should always use this function to get a new ListSecondaryStagingStoresParams instance,
 // as then you are sure you have configured all required params
 func (s *ImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams {
 	p := &ListSecondaryStagingStoresParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ImageStoreService) GetSecondaryStagingStoreID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListSecondaryStagingStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListSecondaryStagingStores(p)
 	if err != nil {
@@ -631,24 +404,37 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string) (string, err
 		return l.SecondaryStagingStores[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.SecondaryStagingStores {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*SecondaryStagingStore
+	for _, v := range l.SecondaryStagingStores {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "SecondaryStagingStore", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string) (*SecondaryStagingStore, int, error) {
-	id, err := s.GetSecondaryStagingStoreID(name)
+func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	id, err := s.GetSecondaryStagingStoreID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetSecondaryStagingStoreByID(id)
+	r, count, err := s.GetSecondaryStagingStoreByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -656,11 +442,16 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string) (*Second
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string) (*SecondaryStagingStore, int, error) {
+func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListSecondaryStagingStores(p)
 	if err != nil {
@@ -683,179 +474,42 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string) (*SecondaryS
 }
 
 // Lists secondary staging stores.
+//
+// See also GetSecondaryStagingStoreID, GetSecondaryStagingStoreByName, GetSecondaryStagingStoreByID.
 func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
-	resp, err := s.cs.newRequest("listSecondaryStagingStores", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListSecondaryStagingStoresResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListSecondaryStagingStoresResponse struct {
-	Count                  int                      `json:"count"`
-	SecondaryStagingStores []*SecondaryStagingStore `json:"secondarystagingstore"`
-}
-
-type SecondaryStagingStore struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
-}
-
-type DeleteSecondaryStagingStoreParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-// You should always use this function to get a new DeleteSecondaryStagingStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams {
-	p := &DeleteSecondaryStagingStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Deletes a secondary staging store .
-func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecondaryStagingStore", p.toURLValues())
+	resp, err := s.cs.newRequest("listSecondaryStagingStores", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteSecondaryStagingStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListSecondaryStagingStoresResponse
+	if err := s.cs.unmarshal("listSecondaryStagingStores", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteSecondaryStagingStoreResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type UpdateCloudToUseObjectStoreParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+// Lists the objects like ListSecondaryStagingStores, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ImageStoreService) StreamSecondaryStagingStores(ctx context.Context, p *ListSecondaryStagingStoresParams, fn func(*SecondaryStagingStore) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	return u
-}
-
-func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
-}
-
-func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
-	return
-}
-
-func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
-
-// You should always use this function to get a new UpdateCloudToUseObjectStoreParams instance,
-// as then you are sure you have configured all required params
-func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams {
-	p := &UpdateCloudToUseObjectStoreParams{}
-	p.p = make(map[string]interface{})
-	p.p["provider"] = provider
-	return p
-}
 
-// Migrate current NFS secondary storages to use object store.
-func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
-	resp, err := s.cs.newRequest("updateCloudToUseObjectStore", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r UpdateCloudToUseObjectStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type UpdateCloudToUseObjectStoreResponse struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
+	return s.cs.streamList(ctx, "listSecondaryStagingStores", p.URLValues(), "secondarystagingstore", func(b json.RawMessage) error {
+		var v SecondaryStagingStore
+		if err := s.cs.unmarshalElement("listSecondaryStagingStores", "secondarystagingstore", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
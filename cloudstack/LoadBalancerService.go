@@ -17,205 +17,334 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateLoadBalancerRuleParams struct {
-	p map[string]interface{}
+type LoadBalancerService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
+func NewLoadBalancerService(cs *CloudStackClient) *LoadBalancerService {
+	return &LoadBalancerService{cs: cs}
+}
+
+type RemoveCertFromLoadBalancerParams = cloudstackcommon.RemoveCertFromLoadBalancerParams
+type RemoveCertFromLoadBalancerResponse = cloudstackcommon.RemoveCertFromLoadBalancerResponse
+
+// You should always use this function to get a new RemoveCertFromLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lbruleid
+func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams {
+	p := &RemoveCertFromLoadBalancerParams{}
+	p.SetLbruleid(lbruleid)
+	return p
+}
+
+// Removes a certificate from a Load Balancer Rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
+
+	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+
+	var r RemoveCertFromLoadBalancerResponse
+	if err := s.cs.unmarshal("removeCertFromLoadBalancer", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("removeCertFromLoadBalancer", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	return &r, nil
+}
+
+type AssignCertToLoadBalancerParams = cloudstackcommon.AssignCertToLoadBalancerParams
+type AssignCertToLoadBalancerResponse = cloudstackcommon.AssignCertToLoadBalancerResponse
+
+// You should always use this function to get a new AssignCertToLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - certid
+//   - lbruleid
+func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
+	p := &AssignCertToLoadBalancerParams{}
+	p.SetCertid(certid)
+	p.SetLbruleid(lbruleid)
+	return p
+}
+
+// Assigns a certificate to a Load Balancer Rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+
+	resp, err := s.cs.newRequest("assignCertToLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+
+	var r AssignCertToLoadBalancerResponse
+	if err := s.cs.unmarshal("assignCertToLoadBalancer", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("assignCertToLoadBalancer", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
+	return &r, nil
+}
+
+type AddF5LoadBalancerParams struct {
+	Networkdevicetype *string `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddF5LoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Networkdevicetype; v != nil {
+		u.Set("networkdevicetype", *v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["publicport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicport", vv)
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
 	}
 	return u
 }
 
-func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddF5LoadBalancerParams) Command() string {
+	return "addF5LoadBalancer"
 }
 
-func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["algorithm"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AddF5LoadBalancerParams) IsAsync() bool {
+	return true
 }
 
-func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddF5LoadBalancerParams) ResponseType() interface{} {
+	return &AddF5LoadBalancerResponse{}
 }
 
-func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddF5LoadBalancerParams) Validate() error {
+	v := &validator{api: "addF5LoadBalancer"}
+	v.required("networkdevicetype", p.Networkdevicetype != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
 }
 
-func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// This param is required.
+func (p *AddF5LoadBalancerParams) SetNetworkdevicetype(v string) {
+	p.Networkdevicetype = &v
 	return
 }
 
-func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddF5LoadBalancerParams) GetNetworkdevicetype() string {
+	if p.Networkdevicetype == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
-	return
+	return *p.Networkdevicetype
 }
 
-func (p *CreateLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *AddF5LoadBalancerParams) HasNetworkdevicetype() bool {
+	return p.Networkdevicetype != nil
 }
 
-func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+func (p *AddF5LoadBalancerParams) ResetNetworkdevicetype() {
+	p.Networkdevicetype = nil
 }
 
-func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["openfirewall"] = v
+// Sets the 'password' param: the password. This param is required.
+func (p *AddF5LoadBalancerParams) SetPassword(v string) {
+	p.Password = &v
 	return
 }
 
-func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddF5LoadBalancerParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	p.p["privateport"] = v
-	return
+	return *p.Password
 }
 
-func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+func (p *AddF5LoadBalancerParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddF5LoadBalancerParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddF5LoadBalancerParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddF5LoadBalancerParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	p.p["publicipid"] = v
+	return *p.Physicalnetworkid
+}
+
+func (p *AddF5LoadBalancerParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddF5LoadBalancerParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddF5LoadBalancerParams) SetUrl(v string) {
+	p.Url = &v
 	return
 }
 
-func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddF5LoadBalancerParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
 	}
-	p.p["publicport"] = v
+	return *p.Url
+}
+
+func (p *AddF5LoadBalancerParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddF5LoadBalancerParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddF5LoadBalancerParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
-func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddF5LoadBalancerParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Username
 }
 
-// You should always use this function to get a new CreateLoadBalancerRuleParams instance,
+func (p *AddF5LoadBalancerParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddF5LoadBalancerParams) ResetUsername() {
+	p.Username = nil
+}
+
+// You should always use this function to get a new AddF5LoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
-	p := &CreateLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["algorithm"] = algorithm
-	p.p["name"] = name
-	p.p["privateport"] = privateport
-	p.p["publicport"] = publicport
+//
+// The required params are:
+//
+//   - networkdevicetype
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - url
+//   - username: the name of the user
+func (s *LoadBalancerService) NewAddF5LoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddF5LoadBalancerParams {
+	p := &AddF5LoadBalancerParams{}
+	p.SetNetworkdevicetype(networkdevicetype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Creates a load balancer rule
-func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancerRule", p.toURLValues())
+// Adds a F5 BigIP load balancer device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.3.
+// See also ListF5LoadBalancers.
+func (s *LoadBalancerService) AddF5LoadBalancer(p *AddF5LoadBalancerParams) (*AddF5LoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addF5LoadBalancer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddF5LoadBalancerResponse
+	if err := s.cs.unmarshal("addF5LoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -236,89 +365,141 @@ func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRulePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addF5LoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Account     string `json:"account,omitempty"`
-	Algorithm   string `json:"algorithm,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Description string `json:"description,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Fordisplay  bool   `json:"fordisplay,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Privateport string `json:"privateport,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Publicip    string `json:"publicip,omitempty"`
-	Publicipid  string `json:"publicipid,omitempty"`
-	Publicport  string `json:"publicport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type DeleteLoadBalancerRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
+type AddF5LoadBalancerResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Lbdevicecapacity  int64                      `json:"lbdevicecapacity,omitempty" xml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated Bool                       `json:"lbdevicededicated,omitempty" xml:"lbdevicededicated,omitempty"`
+	Lbdeviceid        string                     `json:"lbdeviceid,omitempty" xml:"lbdeviceid,omitempty"`
+	Lbdevicename      string                     `json:"lbdevicename,omitempty" xml:"lbdevicename,omitempty"`
+	Lbdevicestate     string                     `json:"lbdevicestate,omitempty" xml:"lbdevicestate,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ConfigureF5LoadBalancerParams struct {
+	Lbdevicecapacity *int64  `json:"lbdevicecapacity,omitempty" yaml:"lbdevicecapacity,omitempty"`
+	Lbdeviceid       *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigureF5LoadBalancerParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Lbdevicecapacity; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("lbdevicecapacity", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
 	}
 	return u
 }
 
-func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ConfigureF5LoadBalancerParams) Command() string {
+	return "configureF5LoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigureF5LoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigureF5LoadBalancerParams) ResponseType() interface{} {
+	return &ConfigureF5LoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigureF5LoadBalancerParams) Validate() error {
+	v := &validator{api: "configureF5LoadBalancer"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+func (p *ConfigureF5LoadBalancerParams) SetLbdevicecapacity(v int64) {
+	p.Lbdevicecapacity = &v
+	return
+}
+
+func (p *ConfigureF5LoadBalancerParams) GetLbdevicecapacity() int64 {
+	if p.Lbdevicecapacity == nil {
+		return 0
 	}
-	p.p["id"] = v
+	return *p.Lbdevicecapacity
+}
+
+func (p *ConfigureF5LoadBalancerParams) HasLbdevicecapacity() bool {
+	return p.Lbdevicecapacity != nil
+}
+
+func (p *ConfigureF5LoadBalancerParams) ResetLbdevicecapacity() {
+	p.Lbdevicecapacity = nil
+}
+
+// This param is required.
+func (p *ConfigureF5LoadBalancerParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
 	return
 }
 
-// You should always use this function to get a new DeleteLoadBalancerRuleParams instance,
+func (p *ConfigureF5LoadBalancerParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
+	}
+	return *p.Lbdeviceid
+}
+
+func (p *ConfigureF5LoadBalancerParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *ConfigureF5LoadBalancerParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+// You should always use this function to get a new ConfigureF5LoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
-	p := &DeleteLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *LoadBalancerService) NewConfigureF5LoadBalancerParams(lbdeviceid string) *ConfigureF5LoadBalancerParams {
+	p := &ConfigureF5LoadBalancerParams{}
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// Deletes a load balancer rule.
-func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancerRule", p.toURLValues())
+// configures a F5 load balancer device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.3.
+// See also ListF5LoadBalancers.
+func (s *LoadBalancerService) ConfigureF5LoadBalancer(p *ConfigureF5LoadBalancerParams) (*ConfigureF5LoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("configureF5LoadBalancer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ConfigureF5LoadBalancerResponse
+	if err := s.cs.unmarshal("configureF5LoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -334,88 +515,121 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("configureF5LoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type ConfigureF5LoadBalancerResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Lbdevicecapacity  int64                      `json:"lbdevicecapacity,omitempty" xml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated Bool                       `json:"lbdevicededicated,omitempty" xml:"lbdevicededicated,omitempty"`
+	Lbdeviceid        string                     `json:"lbdeviceid,omitempty" xml:"lbdeviceid,omitempty"`
+	Lbdevicename      string                     `json:"lbdevicename,omitempty" xml:"lbdevicename,omitempty"`
+	Lbdevicestate     string                     `json:"lbdevicestate,omitempty" xml:"lbdevicestate,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type RemoveFromLoadBalancerRuleParams struct {
-	p map[string]interface{}
+type DeleteF5LoadBalancerParams struct {
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteF5LoadBalancerParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("virtualmachineids", vv)
-	}
-	if v, found := p.p["vmidipmap"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), vv)
-			i++
-		}
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
 	}
 	return u
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DeleteF5LoadBalancerParams) Command() string {
+	return "deleteF5LoadBalancer"
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineids"] = v
+// Returns true if the API command starts an async job
+func (p *DeleteF5LoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteF5LoadBalancerParams) ResponseType() interface{} {
+	return &DeleteF5LoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteF5LoadBalancerParams) Validate() error {
+	v := &validator{api: "deleteF5LoadBalancer"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteF5LoadBalancerParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
 	return
 }
 
-func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteF5LoadBalancerParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
 	}
-	p.p["vmidipmap"] = v
-	return
+	return *p.Lbdeviceid
 }
 
-// You should always use this function to get a new RemoveFromLoadBalancerRuleParams instance,
+func (p *DeleteF5LoadBalancerParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *DeleteF5LoadBalancerParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+// You should always use this function to get a new DeleteF5LoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams {
-	p := &RemoveFromLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *LoadBalancerService) NewDeleteF5LoadBalancerParams(lbdeviceid string) *DeleteF5LoadBalancerParams {
+	p := &DeleteF5LoadBalancerParams{}
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// Removes a virtual machine or a list of virtual machines from a load balancer rule.
-func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", p.toURLValues())
+//	delete a F5 load balancer device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.3.
+// See also ListF5LoadBalancers.
+func (s *LoadBalancerService) DeleteF5LoadBalancer(p *DeleteF5LoadBalancerParams) (*DeleteF5LoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteF5LoadBalancer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveFromLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteF5LoadBalancerResponse
+	if err := s.cs.unmarshal("deleteF5LoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -431,332 +645,279 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteF5LoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveFromLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type DeleteF5LoadBalancerResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type AssignToLoadBalancerRuleParams struct {
-	p map[string]interface{}
+type ListF5LoadBalancersParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid        *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
 }
 
-func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListF5LoadBalancersParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
 	}
-	if v, found := p.p["virtualmachineids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("virtualmachineids", vv)
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
 	}
-	if v, found := p.p["vmidipmap"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("vmidipmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("vmidipmap[%d].value", i), vv)
-			i++
-		}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
 	return u
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListF5LoadBalancersParams) Command() string {
+	return "listF5LoadBalancers"
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineids"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListF5LoadBalancersParams) IsAsync() bool {
+	return false
 }
 
-func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmidipmap"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListF5LoadBalancersParams) ResponseType() interface{} {
+	return &ListF5LoadBalancersResponse{}
 }
 
-// You should always use this function to get a new AssignToLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
-	p := &AssignToLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListF5LoadBalancersParams) Validate() error {
+	v := &validator{api: "listF5LoadBalancers"}
+	return v.err()
 }
 
-// Assigns virtual machine or a list of virtual machines to a load balancer rule.
-func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToLoadBalancerRule", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListF5LoadBalancersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
 
-	var r AssignToLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListF5LoadBalancersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
+	return *p.Keyword
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+func (p *ListF5LoadBalancersParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-type AssignToLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *ListF5LoadBalancersParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-type CreateLBStickinessPolicyParams struct {
-	p map[string]interface{}
+func (p *ListF5LoadBalancersParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
+	return
 }
 
-func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+func (p *ListF5LoadBalancersParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
 	}
-	if v, found := p.p["methodname"]; found {
-		u.Set("methodname", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["param"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("param[%d].key", i), k)
-			u.Set(fmt.Sprintf("param[%d].value", i), vv)
-			i++
-		}
-	}
-	return u
+	return *p.Lbdeviceid
 }
 
-func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
+func (p *ListF5LoadBalancersParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
 }
 
-func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+func (p *ListF5LoadBalancersParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
 }
 
-func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListF5LoadBalancersParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListF5LoadBalancersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["methodname"] = v
-	return
+	return *p.Page
 }
 
-func (p *CreateLBStickinessPolicyParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *ListF5LoadBalancersParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *CreateLBStickinessPolicyParams) SetParam(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["param"] = v
-	return
+func (p *ListF5LoadBalancersParams) ResetPage() {
+	p.Page = nil
 }
 
-// You should always use this function to get a new CreateLBStickinessPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
-	p := &CreateLBStickinessPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
-	p.p["methodname"] = methodname
-	p.p["name"] = name
-	return p
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListF5LoadBalancersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-// Creates a Load Balancer stickiness policy
-func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBStickinessPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *ListF5LoadBalancersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
+	return *p.Pagesize
+}
 
-	var r CreateLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *ListF5LoadBalancersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListF5LoadBalancersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListF5LoadBalancersParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *ListF5LoadBalancersParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Physicalnetworkid
 }
 
-type CreateLBStickinessPolicyResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Account          string `json:"account,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Domain           string `json:"domain,omitempty"`
-	Domainid         string `json:"domainid,omitempty"`
-	Lbruleid         string `json:"lbruleid,omitempty"`
-	Name             string `json:"name,omitempty"`
-	State            string `json:"state,omitempty"`
-	Stickinesspolicy []struct {
-		Description string            `json:"description,omitempty"`
-		Fordisplay  bool              `json:"fordisplay,omitempty"`
-		Id          string            `json:"id,omitempty"`
-		Methodname  string            `json:"methodname,omitempty"`
-		Name        string            `json:"name,omitempty"`
-		Params      map[string]string `json:"params,omitempty"`
-		State       string            `json:"state,omitempty"`
-	} `json:"stickinesspolicy,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
+func (p *ListF5LoadBalancersParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
 }
 
-type UpdateLBStickinessPolicyParams struct {
-	p map[string]interface{}
+func (p *ListF5LoadBalancersParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
 }
 
-func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+// You should always use this function to get a new ListF5LoadBalancersParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListF5LoadBalancersParams() *ListF5LoadBalancersParams {
+	p := &ListF5LoadBalancersParams{}
+	return p
+}
+
+// lists F5 load balancer devices
+//
+// Only supported by CloudStack 4.3.
+func (s *LoadBalancerService) ListF5LoadBalancers(p *ListF5LoadBalancersParams) (*ListF5LoadBalancersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+
+	resp, err := s.cs.newRequest("listF5LoadBalancers", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	var r ListF5LoadBalancersResponse
+	if err := s.cs.unmarshal("listF5LoadBalancers", resp, &r); err != nil {
+		return nil, err
 	}
-	return u
+	return &r, nil
 }
 
-func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListF5LoadBalancers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamF5LoadBalancers(ctx context.Context, p *ListF5LoadBalancersParams, fn func(*F5LoadBalancer) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["customid"] = v
-	return
+
+	return s.cs.streamList(ctx, "listF5LoadBalancers", p.URLValues(), "f5loadbalancer", func(b json.RawMessage) error {
+		var v F5LoadBalancer
+		if err := s.cs.unmarshalElement("listF5LoadBalancers", "f5loadbalancer", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+type ListF5LoadBalancersResponse struct {
+	Count           int                        `json:"count" xml:"count"`
+	F5LoadBalancers []*F5LoadBalancer          `json:"f5loadbalancer" xml:"f5loadbalancer"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+type F5LoadBalancer struct {
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Lbdevicecapacity  int64                      `json:"lbdevicecapacity,omitempty" xml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated Bool                       `json:"lbdevicededicated,omitempty" xml:"lbdevicededicated,omitempty"`
+	Lbdeviceid        string                     `json:"lbdeviceid,omitempty" xml:"lbdeviceid,omitempty"`
+	Lbdevicename      string                     `json:"lbdevicename,omitempty" xml:"lbdevicename,omitempty"`
+	Lbdevicestate     string                     `json:"lbdevicestate,omitempty" xml:"lbdevicestate,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-// You should always use this function to get a new UpdateLBStickinessPolicyParams instance,
+type RemoveFromGlobalLoadBalancerRuleParams = cloudstackcommon.RemoveFromGlobalLoadBalancerRuleParams
+type RemoveFromGlobalLoadBalancerRuleResponse = cloudstackcommon.RemoveFromGlobalLoadBalancerRuleResponse
+
+// You should always use this function to get a new RemoveFromGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams {
-	p := &UpdateLBStickinessPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+//   - loadbalancerrulelist
+func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams {
+	p := &RemoveFromGlobalLoadBalancerRuleParams{}
+	p.SetId(id)
+	p.SetLoadbalancerrulelist(loadbalancerrulelist)
 	return p
 }
 
-// Updates LB Stickiness policy
-func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBStickinessPolicy", p.toURLValues())
+// Removes a load balancer rule association with global load balancer rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeFromGlobalLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RemoveFromGlobalLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("removeFromGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -772,80 +933,150 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("removeFromGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateLBStickinessPolicyResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Account          string `json:"account,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Domain           string `json:"domain,omitempty"`
-	Domainid         string `json:"domainid,omitempty"`
-	Lbruleid         string `json:"lbruleid,omitempty"`
-	Name             string `json:"name,omitempty"`
-	State            string `json:"state,omitempty"`
-	Stickinesspolicy []struct {
-		Description string            `json:"description,omitempty"`
-		Fordisplay  bool              `json:"fordisplay,omitempty"`
-		Id          string            `json:"id,omitempty"`
-		Methodname  string            `json:"methodname,omitempty"`
-		Name        string            `json:"name,omitempty"`
-		Params      map[string]string `json:"params,omitempty"`
-		State       string            `json:"state,omitempty"`
-	} `json:"stickinesspolicy,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type DeleteLBStickinessPolicyParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
+type RemoveFromLoadBalancerRuleParams struct {
+	Id                *string       `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineids []string      `json:"virtualmachineids,omitempty" yaml:"virtualmachineids,omitempty"`
+	Vmidipmap         []VMIPMapping `json:"vmidipmap,omitempty" yaml:"vmidipmap,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveFromLoadBalancerRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Virtualmachineids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("virtualmachineids", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Vmidipmap; v != nil {
+		for i, vv := range v {
+			if vv.Vmid != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmid", i), vv.Vmid)
+			}
+			if vv.Vmip != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmip", i), vv.Vmip)
+			}
+		}
 	}
 	return u
 }
 
-func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *RemoveFromLoadBalancerRuleParams) Command() string {
+	return "removeFromLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveFromLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveFromLoadBalancerRuleParams) ResponseType() interface{} {
+	return &RemoveFromLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveFromLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "removeFromLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Id
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
+	p.Virtualmachineids = v
 	return
 }
 
-// You should always use this function to get a new DeleteLBStickinessPolicyParams instance,
+func (p *RemoveFromLoadBalancerRuleParams) GetVirtualmachineids() []string {
+	return p.Virtualmachineids
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) HasVirtualmachineids() bool {
+	return p.Virtualmachineids != nil
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) ResetVirtualmachineids() {
+	p.Virtualmachineids = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *RemoveFromLoadBalancerRuleParams) SetVmidipmap(v []VMIPMapping) {
+	p.Vmidipmap = v
+	return
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) GetVmidipmap() []VMIPMapping {
+	return p.Vmidipmap
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) HasVmidipmap() bool {
+	return p.Vmidipmap != nil
+}
+
+func (p *RemoveFromLoadBalancerRuleParams) ResetVmidipmap() {
+	p.Vmidipmap = nil
+}
+
+// You should always use this function to get a new RemoveFromLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
-	p := &DeleteLBStickinessPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams {
+	p := &RemoveFromLoadBalancerRuleParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a LB stickiness policy.
-func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", p.toURLValues())
+// Removes a virtual machine or a list of virtual machines from a load balancer rule.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeFromLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RemoveFromLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("removeFromLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -861,1108 +1092,3707 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("removeFromLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteLBStickinessPolicyResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListLoadBalancerRulesParams struct {
-	p map[string]interface{}
+type RemoveFromLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
+type CreateGlobalLoadBalancerRuleParams struct {
+	Account                     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Description                 *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid                    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Gslbdomainname              *string `json:"gslbdomainname,omitempty" yaml:"gslbdomainname,omitempty"`
+	Gslblbmethod                *string `json:"gslblbmethod,omitempty" yaml:"gslblbmethod,omitempty"`
+	Gslbservicetype             *string `json:"gslbservicetype,omitempty" yaml:"gslbservicetype,omitempty"`
+	Gslbstickysessionmethodname *string `json:"gslbstickysessionmethodname,omitempty" yaml:"gslbstickysessionmethodname,omitempty"`
+	Name                        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Regionid                    *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateGlobalLoadBalancerRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Gslbdomainname; v != nil {
+		u.Set("gslbdomainname", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Gslblbmethod; v != nil {
+		u.Set("gslblbmethod", *v)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if v := p.Gslbservicetype; v != nil {
+		u.Set("gslbservicetype", *v)
 	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Gslbstickysessionmethodname; v != nil {
+		u.Set("gslbstickysessionmethodname", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Regionid; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("regionid", vv)
 	}
 	return u
 }
 
-func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateGlobalLoadBalancerRuleParams) Command() string {
+	return "createGlobalLoadBalancerRule"
 }
 
-func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateGlobalLoadBalancerRuleParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateGlobalLoadBalancerRuleParams) ResponseType() interface{} {
+	return &CreateGlobalLoadBalancerRuleResponse{}
 }
 
-func (p *ListLoadBalancerRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateGlobalLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "createGlobalLoadBalancerRule"}
+	v.required("gslbdomainname", p.Gslbdomainname != nil)
+	v.required("gslbservicetype", p.Gslbservicetype != nil)
+	v.required("name", p.Name != nil)
+	v.required("regionid", p.Regionid != nil)
+	return v.err()
 }
 
-func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+// Sets the 'account' param: the name of the account.
+func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateGlobalLoadBalancerRuleParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListLoadBalancerRulesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
+	p.Description = &v
 	return
 }
 
-func (p *ListLoadBalancerRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateGlobalLoadBalancerRuleParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
 	}
-	p.p["page"] = v
-	return
+	return *p.Description
 }
 
-func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) HasDescription() bool {
+	return p.Description != nil
 }
 
-func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) ResetDescription() {
+	p.Description = nil
 }
 
-func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicipid"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateGlobalLoadBalancerRuleParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["tags"] = v
-	return
+	return *p.Domainid
 }
 
-func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-// You should always use this function to get a new ListLoadBalancerRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams {
-	p := &ListLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
-	return p
+// This param is required.
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
+	p.Gslbdomainname = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerRuleID(name string) (string, error) {
-	p := &ListLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
-
-	l, err := s.ListLoadBalancerRules(p)
-	if err != nil {
-		return "", err
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslbdomainname() string {
+	if p.Gslbdomainname == nil {
+		return ""
 	}
+	return *p.Gslbdomainname
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *CreateGlobalLoadBalancerRuleParams) HasGslbdomainname() bool {
+	return p.Gslbdomainname != nil
+}
 
-	if l.Count == 1 {
-		return l.LoadBalancerRules[0].Id, nil
-	}
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbdomainname() {
+	p.Gslbdomainname = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.LoadBalancerRules {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
+	p.Gslblbmethod = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string) (*LoadBalancerRule, int, error) {
-	id, err := s.GetLoadBalancerRuleID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslblbmethod() string {
+	if p.Gslblbmethod == nil {
+		return ""
 	}
+	return *p.Gslblbmethod
+}
 
-	r, count, err := s.GetLoadBalancerRuleByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *CreateGlobalLoadBalancerRuleParams) HasGslblbmethod() bool {
+	return p.Gslblbmethod != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string) (*LoadBalancerRule, int, error) {
-	p := &ListLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslblbmethod() {
+	p.Gslblbmethod = nil
+}
 
-	p.p["id"] = id
+// This param is required.
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
+	p.Gslbservicetype = &v
+	return
+}
 
-	l, err := s.ListLoadBalancerRules(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslbservicetype() string {
+	if p.Gslbservicetype == nil {
+		return ""
 	}
+	return *p.Gslbservicetype
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *CreateGlobalLoadBalancerRuleParams) HasGslbservicetype() bool {
+	return p.Gslbservicetype != nil
+}
 
-	if l.Count == 1 {
-		return l.LoadBalancerRules[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancerRule UUID: %s!", id)
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbservicetype() {
+	p.Gslbservicetype = nil
 }
 
-// Lists load balancer rules.
-func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRules", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
+	p.Gslbstickysessionmethodname = &v
+	return
+}
 
-	var r ListLoadBalancerRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateGlobalLoadBalancerRuleParams) GetGslbstickysessionmethodname() string {
+	if p.Gslbstickysessionmethodname == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Gslbstickysessionmethodname
 }
 
-type ListLoadBalancerRulesResponse struct {
-	Count             int                 `json:"count"`
-	LoadBalancerRules []*LoadBalancerRule `json:"loadbalancerrule"`
+func (p *CreateGlobalLoadBalancerRuleParams) HasGslbstickysessionmethodname() bool {
+	return p.Gslbstickysessionmethodname != nil
 }
 
-type LoadBalancerRule struct {
-	Account     string `json:"account,omitempty"`
-	Algorithm   string `json:"algorithm,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Description string `json:"description,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Fordisplay  bool   `json:"fordisplay,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Privateport string `json:"privateport,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Publicip    string `json:"publicip,omitempty"`
-	Publicipid  string `json:"publicipid,omitempty"`
-	Publicport  string `json:"publicport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
+func (p *CreateGlobalLoadBalancerRuleParams) ResetGslbstickysessionmethodname() {
+	p.Gslbstickysessionmethodname = nil
 }
 
-type ListLBStickinessPoliciesParams struct {
-	p map[string]interface{}
+// This param is required.
+func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
+	p.Name = &v
+	return
 }
 
-func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+func (p *CreateGlobalLoadBalancerRuleParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
+	return *p.Name
 }
 
-func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) HasName() bool {
+	return p.Name != nil
 }
 
-func (p *ListLBStickinessPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) ResetName() {
+	p.Name = nil
 }
 
-func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// This param is required.
+func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
+	p.Regionid = &v
 	return
 }
 
-func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateGlobalLoadBalancerRuleParams) GetRegionid() int {
+	if p.Regionid == nil {
+		return 0
 	}
-	p.p["lbruleid"] = v
-	return
+	return *p.Regionid
 }
 
-func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) HasRegionid() bool {
+	return p.Regionid != nil
 }
 
-func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *CreateGlobalLoadBalancerRuleParams) ResetRegionid() {
+	p.Regionid = nil
 }
 
-// You should always use this function to get a new ListLBStickinessPoliciesParams instance,
+// You should always use this function to get a new CreateGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams {
-	p := &ListLBStickinessPoliciesParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - gslbdomainname
+//   - gslbservicetype
+//   - name
+//   - regionid
+func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams {
+	p := &CreateGlobalLoadBalancerRuleParams{}
+	p.SetGslbdomainname(gslbdomainname)
+	p.SetGslbservicetype(gslbservicetype)
+	p.SetName(name)
+	p.SetRegionid(regionid)
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string) (*LBStickinessPolicy, int, error) {
-	p := &ListLBStickinessPoliciesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+// Creates a global load balancer rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListGlobalLoadBalancerRules, GetGlobalLoadBalancerRuleID, GetGlobalLoadBalancerRuleByName, GetGlobalLoadBalancerRuleByID, GetGlobalLoadBalancerRulesByTags.
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
-	l, err := s.ListLBStickinessPolicies(p)
+	resp, err := s.cs.newRequest("createGlobalLoadBalancerRule", p.URLValues())
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+		return nil, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	var r CreateGlobalLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("createGlobalLoadBalancerRule", resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.LBStickinessPolicies[0], l.Count, nil
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createGlobalLoadBalancerRule", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for LBStickinessPolicy UUID: %s!", id)
+	return &r, nil
 }
 
-// Lists LBStickiness policies.
-func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBStickinessPolicies", p.toURLValues())
+type CreateGlobalLoadBalancerRuleResponse struct {
+	JobID                       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description                 string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gslbdomainname              string                     `json:"gslbdomainname,omitempty" xml:"gslbdomainname,omitempty"`
+	Gslblbmethod                string                     `json:"gslblbmethod,omitempty" xml:"gslblbmethod,omitempty"`
+	Gslbservicetype             string                     `json:"gslbservicetype,omitempty" xml:"gslbservicetype,omitempty"`
+	Gslbstickysessionmethodname string                     `json:"gslbstickysessionmethodname,omitempty" xml:"gslbstickysessionmethodname,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerrule            []LoadBalancerRule         `json:"loadbalancerrule,omitempty" xml:"loadbalancerrule,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionid                    int                        `json:"regionid,omitempty" xml:"regionid,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteGlobalLoadBalancerRuleParams = cloudstackcommon.DeleteGlobalLoadBalancerRuleParams
+type DeleteGlobalLoadBalancerRuleResponse = cloudstackcommon.DeleteGlobalLoadBalancerRuleResponse
+
+// You should always use this function to get a new DeleteGlobalLoadBalancerRuleParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams {
+	p := &DeleteGlobalLoadBalancerRuleParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a global load balancer rule.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListGlobalLoadBalancerRules, GetGlobalLoadBalancerRuleID, GetGlobalLoadBalancerRuleByName, GetGlobalLoadBalancerRuleByID, GetGlobalLoadBalancerRulesByTags.
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteGlobalLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListLBStickinessPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteGlobalLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("deleteGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
-
-type ListLBStickinessPoliciesResponse struct {
-	Count                int                   `json:"count"`
-	LBStickinessPolicies []*LBStickinessPolicy `json:"lbstickinesspolicy"`
-}
 
-type LBStickinessPolicy struct {
-	Account          string `json:"account,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Domain           string `json:"domain,omitempty"`
-	Domainid         string `json:"domainid,omitempty"`
-	Lbruleid         string `json:"lbruleid,omitempty"`
-	Name             string `json:"name,omitempty"`
-	State            string `json:"state,omitempty"`
-	Stickinesspolicy []struct {
-		Description string            `json:"description,omitempty"`
-		Fordisplay  bool              `json:"fordisplay,omitempty"`
-		Id          string            `json:"id,omitempty"`
-		Methodname  string            `json:"methodname,omitempty"`
-		Name        string            `json:"name,omitempty"`
-		Params      map[string]string `json:"params,omitempty"`
-		State       string            `json:"state,omitempty"`
-	} `json:"stickinesspolicy,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-type ListLBHealthCheckPoliciesParams struct {
-	p map[string]interface{}
+		if err := s.cs.unmarshal("deleteGlobalLoadBalancerRule", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
-func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
+type ListGlobalLoadBalancerRulesParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Regionid    *int              `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListGlobalLoadBalancerRulesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Regionid; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("regionid", vv)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
 	return u
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListGlobalLoadBalancerRulesParams) Command() string {
+	return "listGlobalLoadBalancerRules"
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListGlobalLoadBalancerRulesParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListGlobalLoadBalancerRulesParams) ResponseType() interface{} {
+	return &ListGlobalLoadBalancerRulesResponse{}
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListGlobalLoadBalancerRulesParams) Validate() error {
+	v := &validator{api: "listGlobalLoadBalancerRules"}
+	return v.err()
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListGlobalLoadBalancerRulesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Account
 }
 
-// You should always use this function to get a new ListLBHealthCheckPoliciesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams {
-	p := &ListLBHealthCheckPoliciesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListGlobalLoadBalancerRulesParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string) (*LBHealthCheckPolicy, int, error) {
-	p := &ListLBHealthCheckPoliciesParams{}
-	p.p = make(map[string]interface{})
+func (p *ListGlobalLoadBalancerRulesParams) ResetAccount() {
+	p.Account = nil
+}
 
-	p.p["id"] = id
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
 
-	l, err := s.ListLBHealthCheckPolicies(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *ListGlobalLoadBalancerRulesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
+	return *p.Domainid
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *ListGlobalLoadBalancerRulesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
 
-	if l.Count == 1 {
-		return l.LBHealthCheckPolicies[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for LBHealthCheckPolicy UUID: %s!", id)
+func (p *ListGlobalLoadBalancerRulesParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-// Lists load balancer HealthCheck policies.
-func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	var r ListLBHealthCheckPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListGlobalLoadBalancerRulesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Id
 }
 
-type ListLBHealthCheckPoliciesResponse struct {
-	Count                 int                    `json:"count"`
-	LBHealthCheckPolicies []*LBHealthCheckPolicy `json:"lbhealthcheckpolicy"`
+func (p *ListGlobalLoadBalancerRulesParams) HasId() bool {
+	return p.Id != nil
 }
 
-type LBHealthCheckPolicy struct {
-	Account           string `json:"account,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Healthcheckpolicy []struct {
-		Description             string `json:"description,omitempty"`
-		Fordisplay              bool   `json:"fordisplay,omitempty"`
-		Healthcheckinterval     int    `json:"healthcheckinterval,omitempty"`
-		Healthcheckthresshold   int    `json:"healthcheckthresshold,omitempty"`
-		Id                      string `json:"id,omitempty"`
-		Pingpath                string `json:"pingpath,omitempty"`
-		Responsetime            int    `json:"responsetime,omitempty"`
-		State                   string `json:"state,omitempty"`
-		Unhealthcheckthresshold int    `json:"unhealthcheckthresshold,omitempty"`
-	} `json:"healthcheckpolicy,omitempty"`
-	Lbruleid string `json:"lbruleid,omitempty"`
-	Zoneid   string `json:"zoneid,omitempty"`
+func (p *ListGlobalLoadBalancerRulesParams) ResetId() {
+	p.Id = nil
 }
 
-type CreateLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
 }
 
-func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["healthythreshold"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("healthythreshold", vv)
-	}
-	if v, found := p.p["intervaltime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("intervaltime", vv)
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["pingpath"]; found {
-		u.Set("pingpath", v.(string))
-	}
-	if v, found := p.p["responsetimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("responsetimeout", vv)
-	}
-	if v, found := p.p["unhealthythreshold"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("unhealthythreshold", vv)
+func (p *ListGlobalLoadBalancerRulesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	return u
+	return *p.Isrecursive
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
+func (p *ListGlobalLoadBalancerRulesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+func (p *ListGlobalLoadBalancerRulesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["healthythreshold"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListGlobalLoadBalancerRulesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["intervaltime"] = v
-	return
+	return *p.Keyword
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
-	return
+func (p *ListGlobalLoadBalancerRulesParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingpath"] = v
-	return
+func (p *ListGlobalLoadBalancerRulesParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["responsetimeout"] = v
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListGlobalLoadBalancerRulesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["unhealthythreshold"] = v
-	return
+	return *p.Listall
 }
 
-// You should always use this function to get a new CreateLBHealthCheckPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
-	p := &CreateLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
-	return p
+func (p *ListGlobalLoadBalancerRulesParams) HasListall() bool {
+	return p.Listall != nil
 }
 
-// Creates a Load Balancer healthcheck policy
-func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListGlobalLoadBalancerRulesParams) ResetListall() {
+	p.Listall = nil
+}
 
-	var r CreateLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListGlobalLoadBalancerRulesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
+	return *p.Page
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListGlobalLoadBalancerRulesParams) HasPage() bool {
+	return p.Page != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ListGlobalLoadBalancerRulesParams) ResetPage() {
+	p.Page = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-type CreateLBHealthCheckPolicyResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Account           string `json:"account,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Healthcheckpolicy []struct {
-		Description             string `json:"description,omitempty"`
-		Fordisplay              bool   `json:"fordisplay,omitempty"`
-		Healthcheckinterval     int    `json:"healthcheckinterval,omitempty"`
-		Healthcheckthresshold   int    `json:"healthcheckthresshold,omitempty"`
-		Id                      string `json:"id,omitempty"`
-		Pingpath                string `json:"pingpath,omitempty"`
-		Responsetime            int    `json:"responsetime,omitempty"`
-		State                   string `json:"state,omitempty"`
-		Unhealthcheckthresshold int    `json:"unhealthcheckthresshold,omitempty"`
-	} `json:"healthcheckpolicy,omitempty"`
-	Lbruleid string `json:"lbruleid,omitempty"`
-	Zoneid   string `json:"zoneid,omitempty"`
+func (p *ListGlobalLoadBalancerRulesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
 }
 
-type UpdateLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
+func (p *ListGlobalLoadBalancerRulesParams) HasPagesize() bool {
+	return p.Pagesize != nil
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *ListGlobalLoadBalancerRulesParams) ResetPagesize() {
+	p.Pagesize = nil
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListGlobalLoadBalancerRulesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
-	return
+	return *p.Projectid
 }
 
-func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListGlobalLoadBalancerRulesParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-// You should always use this function to get a new UpdateLBHealthCheckPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams {
-	p := &UpdateLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListGlobalLoadBalancerRulesParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-// Updates LB HealthCheck policy
-func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
+	p.Regionid = &v
+	return
+}
 
-	var r UpdateLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListGlobalLoadBalancerRulesParams) GetRegionid() int {
+	if p.Regionid == nil {
+		return 0
 	}
+	return *p.Regionid
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListGlobalLoadBalancerRulesParams) HasRegionid() bool {
+	return p.Regionid != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ListGlobalLoadBalancerRulesParams) ResetRegionid() {
+	p.Regionid = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
 }
 
-type UpdateLBHealthCheckPolicyResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Account           string `json:"account,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Healthcheckpolicy []struct {
-		Description             string `json:"description,omitempty"`
-		Fordisplay              bool   `json:"fordisplay,omitempty"`
-		Healthcheckinterval     int    `json:"healthcheckinterval,omitempty"`
-		Healthcheckthresshold   int    `json:"healthcheckthresshold,omitempty"`
-		Id                      string `json:"id,omitempty"`
-		Pingpath                string `json:"pingpath,omitempty"`
-		Responsetime            int    `json:"responsetime,omitempty"`
-		State                   string `json:"state,omitempty"`
-		Unhealthcheckthresshold int    `json:"unhealthcheckthresshold,omitempty"`
-	} `json:"healthcheckpolicy,omitempty"`
-	Lbruleid string `json:"lbruleid,omitempty"`
-	Zoneid   string `json:"zoneid,omitempty"`
-}
-
-type DeleteLBHealthCheckPolicyParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *ListGlobalLoadBalancerRulesParams) GetTags() map[string]string {
+	return p.Tags
 }
 
-func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListGlobalLoadBalancerRulesParams) HasTags() bool {
+	return p.Tags != nil
 }
 
-// You should always use this function to get a new DeleteLBHealthCheckPolicyParams instance,
+func (p *ListGlobalLoadBalancerRulesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// You should always use this function to get a new ListGlobalLoadBalancerRulesParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
-	p := &DeleteLBHealthCheckPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams {
+	p := &ListGlobalLoadBalancerRulesParams{}
 	return p
 }
 
-// Deletes a load balancer HealthCheck policy.
-func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, error) {
+	p := &ListGlobalLoadBalancerRulesParams{}
+
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
 
-	var r DeleteLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	l, err := s.ListGlobalLoadBalancerRules(p)
+	if err != nil {
+		return "", err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListGlobalLoadBalancerRules(p)
 		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+			return "", err
 		}
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
-	return &r, nil
-}
 
-type DeleteLBHealthCheckPolicyResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+	if l.Count == 1 {
+		return l.GlobalLoadBalancerRules[0].Id, nil
+	}
 
-type ListLoadBalancerRuleInstancesParams struct {
-	p map[string]interface{}
+	var matches []*GlobalLoadBalancerRule
+	for _, v := range l.GlobalLoadBalancerRules {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "GlobalLoadBalancerRule", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["applied"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("applied", vv)
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	id, err := s.GetGlobalLoadBalancerRuleID(name, opts...)
+	if err != nil {
+		return nil, -1, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	r, count, err := s.GetGlobalLoadBalancerRuleByID(id, opts...)
+	if err != nil {
+		return nil, count, err
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	p := &ListGlobalLoadBalancerRulesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["lbvmips"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lbvmips", vv)
+
+	l, err := s.ListGlobalLoadBalancerRules(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListGlobalLoadBalancerRules(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.GlobalLoadBalancerRules[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for GlobalLoadBalancerRule UUID: %s!", id)
+}
+
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *LoadBalancerService) GetGlobalLoadBalancerRulesByTags(tags map[string]string, opts ...OptionFunc) ([]*GlobalLoadBalancerRule, error) {
+	p := &ListGlobalLoadBalancerRulesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListGlobalLoadBalancerRules(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListGlobalLoadBalancerRules(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.GlobalLoadBalancerRules, nil
+}
+
+// Lists load balancer rules.
+//
+// See also GetGlobalLoadBalancerRuleID, GetGlobalLoadBalancerRuleByName, GetGlobalLoadBalancerRuleByID, GetGlobalLoadBalancerRulesByTags.
+func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListGlobalLoadBalancerRulesResponse
+	if err := s.cs.unmarshal("listGlobalLoadBalancerRules", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListGlobalLoadBalancerRules, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamGlobalLoadBalancerRules(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, fn func(*GlobalLoadBalancerRule) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listGlobalLoadBalancerRules", p.URLValues(), "globalloadbalancerrule", func(b json.RawMessage) error {
+		var v GlobalLoadBalancerRule
+		if err := s.cs.unmarshalElement("listGlobalLoadBalancerRules", "globalloadbalancerrule", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListGlobalLoadBalancerRulesResponse struct {
+	Count                   int                        `json:"count" xml:"count"`
+	GlobalLoadBalancerRules []*GlobalLoadBalancerRule  `json:"globalloadbalancerrule" xml:"globalloadbalancerrule"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type GlobalLoadBalancerRule struct {
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description                 string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gslbdomainname              string                     `json:"gslbdomainname,omitempty" xml:"gslbdomainname,omitempty"`
+	Gslblbmethod                string                     `json:"gslblbmethod,omitempty" xml:"gslblbmethod,omitempty"`
+	Gslbservicetype             string                     `json:"gslbservicetype,omitempty" xml:"gslbservicetype,omitempty"`
+	Gslbstickysessionmethodname string                     `json:"gslbstickysessionmethodname,omitempty" xml:"gslbstickysessionmethodname,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerrule            []LoadBalancerRule         `json:"loadbalancerrule,omitempty" xml:"loadbalancerrule,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionid                    int                        `json:"regionid,omitempty" xml:"regionid,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateGlobalLoadBalancerRuleParams struct {
+	Description                 *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Gslblbmethod                *string `json:"gslblbmethod,omitempty" yaml:"gslblbmethod,omitempty"`
+	Gslbstickysessionmethodname *string `json:"gslbstickysessionmethodname,omitempty" yaml:"gslbstickysessionmethodname,omitempty"`
+	Id                          *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Gslblbmethod; v != nil {
+		u.Set("gslblbmethod", *v)
+	}
+	if v := p.Gslbstickysessionmethodname; v != nil {
+		u.Set("gslbstickysessionmethodname", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateGlobalLoadBalancerRuleParams) Command() string {
+	return "updateGlobalLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateGlobalLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateGlobalLoadBalancerRuleParams) ResponseType() interface{} {
+	return &UpdateGlobalLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateGlobalLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "updateGlobalLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetDescription() {
+	p.Description = nil
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
+	p.Gslblbmethod = &v
+	return
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetGslblbmethod() string {
+	if p.Gslblbmethod == nil {
+		return ""
+	}
+	return *p.Gslblbmethod
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) HasGslblbmethod() bool {
+	return p.Gslblbmethod != nil
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetGslblbmethod() {
+	p.Gslblbmethod = nil
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
+	p.Gslbstickysessionmethodname = &v
+	return
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetGslbstickysessionmethodname() string {
+	if p.Gslbstickysessionmethodname == nil {
+		return ""
+	}
+	return *p.Gslbstickysessionmethodname
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) HasGslbstickysessionmethodname() bool {
+	return p.Gslbstickysessionmethodname != nil
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetGslbstickysessionmethodname() {
+	p.Gslbstickysessionmethodname = nil
+}
+
+// This param is required.
+func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateGlobalLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateGlobalLoadBalancerRuleParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams {
+	p := &UpdateGlobalLoadBalancerRuleParams{}
+	p.SetId(id)
+	return p
+}
+
+// update global load balancer rules.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListGlobalLoadBalancerRules, GetGlobalLoadBalancerRuleID, GetGlobalLoadBalancerRuleByName, GetGlobalLoadBalancerRuleByID, GetGlobalLoadBalancerRulesByTags.
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateGlobalLoadBalancerRule", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateGlobalLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("updateGlobalLoadBalancerRule", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateGlobalLoadBalancerRule", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type UpdateGlobalLoadBalancerRuleResponse struct {
+	JobID                       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description                 string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gslbdomainname              string                     `json:"gslbdomainname,omitempty" xml:"gslbdomainname,omitempty"`
+	Gslblbmethod                string                     `json:"gslblbmethod,omitempty" xml:"gslblbmethod,omitempty"`
+	Gslbservicetype             string                     `json:"gslbservicetype,omitempty" xml:"gslbservicetype,omitempty"`
+	Gslbstickysessionmethodname string                     `json:"gslbstickysessionmethodname,omitempty" xml:"gslbstickysessionmethodname,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerrule            []LoadBalancerRule         `json:"loadbalancerrule,omitempty" xml:"loadbalancerrule,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionid                    int                        `json:"regionid,omitempty" xml:"regionid,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateLBHealthCheckPolicyParams struct {
+	Description        *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay         *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Healthythreshold   *int    `json:"healthythreshold,omitempty" yaml:"healthythreshold,omitempty"`
+	Intervaltime       *int    `json:"intervaltime,omitempty" yaml:"intervaltime,omitempty"`
+	Lbruleid           *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Pingpath           *string `json:"pingpath,omitempty" yaml:"pingpath,omitempty"`
+	Responsetimeout    *int    `json:"responsetimeout,omitempty" yaml:"responsetimeout,omitempty"`
+	Unhealthythreshold *int    `json:"unhealthythreshold,omitempty" yaml:"unhealthythreshold,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateLBHealthCheckPolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Healthythreshold; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("healthythreshold", vv)
+	}
+	if v := p.Intervaltime; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("intervaltime", vv)
+	}
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
+	}
+	if v := p.Pingpath; v != nil {
+		u.Set("pingpath", *v)
+	}
+	if v := p.Responsetimeout; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("responsetimeout", vv)
+	}
+	if v := p.Unhealthythreshold; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("unhealthythreshold", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateLBHealthCheckPolicyParams) Command() string {
+	return "createLBHealthCheckPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateLBHealthCheckPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateLBHealthCheckPolicyParams) ResponseType() interface{} {
+	return &CreateLBHealthCheckPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateLBHealthCheckPolicyParams) Validate() error {
+	v := &validator{api: "createLBHealthCheckPolicy"}
+	v.required("lbruleid", p.Lbruleid != nil)
+	return v.err()
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetHealthythreshold(v int) {
+	p.Healthythreshold = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetHealthythreshold() int {
+	if p.Healthythreshold == nil {
+		return 0
+	}
+	return *p.Healthythreshold
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasHealthythreshold() bool {
+	return p.Healthythreshold != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetHealthythreshold() {
+	p.Healthythreshold = nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetIntervaltime(v int) {
+	p.Intervaltime = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetIntervaltime() int {
+	if p.Intervaltime == nil {
+		return 0
+	}
+	return *p.Intervaltime
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasIntervaltime() bool {
+	return p.Intervaltime != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetIntervaltime() {
+	p.Intervaltime = nil
+}
+
+// This param is required.
+func (p *CreateLBHealthCheckPolicyParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
+	}
+	return *p.Lbruleid
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetPingpath(v string) {
+	p.Pingpath = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetPingpath() string {
+	if p.Pingpath == nil {
+		return ""
+	}
+	return *p.Pingpath
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasPingpath() bool {
+	return p.Pingpath != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetPingpath() {
+	p.Pingpath = nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetResponsetimeout(v int) {
+	p.Responsetimeout = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetResponsetimeout() int {
+	if p.Responsetimeout == nil {
+		return 0
+	}
+	return *p.Responsetimeout
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasResponsetimeout() bool {
+	return p.Responsetimeout != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetResponsetimeout() {
+	p.Responsetimeout = nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) SetUnhealthythreshold(v int) {
+	p.Unhealthythreshold = &v
+	return
+}
+
+func (p *CreateLBHealthCheckPolicyParams) GetUnhealthythreshold() int {
+	if p.Unhealthythreshold == nil {
+		return 0
+	}
+	return *p.Unhealthythreshold
+}
+
+func (p *CreateLBHealthCheckPolicyParams) HasUnhealthythreshold() bool {
+	return p.Unhealthythreshold != nil
+}
+
+func (p *CreateLBHealthCheckPolicyParams) ResetUnhealthythreshold() {
+	p.Unhealthythreshold = nil
+}
+
+// You should always use this function to get a new CreateLBHealthCheckPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lbruleid
+func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams {
+	p := &CreateLBHealthCheckPolicyParams{}
+	p.SetLbruleid(lbruleid)
+	return p
+}
+
+// Creates a Load Balancer healthcheck policy
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLBHealthCheckPolicies, GetLBHealthCheckPolicyByID.
+func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createLBHealthCheckPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBHealthCheckPolicyResponse
+	if err := s.cs.unmarshal("createLBHealthCheckPolicy", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createLBHealthCheckPolicy", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type CreateLBHealthCheckPolicyResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Healthcheckpolicy []HealthCheckPolicy        `json:"healthcheckpolicy,omitempty" xml:"healthcheckpolicy,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLBHealthCheckPolicyParams = cloudstackcommon.DeleteLBHealthCheckPolicyParams
+type DeleteLBHealthCheckPolicyResponse = cloudstackcommon.DeleteLBHealthCheckPolicyResponse
+
+// You should always use this function to get a new DeleteLBHealthCheckPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams {
+	p := &DeleteLBHealthCheckPolicyParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a load balancer HealthCheck policy.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLBHealthCheckPolicies, GetLBHealthCheckPolicyByID.
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteLBHealthCheckPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBHealthCheckPolicyResponse
+	if err := s.cs.unmarshal("deleteLBHealthCheckPolicy", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteLBHealthCheckPolicy", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListLBHealthCheckPoliciesParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbruleid   *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLBHealthCheckPoliciesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListLBHealthCheckPoliciesParams) Command() string {
+	return "listLBHealthCheckPolicies"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListLBHealthCheckPoliciesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLBHealthCheckPoliciesParams) ResponseType() interface{} {
+	return &ListLBHealthCheckPoliciesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLBHealthCheckPoliciesParams) Validate() error {
+	v := &validator{api: "listLBHealthCheckPolicies"}
+	return v.err()
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *ListLBHealthCheckPoliciesParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *ListLBHealthCheckPoliciesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListLBHealthCheckPoliciesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLBHealthCheckPoliciesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListLBHealthCheckPoliciesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
+	}
+	return *p.Lbruleid
+}
+
+func (p *ListLBHealthCheckPoliciesParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLBHealthCheckPoliciesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListLBHealthCheckPoliciesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLBHealthCheckPoliciesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListLBHealthCheckPoliciesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListLBHealthCheckPoliciesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListLBHealthCheckPoliciesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListLBHealthCheckPoliciesParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams {
+	p := &ListLBHealthCheckPoliciesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
+	p := &ListLBHealthCheckPoliciesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListLBHealthCheckPolicies(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.LBHealthCheckPolicies[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for LBHealthCheckPolicy UUID: %s!", id)
+}
+
+// Lists load balancer HealthCheck policies.
+//
+// See also GetLBHealthCheckPolicyByID.
+func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListLBHealthCheckPoliciesResponse
+	if err := s.cs.unmarshal("listLBHealthCheckPolicies", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListLBHealthCheckPolicies, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamLBHealthCheckPolicies(ctx context.Context, p *ListLBHealthCheckPoliciesParams, fn func(*LBHealthCheckPolicy) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listLBHealthCheckPolicies", p.URLValues(), "lbhealthcheckpolicy", func(b json.RawMessage) error {
+		var v LBHealthCheckPolicy
+		if err := s.cs.unmarshalElement("listLBHealthCheckPolicies", "lbhealthcheckpolicy", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListLBHealthCheckPoliciesResponse struct {
+	Count                 int                        `json:"count" xml:"count"`
+	LBHealthCheckPolicies []*LBHealthCheckPolicy     `json:"lbhealthcheckpolicy" xml:"lbhealthcheckpolicy"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type LBHealthCheckPolicy struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Healthcheckpolicy []HealthCheckPolicy        `json:"healthcheckpolicy,omitempty" xml:"healthcheckpolicy,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateLBHealthCheckPolicyParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateLBHealthCheckPolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateLBHealthCheckPolicyParams) Command() string {
+	return "updateLBHealthCheckPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateLBHealthCheckPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateLBHealthCheckPolicyParams) ResponseType() interface{} {
+	return &UpdateLBHealthCheckPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateLBHealthCheckPolicyParams) Validate() error {
+	v := &validator{api: "updateLBHealthCheckPolicy"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
+	}
+	return *p.Customid
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateLBHealthCheckPolicyParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateLBHealthCheckPolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateLBHealthCheckPolicyParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateLBHealthCheckPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams {
+	p := &UpdateLBHealthCheckPolicyParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates LB HealthCheck policy
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListLBHealthCheckPolicies, GetLBHealthCheckPolicyByID.
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateLBHealthCheckPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLBHealthCheckPolicyResponse
+	if err := s.cs.unmarshal("updateLBHealthCheckPolicy", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateLBHealthCheckPolicy", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type UpdateLBHealthCheckPolicyResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Healthcheckpolicy []HealthCheckPolicy        `json:"healthcheckpolicy,omitempty" xml:"healthcheckpolicy,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateLBStickinessPolicyParams struct {
+	Description *string           `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Lbruleid    *string           `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Methodname  *string           `json:"methodname,omitempty" yaml:"methodname,omitempty"`
+	Name        *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Param       map[string]string `json:"param,omitempty" yaml:"param,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateLBStickinessPolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
+	}
+	if v := p.Methodname; v != nil {
+		u.Set("methodname", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Param; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("param[%d].name", i), k)
+			u.Set(fmt.Sprintf("param[%d].value", i), vv)
+			i++
+		}
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateLBStickinessPolicyParams) Command() string {
+	return "createLBStickinessPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateLBStickinessPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateLBStickinessPolicyParams) ResponseType() interface{} {
+	return &CreateLBStickinessPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateLBStickinessPolicyParams) Validate() error {
+	v := &validator{api: "createLBStickinessPolicy"}
+	v.required("lbruleid", p.Lbruleid != nil)
+	v.required("methodname", p.Methodname != nil)
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateLBStickinessPolicyParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *CreateLBStickinessPolicyParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateLBStickinessPolicyParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *CreateLBStickinessPolicyParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *CreateLBStickinessPolicyParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *CreateLBStickinessPolicyParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *CreateLBStickinessPolicyParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
+	}
+	return *p.Lbruleid
+}
+
+func (p *CreateLBStickinessPolicyParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+// This param is required.
+func (p *CreateLBStickinessPolicyParams) SetMethodname(v string) {
+	p.Methodname = &v
+	return
+}
+
+func (p *CreateLBStickinessPolicyParams) GetMethodname() string {
+	if p.Methodname == nil {
+		return ""
+	}
+	return *p.Methodname
+}
+
+func (p *CreateLBStickinessPolicyParams) HasMethodname() bool {
+	return p.Methodname != nil
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetMethodname() {
+	p.Methodname = nil
+}
+
+// This param is required.
+func (p *CreateLBStickinessPolicyParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateLBStickinessPolicyParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateLBStickinessPolicyParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreateLBStickinessPolicyParams) SetParam(v map[string]string) {
+	p.Param = v
+	return
+}
+
+func (p *CreateLBStickinessPolicyParams) GetParam() map[string]string {
+	return p.Param
+}
+
+func (p *CreateLBStickinessPolicyParams) HasParam() bool {
+	return p.Param != nil
+}
+
+func (p *CreateLBStickinessPolicyParams) ResetParam() {
+	p.Param = nil
+}
+
+// You should always use this function to get a new CreateLBStickinessPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lbruleid
+//   - methodname
+//   - name
+func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams {
+	p := &CreateLBStickinessPolicyParams{}
+	p.SetLbruleid(lbruleid)
+	p.SetMethodname(methodname)
+	p.SetName(name)
+	return p
+}
+
+// Creates a Load Balancer stickiness policy
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLBStickinessPolicies, GetLBStickinessPolicyByID.
+func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createLBStickinessPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLBStickinessPolicyResponse
+	if err := s.cs.unmarshal("createLBStickinessPolicy", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createLBStickinessPolicy", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type CreateLBStickinessPolicyResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account          string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description      string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain           string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid         string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Lbruleid         string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Stickinesspolicy []StickinessPolicy         `json:"stickinesspolicy,omitempty" xml:"stickinesspolicy,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLBStickinessPolicyParams = cloudstackcommon.DeleteLBStickinessPolicyParams
+type DeleteLBStickinessPolicyResponse = cloudstackcommon.DeleteLBStickinessPolicyResponse
+
+// You should always use this function to get a new DeleteLBStickinessPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams {
+	p := &DeleteLBStickinessPolicyParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a LB stickiness policy.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLBStickinessPolicies, GetLBStickinessPolicyByID.
+func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteLBStickinessPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLBStickinessPolicyResponse
+	if err := s.cs.unmarshal("deleteLBStickinessPolicy", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteLBStickinessPolicy", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListLBStickinessPoliciesParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbruleid   *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLBStickinessPoliciesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListLBStickinessPoliciesParams) Command() string {
+	return "listLBStickinessPolicies"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListLBStickinessPoliciesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLBStickinessPoliciesParams) ResponseType() interface{} {
+	return &ListLBStickinessPoliciesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLBStickinessPoliciesParams) Validate() error {
+	v := &validator{api: "listLBStickinessPolicies"}
+	return v.err()
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *ListLBStickinessPoliciesParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *ListLBStickinessPoliciesParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListLBStickinessPoliciesParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *ListLBStickinessPoliciesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListLBStickinessPoliciesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListLBStickinessPoliciesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListLBStickinessPoliciesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLBStickinessPoliciesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListLBStickinessPoliciesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListLBStickinessPoliciesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLBStickinessPoliciesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListLBStickinessPoliciesParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *ListLBStickinessPoliciesParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
+	}
+	return *p.Lbruleid
+}
+
+func (p *ListLBStickinessPoliciesParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *ListLBStickinessPoliciesParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLBStickinessPoliciesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListLBStickinessPoliciesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListLBStickinessPoliciesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListLBStickinessPoliciesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLBStickinessPoliciesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListLBStickinessPoliciesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListLBStickinessPoliciesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListLBStickinessPoliciesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListLBStickinessPoliciesParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams {
+	p := &ListLBStickinessPoliciesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
+	p := &ListLBStickinessPoliciesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListLBStickinessPolicies(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.LBStickinessPolicies[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for LBStickinessPolicy UUID: %s!", id)
+}
+
+// Lists LBStickiness policies.
+//
+// See also GetLBStickinessPolicyByID.
+func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listLBStickinessPolicies", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListLBStickinessPoliciesResponse
+	if err := s.cs.unmarshal("listLBStickinessPolicies", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListLBStickinessPolicies, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamLBStickinessPolicies(ctx context.Context, p *ListLBStickinessPoliciesParams, fn func(*LBStickinessPolicy) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listLBStickinessPolicies", p.URLValues(), "lbstickinesspolicy", func(b json.RawMessage) error {
+		var v LBStickinessPolicy
+		if err := s.cs.unmarshalElement("listLBStickinessPolicies", "lbstickinesspolicy", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListLBStickinessPoliciesResponse struct {
+	Count                int                        `json:"count" xml:"count"`
+	LBStickinessPolicies []*LBStickinessPolicy      `json:"lbstickinesspolicy" xml:"lbstickinesspolicy"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type LBStickinessPolicy struct {
+	Account          string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description      string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain           string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid         string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Lbruleid         string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Stickinesspolicy []StickinessPolicy         `json:"stickinesspolicy,omitempty" xml:"stickinesspolicy,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateLBStickinessPolicyParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateLBStickinessPolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateLBStickinessPolicyParams) Command() string {
+	return "updateLBStickinessPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateLBStickinessPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateLBStickinessPolicyParams) ResponseType() interface{} {
+	return &UpdateLBStickinessPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateLBStickinessPolicyParams) Validate() error {
+	v := &validator{api: "updateLBStickinessPolicy"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateLBStickinessPolicyParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
+	}
+	return *p.Customid
+}
+
+func (p *UpdateLBStickinessPolicyParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateLBStickinessPolicyParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateLBStickinessPolicyParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *UpdateLBStickinessPolicyParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateLBStickinessPolicyParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateLBStickinessPolicyParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateLBStickinessPolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateLBStickinessPolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateLBStickinessPolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateLBStickinessPolicyParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateLBStickinessPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams {
+	p := &UpdateLBStickinessPolicyParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates LB Stickiness policy
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListLBStickinessPolicies, GetLBStickinessPolicyByID.
+func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateLBStickinessPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateLBStickinessPolicyResponse
+	if err := s.cs.unmarshal("updateLBStickinessPolicy", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateLBStickinessPolicy", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type UpdateLBStickinessPolicyResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account          string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description      string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain           string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid         string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Lbruleid         string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Stickinesspolicy []StickinessPolicy         `json:"stickinesspolicy,omitempty" xml:"stickinesspolicy,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateLoadBalancerParams struct {
+	Algorithm                *LBAlgorithm `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	Description              *string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay               *bool        `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Instanceport             *int         `json:"instanceport,omitempty" yaml:"instanceport,omitempty"`
+	Name                     *string      `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                *string      `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Scheme                   *string      `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Sourceipaddress          *string      `json:"sourceipaddress,omitempty" yaml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid *string      `json:"sourceipaddressnetworkid,omitempty" yaml:"sourceipaddressnetworkid,omitempty"`
+	Sourceport               *int         `json:"sourceport,omitempty" yaml:"sourceport,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Algorithm; v != nil {
+		u.Set("algorithm", string(*v))
+	}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Instanceport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("instanceport", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Scheme; v != nil {
+		u.Set("scheme", *v)
+	}
+	if v := p.Sourceipaddress; v != nil {
+		u.Set("sourceipaddress", *v)
+	}
+	if v := p.Sourceipaddressnetworkid; v != nil {
+		u.Set("sourceipaddressnetworkid", *v)
+	}
+	if v := p.Sourceport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("sourceport", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateLoadBalancerParams) Command() string {
+	return "createLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateLoadBalancerParams) ResponseType() interface{} {
+	return &CreateLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateLoadBalancerParams) Validate() error {
+	v := &validator{api: "createLoadBalancer"}
+	v.required("algorithm", p.Algorithm != nil)
+	if p.Algorithm != nil {
+		v.enum("algorithm", string(*p.Algorithm), p.Algorithm.Known(), false)
+	}
+	v.required("instanceport", p.Instanceport != nil)
+	v.required("name", p.Name != nil)
+	v.required("networkid", p.Networkid != nil)
+	v.required("scheme", p.Scheme != nil)
+	v.required("sourceipaddressnetworkid", p.Sourceipaddressnetworkid != nil)
+	v.required("sourceport", p.Sourceport != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CreateLoadBalancerParams) SetAlgorithm(v LBAlgorithm) {
+	p.Algorithm = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetAlgorithm() LBAlgorithm {
+	if p.Algorithm == nil {
+		return ""
+	}
+	return *p.Algorithm
+}
+
+func (p *CreateLoadBalancerParams) HasAlgorithm() bool {
+	return p.Algorithm != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetAlgorithm() {
+	p.Algorithm = nil
+}
+
+func (p *CreateLoadBalancerParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *CreateLoadBalancerParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *CreateLoadBalancerParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
+	p.Instanceport = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetInstanceport() int {
+	if p.Instanceport == nil {
+		return 0
+	}
+	return *p.Instanceport
+}
+
+func (p *CreateLoadBalancerParams) HasInstanceport() bool {
+	return p.Instanceport != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetInstanceport() {
+	p.Instanceport = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateLoadBalancerParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network. This param is required.
+func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *CreateLoadBalancerParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerParams) SetScheme(v string) {
+	p.Scheme = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetScheme() string {
+	if p.Scheme == nil {
+		return ""
+	}
+	return *p.Scheme
+}
+
+func (p *CreateLoadBalancerParams) HasScheme() bool {
+	return p.Scheme != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetScheme() {
+	p.Scheme = nil
+}
+
+func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
+	p.Sourceipaddress = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetSourceipaddress() string {
+	if p.Sourceipaddress == nil {
+		return ""
+	}
+	return *p.Sourceipaddress
+}
+
+func (p *CreateLoadBalancerParams) HasSourceipaddress() bool {
+	return p.Sourceipaddress != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetSourceipaddress() {
+	p.Sourceipaddress = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
+	p.Sourceipaddressnetworkid = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetSourceipaddressnetworkid() string {
+	if p.Sourceipaddressnetworkid == nil {
+		return ""
+	}
+	return *p.Sourceipaddressnetworkid
+}
+
+func (p *CreateLoadBalancerParams) HasSourceipaddressnetworkid() bool {
+	return p.Sourceipaddressnetworkid != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetSourceipaddressnetworkid() {
+	p.Sourceipaddressnetworkid = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerParams) SetSourceport(v int) {
+	p.Sourceport = &v
+	return
+}
+
+func (p *CreateLoadBalancerParams) GetSourceport() int {
+	if p.Sourceport == nil {
+		return 0
+	}
+	return *p.Sourceport
+}
+
+func (p *CreateLoadBalancerParams) HasSourceport() bool {
+	return p.Sourceport != nil
+}
+
+func (p *CreateLoadBalancerParams) ResetSourceport() {
+	p.Sourceport = nil
+}
+
+// You should always use this function to get a new CreateLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - algorithm
+//   - instanceport
+//   - name
+//   - networkid: the ID of the network
+//   - scheme
+//   - sourceipaddressnetworkid
+//   - sourceport
+func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm LBAlgorithm, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams {
+	p := &CreateLoadBalancerParams{}
+	p.SetAlgorithm(algorithm)
+	p.SetInstanceport(instanceport)
+	p.SetName(name)
+	p.SetNetworkid(networkid)
+	p.SetScheme(scheme)
+	p.SetSourceipaddressnetworkid(sourceipaddressnetworkid)
+	p.SetSourceport(sourceport)
+	return p
+}
+
+// Creates a Load Balancer
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLoadBalancers, GetLoadBalancerID, GetLoadBalancerByName, GetLoadBalancerByID, GetLoadBalancersByTags.
+func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateLoadBalancerResponse
+	if err := s.cs.unmarshal("createLoadBalancer", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createLoadBalancer", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	return &r, nil
+}
+
+type CreateLoadBalancerResponse struct {
+	JobID                    string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                  string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Algorithm                LBAlgorithm                `json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	Description              string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain                   string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                 string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay               Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                       string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerinstance     []LoadBalancerInstance     `json:"loadbalancerinstance,omitempty" xml:"loadbalancerinstance,omitempty"`
+	Loadbalancerrule         []InternalLoadBalancerRule `json:"loadbalancerrule,omitempty" xml:"loadbalancerrule,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid                string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Project                  string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Sourceipaddress          string                     `json:"sourceipaddress,omitempty" xml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid string                     `json:"sourceipaddressnetworkid,omitempty" xml:"sourceipaddressnetworkid,omitempty"`
+	Tags                     []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLoadBalancerParams = cloudstackcommon.DeleteLoadBalancerParams
+type DeleteLoadBalancerResponse = cloudstackcommon.DeleteLoadBalancerResponse
+
+// You should always use this function to get a new DeleteLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams {
+	p := &DeleteLoadBalancerParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a load balancer
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLoadBalancers, GetLoadBalancerID, GetLoadBalancerByName, GetLoadBalancerByID, GetLoadBalancersByTags.
+func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteLoadBalancerResponse
+	if err := s.cs.unmarshal("deleteLoadBalancer", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteLoadBalancer", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListLoadBalancersParams struct {
+	Account                  *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid                 *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay               *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                       *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive              *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword                  *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall                  *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name                     *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid                *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page                     *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                 *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid                *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Scheme                   *string           `json:"scheme,omitempty" yaml:"scheme,omitempty"`
+	Sourceipaddress          *string           `json:"sourceipaddress,omitempty" yaml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid *string           `json:"sourceipaddressnetworkid,omitempty" yaml:"sourceipaddressnetworkid,omitempty"`
+	Tags                     map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLoadBalancersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Scheme; v != nil {
+		u.Set("scheme", *v)
+	}
+	if v := p.Sourceipaddress; v != nil {
+		u.Set("sourceipaddress", *v)
+	}
+	if v := p.Sourceipaddressnetworkid; v != nil {
+		u.Set("sourceipaddressnetworkid", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
 	return u
 }
 
-func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ListLoadBalancersParams) Command() string {
+	return "listLoadBalancers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListLoadBalancersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLoadBalancersParams) ResponseType() interface{} {
+	return &ListLoadBalancersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLoadBalancersParams) Validate() error {
+	v := &validator{api: "listLoadBalancers"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListLoadBalancersParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListLoadBalancersParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListLoadBalancersParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListLoadBalancersParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListLoadBalancersParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListLoadBalancersParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *ListLoadBalancersParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListLoadBalancersParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListLoadBalancersParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListLoadBalancersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListLoadBalancersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListLoadBalancersParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListLoadBalancersParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLoadBalancersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListLoadBalancersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLoadBalancersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListLoadBalancersParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListLoadBalancersParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListLoadBalancersParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListLoadBalancersParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListLoadBalancersParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListLoadBalancersParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListLoadBalancersParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListLoadBalancersParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListLoadBalancersParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLoadBalancersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListLoadBalancersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListLoadBalancersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLoadBalancersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListLoadBalancersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListLoadBalancersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListLoadBalancersParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListLoadBalancersParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListLoadBalancersParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListLoadBalancersParams) SetScheme(v string) {
+	p.Scheme = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetScheme() string {
+	if p.Scheme == nil {
+		return ""
+	}
+	return *p.Scheme
+}
+
+func (p *ListLoadBalancersParams) HasScheme() bool {
+	return p.Scheme != nil
+}
+
+func (p *ListLoadBalancersParams) ResetScheme() {
+	p.Scheme = nil
+}
+
+func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
+	p.Sourceipaddress = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetSourceipaddress() string {
+	if p.Sourceipaddress == nil {
+		return ""
+	}
+	return *p.Sourceipaddress
+}
+
+func (p *ListLoadBalancersParams) HasSourceipaddress() bool {
+	return p.Sourceipaddress != nil
+}
+
+func (p *ListLoadBalancersParams) ResetSourceipaddress() {
+	p.Sourceipaddress = nil
+}
+
+func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
+	p.Sourceipaddressnetworkid = &v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetSourceipaddressnetworkid() string {
+	if p.Sourceipaddressnetworkid == nil {
+		return ""
+	}
+	return *p.Sourceipaddressnetworkid
+}
+
+func (p *ListLoadBalancersParams) HasSourceipaddressnetworkid() bool {
+	return p.Sourceipaddressnetworkid != nil
+}
+
+func (p *ListLoadBalancersParams) ResetSourceipaddressnetworkid() {
+	p.Sourceipaddressnetworkid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListLoadBalancersParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListLoadBalancersParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListLoadBalancersParams) ResetTags() {
+	p.Tags = nil
+}
+
+// You should always use this function to get a new ListLoadBalancersParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersParams {
+	p := &ListLoadBalancersParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListLoadBalancersParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	p.p["applied"] = v
-	return
-}
 
-func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListLoadBalancers(p)
+	if err != nil {
+		return "", err
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListLoadBalancers(p)
+		if err != nil {
+			return "", err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
-	p.p["lbvmips"] = v
-	return
-}
 
-func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.LoadBalancers[0].Id, nil
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var matches []*LoadBalancer
+	for _, v := range l.LoadBalancers {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
 	}
-	p.p["pagesize"] = v
-	return
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "LoadBalancer", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-// You should always use this function to get a new ListLoadBalancerRuleInstancesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams {
-	p := &ListLoadBalancerRuleInstancesParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	id, err := s.GetLoadBalancerID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	r, count, err := s.GetLoadBalancerByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string) (*LoadBalancerRuleInstance, int, error) {
-	p := &ListLoadBalancerRuleInstancesParams{}
-	p.p = make(map[string]interface{})
+func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	p := &ListLoadBalancersParams{}
 
-	p.p["id"] = id
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListLoadBalancerRuleInstances(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListLoadBalancers(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -1972,137 +4802,267 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string) (*LoadB
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListLoadBalancers(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
-		return l.LoadBalancerRuleInstances[0], l.Count, nil
+		return l.LoadBalancers[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancerRuleInstance UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancer UUID: %s!", id)
 }
 
-// List all virtual machine instances that are assigned to a load balancer rule.
-func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.toURLValues())
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *LoadBalancerService) GetLoadBalancersByTags(tags map[string]string, opts ...OptionFunc) ([]*LoadBalancer, error) {
+	p := &ListLoadBalancersParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListLoadBalancers(p)
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListLoadBalancerRuleInstancesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListLoadBalancers(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.LoadBalancers, nil
+}
+
+// Lists Load Balancers
+//
+// See also GetLoadBalancerID, GetLoadBalancerByName, GetLoadBalancerByID, GetLoadBalancersByTags.
+func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listLoadBalancers", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListLoadBalancersResponse
+	if err := s.cs.unmarshal("listLoadBalancers", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListLoadBalancerRuleInstancesResponse struct {
-	Count                     int                         `json:"count"`
-	LoadBalancerRuleInstances []*LoadBalancerRuleInstance `json:"loadbalancerruleinstance"`
+// Lists the objects like ListLoadBalancers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamLoadBalancers(ctx context.Context, p *ListLoadBalancersParams, fn func(*LoadBalancer) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listLoadBalancers", p.URLValues(), "loadbalancer", func(b json.RawMessage) error {
+		var v LoadBalancer
+		if err := s.cs.unmarshalElement("listLoadBalancers", "loadbalancer", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type LoadBalancerRuleInstance struct {
-	Lbvmipaddresses          []string `json:"lbvmipaddresses,omitempty"`
-	Loadbalancerruleinstance string   `json:"loadbalancerruleinstance,omitempty"`
+type ListLoadBalancersResponse struct {
+	Count         int                        `json:"count" xml:"count"`
+	LoadBalancers []*LoadBalancer            `json:"loadbalancer" xml:"loadbalancer"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type UpdateLoadBalancerRuleParams struct {
-	p map[string]interface{}
+type LoadBalancer struct {
+	Account                  string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Algorithm                LBAlgorithm                `json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	Description              string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain                   string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                 string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay               Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                       string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerinstance     []LoadBalancerInstance     `json:"loadbalancerinstance,omitempty" xml:"loadbalancerinstance,omitempty"`
+	Loadbalancerrule         []InternalLoadBalancerRule `json:"loadbalancerrule,omitempty" xml:"loadbalancerrule,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid                string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Project                  string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Sourceipaddress          string                     `json:"sourceipaddress,omitempty" xml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid string                     `json:"sourceipaddressnetworkid,omitempty" xml:"sourceipaddressnetworkid,omitempty"`
+	Tags                     []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
+type UpdateLoadBalancerParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateLoadBalancerParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["algorithm"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateLoadBalancerParams) Command() string {
+	return "updateLoadBalancer"
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateLoadBalancerParams) IsAsync() bool {
+	return true
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateLoadBalancerParams) ResponseType() interface{} {
+	return &UpdateLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateLoadBalancerParams) Validate() error {
+	v := &validator{api: "updateLoadBalancer"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
+	p.Customid = &v
 	return
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateLoadBalancerParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Customid
+}
+
+func (p *UpdateLoadBalancerParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateLoadBalancerParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateLoadBalancerParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *UpdateLoadBalancerParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateLoadBalancerParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateLoadBalancerParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateLoadBalancerParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["name"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new UpdateLoadBalancerRuleParams instance,
+func (p *UpdateLoadBalancerParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateLoadBalancerParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams {
-	p := &UpdateLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams {
+	p := &UpdateLoadBalancerParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates load balancer
-func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancerRule", p.toURLValues())
+// Updates a Load Balancer
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListLoadBalancers, GetLoadBalancerID, GetLoadBalancerByName, GetLoadBalancerByID, GetLoadBalancersByTags.
+func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateLoadBalancer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateLoadBalancerResponse
+	if err := s.cs.unmarshal("updateLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2123,374 +5083,459 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Account     string `json:"account,omitempty"`
-	Algorithm   string `json:"algorithm,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Description string `json:"description,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Fordisplay  bool   `json:"fordisplay,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Privateport string `json:"privateport,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Publicip    string `json:"publicip,omitempty"`
-	Publicipid  string `json:"publicipid,omitempty"`
-	Publicport  string `json:"publicport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type UploadSslCertParams struct {
-	p map[string]interface{}
+type UpdateLoadBalancerResponse struct {
+	JobID                    string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                  string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Algorithm                LBAlgorithm                `json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	Description              string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain                   string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                 string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay               Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                       string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerinstance     []LoadBalancerInstance     `json:"loadbalancerinstance,omitempty" xml:"loadbalancerinstance,omitempty"`
+	Loadbalancerrule         []InternalLoadBalancerRule `json:"loadbalancerrule,omitempty" xml:"loadbalancerrule,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid                string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Project                  string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Sourceipaddress          string                     `json:"sourceipaddress,omitempty" xml:"sourceipaddress,omitempty"`
+	Sourceipaddressnetworkid string                     `json:"sourceipaddressnetworkid,omitempty" xml:"sourceipaddressnetworkid,omitempty"`
+	Tags                     []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *UploadSslCertParams) toURLValues() url.Values {
+type CreateLoadBalancerRuleParams struct {
+	Account      *string      `json:"account,omitempty" yaml:"account,omitempty"`
+	Algorithm    *LBAlgorithm `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	Cidrlist     []string     `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Description  *string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid     *string      `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay   *bool        `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Name         *string      `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid    *string      `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Openfirewall *bool        `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Privateport  *int         `json:"privateport,omitempty" yaml:"privateport,omitempty"`
+	Protocol     *Protocol    `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Publicipid   *string      `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+	Publicport   *int         `json:"publicport,omitempty" yaml:"publicport,omitempty"`
+	Zoneid       *string      `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateLoadBalancerRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Algorithm; v != nil {
+		u.Set("algorithm", string(*v))
+	}
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
+	}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["certchain"]; found {
-		u.Set("certchain", v.(string))
+	if v := p.Openfirewall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("openfirewall", vv)
 	}
-	if v, found := p.p["certificate"]; found {
-		u.Set("certificate", v.(string))
+	if v := p.Privateport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("privateport", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Publicipid; v != nil {
+		u.Set("publicipid", *v)
 	}
-	if v, found := p.p["privatekey"]; found {
-		u.Set("privatekey", v.(string))
+	if v := p.Publicport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("publicport", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *UploadSslCertParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *CreateLoadBalancerRuleParams) Command() string {
+	return "createLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateLoadBalancerRuleParams) ResponseType() interface{} {
+	return &CreateLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "createLoadBalancerRule"}
+	v.required("algorithm", p.Algorithm != nil)
+	if p.Algorithm != nil {
+		v.enum("algorithm", string(*p.Algorithm), p.Algorithm.Known(), false)
 	}
-	p.p["account"] = v
+	v.required("name", p.Name != nil)
+	v.required("privateport", p.Privateport != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
+	}
+	v.required("publicport", p.Publicport != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *UploadSslCertParams) SetCertchain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["certchain"] = v
+	return *p.Account
+}
+
+func (p *CreateLoadBalancerRuleParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetAccount() {
+	p.Account = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerRuleParams) SetAlgorithm(v LBAlgorithm) {
+	p.Algorithm = &v
 	return
 }
 
-func (p *UploadSslCertParams) SetCertificate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetAlgorithm() LBAlgorithm {
+	if p.Algorithm == nil {
+		return ""
 	}
-	p.p["certificate"] = v
+	return *p.Algorithm
+}
+
+func (p *CreateLoadBalancerRuleParams) HasAlgorithm() bool {
+	return p.Algorithm != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetAlgorithm() {
+	p.Algorithm = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *CreateLoadBalancerRuleParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
 	return
 }
 
-func (p *UploadSslCertParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *CreateLoadBalancerRuleParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+func (p *CreateLoadBalancerRuleParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateLoadBalancerRuleParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *CreateLoadBalancerRuleParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateLoadBalancerRuleParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateLoadBalancerRuleParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Domainid
+}
+
+func (p *CreateLoadBalancerRuleParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateLoadBalancerRuleParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *UploadSslCertParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["password"] = v
+	return *p.Fordisplay
+}
+
+func (p *CreateLoadBalancerRuleParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *CreateLoadBalancerRuleParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UploadSslCertParams) SetPrivatekey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["privatekey"] = v
+	return *p.Name
+}
+
+func (p *CreateLoadBalancerRuleParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateLoadBalancerRuleParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *CreateLoadBalancerRuleParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *UploadSslCertParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Networkid
 }
 
-// You should always use this function to get a new UploadSslCertParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, privatekey string) *UploadSslCertParams {
-	p := &UploadSslCertParams{}
-	p.p = make(map[string]interface{})
-	p.p["certificate"] = certificate
-	p.p["privatekey"] = privatekey
-	return p
+func (p *CreateLoadBalancerRuleParams) HasNetworkid() bool {
+	return p.Networkid != nil
 }
 
-// Upload a certificate to cloudstack
-func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
-	resp, err := s.cs.newRequest("uploadSslCert", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r UploadSslCertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *CreateLoadBalancerRuleParams) ResetNetworkid() {
+	p.Networkid = nil
 }
 
-type UploadSslCertResponse struct {
-	Account              string   `json:"account,omitempty"`
-	Certchain            string   `json:"certchain,omitempty"`
-	Certificate          string   `json:"certificate,omitempty"`
-	Domain               string   `json:"domain,omitempty"`
-	Domainid             string   `json:"domainid,omitempty"`
-	Fingerprint          string   `json:"fingerprint,omitempty"`
-	Id                   string   `json:"id,omitempty"`
-	Loadbalancerrulelist []string `json:"loadbalancerrulelist,omitempty"`
-	Project              string   `json:"project,omitempty"`
-	Projectid            string   `json:"projectid,omitempty"`
+func (p *CreateLoadBalancerRuleParams) SetOpenfirewall(v bool) {
+	p.Openfirewall = &v
+	return
 }
 
-type DeleteSslCertParams struct {
-	p map[string]interface{}
+func (p *CreateLoadBalancerRuleParams) GetOpenfirewall() bool {
+	if p.Openfirewall == nil {
+		return false
+	}
+	return *p.Openfirewall
 }
 
-func (p *DeleteSslCertParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *CreateLoadBalancerRuleParams) HasOpenfirewall() bool {
+	return p.Openfirewall != nil
 }
 
-func (p *DeleteSslCertParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CreateLoadBalancerRuleParams) ResetOpenfirewall() {
+	p.Openfirewall = nil
 }
 
-// You should always use this function to get a new DeleteSslCertParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
-	p := &DeleteSslCertParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// This param is required.
+func (p *CreateLoadBalancerRuleParams) SetPrivateport(v int) {
+	p.Privateport = &v
+	return
 }
 
-// Delete a certificate to cloudstack
-func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
-	resp, err := s.cs.newRequest("deleteSslCert", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *CreateLoadBalancerRuleParams) GetPrivateport() int {
+	if p.Privateport == nil {
+		return 0
 	}
+	return *p.Privateport
+}
 
-	var r DeleteSslCertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *CreateLoadBalancerRuleParams) HasPrivateport() bool {
+	return p.Privateport != nil
 }
 
-type DeleteSslCertResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+func (p *CreateLoadBalancerRuleParams) ResetPrivateport() {
+	p.Privateport = nil
 }
 
-type ListSslCertsParams struct {
-	p map[string]interface{}
+func (p *CreateLoadBalancerRuleParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
+	return
 }
 
-func (p *ListSslCertsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
-	}
-	if v, found := p.p["certid"]; found {
-		u.Set("certid", v.(string))
+func (p *CreateLoadBalancerRuleParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
+	return *p.Protocol
 }
 
-func (p *ListSslCertsParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
-	return
+func (p *CreateLoadBalancerRuleParams) HasProtocol() bool {
+	return p.Protocol != nil
 }
 
-func (p *ListSslCertsParams) SetCertid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["certid"] = v
-	return
+func (p *CreateLoadBalancerRuleParams) ResetProtocol() {
+	p.Protocol = nil
 }
 
-func (p *ListSslCertsParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
+func (p *CreateLoadBalancerRuleParams) SetPublicipid(v string) {
+	p.Publicipid = &v
 	return
 }
 
-func (p *ListSslCertsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateLoadBalancerRuleParams) GetPublicipid() string {
+	if p.Publicipid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Publicipid
 }
 
-// You should always use this function to get a new ListSslCertsParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
-	p := &ListSslCertsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *CreateLoadBalancerRuleParams) HasPublicipid() bool {
+	return p.Publicipid != nil
 }
 
-// Lists SSL certificates
-func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
-	resp, err := s.cs.newRequest("listSslCerts", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateLoadBalancerRuleParams) ResetPublicipid() {
+	p.Publicipid = nil
+}
 
-	var r ListSslCertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// This param is required.
+func (p *CreateLoadBalancerRuleParams) SetPublicport(v int) {
+	p.Publicport = &v
+	return
+}
+
+func (p *CreateLoadBalancerRuleParams) GetPublicport() int {
+	if p.Publicport == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Publicport
 }
 
-type ListSslCertsResponse struct {
-	Count    int        `json:"count"`
-	SslCerts []*SslCert `json:"sslcert"`
+func (p *CreateLoadBalancerRuleParams) HasPublicport() bool {
+	return p.Publicport != nil
 }
 
-type SslCert struct {
-	Account              string   `json:"account,omitempty"`
-	Certchain            string   `json:"certchain,omitempty"`
-	Certificate          string   `json:"certificate,omitempty"`
-	Domain               string   `json:"domain,omitempty"`
-	Domainid             string   `json:"domainid,omitempty"`
-	Fingerprint          string   `json:"fingerprint,omitempty"`
-	Id                   string   `json:"id,omitempty"`
-	Loadbalancerrulelist []string `json:"loadbalancerrulelist,omitempty"`
-	Project              string   `json:"project,omitempty"`
-	Projectid            string   `json:"projectid,omitempty"`
+func (p *CreateLoadBalancerRuleParams) ResetPublicport() {
+	p.Publicport = nil
 }
 
-type AssignCertToLoadBalancerParams struct {
-	p map[string]interface{}
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *CreateLoadBalancerRuleParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
 }
 
-func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["certid"]; found {
-		u.Set("certid", v.(string))
+func (p *CreateLoadBalancerRuleParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	return u
+	return *p.Zoneid
 }
 
-func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["certid"] = v
-	return
+func (p *CreateLoadBalancerRuleParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
-	return
+func (p *CreateLoadBalancerRuleParams) ResetZoneid() {
+	p.Zoneid = nil
 }
 
-// You should always use this function to get a new AssignCertToLoadBalancerParams instance,
+// You should always use this function to get a new CreateLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams {
-	p := &AssignCertToLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["certid"] = certid
-	p.p["lbruleid"] = lbruleid
+//
+// The required params are:
+//
+//   - algorithm
+//   - name
+//   - privateport
+//   - publicport
+func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm LBAlgorithm, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams {
+	p := &CreateLoadBalancerRuleParams{}
+	p.SetAlgorithm(algorithm)
+	p.SetName(name)
+	p.SetPrivateport(privateport)
+	p.SetPublicport(publicport)
 	return p
 }
 
-// Assigns a certificate to a Load Balancer Rule
-func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("assignCertToLoadBalancer", p.toURLValues())
+// Creates a load balancer rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLoadBalancerRules, GetLoadBalancerRuleID, GetLoadBalancerRuleByName, GetLoadBalancerRuleByID, GetLoadBalancerRulesByTags.
+func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AssignCertToLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("createLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2506,60 +5551,76 @@ func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalanc
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AssignCertToLoadBalancerResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type RemoveCertFromLoadBalancerParams struct {
-	p map[string]interface{}
-}
-
-func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	return u
-}
-
-func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
-	return
-}
+type CreateLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Algorithm   LBAlgorithm                `json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateport string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicip    string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid  string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Publicport  string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Zoneid      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLoadBalancerRuleParams = cloudstackcommon.DeleteLoadBalancerRuleParams
+type DeleteLoadBalancerRuleResponse = cloudstackcommon.DeleteLoadBalancerRuleResponse
 
-// You should always use this function to get a new RemoveCertFromLoadBalancerParams instance,
+// You should always use this function to get a new DeleteLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams {
-	p := &RemoveCertFromLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams {
+	p := &DeleteLoadBalancerRuleParams{}
+	p.SetId(id)
 	return p
 }
 
-// Removes a certificate from a Load Balancer Rule
-func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("removeCertFromLoadBalancer", p.toURLValues())
+// Deletes a load balancer rule.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLoadBalancerRules, GetLoadBalancerRuleID, GetLoadBalancerRuleByName, GetLoadBalancerRuleByID, GetLoadBalancerRulesByTags.
+func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveCertFromLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("deleteLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2575,871 +5636,891 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveCertFromLoadBalancerResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type AddNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
+type ListLoadBalancerRulesParams struct {
+	Account          *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay       *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id               *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid        *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page             *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publicipid       *string           `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Virtualmachineid *string           `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLoadBalancerRulesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["gslbprovider"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("gslbprovider", vv)
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["gslbproviderprivateip"]; found {
-		u.Set("gslbproviderprivateip", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["gslbproviderpublicip"]; found {
-		u.Set("gslbproviderpublicip", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
 	}
-	if v, found := p.p["isexclusivegslbprovider"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isexclusivegslbprovider", vv)
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Publicipid; v != nil {
+		u.Set("publicipid", *v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ListLoadBalancerRulesParams) Command() string {
+	return "listLoadBalancerRules"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListLoadBalancerRulesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLoadBalancerRulesParams) ResponseType() interface{} {
+	return &ListLoadBalancerRulesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLoadBalancerRulesParams) Validate() error {
+	v := &validator{api: "listLoadBalancerRules"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListLoadBalancerRulesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListLoadBalancerRulesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListLoadBalancerRulesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListLoadBalancerRulesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["gslbprovider"] = v
+	return *p.Domainid
+}
+
+func (p *ListLoadBalancerRulesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListLoadBalancerRulesParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *ListLoadBalancerRulesParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *ListLoadBalancerRulesParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListLoadBalancerRulesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListLoadBalancerRulesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListLoadBalancerRulesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListLoadBalancerRulesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["gslbproviderprivateip"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListLoadBalancerRulesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLoadBalancerRulesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["gslbproviderpublicip"] = v
+	return *p.Keyword
+}
+
+func (p *ListLoadBalancerRulesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListLoadBalancerRulesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["isexclusivegslbprovider"] = v
+	return *p.Listall
+}
+
+func (p *ListLoadBalancerRulesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListLoadBalancerRulesParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["networkdevicetype"] = v
+	return *p.Name
+}
+
+func (p *ListLoadBalancerRulesParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListLoadBalancerRulesParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["password"] = v
+	return *p.Networkid
+}
+
+func (p *ListLoadBalancerRulesParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListLoadBalancerRulesParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLoadBalancerRulesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["physicalnetworkid"] = v
-	return
+	return *p.Page
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+func (p *ListLoadBalancerRulesParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
+func (p *ListLoadBalancerRulesParams) ResetPage() {
+	p.Page = nil
 }
 
-// You should always use this function to get a new AddNetscalerLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams {
-	p := &AddNetscalerLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
-	return p
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLoadBalancerRulesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-// Adds a netscaler load balancer device
-func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("addNetscalerLoadBalancer", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *ListLoadBalancerRulesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
+	return *p.Pagesize
+}
 
-	var r AddNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *ListLoadBalancerRulesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListLoadBalancerRulesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListLoadBalancerRulesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *ListLoadBalancerRulesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Projectid
 }
 
-type AddNetscalerLoadBalancerResponse struct {
-	JobID                   string   `json:"jobid,omitempty"`
-	Gslbprovider            bool     `json:"gslbprovider,omitempty"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip,omitempty"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip,omitempty"`
-	Ipaddress               string   `json:"ipaddress,omitempty"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider,omitempty"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity,omitempty"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated,omitempty"`
-	Lbdeviceid              string   `json:"lbdeviceid,omitempty"`
-	Lbdevicename            string   `json:"lbdevicename,omitempty"`
-	Lbdevicestate           string   `json:"lbdevicestate,omitempty"`
-	Physicalnetworkid       string   `json:"physicalnetworkid,omitempty"`
-	Podids                  []string `json:"podids,omitempty"`
-	Privateinterface        string   `json:"privateinterface,omitempty"`
-	Provider                string   `json:"provider,omitempty"`
-	Publicinterface         string   `json:"publicinterface,omitempty"`
+func (p *ListLoadBalancerRulesParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-type DeleteNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
+func (p *ListLoadBalancerRulesParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
-	}
-	return u
+func (p *ListLoadBalancerRulesParams) SetPublicipid(v string) {
+	p.Publicipid = &v
+	return
 }
 
-func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetPublicipid() string {
+	if p.Publicipid == nil {
+		return ""
 	}
-	p.p["lbdeviceid"] = v
-	return
+	return *p.Publicipid
 }
 
-// You should always use this function to get a new DeleteNetscalerLoadBalancerParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams {
-	p := &DeleteNetscalerLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
-	return p
+func (p *ListLoadBalancerRulesParams) HasPublicipid() bool {
+	return p.Publicipid != nil
 }
 
-//  delete a netscaler load balancer device
-func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListLoadBalancerRulesParams) ResetPublicipid() {
+	p.Publicipid = nil
+}
 
-	var r DeleteNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListLoadBalancerRulesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListLoadBalancerRulesParams) GetTags() map[string]string {
+	return p.Tags
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+func (p *ListLoadBalancerRulesParams) HasTags() bool {
+	return p.Tags != nil
 }
 
-type DeleteNetscalerLoadBalancerResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *ListLoadBalancerRulesParams) ResetTags() {
+	p.Tags = nil
 }
 
-type ConfigureNetscalerLoadBalancerParams struct {
-	p map[string]interface{}
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListLoadBalancerRulesParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["inline"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("inline", vv)
-	}
-	if v, found := p.p["lbdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("lbdevicecapacity", vv)
+func (p *ListLoadBalancerRulesParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	if v, found := p.p["lbdevicededicated"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lbdevicededicated", vv)
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
-	}
-	if v, found := p.p["podids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("podids", vv)
-	}
-	return u
+	return *p.Virtualmachineid
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["inline"] = v
-	return
+func (p *ListLoadBalancerRulesParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdevicecapacity"] = v
-	return
+func (p *ListLoadBalancerRulesParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdevicededicated"] = v
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListLoadBalancerRulesParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRulesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	p.p["lbdeviceid"] = v
-	return
+	return *p.Zoneid
 }
 
-func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podids"] = v
-	return
+func (p *ListLoadBalancerRulesParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
+func (p *ListLoadBalancerRulesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListLoadBalancerRulesParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams {
-	p := &ConfigureNetscalerLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams {
+	p := &ListLoadBalancerRulesParams{}
 	return p
 }
 
-// configures a netscaler load balancer device
-func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*ConfigureNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("configureNetscalerLoadBalancer", p.toURLValues())
-	if err != nil {
-		return nil, err
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListLoadBalancerRulesParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
 
-	var r ConfigureNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	l, err := s.ListLoadBalancerRules(p)
+	if err != nil {
+		return "", err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListLoadBalancerRules(p)
 		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+			return "", err
 		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	if l.Count == 1 {
+		return l.LoadBalancerRules[0].Id, nil
+	}
+
+	var matches []*LoadBalancerRule
+	for _, v := range l.LoadBalancerRules {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
-	return &r, nil
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "LoadBalancerRule", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-type ConfigureNetscalerLoadBalancerResponse struct {
-	JobID                   string   `json:"jobid,omitempty"`
-	Gslbprovider            bool     `json:"gslbprovider,omitempty"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip,omitempty"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip,omitempty"`
-	Ipaddress               string   `json:"ipaddress,omitempty"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider,omitempty"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity,omitempty"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated,omitempty"`
-	Lbdeviceid              string   `json:"lbdeviceid,omitempty"`
-	Lbdevicename            string   `json:"lbdevicename,omitempty"`
-	Lbdevicestate           string   `json:"lbdevicestate,omitempty"`
-	Physicalnetworkid       string   `json:"physicalnetworkid,omitempty"`
-	Podids                  []string `json:"podids,omitempty"`
-	Privateinterface        string   `json:"privateinterface,omitempty"`
-	Provider                string   `json:"provider,omitempty"`
-	Publicinterface         string   `json:"publicinterface,omitempty"`
-}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	id, err := s.GetLoadBalancerRuleID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
 
-type ListNetscalerLoadBalancersParams struct {
-	p map[string]interface{}
+	r, count, err := s.GetLoadBalancerRuleByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
 }
 
-func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	p := &ListLoadBalancerRulesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+
+	l, err := s.ListLoadBalancerRules(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListLoadBalancerRules(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
 	}
-	return u
-}
 
-func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.LoadBalancerRules[0], l.Count, nil
 	}
-	p.p["lbdeviceid"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancerRule UUID: %s!", id)
 }
 
-func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *LoadBalancerService) GetLoadBalancerRulesByTags(tags map[string]string, opts ...OptionFunc) ([]*LoadBalancerRule, error) {
+	p := &ListLoadBalancerRulesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListLoadBalancerRules(p)
+	if err != nil {
+		return nil, err
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListLoadBalancerRules(p)
+		if err != nil {
+			return nil, err
+		}
 	}
-	p.p["physicalnetworkid"] = v
-	return
-}
 
-// You should always use this function to get a new ListNetscalerLoadBalancersParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams {
-	p := &ListNetscalerLoadBalancersParams{}
-	p.p = make(map[string]interface{})
-	return p
+	return l.LoadBalancerRules, nil
 }
 
-// lists netscaler load balancer devices
-func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", p.toURLValues())
+// Lists load balancer rules.
+//
+// See also GetLoadBalancerRuleID, GetLoadBalancerRuleByName, GetLoadBalancerRuleByID, GetLoadBalancerRulesByTags.
+func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listLoadBalancerRules", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListNetscalerLoadBalancersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListLoadBalancerRulesResponse
+	if err := s.cs.unmarshal("listLoadBalancerRules", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListNetscalerLoadBalancersResponse struct {
-	Count                  int                      `json:"count"`
-	NetscalerLoadBalancers []*NetscalerLoadBalancer `json:"netscalerloadbalancer"`
+// Lists the objects like ListLoadBalancerRules, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamLoadBalancerRules(ctx context.Context, p *ListLoadBalancerRulesParams, fn func(*LoadBalancerRule) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listLoadBalancerRules", p.URLValues(), "loadbalancerrule", func(b json.RawMessage) error {
+		var v LoadBalancerRule
+		if err := s.cs.unmarshalElement("listLoadBalancerRules", "loadbalancerrule", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type NetscalerLoadBalancer struct {
-	Gslbprovider            bool     `json:"gslbprovider,omitempty"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip,omitempty"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip,omitempty"`
-	Ipaddress               string   `json:"ipaddress,omitempty"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider,omitempty"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity,omitempty"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated,omitempty"`
-	Lbdeviceid              string   `json:"lbdeviceid,omitempty"`
-	Lbdevicename            string   `json:"lbdevicename,omitempty"`
-	Lbdevicestate           string   `json:"lbdevicestate,omitempty"`
-	Physicalnetworkid       string   `json:"physicalnetworkid,omitempty"`
-	Podids                  []string `json:"podids,omitempty"`
-	Privateinterface        string   `json:"privateinterface,omitempty"`
-	Provider                string   `json:"provider,omitempty"`
-	Publicinterface         string   `json:"publicinterface,omitempty"`
+type ListLoadBalancerRulesResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	LoadBalancerRules []*LoadBalancerRule        `json:"loadbalancerrule" xml:"loadbalancerrule"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type CreateGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+type LoadBalancerRule struct {
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Algorithm   LBAlgorithm                `json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateport string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicip    string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid  string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Publicport  string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Zoneid      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateLoadBalancerRuleParams struct {
+	Algorithm   *LBAlgorithm `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	Customid    *string      `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Description *string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool        `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string      `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string      `json:"name,omitempty" yaml:"name,omitempty"`
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateLoadBalancerRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if v := p.Algorithm; v != nil {
+		u.Set("algorithm", string(*v))
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["gslbdomainname"]; found {
-		u.Set("gslbdomainname", v.(string))
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
 	}
-	if v, found := p.p["gslblbmethod"]; found {
-		u.Set("gslblbmethod", v.(string))
-	}
-	if v, found := p.p["gslbservicetype"]; found {
-		u.Set("gslbservicetype", v.(string))
-	}
-	if v, found := p.p["gslbstickysessionmethodname"]; found {
-		u.Set("gslbstickysessionmethodname", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
 	return u
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *CreateGlobalLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateLoadBalancerRuleParams) Command() string {
+	return "updateLoadBalancerRule"
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateLoadBalancerRuleParams) IsAsync() bool {
+	return true
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslbdomainname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbdomainname"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateLoadBalancerRuleParams) ResponseType() interface{} {
+	return &UpdateLoadBalancerRuleResponse{}
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "updateLoadBalancerRule"}
+	if p.Algorithm != nil {
+		v.enum("algorithm", string(*p.Algorithm), p.Algorithm.Known(), false)
 	}
-	p.p["gslblbmethod"] = v
-	return
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslbservicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbservicetype"] = v
+func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v LBAlgorithm) {
+	p.Algorithm = &v
 	return
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateLoadBalancerRuleParams) GetAlgorithm() LBAlgorithm {
+	if p.Algorithm == nil {
+		return ""
 	}
-	p.p["gslbstickysessionmethodname"] = v
-	return
+	return *p.Algorithm
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *UpdateLoadBalancerRuleParams) HasAlgorithm() bool {
+	return p.Algorithm != nil
 }
 
-func (p *CreateGlobalLoadBalancerRuleParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
-	return
+func (p *UpdateLoadBalancerRuleParams) ResetAlgorithm() {
+	p.Algorithm = nil
 }
 
-// You should always use this function to get a new CreateGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams {
-	p := &CreateGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["gslbdomainname"] = gslbdomainname
-	p.p["gslbservicetype"] = gslbservicetype
-	p.p["name"] = name
-	p.p["regionid"] = regionid
-	return p
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only. Only supported by CloudStack 4.4.
+func (p *UpdateLoadBalancerRuleParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
 }
 
-// Creates a global load balancer rule
-func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("createGlobalLoadBalancerRule", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *UpdateLoadBalancerRuleParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
+	return *p.Customid
+}
 
-	var r CreateGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *UpdateLoadBalancerRuleParams) HasCustomid() bool {
+	return p.Customid != nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UpdateLoadBalancerRuleParams) ResetCustomid() {
+	p.Customid = nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *UpdateLoadBalancerRuleParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *UpdateLoadBalancerRuleParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Description
 }
 
-type CreateGlobalLoadBalancerRuleResponse struct {
-	JobID                       string `json:"jobid,omitempty"`
-	Account                     string `json:"account,omitempty"`
-	Description                 string `json:"description,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gslbdomainname              string `json:"gslbdomainname,omitempty"`
-	Gslblbmethod                string `json:"gslblbmethod,omitempty"`
-	Gslbservicetype             string `json:"gslbservicetype,omitempty"`
-	Gslbstickysessionmethodname string `json:"gslbstickysessionmethodname,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Loadbalancerrule            []struct {
-		Account     string `json:"account,omitempty"`
-		Algorithm   string `json:"algorithm,omitempty"`
-		Cidrlist    string `json:"cidrlist,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Fordisplay  bool   `json:"fordisplay,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Name        string `json:"name,omitempty"`
-		Networkid   string `json:"networkid,omitempty"`
-		Privateport string `json:"privateport,omitempty"`
-		Project     string `json:"project,omitempty"`
-		Projectid   string `json:"projectid,omitempty"`
-		Protocol    string `json:"protocol,omitempty"`
-		Publicip    string `json:"publicip,omitempty"`
-		Publicipid  string `json:"publicipid,omitempty"`
-		Publicport  string `json:"publicport,omitempty"`
-		State       string `json:"state,omitempty"`
-		Tags        []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Zoneid string `json:"zoneid,omitempty"`
-	} `json:"loadbalancerrule,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	Regionid  int    `json:"regionid,omitempty"`
-}
-
-type DeleteGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *UpdateLoadBalancerRuleParams) HasDescription() bool {
+	return p.Description != nil
 }
 
-func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateLoadBalancerRuleParams) ResetDescription() {
+	p.Description = nil
 }
 
-// You should always use this function to get a new DeleteGlobalLoadBalancerRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams {
-	p := &DeleteGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *UpdateLoadBalancerRuleParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
 }
 
-// Deletes a global load balancer rule.
-func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteGlobalLoadBalancerRule", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *UpdateLoadBalancerRuleParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
+	return *p.Fordisplay
+}
 
-	var r DeleteGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *UpdateLoadBalancerRuleParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UpdateLoadBalancerRuleParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// This param is required.
+func (p *UpdateLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-type DeleteGlobalLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *UpdateLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
 }
 
-type UpdateGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+func (p *UpdateLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["gslblbmethod"]; found {
-		u.Set("gslblbmethod", v.(string))
-	}
-	if v, found := p.p["gslbstickysessionmethodname"]; found {
-		u.Set("gslbstickysessionmethodname", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *UpdateLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
+func (p *UpdateLoadBalancerRuleParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) SetGslblbmethod(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateLoadBalancerRuleParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["gslblbmethod"] = v
-	return
+	return *p.Name
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) SetGslbstickysessionmethodname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslbstickysessionmethodname"] = v
-	return
+func (p *UpdateLoadBalancerRuleParams) HasName() bool {
+	return p.Name != nil
 }
 
-func (p *UpdateGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateLoadBalancerRuleParams) ResetName() {
+	p.Name = nil
 }
 
-// You should always use this function to get a new UpdateGlobalLoadBalancerRuleParams instance,
+// You should always use this function to get a new UpdateLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams {
-	p := &UpdateGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams {
+	p := &UpdateLoadBalancerRuleParams{}
+	p.SetId(id)
 	return p
 }
 
-// update global load balancer rules.
-func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("updateGlobalLoadBalancerRule", p.toURLValues())
+// Updates load balancer
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListLoadBalancerRules, GetLoadBalancerRuleID, GetLoadBalancerRuleByName, GetLoadBalancerRuleByID, GetLoadBalancerRulesByTags.
+func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("updateLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3460,266 +6541,249 @@ func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateGlobalLoadBalancerRuleResponse struct {
-	JobID                       string `json:"jobid,omitempty"`
-	Account                     string `json:"account,omitempty"`
-	Description                 string `json:"description,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gslbdomainname              string `json:"gslbdomainname,omitempty"`
-	Gslblbmethod                string `json:"gslblbmethod,omitempty"`
-	Gslbservicetype             string `json:"gslbservicetype,omitempty"`
-	Gslbstickysessionmethodname string `json:"gslbstickysessionmethodname,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Loadbalancerrule            []struct {
-		Account     string `json:"account,omitempty"`
-		Algorithm   string `json:"algorithm,omitempty"`
-		Cidrlist    string `json:"cidrlist,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Fordisplay  bool   `json:"fordisplay,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Name        string `json:"name,omitempty"`
-		Networkid   string `json:"networkid,omitempty"`
-		Privateport string `json:"privateport,omitempty"`
-		Project     string `json:"project,omitempty"`
-		Projectid   string `json:"projectid,omitempty"`
-		Protocol    string `json:"protocol,omitempty"`
-		Publicip    string `json:"publicip,omitempty"`
-		Publicipid  string `json:"publicipid,omitempty"`
-		Publicport  string `json:"publicport,omitempty"`
-		State       string `json:"state,omitempty"`
-		Tags        []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Zoneid string `json:"zoneid,omitempty"`
-	} `json:"loadbalancerrule,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	Regionid  int    `json:"regionid,omitempty"`
+type UpdateLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Algorithm   LBAlgorithm                `json:"algorithm,omitempty" xml:"algorithm,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateport string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicip    string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid  string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Publicport  string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Zoneid      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListGlobalLoadBalancerRulesParams struct {
-	p map[string]interface{}
+type ListLoadBalancerRuleInstancesParams struct {
+	Applied  *bool   `json:"applied,omitempty" yaml:"applied,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbvmips  *bool   `json:"lbvmips,omitempty" yaml:"lbvmips,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLoadBalancerRuleInstancesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Applied; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("applied", vv)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Lbvmips; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("lbvmips", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
-	}
 	return u
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListLoadBalancerRuleInstancesParams) Command() string {
+	return "listLoadBalancerRuleInstances"
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListLoadBalancerRuleInstancesParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLoadBalancerRuleInstancesParams) ResponseType() interface{} {
+	return &ListLoadBalancerRuleInstancesResponse{}
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLoadBalancerRuleInstancesParams) Validate() error {
+	v := &validator{api: "listLoadBalancerRuleInstances"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
+	p.Applied = &v
 	return
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRuleInstancesParams) GetApplied() bool {
+	if p.Applied == nil {
+		return false
 	}
-	p.p["listall"] = v
-	return
+	return *p.Applied
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListLoadBalancerRuleInstancesParams) HasApplied() bool {
+	return p.Applied != nil
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *ListLoadBalancerRuleInstancesParams) ResetApplied() {
+	p.Applied = nil
+}
+
+// This param is required.
+func (p *ListLoadBalancerRuleInstancesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRuleInstancesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Id
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLoadBalancerRuleInstancesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRuleInstancesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["regionid"] = v
+	return *p.Keyword
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *ListLoadBalancerRuleInstancesParams) SetLbvmips(v bool) {
+	p.Lbvmips = &v
 	return
 }
 
-func (p *ListGlobalLoadBalancerRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRuleInstancesParams) GetLbvmips() bool {
+	if p.Lbvmips == nil {
+		return false
 	}
-	p.p["tags"] = v
-	return
+	return *p.Lbvmips
 }
 
-// You should always use this function to get a new ListGlobalLoadBalancerRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams {
-	p := &ListGlobalLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListLoadBalancerRuleInstancesParams) HasLbvmips() bool {
+	return p.Lbvmips != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string) (string, error) {
-	p := &ListGlobalLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
+func (p *ListLoadBalancerRuleInstancesParams) ResetLbvmips() {
+	p.Lbvmips = nil
+}
 
-	p.p["keyword"] = keyword
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLoadBalancerRuleInstancesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
 
-	l, err := s.ListGlobalLoadBalancerRules(p)
-	if err != nil {
-		return "", err
+func (p *ListLoadBalancerRuleInstancesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
+	return *p.Page
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
+func (p *ListLoadBalancerRuleInstancesParams) HasPage() bool {
+	return p.Page != nil
+}
 
-	if l.Count == 1 {
-		return l.GlobalLoadBalancerRules[0].Id, nil
-	}
+func (p *ListLoadBalancerRuleInstancesParams) ResetPage() {
+	p.Page = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.GlobalLoadBalancerRules {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLoadBalancerRuleInstancesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string) (*GlobalLoadBalancerRule, int, error) {
-	id, err := s.GetGlobalLoadBalancerRuleID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *ListLoadBalancerRuleInstancesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
+	return *p.Pagesize
+}
 
-	r, count, err := s.GetGlobalLoadBalancerRuleByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *ListLoadBalancerRuleInstancesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListLoadBalancerRuleInstancesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListLoadBalancerRuleInstancesParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams {
+	p := &ListLoadBalancerRuleInstancesParams{}
+	p.SetId(id)
+	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string) (*GlobalLoadBalancerRule, int, error) {
-	p := &ListGlobalLoadBalancerRulesParams{}
-	p.p = make(map[string]interface{})
+func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*LoadBalancerRuleInstance, int, error) {
+	p := &ListLoadBalancerRuleInstancesParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
+	p.SetId(id)
 
-	l, err := s.ListGlobalLoadBalancerRules(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListLoadBalancerRuleInstances(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -3734,149 +6798,159 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string) (*GlobalL
 	}
 
 	if l.Count == 1 {
-		return l.GlobalLoadBalancerRules[0], l.Count, nil
+		return l.LoadBalancerRuleInstances[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancerRuleInstance UUID: %s!", id)
+}
+
+// List all virtual machine instances that are assigned to a load balancer rule.
+//
+// See also GetLoadBalancerRuleInstanceByID.
+func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for GlobalLoadBalancerRule UUID: %s!", id)
-}
 
-// Lists load balancer rules.
-func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", p.toURLValues())
+	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListGlobalLoadBalancerRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListLoadBalancerRuleInstancesResponse
+	if err := s.cs.unmarshal("listLoadBalancerRuleInstances", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListGlobalLoadBalancerRulesResponse struct {
-	Count                   int                       `json:"count"`
-	GlobalLoadBalancerRules []*GlobalLoadBalancerRule `json:"globalloadbalancerrule"`
-}
-
-type GlobalLoadBalancerRule struct {
-	Account                     string `json:"account,omitempty"`
-	Description                 string `json:"description,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gslbdomainname              string `json:"gslbdomainname,omitempty"`
-	Gslblbmethod                string `json:"gslblbmethod,omitempty"`
-	Gslbservicetype             string `json:"gslbservicetype,omitempty"`
-	Gslbstickysessionmethodname string `json:"gslbstickysessionmethodname,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Loadbalancerrule            []struct {
-		Account     string `json:"account,omitempty"`
-		Algorithm   string `json:"algorithm,omitempty"`
-		Cidrlist    string `json:"cidrlist,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Fordisplay  bool   `json:"fordisplay,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Name        string `json:"name,omitempty"`
-		Networkid   string `json:"networkid,omitempty"`
-		Privateport string `json:"privateport,omitempty"`
-		Project     string `json:"project,omitempty"`
-		Projectid   string `json:"projectid,omitempty"`
-		Protocol    string `json:"protocol,omitempty"`
-		Publicip    string `json:"publicip,omitempty"`
-		Publicipid  string `json:"publicipid,omitempty"`
-		Publicport  string `json:"publicport,omitempty"`
-		State       string `json:"state,omitempty"`
-		Tags        []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Zoneid string `json:"zoneid,omitempty"`
-	} `json:"loadbalancerrule,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	Regionid  int    `json:"regionid,omitempty"`
-}
-
-type AssignToGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["gslblbruleweightsmap"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].key", i), k)
-			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].value", i), vv)
-			i++
+// Lists the objects like ListLoadBalancerRuleInstances, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamLoadBalancerRuleInstances(ctx context.Context, p *ListLoadBalancerRuleInstancesParams, fn func(*LoadBalancerRuleInstance) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["loadbalancerrulelist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("loadbalancerrulelist", vv)
-	}
-	return u
-}
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gslblbruleweightsmap"] = v
-	return
+	return s.cs.streamList(ctx, "listLoadBalancerRuleInstances", p.URLValues(), "loadbalancerruleinstance", func(b json.RawMessage) error {
+		var v LoadBalancerRuleInstance
+		if err := s.cs.unmarshalElement("listLoadBalancerRuleInstances", "loadbalancerruleinstance", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+type ListLoadBalancerRuleInstancesResponse struct {
+	Count                     int                         `json:"count" xml:"count"`
+	LoadBalancerRuleInstances []*LoadBalancerRuleInstance `json:"loadbalancerruleinstance" xml:"loadbalancerruleinstance"`
+	Extra                     map[string]json.RawMessage  `json:"-" xml:"-"`
 }
 
-func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["loadbalancerrulelist"] = v
-	return
-}
+type LoadBalancerRuleInstance struct {
+	Account                  string                      `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup            []AffinityGroup             `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber                int                         `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed                 int                         `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                  Percentage                  `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created                  Date                        `json:"created,omitempty" xml:"created,omitempty"`
+	Details                  map[string]string           `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread               int64                       `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite              int64                       `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread              int64                       `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite             int64                       `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Displayname              string                      `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm                Bool                        `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                   string                      `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                 string                      `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork        Bool                        `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                    string                      `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid                  string                      `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid                string                      `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable                 Bool                        `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                   string                      `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname                 string                      `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor               HypervisorType              `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                       string                      `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename             string                      `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable    Bool                        `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext           string                      `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                    string                      `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname                  string                      `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair                  string                      `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Lbvmipaddresses          []string                    `json:"lbvmipaddresses,omitempty" xml:"lbvmipaddresses,omitempty"`
+	Loadbalancerruleinstance string                      `json:"loadbalancerruleinstance,omitempty" xml:"loadbalancerruleinstance,omitempty"`
+	Memory                   int                         `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                     string                      `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread           int64                       `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite          int64                       `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                      []LoadBalancerNic           `json:"nic,omitempty" xml:"nic,omitempty"`
+	Password                 string                      `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled          Bool                        `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project                  string                      `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                string                      `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip                 string                      `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid               string                      `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid             int64                       `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype           string                      `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup            []LoadBalancerSecurityGroup `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid        string                      `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname      string                      `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate             string                      `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                    VMState                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                     []Tag                       `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext      string                      `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid               string                      `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename             string                      `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Zoneid                   string                      `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                 string                      `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                    map[string]json.RawMessage  `json:"-" xml:"-"`
+}
+
+type AddNetscalerLoadBalancerParams = cloudstackcommon.AddNetscalerLoadBalancerParams
+type AddNetscalerLoadBalancerResponse = cloudstackcommon.AddNetscalerLoadBalancerResponse
 
-// You should always use this function to get a new AssignToGlobalLoadBalancerRuleParams instance,
+// You should always use this function to get a new AddNetscalerLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams {
-	p := &AssignToGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+//
+// The required params are:
+//
+//   - networkdevicetype
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - url
+//   - username: the name of the user
+func (s *LoadBalancerService) NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams {
+	p := &AddNetscalerLoadBalancerParams{}
+	p.SetNetworkdevicetype(networkdevicetype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Assign load balancer rule or list of load balancer rules to a global load balancer rules.
-func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("assignToGlobalLoadBalancerRule", p.toURLValues())
+// Adds a netscaler load balancer device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetscalerLoadBalancers.
+func (s *LoadBalancerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addNetscalerLoadBalancer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AssignToGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddNetscalerLoadBalancerResponse
+	if err := s.cs.unmarshal("addNetscalerLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3892,73 +6966,111 @@ func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLo
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("addNetscalerLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AssignToGlobalLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type ConfigureNetscalerLoadBalancerParams = cloudstackcommon.ConfigureNetscalerLoadBalancerParams
+type ConfigureNetscalerLoadBalancerResponse = cloudstackcommon.ConfigureNetscalerLoadBalancerResponse
 
-type RemoveFromGlobalLoadBalancerRuleParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ConfigureNetscalerLoadBalancerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *LoadBalancerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams {
+	p := &ConfigureNetscalerLoadBalancerParams{}
+	p.SetLbdeviceid(lbdeviceid)
+	return p
 }
 
-func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// configures a netscaler load balancer device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetscalerLoadBalancers.
+func (s *LoadBalancerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*ConfigureNetscalerLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["loadbalancerrulelist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("loadbalancerrulelist", vv)
+
+	resp, err := s.cs.newRequest("configureNetscalerLoadBalancer", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ConfigureNetscalerLoadBalancerResponse
+	if err := s.cs.unmarshal("configureNetscalerLoadBalancer", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("configureNetscalerLoadBalancer", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["loadbalancerrulelist"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new RemoveFromGlobalLoadBalancerRuleParams instance,
+type DeleteNetscalerLoadBalancerParams = cloudstackcommon.DeleteNetscalerLoadBalancerParams
+type DeleteNetscalerLoadBalancerResponse = cloudstackcommon.DeleteNetscalerLoadBalancerResponse
+
+// You should always use this function to get a new DeleteNetscalerLoadBalancerParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams {
-	p := &RemoveFromGlobalLoadBalancerRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["loadbalancerrulelist"] = loadbalancerrulelist
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *LoadBalancerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams {
+	p := &DeleteNetscalerLoadBalancerParams{}
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// Removes a load balancer rule association with global load balancer rule
-func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newRequest("removeFromGlobalLoadBalancerRule", p.toURLValues())
+//	delete a netscaler load balancer device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetscalerLoadBalancers.
+func (s *LoadBalancerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNetscalerLoadBalancer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveFromGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteNetscalerLoadBalancerResponse
+	if err := s.cs.unmarshal("deleteNetscalerLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3974,611 +7086,588 @@ func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlob
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteNetscalerLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveFromGlobalLoadBalancerRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type ListNetscalerLoadBalancersParams = cloudstackcommon.ListNetscalerLoadBalancersParams
+type ListNetscalerLoadBalancersResponse = cloudstackcommon.ListNetscalerLoadBalancersResponse
+type NetscalerLoadBalancer = cloudstackcommon.NetscalerLoadBalancer
 
-type CreateLoadBalancerParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListNetscalerLoadBalancersParams instance,
+// as then you are sure you have configured all required params
+func (s *LoadBalancerService) NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams {
+	p := &ListNetscalerLoadBalancersParams{}
+	return p
 }
 
-func (p *CreateLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// lists netscaler load balancer devices
+func (s *LoadBalancerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["algorithm"]; found {
-		u.Set("algorithm", v.(string))
+
+	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+
+	var r ListNetscalerLoadBalancersResponse
+	if err := s.cs.unmarshal("listNetscalerLoadBalancers", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	return &r, nil
+}
+
+// Lists the objects like ListNetscalerLoadBalancers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamNetscalerLoadBalancers(ctx context.Context, p *ListNetscalerLoadBalancersParams, fn func(*NetscalerLoadBalancer) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	if v, found := p.p["instanceport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("instanceport", vv)
+
+	return s.cs.streamList(ctx, "listNetscalerLoadBalancers", p.URLValues(), "netscalerloadbalancer", func(b json.RawMessage) error {
+		var v NetscalerLoadBalancer
+		if err := s.cs.unmarshalElement("listNetscalerLoadBalancers", "netscalerloadbalancer", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type DeleteSslCertParams = cloudstackcommon.DeleteSslCertParams
+type DeleteSslCertResponse = cloudstackcommon.DeleteSslCertResponse
+
+// You should always use this function to get a new DeleteSslCertParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertParams {
+	p := &DeleteSslCertParams{}
+	p.SetId(id)
+	return p
+}
+
+// Delete a certificate to cloudstack
+//
+// See also ListSslCerts.
+func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+
+	resp, err := s.cs.newRequest("deleteSslCert", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+
+	var r DeleteSslCertResponse
+	if err := s.cs.unmarshal("deleteSslCert", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["scheme"]; found {
-		u.Set("scheme", v.(string))
+	return &r, nil
+}
+
+type ListSslCertsParams struct {
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Certid    *string `json:"certid,omitempty" yaml:"certid,omitempty"`
+	Lbruleid  *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSslCertsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accountid; v != nil {
+		u.Set("accountid", *v)
 	}
-	if v, found := p.p["sourceipaddress"]; found {
-		u.Set("sourceipaddress", v.(string))
+	if v := p.Certid; v != nil {
+		u.Set("certid", *v)
 	}
-	if v, found := p.p["sourceipaddressnetworkid"]; found {
-		u.Set("sourceipaddressnetworkid", v.(string))
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
 	}
-	if v, found := p.p["sourceport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sourceport", vv)
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
 	return u
 }
 
-func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["algorithm"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListSslCertsParams) Command() string {
+	return "listSslCerts"
 }
 
-func (p *CreateLoadBalancerParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListSslCertsParams) IsAsync() bool {
+	return false
 }
 
-func (p *CreateLoadBalancerParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSslCertsParams) ResponseType() interface{} {
+	return &ListSslCertsResponse{}
 }
 
-func (p *CreateLoadBalancerParams) SetInstanceport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["instanceport"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSslCertsParams) Validate() error {
+	v := &validator{api: "listSslCerts"}
+	return v.err()
 }
 
-func (p *CreateLoadBalancerParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Sets the 'accountid' param: the ID of the account.
+func (p *ListSslCertsParams) SetAccountid(v string) {
+	p.Accountid = &v
 	return
 }
 
-func (p *CreateLoadBalancerParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSslCertsParams) GetAccountid() string {
+	if p.Accountid == nil {
+		return ""
 	}
-	p.p["networkid"] = v
-	return
+	return *p.Accountid
 }
 
-func (p *CreateLoadBalancerParams) SetScheme(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scheme"] = v
+func (p *ListSslCertsParams) HasAccountid() bool {
+	return p.Accountid != nil
+}
+
+func (p *ListSslCertsParams) ResetAccountid() {
+	p.Accountid = nil
+}
+
+func (p *ListSslCertsParams) SetCertid(v string) {
+	p.Certid = &v
 	return
 }
 
-func (p *CreateLoadBalancerParams) SetSourceipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSslCertsParams) GetCertid() string {
+	if p.Certid == nil {
+		return ""
 	}
-	p.p["sourceipaddress"] = v
+	return *p.Certid
+}
+
+func (p *ListSslCertsParams) HasCertid() bool {
+	return p.Certid != nil
+}
+
+func (p *ListSslCertsParams) ResetCertid() {
+	p.Certid = nil
+}
+
+func (p *ListSslCertsParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
 	return
 }
 
-func (p *CreateLoadBalancerParams) SetSourceipaddressnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSslCertsParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
 	}
-	p.p["sourceipaddressnetworkid"] = v
+	return *p.Lbruleid
+}
+
+func (p *ListSslCertsParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *ListSslCertsParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project. Only supported by CloudStack 4.4.
+func (p *ListSslCertsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *CreateLoadBalancerParams) SetSourceport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSslCertsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["sourceport"] = v
-	return
+	return *p.Projectid
 }
 
-// You should always use this function to get a new CreateLoadBalancerParams instance,
+func (p *ListSslCertsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListSslCertsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// You should always use this function to get a new ListSslCertsParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams {
-	p := &CreateLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["algorithm"] = algorithm
-	p.p["instanceport"] = instanceport
-	p.p["name"] = name
-	p.p["networkid"] = networkid
-	p.p["scheme"] = scheme
-	p.p["sourceipaddressnetworkid"] = sourceipaddressnetworkid
-	p.p["sourceport"] = sourceport
+func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
+	p := &ListSslCertsParams{}
 	return p
 }
 
-// Creates a Load Balancer
-func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("createLoadBalancer", p.toURLValues())
+// Lists SSL certificates
+func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSslCerts", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListSslCertsResponse
+	if err := s.cs.unmarshal("listSslCerts", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
+// Lists the objects like ListSslCerts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *LoadBalancerService) StreamSslCerts(ctx context.Context, p *ListSslCertsParams, fn func(*SslCert) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	return s.cs.streamList(ctx, "listSslCerts", p.URLValues(), "sslcert", func(b json.RawMessage) error {
+		var v SslCert
+		if err := s.cs.unmarshalElement("listSslCerts", "sslcert", b, &v); err != nil {
+			return err
 		}
-	}
-	return &r, nil
+		return fn(&v)
+	})
+}
+
+type ListSslCertsResponse struct {
+	Count    int                        `json:"count" xml:"count"`
+	SslCerts []*SslCert                 `json:"sslcert" xml:"sslcert"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type CreateLoadBalancerResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Algorithm            string `json:"algorithm,omitempty"`
-	Description          string `json:"description,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Loadbalancerinstance []struct {
-		Id        string `json:"id,omitempty"`
-		Ipaddress string `json:"ipaddress,omitempty"`
-		Name      string `json:"name,omitempty"`
-		State     string `json:"state,omitempty"`
-	} `json:"loadbalancerinstance,omitempty"`
-	Loadbalancerrule []struct {
-		Instanceport int    `json:"instanceport,omitempty"`
-		Sourceport   int    `json:"sourceport,omitempty"`
-		State        string `json:"state,omitempty"`
-	} `json:"loadbalancerrule,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Networkid                string `json:"networkid,omitempty"`
-	Project                  string `json:"project,omitempty"`
-	Projectid                string `json:"projectid,omitempty"`
-	Sourceipaddress          string `json:"sourceipaddress,omitempty"`
-	Sourceipaddressnetworkid string `json:"sourceipaddressnetworkid,omitempty"`
-	Tags                     []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
+type SslCert struct {
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Certchain            string                     `json:"certchain,omitempty" xml:"certchain,omitempty"`
+	Certificate          string                     `json:"certificate,omitempty" xml:"certificate,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fingerprint          string                     `json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerrulelist []string                   `json:"loadbalancerrulelist,omitempty" xml:"loadbalancerrulelist,omitempty"`
+	Privatekey           string                     `json:"privatekey,omitempty" xml:"privatekey,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListLoadBalancersParams struct {
-	p map[string]interface{}
+type UploadSslCertParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Certchain   *string `json:"certchain,omitempty" yaml:"certchain,omitempty"`
+	Certificate *string `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Password    *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Privatekey  *string `json:"privatekey,omitempty" yaml:"privatekey,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
 }
 
-func (p *ListLoadBalancersParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UploadSslCertParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Certchain; v != nil {
+		u.Set("certchain", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Certificate; v != nil {
+		u.Set("certificate", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["scheme"]; found {
-		u.Set("scheme", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["sourceipaddress"]; found {
-		u.Set("sourceipaddress", v.(string))
+	if v := p.Privatekey; v != nil {
+		u.Set("privatekey", *v)
 	}
-	if v, found := p.p["sourceipaddressnetworkid"]; found {
-		u.Set("sourceipaddressnetworkid", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
 	return u
 }
 
-func (p *ListLoadBalancersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UploadSslCertParams) Command() string {
+	return "uploadSslCert"
 }
 
-func (p *ListLoadBalancersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UploadSslCertParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListLoadBalancersParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UploadSslCertParams) ResponseType() interface{} {
+	return &UploadSslCertResponse{}
 }
 
-func (p *ListLoadBalancersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UploadSslCertParams) Validate() error {
+	v := &validator{api: "uploadSslCert"}
+	v.required("certificate", p.Certificate != nil)
+	v.required("privatekey", p.Privatekey != nil)
+	return v.err()
 }
 
-func (p *ListLoadBalancersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+// Sets the 'account' param: the name of the account. Only supported by CloudStack 4.4.
+func (p *UploadSslCertParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListLoadBalancersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadSslCertParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListLoadBalancersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *UploadSslCertParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListLoadBalancersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *UploadSslCertParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListLoadBalancersParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+func (p *UploadSslCertParams) SetCertchain(v string) {
+	p.Certchain = &v
 	return
 }
 
-func (p *ListLoadBalancersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadSslCertParams) GetCertchain() string {
+	if p.Certchain == nil {
+		return ""
 	}
-	p.p["page"] = v
-	return
+	return *p.Certchain
 }
 
-func (p *ListLoadBalancersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *UploadSslCertParams) HasCertchain() bool {
+	return p.Certchain != nil
 }
 
-func (p *ListLoadBalancersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+func (p *UploadSslCertParams) ResetCertchain() {
+	p.Certchain = nil
 }
 
-func (p *ListLoadBalancersParams) SetScheme(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scheme"] = v
+// This param is required.
+func (p *UploadSslCertParams) SetCertificate(v string) {
+	p.Certificate = &v
 	return
 }
 
-func (p *ListLoadBalancersParams) SetSourceipaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadSslCertParams) GetCertificate() string {
+	if p.Certificate == nil {
+		return ""
 	}
-	p.p["sourceipaddress"] = v
-	return
+	return *p.Certificate
 }
 
-func (p *ListLoadBalancersParams) SetSourceipaddressnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourceipaddressnetworkid"] = v
+func (p *UploadSslCertParams) HasCertificate() bool {
+	return p.Certificate != nil
+}
+
+func (p *UploadSslCertParams) ResetCertificate() {
+	p.Certificate = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. Only supported by CloudStack 4.4.
+func (p *UploadSslCertParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListLoadBalancersParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadSslCertParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["tags"] = v
-	return
+	return *p.Domainid
 }
 
-// You should always use this function to get a new ListLoadBalancersParams instance,
-// as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersParams {
-	p := &ListLoadBalancersParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *UploadSslCertParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerID(name string) (string, error) {
-	p := &ListLoadBalancersParams{}
-	p.p = make(map[string]interface{})
+func (p *UploadSslCertParams) ResetDomainid() {
+	p.Domainid = nil
+}
 
-	p.p["name"] = name
+// Sets the 'password' param: the password.
+func (p *UploadSslCertParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
 
-	l, err := s.ListLoadBalancers(p)
-	if err != nil {
-		return "", err
+func (p *UploadSslCertParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
+	return *p.Password
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *UploadSslCertParams) HasPassword() bool {
+	return p.Password != nil
+}
 
-	if l.Count == 1 {
-		return l.LoadBalancers[0].Id, nil
-	}
+func (p *UploadSslCertParams) ResetPassword() {
+	p.Password = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.LoadBalancers {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+// This param is required.
+func (p *UploadSslCertParams) SetPrivatekey(v string) {
+	p.Privatekey = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerByName(name string) (*LoadBalancer, int, error) {
-	id, err := s.GetLoadBalancerID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *UploadSslCertParams) GetPrivatekey() string {
+	if p.Privatekey == nil {
+		return ""
 	}
+	return *p.Privatekey
+}
 
-	r, count, err := s.GetLoadBalancerByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *UploadSslCertParams) HasPrivatekey() bool {
+	return p.Privatekey != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *LoadBalancerService) GetLoadBalancerByID(id string) (*LoadBalancer, int, error) {
-	p := &ListLoadBalancersParams{}
-	p.p = make(map[string]interface{})
+func (p *UploadSslCertParams) ResetPrivatekey() {
+	p.Privatekey = nil
+}
 
-	p.p["id"] = id
+// Sets the 'projectid' param: the ID of the project. Only supported by CloudStack 4.4.
+func (p *UploadSslCertParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
 
-	l, err := s.ListLoadBalancers(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *UploadSslCertParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
+	return *p.Projectid
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *UploadSslCertParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
 
-	if l.Count == 1 {
-		return l.LoadBalancers[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for LoadBalancer UUID: %s!", id)
+func (p *UploadSslCertParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-// Lists Load Balancers
-func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancers", p.toURLValues())
+// You should always use this function to get a new UploadSslCertParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - certificate
+//   - privatekey
+func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, privatekey string) *UploadSslCertParams {
+	p := &UploadSslCertParams{}
+	p.SetCertificate(certificate)
+	p.SetPrivatekey(privatekey)
+	return p
+}
+
+// Upload a certificate to cloudstack
+//
+// See also ListSslCerts.
+func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("uploadSslCert", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListLoadBalancersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UploadSslCertResponse
+	if err := s.cs.unmarshal("uploadSslCert", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListLoadBalancersResponse struct {
-	Count         int             `json:"count"`
-	LoadBalancers []*LoadBalancer `json:"loadbalancer"`
-}
-
-type LoadBalancer struct {
-	Account              string `json:"account,omitempty"`
-	Algorithm            string `json:"algorithm,omitempty"`
-	Description          string `json:"description,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Loadbalancerinstance []struct {
-		Id        string `json:"id,omitempty"`
-		Ipaddress string `json:"ipaddress,omitempty"`
-		Name      string `json:"name,omitempty"`
-		State     string `json:"state,omitempty"`
-	} `json:"loadbalancerinstance,omitempty"`
-	Loadbalancerrule []struct {
-		Instanceport int    `json:"instanceport,omitempty"`
-		Sourceport   int    `json:"sourceport,omitempty"`
-		State        string `json:"state,omitempty"`
-	} `json:"loadbalancerrule,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Networkid                string `json:"networkid,omitempty"`
-	Project                  string `json:"project,omitempty"`
-	Projectid                string `json:"projectid,omitempty"`
-	Sourceipaddress          string `json:"sourceipaddress,omitempty"`
-	Sourceipaddressnetworkid string `json:"sourceipaddressnetworkid,omitempty"`
-	Tags                     []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-}
-
-type DeleteLoadBalancerParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteLoadBalancerParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type UploadSslCertResponse struct {
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Certchain            string                     `json:"certchain,omitempty" xml:"certchain,omitempty"`
+	Certificate          string                     `json:"certificate,omitempty" xml:"certificate,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fingerprint          string                     `json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Loadbalancerrulelist []string                   `json:"loadbalancerrulelist,omitempty" xml:"loadbalancerrulelist,omitempty"`
+	Privatekey           string                     `json:"privatekey,omitempty" xml:"privatekey,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AssignToGlobalLoadBalancerRuleParams = cloudstackcommon.AssignToGlobalLoadBalancerRuleParams
+type AssignToGlobalLoadBalancerRuleResponse = cloudstackcommon.AssignToGlobalLoadBalancerRuleResponse
 
-// You should always use this function to get a new DeleteLoadBalancerParams instance,
+// You should always use this function to get a new AssignToGlobalLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams {
-	p := &DeleteLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+//   - loadbalancerrulelist
+func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams {
+	p := &AssignToGlobalLoadBalancerRuleParams{}
+	p.SetId(id)
+	p.SetLoadbalancerrulelist(loadbalancerrulelist)
 	return p
 }
 
-// Deletes a load balancer
-func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("deleteLoadBalancer", p.toURLValues())
+// Assign load balancer rule or list of load balancer rules to a global load balancer rules.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("assignToGlobalLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AssignToGlobalLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("assignToGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4594,83 +7683,150 @@ func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("assignToGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteLoadBalancerResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type UpdateLoadBalancerParams struct {
-	p map[string]interface{}
+type AssignToLoadBalancerRuleParams struct {
+	Id                *string       `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineids []string      `json:"virtualmachineids,omitempty" yaml:"virtualmachineids,omitempty"`
+	Vmidipmap         []VMIPMapping `json:"vmidipmap,omitempty" yaml:"vmidipmap,omitempty"`
 }
 
-func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AssignToLoadBalancerRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if v := p.Virtualmachineids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("virtualmachineids", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Vmidipmap; v != nil {
+		for i, vv := range v {
+			if vv.Vmid != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmid", i), vv.Vmid)
+			}
+			if vv.Vmip != "" {
+				u.Set(fmt.Sprintf("vmidipmap[%d].vmip", i), vv.Vmip)
+			}
+		}
 	}
 	return u
 }
 
-func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+// Returns the name of the API command of these params
+func (p *AssignToLoadBalancerRuleParams) Command() string {
+	return "assignToLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *AssignToLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AssignToLoadBalancerRuleParams) ResponseType() interface{} {
+	return &AssignToLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AssignToLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "assignToLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateLoadBalancerParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssignToLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Id
+}
+
+func (p *AssignToLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *AssignToLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *AssignToLoadBalancerRuleParams) SetVirtualmachineids(v []string) {
+	p.Virtualmachineids = v
 	return
 }
 
-func (p *UpdateLoadBalancerParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+func (p *AssignToLoadBalancerRuleParams) GetVirtualmachineids() []string {
+	return p.Virtualmachineids
+}
+
+func (p *AssignToLoadBalancerRuleParams) HasVirtualmachineids() bool {
+	return p.Virtualmachineids != nil
+}
+
+func (p *AssignToLoadBalancerRuleParams) ResetVirtualmachineids() {
+	p.Virtualmachineids = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *AssignToLoadBalancerRuleParams) SetVmidipmap(v []VMIPMapping) {
+	p.Vmidipmap = v
 	return
 }
 
-// You should always use this function to get a new UpdateLoadBalancerParams instance,
+func (p *AssignToLoadBalancerRuleParams) GetVmidipmap() []VMIPMapping {
+	return p.Vmidipmap
+}
+
+func (p *AssignToLoadBalancerRuleParams) HasVmidipmap() bool {
+	return p.Vmidipmap != nil
+}
+
+func (p *AssignToLoadBalancerRuleParams) ResetVmidipmap() {
+	p.Vmidipmap = nil
+}
+
+// You should always use this function to get a new AssignToLoadBalancerRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams {
-	p := &UpdateLoadBalancerParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams {
+	p := &AssignToLoadBalancerRuleParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates a Load Balancer
-func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
-	resp, err := s.cs.newRequest("updateLoadBalancer", p.toURLValues())
+// Assigns virtual machine or a list of virtual machines to a load balancer rule.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("assignToLoadBalancerRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AssignToLoadBalancerRuleResponse
+	if err := s.cs.unmarshal("assignToLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4686,54 +7842,16 @@ func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("assignToLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateLoadBalancerResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Algorithm            string `json:"algorithm,omitempty"`
-	Description          string `json:"description,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Loadbalancerinstance []struct {
-		Id        string `json:"id,omitempty"`
-		Ipaddress string `json:"ipaddress,omitempty"`
-		Name      string `json:"name,omitempty"`
-		State     string `json:"state,omitempty"`
-	} `json:"loadbalancerinstance,omitempty"`
-	Loadbalancerrule []struct {
-		Instanceport int    `json:"instanceport,omitempty"`
-		Sourceport   int    `json:"sourceport,omitempty"`
-		State        string `json:"state,omitempty"`
-	} `json:"loadbalancerrule,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Networkid                string `json:"networkid,omitempty"`
-	Project                  string `json:"project,omitempty"`
-	Projectid                string `json:"projectid,omitempty"`
-	Sourceipaddress          string `json:"sourceipaddress,omitempty"`
-	Sourceipaddressnetworkid string `json:"sourceipaddressnetworkid,omitempty"`
-	Tags                     []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
+type AssignToLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
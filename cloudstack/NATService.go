@@ -17,200 +17,245 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type EnableStaticNatParams struct {
-	p map[string]interface{}
+type NATService struct {
+	cs *CloudStackClient
+}
+
+func NewNATService(cs *CloudStackClient) *NATService {
+	return &NATService{cs: cs}
 }
 
-func (p *EnableStaticNatParams) toURLValues() url.Values {
+type CreateIpForwardingRuleParams struct {
+	Cidrlist     []string  `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport      *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Ipaddressid  *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Openfirewall *bool     `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Protocol     *Protocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport    *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateIpForwardingRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("endport", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Openfirewall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("openfirewall", vv)
 	}
-	if v, found := p.p["vmguestip"]; found {
-		u.Set("vmguestip", v.(string))
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
+	}
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("startport", vv)
 	}
 	return u
 }
 
-func (p *EnableStaticNatParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateIpForwardingRuleParams) Command() string {
+	return "createIpForwardingRule"
 }
 
-func (p *EnableStaticNatParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateIpForwardingRuleParams) IsAsync() bool {
+	return true
 }
 
-func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateIpForwardingRuleParams) ResponseType() interface{} {
+	return &CreateIpForwardingRuleResponse{}
 }
 
-func (p *EnableStaticNatParams) SetVmguestip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateIpForwardingRuleParams) Validate() error {
+	v := &validator{api: "createIpForwardingRule"}
+	v.required("ipaddressid", p.Ipaddressid != nil)
+	v.required("protocol", p.Protocol != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
 	}
-	p.p["vmguestip"] = v
-	return
+	v.required("startport", p.Startport != nil)
+	return v.err()
 }
 
-// You should always use this function to get a new EnableStaticNatParams instance,
-// as then you are sure you have configured all required params
-func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams {
-	p := &EnableStaticNatParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
+	return
 }
 
-// Enables static nat for given ip address
-func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("enableStaticNat", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateIpForwardingRuleParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
 
-	var r EnableStaticNatResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *CreateIpForwardingRuleParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
 }
 
-type EnableStaticNatResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+func (p *CreateIpForwardingRuleParams) ResetCidrlist() {
+	p.Cidrlist = nil
 }
 
-type CreateIpForwardingRuleParams struct {
-	p map[string]interface{}
+// Sets the 'endport' param: the end of the port range.
+func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
+	p.Endport = &v
+	return
 }
 
-func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
+func (p *CreateIpForwardingRuleParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
-	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
-	}
-	return u
+	return *p.Endport
 }
 
-func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
-	return
+func (p *CreateIpForwardingRuleParams) HasEndport() bool {
+	return p.Endport != nil
 }
 
-func (p *CreateIpForwardingRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
-	return
+func (p *CreateIpForwardingRuleParams) ResetEndport() {
+	p.Endport = nil
 }
 
+// Sets the 'ipaddressid' param: the ID of the public IP address. This param is required.
 func (p *CreateIpForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
+	p.Ipaddressid = &v
 	return
 }
 
-func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateIpForwardingRuleParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
 	}
-	p.p["openfirewall"] = v
+	return *p.Ipaddressid
+}
+
+func (p *CreateIpForwardingRuleParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *CreateIpForwardingRuleParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+func (p *CreateIpForwardingRuleParams) SetOpenfirewall(v bool) {
+	p.Openfirewall = &v
 	return
 }
 
-func (p *CreateIpForwardingRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateIpForwardingRuleParams) GetOpenfirewall() bool {
+	if p.Openfirewall == nil {
+		return false
 	}
-	p.p["protocol"] = v
+	return *p.Openfirewall
+}
+
+func (p *CreateIpForwardingRuleParams) HasOpenfirewall() bool {
+	return p.Openfirewall != nil
+}
+
+func (p *CreateIpForwardingRuleParams) ResetOpenfirewall() {
+	p.Openfirewall = nil
+}
+
+// This param is required.
+func (p *CreateIpForwardingRuleParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
 	return
 }
 
-func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateIpForwardingRuleParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	p.p["startport"] = v
+	return *p.Protocol
+}
+
+func (p *CreateIpForwardingRuleParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *CreateIpForwardingRuleParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+// Sets the 'startport' param: the start of the port range. This param is required.
+func (p *CreateIpForwardingRuleParams) SetStartport(v int) {
+	p.Startport = &v
 	return
 }
 
+func (p *CreateIpForwardingRuleParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
+	}
+	return *p.Startport
+}
+
+func (p *CreateIpForwardingRuleParams) HasStartport() bool {
+	return p.Startport != nil
+}
+
+func (p *CreateIpForwardingRuleParams) ResetStartport() {
+	p.Startport = nil
+}
+
 // You should always use this function to get a new CreateIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams {
+//
+// The required params are:
+//
+//   - ipaddressid: the ID of the public IP address
+//   - protocol
+//   - startport: the start of the port range
+func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol Protocol, startport int) *CreateIpForwardingRuleParams {
 	p := &CreateIpForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["protocol"] = protocol
-	p.p["startport"] = startport
+	p.SetIpaddressid(ipaddressid)
+	p.SetProtocol(protocol)
+	p.SetStartport(startport)
 	return p
 }
 
 // Creates an ip forwarding rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIpForwardingRules, GetIpForwardingRuleByID.
 func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createIpForwardingRule", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createIpForwardingRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateIpForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createIpForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -231,7 +276,7 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createIpForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -239,78 +284,60 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 }
 
 type CreateIpForwardingRuleResponse struct {
-	JobID          string `json:"jobid,omitempty"`
-	Cidrlist       string `json:"cidrlist,omitempty"`
-	Fordisplay     bool   `json:"fordisplay,omitempty"`
-	Id             string `json:"id,omitempty"`
-	Ipaddress      string `json:"ipaddress,omitempty"`
-	Ipaddressid    string `json:"ipaddressid,omitempty"`
-	Networkid      string `json:"networkid,omitempty"`
-	Privateendport string `json:"privateendport,omitempty"`
-	Privateport    string `json:"privateport,omitempty"`
-	Protocol       string `json:"protocol,omitempty"`
-	Publicendport  string `json:"publicendport,omitempty"`
-	Publicport     string `json:"publicport,omitempty"`
-	State          string `json:"state,omitempty"`
-	Tags           []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vmguestip                 string `json:"vmguestip,omitempty"`
-}
-
-type DeleteIpForwardingRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteIpForwardingRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Cidrlist                  string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Fordisplay                Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid               string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateendport            string                     `json:"privateendport,omitempty" xml:"privateendport,omitempty"`
+	Privateport               string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Protocol                  Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicendport             string                     `json:"publicendport,omitempty" xml:"publicendport,omitempty"`
+	Publicport                string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vmguestip                 string                     `json:"vmguestip,omitempty" xml:"vmguestip,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteIpForwardingRuleParams = cloudstackcommon.DeleteIpForwardingRuleParams
+type DeleteIpForwardingRuleResponse = cloudstackcommon.DeleteIpForwardingRuleResponse
 
 // You should always use this function to get a new DeleteIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams {
 	p := &DeleteIpForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes an ip forwarding rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListIpForwardingRules, GetIpForwardingRuleByID.
 func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteIpForwardingRule", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteIpForwardingRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteIpForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteIpForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -326,170 +353,340 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteIpForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteIpForwardingRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type ListIpForwardingRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid      *string `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListIpForwardingRulesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListIpForwardingRulesParams) Command() string {
+	return "listIpForwardingRules"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListIpForwardingRulesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListIpForwardingRulesParams) ResponseType() interface{} {
+	return &ListIpForwardingRulesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListIpForwardingRulesParams) Validate() error {
+	v := &validator{api: "listIpForwardingRules"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ListIpForwardingRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *ListIpForwardingRulesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListIpForwardingRulesParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Domainid
+}
+
+func (p *ListIpForwardingRulesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListIpForwardingRulesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["ipaddressid"] = v
+	return *p.Id
+}
+
+func (p *ListIpForwardingRulesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address.
+func (p *ListIpForwardingRulesParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
+	return *p.Ipaddressid
+}
+
+func (p *ListIpForwardingRulesParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListIpForwardingRulesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListIpForwardingRulesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListIpForwardingRulesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListIpForwardingRulesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListIpForwardingRulesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["page"] = v
+	return *p.Listall
+}
+
+func (p *ListIpForwardingRulesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListIpForwardingRulesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListIpForwardingRulesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListIpForwardingRulesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListIpForwardingRulesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListIpForwardingRulesParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListIpForwardingRulesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Projectid
+}
+
+func (p *ListIpForwardingRulesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListIpForwardingRulesParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *ListIpForwardingRulesParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *ListIpForwardingRulesParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListIpForwardingRulesParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new ListIpForwardingRulesParams instance,
 // as then you are sure you have configured all required params
 func (s *NATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesParams {
 	p := &ListIpForwardingRulesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NATService) GetIpForwardingRuleByID(id string) (*IpForwardingRule, int, error) {
+func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
 	p := &ListIpForwardingRulesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListIpForwardingRules(p)
 	if err != nil {
@@ -501,6 +698,20 @@ func (s *NATService) GetIpForwardingRuleByID(id string) (*IpForwardingRule, int,
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListIpForwardingRules(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -512,96 +723,105 @@ func (s *NATService) GetIpForwardingRuleByID(id string) (*IpForwardingRule, int,
 }
 
 // List the ip forwarding rules
+//
+// See also GetIpForwardingRuleByID.
 func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpForwardingRules", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listIpForwardingRules", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIpForwardingRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listIpForwardingRules", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListIpForwardingRulesResponse struct {
-	Count             int                 `json:"count"`
-	IpForwardingRules []*IpForwardingRule `json:"ipforwardingrule"`
-}
-
-type IpForwardingRule struct {
-	Cidrlist       string `json:"cidrlist,omitempty"`
-	Fordisplay     bool   `json:"fordisplay,omitempty"`
-	Id             string `json:"id,omitempty"`
-	Ipaddress      string `json:"ipaddress,omitempty"`
-	Ipaddressid    string `json:"ipaddressid,omitempty"`
-	Networkid      string `json:"networkid,omitempty"`
-	Privateendport string `json:"privateendport,omitempty"`
-	Privateport    string `json:"privateport,omitempty"`
-	Protocol       string `json:"protocol,omitempty"`
-	Publicendport  string `json:"publicendport,omitempty"`
-	Publicport     string `json:"publicport,omitempty"`
-	State          string `json:"state,omitempty"`
-	Tags           []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vmguestip                 string `json:"vmguestip,omitempty"`
-}
-
-type DisableStaticNatParams struct {
-	p map[string]interface{}
-}
-
-func (p *DisableStaticNatParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+// Lists the objects like ListIpForwardingRules, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NATService) StreamIpForwardingRules(ctx context.Context, p *ListIpForwardingRulesParams, fn func(*IpForwardingRule) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
+
+	return s.cs.streamList(ctx, "listIpForwardingRules", p.URLValues(), "ipforwardingrule", func(b json.RawMessage) error {
+		var v IpForwardingRule
+		if err := s.cs.unmarshalElement("listIpForwardingRules", "ipforwardingrule", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *DisableStaticNatParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
-	return
+type ListIpForwardingRulesResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	IpForwardingRules []*IpForwardingRule        `json:"ipforwardingrule" xml:"ipforwardingrule"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
+type IpForwardingRule struct {
+	Cidrlist                  string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Fordisplay                Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid               string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateendport            string                     `json:"privateendport,omitempty" xml:"privateendport,omitempty"`
+	Privateport               string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Protocol                  Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicendport             string                     `json:"publicendport,omitempty" xml:"publicendport,omitempty"`
+	Publicport                string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vmguestip                 string                     `json:"vmguestip,omitempty" xml:"vmguestip,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DisableStaticNatParams = cloudstackcommon.DisableStaticNatParams
+type DisableStaticNatResponse = cloudstackcommon.DisableStaticNatResponse
+
 // You should always use this function to get a new DisableStaticNatParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ipaddressid: the ID of the public IP address
 func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams {
 	p := &DisableStaticNatParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
+	p.SetIpaddressid(ipaddressid)
 	return p
 }
 
 // Disables static rule for given ip address
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
-	resp, err := s.cs.newRequest("disableStaticNat", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("disableStaticNat", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableStaticNatResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("disableStaticNat", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -617,15 +837,46 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("disableStaticNat", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DisableStaticNatResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type EnableStaticNatParams = cloudstackcommon.EnableStaticNatParams
+type EnableStaticNatResponse = cloudstackcommon.EnableStaticNatResponse
+
+// You should always use this function to get a new EnableStaticNatParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ipaddressid: the ID of the public IP address
+//   - virtualmachineid: the ID of the virtual machine
+func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams {
+	p := &EnableStaticNatParams{}
+	p.SetIpaddressid(ipaddressid)
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Enables static nat for given ip address
+func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("enableStaticNat", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r EnableStaticNatResponse
+	if err := s.cs.unmarshal("enableStaticNat", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
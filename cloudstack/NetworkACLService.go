@@ -17,407 +17,392 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateNetworkACLParams struct {
-	p map[string]interface{}
+type NetworkACLService struct {
+	cs *CloudStackClient
+}
+
+func NewNetworkACLService(cs *CloudStackClient) *NetworkACLService {
+	return &NetworkACLService{cs: cs}
 }
 
-func (p *CreateNetworkACLParams) toURLValues() url.Values {
+type CreateNetworkACLParams struct {
+	Aclid       *string         `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Action      *string         `json:"action,omitempty" yaml:"action,omitempty"`
+	Cidrlist    []string        `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport     *int            `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay  *bool           `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode    *int            `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype    *int            `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Networkid   *string         `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Number      *int            `json:"number,omitempty" yaml:"number,omitempty"`
+	Protocol    *Protocol       `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport   *int            `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Traffictype *ACLTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateNetworkACLParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if v := p.Aclid; v != nil {
+		u.Set("aclid", *v)
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if v := p.Action; v != nil {
+		u.Set("action", *v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("cidrlist", vv)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("endport", vv)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Icmpcode; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("icmpcode", vv)
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Icmptype; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("icmptype", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["number"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Number; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("number", vv)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("startport", vv)
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
 	}
 	return u
 }
 
-func (p *CreateNetworkACLParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *CreateNetworkACLParams) Command() string {
+	return "createNetworkACL"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateNetworkACLParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateNetworkACLParams) ResponseType() interface{} {
+	return &CreateNetworkACLResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateNetworkACLParams) Validate() error {
+	v := &validator{api: "createNetworkACL"}
+	v.required("protocol", p.Protocol != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), true)
+	}
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
 	}
-	p.p["aclid"] = v
+	return v.err()
+}
+
+func (p *CreateNetworkACLParams) SetAclid(v string) {
+	p.Aclid = &v
 	return
 }
 
-func (p *CreateNetworkACLParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetAclid() string {
+	if p.Aclid == nil {
+		return ""
 	}
-	p.p["action"] = v
+	return *p.Aclid
+}
+
+func (p *CreateNetworkACLParams) HasAclid() bool {
+	return p.Aclid != nil
+}
+
+func (p *CreateNetworkACLParams) ResetAclid() {
+	p.Aclid = nil
+}
+
+func (p *CreateNetworkACLParams) SetAction(v string) {
+	p.Action = &v
 	return
 }
 
-func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetAction() string {
+	if p.Action == nil {
+		return ""
 	}
-	p.p["cidrlist"] = v
+	return *p.Action
+}
+
+func (p *CreateNetworkACLParams) HasAction() bool {
+	return p.Action != nil
+}
+
+func (p *CreateNetworkACLParams) ResetAction() {
+	p.Action = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *CreateNetworkACLParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
 	return
 }
 
+func (p *CreateNetworkACLParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *CreateNetworkACLParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *CreateNetworkACLParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'endport' param: the end of the port range.
 func (p *CreateNetworkACLParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
+	p.Endport = &v
 	return
 }
 
-func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
 	}
-	p.p["fordisplay"] = v
-	return
+	return *p.Endport
 }
 
-func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
-	return
+func (p *CreateNetworkACLParams) HasEndport() bool {
+	return p.Endport != nil
 }
 
-func (p *CreateNetworkACLParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
-	return
+func (p *CreateNetworkACLParams) ResetEndport() {
+	p.Endport = nil
 }
 
-func (p *CreateNetworkACLParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateNetworkACLParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *CreateNetworkACLParams) SetNumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["number"] = v
-	return
+	return *p.Fordisplay
 }
 
-func (p *CreateNetworkACLParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
-	return
+func (p *CreateNetworkACLParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-func (p *CreateNetworkACLParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+func (p *CreateNetworkACLParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Sets the 'icmpcode' param: the ICMP code, for the icmp protocol.
+func (p *CreateNetworkACLParams) SetIcmpcode(v int) {
+	p.Icmpcode = &v
 	return
 }
 
-func (p *CreateNetworkACLParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetIcmpcode() int {
+	if p.Icmpcode == nil {
+		return 0
 	}
-	p.p["traffictype"] = v
-	return
+	return *p.Icmpcode
 }
 
-// You should always use this function to get a new CreateNetworkACLParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
-	p := &CreateNetworkACLParams{}
-	p.p = make(map[string]interface{})
-	p.p["protocol"] = protocol
-	return p
+func (p *CreateNetworkACLParams) HasIcmpcode() bool {
+	return p.Icmpcode != nil
 }
 
-// Creates a ACL rule in the given network (the network has to belong to VPC)
-func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACL", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateNetworkACLParams) ResetIcmpcode() {
+	p.Icmpcode = nil
+}
 
-	var r CreateNetworkACLResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'icmptype' param: the ICMP type, for the icmp protocol.
+func (p *CreateNetworkACLParams) SetIcmptype(v int) {
+	p.Icmptype = &v
+	return
+}
+
+func (p *CreateNetworkACLParams) GetIcmptype() int {
+	if p.Icmptype == nil {
+		return 0
 	}
+	return *p.Icmptype
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *CreateNetworkACLParams) HasIcmptype() bool {
+	return p.Icmptype != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *CreateNetworkACLParams) ResetIcmptype() {
+	p.Icmptype = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Sets the 'networkid' param: the ID of the network.
+func (p *CreateNetworkACLParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
 }
 
-type CreateNetworkACLResponse struct {
-	JobID      string `json:"jobid,omitempty"`
-	Aclid      string `json:"aclid,omitempty"`
-	Action     string `json:"action,omitempty"`
-	Cidrlist   string `json:"cidrlist,omitempty"`
-	Endport    string `json:"endport,omitempty"`
-	Fordisplay bool   `json:"fordisplay,omitempty"`
-	Icmpcode   int    `json:"icmpcode,omitempty"`
-	Icmptype   int    `json:"icmptype,omitempty"`
-	Id         string `json:"id,omitempty"`
-	Number     int    `json:"number,omitempty"`
-	Protocol   string `json:"protocol,omitempty"`
-	Startport  string `json:"startport,omitempty"`
-	State      string `json:"state,omitempty"`
-	Tags       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype string `json:"traffictype,omitempty"`
+func (p *CreateNetworkACLParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
 }
 
-type UpdateNetworkACLItemParams struct {
-	p map[string]interface{}
+func (p *CreateNetworkACLParams) HasNetworkid() bool {
+	return p.Networkid != nil
 }
 
-func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
-	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
-	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["number"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("number", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
-	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
-	}
-	return u
+func (p *CreateNetworkACLParams) ResetNetworkid() {
+	p.Networkid = nil
 }
 
-func (p *UpdateNetworkACLItemParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["action"] = v
+func (p *CreateNetworkACLParams) SetNumber(v int) {
+	p.Number = &v
 	return
 }
 
-func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetNumber() int {
+	if p.Number == nil {
+		return 0
 	}
-	p.p["cidrlist"] = v
-	return
+	return *p.Number
 }
 
-func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
-	return
+func (p *CreateNetworkACLParams) HasNumber() bool {
+	return p.Number != nil
 }
 
-func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
-	return
+func (p *CreateNetworkACLParams) ResetNumber() {
+	p.Number = nil
 }
 
-func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+// This param is required.
+func (p *CreateNetworkACLParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
 	return
 }
 
-func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	p.p["icmpcode"] = v
-	return
+	return *p.Protocol
 }
 
-func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmptype"] = v
-	return
+func (p *CreateNetworkACLParams) HasProtocol() bool {
+	return p.Protocol != nil
 }
 
-func (p *UpdateNetworkACLItemParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CreateNetworkACLParams) ResetProtocol() {
+	p.Protocol = nil
 }
 
-func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["number"] = v
+// Sets the 'startport' param: the start of the port range.
+func (p *CreateNetworkACLParams) SetStartport(v int) {
+	p.Startport = &v
 	return
 }
 
-func (p *UpdateNetworkACLItemParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
 	}
-	p.p["protocol"] = v
-	return
+	return *p.Startport
 }
 
-func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startport"] = v
+func (p *CreateNetworkACLParams) HasStartport() bool {
+	return p.Startport != nil
+}
+
+func (p *CreateNetworkACLParams) ResetStartport() {
+	p.Startport = nil
+}
+
+func (p *CreateNetworkACLParams) SetTraffictype(v ACLTrafficType) {
+	p.Traffictype = &v
 	return
 }
 
-func (p *UpdateNetworkACLItemParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkACLParams) GetTraffictype() ACLTrafficType {
+	if p.Traffictype == nil {
+		return ""
 	}
-	p.p["traffictype"] = v
-	return
+	return *p.Traffictype
 }
 
-// You should always use this function to get a new UpdateNetworkACLItemParams instance,
+func (p *CreateNetworkACLParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *CreateNetworkACLParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+// You should always use this function to get a new CreateNetworkACLParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams {
-	p := &UpdateNetworkACLItemParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - protocol
+func (s *NetworkACLService) NewCreateNetworkACLParams(protocol Protocol) *CreateNetworkACLParams {
+	p := &CreateNetworkACLParams{}
+	p.SetProtocol(protocol)
 	return p
 }
 
-// Updates ACL Item with specified Id
-func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLItem", p.toURLValues())
+// Creates a ACL rule in the given network (the network has to belong to VPC)
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkACLs, GetNetworkACLByID, GetNetworkACLsByTags.
+func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createNetworkACL", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateNetworkACLItemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateNetworkACLResponse
+	if err := s.cs.unmarshal("createNetworkACL", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -438,83 +423,65 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createNetworkACL", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateNetworkACLItemResponse struct {
-	JobID      string `json:"jobid,omitempty"`
-	Aclid      string `json:"aclid,omitempty"`
-	Action     string `json:"action,omitempty"`
-	Cidrlist   string `json:"cidrlist,omitempty"`
-	Endport    string `json:"endport,omitempty"`
-	Fordisplay bool   `json:"fordisplay,omitempty"`
-	Icmpcode   int    `json:"icmpcode,omitempty"`
-	Icmptype   int    `json:"icmptype,omitempty"`
-	Id         string `json:"id,omitempty"`
-	Number     int    `json:"number,omitempty"`
-	Protocol   string `json:"protocol,omitempty"`
-	Startport  string `json:"startport,omitempty"`
-	State      string `json:"state,omitempty"`
-	Tags       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype string `json:"traffictype,omitempty"`
-}
-
-type DeleteNetworkACLParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteNetworkACLParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteNetworkACLParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type CreateNetworkACLResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Aclid       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Action      string                     `json:"action,omitempty" xml:"action,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Number      int                        `json:"number,omitempty" xml:"number,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype ACLTrafficType             `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetworkACLParams = cloudstackcommon.DeleteNetworkACLParams
+type DeleteNetworkACLResponse = cloudstackcommon.DeleteNetworkACLResponse
 
 // You should always use this function to get a new DeleteNetworkACLParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams {
 	p := &DeleteNetworkACLParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a Network ACL
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkACLs, GetNetworkACLByID, GetNetworkACLsByTags.
 func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACL", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNetworkACL", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkACLResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteNetworkACL", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -530,231 +497,470 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteNetworkACL", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteNetworkACLResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type ListNetworkACLsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkACLsParams) toURLValues() url.Values {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Aclid       *string           `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Action      *string           `json:"action,omitempty" yaml:"action,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Protocol    *Protocol         `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype *ACLTrafficType   `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworkACLsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Aclid; v != nil {
+		u.Set("aclid", *v)
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if v := p.Action; v != nil {
+		u.Set("action", *v)
 	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
 	}
 	return u
 }
 
-func (p *ListNetworkACLsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListNetworkACLsParams) Command() string {
+	return "listNetworkACLs"
 }
 
-func (p *ListNetworkACLsParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["aclid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListNetworkACLsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListNetworkACLsParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["action"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworkACLsParams) ResponseType() interface{} {
+	return &ListNetworkACLsResponse{}
 }
 
-func (p *ListNetworkACLsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworkACLsParams) Validate() error {
+	v := &validator{api: "listNetworkACLs"}
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
 	}
-	p.p["domainid"] = v
-	return
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
+	}
+	return v.err()
 }
 
-func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListNetworkACLsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListNetworkACLsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+func (p *ListNetworkACLsParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListNetworkACLsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListNetworkACLsParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListNetworkACLsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+func (p *ListNetworkACLsParams) SetAclid(v string) {
+	p.Aclid = &v
 	return
 }
 
-func (p *ListNetworkACLsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLsParams) GetAclid() string {
+	if p.Aclid == nil {
+		return ""
 	}
-	p.p["networkid"] = v
-	return
+	return *p.Aclid
 }
 
-func (p *ListNetworkACLsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListNetworkACLsParams) HasAclid() bool {
+	return p.Aclid != nil
 }
 
-func (p *ListNetworkACLsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListNetworkACLsParams) ResetAclid() {
+	p.Aclid = nil
 }
 
-func (p *ListNetworkACLsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+func (p *ListNetworkACLsParams) SetAction(v string) {
+	p.Action = &v
 	return
 }
 
-func (p *ListNetworkACLsParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLsParams) GetAction() string {
+	if p.Action == nil {
+		return ""
 	}
-	p.p["protocol"] = v
-	return
+	return *p.Action
 }
 
-func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+func (p *ListNetworkACLsParams) HasAction() bool {
+	return p.Action != nil
+}
+
+func (p *ListNetworkACLsParams) ResetAction() {
+	p.Action = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListNetworkACLsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListNetworkACLsParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["traffictype"] = v
-	return
+	return *p.Domainid
 }
 
-// You should always use this function to get a new ListNetworkACLsParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
-	p := &ListNetworkACLsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListNetworkACLsParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkACLService) GetNetworkACLByID(id string) (*NetworkACL, int, error) {
-	p := &ListNetworkACLsParams{}
-	p.p = make(map[string]interface{})
+func (p *ListNetworkACLsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListNetworkACLsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *ListNetworkACLsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListNetworkACLsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListNetworkACLsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListNetworkACLsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListNetworkACLsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListNetworkACLsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListNetworkACLsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListNetworkACLsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworkACLsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListNetworkACLsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworkACLsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListNetworkACLsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListNetworkACLsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListNetworkACLsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListNetworkACLsParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListNetworkACLsParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListNetworkACLsParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworkACLsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNetworkACLsParams) HasPage() bool {
+	return p.Page != nil
+}
 
-	p.p["id"] = id
+func (p *ListNetworkACLsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworkACLsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetworkACLsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworkACLsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListNetworkACLsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListNetworkACLsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListNetworkACLsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListNetworkACLsParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+func (p *ListNetworkACLsParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *ListNetworkACLsParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListNetworkACLsParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListNetworkACLsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListNetworkACLsParams) ResetTags() {
+	p.Tags = nil
+}
+
+func (p *ListNetworkACLsParams) SetTraffictype(v ACLTrafficType) {
+	p.Traffictype = &v
+	return
+}
+
+func (p *ListNetworkACLsParams) GetTraffictype() ACLTrafficType {
+	if p.Traffictype == nil {
+		return ""
+	}
+	return *p.Traffictype
+}
+
+func (p *ListNetworkACLsParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *ListNetworkACLsParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+// You should always use this function to get a new ListNetworkACLsParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
+	p := &ListNetworkACLsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
+	p := &ListNetworkACLsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListNetworkACLs(p)
 	if err != nil {
@@ -766,6 +972,20 @@ func (s *NetworkACLService) GetNetworkACLByID(id string) (*NetworkACL, int, erro
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworkACLs(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -776,129 +996,469 @@ func (s *NetworkACLService) GetNetworkACLByID(id string) (*NetworkACL, int, erro
 	return nil, l.Count, fmt.Errorf("There is more then one result for NetworkACL UUID: %s!", id)
 }
 
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *NetworkACLService) GetNetworkACLsByTags(tags map[string]string, opts ...OptionFunc) ([]*NetworkACL, error) {
+	p := &ListNetworkACLsParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListNetworkACLs(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworkACLs(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.NetworkACLs, nil
+}
+
 // Lists all network ACL items
+//
+// See also GetNetworkACLByID, GetNetworkACLsByTags.
 func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLs", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNetworkACLs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkACLsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNetworkACLs", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListNetworkACLsResponse struct {
-	Count       int           `json:"count"`
-	NetworkACLs []*NetworkACL `json:"networkacl"`
+// Lists the objects like ListNetworkACLs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkACLService) StreamNetworkACLs(ctx context.Context, p *ListNetworkACLsParams, fn func(*NetworkACL) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNetworkACLs", p.URLValues(), "networkacl", func(b json.RawMessage) error {
+		var v NetworkACL
+		if err := s.cs.unmarshalElement("listNetworkACLs", "networkacl", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type NetworkACL struct {
-	Aclid      string `json:"aclid,omitempty"`
-	Action     string `json:"action,omitempty"`
-	Cidrlist   string `json:"cidrlist,omitempty"`
-	Endport    string `json:"endport,omitempty"`
-	Fordisplay bool   `json:"fordisplay,omitempty"`
-	Icmpcode   int    `json:"icmpcode,omitempty"`
-	Icmptype   int    `json:"icmptype,omitempty"`
-	Id         string `json:"id,omitempty"`
-	Number     int    `json:"number,omitempty"`
-	Protocol   string `json:"protocol,omitempty"`
-	Startport  string `json:"startport,omitempty"`
-	State      string `json:"state,omitempty"`
-	Tags       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype string `json:"traffictype,omitempty"`
+type ListNetworkACLsResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	NetworkACLs []*NetworkACL              `json:"networkacl" xml:"networkacl"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type CreateNetworkACLListParams struct {
-	p map[string]interface{}
+type NetworkACL struct {
+	Aclid       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Action      string                     `json:"action,omitempty" xml:"action,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Number      int                        `json:"number,omitempty" xml:"number,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype ACLTrafficType             `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *CreateNetworkACLListParams) toURLValues() url.Values {
+type UpdateNetworkACLItemParams struct {
+	Action      *string         `json:"action,omitempty" yaml:"action,omitempty"`
+	Cidrlist    []string        `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Customid    *string         `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Endport     *int            `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Fordisplay  *bool           `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Icmpcode    *int            `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype    *int            `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Id          *string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Number      *int            `json:"number,omitempty" yaml:"number,omitempty"`
+	Protocol    *Protocol       `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport   *int            `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Traffictype *ACLTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateNetworkACLItemParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Action; v != nil {
+		u.Set("action", *v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
+	}
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
+	}
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("endport", vv)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Icmpcode; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmpcode", vv)
+	}
+	if v := p.Icmptype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmptype", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Number; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("number", vv)
+	}
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
+	}
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("startport", vv)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
 	}
 	return u
 }
 
-func (p *CreateNetworkACLListParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *UpdateNetworkACLItemParams) Command() string {
+	return "updateNetworkACLItem"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateNetworkACLItemParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateNetworkACLItemParams) ResponseType() interface{} {
+	return &UpdateNetworkACLItemResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateNetworkACLItemParams) Validate() error {
+	v := &validator{api: "updateNetworkACLItem"}
+	v.required("id", p.Id != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), true)
+	}
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
 	}
-	p.p["description"] = v
+	return v.err()
+}
+
+func (p *UpdateNetworkACLItemParams) SetAction(v string) {
+	p.Action = &v
 	return
 }
 
-func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkACLItemParams) GetAction() string {
+	if p.Action == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Action
+}
+
+func (p *UpdateNetworkACLItemParams) HasAction() bool {
+	return p.Action != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetAction() {
+	p.Action = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *UpdateNetworkACLItemParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
 	return
 }
 
-func (p *CreateNetworkACLListParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkACLItemParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *UpdateNetworkACLItemParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only. Only supported by CloudStack 4.4.
+func (p *UpdateNetworkACLItemParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Customid
+}
+
+func (p *UpdateNetworkACLItemParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'endport' param: the end of the port range.
+func (p *UpdateNetworkACLItemParams) SetEndport(v int) {
+	p.Endport = &v
 	return
 }
 
-func (p *CreateNetworkACLListParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkACLItemParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
 	}
-	p.p["vpcid"] = v
+	return *p.Endport
+}
+
+func (p *UpdateNetworkACLItemParams) HasEndport() bool {
+	return p.Endport != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetEndport() {
+	p.Endport = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *UpdateNetworkACLItemParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-// You should always use this function to get a new CreateNetworkACLListParams instance,
+func (p *UpdateNetworkACLItemParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateNetworkACLItemParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Sets the 'icmpcode' param: the ICMP code, for the icmp protocol.
+func (p *UpdateNetworkACLItemParams) SetIcmpcode(v int) {
+	p.Icmpcode = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetIcmpcode() int {
+	if p.Icmpcode == nil {
+		return 0
+	}
+	return *p.Icmpcode
+}
+
+func (p *UpdateNetworkACLItemParams) HasIcmpcode() bool {
+	return p.Icmpcode != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetIcmpcode() {
+	p.Icmpcode = nil
+}
+
+// Sets the 'icmptype' param: the ICMP type, for the icmp protocol.
+func (p *UpdateNetworkACLItemParams) SetIcmptype(v int) {
+	p.Icmptype = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetIcmptype() int {
+	if p.Icmptype == nil {
+		return 0
+	}
+	return *p.Icmptype
+}
+
+func (p *UpdateNetworkACLItemParams) HasIcmptype() bool {
+	return p.Icmptype != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetIcmptype() {
+	p.Icmptype = nil
+}
+
+// This param is required.
+func (p *UpdateNetworkACLItemParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateNetworkACLItemParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateNetworkACLItemParams) SetNumber(v int) {
+	p.Number = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetNumber() int {
+	if p.Number == nil {
+		return 0
+	}
+	return *p.Number
+}
+
+func (p *UpdateNetworkACLItemParams) HasNumber() bool {
+	return p.Number != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetNumber() {
+	p.Number = nil
+}
+
+func (p *UpdateNetworkACLItemParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+func (p *UpdateNetworkACLItemParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+// Sets the 'startport' param: the start of the port range.
+func (p *UpdateNetworkACLItemParams) SetStartport(v int) {
+	p.Startport = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
+	}
+	return *p.Startport
+}
+
+func (p *UpdateNetworkACLItemParams) HasStartport() bool {
+	return p.Startport != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetStartport() {
+	p.Startport = nil
+}
+
+func (p *UpdateNetworkACLItemParams) SetTraffictype(v ACLTrafficType) {
+	p.Traffictype = &v
+	return
+}
+
+func (p *UpdateNetworkACLItemParams) GetTraffictype() ACLTrafficType {
+	if p.Traffictype == nil {
+		return ""
+	}
+	return *p.Traffictype
+}
+
+func (p *UpdateNetworkACLItemParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *UpdateNetworkACLItemParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+// You should always use this function to get a new UpdateNetworkACLItemParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams {
-	p := &CreateNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["vpcid"] = vpcid
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams {
+	p := &UpdateNetworkACLItemParams{}
+	p.SetId(id)
 	return p
 }
 
-// Creates a Network ACL for the given VPC
-func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkACLList", p.toURLValues())
+// Updates ACL Item with specified Id
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateNetworkACLItem", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateNetworkACLItemResponse
+	if err := s.cs.unmarshal("updateNetworkACLItem", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -919,63 +1479,197 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateNetworkACLItem", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateNetworkACLListResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Description string `json:"description,omitempty"`
-	Fordisplay  bool   `json:"fordisplay,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Vpcid       string `json:"vpcid,omitempty"`
+type UpdateNetworkACLItemResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Aclid       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Action      string                     `json:"action,omitempty" xml:"action,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Number      int                        `json:"number,omitempty" xml:"number,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype ACLTrafficType             `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type DeleteNetworkACLListParams struct {
-	p map[string]interface{}
+type CreateNetworkACLListParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
 }
 
-func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateNetworkACLListParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
 	return u
 }
 
-func (p *DeleteNetworkACLListParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *CreateNetworkACLListParams) Command() string {
+	return "createNetworkACLList"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateNetworkACLListParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateNetworkACLListParams) ResponseType() interface{} {
+	return &CreateNetworkACLListResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateNetworkACLListParams) Validate() error {
+	v := &validator{api: "createNetworkACLList"}
+	v.required("name", p.Name != nil)
+	v.required("vpcid", p.Vpcid != nil)
+	return v.err()
+}
+
+func (p *CreateNetworkACLListParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateNetworkACLListParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Description
+}
+
+func (p *CreateNetworkACLListParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateNetworkACLListParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateNetworkACLListParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-// You should always use this function to get a new DeleteNetworkACLListParams instance,
+func (p *CreateNetworkACLListParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *CreateNetworkACLListParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateNetworkACLListParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *CreateNetworkACLListParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateNetworkACLListParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateNetworkACLListParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateNetworkACLListParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC. This param is required.
+func (p *CreateNetworkACLListParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *CreateNetworkACLListParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *CreateNetworkACLListParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *CreateNetworkACLListParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// You should always use this function to get a new CreateNetworkACLListParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams {
-	p := &DeleteNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - name
+//   - vpcid: the ID of the VPC
+func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams {
+	p := &CreateNetworkACLListParams{}
+	p.SetName(name)
+	p.SetVpcid(vpcid)
 	return p
 }
 
-// Deletes a Network ACL
-func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkACLList", p.toURLValues())
+// Creates a Network ACL for the given VPC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkACLLists, GetNetworkACLListID, GetNetworkACLListByName, GetNetworkACLListByID.
+func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createNetworkACLList", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateNetworkACLListResponse
+	if err := s.cs.unmarshal("createNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -991,82 +1685,61 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
 			return nil, err
 		}
-	}
-	return &r, nil
-}
-
-type DeleteNetworkACLListResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
 
-type ReplaceNetworkACLListParams struct {
-	p map[string]interface{}
-}
-
-func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
-	}
-	if v, found := p.p["gatewayid"]; found {
-		u.Set("gatewayid", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	return u
-}
-
-func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["aclid"] = v
-	return
-}
-
-func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("createNetworkACLList", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["gatewayid"] = v
-	return
+	return &r, nil
 }
 
-func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+type CreateNetworkACLListResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Vpcid       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-// You should always use this function to get a new ReplaceNetworkACLListParams instance,
+type DeleteNetworkACLListParams = cloudstackcommon.DeleteNetworkACLListParams
+type DeleteNetworkACLListResponse = cloudstackcommon.DeleteNetworkACLListResponse
+
+// You should always use this function to get a new DeleteNetworkACLListParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams {
-	p := &ReplaceNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["aclid"] = aclid
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams {
+	p := &DeleteNetworkACLListParams{}
+	p.SetId(id)
 	return p
 }
 
-// Replaces ACL associated with a Network or private gateway
-func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("replaceNetworkACLList", p.toURLValues())
+// Deletes a Network ACL
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkACLLists, GetNetworkACLListID, GetNetworkACLListByName, GetNetworkACLListByID.
+func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNetworkACLList", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReplaceNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteNetworkACLListResponse
+	if err := s.cs.unmarshal("deleteNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1082,199 +1755,408 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteNetworkACLList", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ReplaceNetworkACLListResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type ListNetworkACLListsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkACLListsParams) toURLValues() url.Values {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid   *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworkACLListsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListNetworkACLListsParams) Command() string {
+	return "listNetworkACLLists"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNetworkACLListsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworkACLListsParams) ResponseType() interface{} {
+	return &ListNetworkACLListsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworkACLListsParams) Validate() error {
+	v := &validator{api: "listNetworkACLLists"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ListNetworkACLListsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *ListNetworkACLListsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListNetworkACLListsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *ListNetworkACLListsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListNetworkACLListsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *ListNetworkACLListsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListNetworkACLListsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
+	return *p.Id
+}
+
+func (p *ListNetworkACLListsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListNetworkACLListsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListNetworkACLListsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworkACLListsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListNetworkACLListsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListNetworkACLListsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["name"] = v
+	return *p.Listall
+}
+
+func (p *ListNetworkACLListsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListNetworkACLListsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["networkid"] = v
+	return *p.Name
+}
+
+func (p *ListNetworkACLListsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListNetworkACLListsParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Networkid
+}
+
+func (p *ListNetworkACLListsParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworkACLListsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListNetworkACLListsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworkACLListsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListNetworkACLListsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListNetworkACLListsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListNetworkACLListsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkACLListsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["vpcid"] = v
+	return *p.Projectid
+}
+
+func (p *ListNetworkACLListsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListNetworkACLListsParams) SetVpcid(v string) {
+	p.Vpcid = &v
 	return
 }
 
+func (p *ListNetworkACLListsParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *ListNetworkACLListsParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListNetworkACLListsParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
 // You should always use this function to get a new ListNetworkACLListsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsParams {
 	p := &ListNetworkACLListsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkACLService) GetNetworkACLListID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListNetworkACLListsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListNetworkACLLists(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworkACLLists(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
@@ -1283,24 +2165,36 @@ func (s *NetworkACLService) GetNetworkACLListID(name string) (string, error) {
 		return l.NetworkACLLists[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.NetworkACLLists {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*NetworkACLList
+	for _, v := range l.NetworkACLLists {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "NetworkACLList", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkACLService) GetNetworkACLListByName(name string) (*NetworkACLList, int, error) {
-	id, err := s.GetNetworkACLListID(name)
+func (s *NetworkACLService) GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	id, err := s.GetNetworkACLListID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetNetworkACLListByID(id)
+	r, count, err := s.GetNetworkACLListByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1308,11 +2202,16 @@ func (s *NetworkACLService) GetNetworkACLListByName(name string) (*NetworkACLLis
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkACLService) GetNetworkACLListByID(id string) (*NetworkACLList, int, error) {
+func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
 	p := &ListNetworkACLListsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListNetworkACLLists(p)
 	if err != nil {
@@ -1324,6 +2223,20 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string) (*NetworkACLList, i
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworkACLLists(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -1335,96 +2248,255 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string) (*NetworkACLList, i
 }
 
 // Lists all network ACLs
+//
+// See also GetNetworkACLListID, GetNetworkACLListByName, GetNetworkACLListByID.
 func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLLists", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNetworkACLLists", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkACLListsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNetworkACLLists", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListNetworkACLLists, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkACLService) StreamNetworkACLLists(ctx context.Context, p *ListNetworkACLListsParams, fn func(*NetworkACLList) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNetworkACLLists", p.URLValues(), "networkacllist", func(b json.RawMessage) error {
+		var v NetworkACLList
+		if err := s.cs.unmarshalElement("listNetworkACLLists", "networkacllist", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListNetworkACLListsResponse struct {
-	Count           int               `json:"count"`
-	NetworkACLLists []*NetworkACLList `json:"networkacllist"`
+	Count           int                        `json:"count" xml:"count"`
+	NetworkACLLists []*NetworkACLList          `json:"networkacllist" xml:"networkacllist"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type NetworkACLList struct {
-	Description string `json:"description,omitempty"`
-	Fordisplay  bool   `json:"fordisplay,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Vpcid       string `json:"vpcid,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Fordisplay  Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Vpcid       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReplaceNetworkACLListParams = cloudstackcommon.ReplaceNetworkACLListParams
+type ReplaceNetworkACLListResponse = cloudstackcommon.ReplaceNetworkACLListResponse
+
+// You should always use this function to get a new ReplaceNetworkACLListParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - aclid
+func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams {
+	p := &ReplaceNetworkACLListParams{}
+	p.SetAclid(aclid)
+	return p
+}
+
+// Replaces ACL associated with a Network or private gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkACLLists, GetNetworkACLListID, GetNetworkACLListByName, GetNetworkACLListByID.
+func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("replaceNetworkACLList", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReplaceNetworkACLListResponse
+	if err := s.cs.unmarshal("replaceNetworkACLList", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("replaceNetworkACLList", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
 type UpdateNetworkACLListParams struct {
-	p map[string]interface{}
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateNetworkACLListParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *UpdateNetworkACLListParams) Command() string {
+	return "updateNetworkACLList"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateNetworkACLListParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateNetworkACLListParams) ResponseType() interface{} {
+	return &UpdateNetworkACLListResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateNetworkACLListParams) Validate() error {
+	v := &validator{api: "updateNetworkACLList"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
 func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.Customid = &v
 	return
 }
 
-func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkACLListParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Customid
+}
+
+func (p *UpdateNetworkACLListParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateNetworkACLListParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateNetworkACLListParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *UpdateNetworkACLListParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkACLListParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *UpdateNetworkACLListParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateNetworkACLListParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateNetworkACLListParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
+func (p *UpdateNetworkACLListParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateNetworkACLListParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateNetworkACLListParams) ResetId() {
+	p.Id = nil
+}
+
 // You should always use this function to get a new UpdateNetworkACLListParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams {
 	p := &UpdateNetworkACLListParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates Network ACL list
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListNetworkACLLists, GetNetworkACLListID, GetNetworkACLListByName, GetNetworkACLListByID.
 func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkACLList", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateNetworkACLList", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1440,7 +2512,7 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateNetworkACLList", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1448,7 +2520,8 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 }
 
 type UpdateNetworkACLListResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -17,229 +17,135 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"net/url"
-	"strconv"
-)
 
-type AddNetworkDeviceParams struct {
-	p map[string]interface{}
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddNetworkDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["networkdeviceparameterlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
-	}
-	return u
+type NetworkDeviceService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdeviceparameterlist"] = v
-	return
+func NewNetworkDeviceService(cs *CloudStackClient) *NetworkDeviceService {
+	return &NetworkDeviceService{cs: cs}
 }
 
-func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdevicetype"] = v
-	return
-}
+type AddNetworkDeviceParams = cloudstackcommon.AddNetworkDeviceParams
+type AddNetworkDeviceResponse = cloudstackcommon.AddNetworkDeviceResponse
 
 // You should always use this function to get a new AddNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDeviceParams {
 	p := &AddNetworkDeviceParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall, ExternalLoadBalancer, PxeServer
+//
+// See also ListNetworkDevice.
 func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkDevice", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addNetworkDevice", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNetworkDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addNetworkDevice", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddNetworkDeviceResponse struct {
-	Id string `json:"id,omitempty"`
-}
+type DeleteNetworkDeviceParams = cloudstackcommon.DeleteNetworkDeviceParams
+type DeleteNetworkDeviceResponse = cloudstackcommon.DeleteNetworkDeviceResponse
 
-type ListNetworkDeviceParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new DeleteNetworkDeviceParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams {
+	p := &DeleteNetworkDeviceParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListNetworkDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["networkdeviceparameterlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("networkdeviceparameterlist[%d].value", i), vv)
-			i++
+// Deletes network device.
+//
+// See also ListNetworkDevice.
+func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListNetworkDeviceParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdeviceparameterlist"] = v
-	return
-}
 
-func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteNetworkDevice", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["networkdevicetype"] = v
-	return
-}
 
-func (p *ListNetworkDeviceParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteNetworkDeviceResponse
+	if err := s.cs.unmarshal("deleteNetworkDevice", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListNetworkDeviceParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListNetworkDeviceParams = cloudstackcommon.ListNetworkDeviceParams
+type ListNetworkDeviceResponse = cloudstackcommon.ListNetworkDeviceResponse
+type NetworkDevice = cloudstackcommon.NetworkDevice
 
 // You should always use this function to get a new ListNetworkDeviceParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDeviceParams {
 	p := &ListNetworkDeviceParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // List network devices
 func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkDevice", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNetworkDevice", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNetworkDevice", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListNetworkDeviceResponse struct {
-	Count         int              `json:"count"`
-	NetworkDevice []*NetworkDevice `json:"networkdevice"`
-}
-
-type NetworkDevice struct {
-	Id string `json:"id,omitempty"`
-}
-
-type DeleteNetworkDeviceParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteNetworkDeviceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new DeleteNetworkDeviceParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams {
-	p := &DeleteNetworkDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Deletes network device.
-func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkDevice", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteNetworkDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Lists the objects like ListNetworkDevice, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkDeviceService) StreamNetworkDevice(ctx context.Context, p *ListNetworkDeviceParams, fn func(*NetworkDevice) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return &r, nil
-}
 
-type DeleteNetworkDeviceResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+	return s.cs.streamList(ctx, "listNetworkDevice", p.URLValues(), "networkdevice", func(b json.RawMessage) error {
+		var v NetworkDevice
+		if err := s.cs.unmarshalElement("listNetworkDevice", "networkdevice", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
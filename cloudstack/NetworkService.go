@@ -17,463 +17,1276 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type DedicatePublicIpRangeParams struct {
-	p map[string]interface{}
+type NetworkService struct {
+	cs *CloudStackClient
+}
+
+func NewNetworkService(cs *CloudStackClient) *NetworkService {
+	return &NetworkService{cs: cs}
+}
+
+type ListF5LoadBalancerNetworksParams struct {
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListF5LoadBalancerNetworksParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
 	return u
 }
 
-func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Returns the name of the API command of these params
+func (p *ListF5LoadBalancerNetworksParams) Command() string {
+	return "listF5LoadBalancerNetworks"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListF5LoadBalancerNetworksParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListF5LoadBalancerNetworksParams) ResponseType() interface{} {
+	return &ListF5LoadBalancerNetworksResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListF5LoadBalancerNetworksParams) Validate() error {
+	v := &validator{api: "listF5LoadBalancerNetworks"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListF5LoadBalancerNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListF5LoadBalancerNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Keyword
+}
+
+func (p *ListF5LoadBalancerNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// This param is required.
+func (p *ListF5LoadBalancerNetworksParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
 	return
 }
 
-func (p *DedicatePublicIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListF5LoadBalancerNetworksParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Lbdeviceid
+}
+
+func (p *ListF5LoadBalancerNetworksParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListF5LoadBalancerNetworksParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListF5LoadBalancerNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Page
+}
+
+func (p *ListF5LoadBalancerNetworksParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListF5LoadBalancerNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-// You should always use this function to get a new DedicatePublicIpRangeParams instance,
+func (p *ListF5LoadBalancerNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListF5LoadBalancerNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListF5LoadBalancerNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListF5LoadBalancerNetworksParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewDedicatePublicIpRangeParams(account string, domainid string, id string) *DedicatePublicIpRangeParams {
-	p := &DedicatePublicIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *NetworkService) NewListF5LoadBalancerNetworksParams(lbdeviceid string) *ListF5LoadBalancerNetworksParams {
+	p := &ListF5LoadBalancerNetworksParams{}
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// Dedicates a Public IP range to an account
-func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePublicIpRange", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetF5LoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, error) {
+	p := &ListF5LoadBalancerNetworksParams{}
+
+	p.SetKeyword(keyword)
+	p.SetLbdeviceid(lbdeviceid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListF5LoadBalancerNetworks(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.F5LoadBalancerNetworks[0].Id, nil
+	}
+
+	var matches []*F5LoadBalancerNetwork
+	for _, v := range l.F5LoadBalancerNetworks {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "F5LoadBalancerNetwork", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// lists network that are using a F5 load balancer device
+//
+// Only supported by CloudStack 4.3.
+// See also GetF5LoadBalancerNetworkID.
+func (s *NetworkService) ListF5LoadBalancerNetworks(p *ListF5LoadBalancerNetworksParams) (*ListF5LoadBalancerNetworksResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listF5LoadBalancerNetworks", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DedicatePublicIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListF5LoadBalancerNetworksResponse
+	if err := s.cs.unmarshal("listF5LoadBalancerNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DedicatePublicIpRangeResponse struct {
-	Account           string `json:"account,omitempty"`
-	Description       string `json:"description,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Endip             string `json:"endip,omitempty"`
-	Endipv6           string `json:"endipv6,omitempty"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork,omitempty"`
-	Gateway           string `json:"gateway,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Ip6cidr           string `json:"ip6cidr,omitempty"`
-	Ip6gateway        string `json:"ip6gateway,omitempty"`
-	Netmask           string `json:"netmask,omitempty"`
-	Networkid         string `json:"networkid,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Podid             string `json:"podid,omitempty"`
-	Podname           string `json:"podname,omitempty"`
-	Project           string `json:"project,omitempty"`
-	Projectid         string `json:"projectid,omitempty"`
-	Startip           string `json:"startip,omitempty"`
-	Startipv6         string `json:"startipv6,omitempty"`
-	Vlan              string `json:"vlan,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
-}
-
-type ReleasePublicIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
+// Lists the objects like ListF5LoadBalancerNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamF5LoadBalancerNetworks(ctx context.Context, p *ListF5LoadBalancerNetworksParams, fn func(*F5LoadBalancerNetwork) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listF5LoadBalancerNetworks", p.URLValues(), "f5loadbalancernetwork", func(b json.RawMessage) error {
+		var v F5LoadBalancerNetwork
+		if err := s.cs.unmarshalElement("listF5LoadBalancerNetworks", "f5loadbalancernetwork", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListF5LoadBalancerNetworksResponse struct {
+	Count                  int                        `json:"count" xml:"count"`
+	F5LoadBalancerNetworks []*F5LoadBalancerNetwork   `json:"f5loadbalancernetwork" xml:"f5loadbalancernetwork"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type F5LoadBalancerNetwork struct {
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 string                     `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNetscalerLoadBalancerNetworksParams struct {
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetscalerLoadBalancerNetworksParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
 	return u
 }
 
-func (p *ReleasePublicIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ListNetscalerLoadBalancerNetworksParams) Command() string {
+	return "listNetscalerLoadBalancerNetworks"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNetscalerLoadBalancerNetworksParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetscalerLoadBalancerNetworksParams) ResponseType() interface{} {
+	return &ListNetscalerLoadBalancerNetworksResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetscalerLoadBalancerNetworksParams) Validate() error {
+	v := &validator{api: "listNetscalerLoadBalancerNetworks"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Keyword
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// This param is required.
+func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
 	return
 }
 
-// You should always use this function to get a new ReleasePublicIpRangeParams instance,
+func (p *ListNetscalerLoadBalancerNetworksParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
+	}
+	return *p.Lbdeviceid
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetscalerLoadBalancerNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListNetscalerLoadBalancerNetworksParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams {
-	p := &ReleasePublicIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams {
+	p := &ListNetscalerLoadBalancerNetworksParams{}
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// Releases a Public IP range back to the system pool
-func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("releasePublicIpRange", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, error) {
+	p := &ListNetscalerLoadBalancerNetworksParams{}
+
+	p.SetKeyword(keyword)
+	p.SetLbdeviceid(lbdeviceid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListNetscalerLoadBalancerNetworks(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.NetscalerLoadBalancerNetworks[0].Id, nil
+	}
+
+	var matches []*NetscalerLoadBalancerNetwork
+	for _, v := range l.NetscalerLoadBalancerNetworks {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "NetscalerLoadBalancerNetwork", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// lists network that are using a netscaler load balancer device
+//
+// See also GetNetscalerLoadBalancerNetworkID.
+func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReleasePublicIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListNetscalerLoadBalancerNetworksResponse
+	if err := s.cs.unmarshal("listNetscalerLoadBalancerNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ReleasePublicIpRangeResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+// Lists the objects like ListNetscalerLoadBalancerNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamNetscalerLoadBalancerNetworks(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, fn func(*NetscalerLoadBalancerNetwork) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNetscalerLoadBalancerNetworks", p.URLValues(), "netscalerloadbalancernetwork", func(b json.RawMessage) error {
+		var v NetscalerLoadBalancerNetwork
+		if err := s.cs.unmarshalElement("listNetscalerLoadBalancerNetworks", "netscalerloadbalancernetwork", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type CreateNetworkParams struct {
-	p map[string]interface{}
+type ListNetscalerLoadBalancerNetworksResponse struct {
+	Count                         int                             `json:"count" xml:"count"`
+	NetscalerLoadBalancerNetworks []*NetscalerLoadBalancerNetwork `json:"netscalerloadbalancernetwork" xml:"netscalerloadbalancernetwork"`
+	Extra                         map[string]json.RawMessage      `json:"-" xml:"-"`
+}
+
+type NetscalerLoadBalancerNetwork struct {
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Strechedl2subnet            Bool                       `json:"strechedl2subnet,omitempty" xml:"strechedl2subnet,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Zonesnetworkspans           []string                   `json:"zonesnetworkspans,omitempty" xml:"zonesnetworkspans,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *CreateNetworkParams) toURLValues() url.Values {
+type CreateNetworkParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Aclid             *string `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Acltype           *string `json:"acltype,omitempty" yaml:"acltype,omitempty"`
+	Displaynetwork    *bool   `json:"displaynetwork,omitempty" yaml:"displaynetwork,omitempty"`
+	Displaytext       *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endip             *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Endipv6           *string `json:"endipv6,omitempty" yaml:"endipv6,omitempty"`
+	Gateway           *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ip6cidr           *string `json:"ip6cidr,omitempty" yaml:"ip6cidr,omitempty"`
+	Ip6gateway        *string `json:"ip6gateway,omitempty" yaml:"ip6gateway,omitempty"`
+	Isolatedpvlan     *string `json:"isolatedpvlan,omitempty" yaml:"isolatedpvlan,omitempty"`
+	Name              *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Netmask           *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Networkdomain     *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Networkofferingid *string `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Startip           *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Startipv6         *string `json:"startipv6,omitempty" yaml:"startipv6,omitempty"`
+	Subdomainaccess   *bool   `json:"subdomainaccess,omitempty" yaml:"subdomainaccess,omitempty"`
+	Vlan              *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid             *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateNetworkParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Aclid; v != nil {
+		u.Set("aclid", *v)
 	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if v := p.Acltype; v != nil {
+		u.Set("acltype", *v)
 	}
-	if v, found := p.p["acltype"]; found {
-		u.Set("acltype", v.(string))
-	}
-	if v, found := p.p["displaynetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Displaynetwork; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("displaynetwork", vv)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
 	}
-	if v, found := p.p["endipv6"]; found {
-		u.Set("endipv6", v.(string))
+	if v := p.Endipv6; v != nil {
+		u.Set("endipv6", *v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
 	}
-	if v, found := p.p["ip6cidr"]; found {
-		u.Set("ip6cidr", v.(string))
+	if v := p.Ip6cidr; v != nil {
+		u.Set("ip6cidr", *v)
 	}
-	if v, found := p.p["ip6gateway"]; found {
-		u.Set("ip6gateway", v.(string))
+	if v := p.Ip6gateway; v != nil {
+		u.Set("ip6gateway", *v)
 	}
-	if v, found := p.p["isolatedpvlan"]; found {
-		u.Set("isolatedpvlan", v.(string))
+	if v := p.Isolatedpvlan; v != nil {
+		u.Set("isolatedpvlan", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
 	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
+	if v := p.Networkofferingid; v != nil {
+		u.Set("networkofferingid", *v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
 	}
-	if v, found := p.p["startipv6"]; found {
-		u.Set("startipv6", v.(string))
+	if v := p.Startipv6; v != nil {
+		u.Set("startipv6", *v)
 	}
-	if v, found := p.p["subdomainaccess"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Subdomainaccess; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("subdomainaccess", vv)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *CreateNetworkParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateNetworkParams) Command() string {
+	return "createNetwork"
 }
 
-func (p *CreateNetworkParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["aclid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateNetworkParams) IsAsync() bool {
+	return false
 }
 
-func (p *CreateNetworkParams) SetAcltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["acltype"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateNetworkParams) ResponseType() interface{} {
+	return &CreateNetworkResponse{}
 }
 
-func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaynetwork"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateNetworkParams) Validate() error {
+	v := &validator{api: "createNetwork"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	v.required("networkofferingid", p.Networkofferingid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
 }
 
-func (p *CreateNetworkParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+// Sets the 'account' param: the name of the account.
+func (p *CreateNetworkParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *CreateNetworkParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
-	return
+	return *p.Account
 }
 
-func (p *CreateNetworkParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
-	return
+func (p *CreateNetworkParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *CreateNetworkParams) SetEndipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endipv6"] = v
-	return
+func (p *CreateNetworkParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *CreateNetworkParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+func (p *CreateNetworkParams) SetAclid(v string) {
+	p.Aclid = &v
 	return
 }
 
-func (p *CreateNetworkParams) SetIp6cidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) GetAclid() string {
+	if p.Aclid == nil {
+		return ""
 	}
-	p.p["ip6cidr"] = v
-	return
+	return *p.Aclid
 }
 
-func (p *CreateNetworkParams) SetIp6gateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6gateway"] = v
-	return
+func (p *CreateNetworkParams) HasAclid() bool {
+	return p.Aclid != nil
 }
 
-func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isolatedpvlan"] = v
-	return
+func (p *CreateNetworkParams) ResetAclid() {
+	p.Aclid = nil
 }
 
-func (p *CreateNetworkParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+func (p *CreateNetworkParams) SetAcltype(v string) {
+	p.Acltype = &v
 	return
 }
 
-func (p *CreateNetworkParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) GetAcltype() string {
+	if p.Acltype == nil {
+		return ""
 	}
-	p.p["netmask"] = v
-	return
+	return *p.Acltype
 }
 
-func (p *CreateNetworkParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
-	return
+func (p *CreateNetworkParams) HasAcltype() bool {
+	return p.Acltype != nil
 }
 
-func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkofferingid"] = v
-	return
+func (p *CreateNetworkParams) ResetAcltype() {
+	p.Acltype = nil
 }
 
-func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+func (p *CreateNetworkParams) SetDisplaynetwork(v bool) {
+	p.Displaynetwork = &v
 	return
 }
 
-func (p *CreateNetworkParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) GetDisplaynetwork() bool {
+	if p.Displaynetwork == nil {
+		return false
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Displaynetwork
 }
 
-func (p *CreateNetworkParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
-	return
+func (p *CreateNetworkParams) HasDisplaynetwork() bool {
+	return p.Displaynetwork != nil
 }
 
-func (p *CreateNetworkParams) SetStartipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startipv6"] = v
-	return
+func (p *CreateNetworkParams) ResetDisplaynetwork() {
+	p.Displaynetwork = nil
 }
 
-func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["subdomainaccess"] = v
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *CreateNetworkParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *CreateNetworkParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["vlan"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *CreateNetworkParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateNetworkParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateNetworkParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateNetworkParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateNetworkParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'endip' param: the last IP address of the range.
+func (p *CreateNetworkParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
 	}
-	p.p["vpcid"] = v
+	return *p.Endip
+}
+
+func (p *CreateNetworkParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *CreateNetworkParams) ResetEndip() {
+	p.Endip = nil
+}
+
+func (p *CreateNetworkParams) SetEndipv6(v string) {
+	p.Endipv6 = &v
 	return
 }
 
-func (p *CreateNetworkParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkParams) GetEndipv6() string {
+	if p.Endipv6 == nil {
+		return ""
+	}
+	return *p.Endipv6
+}
+
+func (p *CreateNetworkParams) HasEndipv6() bool {
+	return p.Endipv6 != nil
+}
+
+func (p *CreateNetworkParams) ResetEndipv6() {
+	p.Endipv6 = nil
+}
+
+func (p *CreateNetworkParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreateNetworkParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreateNetworkParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+func (p *CreateNetworkParams) SetIp6cidr(v string) {
+	p.Ip6cidr = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetIp6cidr() string {
+	if p.Ip6cidr == nil {
+		return ""
+	}
+	return *p.Ip6cidr
+}
+
+func (p *CreateNetworkParams) HasIp6cidr() bool {
+	return p.Ip6cidr != nil
+}
+
+func (p *CreateNetworkParams) ResetIp6cidr() {
+	p.Ip6cidr = nil
+}
+
+func (p *CreateNetworkParams) SetIp6gateway(v string) {
+	p.Ip6gateway = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetIp6gateway() string {
+	if p.Ip6gateway == nil {
+		return ""
+	}
+	return *p.Ip6gateway
+}
+
+func (p *CreateNetworkParams) HasIp6gateway() bool {
+	return p.Ip6gateway != nil
+}
+
+func (p *CreateNetworkParams) ResetIp6gateway() {
+	p.Ip6gateway = nil
+}
+
+func (p *CreateNetworkParams) SetIsolatedpvlan(v string) {
+	p.Isolatedpvlan = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetIsolatedpvlan() string {
+	if p.Isolatedpvlan == nil {
+		return ""
+	}
+	return *p.Isolatedpvlan
+}
+
+func (p *CreateNetworkParams) HasIsolatedpvlan() bool {
+	return p.Isolatedpvlan != nil
+}
+
+func (p *CreateNetworkParams) ResetIsolatedpvlan() {
+	p.Isolatedpvlan = nil
+}
+
+// This param is required.
+func (p *CreateNetworkParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateNetworkParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateNetworkParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'netmask' param: the netmask.
+func (p *CreateNetworkParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *CreateNetworkParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *CreateNetworkParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *CreateNetworkParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
+	}
+	return *p.Networkdomain
+}
+
+func (p *CreateNetworkParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *CreateNetworkParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+// This param is required.
+func (p *CreateNetworkParams) SetNetworkofferingid(v string) {
+	p.Networkofferingid = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetNetworkofferingid() string {
+	if p.Networkofferingid == nil {
+		return ""
+	}
+	return *p.Networkofferingid
+}
+
+func (p *CreateNetworkParams) HasNetworkofferingid() bool {
+	return p.Networkofferingid != nil
+}
+
+func (p *CreateNetworkParams) ResetNetworkofferingid() {
+	p.Networkofferingid = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *CreateNetworkParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *CreateNetworkParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *CreateNetworkParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateNetworkParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *CreateNetworkParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateNetworkParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range.
+func (p *CreateNetworkParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *CreateNetworkParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *CreateNetworkParams) ResetStartip() {
+	p.Startip = nil
+}
+
+func (p *CreateNetworkParams) SetStartipv6(v string) {
+	p.Startipv6 = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetStartipv6() string {
+	if p.Startipv6 == nil {
+		return ""
+	}
+	return *p.Startipv6
+}
+
+func (p *CreateNetworkParams) HasStartipv6() bool {
+	return p.Startipv6 != nil
+}
+
+func (p *CreateNetworkParams) ResetStartipv6() {
+	p.Startipv6 = nil
+}
+
+func (p *CreateNetworkParams) SetSubdomainaccess(v bool) {
+	p.Subdomainaccess = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetSubdomainaccess() bool {
+	if p.Subdomainaccess == nil {
+		return false
+	}
+	return *p.Subdomainaccess
+}
+
+func (p *CreateNetworkParams) HasSubdomainaccess() bool {
+	return p.Subdomainaccess != nil
+}
+
+func (p *CreateNetworkParams) ResetSubdomainaccess() {
+	p.Subdomainaccess = nil
+}
+
+func (p *CreateNetworkParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *CreateNetworkParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *CreateNetworkParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *CreateNetworkParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *CreateNetworkParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Vpcid
+}
+
+func (p *CreateNetworkParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *CreateNetworkParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *CreateNetworkParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *CreateNetworkParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreateNetworkParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreateNetworkParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new CreateNetworkParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
+//   - networkofferingid
+//   - zoneid: the ID of the zone
 func (s *NetworkService) NewCreateNetworkParams(displaytext string, name string, networkofferingid string, zoneid string) *CreateNetworkParams {
 	p := &CreateNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["networkofferingid"] = networkofferingid
-	p.p["zoneid"] = zoneid
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetNetworkofferingid(networkofferingid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Creates a network
+//
+// See also ListNetworks, GetNetworkID, GetNetworkByName, GetNetworkByID, GetNetworksByTags.
 func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createNetwork", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createNetwork", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -483,143 +1296,97 @@ func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkRe
 	}
 
 	var r CreateNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type CreateNetworkResponse struct {
-	Account                     string `json:"account,omitempty"`
-	Aclid                       string `json:"aclid,omitempty"`
-	Acltype                     string `json:"acltype,omitempty"`
-	Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-	Broadcasturi                string `json:"broadcasturi,omitempty"`
-	Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-	Cidr                        string `json:"cidr,omitempty"`
-	Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-	Displaytext                 string `json:"displaytext,omitempty"`
-	Dns1                        string `json:"dns1,omitempty"`
-	Dns2                        string `json:"dns2,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gateway                     string `json:"gateway,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Ip6cidr                     string `json:"ip6cidr,omitempty"`
-	Ip6gateway                  string `json:"ip6gateway,omitempty"`
-	Isdefault                   bool   `json:"isdefault,omitempty"`
-	Ispersistent                bool   `json:"ispersistent,omitempty"`
-	Issystem                    bool   `json:"issystem,omitempty"`
-	Name                        string `json:"name,omitempty"`
-	Netmask                     string `json:"netmask,omitempty"`
-	Networkcidr                 string `json:"networkcidr,omitempty"`
-	Networkdomain               string `json:"networkdomain,omitempty"`
-	Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-	Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-	Networkofferingid           string `json:"networkofferingid,omitempty"`
-	Networkofferingname         string `json:"networkofferingname,omitempty"`
-	Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-	Project                     string `json:"project,omitempty"`
-	Projectid                   string `json:"projectid,omitempty"`
-	Related                     string `json:"related,omitempty"`
-	Reservediprange             string `json:"reservediprange,omitempty"`
-	Restartrequired             bool   `json:"restartrequired,omitempty"`
-	Service                     []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-	State            string `json:"state,omitempty"`
-	Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-	Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-	Tags             []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype       string   `json:"traffictype,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Vlan              string   `json:"vlan,omitempty"`
-	Vpcid             string   `json:"vpcid,omitempty"`
-	Zoneid            string   `json:"zoneid,omitempty"`
-	Zonename          string   `json:"zonename,omitempty"`
-	Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-}
-
-type DeleteNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteNetworkParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteNetworkParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
-	return
-}
-
-func (p *DeleteNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Strechedl2subnet            Bool                       `json:"strechedl2subnet,omitempty" xml:"strechedl2subnet,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Zonesnetworkspans           []string                   `json:"zonesnetworkspans,omitempty" xml:"zonesnetworkspans,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetworkParams = cloudstackcommon.DeleteNetworkParams
+type DeleteNetworkResponse = cloudstackcommon.DeleteNetworkResponse
 
 // You should always use this function to get a new DeleteNetworkParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams {
 	p := &DeleteNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworks, GetNetworkID, GetNetworkByName, GetNetworkByID, GetNetworksByTags.
 func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetwork", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNetwork", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -635,320 +1402,658 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteNetworkResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type ListNetworksParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworksParams) toURLValues() url.Values {
+	Account           *string             `json:"account,omitempty" yaml:"account,omitempty"`
+	Acltype           *string             `json:"acltype,omitempty" yaml:"acltype,omitempty"`
+	Canusefordeploy   *bool               `json:"canusefordeploy,omitempty" yaml:"canusefordeploy,omitempty"`
+	Displaynetwork    *bool               `json:"displaynetwork,omitempty" yaml:"displaynetwork,omitempty"`
+	Domainid          *string             `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvpc            *bool               `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Id                *string             `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool               `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Issystem          *bool               `json:"issystem,omitempty" yaml:"issystem,omitempty"`
+	Keyword           *string             `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool               `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page              *int                `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int                `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string             `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid         *string             `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Restartrequired   *bool               `json:"restartrequired,omitempty" yaml:"restartrequired,omitempty"`
+	Specifyipranges   *bool               `json:"specifyipranges,omitempty" yaml:"specifyipranges,omitempty"`
+	Supportedservices []string            `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags              map[string]string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype       *NetworkTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Type              *string             `json:"type,omitempty" yaml:"type,omitempty"`
+	Vpcid             *string             `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid            *string             `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworksParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["acltype"]; found {
-		u.Set("acltype", v.(string))
+	if v := p.Acltype; v != nil {
+		u.Set("acltype", *v)
 	}
-	if v, found := p.p["canusefordeploy"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Canusefordeploy; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("canusefordeploy", vv)
 	}
-	if v, found := p.p["displaynetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Displaynetwork; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("displaynetwork", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Forvpc; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("forvpc", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["issystem"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Issystem; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("issystem", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["restartrequired"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Restartrequired; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("restartrequired", vv)
 	}
-	if v, found := p.p["specifyipranges"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Specifyipranges; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("specifyipranges", vv)
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Supportedservices; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("supportedservices", vv)
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListNetworksParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListNetworksParams) Command() string {
+	return "listNetworks"
 }
 
-func (p *ListNetworksParams) SetAcltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["acltype"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListNetworksParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["canusefordeploy"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworksParams) ResponseType() interface{} {
+	return &ListNetworksResponse{}
 }
 
-func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworksParams) Validate() error {
+	v := &validator{api: "listNetworks"}
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
 	}
-	p.p["displaynetwork"] = v
-	return
+	return v.err()
 }
 
-func (p *ListNetworksParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListNetworksParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListNetworksParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["forvpc"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListNetworksParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListNetworksParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListNetworksParams) SetAcltype(v string) {
+	p.Acltype = &v
+	return
+}
+
+func (p *ListNetworksParams) GetAcltype() string {
+	if p.Acltype == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Acltype
+}
+
+func (p *ListNetworksParams) HasAcltype() bool {
+	return p.Acltype != nil
+}
+
+func (p *ListNetworksParams) ResetAcltype() {
+	p.Acltype = nil
+}
+
+func (p *ListNetworksParams) SetCanusefordeploy(v bool) {
+	p.Canusefordeploy = &v
 	return
 }
 
-func (p *ListNetworksParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetCanusefordeploy() bool {
+	if p.Canusefordeploy == nil {
+		return false
 	}
-	p.p["isrecursive"] = v
+	return *p.Canusefordeploy
+}
+
+func (p *ListNetworksParams) HasCanusefordeploy() bool {
+	return p.Canusefordeploy != nil
+}
+
+func (p *ListNetworksParams) ResetCanusefordeploy() {
+	p.Canusefordeploy = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *ListNetworksParams) SetDisplaynetwork(v bool) {
+	p.Displaynetwork = &v
 	return
 }
 
-func (p *ListNetworksParams) SetIssystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetDisplaynetwork() bool {
+	if p.Displaynetwork == nil {
+		return false
 	}
-	p.p["issystem"] = v
+	return *p.Displaynetwork
+}
+
+func (p *ListNetworksParams) HasDisplaynetwork() bool {
+	return p.Displaynetwork != nil
+}
+
+func (p *ListNetworksParams) ResetDisplaynetwork() {
+	p.Displaynetwork = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListNetworksParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Domainid
+}
+
+func (p *ListNetworksParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListNetworksParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListNetworksParams) SetForvpc(v bool) {
+	p.Forvpc = &v
 	return
 }
 
-func (p *ListNetworksParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetForvpc() bool {
+	if p.Forvpc == nil {
+		return false
 	}
-	p.p["listall"] = v
+	return *p.Forvpc
+}
+
+func (p *ListNetworksParams) HasForvpc() bool {
+	return p.Forvpc != nil
+}
+
+func (p *ListNetworksParams) ResetForvpc() {
+	p.Forvpc = nil
+}
+
+func (p *ListNetworksParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Id
+}
+
+func (p *ListNetworksParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListNetworksParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListNetworksParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["pagesize"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListNetworksParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListNetworksParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+func (p *ListNetworksParams) SetIssystem(v bool) {
+	p.Issystem = &v
 	return
 }
 
-func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetIssystem() bool {
+	if p.Issystem == nil {
+		return false
 	}
-	p.p["physicalnetworkid"] = v
+	return *p.Issystem
+}
+
+func (p *ListNetworksParams) HasIssystem() bool {
+	return p.Issystem != nil
+}
+
+func (p *ListNetworksParams) ResetIssystem() {
+	p.Issystem = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListNetworksParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Keyword
+}
+
+func (p *ListNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworksParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListNetworksParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListNetworksParams) SetRestartrequired(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["restartrequired"] = v
+	return *p.Listall
+}
+
+func (p *ListNetworksParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListNetworksParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworksParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["specifyipranges"] = v
+	return *p.Page
+}
+
+func (p *ListNetworksParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetworksParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListNetworksParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["supportedservices"] = v
+	return *p.Pagesize
+}
+
+func (p *ListNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListNetworksParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-func (p *ListNetworksParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Physicalnetworkid
+}
+
+func (p *ListNetworksParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListNetworksParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListNetworksParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListNetworksParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["traffictype"] = v
+	return *p.Projectid
+}
+
+func (p *ListNetworksParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListNetworksParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListNetworksParams) SetRestartrequired(v bool) {
+	p.Restartrequired = &v
 	return
 }
 
-func (p *ListNetworksParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetRestartrequired() bool {
+	if p.Restartrequired == nil {
+		return false
 	}
-	p.p["networkType"] = v
+	return *p.Restartrequired
+}
+
+func (p *ListNetworksParams) HasRestartrequired() bool {
+	return p.Restartrequired != nil
+}
+
+func (p *ListNetworksParams) ResetRestartrequired() {
+	p.Restartrequired = nil
+}
+
+func (p *ListNetworksParams) SetSpecifyipranges(v bool) {
+	p.Specifyipranges = &v
 	return
 }
 
-func (p *ListNetworksParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetSpecifyipranges() bool {
+	if p.Specifyipranges == nil {
+		return false
 	}
-	p.p["vpcid"] = v
+	return *p.Specifyipranges
+}
+
+func (p *ListNetworksParams) HasSpecifyipranges() bool {
+	return p.Specifyipranges != nil
+}
+
+func (p *ListNetworksParams) ResetSpecifyipranges() {
+	p.Specifyipranges = nil
+}
+
+func (p *ListNetworksParams) SetSupportedservices(v []string) {
+	p.Supportedservices = v
 	return
 }
 
-func (p *ListNetworksParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworksParams) GetSupportedservices() []string {
+	return p.Supportedservices
+}
+
+func (p *ListNetworksParams) HasSupportedservices() bool {
+	return p.Supportedservices != nil
+}
+
+func (p *ListNetworksParams) ResetSupportedservices() {
+	p.Supportedservices = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListNetworksParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListNetworksParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListNetworksParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListNetworksParams) ResetTags() {
+	p.Tags = nil
+}
+
+func (p *ListNetworksParams) SetTraffictype(v NetworkTrafficType) {
+	p.Traffictype = &v
+	return
+}
+
+func (p *ListNetworksParams) GetTraffictype() NetworkTrafficType {
+	if p.Traffictype == nil {
+		return ""
+	}
+	return *p.Traffictype
+}
+
+func (p *ListNetworksParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *ListNetworksParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+func (p *ListNetworksParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ListNetworksParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ListNetworksParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListNetworksParams) ResetType() {
+	p.Type = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListNetworksParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *ListNetworksParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Vpcid
+}
+
+func (p *ListNetworksParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListNetworksParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListNetworksParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *ListNetworksParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListNetworksParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListNetworksParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListNetworksParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListNetworksParams() *ListNetworksParams {
 	p := &ListNetworksParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNetworkID(keyword string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetNetworkID(keyword string, opts ...OptionFunc) (string, error) {
 	p := &ListNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListNetworks(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworks(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
@@ -957,24 +2062,39 @@ func (s *NetworkService) GetNetworkID(keyword string) (string, error) {
 		return l.Networks[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Networks {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*Network
+	for _, v := range l.Networks {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Network", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNetworkByName(name string) (*Network, int, error) {
-	id, err := s.GetNetworkID(name)
+func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error) {
+	id, err := s.GetNetworkID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetNetworkByID(id)
+	r, count, err := s.GetNetworkByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -982,11 +2102,16 @@ func (s *NetworkService) GetNetworkByName(name string) (*Network, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNetworkByID(id string) (*Network, int, error) {
+func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
 	p := &ListNetworksParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListNetworks(p)
 	if err != nil {
@@ -998,6 +2123,20 @@ func (s *NetworkService) GetNetworkByID(id string) (*Network, int, error) {
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworks(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -1008,156 +2147,248 @@ func (s *NetworkService) GetNetworkByID(id string) (*Network, int, error) {
 	return nil, l.Count, fmt.Errorf("There is more then one result for Network UUID: %s!", id)
 }
 
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *NetworkService) GetNetworksByTags(tags map[string]string, opts ...OptionFunc) ([]*Network, error) {
+	p := &ListNetworksParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListNetworks(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListNetworks(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.Networks, nil
+}
+
 // Lists all available networks.
+//
+// See also GetNetworkID, GetNetworkByName, GetNetworkByID, GetNetworksByTags.
 func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetworks", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNetworks", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamNetworks(ctx context.Context, p *ListNetworksParams, fn func(*Network) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNetworks", p.URLValues(), "network", func(b json.RawMessage) error {
+		var v Network
+		if err := s.cs.unmarshalElement("listNetworks", "network", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListNetworksResponse struct {
-	Count    int        `json:"count"`
-	Networks []*Network `json:"network"`
+	Count    int                        `json:"count" xml:"count"`
+	Networks []*Network                 `json:"network" xml:"network"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type Network struct {
-	Account                     string `json:"account,omitempty"`
-	Aclid                       string `json:"aclid,omitempty"`
-	Acltype                     string `json:"acltype,omitempty"`
-	Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-	Broadcasturi                string `json:"broadcasturi,omitempty"`
-	Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-	Cidr                        string `json:"cidr,omitempty"`
-	Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-	Displaytext                 string `json:"displaytext,omitempty"`
-	Dns1                        string `json:"dns1,omitempty"`
-	Dns2                        string `json:"dns2,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gateway                     string `json:"gateway,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Ip6cidr                     string `json:"ip6cidr,omitempty"`
-	Ip6gateway                  string `json:"ip6gateway,omitempty"`
-	Isdefault                   bool   `json:"isdefault,omitempty"`
-	Ispersistent                bool   `json:"ispersistent,omitempty"`
-	Issystem                    bool   `json:"issystem,omitempty"`
-	Name                        string `json:"name,omitempty"`
-	Netmask                     string `json:"netmask,omitempty"`
-	Networkcidr                 string `json:"networkcidr,omitempty"`
-	Networkdomain               string `json:"networkdomain,omitempty"`
-	Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-	Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-	Networkofferingid           string `json:"networkofferingid,omitempty"`
-	Networkofferingname         string `json:"networkofferingname,omitempty"`
-	Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-	Project                     string `json:"project,omitempty"`
-	Projectid                   string `json:"projectid,omitempty"`
-	Related                     string `json:"related,omitempty"`
-	Reservediprange             string `json:"reservediprange,omitempty"`
-	Restartrequired             bool   `json:"restartrequired,omitempty"`
-	Service                     []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-	State            string `json:"state,omitempty"`
-	Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-	Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-	Tags             []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype       string   `json:"traffictype,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Vlan              string   `json:"vlan,omitempty"`
-	Vpcid             string   `json:"vpcid,omitempty"`
-	Zoneid            string   `json:"zoneid,omitempty"`
-	Zonename          string   `json:"zonename,omitempty"`
-	Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Strechedl2subnet            Bool                       `json:"strechedl2subnet,omitempty" xml:"strechedl2subnet,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Zonesnetworkspans           []string                   `json:"zonesnetworkspans,omitempty" xml:"zonesnetworkspans,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type RestartNetworkParams struct {
-	p map[string]interface{}
+	Cleanup *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *RestartNetworkParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RestartNetworkParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Cleanup; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("cleanup", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *RestartNetworkParams) Command() string {
+	return "restartNetwork"
+}
+
+// Returns true if the API command starts an async job
+func (p *RestartNetworkParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RestartNetworkParams) ResponseType() interface{} {
+	return &RestartNetworkResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RestartNetworkParams) Validate() error {
+	v := &validator{api: "restartNetwork"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
 func (p *RestartNetworkParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cleanup"] = v
+	p.Cleanup = &v
 	return
 }
 
-func (p *RestartNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RestartNetworkParams) GetCleanup() bool {
+	if p.Cleanup == nil {
+		return false
 	}
-	p.p["id"] = v
-	return
+	return *p.Cleanup
 }
 
-// You should always use this function to get a new RestartNetworkParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParams {
-	p := &RestartNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (p *RestartNetworkParams) HasCleanup() bool {
+	return p.Cleanup != nil
+}
+
+func (p *RestartNetworkParams) ResetCleanup() {
+	p.Cleanup = nil
+}
+
+// This param is required.
+func (p *RestartNetworkParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RestartNetworkParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RestartNetworkParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RestartNetworkParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RestartNetworkParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParams {
+	p := &RestartNetworkParams{}
+	p.SetId(id)
 	return p
 }
 
 // Restarts the network; includes 1) restarting network elements - virtual routers, dhcp servers 2) reapplying all public ips 3) reapplying loadBalancing/portForwarding rules
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworks, GetNetworkID, GetNetworkByName, GetNetworkByID, GetNetworksByTags.
 func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
-	resp, err := s.cs.newRequest("restartNetwork", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("restartNetwork", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestartNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("restartNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1178,7 +2409,7 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("restartNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1186,181 +2417,324 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 }
 
 type RestartNetworkResponse struct {
-	JobID                 string `json:"jobid,omitempty"`
-	Account               string `json:"account,omitempty"`
-	Allocated             string `json:"allocated,omitempty"`
-	Associatednetworkid   string `json:"associatednetworkid,omitempty"`
-	Associatednetworkname string `json:"associatednetworkname,omitempty"`
-	Domain                string `json:"domain,omitempty"`
-	Domainid              string `json:"domainid,omitempty"`
-	Fordisplay            bool   `json:"fordisplay,omitempty"`
-	Forvirtualnetwork     bool   `json:"forvirtualnetwork,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Ipaddress             string `json:"ipaddress,omitempty"`
-	Isportable            bool   `json:"isportable,omitempty"`
-	Issourcenat           bool   `json:"issourcenat,omitempty"`
-	Isstaticnat           bool   `json:"isstaticnat,omitempty"`
-	Issystem              bool   `json:"issystem,omitempty"`
-	Networkid             string `json:"networkid,omitempty"`
-	Physicalnetworkid     string `json:"physicalnetworkid,omitempty"`
-	Project               string `json:"project,omitempty"`
-	Projectid             string `json:"projectid,omitempty"`
-	Purpose               string `json:"purpose,omitempty"`
-	State                 string `json:"state,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vlanid                    string `json:"vlanid,omitempty"`
-	Vlanname                  string `json:"vlanname,omitempty"`
-	Vmipaddress               string `json:"vmipaddress,omitempty"`
-	Vpcid                     string `json:"vpcid,omitempty"`
-	Zoneid                    string `json:"zoneid,omitempty"`
-	Zonename                  string `json:"zonename,omitempty"`
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Allocated                 string                     `json:"allocated,omitempty" xml:"allocated,omitempty"`
+	Associatednetworkid       string                     `json:"associatednetworkid,omitempty" xml:"associatednetworkid,omitempty"`
+	Associatednetworkname     string                     `json:"associatednetworkname,omitempty" xml:"associatednetworkname,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay                Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Forvirtualnetwork         Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isportable                Bool                       `json:"isportable,omitempty" xml:"isportable,omitempty"`
+	Issourcenat               Bool                       `json:"issourcenat,omitempty" xml:"issourcenat,omitempty"`
+	Isstaticnat               Bool                       `json:"isstaticnat,omitempty" xml:"isstaticnat,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                 string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Purpose                   string                     `json:"purpose,omitempty" xml:"purpose,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vlanid                    string                     `json:"vlanid,omitempty" xml:"vlanid,omitempty"`
+	Vlanname                  string                     `json:"vlanname,omitempty" xml:"vlanname,omitempty"`
+	Vmipaddress               string                     `json:"vmipaddress,omitempty" xml:"vmipaddress,omitempty"`
+	Vpcid                     string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                  string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type UpdateNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateNetworkParams) toURLValues() url.Values {
+	Changecidr        *bool   `json:"changecidr,omitempty" yaml:"changecidr,omitempty"`
+	Customid          *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Displaynetwork    *bool   `json:"displaynetwork,omitempty" yaml:"displaynetwork,omitempty"`
+	Displaytext       *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Guestvmcidr       *string `json:"guestvmcidr,omitempty" yaml:"guestvmcidr,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name              *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain     *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Networkofferingid *string `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateNetworkParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["changecidr"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Changecidr; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("changecidr", vv)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["displaynetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Displaynetwork; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("displaynetwork", vv)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["guestvmcidr"]; found {
-		u.Set("guestvmcidr", v.(string))
+	if v := p.Guestvmcidr; v != nil {
+		u.Set("guestvmcidr", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
 	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
+	if v := p.Networkofferingid; v != nil {
+		u.Set("networkofferingid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *UpdateNetworkParams) Command() string {
+	return "updateNetwork"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateNetworkParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateNetworkParams) ResponseType() interface{} {
+	return &UpdateNetworkResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateNetworkParams) Validate() error {
+	v := &validator{api: "updateNetwork"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
 func (p *UpdateNetworkParams) SetChangecidr(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["changecidr"] = v
+	p.Changecidr = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetChangecidr() bool {
+	if p.Changecidr == nil {
+		return false
 	}
-	p.p["customid"] = v
+	return *p.Changecidr
+}
+
+func (p *UpdateNetworkParams) HasChangecidr() bool {
+	return p.Changecidr != nil
+}
+
+func (p *UpdateNetworkParams) ResetChangecidr() {
+	p.Changecidr = nil
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only. Only supported by CloudStack 4.4.
+func (p *UpdateNetworkParams) SetCustomid(v string) {
+	p.Customid = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["displaynetwork"] = v
+	return *p.Customid
+}
+
+func (p *UpdateNetworkParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateNetworkParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+func (p *UpdateNetworkParams) SetDisplaynetwork(v bool) {
+	p.Displaynetwork = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetDisplaynetwork() bool {
+	if p.Displaynetwork == nil {
+		return false
 	}
-	p.p["displaytext"] = v
+	return *p.Displaynetwork
+}
+
+func (p *UpdateNetworkParams) HasDisplaynetwork() bool {
+	return p.Displaynetwork != nil
+}
+
+func (p *UpdateNetworkParams) ResetDisplaynetwork() {
+	p.Displaynetwork = nil
+}
+
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateNetworkParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["guestvmcidr"] = v
+	return *p.Displaytext
+}
+
+func (p *UpdateNetworkParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *UpdateNetworkParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+func (p *UpdateNetworkParams) SetGuestvmcidr(v string) {
+	p.Guestvmcidr = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetGuestvmcidr() string {
+	if p.Guestvmcidr == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Guestvmcidr
+}
+
+func (p *UpdateNetworkParams) HasGuestvmcidr() bool {
+	return p.Guestvmcidr != nil
+}
+
+func (p *UpdateNetworkParams) ResetGuestvmcidr() {
+	p.Guestvmcidr = nil
+}
+
+// This param is required.
+func (p *UpdateNetworkParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Id
+}
+
+func (p *UpdateNetworkParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateNetworkParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateNetworkParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["networkdomain"] = v
+	return *p.Name
+}
+
+func (p *UpdateNetworkParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateNetworkParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *UpdateNetworkParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
 	return
 }
 
-func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateNetworkParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
 	}
-	p.p["networkofferingid"] = v
+	return *p.Networkdomain
+}
+
+func (p *UpdateNetworkParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *UpdateNetworkParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+func (p *UpdateNetworkParams) SetNetworkofferingid(v string) {
+	p.Networkofferingid = &v
 	return
 }
 
+func (p *UpdateNetworkParams) GetNetworkofferingid() string {
+	if p.Networkofferingid == nil {
+		return ""
+	}
+	return *p.Networkofferingid
+}
+
+func (p *UpdateNetworkParams) HasNetworkofferingid() bool {
+	return p.Networkofferingid != nil
+}
+
+func (p *UpdateNetworkParams) ResetNetworkofferingid() {
+	p.Networkofferingid = nil
+}
+
 // You should always use this function to get a new UpdateNetworkParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams {
 	p := &UpdateNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworks, GetNetworkID, GetNetworkByName, GetNetworkByID, GetNetworksByTags.
 func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updateNetwork", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateNetwork", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1381,7 +2755,7 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1389,205 +2763,143 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 }
 
 type UpdateNetworkResponse struct {
-	JobID                       string `json:"jobid,omitempty"`
-	Account                     string `json:"account,omitempty"`
-	Aclid                       string `json:"aclid,omitempty"`
-	Acltype                     string `json:"acltype,omitempty"`
-	Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-	Broadcasturi                string `json:"broadcasturi,omitempty"`
-	Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-	Cidr                        string `json:"cidr,omitempty"`
-	Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-	Displaytext                 string `json:"displaytext,omitempty"`
-	Dns1                        string `json:"dns1,omitempty"`
-	Dns2                        string `json:"dns2,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gateway                     string `json:"gateway,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Ip6cidr                     string `json:"ip6cidr,omitempty"`
-	Ip6gateway                  string `json:"ip6gateway,omitempty"`
-	Isdefault                   bool   `json:"isdefault,omitempty"`
-	Ispersistent                bool   `json:"ispersistent,omitempty"`
-	Issystem                    bool   `json:"issystem,omitempty"`
-	Name                        string `json:"name,omitempty"`
-	Netmask                     string `json:"netmask,omitempty"`
-	Networkcidr                 string `json:"networkcidr,omitempty"`
-	Networkdomain               string `json:"networkdomain,omitempty"`
-	Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-	Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-	Networkofferingid           string `json:"networkofferingid,omitempty"`
-	Networkofferingname         string `json:"networkofferingname,omitempty"`
-	Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-	Project                     string `json:"project,omitempty"`
-	Projectid                   string `json:"projectid,omitempty"`
-	Related                     string `json:"related,omitempty"`
-	Reservediprange             string `json:"reservediprange,omitempty"`
-	Restartrequired             bool   `json:"restartrequired,omitempty"`
-	Service                     []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-	State            string `json:"state,omitempty"`
-	Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-	Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-	Tags             []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype       string   `json:"traffictype,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Vlan              string   `json:"vlan,omitempty"`
-	Vpcid             string   `json:"vpcid,omitempty"`
-	Zoneid            string   `json:"zoneid,omitempty"`
-	Zonename          string   `json:"zonename,omitempty"`
-	Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-}
-
-type CreatePhysicalNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["broadcastdomainrange"]; found {
-		u.Set("broadcastdomainrange", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["isolationmethods"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("isolationmethods", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networkspeed"]; found {
-		u.Set("networkspeed", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("tags", vv)
-	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
+	JobID                       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Strechedl2subnet            Bool                       `json:"strechedl2subnet,omitempty" xml:"strechedl2subnet,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Zonesnetworkspans           []string                   `json:"zonesnetworkspans,omitempty" xml:"zonesnetworkspans,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNetworkIsolationMethodsParams = cloudstackcommon.ListNetworkIsolationMethodsParams
+type ListNetworkIsolationMethodsResponse = cloudstackcommon.ListNetworkIsolationMethodsResponse
+type NetworkIsolationMethod = cloudstackcommon.NetworkIsolationMethod
 
-func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["broadcastdomainrange"] = v
-	return
-}
-
-func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// You should always use this function to get a new ListNetworkIsolationMethodsParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams {
+	p := &ListNetworkIsolationMethodsParams{}
+	return p
 }
 
-func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists supported methods of network isolation
+func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["isolationmethods"] = v
-	return
-}
 
-func (p *CreatePhysicalNetworkParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListNetworkIsolationMethodsResponse
+	if err := s.cs.unmarshal("listNetworkIsolationMethods", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["networkspeed"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListNetworkIsolationMethods, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamNetworkIsolationMethods(ctx context.Context, p *ListNetworkIsolationMethodsParams, fn func(*NetworkIsolationMethod) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["tags"] = v
-	return
-}
 
-func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
-	return
+	return s.cs.streamList(ctx, "listNetworkIsolationMethods", p.URLValues(), "networkisolationmethod", func(b json.RawMessage) error {
+		var v NetworkIsolationMethod
+		if err := s.cs.unmarshalElement("listNetworkIsolationMethods", "networkisolationmethod", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type AddNetworkServiceProviderParams = cloudstackcommon.AddNetworkServiceProviderParams
+type AddNetworkServiceProviderResponse = cloudstackcommon.AddNetworkServiceProviderResponse
 
-// You should always use this function to get a new CreatePhysicalNetworkParams instance,
+// You should always use this function to get a new AddNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams {
-	p := &CreatePhysicalNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - name
+//   - physicalnetworkid: the ID of the physical network
+func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams {
+	p := &AddNetworkServiceProviderParams{}
+	p.SetName(name)
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
-// Creates a physical network
-func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("createPhysicalNetwork", p.toURLValues())
+// Adds a network serviceProvider to a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkServiceProviders, GetNetworkServiceProviderID.
+func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addNetworkServiceProvider", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreatePhysicalNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddNetworkServiceProviderResponse
+	if err := s.cs.unmarshal("addNetworkServiceProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1608,68 +2920,46 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addNetworkServiceProvider", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreatePhysicalNetworkResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Broadcastdomainrange string `json:"broadcastdomainrange,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Isolationmethods     string `json:"isolationmethods,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkspeed         string `json:"networkspeed,omitempty"`
-	State                string `json:"state,omitempty"`
-	Tags                 string `json:"tags,omitempty"`
-	Vlan                 string `json:"vlan,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-}
-
-type DeletePhysicalNetworkParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeletePhysicalNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteNetworkServiceProviderParams = cloudstackcommon.DeleteNetworkServiceProviderParams
+type DeleteNetworkServiceProviderResponse = cloudstackcommon.DeleteNetworkServiceProviderResponse
 
-// You should always use this function to get a new DeletePhysicalNetworkParams instance,
+// You should always use this function to get a new DeleteNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams {
-	p := &DeletePhysicalNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams {
+	p := &DeleteNetworkServiceProviderParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a Physical Network.
-func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("deletePhysicalNetwork", p.toURLValues())
+// Deletes a Network Service Provider.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkServiceProviders, GetNetworkServiceProviderID.
+func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeletePhysicalNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteNetworkServiceProviderResponse
+	if err := s.cs.unmarshal("deleteNetworkServiceProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1685,606 +2975,808 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteNetworkServiceProvider", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeletePhysicalNetworkResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type ListNetworkServiceProvidersParams = cloudstackcommon.ListNetworkServiceProvidersParams
+type ListNetworkServiceProvidersResponse = cloudstackcommon.ListNetworkServiceProvidersResponse
+type NetworkServiceProvider = cloudstackcommon.NetworkServiceProvider
 
-type ListPhysicalNetworksParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListNetworkServiceProvidersParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
+	p := &ListNetworkServiceProvidersParams{}
+	return p
 }
 
-func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListNetworkServiceProvidersParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	l, err := s.ListNetworkServiceProviders(p)
+	if err != nil {
+		return "", err
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+
+	if l.Count == 1 {
+		return l.NetworkServiceProviders[0].Id, nil
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+
+	var matches []*NetworkServiceProvider
+	for _, v := range l.NetworkServiceProviders {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if len(matches) == 1 {
+		return matches[0].Id, nil
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "NetworkServiceProvider", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
 	}
-	return u
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-func (p *ListPhysicalNetworksParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists network serviceproviders for a given physical network.
+//
+// See also GetNetworkServiceProviderID.
+func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listNetworkServiceProviders", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListPhysicalNetworksParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListNetworkServiceProvidersResponse
+	if err := s.cs.unmarshal("listNetworkServiceProviders", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListPhysicalNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListNetworkServiceProviders, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamNetworkServiceProviders(ctx context.Context, p *ListNetworkServiceProvidersParams, fn func(*NetworkServiceProvider) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+	return s.cs.streamList(ctx, "listNetworkServiceProviders", p.URLValues(), "networkserviceprovider", func(b json.RawMessage) error {
+		var v NetworkServiceProvider
+		if err := s.cs.unmarshalElement("listNetworkServiceProviders", "networkserviceprovider", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type UpdateNetworkServiceProviderParams = cloudstackcommon.UpdateNetworkServiceProviderParams
+type UpdateNetworkServiceProviderResponse = cloudstackcommon.UpdateNetworkServiceProviderResponse
 
-// You should always use this function to get a new ListPhysicalNetworksParams instance,
+// You should always use this function to get a new UpdateNetworkServiceProviderParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksParams {
-	p := &ListPhysicalNetworksParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams {
+	p := &UpdateNetworkServiceProviderParams{}
+	p.SetId(id)
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetPhysicalNetworkID(name string) (string, error) {
-	p := &ListPhysicalNetworksParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
+// Updates a network serviceProvider of a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNetworkServiceProviders, GetNetworkServiceProviderID.
+func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
-	l, err := s.ListPhysicalNetworks(p)
+	resp, err := s.cs.newRequest("updateNetworkServiceProvider", p.URLValues())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	var r UpdateNetworkServiceProviderResponse
+	if err := s.cs.unmarshal("updateNetworkServiceProvider", resp, &r); err != nil {
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.PhysicalNetworks[0].Id, nil
-	}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-	if l.Count > 1 {
-		for _, v := range l.PhysicalNetworks {
-			if v.Name == name {
-				return v.Id, nil
-			}
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateNetworkServiceProvider", b, &r); err != nil {
+			return nil, err
 		}
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+	return &r, nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetPhysicalNetworkByName(name string) (*PhysicalNetwork, int, error) {
-	id, err := s.GetPhysicalNetworkID(name)
-	if err != nil {
-		return nil, -1, err
-	}
+type ListNiciraNvpDeviceNetworksParams struct {
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nvpdeviceid *string `json:"nvpdeviceid,omitempty" yaml:"nvpdeviceid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
 
-	r, count, err := s.GetPhysicalNetworkByID(id)
-	if err != nil {
-		return nil, count, err
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNiciraNvpDeviceNetworksParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	return r, count, nil
+	if v := p.Nvpdeviceid; v != nil {
+		u.Set("nvpdeviceid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetPhysicalNetworkByID(id string) (*PhysicalNetwork, int, error) {
-	p := &ListPhysicalNetworksParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+// Returns the name of the API command of these params
+func (p *ListNiciraNvpDeviceNetworksParams) Command() string {
+	return "listNiciraNvpDeviceNetworks"
+}
 
-	l, err := s.ListPhysicalNetworks(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
+// Returns true if the API command starts an async job
+func (p *ListNiciraNvpDeviceNetworksParams) IsAsync() bool {
+	return false
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNiciraNvpDeviceNetworksParams) ResponseType() interface{} {
+	return &ListNiciraNvpDeviceNetworksResponse{}
+}
 
-	if l.Count == 1 {
-		return l.PhysicalNetworks[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for PhysicalNetwork UUID: %s!", id)
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNiciraNvpDeviceNetworksParams) Validate() error {
+	v := &validator{api: "listNiciraNvpDeviceNetworks"}
+	v.required("nvpdeviceid", p.Nvpdeviceid != nil)
+	return v.err()
 }
 
-// Lists physical networks
-func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPhysicalNetworks", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
 
-	var r ListPhysicalNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListNiciraNvpDeviceNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Keyword
 }
 
-type ListPhysicalNetworksResponse struct {
-	Count            int                `json:"count"`
-	PhysicalNetworks []*PhysicalNetwork `json:"physicalnetwork"`
+func (p *ListNiciraNvpDeviceNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-type PhysicalNetwork struct {
-	Broadcastdomainrange string `json:"broadcastdomainrange,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Isolationmethods     string `json:"isolationmethods,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkspeed         string `json:"networkspeed,omitempty"`
-	State                string `json:"state,omitempty"`
-	Tags                 string `json:"tags,omitempty"`
-	Vlan                 string `json:"vlan,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
+func (p *ListNiciraNvpDeviceNetworksParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-type UpdatePhysicalNetworkParams struct {
-	p map[string]interface{}
+// This param is required.
+func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
+	p.Nvpdeviceid = &v
+	return
 }
 
-func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["networkspeed"]; found {
-		u.Set("networkspeed", v.(string))
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("tags", vv)
+func (p *ListNiciraNvpDeviceNetworksParams) GetNvpdeviceid() string {
+	if p.Nvpdeviceid == nil {
+		return ""
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
-	}
-	return u
+	return *p.Nvpdeviceid
 }
 
-func (p *UpdatePhysicalNetworkParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListNiciraNvpDeviceNetworksParams) HasNvpdeviceid() bool {
+	return p.Nvpdeviceid != nil
 }
 
-func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkspeed"] = v
-	return
+func (p *ListNiciraNvpDeviceNetworksParams) ResetNvpdeviceid() {
+	p.Nvpdeviceid = nil
 }
 
-func (p *UpdatePhysicalNetworkParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNiciraNvpDeviceNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["tags"] = v
+	return *p.Page
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNiciraNvpDeviceNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["vlan"] = v
-	return
+	return *p.Pagesize
 }
 
-// You should always use this function to get a new UpdatePhysicalNetworkParams instance,
+func (p *ListNiciraNvpDeviceNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNiciraNvpDeviceNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListNiciraNvpDeviceNetworksParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams {
-	p := &UpdatePhysicalNetworkParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - nvpdeviceid
+func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams {
+	p := &ListNiciraNvpDeviceNetworksParams{}
+	p.SetNvpdeviceid(nvpdeviceid)
 	return p
 }
 
-// Updates a physical network
-func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newRequest("updatePhysicalNetwork", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, error) {
+	p := &ListNiciraNvpDeviceNetworksParams{}
+
+	p.SetKeyword(keyword)
+	p.SetNvpdeviceid(nvpdeviceid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListNiciraNvpDeviceNetworks(p)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var r UpdatePhysicalNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
+	if l.Count == 1 {
+		return l.NiciraNvpDeviceNetworks[0].Id, nil
+	}
+
+	var matches []*NiciraNvpDeviceNetwork
+	for _, v := range l.NiciraNvpDeviceNetworks {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "NiciraNvpDeviceNetwork", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
 		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
+// lists network that are using a nicira nvp device
+//
+// See also GetNiciraNvpDeviceNetworkID.
+func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListNiciraNvpDeviceNetworksResponse
+	if err := s.cs.unmarshal("listNiciraNvpDeviceNetworks", resp, &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdatePhysicalNetworkResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Broadcastdomainrange string `json:"broadcastdomainrange,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Isolationmethods     string `json:"isolationmethods,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkspeed         string `json:"networkspeed,omitempty"`
-	State                string `json:"state,omitempty"`
-	Tags                 string `json:"tags,omitempty"`
-	Vlan                 string `json:"vlan,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
+// Lists the objects like ListNiciraNvpDeviceNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamNiciraNvpDeviceNetworks(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, fn func(*NiciraNvpDeviceNetwork) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNiciraNvpDeviceNetworks", p.URLValues(), "niciranvpdevicenetwork", func(b json.RawMessage) error {
+		var v NiciraNvpDeviceNetwork
+		if err := s.cs.unmarshalElement("listNiciraNvpDeviceNetworks", "niciranvpdevicenetwork", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListNiciraNvpDeviceNetworksResponse struct {
+	Count                   int                        `json:"count" xml:"count"`
+	NiciraNvpDeviceNetworks []*NiciraNvpDeviceNetwork  `json:"niciranvpdevicenetwork" xml:"niciranvpdevicenetwork"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type NiciraNvpDeviceNetwork struct {
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Strechedl2subnet            Bool                       `json:"strechedl2subnet,omitempty" xml:"strechedl2subnet,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Zonesnetworkspans           []string                   `json:"zonesnetworkspans,omitempty" xml:"zonesnetworkspans,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListSupportedNetworkServicesParams struct {
-	p map[string]interface{}
+type ListPaloAltoFirewallNetworksParams struct {
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPaloAltoFirewallNetworksParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["service"]; found {
-		u.Set("service", v.(string))
-	}
 	return u
 }
 
-func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListPaloAltoFirewallNetworksParams) Command() string {
+	return "listPaloAltoFirewallNetworks"
 }
 
-func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListPaloAltoFirewallNetworksParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPaloAltoFirewallNetworksParams) ResponseType() interface{} {
+	return &ListPaloAltoFirewallNetworksResponse{}
 }
 
-func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPaloAltoFirewallNetworksParams) Validate() error {
+	v := &validator{api: "listPaloAltoFirewallNetworks"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListSupportedNetworkServicesParams) SetService(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPaloAltoFirewallNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["service"] = v
-	return
+	return *p.Keyword
 }
 
-// You should always use this function to get a new ListSupportedNetworkServicesParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams {
-	p := &ListSupportedNetworkServicesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListPaloAltoFirewallNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-// Lists all network services provided by CloudStack or for the given Provider.
-func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
-	resp, err := s.cs.newRequest("listSupportedNetworkServices", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListPaloAltoFirewallNetworksParams) ResetKeyword() {
+	p.Keyword = nil
+}
 
-	var r ListSupportedNetworkServicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// This param is required.
+func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
+	return
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Lbdeviceid
 }
 
-type ListSupportedNetworkServicesResponse struct {
-	Count                    int                        `json:"count"`
-	SupportedNetworkServices []*SupportedNetworkService `json:"supportednetworkservice"`
+func (p *ListPaloAltoFirewallNetworksParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
 }
 
-type SupportedNetworkService struct {
-	Capability []struct {
-		Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-		Name                       string `json:"name,omitempty"`
-		Value                      string `json:"value,omitempty"`
-	} `json:"capability,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Provider []struct {
-		Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-		Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-		Id                           string   `json:"id,omitempty"`
-		Name                         string   `json:"name,omitempty"`
-		Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-		Servicelist                  []string `json:"servicelist,omitempty"`
-		State                        string   `json:"state,omitempty"`
-	} `json:"provider,omitempty"`
+func (p *ListPaloAltoFirewallNetworksParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
 }
 
-type AddNetworkServiceProviderParams struct {
-	p map[string]interface{}
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
+	p.Page = &v
+	return
 }
 
-func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["destinationphysicalnetworkid"]; found {
-		u.Set("destinationphysicalnetworkid", v.(string))
+func (p *ListPaloAltoFirewallNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["servicelist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("servicelist", vv)
-	}
-	return u
+	return *p.Page
 }
 
-func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destinationphysicalnetworkid"] = v
-	return
+func (p *ListPaloAltoFirewallNetworksParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *AddNetworkServiceProviderParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *ListPaloAltoFirewallNetworksParams) ResetPage() {
+	p.Page = nil
 }
 
-func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPaloAltoFirewallNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["servicelist"] = v
-	return
+	return *p.Pagesize
 }
 
-// You should always use this function to get a new AddNetworkServiceProviderParams instance,
+func (p *ListPaloAltoFirewallNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPaloAltoFirewallNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListPaloAltoFirewallNetworksParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams {
-	p := &AddNetworkServiceProviderParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["physicalnetworkid"] = physicalnetworkid
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams {
+	p := &ListPaloAltoFirewallNetworksParams{}
+	p.SetLbdeviceid(lbdeviceid)
 	return p
 }
 
-// Adds a network serviceProvider to a physical network
-func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("addNetworkServiceProvider", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, error) {
+	p := &ListPaloAltoFirewallNetworksParams{}
+
+	p.SetKeyword(keyword)
+	p.SetLbdeviceid(lbdeviceid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListPaloAltoFirewallNetworks(p)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var r AddNetworkServiceProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
+	if l.Count == 1 {
+		return l.PaloAltoFirewallNetworks[0].Id, nil
+	}
+
+	var matches []*PaloAltoFirewallNetwork
+	for _, v := range l.PaloAltoFirewallNetworks {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "PaloAltoFirewallNetwork", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
 		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
+// lists network that are using Palo Alto firewall device
+//
+// See also GetPaloAltoFirewallNetworkID.
+func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListPaloAltoFirewallNetworksResponse
+	if err := s.cs.unmarshal("listPaloAltoFirewallNetworks", resp, &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
-type AddNetworkServiceProviderResponse struct {
-	JobID                        string   `json:"jobid,omitempty"`
-	Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-	Id                           string   `json:"id,omitempty"`
-	Name                         string   `json:"name,omitempty"`
-	Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-	Servicelist                  []string `json:"servicelist,omitempty"`
-	State                        string   `json:"state,omitempty"`
-}
+// Lists the objects like ListPaloAltoFirewallNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamPaloAltoFirewallNetworks(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, fn func(*PaloAltoFirewallNetwork) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type DeleteNetworkServiceProviderParams struct {
-	p map[string]interface{}
+	return s.cs.streamList(ctx, "listPaloAltoFirewallNetworks", p.URLValues(), "paloaltofirewallnetwork", func(b json.RawMessage) error {
+		var v PaloAltoFirewallNetwork
+		if err := s.cs.unmarshalElement("listPaloAltoFirewallNetworks", "paloaltofirewallnetwork", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+type ListPaloAltoFirewallNetworksResponse struct {
+	Count                    int                        `json:"count" xml:"count"`
+	PaloAltoFirewallNetworks []*PaloAltoFirewallNetwork `json:"paloaltofirewallnetwork" xml:"paloaltofirewallnetwork"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type PaloAltoFirewallNetwork struct {
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Strechedl2subnet            Bool                       `json:"strechedl2subnet,omitempty" xml:"strechedl2subnet,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Zonesnetworkspans           []string                   `json:"zonesnetworkspans,omitempty" xml:"zonesnetworkspans,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreatePhysicalNetworkParams = cloudstackcommon.CreatePhysicalNetworkParams
+type CreatePhysicalNetworkResponse = cloudstackcommon.CreatePhysicalNetworkResponse
 
-// You should always use this function to get a new DeleteNetworkServiceProviderParams instance,
+// You should always use this function to get a new CreatePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams {
-	p := &DeleteNetworkServiceProviderParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - name
+//   - zoneid: the ID of the zone
+func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams {
+	p := &CreatePhysicalNetworkParams{}
+	p.SetName(name)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Deletes a Network Service Provider.
-func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkServiceProvider", p.toURLValues())
+// Creates a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPhysicalNetworks, GetPhysicalNetworkID, GetPhysicalNetworkByName, GetPhysicalNetworkByID.
+func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createPhysicalNetwork", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteNetworkServiceProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreatePhysicalNetworkResponse
+	if err := s.cs.unmarshal("createPhysicalNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2300,115 +3792,100 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createPhysicalNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteNetworkServiceProviderResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListNetworkServiceProvidersParams struct {
-	p map[string]interface{}
-}
+type DeletePhysicalNetworkParams = cloudstackcommon.DeletePhysicalNetworkParams
+type DeletePhysicalNetworkResponse = cloudstackcommon.DeletePhysicalNetworkResponse
 
-func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	return u
+// You should always use this function to get a new DeletePhysicalNetworkParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams {
+	p := &DeletePhysicalNetworkParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Deletes a Physical Network.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPhysicalNetworks, GetPhysicalNetworkID, GetPhysicalNetworkByName, GetPhysicalNetworkByID.
+func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListNetworkServiceProvidersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deletePhysicalNetwork", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeletePhysicalNetworkResponse
+	if err := s.cs.unmarshal("deletePhysicalNetwork", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("deletePhysicalNetwork", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["physicalnetworkid"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListNetworkServiceProvidersParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
-}
+type ListPhysicalNetworksParams = cloudstackcommon.ListPhysicalNetworksParams
+type ListPhysicalNetworksResponse = cloudstackcommon.ListPhysicalNetworksResponse
+type PhysicalNetwork = cloudstackcommon.PhysicalNetwork
 
-// You should always use this function to get a new ListNetworkServiceProvidersParams instance,
+// You should always use this function to get a new ListPhysicalNetworksParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams {
-	p := &ListNetworkServiceProvidersParams{}
-	p.p = make(map[string]interface{})
+func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksParams {
+	p := &ListPhysicalNetworksParams{}
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNetworkServiceProviderID(name string) (string, error) {
-	p := &ListNetworkServiceProvidersParams{}
-	p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListPhysicalNetworksParams{}
 
-	p.p["name"] = name
+	p.SetName(name)
 
-	l, err := s.ListNetworkServiceProviders(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListPhysicalNetworks(p)
 	if err != nil {
 		return "", err
 	}
@@ -2418,112 +3895,151 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string) (string, error
 	}
 
 	if l.Count == 1 {
-		return l.NetworkServiceProviders[0].Id, nil
+		return l.PhysicalNetworks[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.NetworkServiceProviders {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*PhysicalNetwork
+	for _, v := range l.PhysicalNetworks {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "PhysicalNetwork", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-// Lists network serviceproviders for a given physical network.
-func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkServiceProviders", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	id, err := s.GetPhysicalNetworkID(name, opts...)
 	if err != nil {
-		return nil, err
+		return nil, -1, err
 	}
 
-	var r ListNetworkServiceProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	r, count, err := s.GetPhysicalNetworkByID(id, opts...)
+	if err != nil {
+		return nil, count, err
 	}
-	return &r, nil
-}
-
-type ListNetworkServiceProvidersResponse struct {
-	Count                   int                       `json:"count"`
-	NetworkServiceProviders []*NetworkServiceProvider `json:"networkserviceprovider"`
+	return r, count, nil
 }
 
-type NetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-	Id                           string   `json:"id,omitempty"`
-	Name                         string   `json:"name,omitempty"`
-	Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-	Servicelist                  []string `json:"servicelist,omitempty"`
-	State                        string   `json:"state,omitempty"`
-}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	p := &ListPhysicalNetworksParams{}
 
-type UpdateNetworkServiceProviderParams struct {
-	p map[string]interface{}
-}
+	p.SetId(id)
 
-func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	l, err := s.ListPhysicalNetworks(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	if v, found := p.p["servicelist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("servicelist", vv)
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+
+	if l.Count == 1 {
+		return l.PhysicalNetworks[0], l.Count, nil
 	}
-	return u
+	return nil, l.Count, fmt.Errorf("There is more then one result for PhysicalNetwork UUID: %s!", id)
 }
 
-func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists physical networks
+//
+// See also GetPhysicalNetworkID, GetPhysicalNetworkByName, GetPhysicalNetworkByID.
+func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listPhysicalNetworks", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["servicelist"] = v
-	return
+
+	var r ListPhysicalNetworksResponse
+	if err := s.cs.unmarshal("listPhysicalNetworks", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListPhysicalNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamPhysicalNetworks(ctx context.Context, p *ListPhysicalNetworksParams, fn func(*PhysicalNetwork) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["state"] = v
-	return
+
+	return s.cs.streamList(ctx, "listPhysicalNetworks", p.URLValues(), "physicalnetwork", func(b json.RawMessage) error {
+		var v PhysicalNetwork
+		if err := s.cs.unmarshalElement("listPhysicalNetworks", "physicalnetwork", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new UpdateNetworkServiceProviderParams instance,
+type UpdatePhysicalNetworkParams = cloudstackcommon.UpdatePhysicalNetworkParams
+type UpdatePhysicalNetworkResponse = cloudstackcommon.UpdatePhysicalNetworkResponse
+
+// You should always use this function to get a new UpdatePhysicalNetworkParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams {
-	p := &UpdateNetworkServiceProviderParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams {
+	p := &UpdatePhysicalNetworkParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates a network serviceProvider of a physical network
-func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkServiceProvider", p.toURLValues())
+// Updates a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPhysicalNetworks, GetPhysicalNetworkID, GetPhysicalNetworkByName, GetPhysicalNetworkByID.
+func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updatePhysicalNetwork", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateNetworkServiceProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdatePhysicalNetworkResponse
+	if err := s.cs.unmarshal("updatePhysicalNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2544,124 +4060,424 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updatePhysicalNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateNetworkServiceProviderResponse struct {
-	JobID                        string   `json:"jobid,omitempty"`
-	Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-	Id                           string   `json:"id,omitempty"`
-	Name                         string   `json:"name,omitempty"`
-	Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-	Servicelist                  []string `json:"servicelist,omitempty"`
-	State                        string   `json:"state,omitempty"`
-}
+type DedicatePublicIpRangeParams = cloudstackcommon.DedicatePublicIpRangeParams
+type DedicatePublicIpRangeResponse = cloudstackcommon.DedicatePublicIpRangeResponse
 
-type CreateStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new DedicatePublicIpRangeParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - account: the name of the account
+//   - domainid: the ID of the domain
+//   - id
+func (s *NetworkService) NewDedicatePublicIpRangeParams(account string, domainid string, id string) *DedicatePublicIpRangeParams {
+	p := &DedicatePublicIpRangeParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetId(id)
+	return p
 }
 
-func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
-	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
+// Dedicates a Public IP range to an account
+func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+
+	resp, err := s.cs.newRequest("dedicatePublicIpRange", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
+
+	var r DedicatePublicIpRangeResponse
+	if err := s.cs.unmarshal("dedicatePublicIpRange", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["vlan"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("vlan", vv)
+	return &r, nil
+}
+
+type ReleasePublicIpRangeParams = cloudstackcommon.ReleasePublicIpRangeParams
+type ReleasePublicIpRangeResponse = cloudstackcommon.ReleasePublicIpRangeResponse
+
+// You should always use this function to get a new ReleasePublicIpRangeParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams {
+	p := &ReleasePublicIpRangeParams{}
+	p.SetId(id)
+	return p
+}
+
+// Releases a Public IP range back to the system pool
+func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return u
+
+	resp, err := s.cs.newRequest("releasePublicIpRange", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ReleasePublicIpRangeResponse
+	if err := s.cs.unmarshal("releasePublicIpRange", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type ListSrxFirewallNetworksParams struct {
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSrxFirewallNetworksParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
-	p.p["endip"] = v
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSrxFirewallNetworksParams) Command() string {
+	return "listSrxFirewallNetworks"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSrxFirewallNetworksParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSrxFirewallNetworksParams) ResponseType() interface{} {
+	return &ListSrxFirewallNetworksResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSrxFirewallNetworksParams) Validate() error {
+	v := &validator{api: "listSrxFirewallNetworks"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSrxFirewallNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["gateway"] = v
+	return *p.Keyword
+}
+
+func (p *ListSrxFirewallNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSrxFirewallNetworksParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// This param is required.
+func (p *ListSrxFirewallNetworksParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
 	return
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallNetworksParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
 	}
-	p.p["netmask"] = v
+	return *p.Lbdeviceid
+}
+
+func (p *ListSrxFirewallNetworksParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *ListSrxFirewallNetworksParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSrxFirewallNetworksParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["podid"] = v
+	return *p.Page
+}
+
+func (p *ListSrxFirewallNetworksParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSrxFirewallNetworksParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSrxFirewallNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["startip"] = v
-	return
+	return *p.Pagesize
+}
+
+func (p *ListSrxFirewallNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSrxFirewallNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListSrxFirewallNetworksParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lbdeviceid
+func (s *NetworkService) NewListSrxFirewallNetworksParams(lbdeviceid string) *ListSrxFirewallNetworksParams {
+	p := &ListSrxFirewallNetworksParams{}
+	p.SetLbdeviceid(lbdeviceid)
+	return p
 }
 
-func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkService) GetSrxFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, error) {
+	p := &ListSrxFirewallNetworksParams{}
+
+	p.SetKeyword(keyword)
+	p.SetLbdeviceid(lbdeviceid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	p.p["vlan"] = v
-	return
+
+	l, err := s.ListSrxFirewallNetworks(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
+
+	if l.Count == 1 {
+		return l.SrxFirewallNetworks[0].Id, nil
+	}
+
+	var matches []*SrxFirewallNetwork
+	for _, v := range l.SrxFirewallNetworks {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "SrxFirewallNetwork", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
+// lists network that are using SRX firewall device
+//
+// Only supported by CloudStack 4.3.
+// See also GetSrxFirewallNetworkID.
+func (s *NetworkService) ListSrxFirewallNetworks(p *ListSrxFirewallNetworksParams) (*ListSrxFirewallNetworksResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSrxFirewallNetworks", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSrxFirewallNetworksResponse
+	if err := s.cs.unmarshal("listSrxFirewallNetworks", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListSrxFirewallNetworks, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamSrxFirewallNetworks(ctx context.Context, p *ListSrxFirewallNetworksParams, fn func(*SrxFirewallNetwork) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listSrxFirewallNetworks", p.URLValues(), "srxfirewallnetwork", func(b json.RawMessage) error {
+		var v SrxFirewallNetwork
+		if err := s.cs.unmarshalElement("listSrxFirewallNetworks", "srxfirewallnetwork", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListSrxFirewallNetworksResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	SrxFirewallNetworks []*SrxFirewallNetwork      `json:"srxfirewallnetwork" xml:"srxfirewallnetwork"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SrxFirewallNetwork struct {
+	Account                     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid                       string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Acltype                     string                     `json:"acltype,omitempty" xml:"acltype,omitempty"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype,omitempty" xml:"broadcastdomaintype,omitempty"`
+	Broadcasturi                string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Canusefordeploy             Bool                       `json:"canusefordeploy,omitempty" xml:"canusefordeploy,omitempty"`
+	Cidr                        string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Displaynetwork              Bool                       `json:"displaynetwork,omitempty" xml:"displaynetwork,omitempty"`
+	Displaytext                 string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                        string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                        string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway                     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr                     string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway                  string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Isdefault                   Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent                Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Issystem                    Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Name                        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask                     string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkcidr                 string                     `json:"networkcidr,omitempty" xml:"networkcidr,omitempty"`
+	Networkdomain               string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networkofferingavailability string                     `json:"networkofferingavailability,omitempty" xml:"networkofferingavailability,omitempty"`
+	Networkofferingconservemode Bool                       `json:"networkofferingconservemode,omitempty" xml:"networkofferingconservemode,omitempty"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext,omitempty" xml:"networkofferingdisplaytext,omitempty"`
+	Networkofferingid           string                     `json:"networkofferingid,omitempty" xml:"networkofferingid,omitempty"`
+	Networkofferingname         string                     `json:"networkofferingname,omitempty" xml:"networkofferingname,omitempty"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Related                     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Reservediprange             string                     `json:"reservediprange,omitempty" xml:"reservediprange,omitempty"`
+	Restartrequired             Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service                     []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Specifyipranges             Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	State                       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Subdomainaccess             Bool                       `json:"subdomainaccess,omitempty" xml:"subdomainaccess,omitempty"`
+	Tags                        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype                 string                     `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type                        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Vlan                        string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid                       string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                      string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                    string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateStorageNetworkIpRangeParams = cloudstackcommon.CreateStorageNetworkIpRangeParams
+type CreateStorageNetworkIpRangeResponse = cloudstackcommon.CreateStorageNetworkIpRangeResponse
+
 // You should always use this function to get a new CreateStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - gateway
+//   - netmask: the netmask
+//   - podid: the ID of the pod
+//   - startip: the first IP address of the range
 func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams {
 	p := &CreateStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["gateway"] = gateway
-	p.p["netmask"] = netmask
-	p.p["podid"] = podid
-	p.p["startip"] = startip
+	p.SetGateway(gateway)
+	p.SetNetmask(netmask)
+	p.SetPodid(podid)
+	p.SetStartip(startip)
 	return p
 }
 
 // Creates a Storage network IP range.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListStorageNetworkIpRange, GetStorageNetworkIpRangeByID.
 func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createStorageNetworkIpRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createStorageNetworkIpRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2682,67 +4498,46 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createStorageNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateStorageNetworkIpRangeResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Endip     string `json:"endip,omitempty"`
-	Gateway   string `json:"gateway,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Netmask   string `json:"netmask,omitempty"`
-	Networkid string `json:"networkid,omitempty"`
-	Podid     string `json:"podid,omitempty"`
-	Startip   string `json:"startip,omitempty"`
-	Vlan      int    `json:"vlan,omitempty"`
-	Zoneid    string `json:"zoneid,omitempty"`
-}
-
-type DeleteStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteStorageNetworkIpRangeParams = cloudstackcommon.DeleteStorageNetworkIpRangeParams
+type DeleteStorageNetworkIpRangeResponse = cloudstackcommon.DeleteStorageNetworkIpRangeResponse
 
 // You should always use this function to get a new DeleteStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams {
 	p := &DeleteStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a storage network IP Range.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListStorageNetworkIpRange, GetStorageNetworkIpRangeByID.
 func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteStorageNetworkIpRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2758,113 +4553,35 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteStorageNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteStorageNetworkIpRangeResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListStorageNetworkIpRangeParams = cloudstackcommon.ListStorageNetworkIpRangeParams
+type ListStorageNetworkIpRangeResponse = cloudstackcommon.ListStorageNetworkIpRangeResponse
+type StorageNetworkIpRange = cloudstackcommon.StorageNetworkIpRange
 
 // You should always use this function to get a new ListStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams {
 	p := &ListStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetStorageNetworkIpRangeByID(id string) (*StorageNetworkIpRange, int, error) {
+func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
 	p := &ListStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListStorageNetworkIpRange(p)
 	if err != nil {
@@ -2887,122 +4604,79 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string) (*StorageNetwor
 }
 
 // List a storage network IP range.
+//
+// See also GetStorageNetworkIpRangeByID.
 func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListStorageNetworkIpRangeResponse struct {
-	Count                 int                      `json:"count"`
-	StorageNetworkIpRange []*StorageNetworkIpRange `json:"storagenetworkiprange"`
-}
-
-type StorageNetworkIpRange struct {
-	Endip     string `json:"endip,omitempty"`
-	Gateway   string `json:"gateway,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Netmask   string `json:"netmask,omitempty"`
-	Networkid string `json:"networkid,omitempty"`
-	Podid     string `json:"podid,omitempty"`
-	Startip   string `json:"startip,omitempty"`
-	Vlan      int    `json:"vlan,omitempty"`
-	Zoneid    string `json:"zoneid,omitempty"`
-}
-
-type UpdateStorageNetworkIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
-	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
-	}
-	if v, found := p.p["vlan"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("vlan", vv)
-	}
-	return u
-}
-
-func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
-	return
-}
-
-func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListStorageNetworkIpRange, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamStorageNetworkIpRange(ctx context.Context, p *ListStorageNetworkIpRangeParams, fn func(*StorageNetworkIpRange) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
-	return
+	return s.cs.streamList(ctx, "listStorageNetworkIpRange", p.URLValues(), "storagenetworkiprange", func(b json.RawMessage) error {
+		var v StorageNetworkIpRange
+		if err := s.cs.unmarshalElement("listStorageNetworkIpRange", "storagenetworkiprange", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
-	return
-}
-
-func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
-	return
-}
+type UpdateStorageNetworkIpRangeParams = cloudstackcommon.UpdateStorageNetworkIpRangeParams
+type UpdateStorageNetworkIpRangeResponse = cloudstackcommon.UpdateStorageNetworkIpRangeResponse
 
 // You should always use this function to get a new UpdateStorageNetworkIpRangeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams {
 	p := &UpdateStorageNetworkIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Update a Storage network IP range, only allowed when no IPs in this range have been allocated.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListStorageNetworkIpRange, GetStorageNetworkIpRangeByID.
 func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateStorageNetworkIpRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3023,685 +4697,59 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateStorageNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateStorageNetworkIpRangeResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Endip     string `json:"endip,omitempty"`
-	Gateway   string `json:"gateway,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Netmask   string `json:"netmask,omitempty"`
-	Networkid string `json:"networkid,omitempty"`
-	Podid     string `json:"podid,omitempty"`
-	Startip   string `json:"startip,omitempty"`
-	Vlan      int    `json:"vlan,omitempty"`
-	Zoneid    string `json:"zoneid,omitempty"`
-}
-
-type ListPaloAltoFirewallNetworksParams struct {
-	p map[string]interface{}
-}
+type ListSupportedNetworkServicesParams = cloudstackcommon.ListSupportedNetworkServicesParams
+type ListSupportedNetworkServicesResponse = cloudstackcommon.ListSupportedNetworkServicesResponse
+type SupportedNetworkService = cloudstackcommon.SupportedNetworkService
 
-func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListPaloAltoFirewallNetworksParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdeviceid"] = v
-	return
-}
-
-func (p *ListPaloAltoFirewallNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListPaloAltoFirewallNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListPaloAltoFirewallNetworksParams instance,
+// You should always use this function to get a new ListSupportedNetworkServicesParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams {
-	p := &ListPaloAltoFirewallNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
+func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams {
+	p := &ListSupportedNetworkServicesParams{}
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string) (string, error) {
-	p := &ListPaloAltoFirewallNetworksParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["keyword"] = keyword
-	p.p["lbdeviceid"] = lbdeviceid
-
-	l, err := s.ListPaloAltoFirewallNetworks(p)
-	if err != nil {
-		return "", err
-	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
-
-	if l.Count == 1 {
-		return l.PaloAltoFirewallNetworks[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.PaloAltoFirewallNetworks {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+// Lists all network services provided by CloudStack or for the given Provider.
+func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
-}
 
-// lists network that are using Palo Alto firewall device
-func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", p.toURLValues())
+	resp, err := s.cs.newRequest("listSupportedNetworkServices", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListPaloAltoFirewallNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListSupportedNetworkServicesResponse
+	if err := s.cs.unmarshal("listSupportedNetworkServices", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListPaloAltoFirewallNetworksResponse struct {
-	Count                    int                        `json:"count"`
-	PaloAltoFirewallNetworks []*PaloAltoFirewallNetwork `json:"paloaltofirewallnetwork"`
-}
-
-type PaloAltoFirewallNetwork struct {
-	Account                     string `json:"account,omitempty"`
-	Aclid                       string `json:"aclid,omitempty"`
-	Acltype                     string `json:"acltype,omitempty"`
-	Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-	Broadcasturi                string `json:"broadcasturi,omitempty"`
-	Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-	Cidr                        string `json:"cidr,omitempty"`
-	Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-	Displaytext                 string `json:"displaytext,omitempty"`
-	Dns1                        string `json:"dns1,omitempty"`
-	Dns2                        string `json:"dns2,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gateway                     string `json:"gateway,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Ip6cidr                     string `json:"ip6cidr,omitempty"`
-	Ip6gateway                  string `json:"ip6gateway,omitempty"`
-	Isdefault                   bool   `json:"isdefault,omitempty"`
-	Ispersistent                bool   `json:"ispersistent,omitempty"`
-	Issystem                    bool   `json:"issystem,omitempty"`
-	Name                        string `json:"name,omitempty"`
-	Netmask                     string `json:"netmask,omitempty"`
-	Networkcidr                 string `json:"networkcidr,omitempty"`
-	Networkdomain               string `json:"networkdomain,omitempty"`
-	Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-	Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-	Networkofferingid           string `json:"networkofferingid,omitempty"`
-	Networkofferingname         string `json:"networkofferingname,omitempty"`
-	Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-	Project                     string `json:"project,omitempty"`
-	Projectid                   string `json:"projectid,omitempty"`
-	Related                     string `json:"related,omitempty"`
-	Reservediprange             string `json:"reservediprange,omitempty"`
-	Restartrequired             bool   `json:"restartrequired,omitempty"`
-	Service                     []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-	State            string `json:"state,omitempty"`
-	Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-	Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-	Tags             []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype       string   `json:"traffictype,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Vlan              string   `json:"vlan,omitempty"`
-	Vpcid             string   `json:"vpcid,omitempty"`
-	Zoneid            string   `json:"zoneid,omitempty"`
-	Zonename          string   `json:"zonename,omitempty"`
-	Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-}
-
-type ListNetscalerLoadBalancerNetworksParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["lbdeviceid"]; found {
-		u.Set("lbdeviceid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListNetscalerLoadBalancerNetworksParams) SetLbdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbdeviceid"] = v
-	return
-}
-
-func (p *ListNetscalerLoadBalancerNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListNetscalerLoadBalancerNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListNetscalerLoadBalancerNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams {
-	p := &ListNetscalerLoadBalancerNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbdeviceid"] = lbdeviceid
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string) (string, error) {
-	p := &ListNetscalerLoadBalancerNetworksParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["keyword"] = keyword
-	p.p["lbdeviceid"] = lbdeviceid
-
-	l, err := s.ListNetscalerLoadBalancerNetworks(p)
-	if err != nil {
-		return "", err
-	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
-
-	if l.Count == 1 {
-		return l.NetscalerLoadBalancerNetworks[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.NetscalerLoadBalancerNetworks {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+// Lists the objects like ListSupportedNetworkServices, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkService) StreamSupportedNetworkServices(ctx context.Context, p *ListSupportedNetworkServicesParams, fn func(*SupportedNetworkService) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
-}
-
-// lists network that are using a netscaler load balancer device
-func (s *NetworkService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListNetscalerLoadBalancerNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListNetscalerLoadBalancerNetworksResponse struct {
-	Count                         int                             `json:"count"`
-	NetscalerLoadBalancerNetworks []*NetscalerLoadBalancerNetwork `json:"netscalerloadbalancernetwork"`
-}
-
-type NetscalerLoadBalancerNetwork struct {
-	Account                     string `json:"account,omitempty"`
-	Aclid                       string `json:"aclid,omitempty"`
-	Acltype                     string `json:"acltype,omitempty"`
-	Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-	Broadcasturi                string `json:"broadcasturi,omitempty"`
-	Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-	Cidr                        string `json:"cidr,omitempty"`
-	Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-	Displaytext                 string `json:"displaytext,omitempty"`
-	Dns1                        string `json:"dns1,omitempty"`
-	Dns2                        string `json:"dns2,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gateway                     string `json:"gateway,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Ip6cidr                     string `json:"ip6cidr,omitempty"`
-	Ip6gateway                  string `json:"ip6gateway,omitempty"`
-	Isdefault                   bool   `json:"isdefault,omitempty"`
-	Ispersistent                bool   `json:"ispersistent,omitempty"`
-	Issystem                    bool   `json:"issystem,omitempty"`
-	Name                        string `json:"name,omitempty"`
-	Netmask                     string `json:"netmask,omitempty"`
-	Networkcidr                 string `json:"networkcidr,omitempty"`
-	Networkdomain               string `json:"networkdomain,omitempty"`
-	Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-	Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-	Networkofferingid           string `json:"networkofferingid,omitempty"`
-	Networkofferingname         string `json:"networkofferingname,omitempty"`
-	Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-	Project                     string `json:"project,omitempty"`
-	Projectid                   string `json:"projectid,omitempty"`
-	Related                     string `json:"related,omitempty"`
-	Reservediprange             string `json:"reservediprange,omitempty"`
-	Restartrequired             bool   `json:"restartrequired,omitempty"`
-	Service                     []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-	State            string `json:"state,omitempty"`
-	Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-	Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-	Tags             []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype       string   `json:"traffictype,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Vlan              string   `json:"vlan,omitempty"`
-	Vpcid             string   `json:"vpcid,omitempty"`
-	Zoneid            string   `json:"zoneid,omitempty"`
-	Zonename          string   `json:"zonename,omitempty"`
-	Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-}
-
-type ListNiciraNvpDeviceNetworksParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["nvpdeviceid"]; found {
-		u.Set("nvpdeviceid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListNiciraNvpDeviceNetworksParams) SetNvpdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nvpdeviceid"] = v
-	return
-}
 
-func (p *ListNiciraNvpDeviceNetworksParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListNiciraNvpDeviceNetworksParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListNiciraNvpDeviceNetworksParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams {
-	p := &ListNiciraNvpDeviceNetworksParams{}
-	p.p = make(map[string]interface{})
-	p.p["nvpdeviceid"] = nvpdeviceid
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string) (string, error) {
-	p := &ListNiciraNvpDeviceNetworksParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["keyword"] = keyword
-	p.p["nvpdeviceid"] = nvpdeviceid
-
-	l, err := s.ListNiciraNvpDeviceNetworks(p)
-	if err != nil {
-		return "", err
-	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
-
-	if l.Count == 1 {
-		return l.NiciraNvpDeviceNetworks[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.NiciraNvpDeviceNetworks {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	return s.cs.streamList(ctx, "listSupportedNetworkServices", p.URLValues(), "supportednetworkservice", func(b json.RawMessage) error {
+		var v SupportedNetworkService
+		if err := s.cs.unmarshalElement("listSupportedNetworkServices", "supportednetworkservice", b, &v); err != nil {
+			return err
 		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
-}
-
-// lists network that are using a nicira nvp device
-func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListNiciraNvpDeviceNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListNiciraNvpDeviceNetworksResponse struct {
-	Count                   int                       `json:"count"`
-	NiciraNvpDeviceNetworks []*NiciraNvpDeviceNetwork `json:"niciranvpdevicenetwork"`
-}
-
-type NiciraNvpDeviceNetwork struct {
-	Account                     string `json:"account,omitempty"`
-	Aclid                       string `json:"aclid,omitempty"`
-	Acltype                     string `json:"acltype,omitempty"`
-	Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-	Broadcasturi                string `json:"broadcasturi,omitempty"`
-	Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-	Cidr                        string `json:"cidr,omitempty"`
-	Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-	Displaytext                 string `json:"displaytext,omitempty"`
-	Dns1                        string `json:"dns1,omitempty"`
-	Dns2                        string `json:"dns2,omitempty"`
-	Domain                      string `json:"domain,omitempty"`
-	Domainid                    string `json:"domainid,omitempty"`
-	Gateway                     string `json:"gateway,omitempty"`
-	Id                          string `json:"id,omitempty"`
-	Ip6cidr                     string `json:"ip6cidr,omitempty"`
-	Ip6gateway                  string `json:"ip6gateway,omitempty"`
-	Isdefault                   bool   `json:"isdefault,omitempty"`
-	Ispersistent                bool   `json:"ispersistent,omitempty"`
-	Issystem                    bool   `json:"issystem,omitempty"`
-	Name                        string `json:"name,omitempty"`
-	Netmask                     string `json:"netmask,omitempty"`
-	Networkcidr                 string `json:"networkcidr,omitempty"`
-	Networkdomain               string `json:"networkdomain,omitempty"`
-	Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-	Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-	Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-	Networkofferingid           string `json:"networkofferingid,omitempty"`
-	Networkofferingname         string `json:"networkofferingname,omitempty"`
-	Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-	Project                     string `json:"project,omitempty"`
-	Projectid                   string `json:"projectid,omitempty"`
-	Related                     string `json:"related,omitempty"`
-	Reservediprange             string `json:"reservediprange,omitempty"`
-	Restartrequired             bool   `json:"restartrequired,omitempty"`
-	Service                     []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-	State            string `json:"state,omitempty"`
-	Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-	Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-	Tags             []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Traffictype       string   `json:"traffictype,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Vlan              string   `json:"vlan,omitempty"`
-	Vpcid             string   `json:"vpcid,omitempty"`
-	Zoneid            string   `json:"zoneid,omitempty"`
-	Zonename          string   `json:"zonename,omitempty"`
-	Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-}
-
-type ListNetworkIsolationMethodsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListNetworkIsolationMethodsParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams {
-	p := &ListNetworkIsolationMethodsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Lists supported methods of network isolation
-func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListNetworkIsolationMethodsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListNetworkIsolationMethodsResponse struct {
-	Count                   int                       `json:"count"`
-	NetworkIsolationMethods []*NetworkIsolationMethod `json:"networkisolationmethod"`
-}
-
-type NetworkIsolationMethod struct {
-	Name string `json:"name,omitempty"`
+		return fn(&v)
+	})
 }
@@ -17,63 +17,54 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
-)
 
-type AddIpToNicParams struct {
-	p map[string]interface{}
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddIpToNicParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
-	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
-	}
-	return u
+type NicService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddIpToNicParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
-	return
+func NewNicService(cs *CloudStackClient) *NicService {
+	return &NicService{cs: cs}
 }
 
-func (p *AddIpToNicParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicid"] = v
-	return
-}
+type RemoveIpFromNicParams = cloudstackcommon.RemoveIpFromNicParams
+type RemoveIpFromNicResponse = cloudstackcommon.RemoveIpFromNicResponse
 
-// You should always use this function to get a new AddIpToNicParams instance,
+// You should always use this function to get a new RemoveIpFromNicParams instance,
 // as then you are sure you have configured all required params
-func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
-	p := &AddIpToNicParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
+//
+// The required params are:
+//
+//   - id
+func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams {
+	p := &RemoveIpFromNicParams{}
+	p.SetId(id)
 	return p
 }
 
-// Assigns secondary IP to NIC
-func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
-	resp, err := s.cs.newRequest("addIpToNic", p.toURLValues())
+// Removes secondary IP from the NIC.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeIpFromNic", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddIpToNicResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RemoveIpFromNicResponse
+	if err := s.cs.unmarshal("removeIpFromNic", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -89,68 +80,45 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("removeIpFromNic", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddIpToNicResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Ipaddress        string `json:"ipaddress,omitempty"`
-	Networkid        string `json:"networkid,omitempty"`
-	Nicid            string `json:"nicid,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-}
+type AddIpToNicParams = cloudstackcommon.AddIpToNicParams
+type AddIpToNicResponse = cloudstackcommon.AddIpToNicResponse
 
-type RemoveIpFromNicParams struct {
-	p map[string]interface{}
-}
-
-func (p *RemoveIpFromNicParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *RemoveIpFromNicParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new RemoveIpFromNicParams instance,
+// You should always use this function to get a new AddIpToNicParams instance,
 // as then you are sure you have configured all required params
-func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams {
-	p := &RemoveIpFromNicParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - nicid
+func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
+	p := &AddIpToNicParams{}
+	p.SetNicid(nicid)
 	return p
 }
 
-// Removes secondary IP from the NIC.
-func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
-	resp, err := s.cs.newRequest("removeIpFromNic", p.toURLValues())
+// Assigns secondary IP to NIC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addIpToNic", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveIpFromNicResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddIpToNicResponse
+	if err := s.cs.unmarshal("addIpToNic", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -166,156 +134,302 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("addIpToNic", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveIpFromNicResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type ListNicsParams struct {
-	p map[string]interface{}
+	Fordisplay       *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Networkid        *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Nicid            *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *ListNicsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNicsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+	if v := p.Nicid; v != nil {
+		u.Set("nicid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListNicsParams) Command() string {
+	return "listNics"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNicsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNicsParams) ResponseType() interface{} {
+	return &ListNicsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNicsParams) Validate() error {
+	v := &validator{api: "listNics"}
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
 func (p *ListNicsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListNicsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNicsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Fordisplay
+}
+
+func (p *ListNicsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListNicsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNicsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListNicsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNicsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["networkid"] = v
+	return *p.Keyword
+}
+
+func (p *ListNicsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNicsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'networkid' param: the ID of the network. Only supported by CloudStack 4.4.
+func (p *ListNicsParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *ListNicsParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNicsParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["nicid"] = v
+	return *p.Networkid
+}
+
+func (p *ListNicsParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListNicsParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+func (p *ListNicsParams) SetNicid(v string) {
+	p.Nicid = &v
 	return
 }
 
-func (p *ListNicsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNicsParams) GetNicid() string {
+	if p.Nicid == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Nicid
+}
+
+func (p *ListNicsParams) HasNicid() bool {
+	return p.Nicid != nil
+}
+
+func (p *ListNicsParams) ResetNicid() {
+	p.Nicid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNicsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListNicsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNicsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListNicsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNicsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNicsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListNicsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNicsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListNicsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNicsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *ListNicsParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *ListNicsParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *ListNicsParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListNicsParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new ListNicsParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - virtualmachineid: the ID of the virtual machine
 func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams {
 	p := &ListNicsParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // list the vm nics  IP to NIC
 func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
-	resp, err := s.cs.newRequest("listNics", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNics", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNicsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNics", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListNics, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NicService) StreamNics(ctx context.Context, p *ListNicsParams, fn func(*Nic) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNics", p.URLValues(), "nic", func(b json.RawMessage) error {
+		var v Nic
+		if err := s.cs.unmarshalElement("listNics", "nic", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListNicsResponse struct {
-	Count int    `json:"count"`
-	Nics  []*Nic `json:"nic"`
+	Count int                        `json:"count" xml:"count"`
+	Nics  []*Nic                     `json:"nic" xml:"nic"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type Nic struct {
-	Broadcasturi     string   `json:"broadcasturi,omitempty"`
-	Deviceid         string   `json:"deviceid,omitempty"`
-	Gateway          string   `json:"gateway,omitempty"`
-	Id               string   `json:"id,omitempty"`
-	Ip6address       string   `json:"ip6address,omitempty"`
-	Ip6cidr          string   `json:"ip6cidr,omitempty"`
-	Ip6gateway       string   `json:"ip6gateway,omitempty"`
-	Ipaddress        string   `json:"ipaddress,omitempty"`
-	Isdefault        bool     `json:"isdefault,omitempty"`
-	Isolationuri     string   `json:"isolationuri,omitempty"`
-	Macaddress       string   `json:"macaddress,omitempty"`
-	Netmask          string   `json:"netmask,omitempty"`
-	Networkid        string   `json:"networkid,omitempty"`
-	Networkname      string   `json:"networkname,omitempty"`
-	Secondaryip      []string `json:"secondaryip,omitempty"`
-	Traffictype      string   `json:"traffictype,omitempty"`
-	Type             string   `json:"type,omitempty"`
-	Virtualmachineid string   `json:"virtualmachineid,omitempty"`
+	Broadcasturi     string                     `json:"broadcasturi,omitempty" xml:"broadcasturi,omitempty"`
+	Deviceid         string                     `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	Gateway          string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6address       string                     `json:"ip6address,omitempty" xml:"ip6address,omitempty"`
+	Ip6cidr          string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway       string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isdefault        Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Isolationuri     string                     `json:"isolationuri,omitempty" xml:"isolationuri,omitempty"`
+	Macaddress       string                     `json:"macaddress,omitempty" xml:"macaddress,omitempty"`
+	Netmask          string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid        string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Networkname      string                     `json:"networkname,omitempty" xml:"networkname,omitempty"`
+	Secondaryip      []string                   `json:"secondaryip,omitempty" xml:"secondaryip,omitempty"`
+	Traffictype      NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Type             string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -17,111 +17,61 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
-	"strconv"
-)
-
-type AddNiciraNvpDeviceParams struct {
-	p map[string]interface{}
-}
 
-func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
-	}
-	if v, found := p.p["l3gatewayserviceuuid"]; found {
-		u.Set("l3gatewayserviceuuid", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["transportzoneuuid"]; found {
-		u.Set("transportzoneuuid", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
-}
-
-func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
-	return
-}
-
-func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["l3gatewayserviceuuid"] = v
-	return
-}
-
-func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
+type NiciraNVPService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["transportzoneuuid"] = v
-	return
+func NewNiciraNVPService(cs *CloudStackClient) *NiciraNVPService {
+	return &NiciraNVPService{cs: cs}
 }
 
-func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
+type AddNiciraNvpDeviceParams = cloudstackcommon.AddNiciraNvpDeviceParams
+type AddNiciraNvpDeviceResponse = cloudstackcommon.AddNiciraNvpDeviceResponse
 
 // You should always use this function to get a new AddNiciraNvpDeviceParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hostname
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - transportzoneuuid
+//   - username: the name of the user
 func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams {
 	p := &AddNiciraNvpDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["transportzoneuuid"] = transportzoneuuid
-	p.p["username"] = username
+	p.SetHostname(hostname)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetTransportzoneuuid(transportzoneuuid)
+	p.SetUsername(username)
 	return p
 }
 
 // Adds a Nicira NVP device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNiciraNvpDevices.
 func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addNiciraNvpDevice", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addNiciraNvpDevice", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNiciraNvpDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addNiciraNvpDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -142,65 +92,46 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addNiciraNvpDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddNiciraNvpDeviceResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	L3gatewayserviceuuid string `json:"l3gatewayserviceuuid,omitempty"`
-	Niciradevicename     string `json:"niciradevicename,omitempty"`
-	Nvpdeviceid          string `json:"nvpdeviceid,omitempty"`
-	Physicalnetworkid    string `json:"physicalnetworkid,omitempty"`
-	Provider             string `json:"provider,omitempty"`
-	Transportzoneuuid    string `json:"transportzoneuuid,omitempty"`
-}
-
-type DeleteNiciraNvpDeviceParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["nvpdeviceid"]; found {
-		u.Set("nvpdeviceid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nvpdeviceid"] = v
-	return
-}
+type DeleteNiciraNvpDeviceParams = cloudstackcommon.DeleteNiciraNvpDeviceParams
+type DeleteNiciraNvpDeviceResponse = cloudstackcommon.DeleteNiciraNvpDeviceResponse
 
 // You should always use this function to get a new DeleteNiciraNvpDeviceParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - nvpdeviceid
 func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams {
 	p := &DeleteNiciraNvpDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["nvpdeviceid"] = nvpdeviceid
+	p.SetNvpdeviceid(nvpdeviceid)
 	return p
 }
 
-//  delete a nicira nvp device
+//	delete a nicira nvp device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListNiciraNvpDevices.
 func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNiciraNvpDevice", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNiciraNvpDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteNiciraNvpDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -216,121 +147,59 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteNiciraNvpDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteNiciraNvpDeviceResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListNiciraNvpDevicesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["nvpdeviceid"]; found {
-		u.Set("nvpdeviceid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	return u
-}
-
-func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nvpdeviceid"] = v
-	return
-}
-
-func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
+type ListNiciraNvpDevicesParams = cloudstackcommon.ListNiciraNvpDevicesParams
+type ListNiciraNvpDevicesResponse = cloudstackcommon.ListNiciraNvpDevicesResponse
+type NiciraNvpDevice = cloudstackcommon.NiciraNvpDevice
 
 // You should always use this function to get a new ListNiciraNvpDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams {
 	p := &ListNiciraNvpDevicesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists Nicira NVP devices
 func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDevices", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNiciraNvpDevices", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNiciraNvpDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNiciraNvpDevices", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListNiciraNvpDevicesResponse struct {
-	Count            int                `json:"count"`
-	NiciraNvpDevices []*NiciraNvpDevice `json:"niciranvpdevice"`
-}
+// Lists the objects like ListNiciraNvpDevices, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NiciraNVPService) StreamNiciraNvpDevices(ctx context.Context, p *ListNiciraNvpDevicesParams, fn func(*NiciraNvpDevice) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type NiciraNvpDevice struct {
-	Hostname             string `json:"hostname,omitempty"`
-	L3gatewayserviceuuid string `json:"l3gatewayserviceuuid,omitempty"`
-	Niciradevicename     string `json:"niciradevicename,omitempty"`
-	Nvpdeviceid          string `json:"nvpdeviceid,omitempty"`
-	Physicalnetworkid    string `json:"physicalnetworkid,omitempty"`
-	Provider             string `json:"provider,omitempty"`
-	Transportzoneuuid    string `json:"transportzoneuuid,omitempty"`
+	return s.cs.streamList(ctx, "listNiciraNvpDevices", p.URLValues(), "niciranvpdevice", func(b json.RawMessage) error {
+		var v NiciraNvpDevice
+		if err := s.cs.unmarshalElement("listNiciraNvpDevices", "niciranvpdevice", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
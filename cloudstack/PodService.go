@@ -17,457 +17,296 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreatePodParams struct {
-	p map[string]interface{}
+type PodService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreatePodParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
-	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
-	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func NewPodService(cs *CloudStackClient) *PodService {
+	return &PodService{cs: cs}
 }
 
-func (p *CreatePodParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
-}
+type ListDedicatedPodsParams = cloudstackcommon.ListDedicatedPodsParams
+type ListDedicatedPodsResponse = cloudstackcommon.ListDedicatedPodsResponse
+type DedicatedPod = cloudstackcommon.DedicatedPod
 
-func (p *CreatePodParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
-	return
+// You should always use this function to get a new ListDedicatedPodsParams instance,
+// as then you are sure you have configured all required params
+func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
+	p := &ListDedicatedPodsParams{}
+	return p
 }
 
-func (p *CreatePodParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists dedicated pods.
+func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["gateway"] = v
-	return
-}
 
-func (p *CreatePodParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listDedicatedPods", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *CreatePodParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListDedicatedPodsResponse
+	if err := s.cs.unmarshal("listDedicatedPods", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["netmask"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreatePodParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListDedicatedPods, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *PodService) StreamDedicatedPods(ctx context.Context, p *ListDedicatedPodsParams, fn func(*DedicatedPod) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["startip"] = v
-	return
-}
 
-func (p *CreatePodParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+	return s.cs.streamList(ctx, "listDedicatedPods", p.URLValues(), "dedicatedpod", func(b json.RawMessage) error {
+		var v DedicatedPod
+		if err := s.cs.unmarshalElement("listDedicatedPods", "dedicatedpod", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new CreatePodParams instance,
+type ReleaseDedicatedPodParams = cloudstackcommon.ReleaseDedicatedPodParams
+type ReleaseDedicatedPodResponse = cloudstackcommon.ReleaseDedicatedPodResponse
+
+// You should always use this function to get a new ReleaseDedicatedPodParams instance,
 // as then you are sure you have configured all required params
-func (s *PodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams {
-	p := &CreatePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["gateway"] = gateway
-	p.p["name"] = name
-	p.p["netmask"] = netmask
-	p.p["startip"] = startip
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - podid: the ID of the pod
+func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams {
+	p := &ReleaseDedicatedPodParams{}
+	p.SetPodid(podid)
 	return p
 }
 
-// Creates a new Pod.
-func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
-	resp, err := s.cs.newRequest("createPod", p.toURLValues())
+// Release the dedication for the pod
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListDedicatedPods.
+func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("releaseDedicatedPod", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreatePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ReleaseDedicatedPodResponse
+	if err := s.cs.unmarshal("releaseDedicatedPod", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
 
-type CreatePodResponse struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Endip    string `json:"endip,omitempty"`
-	Gateway  string `json:"gateway,omitempty"`
-	Id       string `json:"id,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Netmask  string `json:"netmask,omitempty"`
-	Startip  string `json:"startip,omitempty"`
-	Zoneid   string `json:"zoneid,omitempty"`
-	Zonename string `json:"zonename,omitempty"`
-}
-
-type UpdatePodParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdatePodParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
-	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
-	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
-	}
-	return u
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *UpdatePodParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("releaseDedicatedPod", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["allocationstate"] = v
-	return
+	return &r, nil
 }
 
-func (p *UpdatePodParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
-	return
-}
+type CreatePodParams = cloudstackcommon.CreatePodParams
+type CreatePodResponse = cloudstackcommon.CreatePodResponse
 
-func (p *UpdatePodParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
-	return
+// You should always use this function to get a new CreatePodParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - gateway
+//   - name
+//   - netmask: the netmask
+//   - startip: the first IP address of the range
+//   - zoneid: the ID of the zone
+func (s *PodService) NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams {
+	p := &CreatePodParams{}
+	p.SetGateway(gateway)
+	p.SetName(name)
+	p.SetNetmask(netmask)
+	p.SetStartip(startip)
+	p.SetZoneid(zoneid)
+	return p
 }
 
-func (p *UpdatePodParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Creates a new Pod.
+//
+// See also ListPods, GetPodID, GetPodByName, GetPodByID.
+func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *UpdatePodParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("createPod", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *UpdatePodParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r CreatePodResponse
+	if err := s.cs.unmarshal("createPod", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["netmask"] = v
-	return
+	return &r, nil
 }
 
-func (p *UpdatePodParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
-	return
-}
+type DedicatePodParams = cloudstackcommon.DedicatePodParams
+type DedicatePodResponse = cloudstackcommon.DedicatePodResponse
 
-// You should always use this function to get a new UpdatePodParams instance,
+// You should always use this function to get a new DedicatePodParams instance,
 // as then you are sure you have configured all required params
-func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
-	p := &UpdatePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - domainid: the ID of the domain
+//   - podid: the ID of the pod
+func (s *PodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
+	p := &DedicatePodParams{}
+	p.SetDomainid(domainid)
+	p.SetPodid(podid)
 	return p
 }
 
-// Updates a Pod.
-func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
-	resp, err := s.cs.newRequest("updatePod", p.toURLValues())
+// Dedicates a Pod.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPods, GetPodID, GetPodByName, GetPodByID.
+func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("dedicatePod", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdatePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DedicatePodResponse
+	if err := s.cs.unmarshal("dedicatePod", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
 
-type UpdatePodResponse struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Endip    string `json:"endip,omitempty"`
-	Gateway  string `json:"gateway,omitempty"`
-	Id       string `json:"id,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Netmask  string `json:"netmask,omitempty"`
-	Startip  string `json:"startip,omitempty"`
-	Zoneid   string `json:"zoneid,omitempty"`
-	Zonename string `json:"zonename,omitempty"`
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-type DeletePodParams struct {
-	p map[string]interface{}
-}
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-func (p *DeletePodParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+		if err := s.cs.unmarshal("dedicatePod", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	return u
+	return &r, nil
 }
 
-func (p *DeletePodParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeletePodParams = cloudstackcommon.DeletePodParams
+type DeletePodResponse = cloudstackcommon.DeletePodResponse
 
 // You should always use this function to get a new DeletePodParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
 	p := &DeletePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a Pod.
+//
+// See also ListPods, GetPodID, GetPodByName, GetPodByID.
 func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
-	resp, err := s.cs.newRequest("deletePod", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deletePod", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deletePod", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeletePodResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ListPodsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPodsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListPodsParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
-}
-
-func (p *ListPodsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListPodsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListPodsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListPodsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListPodsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListPodsParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
-	return
-}
-
-func (p *ListPodsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListPodsParams = cloudstackcommon.ListPodsParams
+type ListPodsResponse = cloudstackcommon.ListPodsResponse
+type Pod = cloudstackcommon.Pod
 
 // You should always use this function to get a new ListPodsParams instance,
 // as then you are sure you have configured all required params
 func (s *PodService) NewListPodsParams() *ListPodsParams {
 	p := &ListPodsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PodService) GetPodID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListPodsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListPods(p)
 	if err != nil {
@@ -482,24 +321,37 @@ func (s *PodService) GetPodID(name string) (string, error) {
 		return l.Pods[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Pods {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Pod
+	for _, v := range l.Pods {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Pod", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PodService) GetPodByName(name string) (*Pod, int, error) {
-	id, err := s.GetPodID(name)
+func (s *PodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error) {
+	id, err := s.GetPodID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetPodByID(id)
+	r, count, err := s.GetPodByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -507,11 +359,16 @@ func (s *PodService) GetPodByName(name string) (*Pod, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PodService) GetPodByID(id string) (*Pod, int, error) {
+func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error) {
 	p := &ListPodsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListPods(p)
 	if err != nil {
@@ -534,341 +391,79 @@ func (s *PodService) GetPodByID(id string) (*Pod, int, error) {
 }
 
 // Lists all Pods.
+//
+// See also GetPodID, GetPodByName, GetPodByID.
 func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
-	resp, err := s.cs.newRequest("listPods", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listPods", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPodsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listPods", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListPodsResponse struct {
-	Count int    `json:"count"`
-	Pods  []*Pod `json:"pod"`
-}
-
-type Pod struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Endip    string `json:"endip,omitempty"`
-	Gateway  string `json:"gateway,omitempty"`
-	Id       string `json:"id,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Netmask  string `json:"netmask,omitempty"`
-	Startip  string `json:"startip,omitempty"`
-	Zoneid   string `json:"zoneid,omitempty"`
-	Zonename string `json:"zonename,omitempty"`
-}
-
-type DedicatePodParams struct {
-	p map[string]interface{}
-}
-
-func (p *DedicatePodParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	return u
-}
-
-func (p *DedicatePodParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *DedicatePodParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *DedicatePodParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-// You should always use this function to get a new DedicatePodParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewDedicatePodParams(domainid string, podid string) *DedicatePodParams {
-	p := &DedicatePodParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["podid"] = podid
-	return p
-}
-
-// Dedicates a Pod.
-func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
-	resp, err := s.cs.newRequest("dedicatePod", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DedicatePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+// Lists the objects like ListPods, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *PodService) StreamPods(ctx context.Context, p *ListPodsParams, fn func(*Pod) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	return &r, nil
-}
-
-type DedicatePodResponse struct {
-	JobID           string `json:"jobid,omitempty"`
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Id              string `json:"id,omitempty"`
-	Podid           string `json:"podid,omitempty"`
-	Podname         string `json:"podname,omitempty"`
-}
-
-type ReleaseDedicatedPodParams struct {
-	p map[string]interface{}
-}
 
-func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	return u
+	return s.cs.streamList(ctx, "listPods", p.URLValues(), "pod", func(b json.RawMessage) error {
+		var v Pod
+		if err := s.cs.unmarshalElement("listPods", "pod", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
+type UpdatePodParams = cloudstackcommon.UpdatePodParams
+type UpdatePodResponse = cloudstackcommon.UpdatePodResponse
 
-// You should always use this function to get a new ReleaseDedicatedPodParams instance,
+// You should always use this function to get a new UpdatePodParams instance,
 // as then you are sure you have configured all required params
-func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams {
-	p := &ReleaseDedicatedPodParams{}
-	p.p = make(map[string]interface{})
-	p.p["podid"] = podid
+//
+// The required params are:
+//
+//   - id
+func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
+	p := &UpdatePodParams{}
+	p.SetId(id)
 	return p
 }
 
-// Release the dedication for the pod
-func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedPod", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ReleaseDedicatedPodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+// Updates a Pod.
+//
+// See also ListPods, GetPodID, GetPodByName, GetPodByID.
+func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
-
-type ReleaseDedicatedPodResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListDedicatedPodsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDedicatedPodsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	return u
-}
-
-func (p *ListDedicatedPodsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
 
-func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
-	return
-}
-
-func (p *ListDedicatedPodsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListDedicatedPodsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListDedicatedPodsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListDedicatedPodsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListDedicatedPodsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-// You should always use this function to get a new ListDedicatedPodsParams instance,
-// as then you are sure you have configured all required params
-func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
-	p := &ListDedicatedPodsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Lists dedicated pods.
-func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedPods", p.toURLValues())
+	resp, err := s.cs.newRequest("updatePod", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListDedicatedPodsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdatePodResponse
+	if err := s.cs.unmarshal("updatePod", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type ListDedicatedPodsResponse struct {
-	Count         int             `json:"count"`
-	DedicatedPods []*DedicatedPod `json:"dedicatedpod"`
-}
-
-type DedicatedPod struct {
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Id              string `json:"id,omitempty"`
-	Podid           string `json:"podid,omitempty"`
-	Podname         string `json:"podname,omitempty"`
-}
@@ -0,0 +1,544 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListDedicatedPods(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"domainid":        {"test-domainid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"5"},
+		"pagesize":        {"6"},
+		"podid":           {"test-podid"},
+	}
+	cs, done := newTestClient(t, "listDedicatedPods", params, `{"listdedicatedpodsresponse":{"count":1,"dedicatedpod":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","podid":"test-podid","podname":"test-podname"}]}}`)
+	defer done()
+
+	p := cs.Pod.NewListDedicatedPodsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetDomainid("test-domainid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetPodid("test-podid")
+	checkURLValues(t, "listDedicatedPods", p.URLValues(), params)
+
+	r, err := cs.Pod.ListDedicatedPods(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedPods: %v", err)
+	}
+	if r.DedicatedPods[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedPods[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedPods[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedPods" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDedicatedPods: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDedicatedPods: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDedicatedPods: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DedicatedPod
+	count, err := cs.Pod.StreamDedicatedPods(context.Background(), p, func(v *DedicatedPod) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDedicatedPods: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DedicatedPods) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DedicatedPods)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDedicatedPods", params, `<listdedicatedpodsresponse><count>1</count><dedicatedpod><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><domainid>test-domainid</domainid><id>test-id</id><podid>test-podid</podid><podname>test-podname</podname></dedicatedpod></listdedicatedpodsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.ListDedicatedPods(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedPods using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Pod.StreamDedicatedPods(context.Background(), p, func(v *DedicatedPod) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDedicatedPods using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DedicatedPods) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DedicatedPods)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleaseDedicatedPod(t *testing.T) {
+	params := url.Values{
+		"podid": {"test-podid"},
+	}
+	cs, done := newTestClient(t, "releaseDedicatedPod", params, `{"releasededicatedpodresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Pod.NewReleaseDedicatedPodParams("test-podid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetPodid("test-podid")
+	checkURLValues(t, "releaseDedicatedPod", p.URLValues(), params)
+
+	r, err := cs.Pod.ReleaseDedicatedPod(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedPod: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedPod" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releaseDedicatedPod: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releaseDedicatedPod: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releaseDedicatedPod: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releaseDedicatedPod", params, `<releasededicatedpodresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></releasededicatedpodresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.ReleaseDedicatedPod(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedPod using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreatePod(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"endip":           {"test-endip"},
+		"gateway":         {"test-gateway"},
+		"name":            {"test-name"},
+		"netmask":         {"test-netmask"},
+		"startip":         {"test-startip"},
+		"zoneid":          {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createPod", params, `{"createpodresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"endip":"test-endip","gateway":"test-gateway","id":"test-id","name":"test-name","netmask":"test-netmask","startip":"test-startip","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Pod.NewCreatePodParams("test-gateway", "test-name", "test-netmask", "test-startip", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetName("test-name")
+	p.SetNetmask("test-netmask")
+	p.SetStartip("test-startip")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createPod", p.URLValues(), params)
+
+	r, err := cs.Pod.CreatePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPod: %v", err)
+	}
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createPod" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createPod: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createPod: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createPod: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createPod", params, `<createpodresponse><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><name>test-name</name><netmask>test-netmask</netmask><startip>test-startip</startip><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createpodresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.CreatePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPod using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDedicatePod(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"podid":    {"test-podid"},
+	}
+	cs, done := newTestClient(t, "dedicatePod", params, `{"dedicatepodresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","podid":"test-podid","podname":"test-podname"}}`)
+	defer done()
+
+	p := cs.Pod.NewDedicatePodParams("test-domainid", "test-podid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPodid("test-podid")
+	checkURLValues(t, "dedicatePod", p.URLValues(), params)
+
+	r, err := cs.Pod.DedicatePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicatePod: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "dedicatePod" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute dedicatePod: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of dedicatePod: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of dedicatePod: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "dedicatePod", params, `<dedicatepodresponse><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><podid>test-podid</podid><podname>test-podname</podname></dedicatepodresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.DedicatePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicatePod using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeletePod(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deletePod", params, `{"deletepodresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Pod.NewDeletePodParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deletePod", p.URLValues(), params)
+
+	r, err := cs.Pod.DeletePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePod: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deletePod" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deletePod: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deletePod: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deletePod: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deletePod", params, `<deletepodresponse><displaytext>test-displaytext</displaytext><success>true</success></deletepodresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.DeletePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePod using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPods(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"id":              {"test-id"},
+		"keyword":         {"test-keyword"},
+		"name":            {"test-name"},
+		"page":            {"5"},
+		"pagesize":        {"6"},
+		"showcapacities":  {"true"},
+		"zoneid":          {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listPods", params, `{"listpodsresponse":{"count":1,"pod":[{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"endip":"test-endip","gateway":"test-gateway","id":"test-id","name":"test-name","netmask":"test-netmask","startip":"test-startip","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Pod.NewListPodsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetShowcapacities(true)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listPods", p.URLValues(), params)
+
+	r, err := cs.Pod.ListPods(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPods: %v", err)
+	}
+	if r.Pods[0].Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Pods[0].Allocationstate to be %v, got %v", "test-allocationstate", r.Pods[0].Allocationstate)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPods" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPods: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPods: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPods: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Pod
+	count, err := cs.Pod.StreamPods(context.Background(), p, func(v *Pod) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPods: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Pods) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Pods)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPods", params, `<listpodsresponse><count>1</count><pod><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><name>test-name</name><netmask>test-netmask</netmask><startip>test-startip</startip><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></pod></listpodsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.ListPods(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPods using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Pod.StreamPods(context.Background(), p, func(v *Pod) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPods using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Pods) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Pods)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdatePod(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"endip":           {"test-endip"},
+		"gateway":         {"test-gateway"},
+		"id":              {"test-id"},
+		"name":            {"test-name"},
+		"netmask":         {"test-netmask"},
+		"startip":         {"test-startip"},
+	}
+	cs, done := newTestClient(t, "updatePod", params, `{"updatepodresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"endip":"test-endip","gateway":"test-gateway","id":"test-id","name":"test-name","netmask":"test-netmask","startip":"test-startip","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Pod.NewUpdatePodParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetNetmask("test-netmask")
+	p.SetStartip("test-startip")
+	checkURLValues(t, "updatePod", p.URLValues(), params)
+
+	r, err := cs.Pod.UpdatePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call updatePod: %v", err)
+	}
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updatePod" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updatePod: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updatePod: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updatePod: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updatePod", params, `<updatepodresponse><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><name>test-name</name><netmask>test-netmask</netmask><startip>test-startip</startip><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatepodresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pod.UpdatePod(p)
+	if err != nil {
+		t.Fatalf("Failed to call updatePod using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,114 +17,63 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type CreatePortableIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
-	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
-	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
-	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
-	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
-	}
-	return u
-}
-
-func (p *CreatePortableIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
-	return
-}
 
-func (p *CreatePortableIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
-	return
-}
-
-func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
-	return
+type PortableIPService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreatePortableIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
-	return
+func NewPortableIPService(cs *CloudStackClient) *PortableIPService {
+	return &PortableIPService{cs: cs}
 }
 
-func (p *CreatePortableIpRangeParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
-	return
-}
+type CreatePortableIpRangeParams = cloudstackcommon.CreatePortableIpRangeParams
+type CreatePortableIpRangeResponse = cloudstackcommon.CreatePortableIpRangeResponse
 
 // You should always use this function to get a new CreatePortableIpRangeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - endip: the last IP address of the range
+//   - gateway
+//   - netmask: the netmask
+//   - regionid
+//   - startip: the first IP address of the range
 func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams {
 	p := &CreatePortableIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["endip"] = endip
-	p.p["gateway"] = gateway
-	p.p["netmask"] = netmask
-	p.p["regionid"] = regionid
-	p.p["startip"] = startip
+	p.SetEndip(endip)
+	p.SetGateway(gateway)
+	p.SetNetmask(netmask)
+	p.SetRegionid(regionid)
+	p.SetStartip(startip)
 	return p
 }
 
 // adds a range of portable public IP's to a region
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPortableIpRanges, GetPortableIpRangeByID.
 func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createPortableIpRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createPortableIpRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreatePortableIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createPortableIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -145,77 +94,46 @@ func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createPortableIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreatePortableIpRangeResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Endip             string `json:"endip,omitempty"`
-	Gateway           string `json:"gateway,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Netmask           string `json:"netmask,omitempty"`
-	Portableipaddress []struct {
-		Accountid         string `json:"accountid,omitempty"`
-		Allocated         string `json:"allocated,omitempty"`
-		Domainid          string `json:"domainid,omitempty"`
-		Ipaddress         string `json:"ipaddress,omitempty"`
-		Networkid         string `json:"networkid,omitempty"`
-		Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-		Regionid          int    `json:"regionid,omitempty"`
-		State             string `json:"state,omitempty"`
-		Vpcid             string `json:"vpcid,omitempty"`
-		Zoneid            string `json:"zoneid,omitempty"`
-	} `json:"portableipaddress,omitempty"`
-	Regionid int    `json:"regionid,omitempty"`
-	Startip  string `json:"startip,omitempty"`
-	Vlan     string `json:"vlan,omitempty"`
-}
-
-type DeletePortableIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeletePortableIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeletePortableIpRangeParams = cloudstackcommon.DeletePortableIpRangeParams
+type DeletePortableIpRangeResponse = cloudstackcommon.DeletePortableIpRangeResponse
 
 // You should always use this function to get a new DeletePortableIpRangeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *PortableIPService) NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams {
 	p := &DeletePortableIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // deletes a range of portable public IP's associated with a region
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPortableIpRanges, GetPortableIpRangeByID.
 func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deletePortableIpRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deletePortableIpRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePortableIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deletePortableIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -231,103 +149,35 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deletePortableIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeletePortableIpRangeResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListPortableIpRangesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPortableIpRangesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("regionid", vv)
-	}
-	return u
-}
-
-func (p *ListPortableIpRangesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListPortableIpRangesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListPortableIpRangesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListPortableIpRangesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListPortableIpRangesParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["regionid"] = v
-	return
-}
+type ListPortableIpRangesParams = cloudstackcommon.ListPortableIpRangesParams
+type ListPortableIpRangesResponse = cloudstackcommon.ListPortableIpRangesResponse
+type PortableIpRange = cloudstackcommon.PortableIpRange
 
 // You should always use this function to get a new ListPortableIpRangesParams instance,
 // as then you are sure you have configured all required params
 func (s *PortableIPService) NewListPortableIpRangesParams() *ListPortableIpRangesParams {
 	p := &ListPortableIpRangesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PortableIPService) GetPortableIpRangeByID(id string) (*PortableIpRange, int, error) {
+func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
 	p := &ListPortableIpRangesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListPortableIpRanges(p)
 	if err != nil {
@@ -350,42 +200,42 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string) (*PortableIpRange,
 }
 
 // list portable IP ranges
+//
+// See also GetPortableIpRangeByID.
 func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listPortableIpRanges", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listPortableIpRanges", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPortableIpRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listPortableIpRanges", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListPortableIpRangesResponse struct {
-	Count            int                `json:"count"`
-	PortableIpRanges []*PortableIpRange `json:"portableiprange"`
-}
+// Lists the objects like ListPortableIpRanges, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *PortableIPService) StreamPortableIpRanges(ctx context.Context, p *ListPortableIpRangesParams, fn func(*PortableIpRange) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type PortableIpRange struct {
-	Endip             string `json:"endip,omitempty"`
-	Gateway           string `json:"gateway,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Netmask           string `json:"netmask,omitempty"`
-	Portableipaddress []struct {
-		Accountid         string `json:"accountid,omitempty"`
-		Allocated         string `json:"allocated,omitempty"`
-		Domainid          string `json:"domainid,omitempty"`
-		Ipaddress         string `json:"ipaddress,omitempty"`
-		Networkid         string `json:"networkid,omitempty"`
-		Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-		Regionid          int    `json:"regionid,omitempty"`
-		State             string `json:"state,omitempty"`
-		Vpcid             string `json:"vpcid,omitempty"`
-		Zoneid            string `json:"zoneid,omitempty"`
-	} `json:"portableipaddress,omitempty"`
-	Regionid int    `json:"regionid,omitempty"`
-	Startip  string `json:"startip,omitempty"`
-	Vlan     string `json:"vlan,omitempty"`
+	return s.cs.streamList(ctx, "listPortableIpRanges", p.URLValues(), "portableiprange", func(b json.RawMessage) error {
+		var v PortableIpRange
+		if err := s.cs.unmarshalElement("listPortableIpRanges", "portableiprange", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -17,88 +17,55 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type CreateProjectParams struct {
-	p map[string]interface{}
-}
 
-func (p *CreateProjectParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+type ProjectService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateProjectParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+func NewProjectService(cs *CloudStackClient) *ProjectService {
+	return &ProjectService{cs: cs}
 }
 
-func (p *CreateProjectParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
+type ActivateProjectParams = cloudstackcommon.ActivateProjectParams
+type ActivateProjectResponse = cloudstackcommon.ActivateProjectResponse
 
-func (p *CreateProjectParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-// You should always use this function to get a new CreateProjectParams instance,
+// You should always use this function to get a new ActivateProjectParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewCreateProjectParams(displaytext string, name string) *CreateProjectParams {
-	p := &CreateProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
+//
+// The required params are:
+//
+//   - id
+func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectParams {
+	p := &ActivateProjectParams{}
+	p.SetId(id)
 	return p
 }
 
-// Creates a project
-func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
-	resp, err := s.cs.newRequest("createProject", p.toURLValues())
+// Activates a project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjects, GetProjectID, GetProjectByName, GetProjectByID, GetProjectsByTags.
+func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("activateProject", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ActivateProjectResponse
+	if err := s.cs.unmarshal("activateProject", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -119,203 +86,48 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("activateProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateProjectResponse struct {
-	JobID                     string `json:"jobid,omitempty"`
-	Account                   string `json:"account,omitempty"`
-	Cpuavailable              string `json:"cpuavailable,omitempty"`
-	Cpulimit                  string `json:"cpulimit,omitempty"`
-	Cputotal                  int64  `json:"cputotal,omitempty"`
-	Displaytext               string `json:"displaytext,omitempty"`
-	Domain                    string `json:"domain,omitempty"`
-	Domainid                  string `json:"domainid,omitempty"`
-	Id                        string `json:"id,omitempty"`
-	Ipavailable               string `json:"ipavailable,omitempty"`
-	Iplimit                   string `json:"iplimit,omitempty"`
-	Iptotal                   int64  `json:"iptotal,omitempty"`
-	Memoryavailable           string `json:"memoryavailable,omitempty"`
-	Memorylimit               string `json:"memorylimit,omitempty"`
-	Memorytotal               int64  `json:"memorytotal,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Networkavailable          string `json:"networkavailable,omitempty"`
-	Networklimit              string `json:"networklimit,omitempty"`
-	Networktotal              int64  `json:"networktotal,omitempty"`
-	Primarystorageavailable   string `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64  `json:"primarystoragetotal,omitempty"`
-	Secondarystorageavailable string `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64  `json:"secondarystoragetotal,omitempty"`
-	Snapshotavailable         string `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64  `json:"snapshottotal,omitempty"`
-	State                     string `json:"state,omitempty"`
-	Tags                      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templateavailable string `json:"templateavailable,omitempty"`
-	Templatelimit     string `json:"templatelimit,omitempty"`
-	Templatetotal     int64  `json:"templatetotal,omitempty"`
-	Vmavailable       string `json:"vmavailable,omitempty"`
-	Vmlimit           string `json:"vmlimit,omitempty"`
-	Vmrunning         int    `json:"vmrunning,omitempty"`
-	Vmstopped         int    `json:"vmstopped,omitempty"`
-	Vmtotal           int64  `json:"vmtotal,omitempty"`
-	Volumeavailable   string `json:"volumeavailable,omitempty"`
-	Volumelimit       string `json:"volumelimit,omitempty"`
-	Volumetotal       int64  `json:"volumetotal,omitempty"`
-	Vpcavailable      string `json:"vpcavailable,omitempty"`
-	Vpclimit          string `json:"vpclimit,omitempty"`
-	Vpctotal          int64  `json:"vpctotal,omitempty"`
-}
-
-type DeleteProjectParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteProjectParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type CreateProjectParams = cloudstackcommon.CreateProjectParams
+type CreateProjectResponse = cloudstackcommon.CreateProjectResponse
 
-// You should always use this function to get a new DeleteProjectParams instance,
+// You should always use this function to get a new CreateProjectParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
-	p := &DeleteProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
+func (s *ProjectService) NewCreateProjectParams(displaytext string, name string) *CreateProjectParams {
+	p := &CreateProjectParams{}
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
 	return p
 }
 
-// Deletes a project
-func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteProject", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+// Creates a project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjects, GetProjectID, GetProjectByName, GetProjectByID, GetProjectsByTags.
+func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
-
-type DeleteProjectResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type UpdateProjectParams struct {
-	p map[string]interface{}
-}
 
-func (p *UpdateProjectParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *UpdateProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *UpdateProjectParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
-}
-
-func (p *UpdateProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new UpdateProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
-	p := &UpdateProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Updates a project
-func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
-	resp, err := s.cs.newRequest("updateProject", p.toURLValues())
+	resp, err := s.cs.newRequest("createProject", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateProjectResponse
+	if err := s.cs.unmarshal("createProject", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -336,238 +148,46 @@ func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectRe
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateProjectResponse struct {
-	JobID                     string `json:"jobid,omitempty"`
-	Account                   string `json:"account,omitempty"`
-	Cpuavailable              string `json:"cpuavailable,omitempty"`
-	Cpulimit                  string `json:"cpulimit,omitempty"`
-	Cputotal                  int64  `json:"cputotal,omitempty"`
-	Displaytext               string `json:"displaytext,omitempty"`
-	Domain                    string `json:"domain,omitempty"`
-	Domainid                  string `json:"domainid,omitempty"`
-	Id                        string `json:"id,omitempty"`
-	Ipavailable               string `json:"ipavailable,omitempty"`
-	Iplimit                   string `json:"iplimit,omitempty"`
-	Iptotal                   int64  `json:"iptotal,omitempty"`
-	Memoryavailable           string `json:"memoryavailable,omitempty"`
-	Memorylimit               string `json:"memorylimit,omitempty"`
-	Memorytotal               int64  `json:"memorytotal,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Networkavailable          string `json:"networkavailable,omitempty"`
-	Networklimit              string `json:"networklimit,omitempty"`
-	Networktotal              int64  `json:"networktotal,omitempty"`
-	Primarystorageavailable   string `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64  `json:"primarystoragetotal,omitempty"`
-	Secondarystorageavailable string `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64  `json:"secondarystoragetotal,omitempty"`
-	Snapshotavailable         string `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64  `json:"snapshottotal,omitempty"`
-	State                     string `json:"state,omitempty"`
-	Tags                      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templateavailable string `json:"templateavailable,omitempty"`
-	Templatelimit     string `json:"templatelimit,omitempty"`
-	Templatetotal     int64  `json:"templatetotal,omitempty"`
-	Vmavailable       string `json:"vmavailable,omitempty"`
-	Vmlimit           string `json:"vmlimit,omitempty"`
-	Vmrunning         int    `json:"vmrunning,omitempty"`
-	Vmstopped         int    `json:"vmstopped,omitempty"`
-	Vmtotal           int64  `json:"vmtotal,omitempty"`
-	Volumeavailable   string `json:"volumeavailable,omitempty"`
-	Volumelimit       string `json:"volumelimit,omitempty"`
-	Volumetotal       int64  `json:"volumetotal,omitempty"`
-	Vpcavailable      string `json:"vpcavailable,omitempty"`
-	Vpclimit          string `json:"vpclimit,omitempty"`
-	Vpctotal          int64  `json:"vpctotal,omitempty"`
-}
-
-type ActivateProjectParams struct {
-	p map[string]interface{}
-}
+type DeleteProjectParams = cloudstackcommon.DeleteProjectParams
+type DeleteProjectResponse = cloudstackcommon.DeleteProjectResponse
 
-func (p *ActivateProjectParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *ActivateProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new ActivateProjectParams instance,
+// You should always use this function to get a new DeleteProjectParams instance,
 // as then you are sure you have configured all required params
-func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectParams {
-	p := &ActivateProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams {
+	p := &DeleteProjectParams{}
+	p.SetId(id)
 	return p
 }
 
-// Activates a project
-func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
-	resp, err := s.cs.newRequest("activateProject", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ActivateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+// Deletes a project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjects, GetProjectID, GetProjectByName, GetProjectByID, GetProjectsByTags.
+func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
-
-type ActivateProjectResponse struct {
-	JobID                     string `json:"jobid,omitempty"`
-	Account                   string `json:"account,omitempty"`
-	Cpuavailable              string `json:"cpuavailable,omitempty"`
-	Cpulimit                  string `json:"cpulimit,omitempty"`
-	Cputotal                  int64  `json:"cputotal,omitempty"`
-	Displaytext               string `json:"displaytext,omitempty"`
-	Domain                    string `json:"domain,omitempty"`
-	Domainid                  string `json:"domainid,omitempty"`
-	Id                        string `json:"id,omitempty"`
-	Ipavailable               string `json:"ipavailable,omitempty"`
-	Iplimit                   string `json:"iplimit,omitempty"`
-	Iptotal                   int64  `json:"iptotal,omitempty"`
-	Memoryavailable           string `json:"memoryavailable,omitempty"`
-	Memorylimit               string `json:"memorylimit,omitempty"`
-	Memorytotal               int64  `json:"memorytotal,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Networkavailable          string `json:"networkavailable,omitempty"`
-	Networklimit              string `json:"networklimit,omitempty"`
-	Networktotal              int64  `json:"networktotal,omitempty"`
-	Primarystorageavailable   string `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64  `json:"primarystoragetotal,omitempty"`
-	Secondarystorageavailable string `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64  `json:"secondarystoragetotal,omitempty"`
-	Snapshotavailable         string `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64  `json:"snapshottotal,omitempty"`
-	State                     string `json:"state,omitempty"`
-	Tags                      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templateavailable string `json:"templateavailable,omitempty"`
-	Templatelimit     string `json:"templatelimit,omitempty"`
-	Templatetotal     int64  `json:"templatetotal,omitempty"`
-	Vmavailable       string `json:"vmavailable,omitempty"`
-	Vmlimit           string `json:"vmlimit,omitempty"`
-	Vmrunning         int    `json:"vmrunning,omitempty"`
-	Vmstopped         int    `json:"vmstopped,omitempty"`
-	Vmtotal           int64  `json:"vmtotal,omitempty"`
-	Volumeavailable   string `json:"volumeavailable,omitempty"`
-	Volumelimit       string `json:"volumelimit,omitempty"`
-	Volumetotal       int64  `json:"volumetotal,omitempty"`
-	Vpcavailable      string `json:"vpcavailable,omitempty"`
-	Vpclimit          string `json:"vpclimit,omitempty"`
-	Vpctotal          int64  `json:"vpctotal,omitempty"`
-}
-
-type SuspendProjectParams struct {
-	p map[string]interface{}
-}
-
-func (p *SuspendProjectParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *SuspendProjectParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
 
-// You should always use this function to get a new SuspendProjectParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
-	p := &SuspendProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Suspends a project
-func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
-	resp, err := s.cs.newRequest("suspendProject", p.toURLValues())
+	resp, err := s.cs.newRequest("deleteProject", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r SuspendProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteProjectResponse
+	if err := s.cs.unmarshal("deleteProject", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -583,243 +203,38 @@ func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjec
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type SuspendProjectResponse struct {
-	JobID                     string `json:"jobid,omitempty"`
-	Account                   string `json:"account,omitempty"`
-	Cpuavailable              string `json:"cpuavailable,omitempty"`
-	Cpulimit                  string `json:"cpulimit,omitempty"`
-	Cputotal                  int64  `json:"cputotal,omitempty"`
-	Displaytext               string `json:"displaytext,omitempty"`
-	Domain                    string `json:"domain,omitempty"`
-	Domainid                  string `json:"domainid,omitempty"`
-	Id                        string `json:"id,omitempty"`
-	Ipavailable               string `json:"ipavailable,omitempty"`
-	Iplimit                   string `json:"iplimit,omitempty"`
-	Iptotal                   int64  `json:"iptotal,omitempty"`
-	Memoryavailable           string `json:"memoryavailable,omitempty"`
-	Memorylimit               string `json:"memorylimit,omitempty"`
-	Memorytotal               int64  `json:"memorytotal,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Networkavailable          string `json:"networkavailable,omitempty"`
-	Networklimit              string `json:"networklimit,omitempty"`
-	Networktotal              int64  `json:"networktotal,omitempty"`
-	Primarystorageavailable   string `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64  `json:"primarystoragetotal,omitempty"`
-	Secondarystorageavailable string `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64  `json:"secondarystoragetotal,omitempty"`
-	Snapshotavailable         string `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64  `json:"snapshottotal,omitempty"`
-	State                     string `json:"state,omitempty"`
-	Tags                      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templateavailable string `json:"templateavailable,omitempty"`
-	Templatelimit     string `json:"templatelimit,omitempty"`
-	Templatetotal     int64  `json:"templatetotal,omitempty"`
-	Vmavailable       string `json:"vmavailable,omitempty"`
-	Vmlimit           string `json:"vmlimit,omitempty"`
-	Vmrunning         int    `json:"vmrunning,omitempty"`
-	Vmstopped         int    `json:"vmstopped,omitempty"`
-	Vmtotal           int64  `json:"vmtotal,omitempty"`
-	Volumeavailable   string `json:"volumeavailable,omitempty"`
-	Volumelimit       string `json:"volumelimit,omitempty"`
-	Volumetotal       int64  `json:"volumetotal,omitempty"`
-	Vpcavailable      string `json:"vpcavailable,omitempty"`
-	Vpclimit          string `json:"vpclimit,omitempty"`
-	Vpctotal          int64  `json:"vpctotal,omitempty"`
-}
-
-type ListProjectsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListProjectsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
-	}
-	return u
-}
-
-func (p *ListProjectsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
-}
-
-func (p *ListProjectsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
-}
+type ListProjectsParams = cloudstackcommon.ListProjectsParams
+type ListProjectsResponse = cloudstackcommon.ListProjectsResponse
+type Project = cloudstackcommon.Project
 
 // You should always use this function to get a new ListProjectsParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewListProjectsParams() *ListProjectsParams {
 	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListProjects(p)
 	if err != nil {
@@ -834,24 +249,37 @@ func (s *ProjectService) GetProjectID(name string) (string, error) {
 		return l.Projects[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Projects {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Project
+	for _, v := range l.Projects {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Project", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectByName(name string) (*Project, int, error) {
-	id, err := s.GetProjectID(name)
+func (s *ProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error) {
+	id, err := s.GetProjectID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetProjectByID(id)
+	r, count, err := s.GetProjectByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -859,11 +287,16 @@ func (s *ProjectService) GetProjectByName(name string) (*Project, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectByID(id string) (*Project, int, error) {
+func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
 	p := &ListProjectsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListProjects(p)
 	if err != nil {
@@ -885,234 +318,266 @@ func (s *ProjectService) GetProjectByID(id string) (*Project, int, error) {
 	return nil, l.Count, fmt.Errorf("There is more then one result for Project UUID: %s!", id)
 }
 
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *ProjectService) GetProjectsByTags(tags map[string]string, opts ...OptionFunc) ([]*Project, error) {
+	p := &ListProjectsParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListProjects(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.Projects, nil
+}
+
 // Lists projects and provides detailed information for listed projects
+//
+// See also GetProjectID, GetProjectByName, GetProjectByID, GetProjectsByTags.
 func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
-	resp, err := s.cs.newRequest("listProjects", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listProjects", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listProjects", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListProjectsResponse struct {
-	Count    int        `json:"count"`
-	Projects []*Project `json:"project"`
-}
+// Lists the objects like ListProjects, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ProjectService) StreamProjects(ctx context.Context, p *ListProjectsParams, fn func(*Project) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type Project struct {
-	Account                   string `json:"account,omitempty"`
-	Cpuavailable              string `json:"cpuavailable,omitempty"`
-	Cpulimit                  string `json:"cpulimit,omitempty"`
-	Cputotal                  int64  `json:"cputotal,omitempty"`
-	Displaytext               string `json:"displaytext,omitempty"`
-	Domain                    string `json:"domain,omitempty"`
-	Domainid                  string `json:"domainid,omitempty"`
-	Id                        string `json:"id,omitempty"`
-	Ipavailable               string `json:"ipavailable,omitempty"`
-	Iplimit                   string `json:"iplimit,omitempty"`
-	Iptotal                   int64  `json:"iptotal,omitempty"`
-	Memoryavailable           string `json:"memoryavailable,omitempty"`
-	Memorylimit               string `json:"memorylimit,omitempty"`
-	Memorytotal               int64  `json:"memorytotal,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Networkavailable          string `json:"networkavailable,omitempty"`
-	Networklimit              string `json:"networklimit,omitempty"`
-	Networktotal              int64  `json:"networktotal,omitempty"`
-	Primarystorageavailable   string `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64  `json:"primarystoragetotal,omitempty"`
-	Secondarystorageavailable string `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64  `json:"secondarystoragetotal,omitempty"`
-	Snapshotavailable         string `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64  `json:"snapshottotal,omitempty"`
-	State                     string `json:"state,omitempty"`
-	Tags                      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templateavailable string `json:"templateavailable,omitempty"`
-	Templatelimit     string `json:"templatelimit,omitempty"`
-	Templatetotal     int64  `json:"templatetotal,omitempty"`
-	Vmavailable       string `json:"vmavailable,omitempty"`
-	Vmlimit           string `json:"vmlimit,omitempty"`
-	Vmrunning         int    `json:"vmrunning,omitempty"`
-	Vmstopped         int    `json:"vmstopped,omitempty"`
-	Vmtotal           int64  `json:"vmtotal,omitempty"`
-	Volumeavailable   string `json:"volumeavailable,omitempty"`
-	Volumelimit       string `json:"volumelimit,omitempty"`
-	Volumetotal       int64  `json:"volumetotal,omitempty"`
-	Vpcavailable      string `json:"vpcavailable,omitempty"`
-	Vpclimit          string `json:"vpclimit,omitempty"`
-	Vpctotal          int64  `json:"vpctotal,omitempty"`
+	return s.cs.streamList(ctx, "listProjects", p.URLValues(), "project", func(b json.RawMessage) error {
+		var v Project
+		if err := s.cs.unmarshalElement("listProjects", "project", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type ListProjectInvitationsParams struct {
-	p map[string]interface{}
+type SuspendProjectParams = cloudstackcommon.SuspendProjectParams
+type SuspendProjectResponse = cloudstackcommon.SuspendProjectResponse
+
+// You should always use this function to get a new SuspendProjectParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParams {
+	p := &SuspendProjectParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListProjectInvitationsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["activeonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("activeonly", vv)
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+// Suspends a project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjects, GetProjectID, GetProjectByName, GetProjectByID, GetProjectsByTags.
+func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+
+	resp, err := s.cs.newRequest("suspendProject", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+
+	var r SuspendProjectResponse
+	if err := s.cs.unmarshal("suspendProject", resp, &r); err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *ListProjectInvitationsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("suspendProject", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["account"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["activeonly"] = v
-	return
+type UpdateProjectParams = cloudstackcommon.UpdateProjectParams
+type UpdateProjectResponse = cloudstackcommon.UpdateProjectResponse
+
+// You should always use this function to get a new UpdateProjectParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams {
+	p := &UpdateProjectParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListProjectInvitationsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Updates a project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjects, GetProjectID, GetProjectByName, GetProjectByID, GetProjectsByTags.
+func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["domainid"] = v
-	return
-}
 
-func (p *ListProjectInvitationsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("updateProject", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateProjectResponse
+	if err := s.cs.unmarshal("updateProject", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["isrecursive"] = v
-	return
-}
 
-func (p *ListProjectInvitationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateProject", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["keyword"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListProjectInvitationsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+type DeleteProjectInvitationParams = cloudstackcommon.DeleteProjectInvitationParams
+type DeleteProjectInvitationResponse = cloudstackcommon.DeleteProjectInvitationResponse
+
+// You should always use this function to get a new DeleteProjectInvitationParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
+	p := &DeleteProjectInvitationParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListProjectInvitationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Accepts or declines project invitation
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjectInvitations, GetProjectInvitationByID.
+func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListProjectInvitationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteProjectInvitation", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListProjectInvitationsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteProjectInvitationResponse
+	if err := s.cs.unmarshal("deleteProjectInvitation", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *ListProjectInvitationsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteProjectInvitation", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["state"] = v
-	return
+	return &r, nil
 }
 
+type ListProjectInvitationsParams = cloudstackcommon.ListProjectInvitationsParams
+type ListProjectInvitationsResponse = cloudstackcommon.ListProjectInvitationsResponse
+type ProjectInvitation = cloudstackcommon.ProjectInvitation
+
 // You should always use this function to get a new ListProjectInvitationsParams instance,
 // as then you are sure you have configured all required params
 func (s *ProjectService) NewListProjectInvitationsParams() *ListProjectInvitationsParams {
 	p := &ListProjectInvitationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ProjectService) GetProjectInvitationByID(id string) (*ProjectInvitation, int, error) {
+func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
 	p := &ListProjectInvitationsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListProjectInvitations(p)
 	if err != nil {
@@ -1124,6 +589,20 @@ func (s *ProjectService) GetProjectInvitationByID(id string) (*ProjectInvitation
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListProjectInvitations(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -1135,179 +614,79 @@ func (s *ProjectService) GetProjectInvitationByID(id string) (*ProjectInvitation
 }
 
 // Lists projects and provides detailed information for listed projects
+//
+// See also GetProjectInvitationByID.
 func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectInvitations", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listProjectInvitations", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectInvitationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listProjectInvitations", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListProjectInvitationsResponse struct {
-	Count              int                  `json:"count"`
-	ProjectInvitations []*ProjectInvitation `json:"projectinvitation"`
-}
-
-type ProjectInvitation struct {
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Email     string `json:"email,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	State     string `json:"state,omitempty"`
-}
-
-type UpdateProjectInvitationParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accept"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("accept", vv)
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["token"]; found {
-		u.Set("token", v.(string))
-	}
-	return u
-}
-
-func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accept"] = v
-	return
-}
-
-func (p *UpdateProjectInvitationParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListProjectInvitations, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ProjectService) StreamProjectInvitations(ctx context.Context, p *ListProjectInvitationsParams, fn func(*ProjectInvitation) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["account"] = v
-	return
-}
 
-func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+	return s.cs.streamList(ctx, "listProjectInvitations", p.URLValues(), "projectinvitation", func(b json.RawMessage) error {
+		var v ProjectInvitation
+		if err := s.cs.unmarshalElement("listProjectInvitations", "projectinvitation", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *UpdateProjectInvitationParams) SetToken(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["token"] = v
-	return
-}
+type UpdateProjectInvitationParams = cloudstackcommon.UpdateProjectInvitationParams
+type UpdateProjectInvitationResponse = cloudstackcommon.UpdateProjectInvitationResponse
 
 // You should always use this function to get a new UpdateProjectInvitationParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - projectid: the ID of the project
 func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams {
 	p := &UpdateProjectInvitationParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+	p.SetProjectid(projectid)
 	return p
 }
 
 // Accepts or declines project invitation
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListProjectInvitations, GetProjectInvitationByID.
 func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("updateProjectInvitation", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r UpdateProjectInvitationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
-
-type UpdateProjectInvitationResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type DeleteProjectInvitationParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteProjectInvitationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
 
-// You should always use this function to get a new DeleteProjectInvitationParams instance,
-// as then you are sure you have configured all required params
-func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams {
-	p := &DeleteProjectInvitationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Accepts or declines project invitation
-func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
-	resp, err := s.cs.newRequest("deleteProjectInvitation", p.toURLValues())
+	resp, err := s.cs.newRequest("updateProjectInvitation", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteProjectInvitationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateProjectInvitationResponse
+	if err := s.cs.unmarshal("updateProjectInvitation", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1323,15 +702,9 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateProjectInvitation", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type DeleteProjectInvitationResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
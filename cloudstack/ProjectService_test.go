@@ -0,0 +1,680 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActivateProject(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "activateProject", params, `{"activateprojectresponse":{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"jobid":"test-jobid","memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Project.NewActivateProjectParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "activateProject", p.URLValues(), params)
+
+	r, err := cs.Project.ActivateProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call activateProject: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "activateProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute activateProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of activateProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of activateProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "activateProject", params, `<activateprojectresponse><account>test-account</account><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><jobid>test-jobid</jobid><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></activateprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.ActivateProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call activateProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateProject(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"displaytext": {"test-displaytext"},
+		"domainid":    {"test-domainid"},
+		"name":        {"test-name"},
+	}
+	cs, done := newTestClient(t, "createProject", params, `{"createprojectresponse":{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"jobid":"test-jobid","memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Project.NewCreateProjectParams("test-displaytext", "test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	checkURLValues(t, "createProject", p.URLValues(), params)
+
+	r, err := cs.Project.CreateProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call createProject: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createProject", params, `<createprojectresponse><account>test-account</account><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><jobid>test-jobid</jobid><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></createprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.CreateProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call createProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteProject", params, `{"deleteprojectresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Project.NewDeleteProjectParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteProject", p.URLValues(), params)
+
+	r, err := cs.Project.DeleteProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteProject: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteProject", params, `<deleteprojectresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.DeleteProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListProjects(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"displaytext":   {"test-displaytext"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"name":          {"test-name"},
+		"page":          {"9"},
+		"pagesize":      {"10"},
+		"state":         {"test-state"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listProjects", params, `{"listprojectsresponse":{"count":1,"project":[{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}]}}`)
+	defer done()
+
+	p := cs.Project.NewListProjectsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetState("test-state")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listProjects", p.URLValues(), params)
+
+	r, err := cs.Project.ListProjects(p)
+	if err != nil {
+		t.Fatalf("Failed to call listProjects: %v", err)
+	}
+	if r.Projects[0].Account != "test-account" {
+		t.Errorf("Expected r.Projects[0].Account to be %v, got %v", "test-account", r.Projects[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listProjects" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listProjects: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listProjects: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listProjects: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Project
+	count, err := cs.Project.StreamProjects(context.Background(), p, func(v *Project) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listProjects: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Projects) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Projects)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listProjects", params, `<listprojectsresponse><count>1</count><project><account>test-account</account><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></project></listprojectsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.ListProjects(p)
+	if err != nil {
+		t.Fatalf("Failed to call listProjects using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Project.StreamProjects(context.Background(), p, func(v *Project) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listProjects using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Projects) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Projects)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestSuspendProject(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "suspendProject", params, `{"suspendprojectresponse":{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"jobid":"test-jobid","memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Project.NewSuspendProjectParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "suspendProject", p.URLValues(), params)
+
+	r, err := cs.Project.SuspendProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call suspendProject: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "suspendProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute suspendProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of suspendProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of suspendProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "suspendProject", params, `<suspendprojectresponse><account>test-account</account><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><jobid>test-jobid</jobid><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></suspendprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.SuspendProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call suspendProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateProject(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"displaytext": {"test-displaytext"},
+		"id":          {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateProject", params, `{"updateprojectresponse":{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"jobid":"test-jobid","memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Project.NewUpdateProjectParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDisplaytext("test-displaytext")
+	p.SetId("test-id")
+	checkURLValues(t, "updateProject", p.URLValues(), params)
+
+	r, err := cs.Project.UpdateProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateProject: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateProject", params, `<updateprojectresponse><account>test-account</account><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><jobid>test-jobid</jobid><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></updateprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.UpdateProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteProjectInvitation(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteProjectInvitation", params, `{"deleteprojectinvitationresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Project.NewDeleteProjectInvitationParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteProjectInvitation", p.URLValues(), params)
+
+	r, err := cs.Project.DeleteProjectInvitation(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteProjectInvitation: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteProjectInvitation" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteProjectInvitation: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteProjectInvitation: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteProjectInvitation: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteProjectInvitation", params, `<deleteprojectinvitationresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteprojectinvitationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.DeleteProjectInvitation(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteProjectInvitation using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListProjectInvitations(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"activeonly":  {"true"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+		"state":       {"test-state"},
+	}
+	cs, done := newTestClient(t, "listProjectInvitations", params, `{"listprojectinvitationsresponse":{"count":1,"projectinvitation":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","email":"test-email","id":"test-id","project":"test-project","projectid":"test-projectid","state":"test-state"}]}}`)
+	defer done()
+
+	p := cs.Project.NewListProjectInvitationsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetActiveonly(true)
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	checkURLValues(t, "listProjectInvitations", p.URLValues(), params)
+
+	r, err := cs.Project.ListProjectInvitations(p)
+	if err != nil {
+		t.Fatalf("Failed to call listProjectInvitations: %v", err)
+	}
+	if r.ProjectInvitations[0].Account != "test-account" {
+		t.Errorf("Expected r.ProjectInvitations[0].Account to be %v, got %v", "test-account", r.ProjectInvitations[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listProjectInvitations" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listProjectInvitations: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listProjectInvitations: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listProjectInvitations: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ProjectInvitation
+	count, err := cs.Project.StreamProjectInvitations(context.Background(), p, func(v *ProjectInvitation) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listProjectInvitations: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ProjectInvitations) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ProjectInvitations)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listProjectInvitations", params, `<listprojectinvitationsresponse><count>1</count><projectinvitation><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><id>test-id</id><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state></projectinvitation></listprojectinvitationsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.ListProjectInvitations(p)
+	if err != nil {
+		t.Fatalf("Failed to call listProjectInvitations using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Project.StreamProjectInvitations(context.Background(), p, func(v *ProjectInvitation) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listProjectInvitations using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ProjectInvitations) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ProjectInvitations)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateProjectInvitation(t *testing.T) {
+	params := url.Values{
+		"accept":    {"true"},
+		"account":   {"test-account"},
+		"projectid": {"test-projectid"},
+		"token":     {"test-token"},
+	}
+	cs, done := newTestClient(t, "updateProjectInvitation", params, `{"updateprojectinvitationresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Project.NewUpdateProjectInvitationParams("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccept(true)
+	p.SetAccount("test-account")
+	p.SetProjectid("test-projectid")
+	p.SetToken("test-token")
+	checkURLValues(t, "updateProjectInvitation", p.URLValues(), params)
+
+	r, err := cs.Project.UpdateProjectInvitation(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateProjectInvitation: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateProjectInvitation" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateProjectInvitation: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateProjectInvitation: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateProjectInvitation: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateProjectInvitation", params, `<updateprojectinvitationresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></updateprojectinvitationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Project.UpdateProjectInvitation(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateProjectInvitation using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,95 +17,57 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"net/url"
-	"strconv"
-	"strings"
-)
-
-type CreateTagsParams struct {
-	p map[string]interface{}
-}
 
-func (p *CreateTagsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customer"]; found {
-		u.Set("customer", v.(string))
-	}
-	if v, found := p.p["resourceids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("resourceids", vv)
-	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
-	}
-	return u
-}
-
-func (p *CreateTagsParams) SetCustomer(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customer"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateTagsParams) SetResourceids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceids"] = v
-	return
+type ResourcetagsService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateTagsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
-	return
+func NewResourcetagsService(cs *CloudStackClient) *ResourcetagsService {
+	return &ResourcetagsService{cs: cs}
 }
 
-func (p *CreateTagsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
-}
+type CreateTagsParams = cloudstackcommon.CreateTagsParams
+type CreateTagsResponse = cloudstackcommon.CreateTagsResponse
 
 // You should always use this function to get a new CreateTagsParams instance,
 // as then you are sure you have configured all required params
-func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams {
+//
+// The required params are:
+//
+//   - resourceids
+//   - resourcetype: the type of the resource, like UserVm or Volume
+//   - tags: the tags, as key/value pairs
+func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resourcetype TaggedResourceType, tags map[string]string) *CreateTagsParams {
 	p := &CreateTagsParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceids"] = resourceids
-	p.p["resourcetype"] = resourcetype
-	p.p["tags"] = tags
+	p.SetResourceids(resourceids)
+	p.SetResourcetype(resourcetype)
+	p.SetTags(tags)
 	return p
 }
 
 // Creates resource tag(s)
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTags.
 func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
-	resp, err := s.cs.newRequest("createTags", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createTags", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createTags", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -121,89 +83,48 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createTags", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateTagsResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type DeleteTagsParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteTagsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["resourceids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("resourceids", vv)
-	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
-	}
-	return u
-}
-
-func (p *DeleteTagsParams) SetResourceids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceids"] = v
-	return
-}
-
-func (p *DeleteTagsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
-	return
-}
-
-func (p *DeleteTagsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
-}
+type DeleteTagsParams = cloudstackcommon.DeleteTagsParams
+type DeleteTagsResponse = cloudstackcommon.DeleteTagsResponse
 
 // You should always use this function to get a new DeleteTagsParams instance,
 // as then you are sure you have configured all required params
-func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams {
+//
+// The required params are:
+//
+//   - resourceids
+//   - resourcetype: the type of the resource, like UserVm or Volume
+func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resourcetype TaggedResourceType) *DeleteTagsParams {
 	p := &DeleteTagsParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceids"] = resourceids
-	p.p["resourcetype"] = resourcetype
+	p.SetResourceids(resourceids)
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
 // Deleting resource tag(s)
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTags.
 func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
-	resp, err := s.cs.newRequest("deleteTags", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteTags", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteTags", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -219,214 +140,59 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteTags", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteTagsResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListTagsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListTagsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["customer"]; found {
-		u.Set("customer", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
-	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
-	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
-	}
-	return u
-}
-
-func (p *ListTagsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListTagsParams) SetCustomer(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customer"] = v
-	return
-}
-
-func (p *ListTagsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListTagsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
-
-func (p *ListTagsParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["key"] = v
-	return
-}
-
-func (p *ListTagsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListTagsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListTagsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListTagsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListTagsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
-
-func (p *ListTagsParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
-	return
-}
-
-func (p *ListTagsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
-	return
-}
-
-func (p *ListTagsParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
-	return
-}
+type ListTagsParams = cloudstackcommon.ListTagsParams
+type ListTagsResponse = cloudstackcommon.ListTagsResponse
+type Tag = cloudstackcommon.Tag
 
 // You should always use this function to get a new ListTagsParams instance,
 // as then you are sure you have configured all required params
 func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
 	p := &ListTagsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // List resource tag(s)
 func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
-	resp, err := s.cs.newRequest("listTags", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listTags", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listTags", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListTagsResponse struct {
-	Count int    `json:"count"`
-	Tags  []*Tag `json:"tag"`
-}
+// Lists the objects like ListTags, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ResourcetagsService) StreamTags(ctx context.Context, p *ListTagsParams, fn func(*Tag) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type Tag struct {
-	Account      string `json:"account,omitempty"`
-	Customer     string `json:"customer,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Key          string `json:"key,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Resourceid   string `json:"resourceid,omitempty"`
-	Resourcetype string `json:"resourcetype,omitempty"`
-	Value        string `json:"value,omitempty"`
+	return s.cs.streamList(ctx, "listTags", p.URLValues(), "tag", func(b json.RawMessage) error {
+		var v Tag
+		if err := s.cs.unmarshalElement("listTags", "tag", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -17,468 +17,111 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
-)
-
-type StartRouterParams struct {
-	p map[string]interface{}
-}
-
-func (p *StartRouterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *StartRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new StartRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
-	p := &StartRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Starts a router.
-func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
-	resp, err := s.cs.newRequest("startRouter", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r StartRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+type RouterService struct {
+	cs *CloudStackClient
 }
 
-type StartRouterResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+func NewRouterService(cs *CloudStackClient) *RouterService {
+	return &RouterService{cs: cs}
 }
 
-type RebootRouterParams struct {
-	p map[string]interface{}
+type DestroyRouterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *RebootRouterParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DestroyRouterParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *RebootRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new RebootRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
-	p := &RebootRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Starts a router.
-func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
-	resp, err := s.cs.newRequest("rebootRouter", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r RebootRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
-}
-
-type RebootRouterResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+// Returns the name of the API command of these params
+func (p *DestroyRouterParams) Command() string {
+	return "destroyRouter"
 }
 
-type StopRouterParams struct {
-	p map[string]interface{}
+// Returns true if the API command starts an async job
+func (p *DestroyRouterParams) IsAsync() bool {
+	return true
 }
 
-func (p *StopRouterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DestroyRouterParams) ResponseType() interface{} {
+	return &DestroyRouterResponse{}
 }
 
-func (p *StopRouterParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DestroyRouterParams) Validate() error {
+	v := &validator{api: "destroyRouter"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *StopRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// This param is required.
+func (p *DestroyRouterParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new StopRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
-	p := &StopRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Stops a router.
-func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
-	resp, err := s.cs.newRequest("stopRouter", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r StopRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *DestroyRouterParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return &r, nil
-}
-
-type StopRouterResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+	return *p.Id
 }
 
-type DestroyRouterParams struct {
-	p map[string]interface{}
-}
-
-func (p *DestroyRouterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *DestroyRouterParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *DestroyRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *DestroyRouterParams) ResetId() {
+	p.Id = nil
 }
 
 // You should always use this function to get a new DestroyRouterParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
 	p := &DestroyRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Destroys a router.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListRouters, GetRouterID, GetRouterByName, GetRouterByID.
 func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
-	resp, err := s.cs.newRequest("destroyRouter", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("destroyRouter", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroyRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("destroyRouter", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -499,7 +142,7 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("destroyRouter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -507,783 +150,1493 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 }
 
 type DestroyRouterResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ChangeServiceForRouterParams struct {
-	p map[string]interface{}
-}
-
-func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
-	}
-	return u
-}
-
-func (p *ChangeServiceForRouterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
-	return
-}
-
-// You should always use this function to get a new ChangeServiceForRouterParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams {
-	p := &ChangeServiceForRouterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
-	return p
-}
-
-// Upgrades domain router to a new service offering
-func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForRouter", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ChangeServiceForRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ChangeServiceForRouterResponse struct {
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListRoutersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListRoutersParams) toURLValues() url.Values {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid   *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvpc      *bool   `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Hostid      *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid   *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid       *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Version     *string `json:"version,omitempty" yaml:"version,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListRoutersParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Forvpc; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("forvpc", vv)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
-	if v, found := p.p["version"]; found {
-		u.Set("version", v.(string))
+	if v := p.Version; v != nil {
+		u.Set("version", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListRoutersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListRoutersParams) Command() string {
+	return "listRouters"
 }
 
-func (p *ListRoutersParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListRoutersParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListRoutersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListRoutersParams) ResponseType() interface{} {
+	return &ListRoutersResponse{}
 }
 
-func (p *ListRoutersParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListRoutersParams) Validate() error {
+	v := &validator{api: "listRouters"}
+	return v.err()
 }
 
-func (p *ListRoutersParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListRoutersParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListRoutersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListRoutersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+func (p *ListRoutersParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListRoutersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListRoutersParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListRoutersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *ListRoutersParams) SetClusterid(v string) {
+	p.Clusterid = &v
 	return
 }
 
-func (p *ListRoutersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
 	}
-	p.p["name"] = v
-	return
+	return *p.Clusterid
 }
 
-func (p *ListRoutersParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+func (p *ListRoutersParams) HasClusterid() bool {
+	return p.Clusterid != nil
 }
 
-func (p *ListRoutersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListRoutersParams) ResetClusterid() {
+	p.Clusterid = nil
 }
 
-func (p *ListRoutersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListRoutersParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListRoutersParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["podid"] = v
+	return *p.Domainid
+}
+
+func (p *ListRoutersParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListRoutersParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListRoutersParams) SetForvpc(v bool) {
+	p.Forvpc = &v
 	return
 }
 
-func (p *ListRoutersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetForvpc() bool {
+	if p.Forvpc == nil {
+		return false
 	}
-	p.p["projectid"] = v
+	return *p.Forvpc
+}
+
+func (p *ListRoutersParams) HasForvpc() bool {
+	return p.Forvpc != nil
+}
+
+func (p *ListRoutersParams) ResetForvpc() {
+	p.Forvpc = nil
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *ListRoutersParams) SetHostid(v string) {
+	p.Hostid = &v
 	return
 }
 
-func (p *ListRoutersParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
 	}
-	p.p["state"] = v
+	return *p.Hostid
+}
+
+func (p *ListRoutersParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ListRoutersParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+func (p *ListRoutersParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListRoutersParams) SetVersion(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["version"] = v
+	return *p.Id
+}
+
+func (p *ListRoutersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListRoutersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListRoutersParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListRoutersParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["vpcid"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListRoutersParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListRoutersParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListRoutersParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListRoutersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRoutersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListRoutersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListRoutersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListRoutersParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListRoutersParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListRoutersParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListRoutersParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListRoutersParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListRoutersParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListRoutersParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListRoutersParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListRoutersParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListRoutersParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListRoutersParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListRoutersParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListRoutersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListRoutersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListRoutersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListRoutersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListRoutersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListRoutersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListRoutersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListRoutersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListRoutersParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListRoutersParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListRoutersParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListRoutersParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListRoutersParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListRoutersParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListRoutersParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListRoutersParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListRoutersParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListRoutersParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.State
+}
+
+func (p *ListRoutersParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListRoutersParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListRoutersParams) SetVersion(v string) {
+	p.Version = &v
 	return
 }
 
+func (p *ListRoutersParams) GetVersion() string {
+	if p.Version == nil {
+		return ""
+	}
+	return *p.Version
+}
+
+func (p *ListRoutersParams) HasVersion() bool {
+	return p.Version != nil
+}
+
+func (p *ListRoutersParams) ResetVersion() {
+	p.Version = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListRoutersParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *ListRoutersParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *ListRoutersParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListRoutersParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListRoutersParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListRoutersParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListRoutersParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListRoutersParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListRoutersParams instance,
 // as then you are sure you have configured all required params
 func (s *RouterService) NewListRoutersParams() *ListRoutersParams {
 	p := &ListRoutersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *RouterService) GetRouterID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListRoutersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListRouters(p)
 	if err != nil {
 		return "", err
 	}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListRouters(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Routers[0].Id, nil
+	}
+
+	var matches []*Router
+	for _, v := range l.Routers {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Router", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *RouterService) GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error) {
+	id, err := s.GetRouterID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	r, count, err := s.GetRouterByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error) {
+	p := &ListRoutersParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListRouters(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListRouters(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Routers[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Router UUID: %s!", id)
+}
+
+// List routers.
+//
+// See also GetRouterID, GetRouterByName, GetRouterByID.
+func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listRouters", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListRoutersResponse
+	if err := s.cs.unmarshal("listRouters", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListRouters, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *RouterService) StreamRouters(ctx context.Context, p *ListRoutersParams, fn func(*Router) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listRouters", p.URLValues(), "router", func(b json.RawMessage) error {
+		var v Router
+		if err := s.cs.unmarshalElement("listRouters", "router", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListRoutersResponse struct {
+	Count   int                        `json:"count" xml:"count"`
+	Routers []*Router                  `json:"router" xml:"router"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Router struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RebootRouterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RebootRouterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RebootRouterParams) Command() string {
+	return "rebootRouter"
+}
+
+// Returns true if the API command starts an async job
+func (p *RebootRouterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RebootRouterParams) ResponseType() interface{} {
+	return &RebootRouterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RebootRouterParams) Validate() error {
+	v := &validator{api: "rebootRouter"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RebootRouterParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RebootRouterParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RebootRouterParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RebootRouterParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RebootRouterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
+	p := &RebootRouterParams{}
+	p.SetId(id)
+	return p
+}
+
+// Starts a router.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListRouters, GetRouterID, GetRouterByName, GetRouterByID.
+func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("rebootRouter", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootRouterResponse
+	if err := s.cs.unmarshal("rebootRouter", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("rebootRouter", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type RebootRouterResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StartRouterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StartRouterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *StartRouterParams) Command() string {
+	return "startRouter"
+}
+
+// Returns true if the API command starts an async job
+func (p *StartRouterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StartRouterParams) ResponseType() interface{} {
+	return &StartRouterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StartRouterParams) Validate() error {
+	v := &validator{api: "startRouter"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *StartRouterParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *StartRouterParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *StartRouterParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *StartRouterParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new StartRouterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
+	p := &StartRouterParams{}
+	p.SetId(id)
+	return p
+}
+
+// Starts a router.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListRouters, GetRouterID, GetRouterByName, GetRouterByID.
+func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("startRouter", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartRouterResponse
+	if err := s.cs.unmarshal("startRouter", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("startRouter", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type StartRouterResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StopRouterParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StopRouterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *StopRouterParams) Command() string {
+	return "stopRouter"
+}
+
+// Returns true if the API command starts an async job
+func (p *StopRouterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StopRouterParams) ResponseType() interface{} {
+	return &StopRouterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StopRouterParams) Validate() error {
+	v := &validator{api: "stopRouter"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *StopRouterParams) SetForced(v bool) {
+	p.Forced = &v
+	return
+}
+
+func (p *StopRouterParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
 	}
+	return *p.Forced
+}
 
-	if l.Count == 1 {
-		return l.Routers[0].Id, nil
-	}
+func (p *StopRouterParams) HasForced() bool {
+	return p.Forced != nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.Routers {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+func (p *StopRouterParams) ResetForced() {
+	p.Forced = nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *RouterService) GetRouterByName(name string) (*Router, int, error) {
-	id, err := s.GetRouterID(name)
-	if err != nil {
-		return nil, -1, err
-	}
+// This param is required.
+func (p *StopRouterParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	r, count, err := s.GetRouterByID(id)
-	if err != nil {
-		return nil, count, err
+func (p *StopRouterParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return r, count, nil
+	return *p.Id
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *RouterService) GetRouterByID(id string) (*Router, int, error) {
-	p := &ListRoutersParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+func (p *StopRouterParams) HasId() bool {
+	return p.Id != nil
+}
 
-	l, err := s.ListRouters(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
+func (p *StopRouterParams) ResetId() {
+	p.Id = nil
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+// You should always use this function to get a new StopRouterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
+	p := &StopRouterParams{}
+	p.SetId(id)
+	return p
+}
 
-	if l.Count == 1 {
-		return l.Routers[0], l.Count, nil
+// Stops a router.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListRouters, GetRouterID, GetRouterByName, GetRouterByID.
+func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Router UUID: %s!", id)
-}
 
-// List routers.
-func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
-	resp, err := s.cs.newRequest("listRouters", p.toURLValues())
+	resp, err := s.cs.newRequest("stopRouter", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListRoutersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r StopRouterResponse
+	if err := s.cs.unmarshal("stopRouter", resp, &r); err != nil {
 		return nil, err
 	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("stopRouter", b, &r); err != nil {
+			return nil, err
+		}
+	}
 	return &r, nil
 }
 
-type ListRoutersResponse struct {
-	Count   int       `json:"count"`
-	Routers []*Router `json:"router"`
+type StopRouterResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type Router struct {
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ListVirtualRouterElementsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
+type ChangeServiceForRouterParams struct {
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ChangeServiceForRouterParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
 	}
 	return u
 }
 
-func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabled"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ChangeServiceForRouterParams) Command() string {
+	return "changeServiceForRouter"
 }
 
-func (p *ListVirtualRouterElementsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ChangeServiceForRouterParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ChangeServiceForRouterParams) ResponseType() interface{} {
+	return &ChangeServiceForRouterResponse{}
 }
 
-func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ChangeServiceForRouterParams) Validate() error {
+	v := &validator{api: "changeServiceForRouter"}
+	v.required("id", p.Id != nil)
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	return v.err()
 }
 
-func (p *ListVirtualRouterElementsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// This param is required.
+func (p *ChangeServiceForRouterParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ChangeServiceForRouterParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new ListVirtualRouterElementsParams instance,
-// as then you are sure you have configured all required params
-func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams {
-	p := &ListVirtualRouterElementsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ChangeServiceForRouterParams) HasId() bool {
+	return p.Id != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *RouterService) GetVirtualRouterElementByID(id string) (*VirtualRouterElement, int, error) {
-	p := &ListVirtualRouterElementsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListVirtualRouterElements(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
-
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
-
-	if l.Count == 1 {
-		return l.VirtualRouterElements[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualRouterElement UUID: %s!", id)
+func (p *ChangeServiceForRouterParams) ResetId() {
+	p.Id = nil
 }
 
-// Lists all available virtual router elements.
-func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualRouterElements", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *ChangeServiceForRouterParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
 
-	var r ListVirtualRouterElementsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ChangeServiceForRouterParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Serviceofferingid
 }
 
-type ListVirtualRouterElementsResponse struct {
-	Count                 int                     `json:"count"`
-	VirtualRouterElements []*VirtualRouterElement `json:"virtualrouterelement"`
+func (p *ChangeServiceForRouterParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
 }
 
-type VirtualRouterElement struct {
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Enabled   bool   `json:"enabled,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Nspid     string `json:"nspid,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
+func (p *ChangeServiceForRouterParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
 }
 
-type ConfigureVirtualRouterElementParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ChangeServiceForRouterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - serviceofferingid: the ID of the service offering
+func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams {
+	p := &ChangeServiceForRouterParams{}
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
+	return p
 }
 
-func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// Upgrades domain router to a new service offering
+func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return u
-}
 
-func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("changeServiceForRouter", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["enabled"] = v
-	return
-}
 
-func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ChangeServiceForRouterResponse
+	if err := s.cs.unmarshal("changeServiceForRouter", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
+type ChangeServiceForRouterResponse struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ConfigureVirtualRouterElementParams = cloudstackcommon.ConfigureVirtualRouterElementParams
+type ConfigureVirtualRouterElementResponse = cloudstackcommon.ConfigureVirtualRouterElementResponse
+
 // You should always use this function to get a new ConfigureVirtualRouterElementParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - enabled
+//   - id
 func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams {
 	p := &ConfigureVirtualRouterElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["enabled"] = enabled
-	p.p["id"] = id
+	p.SetEnabled(enabled)
+	p.SetId(id)
 	return p
 }
 
 // Configures a virtual router element.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualRouterElements, GetVirtualRouterElementByID.
 func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("configureVirtualRouterElement", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("configureVirtualRouterElement", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ConfigureVirtualRouterElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("configureVirtualRouterElement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1304,77 +1657,46 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("configureVirtualRouterElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ConfigureVirtualRouterElementResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Enabled   bool   `json:"enabled,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Nspid     string `json:"nspid,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-}
-
-type CreateVirtualRouterElementParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
-	}
-	if v, found := p.p["providertype"]; found {
-		u.Set("providertype", v.(string))
-	}
-	return u
-}
-
-func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
-	return
-}
-
-func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["providertype"] = v
-	return
-}
+type CreateVirtualRouterElementParams = cloudstackcommon.CreateVirtualRouterElementParams
+type CreateVirtualRouterElementResponse = cloudstackcommon.CreateVirtualRouterElementResponse
 
 // You should always use this function to get a new CreateVirtualRouterElementParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - nspid
 func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams {
 	p := &CreateVirtualRouterElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["nspid"] = nspid
+	p.SetNspid(nspid)
 	return p
 }
 
 // Create a virtual router element.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualRouterElements, GetVirtualRouterElementByID.
 func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newRequest("createVirtualRouterElement", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVirtualRouterElement", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVirtualRouterElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createVirtualRouterElement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1395,21 +1717,93 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVirtualRouterElement", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListVirtualRouterElementsParams = cloudstackcommon.ListVirtualRouterElementsParams
+type ListVirtualRouterElementsResponse = cloudstackcommon.ListVirtualRouterElementsResponse
+type VirtualRouterElement = cloudstackcommon.VirtualRouterElement
+
+// You should always use this function to get a new ListVirtualRouterElementsParams instance,
+// as then you are sure you have configured all required params
+func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams {
+	p := &ListVirtualRouterElementsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
+	p := &ListVirtualRouterElementsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVirtualRouterElements(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VirtualRouterElements[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualRouterElement UUID: %s!", id)
+}
+
+// Lists all available virtual router elements.
+//
+// See also GetVirtualRouterElementByID.
+func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
+
+	resp, err := s.cs.newRequest("listVirtualRouterElements", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVirtualRouterElementsResponse
+	if err := s.cs.unmarshal("listVirtualRouterElements", resp, &r); err != nil {
+		return nil, err
+	}
 	return &r, nil
 }
 
-type CreateVirtualRouterElementResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Enabled   bool   `json:"enabled,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Nspid     string `json:"nspid,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
+// Lists the objects like ListVirtualRouterElements, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *RouterService) StreamVirtualRouterElements(ctx context.Context, p *ListVirtualRouterElementsParams, fn func(*VirtualRouterElement) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listVirtualRouterElements", p.URLValues(), "virtualrouterelement", func(b json.RawMessage) error {
+		var v VirtualRouterElement
+		if err := s.cs.unmarshalElement("listVirtualRouterElements", "virtualrouterelement", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -0,0 +1,672 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDestroyRouter(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "destroyRouter", params, `{"destroyrouterresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"jobid":"test-jobid","linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Router.NewDestroyRouterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "destroyRouter", p.URLValues(), params)
+
+	r, err := cs.Router.DestroyRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call destroyRouter: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "destroyRouter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute destroyRouter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of destroyRouter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of destroyRouter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "destroyRouter", params, `<destroyrouterresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><jobid>test-jobid</jobid><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></destroyrouterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.DestroyRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call destroyRouter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListRouters(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"clusterid":   {"test-clusterid"},
+		"domainid":    {"test-domainid"},
+		"forvpc":      {"true"},
+		"hostid":      {"test-hostid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"networkid":   {"test-networkid"},
+		"page":        {"12"},
+		"pagesize":    {"13"},
+		"podid":       {"test-podid"},
+		"projectid":   {"test-projectid"},
+		"state":       {"test-state"},
+		"version":     {"test-version"},
+		"vpcid":       {"test-vpcid"},
+		"zoneid":      {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listRouters", params, `{"listroutersresponse":{"count":1,"router":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Router.NewListRoutersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetClusterid("test-clusterid")
+	p.SetDomainid("test-domainid")
+	p.SetForvpc(true)
+	p.SetHostid("test-hostid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(12)
+	p.SetPagesize(13)
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	p.SetVersion("test-version")
+	p.SetVpcid("test-vpcid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listRouters", p.URLValues(), params)
+
+	r, err := cs.Router.ListRouters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listRouters: %v", err)
+	}
+	if r.Routers[0].Account != "test-account" {
+		t.Errorf("Expected r.Routers[0].Account to be %v, got %v", "test-account", r.Routers[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listRouters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listRouters: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listRouters: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listRouters: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Router
+	count, err := cs.Router.StreamRouters(context.Background(), p, func(v *Router) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listRouters: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Routers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Routers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listRouters", params, `<listroutersresponse><count>1</count><router><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></router></listroutersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.ListRouters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listRouters using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Router.StreamRouters(context.Background(), p, func(v *Router) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listRouters using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Routers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Routers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRebootRouter(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "rebootRouter", params, `{"rebootrouterresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"jobid":"test-jobid","linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Router.NewRebootRouterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "rebootRouter", p.URLValues(), params)
+
+	r, err := cs.Router.RebootRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call rebootRouter: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "rebootRouter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute rebootRouter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of rebootRouter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of rebootRouter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "rebootRouter", params, `<rebootrouterresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><jobid>test-jobid</jobid><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></rebootrouterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.RebootRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call rebootRouter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestStartRouter(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "startRouter", params, `{"startrouterresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"jobid":"test-jobid","linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Router.NewStartRouterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "startRouter", p.URLValues(), params)
+
+	r, err := cs.Router.StartRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call startRouter: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "startRouter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute startRouter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of startRouter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of startRouter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "startRouter", params, `<startrouterresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><jobid>test-jobid</jobid><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></startrouterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.StartRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call startRouter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestStopRouter(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+	cs, done := newTestClient(t, "stopRouter", params, `{"stoprouterresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"jobid":"test-jobid","linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Router.NewStopRouterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetForced(true)
+	p.SetId("test-id")
+	checkURLValues(t, "stopRouter", p.URLValues(), params)
+
+	r, err := cs.Router.StopRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call stopRouter: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "stopRouter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute stopRouter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of stopRouter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of stopRouter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "stopRouter", params, `<stoprouterresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><jobid>test-jobid</jobid><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></stoprouterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.StopRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call stopRouter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestChangeServiceForRouter(t *testing.T) {
+	params := url.Values{
+		"id":                {"test-id"},
+		"serviceofferingid": {"test-serviceofferingid"},
+	}
+	cs, done := newTestClient(t, "changeServiceForRouter", params, `{"changeserviceforrouterresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Router.NewChangeServiceForRouterParams("test-id", "test-serviceofferingid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetServiceofferingid("test-serviceofferingid")
+	checkURLValues(t, "changeServiceForRouter", p.URLValues(), params)
+
+	r, err := cs.Router.ChangeServiceForRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call changeServiceForRouter: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "changeServiceForRouter" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute changeServiceForRouter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of changeServiceForRouter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of changeServiceForRouter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "changeServiceForRouter", params, `<changeserviceforrouterresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></changeserviceforrouterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.ChangeServiceForRouter(p)
+	if err != nil {
+		t.Fatalf("Failed to call changeServiceForRouter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestConfigureVirtualRouterElement(t *testing.T) {
+	params := url.Values{
+		"enabled": {"true"},
+		"id":      {"test-id"},
+	}
+	cs, done := newTestClient(t, "configureVirtualRouterElement", params, `{"configurevirtualrouterelementresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid","project":"test-project","projectid":"test-projectid"}}`)
+	defer done()
+
+	p := cs.Router.NewConfigureVirtualRouterElementParams(true, "test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	checkURLValues(t, "configureVirtualRouterElement", p.URLValues(), params)
+
+	r, err := cs.Router.ConfigureVirtualRouterElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureVirtualRouterElement: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "configureVirtualRouterElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute configureVirtualRouterElement: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of configureVirtualRouterElement: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of configureVirtualRouterElement: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "configureVirtualRouterElement", params, `<configurevirtualrouterelementresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><enabled>true</enabled><id>test-id</id><jobid>test-jobid</jobid><nspid>test-nspid</nspid><project>test-project</project><projectid>test-projectid</projectid></configurevirtualrouterelementresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.ConfigureVirtualRouterElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureVirtualRouterElement using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVirtualRouterElement(t *testing.T) {
+	params := url.Values{
+		"nspid":        {"test-nspid"},
+		"providertype": {"test-providertype"},
+	}
+	cs, done := newTestClient(t, "createVirtualRouterElement", params, `{"createvirtualrouterelementresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid","project":"test-project","projectid":"test-projectid"}}`)
+	defer done()
+
+	p := cs.Router.NewCreateVirtualRouterElementParams("test-nspid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetNspid("test-nspid")
+	p.SetProvidertype("test-providertype")
+	checkURLValues(t, "createVirtualRouterElement", p.URLValues(), params)
+
+	r, err := cs.Router.CreateVirtualRouterElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVirtualRouterElement: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVirtualRouterElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVirtualRouterElement: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVirtualRouterElement: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVirtualRouterElement: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVirtualRouterElement", params, `<createvirtualrouterelementresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><enabled>true</enabled><id>test-id</id><jobid>test-jobid</jobid><nspid>test-nspid</nspid><project>test-project</project><projectid>test-projectid</projectid></createvirtualrouterelementresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.CreateVirtualRouterElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVirtualRouterElement using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVirtualRouterElements(t *testing.T) {
+	params := url.Values{
+		"enabled":  {"true"},
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"nspid":    {"test-nspid"},
+		"page":     {"5"},
+		"pagesize": {"6"},
+	}
+	cs, done := newTestClient(t, "listVirtualRouterElements", params, `{"listvirtualrouterelementsresponse":{"count":1,"virtualrouterelement":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","enabled":true,"id":"test-id","nspid":"test-nspid","project":"test-project","projectid":"test-projectid"}]}}`)
+	defer done()
+
+	p := cs.Router.NewListVirtualRouterElementsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetNspid("test-nspid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listVirtualRouterElements", p.URLValues(), params)
+
+	r, err := cs.Router.ListVirtualRouterElements(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVirtualRouterElements: %v", err)
+	}
+	if r.VirtualRouterElements[0].Account != "test-account" {
+		t.Errorf("Expected r.VirtualRouterElements[0].Account to be %v, got %v", "test-account", r.VirtualRouterElements[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVirtualRouterElements" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVirtualRouterElements: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVirtualRouterElements: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVirtualRouterElements: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VirtualRouterElement
+	count, err := cs.Router.StreamVirtualRouterElements(context.Background(), p, func(v *VirtualRouterElement) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVirtualRouterElements: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VirtualRouterElements) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VirtualRouterElements)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVirtualRouterElements", params, `<listvirtualrouterelementsresponse><count>1</count><virtualrouterelement><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><enabled>true</enabled><id>test-id</id><nspid>test-nspid</nspid><project>test-project</project><projectid>test-projectid</projectid></virtualrouterelement></listvirtualrouterelementsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Router.ListVirtualRouterElements(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVirtualRouterElements using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Router.StreamVirtualRouterElements(context.Background(), p, func(v *VirtualRouterElement) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVirtualRouterElements using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VirtualRouterElements) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VirtualRouterElements)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
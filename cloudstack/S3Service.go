@@ -17,214 +17,87 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
-)
-
-type AddS3Params struct {
-	p map[string]interface{}
-}
-
-func (p *AddS3Params) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accesskey"]; found {
-		u.Set("accesskey", v.(string))
-	}
-	if v, found := p.p["bucket"]; found {
-		u.Set("bucket", v.(string))
-	}
-	if v, found := p.p["connectiontimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("connectiontimeout", vv)
-	}
-	if v, found := p.p["endpoint"]; found {
-		u.Set("endpoint", v.(string))
-	}
-	if v, found := p.p["maxerrorretry"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxerrorretry", vv)
-	}
-	if v, found := p.p["secretkey"]; found {
-		u.Set("secretkey", v.(string))
-	}
-	if v, found := p.p["sockettimeout"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sockettimeout", vv)
-	}
-	if v, found := p.p["usehttps"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("usehttps", vv)
-	}
-	return u
-}
 
-func (p *AddS3Params) SetAccesskey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accesskey"] = v
-	return
-}
-
-func (p *AddS3Params) SetBucket(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bucket"] = v
-	return
-}
-
-func (p *AddS3Params) SetConnectiontimeout(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["connectiontimeout"] = v
-	return
-}
-
-func (p *AddS3Params) SetEndpoint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endpoint"] = v
-	return
-}
-
-func (p *AddS3Params) SetMaxerrorretry(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxerrorretry"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddS3Params) SetSecretkey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["secretkey"] = v
-	return
+type S3Service struct {
+	cs *CloudStackClient
 }
 
-func (p *AddS3Params) SetSockettimeout(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sockettimeout"] = v
-	return
+func NewS3Service(cs *CloudStackClient) *S3Service {
+	return &S3Service{cs: cs}
 }
 
-func (p *AddS3Params) SetUsehttps(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usehttps"] = v
-	return
-}
+type AddS3Params = cloudstackcommon.AddS3Params
+type AddS3Response = cloudstackcommon.AddS3Response
 
 // You should always use this function to get a new AddS3Params instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - accesskey
+//   - bucket
+//   - secretkey
 func (s *S3Service) NewAddS3Params(accesskey string, bucket string, secretkey string) *AddS3Params {
 	p := &AddS3Params{}
-	p.p = make(map[string]interface{})
-	p.p["accesskey"] = accesskey
-	p.p["bucket"] = bucket
-	p.p["secretkey"] = secretkey
+	p.SetAccesskey(accesskey)
+	p.SetBucket(bucket)
+	p.SetSecretkey(secretkey)
 	return p
 }
 
 // Adds S3
+//
+// See also ListS3s, GetS3ID.
 func (s *S3Service) AddS3(p *AddS3Params) (*AddS3Response, error) {
-	resp, err := s.cs.newRequest("addS3", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addS3", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddS3Response
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addS3", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddS3Response struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
-}
-
-type ListS3sParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListS3sParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListS3sParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListS3sParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListS3sParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListS3sParams = cloudstackcommon.ListS3sParams
+type ListS3sResponse = cloudstackcommon.ListS3sResponse
+type S3 = cloudstackcommon.S3
 
 // You should always use this function to get a new ListS3sParams instance,
 // as then you are sure you have configured all required params
 func (s *S3Service) NewListS3sParams() *ListS3sParams {
 	p := &ListS3sParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *S3Service) GetS3ID(keyword string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *S3Service) GetS3ID(keyword string, opts ...OptionFunc) (string, error) {
 	p := &ListS3sParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListS3s(p)
 	if err != nil {
@@ -239,43 +112,66 @@ func (s *S3Service) GetS3ID(keyword string) (string, error) {
 		return l.S3s[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.S3s {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*S3
+	for _, v := range l.S3s {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "S3", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists S3s
+//
+// See also GetS3ID.
 func (s *S3Service) ListS3s(p *ListS3sParams) (*ListS3sResponse, error) {
-	resp, err := s.cs.newRequest("listS3s", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listS3s", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListS3sResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listS3s", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListS3sResponse struct {
-	Count int   `json:"count"`
-	S3s   []*S3 `json:"s3"`
-}
+// Lists the objects like ListS3s, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *S3Service) StreamS3s(ctx context.Context, p *ListS3sParams, fn func(*S3) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type S3 struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
+	return s.cs.streamList(ctx, "listS3s", p.URLValues(), "s3", func(b json.RawMessage) error {
+		var v S3
+		if err := s.cs.unmarshalElement("listS3s", "s3", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -0,0 +1,186 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddS3(t *testing.T) {
+	params := url.Values{
+		"accesskey":         {"test-accesskey"},
+		"bucket":            {"test-bucket"},
+		"connectiontimeout": {"3"},
+		"endpoint":          {"test-endpoint"},
+		"maxerrorretry":     {"5"},
+		"secretkey":         {"test-secretkey"},
+		"sockettimeout":     {"7"},
+		"usehttps":          {"true"},
+	}
+	cs, done := newTestClient(t, "addS3", params, `{"adds3response":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.S3.NewAddS3Params("test-accesskey", "test-bucket", "test-secretkey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccesskey("test-accesskey")
+	p.SetBucket("test-bucket")
+	p.SetConnectiontimeout(3)
+	p.SetEndpoint("test-endpoint")
+	p.SetMaxerrorretry(5)
+	p.SetSecretkey("test-secretkey")
+	p.SetSockettimeout(7)
+	p.SetUsehttps(true)
+	checkURLValues(t, "addS3", p.URLValues(), params)
+
+	r, err := cs.S3.AddS3(p)
+	if err != nil {
+		t.Fatalf("Failed to call addS3: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addS3" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addS3: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addS3: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addS3: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addS3", params, `<adds3response><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></adds3response>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.S3.AddS3(p)
+	if err != nil {
+		t.Fatalf("Failed to call addS3 using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListS3s(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+	cs, done := newTestClient(t, "listS3s", params, `{"lists3sresponse":{"count":1,"s3":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.S3.NewListS3sParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	checkURLValues(t, "listS3s", p.URLValues(), params)
+
+	r, err := cs.S3.ListS3s(p)
+	if err != nil {
+		t.Fatalf("Failed to call listS3s: %v", err)
+	}
+	if r.S3s[0].Id != "test-id" {
+		t.Errorf("Expected r.S3s[0].Id to be %v, got %v", "test-id", r.S3s[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listS3s" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listS3s: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listS3s: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listS3s: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*S3
+	count, err := cs.S3.StreamS3s(context.Background(), p, func(v *S3) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listS3s: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.S3s) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.S3s)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listS3s", params, `<lists3sresponse><count>1</count><s3><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></s3></lists3sresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.S3.ListS3s(p)
+	if err != nil {
+		t.Fatalf("Failed to call listS3s using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.S3.StreamS3s(context.Background(), p, func(v *S3) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listS3s using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.S3s) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.S3s)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,97 +17,210 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
-	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type SSHService struct {
+	cs *CloudStackClient
+}
+
+func NewSSHService(cs *CloudStackClient) *SSHService {
+	return &SSHService{cs: cs}
+}
+
 type ResetSSHKeyForVirtualMachineParams struct {
-	p map[string]interface{}
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keypair   *string `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ResetSSHKeyForVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Keypair; v != nil {
+		u.Set("keypair", *v)
 	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ResetSSHKeyForVirtualMachineParams) Command() string {
+	return "resetSSHKeyForVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *ResetSSHKeyForVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ResetSSHKeyForVirtualMachineParams) ResponseType() interface{} {
+	return &ResetSSHKeyForVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ResetSSHKeyForVirtualMachineParams) Validate() error {
+	v := &validator{api: "resetSSHKeyForVirtualMachine"}
+	v.required("id", p.Id != nil)
+	v.required("keypair", p.Keypair != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetSSHKeyForVirtualMachineParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ResetSSHKeyForVirtualMachineParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetSSHKeyForVirtualMachineParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Domainid
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *ResetSSHKeyForVirtualMachineParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetSSHKeyForVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["keypair"] = v
+	return *p.Id
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *ResetSSHKeyForVirtualMachineParams) SetKeypair(v string) {
+	p.Keypair = &v
 	return
 }
 
-func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetSSHKeyForVirtualMachineParams) GetKeypair() string {
+	if p.Keypair == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Keypair
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) HasKeypair() bool {
+	return p.Keypair != nil
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) ResetKeypair() {
+	p.Keypair = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ResetSSHKeyForVirtualMachineParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
+func (p *ResetSSHKeyForVirtualMachineParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ResetSSHKeyForVirtualMachineParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
 // You should always use this function to get a new ResetSSHKeyForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - keypair
 func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string, keypair string) *ResetSSHKeyForVirtualMachineParams {
 	p := &ResetSSHKeyForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["keypair"] = keypair
+	p.SetId(id)
+	p.SetKeypair(keypair)
 	return p
 }
 
 // Resets the SSH Key for virtual machine. The virtual machine must be in a "Stopped" state. [async]
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("resetSSHKeyForVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetSSHKeyForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("resetSSHKeyForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -128,7 +241,7 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("resetSSHKeyForVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -136,341 +249,94 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 }
 
 type ResetSSHKeyForVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type RegisterSSHKeyPairParams struct {
-	p map[string]interface{}
-}
-
-func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["publickey"]; found {
-		u.Set("publickey", v.(string))
-	}
-	return u
-}
-
-func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *RegisterSSHKeyPairParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
-
-func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publickey"] = v
-	return
-}
-
-// You should always use this function to get a new RegisterSSHKeyPairParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams {
-	p := &RegisterSSHKeyPairParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["publickey"] = publickey
-	return p
-}
-
-// Register a public key in a keypair under a certain name
-func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("registerSSHKeyPair", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	if resp, err = getRawValue(resp); err != nil {
-		return nil, err
-	}
-
-	var r RegisterSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type RegisterSSHKeyPairResponse struct {
-	Fingerprint string `json:"fingerprint,omitempty"`
-	Name        string `json:"name,omitempty"`
-}
-
-type CreateSSHKeyPairParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateSSHKeyPairParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *CreateSSHKeyPairParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateSSHKeyPairParams = cloudstackcommon.CreateSSHKeyPairParams
+type CreateSSHKeyPairResponse = cloudstackcommon.CreateSSHKeyPairResponse
 
 // You should always use this function to get a new CreateSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
 func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams {
 	p := &CreateSSHKeyPairParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
 // Create a new keypair and returns the private key
+//
+// See also ListSSHKeyPairs.
 func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("createSSHKeyPair", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createSSHKeyPair", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -480,254 +346,138 @@ func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyP
 	}
 
 	var r CreateSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createSSHKeyPair", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type CreateSSHKeyPairResponse struct {
-	Privatekey string `json:"privatekey,omitempty"`
-}
-
-type DeleteSSHKeyPairParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *DeleteSSHKeyPairParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
+type DeleteSSHKeyPairParams = cloudstackcommon.DeleteSSHKeyPairParams
+type DeleteSSHKeyPairResponse = cloudstackcommon.DeleteSSHKeyPairResponse
 
 // You should always use this function to get a new DeleteSSHKeyPairParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
 func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams {
 	p := &DeleteSSHKeyPairParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
 // Deletes a keypair by name
+//
+// See also ListSSHKeyPairs.
 func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
-	resp, err := s.cs.newRequest("deleteSSHKeyPair", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteSSHKeyPair", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteSSHKeyPair", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteSSHKeyPairResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ListSSHKeyPairsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fingerprint"]; found {
-		u.Set("fingerprint", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
+type ListSSHKeyPairsParams = cloudstackcommon.ListSSHKeyPairsParams
+type ListSSHKeyPairsResponse = cloudstackcommon.ListSSHKeyPairsResponse
+type SSHKeyPair = cloudstackcommon.SSHKeyPair
 
-func (p *ListSSHKeyPairsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// You should always use this function to get a new ListSSHKeyPairsParams instance,
+// as then you are sure you have configured all required params
+func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
+	p := &ListSSHKeyPairsParams{}
+	return p
 }
 
-func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// List registered keypairs
+func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["domainid"] = v
-	return
-}
 
-func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listSSHKeyPairs", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["fingerprint"] = v
-	return
-}
 
-func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListSSHKeyPairsResponse
+	if err := s.cs.unmarshal("listSSHKeyPairs", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["isrecursive"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListSSHKeyPairs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SSHService) StreamSSHKeyPairs(ctx context.Context, p *ListSSHKeyPairsParams, fn func(*SSHKeyPair) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListSSHKeyPairsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+	return s.cs.streamList(ctx, "listSSHKeyPairs", p.URLValues(), "sshkeypair", func(b json.RawMessage) error {
+		var v SSHKeyPair
+		if err := s.cs.unmarshalElement("listSSHKeyPairs", "sshkeypair", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListSSHKeyPairsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type RegisterSSHKeyPairParams = cloudstackcommon.RegisterSSHKeyPairParams
+type RegisterSSHKeyPairResponse = cloudstackcommon.RegisterSSHKeyPairResponse
 
-func (p *ListSSHKeyPairsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// You should always use this function to get a new RegisterSSHKeyPairParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
+//   - publickey
+func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams {
+	p := &RegisterSSHKeyPairParams{}
+	p.SetName(name)
+	p.SetPublickey(publickey)
+	return p
 }
 
-func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Register a public key in a keypair under a certain name
+//
+// See also ListSSHKeyPairs.
+func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("registerSSHKeyPair", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["projectid"] = v
-	return
-}
 
-// You should always use this function to get a new ListSSHKeyPairsParams instance,
-// as then you are sure you have configured all required params
-func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
-	p := &ListSSHKeyPairsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// List registered keypairs
-func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
-	resp, err := s.cs.newRequest("listSSHKeyPairs", p.toURLValues())
-	if err != nil {
+	if resp, err = getRawValue(resp); err != nil {
 		return nil, err
 	}
 
-	var r ListSSHKeyPairsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RegisterSSHKeyPairResponse
+	if err := s.cs.unmarshal("registerSSHKeyPair", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type ListSSHKeyPairsResponse struct {
-	Count       int           `json:"count"`
-	SSHKeyPairs []*SSHKeyPair `json:"sshkeypair"`
-}
-
-type SSHKeyPair struct {
-	Fingerprint string `json:"fingerprint,omitempty"`
-	Name        string `json:"name,omitempty"`
-}
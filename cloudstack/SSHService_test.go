@@ -0,0 +1,391 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestResetSSHKeyForVirtualMachine(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"id":        {"test-id"},
+		"keypair":   {"test-keypair"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "resetSSHKeyForVirtualMachine", params, `{"resetsshkeyforvirtualmachineresponse":{"account":"test-account","affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"diskioread":1,"diskiowrite":1,"diskkbsread":1,"diskkbswrite":1,"diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayname":"test-displayname","displayvm":true,"domain":"test-domain","domainid":"test-domainid","forvirtualnetwork":true,"group":"test-group","groupid":"test-groupid","guestosid":"test-guestosid","haenable":true,"hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","instancename":"test-instancename","isdynamicallyscalable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","keypair":"test-keypair","memory":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"ostypeid":1,"password":"test-password","passwordenabled":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","rootdeviceid":1,"rootdevicetype":"test-rootdevicetype","securitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","servicestate":"test-servicestate","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","vgpu":"test-vgpu","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SSH.NewResetSSHKeyForVirtualMachineParams("test-id", "test-keypair")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetKeypair("test-keypair")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "resetSSHKeyForVirtualMachine", p.URLValues(), params)
+
+	r, err := cs.SSH.ResetSSHKeyForVirtualMachine(p)
+	if err != nil {
+		t.Fatalf("Failed to call resetSSHKeyForVirtualMachine: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "resetSSHKeyForVirtualMachine" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute resetSSHKeyForVirtualMachine: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of resetSSHKeyForVirtualMachine: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of resetSSHKeyForVirtualMachine: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "resetSSHKeyForVirtualMachine", params, `<resetsshkeyforvirtualmachineresponse><account>test-account</account><affinitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></affinitygroup><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><diskioread>1</diskioread><diskiowrite>1</diskiowrite><diskkbsread>1</diskkbsread><diskkbswrite>1</diskkbswrite><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayname>test-displayname</displayname><displayvm>true</displayvm><domain>test-domain</domain><domainid>test-domainid</domainid><forvirtualnetwork>true</forvirtualnetwork><group>test-group</group><groupid>test-groupid</groupid><guestosid>test-guestosid</guestosid><haenable>true</haenable><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><instancename>test-instancename</instancename><isdynamicallyscalable>true</isdynamicallyscalable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><keypair>test-keypair</keypair><memory>1</memory><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><ostypeid>1</ostypeid><password>test-password</password><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><rootdeviceid>1</rootdeviceid><rootdevicetype>test-rootdevicetype</rootdevicetype><securitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></securitygroup><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><servicestate>test-servicestate</servicestate><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><vgpu>test-vgpu</vgpu><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></resetsshkeyforvirtualmachineresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SSH.ResetSSHKeyForVirtualMachine(p)
+	if err != nil {
+		t.Fatalf("Failed to call resetSSHKeyForVirtualMachine using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateSSHKeyPair(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "createSSHKeyPair", params, `{"createsshkeypairresponse":{"createsshkeypair":{"privatekey":"test-privatekey"}}}`)
+	defer done()
+
+	p := cs.SSH.NewCreateSSHKeyPairParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "createSSHKeyPair", p.URLValues(), params)
+
+	r, err := cs.SSH.CreateSSHKeyPair(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSSHKeyPair: %v", err)
+	}
+	if r.Privatekey != "test-privatekey" {
+		t.Errorf("Expected r.Privatekey to be %v, got %v", "test-privatekey", r.Privatekey)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createSSHKeyPair" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createSSHKeyPair: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createSSHKeyPair: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createSSHKeyPair: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createSSHKeyPair", params, `<createsshkeypairresponse><createsshkeypair><privatekey>test-privatekey</privatekey></createsshkeypair></createsshkeypairresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SSH.CreateSSHKeyPair(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSSHKeyPair using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSSHKeyPair(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "deleteSSHKeyPair", params, `{"deletesshkeypairresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.SSH.NewDeleteSSHKeyPairParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "deleteSSHKeyPair", p.URLValues(), params)
+
+	r, err := cs.SSH.DeleteSSHKeyPair(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSSHKeyPair: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSSHKeyPair" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSSHKeyPair: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSSHKeyPair: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSSHKeyPair: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSSHKeyPair", params, `<deletesshkeypairresponse><displaytext>test-displaytext</displaytext><success>true</success></deletesshkeypairresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SSH.DeleteSSHKeyPair(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSSHKeyPair using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSSHKeyPairs(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"fingerprint": {"test-fingerprint"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "listSSHKeyPairs", params, `{"listsshkeypairsresponse":{"count":1,"sshkeypair":[{"fingerprint":"test-fingerprint","name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.SSH.NewListSSHKeyPairsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFingerprint("test-fingerprint")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "listSSHKeyPairs", p.URLValues(), params)
+
+	r, err := cs.SSH.ListSSHKeyPairs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSSHKeyPairs: %v", err)
+	}
+	if r.SSHKeyPairs[0].Fingerprint != "test-fingerprint" {
+		t.Errorf("Expected r.SSHKeyPairs[0].Fingerprint to be %v, got %v", "test-fingerprint", r.SSHKeyPairs[0].Fingerprint)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSSHKeyPairs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSSHKeyPairs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSSHKeyPairs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSSHKeyPairs: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SSHKeyPair
+	count, err := cs.SSH.StreamSSHKeyPairs(context.Background(), p, func(v *SSHKeyPair) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSSHKeyPairs: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SSHKeyPairs) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SSHKeyPairs)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSSHKeyPairs", params, `<listsshkeypairsresponse><count>1</count><sshkeypair><fingerprint>test-fingerprint</fingerprint><name>test-name</name></sshkeypair></listsshkeypairsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SSH.ListSSHKeyPairs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSSHKeyPairs using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.SSH.StreamSSHKeyPairs(context.Background(), p, func(v *SSHKeyPair) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSSHKeyPairs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SSHKeyPairs) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SSHKeyPairs)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRegisterSSHKeyPair(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+		"publickey": {"test-publickey"},
+	}
+	cs, done := newTestClient(t, "registerSSHKeyPair", params, `{"registersshkeypairresponse":{"registersshkeypair":{"fingerprint":"test-fingerprint","name":"test-name"}}}`)
+	defer done()
+
+	p := cs.SSH.NewRegisterSSHKeyPairParams("test-name", "test-publickey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	p.SetPublickey("test-publickey")
+	checkURLValues(t, "registerSSHKeyPair", p.URLValues(), params)
+
+	r, err := cs.SSH.RegisterSSHKeyPair(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerSSHKeyPair: %v", err)
+	}
+	if r.Fingerprint != "test-fingerprint" {
+		t.Errorf("Expected r.Fingerprint to be %v, got %v", "test-fingerprint", r.Fingerprint)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "registerSSHKeyPair" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute registerSSHKeyPair: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of registerSSHKeyPair: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of registerSSHKeyPair: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "registerSSHKeyPair", params, `<registersshkeypairresponse><registersshkeypair><fingerprint>test-fingerprint</fingerprint><name>test-name</name></registersshkeypair></registersshkeypairresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SSH.RegisterSSHKeyPair(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerSSHKeyPair using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,729 +17,1175 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type SecurityGroupService struct {
+	cs *CloudStackClient
+}
+
+func NewSecurityGroupService(cs *CloudStackClient) *SecurityGroupService {
+	return &SecurityGroupService{cs: cs}
+}
+
 type CreateSecurityGroupParams struct {
-	p map[string]interface{}
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
 }
 
-func (p *CreateSecurityGroupParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateSecurityGroupParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *CreateSecurityGroupParams) Command() string {
+	return "createSecurityGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateSecurityGroupParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateSecurityGroupParams) ResponseType() interface{} {
+	return &CreateSecurityGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateSecurityGroupParams) Validate() error {
+	v := &validator{api: "createSecurityGroup"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *CreateSecurityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *CreateSecurityGroupParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSecurityGroupParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["description"] = v
+	return *p.Account
+}
+
+func (p *CreateSecurityGroupParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateSecurityGroupParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *CreateSecurityGroupParams) SetDescription(v string) {
+	p.Description = &v
 	return
 }
 
-func (p *CreateSecurityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSecurityGroupParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Description
+}
+
+func (p *CreateSecurityGroupParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateSecurityGroupParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateSecurityGroupParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateSecurityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSecurityGroupParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Domainid
+}
+
+func (p *CreateSecurityGroupParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateSecurityGroupParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateSecurityGroupParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *CreateSecurityGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSecurityGroupParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Name
+}
+
+func (p *CreateSecurityGroupParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateSecurityGroupParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateSecurityGroupParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
+func (p *CreateSecurityGroupParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *CreateSecurityGroupParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateSecurityGroupParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
 // You should always use this function to get a new CreateSecurityGroupParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
 func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams {
 	p := &CreateSecurityGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
 // Creates a security group
+//
+// See also ListSecurityGroups, GetSecurityGroupID, GetSecurityGroupByName, GetSecurityGroupByID, GetSecurityGroupsByTags.
 func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("createSecurityGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createSecurityGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSecurityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createSecurityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type CreateSecurityGroupResponse struct {
-	Account     string `json:"account,omitempty"`
-	Description string `json:"description,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Egressrule  []struct {
-		Account           string `json:"account,omitempty"`
-		Cidr              string `json:"cidr,omitempty"`
-		Endport           int    `json:"endport,omitempty"`
-		Icmpcode          int    `json:"icmpcode,omitempty"`
-		Icmptype          int    `json:"icmptype,omitempty"`
-		Protocol          string `json:"protocol,omitempty"`
-		Ruleid            string `json:"ruleid,omitempty"`
-		Securitygroupname string `json:"securitygroupname,omitempty"`
-		Startport         int    `json:"startport,omitempty"`
-		Tags              []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"egressrule,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ingressrule []struct {
-		Account           string `json:"account,omitempty"`
-		Cidr              string `json:"cidr,omitempty"`
-		Endport           int    `json:"endport,omitempty"`
-		Icmpcode          int    `json:"icmpcode,omitempty"`
-		Icmptype          int    `json:"icmptype,omitempty"`
-		Protocol          string `json:"protocol,omitempty"`
-		Ruleid            string `json:"ruleid,omitempty"`
-		Securitygroupname string `json:"securitygroupname,omitempty"`
-		Startport         int    `json:"startport,omitempty"`
-		Tags              []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"ingressrule,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	Tags      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-}
-
-type DeleteSecurityGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteSecurityGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *DeleteSecurityGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *DeleteSecurityGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Egressrule  []SecurityGroupRule        `json:"egressrule,omitempty" xml:"egressrule,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ingressrule []SecurityGroupRule        `json:"ingressrule,omitempty" xml:"ingressrule,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteSecurityGroupParams = cloudstackcommon.DeleteSecurityGroupParams
+type DeleteSecurityGroupResponse = cloudstackcommon.DeleteSecurityGroupResponse
 
 // You should always use this function to get a new DeleteSecurityGroupParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams {
 	p := &DeleteSecurityGroupParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Deletes security group
+//
+// See also ListSecurityGroups, GetSecurityGroupID, GetSecurityGroupByName, GetSecurityGroupByID, GetSecurityGroupsByTags.
 func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteSecurityGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteSecurityGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSecurityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteSecurityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteSecurityGroupResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type AuthorizeSecurityGroupIngressParams struct {
-	p map[string]interface{}
-}
-
-func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
+type ListSecurityGroupsParams struct {
+	Account           *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page              *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid         *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Securitygroupname *string           `json:"securitygroupname,omitempty" yaml:"securitygroupname,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Virtualmachineid  *string           `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSecurityGroupsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
-	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
 	}
-	if v, found := p.p["securitygroupid"]; found {
-		u.Set("securitygroupid", v.(string))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["securitygroupname"]; found {
-		u.Set("securitygroupname", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+	if v := p.Securitygroupname; v != nil {
+		u.Set("securitygroupname", *v)
 	}
-	if v, found := p.p["usersecuritygrouplist"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].key", i), k)
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].value", i), vv)
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
 		}
 	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
 	return u
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Returns the name of the API command of these params
+func (p *ListSecurityGroupsParams) Command() string {
+	return "listSecurityGroups"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSecurityGroupsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSecurityGroupsParams) ResponseType() interface{} {
+	return &ListSecurityGroupsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSecurityGroupsParams) Validate() error {
+	v := &validator{api: "listSecurityGroups"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListSecurityGroupsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["cidrlist"] = v
+	return *p.Account
+}
+
+func (p *ListSecurityGroupsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListSecurityGroupsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Domainid
+}
+
+func (p *ListSecurityGroupsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListSecurityGroupsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["endport"] = v
+	return *p.Id
+}
+
+func (p *ListSecurityGroupsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["icmpcode"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListSecurityGroupsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSecurityGroupsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["icmptype"] = v
+	return *p.Keyword
+}
+
+func (p *ListSecurityGroupsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListSecurityGroupsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["projectid"] = v
+	return *p.Listall
+}
+
+func (p *ListSecurityGroupsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSecurityGroupsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["protocol"] = v
+	return *p.Page
+}
+
+func (p *ListSecurityGroupsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSecurityGroupsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["securitygroupid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListSecurityGroupsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListSecurityGroupsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["securitygroupname"] = v
+	return *p.Projectid
+}
+
+func (p *ListSecurityGroupsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
+	p.Securitygroupname = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSecurityGroupsParams) GetSecuritygroupname() string {
+	if p.Securitygroupname == nil {
+		return ""
 	}
-	p.p["startport"] = v
+	return *p.Securitygroupname
+}
+
+func (p *ListSecurityGroupsParams) HasSecuritygroupname() bool {
+	return p.Securitygroupname != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetSecuritygroupname() {
+	p.Securitygroupname = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
+	p.Tags = v
 	return
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usersecuritygrouplist"] = v
+func (p *ListSecurityGroupsParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListSecurityGroupsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetTags() {
+	p.Tags = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-// You should always use this function to get a new AuthorizeSecurityGroupIngressParams instance,
+func (p *ListSecurityGroupsParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *ListSecurityGroupsParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListSecurityGroupsParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new ListSecurityGroupsParams instance,
 // as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams {
-	p := &AuthorizeSecurityGroupIngressParams{}
-	p.p = make(map[string]interface{})
+func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroupsParams {
+	p := &ListSecurityGroupsParams{}
 	return p
 }
 
-// Authorizes a particular ingress rule for this security group
-func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupIngress", p.toURLValues())
-	if err != nil {
-		return nil, err
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, error) {
+	p := &ListSecurityGroupsParams{}
+
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
 
-	var r AuthorizeSecurityGroupIngressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	l, err := s.ListSecurityGroups(p)
+	if err != nil {
+		return "", err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListSecurityGroups(p)
 		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+			return "", err
 		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	if l.Count == 1 {
+		return l.SecurityGroups[0].Id, nil
+	}
+
+	var matches []*SecurityGroup
+	for _, v := range l.SecurityGroups {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
 	}
-	return &r, nil
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "SecurityGroup", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-type AuthorizeSecurityGroupIngressResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Account           string `json:"account,omitempty"`
-	Cidr              string `json:"cidr,omitempty"`
-	Endport           int    `json:"endport,omitempty"`
-	Icmpcode          int    `json:"icmpcode,omitempty"`
-	Icmptype          int    `json:"icmptype,omitempty"`
-	Protocol          string `json:"protocol,omitempty"`
-	Ruleid            string `json:"ruleid,omitempty"`
-	Securitygroupname string `json:"securitygroupname,omitempty"`
-	Startport         int    `json:"startport,omitempty"`
-	Tags              []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-}
-
-type RevokeSecurityGroupIngressParams struct {
-	p map[string]interface{}
-}
-
-func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	id, err := s.GetSecurityGroupID(name, opts...)
+	if err != nil {
+		return nil, -1, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	r, count, err := s.GetSecurityGroupByID(id, opts...)
+	if err != nil {
+		return nil, count, err
 	}
-	return u
+	return r, count, nil
 }
 
-func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	p := &ListSecurityGroupsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-// You should always use this function to get a new RevokeSecurityGroupIngressParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams {
-	p := &RevokeSecurityGroupIngressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+	l, err := s.ListSecurityGroups(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListSecurityGroups(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.SecurityGroups[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for SecurityGroup UUID: %s!", id)
 }
 
-// Deletes a particular ingress rule from this security group
-func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupIngress", p.toURLValues())
-	if err != nil {
-		return nil, err
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *SecurityGroupService) GetSecurityGroupsByTags(tags map[string]string, opts ...OptionFunc) ([]*SecurityGroup, error) {
+	p := &ListSecurityGroupsParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
 
-	var r RevokeSecurityGroupIngressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	l, err := s.ListSecurityGroups(p)
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListSecurityGroups(p)
 		if err != nil {
 			return nil, err
 		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+	}
+
+	return l.SecurityGroups, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+// Lists security groups
+//
+// See also GetSecurityGroupID, GetSecurityGroupByName, GetSecurityGroupByID, GetSecurityGroupsByTags.
+func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
+
+	resp, err := s.cs.newRequest("listSecurityGroups", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListSecurityGroupsResponse
+	if err := s.cs.unmarshal("listSecurityGroups", resp, &r); err != nil {
+		return nil, err
+	}
 	return &r, nil
 }
 
-type RevokeSecurityGroupIngressResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+// Lists the objects like ListSecurityGroups, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SecurityGroupService) StreamSecurityGroups(ctx context.Context, p *ListSecurityGroupsParams, fn func(*SecurityGroup) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listSecurityGroups", p.URLValues(), "securitygroup", func(b json.RawMessage) error {
+		var v SecurityGroup
+		if err := s.cs.unmarshalElement("listSecurityGroups", "securitygroup", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type AuthorizeSecurityGroupEgressParams struct {
-	p map[string]interface{}
+type ListSecurityGroupsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	SecurityGroups []*SecurityGroup           `json:"securitygroup" xml:"securitygroup"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
+type SecurityGroup struct {
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Egressrule  []SecurityGroupRule        `json:"egressrule,omitempty" xml:"egressrule,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ingressrule []SecurityGroupRule        `json:"ingressrule,omitempty" xml:"ingressrule,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AuthorizeSecurityGroupEgressParams struct {
+	Account               *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist              []string          `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid              *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endport               *int              `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Icmpcode              *int              `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype              *int              `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Projectid             *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Protocol              *Protocol         `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Securitygroupid       *string           `json:"securitygroupid,omitempty" yaml:"securitygroupid,omitempty"`
+	Securitygroupname     *string           `json:"securitygroupname,omitempty" yaml:"securitygroupname,omitempty"`
+	Startport             *int              `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Usersecuritygrouplist map[string]string `json:"usersecuritygrouplist,omitempty" yaml:"usersecuritygrouplist,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AuthorizeSecurityGroupEgressParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("cidrlist", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("endport", vv)
 	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Icmpcode; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("icmpcode", vv)
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Icmptype; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("icmptype", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["securitygroupid"]; found {
-		u.Set("securitygroupid", v.(string))
+	if v := p.Securitygroupid; v != nil {
+		u.Set("securitygroupid", *v)
 	}
-	if v, found := p.p["securitygroupname"]; found {
-		u.Set("securitygroupname", v.(string))
+	if v := p.Securitygroupname; v != nil {
+		u.Set("securitygroupname", *v)
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("startport", vv)
 	}
-	if v, found := p.p["usersecuritygrouplist"]; found {
+	if v := p.Usersecuritygrouplist; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].key", i), k)
-			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].value", i), vv)
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), vv)
 			i++
 		}
 	}
 	return u
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *AuthorizeSecurityGroupEgressParams) Command() string {
+	return "authorizeSecurityGroupEgress"
+}
+
+// Returns true if the API command starts an async job
+func (p *AuthorizeSecurityGroupEgressParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AuthorizeSecurityGroupEgressParams) ResponseType() interface{} {
+	return &AuthorizeSecurityGroupEgressResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AuthorizeSecurityGroupEgressParams) Validate() error {
+	v := &validator{api: "authorizeSecurityGroupEgress"}
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
 	}
-	p.p["account"] = v
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["cidrlist"] = v
+	return *p.Account
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *AuthorizeSecurityGroupEgressParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
 	return
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
 func (p *AuthorizeSecurityGroupEgressParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.Domainid = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["endport"] = v
+	return *p.Domainid
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'endport' param: the end of the port range.
+func (p *AuthorizeSecurityGroupEgressParams) SetEndport(v int) {
+	p.Endport = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
 	}
-	p.p["icmpcode"] = v
+	return *p.Endport
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasEndport() bool {
+	return p.Endport != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetEndport() {
+	p.Endport = nil
+}
+
+// Sets the 'icmpcode' param: the ICMP code, for the icmp protocol.
+func (p *AuthorizeSecurityGroupEgressParams) SetIcmpcode(v int) {
+	p.Icmpcode = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetIcmpcode() int {
+	if p.Icmpcode == nil {
+		return 0
 	}
-	p.p["icmptype"] = v
+	return *p.Icmpcode
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasIcmpcode() bool {
+	return p.Icmpcode != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetIcmpcode() {
+	p.Icmpcode = nil
+}
+
+// Sets the 'icmptype' param: the ICMP type, for the icmp protocol.
+func (p *AuthorizeSecurityGroupEgressParams) SetIcmptype(v int) {
+	p.Icmptype = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetIcmptype() int {
+	if p.Icmptype == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Icmptype
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasIcmptype() bool {
+	return p.Icmptype != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetIcmptype() {
+	p.Icmptype = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *AuthorizeSecurityGroupEgressParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["protocol"] = v
+	return *p.Projectid
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	p.p["securitygroupid"] = v
+	return *p.Protocol
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupid(v string) {
+	p.Securitygroupid = &v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupEgressParams) GetSecuritygroupid() string {
+	if p.Securitygroupid == nil {
+		return ""
 	}
-	p.p["securitygroupname"] = v
+	return *p.Securitygroupid
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasSecuritygroupid() bool {
+	return p.Securitygroupid != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetSecuritygroupid() {
+	p.Securitygroupid = nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) SetSecuritygroupname(v string) {
+	p.Securitygroupname = &v
 	return
 }
 
+func (p *AuthorizeSecurityGroupEgressParams) GetSecuritygroupname() string {
+	if p.Securitygroupname == nil {
+		return ""
+	}
+	return *p.Securitygroupname
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasSecuritygroupname() bool {
+	return p.Securitygroupname != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetSecuritygroupname() {
+	p.Securitygroupname = nil
+}
+
+// Sets the 'startport' param: the start of the port range.
 func (p *AuthorizeSecurityGroupEgressParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.Startport = &v
+	return
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
 	}
-	p.p["startport"] = v
+	return *p.Startport
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasStartport() bool {
+	return p.Startport != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetStartport() {
+	p.Startport = nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v map[string]string) {
+	p.Usersecuritygrouplist = v
 	return
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetUsersecuritygrouplist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["usersecuritygrouplist"] = v
-	return
+func (p *AuthorizeSecurityGroupEgressParams) GetUsersecuritygrouplist() map[string]string {
+	return p.Usersecuritygrouplist
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) HasUsersecuritygrouplist() bool {
+	return p.Usersecuritygrouplist != nil
+}
+
+func (p *AuthorizeSecurityGroupEgressParams) ResetUsersecuritygrouplist() {
+	p.Usersecuritygrouplist = nil
 }
 
 // You should always use this function to get a new AuthorizeSecurityGroupEgressParams instance,
 // as then you are sure you have configured all required params
 func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams {
 	p := &AuthorizeSecurityGroupEgressParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Authorizes a particular egress rule for this security group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("authorizeSecurityGroupEgress", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("authorizeSecurityGroupEgress", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AuthorizeSecurityGroupEgressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("authorizeSecurityGroupEgress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -760,7 +1206,7 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurity
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("authorizeSecurityGroupEgress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -768,71 +1214,52 @@ func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurity
 }
 
 type AuthorizeSecurityGroupEgressResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Account           string `json:"account,omitempty"`
-	Cidr              string `json:"cidr,omitempty"`
-	Endport           int    `json:"endport,omitempty"`
-	Icmpcode          int    `json:"icmpcode,omitempty"`
-	Icmptype          int    `json:"icmptype,omitempty"`
-	Protocol          string `json:"protocol,omitempty"`
-	Ruleid            string `json:"ruleid,omitempty"`
-	Securitygroupname string `json:"securitygroupname,omitempty"`
-	Startport         int    `json:"startport,omitempty"`
-	Tags              []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-}
-
-type RevokeSecurityGroupEgressParams struct {
-	p map[string]interface{}
-}
-
-func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr              string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Endport           int                        `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode          int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype          int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Protocol          Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Ruleid            string                     `json:"ruleid,omitempty" xml:"ruleid,omitempty"`
+	Securitygroupname string                     `json:"securitygroupname,omitempty" xml:"securitygroupname,omitempty"`
+	Startport         int                        `json:"startport,omitempty" xml:"startport,omitempty"`
+	Tags              []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RevokeSecurityGroupEgressParams = cloudstackcommon.RevokeSecurityGroupEgressParams
+type RevokeSecurityGroupEgressResponse = cloudstackcommon.RevokeSecurityGroupEgressResponse
 
 // You should always use this function to get a new RevokeSecurityGroupEgressParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams {
 	p := &RevokeSecurityGroupEgressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a particular egress rule from this security group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newRequest("revokeSecurityGroupEgress", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("revokeSecurityGroupEgress", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevokeSecurityGroupEgressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("revokeSecurityGroupEgress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -848,335 +1275,463 @@ func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupE
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("revokeSecurityGroupEgress", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RevokeSecurityGroupEgressResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListSecurityGroupsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSecurityGroupsParams) toURLValues() url.Values {
+type AuthorizeSecurityGroupIngressParams struct {
+	Account               *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist              []string          `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid              *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endport               *int              `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Icmpcode              *int              `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype              *int              `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Projectid             *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Protocol              *Protocol         `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Securitygroupid       *string           `json:"securitygroupid,omitempty" yaml:"securitygroupid,omitempty"`
+	Securitygroupname     *string           `json:"securitygroupname,omitempty" yaml:"securitygroupname,omitempty"`
+	Startport             *int              `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Usersecuritygrouplist map[string]string `json:"usersecuritygrouplist,omitempty" yaml:"usersecuritygrouplist,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AuthorizeSecurityGroupIngressParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("endport", vv)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Icmpcode; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmpcode", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Icmptype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmptype", vv)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Securitygroupid; v != nil {
+		u.Set("securitygroupid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Securitygroupname; v != nil {
+		u.Set("securitygroupname", *v)
 	}
-	if v, found := p.p["securitygroupname"]; found {
-		u.Set("securitygroupname", v.(string))
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("startport", vv)
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Usersecuritygrouplist; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].account", i), k)
+			u.Set(fmt.Sprintf("usersecuritygrouplist[%d].group", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
 	return u
 }
 
-func (p *ListSecurityGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *AuthorizeSecurityGroupIngressParams) Command() string {
+	return "authorizeSecurityGroupIngress"
+}
+
+// Returns true if the API command starts an async job
+func (p *AuthorizeSecurityGroupIngressParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AuthorizeSecurityGroupIngressParams) ResponseType() interface{} {
+	return &AuthorizeSecurityGroupIngressResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AuthorizeSecurityGroupIngressParams) Validate() error {
+	v := &validator{api: "authorizeSecurityGroupIngress"}
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
 	}
-	p.p["account"] = v
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *AuthorizeSecurityGroupIngressParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+func (p *AuthorizeSecurityGroupIngressParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *AuthorizeSecurityGroupIngressParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
+	return *p.Domainid
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'endport' param: the end of the port range.
+func (p *AuthorizeSecurityGroupIngressParams) SetEndport(v int) {
+	p.Endport = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
 	}
-	p.p["keyword"] = v
+	return *p.Endport
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasEndport() bool {
+	return p.Endport != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetEndport() {
+	p.Endport = nil
+}
+
+// Sets the 'icmpcode' param: the ICMP code, for the icmp protocol.
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmpcode(v int) {
+	p.Icmpcode = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetIcmpcode() int {
+	if p.Icmpcode == nil {
+		return 0
 	}
-	p.p["listall"] = v
+	return *p.Icmpcode
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasIcmpcode() bool {
+	return p.Icmpcode != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetIcmpcode() {
+	p.Icmpcode = nil
+}
+
+// Sets the 'icmptype' param: the ICMP type, for the icmp protocol.
+func (p *AuthorizeSecurityGroupIngressParams) SetIcmptype(v int) {
+	p.Icmptype = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetIcmptype() int {
+	if p.Icmptype == nil {
+		return 0
 	}
-	p.p["page"] = v
+	return *p.Icmptype
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasIcmptype() bool {
+	return p.Icmptype != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetIcmptype() {
+	p.Icmptype = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *AuthorizeSecurityGroupIngressParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
+	return *p.Projectid
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Protocol
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupid(v string) {
+	p.Securitygroupid = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetSecuritygroupname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetSecuritygroupid() string {
+	if p.Securitygroupid == nil {
+		return ""
 	}
-	p.p["securitygroupname"] = v
+	return *p.Securitygroupid
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasSecuritygroupid() bool {
+	return p.Securitygroupid != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetSecuritygroupid() {
+	p.Securitygroupid = nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) SetSecuritygroupname(v string) {
+	p.Securitygroupname = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetSecuritygroupname() string {
+	if p.Securitygroupname == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Securitygroupname
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) HasSecuritygroupname() bool {
+	return p.Securitygroupname != nil
+}
+
+func (p *AuthorizeSecurityGroupIngressParams) ResetSecuritygroupname() {
+	p.Securitygroupname = nil
+}
+
+// Sets the 'startport' param: the start of the port range.
+func (p *AuthorizeSecurityGroupIngressParams) SetStartport(v int) {
+	p.Startport = &v
 	return
 }
 
-func (p *ListSecurityGroupsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
 	}
-	p.p["virtualmachineid"] = v
-	return
+	return *p.Startport
 }
 
-// You should always use this function to get a new ListSecurityGroupsParams instance,
-// as then you are sure you have configured all required params
-func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroupsParams {
-	p := &ListSecurityGroupsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *AuthorizeSecurityGroupIngressParams) HasStartport() bool {
+	return p.Startport != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SecurityGroupService) GetSecurityGroupID(keyword string) (string, error) {
-	p := &ListSecurityGroupsParams{}
-	p.p = make(map[string]interface{})
+func (p *AuthorizeSecurityGroupIngressParams) ResetStartport() {
+	p.Startport = nil
+}
 
-	p.p["keyword"] = keyword
+func (p *AuthorizeSecurityGroupIngressParams) SetUsersecuritygrouplist(v map[string]string) {
+	p.Usersecuritygrouplist = v
+	return
+}
 
-	l, err := s.ListSecurityGroups(p)
-	if err != nil {
-		return "", err
-	}
+func (p *AuthorizeSecurityGroupIngressParams) GetUsersecuritygrouplist() map[string]string {
+	return p.Usersecuritygrouplist
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
+func (p *AuthorizeSecurityGroupIngressParams) HasUsersecuritygrouplist() bool {
+	return p.Usersecuritygrouplist != nil
+}
 
-	if l.Count == 1 {
-		return l.SecurityGroups[0].Id, nil
-	}
+func (p *AuthorizeSecurityGroupIngressParams) ResetUsersecuritygrouplist() {
+	p.Usersecuritygrouplist = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.SecurityGroups {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+// You should always use this function to get a new AuthorizeSecurityGroupIngressParams instance,
+// as then you are sure you have configured all required params
+func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams {
+	p := &AuthorizeSecurityGroupIngressParams{}
+	return p
+}
+
+// Authorizes a particular ingress rule for this security group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
-}
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SecurityGroupService) GetSecurityGroupByName(name string) (*SecurityGroup, int, error) {
-	id, err := s.GetSecurityGroupID(name)
+	resp, err := s.cs.newRequest("authorizeSecurityGroupIngress", p.URLValues())
 	if err != nil {
-		return nil, -1, err
+		return nil, err
 	}
 
-	r, count, err := s.GetSecurityGroupByID(id)
-	if err != nil {
-		return nil, count, err
+	var r AuthorizeSecurityGroupIngressResponse
+	if err := s.cs.unmarshal("authorizeSecurityGroupIngress", resp, &r); err != nil {
+		return nil, err
 	}
-	return r, count, nil
-}
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SecurityGroupService) GetSecurityGroupByID(id string) (*SecurityGroup, int, error) {
-	p := &ListSecurityGroupsParams{}
-	p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-	p.p["id"] = id
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-	l, err := s.ListSecurityGroups(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		if err := s.cs.unmarshal("authorizeSecurityGroupIngress", b, &r); err != nil {
+			return nil, err
 		}
-		return nil, -1, err
 	}
+	return &r, nil
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+type AuthorizeSecurityGroupIngressResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr              string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Endport           int                        `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode          int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype          int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Protocol          Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Ruleid            string                     `json:"ruleid,omitempty" xml:"ruleid,omitempty"`
+	Securitygroupname string                     `json:"securitygroupname,omitempty" xml:"securitygroupname,omitempty"`
+	Startport         int                        `json:"startport,omitempty" xml:"startport,omitempty"`
+	Tags              []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RevokeSecurityGroupIngressParams = cloudstackcommon.RevokeSecurityGroupIngressParams
+type RevokeSecurityGroupIngressResponse = cloudstackcommon.RevokeSecurityGroupIngressResponse
 
-	if l.Count == 1 {
-		return l.SecurityGroups[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for SecurityGroup UUID: %s!", id)
+// You should always use this function to get a new RevokeSecurityGroupIngressParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams {
+	p := &RevokeSecurityGroupIngressParams{}
+	p.SetId(id)
+	return p
 }
 
-// Lists security groups
-func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listSecurityGroups", p.toURLValues())
+// Deletes a particular ingress rule from this security group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("revokeSecurityGroupIngress", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListSecurityGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RevokeSecurityGroupIngressResponse
+	if err := s.cs.unmarshal("revokeSecurityGroupIngress", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
 
-type ListSecurityGroupsResponse struct {
-	Count          int              `json:"count"`
-	SecurityGroups []*SecurityGroup `json:"securitygroup"`
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-type SecurityGroup struct {
-	Account     string `json:"account,omitempty"`
-	Description string `json:"description,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Egressrule  []struct {
-		Account           string `json:"account,omitempty"`
-		Cidr              string `json:"cidr,omitempty"`
-		Endport           int    `json:"endport,omitempty"`
-		Icmpcode          int    `json:"icmpcode,omitempty"`
-		Icmptype          int    `json:"icmptype,omitempty"`
-		Protocol          string `json:"protocol,omitempty"`
-		Ruleid            string `json:"ruleid,omitempty"`
-		Securitygroupname string `json:"securitygroupname,omitempty"`
-		Startport         int    `json:"startport,omitempty"`
-		Tags              []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"egressrule,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ingressrule []struct {
-		Account           string `json:"account,omitempty"`
-		Cidr              string `json:"cidr,omitempty"`
-		Endport           int    `json:"endport,omitempty"`
-		Icmpcode          int    `json:"icmpcode,omitempty"`
-		Icmptype          int    `json:"icmptype,omitempty"`
-		Protocol          string `json:"protocol,omitempty"`
-		Ruleid            string `json:"ruleid,omitempty"`
-		Securitygroupname string `json:"securitygroupname,omitempty"`
-		Startport         int    `json:"startport,omitempty"`
-		Tags              []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"ingressrule,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	Tags      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
+		if err := s.cs.unmarshal("revokeSecurityGroupIngress", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
@@ -0,0 +1,555 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateSecurityGroup(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"description": {"test-description"},
+		"domainid":    {"test-domainid"},
+		"name":        {"test-name"},
+		"projectid":   {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "createSecurityGroup", params, `{"createsecuritygroupresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewCreateSecurityGroupParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDescription("test-description")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "createSecurityGroup", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.CreateSecurityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSecurityGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createSecurityGroup" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createSecurityGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createSecurityGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createSecurityGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createSecurityGroup", params, `<createsecuritygroupresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></createsecuritygroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.CreateSecurityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSecurityGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSecurityGroup(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"id":        {"test-id"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "deleteSecurityGroup", params, `{"deletesecuritygroupresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewDeleteSecurityGroupParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "deleteSecurityGroup", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.DeleteSecurityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSecurityGroup: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSecurityGroup" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSecurityGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSecurityGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSecurityGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSecurityGroup", params, `<deletesecuritygroupresponse><displaytext>test-displaytext</displaytext><success>true</success></deletesecuritygroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.DeleteSecurityGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSecurityGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSecurityGroups(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"id":                {"test-id"},
+		"isrecursive":       {"true"},
+		"keyword":           {"test-keyword"},
+		"listall":           {"true"},
+		"page":              {"7"},
+		"pagesize":          {"8"},
+		"projectid":         {"test-projectid"},
+		"securitygroupname": {"test-securitygroupname"},
+		"tags[0].key":       {"test-tagskey"},
+		"tags[0].value":     {"test-tagsvalue"},
+		"virtualmachineid":  {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "listSecurityGroups", params, `{"listsecuritygroupsresponse":{"count":1,"securitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}]}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewListSecurityGroupsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetSecuritygroupname("test-securitygroupname")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "listSecurityGroups", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.ListSecurityGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSecurityGroups: %v", err)
+	}
+	if r.SecurityGroups[0].Account != "test-account" {
+		t.Errorf("Expected r.SecurityGroups[0].Account to be %v, got %v", "test-account", r.SecurityGroups[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSecurityGroups" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSecurityGroups: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSecurityGroups: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSecurityGroups: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SecurityGroup
+	count, err := cs.SecurityGroup.StreamSecurityGroups(context.Background(), p, func(v *SecurityGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSecurityGroups: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SecurityGroups) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SecurityGroups)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSecurityGroups", params, `<listsecuritygroupsresponse><count>1</count><securitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></securitygroup></listsecuritygroupsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.ListSecurityGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSecurityGroups using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.SecurityGroup.StreamSecurityGroups(context.Background(), p, func(v *SecurityGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSecurityGroups using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SecurityGroups) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SecurityGroups)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAuthorizeSecurityGroupEgress(t *testing.T) {
+	params := url.Values{
+		"account":                          {"test-account"},
+		"cidrlist":                         {"test-cidrlist1, test-cidrlist2"},
+		"domainid":                         {"test-domainid"},
+		"endport":                          {"4"},
+		"icmpcode":                         {"5"},
+		"icmptype":                         {"6"},
+		"projectid":                        {"test-projectid"},
+		"protocol":                         {"all"},
+		"securitygroupid":                  {"test-securitygroupid"},
+		"securitygroupname":                {"test-securitygroupname"},
+		"startport":                        {"11"},
+		"usersecuritygrouplist[0].account": {"test-usersecuritygrouplistkey"},
+		"usersecuritygrouplist[0].group":   {"test-usersecuritygrouplistvalue"},
+	}
+	cs, done := newTestClient(t, "authorizeSecurityGroupEgress", params, `{"authorizesecuritygroupegressresponse":{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"jobid":"test-jobid","protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewAuthorizeSecurityGroupEgressParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetDomainid("test-domainid")
+	p.SetEndport(4)
+	p.SetIcmpcode(5)
+	p.SetIcmptype(6)
+	p.SetProjectid("test-projectid")
+	p.SetProtocol(ProtocolAll)
+	p.SetSecuritygroupid("test-securitygroupid")
+	p.SetSecuritygroupname("test-securitygroupname")
+	p.SetStartport(11)
+	p.SetUsersecuritygrouplist(map[string]string{"test-usersecuritygrouplistkey": "test-usersecuritygrouplistvalue"})
+	checkURLValues(t, "authorizeSecurityGroupEgress", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.AuthorizeSecurityGroupEgress(p)
+	if err != nil {
+		t.Fatalf("Failed to call authorizeSecurityGroupEgress: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "authorizeSecurityGroupEgress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute authorizeSecurityGroupEgress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of authorizeSecurityGroupEgress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of authorizeSecurityGroupEgress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "authorizeSecurityGroupEgress", params, `<authorizesecuritygroupegressresponse><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><jobid>test-jobid</jobid><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></authorizesecuritygroupegressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.AuthorizeSecurityGroupEgress(p)
+	if err != nil {
+		t.Fatalf("Failed to call authorizeSecurityGroupEgress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRevokeSecurityGroupEgress(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "revokeSecurityGroupEgress", params, `{"revokesecuritygroupegressresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewRevokeSecurityGroupEgressParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "revokeSecurityGroupEgress", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.RevokeSecurityGroupEgress(p)
+	if err != nil {
+		t.Fatalf("Failed to call revokeSecurityGroupEgress: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "revokeSecurityGroupEgress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute revokeSecurityGroupEgress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of revokeSecurityGroupEgress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of revokeSecurityGroupEgress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "revokeSecurityGroupEgress", params, `<revokesecuritygroupegressresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></revokesecuritygroupegressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.RevokeSecurityGroupEgress(p)
+	if err != nil {
+		t.Fatalf("Failed to call revokeSecurityGroupEgress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAuthorizeSecurityGroupIngress(t *testing.T) {
+	params := url.Values{
+		"account":                          {"test-account"},
+		"cidrlist":                         {"test-cidrlist1, test-cidrlist2"},
+		"domainid":                         {"test-domainid"},
+		"endport":                          {"4"},
+		"icmpcode":                         {"5"},
+		"icmptype":                         {"6"},
+		"projectid":                        {"test-projectid"},
+		"protocol":                         {"all"},
+		"securitygroupid":                  {"test-securitygroupid"},
+		"securitygroupname":                {"test-securitygroupname"},
+		"startport":                        {"11"},
+		"usersecuritygrouplist[0].account": {"test-usersecuritygrouplistkey"},
+		"usersecuritygrouplist[0].group":   {"test-usersecuritygrouplistvalue"},
+	}
+	cs, done := newTestClient(t, "authorizeSecurityGroupIngress", params, `{"authorizesecuritygroupingressresponse":{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"jobid":"test-jobid","protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewAuthorizeSecurityGroupIngressParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetDomainid("test-domainid")
+	p.SetEndport(4)
+	p.SetIcmpcode(5)
+	p.SetIcmptype(6)
+	p.SetProjectid("test-projectid")
+	p.SetProtocol(ProtocolAll)
+	p.SetSecuritygroupid("test-securitygroupid")
+	p.SetSecuritygroupname("test-securitygroupname")
+	p.SetStartport(11)
+	p.SetUsersecuritygrouplist(map[string]string{"test-usersecuritygrouplistkey": "test-usersecuritygrouplistvalue"})
+	checkURLValues(t, "authorizeSecurityGroupIngress", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.AuthorizeSecurityGroupIngress(p)
+	if err != nil {
+		t.Fatalf("Failed to call authorizeSecurityGroupIngress: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "authorizeSecurityGroupIngress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute authorizeSecurityGroupIngress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of authorizeSecurityGroupIngress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of authorizeSecurityGroupIngress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "authorizeSecurityGroupIngress", params, `<authorizesecuritygroupingressresponse><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><jobid>test-jobid</jobid><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></authorizesecuritygroupingressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.AuthorizeSecurityGroupIngress(p)
+	if err != nil {
+		t.Fatalf("Failed to call authorizeSecurityGroupIngress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRevokeSecurityGroupIngress(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "revokeSecurityGroupIngress", params, `{"revokesecuritygroupingressresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.SecurityGroup.NewRevokeSecurityGroupIngressParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "revokeSecurityGroupIngress", p.URLValues(), params)
+
+	r, err := cs.SecurityGroup.RevokeSecurityGroupIngress(p)
+	if err != nil {
+		t.Fatalf("Failed to call revokeSecurityGroupIngress: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "revokeSecurityGroupIngress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute revokeSecurityGroupIngress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of revokeSecurityGroupIngress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of revokeSecurityGroupIngress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "revokeSecurityGroupIngress", params, `<revokesecuritygroupingressresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></revokesecuritygroupingressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SecurityGroup.RevokeSecurityGroupIngress(p)
+	if err != nil {
+		t.Fatalf("Failed to call revokeSecurityGroupIngress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
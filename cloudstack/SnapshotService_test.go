@@ -0,0 +1,917 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateSnapshot(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"policyid":  {"test-policyid"},
+		"quiescevm": {"true"},
+		"volumeid":  {"test-volumeid"},
+	}
+	cs, done := newTestClient(t, "createSnapshot", params, `{"createsnapshotresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","intervaltype":"test-intervaltype","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","revertable":true,"snapshottype":"test-snapshottype","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"volumeid":"test-volumeid","volumename":"test-volumename","volumetype":"test-volumetype","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Snapshot.NewCreateSnapshotParams("test-volumeid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPolicyid("test-policyid")
+	p.SetQuiescevm(true)
+	p.SetVolumeid("test-volumeid")
+	checkURLValues(t, "createSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.CreateSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSnapshot: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createSnapshot", params, `<createsnapshotresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><intervaltype>test-intervaltype</intervaltype><jobid>test-jobid</jobid><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><revertable>true</revertable><snapshottype>test-snapshottype</snapshottype><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><volumeid>test-volumeid</volumeid><volumename>test-volumename</volumename><volumetype>test-volumetype</volumetype><zoneid>test-zoneid</zoneid></createsnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.CreateSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSnapshot(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteSnapshot", params, `{"deletesnapshotresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Snapshot.NewDeleteSnapshotParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.DeleteSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSnapshot: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSnapshot", params, `<deletesnapshotresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletesnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.DeleteSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"intervaltype":  {"DAILY"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"name":          {"test-name"},
+		"page":          {"9"},
+		"pagesize":      {"10"},
+		"projectid":     {"test-projectid"},
+		"snapshottype":  {"test-snapshottype"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+		"volumeid":      {"test-volumeid"},
+		"zoneid":        {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listSnapshots", params, `{"listsnapshotsresponse":{"count":1,"snapshot":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","intervaltype":"test-intervaltype","name":"test-name","project":"test-project","projectid":"test-projectid","revertable":true,"snapshottype":"test-snapshottype","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"volumeid":"test-volumeid","volumename":"test-volumename","volumetype":"test-volumetype","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Snapshot.NewListSnapshotsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIntervaltype(SnapshotIntervalDaily)
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetSnapshottype("test-snapshottype")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVolumeid("test-volumeid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listSnapshots", p.URLValues(), params)
+
+	r, err := cs.Snapshot.ListSnapshots(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSnapshots: %v", err)
+	}
+	if r.Snapshots[0].Account != "test-account" {
+		t.Errorf("Expected r.Snapshots[0].Account to be %v, got %v", "test-account", r.Snapshots[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSnapshots" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSnapshots: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSnapshots: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSnapshots: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Snapshot
+	count, err := cs.Snapshot.StreamSnapshots(context.Background(), p, func(v *Snapshot) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSnapshots: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Snapshots) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Snapshots)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSnapshots", params, `<listsnapshotsresponse><count>1</count><snapshot><account>test-account</account><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><intervaltype>test-intervaltype</intervaltype><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><revertable>true</revertable><snapshottype>test-snapshottype</snapshottype><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><volumeid>test-volumeid</volumeid><volumename>test-volumename</volumename><volumetype>test-volumetype</volumetype><zoneid>test-zoneid</zoneid></snapshot></listsnapshotsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.ListSnapshots(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSnapshots using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Snapshot.StreamSnapshots(context.Background(), p, func(v *Snapshot) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSnapshots using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Snapshots) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Snapshots)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRevertSnapshot(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "revertSnapshot", params, `{"revertsnapshotresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","intervaltype":"test-intervaltype","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","revertable":true,"snapshottype":"test-snapshottype","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"volumeid":"test-volumeid","volumename":"test-volumename","volumetype":"test-volumetype","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Snapshot.NewRevertSnapshotParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "revertSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.RevertSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call revertSnapshot: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "revertSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute revertSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of revertSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of revertSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "revertSnapshot", params, `<revertsnapshotresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><intervaltype>test-intervaltype</intervaltype><jobid>test-jobid</jobid><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><revertable>true</revertable><snapshottype>test-snapshottype</snapshottype><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><volumeid>test-volumeid</volumeid><volumename>test-volumename</volumename><volumetype>test-volumetype</volumetype><zoneid>test-zoneid</zoneid></revertsnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.RevertSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call revertSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateSnapshotPolicy(t *testing.T) {
+	params := url.Values{
+		"fordisplay":   {"true"},
+		"intervaltype": {"DAILY"},
+		"maxsnaps":     {"3"},
+		"schedule":     {"test-schedule"},
+		"timezone":     {"test-timezone"},
+		"volumeid":     {"test-volumeid"},
+	}
+	cs, done := newTestClient(t, "createSnapshotPolicy", params, `{"createsnapshotpolicyresponse":{"fordisplay":true,"id":"test-id","intervaltype":1,"maxsnaps":1,"schedule":"test-schedule","timezone":"test-timezone","volumeid":"test-volumeid"}}`)
+	defer done()
+
+	p := cs.Snapshot.NewCreateSnapshotPolicyParams(SnapshotIntervalDaily, 3, "test-schedule", "test-timezone", "test-volumeid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFordisplay(true)
+	p.SetIntervaltype(SnapshotIntervalDaily)
+	p.SetMaxsnaps(3)
+	p.SetSchedule("test-schedule")
+	p.SetTimezone("test-timezone")
+	p.SetVolumeid("test-volumeid")
+	checkURLValues(t, "createSnapshotPolicy", p.URLValues(), params)
+
+	r, err := cs.Snapshot.CreateSnapshotPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSnapshotPolicy: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createSnapshotPolicy" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createSnapshotPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createSnapshotPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createSnapshotPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createSnapshotPolicy", params, `<createsnapshotpolicyresponse><fordisplay>true</fordisplay><id>test-id</id><intervaltype>1</intervaltype><maxsnaps>1</maxsnaps><schedule>test-schedule</schedule><timezone>test-timezone</timezone><volumeid>test-volumeid</volumeid></createsnapshotpolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.CreateSnapshotPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSnapshotPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSnapshotPolicies(t *testing.T) {
+	params := url.Values{
+		"id":  {"test-id"},
+		"ids": {"test-ids1, test-ids2"},
+	}
+	cs, done := newTestClient(t, "deleteSnapshotPolicies", params, `{"deletesnapshotpoliciesresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Snapshot.NewDeleteSnapshotPoliciesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	checkURLValues(t, "deleteSnapshotPolicies", p.URLValues(), params)
+
+	r, err := cs.Snapshot.DeleteSnapshotPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSnapshotPolicies: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSnapshotPolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSnapshotPolicies: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSnapshotPolicies: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSnapshotPolicies: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSnapshotPolicies", params, `<deletesnapshotpoliciesresponse><displaytext>test-displaytext</displaytext><success>true</success></deletesnapshotpoliciesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.DeleteSnapshotPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSnapshotPolicies using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSnapshotPolicies(t *testing.T) {
+	params := url.Values{
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+		"keyword":    {"test-keyword"},
+		"page":       {"4"},
+		"pagesize":   {"5"},
+		"volumeid":   {"test-volumeid"},
+	}
+	cs, done := newTestClient(t, "listSnapshotPolicies", params, `{"listsnapshotpoliciesresponse":{"count":1,"snapshotpolicy":[{"fordisplay":true,"id":"test-id","intervaltype":1,"maxsnaps":1,"schedule":"test-schedule","timezone":"test-timezone","volumeid":"test-volumeid"}]}}`)
+	defer done()
+
+	p := cs.Snapshot.NewListSnapshotPoliciesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetVolumeid("test-volumeid")
+	checkURLValues(t, "listSnapshotPolicies", p.URLValues(), params)
+
+	r, err := cs.Snapshot.ListSnapshotPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSnapshotPolicies: %v", err)
+	}
+	if r.SnapshotPolicies[0].Id != "test-id" {
+		t.Errorf("Expected r.SnapshotPolicies[0].Id to be %v, got %v", "test-id", r.SnapshotPolicies[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSnapshotPolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSnapshotPolicies: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSnapshotPolicies: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSnapshotPolicies: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SnapshotPolicy
+	count, err := cs.Snapshot.StreamSnapshotPolicies(context.Background(), p, func(v *SnapshotPolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSnapshotPolicies: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SnapshotPolicies) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SnapshotPolicies)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSnapshotPolicies", params, `<listsnapshotpoliciesresponse><count>1</count><snapshotpolicy><fordisplay>true</fordisplay><id>test-id</id><intervaltype>1</intervaltype><maxsnaps>1</maxsnaps><schedule>test-schedule</schedule><timezone>test-timezone</timezone><volumeid>test-volumeid</volumeid></snapshotpolicy></listsnapshotpoliciesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.ListSnapshotPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSnapshotPolicies using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Snapshot.StreamSnapshotPolicies(context.Background(), p, func(v *SnapshotPolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSnapshotPolicies using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SnapshotPolicies) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SnapshotPolicies)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateSnapshotPolicy(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateSnapshotPolicy", params, `{"updatesnapshotpolicyresponse":{"fordisplay":true,"id":"test-id","intervaltype":1,"jobid":"test-jobid","maxsnaps":1,"schedule":"test-schedule","timezone":"test-timezone","volumeid":"test-volumeid"}}`)
+	defer done()
+
+	p := cs.Snapshot.NewUpdateSnapshotPolicyParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateSnapshotPolicy", p.URLValues(), params)
+
+	r, err := cs.Snapshot.UpdateSnapshotPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateSnapshotPolicy: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateSnapshotPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateSnapshotPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateSnapshotPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateSnapshotPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateSnapshotPolicy", params, `<updatesnapshotpolicyresponse><fordisplay>true</fordisplay><id>test-id</id><intervaltype>1</intervaltype><jobid>test-jobid</jobid><maxsnaps>1</maxsnaps><schedule>test-schedule</schedule><timezone>test-timezone</timezone><volumeid>test-volumeid</volumeid></updatesnapshotpolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.UpdateSnapshotPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateSnapshotPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRevertToVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"vmsnapshotid": {"test-vmsnapshotid"},
+	}
+	cs, done := newTestClient(t, "revertToVMSnapshot", params, `{"reverttovmsnapshotresponse":{"account":"test-account","affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"diskioread":1,"diskiowrite":1,"diskkbsread":1,"diskkbswrite":1,"diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayname":"test-displayname","displayvm":true,"domain":"test-domain","domainid":"test-domainid","forvirtualnetwork":true,"group":"test-group","groupid":"test-groupid","guestosid":"test-guestosid","haenable":true,"hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","instancename":"test-instancename","isdynamicallyscalable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","keypair":"test-keypair","memory":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"ostypeid":1,"password":"test-password","passwordenabled":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","rootdeviceid":1,"rootdevicetype":"test-rootdevicetype","securitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","servicestate":"test-servicestate","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","vgpu":"test-vgpu","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Snapshot.NewRevertToVMSnapshotParams("test-vmsnapshotid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetVmsnapshotid("test-vmsnapshotid")
+	checkURLValues(t, "revertToVMSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.RevertToVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call revertToVMSnapshot: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "revertToVMSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute revertToVMSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of revertToVMSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of revertToVMSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "revertToVMSnapshot", params, `<reverttovmsnapshotresponse><account>test-account</account><affinitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></affinitygroup><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><diskioread>1</diskioread><diskiowrite>1</diskiowrite><diskkbsread>1</diskkbsread><diskkbswrite>1</diskkbswrite><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayname>test-displayname</displayname><displayvm>true</displayvm><domain>test-domain</domain><domainid>test-domainid</domainid><forvirtualnetwork>true</forvirtualnetwork><group>test-group</group><groupid>test-groupid</groupid><guestosid>test-guestosid</guestosid><haenable>true</haenable><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><instancename>test-instancename</instancename><isdynamicallyscalable>true</isdynamicallyscalable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><keypair>test-keypair</keypair><memory>1</memory><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><ostypeid>1</ostypeid><password>test-password</password><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><rootdeviceid>1</rootdeviceid><rootdevicetype>test-rootdevicetype</rootdevicetype><securitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></securitygroup><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><servicestate>test-servicestate</servicestate><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><vgpu>test-vgpu</vgpu><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></reverttovmsnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.RevertToVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call revertToVMSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"description":      {"test-description"},
+		"name":             {"test-name"},
+		"quiescevm":        {"true"},
+		"snapshotmemory":   {"true"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "createVMSnapshot", params, `{"createvmsnapshotresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","current":true,"description":"test-description","displayname":"test-displayname","domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","name":"test-name","parent":"test-parent","parentName":"test-parentName","project":"test-project","projectid":"test-projectid","state":"test-state","type":"test-type","virtualmachineid":"test-virtualmachineid","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Snapshot.NewCreateVMSnapshotParams("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetName("test-name")
+	p.SetQuiescevm(true)
+	p.SetSnapshotmemory(true)
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "createVMSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.CreateVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVMSnapshot: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVMSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVMSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVMSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVMSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVMSnapshot", params, `<createvmsnapshotresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><current>true</current><description>test-description</description><displayname>test-displayname</displayname><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><parent>test-parent</parent><parentName>test-parentName</parentName><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><zoneid>test-zoneid</zoneid></createvmsnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.CreateVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVMSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"vmsnapshotid": {"test-vmsnapshotid"},
+	}
+	cs, done := newTestClient(t, "deleteVMSnapshot", params, `{"deletevmsnapshotresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Snapshot.NewDeleteVMSnapshotParams("test-vmsnapshotid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetVmsnapshotid("test-vmsnapshotid")
+	checkURLValues(t, "deleteVMSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.DeleteVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVMSnapshot: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVMSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVMSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVMSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVMSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVMSnapshot", params, `<deletevmsnapshotresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletevmsnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.DeleteVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVMSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"domainid":         {"test-domainid"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"name":             {"test-name"},
+		"page":             {"7"},
+		"pagesize":         {"8"},
+		"projectid":        {"test-projectid"},
+		"state":            {"test-state"},
+		"tags[0].key":      {"test-tagskey"},
+		"tags[0].value":    {"test-tagsvalue"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"vmsnapshotid":     {"test-vmsnapshotid"},
+	}
+	cs, done := newTestClient(t, "listVMSnapshot", params, `{"listvmsnapshotresponse":{"count":1,"vmsnapshot":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","current":true,"description":"test-description","displayname":"test-displayname","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","parent":"test-parent","parentName":"test-parentName","project":"test-project","projectid":"test-projectid","state":"test-state","type":"test-type","virtualmachineid":"test-virtualmachineid","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Snapshot.NewListVMSnapshotParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetVmsnapshotid("test-vmsnapshotid")
+	checkURLValues(t, "listVMSnapshot", p.URLValues(), params)
+
+	r, err := cs.Snapshot.ListVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVMSnapshot: %v", err)
+	}
+	if r.VMSnapshot[0].Account != "test-account" {
+		t.Errorf("Expected r.VMSnapshot[0].Account to be %v, got %v", "test-account", r.VMSnapshot[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVMSnapshot" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVMSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVMSnapshot: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVMSnapshot: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VMSnapshot
+	count, err := cs.Snapshot.StreamVMSnapshot(context.Background(), p, func(v *VMSnapshot) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVMSnapshot: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VMSnapshot) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VMSnapshot)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVMSnapshot", params, `<listvmsnapshotresponse><count>1</count><vmsnapshot><account>test-account</account><created>2014-01-02T15:04:05+0100</created><current>true</current><description>test-description</description><displayname>test-displayname</displayname><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><parent>test-parent</parent><parentName>test-parentName</parentName><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><zoneid>test-zoneid</zoneid></vmsnapshot></listvmsnapshotresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Snapshot.ListVMSnapshot(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVMSnapshot using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Snapshot.StreamVMSnapshot(context.Background(), p, func(v *VMSnapshot) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVMSnapshot using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VMSnapshot) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VMSnapshot)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,116 +17,52 @@
 package cloudstack
 
 import (
-	"encoding/json"
-	"net/url"
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddStratosphereSspParams struct {
-	p map[string]interface{}
+type StratosphereSSPService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddStratosphereSspParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["tenantuuid"]; found {
-		u.Set("tenantuuid", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *AddStratosphereSspParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *AddStratosphereSspParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
-}
-
-func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tenantuuid"] = v
-	return
-}
-
-func (p *AddStratosphereSspParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+func NewStratosphereSSPService(cs *CloudStackClient) *StratosphereSSPService {
+	return &StratosphereSSPService{cs: cs}
 }
 
-func (p *AddStratosphereSspParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
-
-func (p *AddStratosphereSspParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type AddStratosphereSspParams = cloudstackcommon.AddStratosphereSspParams
+type AddStratosphereSspResponse = cloudstackcommon.AddStratosphereSspResponse
 
 // You should always use this function to get a new AddStratosphereSspParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
+//   - url
+//   - zoneid: the ID of the zone
 func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams {
 	p := &AddStratosphereSspParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Adds stratosphere ssp server
 func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
-	resp, err := s.cs.newRequest("addStratosphereSsp", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addStratosphereSsp", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddStratosphereSspResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addStratosphereSsp", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type AddStratosphereSspResponse struct {
-	Hostid string `json:"hostid,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Url    string `json:"url,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
@@ -0,0 +1,180 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddSwift(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"key":      {"test-key"},
+		"url":      {"test-url"},
+		"username": {"test-username"},
+	}
+	cs, done := newTestClient(t, "addSwift", params, `{"addswiftresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Swift.NewAddSwiftParams("test-url")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetKey("test-key")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addSwift", p.URLValues(), params)
+
+	r, err := cs.Swift.AddSwift(p)
+	if err != nil {
+		t.Fatalf("Failed to call addSwift: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addSwift" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addSwift: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addSwift: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addSwift: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addSwift", params, `<addswiftresponse><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></addswiftresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Swift.AddSwift(p)
+	if err != nil {
+		t.Fatalf("Failed to call addSwift using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSwifts(t *testing.T) {
+	params := url.Values{
+		"id":       {"1"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+	}
+	cs, done := newTestClient(t, "listSwifts", params, `{"listswiftsresponse":{"count":1,"swift":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Swift.NewListSwiftsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId(1)
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listSwifts", p.URLValues(), params)
+
+	r, err := cs.Swift.ListSwifts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSwifts: %v", err)
+	}
+	if r.Swifts[0].Id != "test-id" {
+		t.Errorf("Expected r.Swifts[0].Id to be %v, got %v", "test-id", r.Swifts[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSwifts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSwifts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSwifts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSwifts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Swift
+	count, err := cs.Swift.StreamSwifts(context.Background(), p, func(v *Swift) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSwifts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Swifts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Swifts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSwifts", params, `<listswiftsresponse><count>1</count><swift><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></swift></listswiftsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Swift.ListSwifts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSwifts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Swift.StreamSwifts(context.Background(), p, func(v *Swift) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSwifts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Swifts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Swifts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
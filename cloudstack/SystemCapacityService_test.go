@@ -0,0 +1,126 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListCapacity(t *testing.T) {
+	params := url.Values{
+		"clusterid":   {"test-clusterid"},
+		"fetchlatest": {"true"},
+		"keyword":     {"test-keyword"},
+		"page":        {"4"},
+		"pagesize":    {"5"},
+		"podid":       {"test-podid"},
+		"sortby":      {"test-sortby"},
+		"type":        {"8"},
+		"zoneid":      {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listCapacity", params, `{"listcapacityresponse":{"capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"count":1}}`)
+	defer done()
+
+	p := cs.SystemCapacity.NewListCapacityParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	p.SetFetchlatest(true)
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetPodid("test-podid")
+	p.SetSortby("test-sortby")
+	p.SetType(8)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listCapacity", p.URLValues(), params)
+
+	r, err := cs.SystemCapacity.ListCapacity(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCapacity: %v", err)
+	}
+	if r.Capacity[0].Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Capacity[0].Clusterid to be %v, got %v", "test-clusterid", r.Capacity[0].Clusterid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listCapacity" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listCapacity: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listCapacity: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listCapacity: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Capacity
+	count, err := cs.SystemCapacity.StreamCapacity(context.Background(), p, func(v *Capacity) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listCapacity: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Capacity) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Capacity)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listCapacity", params, `<listcapacityresponse><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><count>1</count></listcapacityresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemCapacity.ListCapacity(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCapacity using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.SystemCapacity.StreamCapacity(context.Background(), p, func(v *Capacity) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listCapacity using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Capacity) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Capacity)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,221 +17,153 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateTemplateParams struct {
-	p map[string]interface{}
+type TemplateService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateTemplateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bits"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("bits", vv)
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
-	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
-	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
-	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
-	}
-	if v, found := p.p["snapshotid"]; found {
-		u.Set("snapshotid", v.(string))
-	}
-	if v, found := p.p["templatetag"]; found {
-		u.Set("templatetag", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
-	}
-	return u
+func NewTemplateService(cs *CloudStackClient) *TemplateService {
+	return &TemplateService{cs: cs}
 }
 
-func (p *CreateTemplateParams) SetBits(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bits"] = v
-	return
-}
+type UpgradeRouterTemplateParams = cloudstackcommon.UpgradeRouterTemplateParams
+type UpgradeRouterTemplateResponse = cloudstackcommon.UpgradeRouterTemplateResponse
 
-func (p *CreateTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// You should always use this function to get a new UpgradeRouterTemplateParams instance,
+// as then you are sure you have configured all required params
+func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams {
+	p := &UpgradeRouterTemplateParams{}
+	return p
 }
 
-func (p *CreateTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Upgrades router to use newer template
+func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["displaytext"] = v
-	return
-}
 
-func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("upgradeRouterTemplate", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["isdynamicallyscalable"] = v
-	return
-}
 
-func (p *CreateTemplateParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpgradeRouterTemplateResponse
+	if err := s.cs.unmarshal("upgradeRouterTemplate", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["isfeatured"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreateTemplateParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
-	return
-}
+type CopyTemplateParams = cloudstackcommon.CopyTemplateParams
+type CopyTemplateResponse = cloudstackcommon.CopyTemplateResponse
 
-func (p *CreateTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *CreateTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
-	return
+// You should always use this function to get a new CopyTemplateParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - destzoneid
+//   - id
+func (s *TemplateService) NewCopyTemplateParams(destzoneid string, id string) *CopyTemplateParams {
+	p := &CopyTemplateParams{}
+	p.SetDestzoneid(destzoneid)
+	p.SetId(id)
+	return p
 }
 
-func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Copies a template from one zone to another.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["passwordenabled"] = v
-	return
-}
 
-func (p *CreateTemplateParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("copyTemplate", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["requireshvm"] = v
-	return
-}
 
-func (p *CreateTemplateParams) SetSnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r CopyTemplateResponse
+	if err := s.cs.unmarshal("copyTemplate", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["snapshotid"] = v
-	return
-}
 
-func (p *CreateTemplateParams) SetTemplatetag(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templatetag"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *CreateTemplateParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("copyTemplate", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["virtualmachineid"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreateTemplateParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
-	return
-}
+type CreateTemplateParams = cloudstackcommon.CreateTemplateParams
+type CreateTemplateResponse = cloudstackcommon.CreateTemplateResponse
 
 // You should always use this function to get a new CreateTemplateParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
+//   - ostypeid: the ID of the OS type
 func (s *TemplateService) NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams {
 	p := &CreateTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["ostypeid"] = ostypeid
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetOstypeid(ostypeid)
 	return p
 }
 
 // Creates a template of a virtual machine. The virtual machine must be in a STOPPED state. A template created from this command is automatically designated as a private template visible to the account that created it.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
 func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("createTemplate", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createTemplate", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -252,1354 +184,839 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createTemplate", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateTemplateResponse struct {
-	JobID                 string            `json:"jobid,omitempty"`
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type RegisterTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *RegisterTemplateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["bits"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("bits", vv)
-	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
-	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
-	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrouting", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
-	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["requireshvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("requireshvm", vv)
-	}
-	if v, found := p.p["sshkeyenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sshkeyenabled", vv)
-	}
-	if v, found := p.p["templatetag"]; found {
-		u.Set("templatetag", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *RegisterTemplateParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetBits(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bits"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["checksum"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrouting"] = v
-	return
-}
-
-func (p *RegisterTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type DeleteTemplateParams = cloudstackcommon.DeleteTemplateParams
+type DeleteTemplateResponse = cloudstackcommon.DeleteTemplateResponse
 
-func (p *RegisterTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ostypeid"] = v
-	return
+// You should always use this function to get a new DeleteTemplateParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
+	p := &DeleteTemplateParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Deletes a template from the system. All virtual machines using the deleted template will not be affected.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["passwordenabled"] = v
-	return
-}
 
-func (p *RegisterTemplateParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteTemplate", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteTemplateResponse
+	if err := s.cs.unmarshal("deleteTemplate", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["requireshvm"] = v
-	return
-}
 
-func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sshkeyenabled"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *RegisterTemplateParams) SetTemplatetag(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("deleteTemplate", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["templatetag"] = v
-	return
+	return &r, nil
 }
 
-func (p *RegisterTemplateParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
+type ExtractTemplateParams = cloudstackcommon.ExtractTemplateParams
+type ExtractTemplateResponse = cloudstackcommon.ExtractTemplateResponse
 
-func (p *RegisterTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
-
-// You should always use this function to get a new RegisterTemplateParams instance,
+// You should always use this function to get a new ExtractTemplateParams instance,
 // as then you are sure you have configured all required params
-func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, ostypeid string, url string, zoneid string) *RegisterTemplateParams {
-	p := &RegisterTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["format"] = format
-	p.p["hypervisor"] = hypervisor
-	p.p["name"] = name
-	p.p["ostypeid"] = ostypeid
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - id
+//   - mode
+func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams {
+	p := &ExtractTemplateParams{}
+	p.SetId(id)
+	p.SetMode(mode)
 	return p
 }
 
-// Registers an existing template into the CloudStack cloud.
-func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("registerTemplate", p.toURLValues())
+// Extracts a template
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("extractTemplate", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RegisterTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ExtractTemplateResponse
+	if err := s.cs.unmarshal("extractTemplate", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
 
-type RegisterTemplateResponse struct {
-	Count            int                 `json:"count"`
-	RegisterTemplate []*RegisterTemplate `json:"template"`
-}
-
-type RegisterTemplate struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-type UpdateTemplateParams struct {
-	p map[string]interface{}
-}
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-func (p *UpdateTemplateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bootable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("bootable", vv)
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+		if err := s.cs.unmarshal("extractTemplate", b, &r); err != nil {
+			return nil, err
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
-	}
-	if v, found := p.p["isrouting"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrouting", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
-	}
-	if v, found := p.p["passwordenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passwordenabled", vv)
-	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
-	}
-	return u
+	return &r, nil
 }
 
-func (p *UpdateTemplateParams) SetBootable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bootable"] = v
-	return
-}
+type ListTemplatesParams = cloudstackcommon.ListTemplatesParams
+type ListTemplatesResponse = cloudstackcommon.ListTemplatesResponse
+type Template = cloudstackcommon.Template
 
-func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// You should always use this function to get a new ListTemplatesParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - templatefilter
+func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTemplatesParams {
+	p := &ListTemplatesParams{}
+	p.SetTemplatefilter(templatefilter)
+	return p
 }
 
-func (p *UpdateTemplateParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
-}
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *TemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, error) {
+	p := &ListTemplatesParams{}
 
-func (p *UpdateTemplateParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["format"] = v
-	return
-}
+	p.SetName(name)
+	p.SetTemplatefilter(templatefilter)
+	p.SetZoneid(zoneid)
 
-func (p *UpdateTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListTemplates(p)
+	if err != nil {
+		return "", err
 	}
-	p.p["isdynamicallyscalable"] = v
-	return
-}
 
-func (p *UpdateTemplateParams) SetIsrouting(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListTemplates(p)
+		if err != nil {
+			return "", err
+		}
 	}
-	p.p["isrouting"] = v
-	return
-}
 
-func (p *UpdateTemplateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *UpdateTemplateParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.Templates[0].Id, nil
 	}
-	p.p["ostypeid"] = v
-	return
-}
 
-func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var matches []*Template
+	for _, v := range l.Templates {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
 	}
-	p.p["passwordenabled"] = v
-	return
-}
-
-func (p *UpdateTemplateParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Template", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
 	}
-	p.p["sortkey"] = v
-	return
-}
-
-// You should always use this function to get a new UpdateTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplateParams {
-	p := &UpdateTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-// Updates attributes of a template.
-func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplate", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *TemplateService) GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error) {
+	id, err := s.GetTemplateID(name, templatefilter, zoneid, opts...)
 	if err != nil {
-		return nil, err
+		return nil, -1, err
 	}
 
-	var r UpdateTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	r, count, err := s.GetTemplateByID(id, templatefilter, opts...)
+	if err != nil {
+		return nil, count, err
 	}
-	return &r, nil
+	return r, count, nil
 }
 
-type UpdateTemplateResponse struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type CopyTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *CopyTemplateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["destzoneid"]; found {
-		u.Set("destzoneid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
+	p := &ListTemplatesParams{}
+
+	p.SetId(id)
+	p.SetTemplatefilter(templatefilter)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["sourcezoneid"]; found {
-		u.Set("sourcezoneid", v.(string))
+
+	l, err := s.ListTemplates(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	return u
-}
 
-func (p *CopyTemplateParams) SetDestzoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListTemplates(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
 	}
-	p.p["destzoneid"] = v
-	return
-}
 
-func (p *CopyTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *CopyTemplateParams) SetSourcezoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.Templates[0], l.Count, nil
 	}
-	p.p["sourcezoneid"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for Template UUID: %s!", id)
 }
 
-// You should always use this function to get a new CopyTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewCopyTemplateParams(destzoneid string, id string) *CopyTemplateParams {
-	p := &CopyTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["destzoneid"] = destzoneid
-	p.p["id"] = id
-	return p
-}
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *TemplateService) GetTemplatesByTags(tags map[string]string, templatefilter string, opts ...OptionFunc) ([]*Template, error) {
+	p := &ListTemplatesParams{}
 
-// Copies a template from one zone to another.
-func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
-	resp, err := s.cs.newRequest("copyTemplate", p.toURLValues())
-	if err != nil {
-		return nil, err
+	p.SetTags(tags)
+	p.SetTemplatefilter(templatefilter)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
 
-	var r CopyTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	l, err := s.ListTemplates(p)
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListTemplates(p)
 		if err != nil {
 			return nil, err
 		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+	return l.Templates, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+// List all public, private, and privileged templates.
+//
+// See also GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
 
-type CopyTemplateResponse struct {
-	JobID                 string            `json:"jobid,omitempty"`
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type DeleteTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteTemplateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	resp, err := s.cs.newRequest("listTemplates", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *DeleteTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListTemplatesResponse
+	if err := s.cs.unmarshal("listTemplates", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
-func (p *DeleteTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListTemplates, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *TemplateService) StreamTemplates(ctx context.Context, p *ListTemplatesParams, fn func(*Template) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
+
+	return s.cs.streamList(ctx, "listTemplates", p.URLValues(), "template", func(b json.RawMessage) error {
+		var v Template
+		if err := s.cs.unmarshalElement("listTemplates", "template", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new DeleteTemplateParams instance,
+type PrepareTemplateParams = cloudstackcommon.PrepareTemplateParams
+type PrepareTemplateResponse = cloudstackcommon.PrepareTemplateResponse
+
+// You should always use this function to get a new PrepareTemplateParams instance,
 // as then you are sure you have configured all required params
-func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplateParams {
-	p := &DeleteTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - templateid: the ID of the template
+//   - zoneid: the ID of the zone
+func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams {
+	p := &PrepareTemplateParams{}
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Deletes a template from the system. All virtual machines using the deleted template will not be affected.
-func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
-	resp, err := s.cs.newRequest("deleteTemplate", p.toURLValues())
+// load template into primary storage
+//
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("prepareTemplate", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r PrepareTemplateResponse
+	if err := s.cs.unmarshal("prepareTemplate", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+type RegisterTemplateParams = cloudstackcommon.RegisterTemplateParams
+type RegisterTemplateResponse = cloudstackcommon.RegisterTemplateResponse
+type RegisterTemplate = cloudstackcommon.RegisterTemplate
+
+// You should always use this function to get a new RegisterTemplateParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - format
+//   - hypervisor: the hypervisor, like KVM, XenServer or VMware
+//   - name
+//   - ostypeid: the ID of the OS type
+//   - url
+//   - zoneid: the ID of the zone
+func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor HypervisorType, name string, ostypeid string, url string, zoneid string) *RegisterTemplateParams {
+	p := &RegisterTemplateParams{}
+	p.SetDisplaytext(displaytext)
+	p.SetFormat(format)
+	p.SetHypervisor(hypervisor)
+	p.SetName(name)
+	p.SetOstypeid(ostypeid)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
+	return p
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+// Registers an existing template into the CloudStack cloud.
+//
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
 
-type DeleteTemplateResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+	resp, err := s.cs.newRequest("registerTemplate", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
 
-type ListTemplatesParams struct {
-	p map[string]interface{}
+	var r RegisterTemplateResponse
+	if err := s.cs.unmarshal("registerTemplate", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-func (p *ListTemplatesParams) toURLValues() url.Values {
+type UpdateTemplateParams struct {
+	Bootable              *bool             `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Details               map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Format                *string           `json:"format,omitempty" yaml:"format,omitempty"`
+	Id                    *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdynamicallyscalable *bool             `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isrouting             *bool             `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string           `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool             `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Sortkey               *int              `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateTemplateParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Bootable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("bootable", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Format; v != nil {
+		u.Set("format", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Isdynamicallyscalable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isdynamicallyscalable", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Isrouting; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrouting", vv)
 	}
-	if v, found := p.p["showremoved"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showremoved", vv)
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
 	}
-	if v, found := p.p["templatefilter"]; found {
-		u.Set("templatefilter", v.(string))
+	if v := p.Passwordenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("passwordenabled", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Sortkey; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("sortkey", vv)
 	}
 	return u
 }
 
-func (p *ListTemplatesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateTemplateParams) Command() string {
+	return "updateTemplate"
 }
 
-func (p *ListTemplatesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateTemplateParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListTemplatesParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateTemplateParams) ResponseType() interface{} {
+	return &UpdateTemplateResponse{}
 }
 
-func (p *ListTemplatesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateTemplateParams) Validate() error {
+	v := &validator{api: "updateTemplate"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *ListTemplatesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+func (p *UpdateTemplateParams) SetBootable(v bool) {
+	p.Bootable = &v
 	return
 }
 
-func (p *ListTemplatesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateTemplateParams) GetBootable() bool {
+	if p.Bootable == nil {
+		return false
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Bootable
 }
 
-func (p *ListTemplatesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *UpdateTemplateParams) HasBootable() bool {
+	return p.Bootable != nil
 }
 
-func (p *ListTemplatesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *UpdateTemplateParams) ResetBootable() {
+	p.Bootable = nil
 }
 
-func (p *ListTemplatesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Only supported by CloudStack 4.4.
+func (p *UpdateTemplateParams) SetDetails(v map[string]string) {
+	p.Details = v
 	return
 }
 
-func (p *ListTemplatesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *UpdateTemplateParams) GetDetails() map[string]string {
+	return p.Details
 }
 
-func (p *ListTemplatesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+func (p *UpdateTemplateParams) HasDetails() bool {
+	return p.Details != nil
 }
 
-func (p *ListTemplatesParams) SetShowremoved(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showremoved"] = v
-	return
+func (p *UpdateTemplateParams) ResetDetails() {
+	p.Details = nil
 }
 
-func (p *ListTemplatesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateTemplateParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *ListTemplatesParams) SetTemplatefilter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateTemplateParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["templatefilter"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *ListTemplatesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+func (p *UpdateTemplateParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
 }
 
-// You should always use this function to get a new ListTemplatesParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTemplatesParams {
-	p := &ListTemplatesParams{}
-	p.p = make(map[string]interface{})
-	p.p["templatefilter"] = templatefilter
-	return p
+func (p *UpdateTemplateParams) ResetDisplaytext() {
+	p.Displaytext = nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *TemplateService) GetTemplateID(name string, templatefilter string, zoneid string) (string, error) {
-	p := &ListTemplatesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
-	p.p["templatefilter"] = templatefilter
-	p.p["zoneid"] = zoneid
+func (p *UpdateTemplateParams) SetFormat(v string) {
+	p.Format = &v
+	return
+}
 
-	l, err := s.ListTemplates(p)
-	if err != nil {
-		return "", err
+func (p *UpdateTemplateParams) GetFormat() string {
+	if p.Format == nil {
+		return ""
 	}
+	return *p.Format
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *UpdateTemplateParams) HasFormat() bool {
+	return p.Format != nil
+}
 
-	if l.Count == 1 {
-		return l.Templates[0].Id, nil
-	}
+func (p *UpdateTemplateParams) ResetFormat() {
+	p.Format = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.Templates {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+// This param is required.
+func (p *UpdateTemplateParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *TemplateService) GetTemplateByName(name string, templatefilter string, zoneid string) (*Template, int, error) {
-	id, err := s.GetTemplateID(name, templatefilter, zoneid)
-	if err != nil {
-		return nil, -1, err
+func (p *UpdateTemplateParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
+	return *p.Id
+}
 
-	r, count, err := s.GetTemplateByID(id, templatefilter)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *UpdateTemplateParams) HasId() bool {
+	return p.Id != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *TemplateService) GetTemplateByID(id string, templatefilter string) (*Template, int, error) {
-	p := &ListTemplatesParams{}
-	p.p = make(map[string]interface{})
+func (p *UpdateTemplateParams) ResetId() {
+	p.Id = nil
+}
 
-	p.p["id"] = id
-	p.p["templatefilter"] = templatefilter
+func (p *UpdateTemplateParams) SetIsdynamicallyscalable(v bool) {
+	p.Isdynamicallyscalable = &v
+	return
+}
 
-	l, err := s.ListTemplates(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *UpdateTemplateParams) GetIsdynamicallyscalable() bool {
+	if p.Isdynamicallyscalable == nil {
+		return false
 	}
+	return *p.Isdynamicallyscalable
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *UpdateTemplateParams) HasIsdynamicallyscalable() bool {
+	return p.Isdynamicallyscalable != nil
+}
 
-	if l.Count == 1 {
-		return l.Templates[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Template UUID: %s!", id)
+func (p *UpdateTemplateParams) ResetIsdynamicallyscalable() {
+	p.Isdynamicallyscalable = nil
 }
 
-// List all public, private, and privileged templates.
-func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplates", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdateTemplateParams) SetIsrouting(v bool) {
+	p.Isrouting = &v
+	return
+}
 
-	var r ListTemplatesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdateTemplateParams) GetIsrouting() bool {
+	if p.Isrouting == nil {
+		return false
 	}
-	return &r, nil
+	return *p.Isrouting
 }
 
-type ListTemplatesResponse struct {
-	Count     int         `json:"count"`
-	Templates []*Template `json:"template"`
-}
-
-type Template struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type UpdateTemplatePermissionsParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accounts"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("accounts", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isextractable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isextractable", vv)
-	}
-	if v, found := p.p["isfeatured"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isfeatured", vv)
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["op"]; found {
-		u.Set("op", v.(string))
-	}
-	if v, found := p.p["projectids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("projectids", vv)
-	}
-	return u
+func (p *UpdateTemplateParams) HasIsrouting() bool {
+	return p.Isrouting != nil
+}
+
+func (p *UpdateTemplateParams) ResetIsrouting() {
+	p.Isrouting = nil
 }
 
-func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounts"] = v
+func (p *UpdateTemplateParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UpdateTemplatePermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateTemplateParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Name
 }
 
-func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isextractable"] = v
-	return
+func (p *UpdateTemplateParams) HasName() bool {
+	return p.Name != nil
 }
 
-func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isfeatured"] = v
-	return
+func (p *UpdateTemplateParams) ResetName() {
+	p.Name = nil
 }
 
-func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ispublic"] = v
+// Sets the 'ostypeid' param: the ID of the OS type.
+func (p *UpdateTemplateParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
 	return
 }
 
-func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateTemplateParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
 	}
-	p.p["op"] = v
+	return *p.Ostypeid
+}
+
+func (p *UpdateTemplateParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
+
+func (p *UpdateTemplateParams) ResetOstypeid() {
+	p.Ostypeid = nil
+}
+
+func (p *UpdateTemplateParams) SetPasswordenabled(v bool) {
+	p.Passwordenabled = &v
 	return
 }
 
-func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateTemplateParams) GetPasswordenabled() bool {
+	if p.Passwordenabled == nil {
+		return false
 	}
-	p.p["projectids"] = v
-	return
+	return *p.Passwordenabled
 }
 
-// You should always use this function to get a new UpdateTemplatePermissionsParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams {
-	p := &UpdateTemplatePermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *UpdateTemplateParams) HasPasswordenabled() bool {
+	return p.Passwordenabled != nil
 }
 
-// Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A priviledged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.
-func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("updateTemplatePermissions", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdateTemplateParams) ResetPasswordenabled() {
+	p.Passwordenabled = nil
+}
 
-	var r UpdateTemplatePermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdateTemplateParams) SetSortkey(v int) {
+	p.Sortkey = &v
+	return
+}
+
+func (p *UpdateTemplateParams) GetSortkey() int {
+	if p.Sortkey == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Sortkey
 }
 
-type UpdateTemplatePermissionsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+func (p *UpdateTemplateParams) HasSortkey() bool {
+	return p.Sortkey != nil
 }
 
-type ListTemplatePermissionsParams struct {
-	p map[string]interface{}
+func (p *UpdateTemplateParams) ResetSortkey() {
+	p.Sortkey = nil
 }
 
-func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// You should always use this function to get a new UpdateTemplateParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplateParams {
+	p := &UpdateTemplateParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates attributes of a template.
+//
+// See also ListTemplates, GetTemplateID, GetTemplateByName, GetTemplateByID, GetTemplatesByTags.
+func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	resp, err := s.cs.newRequest("updateTemplate", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *ListTemplatePermissionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateTemplateResponse
+	if err := s.cs.unmarshal("updateTemplate", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
+type UpdateTemplateResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTemplatePermissionsParams = cloudstackcommon.ListTemplatePermissionsParams
+type ListTemplatePermissionsResponse = cloudstackcommon.ListTemplatePermissionsResponse
+type TemplatePermission = cloudstackcommon.TemplatePermission
+
 // You should always use this function to get a new ListTemplatePermissionsParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *TemplateService) NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams {
 	p := &ListTemplatePermissionsParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *TemplateService) GetTemplatePermissionByID(id string) (*TemplatePermission, int, error) {
+func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
 	p := &ListTemplatePermissionsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
-	p.p["id"] = id
+	p.SetId(id)
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListTemplatePermissions(p)
 	if err != nil {
@@ -1622,361 +1039,481 @@ func (s *TemplateService) GetTemplatePermissionByID(id string) (*TemplatePermiss
 }
 
 // List template visibility and all accounts that have permissions to view this template.
+//
+// See also GetTemplatePermissionByID.
 func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listTemplatePermissions", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listTemplatePermissions", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTemplatePermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listTemplatePermissions", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListTemplatePermissionsResponse struct {
-	Count               int                   `json:"count"`
-	TemplatePermissions []*TemplatePermission `json:"templatepermission"`
+// Lists the objects like ListTemplatePermissions, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *TemplateService) StreamTemplatePermissions(ctx context.Context, p *ListTemplatePermissionsParams, fn func(*TemplatePermission) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listTemplatePermissions", p.URLValues(), "templatepermission", func(b json.RawMessage) error {
+		var v TemplatePermission
+		if err := s.cs.unmarshalElement("listTemplatePermissions", "templatepermission", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type UpdateTemplatePermissionsParams = cloudstackcommon.UpdateTemplatePermissionsParams
+type UpdateTemplatePermissionsResponse = cloudstackcommon.UpdateTemplatePermissionsResponse
+
+// You should always use this function to get a new UpdateTemplatePermissionsParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams {
+	p := &UpdateTemplatePermissionsParams{}
+	p.SetId(id)
+	return p
 }
 
-type TemplatePermission struct {
-	Account    []string `json:"account,omitempty"`
-	Domainid   string   `json:"domainid,omitempty"`
-	Id         string   `json:"id,omitempty"`
-	Ispublic   bool     `json:"ispublic,omitempty"`
-	Projectids []string `json:"projectids,omitempty"`
+// Updates a template visibility permissions. A public template is visible to all accounts within the same domain. A private template is visible only to the owner of the template. A priviledged template is a private template with account permissions added. Only accounts specified under the template permissions are visible to them.
+//
+// See also ListTemplatePermissions, GetTemplatePermissionByID.
+func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateTemplatePermissions", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateTemplatePermissionsResponse
+	if err := s.cs.unmarshal("updateTemplatePermissions", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-type ExtractTemplateParams struct {
-	p map[string]interface{}
+type ListUcsTemplatesParams struct {
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
 }
 
-func (p *ExtractTemplateParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUcsTemplatesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
 	}
 	return u
 }
 
-func (p *ExtractTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *ListUcsTemplatesParams) Command() string {
+	return "listUcsTemplates"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUcsTemplatesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUcsTemplatesParams) ResponseType() interface{} {
+	return &ListUcsTemplatesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUcsTemplatesParams) Validate() error {
+	v := &validator{api: "listUcsTemplates"}
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListUcsTemplatesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ExtractTemplateParams) SetMode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUcsTemplatesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["mode"] = v
+	return *p.Keyword
+}
+
+func (p *ListUcsTemplatesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListUcsTemplatesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListUcsTemplatesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ExtractTemplateParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUcsTemplatesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["url"] = v
+	return *p.Page
+}
+
+func (p *ListUcsTemplatesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListUcsTemplatesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListUcsTemplatesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ExtractTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUcsTemplatesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["zoneid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListUcsTemplatesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListUcsTemplatesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// This param is required.
+func (p *ListUcsTemplatesParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
 	return
 }
 
-// You should always use this function to get a new ExtractTemplateParams instance,
+func (p *ListUcsTemplatesParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
+	}
+	return *p.Ucsmanagerid
+}
+
+func (p *ListUcsTemplatesParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *ListUcsTemplatesParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+// You should always use this function to get a new ListUcsTemplatesParams instance,
 // as then you are sure you have configured all required params
-func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams {
-	p := &ExtractTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
+//
+// The required params are:
+//
+//   - ucsmanagerid
+func (s *TemplateService) NewListUcsTemplatesParams(ucsmanagerid string) *ListUcsTemplatesParams {
+	p := &ListUcsTemplatesParams{}
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// Extracts a template
-func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
-	resp, err := s.cs.newRequest("extractTemplate", p.toURLValues())
+// List templates in ucs manager
+//
+// Only supported by CloudStack 4.3.
+func (s *TemplateService) ListUcsTemplates(p *ListUcsTemplatesParams) (*ListUcsTemplatesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listUcsTemplates", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ExtractTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListUcsTemplatesResponse
+	if err := s.cs.unmarshal("listUcsTemplates", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+// Lists the objects like ListUcsTemplates, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *TemplateService) StreamUcsTemplates(ctx context.Context, p *ListUcsTemplatesParams, fn func(*UcsTemplate) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
+	return s.cs.streamList(ctx, "listUcsTemplates", p.URLValues(), "ucstemplate", func(b json.RawMessage) error {
+		var v UcsTemplate
+		if err := s.cs.unmarshalElement("listUcsTemplates", "ucstemplate", b, &v); err != nil {
+			return err
 		}
+		return fn(&v)
+	})
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+type ListUcsTemplatesResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	UcsTemplates []*UcsTemplate             `json:"ucstemplate" xml:"ucstemplate"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ExtractTemplateResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Accountid        string `json:"accountid,omitempty"`
-	Created          string `json:"created,omitempty"`
-	ExtractId        string `json:"extractId,omitempty"`
-	ExtractMode      string `json:"extractMode,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Resultstring     string `json:"resultstring,omitempty"`
-	State            string `json:"state,omitempty"`
-	Status           string `json:"status,omitempty"`
-	Storagetype      string `json:"storagetype,omitempty"`
-	Uploadpercentage int    `json:"uploadpercentage,omitempty"`
-	Url              string `json:"url,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
+type UcsTemplate struct {
+	Ucsdn string                     `json:"ucsdn,omitempty" xml:"ucsdn,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type PrepareTemplateParams struct {
-	p map[string]interface{}
+type InstantiateUcsTemplateAndAssocaciateToBladeParams struct {
+	Bladeid      *string `json:"bladeid,omitempty" yaml:"bladeid,omitempty"`
+	Profilename  *string `json:"profilename,omitempty" yaml:"profilename,omitempty"`
+	Templatedn   *string `json:"templatedn,omitempty" yaml:"templatedn,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
 }
 
-func (p *PrepareTemplateParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Bladeid; v != nil {
+		u.Set("bladeid", *v)
+	}
+	if v := p.Profilename; v != nil {
+		u.Set("profilename", *v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if v := p.Templatedn; v != nil {
+		u.Set("templatedn", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
 	}
 	return u
 }
 
-func (p *PrepareTemplateParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) Command() string {
+	return "instantiateUcsTemplateAndAssocaciateToBlade"
 }
 
-func (p *PrepareTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) IsAsync() bool {
+	return true
 }
 
-// You should always use this function to get a new PrepareTemplateParams instance,
-// as then you are sure you have configured all required params
-func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams {
-	p := &PrepareTemplateParams{}
-	p.p = make(map[string]interface{})
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
-	return p
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) ResponseType() interface{} {
+	return &InstantiateUcsTemplateAndAssocaciateToBladeResponse{}
 }
 
-// load template into primary storage
-func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
-	resp, err := s.cs.newRequest("prepareTemplate", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) Validate() error {
+	v := &validator{api: "instantiateUcsTemplateAndAssocaciateToBlade"}
+	v.required("bladeid", p.Bladeid != nil)
+	v.required("templatedn", p.Templatedn != nil)
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
 
-	var r PrepareTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// This param is required.
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) SetBladeid(v string) {
+	p.Bladeid = &v
+	return
 }
 
-type PrepareTemplateResponse struct {
-	Account               string            `json:"account,omitempty"`
-	Accountid             string            `json:"accountid,omitempty"`
-	Bootable              bool              `json:"bootable,omitempty"`
-	Checksum              string            `json:"checksum,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	CrossZones            bool              `json:"crossZones,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Format                string            `json:"format,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isextractable         bool              `json:"isextractable,omitempty"`
-	Isfeatured            bool              `json:"isfeatured,omitempty"`
-	Ispublic              bool              `json:"ispublic,omitempty"`
-	Isready               bool              `json:"isready,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Ostypeid              string            `json:"ostypeid,omitempty"`
-	Ostypename            string            `json:"ostypename,omitempty"`
-	Passwordenabled       bool              `json:"passwordenabled,omitempty"`
-	Project               string            `json:"project,omitempty"`
-	Projectid             string            `json:"projectid,omitempty"`
-	Removed               string            `json:"removed,omitempty"`
-	Size                  int64             `json:"size,omitempty"`
-	Sourcetemplateid      string            `json:"sourcetemplateid,omitempty"`
-	Sshkeyenabled         bool              `json:"sshkeyenabled,omitempty"`
-	Status                string            `json:"status,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatetag  string `json:"templatetag,omitempty"`
-	Templatetype string `json:"templatetype,omitempty"`
-	Zoneid       string `json:"zoneid,omitempty"`
-	Zonename     string `json:"zonename,omitempty"`
-}
-
-type UpgradeRouterTemplateParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) GetBladeid() string {
+	if p.Bladeid == nil {
+		return ""
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+	return *p.Bladeid
 }
 
-func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) HasBladeid() bool {
+	return p.Bladeid != nil
 }
 
-func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) ResetBladeid() {
+	p.Bladeid = nil
 }
 
-func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) SetProfilename(v string) {
+	p.Profilename = &v
 	return
 }
 
-func (p *UpgradeRouterTemplateParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) GetProfilename() string {
+	if p.Profilename == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Profilename
+}
+
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) HasProfilename() bool {
+	return p.Profilename != nil
+}
+
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) ResetProfilename() {
+	p.Profilename = nil
+}
+
+// This param is required.
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) SetTemplatedn(v string) {
+	p.Templatedn = &v
 	return
 }
 
-func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) GetTemplatedn() string {
+	if p.Templatedn == nil {
+		return ""
 	}
-	p.p["podid"] = v
+	return *p.Templatedn
+}
+
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) HasTemplatedn() bool {
+	return p.Templatedn != nil
+}
+
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) ResetTemplatedn() {
+	p.Templatedn = nil
+}
+
+// This param is required.
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
 	return
 }
 
-func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Ucsmanagerid
 }
 
-// You should always use this function to get a new UpgradeRouterTemplateParams instance,
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *InstantiateUcsTemplateAndAssocaciateToBladeParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+// You should always use this function to get a new InstantiateUcsTemplateAndAssocaciateToBladeParams instance,
 // as then you are sure you have configured all required params
-func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams {
-	p := &UpgradeRouterTemplateParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - bladeid
+//   - templatedn
+//   - ucsmanagerid
+func (s *TemplateService) NewInstantiateUcsTemplateAndAssocaciateToBladeParams(bladeid string, templatedn string, ucsmanagerid string) *InstantiateUcsTemplateAndAssocaciateToBladeParams {
+	p := &InstantiateUcsTemplateAndAssocaciateToBladeParams{}
+	p.SetBladeid(bladeid)
+	p.SetTemplatedn(templatedn)
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// Upgrades router to use newer template
-func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
-	resp, err := s.cs.newRequest("upgradeRouterTemplate", p.toURLValues())
+// create a profile of template and associate to a blade
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.3.
+func (s *TemplateService) InstantiateUcsTemplateAndAssocaciateToBlade(p *InstantiateUcsTemplateAndAssocaciateToBladeParams) (*InstantiateUcsTemplateAndAssocaciateToBladeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("instantiateUcsTemplateAndAssocaciateToBlade", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpgradeRouterTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r InstantiateUcsTemplateAndAssocaciateToBladeResponse
+	if err := s.cs.unmarshal("instantiateUcsTemplateAndAssocaciateToBlade", resp, &r); err != nil {
 		return nil, err
 	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("instantiateUcsTemplateAndAssocaciateToBlade", b, &r); err != nil {
+			return nil, err
+		}
+	}
 	return &r, nil
 }
 
-type UpgradeRouterTemplateResponse struct {
-	Jobid     string `json:"jobid,omitempty"`
-	Jobstatus int    `json:"jobstatus,omitempty"`
+type InstantiateUcsTemplateAndAssocaciateToBladeResponse struct {
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Bladedn      string                     `json:"bladedn,omitempty" xml:"bladedn,omitempty"`
+	Hostid       string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Profiledn    string                     `json:"profiledn,omitempty" xml:"profiledn,omitempty"`
+	Ucsmanagerid string                     `json:"ucsmanagerid,omitempty" xml:"ucsmanagerid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
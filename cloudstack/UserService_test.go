@@ -0,0 +1,851 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestImportLdapUsers(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+		"accountdetails[0].test-accountdetailskey": {"test-accountdetailsvalue"},
+		"accounttype": {"3"},
+		"domainid":    {"test-domainid"},
+		"group":       {"test-group"},
+		"keyword":     {"test-keyword"},
+		"page":        {"7"},
+		"pagesize":    {"8"},
+		"timezone":    {"test-timezone"},
+	}
+	cs, done := newTestClient(t, "importLdapUsers", params, `{"importldapusersresponse":{"domain":"test-domain","email":"test-email","firstname":"test-firstname","lastname":"test-lastname","principal":"test-principal","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewImportLdapUsersParams(3)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAccountdetails(map[string]string{"test-accountdetailskey": "test-accountdetailsvalue"})
+	p.SetAccounttype(3)
+	p.SetDomainid("test-domainid")
+	p.SetGroup("test-group")
+	p.SetKeyword("test-keyword")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetTimezone("test-timezone")
+	checkURLValues(t, "importLdapUsers", p.URLValues(), params)
+
+	r, err := cs.User.ImportLdapUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call importLdapUsers: %v", err)
+	}
+	if r.Domain != "test-domain" {
+		t.Errorf("Expected r.Domain to be %v, got %v", "test-domain", r.Domain)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "importLdapUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute importLdapUsers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of importLdapUsers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of importLdapUsers: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "importLdapUsers", params, `<importldapusersresponse><domain>test-domain</domain><email>test-email</email><firstname>test-firstname</firstname><lastname>test-lastname</lastname><principal>test-principal</principal><username>test-username</username></importldapusersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.ImportLdapUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call importLdapUsers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLdapUsers(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"listtype": {"test-listtype"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+	}
+	cs, done := newTestClient(t, "listLdapUsers", params, `{"listldapusersresponse":{"count":1,"ldapuser":[{"domain":"test-domain","email":"test-email","firstname":"test-firstname","lastname":"test-lastname","principal":"test-principal","username":"test-username"}]}}`)
+	defer done()
+
+	p := cs.User.NewListLdapUsersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetListtype("test-listtype")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listLdapUsers", p.URLValues(), params)
+
+	r, err := cs.User.ListLdapUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLdapUsers: %v", err)
+	}
+	if r.LdapUsers[0].Domain != "test-domain" {
+		t.Errorf("Expected r.LdapUsers[0].Domain to be %v, got %v", "test-domain", r.LdapUsers[0].Domain)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLdapUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLdapUsers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLdapUsers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLdapUsers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LdapUser
+	count, err := cs.User.StreamLdapUsers(context.Background(), p, func(v *LdapUser) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLdapUsers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LdapUsers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LdapUsers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLdapUsers", params, `<listldapusersresponse><count>1</count><ldapuser><domain>test-domain</domain><email>test-email</email><firstname>test-firstname</firstname><lastname>test-lastname</lastname><principal>test-principal</principal><username>test-username</username></ldapuser></listldapusersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.ListLdapUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLdapUsers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.User.StreamLdapUsers(context.Background(), p, func(v *LdapUser) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLdapUsers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LdapUsers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LdapUsers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"email":     {"test-email"},
+		"firstname": {"test-firstname"},
+		"lastname":  {"test-lastname"},
+		"password":  {"test-password"},
+		"timezone":  {"test-timezone"},
+		"userid":    {"test-userid"},
+		"username":  {"test-username"},
+	}
+	cs, done := newTestClient(t, "createUser", params, `{"createuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewCreateUserParams("test-account", "test-email", "test-firstname", "test-lastname", "test-password", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetEmail("test-email")
+	p.SetFirstname("test-firstname")
+	p.SetLastname("test-lastname")
+	p.SetPassword("test-password")
+	p.SetTimezone("test-timezone")
+	p.SetUserid("test-userid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "createUser", p.URLValues(), params)
+
+	r, err := cs.User.CreateUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call createUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createUser", params, `<createuserresponse><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></createuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.CreateUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call createUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteUser", params, `{"deleteuserresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.User.NewDeleteUserParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteUser", p.URLValues(), params)
+
+	r, err := cs.User.DeleteUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteUser: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteUser", params, `<deleteuserresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.DeleteUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisableUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "disableUser", params, `{"disableuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"jobid":"test-jobid","lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewDisableUserParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "disableUser", p.URLValues(), params)
+
+	r, err := cs.User.DisableUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disableUser" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disableUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disableUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disableUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disableUser", params, `<disableuserresponse><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><jobid>test-jobid</jobid><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></disableuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.DisableUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestEnableUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "enableUser", params, `{"enableuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewEnableUserParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "enableUser", p.URLValues(), params)
+
+	r, err := cs.User.EnableUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "enableUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute enableUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of enableUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of enableUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "enableUser", params, `<enableuserresponse><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></enableuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.EnableUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	params := url.Values{
+		"userapikey": {"test-userapikey"},
+	}
+	cs, done := newTestClient(t, "getUser", params, `{"getuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewGetUserParams("test-userapikey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetUserapikey("test-userapikey")
+	checkURLValues(t, "getUser", p.URLValues(), params)
+
+	r, err := cs.User.GetUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call getUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "getUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute getUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of getUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of getUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "getUser", params, `<getuserresponse><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></getuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.GetUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call getUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"accounttype": {"2"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"state":       {"test-state"},
+		"username":    {"test-username"},
+	}
+	cs, done := newTestClient(t, "listUsers", params, `{"listusersresponse":{"count":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}]}}`)
+	defer done()
+
+	p := cs.User.NewListUsersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAccounttype(2)
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetState("test-state")
+	p.SetUsername("test-username")
+	checkURLValues(t, "listUsers", p.URLValues(), params)
+
+	r, err := cs.User.ListUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUsers: %v", err)
+	}
+	if r.Users[0].Account != "test-account" {
+		t.Errorf("Expected r.Users[0].Account to be %v, got %v", "test-account", r.Users[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUsers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUsers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUsers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*User
+	count, err := cs.User.StreamUsers(context.Background(), p, func(v *User) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUsers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Users) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Users)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUsers", params, `<listusersresponse><count>1</count><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user></listusersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.ListUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUsers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.User.StreamUsers(context.Background(), p, func(v *User) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUsers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Users) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Users)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestLockUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "lockUser", params, `{"lockuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewLockUserParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "lockUser", p.URLValues(), params)
+
+	r, err := cs.User.LockUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call lockUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "lockUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute lockUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of lockUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of lockUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "lockUser", params, `<lockuserresponse><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></lockuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.LockUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call lockUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	params := url.Values{
+		"email":         {"test-email"},
+		"firstname":     {"test-firstname"},
+		"id":            {"test-id"},
+		"lastname":      {"test-lastname"},
+		"password":      {"test-password"},
+		"timezone":      {"test-timezone"},
+		"userapikey":    {"test-userapikey"},
+		"username":      {"test-username"},
+		"usersecretkey": {"test-usersecretkey"},
+	}
+	cs, done := newTestClient(t, "updateUser", params, `{"updateuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`)
+	defer done()
+
+	p := cs.User.NewUpdateUserParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEmail("test-email")
+	p.SetFirstname("test-firstname")
+	p.SetId("test-id")
+	p.SetLastname("test-lastname")
+	p.SetPassword("test-password")
+	p.SetTimezone("test-timezone")
+	p.SetUserapikey("test-userapikey")
+	p.SetUsername("test-username")
+	p.SetUsersecretkey("test-usersecretkey")
+	checkURLValues(t, "updateUser", p.URLValues(), params)
+
+	r, err := cs.User.UpdateUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateUser", params, `<updateuserresponse><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></updateuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.UpdateUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRegisterUserKeys(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "registerUserKeys", params, `{"registeruserkeysresponse":{"apikey":"test-apikey","secretkey":"test-secretkey"}}`)
+	defer done()
+
+	p := cs.User.NewRegisterUserKeysParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "registerUserKeys", p.URLValues(), params)
+
+	r, err := cs.User.RegisterUserKeys(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerUserKeys: %v", err)
+	}
+	if r.Apikey != "test-apikey" {
+		t.Errorf("Expected r.Apikey to be %v, got %v", "test-apikey", r.Apikey)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "registerUserKeys" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute registerUserKeys: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of registerUserKeys: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of registerUserKeys: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "registerUserKeys", params, `<registeruserkeysresponse><apikey>test-apikey</apikey><secretkey>test-secretkey</secretkey></registeruserkeysresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.RegisterUserKeys(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerUserKeys using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestGetVirtualMachineUserData(t *testing.T) {
+	params := url.Values{
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "getVirtualMachineUserData", params, `{"getvirtualmachineuserdataresponse":{"userdata":"test-userdata","virtualmachineid":"test-virtualmachineid"}}`)
+	defer done()
+
+	p := cs.User.NewGetVirtualMachineUserDataParams("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "getVirtualMachineUserData", p.URLValues(), params)
+
+	r, err := cs.User.GetVirtualMachineUserData(p)
+	if err != nil {
+		t.Fatalf("Failed to call getVirtualMachineUserData: %v", err)
+	}
+	if r.Userdata != "test-userdata" {
+		t.Errorf("Expected r.Userdata to be %v, got %v", "test-userdata", r.Userdata)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "getVirtualMachineUserData" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute getVirtualMachineUserData: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of getVirtualMachineUserData: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of getVirtualMachineUserData: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "getVirtualMachineUserData", params, `<getvirtualmachineuserdataresponse><userdata>test-userdata</userdata><virtualmachineid>test-virtualmachineid</virtualmachineid></getvirtualmachineuserdataresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.User.GetVirtualMachineUserData(p)
+	if err != nil {
+		t.Fatalf("Failed to call getVirtualMachineUserData using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,485 +17,46 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type CreateVlanIpRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["endip"]; found {
-		u.Set("endip", v.(string))
-	}
-	if v, found := p.p["endipv6"]; found {
-		u.Set("endipv6", v.(string))
-	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
-	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
-	}
-	if v, found := p.p["ip6cidr"]; found {
-		u.Set("ip6cidr", v.(string))
-	}
-	if v, found := p.p["ip6gateway"]; found {
-		u.Set("ip6gateway", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["startip"]; found {
-		u.Set("startip", v.(string))
-	}
-	if v, found := p.p["startipv6"]; found {
-		u.Set("startipv6", v.(string))
-	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateVlanIpRangeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetEndip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endip"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endipv6"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6cidr"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6gateway"] = v
-	return
-}
 
-func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetStartip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startip"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startipv6"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
-	return
-}
-
-func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
-
-// You should always use this function to get a new CreateVlanIpRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
-	p := &CreateVlanIpRangeParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Creates a VLAN IP range.
-func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("createVlanIpRange", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r CreateVlanIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type CreateVlanIpRangeResponse struct {
-	Account           string `json:"account,omitempty"`
-	Description       string `json:"description,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Endip             string `json:"endip,omitempty"`
-	Endipv6           string `json:"endipv6,omitempty"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork,omitempty"`
-	Gateway           string `json:"gateway,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Ip6cidr           string `json:"ip6cidr,omitempty"`
-	Ip6gateway        string `json:"ip6gateway,omitempty"`
-	Netmask           string `json:"netmask,omitempty"`
-	Networkid         string `json:"networkid,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Podid             string `json:"podid,omitempty"`
-	Podname           string `json:"podname,omitempty"`
-	Project           string `json:"project,omitempty"`
-	Projectid         string `json:"projectid,omitempty"`
-	Startip           string `json:"startip,omitempty"`
-	Startipv6         string `json:"startipv6,omitempty"`
-	Vlan              string `json:"vlan,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-type DeleteVlanIpRangeParams struct {
-	p map[string]interface{}
+type VLANService struct {
+	cs *CloudStackClient
 }
 
-func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func NewVLANService(cs *CloudStackClient) *VLANService {
+	return &VLANService{cs: cs}
 }
 
-func (p *DeleteVlanIpRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type ListDedicatedGuestVlanRangesParams = cloudstackcommon.ListDedicatedGuestVlanRangesParams
+type ListDedicatedGuestVlanRangesResponse = cloudstackcommon.ListDedicatedGuestVlanRangesResponse
+type DedicatedGuestVlanRange = cloudstackcommon.DedicatedGuestVlanRange
 
-// You should always use this function to get a new DeleteVlanIpRangeParams instance,
+// You should always use this function to get a new ListDedicatedGuestVlanRangesParams instance,
 // as then you are sure you have configured all required params
-func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams {
-	p := &DeleteVlanIpRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams {
+	p := &ListDedicatedGuestVlanRangesParams{}
 	return p
 }
 
-// Creates a VLAN IP range.
-func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVlanIpRange", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteVlanIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type DeleteVlanIpRangeResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ListVlanIpRangesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVlanIpRangesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListVlanIpRangesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvirtualnetwork"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *ListVlanIpRangesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
+	p := &ListDedicatedGuestVlanRangesParams{}
 
-func (p *ListVlanIpRangesParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
-	return
-}
+	p.SetId(id)
 
-func (p *ListVlanIpRangesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
-}
 
-// You should always use this function to get a new ListVlanIpRangesParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
-	p := &ListVlanIpRangesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VLANService) GetVlanIpRangeByID(id string) (*VlanIpRange, int, error) {
-	p := &ListVlanIpRangesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListVlanIpRanges(p)
+	l, err := s.ListDedicatedGuestVlanRanges(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -505,206 +66,104 @@ func (s *VLANService) GetVlanIpRangeByID(id string) (*VlanIpRange, int, error) {
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListDedicatedGuestVlanRanges(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
-		return l.VlanIpRanges[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VlanIpRange UUID: %s!", id)
-}
-
-// Lists all VLAN IP ranges.
-func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listVlanIpRanges", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListVlanIpRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListVlanIpRangesResponse struct {
-	Count        int            `json:"count"`
-	VlanIpRanges []*VlanIpRange `json:"vlaniprange"`
-}
-
-type VlanIpRange struct {
-	Account           string `json:"account,omitempty"`
-	Description       string `json:"description,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Endip             string `json:"endip,omitempty"`
-	Endipv6           string `json:"endipv6,omitempty"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork,omitempty"`
-	Gateway           string `json:"gateway,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Ip6cidr           string `json:"ip6cidr,omitempty"`
-	Ip6gateway        string `json:"ip6gateway,omitempty"`
-	Netmask           string `json:"netmask,omitempty"`
-	Networkid         string `json:"networkid,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Podid             string `json:"podid,omitempty"`
-	Podname           string `json:"podname,omitempty"`
-	Project           string `json:"project,omitempty"`
-	Projectid         string `json:"projectid,omitempty"`
-	Startip           string `json:"startip,omitempty"`
-	Startipv6         string `json:"startipv6,omitempty"`
-	Vlan              string `json:"vlan,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
-}
-
-type DedicateGuestVlanRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["vlanrange"]; found {
-		u.Set("vlanrange", v.(string))
-	}
-	return u
-}
-
-func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		return l.DedicatedGuestVlanRanges[0], l.Count, nil
 	}
-	p.p["projectid"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for DedicatedGuestVlanRange UUID: %s!", id)
 }
 
-func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists dedicated guest vlan ranges
+//
+// See also GetDedicatedGuestVlanRangeByID.
+func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["vlanrange"] = v
-	return
-}
-
-// You should always use this function to get a new DedicateGuestVlanRangeParams instance,
-// as then you are sure you have configured all required params
-func (s *VLANService) NewDedicateGuestVlanRangeParams(account string, domainid string, physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams {
-	p := &DedicateGuestVlanRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["vlanrange"] = vlanrange
-	return p
-}
 
-// Dedicates a guest vlan range to an account
-func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("dedicateGuestVlanRange", p.toURLValues())
+	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DedicateGuestVlanRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListDedicatedGuestVlanRangesResponse
+	if err := s.cs.unmarshal("listDedicatedGuestVlanRanges", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DedicateGuestVlanRangeResponse struct {
-	Account           string `json:"account,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Guestvlanrange    string `json:"guestvlanrange,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Physicalnetworkid int64  `json:"physicalnetworkid,omitempty"`
-	Project           string `json:"project,omitempty"`
-	Projectid         string `json:"projectid,omitempty"`
-	Zoneid            int64  `json:"zoneid,omitempty"`
-}
-
-type ReleaseDedicatedGuestVlanRangeParams struct {
-	p map[string]interface{}
-}
-
-func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// Lists the objects like ListDedicatedGuestVlanRanges, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VLANService) StreamDedicatedGuestVlanRanges(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, fn func(*DedicatedGuestVlanRange) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
-}
 
-func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+	return s.cs.streamList(ctx, "listDedicatedGuestVlanRanges", p.URLValues(), "dedicatedguestvlanrange", func(b json.RawMessage) error {
+		var v DedicatedGuestVlanRange
+		if err := s.cs.unmarshalElement("listDedicatedGuestVlanRanges", "dedicatedguestvlanrange", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
+type ReleaseDedicatedGuestVlanRangeParams = cloudstackcommon.ReleaseDedicatedGuestVlanRangeParams
+type ReleaseDedicatedGuestVlanRangeResponse = cloudstackcommon.ReleaseDedicatedGuestVlanRangeResponse
+
 // You should always use this function to get a new ReleaseDedicatedGuestVlanRangeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams {
 	p := &ReleaseDedicatedGuestVlanRangeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Releases a dedicated guest vlan range to the system
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListDedicatedGuestVlanRanges, GetDedicatedGuestVlanRangeByID.
 func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("releaseDedicatedGuestVlanRange", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedGuestVlanRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("releaseDedicatedGuestVlanRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -720,159 +179,147 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("releaseDedicatedGuestVlanRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ReleaseDedicatedGuestVlanRangeResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type DedicateGuestVlanRangeParams = cloudstackcommon.DedicateGuestVlanRangeParams
+type DedicateGuestVlanRangeResponse = cloudstackcommon.DedicateGuestVlanRangeResponse
 
-type ListDedicatedGuestVlanRangesParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new DedicateGuestVlanRangeParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - account: the name of the account
+//   - domainid: the ID of the domain
+//   - physicalnetworkid: the ID of the physical network
+//   - vlanrange
+func (s *VLANService) NewDedicateGuestVlanRangeParams(account string, domainid string, physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams {
+	p := &DedicateGuestVlanRangeParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetVlanrange(vlanrange)
+	return p
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["guestvlanrange"]; found {
-		u.Set("guestvlanrange", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+// Dedicates a guest vlan range to an account
+func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return u
-}
 
-func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("dedicateGuestVlanRange", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
-	return
-}
 
-func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DedicateGuestVlanRangeResponse
+	if err := s.cs.unmarshal("dedicateGuestVlanRange", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["domainid"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestvlanrange"] = v
-	return
+type CreateVlanIpRangeParams = cloudstackcommon.CreateVlanIpRangeParams
+type CreateVlanIpRangeResponse = cloudstackcommon.CreateVlanIpRangeResponse
+
+// You should always use this function to get a new CreateVlanIpRangeParams instance,
+// as then you are sure you have configured all required params
+func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
+	p := &CreateVlanIpRangeParams{}
+	return p
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Creates a VLAN IP range.
+//
+// See also ListVlanIpRanges, GetVlanIpRangeByID.
+func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("createVlanIpRange", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r CreateVlanIpRangeResponse
+	if err := s.cs.unmarshal("createVlanIpRange", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+type DeleteVlanIpRangeParams = cloudstackcommon.DeleteVlanIpRangeParams
+type DeleteVlanIpRangeResponse = cloudstackcommon.DeleteVlanIpRangeResponse
+
+// You should always use this function to get a new DeleteVlanIpRangeParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams {
+	p := &DeleteVlanIpRangeParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Creates a VLAN IP range.
+//
+// See also ListVlanIpRanges, GetVlanIpRangeByID.
+func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["physicalnetworkid"] = v
-	return
-}
 
-func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteVlanIpRange", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteVlanIpRangeResponse
+	if err := s.cs.unmarshal("deleteVlanIpRange", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["zoneid"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new ListDedicatedGuestVlanRangesParams instance,
+type ListVlanIpRangesParams = cloudstackcommon.ListVlanIpRangesParams
+type ListVlanIpRangesResponse = cloudstackcommon.ListVlanIpRangesResponse
+type VlanIpRange = cloudstackcommon.VlanIpRange
+
+// You should always use this function to get a new ListVlanIpRangesParams instance,
 // as then you are sure you have configured all required params
-func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams {
-	p := &ListDedicatedGuestVlanRangesParams{}
-	p.p = make(map[string]interface{})
+func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
+	p := &ListVlanIpRangesParams{}
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string) (*DedicatedGuestVlanRange, int, error) {
-	p := &ListDedicatedGuestVlanRangesParams{}
-	p.p = make(map[string]interface{})
+func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
+	p := &ListVlanIpRangesParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListDedicatedGuestVlanRanges(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVlanIpRanges(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -882,43 +329,67 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string) (*DedicatedGuest
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVlanIpRanges(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
-		return l.DedicatedGuestVlanRanges[0], l.Count, nil
+		return l.VlanIpRanges[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for DedicatedGuestVlanRange UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for VlanIpRange UUID: %s!", id)
 }
 
-// Lists dedicated guest vlan ranges
-func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.toURLValues())
+// Lists all VLAN IP ranges.
+//
+// See also GetVlanIpRangeByID.
+func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVlanIpRanges", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListDedicatedGuestVlanRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListVlanIpRangesResponse
+	if err := s.cs.unmarshal("listVlanIpRanges", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListDedicatedGuestVlanRangesResponse struct {
-	Count                    int                        `json:"count"`
-	DedicatedGuestVlanRanges []*DedicatedGuestVlanRange `json:"dedicatedguestvlanrange"`
-}
+// Lists the objects like ListVlanIpRanges, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VLANService) StreamVlanIpRanges(ctx context.Context, p *ListVlanIpRangesParams, fn func(*VlanIpRange) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type DedicatedGuestVlanRange struct {
-	Account           string `json:"account,omitempty"`
-	Domain            string `json:"domain,omitempty"`
-	Domainid          string `json:"domainid,omitempty"`
-	Guestvlanrange    string `json:"guestvlanrange,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Physicalnetworkid int64  `json:"physicalnetworkid,omitempty"`
-	Project           string `json:"project,omitempty"`
-	Projectid         string `json:"projectid,omitempty"`
-	Zoneid            int64  `json:"zoneid,omitempty"`
+	return s.cs.streamList(ctx, "listVlanIpRanges", p.URLValues(), "vlaniprange", func(b json.RawMessage) error {
+		var v VlanIpRange
+		if err := s.cs.unmarshalElement("listVlanIpRanges", "vlaniprange", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -0,0 +1,511 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListDedicatedGuestVlanRanges(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"guestvlanrange":    {"test-guestvlanrange"},
+		"id":                {"test-id"},
+		"keyword":           {"test-keyword"},
+		"page":              {"6"},
+		"pagesize":          {"7"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"projectid":         {"test-projectid"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listDedicatedGuestVlanRanges", params, `{"listdedicatedguestvlanrangesresponse":{"count":1,"dedicatedguestvlanrange":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","guestvlanrange":"test-guestvlanrange","id":"test-id","physicalnetworkid":1,"project":"test-project","projectid":"test-projectid","zoneid":1}]}}`)
+	defer done()
+
+	p := cs.VLAN.NewListDedicatedGuestVlanRangesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetGuestvlanrange("test-guestvlanrange")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listDedicatedGuestVlanRanges", p.URLValues(), params)
+
+	r, err := cs.VLAN.ListDedicatedGuestVlanRanges(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedGuestVlanRanges: %v", err)
+	}
+	if r.DedicatedGuestVlanRanges[0].Account != "test-account" {
+		t.Errorf("Expected r.DedicatedGuestVlanRanges[0].Account to be %v, got %v", "test-account", r.DedicatedGuestVlanRanges[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedGuestVlanRanges" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDedicatedGuestVlanRanges: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDedicatedGuestVlanRanges: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDedicatedGuestVlanRanges: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DedicatedGuestVlanRange
+	count, err := cs.VLAN.StreamDedicatedGuestVlanRanges(context.Background(), p, func(v *DedicatedGuestVlanRange) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDedicatedGuestVlanRanges: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DedicatedGuestVlanRanges) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DedicatedGuestVlanRanges)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDedicatedGuestVlanRanges", params, `<listdedicatedguestvlanrangesresponse><count>1</count><dedicatedguestvlanrange><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><guestvlanrange>test-guestvlanrange</guestvlanrange><id>test-id</id><physicalnetworkid>1</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><zoneid>1</zoneid></dedicatedguestvlanrange></listdedicatedguestvlanrangesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VLAN.ListDedicatedGuestVlanRanges(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedGuestVlanRanges using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VLAN.StreamDedicatedGuestVlanRanges(context.Background(), p, func(v *DedicatedGuestVlanRange) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDedicatedGuestVlanRanges using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DedicatedGuestVlanRanges) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DedicatedGuestVlanRanges)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleaseDedicatedGuestVlanRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "releaseDedicatedGuestVlanRange", params, `{"releasededicatedguestvlanrangeresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VLAN.NewReleaseDedicatedGuestVlanRangeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "releaseDedicatedGuestVlanRange", p.URLValues(), params)
+
+	r, err := cs.VLAN.ReleaseDedicatedGuestVlanRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedGuestVlanRange: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedGuestVlanRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releaseDedicatedGuestVlanRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releaseDedicatedGuestVlanRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releaseDedicatedGuestVlanRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releaseDedicatedGuestVlanRange", params, `<releasededicatedguestvlanrangeresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></releasededicatedguestvlanrangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VLAN.ReleaseDedicatedGuestVlanRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedGuestVlanRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDedicateGuestVlanRange(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"projectid":         {"test-projectid"},
+		"vlanrange":         {"test-vlanrange"},
+	}
+	cs, done := newTestClient(t, "dedicateGuestVlanRange", params, `{"dedicateguestvlanrangeresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","guestvlanrange":"test-guestvlanrange","id":"test-id","physicalnetworkid":1,"project":"test-project","projectid":"test-projectid","zoneid":1}}`)
+	defer done()
+
+	p := cs.VLAN.NewDedicateGuestVlanRangeParams("test-account", "test-domainid", "test-physicalnetworkid", "test-vlanrange")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetVlanrange("test-vlanrange")
+	checkURLValues(t, "dedicateGuestVlanRange", p.URLValues(), params)
+
+	r, err := cs.VLAN.DedicateGuestVlanRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateGuestVlanRange: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateGuestVlanRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute dedicateGuestVlanRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of dedicateGuestVlanRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of dedicateGuestVlanRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "dedicateGuestVlanRange", params, `<dedicateguestvlanrangeresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><guestvlanrange>test-guestvlanrange</guestvlanrange><id>test-id</id><physicalnetworkid>1</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><zoneid>1</zoneid></dedicateguestvlanrangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VLAN.DedicateGuestVlanRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateGuestVlanRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVlanIpRange(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"endip":             {"test-endip"},
+		"endipv6":           {"test-endipv6"},
+		"forvirtualnetwork": {"true"},
+		"gateway":           {"test-gateway"},
+		"ip6cidr":           {"test-ip6cidr"},
+		"ip6gateway":        {"test-ip6gateway"},
+		"netmask":           {"test-netmask"},
+		"networkid":         {"test-networkid"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"podid":             {"test-podid"},
+		"projectid":         {"test-projectid"},
+		"startip":           {"test-startip"},
+		"startipv6":         {"test-startipv6"},
+		"vlan":              {"test-vlan"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createVlanIpRange", params, `{"createvlaniprangeresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","endip":"test-endip","endipv6":"test-endipv6","forvirtualnetwork":true,"gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","netmask":"test-netmask","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","podid":"test-podid","podname":"test-podname","project":"test-project","projectid":"test-projectid","startip":"test-startip","startipv6":"test-startipv6","vlan":"test-vlan","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.VLAN.NewCreateVlanIpRangeParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetEndip("test-endip")
+	p.SetEndipv6("test-endipv6")
+	p.SetForvirtualnetwork(true)
+	p.SetGateway("test-gateway")
+	p.SetIp6cidr("test-ip6cidr")
+	p.SetIp6gateway("test-ip6gateway")
+	p.SetNetmask("test-netmask")
+	p.SetNetworkid("test-networkid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetStartip("test-startip")
+	p.SetStartipv6("test-startipv6")
+	p.SetVlan("test-vlan")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createVlanIpRange", p.URLValues(), params)
+
+	r, err := cs.VLAN.CreateVlanIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVlanIpRange: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVlanIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVlanIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVlanIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVlanIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVlanIpRange", params, `<createvlaniprangeresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><endip>test-endip</endip><endipv6>test-endipv6</endipv6><forvirtualnetwork>true</forvirtualnetwork><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><netmask>test-netmask</netmask><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><podid>test-podid</podid><podname>test-podname</podname><project>test-project</project><projectid>test-projectid</projectid><startip>test-startip</startip><startipv6>test-startipv6</startipv6><vlan>test-vlan</vlan><zoneid>test-zoneid</zoneid></createvlaniprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VLAN.CreateVlanIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVlanIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVlanIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVlanIpRange", params, `{"deletevlaniprangeresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.VLAN.NewDeleteVlanIpRangeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVlanIpRange", p.URLValues(), params)
+
+	r, err := cs.VLAN.DeleteVlanIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVlanIpRange: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVlanIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVlanIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVlanIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVlanIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVlanIpRange", params, `<deletevlaniprangeresponse><displaytext>test-displaytext</displaytext><success>true</success></deletevlaniprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VLAN.DeleteVlanIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVlanIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVlanIpRanges(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"forvirtualnetwork": {"true"},
+		"id":                {"test-id"},
+		"keyword":           {"test-keyword"},
+		"networkid":         {"test-networkid"},
+		"page":              {"7"},
+		"pagesize":          {"8"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"podid":             {"test-podid"},
+		"projectid":         {"test-projectid"},
+		"vlan":              {"test-vlan"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listVlanIpRanges", params, `{"listvlaniprangesresponse":{"count":1,"vlaniprange":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","endip":"test-endip","endipv6":"test-endipv6","forvirtualnetwork":true,"gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","netmask":"test-netmask","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","podid":"test-podid","podname":"test-podname","project":"test-project","projectid":"test-projectid","startip":"test-startip","startipv6":"test-startipv6","vlan":"test-vlan","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.VLAN.NewListVlanIpRangesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetForvirtualnetwork(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetVlan("test-vlan")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listVlanIpRanges", p.URLValues(), params)
+
+	r, err := cs.VLAN.ListVlanIpRanges(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVlanIpRanges: %v", err)
+	}
+	if r.VlanIpRanges[0].Account != "test-account" {
+		t.Errorf("Expected r.VlanIpRanges[0].Account to be %v, got %v", "test-account", r.VlanIpRanges[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVlanIpRanges" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVlanIpRanges: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVlanIpRanges: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVlanIpRanges: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VlanIpRange
+	count, err := cs.VLAN.StreamVlanIpRanges(context.Background(), p, func(v *VlanIpRange) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVlanIpRanges: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VlanIpRanges) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VlanIpRanges)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVlanIpRanges", params, `<listvlaniprangesresponse><count>1</count><vlaniprange><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><endip>test-endip</endip><endipv6>test-endipv6</endipv6><forvirtualnetwork>true</forvirtualnetwork><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><netmask>test-netmask</netmask><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><podid>test-podid</podid><podname>test-podname</podname><project>test-project</project><projectid>test-projectid</projectid><startip>test-startip</startip><startipv6>test-startipv6</startipv6><vlan>test-vlan</vlan><zoneid>test-zoneid</zoneid></vlaniprange></listvlaniprangesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VLAN.ListVlanIpRanges(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVlanIpRanges using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VLAN.StreamVlanIpRanges(context.Background(), p, func(v *VlanIpRange) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVlanIpRanges using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VlanIpRanges) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VlanIpRanges)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,310 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateInstanceGroup(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "createInstanceGroup", params, `{"createinstancegroupresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","project":"test-project","projectid":"test-projectid"}}`)
+	defer done()
+
+	p := cs.VMGroup.NewCreateInstanceGroupParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "createInstanceGroup", p.URLValues(), params)
+
+	r, err := cs.VMGroup.CreateInstanceGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createInstanceGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createInstanceGroup" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createInstanceGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createInstanceGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createInstanceGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createInstanceGroup", params, `<createinstancegroupresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid></createinstancegroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VMGroup.CreateInstanceGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createInstanceGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteInstanceGroup(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteInstanceGroup", params, `{"deleteinstancegroupresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.VMGroup.NewDeleteInstanceGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteInstanceGroup", p.URLValues(), params)
+
+	r, err := cs.VMGroup.DeleteInstanceGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteInstanceGroup: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteInstanceGroup" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteInstanceGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteInstanceGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteInstanceGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteInstanceGroup", params, `<deleteinstancegroupresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteinstancegroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VMGroup.DeleteInstanceGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteInstanceGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListInstanceGroups(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "listInstanceGroups", params, `{"listinstancegroupsresponse":{"count":1,"instancegroup":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","project":"test-project","projectid":"test-projectid"}]}}`)
+	defer done()
+
+	p := cs.VMGroup.NewListInstanceGroupsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "listInstanceGroups", p.URLValues(), params)
+
+	r, err := cs.VMGroup.ListInstanceGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listInstanceGroups: %v", err)
+	}
+	if r.InstanceGroups[0].Account != "test-account" {
+		t.Errorf("Expected r.InstanceGroups[0].Account to be %v, got %v", "test-account", r.InstanceGroups[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listInstanceGroups" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listInstanceGroups: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listInstanceGroups: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listInstanceGroups: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*InstanceGroup
+	count, err := cs.VMGroup.StreamInstanceGroups(context.Background(), p, func(v *InstanceGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listInstanceGroups: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.InstanceGroups) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.InstanceGroups)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listInstanceGroups", params, `<listinstancegroupsresponse><count>1</count><instancegroup><account>test-account</account><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid></instancegroup></listinstancegroupsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VMGroup.ListInstanceGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listInstanceGroups using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VMGroup.StreamInstanceGroups(context.Background(), p, func(v *InstanceGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listInstanceGroups using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.InstanceGroups) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.InstanceGroups)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateInstanceGroup(t *testing.T) {
+	params := url.Values{
+		"id":   {"test-id"},
+		"name": {"test-name"},
+	}
+	cs, done := newTestClient(t, "updateInstanceGroup", params, `{"updateinstancegroupresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","project":"test-project","projectid":"test-projectid"}}`)
+	defer done()
+
+	p := cs.VMGroup.NewUpdateInstanceGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetName("test-name")
+	checkURLValues(t, "updateInstanceGroup", p.URLValues(), params)
+
+	r, err := cs.VMGroup.UpdateInstanceGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateInstanceGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateInstanceGroup" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateInstanceGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateInstanceGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateInstanceGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateInstanceGroup", params, `<updateinstancegroupresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid></updateinstancegroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VMGroup.UpdateInstanceGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateInstanceGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
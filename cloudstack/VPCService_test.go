@@ -0,0 +1,1198 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreatePrivateGateway(t *testing.T) {
+	params := url.Values{
+		"aclid":              {"test-aclid"},
+		"gateway":            {"test-gateway"},
+		"ipaddress":          {"test-ipaddress"},
+		"netmask":            {"test-netmask"},
+		"networkofferingid":  {"test-networkofferingid"},
+		"physicalnetworkid":  {"test-physicalnetworkid"},
+		"sourcenatsupported": {"true"},
+		"vlan":               {"test-vlan"},
+		"vpcid":              {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "createPrivateGateway", params, `{"createprivategatewayresponse":{"account":"test-account","aclid":"test-aclid","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ipaddress":"test-ipaddress","jobid":"test-jobid","netmask":"test-netmask","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","sourcenatsupported":true,"state":"test-state","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.VPC.NewCreatePrivateGatewayParams("test-gateway", "test-ipaddress", "test-netmask", "test-vlan", "test-vpcid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAclid("test-aclid")
+	p.SetGateway("test-gateway")
+	p.SetIpaddress("test-ipaddress")
+	p.SetNetmask("test-netmask")
+	p.SetNetworkofferingid("test-networkofferingid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetSourcenatsupported(true)
+	p.SetVlan("test-vlan")
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "createPrivateGateway", p.URLValues(), params)
+
+	r, err := cs.VPC.CreatePrivateGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPrivateGateway: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createPrivateGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createPrivateGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createPrivateGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createPrivateGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createPrivateGateway", params, `<createprivategatewayresponse><account>test-account</account><aclid>test-aclid</aclid><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><netmask>test-netmask</netmask><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><sourcenatsupported>true</sourcenatsupported><state>test-state</state><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createprivategatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.CreatePrivateGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPrivateGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeletePrivateGateway(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deletePrivateGateway", params, `{"deleteprivategatewayresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPC.NewDeletePrivateGatewayParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deletePrivateGateway", p.URLValues(), params)
+
+	r, err := cs.VPC.DeletePrivateGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePrivateGateway: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deletePrivateGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deletePrivateGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deletePrivateGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deletePrivateGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deletePrivateGateway", params, `<deleteprivategatewayresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteprivategatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.DeletePrivateGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePrivateGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPrivateGateways(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"ipaddress":   {"test-ipaddress"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+		"state":       {"test-state"},
+		"vlan":        {"test-vlan"},
+		"vpcid":       {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "listPrivateGateways", params, `{"listprivategatewaysresponse":{"count":1,"privategateway":[{"account":"test-account","aclid":"test-aclid","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ipaddress":"test-ipaddress","netmask":"test-netmask","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","sourcenatsupported":true,"state":"test-state","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.VPC.NewListPrivateGatewaysParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIpaddress("test-ipaddress")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	p.SetVlan("test-vlan")
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "listPrivateGateways", p.URLValues(), params)
+
+	r, err := cs.VPC.ListPrivateGateways(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPrivateGateways: %v", err)
+	}
+	if r.PrivateGateways[0].Account != "test-account" {
+		t.Errorf("Expected r.PrivateGateways[0].Account to be %v, got %v", "test-account", r.PrivateGateways[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPrivateGateways" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPrivateGateways: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPrivateGateways: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPrivateGateways: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PrivateGateway
+	count, err := cs.VPC.StreamPrivateGateways(context.Background(), p, func(v *PrivateGateway) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPrivateGateways: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PrivateGateways) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PrivateGateways)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPrivateGateways", params, `<listprivategatewaysresponse><count>1</count><privategateway><account>test-account</account><aclid>test-aclid</aclid><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ipaddress>test-ipaddress</ipaddress><netmask>test-netmask</netmask><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><sourcenatsupported>true</sourcenatsupported><state>test-state</state><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></privategateway></listprivategatewaysresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.ListPrivateGateways(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPrivateGateways using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPC.StreamPrivateGateways(context.Background(), p, func(v *PrivateGateway) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPrivateGateways using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PrivateGateways) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PrivateGateways)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateStaticRoute(t *testing.T) {
+	params := url.Values{
+		"cidr":      {"test-cidr"},
+		"gatewayid": {"test-gatewayid"},
+	}
+	cs, done := newTestClient(t, "createStaticRoute", params, `{"createstaticrouteresponse":{"account":"test-account","cidr":"test-cidr","domain":"test-domain","domainid":"test-domainid","gatewayid":"test-gatewayid","id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcid":"test-vpcid"}}`)
+	defer done()
+
+	p := cs.VPC.NewCreateStaticRouteParams("test-cidr", "test-gatewayid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCidr("test-cidr")
+	p.SetGatewayid("test-gatewayid")
+	checkURLValues(t, "createStaticRoute", p.URLValues(), params)
+
+	r, err := cs.VPC.CreateStaticRoute(p)
+	if err != nil {
+		t.Fatalf("Failed to call createStaticRoute: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createStaticRoute" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createStaticRoute: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createStaticRoute: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createStaticRoute: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createStaticRoute", params, `<createstaticrouteresponse><account>test-account</account><cidr>test-cidr</cidr><domain>test-domain</domain><domainid>test-domainid</domainid><gatewayid>test-gatewayid</gatewayid><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vpcid>test-vpcid</vpcid></createstaticrouteresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.CreateStaticRoute(p)
+	if err != nil {
+		t.Fatalf("Failed to call createStaticRoute using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteStaticRoute(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteStaticRoute", params, `{"deletestaticrouteresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPC.NewDeleteStaticRouteParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteStaticRoute", p.URLValues(), params)
+
+	r, err := cs.VPC.DeleteStaticRoute(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteStaticRoute: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteStaticRoute" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteStaticRoute: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteStaticRoute: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteStaticRoute: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteStaticRoute", params, `<deletestaticrouteresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletestaticrouteresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.DeleteStaticRoute(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteStaticRoute using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListStaticRoutes(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"gatewayid":     {"test-gatewayid"},
+		"id":            {"test-id"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"page":          {"8"},
+		"pagesize":      {"9"},
+		"projectid":     {"test-projectid"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+		"vpcid":         {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "listStaticRoutes", params, `{"liststaticroutesresponse":{"count":1,"staticroute":[{"account":"test-account","cidr":"test-cidr","domain":"test-domain","domainid":"test-domainid","gatewayid":"test-gatewayid","id":"test-id","project":"test-project","projectid":"test-projectid","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcid":"test-vpcid"}]}}`)
+	defer done()
+
+	p := cs.VPC.NewListStaticRoutesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetGatewayid("test-gatewayid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "listStaticRoutes", p.URLValues(), params)
+
+	r, err := cs.VPC.ListStaticRoutes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStaticRoutes: %v", err)
+	}
+	if r.StaticRoutes[0].Account != "test-account" {
+		t.Errorf("Expected r.StaticRoutes[0].Account to be %v, got %v", "test-account", r.StaticRoutes[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listStaticRoutes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listStaticRoutes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listStaticRoutes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listStaticRoutes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*StaticRoute
+	count, err := cs.VPC.StreamStaticRoutes(context.Background(), p, func(v *StaticRoute) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listStaticRoutes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.StaticRoutes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.StaticRoutes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listStaticRoutes", params, `<liststaticroutesresponse><count>1</count><staticroute><account>test-account</account><cidr>test-cidr</cidr><domain>test-domain</domain><domainid>test-domainid</domainid><gatewayid>test-gatewayid</gatewayid><id>test-id</id><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vpcid>test-vpcid</vpcid></staticroute></liststaticroutesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.ListStaticRoutes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStaticRoutes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPC.StreamStaticRoutes(context.Background(), p, func(v *StaticRoute) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listStaticRoutes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.StaticRoutes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.StaticRoutes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVPC(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"cidr":          {"test-cidr"},
+		"displaytext":   {"test-displaytext"},
+		"domainid":      {"test-domainid"},
+		"fordisplay":    {"true"},
+		"name":          {"test-name"},
+		"networkdomain": {"test-networkdomain"},
+		"projectid":     {"test-projectid"},
+		"start":         {"true"},
+		"vpcofferingid": {"test-vpcofferingid"},
+		"zoneid":        {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createVPC", params, `{"createvpcresponse":{"account":"test-account","cidr":"test-cidr","created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","name":"test-name","network":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename","zonesnetworkspans":["test-zonesnetworkspans"]}],"networkdomain":"test-networkdomain","project":"test-project","projectid":"test-projectid","regionlevelvpc":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcofferingid":"test-vpcofferingid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.VPC.NewCreateVPCParams("test-cidr", "test-displaytext", "test-name", "test-vpcofferingid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCidr("test-cidr")
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetName("test-name")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetProjectid("test-projectid")
+	p.SetStart(true)
+	p.SetVpcofferingid("test-vpcofferingid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createVPC", p.URLValues(), params)
+
+	r, err := cs.VPC.CreateVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVPC: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVPC" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVPC: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVPC: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVPC: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVPC", params, `<createvpcresponse><account>test-account</account><cidr>test-cidr</cidr><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><network><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><strechedl2subnet>true</strechedl2subnet><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename><zonesnetworkspans>test-zonesnetworkspans</zonesnetworkspans></network><networkdomain>test-networkdomain</networkdomain><project>test-project</project><projectid>test-projectid</projectid><regionlevelvpc>true</regionlevelvpc><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vpcofferingid>test-vpcofferingid</vpcofferingid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createvpcresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.CreateVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVPC using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVPC(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVPC", params, `{"deletevpcresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPC.NewDeleteVPCParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVPC", p.URLValues(), params)
+
+	r, err := cs.VPC.DeleteVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVPC: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVPC" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVPC: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVPC: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVPC: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVPC", params, `<deletevpcresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletevpcresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.DeleteVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVPC using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVPCs(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"cidr":              {"test-cidr"},
+		"displaytext":       {"test-displaytext"},
+		"domainid":          {"test-domainid"},
+		"fordisplay":        {"true"},
+		"id":                {"test-id"},
+		"isrecursive":       {"true"},
+		"keyword":           {"test-keyword"},
+		"listall":           {"true"},
+		"name":              {"test-name"},
+		"page":              {"11"},
+		"pagesize":          {"12"},
+		"projectid":         {"test-projectid"},
+		"restartrequired":   {"true"},
+		"state":             {"test-state"},
+		"supportedservices": {"test-supportedservices1, test-supportedservices2"},
+		"tags[0].key":       {"test-tagskey"},
+		"tags[0].value":     {"test-tagsvalue"},
+		"vpcofferingid":     {"test-vpcofferingid"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listVPCs", params, `{"listvpcsresponse":{"count":1,"vpc":[{"account":"test-account","cidr":"test-cidr","created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","name":"test-name","network":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename","zonesnetworkspans":["test-zonesnetworkspans"]}],"networkdomain":"test-networkdomain","project":"test-project","projectid":"test-projectid","regionlevelvpc":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcofferingid":"test-vpcofferingid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.VPC.NewListVPCsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCidr("test-cidr")
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetProjectid("test-projectid")
+	p.SetRestartrequired(true)
+	p.SetState("test-state")
+	p.SetSupportedservices([]string{"test-supportedservices1", "test-supportedservices2"})
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVpcofferingid("test-vpcofferingid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listVPCs", p.URLValues(), params)
+
+	r, err := cs.VPC.ListVPCs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVPCs: %v", err)
+	}
+	if r.VPCs[0].Account != "test-account" {
+		t.Errorf("Expected r.VPCs[0].Account to be %v, got %v", "test-account", r.VPCs[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVPCs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVPCs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVPCs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVPCs: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VPC
+	count, err := cs.VPC.StreamVPCs(context.Background(), p, func(v *VPC) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVPCs: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VPCs) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VPCs)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVPCs", params, `<listvpcsresponse><count>1</count><vpc><account>test-account</account><cidr>test-cidr</cidr><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><name>test-name</name><network><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><strechedl2subnet>true</strechedl2subnet><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename><zonesnetworkspans>test-zonesnetworkspans</zonesnetworkspans></network><networkdomain>test-networkdomain</networkdomain><project>test-project</project><projectid>test-projectid</projectid><regionlevelvpc>true</regionlevelvpc><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vpcofferingid>test-vpcofferingid</vpcofferingid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></vpc></listvpcsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.ListVPCs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVPCs using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPC.StreamVPCs(context.Background(), p, func(v *VPC) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVPCs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VPCs) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VPCs)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRestartVPC(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "restartVPC", params, `{"restartvpcresponse":{"account":"test-account","cidr":"test-cidr","created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","name":"test-name","network":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename","zonesnetworkspans":["test-zonesnetworkspans"]}],"networkdomain":"test-networkdomain","project":"test-project","projectid":"test-projectid","regionlevelvpc":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcofferingid":"test-vpcofferingid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.VPC.NewRestartVPCParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "restartVPC", p.URLValues(), params)
+
+	r, err := cs.VPC.RestartVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call restartVPC: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "restartVPC" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute restartVPC: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of restartVPC: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of restartVPC: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "restartVPC", params, `<restartvpcresponse><account>test-account</account><cidr>test-cidr</cidr><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><network><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><strechedl2subnet>true</strechedl2subnet><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename><zonesnetworkspans>test-zonesnetworkspans</zonesnetworkspans></network><networkdomain>test-networkdomain</networkdomain><project>test-project</project><projectid>test-projectid</projectid><regionlevelvpc>true</regionlevelvpc><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vpcofferingid>test-vpcofferingid</vpcofferingid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></restartvpcresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.RestartVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call restartVPC using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVPC(t *testing.T) {
+	params := url.Values{
+		"customid":    {"test-customid"},
+		"displaytext": {"test-displaytext"},
+		"fordisplay":  {"true"},
+		"id":          {"test-id"},
+		"name":        {"test-name"},
+	}
+	cs, done := newTestClient(t, "updateVPC", params, `{"updatevpcresponse":{"account":"test-account","cidr":"test-cidr","created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","name":"test-name","network":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","strechedl2subnet":true,"subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename","zonesnetworkspans":["test-zonesnetworkspans"]}],"networkdomain":"test-networkdomain","project":"test-project","projectid":"test-projectid","regionlevelvpc":true,"restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcofferingid":"test-vpcofferingid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.VPC.NewUpdateVPCParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetDisplaytext("test-displaytext")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetName("test-name")
+	checkURLValues(t, "updateVPC", p.URLValues(), params)
+
+	r, err := cs.VPC.UpdateVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVPC: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVPC" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVPC: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVPC: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVPC: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVPC", params, `<updatevpcresponse><account>test-account</account><cidr>test-cidr</cidr><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><network><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><strechedl2subnet>true</strechedl2subnet><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename><zonesnetworkspans>test-zonesnetworkspans</zonesnetworkspans></network><networkdomain>test-networkdomain</networkdomain><project>test-project</project><projectid>test-projectid</projectid><regionlevelvpc>true</regionlevelvpc><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vpcofferingid>test-vpcofferingid</vpcofferingid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatevpcresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.UpdateVPC(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVPC using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVPCOffering(t *testing.T) {
+	params := url.Values{
+		"displaytext":                      {"test-displaytext"},
+		"name":                             {"test-name"},
+		"servicecapabilitylist[0].service": {"test-service"},
+		"servicecapabilitylist[0].capabilitytype":  {"test-capabilitytype"},
+		"servicecapabilitylist[0].capabilityvalue": {"test-capabilityvalue"},
+		"serviceofferingid":                        {"test-serviceofferingid"},
+		"serviceproviderlist[0].service":           {"test-service"},
+		"serviceproviderlist[0].provider":          {"test-provider"},
+		"supportedservices":                        {"test-supportedservices1, test-supportedservices2"},
+	}
+	cs, done := newTestClient(t, "createVPCOffering", params, `{"createvpcofferingresponse":{"created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"id":"test-id","isdefault":true,"jobid":"test-jobid","name":"test-name","service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","supportsregionLevelvpc":true}}`)
+	defer done()
+
+	p := cs.VPC.NewCreateVPCOfferingParams("test-displaytext", "test-name", []string{"test-supportedservices1", "test-supportedservices2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDisplaytext("test-displaytext")
+	p.SetName("test-name")
+	p.SetServicecapabilitylist([]ServiceCapability{{Service: "test-service", Capabilitytype: "test-capabilitytype", Capabilityvalue: "test-capabilityvalue"}})
+	p.SetServiceofferingid("test-serviceofferingid")
+	p.SetServiceproviderlist([]ServiceProvider{{Service: "test-service", Provider: "test-provider"}})
+	p.SetSupportedservices([]string{"test-supportedservices1", "test-supportedservices2"})
+	checkURLValues(t, "createVPCOffering", p.URLValues(), params)
+
+	r, err := cs.VPC.CreateVPCOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVPCOffering: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVPCOffering" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVPCOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVPCOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVPCOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVPCOffering", params, `<createvpcofferingresponse><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><id>test-id</id><isdefault>true</isdefault><jobid>test-jobid</jobid><name>test-name</name><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><supportsregionLevelvpc>true</supportsregionLevelvpc></createvpcofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.CreateVPCOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVPCOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVPCOffering(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVPCOffering", params, `{"deletevpcofferingresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPC.NewDeleteVPCOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVPCOffering", p.URLValues(), params)
+
+	r, err := cs.VPC.DeleteVPCOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVPCOffering: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVPCOffering" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVPCOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVPCOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVPCOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVPCOffering", params, `<deletevpcofferingresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletevpcofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.DeleteVPCOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVPCOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVPCOfferings(t *testing.T) {
+	params := url.Values{
+		"displaytext":       {"test-displaytext"},
+		"id":                {"test-id"},
+		"isdefault":         {"true"},
+		"keyword":           {"test-keyword"},
+		"name":              {"test-name"},
+		"page":              {"6"},
+		"pagesize":          {"7"},
+		"state":             {"test-state"},
+		"supportedservices": {"test-supportedservices1, test-supportedservices2"},
+	}
+	cs, done := newTestClient(t, "listVPCOfferings", params, `{"listvpcofferingsresponse":{"count":1,"vpcoffering":[{"created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"id":"test-id","isdefault":true,"name":"test-name","service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","supportsregionLevelvpc":true}]}}`)
+	defer done()
+
+	p := cs.VPC.NewListVPCOfferingsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDisplaytext("test-displaytext")
+	p.SetId("test-id")
+	p.SetIsdefault(true)
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetState("test-state")
+	p.SetSupportedservices([]string{"test-supportedservices1", "test-supportedservices2"})
+	checkURLValues(t, "listVPCOfferings", p.URLValues(), params)
+
+	r, err := cs.VPC.ListVPCOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVPCOfferings: %v", err)
+	}
+	if r.VPCOfferings[0].Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.VPCOfferings[0].Displaytext to be %v, got %v", "test-displaytext", r.VPCOfferings[0].Displaytext)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVPCOfferings" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVPCOfferings: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVPCOfferings: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVPCOfferings: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VPCOffering
+	count, err := cs.VPC.StreamVPCOfferings(context.Background(), p, func(v *VPCOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVPCOfferings: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VPCOfferings) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VPCOfferings)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVPCOfferings", params, `<listvpcofferingsresponse><count>1</count><vpcoffering><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><id>test-id</id><isdefault>true</isdefault><name>test-name</name><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><supportsregionLevelvpc>true</supportsregionLevelvpc></vpcoffering></listvpcofferingsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.ListVPCOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVPCOfferings using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPC.StreamVPCOfferings(context.Background(), p, func(v *VPCOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVPCOfferings using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VPCOfferings) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VPCOfferings)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVPCOffering(t *testing.T) {
+	params := url.Values{
+		"displaytext": {"test-displaytext"},
+		"id":          {"test-id"},
+		"name":        {"test-name"},
+		"state":       {"test-state"},
+	}
+	cs, done := newTestClient(t, "updateVPCOffering", params, `{"updatevpcofferingresponse":{"created":"2014-01-02T15:04:05+0100","displaytext":"test-displaytext","distributedvpcrouter":true,"id":"test-id","isdefault":true,"jobid":"test-jobid","name":"test-name","service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"state":"test-state","supportsregionLevelvpc":true}}`)
+	defer done()
+
+	p := cs.VPC.NewUpdateVPCOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDisplaytext("test-displaytext")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetState("test-state")
+	checkURLValues(t, "updateVPCOffering", p.URLValues(), params)
+
+	r, err := cs.VPC.UpdateVPCOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVPCOffering: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVPCOffering" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVPCOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVPCOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVPCOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVPCOffering", params, `<updatevpcofferingresponse><created>2014-01-02T15:04:05+0100</created><displaytext>test-displaytext</displaytext><distributedvpcrouter>true</distributedvpcrouter><id>test-id</id><isdefault>true</isdefault><jobid>test-jobid</jobid><name>test-name</name><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><state>test-state</state><supportsregionLevelvpc>true</supportsregionLevelvpc></updatevpcofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPC.UpdateVPCOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVPCOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,111 +17,236 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type VPNService struct {
+	cs *CloudStackClient
+}
+
+func NewVPNService(cs *CloudStackClient) *VPNService {
+	return &VPNService{cs: cs}
+}
+
 type CreateRemoteAccessVpnParams struct {
-	p map[string]interface{}
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay   *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Iprange      *string `json:"iprange,omitempty" yaml:"iprange,omitempty"`
+	Openfirewall *bool   `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Publicipid   *string `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
 }
 
-func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateRemoteAccessVpnParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["iprange"]; found {
-		u.Set("iprange", v.(string))
+	if v := p.Iprange; v != nil {
+		u.Set("iprange", *v)
 	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Openfirewall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("openfirewall", vv)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if v := p.Publicipid; v != nil {
+		u.Set("publicipid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *CreateRemoteAccessVpnParams) Command() string {
+	return "createRemoteAccessVpn"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateRemoteAccessVpnParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateRemoteAccessVpnParams) ResponseType() interface{} {
+	return &CreateRemoteAccessVpnResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateRemoteAccessVpnParams) Validate() error {
+	v := &validator{api: "createRemoteAccessVpn"}
+	v.required("publicipid", p.Publicipid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateRemoteAccessVpnParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *CreateRemoteAccessVpnParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateRemoteAccessVpnParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateRemoteAccessVpnParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateRemoteAccessVpnParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *CreateRemoteAccessVpnParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateRemoteAccessVpnParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateRemoteAccessVpnParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateRemoteAccessVpnParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["iprange"] = v
+	return *p.Fordisplay
+}
+
+func (p *CreateRemoteAccessVpnParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateRemoteAccessVpnParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *CreateRemoteAccessVpnParams) SetIprange(v string) {
+	p.Iprange = &v
 	return
 }
 
-func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateRemoteAccessVpnParams) GetIprange() string {
+	if p.Iprange == nil {
+		return ""
 	}
-	p.p["openfirewall"] = v
+	return *p.Iprange
+}
+
+func (p *CreateRemoteAccessVpnParams) HasIprange() bool {
+	return p.Iprange != nil
+}
+
+func (p *CreateRemoteAccessVpnParams) ResetIprange() {
+	p.Iprange = nil
+}
+
+func (p *CreateRemoteAccessVpnParams) SetOpenfirewall(v bool) {
+	p.Openfirewall = &v
 	return
 }
 
-func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateRemoteAccessVpnParams) GetOpenfirewall() bool {
+	if p.Openfirewall == nil {
+		return false
 	}
-	p.p["publicipid"] = v
+	return *p.Openfirewall
+}
+
+func (p *CreateRemoteAccessVpnParams) HasOpenfirewall() bool {
+	return p.Openfirewall != nil
+}
+
+func (p *CreateRemoteAccessVpnParams) ResetOpenfirewall() {
+	p.Openfirewall = nil
+}
+
+// This param is required.
+func (p *CreateRemoteAccessVpnParams) SetPublicipid(v string) {
+	p.Publicipid = &v
 	return
 }
 
+func (p *CreateRemoteAccessVpnParams) GetPublicipid() string {
+	if p.Publicipid == nil {
+		return ""
+	}
+	return *p.Publicipid
+}
+
+func (p *CreateRemoteAccessVpnParams) HasPublicipid() bool {
+	return p.Publicipid != nil
+}
+
+func (p *CreateRemoteAccessVpnParams) ResetPublicipid() {
+	p.Publicipid = nil
+}
+
 // You should always use this function to get a new CreateRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - publicipid
 func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams {
 	p := &CreateRemoteAccessVpnParams{}
-	p.p = make(map[string]interface{})
-	p.p["publicipid"] = publicipid
+	p.SetPublicipid(publicipid)
 	return p
 }
 
 // Creates a l2tp/ipsec remote access vpn
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListRemoteAccessVpns, GetRemoteAccessVpnByID.
 func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("createRemoteAccessVpn", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createRemoteAccessVpn", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateRemoteAccessVpnResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createRemoteAccessVpn", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -142,7 +267,7 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createRemoteAccessVpn", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -150,62 +275,55 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 }
 
 type CreateRemoteAccessVpnResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Account      string `json:"account,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Fordisplay   bool   `json:"fordisplay,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Iprange      string `json:"iprange,omitempty"`
-	Presharedkey string `json:"presharedkey,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Publicip     string `json:"publicip,omitempty"`
-	Publicipid   string `json:"publicipid,omitempty"`
-	State        string `json:"state,omitempty"`
-}
-
-type DeleteRemoteAccessVpnParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicipid"] = v
-	return
-}
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay   Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iprange      string                     `json:"iprange,omitempty" xml:"iprange,omitempty"`
+	Presharedkey string                     `json:"presharedkey,omitempty" xml:"presharedkey,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip     string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid   string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteRemoteAccessVpnParams = cloudstackcommon.DeleteRemoteAccessVpnParams
+type DeleteRemoteAccessVpnResponse = cloudstackcommon.DeleteRemoteAccessVpnResponse
 
 // You should always use this function to get a new DeleteRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - publicipid
 func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams {
 	p := &DeleteRemoteAccessVpnParams{}
-	p.p = make(map[string]interface{})
-	p.p["publicipid"] = publicipid
+	p.SetPublicipid(publicipid)
 	return p
 }
 
 // Destroys a l2tp/ipsec remote access vpn
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListRemoteAccessVpns, GetRemoteAccessVpnByID.
 func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteRemoteAccessVpn", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteRemoteAccessVpnResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteRemoteAccessVpn", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -221,182 +339,365 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteRemoteAccessVpn", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteRemoteAccessVpnResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type ListRemoteAccessVpnsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publicipid  *string `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListRemoteAccessVpnsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["publicipid"]; found {
-		u.Set("publicipid", v.(string))
+	if v := p.Publicipid; v != nil {
+		u.Set("publicipid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListRemoteAccessVpnsParams) Command() string {
+	return "listRemoteAccessVpns"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListRemoteAccessVpnsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListRemoteAccessVpnsParams) ResponseType() interface{} {
+	return &ListRemoteAccessVpnsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListRemoteAccessVpnsParams) Validate() error {
+	v := &validator{api: "listRemoteAccessVpns"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *ListRemoteAccessVpnsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListRemoteAccessVpnsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *ListRemoteAccessVpnsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListRemoteAccessVpnsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *ListRemoteAccessVpnsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListRemoteAccessVpnsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
+	return *p.Id
+}
+
+func (p *ListRemoteAccessVpnsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListRemoteAccessVpnsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListRemoteAccessVpnsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListRemoteAccessVpnsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListRemoteAccessVpnsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListRemoteAccessVpnsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["networkid"] = v
+	return *p.Listall
+}
+
+func (p *ListRemoteAccessVpnsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListRemoteAccessVpnsParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Networkid
+}
+
+func (p *ListRemoteAccessVpnsParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListRemoteAccessVpnsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListRemoteAccessVpnsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListRemoteAccessVpnsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListRemoteAccessVpnsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListRemoteAccessVpnsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListRemoteAccessVpnsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["publicipid"] = v
+	return *p.Projectid
+}
+
+func (p *ListRemoteAccessVpnsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListRemoteAccessVpnsParams) SetPublicipid(v string) {
+	p.Publicipid = &v
 	return
 }
 
+func (p *ListRemoteAccessVpnsParams) GetPublicipid() string {
+	if p.Publicipid == nil {
+		return ""
+	}
+	return *p.Publicipid
+}
+
+func (p *ListRemoteAccessVpnsParams) HasPublicipid() bool {
+	return p.Publicipid != nil
+}
+
+func (p *ListRemoteAccessVpnsParams) ResetPublicipid() {
+	p.Publicipid = nil
+}
+
 // You should always use this function to get a new ListRemoteAccessVpnsParams instance,
 // as then you are sure you have configured all required params
 func (s *VPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams {
 	p := &ListRemoteAccessVpnsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetRemoteAccessVpnByID(id string) (*RemoteAccessVpn, int, error) {
+func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
 	p := &ListRemoteAccessVpnsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListRemoteAccessVpns(p)
 	if err != nil {
@@ -408,6 +709,20 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string) (*RemoteAccessVpn, int, e
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListRemoteAccessVpns(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -419,103 +734,207 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string) (*RemoteAccessVpn, int, e
 }
 
 // Lists remote access vpns
+//
+// See also GetRemoteAccessVpnByID.
 func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
-	resp, err := s.cs.newRequest("listRemoteAccessVpns", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listRemoteAccessVpns", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRemoteAccessVpnsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listRemoteAccessVpns", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListRemoteAccessVpns, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPNService) StreamRemoteAccessVpns(ctx context.Context, p *ListRemoteAccessVpnsParams, fn func(*RemoteAccessVpn) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listRemoteAccessVpns", p.URLValues(), "remoteaccessvpn", func(b json.RawMessage) error {
+		var v RemoteAccessVpn
+		if err := s.cs.unmarshalElement("listRemoteAccessVpns", "remoteaccessvpn", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListRemoteAccessVpnsResponse struct {
-	Count            int                `json:"count"`
-	RemoteAccessVpns []*RemoteAccessVpn `json:"remoteaccessvpn"`
+	Count            int                        `json:"count" xml:"count"`
+	RemoteAccessVpns []*RemoteAccessVpn         `json:"remoteaccessvpn" xml:"remoteaccessvpn"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type RemoteAccessVpn struct {
-	Account      string `json:"account,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Fordisplay   bool   `json:"fordisplay,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Iprange      string `json:"iprange,omitempty"`
-	Presharedkey string `json:"presharedkey,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Publicip     string `json:"publicip,omitempty"`
-	Publicipid   string `json:"publicipid,omitempty"`
-	State        string `json:"state,omitempty"`
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay   Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iprange      string                     `json:"iprange,omitempty" xml:"iprange,omitempty"`
+	Presharedkey string                     `json:"presharedkey,omitempty" xml:"presharedkey,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip     string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid   string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type UpdateRemoteAccessVpnParams struct {
-	p map[string]interface{}
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateRemoteAccessVpnParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *UpdateRemoteAccessVpnParams) Command() string {
+	return "updateRemoteAccessVpn"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateRemoteAccessVpnParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateRemoteAccessVpnParams) ResponseType() interface{} {
+	return &UpdateRemoteAccessVpnResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateRemoteAccessVpnParams) Validate() error {
+	v := &validator{api: "updateRemoteAccessVpn"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
 func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+	p.Customid = &v
 	return
 }
 
-func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateRemoteAccessVpnParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Customid
+}
+
+func (p *UpdateRemoteAccessVpnParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateRemoteAccessVpnParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateRemoteAccessVpnParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateRemoteAccessVpnParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *UpdateRemoteAccessVpnParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateRemoteAccessVpnParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateRemoteAccessVpnParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
+func (p *UpdateRemoteAccessVpnParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateRemoteAccessVpnParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateRemoteAccessVpnParams) ResetId() {
+	p.Id = nil
+}
+
 // You should always use this function to get a new UpdateRemoteAccessVpnParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams {
 	p := &UpdateRemoteAccessVpnParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates remote access vpn
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListRemoteAccessVpns, GetRemoteAccessVpnByID.
 func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newRequest("updateRemoteAccessVpn", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateRemoteAccessVpn", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateRemoteAccessVpnResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateRemoteAccessVpn", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -536,7 +955,7 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateRemoteAccessVpn", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -544,107 +963,188 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 }
 
 type UpdateRemoteAccessVpnResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Account      string `json:"account,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Fordisplay   bool   `json:"fordisplay,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Iprange      string `json:"iprange,omitempty"`
-	Presharedkey string `json:"presharedkey,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Publicip     string `json:"publicip,omitempty"`
-	Publicipid   string `json:"publicipid,omitempty"`
-	State        string `json:"state,omitempty"`
-}
-
-type AddVpnUserParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddVpnUserParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay   Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iprange      string                     `json:"iprange,omitempty" xml:"iprange,omitempty"`
+	Presharedkey string                     `json:"presharedkey,omitempty" xml:"presharedkey,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip     string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid   string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVpnConnectionParams struct {
+	Fordisplay           *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Passive              *bool   `json:"passive,omitempty" yaml:"passive,omitempty"`
+	S2scustomergatewayid *string `json:"s2scustomergatewayid,omitempty" yaml:"s2scustomergatewayid,omitempty"`
+	S2svpngatewayid      *string `json:"s2svpngatewayid,omitempty" yaml:"s2svpngatewayid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVpnConnectionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Passive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("passive", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.S2scustomergatewayid; v != nil {
+		u.Set("s2scustomergatewayid", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.S2svpngatewayid; v != nil {
+		u.Set("s2svpngatewayid", *v)
 	}
 	return u
 }
 
-func (p *AddVpnUserParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Returns the name of the API command of these params
+func (p *CreateVpnConnectionParams) Command() string {
+	return "createVpnConnection"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVpnConnectionParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVpnConnectionParams) ResponseType() interface{} {
+	return &CreateVpnConnectionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVpnConnectionParams) Validate() error {
+	v := &validator{api: "createVpnConnection"}
+	v.required("s2scustomergatewayid", p.S2scustomergatewayid != nil)
+	v.required("s2svpngatewayid", p.S2svpngatewayid != nil)
+	return v.err()
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *AddVpnUserParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVpnConnectionParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["domainid"] = v
+	return *p.Fordisplay
+}
+
+func (p *CreateVpnConnectionParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateVpnConnectionParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *CreateVpnConnectionParams) SetPassive(v bool) {
+	p.Passive = &v
 	return
 }
 
-func (p *AddVpnUserParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVpnConnectionParams) GetPassive() bool {
+	if p.Passive == nil {
+		return false
 	}
-	p.p["password"] = v
+	return *p.Passive
+}
+
+func (p *CreateVpnConnectionParams) HasPassive() bool {
+	return p.Passive != nil
+}
+
+func (p *CreateVpnConnectionParams) ResetPassive() {
+	p.Passive = nil
+}
+
+// This param is required.
+func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
+	p.S2scustomergatewayid = &v
 	return
 }
 
-func (p *AddVpnUserParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVpnConnectionParams) GetS2scustomergatewayid() string {
+	if p.S2scustomergatewayid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.S2scustomergatewayid
+}
+
+func (p *CreateVpnConnectionParams) HasS2scustomergatewayid() bool {
+	return p.S2scustomergatewayid != nil
+}
+
+func (p *CreateVpnConnectionParams) ResetS2scustomergatewayid() {
+	p.S2scustomergatewayid = nil
+}
+
+// This param is required.
+func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
+	p.S2svpngatewayid = &v
 	return
 }
 
-func (p *AddVpnUserParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVpnConnectionParams) GetS2svpngatewayid() string {
+	if p.S2svpngatewayid == nil {
+		return ""
 	}
-	p.p["username"] = v
-	return
+	return *p.S2svpngatewayid
 }
 
-// You should always use this function to get a new AddVpnUserParams instance,
+func (p *CreateVpnConnectionParams) HasS2svpngatewayid() bool {
+	return p.S2svpngatewayid != nil
+}
+
+func (p *CreateVpnConnectionParams) ResetS2svpngatewayid() {
+	p.S2svpngatewayid = nil
+}
+
+// You should always use this function to get a new CreateVpnConnectionParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddVpnUserParams {
-	p := &AddVpnUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - s2scustomergatewayid
+//   - s2svpngatewayid
+func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams {
+	p := &CreateVpnConnectionParams{}
+	p.SetS2scustomergatewayid(s2scustomergatewayid)
+	p.SetS2svpngatewayid(s2svpngatewayid)
 	return p
 }
 
-// Adds vpn users
-func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("addVpnUser", p.toURLValues())
+// Create site to site vpn connection
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnConnections, GetVpnConnectionByID.
+func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVpnConnection", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddVpnUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateVpnConnectionResponse
+	if err := s.cs.unmarshal("createVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -665,99 +1165,73 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddVpnUserResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	State     string `json:"state,omitempty"`
-	Username  string `json:"username,omitempty"`
-}
-
-type RemoveVpnUserParams struct {
-	p map[string]interface{}
-}
-
-func (p *RemoveVpnUserParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
-}
-
-func (p *RemoveVpnUserParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *RemoveVpnUserParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *RemoveVpnUserParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
-
-func (p *RemoveVpnUserParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
+type CreateVpnConnectionResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist             string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd                  Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime          int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy            string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime          int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy            string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipsecpsk             string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Passive              Bool                       `json:"passive,omitempty" xml:"passive,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed              Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid,omitempty" xml:"s2scustomergatewayid,omitempty"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid,omitempty" xml:"s2svpngatewayid,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVpnConnectionParams = cloudstackcommon.DeleteVpnConnectionParams
+type DeleteVpnConnectionResponse = cloudstackcommon.DeleteVpnConnectionResponse
 
-// You should always use this function to get a new RemoveVpnUserParams instance,
+// You should always use this function to get a new DeleteVpnConnectionParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParams {
-	p := &RemoveVpnUserParams{}
-	p.p = make(map[string]interface{})
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - id
+func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams {
+	p := &DeleteVpnConnectionParams{}
+	p.SetId(id)
 	return p
 }
 
-// Removes vpn user
-func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
-	resp, err := s.cs.newRequest("removeVpnUser", p.toURLValues())
+// Delete site to site vpn connection
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnConnections, GetVpnConnectionByID.
+func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVpnConnection", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveVpnUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteVpnConnectionResponse
+	if err := s.cs.unmarshal("deleteVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -773,587 +1247,586 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveVpnUserResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListVpnUsersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVpnUsersParams) toURLValues() url.Values {
+type ListVpnConnectionsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVpnConnectionsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
 	return u
 }
 
-func (p *ListVpnUsersParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListVpnConnectionsParams) Command() string {
+	return "listVpnConnections"
 }
 
-func (p *ListVpnUsersParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListVpnConnectionsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListVpnUsersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVpnConnectionsParams) ResponseType() interface{} {
+	return &ListVpnConnectionsResponse{}
 }
 
-func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVpnConnectionsParams) Validate() error {
+	v := &validator{api: "listVpnConnections"}
+	return v.err()
 }
 
-func (p *ListVpnUsersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListVpnConnectionsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListVpnUsersParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["listall"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListVpnUsersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListVpnConnectionsParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListVpnUsersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListVpnConnectionsParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListVpnUsersParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVpnConnectionsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListVpnUsersParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["username"] = v
-	return
+	return *p.Domainid
 }
 
-// You should always use this function to get a new ListVpnUsersParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
-	p := &ListVpnUsersParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListVpnConnectionsParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetVpnUserByID(id string) (*VpnUser, int, error) {
-	p := &ListVpnUsersParams{}
-	p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) ResetDomainid() {
+	p.Domainid = nil
+}
 
-	p.p["id"] = id
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
 
-	l, err := s.ListVpnUsers(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *ListVpnConnectionsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
+	return *p.Fordisplay
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *ListVpnConnectionsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
 
-	if l.Count == 1 {
-		return l.VpnUsers[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VpnUser UUID: %s!", id)
+func (p *ListVpnConnectionsParams) ResetFordisplay() {
+	p.Fordisplay = nil
 }
 
-// Lists vpn users
-func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
-	resp, err := s.cs.newRequest("listVpnUsers", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListVpnConnectionsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	var r ListVpnUsersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListVpnConnectionsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Id
 }
 
-type ListVpnUsersResponse struct {
-	Count    int        `json:"count"`
-	VpnUsers []*VpnUser `json:"vpnuser"`
+func (p *ListVpnConnectionsParams) HasId() bool {
+	return p.Id != nil
 }
 
-type VpnUser struct {
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	State     string `json:"state,omitempty"`
-	Username  string `json:"username,omitempty"`
+func (p *ListVpnConnectionsParams) ResetId() {
+	p.Id = nil
 }
 
-type CreateVpnCustomerGatewayParams struct {
-	p map[string]interface{}
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
 }
 
-func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["cidrlist"]; found {
-		u.Set("cidrlist", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["dpd"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("dpd", vv)
-	}
-	if v, found := p.p["esplifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("esplifetime", vv)
-	}
-	if v, found := p.p["esppolicy"]; found {
-		u.Set("esppolicy", v.(string))
-	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+func (p *ListVpnConnectionsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	if v, found := p.p["ikelifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("ikelifetime", vv)
-	}
-	if v, found := p.p["ikepolicy"]; found {
-		u.Set("ikepolicy", v.(string))
-	}
-	if v, found := p.p["ipsecpsk"]; found {
-		u.Set("ipsecpsk", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
+	return *p.Isrecursive
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+func (p *ListVpnConnectionsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
-	return
+func (p *ListVpnConnectionsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVpnConnectionsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["dpd"] = v
-	return
+	return *p.Keyword
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["esplifetime"] = v
-	return
+func (p *ListVpnConnectionsParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["esppolicy"] = v
-	return
+func (p *ListVpnConnectionsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVpnConnectionsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["ikelifetime"] = v
-	return
+	return *p.Listall
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ikepolicy"] = v
-	return
+func (p *ListVpnConnectionsParams) HasListall() bool {
+	return p.Listall != nil
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipsecpsk"] = v
+func (p *ListVpnConnectionsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVpnConnectionsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["name"] = v
-	return
+	return *p.Page
 }
 
-// You should always use this function to get a new CreateVpnCustomerGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams {
-	p := &CreateVpnCustomerGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidrlist"] = cidrlist
-	p.p["esppolicy"] = esppolicy
-	p.p["gateway"] = gateway
-	p.p["ikepolicy"] = ikepolicy
-	p.p["ipsecpsk"] = ipsecpsk
-	return p
+func (p *ListVpnConnectionsParams) HasPage() bool {
+	return p.Page != nil
 }
 
-// Creates site to site vpn customer gateway
-func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnCustomerGateway", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListVpnConnectionsParams) ResetPage() {
+	p.Page = nil
+}
 
-	var r CreateVpnCustomerGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVpnConnectionsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVpnConnectionsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
+	return *p.Pagesize
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListVpnConnectionsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ListVpnConnectionsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVpnConnectionsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListVpnConnectionsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Projectid
 }
 
-type CreateVpnCustomerGatewayResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Account     string `json:"account,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Dpd         bool   `json:"dpd,omitempty"`
-	Esplifetime int64  `json:"esplifetime,omitempty"`
-	Esppolicy   string `json:"esppolicy,omitempty"`
-	Gateway     string `json:"gateway,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ikelifetime int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy   string `json:"ikepolicy,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipsecpsk    string `json:"ipsecpsk,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	Removed     string `json:"removed,omitempty"`
+func (p *ListVpnConnectionsParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-type CreateVpnGatewayParams struct {
-	p map[string]interface{}
+func (p *ListVpnConnectionsParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-func (p *CreateVpnGatewayParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
-	}
-	return u
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListVpnConnectionsParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
 }
 
-func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnConnectionsParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
-	return
+	return *p.Vpcid
 }
 
-func (p *CreateVpnGatewayParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
-	return
+func (p *ListVpnConnectionsParams) HasVpcid() bool {
+	return p.Vpcid != nil
 }
 
-// You should always use this function to get a new CreateVpnGatewayParams instance,
+func (p *ListVpnConnectionsParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// You should always use this function to get a new ListVpnConnectionsParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams {
-	p := &CreateVpnGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["vpcid"] = vpcid
+func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
+	p := &ListVpnConnectionsParams{}
 	return p
 }
 
-// Creates site to site vpn local gateway
-func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createVpnGateway", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
+	p := &ListVpnConnectionsParams{}
 
-	var r CreateVpnGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+	p.SetId(id)
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
 		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+	}
+
+	l, err := s.ListVpnConnections(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
+		return nil, -1, err
+	}
 
-		b, err = getRawValue(b)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVpnConnections(p)
 		if err != nil {
-			return nil, err
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
 		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VpnConnections[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VpnConnection UUID: %s!", id)
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+// Lists site to site vpn connection gateways
+//
+// See also GetVpnConnectionByID.
+func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
+
+	resp, err := s.cs.newRequest("listVpnConnections", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVpnConnectionsResponse
+	if err := s.cs.unmarshal("listVpnConnections", resp, &r); err != nil {
+		return nil, err
+	}
 	return &r, nil
 }
 
-type CreateVpnGatewayResponse struct {
-	JobID      string `json:"jobid,omitempty"`
-	Account    string `json:"account,omitempty"`
-	Domain     string `json:"domain,omitempty"`
-	Domainid   string `json:"domainid,omitempty"`
-	Fordisplay bool   `json:"fordisplay,omitempty"`
-	Id         string `json:"id,omitempty"`
-	Project    string `json:"project,omitempty"`
-	Projectid  string `json:"projectid,omitempty"`
-	Publicip   string `json:"publicip,omitempty"`
-	Removed    string `json:"removed,omitempty"`
-	Vpcid      string `json:"vpcid,omitempty"`
+// Lists the objects like ListVpnConnections, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPNService) StreamVpnConnections(ctx context.Context, p *ListVpnConnectionsParams, fn func(*VpnConnection) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listVpnConnections", p.URLValues(), "vpnconnection", func(b json.RawMessage) error {
+		var v VpnConnection
+		if err := s.cs.unmarshalElement("listVpnConnections", "vpnconnection", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type CreateVpnConnectionParams struct {
-	p map[string]interface{}
+type ListVpnConnectionsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	VpnConnections []*VpnConnection           `json:"vpnconnection" xml:"vpnconnection"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VpnConnection struct {
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist             string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd                  Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime          int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy            string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime          int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy            string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipsecpsk             string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Passive              Bool                       `json:"passive,omitempty" xml:"passive,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed              Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid,omitempty" xml:"s2scustomergatewayid,omitempty"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid,omitempty" xml:"s2svpngatewayid,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ResetVpnConnectionParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *CreateVpnConnectionParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ResetVpnConnectionParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["passive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("passive", vv)
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["s2scustomergatewayid"]; found {
-		u.Set("s2scustomergatewayid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["s2svpngatewayid"]; found {
-		u.Set("s2svpngatewayid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+// Returns the name of the API command of these params
+func (p *ResetVpnConnectionParams) Command() string {
+	return "resetVpnConnection"
+}
+
+// Returns true if the API command starts an async job
+func (p *ResetVpnConnectionParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ResetVpnConnectionParams) ResponseType() interface{} {
+	return &ResetVpnConnectionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ResetVpnConnectionParams) Validate() error {
+	v := &validator{api: "resetVpnConnection"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ResetVpnConnectionParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *CreateVpnConnectionParams) SetPassive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetVpnConnectionParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["passive"] = v
+	return *p.Account
+}
+
+func (p *ResetVpnConnectionParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ResetVpnConnectionParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ResetVpnConnectionParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateVpnConnectionParams) SetS2scustomergatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetVpnConnectionParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["s2scustomergatewayid"] = v
+	return *p.Domainid
+}
+
+func (p *ResetVpnConnectionParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ResetVpnConnectionParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *ResetVpnConnectionParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *CreateVpnConnectionParams) SetS2svpngatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResetVpnConnectionParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["s2svpngatewayid"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new CreateVpnConnectionParams instance,
+func (p *ResetVpnConnectionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ResetVpnConnectionParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new ResetVpnConnectionParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams {
-	p := &CreateVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["s2scustomergatewayid"] = s2scustomergatewayid
-	p.p["s2svpngatewayid"] = s2svpngatewayid
+//
+// The required params are:
+//
+//   - id
+func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams {
+	p := &ResetVpnConnectionParams{}
+	p.SetId(id)
 	return p
 }
 
-// Create site to site vpn connection
-func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("createVpnConnection", p.toURLValues())
+// Reset site to site vpn connection
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnConnections, GetVpnConnectionByID.
+func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("resetVpnConnection", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ResetVpnConnectionResponse
+	if err := s.cs.unmarshal("resetVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1374,80 +1847,179 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("resetVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateVpnConnectionResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Cidrlist             string `json:"cidrlist,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Dpd                  bool   `json:"dpd,omitempty"`
-	Esplifetime          int64  `json:"esplifetime,omitempty"`
-	Esppolicy            string `json:"esppolicy,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Ikelifetime          int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy            string `json:"ikepolicy,omitempty"`
-	Ipsecpsk             string `json:"ipsecpsk,omitempty"`
-	Passive              bool   `json:"passive,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Removed              string `json:"removed,omitempty"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid,omitempty"`
-	S2svpngatewayid      string `json:"s2svpngatewayid,omitempty"`
-	State                string `json:"state,omitempty"`
-}
-
-type DeleteVpnCustomerGatewayParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
+type ResetVpnConnectionResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist             string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd                  Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime          int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy            string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime          int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy            string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipsecpsk             string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Passive              Bool                       `json:"passive,omitempty" xml:"passive,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed              Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid,omitempty" xml:"s2scustomergatewayid,omitempty"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid,omitempty" xml:"s2svpngatewayid,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateVpnConnectionParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVpnConnectionParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *UpdateVpnConnectionParams) Command() string {
+	return "updateVpnConnection"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateVpnConnectionParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVpnConnectionParams) ResponseType() interface{} {
+	return &UpdateVpnConnectionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVpnConnectionParams) Validate() error {
+	v := &validator{api: "updateVpnConnection"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateVpnConnectionParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Customid
+}
+
+func (p *UpdateVpnConnectionParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateVpnConnectionParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-// You should always use this function to get a new DeleteVpnCustomerGatewayParams instance,
+func (p *UpdateVpnConnectionParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateVpnConnectionParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateVpnConnectionParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateVpnConnectionParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateVpnConnectionParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateVpnConnectionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateVpnConnectionParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateVpnConnectionParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams {
-	p := &DeleteVpnCustomerGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams {
+	p := &UpdateVpnConnectionParams{}
+	p.SetId(id)
 	return p
 }
 
-// Delete site to site vpn customer gateway
-func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", p.toURLValues())
+// Updates site to site vpn connection
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListVpnConnections, GetVpnConnectionByID.
+func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateVpnConnection", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteVpnCustomerGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateVpnConnectionResponse
+	if err := s.cs.unmarshal("updateVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1463,60 +2035,86 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteVpnCustomerGatewayResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type DeleteVpnGatewayParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteVpnGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type UpdateVpnConnectionResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist             string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd                  Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime          int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy            string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime          int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy            string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipsecpsk             string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Passive              Bool                       `json:"passive,omitempty" xml:"passive,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed              Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid,omitempty" xml:"s2scustomergatewayid,omitempty"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid,omitempty" xml:"s2svpngatewayid,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVpnCustomerGatewayParams = cloudstackcommon.CreateVpnCustomerGatewayParams
+type CreateVpnCustomerGatewayResponse = cloudstackcommon.CreateVpnCustomerGatewayResponse
 
-// You should always use this function to get a new DeleteVpnGatewayParams instance,
+// You should always use this function to get a new CreateVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams {
-	p := &DeleteVpnGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - cidrlist: the list of CIDRs
+//   - esppolicy
+//   - gateway
+//   - ikepolicy
+//   - ipsecpsk
+func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams {
+	p := &CreateVpnCustomerGatewayParams{}
+	p.SetCidrlist(cidrlist)
+	p.SetEsppolicy(esppolicy)
+	p.SetGateway(gateway)
+	p.SetIkepolicy(ikepolicy)
+	p.SetIpsecpsk(ipsecpsk)
 	return p
 }
 
-// Delete site to site vpn gateway
-func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnGateway", p.toURLValues())
+// Creates site to site vpn customer gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnCustomerGateways, GetVpnCustomerGatewayID, GetVpnCustomerGatewayByName, GetVpnCustomerGatewayByID.
+func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVpnCustomerGateway", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteVpnGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateVpnCustomerGatewayResponse
+	if err := s.cs.unmarshal("createVpnCustomerGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1532,60 +2130,51 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createVpnCustomerGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteVpnGatewayResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type DeleteVpnConnectionParams struct {
-	p map[string]interface{}
-}
+type DeleteVpnCustomerGatewayParams = cloudstackcommon.DeleteVpnCustomerGatewayParams
+type DeleteVpnCustomerGatewayResponse = cloudstackcommon.DeleteVpnCustomerGatewayResponse
 
-func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteVpnConnectionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new DeleteVpnConnectionParams instance,
+// You should always use this function to get a new DeleteVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams {
-	p := &DeleteVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams {
+	p := &DeleteVpnCustomerGatewayParams{}
+	p.SetId(id)
 	return p
 }
 
-// Delete site to site vpn connection
-func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("deleteVpnConnection", p.toURLValues())
+// Delete site to site vpn customer gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnCustomerGateways, GetVpnCustomerGatewayID, GetVpnCustomerGatewayByName, GetVpnCustomerGatewayByID.
+func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVpnCustomerGateway", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteVpnCustomerGatewayResponse
+	if err := s.cs.unmarshal("deleteVpnCustomerGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1601,189 +2190,229 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteVpnCustomerGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteVpnConnectionResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type ListVpnCustomerGatewaysParams = cloudstackcommon.ListVpnCustomerGatewaysParams
+type ListVpnCustomerGatewaysResponse = cloudstackcommon.ListVpnCustomerGatewaysResponse
+type VpnCustomerGateway = cloudstackcommon.VpnCustomerGateway
 
-type UpdateVpnCustomerGatewayParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListVpnCustomerGatewaysParams instance,
+// as then you are sure you have configured all required params
+func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams {
+	p := &ListVpnCustomerGatewaysParams{}
+	return p
 }
 
-func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["cidrlist"]; found {
-		u.Set("cidrlist", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["dpd"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("dpd", vv)
-	}
-	if v, found := p.p["esplifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("esplifetime", vv)
-	}
-	if v, found := p.p["esppolicy"]; found {
-		u.Set("esppolicy", v.(string))
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, error) {
+	p := &ListVpnCustomerGatewaysParams{}
+
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+
+	l, err := s.ListVpnCustomerGateways(p)
+	if err != nil {
+		return "", err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVpnCustomerGateways(p)
+		if err != nil {
+			return "", err
+		}
 	}
-	if v, found := p.p["ikelifetime"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("ikelifetime", vv)
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
-	if v, found := p.p["ikepolicy"]; found {
-		u.Set("ikepolicy", v.(string))
+
+	if l.Count == 1 {
+		return l.VpnCustomerGateways[0].Id, nil
 	}
-	if v, found := p.p["ipsecpsk"]; found {
-		u.Set("ipsecpsk", v.(string))
+
+	var matches []*VpnCustomerGateway
+	for _, v := range l.VpnCustomerGateways {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "VpnCustomerGateway", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
 	}
-	return u
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	id, err := s.GetVpnCustomerGatewayID(name, opts...)
+	if err != nil {
+		return nil, -1, err
 	}
-	p.p["account"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	r, count, err := s.GetVpnCustomerGatewayByID(id, opts...)
+	if err != nil {
+		return nil, count, err
 	}
-	p.p["cidrlist"] = v
-	return
+	return r, count, nil
 }
 
-func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	p := &ListVpnCustomerGatewaysParams{}
+
+	p.SetId(id)
 
-func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	p.p["dpd"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListVpnCustomerGateways(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	p.p["esplifetime"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVpnCustomerGateways(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
 	}
-	p.p["esppolicy"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	p.p["gateway"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.VpnCustomerGateways[0], l.Count, nil
 	}
-	p.p["id"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for VpnCustomerGateway UUID: %s!", id)
 }
 
-func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists site to site vpn customer gateways
+//
+// See also GetVpnCustomerGatewayID, GetVpnCustomerGatewayByName, GetVpnCustomerGatewayByID.
+func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["ikelifetime"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listVpnCustomerGateways", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["ikepolicy"] = v
-	return
-}
 
-func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListVpnCustomerGatewaysResponse
+	if err := s.cs.unmarshal("listVpnCustomerGateways", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["ipsecpsk"] = v
-	return
+	return &r, nil
 }
 
-func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListVpnCustomerGateways, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPNService) StreamVpnCustomerGateways(ctx context.Context, p *ListVpnCustomerGatewaysParams, fn func(*VpnCustomerGateway) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["name"] = v
-	return
+
+	return s.cs.streamList(ctx, "listVpnCustomerGateways", p.URLValues(), "vpncustomergateway", func(b json.RawMessage) error {
+		var v VpnCustomerGateway
+		if err := s.cs.unmarshalElement("listVpnCustomerGateways", "vpncustomergateway", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
+type UpdateVpnCustomerGatewayParams = cloudstackcommon.UpdateVpnCustomerGatewayParams
+type UpdateVpnCustomerGatewayResponse = cloudstackcommon.UpdateVpnCustomerGatewayResponse
+
 // You should always use this function to get a new UpdateVpnCustomerGatewayParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - cidrlist: the list of CIDRs
+//   - esppolicy
+//   - gateway
+//   - id
+//   - ikepolicy
+//   - ipsecpsk
 func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams {
 	p := &UpdateVpnCustomerGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidrlist"] = cidrlist
-	p.p["esppolicy"] = esppolicy
-	p.p["gateway"] = gateway
-	p.p["id"] = id
-	p.p["ikepolicy"] = ikepolicy
-	p.p["ipsecpsk"] = ipsecpsk
+	p.SetCidrlist(cidrlist)
+	p.SetEsppolicy(esppolicy)
+	p.SetGateway(gateway)
+	p.SetId(id)
+	p.SetIkepolicy(ikepolicy)
+	p.SetIpsecpsk(ipsecpsk)
 	return p
 }
 
 // Update site to site vpn customer gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnCustomerGateways, GetVpnCustomerGatewayID, GetVpnCustomerGatewayByName, GetVpnCustomerGatewayByID.
 func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnCustomerGateway", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateVpnCustomerGateway", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVpnCustomerGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateVpnCustomerGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1804,97 +2433,126 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateVpnCustomerGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateVpnCustomerGatewayResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Account     string `json:"account,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Dpd         bool   `json:"dpd,omitempty"`
-	Esplifetime int64  `json:"esplifetime,omitempty"`
-	Esppolicy   string `json:"esppolicy,omitempty"`
-	Gateway     string `json:"gateway,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ikelifetime int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy   string `json:"ikepolicy,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipsecpsk    string `json:"ipsecpsk,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	Removed     string `json:"removed,omitempty"`
-}
-
-type ResetVpnConnectionParams struct {
-	p map[string]interface{}
+type CreateVpnGatewayParams struct {
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Vpcid      *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
 }
 
-func (p *ResetVpnConnectionParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVpnGatewayParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
 	return u
 }
 
-func (p *ResetVpnConnectionParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Returns the name of the API command of these params
+func (p *CreateVpnGatewayParams) Command() string {
+	return "createVpnGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVpnGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVpnGatewayParams) ResponseType() interface{} {
+	return &CreateVpnGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVpnGatewayParams) Validate() error {
+	v := &validator{api: "createVpnGateway"}
+	v.required("vpcid", p.Vpcid != nil)
+	return v.err()
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ResetVpnConnectionParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVpnGatewayParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["domainid"] = v
+	return *p.Fordisplay
+}
+
+func (p *CreateVpnGatewayParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateVpnGatewayParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC. This param is required.
+func (p *CreateVpnGatewayParams) SetVpcid(v string) {
+	p.Vpcid = &v
 	return
 }
 
-func (p *ResetVpnConnectionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVpnGatewayParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Vpcid
 }
 
-// You should always use this function to get a new ResetVpnConnectionParams instance,
+func (p *CreateVpnGatewayParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *CreateVpnGatewayParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// You should always use this function to get a new CreateVpnGatewayParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams {
-	p := &ResetVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - vpcid: the ID of the VPC
+func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams {
+	p := &CreateVpnGatewayParams{}
+	p.SetVpcid(vpcid)
 	return p
 }
 
-// Reset site to site vpn connection
-func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("resetVpnConnection", p.toURLValues())
+// Creates site to site vpn local gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnGateways, GetVpnGatewayByID.
+func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVpnGateway", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ResetVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateVpnGatewayResponse
+	if err := s.cs.unmarshal("createVpnGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1915,631 +2573,413 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVpnGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ResetVpnConnectionResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Cidrlist             string `json:"cidrlist,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Dpd                  bool   `json:"dpd,omitempty"`
-	Esplifetime          int64  `json:"esplifetime,omitempty"`
-	Esppolicy            string `json:"esppolicy,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Ikelifetime          int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy            string `json:"ikepolicy,omitempty"`
-	Ipsecpsk             string `json:"ipsecpsk,omitempty"`
-	Passive              bool   `json:"passive,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Removed              string `json:"removed,omitempty"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid,omitempty"`
-	S2svpngatewayid      string `json:"s2svpngatewayid,omitempty"`
-	State                string `json:"state,omitempty"`
-}
-
-type ListVpnCustomerGatewaysParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
+type CreateVpnGatewayResponse struct {
+	JobID      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip   string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed    Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Vpcid      string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVpnGatewayParams = cloudstackcommon.DeleteVpnGatewayParams
+type DeleteVpnGatewayResponse = cloudstackcommon.DeleteVpnGatewayResponse
+
+// You should always use this function to get a new DeleteVpnGatewayParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams {
+	p := &DeleteVpnGatewayParams{}
+	p.SetId(id)
+	return p
+}
+
+// Delete site to site vpn gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnGateways, GetVpnGatewayByID.
+func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVpnGateway", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVpnGatewayResponse
+	if err := s.cs.unmarshal("deleteVpnGateway", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteVpnGateway", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListVpnGatewaysParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVpnGatewaysParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
 	return u
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListVpnGatewaysParams) Command() string {
+	return "listVpnGateways"
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListVpnGatewaysParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVpnGatewaysParams) ResponseType() interface{} {
+	return &ListVpnGatewaysResponse{}
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVpnGatewaysParams) Validate() error {
+	v := &validator{api: "listVpnGateways"}
+	return v.err()
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListVpnGatewaysParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["listall"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListVpnGatewaysParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListVpnGatewaysParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVpnGatewaysParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-// You should always use this function to get a new ListVpnCustomerGatewaysParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams {
-	p := &ListVpnCustomerGatewaysParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetVpnCustomerGatewayID(keyword string) (string, error) {
-	p := &ListVpnCustomerGatewaysParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["keyword"] = keyword
-
-	l, err := s.ListVpnCustomerGateways(p)
-	if err != nil {
-		return "", err
+func (p *ListVpnGatewaysParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
-
-	if l.Count == 1 {
-		return l.VpnCustomerGateways[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.VpnCustomerGateways {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+	return *p.Domainid
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetVpnCustomerGatewayByName(name string) (*VpnCustomerGateway, int, error) {
-	id, err := s.GetVpnCustomerGatewayID(name)
-	if err != nil {
-		return nil, -1, err
-	}
-
-	r, count, err := s.GetVpnCustomerGatewayByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetVpnCustomerGatewayByID(id string) (*VpnCustomerGateway, int, error) {
-	p := &ListVpnCustomerGatewaysParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListVpnCustomerGateways(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
-
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
-
-	if l.Count == 1 {
-		return l.VpnCustomerGateways[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VpnCustomerGateway UUID: %s!", id)
+func (p *ListVpnGatewaysParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-// Lists site to site vpn customer gateways
-func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnCustomerGateways", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListVpnCustomerGatewaysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *ListVpnGatewaysParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-type ListVpnCustomerGatewaysResponse struct {
-	Count               int                   `json:"count"`
-	VpnCustomerGateways []*VpnCustomerGateway `json:"vpncustomergateway"`
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this. Only supported by CloudStack 4.4.
+func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
 }
 
-type VpnCustomerGateway struct {
-	Account     string `json:"account,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Dpd         bool   `json:"dpd,omitempty"`
-	Esplifetime int64  `json:"esplifetime,omitempty"`
-	Esppolicy   string `json:"esppolicy,omitempty"`
-	Gateway     string `json:"gateway,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ikelifetime int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy   string `json:"ikepolicy,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipsecpsk    string `json:"ipsecpsk,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	Removed     string `json:"removed,omitempty"`
+func (p *ListVpnGatewaysParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
 }
 
-type ListVpnGatewaysParams struct {
-	p map[string]interface{}
+func (p *ListVpnGatewaysParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-func (p *ListVpnGatewaysParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
-	}
-	return u
+func (p *ListVpnGatewaysParams) ResetFordisplay() {
+	p.Fordisplay = nil
 }
 
-func (p *ListVpnGatewaysParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+func (p *ListVpnGatewaysParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListVpnGatewaysParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["domainid"] = v
-	return
+	return *p.Id
 }
 
-func (p *ListVpnGatewaysParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+func (p *ListVpnGatewaysParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *ListVpnGatewaysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListVpnGatewaysParams) ResetId() {
+	p.Id = nil
 }
 
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
 func (p *ListVpnGatewaysParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListVpnGatewaysParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Isrecursive
 }
 
-func (p *ListVpnGatewaysParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *ListVpnGatewaysParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
 }
 
-func (p *ListVpnGatewaysParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListVpnGatewaysParams) ResetIsrecursive() {
+	p.Isrecursive = nil
 }
 
-func (p *ListVpnGatewaysParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVpnGatewaysParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListVpnGatewaysParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Keyword
 }
 
-func (p *ListVpnGatewaysParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
-	return
+func (p *ListVpnGatewaysParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-// You should always use this function to get a new ListVpnGatewaysParams instance,
-// as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
-	p := &ListVpnGatewaysParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListVpnGatewaysParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetVpnGatewayByID(id string) (*VpnGateway, int, error) {
-	p := &ListVpnGatewaysParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListVpnGateways(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
-
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVpnGatewaysParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
 
-	if l.Count == 1 {
-		return l.VpnGateways[0], l.Count, nil
+func (p *ListVpnGatewaysParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VpnGateway UUID: %s!", id)
+	return *p.Listall
 }
 
-// Lists site 2 site vpn gateways
-func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnGateways", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListVpnGatewaysParams) HasListall() bool {
+	return p.Listall != nil
+}
 
-	var r ListVpnGatewaysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *ListVpnGatewaysParams) ResetListall() {
+	p.Listall = nil
 }
 
-type ListVpnGatewaysResponse struct {
-	Count       int           `json:"count"`
-	VpnGateways []*VpnGateway `json:"vpngateway"`
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVpnGatewaysParams) SetPage(v int) {
+	p.Page = &v
+	return
 }
 
-type VpnGateway struct {
-	Account    string `json:"account,omitempty"`
-	Domain     string `json:"domain,omitempty"`
-	Domainid   string `json:"domainid,omitempty"`
-	Fordisplay bool   `json:"fordisplay,omitempty"`
-	Id         string `json:"id,omitempty"`
-	Project    string `json:"project,omitempty"`
-	Projectid  string `json:"projectid,omitempty"`
-	Publicip   string `json:"publicip,omitempty"`
-	Removed    string `json:"removed,omitempty"`
-	Vpcid      string `json:"vpcid,omitempty"`
+func (p *ListVpnGatewaysParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
 }
 
-type ListVpnConnectionsParams struct {
-	p map[string]interface{}
+func (p *ListVpnGatewaysParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *ListVpnConnectionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
-	}
-	return u
+func (p *ListVpnGatewaysParams) ResetPage() {
+	p.Page = nil
 }
 
-func (p *ListVpnConnectionsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVpnGatewaysParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListVpnConnectionsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["domainid"] = v
-	return
+	return *p.Pagesize
 }
 
-func (p *ListVpnConnectionsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+func (p *ListVpnGatewaysParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVpnGatewaysParams) ResetPagesize() {
+	p.Pagesize = nil
 }
 
-func (p *ListVpnConnectionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVpnGatewaysParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListVpnConnectionsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
-	return
+	return *p.Projectid
 }
 
-func (p *ListVpnConnectionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListVpnGatewaysParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-func (p *ListVpnConnectionsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *ListVpnGatewaysParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-func (p *ListVpnConnectionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListVpnGatewaysParams) SetVpcid(v string) {
+	p.Vpcid = &v
 	return
 }
 
-func (p *ListVpnConnectionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVpnGatewaysParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Vpcid
 }
 
-func (p *ListVpnConnectionsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+func (p *ListVpnGatewaysParams) HasVpcid() bool {
+	return p.Vpcid != nil
 }
 
-func (p *ListVpnConnectionsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
-	return
+func (p *ListVpnGatewaysParams) ResetVpcid() {
+	p.Vpcid = nil
 }
 
-// You should always use this function to get a new ListVpnConnectionsParams instance,
+// You should always use this function to get a new ListVpnGatewaysParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
-	p := &ListVpnConnectionsParams{}
-	p.p = make(map[string]interface{})
+func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
+	p := &ListVpnGatewaysParams{}
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPNService) GetVpnConnectionByID(id string) (*VpnConnection, int, error) {
-	p := &ListVpnConnectionsParams{}
-	p.p = make(map[string]interface{})
+func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+	p := &ListVpnGatewaysParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListVpnConnections(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVpnGateways(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -2549,124 +2989,230 @@ func (s *VPNService) GetVpnConnectionByID(id string) (*VpnConnection, int, error
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVpnGateways(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
-		return l.VpnConnections[0], l.Count, nil
+		return l.VpnGateways[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VpnConnection UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for VpnGateway UUID: %s!", id)
 }
 
-// Lists site to site vpn connection gateways
-func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
-	resp, err := s.cs.newRequest("listVpnConnections", p.toURLValues())
+// Lists site 2 site vpn gateways
+//
+// See also GetVpnGatewayByID.
+func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVpnGateways", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListVpnConnectionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListVpnGatewaysResponse
+	if err := s.cs.unmarshal("listVpnGateways", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListVpnConnectionsResponse struct {
-	Count          int              `json:"count"`
-	VpnConnections []*VpnConnection `json:"vpnconnection"`
+// Lists the objects like ListVpnGateways, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPNService) StreamVpnGateways(ctx context.Context, p *ListVpnGatewaysParams, fn func(*VpnGateway) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listVpnGateways", p.URLValues(), "vpngateway", func(b json.RawMessage) error {
+		var v VpnGateway
+		if err := s.cs.unmarshalElement("listVpnGateways", "vpngateway", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type VpnConnection struct {
-	Account              string `json:"account,omitempty"`
-	Cidrlist             string `json:"cidrlist,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Dpd                  bool   `json:"dpd,omitempty"`
-	Esplifetime          int64  `json:"esplifetime,omitempty"`
-	Esppolicy            string `json:"esppolicy,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Ikelifetime          int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy            string `json:"ikepolicy,omitempty"`
-	Ipsecpsk             string `json:"ipsecpsk,omitempty"`
-	Passive              bool   `json:"passive,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Removed              string `json:"removed,omitempty"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid,omitempty"`
-	S2svpngatewayid      string `json:"s2svpngatewayid,omitempty"`
-	State                string `json:"state,omitempty"`
+type ListVpnGatewaysResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	VpnGateways []*VpnGateway              `json:"vpngateway" xml:"vpngateway"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type UpdateVpnConnectionParams struct {
-	p map[string]interface{}
+type VpnGateway struct {
+	Account    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip   string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed    Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Vpcid      string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateVpnGatewayParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVpnGatewayParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+// Returns the name of the API command of these params
+func (p *UpdateVpnGatewayParams) Command() string {
+	return "updateVpnGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateVpnGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVpnGatewayParams) ResponseType() interface{} {
+	return &UpdateVpnGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVpnGatewayParams) Validate() error {
+	v := &validator{api: "updateVpnGateway"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
+	p.Customid = &v
 	return
 }
 
-func (p *UpdateVpnConnectionParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVpnGatewayParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Customid
+}
+
+func (p *UpdateVpnGatewayParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateVpnGatewayParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *UpdateVpnConnectionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVpnGatewayParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *UpdateVpnGatewayParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateVpnGatewayParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateVpnGatewayParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new UpdateVpnConnectionParams instance,
+func (p *UpdateVpnGatewayParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateVpnGatewayParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateVpnGatewayParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateVpnGatewayParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams {
-	p := &UpdateVpnConnectionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams {
+	p := &UpdateVpnGatewayParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates site to site vpn connection
-func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnConnection", p.toURLValues())
+// Updates site to site vpn local gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// Only supported by CloudStack 4.4.
+// See also ListVpnGateways, GetVpnGatewayByID.
+func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateVpnGateway", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateVpnGatewayResponse
+	if err := s.cs.unmarshal("updateVpnGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2687,103 +3233,221 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateVpnGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateVpnConnectionResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Cidrlist             string `json:"cidrlist,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Dpd                  bool   `json:"dpd,omitempty"`
-	Esplifetime          int64  `json:"esplifetime,omitempty"`
-	Esppolicy            string `json:"esppolicy,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Ikelifetime          int64  `json:"ikelifetime,omitempty"`
-	Ikepolicy            string `json:"ikepolicy,omitempty"`
-	Ipsecpsk             string `json:"ipsecpsk,omitempty"`
-	Passive              bool   `json:"passive,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Removed              string `json:"removed,omitempty"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid,omitempty"`
-	S2svpngatewayid      string `json:"s2svpngatewayid,omitempty"`
-	State                string `json:"state,omitempty"`
-}
+type UpdateVpnGatewayResponse struct {
+	JobID      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip   string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Removed    Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Vpcid      string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddVpnUserParams = cloudstackcommon.AddVpnUserParams
+type AddVpnUserResponse = cloudstackcommon.AddVpnUserResponse
 
-type UpdateVpnGatewayParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new AddVpnUserParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - password: the password
+//   - username: the name of the user
+func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddVpnUserParams {
+	p := &AddVpnUserParams{}
+	p.SetPassword(password)
+	p.SetUsername(username)
+	return p
 }
 
-func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Adds vpn users
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnUsers, GetVpnUserByID.
+func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+
+	resp, err := s.cs.newRequest("addVpnUser", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+
+	var r AddVpnUserResponse
+	if err := s.cs.unmarshal("addVpnUser", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("addVpnUser", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	return u
+	return &r, nil
 }
 
-func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+type ListVpnUsersParams = cloudstackcommon.ListVpnUsersParams
+type ListVpnUsersResponse = cloudstackcommon.ListVpnUsersResponse
+type VpnUser = cloudstackcommon.VpnUser
+
+// You should always use this function to get a new ListVpnUsersParams instance,
+// as then you are sure you have configured all required params
+func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
+	p := &ListVpnUsersParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
+	p := &ListVpnUsersParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	p.p["customid"] = v
-	return
+
+	l, err := s.ListVpnUsers(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVpnUsers(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VpnUsers[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VpnUser UUID: %s!", id)
 }
 
-func (p *UpdateVpnGatewayParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists vpn users
+//
+// See also GetVpnUserByID.
+func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["fordisplay"] = v
-	return
+
+	resp, err := s.cs.newRequest("listVpnUsers", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVpnUsersResponse
+	if err := s.cs.unmarshal("listVpnUsers", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-func (p *UpdateVpnGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListVpnUsers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPNService) StreamVpnUsers(ctx context.Context, p *ListVpnUsersParams, fn func(*VpnUser) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["id"] = v
-	return
+
+	return s.cs.streamList(ctx, "listVpnUsers", p.URLValues(), "vpnuser", func(b json.RawMessage) error {
+		var v VpnUser
+		if err := s.cs.unmarshalElement("listVpnUsers", "vpnuser", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new UpdateVpnGatewayParams instance,
+type RemoveVpnUserParams = cloudstackcommon.RemoveVpnUserParams
+type RemoveVpnUserResponse = cloudstackcommon.RemoveVpnUserResponse
+
+// You should always use this function to get a new RemoveVpnUserParams instance,
 // as then you are sure you have configured all required params
-func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams {
-	p := &UpdateVpnGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - username: the name of the user
+func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParams {
+	p := &RemoveVpnUserParams{}
+	p.SetUsername(username)
 	return p
 }
 
-// Updates site to site vpn local gateway
-func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
-	resp, err := s.cs.newRequest("updateVpnGateway", p.toURLValues())
+// Removes vpn user
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVpnUsers, GetVpnUserByID.
+func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeVpnUser", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateVpnGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RemoveVpnUserResponse
+	if err := s.cs.unmarshal("removeVpnUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2799,28 +3463,9 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("removeVpnUser", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type UpdateVpnGatewayResponse struct {
-	JobID      string `json:"jobid,omitempty"`
-	Account    string `json:"account,omitempty"`
-	Domain     string `json:"domain,omitempty"`
-	Domainid   string `json:"domainid,omitempty"`
-	Fordisplay bool   `json:"fordisplay,omitempty"`
-	Id         string `json:"id,omitempty"`
-	Project    string `json:"project,omitempty"`
-	Projectid  string `json:"projectid,omitempty"`
-	Publicip   string `json:"publicip,omitempty"`
-	Removed    string `json:"removed,omitempty"`
-	Vpcid      string `json:"vpcid,omitempty"`
-}
@@ -0,0 +1,1547 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateRemoteAccessVpn(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"fordisplay":   {"true"},
+		"iprange":      {"test-iprange"},
+		"openfirewall": {"true"},
+		"publicipid":   {"test-publicipid"},
+	}
+	cs, done := newTestClient(t, "createRemoteAccessVpn", params, `{"createremoteaccessvpnresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","iprange":"test-iprange","jobid":"test-jobid","presharedkey":"test-presharedkey","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","state":"test-state"}}`)
+	defer done()
+
+	p := cs.VPN.NewCreateRemoteAccessVpnParams("test-publicipid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetIprange("test-iprange")
+	p.SetOpenfirewall(true)
+	p.SetPublicipid("test-publicipid")
+	checkURLValues(t, "createRemoteAccessVpn", p.URLValues(), params)
+
+	r, err := cs.VPN.CreateRemoteAccessVpn(p)
+	if err != nil {
+		t.Fatalf("Failed to call createRemoteAccessVpn: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createRemoteAccessVpn" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createRemoteAccessVpn: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createRemoteAccessVpn: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createRemoteAccessVpn: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createRemoteAccessVpn", params, `<createremoteaccessvpnresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><iprange>test-iprange</iprange><jobid>test-jobid</jobid><presharedkey>test-presharedkey</presharedkey><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><state>test-state</state></createremoteaccessvpnresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.CreateRemoteAccessVpn(p)
+	if err != nil {
+		t.Fatalf("Failed to call createRemoteAccessVpn using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteRemoteAccessVpn(t *testing.T) {
+	params := url.Values{
+		"publicipid": {"test-publicipid"},
+	}
+	cs, done := newTestClient(t, "deleteRemoteAccessVpn", params, `{"deleteremoteaccessvpnresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPN.NewDeleteRemoteAccessVpnParams("test-publicipid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetPublicipid("test-publicipid")
+	checkURLValues(t, "deleteRemoteAccessVpn", p.URLValues(), params)
+
+	r, err := cs.VPN.DeleteRemoteAccessVpn(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteRemoteAccessVpn: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteRemoteAccessVpn" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteRemoteAccessVpn: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteRemoteAccessVpn: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteRemoteAccessVpn: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteRemoteAccessVpn", params, `<deleteremoteaccessvpnresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteremoteaccessvpnresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.DeleteRemoteAccessVpn(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteRemoteAccessVpn using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListRemoteAccessVpns(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"fordisplay":  {"true"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"networkid":   {"test-networkid"},
+		"page":        {"9"},
+		"pagesize":    {"10"},
+		"projectid":   {"test-projectid"},
+		"publicipid":  {"test-publicipid"},
+	}
+	cs, done := newTestClient(t, "listRemoteAccessVpns", params, `{"listremoteaccessvpnsresponse":{"count":1,"remoteaccessvpn":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","iprange":"test-iprange","presharedkey":"test-presharedkey","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","state":"test-state"}]}}`)
+	defer done()
+
+	p := cs.VPN.NewListRemoteAccessVpnsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetNetworkid("test-networkid")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetPublicipid("test-publicipid")
+	checkURLValues(t, "listRemoteAccessVpns", p.URLValues(), params)
+
+	r, err := cs.VPN.ListRemoteAccessVpns(p)
+	if err != nil {
+		t.Fatalf("Failed to call listRemoteAccessVpns: %v", err)
+	}
+	if r.RemoteAccessVpns[0].Account != "test-account" {
+		t.Errorf("Expected r.RemoteAccessVpns[0].Account to be %v, got %v", "test-account", r.RemoteAccessVpns[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listRemoteAccessVpns" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listRemoteAccessVpns: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listRemoteAccessVpns: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listRemoteAccessVpns: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*RemoteAccessVpn
+	count, err := cs.VPN.StreamRemoteAccessVpns(context.Background(), p, func(v *RemoteAccessVpn) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listRemoteAccessVpns: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.RemoteAccessVpns) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.RemoteAccessVpns)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listRemoteAccessVpns", params, `<listremoteaccessvpnsresponse><count>1</count><remoteaccessvpn><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><iprange>test-iprange</iprange><presharedkey>test-presharedkey</presharedkey><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><state>test-state</state></remoteaccessvpn></listremoteaccessvpnsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.ListRemoteAccessVpns(p)
+	if err != nil {
+		t.Fatalf("Failed to call listRemoteAccessVpns using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPN.StreamRemoteAccessVpns(context.Background(), p, func(v *RemoteAccessVpn) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listRemoteAccessVpns using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.RemoteAccessVpns) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.RemoteAccessVpns)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateRemoteAccessVpn(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateRemoteAccessVpn", params, `{"updateremoteaccessvpnresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","iprange":"test-iprange","jobid":"test-jobid","presharedkey":"test-presharedkey","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","state":"test-state"}}`)
+	defer done()
+
+	p := cs.VPN.NewUpdateRemoteAccessVpnParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateRemoteAccessVpn", p.URLValues(), params)
+
+	r, err := cs.VPN.UpdateRemoteAccessVpn(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateRemoteAccessVpn: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateRemoteAccessVpn" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateRemoteAccessVpn: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateRemoteAccessVpn: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateRemoteAccessVpn: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateRemoteAccessVpn", params, `<updateremoteaccessvpnresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><iprange>test-iprange</iprange><jobid>test-jobid</jobid><presharedkey>test-presharedkey</presharedkey><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><state>test-state</state></updateremoteaccessvpnresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.UpdateRemoteAccessVpn(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateRemoteAccessVpn using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVpnConnection(t *testing.T) {
+	params := url.Values{
+		"fordisplay":           {"true"},
+		"passive":              {"true"},
+		"s2scustomergatewayid": {"test-s2scustomergatewayid"},
+		"s2svpngatewayid":      {"test-s2svpngatewayid"},
+	}
+	cs, done := newTestClient(t, "createVpnConnection", params, `{"createvpnconnectionresponse":{"account":"test-account","cidrlist":"test-cidrlist","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","fordisplay":true,"gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","passive":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","s2scustomergatewayid":"test-s2scustomergatewayid","s2svpngatewayid":"test-s2svpngatewayid","state":"test-state"}}`)
+	defer done()
+
+	p := cs.VPN.NewCreateVpnConnectionParams("test-s2scustomergatewayid", "test-s2svpngatewayid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFordisplay(true)
+	p.SetPassive(true)
+	p.SetS2scustomergatewayid("test-s2scustomergatewayid")
+	p.SetS2svpngatewayid("test-s2svpngatewayid")
+	checkURLValues(t, "createVpnConnection", p.URLValues(), params)
+
+	r, err := cs.VPN.CreateVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVpnConnection: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVpnConnection" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVpnConnection: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVpnConnection: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVpnConnection: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVpnConnection", params, `<createvpnconnectionresponse><account>test-account</account><cidrlist>test-cidrlist</cidrlist><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><fordisplay>true</fordisplay><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipsecpsk>test-ipsecpsk</ipsecpsk><jobid>test-jobid</jobid><passive>true</passive><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><s2scustomergatewayid>test-s2scustomergatewayid</s2scustomergatewayid><s2svpngatewayid>test-s2svpngatewayid</s2svpngatewayid><state>test-state</state></createvpnconnectionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.CreateVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVpnConnection using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVpnConnection(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVpnConnection", params, `{"deletevpnconnectionresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPN.NewDeleteVpnConnectionParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVpnConnection", p.URLValues(), params)
+
+	r, err := cs.VPN.DeleteVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVpnConnection: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVpnConnection" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVpnConnection: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVpnConnection: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVpnConnection: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVpnConnection", params, `<deletevpnconnectionresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletevpnconnectionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.DeleteVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVpnConnection using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVpnConnections(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"fordisplay":  {"true"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+		"vpcid":       {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "listVpnConnections", params, `{"listvpnconnectionsresponse":{"count":1,"vpnconnection":[{"account":"test-account","cidrlist":"test-cidrlist","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","fordisplay":true,"gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipsecpsk":"test-ipsecpsk","passive":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","s2scustomergatewayid":"test-s2scustomergatewayid","s2svpngatewayid":"test-s2svpngatewayid","state":"test-state"}]}}`)
+	defer done()
+
+	p := cs.VPN.NewListVpnConnectionsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "listVpnConnections", p.URLValues(), params)
+
+	r, err := cs.VPN.ListVpnConnections(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnConnections: %v", err)
+	}
+	if r.VpnConnections[0].Account != "test-account" {
+		t.Errorf("Expected r.VpnConnections[0].Account to be %v, got %v", "test-account", r.VpnConnections[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVpnConnections" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVpnConnections: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVpnConnections: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVpnConnections: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VpnConnection
+	count, err := cs.VPN.StreamVpnConnections(context.Background(), p, func(v *VpnConnection) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVpnConnections: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VpnConnections) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VpnConnections)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVpnConnections", params, `<listvpnconnectionsresponse><count>1</count><vpnconnection><account>test-account</account><cidrlist>test-cidrlist</cidrlist><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><fordisplay>true</fordisplay><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipsecpsk>test-ipsecpsk</ipsecpsk><passive>true</passive><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><s2scustomergatewayid>test-s2scustomergatewayid</s2scustomergatewayid><s2svpngatewayid>test-s2svpngatewayid</s2svpngatewayid><state>test-state</state></vpnconnection></listvpnconnectionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.ListVpnConnections(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnConnections using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPN.StreamVpnConnections(context.Background(), p, func(v *VpnConnection) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVpnConnections using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VpnConnections) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VpnConnections)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestResetVpnConnection(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"id":       {"test-id"},
+	}
+	cs, done := newTestClient(t, "resetVpnConnection", params, `{"resetvpnconnectionresponse":{"account":"test-account","cidrlist":"test-cidrlist","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","fordisplay":true,"gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","passive":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","s2scustomergatewayid":"test-s2scustomergatewayid","s2svpngatewayid":"test-s2svpngatewayid","state":"test-state"}}`)
+	defer done()
+
+	p := cs.VPN.NewResetVpnConnectionParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	checkURLValues(t, "resetVpnConnection", p.URLValues(), params)
+
+	r, err := cs.VPN.ResetVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call resetVpnConnection: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "resetVpnConnection" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute resetVpnConnection: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of resetVpnConnection: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of resetVpnConnection: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "resetVpnConnection", params, `<resetvpnconnectionresponse><account>test-account</account><cidrlist>test-cidrlist</cidrlist><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><fordisplay>true</fordisplay><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipsecpsk>test-ipsecpsk</ipsecpsk><jobid>test-jobid</jobid><passive>true</passive><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><s2scustomergatewayid>test-s2scustomergatewayid</s2scustomergatewayid><s2svpngatewayid>test-s2svpngatewayid</s2svpngatewayid><state>test-state</state></resetvpnconnectionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.ResetVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call resetVpnConnection using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVpnConnection(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateVpnConnection", params, `{"updatevpnconnectionresponse":{"account":"test-account","cidrlist":"test-cidrlist","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","fordisplay":true,"gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","passive":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","s2scustomergatewayid":"test-s2scustomergatewayid","s2svpngatewayid":"test-s2svpngatewayid","state":"test-state"}}`)
+	defer done()
+
+	p := cs.VPN.NewUpdateVpnConnectionParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateVpnConnection", p.URLValues(), params)
+
+	r, err := cs.VPN.UpdateVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVpnConnection: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVpnConnection" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVpnConnection: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVpnConnection: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVpnConnection: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVpnConnection", params, `<updatevpnconnectionresponse><account>test-account</account><cidrlist>test-cidrlist</cidrlist><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><fordisplay>true</fordisplay><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipsecpsk>test-ipsecpsk</ipsecpsk><jobid>test-jobid</jobid><passive>true</passive><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><s2scustomergatewayid>test-s2scustomergatewayid</s2scustomergatewayid><s2svpngatewayid>test-s2svpngatewayid</s2svpngatewayid><state>test-state</state></updatevpnconnectionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.UpdateVpnConnection(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVpnConnection using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVpnCustomerGateway(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"cidrlist":    {"test-cidrlist"},
+		"domainid":    {"test-domainid"},
+		"dpd":         {"true"},
+		"esplifetime": {"5"},
+		"esppolicy":   {"test-esppolicy"},
+		"gateway":     {"test-gateway"},
+		"ikelifetime": {"8"},
+		"ikepolicy":   {"test-ikepolicy"},
+		"ipsecpsk":    {"test-ipsecpsk"},
+		"name":        {"test-name"},
+	}
+	cs, done := newTestClient(t, "createVpnCustomerGateway", params, `{"createvpncustomergatewayresponse":{"account":"test-account","cidrlist":"test-cidrlist","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipaddress":"test-ipaddress","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100"}}`)
+	defer done()
+
+	p := cs.VPN.NewCreateVpnCustomerGatewayParams("test-cidrlist", "test-esppolicy", "test-gateway", "test-ikepolicy", "test-ipsecpsk")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCidrlist("test-cidrlist")
+	p.SetDomainid("test-domainid")
+	p.SetDpd(true)
+	p.SetEsplifetime(5)
+	p.SetEsppolicy("test-esppolicy")
+	p.SetGateway("test-gateway")
+	p.SetIkelifetime(8)
+	p.SetIkepolicy("test-ikepolicy")
+	p.SetIpsecpsk("test-ipsecpsk")
+	p.SetName("test-name")
+	checkURLValues(t, "createVpnCustomerGateway", p.URLValues(), params)
+
+	r, err := cs.VPN.CreateVpnCustomerGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVpnCustomerGateway: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVpnCustomerGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVpnCustomerGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVpnCustomerGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVpnCustomerGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVpnCustomerGateway", params, `<createvpncustomergatewayresponse><account>test-account</account><cidrlist>test-cidrlist</cidrlist><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipaddress>test-ipaddress</ipaddress><ipsecpsk>test-ipsecpsk</ipsecpsk><jobid>test-jobid</jobid><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed></createvpncustomergatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.CreateVpnCustomerGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVpnCustomerGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVpnCustomerGateway(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVpnCustomerGateway", params, `{"deletevpncustomergatewayresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPN.NewDeleteVpnCustomerGatewayParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVpnCustomerGateway", p.URLValues(), params)
+
+	r, err := cs.VPN.DeleteVpnCustomerGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVpnCustomerGateway: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVpnCustomerGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVpnCustomerGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVpnCustomerGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVpnCustomerGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVpnCustomerGateway", params, `<deletevpncustomergatewayresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletevpncustomergatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.DeleteVpnCustomerGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVpnCustomerGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVpnCustomerGateways(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"7"},
+		"pagesize":    {"8"},
+		"projectid":   {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "listVpnCustomerGateways", params, `{"listvpncustomergatewaysresponse":{"count":1,"vpncustomergateway":[{"account":"test-account","cidrlist":"test-cidrlist","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipaddress":"test-ipaddress","ipsecpsk":"test-ipsecpsk","name":"test-name","project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100"}]}}`)
+	defer done()
+
+	p := cs.VPN.NewListVpnCustomerGatewaysParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "listVpnCustomerGateways", p.URLValues(), params)
+
+	r, err := cs.VPN.ListVpnCustomerGateways(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnCustomerGateways: %v", err)
+	}
+	if r.VpnCustomerGateways[0].Account != "test-account" {
+		t.Errorf("Expected r.VpnCustomerGateways[0].Account to be %v, got %v", "test-account", r.VpnCustomerGateways[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVpnCustomerGateways" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVpnCustomerGateways: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVpnCustomerGateways: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVpnCustomerGateways: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VpnCustomerGateway
+	count, err := cs.VPN.StreamVpnCustomerGateways(context.Background(), p, func(v *VpnCustomerGateway) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVpnCustomerGateways: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VpnCustomerGateways) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VpnCustomerGateways)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVpnCustomerGateways", params, `<listvpncustomergatewaysresponse><count>1</count><vpncustomergateway><account>test-account</account><cidrlist>test-cidrlist</cidrlist><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipaddress>test-ipaddress</ipaddress><ipsecpsk>test-ipsecpsk</ipsecpsk><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed></vpncustomergateway></listvpncustomergatewaysresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.ListVpnCustomerGateways(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnCustomerGateways using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPN.StreamVpnCustomerGateways(context.Background(), p, func(v *VpnCustomerGateway) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVpnCustomerGateways using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VpnCustomerGateways) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VpnCustomerGateways)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVpnCustomerGateway(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"cidrlist":    {"test-cidrlist"},
+		"domainid":    {"test-domainid"},
+		"dpd":         {"true"},
+		"esplifetime": {"5"},
+		"esppolicy":   {"test-esppolicy"},
+		"gateway":     {"test-gateway"},
+		"id":          {"test-id"},
+		"ikelifetime": {"9"},
+		"ikepolicy":   {"test-ikepolicy"},
+		"ipsecpsk":    {"test-ipsecpsk"},
+		"name":        {"test-name"},
+	}
+	cs, done := newTestClient(t, "updateVpnCustomerGateway", params, `{"updatevpncustomergatewayresponse":{"account":"test-account","cidrlist":"test-cidrlist","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipaddress":"test-ipaddress","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100"}}`)
+	defer done()
+
+	p := cs.VPN.NewUpdateVpnCustomerGatewayParams("test-cidrlist", "test-esppolicy", "test-gateway", "test-id", "test-ikepolicy", "test-ipsecpsk")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCidrlist("test-cidrlist")
+	p.SetDomainid("test-domainid")
+	p.SetDpd(true)
+	p.SetEsplifetime(5)
+	p.SetEsppolicy("test-esppolicy")
+	p.SetGateway("test-gateway")
+	p.SetId("test-id")
+	p.SetIkelifetime(9)
+	p.SetIkepolicy("test-ikepolicy")
+	p.SetIpsecpsk("test-ipsecpsk")
+	p.SetName("test-name")
+	checkURLValues(t, "updateVpnCustomerGateway", p.URLValues(), params)
+
+	r, err := cs.VPN.UpdateVpnCustomerGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVpnCustomerGateway: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVpnCustomerGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVpnCustomerGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVpnCustomerGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVpnCustomerGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVpnCustomerGateway", params, `<updatevpncustomergatewayresponse><account>test-account</account><cidrlist>test-cidrlist</cidrlist><domain>test-domain</domain><domainid>test-domainid</domainid><dpd>true</dpd><esplifetime>1</esplifetime><esppolicy>test-esppolicy</esppolicy><gateway>test-gateway</gateway><id>test-id</id><ikelifetime>1</ikelifetime><ikepolicy>test-ikepolicy</ikepolicy><ipaddress>test-ipaddress</ipaddress><ipsecpsk>test-ipsecpsk</ipsecpsk><jobid>test-jobid</jobid><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed></updatevpncustomergatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.UpdateVpnCustomerGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVpnCustomerGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVpnGateway(t *testing.T) {
+	params := url.Values{
+		"fordisplay": {"true"},
+		"vpcid":      {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "createVpnGateway", params, `{"createvpngatewayresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","vpcid":"test-vpcid"}}`)
+	defer done()
+
+	p := cs.VPN.NewCreateVpnGatewayParams("test-vpcid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFordisplay(true)
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "createVpnGateway", p.URLValues(), params)
+
+	r, err := cs.VPN.CreateVpnGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVpnGateway: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVpnGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVpnGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVpnGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVpnGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVpnGateway", params, `<createvpngatewayresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><vpcid>test-vpcid</vpcid></createvpngatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.CreateVpnGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVpnGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVpnGateway(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVpnGateway", params, `{"deletevpngatewayresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPN.NewDeleteVpnGatewayParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVpnGateway", p.URLValues(), params)
+
+	r, err := cs.VPN.DeleteVpnGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVpnGateway: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVpnGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVpnGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVpnGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVpnGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVpnGateway", params, `<deletevpngatewayresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletevpngatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.DeleteVpnGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVpnGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVpnGateways(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"fordisplay":  {"true"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+		"vpcid":       {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "listVpnGateways", params, `{"listvpngatewaysresponse":{"count":1,"vpngateway":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","vpcid":"test-vpcid"}]}}`)
+	defer done()
+
+	p := cs.VPN.NewListVpnGatewaysParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "listVpnGateways", p.URLValues(), params)
+
+	r, err := cs.VPN.ListVpnGateways(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnGateways: %v", err)
+	}
+	if r.VpnGateways[0].Account != "test-account" {
+		t.Errorf("Expected r.VpnGateways[0].Account to be %v, got %v", "test-account", r.VpnGateways[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVpnGateways" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVpnGateways: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVpnGateways: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVpnGateways: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VpnGateway
+	count, err := cs.VPN.StreamVpnGateways(context.Background(), p, func(v *VpnGateway) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVpnGateways: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VpnGateways) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VpnGateways)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVpnGateways", params, `<listvpngatewaysresponse><count>1</count><vpngateway><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><vpcid>test-vpcid</vpcid></vpngateway></listvpngatewaysresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.ListVpnGateways(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnGateways using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPN.StreamVpnGateways(context.Background(), p, func(v *VpnGateway) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVpnGateways using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VpnGateways) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VpnGateways)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVpnGateway(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateVpnGateway", params, `{"updatevpngatewayresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","removed":"2014-01-02T15:04:05+0100","vpcid":"test-vpcid"}}`)
+	defer done()
+
+	p := cs.VPN.NewUpdateVpnGatewayParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateVpnGateway", p.URLValues(), params)
+
+	r, err := cs.VPN.UpdateVpnGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVpnGateway: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVpnGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVpnGateway: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVpnGateway: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVpnGateway: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVpnGateway", params, `<updatevpngatewayresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><removed>2014-01-02T15:04:05+0100</removed><vpcid>test-vpcid</vpcid></updatevpngatewayresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.UpdateVpnGateway(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVpnGateway using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddVpnUser(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"password":  {"test-password"},
+		"projectid": {"test-projectid"},
+		"username":  {"test-username"},
+	}
+	cs, done := newTestClient(t, "addVpnUser", params, `{"addvpnuserresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","state":"test-state","username":"test-username"}}`)
+	defer done()
+
+	p := cs.VPN.NewAddVpnUserParams("test-password", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPassword("test-password")
+	p.SetProjectid("test-projectid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addVpnUser", p.URLValues(), params)
+
+	r, err := cs.VPN.AddVpnUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call addVpnUser: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addVpnUser" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addVpnUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addVpnUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addVpnUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addVpnUser", params, `<addvpnuserresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><username>test-username</username></addvpnuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.AddVpnUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call addVpnUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVpnUsers(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"7"},
+		"pagesize":    {"8"},
+		"projectid":   {"test-projectid"},
+		"username":    {"test-username"},
+	}
+	cs, done := newTestClient(t, "listVpnUsers", params, `{"listvpnusersresponse":{"count":1,"vpnuser":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","project":"test-project","projectid":"test-projectid","state":"test-state","username":"test-username"}]}}`)
+	defer done()
+
+	p := cs.VPN.NewListVpnUsersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "listVpnUsers", p.URLValues(), params)
+
+	r, err := cs.VPN.ListVpnUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnUsers: %v", err)
+	}
+	if r.VpnUsers[0].Account != "test-account" {
+		t.Errorf("Expected r.VpnUsers[0].Account to be %v, got %v", "test-account", r.VpnUsers[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVpnUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVpnUsers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVpnUsers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVpnUsers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*VpnUser
+	count, err := cs.VPN.StreamVpnUsers(context.Background(), p, func(v *VpnUser) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVpnUsers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.VpnUsers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.VpnUsers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVpnUsers", params, `<listvpnusersresponse><count>1</count><vpnuser><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><project>test-project</project><projectid>test-projectid</projectid><state>test-state</state><username>test-username</username></vpnuser></listvpnusersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.ListVpnUsers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVpnUsers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.VPN.StreamVpnUsers(context.Background(), p, func(v *VpnUser) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVpnUsers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.VpnUsers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.VpnUsers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveVpnUser(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"projectid": {"test-projectid"},
+		"username":  {"test-username"},
+	}
+	cs, done := newTestClient(t, "removeVpnUser", params, `{"removevpnuserresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.VPN.NewRemoveVpnUserParams("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetProjectid("test-projectid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "removeVpnUser", p.URLValues(), params)
+
+	r, err := cs.VPN.RemoveVpnUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeVpnUser: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeVpnUser" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeVpnUser: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeVpnUser: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeVpnUser: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeVpnUser", params, `<removevpnuserresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removevpnuserresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.VPN.RemoveVpnUser(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeVpnUser using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
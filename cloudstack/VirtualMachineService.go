@@ -17,394 +17,342 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type DeployVirtualMachineParams struct {
-	p map[string]interface{}
+type VirtualMachineService struct {
+	cs *CloudStackClient
+}
+
+func NewVirtualMachineService(cs *CloudStackClient) *VirtualMachineService {
+	return &VirtualMachineService{cs: cs}
 }
 
-func (p *DeployVirtualMachineParams) toURLValues() url.Values {
+type UpdateDefaultNicForVirtualMachineParams struct {
+	Nicid            *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateDefaultNicForVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["affinitygroupids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("affinitygroupids", vv)
-	}
-	if v, found := p.p["affinitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("affinitygroupnames", vv)
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["deploymentplanner"]; found {
-		u.Set("deploymentplanner", v.(string))
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
-	}
-	if v, found := p.p["displayname"]; found {
-		u.Set("displayname", v.(string))
-	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["group"]; found {
-		u.Set("group", v.(string))
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["ip6address"]; found {
-		u.Set("ip6address", v.(string))
-	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
-	}
-	if v, found := p.p["iptonetworklist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("iptonetworklist[%d].key", i), k)
-			u.Set(fmt.Sprintf("iptonetworklist[%d].value", i), vv)
-			i++
-		}
+	if v := p.Nicid; v != nil {
+		u.Set("nicid", *v)
 	}
-	if v, found := p.p["keyboard"]; found {
-		u.Set("keyboard", v.(string))
-	}
-	if v, found := p.p["keypair"]; found {
-		u.Set("keypair", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networkids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("networkids", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["rootdisksize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("rootdisksize", vv)
-	}
-	if v, found := p.p["securitygroupids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("securitygroupids", vv)
-	}
-	if v, found := p.p["securitygroupnames"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("securitygroupnames", vv)
-	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
-	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
-	}
-	if v, found := p.p["startvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("startvm", vv)
-	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
-	}
-	if v, found := p.p["userdata"]; found {
-		u.Set("userdata", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *DeployVirtualMachineParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateDefaultNicForVirtualMachineParams) Command() string {
+	return "updateDefaultNicForVirtualMachine"
 }
 
-func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupids"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateDefaultNicForVirtualMachineParams) IsAsync() bool {
+	return true
 }
 
-func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupnames"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateDefaultNicForVirtualMachineParams) ResponseType() interface{} {
+	return &UpdateDefaultNicForVirtualMachineResponse{}
 }
 
-func (p *DeployVirtualMachineParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateDefaultNicForVirtualMachineParams) Validate() error {
+	v := &validator{api: "updateDefaultNicForVirtualMachine"}
+	v.required("nicid", p.Nicid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
 }
 
-func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deploymentplanner"] = v
+// This param is required.
+func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
+	p.Nicid = &v
 	return
 }
 
-func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateDefaultNicForVirtualMachineParams) GetNicid() string {
+	if p.Nicid == nil {
+		return ""
 	}
-	p.p["details"] = v
-	return
+	return *p.Nicid
 }
 
-func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
-	return
+func (p *UpdateDefaultNicForVirtualMachineParams) HasNicid() bool {
+	return p.Nicid != nil
 }
 
-func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayname"] = v
-	return
+func (p *UpdateDefaultNicForVirtualMachineParams) ResetNicid() {
+	p.Nicid = nil
 }
 
-func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvm"] = v
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *DeployVirtualMachineParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateDefaultNicForVirtualMachineParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["domainid"] = v
-	return
+	return *p.Virtualmachineid
 }
 
-func (p *DeployVirtualMachineParams) SetGroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["group"] = v
-	return
+func (p *UpdateDefaultNicForVirtualMachineParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
 }
 
-func (p *DeployVirtualMachineParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
+func (p *UpdateDefaultNicForVirtualMachineParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
 }
 
-func (p *DeployVirtualMachineParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
+// You should always use this function to get a new UpdateDefaultNicForVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - nicid
+//   - virtualmachineid: the ID of the virtual machine
+func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams {
+	p := &UpdateDefaultNicForVirtualMachineParams{}
+	p.SetNicid(nicid)
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
 }
 
-func (p *DeployVirtualMachineParams) SetIp6address(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Changes the default NIC on a VM
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["ip6address"] = v
-	return
-}
 
-func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["ipaddress"] = v
-	return
-}
 
-func (p *DeployVirtualMachineParams) SetIptonetworklist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateDefaultNicForVirtualMachineResponse
+	if err := s.cs.unmarshal("updateDefaultNicForVirtualMachine", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["iptonetworklist"] = v
-	return
-}
 
-func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateDefaultNicForVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["keyboard"] = v
-	return
+	return &r, nil
 }
 
-func (p *DeployVirtualMachineParams) SetKeypair(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keypair"] = v
-	return
+type UpdateDefaultNicForVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *DeployVirtualMachineParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+type RemoveNicFromVirtualMachineParams struct {
+	Nicid            *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveNicFromVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Nicid; v != nil {
+		u.Set("nicid", *v)
 	}
-	p.p["networkids"] = v
-	return
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
 }
 
-func (p *DeployVirtualMachineParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *RemoveNicFromVirtualMachineParams) Command() string {
+	return "removeNicFromVirtualMachine"
 }
 
-func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["rootdisksize"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *RemoveNicFromVirtualMachineParams) IsAsync() bool {
+	return true
 }
 
-func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupids"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveNicFromVirtualMachineParams) ResponseType() interface{} {
+	return &RemoveNicFromVirtualMachineResponse{}
 }
 
-func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupnames"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveNicFromVirtualMachineParams) Validate() error {
+	v := &validator{api: "removeNicFromVirtualMachine"}
+	v.required("nicid", p.Nicid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
 }
 
-func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+// This param is required.
+func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
+	p.Nicid = &v
 	return
 }
 
-func (p *DeployVirtualMachineParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RemoveNicFromVirtualMachineParams) GetNicid() string {
+	if p.Nicid == nil {
+		return ""
 	}
-	p.p["size"] = v
-	return
+	return *p.Nicid
 }
 
-func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startvm"] = v
-	return
+func (p *RemoveNicFromVirtualMachineParams) HasNicid() bool {
+	return p.Nicid != nil
 }
 
-func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
-	return
+func (p *RemoveNicFromVirtualMachineParams) ResetNicid() {
+	p.Nicid = nil
 }
 
-func (p *DeployVirtualMachineParams) SetUserdata(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *DeployVirtualMachineParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RemoveNicFromVirtualMachineParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Virtualmachineid
 }
 
-// You should always use this function to get a new DeployVirtualMachineParams instance,
+func (p *RemoveNicFromVirtualMachineParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *RemoveNicFromVirtualMachineParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new RemoveNicFromVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
-	p := &DeployVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["serviceofferingid"] = serviceofferingid
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - nicid
+//   - virtualmachineid: the ID of the virtual machine
+func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams {
+	p := &RemoveNicFromVirtualMachineParams{}
+	p.SetNicid(nicid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
-func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("deployVirtualMachine", p.toURLValues())
+// Removes VM from specified network by deleting a NIC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeployVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RemoveNicFromVirtualMachineResponse
+	if err := s.cs.unmarshal("removeNicFromVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -425,234 +373,214 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("removeNicFromVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeployVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+type RemoveNicFromVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type DestroyVirtualMachineParams struct {
-	p map[string]interface{}
+type AddNicToVirtualMachineParams struct {
+	Ipaddress        *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Networkid        *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddNicToVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
 	}
-	if v, found := p.p["expunge"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("expunge", vv)
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *AddNicToVirtualMachineParams) Command() string {
+	return "addNicToVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddNicToVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddNicToVirtualMachineParams) ResponseType() interface{} {
+	return &AddNicToVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddNicToVirtualMachineParams) Validate() error {
+	v := &validator{api: "addNicToVirtualMachine"}
+	v.required("networkid", p.Networkid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
+	return
+}
+
+func (p *AddNicToVirtualMachineParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
 	}
-	p.p["expunge"] = v
+	return *p.Ipaddress
+}
+
+func (p *AddNicToVirtualMachineParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *AddNicToVirtualMachineParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// Sets the 'networkid' param: the ID of the network. This param is required.
+func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *DestroyVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddNicToVirtualMachineParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Networkid
+}
+
+func (p *AddNicToVirtualMachineParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *AddNicToVirtualMachineParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-// You should always use this function to get a new DestroyVirtualMachineParams instance,
+func (p *AddNicToVirtualMachineParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *AddNicToVirtualMachineParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *AddNicToVirtualMachineParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new AddNicToVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams {
-	p := &DestroyVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - networkid: the ID of the network
+//   - virtualmachineid: the ID of the virtual machine
+func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams {
+	p := &AddNicToVirtualMachineParams{}
+	p.SetNetworkid(networkid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Destroys a virtual machine. Once destroyed, only the administrator can recover it.
-func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("destroyVirtualMachine", p.toURLValues())
+// Adds VM to specified network by creating a NIC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addNicToVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DestroyVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddNicToVirtualMachineResponse
+	if err := s.cs.unmarshal("addNicToVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -673,222 +601,162 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addNicToVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DestroyVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+type AddNicToVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type RebootVirtualMachineParams struct {
-	p map[string]interface{}
+type ResetPasswordForVirtualMachineParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *RebootVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ResetPasswordForVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *RebootVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *ResetPasswordForVirtualMachineParams) Command() string {
+	return "resetPasswordForVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *ResetPasswordForVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ResetPasswordForVirtualMachineParams) ResponseType() interface{} {
+	return &ResetPasswordForVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ResetPasswordForVirtualMachineParams) Validate() error {
+	v := &validator{api: "resetPasswordForVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new RebootVirtualMachineParams instance,
+func (p *ResetPasswordForVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ResetPasswordForVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ResetPasswordForVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new ResetPasswordForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams {
-	p := &RebootVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams {
+	p := &ResetPasswordForVirtualMachineParams{}
+	p.SetId(id)
 	return p
 }
 
-// Reboots a virtual machine.
-func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("rebootVirtualMachine", p.toURLValues())
+// Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RebootVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ResetPasswordForVirtualMachineResponse
+	if err := s.cs.unmarshal("resetPasswordForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -909,728 +777,340 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("resetPasswordForVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RebootVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+type ResetPasswordForVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type StartVirtualMachineParams struct {
-	p map[string]interface{}
+type ChangeServiceForVirtualMachineParams struct {
+	Details           map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
 }
 
-func (p *StartVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ChangeServiceForVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["deploymentplanner"]; found {
-		u.Set("deploymentplanner", v.(string))
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
 	}
 	return u
 }
 
-func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deploymentplanner"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ChangeServiceForVirtualMachineParams) Command() string {
+	return "changeServiceForVirtualMachine"
 }
 
-func (p *StartVirtualMachineParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ChangeServiceForVirtualMachineParams) IsAsync() bool {
+	return false
 }
 
-func (p *StartVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ChangeServiceForVirtualMachineParams) ResponseType() interface{} {
+	return &ChangeServiceForVirtualMachineResponse{}
 }
 
-// You should always use this function to get a new StartVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVirtualMachineParams {
-	p := &StartVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ChangeServiceForVirtualMachineParams) Validate() error {
+	v := &validator{api: "changeServiceForVirtualMachine"}
+	v.required("id", p.Id != nil)
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	return v.err()
 }
 
-// Starts a virtual machine.
-func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("startVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
 
-	var r StartVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *ChangeServiceForVirtualMachineParams) GetDetails() map[string]string {
+	return p.Details
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ChangeServiceForVirtualMachineParams) HasDetails() bool {
+	return p.Details != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ChangeServiceForVirtualMachineParams) ResetDetails() {
+	p.Details = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// This param is required.
+func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-type StartVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+func (p *ChangeServiceForVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
 }
 
-type StopVirtualMachineParams struct {
-	p map[string]interface{}
+func (p *ChangeServiceForVirtualMachineParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *StopVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *ChangeServiceForVirtualMachineParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *StopVirtualMachineParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
 	return
 }
 
-func (p *StopVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ChangeServiceForVirtualMachineParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Serviceofferingid
 }
 
-// You should always use this function to get a new StopVirtualMachineParams instance,
+func (p *ChangeServiceForVirtualMachineParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *ChangeServiceForVirtualMachineParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+// You should always use this function to get a new ChangeServiceForVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirtualMachineParams {
-	p := &StopVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+//   - serviceofferingid: the ID of the service offering
+func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams {
+	p := &ChangeServiceForVirtualMachineParams{}
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Stops a virtual machine.
-func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("stopVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Changes the service offering for a virtual machine. The virtual machine must be in a "Stopped" state for this command to take effect.
+func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	var r StopVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", p.URLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r ChangeServiceForVirtualMachineResponse
+	if err := s.cs.unmarshal("changeServiceForVirtualMachine", resp, &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
-type StopVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
+type ChangeServiceForVirtualMachineResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type GetVMPasswordParams = cloudstackcommon.GetVMPasswordParams
+type GetVMPasswordResponse = cloudstackcommon.GetVMPasswordResponse
 
-type ResetPasswordForVirtualMachineParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new GetVMPasswordParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPasswordParams {
+	p := &GetVMPasswordParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Returns an encrypted password for the VM
+func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	resp, err := s.cs.newRequest("getVMPassword", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r GetVMPasswordResponse
+	if err := s.cs.unmarshal("getVMPassword", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new ResetPasswordForVirtualMachineParams instance,
+type CleanVMReservationsParams = cloudstackcommon.CleanVMReservationsParams
+type CleanVMReservationsResponse = cloudstackcommon.CleanVMReservationsResponse
+
+// You should always use this function to get a new CleanVMReservationsParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams {
-	p := &ResetPasswordForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
+	p := &CleanVMReservationsParams{}
 	return p
 }
 
-// Resets the password for virtual machine. The virtual machine must be in a "Stopped" state and the template must already support this feature for this command to take effect. [async]
-func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("resetPasswordForVirtualMachine", p.toURLValues())
+// Cleanups VM reservations in the database.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("cleanVMReservations", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ResetPasswordForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CleanVMReservationsResponse
+	if err := s.cs.unmarshal("cleanVMReservations", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1646,1217 +1126,3212 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("cleanVMReservations", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ResetPasswordForVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type UpdateVirtualMachineParams struct {
-	p map[string]interface{}
+type AssignVirtualMachineParams struct {
+	Account          *string  `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string  `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Networkids       []string `json:"networkids,omitempty" yaml:"networkids,omitempty"`
+	Securitygroupids []string `json:"securitygroupids,omitempty" yaml:"securitygroupids,omitempty"`
+	Virtualmachineid *string  `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AssignVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["displayname"]; found {
-		u.Set("displayname", v.(string))
-	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
-	}
-	if v, found := p.p["group"]; found {
-		u.Set("group", v.(string))
-	}
-	if v, found := p.p["haenable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("haenable", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isdynamicallyscalable"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdynamicallyscalable", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Networkids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("networkids", vv)
 	}
-	if v, found := p.p["ostypeid"]; found {
-		u.Set("ostypeid", v.(string))
+	if v := p.Securitygroupids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("securitygroupids", vv)
 	}
-	if v, found := p.p["userdata"]; found {
-		u.Set("userdata", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AssignVirtualMachineParams) Command() string {
+	return "assignVirtualMachine"
 }
 
-func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AssignVirtualMachineParams) IsAsync() bool {
+	return false
 }
 
-func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayname"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AssignVirtualMachineParams) ResponseType() interface{} {
+	return &AssignVirtualMachineResponse{}
 }
 
-func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvm"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AssignVirtualMachineParams) Validate() error {
+	v := &validator{api: "assignVirtualMachine"}
+	v.required("account", p.Account != nil)
+	v.required("domainid", p.Domainid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
 }
 
-func (p *UpdateVirtualMachineParams) SetGroup(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["group"] = v
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *AssignVirtualMachineParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssignVirtualMachineParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["haenable"] = v
-	return
+	return *p.Account
 }
 
-func (p *UpdateVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *AssignVirtualMachineParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdynamicallyscalable"] = v
-	return
+func (p *AssignVirtualMachineParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *UpdateVirtualMachineParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *AssignVirtualMachineParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssignVirtualMachineParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["ostypeid"] = v
-	return
+	return *p.Domainid
 }
 
-func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["userdata"] = v
-	return
+func (p *AssignVirtualMachineParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-// You should always use this function to get a new UpdateVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams {
-	p := &UpdateVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (p *AssignVirtualMachineParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
+	p.Networkids = v
+	return
+}
+
+func (p *AssignVirtualMachineParams) GetNetworkids() []string {
+	return p.Networkids
+}
+
+func (p *AssignVirtualMachineParams) HasNetworkids() bool {
+	return p.Networkids != nil
+}
+
+func (p *AssignVirtualMachineParams) ResetNetworkids() {
+	p.Networkids = nil
+}
+
+func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
+	p.Securitygroupids = v
+	return
+}
+
+func (p *AssignVirtualMachineParams) GetSecuritygroupids() []string {
+	return p.Securitygroupids
+}
+
+func (p *AssignVirtualMachineParams) HasSecuritygroupids() bool {
+	return p.Securitygroupids != nil
+}
+
+func (p *AssignVirtualMachineParams) ResetSecuritygroupids() {
+	p.Securitygroupids = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *AssignVirtualMachineParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *AssignVirtualMachineParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *AssignVirtualMachineParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new AssignVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - account: the name of the account
+//   - domainid: the ID of the domain
+//   - virtualmachineid: the ID of the virtual machine
+func (s *VirtualMachineService) NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string) *AssignVirtualMachineParams {
+	p := &AssignVirtualMachineParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.
-func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateVirtualMachine", p.toURLValues())
+// Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.
+//
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("assignVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AssignVirtualMachineResponse
+	if err := s.cs.unmarshal("assignVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateVirtualMachineResponse struct {
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ListVirtualMachinesParams struct {
-	p map[string]interface{}
+type AssignVirtualMachineResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListVirtualMachinesParams) toURLValues() url.Values {
+type DeployVirtualMachineParams struct {
+	Account            *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupids   []string          `json:"affinitygroupids,omitempty" yaml:"affinitygroupids,omitempty"`
+	Affinitygroupnames []string          `json:"affinitygroupnames,omitempty" yaml:"affinitygroupnames,omitempty"`
+	Customid           *string           `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Deploymentplanner  *string           `json:"deploymentplanner,omitempty" yaml:"deploymentplanner,omitempty"`
+	Details            map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Diskofferingid     *string           `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Displayname        *string           `json:"displayname,omitempty" yaml:"displayname,omitempty"`
+	Displayvm          *bool             `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Domainid           *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Group              *string           `json:"group,omitempty" yaml:"group,omitempty"`
+	Hostid             *string           `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Hypervisor         *HypervisorType   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Ip6address         *string           `json:"ip6address,omitempty" yaml:"ip6address,omitempty"`
+	Ipaddress          *string           `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Iptonetworklist    []IPToNetwork     `json:"iptonetworklist,omitempty" yaml:"iptonetworklist,omitempty"`
+	Keyboard           *string           `json:"keyboard,omitempty" yaml:"keyboard,omitempty"`
+	Keypair            *string           `json:"keypair,omitempty" yaml:"keypair,omitempty"`
+	Name               *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkids         []string          `json:"networkids,omitempty" yaml:"networkids,omitempty"`
+	Projectid          *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Rootdisksize       *int64            `json:"rootdisksize,omitempty" yaml:"rootdisksize,omitempty"`
+	Securitygroupids   []string          `json:"securitygroupids,omitempty" yaml:"securitygroupids,omitempty"`
+	Securitygroupnames []string          `json:"securitygroupnames,omitempty" yaml:"securitygroupnames,omitempty"`
+	Serviceofferingid  *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Size               *int64            `json:"size,omitempty" yaml:"size,omitempty"`
+	Startvm            *bool             `json:"startvm,omitempty" yaml:"startvm,omitempty"`
+	Templateid         *string           `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Userdata           *string           `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+	Zoneid             *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeployVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Affinitygroupids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("affinitygroupids", vv)
 	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
+	if v := p.Affinitygroupnames; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("affinitygroupnames", vv)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("details", vv)
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["displayvm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvm", vv)
+	if v := p.Deploymentplanner; v != nil {
+		u.Set("deploymentplanner", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forvirtualnetwork", vv)
+	if v := p.Diskofferingid; v != nil {
+		u.Set("diskofferingid", *v)
 	}
-	if v, found := p.p["groupid"]; found {
-		u.Set("groupid", v.(string))
+	if v := p.Displayname; v != nil {
+		u.Set("displayname", *v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Displayvm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("displayvm", vv)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Group; v != nil {
+		u.Set("group", *v)
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("ids", vv)
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
 	}
-	if v, found := p.p["isoid"]; found {
-		u.Set("isoid", v.(string))
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Ip6address; v != nil {
+		u.Set("ip6address", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Iptonetworklist; v != nil {
+		for i, vv := range v {
+			if vv.Ip != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].ip", i), vv.Ip)
+			}
+			if vv.Ipv6 != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].ipv6", i), vv.Ipv6)
+			}
+			if vv.Networkid != "" {
+				u.Set(fmt.Sprintf("iptonetworklist[%d].networkid", i), vv.Networkid)
+			}
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Keyboard; v != nil {
+		u.Set("keyboard", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Keypair; v != nil {
+		u.Set("keypair", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Networkids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("networkids", vv)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Rootdisksize; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("rootdisksize", vv)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if v := p.Securitygroupids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("securitygroupids", vv)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.Securitygroupnames; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("securitygroupnames", vv)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
 	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Size; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("size", vv)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if v := p.Startvm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("startvm", vv)
+	}
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Userdata; v != nil {
+		u.Set("userdata", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListVirtualMachinesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DeployVirtualMachineParams) Command() string {
+	return "deployVirtualMachine"
 }
 
-func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["affinitygroupid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *DeployVirtualMachineParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListVirtualMachinesParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeployVirtualMachineParams) ResponseType() interface{} {
+	return &DeployVirtualMachineResponse{}
 }
 
-func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeployVirtualMachineParams) Validate() error {
+	v := &validator{api: "deployVirtualMachine"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
 	}
-	p.p["displayvm"] = v
-	return
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	v.required("templateid", p.Templateid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	v.exclusive("affinitygroupids", p.Affinitygroupids != nil, "affinitygroupnames", p.Affinitygroupnames != nil)
+	v.exclusive("iptonetworklist", p.Iptonetworklist != nil, "networkids", p.Networkids != nil)
+	v.exclusive("securitygroupids", p.Securitygroupids != nil, "securitygroupnames", p.Securitygroupnames != nil)
+	return v.err()
 }
 
-func (p *ListVirtualMachinesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Sets the 'account' param: the name of the account.
+func (p *DeployVirtualMachineParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeployVirtualMachineParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["forvirtualnetwork"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListVirtualMachinesParams) SetGroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["groupid"] = v
-	return
+func (p *DeployVirtualMachineParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListVirtualMachinesParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
+func (p *DeployVirtualMachineParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListVirtualMachinesParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+func (p *DeployVirtualMachineParams) SetAffinitygroupids(v []string) {
+	p.Affinitygroupids = v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *DeployVirtualMachineParams) GetAffinitygroupids() []string {
+	return p.Affinitygroupids
 }
 
-func (p *ListVirtualMachinesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
-	return
+func (p *DeployVirtualMachineParams) HasAffinitygroupids() bool {
+	return p.Affinitygroupids != nil
 }
 
-func (p *ListVirtualMachinesParams) SetIsoid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isoid"] = v
-	return
+func (p *DeployVirtualMachineParams) ResetAffinitygroupids() {
+	p.Affinitygroupids = nil
 }
 
-func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+func (p *DeployVirtualMachineParams) SetAffinitygroupnames(v []string) {
+	p.Affinitygroupnames = v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *DeployVirtualMachineParams) GetAffinitygroupnames() []string {
+	return p.Affinitygroupnames
 }
 
-func (p *ListVirtualMachinesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *DeployVirtualMachineParams) HasAffinitygroupnames() bool {
+	return p.Affinitygroupnames != nil
 }
 
-func (p *ListVirtualMachinesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *DeployVirtualMachineParams) ResetAffinitygroupnames() {
+	p.Affinitygroupnames = nil
 }
 
-func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only. Only supported by CloudStack 4.4.
+func (p *DeployVirtualMachineParams) SetCustomid(v string) {
+	p.Customid = &v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeployVirtualMachineParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["page"] = v
-	return
+	return *p.Customid
 }
 
-func (p *ListVirtualMachinesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *DeployVirtualMachineParams) HasCustomid() bool {
+	return p.Customid != nil
 }
 
-func (p *ListVirtualMachinesParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
+func (p *DeployVirtualMachineParams) ResetCustomid() {
+	p.Customid = nil
 }
 
-func (p *ListVirtualMachinesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+// Only supported by CloudStack 4.4.
+func (p *DeployVirtualMachineParams) SetDeploymentplanner(v string) {
+	p.Deploymentplanner = &v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeployVirtualMachineParams) GetDeploymentplanner() string {
+	if p.Deploymentplanner == nil {
+		return ""
 	}
-	p.p["serviceofferingid"] = v
-	return
+	return *p.Deploymentplanner
 }
 
-func (p *ListVirtualMachinesParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
+func (p *DeployVirtualMachineParams) HasDeploymentplanner() bool {
+	return p.Deploymentplanner != nil
 }
 
-func (p *ListVirtualMachinesParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
-	return
+func (p *DeployVirtualMachineParams) ResetDeploymentplanner() {
+	p.Deploymentplanner = nil
 }
 
-func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+func (p *DeployVirtualMachineParams) SetDetails(v map[string]string) {
+	p.Details = v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
-	return
+func (p *DeployVirtualMachineParams) GetDetails() map[string]string {
+	return p.Details
 }
 
-func (p *ListVirtualMachinesParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+func (p *DeployVirtualMachineParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *DeployVirtualMachineParams) SetDiskofferingid(v string) {
+	p.Diskofferingid = &v
 	return
 }
 
-func (p *ListVirtualMachinesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeployVirtualMachineParams) GetDiskofferingid() string {
+	if p.Diskofferingid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Diskofferingid
 }
 
-// You should always use this function to get a new ListVirtualMachinesParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
-	p := &ListVirtualMachinesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *DeployVirtualMachineParams) HasDiskofferingid() bool {
+	return p.Diskofferingid != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VirtualMachineService) GetVirtualMachineID(name string) (string, error) {
-	p := &ListVirtualMachinesParams{}
-	p.p = make(map[string]interface{})
+func (p *DeployVirtualMachineParams) ResetDiskofferingid() {
+	p.Diskofferingid = nil
+}
 
-	p.p["name"] = name
+func (p *DeployVirtualMachineParams) SetDisplayname(v string) {
+	p.Displayname = &v
+	return
+}
 
-	l, err := s.ListVirtualMachines(p)
-	if err != nil {
-		return "", err
+func (p *DeployVirtualMachineParams) GetDisplayname() string {
+	if p.Displayname == nil {
+		return ""
 	}
+	return *p.Displayname
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *DeployVirtualMachineParams) HasDisplayname() bool {
+	return p.Displayname != nil
+}
 
-	if l.Count == 1 {
-		return l.VirtualMachines[0].Id, nil
-	}
+func (p *DeployVirtualMachineParams) ResetDisplayname() {
+	p.Displayname = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.VirtualMachines {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+func (p *DeployVirtualMachineParams) SetDisplayvm(v bool) {
+	p.Displayvm = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VirtualMachineService) GetVirtualMachineByName(name string) (*VirtualMachine, int, error) {
-	id, err := s.GetVirtualMachineID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *DeployVirtualMachineParams) GetDisplayvm() bool {
+	if p.Displayvm == nil {
+		return false
 	}
+	return *p.Displayvm
+}
 
-	r, count, err := s.GetVirtualMachineByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *DeployVirtualMachineParams) HasDisplayvm() bool {
+	return p.Displayvm != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VirtualMachineService) GetVirtualMachineByID(id string) (*VirtualMachine, int, error) {
-	p := &ListVirtualMachinesParams{}
-	p.p = make(map[string]interface{})
+func (p *DeployVirtualMachineParams) ResetDisplayvm() {
+	p.Displayvm = nil
+}
 
-	p.p["id"] = id
+// Sets the 'domainid' param: the ID of the domain.
+func (p *DeployVirtualMachineParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
 
-	l, err := s.ListVirtualMachines(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *DeployVirtualMachineParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
+	return *p.Domainid
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *DeployVirtualMachineParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
 
-	if l.Count == 1 {
-		return l.VirtualMachines[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualMachine UUID: %s!", id)
+func (p *DeployVirtualMachineParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-// List the virtual machines owned by the account.
-func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachines", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *DeployVirtualMachineParams) SetGroup(v string) {
+	p.Group = &v
+	return
+}
 
-	var r ListVirtualMachinesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *DeployVirtualMachineParams) GetGroup() string {
+	if p.Group == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Group
 }
 
-type ListVirtualMachinesResponse struct {
-	Count           int               `json:"count"`
-	VirtualMachines []*VirtualMachine `json:"virtualmachine"`
+func (p *DeployVirtualMachineParams) HasGroup() bool {
+	return p.Group != nil
 }
 
-type VirtualMachine struct {
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type GetVMPasswordParams struct {
-	p map[string]interface{}
-}
-
-func (p *GetVMPasswordParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *DeployVirtualMachineParams) ResetGroup() {
+	p.Group = nil
 }
 
-func (p *GetVMPasswordParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Sets the 'hostid' param: the ID of the host.
+func (p *DeployVirtualMachineParams) SetHostid(v string) {
+	p.Hostid = &v
 	return
 }
 
-// You should always use this function to get a new GetVMPasswordParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPasswordParams {
-	p := &GetVMPasswordParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *DeployVirtualMachineParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
 }
 
-// Returns an encrypted password for the VM
-func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
-	resp, err := s.cs.newRequest("getVMPassword", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *DeployVirtualMachineParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *DeployVirtualMachineParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *DeployVirtualMachineParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *DeployVirtualMachineParams) SetIp6address(v string) {
+	p.Ip6address = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetIp6address() string {
+	if p.Ip6address == nil {
+		return ""
+	}
+	return *p.Ip6address
+}
+
+func (p *DeployVirtualMachineParams) HasIp6address() bool {
+	return p.Ip6address != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetIp6address() {
+	p.Ip6address = nil
+}
+
+func (p *DeployVirtualMachineParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
+	}
+	return *p.Ipaddress
+}
+
+func (p *DeployVirtualMachineParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+func (p *DeployVirtualMachineParams) SetIptonetworklist(v []IPToNetwork) {
+	p.Iptonetworklist = v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetIptonetworklist() []IPToNetwork {
+	return p.Iptonetworklist
+}
+
+func (p *DeployVirtualMachineParams) HasIptonetworklist() bool {
+	return p.Iptonetworklist != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetIptonetworklist() {
+	p.Iptonetworklist = nil
+}
+
+func (p *DeployVirtualMachineParams) SetKeyboard(v string) {
+	p.Keyboard = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetKeyboard() string {
+	if p.Keyboard == nil {
+		return ""
+	}
+	return *p.Keyboard
+}
+
+func (p *DeployVirtualMachineParams) HasKeyboard() bool {
+	return p.Keyboard != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetKeyboard() {
+	p.Keyboard = nil
+}
+
+func (p *DeployVirtualMachineParams) SetKeypair(v string) {
+	p.Keypair = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetKeypair() string {
+	if p.Keypair == nil {
+		return ""
+	}
+	return *p.Keypair
+}
+
+func (p *DeployVirtualMachineParams) HasKeypair() bool {
+	return p.Keypair != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetKeypair() {
+	p.Keypair = nil
+}
+
+func (p *DeployVirtualMachineParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *DeployVirtualMachineParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *DeployVirtualMachineParams) SetNetworkids(v []string) {
+	p.Networkids = v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetNetworkids() []string {
+	return p.Networkids
+}
+
+func (p *DeployVirtualMachineParams) HasNetworkids() bool {
+	return p.Networkids != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetNetworkids() {
+	p.Networkids = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *DeployVirtualMachineParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *DeployVirtualMachineParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *DeployVirtualMachineParams) SetRootdisksize(v int64) {
+	p.Rootdisksize = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetRootdisksize() int64 {
+	if p.Rootdisksize == nil {
+		return 0
+	}
+	return *p.Rootdisksize
+}
+
+func (p *DeployVirtualMachineParams) HasRootdisksize() bool {
+	return p.Rootdisksize != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetRootdisksize() {
+	p.Rootdisksize = nil
+}
+
+func (p *DeployVirtualMachineParams) SetSecuritygroupids(v []string) {
+	p.Securitygroupids = v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetSecuritygroupids() []string {
+	return p.Securitygroupids
+}
+
+func (p *DeployVirtualMachineParams) HasSecuritygroupids() bool {
+	return p.Securitygroupids != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetSecuritygroupids() {
+	p.Securitygroupids = nil
+}
+
+func (p *DeployVirtualMachineParams) SetSecuritygroupnames(v []string) {
+	p.Securitygroupnames = v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetSecuritygroupnames() []string {
+	return p.Securitygroupnames
+}
+
+func (p *DeployVirtualMachineParams) HasSecuritygroupnames() bool {
+	return p.Securitygroupnames != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetSecuritygroupnames() {
+	p.Securitygroupnames = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *DeployVirtualMachineParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
+	}
+	return *p.Serviceofferingid
+}
+
+func (p *DeployVirtualMachineParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+func (p *DeployVirtualMachineParams) SetSize(v int64) {
+	p.Size = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetSize() int64 {
+	if p.Size == nil {
+		return 0
+	}
+	return *p.Size
+}
+
+func (p *DeployVirtualMachineParams) HasSize() bool {
+	return p.Size != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetSize() {
+	p.Size = nil
+}
+
+func (p *DeployVirtualMachineParams) SetStartvm(v bool) {
+	p.Startvm = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetStartvm() bool {
+	if p.Startvm == nil {
+		return false
+	}
+	return *p.Startvm
+}
+
+func (p *DeployVirtualMachineParams) HasStartvm() bool {
+	return p.Startvm != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetStartvm() {
+	p.Startvm = nil
+}
+
+// Sets the 'templateid' param: the ID of the template. This param is required.
+func (p *DeployVirtualMachineParams) SetTemplateid(v string) {
+	p.Templateid = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
+	}
+	return *p.Templateid
+}
+
+func (p *DeployVirtualMachineParams) HasTemplateid() bool {
+	return p.Templateid != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetTemplateid() {
+	p.Templateid = nil
+}
+
+func (p *DeployVirtualMachineParams) SetUserdata(v string) {
+	p.Userdata = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetUserdata() string {
+	if p.Userdata == nil {
+		return ""
+	}
+	return *p.Userdata
+}
+
+func (p *DeployVirtualMachineParams) HasUserdata() bool {
+	return p.Userdata != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetUserdata() {
+	p.Userdata = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *DeployVirtualMachineParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *DeployVirtualMachineParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *DeployVirtualMachineParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *DeployVirtualMachineParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new DeployVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - serviceofferingid: the ID of the service offering
+//   - templateid: the ID of the template
+//   - zoneid: the ID of the zone
+func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams {
+	p := &DeployVirtualMachineParams{}
+	p.SetServiceofferingid(serviceofferingid)
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deployVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeployVirtualMachineResponse
+	if err := s.cs.unmarshal("deployVirtualMachine", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("deployVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type DeployVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DestroyVirtualMachineParams struct {
+	Expunge *bool   `json:"expunge,omitempty" yaml:"expunge,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DestroyVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Expunge; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("expunge", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DestroyVirtualMachineParams) Command() string {
+	return "destroyVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *DestroyVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DestroyVirtualMachineParams) ResponseType() interface{} {
+	return &DestroyVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DestroyVirtualMachineParams) Validate() error {
+	v := &validator{api: "destroyVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
+	p.Expunge = &v
+	return
+}
+
+func (p *DestroyVirtualMachineParams) GetExpunge() bool {
+	if p.Expunge == nil {
+		return false
+	}
+	return *p.Expunge
+}
+
+func (p *DestroyVirtualMachineParams) HasExpunge() bool {
+	return p.Expunge != nil
+}
+
+func (p *DestroyVirtualMachineParams) ResetExpunge() {
+	p.Expunge = nil
+}
+
+// This param is required.
+func (p *DestroyVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DestroyVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DestroyVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DestroyVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new DestroyVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams {
+	p := &DestroyVirtualMachineParams{}
+	p.SetId(id)
+	return p
+}
+
+// Destroys a virtual machine. Once destroyed, only the administrator can recover it.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("destroyVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DestroyVirtualMachineResponse
+	if err := s.cs.unmarshal("destroyVirtualMachine", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("destroyVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type DestroyVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExpungeVirtualMachineParams = cloudstackcommon.ExpungeVirtualMachineParams
+type ExpungeVirtualMachineResponse = cloudstackcommon.ExpungeVirtualMachineResponse
+
+// You should always use this function to get a new ExpungeVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams {
+	p := &ExpungeVirtualMachineParams{}
+	p.SetId(id)
+	return p
+}
+
+// Expunge a virtual machine. Once expunged, it cannot be recoverd.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("expungeVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ExpungeVirtualMachineResponse
+	if err := s.cs.unmarshal("expungeVirtualMachine", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("expungeVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListVirtualMachinesParams struct {
+	Account           *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid   *string           `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Details           []string          `json:"details,omitempty" yaml:"details,omitempty"`
+	Displayvm         *bool             `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Domainid          *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvirtualnetwork *bool             `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Groupid           *string           `json:"groupid,omitempty" yaml:"groupid,omitempty"`
+	Hostid            *string           `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Hypervisor        *HypervisorType   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids               []string          `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Isoid             *string           `json:"isoid,omitempty" yaml:"isoid,omitempty"`
+	Isrecursive       *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name              *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid         *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page              *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid             *string           `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid         *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Serviceofferingid *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	State             *VMState          `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid         *string           `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Templateid        *string           `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Vpcid             *string           `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid            *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVirtualMachinesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Affinitygroupid; v != nil {
+		u.Set("affinitygroupid", *v)
+	}
+	if v := p.Details; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("details", vv)
+	}
+	if v := p.Displayvm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("displayvm", vv)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Forvirtualnetwork; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forvirtualnetwork", vv)
+	}
+	if v := p.Groupid; v != nil {
+		u.Set("groupid", *v)
+	}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Ids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("ids", vv)
+	}
+	if v := p.Isoid; v != nil {
+		u.Set("isoid", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", string(*v))
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
+	}
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListVirtualMachinesParams) Command() string {
+	return "listVirtualMachines"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVirtualMachinesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVirtualMachinesParams) ResponseType() interface{} {
+	return &ListVirtualMachinesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVirtualMachinesParams) Validate() error {
+	v := &validator{api: "listVirtualMachines"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	if p.State != nil {
+		v.enum("state", string(*p.State), p.State.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListVirtualMachinesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListVirtualMachinesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListVirtualMachinesParams) SetAffinitygroupid(v string) {
+	p.Affinitygroupid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetAffinitygroupid() string {
+	if p.Affinitygroupid == nil {
+		return ""
+	}
+	return *p.Affinitygroupid
+}
+
+func (p *ListVirtualMachinesParams) HasAffinitygroupid() bool {
+	return p.Affinitygroupid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetAffinitygroupid() {
+	p.Affinitygroupid = nil
+}
+
+func (p *ListVirtualMachinesParams) SetDetails(v []string) {
+	p.Details = v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetDetails() []string {
+	return p.Details
+}
+
+func (p *ListVirtualMachinesParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetDetails() {
+	p.Details = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *ListVirtualMachinesParams) SetDisplayvm(v bool) {
+	p.Displayvm = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetDisplayvm() bool {
+	if p.Displayvm == nil {
+		return false
+	}
+	return *p.Displayvm
+}
+
+func (p *ListVirtualMachinesParams) HasDisplayvm() bool {
+	return p.Displayvm != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetDisplayvm() {
+	p.Displayvm = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVirtualMachinesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListVirtualMachinesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListVirtualMachinesParams) SetForvirtualnetwork(v bool) {
+	p.Forvirtualnetwork = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetForvirtualnetwork() bool {
+	if p.Forvirtualnetwork == nil {
+		return false
+	}
+	return *p.Forvirtualnetwork
+}
+
+func (p *ListVirtualMachinesParams) HasForvirtualnetwork() bool {
+	return p.Forvirtualnetwork != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetForvirtualnetwork() {
+	p.Forvirtualnetwork = nil
+}
+
+func (p *ListVirtualMachinesParams) SetGroupid(v string) {
+	p.Groupid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetGroupid() string {
+	if p.Groupid == nil {
+		return ""
+	}
+	return *p.Groupid
+}
+
+func (p *ListVirtualMachinesParams) HasGroupid() bool {
+	return p.Groupid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetGroupid() {
+	p.Groupid = nil
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *ListVirtualMachinesParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *ListVirtualMachinesParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListVirtualMachinesParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *ListVirtualMachinesParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *ListVirtualMachinesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListVirtualMachinesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'ids' param: the IDs of the resources. Only supported by CloudStack 4.4.
+func (p *ListVirtualMachinesParams) SetIds(v []string) {
+	p.Ids = v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetIds() []string {
+	return p.Ids
+}
+
+func (p *ListVirtualMachinesParams) HasIds() bool {
+	return p.Ids != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetIds() {
+	p.Ids = nil
+}
+
+func (p *ListVirtualMachinesParams) SetIsoid(v string) {
+	p.Isoid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetIsoid() string {
+	if p.Isoid == nil {
+		return ""
+	}
+	return *p.Isoid
+}
+
+func (p *ListVirtualMachinesParams) HasIsoid() bool {
+	return p.Isoid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetIsoid() {
+	p.Isoid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVirtualMachinesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListVirtualMachinesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVirtualMachinesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListVirtualMachinesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVirtualMachinesParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListVirtualMachinesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListVirtualMachinesParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListVirtualMachinesParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListVirtualMachinesParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListVirtualMachinesParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVirtualMachinesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListVirtualMachinesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVirtualMachinesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListVirtualMachinesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListVirtualMachinesParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListVirtualMachinesParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVirtualMachinesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListVirtualMachinesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering. Only supported by CloudStack 4.4.
+func (p *ListVirtualMachinesParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
+	}
+	return *p.Serviceofferingid
+}
+
+func (p *ListVirtualMachinesParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+func (p *ListVirtualMachinesParams) SetState(v VMState) {
+	p.State = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetState() VMState {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListVirtualMachinesParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListVirtualMachinesParams) SetStorageid(v string) {
+	p.Storageid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *ListVirtualMachinesParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListVirtualMachinesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListVirtualMachinesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// Sets the 'templateid' param: the ID of the template.
+func (p *ListVirtualMachinesParams) SetTemplateid(v string) {
+	p.Templateid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
+	}
+	return *p.Templateid
+}
+
+func (p *ListVirtualMachinesParams) HasTemplateid() bool {
+	return p.Templateid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetTemplateid() {
+	p.Templateid = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListVirtualMachinesParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *ListVirtualMachinesParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListVirtualMachinesParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListVirtualMachinesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListVirtualMachinesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListVirtualMachinesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListVirtualMachinesParams instance,
+// as then you are sure you have configured all required params
+func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachinesParams {
+	p := &ListVirtualMachinesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListVirtualMachinesParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListVirtualMachines(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVirtualMachines(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.VirtualMachines[0].Id, nil
+	}
+
+	var matches []*VirtualMachine
+	for _, v := range l.VirtualMachines {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "VirtualMachine", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	id, err := s.GetVirtualMachineID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	r, count, err := s.GetVirtualMachineByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	p := &ListVirtualMachinesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListVirtualMachines(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVirtualMachines(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VirtualMachines[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VirtualMachine UUID: %s!", id)
+}
+
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *VirtualMachineService) GetVirtualMachinesByTags(tags map[string]string, opts ...OptionFunc) ([]*VirtualMachine, error) {
+	p := &ListVirtualMachinesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListVirtualMachines(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVirtualMachines(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.VirtualMachines, nil
+}
+
+// List the virtual machines owned by the account.
+//
+// See also GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVirtualMachines", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVirtualMachinesResponse
+	if err := s.cs.unmarshal("listVirtualMachines", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListVirtualMachines, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VirtualMachineService) StreamVirtualMachines(ctx context.Context, p *ListVirtualMachinesParams, fn func(*VirtualMachine) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listVirtualMachines", p.URLValues(), "virtualmachine", func(b json.RawMessage) error {
+		var v VirtualMachine
+		if err := s.cs.unmarshalElement("listVirtualMachines", "virtualmachine", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListVirtualMachinesResponse struct {
+	Count           int                        `json:"count" xml:"count"`
+	VirtualMachines []*VirtualMachine          `json:"virtualmachine" xml:"virtualmachine"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VirtualMachine struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type MigrateVirtualMachineParams struct {
+	Hostid           *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Storageid        *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *MigrateVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *MigrateVirtualMachineParams) Command() string {
+	return "migrateVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *MigrateVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *MigrateVirtualMachineParams) ResponseType() interface{} {
+	return &MigrateVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *MigrateVirtualMachineParams) Validate() error {
+	v := &validator{api: "migrateVirtualMachine"}
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *MigrateVirtualMachineParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *MigrateVirtualMachineParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *MigrateVirtualMachineParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *MigrateVirtualMachineParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
+	p.Storageid = &v
+	return
+}
+
+func (p *MigrateVirtualMachineParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *MigrateVirtualMachineParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *MigrateVirtualMachineParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *MigrateVirtualMachineParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *MigrateVirtualMachineParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *MigrateVirtualMachineParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new MigrateVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - virtualmachineid: the ID of the virtual machine
+func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams {
+	p := &MigrateVirtualMachineParams{}
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	var r GetVMPasswordResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequest("migrateVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVirtualMachineResponse
+	if err := s.cs.unmarshal("migrateVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("migrateVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
+	}
 	return &r, nil
 }
 
-type GetVMPasswordResponse struct {
-	Encryptedpassword string `json:"encryptedpassword,omitempty"`
+type MigrateVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type RestoreVirtualMachineParams struct {
-	p map[string]interface{}
+type RebootVirtualMachineParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RebootVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RebootVirtualMachineParams) Command() string {
+	return "rebootVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *RebootVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RebootVirtualMachineParams) ResponseType() interface{} {
+	return &RebootVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RebootVirtualMachineParams) Validate() error {
+	v := &validator{api: "rebootVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RebootVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RebootVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RebootVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RebootVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RebootVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams {
+	p := &RebootVirtualMachineParams{}
+	p.SetId(id)
+	return p
+}
+
+// Reboots a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+
+	resp, err := s.cs.newRequest("rebootVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RebootVirtualMachineResponse
+	if err := s.cs.unmarshal("rebootVirtualMachine", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("rebootVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	return &r, nil
+}
+
+type RebootVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RecoverVirtualMachineParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RecoverVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *RecoverVirtualMachineParams) Command() string {
+	return "recoverVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *RecoverVirtualMachineParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RecoverVirtualMachineParams) ResponseType() interface{} {
+	return &RecoverVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RecoverVirtualMachineParams) Validate() error {
+	v := &validator{api: "recoverVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RecoverVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RecoverVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RecoverVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RecoverVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RecoverVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams {
+	p := &RecoverVirtualMachineParams{}
+	p.SetId(id)
+	return p
+}
+
+// Recovers a virtual machine.
+//
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("recoverVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RecoverVirtualMachineResponse
+	if err := s.cs.unmarshal("recoverVirtualMachine", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["templateid"] = v
+	return &r, nil
+}
+
+type RecoverVirtualMachineResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RestoreVirtualMachineParams struct {
+	Templateid       *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RestoreVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RestoreVirtualMachineParams) Command() string {
+	return "restoreVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *RestoreVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RestoreVirtualMachineParams) ResponseType() interface{} {
+	return &RestoreVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RestoreVirtualMachineParams) Validate() error {
+	v := &validator{api: "restoreVirtualMachine"}
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'templateid' param: the ID of the template.
+func (p *RestoreVirtualMachineParams) SetTemplateid(v string) {
+	p.Templateid = &v
 	return
 }
 
-func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RestoreVirtualMachineParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Templateid
+}
+
+func (p *RestoreVirtualMachineParams) HasTemplateid() bool {
+	return p.Templateid != nil
+}
+
+func (p *RestoreVirtualMachineParams) ResetTemplateid() {
+	p.Templateid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *RestoreVirtualMachineParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *RestoreVirtualMachineParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *RestoreVirtualMachineParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *RestoreVirtualMachineParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new RestoreVirtualMachineParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - virtualmachineid: the ID of the virtual machine
 func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams {
 	p := &RestoreVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
+	return p
+}
+
+// Restore a VM to original template/ISO or new template/ISO
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("restoreVirtualMachine", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RestoreVirtualMachineResponse
+	if err := s.cs.unmarshal("restoreVirtualMachine", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("restoreVirtualMachine", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type RestoreVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ScaleVirtualMachineParams = cloudstackcommon.ScaleVirtualMachineParams
+type ScaleVirtualMachineResponse = cloudstackcommon.ScaleVirtualMachineResponse
+
+// You should always use this function to get a new ScaleVirtualMachineParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - serviceofferingid: the ID of the service offering
+func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams {
+	p := &ScaleVirtualMachineParams{}
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Restore a VM to original template/ISO or new template/ISO
-func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("restoreVirtualMachine", p.toURLValues())
+// Scales the virtual machine to a new service offering.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("scaleVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RestoreVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ScaleVirtualMachineResponse
+	if err := s.cs.unmarshal("scaleVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2872,496 +4347,150 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("scaleVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RestoreVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ChangeServiceForVirtualMachineParams struct {
-	p map[string]interface{}
+type StartVirtualMachineParams struct {
+	Deploymentplanner *string `json:"deploymentplanner,omitempty" yaml:"deploymentplanner,omitempty"`
+	Hostid            *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StartVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
+	if v := p.Deploymentplanner; v != nil {
+		u.Set("deploymentplanner", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *ChangeServiceForVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// Returns the name of the API command of these params
+func (p *StartVirtualMachineParams) Command() string {
+	return "startVirtualMachine"
 }
 
-func (p *ChangeServiceForVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *StartVirtualMachineParams) IsAsync() bool {
+	return true
 }
 
-func (p *ChangeServiceForVirtualMachineParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StartVirtualMachineParams) ResponseType() interface{} {
+	return &StartVirtualMachineResponse{}
 }
 
-// You should always use this function to get a new ChangeServiceForVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams {
-	p := &ChangeServiceForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
-	return p
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StartVirtualMachineParams) Validate() error {
+	v := &validator{api: "startVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-// Changes the service offering for a virtual machine. The virtual machine must be in a "Stopped" state for this command to take effect.
-func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Only supported by CloudStack 4.4.
+func (p *StartVirtualMachineParams) SetDeploymentplanner(v string) {
+	p.Deploymentplanner = &v
+	return
+}
 
-	var r ChangeServiceForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *StartVirtualMachineParams) GetDeploymentplanner() string {
+	if p.Deploymentplanner == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Deploymentplanner
 }
 
-type ChangeServiceForVirtualMachineResponse struct {
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ScaleVirtualMachineParams struct {
-	p map[string]interface{}
-}
-
-func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
-	}
-	return u
+func (p *StartVirtualMachineParams) HasDeploymentplanner() bool {
+	return p.Deploymentplanner != nil
 }
 
-func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
+func (p *StartVirtualMachineParams) ResetDeploymentplanner() {
+	p.Deploymentplanner = nil
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *StartVirtualMachineParams) SetHostid(v string) {
+	p.Hostid = &v
 	return
 }
 
-func (p *ScaleVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *StartVirtualMachineParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Hostid
+}
+
+func (p *StartVirtualMachineParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *StartVirtualMachineParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+// This param is required.
+func (p *StartVirtualMachineParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *StartVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["serviceofferingid"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new ScaleVirtualMachineParams instance,
+func (p *StartVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *StartVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new StartVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams {
-	p := &ScaleVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVirtualMachineParams {
+	p := &StartVirtualMachineParams{}
+	p.SetId(id)
 	return p
 }
 
-// Scales the virtual machine to a new service offering.
-func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("scaleVirtualMachine", p.toURLValues())
+// Starts a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("startVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ScaleVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r StartVirtualMachineResponse
+	if err := s.cs.unmarshal("startVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3377,343 +4506,194 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("startVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ScaleVirtualMachineResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type StartVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type AssignVirtualMachineParams struct {
-	p map[string]interface{}
+type StopVirtualMachineParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *AssignVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StopVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["networkids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("networkids", vv)
-	}
-	if v, found := p.p["securitygroupids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("securitygroupids", vv)
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *AssignVirtualMachineParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *StopVirtualMachineParams) Command() string {
+	return "stopVirtualMachine"
 }
 
-func (p *AssignVirtualMachineParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *StopVirtualMachineParams) IsAsync() bool {
+	return true
 }
 
-func (p *AssignVirtualMachineParams) SetNetworkids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkids"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StopVirtualMachineParams) ResponseType() interface{} {
+	return &StopVirtualMachineResponse{}
 }
 
-func (p *AssignVirtualMachineParams) SetSecuritygroupids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["securitygroupids"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StopVirtualMachineParams) Validate() error {
+	v := &validator{api: "stopVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *AssignVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *StopVirtualMachineParams) SetForced(v bool) {
+	p.Forced = &v
 	return
 }
 
-// You should always use this function to get a new AssignVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewAssignVirtualMachineParams(account string, domainid string, virtualmachineid string) *AssignVirtualMachineParams {
-	p := &AssignVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
-}
-
-// Change ownership of a VM from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign a VM from any account to any other account in any domain. A domain administrator can reassign a VM to any account in the same domain.
-func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("assignVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r AssignVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *StopVirtualMachineParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
 	}
-	return &r, nil
+	return *p.Forced
 }
 
-type AssignVirtualMachineResponse struct {
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+func (p *StopVirtualMachineParams) HasForced() bool {
+	return p.Forced != nil
 }
 
-type MigrateVirtualMachineParams struct {
-	p map[string]interface{}
+func (p *StopVirtualMachineParams) ResetForced() {
+	p.Forced = nil
 }
 
-func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
+// This param is required.
+func (p *StopVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-func (p *MigrateVirtualMachineParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *StopVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["hostid"] = v
-	return
+	return *p.Id
 }
 
-func (p *MigrateVirtualMachineParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
-	return
+func (p *StopVirtualMachineParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *MigrateVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
+func (p *StopVirtualMachineParams) ResetId() {
+	p.Id = nil
 }
 
-// You should always use this function to get a new MigrateVirtualMachineParams instance,
+// You should always use this function to get a new StopVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams {
-	p := &MigrateVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirtualMachineParams {
+	p := &StopVirtualMachineParams{}
+	p.SetId(id)
 	return p
 }
 
-// Attempts Migration of a VM to a different host or Root volume of the vm to a different storage pool
-func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachine", p.toURLValues())
+// Stops a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("stopVirtualMachine", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r MigrateVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r StopVirtualMachineResponse
+	if err := s.cs.unmarshal("stopVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3734,1344 +4714,615 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("stopVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type MigrateVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type MigrateVirtualMachineWithVolumeParams struct {
-	p map[string]interface{}
+type StopVirtualMachineResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
+type UpdateVirtualMachineParams struct {
+	Customid              *string           `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Details               map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displayname           *string           `json:"displayname,omitempty" yaml:"displayname,omitempty"`
+	Displayvm             *bool             `json:"displayvm,omitempty" yaml:"displayvm,omitempty"`
+	Group                 *string           `json:"group,omitempty" yaml:"group,omitempty"`
+	Haenable              *bool             `json:"haenable,omitempty" yaml:"haenable,omitempty"`
+	Id                    *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdynamicallyscalable *bool             `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Name                  *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string           `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Userdata              *string           `json:"userdata,omitempty" yaml:"userdata,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVirtualMachineParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["migrateto"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("migrateto[%d].key", i), k)
-			u.Set(fmt.Sprintf("migrateto[%d].value", i), vv)
-			i++
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
 		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Displayname; v != nil {
+		u.Set("displayname", *v)
+	}
+	if v := p.Displayvm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("displayvm", vv)
+	}
+	if v := p.Group; v != nil {
+		u.Set("group", *v)
+	}
+	if v := p.Haenable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("haenable", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isdynamicallyscalable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isdynamicallyscalable", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
+	}
+	if v := p.Userdata; v != nil {
+		u.Set("userdata", *v)
 	}
 	return u
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateVirtualMachineParams) Command() string {
+	return "updateVirtualMachine"
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["migrateto"] = v
+// Returns true if the API command starts an async job
+func (p *UpdateVirtualMachineParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVirtualMachineParams) ResponseType() interface{} {
+	return &UpdateVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVirtualMachineParams) Validate() error {
+	v := &validator{api: "updateVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only. Only supported by CloudStack 4.4.
+func (p *UpdateVirtualMachineParams) SetCustomid(v string) {
+	p.Customid = &v
 	return
 }
 
-func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVirtualMachineParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Customid
+}
+
+func (p *UpdateVirtualMachineParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateVirtualMachineParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Only supported by CloudStack 4.4.
+func (p *UpdateVirtualMachineParams) SetDetails(v map[string]string) {
+	p.Details = v
 	return
 }
 
-// You should always use this function to get a new MigrateVirtualMachineWithVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
-	p := &MigrateVirtualMachineWithVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
+func (p *UpdateVirtualMachineParams) GetDetails() map[string]string {
+	return p.Details
 }
 
-// Attempts Migration of a VM with its volumes to a different host
-func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdateVirtualMachineParams) HasDetails() bool {
+	return p.Details != nil
+}
 
-	var r MigrateVirtualMachineWithVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdateVirtualMachineParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *UpdateVirtualMachineParams) SetDisplayname(v string) {
+	p.Displayname = &v
+	return
+}
+
+func (p *UpdateVirtualMachineParams) GetDisplayname() string {
+	if p.Displayname == nil {
+		return ""
 	}
+	return *p.Displayname
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UpdateVirtualMachineParams) HasDisplayname() bool {
+	return p.Displayname != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *UpdateVirtualMachineParams) ResetDisplayname() {
+	p.Displayname = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *UpdateVirtualMachineParams) SetDisplayvm(v bool) {
+	p.Displayvm = &v
+	return
+}
+
+func (p *UpdateVirtualMachineParams) GetDisplayvm() bool {
+	if p.Displayvm == nil {
+		return false
 	}
-	return &r, nil
+	return *p.Displayvm
 }
 
-type MigrateVirtualMachineWithVolumeResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+func (p *UpdateVirtualMachineParams) HasDisplayvm() bool {
+	return p.Displayvm != nil
 }
 
-type RecoverVirtualMachineParams struct {
-	p map[string]interface{}
+func (p *UpdateVirtualMachineParams) ResetDisplayvm() {
+	p.Displayvm = nil
 }
 
-func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *UpdateVirtualMachineParams) SetGroup(v string) {
+	p.Group = &v
+	return
 }
 
-func (p *RecoverVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVirtualMachineParams) GetGroup() string {
+	if p.Group == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Group
 }
 
-// You should always use this function to get a new RecoverVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams {
-	p := &RecoverVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *UpdateVirtualMachineParams) HasGroup() bool {
+	return p.Group != nil
 }
 
-// Recovers a virtual machine.
-func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("recoverVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdateVirtualMachineParams) ResetGroup() {
+	p.Group = nil
+}
 
-	var r RecoverVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *UpdateVirtualMachineParams) SetHaenable(v bool) {
+	p.Haenable = &v
+	return
 }
 
-type RecoverVirtualMachineResponse struct {
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type ExpungeVirtualMachineParams struct {
-	p map[string]interface{}
-}
-
-func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+func (p *UpdateVirtualMachineParams) GetHaenable() bool {
+	if p.Haenable == nil {
+		return false
 	}
-	return u
+	return *p.Haenable
 }
 
-func (p *ExpungeVirtualMachineParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateVirtualMachineParams) HasHaenable() bool {
+	return p.Haenable != nil
 }
 
-// You should always use this function to get a new ExpungeVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams {
-	p := &ExpungeVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *UpdateVirtualMachineParams) ResetHaenable() {
+	p.Haenable = nil
 }
 
-// Expunge a virtual machine. Once expunged, it cannot be recoverd.
-func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("expungeVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// This param is required.
+func (p *UpdateVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	var r ExpungeVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdateVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
+	return *p.Id
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+func (p *UpdateVirtualMachineParams) HasId() bool {
+	return p.Id != nil
 }
 
-type ExpungeVirtualMachineResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *UpdateVirtualMachineParams) ResetId() {
+	p.Id = nil
 }
 
-type CleanVMReservationsParams struct {
-	p map[string]interface{}
+func (p *UpdateVirtualMachineParams) SetIsdynamicallyscalable(v bool) {
+	p.Isdynamicallyscalable = &v
+	return
 }
 
-func (p *CleanVMReservationsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func (p *UpdateVirtualMachineParams) GetIsdynamicallyscalable() bool {
+	if p.Isdynamicallyscalable == nil {
+		return false
 	}
-	return u
+	return *p.Isdynamicallyscalable
 }
 
-// You should always use this function to get a new CleanVMReservationsParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
-	p := &CleanVMReservationsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *UpdateVirtualMachineParams) HasIsdynamicallyscalable() bool {
+	return p.Isdynamicallyscalable != nil
 }
 
-// Cleanups VM reservations in the database.
-func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
-	resp, err := s.cs.newRequest("cleanVMReservations", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r CleanVMReservationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *UpdateVirtualMachineParams) ResetIsdynamicallyscalable() {
+	p.Isdynamicallyscalable = nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+// Only supported by CloudStack 4.4.
+func (p *UpdateVirtualMachineParams) SetName(v string) {
+	p.Name = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *UpdateVirtualMachineParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Name
 }
 
-type CleanVMReservationsResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *UpdateVirtualMachineParams) HasName() bool {
+	return p.Name != nil
 }
 
-type AddNicToVirtualMachineParams struct {
-	p map[string]interface{}
+func (p *UpdateVirtualMachineParams) ResetName() {
+	p.Name = nil
 }
 
-func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
+// Sets the 'ostypeid' param: the ID of the OS type.
+func (p *UpdateVirtualMachineParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
+	return
 }
 
-func (p *AddNicToVirtualMachineParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVirtualMachineParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
 	}
-	p.p["ipaddress"] = v
-	return
+	return *p.Ostypeid
 }
 
-func (p *AddNicToVirtualMachineParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+func (p *UpdateVirtualMachineParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
+
+func (p *UpdateVirtualMachineParams) ResetOstypeid() {
+	p.Ostypeid = nil
+}
+
+func (p *UpdateVirtualMachineParams) SetUserdata(v string) {
+	p.Userdata = &v
 	return
 }
 
-func (p *AddNicToVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVirtualMachineParams) GetUserdata() string {
+	if p.Userdata == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
-	return
+	return *p.Userdata
 }
 
-// You should always use this function to get a new AddNicToVirtualMachineParams instance,
+func (p *UpdateVirtualMachineParams) HasUserdata() bool {
+	return p.Userdata != nil
+}
+
+func (p *UpdateVirtualMachineParams) ResetUserdata() {
+	p.Userdata = nil
+}
+
+// You should always use this function to get a new UpdateVirtualMachineParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams {
-	p := &AddNicToVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
-	p.p["virtualmachineid"] = virtualmachineid
+//
+// The required params are:
+//
+//   - id
+func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams {
+	p := &UpdateVirtualMachineParams{}
+	p.SetId(id)
 	return p
 }
 
-// Adds VM to specified network by creating a NIC
-func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("addNicToVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
+// Updates properties of a virtual machine. The VM has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the VM is stopped. Therefore, stop the VM manually before issuing this call.
+//
+// See also ListVirtualMachines, GetVirtualMachineID, GetVirtualMachineByName, GetVirtualMachineByID, GetVirtualMachinesByTags.
+func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
 
-	var r AddNicToVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	resp, err := s.cs.newRequest("updateVirtualMachine", p.URLValues())
+	if err != nil {
 		return nil, err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+	var r UpdateVirtualMachineResponse
+	if err := s.cs.unmarshal("updateVirtualMachine", resp, &r); err != nil {
+		return nil, err
 	}
 	return &r, nil
 }
 
-type AddNicToVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+type UpdateVirtualMachineResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type RemoveNicFromVirtualMachineParams struct {
-	p map[string]interface{}
+type MigrateVirtualMachineWithVolumeParams struct {
+	Hostid           *string           `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Migrateto        map[string]string `json:"migrateto,omitempty" yaml:"migrateto,omitempty"`
+	Virtualmachineid *string           `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *MigrateVirtualMachineWithVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
 	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
+	if v := p.Migrateto; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("migrateto[%d].volume", i), k)
+			u.Set(fmt.Sprintf("migrateto[%d].pool", i), vv)
+			i++
+		}
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *MigrateVirtualMachineWithVolumeParams) Command() string {
+	return "migrateVirtualMachineWithVolume"
 }
 
-func (p *RemoveNicFromVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *MigrateVirtualMachineWithVolumeParams) IsAsync() bool {
+	return true
 }
 
-// You should always use this function to get a new RemoveNicFromVirtualMachineParams instance,
-// as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams {
-	p := &RemoveNicFromVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *MigrateVirtualMachineWithVolumeParams) ResponseType() interface{} {
+	return &MigrateVirtualMachineWithVolumeResponse{}
 }
 
-// Removes VM from specified network by deleting a NIC
-func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("removeNicFromVirtualMachine", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *MigrateVirtualMachineWithVolumeParams) Validate() error {
+	v := &validator{api: "migrateVirtualMachineWithVolume"}
+	v.required("hostid", p.Hostid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
 
-	var r RemoveNicFromVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'hostid' param: the ID of the host. This param is required.
+func (p *MigrateVirtualMachineWithVolumeParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
 	}
+	return *p.Hostid
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *MigrateVirtualMachineWithVolumeParams) HasHostid() bool {
+	return p.Hostid != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *MigrateVirtualMachineWithVolumeParams) ResetHostid() {
+	p.Hostid = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+func (p *MigrateVirtualMachineWithVolumeParams) SetMigrateto(v map[string]string) {
+	p.Migrateto = v
+	return
 }
 
-type RemoveNicFromVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+func (p *MigrateVirtualMachineWithVolumeParams) GetMigrateto() map[string]string {
+	return p.Migrateto
 }
 
-type UpdateDefaultNicForVirtualMachineParams struct {
-	p map[string]interface{}
+func (p *MigrateVirtualMachineWithVolumeParams) HasMigrateto() bool {
+	return p.Migrateto != nil
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["nicid"]; found {
-		u.Set("nicid", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
+func (p *MigrateVirtualMachineWithVolumeParams) ResetMigrateto() {
+	p.Migrateto = nil
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nicid"] = v
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *MigrateVirtualMachineWithVolumeParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *UpdateDefaultNicForVirtualMachineParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *MigrateVirtualMachineWithVolumeParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
-	return
+	return *p.Virtualmachineid
 }
 
-// You should always use this function to get a new UpdateDefaultNicForVirtualMachineParams instance,
+func (p *MigrateVirtualMachineWithVolumeParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *MigrateVirtualMachineWithVolumeParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new MigrateVirtualMachineWithVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams {
-	p := &UpdateDefaultNicForVirtualMachineParams{}
-	p.p = make(map[string]interface{})
-	p.p["nicid"] = nicid
-	p.p["virtualmachineid"] = virtualmachineid
+//
+// The required params are:
+//
+//   - hostid: the ID of the host
+//   - virtualmachineid: the ID of the virtual machine
+func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(hostid string, virtualmachineid string) *MigrateVirtualMachineWithVolumeParams {
+	p := &MigrateVirtualMachineWithVolumeParams{}
+	p.SetHostid(hostid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Changes the default NIC on a VM
-func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
-	resp, err := s.cs.newRequest("updateDefaultNicForVirtualMachine", p.toURLValues())
+// Attempts Migration of a VM with its volumes to a different host
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("migrateVirtualMachineWithVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateDefaultNicForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r MigrateVirtualMachineWithVolumeResponse
+	if err := s.cs.unmarshal("migrateVirtualMachineWithVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5092,177 +5343,72 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("migrateVirtualMachineWithVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateDefaultNicForVirtualMachineResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+type MigrateVirtualMachineWithVolumeResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
 }
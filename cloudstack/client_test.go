@@ -0,0 +1,875 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// A test server, which checks that every request is correctly signed and returns the response of the called
+// command, as found in responses. The params of the requests are stored in requests, by command.
+type testServer struct {
+	*httptest.Server
+	responses map[string]string
+	requests  map[string][]url.Values
+}
+
+// Returns a test server and a client using it. Fields in a response that are not part of the response type
+// are an error, so the response types are checked as well.
+func newTestServer(t *testing.T, responses map[string]string) (*testServer, *CloudStackClient) {
+	ts := &testServer{responses: responses, requests: make(map[string][]url.Values)}
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse the request: %v", err)
+		}
+		if signature := r.Form.Get("signature"); signature != testSignature(r.Form) {
+			t.Errorf("Invalid signature %s", signature)
+		}
+		command := r.Form.Get("command")
+		ts.requests[command] = append(ts.requests[command], r.Form)
+
+		response, ok := responses[command]
+		if !ok {
+			t.Errorf("Unexpected command %s", command)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+		fmt.Fprint(w, response)
+	}))
+
+	cs := NewClient(ts.URL, "apikey", "secret", false)
+	cs.UnknownFields(RejectUnknownFields)
+	return ts, cs
+}
+
+func TestURLValues(t *testing.T) {
+	cs := NewClient("https://cloudstack.example.com/client/api", "apikey", "secret", false)
+
+	listZones := cs.Zone.NewListZonesParams()
+	listZones.SetAvailable(true)
+	listZones.SetPagesize(500)
+	listZones.SetTags(map[string]string{"env": "prod"})
+
+	createTags := cs.Resourcetags.NewCreateTagsParams([]string{"vm1", "vm2"}, TaggedResourceTypeUserVm, map[string]string{"env": "prod"})
+
+	deploy := cs.VirtualMachine.NewDeployVirtualMachineParams("so1", "tpl1", "zone1")
+	deploy.SetName("web")
+	deploy.SetDetails(map[string]string{"cpuNumber": "2"})
+	deploy.SetIptonetworklist([]IPToNetwork{{Ip: "10.0.0.10", Networkid: "net1"}, {Networkid: "net2"}})
+	deploy.SetSecuritygroupids([]string{"sg1", "sg2"})
+
+	authorize := cs.SecurityGroup.NewAuthorizeSecurityGroupIngressParams()
+	authorize.SetUsersecuritygrouplist(map[string]string{"admin": "web"})
+
+	migrate := cs.VirtualMachine.NewMigrateVirtualMachineWithVolumeParams("host1", "vm1")
+	migrate.SetMigrateto(map[string]string{"vol1": "pool1"})
+
+	tests := []struct {
+		name string
+		cmd  Command
+		want url.Values
+	}{
+		{"bool, int and tags params", listZones, url.Values{
+			"available":     {"true"},
+			"pagesize":      {"500"},
+			"tags[0].key":   {"env"},
+			"tags[0].value": {"prod"},
+		}},
+		{"list and enum params", createTags, url.Values{
+			"resourceids":   {"vm1, vm2"},
+			"resourcetype":  {"UserVm"},
+			"tags[0].key":   {"env"},
+			"tags[0].value": {"prod"},
+		}},
+		{"details and list of objects", deploy, url.Values{
+			"serviceofferingid":            {"so1"},
+			"templateid":                   {"tpl1"},
+			"zoneid":                       {"zone1"},
+			"name":                         {"web"},
+			"details[0].cpuNumber":         {"2"},
+			"iptonetworklist[0].ip":        {"10.0.0.10"},
+			"iptonetworklist[0].networkid": {"net1"},
+			"iptonetworklist[1].networkid": {"net2"},
+			"securitygroupids":             {"sg1, sg2"},
+		}},
+		{"usersecuritygrouplist with account and group keys", authorize, url.Values{
+			"usersecuritygrouplist[0].account": {"admin"},
+			"usersecuritygrouplist[0].group":   {"web"},
+		}},
+		{"migrateto with volume and pool keys", migrate, url.Values{
+			"hostid":              {"host1"},
+			"virtualmachineid":    {"vm1"},
+			"migrateto[0].volume": {"vol1"},
+			"migrateto[0].pool":   {"pool1"},
+		}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.cmd.URLValues(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: unexpected URL values of %s:\n got: %v\nwant: %v", tt.name, tt.cmd.Command(), got, tt.want)
+		}
+	}
+}
+
+func TestListResponse(t *testing.T) {
+	tests := []struct {
+		format   ResponseFormat
+		response string
+	}{
+		{JSONResponse, `{"listzonesresponse":{"count":2,"zone":[` +
+			`{"id":"zone1","name":"Zone 1","securitygroupsenabled":true,"tags":[{"key":"env","value":"prod"}]},` +
+			`{"id":"zone2","name":"Zone 2"}]}}`},
+		{XMLResponse, `<?xml version="1.0" encoding="UTF-8"?><listzonesresponse cloud-stack-version="4.4.0"><count>2</count>` +
+			`<zone><id>zone1</id><name>Zone 1</name><securitygroupsenabled>true</securitygroupsenabled>` +
+			`<tags><key>env</key><value>prod</value></tags></zone>` +
+			`<zone><id>zone2</id><name>Zone 2</name></zone></listzonesresponse>`},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{"listZones": tt.response})
+		cs.ResponseFormat(tt.format)
+
+		p := cs.Zone.NewListZonesParams()
+		p.SetAvailable(true)
+		r, err := cs.Zone.ListZones(p)
+		if err != nil {
+			t.Fatalf("Failed to list the zones (format %d): %v", tt.format, err)
+		}
+		if r.Count != 2 || len(r.Zones) != 2 {
+			t.Fatalf("Expected 2 zones (format %d), got %+v", tt.format, r)
+		}
+		z := r.Zones[0]
+		if z.Id != "zone1" || z.Name != "Zone 1" || !z.Securitygroupsenabled || len(z.Tags) != 1 || z.Tags[0].Value != "prod" {
+			t.Errorf("Unexpected first zone (format %d): %+v", tt.format, z)
+		}
+
+		// Execute decodes the same response
+		out := p.ResponseType()
+		if err := cs.Execute(context.Background(), p, out); err != nil {
+			t.Fatalf("Failed to execute listZones (format %d): %v", tt.format, err)
+		}
+		if !reflect.DeepEqual(out, r) {
+			t.Errorf("Execute decoded %+v (format %d), expected %+v", out, tt.format, r)
+		}
+
+		// The same objects are streamed
+		var streamed []*Zone
+		count, err := cs.Zone.StreamZones(context.Background(), p, func(z *Zone) error {
+			streamed = append(streamed, z)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Failed to stream the zones (format %d): %v", tt.format, err)
+		}
+		if count != r.Count || !reflect.DeepEqual(streamed, r.Zones) {
+			t.Errorf("Streamed %d zones %+v (format %d), expected %+v", count, streamed, tt.format, r.Zones)
+		}
+
+		// Streaming stops at the first error
+		stop := errors.New("stop")
+		streamed = nil
+		if _, err := cs.Zone.StreamZones(context.Background(), p, func(z *Zone) error {
+			streamed = append(streamed, z)
+			return stop
+		}); err != stop || len(streamed) != 1 {
+			t.Errorf("Expected streaming to stop after 1 zone (format %d), got %d zones and error %v", tt.format, len(streamed), err)
+		}
+
+		for _, params := range ts.requests["listZones"] {
+			want := "json"
+			if tt.format == XMLResponse {
+				want = "xml"
+			}
+			if params.Get("available") != "true" || params.Get("response") != want {
+				t.Errorf("Unexpected params %v (format %d)", params, tt.format)
+			}
+		}
+		ts.Close()
+	}
+}
+
+func TestAsyncJob(t *testing.T) {
+	tests := []struct {
+		name   string
+		result string
+		err    string
+	}{
+		{"finished", `{"jobstatus":1,"jobresulttype":"object",` +
+			`"jobresult":{"virtualmachine":{"id":"vm1","name":"web","state":"Running"}}}`, ""},
+		{"failed", `{"jobstatus":2,"jobresulttype":"text","jobresult":"Insufficient capacity"}`,
+			"Insufficient capacity"},
+		{"failed with an object", `{"jobstatus":2,"jobresulttype":"object",` +
+			`"jobresult":{"errorcode":530,"errortext":"Insufficient capacity"}}`, "Undefined error"},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{
+			"deployVirtualMachine": `{"deployvirtualmachineresponse":{"id":"vm1","jobid":"job1"}}`,
+			"queryAsyncJobResult":  `{"queryasyncjobresultresponse":` + tt.result + `}`,
+		})
+		cs = NewAsyncClient(ts.URL, "apikey", "secret", false)
+		cs.UnknownFields(RejectUnknownFields)
+
+		p := cs.VirtualMachine.NewDeployVirtualMachineParams("so1", "tpl1", "zone1")
+		r, err := cs.VirtualMachine.DeployVirtualMachine(p)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: failed to deploy the virtual machine: %v", tt.name, err)
+		case tt.err == "" && (r.Id != "vm1" || r.Name != "web" || r.State != "Running"):
+			t.Errorf("%s: unexpected response %+v", tt.name, r)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", tt.name, tt.err, err)
+		}
+
+		if q := ts.requests["queryAsyncJobResult"]; len(q) != 1 || q[0].Get("jobid") != "job1" {
+			t.Errorf("%s: expected the result of job1 to be queried once, got %v", tt.name, q)
+		}
+		ts.Close()
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(431)
+		fmt.Fprint(w, `{"listzonesresponse":{"uuidList":[],"errorcode":431,"cserrorcode":9999,"errortext":"Unable to find zone"}}`)
+	}))
+	defer ts.Close()
+
+	cs := NewClient(ts.URL, "apikey", "secret", false)
+	p := cs.Zone.NewListZonesParams()
+	if _, err := cs.Zone.ListZones(p); err == nil || !strings.Contains(err.Error(), "Unable to find zone") {
+		t.Errorf("Expected the error text of the response, got %v", err)
+	}
+	if _, err := cs.Zone.StreamZones(context.Background(), p, func(*Zone) error { return nil }); err == nil ||
+		!strings.Contains(err.Error(), "Unable to find zone") {
+		t.Errorf("Expected the error text of the response when streaming, got %v", err)
+	}
+}
+
+func TestVersionGating(t *testing.T) {
+	update43 := func(cs *CloudStackClient) error {
+		p := cs.Firewall.NewUpdatePortForwardingRuleParams()
+		p.SetIpaddressid("ip1")
+		p.SetPrivateport("22")
+		p.SetProtocol(ProtocolTCP)
+		p.SetPublicport("2222")
+		_, err := cs.Firewall.UpdatePortForwardingRule(p)
+		return err
+	}
+	update44 := func(cs *CloudStackClient) error {
+		p := cs.Firewall.NewUpdatePortForwardingRuleParams()
+		p.SetId("rule1")
+		_, err := cs.Firewall.UpdatePortForwardingRule(p)
+		return err
+	}
+	updateVpn := func(cs *CloudStackClient) error {
+		_, err := cs.VPN.UpdateRemoteAccessVpn(cs.VPN.NewUpdateRemoteAccessVpnParams("vpn1"))
+		return err
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		mode    UnsupportedMode
+		call    func(cs *CloudStackClient) error
+		want    *UnsupportedError // nil if the request must be sent
+	}{
+		{"4.3 params on 4.3", "4.3.1", RejectUnsupported, update43, nil},
+		{"4.4 params on 4.4", "4.4", RejectUnsupported, update44, nil},
+		{"4.4 param on 4.3", "4.3.1", RejectUnsupported,
+			update44, &UnsupportedError{API: "updatePortForwardingRule", Param: "id", Version: "4.3.1"}},
+		{"4.4 API on 4.3", "4.3", RejectUnsupported,
+			updateVpn, &UnsupportedError{API: "updateRemoteAccessVpn", Version: "4.3"}},
+		{"4.4 API on a newer server", "4.5.1", RejectUnsupported, updateVpn, nil},
+		{"4.4 API on an older server", "4.2", RejectUnsupported, updateVpn, nil},
+		{"warn about a 4.4 API on 4.3", "4.3", WarnUnsupported, updateVpn, nil},
+		{"ignore a 4.4 API on 4.3", "4.3", IgnoreUnsupported, updateVpn, nil},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{
+			"updatePortForwardingRule": `{"updateportforwardingruleresponse":{"jobid":"job1"}}`,
+			"updateRemoteAccessVpn":    `{"updateremoteaccessvpnresponse":{"jobid":"job1"}}`,
+		})
+		cs.ServerVersion(tt.version)
+		cs.UnsupportedCalls(tt.mode)
+
+		err := tt.call(cs)
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tt.name, err)
+			}
+			if len(ts.requests) != 1 {
+				t.Errorf("%s: expected the request to be sent, got %v", tt.name, ts.requests)
+			}
+		} else {
+			if ue, ok := err.(*UnsupportedError); !ok || *ue != *tt.want {
+				t.Errorf("%s: expected %#v, got %#v", tt.name, tt.want, err)
+			}
+			if len(ts.requests) != 0 {
+				t.Errorf("%s: expected no request to be sent, got %v", tt.name, ts.requests)
+			}
+		}
+		ts.Close()
+	}
+}
+
+func TestGetID(t *testing.T) {
+	vm := func(id, zone, project string) string {
+		return fmt.Sprintf(`{"id":%q,"name":"web","zonename":%q,"project":%q,"domain":"ROOT"}`, id, zone, project)
+	}
+
+	tests := []struct {
+		name     string
+		response string
+		opts     []OptionFunc
+		want     string
+		err      error
+		requests []url.Values // The scope params of the expected requests
+	}{
+		{"scoped by zone and project",
+			`{"count":1,"virtualmachine":[` + vm("vm1", "zone1", "p1") + `]}`,
+			[]OptionFunc{WithZone("zone1"), WithProject("p1")}, "vm1", nil,
+			[]url.Values{{"zoneid": {"zone1"}, "projectid": {"p1"}}}},
+		{"inside projects if not found",
+			`{"count":0}`,
+			[]OptionFunc{WithZone("zone1")}, "", errors.New("No match found for web"),
+			[]url.Values{{"zoneid": {"zone1"}}, {"zoneid": {"zone1"}, "projectid": {"-1"}}}},
+		{"multiple matches",
+			`{"count":2,"virtualmachine":[` + vm("vm1", "zone1", "p1") + `,` + vm("vm2", "zone2", "") + `]}`,
+			nil, "", &AmbiguousError{Type: "VirtualMachine", Name: "web", Candidates: []*Candidate{
+				{ID: "vm1", Name: "web", Zone: "zone1", Project: "p1", Domain: "ROOT"},
+				{ID: "vm2", Name: "web", Zone: "zone2", Domain: "ROOT"},
+			}},
+			[]url.Values{{}}},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{
+			"listVirtualMachines": `{"listvirtualmachinesresponse":` + tt.response + `}`,
+		})
+
+		id, err := cs.VirtualMachine.GetVirtualMachineID("web", tt.opts...)
+		switch want := tt.err.(type) {
+		case nil:
+			if err != nil || id != tt.want {
+				t.Errorf("%s: got %q and error %v, want %q", tt.name, id, err, tt.want)
+			}
+		case *AmbiguousError:
+			if ae, ok := err.(*AmbiguousError); !ok || !reflect.DeepEqual(ae, want) {
+				t.Errorf("%s: expected %+v, got %+v", tt.name, want, err)
+			}
+		default:
+			if err == nil || !strings.Contains(err.Error(), want.Error()) {
+				t.Errorf("%s: expected an error containing %q, got %v", tt.name, want, err)
+			}
+		}
+
+		reqs := ts.requests["listVirtualMachines"]
+		if len(reqs) != len(tt.requests) {
+			t.Errorf("%s: expected %d requests, got %d", tt.name, len(tt.requests), len(reqs))
+		}
+		for i := 0; i < len(reqs) && i < len(tt.requests); i++ {
+			for _, param := range []string{"zoneid", "projectid"} {
+				if got, want := reqs[i].Get(param), tt.requests[i].Get(param); got != want {
+					t.Errorf("%s: expected %s %q in request %d, got %q", tt.name, param, want, i, got)
+				}
+			}
+			if reqs[i].Get("name") != "web" {
+				t.Errorf("%s: expected the name in request %d, got %v", tt.name, i, reqs[i])
+			}
+		}
+		ts.Close()
+	}
+}
+
+func TestScopeError(t *testing.T) {
+	ts, cs := newTestServer(t, nil)
+	defer ts.Close()
+
+	_, err := cs.Zone.GetZoneID("zone1", WithProject("p1"))
+	want := &ScopeError{API: "listZones", Scope: "project"}
+	if se, ok := err.(*ScopeError); !ok || *se != *want {
+		t.Errorf("Expected %v, got %v", want, err)
+	}
+	if err == nil || err.Error() != "API listZones can not be scoped by project" {
+		t.Errorf("Unexpected error message %v", err)
+	}
+	if len(ts.requests) != 0 {
+		t.Errorf("Expected no requests, got %v", ts.requests)
+	}
+}
+
+// Returns the tags of the request, encoded as tags[i].key and tags[i].value
+func requestTags(params url.Values) map[string]string {
+	tags := make(map[string]string)
+	for i := 0; params.Get(fmt.Sprintf("tags[%d].key", i)) != ""; i++ {
+		tags[params.Get(fmt.Sprintf("tags[%d].key", i))] = params.Get(fmt.Sprintf("tags[%d].value", i))
+	}
+	return tags
+}
+
+func TestGetByTags(t *testing.T) {
+	tags := map[string]string{"env": "prod", "tier": "web"}
+
+	tests := []struct {
+		name       string
+		response   string
+		want       []string
+		projectids []string // The projectid of every request
+	}{
+		{"found", `{"count":1,"virtualmachine":[{"id":"vm1","name":"web"}]}`, []string{"vm1"}, []string{""}},
+		{"not found", `{"count":0}`, nil, []string{"", "-1"}},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{
+			"listVirtualMachines": `{"listvirtualmachinesresponse":` + tt.response + `}`,
+		})
+
+		l, err := cs.VirtualMachine.GetVirtualMachinesByTags(tags)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		var ids []string
+		for _, vm := range l {
+			ids = append(ids, vm.Id)
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, ids, tt.want)
+		}
+
+		reqs := ts.requests["listVirtualMachines"]
+		if len(reqs) != len(tt.projectids) {
+			t.Fatalf("%s: expected %d requests, got %v", tt.name, len(tt.projectids), reqs)
+		}
+		for i, r := range reqs {
+			if got := requestTags(r); !reflect.DeepEqual(got, tags) {
+				t.Errorf("%s: expected the tags %v in request %d, got %v", tt.name, tags, i, r)
+			}
+			if r.Get("projectid") != tt.projectids[i] {
+				t.Errorf("%s: expected projectid %q in request %d, got %q", tt.name, tt.projectids[i], i, r.Get("projectid"))
+			}
+		}
+		ts.Close()
+	}
+}
+
+func TestFindByTags(t *testing.T) {
+	tags := map[string]string{"env": "prod", "tier": "web"}
+
+	tests := []struct {
+		name     string
+		tags     string // The tags found by listTags
+		vms      []string
+		other    map[TaggedResourceType][]string
+		listTags int // The expected number of listTags requests
+	}{
+		{"found",
+			`{"count":3,"tag":[` +
+				`{"resourcetype":"UserVm","resourceid":"vm1"},` +
+				`{"resourcetype":"UserVm","resourceid":"vm2"},` +
+				`{"resourcetype":"DiskOffering","resourceid":"do1"}]}`,
+			[]string{"vm1"}, map[TaggedResourceType][]string{TaggedResourceTypeDiskOffering: {"do1"}}, 2},
+		{"not found", `{"count":0}`, nil, map[TaggedResourceType][]string{}, 4},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{
+			"listTags": `{"listtagsresponse":` + tt.tags + `}`,
+			"listVirtualMachines": `{"listvirtualmachinesresponse":{"count":2,"virtualmachine":[` +
+				`{"id":"vm1","name":"web"},{"id":"vm3","name":"db"}]}}`,
+		})
+
+		o, err := cs.Resourcetags.FindByTags(tags)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		var vms []string
+		for _, vm := range o.VirtualMachines {
+			vms = append(vms, vm.Id)
+		}
+		if !reflect.DeepEqual(vms, tt.vms) || !reflect.DeepEqual(o.Other, tt.other) {
+			t.Errorf("%s: got %v and %v, want %v and %v", tt.name, vms, o.Other, tt.vms, tt.other)
+		}
+		if o.Count() != len(tt.vms)+len(tt.other) {
+			t.Errorf("%s: unexpected count %d", tt.name, o.Count())
+		}
+
+		// Every tag is searched separately, and inside projects if nothing is found
+		reqs := ts.requests["listTags"]
+		if len(reqs) != tt.listTags {
+			t.Fatalf("%s: expected %d listTags requests, got %v", tt.name, tt.listTags, reqs)
+		}
+		for i, r := range reqs {
+			if tags[r.Get("key")] != r.Get("value") || r.Get("value") == "" {
+				t.Errorf("%s: unexpected tag in request %d: %v", tt.name, i, r)
+			}
+			want := ""
+			if i >= len(tags) {
+				want = "-1"
+			}
+			if r.Get("projectid") != want {
+				t.Errorf("%s: expected projectid %q in request %d, got %q", tt.name, want, i, r.Get("projectid"))
+			}
+		}
+		if len(tt.vms) == 0 && len(ts.requests["listVirtualMachines"]) != 0 {
+			t.Errorf("%s: expected no virtual machines to be listed", tt.name)
+		}
+		ts.Close()
+	}
+
+	if _, err := NewClient("https://cloudstack.example.com/client/api", "apikey", "secret", false).
+		Resourcetags.FindByTags(nil); err == nil {
+		t.Errorf("Expected an error when no tags are given")
+	}
+}
+
+func TestDynamicClient(t *testing.T) {
+	ts, cs := newTestServer(t, map[string]string{
+		"listApis": `{"listapisresponse":{"count":1,"api":[{"name":"createWidget","isasync":false,"params":[` +
+			`{"name":"name","type":"string","length":10,"required":true},` +
+			`{"name":"size","type":"integer"},` +
+			`{"name":"enabled","type":"boolean"},` +
+			`{"name":"zoneids","type":"list"},` +
+			`{"name":"tags","type":"map"},` +
+			`{"name":"rules","type":"map"}]}]}}`,
+		"createWidget": `{"createwidgetresponse":{"id":"w1","name":"big"}}`,
+	})
+	defer ts.Close()
+
+	dc, err := cs.NewDynamicClient()
+	if err != nil {
+		t.Fatalf("Failed to create the dynamic client: %v", err)
+	}
+	if len(ts.requests["listApis"]) != 1 {
+		t.Errorf("Expected the APIs to be listed once, got %v", ts.requests["listApis"])
+	}
+	if _, found := dc.API("createwidget"); !found {
+		t.Errorf("Expected createWidget to be found, ignoring the case")
+	}
+
+	tests := []struct {
+		name   string
+		api    string
+		params map[string]interface{}
+		want   url.Values // nil if the call must fail with err
+		err    string
+	}{
+		{"all param types", "createWidget", map[string]interface{}{
+			"name":    "big",
+			"size":    int64(3),
+			"enabled": true,
+			"zoneids": []string{"zone1", "zone2"},
+			"tags":    map[string]string{"env": "prod", "tier": "web"},
+			"rules":   []map[string]string{{"port": "80"}, {"port": "443", "protocol": "tcp"}},
+		}, url.Values{
+			"name":              {"big"},
+			"size":              {"3"},
+			"enabled":           {"true"},
+			"zoneids":           {"zone1, zone2"},
+			"tags[0].key":       {"env"},
+			"tags[0].value":     {"prod"},
+			"tags[1].key":       {"tier"},
+			"tags[1].value":     {"web"},
+			"rules[0].port":     {"80"},
+			"rules[1].port":     {"443"},
+			"rules[1].protocol": {"tcp"},
+		}, ""},
+		{"missing required param", "createWidget", map[string]interface{}{"size": 3},
+			nil, "Missing required param name for API createWidget"},
+		{"unknown param", "createWidget", map[string]interface{}{"name": "big", "color": "red"},
+			nil, "Unknown param color for API createWidget"},
+		{"wrong type", "createWidget", map[string]interface{}{"name": "big", "size": "3"},
+			nil, "Invalid value for param size of API createWidget: expected an int or int64, got string"},
+		{"too long", "createWidget", map[string]interface{}{"name": "much too long"},
+			nil, "Invalid value for param name of API createWidget: value is longer than 10 characters"},
+		{"unsupported API", "deleteWidget", nil,
+			nil, "API deleteWidget is not supported by the server"},
+	}
+
+	for _, tt := range tests {
+		ts.requests = make(map[string][]url.Values)
+
+		r, err := dc.Call(tt.api, tt.params)
+		if tt.want == nil {
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("%s: expected error %q, got %v", tt.name, tt.err, err)
+			}
+			if len(ts.requests) != 0 {
+				t.Errorf("%s: expected no requests, got %v", tt.name, ts.requests)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", tt.name, err)
+		}
+		if r["id"] != "w1" {
+			t.Errorf("%s: unexpected response %v", tt.name, r)
+		}
+		reqs := ts.requests[tt.api]
+		if len(reqs) != 1 {
+			t.Fatalf("%s: expected one request, got %v", tt.name, reqs)
+		}
+		for _, k := range []string{"command", "apikey", "response", "signature"} {
+			reqs[0].Del(k)
+		}
+		if !reflect.DeepEqual(reqs[0], tt.want) {
+			t.Errorf("%s: got params %v, want %v", tt.name, reqs[0], tt.want)
+		}
+	}
+}
+
+func TestDetectCapabilities(t *testing.T) {
+	ts, cs := newTestServer(t, map[string]string{
+		"listCapabilities": `{"listcapabilitiesresponse":{"capability":{"cloudstackversion":"4.4.2","securitygroupsenabled":true}}}`,
+		"listApis": `{"listapisresponse":{"count":1,"api":[{"name":"listZones","params":[` +
+			`{"name":"name"},{"name":"tags"},{"name":"id"}]}]}}`,
+		"listZones": `{"listzonesresponse":{"count":1,"zone":[{"id":"zone1","name":"zone1"}]}}`,
+	})
+	defer ts.Close()
+
+	caps, err := cs.DetectCapabilities()
+	if err != nil {
+		t.Fatalf("Failed to detect the capabilities: %v", err)
+	}
+	if caps.Version != "4.4.2" || cs.Capabilities() != caps {
+		t.Errorf("Unexpected capabilities %+v", caps)
+	}
+	if !caps.SupportsAPI("LISTZONES") || caps.SupportsAPI("listVirtualMachines") {
+		t.Errorf("Expected only listZones to be supported")
+	}
+	if !caps.SupportsParam("listZones", "Tags") || caps.SupportsParam("listZones", "pagesize") {
+		t.Errorf("Expected only the name, tags and id params of listZones to be supported")
+	}
+
+	withName := cs.Zone.NewListZonesParams()
+	withName.SetName("zone1")
+	withTags := cs.Zone.NewListZonesParams()
+	withTags.SetTags(map[string]string{"env": "prod"})
+	withPagesize := cs.Zone.NewListZonesParams()
+	withPagesize.SetPagesize(10)
+
+	listZones := func(p *ListZonesParams) func() error {
+		return func() error {
+			_, err := cs.Zone.ListZones(p)
+			return err
+		}
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want *UnsupportedError // nil if the request must be sent
+	}{
+		{"supported param", listZones(withName), nil},
+		{"supported map param", listZones(withTags), nil},
+		{"unsupported param", listZones(withPagesize),
+			&UnsupportedError{API: "listZones", Param: "pagesize", Version: "4.4.2"}},
+		{"unsupported API", func() error {
+			_, err := cs.VirtualMachine.ListVirtualMachines(cs.VirtualMachine.NewListVirtualMachinesParams())
+			return err
+		}, &UnsupportedError{API: "listVirtualMachines", Version: "4.4.2"}},
+	}
+
+	for _, tt := range tests {
+		ts.requests = make(map[string][]url.Values)
+
+		err := tt.call()
+		if tt.want == nil {
+			if err != nil || len(ts.requests) != 1 {
+				t.Errorf("%s: expected the request to be sent, got %v and error %v", tt.name, ts.requests, err)
+			}
+			continue
+		}
+		if ue, ok := err.(*UnsupportedError); !ok || *ue != *tt.want {
+			t.Errorf("%s: expected %#v, got %#v", tt.name, tt.want, err)
+		}
+		if len(ts.requests) != 0 {
+			t.Errorf("%s: expected no requests, got %v", tt.name, ts.requests)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cs := NewClient("https://cloudstack.example.com/client/api", "apikey", "secret", false)
+
+	exclusive := cs.VirtualMachine.NewDeployVirtualMachineParams("so1", "tpl1", "zone1")
+	exclusive.SetSecuritygroupids([]string{"sg1"})
+	exclusive.SetSecuritygroupnames([]string{"web"})
+
+	tests := []struct {
+		name   string
+		params interface{ Validate() error }
+		want   []*FieldError
+	}{
+		{"valid", cs.Firewall.NewCreateFirewallRuleParams("ip1", ProtocolTCP), nil},
+		{"enum value in upper case", cs.Firewall.NewCreateFirewallRuleParams("ip1", Protocol("TCP")), nil},
+		{"protocol number of a network ACL", cs.NetworkACL.NewCreateNetworkACLParams(Protocol("6")), nil},
+		{"unlisted enum value", cs.Firewall.NewCreateFirewallRuleParams("ip1", Protocol("tcpx")), []*FieldError{
+			{Param: "protocol", Message: "is not a known value: tcpx"},
+		}},
+		{"protocol number of a firewall rule", cs.Firewall.NewCreateFirewallRuleParams("ip1", Protocol("6")), []*FieldError{
+			{Param: "protocol", Message: "is not a known value: 6"},
+		}},
+		{"missing required params", &CreateFirewallRuleParams{}, []*FieldError{
+			{Param: "ipaddressid", Message: "is required"},
+			{Param: "protocol", Message: "is required"},
+		}},
+		{"exclusive params", exclusive, []*FieldError{
+			{Param: "securitygroupids", Message: "can not be used together with securitygroupnames"},
+		}},
+	}
+
+	for _, tt := range tests {
+		err := tt.params.Validate()
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		if ve, ok := err.(*ValidationError); !ok || !reflect.DeepEqual(ve.Errors, tt.want) {
+			t.Errorf("%s: expected the errors %v, got %v", tt.name, tt.want, err)
+		}
+	}
+
+}
+
+func TestValidateParams(t *testing.T) {
+	ts, cs := newTestServer(t, nil)
+	defer ts.Close()
+	cs.ValidateParams(true)
+
+	_, err := cs.Firewall.CreateFirewallRule(cs.Firewall.NewCreateFirewallRuleParams("ip1", Protocol("tcpx")))
+	if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("Expected a *ValidationError, got %v", err)
+	}
+	if len(ts.requests) != 0 {
+		t.Errorf("Expected no requests, got %v", ts.requests)
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	responses := []struct {
+		format   ResponseFormat
+		response string
+	}{
+		{JSONResponse, `{"listzonesresponse":{"count":1,"zone":[` +
+			`{"id":"zone1","name":"Zone 1","newfield":"new","tags":[{"key":"env","value":"prod","owner":"admin"}]}]}}`},
+		{XMLResponse, `<?xml version="1.0" encoding="UTF-8"?><listzonesresponse><count>1</count>` +
+			`<zone><id>zone1</id><name>Zone 1</name><newfield>new</newfield>` +
+			`<tags><key>env</key><value>prod</value><owner>admin</owner></tags></zone></listzonesresponse>`},
+	}
+
+	for _, resp := range responses {
+		for _, mode := range []UnknownFieldsMode{IgnoreUnknownFields, KeepUnknownFields, RejectUnknownFields} {
+			ts, cs := newTestServer(t, map[string]string{"listZones": resp.response})
+			cs.ResponseFormat(resp.format)
+			cs.UnknownFields(mode)
+			p := cs.Zone.NewListZonesParams()
+
+			var zones []*Zone
+			l, err := cs.Zone.ListZones(p)
+			if err == nil {
+				zones = l.Zones
+			}
+			var streamed []*Zone
+			_, serr := cs.Zone.StreamZones(context.Background(), p, func(z *Zone) error {
+				streamed = append(streamed, z)
+				return nil
+			})
+
+			if mode == RejectUnknownFields {
+				want := &UnknownFieldsError{API: "listZones", Fields: []string{"zone.newfield", "zone.tags.owner"}}
+				for _, err := range []error{err, serr} {
+					if ue, ok := err.(*UnknownFieldsError); !ok || !reflect.DeepEqual(ue, want) {
+						t.Errorf("Expected %v (format %d), got %v", want, resp.format, err)
+					}
+				}
+				ts.Close()
+				continue
+			}
+			if err != nil || serr != nil {
+				t.Fatalf("Failed to list the zones (format %d, mode %d): %v, %v", resp.format, mode, err, serr)
+			}
+
+			for name, zs := range map[string][]*Zone{"ListZones": zones, "StreamZones": streamed} {
+				if len(zs) != 1 || len(zs[0].Tags) != 1 {
+					t.Fatalf("%s: expected 1 zone with 1 tag (format %d, mode %d), got %+v", name, resp.format, mode, zs)
+				}
+				z, tag := zs[0], zs[0].Tags[0]
+				if mode == IgnoreUnknownFields {
+					if z.Extra != nil || tag.Extra != nil {
+						t.Errorf("%s: expected the unknown fields to be dropped (format %d), got %v and %v",
+							name, resp.format, z.Extra, tag.Extra)
+					}
+					continue
+				}
+				if len(z.Extra) != 1 || !strings.Contains(string(z.Extra["newfield"]), "new") {
+					t.Errorf("%s: expected newfield in the Extra field of the zone (format %d), got %s", name, resp.format, z.Extra)
+				}
+				if len(tag.Extra) != 1 || !strings.Contains(string(tag.Extra["owner"]), "admin") {
+					t.Errorf("%s: expected owner in the Extra field of the tag (format %d), got %s", name, resp.format, tag.Extra)
+				}
+			}
+			ts.Close()
+		}
+	}
+}
+
+func TestCreatedDate(t *testing.T) {
+	want := time.Date(2014, 10, 13, 12, 42, 41, 0, time.FixedZone("", 2*60*60))
+
+	tests := []struct {
+		format   ResponseFormat
+		response string
+	}{
+		{JSONResponse, `{"listvirtualmachinesresponse":{"count":1,"virtualmachine":[` +
+			`{"id":"vm1","name":"web","created":"2014-10-13T12:42:41+0200"}]}}`},
+		{XMLResponse, `<?xml version="1.0" encoding="UTF-8"?><listvirtualmachinesresponse><count>1</count>` +
+			`<virtualmachine><id>vm1</id><name>web</name><created>2014-10-13T12:42:41+0200</created></virtualmachine>` +
+			`</listvirtualmachinesresponse>`},
+	}
+
+	for _, tt := range tests {
+		ts, cs := newTestServer(t, map[string]string{"listVirtualMachines": tt.response})
+		cs.ResponseFormat(tt.format)
+
+		l, err := cs.VirtualMachine.ListVirtualMachines(cs.VirtualMachine.NewListVirtualMachinesParams())
+		if err != nil {
+			t.Fatalf("Failed to list the virtual machines (format %d): %v", tt.format, err)
+		}
+		if len(l.VirtualMachines) != 1 {
+			t.Fatalf("Expected 1 virtual machine (format %d), got %+v", tt.format, l)
+		}
+		created := l.VirtualMachines[0].Created
+		if !created.Equal(want) || created.Raw != "2014-10-13T12:42:41+0200" {
+			t.Errorf("Unexpected created date %v (format %d), want %v", created, tt.format, want)
+		}
+		ts.Close()
+	}
+}
@@ -17,6 +17,8 @@
 package cloudstack
 
 import (
+	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/tls"
@@ -26,14 +28,19 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
 type CSError struct {
-	ErrorCode   int    `json:"errorcode"`
-	CSErrorCode int    `json:"cserrorcode"`
-	ErrorText   string `json:"errortext"`
+	ErrorCode   int    `json:"errorcode" xml:"errorcode"`
+	CSErrorCode int    `json:"cserrorcode" xml:"cserrorcode"`
+	ErrorText   string `json:"errortext" xml:"errortext"`
 }
 
 func (e *CSError) Error() error {
@@ -41,40 +48,54 @@ func (e *CSError) Error() error {
 }
 
 type CloudStackClient struct {
-	client  *http.Client // The http client for communicating
-	baseURL string       // The base URL of the API
-	apiKey  string       // Api key
-	secret  string       // Secret key
-	async   bool         // Wait for async calls to finish
-	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 60 seconds
-
-	APIDiscovery     *APIDiscoveryService
-	Account          *AccountService
-	Address          *AddressService
-	AffinityGroup    *AffinityGroupService
-	Alert            *AlertService
-	Asyncjob         *AsyncjobService
-	AutoScale        *AutoScaleService
-	Baremetal        *BaremetalService
-	BigSwitchVNS     *BigSwitchVNSService
-	Certificate      *CertificateService
-	CloudIdentifier  *CloudIdentifierService
-	Cluster          *ClusterService
-	Configuration    *ConfigurationService
-	DiskOffering     *DiskOfferingService
-	Domain           *DomainService
-	Event            *EventService
-	Firewall         *FirewallService
-	GuestOS          *GuestOSService
-	Host             *HostService
-	Hypervisor       *HypervisorService
-	ISO              *ISOService
-	ImageStore       *ImageStoreService
-	InternalLB       *InternalLBService
-	LDAP             *LDAPService
-	Limit            *LimitService
-	LoadBalancer     *LoadBalancerService
-	Login            *LoginService
+	client   *http.Client // The http client for communicating
+	baseURL  string       // The base URL of the API
+	apiKey   string       // Api key
+	secret   string       // Secret key
+	async    bool         // Wait for async calls to finish
+	timeout  int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 60 seconds
+	validate bool         // Validate the params of every API call before sending the request
+
+	unknownFields UnknownFieldsMode // How unknown fields in responses are handled; defaults to ignoring them
+	format        ResponseFormat    // The format CloudStack returns the responses in; defaults to JSON
+
+	serverVersion string          // The version of the server, used to check if it supports an API call
+	unsupported   UnsupportedMode // How API calls that are not supported by the server version are handled
+
+	capabilities *ServerCapabilities // Detected server capabilities; nil until detected
+
+	APIDiscovery    *APIDiscoveryService
+	Account         *AccountService
+	Address         *AddressService
+	AffinityGroup   *AffinityGroupService
+	Alert           *AlertService
+	Asyncjob        *AsyncjobService
+	AutoScale       *AutoScaleService
+	Baremetal       *BaremetalService
+	BigSwitchVNS    *BigSwitchVNSService
+	Certificate     *CertificateService
+	CloudIdentifier *CloudIdentifierService
+	Cluster         *ClusterService
+	Configuration   *ConfigurationService
+	DiskOffering    *DiskOfferingService
+	Domain          *DomainService
+	Event           *EventService
+	ExtFirewall     *ExtFirewallService
+	ExtLoadBalancer *ExtLoadBalancerService
+	ExternalDevice  *ExternalDeviceService
+	Firewall        *FirewallService
+	GuestOS         *GuestOSService
+	Host            *HostService
+	Hypervisor      *HypervisorService
+	ISO             *ISOService
+	ImageStore      *ImageStoreService
+	InternalLB      *InternalLBService
+	LDAP            *LDAPService
+	Limit           *LimitService
+	LoadBalancer    *LoadBalancerService
+	// Deprecated: LoginService has no methods, as its commands are not part of the API details of this version.
+	Login *LoginService
+	// Deprecated: LogoutService has no methods, as its commands are not part of the API details of this version.
 	Logout           *LogoutService
 	NAT              *NATService
 	NetworkACL       *NetworkACLService
@@ -146,6 +167,9 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 	cs.DiskOffering = NewDiskOfferingService(cs)
 	cs.Domain = NewDomainService(cs)
 	cs.Event = NewEventService(cs)
+	cs.ExtFirewall = NewExtFirewallService(cs)
+	cs.ExtLoadBalancer = NewExtLoadBalancerService(cs)
+	cs.ExternalDevice = NewExternalDeviceService(cs)
 	cs.Firewall = NewFirewallService(cs)
 	cs.GuestOS = NewGuestOSService(cs)
 	cs.Host = NewHostService(cs)
@@ -221,14 +245,107 @@ func (cs *CloudStackClient) AsyncTimeout(timeoutInSeconds int64) {
 	cs.timeout = timeoutInSeconds
 }
 
+// When enabled, the params of every API call are validated (see the Validate method of the params) before sending
+// the request. An API call with invalid params will then return a *ValidationError. The default is to not validate.
+func (cs *CloudStackClient) ValidateParams(validate bool) {
+	cs.validate = validate
+}
+
+// Sets how fields in a response that are not part of the response type are handled. By default they are
+// ignored. Keeping them gives access to fields added by newer CloudStack versions, and returning an error
+// makes it easy to detect differences between the server and this package (for example in tests).
+func (cs *CloudStackClient) UnknownFields(mode UnknownFieldsMode) {
+	cs.unknownFields = mode
+}
+
+// Sets the format CloudStack returns the responses in. By default JSON is used. XML responses are decoded
+// into the same response types, using their xml tags.
+func (cs *CloudStackClient) ResponseFormat(format ResponseFormat) {
+	cs.format = format
+}
+
+// Returns the part of this client that is the same for all versions. This makes it possible to write code
+// that works with the client of any of the version specific packages.
+func (cs *CloudStackClient) Common() *cloudstackcommon.Client {
+	return &cloudstackcommon.Client{
+		AsyncJobClient:   cs,
+		APIDiscovery:     cs.APIDiscovery,
+		Account:          cs.Account,
+		Address:          cs.Address,
+		AffinityGroup:    cs.AffinityGroup,
+		Alert:            cs.Alert,
+		Asyncjob:         cs.Asyncjob,
+		AutoScale:        cs.AutoScale,
+		Baremetal:        cs.Baremetal,
+		BigSwitchVNS:     cs.BigSwitchVNS,
+		Certificate:      cs.Certificate,
+		CloudIdentifier:  cs.CloudIdentifier,
+		Cluster:          cs.Cluster,
+		Configuration:    cs.Configuration,
+		DiskOffering:     cs.DiskOffering,
+		Domain:           cs.Domain,
+		Event:            cs.Event,
+		Firewall:         cs.Firewall,
+		GuestOS:          cs.GuestOS,
+		Host:             cs.Host,
+		Hypervisor:       cs.Hypervisor,
+		ISO:              cs.ISO,
+		ImageStore:       cs.ImageStore,
+		InternalLB:       cs.InternalLB,
+		Limit:            cs.Limit,
+		LoadBalancer:     cs.LoadBalancer,
+		NAT:              cs.NAT,
+		NetworkACL:       cs.NetworkACL,
+		NetworkDevice:    cs.NetworkDevice,
+		NetworkOffering:  cs.NetworkOffering,
+		Network:          cs.Network,
+		Nic:              cs.Nic,
+		NiciraNVP:        cs.NiciraNVP,
+		Pod:              cs.Pod,
+		Pool:             cs.Pool,
+		PortableIP:       cs.PortableIP,
+		Project:          cs.Project,
+		Region:           cs.Region,
+		Resourcemetadata: cs.Resourcemetadata,
+		Resourcetags:     cs.Resourcetags,
+		Router:           cs.Router,
+		S3:               cs.S3,
+		SSH:              cs.SSH,
+		SecurityGroup:    cs.SecurityGroup,
+		ServiceOffering:  cs.ServiceOffering,
+		Snapshot:         cs.Snapshot,
+		StoragePool:      cs.StoragePool,
+		StratosphereSSP:  cs.StratosphereSSP,
+		Swift:            cs.Swift,
+		SystemCapacity:   cs.SystemCapacity,
+		SystemVM:         cs.SystemVM,
+		Template:         cs.Template,
+		UCS:              cs.UCS,
+		Usage:            cs.Usage,
+		User:             cs.User,
+		VLAN:             cs.VLAN,
+		VMGroup:          cs.VMGroup,
+		VPC:              cs.VPC,
+		VPN:              cs.VPN,
+		VirtualMachine:   cs.VirtualMachine,
+		Volume:           cs.Volume,
+		Zone:             cs.Zone,
+	}
+}
+
 // A helper function that you can use to get the result of a running async job. If the job is not finished within the configured
 // timeout, the async job return a warning saying the timer has expired.
 func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (b json.RawMessage, warn error, err error) {
+	return cs.getAsyncJobResult(context.Background(), jobid, timeout)
+}
+
+// Waits for the async job like GetAsyncJobResult, but stops waiting when the context is done
+func (cs *CloudStackClient) getAsyncJobResult(ctx context.Context, jobid string, timeout int64) (b json.RawMessage, warn error, err error) {
 	currentTime := time.Now().Unix()
 	for {
 		p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)
-		r, err := cs.Asyncjob.QueryAsyncJobResult(p)
-		if err != nil {
+		var r QueryAsyncJobResultResponse
+		if err := cs.Execute(ctx, p, &r); err != nil {
 			return nil, nil, err
 		}
 
@@ -240,7 +357,7 @@ func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (b js
 		// When the status is 2, the job has failed
 		if r.Jobstatus == 2 {
 			if r.Jobresulttype == "text" {
-				return nil, nil, fmt.Errorf(string(r.Jobresult))
+				return nil, nil, fmt.Errorf("%s", r.Jobresult)
 			} else {
 				return nil, nil, fmt.Errorf("Undefined error: %s", string(r.Jobresult))
 			}
@@ -249,49 +366,197 @@ func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (b js
 		if time.Now().Unix()-currentTime > timeout {
 			return nil, fmt.Errorf("Timeout while waiting for async job to finish"), nil
 		}
-		time.Sleep(3 * time.Second)
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		case <-time.After(3 * time.Second):
+		}
 	}
 }
 
-// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
-// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS
-// error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
-	params.Set("apikey", cs.apiKey)
-	params.Set("command", api)
-	params.Set("response", "json")
+// An API command with its params, as implemented by the params types of all API commands
+type Command = cloudstackcommon.Command
 
-	// Generate signature for API call
-	// * Serialize parameters and sort them by key, done by Encode
-	// * Convert the entire argument string to lowercase
-	// * Replace all instances of '+' to '%20'
-	// * Calculate HMAC SHA1 of argument string with CloudStack secret
-	// * URL encode the string and convert to base64
-	s := params.Encode()
-	s2 := strings.ToLower(s)
-	s3 := strings.Replace(s2, "+", "%20", -1)
-	mac := hmac.New(sha1.New, []byte(cs.secret))
-	mac.Write([]byte(s3))
-	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+// The API commands of which the response needs special handling in Execute
+var (
+	// The response is wrapped in an object which needs to be removed
+	wrappedResponses = map[string]bool{
+		"createNetwork":      true,
+		"createSSHKeyPair":   true,
+		"registerSSHKeyPair": true,
+	}
 
-	var err error
-	var resp *http.Response
-	if api == "deployVirtualMachine" {
-		// The deployVirtualMachine API should be called using a POST call
-		// so we don't have to worry about the userdata size
+	// The result of the async job only contains the success and displaytext fields
+	successOnlyResponses = map[string]bool{
+		"deleteAccount":                    true,
+		"deleteAccountFromProject":         true,
+		"addAccountToProject":              true,
+		"disassociateIpAddress":            true,
+		"deleteAffinityGroup":              true,
+		"generateAlert":                    true,
+		"deleteAutoScalePolicy":            true,
+		"deleteAutoScaleVmGroup":           true,
+		"deleteAutoScaleVmProfile":         true,
+		"deleteCondition":                  true,
+		"deleteCounter":                    true,
+		"deleteBigSwitchVnsDevice":         true,
+		"releaseDedicatedCluster":          true,
+		"deleteDomain":                     true,
+		"deleteCiscoNexusVSM":              true,
+		"deleteEgressFirewallRule":         true,
+		"deleteFirewallRule":               true,
+		"deletePaloAltoFirewall":           true,
+		"deletePortForwardingRule":         true,
+		"deleteSrxFirewall":                true,
+		"removeGuestOs":                    true,
+		"removeGuestOsMapping":             true,
+		"releaseDedicatedHost":             true,
+		"releaseHostReservation":           true,
+		"deleteIso":                        true,
+		"removeCertFromLoadBalancer":       true,
+		"assignCertToLoadBalancer":         true,
+		"deleteF5LoadBalancer":             true,
+		"removeFromGlobalLoadBalancerRule": true,
+		"removeFromLoadBalancerRule":       true,
+		"deleteGlobalLoadBalancerRule":     true,
+		"deleteLBHealthCheckPolicy":        true,
+		"deleteLBStickinessPolicy":         true,
+		"deleteLoadBalancer":               true,
+		"deleteLoadBalancerRule":           true,
+		"deleteNetscalerLoadBalancer":      true,
+		"assignToGlobalLoadBalancerRule":   true,
+		"assignToLoadBalancerRule":         true,
+		"deleteIpForwardingRule":           true,
+		"disableStaticNat":                 true,
+		"deleteNetworkACL":                 true,
+		"deleteNetworkACLList":             true,
+		"replaceNetworkACLList":            true,
+		"updateNetworkACLList":             true,
+		"deleteNetwork":                    true,
+		"deleteNetworkServiceProvider":     true,
+		"deletePhysicalNetwork":            true,
+		"deleteStorageNetworkIpRange":      true,
+		"removeIpFromNic":                  true,
+		"deleteNiciraNvpDevice":            true,
+		"releaseDedicatedPod":              true,
+		"deletePortableIpRange":            true,
+		"deleteProject":                    true,
+		"deleteProjectInvitation":          true,
+		"updateProjectInvitation":          true,
+		"addResourceDetail":                true,
+		"removeResourceDetail":             true,
+		"createTags":                       true,
+		"deleteTags":                       true,
+		"revokeSecurityGroupEgress":        true,
+		"revokeSecurityGroupIngress":       true,
+		"deleteSnapshot":                   true,
+		"deleteVMSnapshot":                 true,
+		"deleteTemplate":                   true,
+		"deleteTrafficType":                true,
+		"releaseDedicatedGuestVlanRange":   true,
+		"deletePrivateGateway":             true,
+		"deleteStaticRoute":                true,
+		"deleteVPC":                        true,
+		"deleteVPCOffering":                true,
+		"deleteRemoteAccessVpn":            true,
+		"deleteVpnConnection":              true,
+		"deleteVpnCustomerGateway":         true,
+		"deleteVpnGateway":                 true,
+		"removeVpnUser":                    true,
+		"cleanVMReservations":              true,
+		"expungeVirtualMachine":            true,
+		"scaleVirtualMachine":              true,
+		"releaseDedicatedZone":             true,
+	}
+)
 
-		// Add the unescaped signature to the POST params
-		params.Set("signature", signature)
+// Runs any API command and decodes the response into out, which should be a pointer to the response type of
+// the command (see the ResponseType method of the command). Like the API calls of the services, the params are
+// validated first if configured, and the async client waits for async commands to finish. If the job is still
+// running after the configured timeout, a warning is returned and out contains the ID of the running job.
+func (cs *CloudStackClient) Execute(ctx context.Context, cmd Command, out interface{}) error {
+	api := cmd.Command()
+	if v, ok := cmd.(interface{ Validate() error }); ok && cs.validate {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+	}
 
-		// Make a POST call
-		resp, err = cs.client.PostForm(cs.baseURL, params)
-	} else {
-		// Create the final URL before we issue the request
-		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
+	resp, err := cs.newRequestContext(ctx, api, cmd.URLValues())
+	if err != nil {
+		return err
+	}
+	if wrappedResponses[api] {
+		if resp, err = getRawValue(resp); err != nil {
+			return err
+		}
+	}
+	if err := cs.unmarshal(api, resp, out); err != nil {
+		return err
+	}
+	if !cmd.IsAsync() || !cs.async {
+		return nil
+	}
 
-		// Make a GET call
-		resp, err = cs.client.Get(url)
+	var job struct {
+		JobID string `json:"jobid" xml:"jobid"`
+	}
+	if err := decode(resp, &job); err != nil {
+		return err
 	}
+	b, warn, err := cs.getAsyncJobResult(ctx, job.JobID, cs.timeout)
+	if err != nil {
+		return err
+	}
+	if warn != nil {
+		return warn
+	}
+	if !successOnlyResponses[api] {
+		if b, err = getRawValue(b); err != nil {
+			return err
+		}
+	}
+	return cs.unmarshal(api, b, out)
+}
+
+// The layout of the expiry date of a URL signed using signature version 3
+const expiresLayout = "2006-01-02T15:04:05-0700"
+
+// Returns the signed URL of a GET request calling the API command, without sending the request. This makes it
+// possible to let another system (like a UI) call the command, without sharing the secret key. If expiry is not 0,
+// the URL is signed using signature version 3 and is rejected by CloudStack once it has expired. Like the API calls
+// of the services, the params are validated first if configured.
+func (cs *CloudStackClient) SignedURL(cmd Command, expiry time.Duration) (string, error) {
+	api := cmd.Command()
+	if v, ok := cmd.(interface{ Validate() error }); ok && cs.validate {
+		if err := v.Validate(); err != nil {
+			return "", err
+		}
+	}
+
+	params := cmd.URLValues()
+	if err := cs.checkSupported(api, params); err != nil {
+		return "", err
+	}
+	if expiry != 0 {
+		params.Set("signatureVersion", "3")
+		params.Set("expires", time.Now().Add(expiry).UTC().Format(expiresLayout))
+	}
+
+	s, signature := cs.sign(api, params)
+	return cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature), nil
+}
+
+// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
+// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS
+// error details. If a processing (code) error occurs the result will be nil and the generated error
+func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
+	return cs.newRequestContext(context.Background(), api, params)
+}
+
+// Executes the request like newRequest, using the context for the HTTP request
+func (cs *CloudStackClient) newRequestContext(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	resp, err := cs.sendRequest(ctx, api, params)
 	if err != nil {
 		return nil, err
 	}
@@ -302,15 +567,18 @@ func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawM
 		return nil, err
 	}
 
-	// Need to get the raw value to make the result play nice
-	b, err = getRawValue(b)
-	if err != nil {
-		return nil, err
+	// Need to get the raw value to make the result play nice. An XML response is used as it is, as its
+	// root element is decoded the same as the raw value of a JSON response.
+	if !isXML(b) {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if resp.StatusCode != 200 {
 		var e CSError
-		if err := json.Unmarshal(b, &e); err != nil {
+		if err := decode(b, &e); err != nil {
 			return nil, err
 		}
 		return nil, e.Error()
@@ -318,534 +586,698 @@ func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawM
 	return b, nil
 }
 
-// Generic function to get the first raw value from a response as json.RawMessage
-func getRawValue(b json.RawMessage) (json.RawMessage, error) {
-	var m map[string]json.RawMessage
-	if err := json.Unmarshal(b, &m); err != nil {
+// Signs and sends the request, and returns the response of which the caller needs to close the body
+func (cs *CloudStackClient) sendRequest(ctx context.Context, api string, params url.Values) (*http.Response, error) {
+	if err := cs.checkSupported(api, params); err != nil {
 		return nil, err
 	}
-	for _, v := range m {
-		return v, nil
-	}
-	return nil, fmt.Errorf("Unable to extract the raw value from:\n\n%s\n\n", string(b))
-}
-
-type APIDiscoveryService struct {
-	cs *CloudStackClient
-}
-
-func NewAPIDiscoveryService(cs *CloudStackClient) *APIDiscoveryService {
-	return &APIDiscoveryService{cs: cs}
-}
-
-type AccountService struct {
-	cs *CloudStackClient
-}
+	s, signature := cs.sign(api, params)
 
-func NewAccountService(cs *CloudStackClient) *AccountService {
-	return &AccountService{cs: cs}
-}
-
-type AddressService struct {
-	cs *CloudStackClient
-}
-
-func NewAddressService(cs *CloudStackClient) *AddressService {
-	return &AddressService{cs: cs}
-}
-
-type AffinityGroupService struct {
-	cs *CloudStackClient
-}
-
-func NewAffinityGroupService(cs *CloudStackClient) *AffinityGroupService {
-	return &AffinityGroupService{cs: cs}
-}
-
-type AlertService struct {
-	cs *CloudStackClient
-}
-
-func NewAlertService(cs *CloudStackClient) *AlertService {
-	return &AlertService{cs: cs}
-}
-
-type AsyncjobService struct {
-	cs *CloudStackClient
-}
-
-func NewAsyncjobService(cs *CloudStackClient) *AsyncjobService {
-	return &AsyncjobService{cs: cs}
-}
-
-type AutoScaleService struct {
-	cs *CloudStackClient
-}
-
-func NewAutoScaleService(cs *CloudStackClient) *AutoScaleService {
-	return &AutoScaleService{cs: cs}
-}
-
-type BaremetalService struct {
-	cs *CloudStackClient
-}
-
-func NewBaremetalService(cs *CloudStackClient) *BaremetalService {
-	return &BaremetalService{cs: cs}
-}
-
-type BigSwitchVNSService struct {
-	cs *CloudStackClient
-}
-
-func NewBigSwitchVNSService(cs *CloudStackClient) *BigSwitchVNSService {
-	return &BigSwitchVNSService{cs: cs}
-}
-
-type CertificateService struct {
-	cs *CloudStackClient
-}
-
-func NewCertificateService(cs *CloudStackClient) *CertificateService {
-	return &CertificateService{cs: cs}
-}
-
-type CloudIdentifierService struct {
-	cs *CloudStackClient
-}
-
-func NewCloudIdentifierService(cs *CloudStackClient) *CloudIdentifierService {
-	return &CloudIdentifierService{cs: cs}
-}
-
-type ClusterService struct {
-	cs *CloudStackClient
-}
-
-func NewClusterService(cs *CloudStackClient) *ClusterService {
-	return &ClusterService{cs: cs}
-}
-
-type ConfigurationService struct {
-	cs *CloudStackClient
-}
-
-func NewConfigurationService(cs *CloudStackClient) *ConfigurationService {
-	return &ConfigurationService{cs: cs}
-}
-
-type DiskOfferingService struct {
-	cs *CloudStackClient
-}
-
-func NewDiskOfferingService(cs *CloudStackClient) *DiskOfferingService {
-	return &DiskOfferingService{cs: cs}
-}
-
-type DomainService struct {
-	cs *CloudStackClient
-}
-
-func NewDomainService(cs *CloudStackClient) *DomainService {
-	return &DomainService{cs: cs}
-}
-
-type EventService struct {
-	cs *CloudStackClient
-}
-
-func NewEventService(cs *CloudStackClient) *EventService {
-	return &EventService{cs: cs}
-}
-
-type FirewallService struct {
-	cs *CloudStackClient
-}
-
-func NewFirewallService(cs *CloudStackClient) *FirewallService {
-	return &FirewallService{cs: cs}
-}
-
-type GuestOSService struct {
-	cs *CloudStackClient
-}
-
-func NewGuestOSService(cs *CloudStackClient) *GuestOSService {
-	return &GuestOSService{cs: cs}
-}
-
-type HostService struct {
-	cs *CloudStackClient
-}
-
-func NewHostService(cs *CloudStackClient) *HostService {
-	return &HostService{cs: cs}
-}
-
-type HypervisorService struct {
-	cs *CloudStackClient
-}
-
-func NewHypervisorService(cs *CloudStackClient) *HypervisorService {
-	return &HypervisorService{cs: cs}
-}
-
-type ISOService struct {
-	cs *CloudStackClient
-}
-
-func NewISOService(cs *CloudStackClient) *ISOService {
-	return &ISOService{cs: cs}
-}
-
-type ImageStoreService struct {
-	cs *CloudStackClient
-}
-
-func NewImageStoreService(cs *CloudStackClient) *ImageStoreService {
-	return &ImageStoreService{cs: cs}
-}
-
-type InternalLBService struct {
-	cs *CloudStackClient
-}
-
-func NewInternalLBService(cs *CloudStackClient) *InternalLBService {
-	return &InternalLBService{cs: cs}
-}
-
-type LDAPService struct {
-	cs *CloudStackClient
-}
-
-func NewLDAPService(cs *CloudStackClient) *LDAPService {
-	return &LDAPService{cs: cs}
-}
-
-type LimitService struct {
-	cs *CloudStackClient
-}
-
-func NewLimitService(cs *CloudStackClient) *LimitService {
-	return &LimitService{cs: cs}
-}
-
-type LoadBalancerService struct {
-	cs *CloudStackClient
-}
-
-func NewLoadBalancerService(cs *CloudStackClient) *LoadBalancerService {
-	return &LoadBalancerService{cs: cs}
-}
-
-type LoginService struct {
-	cs *CloudStackClient
-}
-
-func NewLoginService(cs *CloudStackClient) *LoginService {
-	return &LoginService{cs: cs}
-}
-
-type LogoutService struct {
-	cs *CloudStackClient
-}
-
-func NewLogoutService(cs *CloudStackClient) *LogoutService {
-	return &LogoutService{cs: cs}
-}
-
-type NATService struct {
-	cs *CloudStackClient
-}
-
-func NewNATService(cs *CloudStackClient) *NATService {
-	return &NATService{cs: cs}
-}
-
-type NetworkACLService struct {
-	cs *CloudStackClient
-}
-
-func NewNetworkACLService(cs *CloudStackClient) *NetworkACLService {
-	return &NetworkACLService{cs: cs}
-}
-
-type NetworkDeviceService struct {
-	cs *CloudStackClient
-}
+	var err error
+	var req *http.Request
+	if api == "deployVirtualMachine" {
+		// The deployVirtualMachine API should be called using a POST call
+		// so we don't have to worry about the userdata size
 
-func NewNetworkDeviceService(cs *CloudStackClient) *NetworkDeviceService {
-	return &NetworkDeviceService{cs: cs}
-}
+		// Add the unescaped signature to the POST params
+		params.Set("signature", signature)
 
-type NetworkOfferingService struct {
-	cs *CloudStackClient
-}
+		// Make a POST call
+		req, err = http.NewRequestWithContext(ctx, "POST", cs.baseURL, strings.NewReader(params.Encode()))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+	} else {
+		// Create the final URL before we issue the request
+		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
 
-func NewNetworkOfferingService(cs *CloudStackClient) *NetworkOfferingService {
-	return &NetworkOfferingService{cs: cs}
-}
+		// Make a GET call
+		req, err = http.NewRequestWithContext(ctx, "GET", url, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
 
-type NetworkService struct {
-	cs *CloudStackClient
+	return cs.client.Do(req)
 }
 
-func NewNetworkService(cs *CloudStackClient) *NetworkService {
-	return &NetworkService{cs: cs}
+// Fail fast if the server is known not to support this call
+func (cs *CloudStackClient) checkSupported(api string, params url.Values) error {
+	if err := cs.checkVersion(api, params); err != nil {
+		return err
+	}
+	if cs.capabilities != nil {
+		return cs.capabilities.check(api, params)
+	}
+	return nil
 }
 
-type NicService struct {
-	cs *CloudStackClient
-}
+// Adds the API key, the command and the response format to the params, and returns the encoded params and their
+// signature
+func (cs *CloudStackClient) sign(api string, params url.Values) (string, string) {
+	params.Set("apikey", cs.apiKey)
+	params.Set("command", api)
+	params.Set("response", "json")
+	if cs.format == XMLResponse {
+		params.Set("response", "xml")
+	}
 
-func NewNicService(cs *CloudStackClient) *NicService {
-	return &NicService{cs: cs}
-}
+	// Generate signature for API call
+	// * Serialize parameters and sort them by key, done by Encode
+	// * Convert the entire argument string to lowercase
+	// * Replace all instances of '+' to '%20'
+	// * Calculate HMAC SHA1 of argument string with CloudStack secret
+	// * URL encode the string and convert to base64
+	s := params.Encode()
+	s2 := strings.ToLower(s)
+	s3 := strings.Replace(s2, "+", "%20", -1)
+	mac := hmac.New(sha1.New, []byte(cs.secret))
+	mac.Write([]byte(s3))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
 
-type NiciraNVPService struct {
-	cs *CloudStackClient
+	return s, signature
 }
 
-func NewNiciraNVPService(cs *CloudStackClient) *NiciraNVPService {
-	return &NiciraNVPService{cs: cs}
-}
+// Executes the request of a 'list' API like newRequest, but decodes the response while it is read instead of
+// reading it completely first. The listed objects (the elements of the list named field) are passed to fn one by
+// one, so lists of any size can be processed using a bounded amount of memory. If fn returns an error, the
+// response is not read any further and the error is returned. Returns the count of the response.
+func (cs *CloudStackClient) streamList(ctx context.Context, api string, params url.Values, field string, fn func(json.RawMessage) error) (int, error) {
+	resp, err := cs.sendRequest(ctx, api, params)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
 
-type OvsElementService struct {
-	cs *CloudStackClient
-}
+	if resp.StatusCode != 200 {
+		// Errors are small, so these can be handled like any other response
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return 0, err
+		}
+		if !isXML(b) {
+			if b, err = getRawValue(b); err != nil {
+				return 0, err
+			}
+		}
+		var e CSError
+		if err := decode(b, &e); err != nil {
+			return 0, err
+		}
+		return 0, e.Error()
+	}
+	if cs.format == XMLResponse {
+		return streamXMLList(resp.Body, field, fn)
+	}
 
-func NewOvsElementService(cs *CloudStackClient) *OvsElementService {
-	return &OvsElementService{cs: cs}
-}
+	// The response is an object with a single field (like 'listeventsresponse') containing the count and the list
+	d := json.NewDecoder(resp.Body)
+	if err := expectDelim(d, '{'); err != nil {
+		return 0, err
+	}
+	if _, err := d.Token(); err != nil {
+		return 0, err
+	}
+	if err := expectDelim(d, '{'); err != nil {
+		return 0, err
+	}
 
-type PodService struct {
-	cs *CloudStackClient
+	count := 0
+	for d.More() {
+		t, err := d.Token()
+		if err != nil {
+			return 0, err
+		}
+		switch t {
+		case "count":
+			if err := d.Decode(&count); err != nil {
+				return 0, err
+			}
+		case field:
+			if err := expectDelim(d, '['); err != nil {
+				return 0, err
+			}
+			for d.More() {
+				var b json.RawMessage
+				if err := d.Decode(&b); err != nil {
+					return 0, err
+				}
+				if err := fn(b); err != nil {
+					return 0, err
+				}
+			}
+			if err := expectDelim(d, ']'); err != nil {
+				return 0, err
+			}
+		default:
+			var skip json.RawMessage
+			if err := d.Decode(&skip); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return count, expectDelim(d, '}')
 }
 
-func NewPodService(cs *CloudStackClient) *PodService {
-	return &PodService{cs: cs}
+// Reads the next token of the decoder, which should be the given delimiter
+func expectDelim(d *json.Decoder, delim json.Delim) error {
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	if t != delim {
+		return fmt.Errorf("Unexpected token in response: expected %s, got %v", delim, t)
+	}
+	return nil
 }
 
-type PoolService struct {
-	cs *CloudStackClient
+// Generic function to get the first raw value from a response as json.RawMessage. The raw value of an XML
+// response is its first child element.
+func getRawValue(b json.RawMessage) (json.RawMessage, error) {
+	if isXML(b) {
+		return getRawXMLValue(b)
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for _, v := range m {
+		return v, nil
+	}
+	return nil, fmt.Errorf("Unable to extract the raw value from:\n\n%s\n\n", string(b))
 }
 
-func NewPoolService(cs *CloudStackClient) *PoolService {
-	return &PoolService{cs: cs}
-}
+// Contains the version of a CloudStack server and the APIs and params it supports
+type ServerCapabilities struct {
+	Version string // The CloudStack version reported by the server
 
-type PortableIPService struct {
-	cs *CloudStackClient
+	apis map[string]map[string]bool // All supported APIs with their params, in lowercase
 }
 
-func NewPortableIPService(cs *CloudStackClient) *PortableIPService {
-	return &PortableIPService{cs: cs}
+// Returns true if the server supports the given API
+func (c *ServerCapabilities) SupportsAPI(api string) bool {
+	_, found := c.apis[strings.ToLower(api)]
+	return found
 }
 
-type ProjectService struct {
-	cs *CloudStackClient
+// Returns true if the server supports the given param for the given API
+func (c *ServerCapabilities) SupportsParam(api string, param string) bool {
+	return c.apis[strings.ToLower(api)][strings.ToLower(param)]
 }
 
-func NewProjectService(cs *CloudStackClient) *ProjectService {
-	return &ProjectService{cs: cs}
-}
+// Checks if both the API and all params are supported by the server
+func (c *ServerCapabilities) check(api string, params url.Values) error {
+	if !c.SupportsAPI(api) {
+		return &UnsupportedError{API: api, Version: c.Version}
+	}
 
-type RegionService struct {
-	cs *CloudStackClient
+	for _, n := range paramNames(params) {
+		if !c.SupportsParam(api, n) {
+			return &UnsupportedError{API: api, Param: n, Version: c.Version}
+		}
+	}
+	return nil
 }
 
-func NewRegionService(cs *CloudStackClient) *RegionService {
-	return &RegionService{cs: cs}
+// Returns the sorted names of the given params. Map params are send as name[i].key, so only the name is used.
+func paramNames(params url.Values) []string {
+	found := make(map[string]bool)
+	names := make([]string, 0, len(params))
+	for k := range params {
+		if i := strings.Index(k, "["); i != -1 {
+			k = k[:i]
+		}
+		if !found[k] {
+			names = append(names, k)
+			found[k] = true
+		}
+	}
+	sort.Strings(names)
+	return names
 }
 
-type ResourcemetadataService struct {
-	cs *CloudStackClient
+// Returned when calling an API or using a param that is not supported by the detected server version
+type UnsupportedError struct {
+	API     string
+	Param   string
+	Version string
 }
 
-func NewResourcemetadataService(cs *CloudStackClient) *ResourcemetadataService {
-	return &ResourcemetadataService{cs: cs}
+func (e *UnsupportedError) Error() string {
+	if e.Param != "" {
+		return fmt.Sprintf("Param %s of API %s is unsupported by server version %s", e.Param, e.API, e.Version)
+	}
+	return fmt.Sprintf("API %s is unsupported by server version %s", e.API, e.Version)
 }
 
-type ResourcetagsService struct {
-	cs *CloudStackClient
-}
+// Detects the version of the CloudStack server and the APIs and params it supports, using the listCapabilities
+// and listApis APIs. After calling this function all API calls made with this client are checked against the
+// detected capabilities, and if not supported they will fail with an *UnsupportedError without sending a request.
+func (cs *CloudStackClient) DetectCapabilities() (*ServerCapabilities, error) {
+	// The generated response types for these two APIs do not match the actual responses, so
+	// both responses are decoded here
+	jc := cs.withFormat(JSONResponse)
+	b, err := jc.newRequest("listCapabilities", url.Values{})
+	if err != nil {
+		return nil, err
+	}
 
-func NewResourcetagsService(cs *CloudStackClient) *ResourcetagsService {
-	return &ResourcetagsService{cs: cs}
-}
+	var c struct {
+		Capability struct {
+			Cloudstackversion string `json:"cloudstackversion"`
+		} `json:"capability"`
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
 
-type RouterService struct {
-	cs *CloudStackClient
-}
+	b, err = jc.newRequest("listApis", url.Values{})
+	if err != nil {
+		return nil, err
+	}
 
-func NewRouterService(cs *CloudStackClient) *RouterService {
-	return &RouterService{cs: cs}
-}
+	var l struct {
+		Apis []struct {
+			Name   string `json:"name"`
+			Params []struct {
+				Name string `json:"name"`
+			} `json:"params"`
+		} `json:"api"`
+	}
+	if err := json.Unmarshal(b, &l); err != nil {
+		return nil, err
+	}
 
-type S3Service struct {
-	cs *CloudStackClient
-}
+	caps := &ServerCapabilities{
+		Version: c.Capability.Cloudstackversion,
+		apis:    make(map[string]map[string]bool),
+	}
+	for _, a := range l.Apis {
+		params := make(map[string]bool)
+		for _, p := range a.Params {
+			params[strings.ToLower(p.Name)] = true
+		}
+		caps.apis[strings.ToLower(a.Name)] = params
+	}
 
-func NewS3Service(cs *CloudStackClient) *S3Service {
-	return &S3Service{cs: cs}
+	cs.capabilities = caps
+	if cs.serverVersion == "" {
+		cs.serverVersion = caps.Version
+	}
+	return caps, nil
 }
 
-type SSHService struct {
-	cs *CloudStackClient
+// Returns the capabilities detected by DetectCapabilities, or nil if they are not detected
+func (cs *CloudStackClient) Capabilities() *ServerCapabilities {
+	return cs.capabilities
 }
 
-func NewSSHService(cs *CloudStackClient) *SSHService {
-	return &SSHService{cs: cs}
+// Contains the details of an API as returned by the listApis API
+type APIInfo struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Isasync     bool            `json:"isasync"`
+	Params      []*APIParamInfo `json:"params"`
 }
 
-type SecurityGroupService struct {
-	cs *CloudStackClient
+// Contains the details of an API param as returned by the listApis API
+type APIParamInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Length      int    `json:"length"`
+	Required    bool   `json:"required"`
 }
 
-func NewSecurityGroupService(cs *CloudStackClient) *SecurityGroupService {
-	return &SecurityGroupService{cs: cs}
+// A client that can call any API the server supports, including APIs that are not part of this package
+// (like plugin APIs). The params are validated using the details returned by the listApis API.
+type DynamicClient struct {
+	cs   *CloudStackClient
+	apis []*APIInfo
+	idx  map[string]*APIInfo // All APIs indexed by their name in lowercase
 }
 
-type ServiceOfferingService struct {
-	cs *CloudStackClient
+// Creates a new DynamicClient using the details returned by the listApis API of the server
+func (cs *CloudStackClient) NewDynamicClient() (*DynamicClient, error) {
+	b, err := cs.withFormat(JSONResponse).newRequest("listApis", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	return newDynamicClient(cs, b)
 }
 
-func NewServiceOfferingService(cs *CloudStackClient) *ServiceOfferingService {
-	return &ServiceOfferingService{cs: cs}
-}
+// Creates a new DynamicClient using the details from a saved listApis response, so the
+// server does not have to be queried every time
+func (cs *CloudStackClient) NewDynamicClientFromFile(file string) (*DynamicClient, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
 
-type SnapshotService struct {
-	cs *CloudStackClient
+	// The saved response is still wrapped in the listapisresponse object
+	b, err = getRawValue(b)
+	if err != nil {
+		return nil, err
+	}
+	return newDynamicClient(cs, b)
 }
 
-func NewSnapshotService(cs *CloudStackClient) *SnapshotService {
-	return &SnapshotService{cs: cs}
-}
+func newDynamicClient(cs *CloudStackClient, b json.RawMessage) (*DynamicClient, error) {
+	var r struct {
+		Apis []*APIInfo `json:"api"`
+	}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, err
+	}
 
-type StoragePoolService struct {
-	cs *CloudStackClient
+	dc := &DynamicClient{
+		cs:   cs,
+		apis: r.Apis,
+		idx:  make(map[string]*APIInfo),
+	}
+	for _, a := range r.Apis {
+		dc.idx[strings.ToLower(a.Name)] = a
+	}
+	return dc, nil
 }
 
-func NewStoragePoolService(cs *CloudStackClient) *StoragePoolService {
-	return &StoragePoolService{cs: cs}
-}
+// Saves the API details in the same format as the listApis response, so the file can
+// be used with NewDynamicClientFromFile
+func (dc *DynamicClient) SaveAPIs(file string) error {
+	var r struct {
+		ListAPIsResponse struct {
+			Count int        `json:"count"`
+			Apis  []*APIInfo `json:"api"`
+		} `json:"listapisresponse"`
+	}
+	r.ListAPIsResponse.Count = len(dc.apis)
+	r.ListAPIsResponse.Apis = dc.apis
 
-type StratosphereSSPService struct {
-	cs *CloudStackClient
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, b, 0644)
 }
 
-func NewStratosphereSSPService(cs *CloudStackClient) *StratosphereSSPService {
-	return &StratosphereSSPService{cs: cs}
+// Returns the details of the given API, or false if the server does not support it
+func (dc *DynamicClient) API(name string) (*APIInfo, bool) {
+	a, found := dc.idx[strings.ToLower(name)]
+	return a, found
 }
 
-type SwiftService struct {
-	cs *CloudStackClient
-}
+// Calls the given API after validating the params. Supported param values are string, bool, int, int64,
+// []string, map[string]string (send as key/value pairs) and []map[string]string (send as a list of maps).
+// Async APIs are waited for until they are finished or the configured AsyncTimeout is reached, in which
+// case the initial response containing the jobid is returned together with a warning.
+func (dc *DynamicClient) Call(api string, params map[string]interface{}) (map[string]interface{}, error) {
+	a, found := dc.API(api)
+	if !found {
+		return nil, fmt.Errorf("API %s is not supported by the server", api)
+	}
 
-func NewSwiftService(cs *CloudStackClient) *SwiftService {
-	return &SwiftService{cs: cs}
-}
+	u, err := a.encodeParams(params)
+	if err != nil {
+		return nil, err
+	}
 
-type SystemCapacityService struct {
-	cs *CloudStackClient
-}
+	// The response is decoded without a response type, which only works for JSON responses
+	cs := dc.cs.withFormat(JSONResponse)
+	b, err := cs.newRequest(a.Name, u)
+	if err != nil {
+		return nil, err
+	}
 
-func NewSystemCapacityService(cs *CloudStackClient) *SystemCapacityService {
-	return &SystemCapacityService{cs: cs}
-}
+	r, err := decodeGeneric(b)
+	if err != nil {
+		return nil, err
+	}
 
-type SystemVMService struct {
-	cs *CloudStackClient
-}
+	if a.Isasync {
+		jobid, ok := r["jobid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("No jobid found in the response of async API %s", a.Name)
+		}
 
-func NewSystemVMService(cs *CloudStackClient) *SystemVMService {
-	return &SystemVMService{cs: cs}
+		b, warn, err := cs.GetAsyncJobResult(jobid, cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return r, warn
+		}
+		return decodeGeneric(b)
+	}
+	return r, nil
 }
 
-type TemplateService struct {
-	cs *CloudStackClient
-}
+// Validates the params against the API details and encodes them as URL values
+func (a *APIInfo) encodeParams(params map[string]interface{}) (url.Values, error) {
+	idx := make(map[string]*APIParamInfo)
+	for _, ap := range a.Params {
+		idx[strings.ToLower(ap.Name)] = ap
+	}
 
-func NewTemplateService(cs *CloudStackClient) *TemplateService {
-	return &TemplateService{cs: cs}
-}
+	names := make([]string, 0, len(params))
+	for n := range params {
+		names = append(names, n)
+	}
+	sort.Strings(names)
 
-type UCSService struct {
-	cs *CloudStackClient
-}
+	u := url.Values{}
+	for _, n := range names {
+		ap, found := idx[strings.ToLower(n)]
+		if !found {
+			return nil, fmt.Errorf("Unknown param %s for API %s", n, a.Name)
+		}
+		if err := ap.encode(u, params[n]); err != nil {
+			return nil, fmt.Errorf("Invalid value for param %s of API %s: %v", ap.Name, a.Name, err)
+		}
+	}
 
-func NewUCSService(cs *CloudStackClient) *UCSService {
-	return &UCSService{cs: cs}
+	for _, ap := range a.Params {
+		if ap.Required && len(u[ap.Name]) == 0 && !hasMapValue(u, ap.Name) {
+			return nil, fmt.Errorf("Missing required param %s for API %s", ap.Name, a.Name)
+		}
+	}
+	return u, nil
 }
 
-type UsageService struct {
-	cs *CloudStackClient
+// Encodes the value and adds it to the URL values, after checking if it matches the param type
+func (ap *APIParamInfo) encode(u url.Values, v interface{}) error {
+	switch ap.Type {
+	case "boolean":
+		vv, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("expected a bool, got %T", v)
+		}
+		u.Set(ap.Name, strconv.FormatBool(vv))
+	case "short", "int", "integer", "long":
+		switch vv := v.(type) {
+		case int:
+			u.Set(ap.Name, strconv.Itoa(vv))
+		case int64:
+			u.Set(ap.Name, strconv.FormatInt(vv, 10))
+		default:
+			return fmt.Errorf("expected an int or int64, got %T", v)
+		}
+	case "list", "set":
+		vv, ok := v.([]string)
+		if !ok {
+			return fmt.Errorf("expected a []string, got %T", v)
+		}
+		u.Set(ap.Name, strings.Join(vv, ", "))
+	case "map":
+		switch vv := v.(type) {
+		case map[string]string:
+			keys := make([]string, 0, len(vv))
+			for k := range vv {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for i, k := range keys {
+				u.Set(fmt.Sprintf("%s[%d].key", ap.Name, i), k)
+				u.Set(fmt.Sprintf("%s[%d].value", ap.Name, i), vv[k])
+			}
+		case []map[string]string:
+			for i, m := range vv {
+				for k, mv := range m {
+					u.Set(fmt.Sprintf("%s[%d].%s", ap.Name, i, k), mv)
+				}
+			}
+		default:
+			return fmt.Errorf("expected a map[string]string or []map[string]string, got %T", v)
+		}
+	default:
+		vv, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", v)
+		}
+		if ap.Length > 0 && len(vv) > ap.Length {
+			return fmt.Errorf("value is longer than %d characters", ap.Length)
+		}
+		u.Set(ap.Name, vv)
+	}
+	return nil
 }
 
-func NewUsageService(cs *CloudStackClient) *UsageService {
-	return &UsageService{cs: cs}
+// Returns true if any map value was set for the given param
+func hasMapValue(u url.Values, name string) bool {
+	for k := range u {
+		if strings.HasPrefix(k, name+"[") {
+			return true
+		}
+	}
+	return false
 }
 
-type UserService struct {
-	cs *CloudStackClient
-}
+// How fields in a response that are not part of the response type are handled
+type UnknownFieldsMode int
 
-func NewUserService(cs *CloudStackClient) *UserService {
-	return &UserService{cs: cs}
-}
+const (
+	IgnoreUnknownFields UnknownFieldsMode = iota // Unknown fields are dropped
+	KeepUnknownFields                            // Unknown fields are kept in the Extra field of the response types
+	RejectUnknownFields                          // Responses with unknown fields return an *UnknownFieldsError
+)
 
-type VLANService struct {
-	cs *CloudStackClient
+// Returned when a response contains fields that are not part of the response type, and the client is
+// configured to return an error for them
+type UnknownFieldsError struct {
+	API    string
+	Fields []string
 }
 
-func NewVLANService(cs *CloudStackClient) *VLANService {
-	return &VLANService{cs: cs}
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("Response of API %s contains unknown fields: %s", e.API, strings.Join(e.Fields, ", "))
 }
 
-type VMGroupService struct {
-	cs *CloudStackClient
-}
+var (
+	extraType       = reflect.TypeOf(map[string]json.RawMessage{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
 
-func NewVMGroupService(cs *CloudStackClient) *VMGroupService {
-	return &VMGroupService{cs: cs}
-}
+// Decodes a response and handles the fields that are not part of the response type as configured
+func (cs *CloudStackClient) unmarshal(api string, b json.RawMessage, v interface{}) error {
+	if isXML(b) {
+		unknown, err := decodeXML(b, v, cs.unknownFields == KeepUnknownFields)
+		if err != nil {
+			return err
+		}
+		if cs.unknownFields == RejectUnknownFields && len(unknown) > 0 {
+			return &UnknownFieldsError{API: api, Fields: unknown}
+		}
+		return nil
+	}
 
-type VPCService struct {
-	cs *CloudStackClient
-}
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	if cs.unknownFields == IgnoreUnknownFields {
+		return nil
+	}
 
-func NewVPCService(cs *CloudStackClient) *VPCService {
-	return &VPCService{cs: cs}
+	unknown := unknownFields(b, reflect.ValueOf(v), "", cs.unknownFields == KeepUnknownFields)
+	if cs.unknownFields == RejectUnknownFields && len(unknown) > 0 {
+		return &UnknownFieldsError{API: api, Fields: unknown}
+	}
+	return nil
 }
 
-type VPNService struct {
-	cs *CloudStackClient
+// Decodes an element of a streamed 'list' response like unmarshal. The names of the unknown fields start
+// with the field of the list (like 'virtualmachine.newfield'), the same as when decoding the whole response.
+func (cs *CloudStackClient) unmarshalElement(api string, field string, b json.RawMessage, v interface{}) error {
+	err := cs.unmarshal(api, b, v)
+	if e, ok := err.(*UnknownFieldsError); ok {
+		for i, f := range e.Fields {
+			e.Fields[i] = field + "." + f
+		}
+	}
+	return err
 }
 
-func NewVPNService(cs *CloudStackClient) *VPNService {
-	return &VPNService{cs: cs}
-}
+// Returns the (sorted and unique) names of all fields in b that are not part of the type of v, like
+// 'virtualmachine.nic.newfield'. If keep is true, the unknown fields are stored in the Extra field of v.
+func unknownFields(b json.RawMessage, v reflect.Value, path string, keep bool) []string {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if reflect.PtrTo(v.Type()).Implements(unmarshalerType) {
+		return nil
+	}
 
-type VirtualMachineService struct {
-	cs *CloudStackClient
-}
+	found := make(map[string]bool)
+	switch v.Kind() {
+	case reflect.Slice:
+		var items []json.RawMessage
+		if err := json.Unmarshal(b, &items); err != nil {
+			return nil
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			for _, f := range unknownFields(items[i], v.Index(i), path, keep) {
+				found[f] = true
+			}
+		}
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil
+		}
 
-func NewVirtualMachineService(cs *CloudStackClient) *VirtualMachineService {
-	return &VirtualMachineService{cs: cs}
-}
+		// Like the decoder, match the names of the fields case-insensitive
+		known := make(map[string]int)
+		extra := -1
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.Name == "Extra" && f.Type == extraType {
+				extra = i
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "" {
+				name = f.Name
+			}
+			known[strings.ToLower(name)] = i
+		}
 
-type VolumeService struct {
-	cs *CloudStackClient
-}
+		for name, raw := range fields {
+			i, ok := known[strings.ToLower(name)]
+			if ok {
+				for _, f := range unknownFields(raw, v.Field(i), path+name+".", keep) {
+					found[f] = true
+				}
+				continue
+			}
+			found[path+name] = true
+			if keep && extra != -1 {
+				if v.Field(extra).IsNil() {
+					v.Field(extra).Set(reflect.MakeMap(extraType))
+				}
+				v.Field(extra).SetMapIndex(reflect.ValueOf(name), reflect.ValueOf(raw))
+			}
+		}
+	}
 
-func NewVolumeService(cs *CloudStackClient) *VolumeService {
-	return &VolumeService{cs: cs}
+	unknown := make([]string, 0, len(found))
+	for f := range found {
+		unknown = append(unknown, f)
+	}
+	sort.Strings(unknown)
+	return unknown
 }
 
-type ZoneService struct {
-	cs *CloudStackClient
-}
+// Decodes a response without knowing its type, keeping numbers as json.Number
+func decodeGeneric(b json.RawMessage) (map[string]interface{}, error) {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
 
-func NewZoneService(cs *CloudStackClient) *ZoneService {
-	return &ZoneService{cs: cs}
+	var r map[string]interface{}
+	if err := d.Decode(&r); err != nil {
+		return nil, err
+	}
+	return r, nil
 }
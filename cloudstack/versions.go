@@ -0,0 +1,799 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// The CloudStack versions supported by this package, oldest first
+var Versions = []string{"4.3", "4.4"}
+
+// The versions supporting an API, and the versions supporting each of its params that is not
+// supported by all of them
+type apiSupport struct {
+	versions []string
+	params   map[string][]string
+}
+
+var apiVersions = map[string]*apiSupport{
+	"activateProject":                {[]string{"4.3", "4.4"}, nil},
+	"addAccountToProject":            {[]string{"4.3", "4.4"}, nil},
+	"addBaremetalDhcp":               {[]string{"4.3", "4.4"}, nil},
+	"addBaremetalHost":               {[]string{"4.3", "4.4"}, nil},
+	"addBaremetalPxeKickStartServer": {[]string{"4.3", "4.4"}, nil},
+	"addBaremetalPxePingServer":      {[]string{"4.3", "4.4"}, nil},
+	"addBigSwitchVnsDevice":          {[]string{"4.3", "4.4"}, nil},
+	"addCiscoAsa1000vResource":       {[]string{"4.3"}, nil},
+	"addCiscoVnmcResource":           {[]string{"4.3"}, nil},
+	"addCluster":                     {[]string{"4.3", "4.4"}, nil},
+	"addExternalFirewall":            {[]string{"4.3"}, nil},
+	"addExternalLoadBalancer":        {[]string{"4.3"}, nil},
+	"addF5LoadBalancer":              {[]string{"4.3"}, nil},
+	"addGuestOs":                     {[]string{"4.4"}, nil},
+	"addGuestOsMapping":              {[]string{"4.4"}, nil},
+	"addHost":                        {[]string{"4.3", "4.4"}, nil},
+	"addImageStore":                  {[]string{"4.3", "4.4"}, nil},
+	"addIpToNic":                     {[]string{"4.3", "4.4"}, nil},
+	"addLdapConfiguration":           {[]string{"4.3", "4.4"}, nil},
+	"addNetscalerLoadBalancer":       {[]string{"4.3", "4.4"}, nil},
+	"addNetworkDevice":               {[]string{"4.3", "4.4"}, nil},
+	"addNetworkServiceProvider":      {[]string{"4.3", "4.4"}, nil},
+	"addNicToVirtualMachine":         {[]string{"4.3", "4.4"}, nil},
+	"addNiciraNvpDevice":             {[]string{"4.3", "4.4"}, nil},
+	"addPaloAltoFirewall":            {[]string{"4.3", "4.4"}, nil},
+	"addRegion":                      {[]string{"4.3", "4.4"}, nil},
+	"addResourceDetail": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"addS3":                          {[]string{"4.3", "4.4"}, nil},
+	"addSecondaryStorage":            {[]string{"4.3", "4.4"}, nil},
+	"addSrxFirewall":                 {[]string{"4.3"}, nil},
+	"addStratosphereSsp":             {[]string{"4.3", "4.4"}, nil},
+	"addSwift":                       {[]string{"4.3", "4.4"}, nil},
+	"addTrafficMonitor":              {[]string{"4.3", "4.4"}, nil},
+	"addTrafficType":                 {[]string{"4.3", "4.4"}, nil},
+	"addUcsManager":                  {[]string{"4.3", "4.4"}, nil},
+	"addVmwareDc":                    {[]string{"4.3"}, nil},
+	"addVpnUser":                     {[]string{"4.3", "4.4"}, nil},
+	"archiveAlerts":                  {[]string{"4.3", "4.4"}, nil},
+	"archiveEvents":                  {[]string{"4.3", "4.4"}, nil},
+	"assignCertToLoadBalancer":       {[]string{"4.3", "4.4"}, nil},
+	"assignToGlobalLoadBalancerRule": {[]string{"4.3", "4.4"}, nil},
+	"assignToLoadBalancerRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"vmidipmap": {"4.4"},
+	}},
+	"assignVirtualMachine": {[]string{"4.3", "4.4"}, nil},
+	"associateIpAddress": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"associateUcsProfileToBlade":           {[]string{"4.3", "4.4"}, nil},
+	"attachIso":                            {[]string{"4.3", "4.4"}, nil},
+	"attachVolume":                         {[]string{"4.3", "4.4"}, nil},
+	"authorizeSecurityGroupEgress":         {[]string{"4.3", "4.4"}, nil},
+	"authorizeSecurityGroupIngress":        {[]string{"4.3", "4.4"}, nil},
+	"cancelHostMaintenance":                {[]string{"4.3", "4.4"}, nil},
+	"cancelStorageMaintenance":             {[]string{"4.3", "4.4"}, nil},
+	"changeServiceForRouter":               {[]string{"4.3", "4.4"}, nil},
+	"changeServiceForSystemVm":             {[]string{"4.3", "4.4"}, nil},
+	"changeServiceForVirtualMachine":       {[]string{"4.3", "4.4"}, nil},
+	"cleanVMReservations":                  {[]string{"4.3", "4.4"}, nil},
+	"configureF5LoadBalancer":              {[]string{"4.3"}, nil},
+	"configureInternalLoadBalancerElement": {[]string{"4.3", "4.4"}, nil},
+	"configureNetscalerLoadBalancer":       {[]string{"4.3", "4.4"}, nil},
+	"configureOvsElement":                  {[]string{"4.4"}, nil},
+	"configurePaloAltoFirewall":            {[]string{"4.3", "4.4"}, nil},
+	"configureSrxFirewall":                 {[]string{"4.3"}, nil},
+	"configureVirtualRouterElement":        {[]string{"4.3", "4.4"}, nil},
+	"copyIso":                              {[]string{"4.3", "4.4"}, nil},
+	"copyTemplate":                         {[]string{"4.3", "4.4"}, nil},
+	"createAccount":                        {[]string{"4.3", "4.4"}, nil},
+	"createAffinityGroup":                  {[]string{"4.3", "4.4"}, nil},
+	"createAutoScalePolicy":                {[]string{"4.3", "4.4"}, nil},
+	"createAutoScaleVmGroup": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createAutoScaleVmProfile": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createCondition":    {[]string{"4.3", "4.4"}, nil},
+	"createCounter":      {[]string{"4.3", "4.4"}, nil},
+	"createDiskOffering": {[]string{"4.3", "4.4"}, nil},
+	"createDomain":       {[]string{"4.3", "4.4"}, nil},
+	"createEgressFirewallRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createFirewallRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createGlobalLoadBalancerRule":      {[]string{"4.3", "4.4"}, nil},
+	"createInstanceGroup":               {[]string{"4.3", "4.4"}, nil},
+	"createInternalLoadBalancerElement": {[]string{"4.3", "4.4"}, nil},
+	"createIpForwardingRule":            {[]string{"4.3", "4.4"}, nil},
+	"createLBHealthCheckPolicy": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createLBStickinessPolicy": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createLoadBalancer": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createLoadBalancerRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createNetwork": {[]string{"4.3", "4.4"}, nil},
+	"createNetworkACL": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createNetworkACLList": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createNetworkOffering": {[]string{"4.3", "4.4"}, nil},
+	"createPhysicalNetwork": {[]string{"4.3", "4.4"}, nil},
+	"createPod":             {[]string{"4.3", "4.4"}, nil},
+	"createPortForwardingRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createPortableIpRange": {[]string{"4.3", "4.4"}, nil},
+	"createPrivateGateway":  {[]string{"4.3", "4.4"}, nil},
+	"createProject":         {[]string{"4.3", "4.4"}, nil},
+	"createRemoteAccessVpn": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createSSHKeyPair":            {[]string{"4.3", "4.4"}, nil},
+	"createSecondaryStagingStore": {[]string{"4.3", "4.4"}, nil},
+	"createSecurityGroup":         {[]string{"4.3", "4.4"}, nil},
+	"createServiceOffering": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customizediops":            {"4.4"},
+		"hypervisorsnapshotreserve": {"4.4"},
+		"maxiops":                   {"4.4"},
+		"miniops":                   {"4.4"},
+	}},
+	"createSnapshot": {[]string{"4.3", "4.4"}, nil},
+	"createSnapshotPolicy": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createStaticRoute":           {[]string{"4.3", "4.4"}, nil},
+	"createStorageNetworkIpRange": {[]string{"4.3", "4.4"}, nil},
+	"createStoragePool":           {[]string{"4.3", "4.4"}, nil},
+	"createTags":                  {[]string{"4.3", "4.4"}, nil},
+	"createTemplate":              {[]string{"4.3", "4.4"}, nil},
+	"createUser":                  {[]string{"4.3", "4.4"}, nil},
+	"createVMSnapshot":            {[]string{"4.3", "4.4"}, nil},
+	"createVPC": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createVPCOffering": {[]string{"4.3", "4.4"}, map[string][]string{
+		"servicecapabilitylist": {"4.4"},
+	}},
+	"createVirtualRouterElement": {[]string{"4.3", "4.4"}, nil},
+	"createVlanIpRange":          {[]string{"4.3", "4.4"}, nil},
+	"createVolume": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid": {"4.4"},
+	}},
+	"createVpnConnection": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createVpnCustomerGateway": {[]string{"4.3", "4.4"}, nil},
+	"createVpnGateway": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"createZone":                   {[]string{"4.3", "4.4"}, nil},
+	"dedicateCluster":              {[]string{"4.3", "4.4"}, nil},
+	"dedicateGuestVlanRange":       {[]string{"4.3", "4.4"}, nil},
+	"dedicateHost":                 {[]string{"4.3", "4.4"}, nil},
+	"dedicatePod":                  {[]string{"4.3", "4.4"}, nil},
+	"dedicatePublicIpRange":        {[]string{"4.3", "4.4"}, nil},
+	"dedicateZone":                 {[]string{"4.3", "4.4"}, nil},
+	"deleteAccount":                {[]string{"4.3", "4.4"}, nil},
+	"deleteAccountFromProject":     {[]string{"4.3", "4.4"}, nil},
+	"deleteAffinityGroup":          {[]string{"4.3", "4.4"}, nil},
+	"deleteAlerts":                 {[]string{"4.3", "4.4"}, nil},
+	"deleteAutoScalePolicy":        {[]string{"4.3", "4.4"}, nil},
+	"deleteAutoScaleVmGroup":       {[]string{"4.3", "4.4"}, nil},
+	"deleteAutoScaleVmProfile":     {[]string{"4.3", "4.4"}, nil},
+	"deleteBigSwitchVnsDevice":     {[]string{"4.3", "4.4"}, nil},
+	"deleteCiscoAsa1000vResource":  {[]string{"4.3"}, nil},
+	"deleteCiscoNexusVSM":          {[]string{"4.3"}, nil},
+	"deleteCiscoVnmcResource":      {[]string{"4.3"}, nil},
+	"deleteCluster":                {[]string{"4.3", "4.4"}, nil},
+	"deleteCondition":              {[]string{"4.3", "4.4"}, nil},
+	"deleteCounter":                {[]string{"4.3", "4.4"}, nil},
+	"deleteDiskOffering":           {[]string{"4.3", "4.4"}, nil},
+	"deleteDomain":                 {[]string{"4.3", "4.4"}, nil},
+	"deleteEgressFirewallRule":     {[]string{"4.3", "4.4"}, nil},
+	"deleteEvents":                 {[]string{"4.3", "4.4"}, nil},
+	"deleteExternalFirewall":       {[]string{"4.3"}, nil},
+	"deleteExternalLoadBalancer":   {[]string{"4.3"}, nil},
+	"deleteF5LoadBalancer":         {[]string{"4.3"}, nil},
+	"deleteFirewallRule":           {[]string{"4.3", "4.4"}, nil},
+	"deleteGlobalLoadBalancerRule": {[]string{"4.3", "4.4"}, nil},
+	"deleteHost":                   {[]string{"4.3", "4.4"}, nil},
+	"deleteImageStore":             {[]string{"4.3", "4.4"}, nil},
+	"deleteInstanceGroup":          {[]string{"4.3", "4.4"}, nil},
+	"deleteIpForwardingRule":       {[]string{"4.3", "4.4"}, nil},
+	"deleteIso":                    {[]string{"4.3", "4.4"}, nil},
+	"deleteLBHealthCheckPolicy":    {[]string{"4.3", "4.4"}, nil},
+	"deleteLBStickinessPolicy":     {[]string{"4.3", "4.4"}, nil},
+	"deleteLdapConfiguration":      {[]string{"4.3", "4.4"}, nil},
+	"deleteLoadBalancer":           {[]string{"4.3", "4.4"}, nil},
+	"deleteLoadBalancerRule":       {[]string{"4.3", "4.4"}, nil},
+	"deleteNetscalerLoadBalancer":  {[]string{"4.3", "4.4"}, nil},
+	"deleteNetwork":                {[]string{"4.3", "4.4"}, nil},
+	"deleteNetworkACL":             {[]string{"4.3", "4.4"}, nil},
+	"deleteNetworkACLList":         {[]string{"4.3", "4.4"}, nil},
+	"deleteNetworkDevice":          {[]string{"4.3", "4.4"}, nil},
+	"deleteNetworkOffering":        {[]string{"4.3", "4.4"}, nil},
+	"deleteNetworkServiceProvider": {[]string{"4.3", "4.4"}, nil},
+	"deleteNiciraNvpDevice":        {[]string{"4.3", "4.4"}, nil},
+	"deletePaloAltoFirewall":       {[]string{"4.3", "4.4"}, nil},
+	"deletePhysicalNetwork":        {[]string{"4.3", "4.4"}, nil},
+	"deletePod":                    {[]string{"4.3", "4.4"}, nil},
+	"deletePortForwardingRule":     {[]string{"4.3", "4.4"}, nil},
+	"deletePortableIpRange":        {[]string{"4.3", "4.4"}, nil},
+	"deletePrivateGateway":         {[]string{"4.3", "4.4"}, nil},
+	"deleteProject":                {[]string{"4.3", "4.4"}, nil},
+	"deleteProjectInvitation":      {[]string{"4.3", "4.4"}, nil},
+	"deleteRemoteAccessVpn":        {[]string{"4.3", "4.4"}, nil},
+	"deleteSSHKeyPair":             {[]string{"4.3", "4.4"}, nil},
+	"deleteSecondaryStagingStore":  {[]string{"4.3", "4.4"}, nil},
+	"deleteSecurityGroup":          {[]string{"4.3", "4.4"}, nil},
+	"deleteServiceOffering":        {[]string{"4.3", "4.4"}, nil},
+	"deleteSnapshot":               {[]string{"4.3", "4.4"}, nil},
+	"deleteSnapshotPolicies":       {[]string{"4.3", "4.4"}, nil},
+	"deleteSrxFirewall":            {[]string{"4.3"}, nil},
+	"deleteSslCert":                {[]string{"4.3", "4.4"}, nil},
+	"deleteStaticRoute":            {[]string{"4.3", "4.4"}, nil},
+	"deleteStorageNetworkIpRange":  {[]string{"4.3", "4.4"}, nil},
+	"deleteStoragePool":            {[]string{"4.3", "4.4"}, nil},
+	"deleteTags":                   {[]string{"4.3", "4.4"}, nil},
+	"deleteTemplate":               {[]string{"4.3", "4.4"}, nil},
+	"deleteTrafficMonitor":         {[]string{"4.3", "4.4"}, nil},
+	"deleteTrafficType":            {[]string{"4.3", "4.4"}, nil},
+	"deleteUcsManager":             {[]string{"4.3"}, nil},
+	"deleteUser":                   {[]string{"4.3", "4.4"}, nil},
+	"deleteVMSnapshot":             {[]string{"4.3", "4.4"}, nil},
+	"deleteVPC":                    {[]string{"4.3", "4.4"}, nil},
+	"deleteVPCOffering":            {[]string{"4.3", "4.4"}, nil},
+	"deleteVlanIpRange":            {[]string{"4.3", "4.4"}, nil},
+	"deleteVolume":                 {[]string{"4.3", "4.4"}, nil},
+	"deleteVpnConnection":          {[]string{"4.3", "4.4"}, nil},
+	"deleteVpnCustomerGateway":     {[]string{"4.3", "4.4"}, nil},
+	"deleteVpnGateway":             {[]string{"4.3", "4.4"}, nil},
+	"deleteZone":                   {[]string{"4.3", "4.4"}, nil},
+	"deployVirtualMachine": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":          {"4.4"},
+		"deploymentplanner": {"4.4"},
+		"rootdisksize":      {"4.4"},
+	}},
+	"destroyRouter":                   {[]string{"4.3", "4.4"}, nil},
+	"destroySystemVm":                 {[]string{"4.3", "4.4"}, nil},
+	"destroyVirtualMachine":           {[]string{"4.3", "4.4"}, nil},
+	"detachIso":                       {[]string{"4.3", "4.4"}, nil},
+	"detachVolume":                    {[]string{"4.3", "4.4"}, nil},
+	"disableAccount":                  {[]string{"4.3", "4.4"}, nil},
+	"disableAutoScaleVmGroup":         {[]string{"4.3", "4.4"}, nil},
+	"disableCiscoNexusVSM":            {[]string{"4.3"}, nil},
+	"disableStaticNat":                {[]string{"4.3", "4.4"}, nil},
+	"disableUser":                     {[]string{"4.3", "4.4"}, nil},
+	"disassociateIpAddress":           {[]string{"4.3", "4.4"}, nil},
+	"disassociateUcsProfileFromBlade": {[]string{"4.3"}, nil},
+	"enableAccount":                   {[]string{"4.3", "4.4"}, nil},
+	"enableAutoScaleVmGroup":          {[]string{"4.3", "4.4"}, nil},
+	"enableCiscoNexusVSM":             {[]string{"4.3"}, nil},
+	"enableStaticNat":                 {[]string{"4.3", "4.4"}, nil},
+	"enableStorageMaintenance":        {[]string{"4.3", "4.4"}, nil},
+	"enableUser":                      {[]string{"4.3", "4.4"}, nil},
+	"expungeVirtualMachine":           {[]string{"4.3", "4.4"}, nil},
+	"extractIso":                      {[]string{"4.3", "4.4"}, nil},
+	"extractTemplate":                 {[]string{"4.3", "4.4"}, nil},
+	"extractVolume":                   {[]string{"4.3", "4.4"}, nil},
+	"findHostsForMigration":           {[]string{"4.3", "4.4"}, nil},
+	"findStoragePoolsForMigration":    {[]string{"4.3", "4.4"}, nil},
+	"generateAlert":                   {[]string{"4.3", "4.4"}, nil},
+	"generateUsageRecords":            {[]string{"4.3", "4.4"}, nil},
+	"getApiLimit":                     {[]string{"4.3", "4.4"}, nil},
+	"getCloudIdentifier":              {[]string{"4.3", "4.4"}, nil},
+	"getUser":                         {[]string{"4.3", "4.4"}, nil},
+	"getVMPassword":                   {[]string{"4.3", "4.4"}, nil},
+	"getVirtualMachineUserData":       {[]string{"4.4"}, nil},
+	"importLdapUsers":                 {[]string{"4.3", "4.4"}, nil},
+	"instantiateUcsTemplateAndAssocaciateToBlade": {[]string{"4.3"}, nil},
+	"ldapCreateAccount":                           {[]string{"4.3", "4.4"}, nil},
+	"listAccounts":                                {[]string{"4.3", "4.4"}, nil},
+	"listAffinityGroupTypes":                      {[]string{"4.3", "4.4"}, nil},
+	"listAffinityGroups":                          {[]string{"4.3", "4.4"}, nil},
+	"listAlerts":                                  {[]string{"4.3", "4.4"}, nil},
+	"listApis":                                    {[]string{"4.3", "4.4"}, nil},
+	"listAsyncJobs":                               {[]string{"4.3", "4.4"}, nil},
+	"listAutoScalePolicies":                       {[]string{"4.3", "4.4"}, nil},
+	"listAutoScaleVmGroups": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listAutoScaleVmProfiles": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay":        {"4.4"},
+		"serviceofferingid": {"4.4"},
+		"zoneid":            {"4.4"},
+	}},
+	"listBaremetalDhcp":            {[]string{"4.3", "4.4"}, nil},
+	"listBaremetalPxeServers":      {[]string{"4.3", "4.4"}, nil},
+	"listBigSwitchVnsDevices":      {[]string{"4.3", "4.4"}, nil},
+	"listCapabilities":             {[]string{"4.3", "4.4"}, nil},
+	"listCapacity":                 {[]string{"4.3", "4.4"}, nil},
+	"listCiscoAsa1000vResources":   {[]string{"4.3"}, nil},
+	"listCiscoNexusVSMs":           {[]string{"4.3"}, nil},
+	"listCiscoVnmcResources":       {[]string{"4.3"}, nil},
+	"listClusters":                 {[]string{"4.3", "4.4"}, nil},
+	"listConditions":               {[]string{"4.3", "4.4"}, nil},
+	"listConfigurations":           {[]string{"4.3", "4.4"}, nil},
+	"listCounters":                 {[]string{"4.3", "4.4"}, nil},
+	"listDedicatedClusters":        {[]string{"4.3", "4.4"}, nil},
+	"listDedicatedGuestVlanRanges": {[]string{"4.3", "4.4"}, nil},
+	"listDedicatedHosts":           {[]string{"4.3", "4.4"}, nil},
+	"listDedicatedPods":            {[]string{"4.3", "4.4"}, nil},
+	"listDedicatedZones":           {[]string{"4.3", "4.4"}, nil},
+	"listDeploymentPlanners":       {[]string{"4.3", "4.4"}, nil},
+	"listDiskOfferings":            {[]string{"4.3", "4.4"}, nil},
+	"listDomainChildren":           {[]string{"4.3", "4.4"}, nil},
+	"listDomains":                  {[]string{"4.3", "4.4"}, nil},
+	"listEgressFirewallRules": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listEventTypes":             {[]string{"4.3", "4.4"}, nil},
+	"listEvents":                 {[]string{"4.3", "4.4"}, nil},
+	"listExternalFirewalls":      {[]string{"4.3"}, nil},
+	"listExternalLoadBalancers":  {[]string{"4.3"}, nil},
+	"listF5LoadBalancerNetworks": {[]string{"4.3"}, nil},
+	"listF5LoadBalancers":        {[]string{"4.3"}, nil},
+	"listFirewallRules": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listGlobalLoadBalancerRules":      {[]string{"4.3", "4.4"}, nil},
+	"listGuestOsMapping":               {[]string{"4.4"}, nil},
+	"listHosts":                        {[]string{"4.3", "4.4"}, nil},
+	"listHypervisorCapabilities":       {[]string{"4.3", "4.4"}, nil},
+	"listHypervisors":                  {[]string{"4.3", "4.4"}, nil},
+	"listImageStores":                  {[]string{"4.3", "4.4"}, nil},
+	"listInstanceGroups":               {[]string{"4.3", "4.4"}, nil},
+	"listInternalLoadBalancerElements": {[]string{"4.3", "4.4"}, nil},
+	"listInternalLoadBalancerVMs":      {[]string{"4.3", "4.4"}, nil},
+	"listIpForwardingRules":            {[]string{"4.3", "4.4"}, nil},
+	"listIsoPermissions":               {[]string{"4.3", "4.4"}, nil},
+	"listIsos":                         {[]string{"4.3", "4.4"}, nil},
+	"listLBHealthCheckPolicies": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+		"id":         {"4.4"},
+	}},
+	"listLBStickinessPolicies": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+		"id":         {"4.4"},
+	}},
+	"listLdapConfigurations": {[]string{"4.3", "4.4"}, nil},
+	"listLdapUsers":          {[]string{"4.3", "4.4"}, nil},
+	"listLoadBalancerRuleInstances": {[]string{"4.3", "4.4"}, map[string][]string{
+		"lbvmips": {"4.4"},
+	}},
+	"listLoadBalancerRules": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listLoadBalancers": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listNetscalerLoadBalancerNetworks": {[]string{"4.3", "4.4"}, nil},
+	"listNetscalerLoadBalancers":        {[]string{"4.3", "4.4"}, nil},
+	"listNetworkACLLists": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listNetworkACLs": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listNetworkDevice":           {[]string{"4.3", "4.4"}, nil},
+	"listNetworkIsolationMethods": {[]string{"4.3", "4.4"}, nil},
+	"listNetworkOfferings":        {[]string{"4.3", "4.4"}, nil},
+	"listNetworkServiceProviders": {[]string{"4.3", "4.4"}, nil},
+	"listNetworks": {[]string{"4.3", "4.4"}, map[string][]string{
+		"displaynetwork": {"4.4"},
+	}},
+	"listNiciraNvpDeviceNetworks": {[]string{"4.3", "4.4"}, nil},
+	"listNiciraNvpDevices":        {[]string{"4.3", "4.4"}, nil},
+	"listNics": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+		"networkid":  {"4.4"},
+	}},
+	"listOsCategories":             {[]string{"4.3", "4.4"}, nil},
+	"listOsTypes":                  {[]string{"4.3", "4.4"}, nil},
+	"listOvsElements":              {[]string{"4.4"}, nil},
+	"listPaloAltoFirewallNetworks": {[]string{"4.3", "4.4"}, nil},
+	"listPaloAltoFirewalls":        {[]string{"4.3", "4.4"}, nil},
+	"listPhysicalNetworks":         {[]string{"4.3", "4.4"}, nil},
+	"listPods":                     {[]string{"4.3", "4.4"}, nil},
+	"listPortForwardingRules": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listPortableIpRanges":   {[]string{"4.3", "4.4"}, nil},
+	"listPrivateGateways":    {[]string{"4.3", "4.4"}, nil},
+	"listProjectAccounts":    {[]string{"4.3", "4.4"}, nil},
+	"listProjectInvitations": {[]string{"4.3", "4.4"}, nil},
+	"listProjects":           {[]string{"4.3", "4.4"}, nil},
+	"listPublicIpAddresses": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listRegions": {[]string{"4.3", "4.4"}, nil},
+	"listRemoteAccessVpns": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listResourceDetails": {[]string{"4.3", "4.4"}, map[string][]string{
+		"value": {"4.4"},
+	}},
+	"listResourceLimits":         {[]string{"4.3", "4.4"}, nil},
+	"listRouters":                {[]string{"4.3", "4.4"}, nil},
+	"listS3s":                    {[]string{"4.3", "4.4"}, nil},
+	"listSSHKeyPairs":            {[]string{"4.3", "4.4"}, nil},
+	"listSecondaryStagingStores": {[]string{"4.3", "4.4"}, nil},
+	"listSecurityGroups":         {[]string{"4.3", "4.4"}, nil},
+	"listServiceOfferings":       {[]string{"4.3", "4.4"}, nil},
+	"listSnapshotPolicies": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+		"id":         {"4.4"},
+	}},
+	"listSnapshots":           {[]string{"4.3", "4.4"}, nil},
+	"listSrxFirewallNetworks": {[]string{"4.3"}, nil},
+	"listSrxFirewalls":        {[]string{"4.3"}, nil},
+	"listSslCerts": {[]string{"4.3", "4.4"}, map[string][]string{
+		"projectid": {"4.4"},
+	}},
+	"listStaticRoutes":             {[]string{"4.3", "4.4"}, nil},
+	"listStorageNetworkIpRange":    {[]string{"4.3", "4.4"}, nil},
+	"listStoragePools":             {[]string{"4.3", "4.4"}, nil},
+	"listStorageProviders":         {[]string{"4.3", "4.4"}, nil},
+	"listSupportedNetworkServices": {[]string{"4.3", "4.4"}, nil},
+	"listSwifts":                   {[]string{"4.3", "4.4"}, nil},
+	"listSystemVms":                {[]string{"4.3", "4.4"}, nil},
+	"listTags":                     {[]string{"4.3", "4.4"}, nil},
+	"listTemplatePermissions":      {[]string{"4.3", "4.4"}, nil},
+	"listTemplates":                {[]string{"4.3", "4.4"}, nil},
+	"listTrafficMonitors":          {[]string{"4.3", "4.4"}, nil},
+	"listTrafficTypeImplementors":  {[]string{"4.3", "4.4"}, nil},
+	"listTrafficTypes":             {[]string{"4.3", "4.4"}, nil},
+	"listUcsBlades":                {[]string{"4.3", "4.4"}, nil},
+	"listUcsManagers":              {[]string{"4.3", "4.4"}, nil},
+	"listUcsProfiles":              {[]string{"4.3", "4.4"}, nil},
+	"listUcsTemplates":             {[]string{"4.3"}, nil},
+	"listUsageRecords":             {[]string{"4.3", "4.4"}, nil},
+	"listUsageTypes":               {[]string{"4.3", "4.4"}, nil},
+	"listUsers":                    {[]string{"4.3", "4.4"}, nil},
+	"listVMSnapshot":               {[]string{"4.3", "4.4"}, nil},
+	"listVPCOfferings":             {[]string{"4.3", "4.4"}, nil},
+	"listVPCs": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listVirtualMachines": {[]string{"4.3", "4.4"}, map[string][]string{
+		"displayvm":         {"4.4"},
+		"ids":               {"4.4"},
+		"serviceofferingid": {"4.4"},
+	}},
+	"listVirtualRouterElements": {[]string{"4.3", "4.4"}, nil},
+	"listVlanIpRanges":          {[]string{"4.3", "4.4"}, nil},
+	"listVmwareDcs":             {[]string{"4.3"}, nil},
+	"listVolumes": {[]string{"4.3", "4.4"}, map[string][]string{
+		"diskofferingid": {"4.4"},
+		"displayvolume":  {"4.4"},
+	}},
+	"listVpnConnections": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listVpnCustomerGateways": {[]string{"4.3", "4.4"}, nil},
+	"listVpnGateways": {[]string{"4.3", "4.4"}, map[string][]string{
+		"fordisplay": {"4.4"},
+	}},
+	"listVpnUsers":                     {[]string{"4.3", "4.4"}, nil},
+	"listZones":                        {[]string{"4.3", "4.4"}, nil},
+	"lockAccount":                      {[]string{"4.3", "4.4"}, nil},
+	"lockUser":                         {[]string{"4.3", "4.4"}, nil},
+	"markDefaultZoneForAccount":        {[]string{"4.3", "4.4"}, nil},
+	"migrateSystemVm":                  {[]string{"4.3", "4.4"}, nil},
+	"migrateVirtualMachine":            {[]string{"4.3", "4.4"}, nil},
+	"migrateVirtualMachineWithVolume":  {[]string{"4.3", "4.4"}, nil},
+	"migrateVolume":                    {[]string{"4.3", "4.4"}, nil},
+	"prepareHostForMaintenance":        {[]string{"4.3", "4.4"}, nil},
+	"prepareTemplate":                  {[]string{"4.3", "4.4"}, nil},
+	"queryAsyncJobResult":              {[]string{"4.3", "4.4"}, nil},
+	"rebootRouter":                     {[]string{"4.3", "4.4"}, nil},
+	"rebootSystemVm":                   {[]string{"4.3", "4.4"}, nil},
+	"rebootVirtualMachine":             {[]string{"4.3", "4.4"}, nil},
+	"reconnectHost":                    {[]string{"4.3", "4.4"}, nil},
+	"recoverVirtualMachine":            {[]string{"4.3", "4.4"}, nil},
+	"refreshUcsBlades":                 {[]string{"4.3"}, nil},
+	"registerIso":                      {[]string{"4.3", "4.4"}, nil},
+	"registerSSHKeyPair":               {[]string{"4.3", "4.4"}, nil},
+	"registerTemplate":                 {[]string{"4.3", "4.4"}, nil},
+	"registerUserKeys":                 {[]string{"4.3", "4.4"}, nil},
+	"releaseDedicatedCluster":          {[]string{"4.3", "4.4"}, nil},
+	"releaseDedicatedGuestVlanRange":   {[]string{"4.3", "4.4"}, nil},
+	"releaseDedicatedHost":             {[]string{"4.3", "4.4"}, nil},
+	"releaseDedicatedPod":              {[]string{"4.3", "4.4"}, nil},
+	"releaseDedicatedZone":             {[]string{"4.3", "4.4"}, nil},
+	"releaseHostReservation":           {[]string{"4.3", "4.4"}, nil},
+	"releasePublicIpRange":             {[]string{"4.3", "4.4"}, nil},
+	"removeCertFromLoadBalancer":       {[]string{"4.3", "4.4"}, nil},
+	"removeFromGlobalLoadBalancerRule": {[]string{"4.3", "4.4"}, nil},
+	"removeFromLoadBalancerRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"vmidipmap": {"4.4"},
+	}},
+	"removeGuestOs":                  {[]string{"4.4"}, nil},
+	"removeGuestOsMapping":           {[]string{"4.4"}, nil},
+	"removeIpFromNic":                {[]string{"4.3", "4.4"}, nil},
+	"removeNicFromVirtualMachine":    {[]string{"4.3", "4.4"}, nil},
+	"removeRegion":                   {[]string{"4.3", "4.4"}, nil},
+	"removeResourceDetail":           {[]string{"4.3", "4.4"}, nil},
+	"removeVmwareDc":                 {[]string{"4.3"}, nil},
+	"removeVpnUser":                  {[]string{"4.3", "4.4"}, nil},
+	"replaceNetworkACLList":          {[]string{"4.3", "4.4"}, nil},
+	"resetApiLimit":                  {[]string{"4.3", "4.4"}, nil},
+	"resetPasswordForVirtualMachine": {[]string{"4.3", "4.4"}, nil},
+	"resetSSHKeyForVirtualMachine":   {[]string{"4.3", "4.4"}, nil},
+	"resetVpnConnection":             {[]string{"4.3", "4.4"}, nil},
+	"resizeVolume":                   {[]string{"4.3", "4.4"}, nil},
+	"restartNetwork":                 {[]string{"4.3", "4.4"}, nil},
+	"restartVPC":                     {[]string{"4.3", "4.4"}, nil},
+	"restoreVirtualMachine":          {[]string{"4.3", "4.4"}, nil},
+	"revertSnapshot":                 {[]string{"4.3", "4.4"}, nil},
+	"revertToVMSnapshot":             {[]string{"4.3", "4.4"}, nil},
+	"revokeSecurityGroupEgress":      {[]string{"4.3", "4.4"}, nil},
+	"revokeSecurityGroupIngress":     {[]string{"4.3", "4.4"}, nil},
+	"scaleSystemVm":                  {[]string{"4.3", "4.4"}, nil},
+	"scaleVirtualMachine":            {[]string{"4.3", "4.4"}, nil},
+	"startInternalLoadBalancerVM":    {[]string{"4.3", "4.4"}, nil},
+	"startRouter":                    {[]string{"4.3", "4.4"}, nil},
+	"startSystemVm":                  {[]string{"4.3", "4.4"}, nil},
+	"startVirtualMachine": {[]string{"4.3", "4.4"}, map[string][]string{
+		"deploymentplanner": {"4.4"},
+	}},
+	"stopInternalLoadBalancerVM": {[]string{"4.3", "4.4"}, nil},
+	"stopRouter":                 {[]string{"4.3", "4.4"}, nil},
+	"stopSystemVm":               {[]string{"4.3", "4.4"}, nil},
+	"stopVirtualMachine":         {[]string{"4.3", "4.4"}, nil},
+	"suspendProject":             {[]string{"4.3", "4.4"}, nil},
+	"updateAccount":              {[]string{"4.3", "4.4"}, nil},
+	"updateAutoScalePolicy":      {[]string{"4.3", "4.4"}, nil},
+	"updateAutoScaleVmGroup": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":   {"4.4"},
+		"fordisplay": {"4.4"},
+	}},
+	"updateAutoScaleVmProfile": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":   {"4.4"},
+		"fordisplay": {"4.4"},
+	}},
+	"updateCloudToUseObjectStore":       {[]string{"4.3", "4.4"}, nil},
+	"updateCluster":                     {[]string{"4.3", "4.4"}, nil},
+	"updateConfiguration":               {[]string{"4.3", "4.4"}, nil},
+	"updateDefaultNicForVirtualMachine": {[]string{"4.3", "4.4"}, nil},
+	"updateDiskOffering":                {[]string{"4.3", "4.4"}, nil},
+	"updateDomain":                      {[]string{"4.3", "4.4"}, nil},
+	"updateEgressFirewallRule":          {[]string{"4.4"}, nil},
+	"updateFirewallRule":                {[]string{"4.4"}, nil},
+	"updateGlobalLoadBalancerRule":      {[]string{"4.3", "4.4"}, nil},
+	"updateGuestOs":                     {[]string{"4.4"}, nil},
+	"updateGuestOsMapping":              {[]string{"4.4"}, nil},
+	"updateHost":                        {[]string{"4.3", "4.4"}, nil},
+	"updateHostPassword":                {[]string{"4.3", "4.4"}, nil},
+	"updateHypervisorCapabilities":      {[]string{"4.3", "4.4"}, nil},
+	"updateInstanceGroup":               {[]string{"4.3", "4.4"}, nil},
+	"updateIpAddress":                   {[]string{"4.4"}, nil},
+	"updateIso": {[]string{"4.3", "4.4"}, map[string][]string{
+		"details": {"4.4"},
+	}},
+	"updateIsoPermissions":      {[]string{"4.3", "4.4"}, nil},
+	"updateLBHealthCheckPolicy": {[]string{"4.4"}, nil},
+	"updateLBStickinessPolicy":  {[]string{"4.4"}, nil},
+	"updateLoadBalancer":        {[]string{"4.4"}, nil},
+	"updateLoadBalancerRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":   {"4.4"},
+		"fordisplay": {"4.4"},
+	}},
+	"updateNetwork": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid": {"4.4"},
+	}},
+	"updateNetworkACLItem": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":   {"4.4"},
+		"fordisplay": {"4.4"},
+	}},
+	"updateNetworkACLList":         {[]string{"4.4"}, nil},
+	"updateNetworkOffering":        {[]string{"4.3", "4.4"}, nil},
+	"updateNetworkServiceProvider": {[]string{"4.3", "4.4"}, nil},
+	"updatePhysicalNetwork":        {[]string{"4.3", "4.4"}, nil},
+	"updatePod":                    {[]string{"4.3", "4.4"}, nil},
+	"updatePortForwardingRule": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":   {"4.4"},
+		"fordisplay": {"4.4"},
+		"id":         {"4.4"},
+	}},
+	"updateProject":               {[]string{"4.3", "4.4"}, nil},
+	"updateProjectInvitation":     {[]string{"4.3", "4.4"}, nil},
+	"updateRegion":                {[]string{"4.3", "4.4"}, nil},
+	"updateRemoteAccessVpn":       {[]string{"4.4"}, nil},
+	"updateResourceCount":         {[]string{"4.3", "4.4"}, nil},
+	"updateResourceLimit":         {[]string{"4.3", "4.4"}, nil},
+	"updateServiceOffering":       {[]string{"4.3", "4.4"}, nil},
+	"updateSnapshotPolicy":        {[]string{"4.4"}, nil},
+	"updateStorageNetworkIpRange": {[]string{"4.3", "4.4"}, nil},
+	"updateStoragePool":           {[]string{"4.3", "4.4"}, nil},
+	"updateTemplate": {[]string{"4.3", "4.4"}, map[string][]string{
+		"details": {"4.4"},
+	}},
+	"updateTemplatePermissions": {[]string{"4.3", "4.4"}, nil},
+	"updateTrafficType":         {[]string{"4.3", "4.4"}, nil},
+	"updateUser":                {[]string{"4.3", "4.4"}, nil},
+	"updateVMAffinityGroup":     {[]string{"4.3", "4.4"}, nil},
+	"updateVPC": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid":   {"4.4"},
+		"fordisplay": {"4.4"},
+	}},
+	"updateVPCOffering": {[]string{"4.3", "4.4"}, nil},
+	"updateVirtualMachine": {[]string{"4.3", "4.4"}, map[string][]string{
+		"customid": {"4.4"},
+		"details":  {"4.4"},
+		"name":     {"4.4"},
+	}},
+	"updateVolume": {[]string{"4.3", "4.4"}, map[string][]string{
+		"chaininfo": {"4.4"},
+		"customid":  {"4.4"},
+	}},
+	"updateVpnConnection":      {[]string{"4.4"}, nil},
+	"updateVpnCustomerGateway": {[]string{"4.3", "4.4"}, nil},
+	"updateVpnGateway":         {[]string{"4.4"}, nil},
+	"updateZone":               {[]string{"4.3", "4.4"}, nil},
+	"upgradeRouterTemplate":    {[]string{"4.3", "4.4"}, nil},
+	"uploadCustomCertificate":  {[]string{"4.3", "4.4"}, nil},
+	"uploadSslCert": {[]string{"4.3", "4.4"}, map[string][]string{
+		"account":   {"4.4"},
+		"domainid":  {"4.4"},
+		"projectid": {"4.4"},
+	}},
+	"uploadVolume": {[]string{"4.3", "4.4"}, map[string][]string{
+		"diskofferingid": {"4.4"},
+	}},
+}
+
+// Returns the CloudStack versions supporting the given API, or nil if the API is not part of this package
+func APIVersions(api string) []string {
+	if s, found := apiVersions[api]; found {
+		return s.versions
+	}
+	return nil
+}
+
+// Returns the CloudStack versions supporting the given param of the given API
+func ParamVersions(api string, param string) []string {
+	s, found := apiVersions[api]
+	if !found {
+		return nil
+	}
+	if vs, found := s.params[param]; found {
+		return vs
+	}
+	return s.versions
+}
+
+// How API calls that are not supported by the version of the server are handled
+type UnsupportedMode int
+
+const (
+	// Return an *UnsupportedError without sending the request
+	RejectUnsupported UnsupportedMode = iota
+
+	// Log a warning and send the request anyway
+	WarnUnsupported
+
+	// Send the request without checking the version
+	IgnoreUnsupported
+)
+
+// Sets the CloudStack version of the server, like '4.3' or '4.3.2'. After setting the version, API calls
+// and params that are not supported by it are handled as configured with UnsupportedCalls (by default
+// they are rejected). If not set, the version detected by DetectCapabilities is used.
+func (cs *CloudStackClient) ServerVersion(version string) {
+	cs.serverVersion = version
+}
+
+// Sets how API calls that are not supported by the version of the server are handled
+func (cs *CloudStackClient) UnsupportedCalls(mode UnsupportedMode) {
+	cs.unsupported = mode
+}
+
+// Checks if the API and all params are supported by the version of the server, if known
+func (cs *CloudStackClient) checkVersion(api string, params url.Values) error {
+	if cs.serverVersion == "" || cs.unsupported == IgnoreUnsupported {
+		return nil
+	}
+
+	err := checkVersion(cs.serverVersion, api, params)
+	if err != nil && cs.unsupported == WarnUnsupported {
+		log.Printf("Warning: %v", err)
+		return nil
+	}
+	return err
+}
+
+func checkVersion(version string, api string, params url.Values) error {
+	v, known := knownVersion(version)
+	s, found := apiVersions[api]
+	if !known || !found {
+		return nil
+	}
+
+	if !containsVersion(s.versions, v) {
+		return &UnsupportedError{API: api, Version: version}
+	}
+	for _, n := range paramNames(params) {
+		if vs, found := s.params[n]; found && !containsVersion(vs, v) {
+			return &UnsupportedError{API: api, Param: n, Version: version}
+		}
+	}
+	return nil
+}
+
+// Returns the supported version matching the given server version. Newer servers are expected to
+// support the same APIs as the newest supported version. For older servers or unknown versions
+// between supported versions, false is returned as it is unknown what they support.
+func knownVersion(version string) (string, bool) {
+	for _, v := range Versions {
+		if version == v || strings.HasPrefix(version, v+".") {
+			return v, true
+		}
+	}
+
+	newest := Versions[len(Versions)-1]
+	if compareVersions(version, newest) > 0 {
+		return newest, true
+	}
+	return "", false
+}
+
+// Compares two versions like '4.3.2' by their numeric parts, returning -1, 0 or 1
+func compareVersions(a string, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		}
+	}
+	return 0
+}
+
+func containsVersion(versions []string, v string) bool {
+	for _, vv := range versions {
+		if vv == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The format CloudStack returns the responses in
+type ResponseFormat int
+
+const (
+	JSONResponse ResponseFormat = iota // The default
+	XMLResponse                        // Decoded into the same response types as JSON responses, using their xml tags
+)
+
+// Returns a copy of the client using the given response format. Used for the responses that are decoded without
+// a response type, which only works for JSON responses.
+func (cs *CloudStackClient) withFormat(format ResponseFormat) *CloudStackClient {
+	c := *cs
+	c.format = format
+	return &c
+}
+
+// Returns true if the response is XML instead of JSON
+func isXML(b []byte) bool {
+	b = bytes.TrimSpace(b)
+	return len(b) > 0 && b[0] == '<'
+}
+
+// Decodes a JSON or XML response, ignoring unknown fields
+func decode(b json.RawMessage, v interface{}) error {
+	if isXML(b) {
+		_, err := decodeXML(b, v, false)
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+var (
+	rawMessageType     = reflect.TypeOf(json.RawMessage{})
+	xmlUnmarshalerType = reflect.TypeOf((*xml.Unmarshaler)(nil)).Elem()
+)
+
+// Decodes the root element of an XML response into v, using the xml tags of the response types. Like the JSON
+// decoder, repeated elements are decoded into slices and the child elements of a map field into its entries. A
+// json.RawMessage field is set to the XML of its element. Returns the (sorted and unique) names of the elements
+// that are not part of the type of v, like 'virtualmachine.nic.newfield', which are stored in the Extra field of
+// v if keep is true.
+func decodeXML(b []byte, v interface{}, keep bool) ([]string, error) {
+	d := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		start, ok := t.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		found := make(map[string]bool)
+		if err := decodeXMLElement(d, start, reflect.ValueOf(v), "", keep, found); err != nil {
+			return nil, err
+		}
+
+		unknown := make([]string, 0, len(found))
+		for f := range found {
+			unknown = append(unknown, f)
+		}
+		sort.Strings(unknown)
+		return unknown, nil
+	}
+}
+
+func decodeXMLElement(d *xml.Decoder, start xml.StartElement, v reflect.Value, path string, keep bool, unknown map[string]bool) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Type() == rawMessageType:
+		b, err := encodeXMLElement(d, start)
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+		return nil
+	case reflect.PtrTo(v.Type()).Implements(xmlUnmarshalerType):
+		return d.DecodeElement(v.Addr().Interface(), &start)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8:
+		// Every element of a list is a separate element with the same name
+		e := reflect.New(v.Type().Elem()).Elem()
+		if err := decodeXMLElement(d, start, e, path, keep, unknown); err != nil {
+			return err
+		}
+		v.Set(reflect.Append(v, e))
+		return nil
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		return eachXMLChild(d, func(child xml.StartElement) error {
+			e := reflect.New(v.Type().Elem())
+			if err := d.DecodeElement(e.Interface(), &child); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(child.Name.Local).Convert(v.Type().Key()), e.Elem())
+			return nil
+		})
+	case v.Kind() == reflect.Struct:
+		// Like the JSON decoder, match the names of the fields case-insensitive
+		known := make(map[string]int)
+		extra := -1
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.Name == "Extra" && f.Type == extraType {
+				extra = i
+				continue
+			}
+			name := strings.Split(f.Tag.Get("xml"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			known[strings.ToLower(name)] = i
+		}
+
+		return eachXMLChild(d, func(child xml.StartElement) error {
+			name := child.Name.Local
+			if i, ok := known[strings.ToLower(name)]; ok {
+				return decodeXMLElement(d, child, v.Field(i), path+name+".", keep, unknown)
+			}
+
+			unknown[path+name] = true
+			if !keep || extra == -1 {
+				return d.Skip()
+			}
+			b, err := encodeXMLElement(d, child)
+			if err != nil {
+				return err
+			}
+			if v.Field(extra).IsNil() {
+				v.Field(extra).Set(reflect.MakeMap(extraType))
+			}
+			v.Field(extra).SetMapIndex(reflect.ValueOf(name), reflect.ValueOf(json.RawMessage(b)))
+			return nil
+		})
+	default:
+		return d.DecodeElement(v.Addr().Interface(), &start)
+	}
+}
+
+// Calls fn for every child element of the element that was just started, until the element ends. The
+// function must read the complete child element.
+func eachXMLChild(d *xml.Decoder, fn func(child xml.StartElement) error) error {
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if err := fn(t); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// Returns the XML of the element that was just started, including the element itself
+func encodeXMLElement(d *xml.Decoder, start xml.StartElement) ([]byte, error) {
+	var buf bytes.Buffer
+	e := xml.NewEncoder(&buf)
+	if err := e.EncodeToken(start); err != nil {
+		return nil, err
+	}
+	for depth := 1; depth > 0; {
+		t, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch t.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.ProcInst, xml.Directive:
+			continue
+		}
+		if err := e.EncodeToken(t); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Returns the first child element of the root element of an XML response
+func getRawXMLValue(b []byte) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(b))
+	depth := 0
+	for {
+		t, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if depth == 1 {
+				return encodeXMLElement(d, t)
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil, fmt.Errorf("Unable to extract the raw value from:\n\n%s\n\n", string(b))
+}
+
+// Streams the elements named field of an XML 'list' response to fn, like streamList does for JSON responses
+func streamXMLList(r io.Reader, field string, fn func(json.RawMessage) error) (int, error) {
+	d := xml.NewDecoder(r)
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := t.(xml.StartElement); ok {
+			break
+		}
+	}
+
+	count := 0
+	err := eachXMLChild(d, func(child xml.StartElement) error {
+		switch child.Name.Local {
+		case "count":
+			return d.DecodeElement(&count, &child)
+		case field:
+			b, err := encodeXMLElement(d, child)
+			if err != nil {
+				return err
+			}
+			return fn(b)
+		default:
+			return d.Skip()
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,817 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateAccount(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+		"accountdetails[0].test-accountdetailskey": {"test-accountdetailsvalue"},
+		"accountid":     {"test-accountid"},
+		"accounttype":   {"4"},
+		"domainid":      {"test-domainid"},
+		"email":         {"test-email"},
+		"firstname":     {"test-firstname"},
+		"lastname":      {"test-lastname"},
+		"networkdomain": {"test-networkdomain"},
+		"password":      {"test-password"},
+		"timezone":      {"test-timezone"},
+		"userid":        {"test-userid"},
+		"username":      {"test-username"},
+	}
+	cs, done := newTestClient(t, "createAccount", params, `{"createaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Account.NewCreateAccountParams(4, "test-email", "test-firstname", "test-lastname", "test-password", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAccountdetails(map[string]string{"test-accountdetailskey": "test-accountdetailsvalue"})
+	p.SetAccountid("test-accountid")
+	p.SetAccounttype(4)
+	p.SetDomainid("test-domainid")
+	p.SetEmail("test-email")
+	p.SetFirstname("test-firstname")
+	p.SetLastname("test-lastname")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetPassword("test-password")
+	p.SetTimezone("test-timezone")
+	p.SetUserid("test-userid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "createAccount", p.URLValues(), params)
+
+	r, err := cs.Account.CreateAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createAccount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createAccount", params, `<createaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></createaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.CreateAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteAccount", params, `{"deleteaccountresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Account.NewDeleteAccountParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteAccount", p.URLValues(), params)
+
+	r, err := cs.Account.DeleteAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAccount: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAccount" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAccount", params, `<deleteaccountresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.DeleteAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisableAccount(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"id":       {"test-id"},
+		"lock":     {"true"},
+	}
+	cs, done := newTestClient(t, "disableAccount", params, `{"disableaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"jobid":"test-jobid","memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Account.NewDisableAccountParams(true)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetLock(true)
+	checkURLValues(t, "disableAccount", p.URLValues(), params)
+
+	r, err := cs.Account.DisableAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disableAccount" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disableAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disableAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disableAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disableAccount", params, `<disableaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><jobid>test-jobid</jobid><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></disableaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.DisableAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestEnableAccount(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"id":       {"test-id"},
+	}
+	cs, done := newTestClient(t, "enableAccount", params, `{"enableaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Account.NewEnableAccountParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	checkURLValues(t, "enableAccount", p.URLValues(), params)
+
+	r, err := cs.Account.EnableAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "enableAccount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute enableAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of enableAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of enableAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "enableAccount", params, `<enableaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></enableaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.EnableAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAccounts(t *testing.T) {
+	params := url.Values{
+		"accounttype":       {"1"},
+		"domainid":          {"test-domainid"},
+		"id":                {"test-id"},
+		"iscleanuprequired": {"true"},
+		"isrecursive":       {"true"},
+		"keyword":           {"test-keyword"},
+		"listall":           {"true"},
+		"name":              {"test-name"},
+		"page":              {"9"},
+		"pagesize":          {"10"},
+		"state":             {"test-state"},
+	}
+	cs, done := newTestClient(t, "listAccounts", params, `{"listaccountsresponse":{"account":[{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}],"count":1}}`)
+	defer done()
+
+	p := cs.Account.NewListAccountsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccounttype(1)
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIscleanuprequired(true)
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetState("test-state")
+	checkURLValues(t, "listAccounts", p.URLValues(), params)
+
+	r, err := cs.Account.ListAccounts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAccounts: %v", err)
+	}
+	if r.Accounts[0].Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Accounts[0].Cpuavailable to be %v, got %v", "test-cpuavailable", r.Accounts[0].Cpuavailable)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAccounts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAccounts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAccounts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAccounts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Account
+	count, err := cs.Account.StreamAccounts(context.Background(), p, func(v *Account) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAccounts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Accounts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Accounts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAccounts", params, `<listaccountsresponse><account><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></account><count>1</count></listaccountsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.ListAccounts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAccounts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Account.StreamAccounts(context.Background(), p, func(v *Account) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAccounts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Accounts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Accounts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestLockAccount(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+	}
+	cs, done := newTestClient(t, "lockAccount", params, `{"lockaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Account.NewLockAccountParams("test-account", "test-domainid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	checkURLValues(t, "lockAccount", p.URLValues(), params)
+
+	r, err := cs.Account.LockAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call lockAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "lockAccount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute lockAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of lockAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of lockAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "lockAccount", params, `<lockaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></lockaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.LockAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call lockAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateAccount(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+		"accountdetails[0].test-accountdetailskey": {"test-accountdetailsvalue"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"networkdomain": {"test-networkdomain"},
+		"newname":       {"test-newname"},
+	}
+	cs, done := newTestClient(t, "updateAccount", params, `{"updateaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Account.NewUpdateAccountParams("test-newname")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAccountdetails(map[string]string{"test-accountdetailskey": "test-accountdetailsvalue"})
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetNewname("test-newname")
+	checkURLValues(t, "updateAccount", p.URLValues(), params)
+
+	r, err := cs.Account.UpdateAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateAccount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateAccount", params, `<updateaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></updateaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.UpdateAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAccountFromProject(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "deleteAccountFromProject", params, `{"deleteaccountfromprojectresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Account.NewDeleteAccountFromProjectParams("test-account", "test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "deleteAccountFromProject", p.URLValues(), params)
+
+	r, err := cs.Account.DeleteAccountFromProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAccountFromProject: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAccountFromProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAccountFromProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAccountFromProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAccountFromProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAccountFromProject", params, `<deleteaccountfromprojectresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteaccountfromprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.DeleteAccountFromProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAccountFromProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddAccountToProject(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"email":     {"test-email"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "addAccountToProject", params, `{"addaccounttoprojectresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Account.NewAddAccountToProjectParams("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetEmail("test-email")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "addAccountToProject", p.URLValues(), params)
+
+	r, err := cs.Account.AddAccountToProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call addAccountToProject: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addAccountToProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addAccountToProject: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addAccountToProject: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addAccountToProject: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addAccountToProject", params, `<addaccounttoprojectresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></addaccounttoprojectresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.AddAccountToProject(p)
+	if err != nil {
+		t.Fatalf("Failed to call addAccountToProject using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestMarkDefaultZoneForAccount(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "markDefaultZoneForAccount", params, `{"markdefaultzoneforaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"jobid":"test-jobid","memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.Account.NewMarkDefaultZoneForAccountParams("test-account", "test-domainid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "markDefaultZoneForAccount", p.URLValues(), params)
+
+	r, err := cs.Account.MarkDefaultZoneForAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call markDefaultZoneForAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "markDefaultZoneForAccount" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute markDefaultZoneForAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of markDefaultZoneForAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of markDefaultZoneForAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "markDefaultZoneForAccount", params, `<markdefaultzoneforaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><jobid>test-jobid</jobid><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></markdefaultzoneforaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.MarkDefaultZoneForAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call markDefaultZoneForAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListProjectAccounts(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"keyword":   {"test-keyword"},
+		"page":      {"3"},
+		"pagesize":  {"4"},
+		"projectid": {"test-projectid"},
+		"role":      {"test-role"},
+	}
+	cs, done := newTestClient(t, "listProjectAccounts", params, `{"listprojectaccountsresponse":{"count":1,"projectaccount":[{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}]}}`)
+	defer done()
+
+	p := cs.Account.NewListProjectAccountsParams("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetProjectid("test-projectid")
+	p.SetRole("test-role")
+	checkURLValues(t, "listProjectAccounts", p.URLValues(), params)
+
+	r, err := cs.Account.ListProjectAccounts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listProjectAccounts: %v", err)
+	}
+	if r.ProjectAccounts[0].Account != "test-account" {
+		t.Errorf("Expected r.ProjectAccounts[0].Account to be %v, got %v", "test-account", r.ProjectAccounts[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listProjectAccounts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listProjectAccounts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listProjectAccounts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listProjectAccounts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ProjectAccount
+	count, err := cs.Account.StreamProjectAccounts(context.Background(), p, func(v *ProjectAccount) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listProjectAccounts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ProjectAccounts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ProjectAccounts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listProjectAccounts", params, `<listprojectaccountsresponse><count>1</count><projectaccount><account>test-account</account><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></projectaccount></listprojectaccountsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Account.ListProjectAccounts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listProjectAccounts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Account.StreamProjectAccounts(context.Background(), p, func(v *ProjectAccount) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listProjectAccounts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ProjectAccounts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ProjectAccounts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
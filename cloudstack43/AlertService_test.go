@@ -0,0 +1,317 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArchiveAlerts(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+	cs, done := newTestClient(t, "archiveAlerts", params, `{"archivealertsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Alert.NewArchiveAlertsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	checkURLValues(t, "archiveAlerts", p.URLValues(), params)
+
+	r, err := cs.Alert.ArchiveAlerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call archiveAlerts: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "archiveAlerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute archiveAlerts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of archiveAlerts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of archiveAlerts: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "archiveAlerts", params, `<archivealertsresponse><displaytext>test-displaytext</displaytext><success>true</success></archivealertsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Alert.ArchiveAlerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call archiveAlerts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAlerts(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+	cs, done := newTestClient(t, "deleteAlerts", params, `{"deletealertsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Alert.NewDeleteAlertsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	checkURLValues(t, "deleteAlerts", p.URLValues(), params)
+
+	r, err := cs.Alert.DeleteAlerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAlerts: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAlerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAlerts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAlerts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAlerts: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAlerts", params, `<deletealertsresponse><displaytext>test-displaytext</displaytext><success>true</success></deletealertsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Alert.DeleteAlerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAlerts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestGenerateAlert(t *testing.T) {
+	params := url.Values{
+		"description": {"test-description"},
+		"name":        {"test-name"},
+		"podid":       {"test-podid"},
+		"type":        {"4"},
+		"zoneid":      {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "generateAlert", params, `{"generatealertresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Alert.NewGenerateAlertParams("test-description", "test-name", 4)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetName("test-name")
+	p.SetPodid("test-podid")
+	p.SetType(4)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "generateAlert", p.URLValues(), params)
+
+	r, err := cs.Alert.GenerateAlert(p)
+	if err != nil {
+		t.Fatalf("Failed to call generateAlert: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "generateAlert" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute generateAlert: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of generateAlert: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of generateAlert: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "generateAlert", params, `<generatealertresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></generatealertresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Alert.GenerateAlert(p)
+	if err != nil {
+		t.Fatalf("Failed to call generateAlert using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAlerts(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"type":     {"test-type"},
+	}
+	cs, done := newTestClient(t, "listAlerts", params, `{"listalertsresponse":{"alert":[{"description":"test-description","id":"test-id","name":"test-name","sent":"test-sent","type":1}],"count":1}}`)
+	defer done()
+
+	p := cs.Alert.NewListAlertsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetType("test-type")
+	checkURLValues(t, "listAlerts", p.URLValues(), params)
+
+	r, err := cs.Alert.ListAlerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAlerts: %v", err)
+	}
+	if r.Alerts[0].Description != "test-description" {
+		t.Errorf("Expected r.Alerts[0].Description to be %v, got %v", "test-description", r.Alerts[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAlerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAlerts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAlerts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAlerts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Alert
+	count, err := cs.Alert.StreamAlerts(context.Background(), p, func(v *Alert) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAlerts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Alerts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Alerts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAlerts", params, `<listalertsresponse><alert><description>test-description</description><id>test-id</id><name>test-name</name><sent>test-sent</sent><type>1</type></alert><count>1</count></listalertsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Alert.ListAlerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAlerts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Alert.StreamAlerts(context.Background(), p, func(v *Alert) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAlerts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Alerts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Alerts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,212 +17,101 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
-	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type QueryAsyncJobResultParams struct {
-	p map[string]interface{}
+type AsyncjobService struct {
+	cs *CloudStackClient
 }
 
-func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["jobid"]; found {
-		u.Set("jobid", v.(string))
-	}
-	return u
+func NewAsyncjobService(cs *CloudStackClient) *AsyncjobService {
+	return &AsyncjobService{cs: cs}
 }
 
-func (p *QueryAsyncJobResultParams) SetJobid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["jobid"] = v
-	return
-}
+type ListAsyncJobsParams = cloudstackcommon.ListAsyncJobsParams
+type ListAsyncJobsResponse = cloudstackcommon.ListAsyncJobsResponse
+type AsyncJob = cloudstackcommon.AsyncJob
 
-// You should always use this function to get a new QueryAsyncJobResultParams instance,
+// You should always use this function to get a new ListAsyncJobsParams instance,
 // as then you are sure you have configured all required params
-func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams {
-	p := &QueryAsyncJobResultParams{}
-	p.p = make(map[string]interface{})
-	p.p["jobid"] = jobid
+func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
+	p := &ListAsyncJobsParams{}
 	return p
 }
 
-// Retrieves the current status of asynchronous job.
-func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
-	resp, err := s.cs.newRequest("queryAsyncJobResult", p.toURLValues())
+// Lists all pending asynchronous jobs for the account.
+func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listAsyncJobs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r QueryAsyncJobResultResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListAsyncJobsResponse
+	if err := s.cs.unmarshal("listAsyncJobs", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type QueryAsyncJobResultResponse struct {
-	Accountid       string          `json:"accountid,omitempty"`
-	Cmd             string          `json:"cmd,omitempty"`
-	Created         string          `json:"created,omitempty"`
-	Jobinstanceid   string          `json:"jobinstanceid,omitempty"`
-	Jobinstancetype string          `json:"jobinstancetype,omitempty"`
-	Jobprocstatus   int             `json:"jobprocstatus,omitempty"`
-	Jobresult       json.RawMessage `json:"jobresult,omitempty"`
-	Jobresultcode   int             `json:"jobresultcode,omitempty"`
-	Jobresulttype   string          `json:"jobresulttype,omitempty"`
-	Jobstatus       int             `json:"jobstatus,omitempty"`
-	Userid          string          `json:"userid,omitempty"`
-}
-
-type ListAsyncJobsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAsyncJobsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+// Lists the objects like ListAsyncJobs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AsyncjobService) StreamAsyncJobs(ctx context.Context, p *ListAsyncJobsParams, fn func(*AsyncJob) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	return u
-}
 
-func (p *ListAsyncJobsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+	return s.cs.streamList(ctx, "listAsyncJobs", p.URLValues(), "asyncjob", func(b json.RawMessage) error {
+		var v AsyncJob
+		if err := s.cs.unmarshalElement("listAsyncJobs", "asyncjob", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListAsyncJobsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
+type QueryAsyncJobResultParams = cloudstackcommon.QueryAsyncJobResultParams
+type QueryAsyncJobResultResponse = cloudstackcommon.QueryAsyncJobResultResponse
 
-func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
-
-func (p *ListAsyncJobsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListAsyncJobsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListAsyncJobsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListAsyncJobsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListAsyncJobsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
-	return
-}
-
-// You should always use this function to get a new ListAsyncJobsParams instance,
+// You should always use this function to get a new QueryAsyncJobResultParams instance,
 // as then you are sure you have configured all required params
-func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
-	p := &ListAsyncJobsParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - jobid
+func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams {
+	p := &QueryAsyncJobResultParams{}
+	p.SetJobid(jobid)
 	return p
 }
 
-// Lists all pending asynchronous jobs for the account.
-func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
-	resp, err := s.cs.newRequest("listAsyncJobs", p.toURLValues())
+// Retrieves the current status of asynchronous job.
+func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("queryAsyncJobResult", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListAsyncJobsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r QueryAsyncJobResultResponse
+	if err := s.cs.unmarshal("queryAsyncJobResult", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type ListAsyncJobsResponse struct {
-	Count     int         `json:"count"`
-	AsyncJobs []*AsyncJob `json:"asyncjob"`
-}
-
-type AsyncJob struct {
-	Accountid       string          `json:"accountid,omitempty"`
-	Cmd             string          `json:"cmd,omitempty"`
-	Created         string          `json:"created,omitempty"`
-	Jobinstanceid   string          `json:"jobinstanceid,omitempty"`
-	Jobinstancetype string          `json:"jobinstancetype,omitempty"`
-	Jobprocstatus   int             `json:"jobprocstatus,omitempty"`
-	Jobresult       json.RawMessage `json:"jobresult,omitempty"`
-	Jobresultcode   int             `json:"jobresultcode,omitempty"`
-	Jobresulttype   string          `json:"jobresulttype,omitempty"`
-	Jobstatus       int             `json:"jobstatus,omitempty"`
-	Userid          string          `json:"userid,omitempty"`
-}
@@ -0,0 +1,1521 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateAutoScalePolicy(t *testing.T) {
+	params := url.Values{
+		"action":       {"test-action"},
+		"conditionids": {"test-conditionids1, test-conditionids2"},
+		"duration":     {"3"},
+		"quiettime":    {"4"},
+	}
+	cs, done := newTestClient(t, "createAutoScalePolicy", params, `{"createautoscalepolicyresponse":{"account":"test-account","action":"test-action","conditions":["test-conditions"],"domain":"test-domain","domainid":"test-domainid","duration":1,"id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","quiettime":1}}`)
+	defer done()
+
+	p := cs.AutoScale.NewCreateAutoScalePolicyParams("test-action", []string{"test-conditionids1", "test-conditionids2"}, 3)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAction("test-action")
+	p.SetConditionids([]string{"test-conditionids1", "test-conditionids2"})
+	p.SetDuration(3)
+	p.SetQuiettime(4)
+	checkURLValues(t, "createAutoScalePolicy", p.URLValues(), params)
+
+	r, err := cs.AutoScale.CreateAutoScalePolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAutoScalePolicy: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createAutoScalePolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createAutoScalePolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createAutoScalePolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createAutoScalePolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createAutoScalePolicy", params, `<createautoscalepolicyresponse><account>test-account</account><action>test-action</action><conditions>test-conditions</conditions><domain>test-domain</domain><domainid>test-domainid</domainid><duration>1</duration><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><quiettime>1</quiettime></createautoscalepolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.CreateAutoScalePolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAutoScalePolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAutoScalePolicy(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteAutoScalePolicy", params, `{"deleteautoscalepolicyresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.AutoScale.NewDeleteAutoScalePolicyParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteAutoScalePolicy", p.URLValues(), params)
+
+	r, err := cs.AutoScale.DeleteAutoScalePolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAutoScalePolicy: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAutoScalePolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAutoScalePolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAutoScalePolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAutoScalePolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAutoScalePolicy", params, `<deleteautoscalepolicyresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteautoscalepolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.DeleteAutoScalePolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAutoScalePolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAutoScalePolicies(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"action":      {"test-action"},
+		"conditionid": {"test-conditionid"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"9"},
+		"pagesize":    {"10"},
+		"vmgroupid":   {"test-vmgroupid"},
+	}
+	cs, done := newTestClient(t, "listAutoScalePolicies", params, `{"listautoscalepoliciesresponse":{"autoscalepolicy":[{"account":"test-account","action":"test-action","conditions":["test-conditions"],"domain":"test-domain","domainid":"test-domainid","duration":1,"id":"test-id","project":"test-project","projectid":"test-projectid","quiettime":1}],"count":1}}`)
+	defer done()
+
+	p := cs.AutoScale.NewListAutoScalePoliciesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAction("test-action")
+	p.SetConditionid("test-conditionid")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetVmgroupid("test-vmgroupid")
+	checkURLValues(t, "listAutoScalePolicies", p.URLValues(), params)
+
+	r, err := cs.AutoScale.ListAutoScalePolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAutoScalePolicies: %v", err)
+	}
+	if r.AutoScalePolicies[0].Account != "test-account" {
+		t.Errorf("Expected r.AutoScalePolicies[0].Account to be %v, got %v", "test-account", r.AutoScalePolicies[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAutoScalePolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAutoScalePolicies: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAutoScalePolicies: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAutoScalePolicies: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*AutoScalePolicy
+	count, err := cs.AutoScale.StreamAutoScalePolicies(context.Background(), p, func(v *AutoScalePolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAutoScalePolicies: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.AutoScalePolicies) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.AutoScalePolicies)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAutoScalePolicies", params, `<listautoscalepoliciesresponse><autoscalepolicy><account>test-account</account><action>test-action</action><conditions>test-conditions</conditions><domain>test-domain</domain><domainid>test-domainid</domainid><duration>1</duration><id>test-id</id><project>test-project</project><projectid>test-projectid</projectid><quiettime>1</quiettime></autoscalepolicy><count>1</count></listautoscalepoliciesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.ListAutoScalePolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAutoScalePolicies using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AutoScale.StreamAutoScalePolicies(context.Background(), p, func(v *AutoScalePolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAutoScalePolicies using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.AutoScalePolicies) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.AutoScalePolicies)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateAutoScalePolicy(t *testing.T) {
+	params := url.Values{
+		"conditionids": {"test-conditionids1, test-conditionids2"},
+		"duration":     {"2"},
+		"id":           {"test-id"},
+		"quiettime":    {"4"},
+	}
+	cs, done := newTestClient(t, "updateAutoScalePolicy", params, `{"updateautoscalepolicyresponse":{"account":"test-account","action":"test-action","conditions":["test-conditions"],"domain":"test-domain","domainid":"test-domainid","duration":1,"id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","quiettime":1}}`)
+	defer done()
+
+	p := cs.AutoScale.NewUpdateAutoScalePolicyParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetConditionids([]string{"test-conditionids1", "test-conditionids2"})
+	p.SetDuration(2)
+	p.SetId("test-id")
+	p.SetQuiettime(4)
+	checkURLValues(t, "updateAutoScalePolicy", p.URLValues(), params)
+
+	r, err := cs.AutoScale.UpdateAutoScalePolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAutoScalePolicy: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateAutoScalePolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateAutoScalePolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateAutoScalePolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateAutoScalePolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateAutoScalePolicy", params, `<updateautoscalepolicyresponse><account>test-account</account><action>test-action</action><conditions>test-conditions</conditions><domain>test-domain</domain><domainid>test-domainid</domainid><duration>1</duration><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><quiettime>1</quiettime></updateautoscalepolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.UpdateAutoScalePolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAutoScalePolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateAutoScaleVmGroup(t *testing.T) {
+	params := url.Values{
+		"interval":           {"1"},
+		"lbruleid":           {"test-lbruleid"},
+		"maxmembers":         {"3"},
+		"minmembers":         {"4"},
+		"scaledownpolicyids": {"test-scaledownpolicyids1, test-scaledownpolicyids2"},
+		"scaleuppolicyids":   {"test-scaleuppolicyids1, test-scaleuppolicyids2"},
+		"vmprofileid":        {"test-vmprofileid"},
+	}
+	cs, done := newTestClient(t, "createAutoScaleVmGroup", params, `{"createautoscalevmgroupresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","interval":1,"jobid":"test-jobid","lbruleid":"test-lbruleid","maxmembers":1,"minmembers":1,"project":"test-project","projectid":"test-projectid","scaledownpolicies":["test-scaledownpolicies"],"scaleuppolicies":["test-scaleuppolicies"],"state":"test-state","vmprofileid":"test-vmprofileid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewCreateAutoScaleVmGroupParams("test-lbruleid", 3, 4, []string{"test-scaledownpolicyids1", "test-scaledownpolicyids2"}, []string{"test-scaleuppolicyids1", "test-scaleuppolicyids2"}, "test-vmprofileid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetInterval(1)
+	p.SetLbruleid("test-lbruleid")
+	p.SetMaxmembers(3)
+	p.SetMinmembers(4)
+	p.SetScaledownpolicyids([]string{"test-scaledownpolicyids1", "test-scaledownpolicyids2"})
+	p.SetScaleuppolicyids([]string{"test-scaleuppolicyids1", "test-scaleuppolicyids2"})
+	p.SetVmprofileid("test-vmprofileid")
+	checkURLValues(t, "createAutoScaleVmGroup", p.URLValues(), params)
+
+	r, err := cs.AutoScale.CreateAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAutoScaleVmGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createAutoScaleVmGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createAutoScaleVmGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createAutoScaleVmGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createAutoScaleVmGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createAutoScaleVmGroup", params, `<createautoscalevmgroupresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><interval>1</interval><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><maxmembers>1</maxmembers><minmembers>1</minmembers><project>test-project</project><projectid>test-projectid</projectid><scaledownpolicies>test-scaledownpolicies</scaledownpolicies><scaleuppolicies>test-scaleuppolicies</scaleuppolicies><state>test-state</state><vmprofileid>test-vmprofileid</vmprofileid></createautoscalevmgroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.CreateAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAutoScaleVmGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAutoScaleVmGroup(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteAutoScaleVmGroup", params, `{"deleteautoscalevmgroupresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.AutoScale.NewDeleteAutoScaleVmGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteAutoScaleVmGroup", p.URLValues(), params)
+
+	r, err := cs.AutoScale.DeleteAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAutoScaleVmGroup: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAutoScaleVmGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAutoScaleVmGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAutoScaleVmGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAutoScaleVmGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAutoScaleVmGroup", params, `<deleteautoscalevmgroupresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteautoscalevmgroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.DeleteAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAutoScaleVmGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisableAutoScaleVmGroup(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "disableAutoScaleVmGroup", params, `{"disableautoscalevmgroupresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","interval":1,"jobid":"test-jobid","lbruleid":"test-lbruleid","maxmembers":1,"minmembers":1,"project":"test-project","projectid":"test-projectid","scaledownpolicies":["test-scaledownpolicies"],"scaleuppolicies":["test-scaleuppolicies"],"state":"test-state","vmprofileid":"test-vmprofileid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewDisableAutoScaleVmGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "disableAutoScaleVmGroup", p.URLValues(), params)
+
+	r, err := cs.AutoScale.DisableAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableAutoScaleVmGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disableAutoScaleVmGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disableAutoScaleVmGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disableAutoScaleVmGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disableAutoScaleVmGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disableAutoScaleVmGroup", params, `<disableautoscalevmgroupresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><interval>1</interval><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><maxmembers>1</maxmembers><minmembers>1</minmembers><project>test-project</project><projectid>test-projectid</projectid><scaledownpolicies>test-scaledownpolicies</scaledownpolicies><scaleuppolicies>test-scaleuppolicies</scaleuppolicies><state>test-state</state><vmprofileid>test-vmprofileid</vmprofileid></disableautoscalevmgroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.DisableAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableAutoScaleVmGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestEnableAutoScaleVmGroup(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "enableAutoScaleVmGroup", params, `{"enableautoscalevmgroupresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","interval":1,"jobid":"test-jobid","lbruleid":"test-lbruleid","maxmembers":1,"minmembers":1,"project":"test-project","projectid":"test-projectid","scaledownpolicies":["test-scaledownpolicies"],"scaleuppolicies":["test-scaleuppolicies"],"state":"test-state","vmprofileid":"test-vmprofileid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewEnableAutoScaleVmGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "enableAutoScaleVmGroup", p.URLValues(), params)
+
+	r, err := cs.AutoScale.EnableAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableAutoScaleVmGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "enableAutoScaleVmGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute enableAutoScaleVmGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of enableAutoScaleVmGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of enableAutoScaleVmGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "enableAutoScaleVmGroup", params, `<enableautoscalevmgroupresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><interval>1</interval><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><maxmembers>1</maxmembers><minmembers>1</minmembers><project>test-project</project><projectid>test-projectid</projectid><scaledownpolicies>test-scaledownpolicies</scaledownpolicies><scaleuppolicies>test-scaleuppolicies</scaleuppolicies><state>test-state</state><vmprofileid>test-vmprofileid</vmprofileid></enableautoscalevmgroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.EnableAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableAutoScaleVmGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAutoScaleVmGroups(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"lbruleid":    {"test-lbruleid"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"policyid":    {"test-policyid"},
+		"projectid":   {"test-projectid"},
+		"vmprofileid": {"test-vmprofileid"},
+		"zoneid":      {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listAutoScaleVmGroups", params, `{"listautoscalevmgroupsresponse":{"autoscalevmgroup":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","interval":1,"lbruleid":"test-lbruleid","maxmembers":1,"minmembers":1,"project":"test-project","projectid":"test-projectid","scaledownpolicies":["test-scaledownpolicies"],"scaleuppolicies":["test-scaleuppolicies"],"state":"test-state","vmprofileid":"test-vmprofileid"}],"count":1}}`)
+	defer done()
+
+	p := cs.AutoScale.NewListAutoScaleVmGroupsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetLbruleid("test-lbruleid")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetPolicyid("test-policyid")
+	p.SetProjectid("test-projectid")
+	p.SetVmprofileid("test-vmprofileid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listAutoScaleVmGroups", p.URLValues(), params)
+
+	r, err := cs.AutoScale.ListAutoScaleVmGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAutoScaleVmGroups: %v", err)
+	}
+	if r.AutoScaleVmGroups[0].Account != "test-account" {
+		t.Errorf("Expected r.AutoScaleVmGroups[0].Account to be %v, got %v", "test-account", r.AutoScaleVmGroups[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAutoScaleVmGroups" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAutoScaleVmGroups: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAutoScaleVmGroups: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAutoScaleVmGroups: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*AutoScaleVmGroup
+	count, err := cs.AutoScale.StreamAutoScaleVmGroups(context.Background(), p, func(v *AutoScaleVmGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAutoScaleVmGroups: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.AutoScaleVmGroups) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.AutoScaleVmGroups)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAutoScaleVmGroups", params, `<listautoscalevmgroupsresponse><autoscalevmgroup><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><interval>1</interval><lbruleid>test-lbruleid</lbruleid><maxmembers>1</maxmembers><minmembers>1</minmembers><project>test-project</project><projectid>test-projectid</projectid><scaledownpolicies>test-scaledownpolicies</scaledownpolicies><scaleuppolicies>test-scaleuppolicies</scaleuppolicies><state>test-state</state><vmprofileid>test-vmprofileid</vmprofileid></autoscalevmgroup><count>1</count></listautoscalevmgroupsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.ListAutoScaleVmGroups(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAutoScaleVmGroups using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AutoScale.StreamAutoScaleVmGroups(context.Background(), p, func(v *AutoScaleVmGroup) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAutoScaleVmGroups using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.AutoScaleVmGroups) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.AutoScaleVmGroups)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateAutoScaleVmGroup(t *testing.T) {
+	params := url.Values{
+		"id":                 {"test-id"},
+		"interval":           {"2"},
+		"maxmembers":         {"3"},
+		"minmembers":         {"4"},
+		"scaledownpolicyids": {"test-scaledownpolicyids1, test-scaledownpolicyids2"},
+		"scaleuppolicyids":   {"test-scaleuppolicyids1, test-scaleuppolicyids2"},
+	}
+	cs, done := newTestClient(t, "updateAutoScaleVmGroup", params, `{"updateautoscalevmgroupresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","interval":1,"jobid":"test-jobid","lbruleid":"test-lbruleid","maxmembers":1,"minmembers":1,"project":"test-project","projectid":"test-projectid","scaledownpolicies":["test-scaledownpolicies"],"scaleuppolicies":["test-scaleuppolicies"],"state":"test-state","vmprofileid":"test-vmprofileid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewUpdateAutoScaleVmGroupParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetInterval(2)
+	p.SetMaxmembers(3)
+	p.SetMinmembers(4)
+	p.SetScaledownpolicyids([]string{"test-scaledownpolicyids1", "test-scaledownpolicyids2"})
+	p.SetScaleuppolicyids([]string{"test-scaleuppolicyids1", "test-scaleuppolicyids2"})
+	checkURLValues(t, "updateAutoScaleVmGroup", p.URLValues(), params)
+
+	r, err := cs.AutoScale.UpdateAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAutoScaleVmGroup: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateAutoScaleVmGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateAutoScaleVmGroup: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateAutoScaleVmGroup: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateAutoScaleVmGroup: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateAutoScaleVmGroup", params, `<updateautoscalevmgroupresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><interval>1</interval><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><maxmembers>1</maxmembers><minmembers>1</minmembers><project>test-project</project><projectid>test-projectid</projectid><scaledownpolicies>test-scaledownpolicies</scaledownpolicies><scaleuppolicies>test-scaleuppolicies</scaleuppolicies><state>test-state</state><vmprofileid>test-vmprofileid</vmprofileid></updateautoscalevmgroupresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.UpdateAutoScaleVmGroup(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAutoScaleVmGroup using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateAutoScaleVmProfile(t *testing.T) {
+	params := url.Values{
+		"autoscaleuserid":       {"test-autoscaleuserid"},
+		"counterparam[0].name":  {"test-counterparamkey"},
+		"counterparam[0].value": {"test-counterparamvalue"},
+		"destroyvmgraceperiod":  {"3"},
+		"otherdeployparams":     {"test-otherdeployparams"},
+		"serviceofferingid":     {"test-serviceofferingid"},
+		"templateid":            {"test-templateid"},
+		"zoneid":                {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createAutoScaleVmProfile", params, `{"createautoscalevmprofileresponse":{"account":"test-account","autoscaleuserid":"test-autoscaleuserid","destroyvmgraceperiod":1,"domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","otherdeployparams":"test-otherdeployparams","project":"test-project","projectid":"test-projectid","serviceofferingid":"test-serviceofferingid","templateid":"test-templateid","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewCreateAutoScaleVmProfileParams("test-serviceofferingid", "test-templateid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAutoscaleuserid("test-autoscaleuserid")
+	p.SetCounterparam(map[string]string{"test-counterparamkey": "test-counterparamvalue"})
+	p.SetDestroyvmgraceperiod(3)
+	p.SetOtherdeployparams("test-otherdeployparams")
+	p.SetServiceofferingid("test-serviceofferingid")
+	p.SetTemplateid("test-templateid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createAutoScaleVmProfile", p.URLValues(), params)
+
+	r, err := cs.AutoScale.CreateAutoScaleVmProfile(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAutoScaleVmProfile: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createAutoScaleVmProfile" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createAutoScaleVmProfile: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createAutoScaleVmProfile: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createAutoScaleVmProfile: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createAutoScaleVmProfile", params, `<createautoscalevmprofileresponse><account>test-account</account><autoscaleuserid>test-autoscaleuserid</autoscaleuserid><destroyvmgraceperiod>1</destroyvmgraceperiod><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><otherdeployparams>test-otherdeployparams</otherdeployparams><project>test-project</project><projectid>test-projectid</projectid><serviceofferingid>test-serviceofferingid</serviceofferingid><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid></createautoscalevmprofileresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.CreateAutoScaleVmProfile(p)
+	if err != nil {
+		t.Fatalf("Failed to call createAutoScaleVmProfile using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteAutoScaleVmProfile(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteAutoScaleVmProfile", params, `{"deleteautoscalevmprofileresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.AutoScale.NewDeleteAutoScaleVmProfileParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteAutoScaleVmProfile", p.URLValues(), params)
+
+	r, err := cs.AutoScale.DeleteAutoScaleVmProfile(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAutoScaleVmProfile: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAutoScaleVmProfile" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteAutoScaleVmProfile: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteAutoScaleVmProfile: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteAutoScaleVmProfile: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteAutoScaleVmProfile", params, `<deleteautoscalevmprofileresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteautoscalevmprofileresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.DeleteAutoScaleVmProfile(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteAutoScaleVmProfile using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListAutoScaleVmProfiles(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"id":                {"test-id"},
+		"isrecursive":       {"true"},
+		"keyword":           {"test-keyword"},
+		"listall":           {"true"},
+		"otherdeployparams": {"test-otherdeployparams"},
+		"page":              {"8"},
+		"pagesize":          {"9"},
+		"projectid":         {"test-projectid"},
+		"templateid":        {"test-templateid"},
+	}
+	cs, done := newTestClient(t, "listAutoScaleVmProfiles", params, `{"listautoscalevmprofilesresponse":{"autoscalevmprofile":[{"account":"test-account","autoscaleuserid":"test-autoscaleuserid","destroyvmgraceperiod":1,"domain":"test-domain","domainid":"test-domainid","id":"test-id","otherdeployparams":"test-otherdeployparams","project":"test-project","projectid":"test-projectid","serviceofferingid":"test-serviceofferingid","templateid":"test-templateid","zoneid":"test-zoneid"}],"count":1}}`)
+	defer done()
+
+	p := cs.AutoScale.NewListAutoScaleVmProfilesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetOtherdeployparams("test-otherdeployparams")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetTemplateid("test-templateid")
+	checkURLValues(t, "listAutoScaleVmProfiles", p.URLValues(), params)
+
+	r, err := cs.AutoScale.ListAutoScaleVmProfiles(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAutoScaleVmProfiles: %v", err)
+	}
+	if r.AutoScaleVmProfiles[0].Account != "test-account" {
+		t.Errorf("Expected r.AutoScaleVmProfiles[0].Account to be %v, got %v", "test-account", r.AutoScaleVmProfiles[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAutoScaleVmProfiles" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAutoScaleVmProfiles: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAutoScaleVmProfiles: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAutoScaleVmProfiles: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*AutoScaleVmProfile
+	count, err := cs.AutoScale.StreamAutoScaleVmProfiles(context.Background(), p, func(v *AutoScaleVmProfile) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAutoScaleVmProfiles: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.AutoScaleVmProfiles) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.AutoScaleVmProfiles)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAutoScaleVmProfiles", params, `<listautoscalevmprofilesresponse><autoscalevmprofile><account>test-account</account><autoscaleuserid>test-autoscaleuserid</autoscaleuserid><destroyvmgraceperiod>1</destroyvmgraceperiod><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><otherdeployparams>test-otherdeployparams</otherdeployparams><project>test-project</project><projectid>test-projectid</projectid><serviceofferingid>test-serviceofferingid</serviceofferingid><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid></autoscalevmprofile><count>1</count></listautoscalevmprofilesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.ListAutoScaleVmProfiles(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAutoScaleVmProfiles using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AutoScale.StreamAutoScaleVmProfiles(context.Background(), p, func(v *AutoScaleVmProfile) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAutoScaleVmProfiles using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.AutoScaleVmProfiles) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.AutoScaleVmProfiles)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateAutoScaleVmProfile(t *testing.T) {
+	params := url.Values{
+		"autoscaleuserid":       {"test-autoscaleuserid"},
+		"counterparam[0].name":  {"test-counterparamkey"},
+		"counterparam[0].value": {"test-counterparamvalue"},
+		"destroyvmgraceperiod":  {"3"},
+		"id":                    {"test-id"},
+		"templateid":            {"test-templateid"},
+	}
+	cs, done := newTestClient(t, "updateAutoScaleVmProfile", params, `{"updateautoscalevmprofileresponse":{"account":"test-account","autoscaleuserid":"test-autoscaleuserid","destroyvmgraceperiod":1,"domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","otherdeployparams":"test-otherdeployparams","project":"test-project","projectid":"test-projectid","serviceofferingid":"test-serviceofferingid","templateid":"test-templateid","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewUpdateAutoScaleVmProfileParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAutoscaleuserid("test-autoscaleuserid")
+	p.SetCounterparam(map[string]string{"test-counterparamkey": "test-counterparamvalue"})
+	p.SetDestroyvmgraceperiod(3)
+	p.SetId("test-id")
+	p.SetTemplateid("test-templateid")
+	checkURLValues(t, "updateAutoScaleVmProfile", p.URLValues(), params)
+
+	r, err := cs.AutoScale.UpdateAutoScaleVmProfile(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAutoScaleVmProfile: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateAutoScaleVmProfile" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateAutoScaleVmProfile: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateAutoScaleVmProfile: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateAutoScaleVmProfile: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateAutoScaleVmProfile", params, `<updateautoscalevmprofileresponse><account>test-account</account><autoscaleuserid>test-autoscaleuserid</autoscaleuserid><destroyvmgraceperiod>1</destroyvmgraceperiod><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><otherdeployparams>test-otherdeployparams</otherdeployparams><project>test-project</project><projectid>test-projectid</projectid><serviceofferingid>test-serviceofferingid</serviceofferingid><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid></updateautoscalevmprofileresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.UpdateAutoScaleVmProfile(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateAutoScaleVmProfile using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateCondition(t *testing.T) {
+	params := url.Values{
+		"account":            {"test-account"},
+		"counterid":          {"test-counterid"},
+		"domainid":           {"test-domainid"},
+		"relationaloperator": {"test-relationaloperator"},
+		"threshold":          {"5"},
+	}
+	cs, done := newTestClient(t, "createCondition", params, `{"createconditionresponse":{"account":"test-account","counter":["test-counter"],"domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","relationaloperator":"test-relationaloperator","threshold":1,"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewCreateConditionParams("test-counterid", "test-relationaloperator", 5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCounterid("test-counterid")
+	p.SetDomainid("test-domainid")
+	p.SetRelationaloperator("test-relationaloperator")
+	p.SetThreshold(5)
+	checkURLValues(t, "createCondition", p.URLValues(), params)
+
+	r, err := cs.AutoScale.CreateCondition(p)
+	if err != nil {
+		t.Fatalf("Failed to call createCondition: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createCondition" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createCondition: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createCondition: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createCondition: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createCondition", params, `<createconditionresponse><account>test-account</account><counter>test-counter</counter><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><project>test-project</project><projectid>test-projectid</projectid><relationaloperator>test-relationaloperator</relationaloperator><threshold>1</threshold><zoneid>test-zoneid</zoneid></createconditionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.CreateCondition(p)
+	if err != nil {
+		t.Fatalf("Failed to call createCondition using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteCondition(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteCondition", params, `{"deleteconditionresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.AutoScale.NewDeleteConditionParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteCondition", p.URLValues(), params)
+
+	r, err := cs.AutoScale.DeleteCondition(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCondition: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCondition" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteCondition: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteCondition: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteCondition: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteCondition", params, `<deleteconditionresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteconditionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.DeleteCondition(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCondition using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListConditions(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"counterid":   {"test-counterid"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"policyid":    {"test-policyid"},
+	}
+	cs, done := newTestClient(t, "listConditions", params, `{"listconditionsresponse":{"condition":[{"account":"test-account","counter":["test-counter"],"domain":"test-domain","domainid":"test-domainid","id":"test-id","project":"test-project","projectid":"test-projectid","relationaloperator":"test-relationaloperator","threshold":1,"zoneid":"test-zoneid"}],"count":1}}`)
+	defer done()
+
+	p := cs.AutoScale.NewListConditionsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCounterid("test-counterid")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetPolicyid("test-policyid")
+	checkURLValues(t, "listConditions", p.URLValues(), params)
+
+	r, err := cs.AutoScale.ListConditions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listConditions: %v", err)
+	}
+	if r.Conditions[0].Account != "test-account" {
+		t.Errorf("Expected r.Conditions[0].Account to be %v, got %v", "test-account", r.Conditions[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listConditions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listConditions: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listConditions: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listConditions: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Condition
+	count, err := cs.AutoScale.StreamConditions(context.Background(), p, func(v *Condition) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listConditions: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Conditions) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Conditions)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listConditions", params, `<listconditionsresponse><condition><account>test-account</account><counter>test-counter</counter><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><project>test-project</project><projectid>test-projectid</projectid><relationaloperator>test-relationaloperator</relationaloperator><threshold>1</threshold><zoneid>test-zoneid</zoneid></condition><count>1</count></listconditionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.ListConditions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listConditions using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AutoScale.StreamConditions(context.Background(), p, func(v *Condition) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listConditions using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Conditions) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Conditions)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateCounter(t *testing.T) {
+	params := url.Values{
+		"name":   {"test-name"},
+		"source": {"test-source"},
+		"value":  {"test-value"},
+	}
+	cs, done := newTestClient(t, "createCounter", params, `{"createcounterresponse":{"id":"test-id","jobid":"test-jobid","name":"test-name","source":"test-source","value":"test-value","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.AutoScale.NewCreateCounterParams("test-name", "test-source", "test-value")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetName("test-name")
+	p.SetSource("test-source")
+	p.SetValue("test-value")
+	checkURLValues(t, "createCounter", p.URLValues(), params)
+
+	r, err := cs.AutoScale.CreateCounter(p)
+	if err != nil {
+		t.Fatalf("Failed to call createCounter: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createCounter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createCounter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createCounter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createCounter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createCounter", params, `<createcounterresponse><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><source>test-source</source><value>test-value</value><zoneid>test-zoneid</zoneid></createcounterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.CreateCounter(p)
+	if err != nil {
+		t.Fatalf("Failed to call createCounter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteCounter(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteCounter", params, `{"deletecounterresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.AutoScale.NewDeleteCounterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteCounter", p.URLValues(), params)
+
+	r, err := cs.AutoScale.DeleteCounter(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCounter: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCounter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteCounter: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteCounter: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteCounter: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteCounter", params, `<deletecounterresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletecounterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.DeleteCounter(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCounter using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListCounters(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"source":   {"test-source"},
+	}
+	cs, done := newTestClient(t, "listCounters", params, `{"listcountersresponse":{"count":1,"counter":[{"id":"test-id","name":"test-name","source":"test-source","value":"test-value","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.AutoScale.NewListCountersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetSource("test-source")
+	checkURLValues(t, "listCounters", p.URLValues(), params)
+
+	r, err := cs.AutoScale.ListCounters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCounters: %v", err)
+	}
+	if r.Counters[0].Id != "test-id" {
+		t.Errorf("Expected r.Counters[0].Id to be %v, got %v", "test-id", r.Counters[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listCounters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listCounters: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listCounters: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listCounters: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Counter
+	count, err := cs.AutoScale.StreamCounters(context.Background(), p, func(v *Counter) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listCounters: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Counters) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Counters)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listCounters", params, `<listcountersresponse><count>1</count><counter><id>test-id</id><name>test-name</name><source>test-source</source><value>test-value</value><zoneid>test-zoneid</zoneid></counter></listcountersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.AutoScale.ListCounters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCounters using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.AutoScale.StreamCounters(context.Background(), p, func(v *Counter) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listCounters using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Counters) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Counters)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,123 +17,61 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
-	"strconv"
-)
-
-type AddBaremetalPxeKickStartServerParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["pxeservertype"]; found {
-		u.Set("pxeservertype", v.(string))
-	}
-	if v, found := p.p["tftpdir"]; found {
-		u.Set("tftpdir", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
-}
-
-func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
-}
 
-func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pxeservertype"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tftpdir"] = v
-	return
+type BaremetalService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+func NewBaremetalService(cs *CloudStackClient) *BaremetalService {
+	return &BaremetalService{cs: cs}
 }
 
-func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
+type AddBaremetalDhcpParams = cloudstackcommon.AddBaremetalDhcpParams
+type AddBaremetalDhcpResponse = cloudstackcommon.AddBaremetalDhcpResponse
 
-// You should always use this function to get a new AddBaremetalPxeKickStartServerParams instance,
+// You should always use this function to get a new AddBaremetalDhcpParams instance,
 // as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams {
-	p := &AddBaremetalPxeKickStartServerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["pxeservertype"] = pxeservertype
-	p.p["tftpdir"] = tftpdir
-	p.p["url"] = url
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - dhcpservertype
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - url
+//   - username: the name of the user
+func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams {
+	p := &AddBaremetalDhcpParams{}
+	p.SetDhcpservertype(dhcpservertype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// add a baremetal pxe server
-func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxeKickStartServer", p.toURLValues())
+// adds a baremetal dhcp server
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListBaremetalDhcp.
+func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addBaremetalDhcp", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddBaremetalPxeKickStartServerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddBaremetalDhcpResponse
+	if err := s.cs.unmarshal("addBaremetalDhcp", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -154,176 +92,105 @@ func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKick
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addBaremetalDhcp", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddBaremetalPxeKickStartServerResponse struct {
-	JobID   string `json:"jobid,omitempty"`
-	Tftpdir string `json:"tftpdir,omitempty"`
-}
-
-type AddBaremetalPxePingServerParams struct {
-	p map[string]interface{}
-}
+type ListBaremetalDhcpParams = cloudstackcommon.ListBaremetalDhcpParams
+type ListBaremetalDhcpResponse = cloudstackcommon.ListBaremetalDhcpResponse
+type BaremetalDhcp = cloudstackcommon.BaremetalDhcp
 
-func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["pingcifspassword"]; found {
-		u.Set("pingcifspassword", v.(string))
-	}
-	if v, found := p.p["pingcifsusername"]; found {
-		u.Set("pingcifsusername", v.(string))
-	}
-	if v, found := p.p["pingdir"]; found {
-		u.Set("pingdir", v.(string))
-	}
-	if v, found := p.p["pingstorageserverip"]; found {
-		u.Set("pingstorageserverip", v.(string))
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["pxeservertype"]; found {
-		u.Set("pxeservertype", v.(string))
-	}
-	if v, found := p.p["tftpdir"]; found {
-		u.Set("tftpdir", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
-}
-
-func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
-}
-
-func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingcifspassword"] = v
-	return
-}
-
-func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingcifsusername"] = v
-	return
-}
-
-func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pingdir"] = v
-	return
+// You should always use this function to get a new ListBaremetalDhcpParams instance,
+// as then you are sure you have configured all required params
+func (s *BaremetalService) NewListBaremetalDhcpParams() *ListBaremetalDhcpParams {
+	p := &ListBaremetalDhcpParams{}
+	return p
 }
 
-func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// list baremetal dhcp servers
+func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pingstorageserverip"] = v
-	return
-}
 
-func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listBaremetalDhcp", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["podid"] = v
-	return
-}
 
-func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListBaremetalDhcpResponse
+	if err := s.cs.unmarshal("listBaremetalDhcp", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["pxeservertype"] = v
-	return
+	return &r, nil
 }
 
-func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListBaremetalDhcp, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *BaremetalService) StreamBaremetalDhcp(ctx context.Context, p *ListBaremetalDhcpParams, fn func(*BaremetalDhcp) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["tftpdir"] = v
-	return
-}
 
-func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+	return s.cs.streamList(ctx, "listBaremetalDhcp", p.URLValues(), "baremetaldhcp", func(b json.RawMessage) error {
+		var v BaremetalDhcp
+		if err := s.cs.unmarshalElement("listBaremetalDhcp", "baremetaldhcp", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
+type AddBaremetalPxeKickStartServerParams = cloudstackcommon.AddBaremetalPxeKickStartServerParams
+type AddBaremetalPxeKickStartServerResponse = cloudstackcommon.AddBaremetalPxeKickStartServerResponse
 
-// You should always use this function to get a new AddBaremetalPxePingServerParams instance,
+// You should always use this function to get a new AddBaremetalPxeKickStartServerParams instance,
 // as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams {
-	p := &AddBaremetalPxePingServerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["pingdir"] = pingdir
-	p.p["pingstorageserverip"] = pingstorageserverip
-	p.p["pxeservertype"] = pxeservertype
-	p.p["tftpdir"] = tftpdir
-	p.p["url"] = url
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - pxeservertype
+//   - tftpdir
+//   - url
+//   - username: the name of the user
+func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams {
+	p := &AddBaremetalPxeKickStartServerParams{}
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetPxeservertype(pxeservertype)
+	p.SetTftpdir(tftpdir)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// add a baremetal ping pxe server
-func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalPxePingServer", p.toURLValues())
+// add a baremetal pxe server
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addBaremetalPxeKickStartServer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddBaremetalPxePingServerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddBaremetalPxeKickStartServerResponse
+	if err := s.cs.unmarshal("addBaremetalPxeKickStartServer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -344,109 +211,59 @@ func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServe
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addBaremetalPxeKickStartServer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddBaremetalPxePingServerResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Pingdir             string `json:"pingdir,omitempty"`
-	Pingstorageserverip string `json:"pingstorageserverip,omitempty"`
-	Tftpdir             string `json:"tftpdir,omitempty"`
-}
-
-type AddBaremetalDhcpParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["dhcpservertype"]; found {
-		u.Set("dhcpservertype", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
-}
-
-func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dhcpservertype"] = v
-	return
-}
+type AddBaremetalPxePingServerParams = cloudstackcommon.AddBaremetalPxePingServerParams
+type AddBaremetalPxePingServerResponse = cloudstackcommon.AddBaremetalPxePingServerResponse
 
-func (p *AddBaremetalDhcpParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
-}
-
-func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *AddBaremetalDhcpParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
-
-func (p *AddBaremetalDhcpParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
-
-// You should always use this function to get a new AddBaremetalDhcpParams instance,
+// You should always use this function to get a new AddBaremetalPxePingServerParams instance,
 // as then you are sure you have configured all required params
-func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams {
-	p := &AddBaremetalDhcpParams{}
-	p.p = make(map[string]interface{})
-	p.p["dhcpservertype"] = dhcpservertype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - pingdir
+//   - pingstorageserverip
+//   - pxeservertype
+//   - tftpdir
+//   - url
+//   - username: the name of the user
+func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams {
+	p := &AddBaremetalPxePingServerParams{}
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetPingdir(pingdir)
+	p.SetPingstorageserverip(pingstorageserverip)
+	p.SetPxeservertype(pxeservertype)
+	p.SetTftpdir(tftpdir)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// adds a baremetal dhcp server
-func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalDhcp", p.toURLValues())
+// add a baremetal ping pxe server
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addBaremetalPxePingServer", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddBaremetalDhcpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddBaremetalPxePingServerResponse
+	if err := s.cs.unmarshal("addBaremetalPxePingServer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -467,216 +284,59 @@ func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBare
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addBaremetalPxePingServer", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddBaremetalDhcpResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Dhcpservertype    string `json:"dhcpservertype,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Url               string `json:"url,omitempty"`
-}
-
-type ListBaremetalDhcpParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["dhcpservertype"]; found {
-		u.Set("dhcpservertype", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dhcpservertype"] = v
-	return
-}
-
-func (p *ListBaremetalDhcpParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListBaremetalDhcpParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListBaremetalDhcpParams instance,
-// as then you are sure you have configured all required params
-func (s *BaremetalService) NewListBaremetalDhcpParams() *ListBaremetalDhcpParams {
-	p := &ListBaremetalDhcpParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// list baremetal dhcp servers
-func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalDhcp", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListBaremetalDhcpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListBaremetalDhcpResponse struct {
-	Count         int              `json:"count"`
-	BaremetalDhcp []*BaremetalDhcp `json:"baremetaldhcp"`
-}
-
-type BaremetalDhcp struct {
-	Dhcpservertype    string `json:"dhcpservertype,omitempty"`
-	Id                string `json:"id,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Url               string `json:"url,omitempty"`
-}
-
-type ListBaremetalPxeServersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListBaremetalPxeServersParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListBaremetalPxeServersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListBaremetalPxeServersParams = cloudstackcommon.ListBaremetalPxeServersParams
+type ListBaremetalPxeServersResponse = cloudstackcommon.ListBaremetalPxeServersResponse
+type BaremetalPxeServer = cloudstackcommon.BaremetalPxeServer
 
 // You should always use this function to get a new ListBaremetalPxeServersParams instance,
 // as then you are sure you have configured all required params
 func (s *BaremetalService) NewListBaremetalPxeServersParams() *ListBaremetalPxeServersParams {
 	p := &ListBaremetalPxeServersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // list baremetal pxe server
 func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalPxeServers", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listBaremetalPxeServers", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBaremetalPxeServersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listBaremetalPxeServers", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListBaremetalPxeServersResponse struct {
-	Count               int                   `json:"count"`
-	BaremetalPxeServers []*BaremetalPxeServer `json:"baremetalpxeserver"`
-}
+// Lists the objects like ListBaremetalPxeServers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *BaremetalService) StreamBaremetalPxeServers(ctx context.Context, p *ListBaremetalPxeServersParams, fn func(*BaremetalPxeServer) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type BaremetalPxeServer struct {
-	Id                string `json:"id,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Url               string `json:"url,omitempty"`
+	return s.cs.streamList(ctx, "listBaremetalPxeServers", p.URLValues(), "baremetalpxeserver", func(b json.RawMessage) error {
+		var v BaremetalPxeServer
+		if err := s.cs.unmarshalElement("listBaremetalPxeServers", "baremetalpxeserver", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
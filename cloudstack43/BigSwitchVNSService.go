@@ -17,64 +17,55 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
-	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddBigSwitchVnsDeviceParams struct {
-	p map[string]interface{}
+type BigSwitchVNSService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddBigSwitchVnsDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	return u
+func NewBigSwitchVNSService(cs *CloudStackClient) *BigSwitchVNSService {
+	return &BigSwitchVNSService{cs: cs}
 }
 
-func (p *AddBigSwitchVnsDeviceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
-	return
-}
-
-func (p *AddBigSwitchVnsDeviceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
+type AddBigSwitchVnsDeviceParams = cloudstackcommon.AddBigSwitchVnsDeviceParams
+type AddBigSwitchVnsDeviceResponse = cloudstackcommon.AddBigSwitchVnsDeviceResponse
 
 // You should always use this function to get a new AddBigSwitchVnsDeviceParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hostname
+//   - physicalnetworkid: the ID of the physical network
 func (s *BigSwitchVNSService) NewAddBigSwitchVnsDeviceParams(hostname string, physicalnetworkid string) *AddBigSwitchVnsDeviceParams {
 	p := &AddBigSwitchVnsDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetHostname(hostname)
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
 // Adds a BigSwitch VNS device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListBigSwitchVnsDevices.
 func (s *BigSwitchVNSService) AddBigSwitchVnsDevice(p *AddBigSwitchVnsDeviceParams) (*AddBigSwitchVnsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("addBigSwitchVnsDevice", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addBigSwitchVnsDevice", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBigSwitchVnsDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addBigSwitchVnsDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -95,63 +86,46 @@ func (s *BigSwitchVNSService) AddBigSwitchVnsDevice(p *AddBigSwitchVnsDevicePara
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addBigSwitchVnsDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddBigSwitchVnsDeviceResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Bigswitchdevicename string `json:"bigswitchdevicename,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Physicalnetworkid   string `json:"physicalnetworkid,omitempty"`
-	Provider            string `json:"provider,omitempty"`
-	Vnsdeviceid         string `json:"vnsdeviceid,omitempty"`
-}
-
-type DeleteBigSwitchVnsDeviceParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteBigSwitchVnsDeviceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["vnsdeviceid"]; found {
-		u.Set("vnsdeviceid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteBigSwitchVnsDeviceParams) SetVnsdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vnsdeviceid"] = v
-	return
-}
+type DeleteBigSwitchVnsDeviceParams = cloudstackcommon.DeleteBigSwitchVnsDeviceParams
+type DeleteBigSwitchVnsDeviceResponse = cloudstackcommon.DeleteBigSwitchVnsDeviceResponse
 
 // You should always use this function to get a new DeleteBigSwitchVnsDeviceParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - vnsdeviceid
 func (s *BigSwitchVNSService) NewDeleteBigSwitchVnsDeviceParams(vnsdeviceid string) *DeleteBigSwitchVnsDeviceParams {
 	p := &DeleteBigSwitchVnsDeviceParams{}
-	p.p = make(map[string]interface{})
-	p.p["vnsdeviceid"] = vnsdeviceid
+	p.SetVnsdeviceid(vnsdeviceid)
 	return p
 }
 
-//  delete a bigswitch vns device
+//	delete a bigswitch vns device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListBigSwitchVnsDevices.
 func (s *BigSwitchVNSService) DeleteBigSwitchVnsDevice(p *DeleteBigSwitchVnsDeviceParams) (*DeleteBigSwitchVnsDeviceResponse, error) {
-	resp, err := s.cs.newRequest("deleteBigSwitchVnsDevice", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteBigSwitchVnsDevice", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBigSwitchVnsDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteBigSwitchVnsDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -167,119 +141,59 @@ func (s *BigSwitchVNSService) DeleteBigSwitchVnsDevice(p *DeleteBigSwitchVnsDevi
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteBigSwitchVnsDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteBigSwitchVnsDeviceResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListBigSwitchVnsDevicesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListBigSwitchVnsDevicesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["vnsdeviceid"]; found {
-		u.Set("vnsdeviceid", v.(string))
-	}
-	return u
-}
-
-func (p *ListBigSwitchVnsDevicesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListBigSwitchVnsDevicesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListBigSwitchVnsDevicesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListBigSwitchVnsDevicesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
-
-func (p *ListBigSwitchVnsDevicesParams) SetVnsdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vnsdeviceid"] = v
-	return
-}
+type ListBigSwitchVnsDevicesParams = cloudstackcommon.ListBigSwitchVnsDevicesParams
+type ListBigSwitchVnsDevicesResponse = cloudstackcommon.ListBigSwitchVnsDevicesResponse
+type BigSwitchVnsDevice = cloudstackcommon.BigSwitchVnsDevice
 
 // You should always use this function to get a new ListBigSwitchVnsDevicesParams instance,
 // as then you are sure you have configured all required params
 func (s *BigSwitchVNSService) NewListBigSwitchVnsDevicesParams() *ListBigSwitchVnsDevicesParams {
 	p := &ListBigSwitchVnsDevicesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists BigSwitch Vns devices
 func (s *BigSwitchVNSService) ListBigSwitchVnsDevices(p *ListBigSwitchVnsDevicesParams) (*ListBigSwitchVnsDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBigSwitchVnsDevices", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listBigSwitchVnsDevices", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBigSwitchVnsDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listBigSwitchVnsDevices", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListBigSwitchVnsDevicesResponse struct {
-	Count               int                   `json:"count"`
-	BigSwitchVnsDevices []*BigSwitchVnsDevice `json:"bigswitchvnsdevice"`
-}
+// Lists the objects like ListBigSwitchVnsDevices, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *BigSwitchVNSService) StreamBigSwitchVnsDevices(ctx context.Context, p *ListBigSwitchVnsDevicesParams, fn func(*BigSwitchVnsDevice) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type BigSwitchVnsDevice struct {
-	Bigswitchdevicename string `json:"bigswitchdevicename,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Physicalnetworkid   string `json:"physicalnetworkid,omitempty"`
-	Provider            string `json:"provider,omitempty"`
-	Vnsdeviceid         string `json:"vnsdeviceid,omitempty"`
+	return s.cs.streamList(ctx, "listBigSwitchVnsDevices", p.URLValues(), "bigswitchvnsdevice", func(b json.RawMessage) error {
+		var v BigSwitchVnsDevice
+		if err := s.cs.unmarshalElement("listBigSwitchVnsDevices", "bigswitchvnsdevice", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -17,98 +17,51 @@
 package cloudstack43
 
 import (
-	"encoding/json"
-	"net/url"
-	"strconv"
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type UploadCustomCertificateParams struct {
-	p map[string]interface{}
+type CertificateService struct {
+	cs *CloudStackClient
 }
 
-func (p *UploadCustomCertificateParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["certificate"]; found {
-		u.Set("certificate", v.(string))
-	}
-	if v, found := p.p["domainsuffix"]; found {
-		u.Set("domainsuffix", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["privatekey"]; found {
-		u.Set("privatekey", v.(string))
-	}
-	return u
+func NewCertificateService(cs *CloudStackClient) *CertificateService {
+	return &CertificateService{cs: cs}
 }
 
-func (p *UploadCustomCertificateParams) SetCertificate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["certificate"] = v
-	return
-}
-
-func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainsuffix"] = v
-	return
-}
-
-func (p *UploadCustomCertificateParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UploadCustomCertificateParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privatekey"] = v
-	return
-}
+type UploadCustomCertificateParams = cloudstackcommon.UploadCustomCertificateParams
+type UploadCustomCertificateResponse = cloudstackcommon.UploadCustomCertificateResponse
 
 // You should always use this function to get a new UploadCustomCertificateParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - certificate
+//   - domainsuffix
 func (s *CertificateService) NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams {
 	p := &UploadCustomCertificateParams{}
-	p.p = make(map[string]interface{})
-	p.p["certificate"] = certificate
-	p.p["domainsuffix"] = domainsuffix
+	p.SetCertificate(certificate)
+	p.SetDomainsuffix(domainsuffix)
 	return p
 }
 
 // Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
-	resp, err := s.cs.newRequest("uploadCustomCertificate", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("uploadCustomCertificate", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UploadCustomCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("uploadCustomCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -129,14 +82,9 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("uploadCustomCertificate", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type UploadCustomCertificateResponse struct {
-	JobID   string `json:"jobid,omitempty"`
-	Message string `json:"message,omitempty"`
-}
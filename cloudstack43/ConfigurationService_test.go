@@ -0,0 +1,573 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListCapabilities(t *testing.T) {
+	params := url.Values{}
+	cs, done := newTestClient(t, "listCapabilities", params, `{"listcapabilitiesresponse":{"capability":[{"allowusercreateprojects":true,"apilimitinterval":1,"apilimitmax":1,"cloudstackversion":"test-cloudstackversion","customdiskofferingmaxsize":1,"kvmsnapshotenabled":true,"projectinviterequired":true,"regionsecondaryenabled":true,"securitygroupsenabled":true,"supportELB":"test-supportELB","userpublictemplateenabled":true}],"count":1}}`)
+	defer done()
+
+	p := cs.Configuration.NewListCapabilitiesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listCapabilities", p.URLValues(), params)
+
+	r, err := cs.Configuration.ListCapabilities(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCapabilities: %v", err)
+	}
+	if r.Capabilities[0].Cloudstackversion != "test-cloudstackversion" {
+		t.Errorf("Expected r.Capabilities[0].Cloudstackversion to be %v, got %v", "test-cloudstackversion", r.Capabilities[0].Cloudstackversion)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listCapabilities" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listCapabilities: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listCapabilities: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listCapabilities: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Capability
+	count, err := cs.Configuration.StreamCapabilities(context.Background(), p, func(v *Capability) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listCapabilities: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Capabilities) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Capabilities)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listCapabilities", params, `<listcapabilitiesresponse><capability><allowusercreateprojects>true</allowusercreateprojects><apilimitinterval>1</apilimitinterval><apilimitmax>1</apilimitmax><cloudstackversion>test-cloudstackversion</cloudstackversion><customdiskofferingmaxsize>1</customdiskofferingmaxsize><kvmsnapshotenabled>true</kvmsnapshotenabled><projectinviterequired>true</projectinviterequired><regionsecondaryenabled>true</regionsecondaryenabled><securitygroupsenabled>true</securitygroupsenabled><supportELB>test-supportELB</supportELB><userpublictemplateenabled>true</userpublictemplateenabled></capability><count>1</count></listcapabilitiesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.ListCapabilities(p)
+	if err != nil {
+		t.Fatalf("Failed to call listCapabilities using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Configuration.StreamCapabilities(context.Background(), p, func(v *Capability) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listCapabilities using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Capabilities) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Capabilities)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListConfigurations(t *testing.T) {
+	params := url.Values{
+		"accountid": {"test-accountid"},
+		"category":  {"test-category"},
+		"clusterid": {"test-clusterid"},
+		"keyword":   {"test-keyword"},
+		"name":      {"test-name"},
+		"page":      {"6"},
+		"pagesize":  {"7"},
+		"storageid": {"test-storageid"},
+		"zoneid":    {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listConfigurations", params, `{"listconfigurationsresponse":{"configuration":[{"category":"test-category","description":"test-description","id":1,"name":"test-name","scope":"test-scope","value":"test-value"}],"count":1}}`)
+	defer done()
+
+	p := cs.Configuration.NewListConfigurationsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccountid("test-accountid")
+	p.SetCategory("test-category")
+	p.SetClusterid("test-clusterid")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetStorageid("test-storageid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listConfigurations", p.URLValues(), params)
+
+	r, err := cs.Configuration.ListConfigurations(p)
+	if err != nil {
+		t.Fatalf("Failed to call listConfigurations: %v", err)
+	}
+	if r.Configurations[0].Category != "test-category" {
+		t.Errorf("Expected r.Configurations[0].Category to be %v, got %v", "test-category", r.Configurations[0].Category)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listConfigurations" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listConfigurations: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listConfigurations: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listConfigurations: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Configuration
+	count, err := cs.Configuration.StreamConfigurations(context.Background(), p, func(v *Configuration) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listConfigurations: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Configurations) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Configurations)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listConfigurations", params, `<listconfigurationsresponse><configuration><category>test-category</category><description>test-description</description><id>1</id><name>test-name</name><scope>test-scope</scope><value>test-value</value></configuration><count>1</count></listconfigurationsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.ListConfigurations(p)
+	if err != nil {
+		t.Fatalf("Failed to call listConfigurations using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Configuration.StreamConfigurations(context.Background(), p, func(v *Configuration) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listConfigurations using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Configurations) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Configurations)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateConfiguration(t *testing.T) {
+	params := url.Values{
+		"accountid": {"test-accountid"},
+		"clusterid": {"test-clusterid"},
+		"name":      {"test-name"},
+		"storageid": {"test-storageid"},
+		"value":     {"test-value"},
+		"zoneid":    {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "updateConfiguration", params, `{"updateconfigurationresponse":{"category":"test-category","description":"test-description","id":1,"name":"test-name","scope":"test-scope","value":"test-value"}}`)
+	defer done()
+
+	p := cs.Configuration.NewUpdateConfigurationParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccountid("test-accountid")
+	p.SetClusterid("test-clusterid")
+	p.SetName("test-name")
+	p.SetStorageid("test-storageid")
+	p.SetValue("test-value")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "updateConfiguration", p.URLValues(), params)
+
+	r, err := cs.Configuration.UpdateConfiguration(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateConfiguration: %v", err)
+	}
+	if r.Category != "test-category" {
+		t.Errorf("Expected r.Category to be %v, got %v", "test-category", r.Category)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateConfiguration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateConfiguration: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateConfiguration: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateConfiguration: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateConfiguration", params, `<updateconfigurationresponse><category>test-category</category><description>test-description</description><id>1</id><name>test-name</name><scope>test-scope</scope><value>test-value</value></updateconfigurationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.UpdateConfiguration(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateConfiguration using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListDeploymentPlanners(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+	cs, done := newTestClient(t, "listDeploymentPlanners", params, `{"listdeploymentplannersresponse":{"count":1,"deploymentplanner":[{"name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.Configuration.NewListDeploymentPlannersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	checkURLValues(t, "listDeploymentPlanners", p.URLValues(), params)
+
+	r, err := cs.Configuration.ListDeploymentPlanners(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDeploymentPlanners: %v", err)
+	}
+	if r.DeploymentPlanners[0].Name != "test-name" {
+		t.Errorf("Expected r.DeploymentPlanners[0].Name to be %v, got %v", "test-name", r.DeploymentPlanners[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDeploymentPlanners" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDeploymentPlanners: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDeploymentPlanners: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDeploymentPlanners: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DeploymentPlanner
+	count, err := cs.Configuration.StreamDeploymentPlanners(context.Background(), p, func(v *DeploymentPlanner) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDeploymentPlanners: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DeploymentPlanners) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DeploymentPlanners)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDeploymentPlanners", params, `<listdeploymentplannersresponse><count>1</count><deploymentplanner><name>test-name</name></deploymentplanner></listdeploymentplannersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.ListDeploymentPlanners(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDeploymentPlanners using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Configuration.StreamDeploymentPlanners(context.Background(), p, func(v *DeploymentPlanner) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDeploymentPlanners using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DeploymentPlanners) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DeploymentPlanners)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddLdapConfiguration(t *testing.T) {
+	params := url.Values{
+		"hostname": {"test-hostname"},
+		"port":     {"2"},
+	}
+	cs, done := newTestClient(t, "addLdapConfiguration", params, `{"addldapconfigurationresponse":{"hostname":"test-hostname","port":1}}`)
+	defer done()
+
+	p := cs.Configuration.NewAddLdapConfigurationParams("test-hostname", 2)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	p.SetPort(2)
+	checkURLValues(t, "addLdapConfiguration", p.URLValues(), params)
+
+	r, err := cs.Configuration.AddLdapConfiguration(p)
+	if err != nil {
+		t.Fatalf("Failed to call addLdapConfiguration: %v", err)
+	}
+	if r.Hostname != "test-hostname" {
+		t.Errorf("Expected r.Hostname to be %v, got %v", "test-hostname", r.Hostname)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addLdapConfiguration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addLdapConfiguration: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addLdapConfiguration: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addLdapConfiguration: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addLdapConfiguration", params, `<addldapconfigurationresponse><hostname>test-hostname</hostname><port>1</port></addldapconfigurationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.AddLdapConfiguration(p)
+	if err != nil {
+		t.Fatalf("Failed to call addLdapConfiguration using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteLdapConfiguration(t *testing.T) {
+	params := url.Values{
+		"hostname": {"test-hostname"},
+	}
+	cs, done := newTestClient(t, "deleteLdapConfiguration", params, `{"deleteldapconfigurationresponse":{"hostname":"test-hostname","port":1}}`)
+	defer done()
+
+	p := cs.Configuration.NewDeleteLdapConfigurationParams("test-hostname")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	checkURLValues(t, "deleteLdapConfiguration", p.URLValues(), params)
+
+	r, err := cs.Configuration.DeleteLdapConfiguration(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLdapConfiguration: %v", err)
+	}
+	if r.Hostname != "test-hostname" {
+		t.Errorf("Expected r.Hostname to be %v, got %v", "test-hostname", r.Hostname)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLdapConfiguration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteLdapConfiguration: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteLdapConfiguration: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteLdapConfiguration: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteLdapConfiguration", params, `<deleteldapconfigurationresponse><hostname>test-hostname</hostname><port>1</port></deleteldapconfigurationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.DeleteLdapConfiguration(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLdapConfiguration using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLdapConfigurations(t *testing.T) {
+	params := url.Values{
+		"hostname": {"test-hostname"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"port":     {"5"},
+	}
+	cs, done := newTestClient(t, "listLdapConfigurations", params, `{"listldapconfigurationsresponse":{"count":1,"ldapconfiguration":[{"hostname":"test-hostname","port":1}]}}`)
+	defer done()
+
+	p := cs.Configuration.NewListLdapConfigurationsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPort(5)
+	checkURLValues(t, "listLdapConfigurations", p.URLValues(), params)
+
+	r, err := cs.Configuration.ListLdapConfigurations(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLdapConfigurations: %v", err)
+	}
+	if r.LdapConfigurations[0].Hostname != "test-hostname" {
+		t.Errorf("Expected r.LdapConfigurations[0].Hostname to be %v, got %v", "test-hostname", r.LdapConfigurations[0].Hostname)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLdapConfigurations" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLdapConfigurations: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLdapConfigurations: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLdapConfigurations: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LdapConfiguration
+	count, err := cs.Configuration.StreamLdapConfigurations(context.Background(), p, func(v *LdapConfiguration) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLdapConfigurations: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LdapConfigurations) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LdapConfigurations)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLdapConfigurations", params, `<listldapconfigurationsresponse><count>1</count><ldapconfiguration><hostname>test-hostname</hostname><port>1</port></ldapconfiguration></listldapconfigurationsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Configuration.ListLdapConfigurations(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLdapConfigurations using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Configuration.StreamLdapConfigurations(context.Background(), p, func(v *LdapConfiguration) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLdapConfigurations using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LdapConfigurations) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LdapConfigurations)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,515 +17,753 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type DiskOfferingService struct {
+	cs *CloudStackClient
+}
+
+func NewDiskOfferingService(cs *CloudStackClient) *DiskOfferingService {
+	return &DiskOfferingService{cs: cs}
+}
+
 type CreateDiskOfferingParams struct {
-	p map[string]interface{}
+	Bytesreadrate             *int64  `json:"bytesreadrate,omitempty" yaml:"bytesreadrate,omitempty"`
+	Byteswriterate            *int64  `json:"byteswriterate,omitempty" yaml:"byteswriterate,omitempty"`
+	Customized                *bool   `json:"customized,omitempty" yaml:"customized,omitempty"`
+	Customizediops            *bool   `json:"customizediops,omitempty" yaml:"customizediops,omitempty"`
+	Disksize                  *int64  `json:"disksize,omitempty" yaml:"disksize,omitempty"`
+	Displayoffering           *bool   `json:"displayoffering,omitempty" yaml:"displayoffering,omitempty"`
+	Displaytext               *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid                  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hypervisorsnapshotreserve *int    `json:"hypervisorsnapshotreserve,omitempty" yaml:"hypervisorsnapshotreserve,omitempty"`
+	Iopsreadrate              *int64  `json:"iopsreadrate,omitempty" yaml:"iopsreadrate,omitempty"`
+	Iopswriterate             *int64  `json:"iopswriterate,omitempty" yaml:"iopswriterate,omitempty"`
+	Maxiops                   *int64  `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops                   *int64  `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Name                      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Storagetype               *string `json:"storagetype,omitempty" yaml:"storagetype,omitempty"`
+	Tags                      *string `json:"tags,omitempty" yaml:"tags,omitempty"`
 }
 
-func (p *CreateDiskOfferingParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateDiskOfferingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bytesreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Bytesreadrate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("bytesreadrate", vv)
 	}
-	if v, found := p.p["byteswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Byteswriterate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("byteswriterate", vv)
 	}
-	if v, found := p.p["customized"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Customized; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("customized", vv)
 	}
-	if v, found := p.p["customizediops"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Customizediops; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("customizediops", vv)
 	}
-	if v, found := p.p["disksize"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Disksize; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("disksize", vv)
 	}
-	if v, found := p.p["displayoffering"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Displayoffering; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("displayoffering", vv)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["hypervisorsnapshotreserve"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Hypervisorsnapshotreserve; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("hypervisorsnapshotreserve", vv)
 	}
-	if v, found := p.p["iopsreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Iopsreadrate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("iopsreadrate", vv)
 	}
-	if v, found := p.p["iopswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Iopswriterate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("iopswriterate", vv)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Maxiops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("maxiops", vv)
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Miniops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("miniops", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["storagetype"]; found {
-		u.Set("storagetype", v.(string))
+	if v := p.Storagetype; v != nil {
+		u.Set("storagetype", *v)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if v := p.Tags; v != nil {
+		u.Set("tags", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *CreateDiskOfferingParams) Command() string {
+	return "createDiskOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateDiskOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateDiskOfferingParams) ResponseType() interface{} {
+	return &CreateDiskOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateDiskOfferingParams) Validate() error {
+	v := &validator{api: "createDiskOffering"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
 func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadrate"] = v
+	p.Bytesreadrate = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetBytesreadrate() int64 {
+	if p.Bytesreadrate == nil {
+		return 0
 	}
-	p.p["byteswriterate"] = v
-	return
+	return *p.Bytesreadrate
 }
 
-func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customized"] = v
-	return
+func (p *CreateDiskOfferingParams) HasBytesreadrate() bool {
+	return p.Bytesreadrate != nil
 }
 
-func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customizediops"] = v
-	return
+func (p *CreateDiskOfferingParams) ResetBytesreadrate() {
+	p.Bytesreadrate = nil
 }
 
-func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["disksize"] = v
+func (p *CreateDiskOfferingParams) SetByteswriterate(v int64) {
+	p.Byteswriterate = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetByteswriterate() int64 {
+	if p.Byteswriterate == nil {
+		return 0
 	}
-	p.p["displayoffering"] = v
-	return
+	return *p.Byteswriterate
 }
 
-func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+func (p *CreateDiskOfferingParams) HasByteswriterate() bool {
+	return p.Byteswriterate != nil
 }
 
-func (p *CreateDiskOfferingParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+func (p *CreateDiskOfferingParams) ResetByteswriterate() {
+	p.Byteswriterate = nil
+}
+
+func (p *CreateDiskOfferingParams) SetCustomized(v bool) {
+	p.Customized = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetCustomized() bool {
+	if p.Customized == nil {
+		return false
 	}
-	p.p["hypervisorsnapshotreserve"] = v
+	return *p.Customized
+}
+
+func (p *CreateDiskOfferingParams) HasCustomized() bool {
+	return p.Customized != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetCustomized() {
+	p.Customized = nil
+}
+
+func (p *CreateDiskOfferingParams) SetCustomizediops(v bool) {
+	p.Customizediops = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetCustomizediops() bool {
+	if p.Customizediops == nil {
+		return false
 	}
-	p.p["iopsreadrate"] = v
+	return *p.Customizediops
+}
+
+func (p *CreateDiskOfferingParams) HasCustomizediops() bool {
+	return p.Customizediops != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetCustomizediops() {
+	p.Customizediops = nil
+}
+
+func (p *CreateDiskOfferingParams) SetDisksize(v int64) {
+	p.Disksize = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetDisksize() int64 {
+	if p.Disksize == nil {
+		return 0
 	}
-	p.p["iopswriterate"] = v
+	return *p.Disksize
+}
+
+func (p *CreateDiskOfferingParams) HasDisksize() bool {
+	return p.Disksize != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetDisksize() {
+	p.Disksize = nil
+}
+
+func (p *CreateDiskOfferingParams) SetDisplayoffering(v bool) {
+	p.Displayoffering = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetDisplayoffering() bool {
+	if p.Displayoffering == nil {
+		return false
 	}
-	p.p["maxiops"] = v
+	return *p.Displayoffering
+}
+
+func (p *CreateDiskOfferingParams) HasDisplayoffering() bool {
+	return p.Displayoffering != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetDisplayoffering() {
+	p.Displayoffering = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *CreateDiskOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["miniops"] = v
+	return *p.Displaytext
+}
+
+func (p *CreateDiskOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateDiskOfferingParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Domainid
+}
+
+func (p *CreateDiskOfferingParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreateDiskOfferingParams) SetHypervisorsnapshotreserve(v int) {
+	p.Hypervisorsnapshotreserve = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetStoragetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetHypervisorsnapshotreserve() int {
+	if p.Hypervisorsnapshotreserve == nil {
+		return 0
 	}
-	p.p["storagetype"] = v
+	return *p.Hypervisorsnapshotreserve
+}
+
+func (p *CreateDiskOfferingParams) HasHypervisorsnapshotreserve() bool {
+	return p.Hypervisorsnapshotreserve != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetHypervisorsnapshotreserve() {
+	p.Hypervisorsnapshotreserve = nil
+}
+
+func (p *CreateDiskOfferingParams) SetIopsreadrate(v int64) {
+	p.Iopsreadrate = &v
 	return
 }
 
-func (p *CreateDiskOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetIopsreadrate() int64 {
+	if p.Iopsreadrate == nil {
+		return 0
 	}
-	p.p["tags"] = v
-	return
+	return *p.Iopsreadrate
 }
 
-// You should always use this function to get a new CreateDiskOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams {
-	p := &CreateDiskOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	return p
+func (p *CreateDiskOfferingParams) HasIopsreadrate() bool {
+	return p.Iopsreadrate != nil
 }
 
-// Creates a disk offering.
-func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createDiskOffering", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateDiskOfferingParams) ResetIopsreadrate() {
+	p.Iopsreadrate = nil
+}
 
-	var r CreateDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateDiskOfferingParams) SetIopswriterate(v int64) {
+	p.Iopswriterate = &v
+	return
+}
+
+func (p *CreateDiskOfferingParams) GetIopswriterate() int64 {
+	if p.Iopswriterate == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Iopswriterate
 }
 
-type CreateDiskOfferingResponse struct {
-	Created            string `json:"created,omitempty"`
-	DiskBytesReadRate  int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate   int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate  int64  `json:"diskIopsWriteRate,omitempty"`
-	Disksize           int64  `json:"disksize,omitempty"`
-	Displayoffering    bool   `json:"displayoffering,omitempty"`
-	Displaytext        string `json:"displaytext,omitempty"`
-	Domain             string `json:"domain,omitempty"`
-	Domainid           string `json:"domainid,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Iscustomized       bool   `json:"iscustomized,omitempty"`
-	Iscustomizediops   bool   `json:"iscustomizediops,omitempty"`
-	Maxiops            int64  `json:"maxiops,omitempty"`
-	Miniops            int64  `json:"miniops,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Storagetype        string `json:"storagetype,omitempty"`
-	Tags               string `json:"tags,omitempty"`
+func (p *CreateDiskOfferingParams) HasIopswriterate() bool {
+	return p.Iopswriterate != nil
 }
 
-type UpdateDiskOfferingParams struct {
-	p map[string]interface{}
+func (p *CreateDiskOfferingParams) ResetIopswriterate() {
+	p.Iopswriterate = nil
 }
 
-func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["displayoffering"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayoffering", vv)
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
-	}
-	return u
+func (p *CreateDiskOfferingParams) SetMaxiops(v int64) {
+	p.Maxiops = &v
+	return
 }
 
-func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetMaxiops() int64 {
+	if p.Maxiops == nil {
+		return 0
 	}
-	p.p["displayoffering"] = v
+	return *p.Maxiops
+}
+
+func (p *CreateDiskOfferingParams) HasMaxiops() bool {
+	return p.Maxiops != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetMaxiops() {
+	p.Maxiops = nil
+}
+
+func (p *CreateDiskOfferingParams) SetMiniops(v int64) {
+	p.Miniops = &v
 	return
 }
 
-func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetMiniops() int64 {
+	if p.Miniops == nil {
+		return 0
 	}
-	p.p["displaytext"] = v
+	return *p.Miniops
+}
+
+func (p *CreateDiskOfferingParams) HasMiniops() bool {
+	return p.Miniops != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetMiniops() {
+	p.Miniops = nil
+}
+
+// This param is required.
+func (p *CreateDiskOfferingParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UpdateDiskOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Name
+}
+
+func (p *CreateDiskOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreateDiskOfferingParams) SetStoragetype(v string) {
+	p.Storagetype = &v
 	return
 }
 
-func (p *UpdateDiskOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetStoragetype() string {
+	if p.Storagetype == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Storagetype
+}
+
+func (p *CreateDiskOfferingParams) HasStoragetype() bool {
+	return p.Storagetype != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetStoragetype() {
+	p.Storagetype = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *CreateDiskOfferingParams) SetTags(v string) {
+	p.Tags = &v
 	return
 }
 
-func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateDiskOfferingParams) GetTags() string {
+	if p.Tags == nil {
+		return ""
 	}
-	p.p["sortkey"] = v
-	return
+	return *p.Tags
 }
 
-// You should always use this function to get a new UpdateDiskOfferingParams instance,
+func (p *CreateDiskOfferingParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *CreateDiskOfferingParams) ResetTags() {
+	p.Tags = nil
+}
+
+// You should always use this function to get a new CreateDiskOfferingParams instance,
 // as then you are sure you have configured all required params
-func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams {
-	p := &UpdateDiskOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
+func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams {
+	p := &CreateDiskOfferingParams{}
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
 	return p
 }
 
-// Updates a disk offering.
-func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateDiskOffering", p.toURLValues())
+// Creates a disk offering.
+//
+// See also ListDiskOfferings, GetDiskOfferingID, GetDiskOfferingByName, GetDiskOfferingByID.
+func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createDiskOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateDiskOfferingResponse
+	if err := s.cs.unmarshal("createDiskOffering", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateDiskOfferingResponse struct {
-	Created            string `json:"created,omitempty"`
-	DiskBytesReadRate  int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate   int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate  int64  `json:"diskIopsWriteRate,omitempty"`
-	Disksize           int64  `json:"disksize,omitempty"`
-	Displayoffering    bool   `json:"displayoffering,omitempty"`
-	Displaytext        string `json:"displaytext,omitempty"`
-	Domain             string `json:"domain,omitempty"`
-	Domainid           string `json:"domainid,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Iscustomized       bool   `json:"iscustomized,omitempty"`
-	Iscustomizediops   bool   `json:"iscustomizediops,omitempty"`
-	Maxiops            int64  `json:"maxiops,omitempty"`
-	Miniops            int64  `json:"miniops,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Storagetype        string `json:"storagetype,omitempty"`
-	Tags               string `json:"tags,omitempty"`
-}
-
-type DeleteDiskOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+type CreateDiskOfferingResponse struct {
+	Created            Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	DiskBytesReadRate  int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate   int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate  int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Disksize           int64                      `json:"disksize,omitempty" xml:"disksize,omitempty"`
+	Displayoffering    Bool                       `json:"displayoffering,omitempty" xml:"displayoffering,omitempty"`
+	Displaytext        string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscustomized       Bool                       `json:"iscustomized,omitempty" xml:"iscustomized,omitempty"`
+	Iscustomizediops   Bool                       `json:"iscustomizediops,omitempty" xml:"iscustomizediops,omitempty"`
+	Maxiops            int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops            int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name               string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Storagetype        string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags               string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *DeleteDiskOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteDiskOfferingParams = cloudstackcommon.DeleteDiskOfferingParams
+type DeleteDiskOfferingResponse = cloudstackcommon.DeleteDiskOfferingResponse
 
 // You should always use this function to get a new DeleteDiskOfferingParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams {
 	p := &DeleteDiskOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a disk offering.
+//
+// See also ListDiskOfferings, GetDiskOfferingID, GetDiskOfferingByName, GetDiskOfferingByID.
 func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteDiskOffering", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteDiskOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteDiskOffering", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteDiskOfferingResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
 type ListDiskOfferingsParams struct {
-	p map[string]interface{}
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *ListDiskOfferingsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDiskOfferingsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListDiskOfferingsParams) Command() string {
+	return "listDiskOfferings"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDiskOfferingsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDiskOfferingsParams) ResponseType() interface{} {
+	return &ListDiskOfferingsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDiskOfferingsParams) Validate() error {
+	v := &validator{api: "listDiskOfferings"}
+	return v.err()
+}
+
+// Sets the 'domainid' param: the ID of the domain.
 func (p *ListDiskOfferingsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+	p.Domainid = &v
 	return
 }
 
-func (p *ListDiskOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListDiskOfferingsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Domainid
+}
+
+func (p *ListDiskOfferingsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListDiskOfferingsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListDiskOfferingsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListDiskOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListDiskOfferingsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Id
+}
+
+func (p *ListDiskOfferingsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListDiskOfferingsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDiskOfferingsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListDiskOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListDiskOfferingsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Keyword
+}
+
+func (p *ListDiskOfferingsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDiskOfferingsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListDiskOfferingsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListDiskOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListDiskOfferingsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Name
+}
+
+func (p *ListDiskOfferingsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListDiskOfferingsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDiskOfferingsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListDiskOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListDiskOfferingsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListDiskOfferingsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDiskOfferingsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDiskOfferingsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
+func (p *ListDiskOfferingsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDiskOfferingsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDiskOfferingsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
 // You should always use this function to get a new ListDiskOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *DiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsParams {
 	p := &ListDiskOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DiskOfferingService) GetDiskOfferingID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListDiskOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListDiskOfferings(p)
 	if err != nil {
@@ -540,24 +778,37 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string) (string, error) {
 		return l.DiskOfferings[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.DiskOfferings {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*DiskOffering
+	for _, v := range l.DiskOfferings {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "DiskOffering", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DiskOfferingService) GetDiskOfferingByName(name string) (*DiskOffering, int, error) {
-	id, err := s.GetDiskOfferingID(name)
+func (s *DiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	id, err := s.GetDiskOfferingID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetDiskOfferingByID(id)
+	r, count, err := s.GetDiskOfferingByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -565,11 +816,16 @@ func (s *DiskOfferingService) GetDiskOfferingByName(name string) (*DiskOffering,
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DiskOfferingService) GetDiskOfferingByID(id string) (*DiskOffering, int, error) {
+func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error) {
 	p := &ListDiskOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListDiskOfferings(p)
 	if err != nil {
@@ -592,41 +848,282 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string) (*DiskOffering, int
 }
 
 // Lists all available disk offerings.
+//
+// See also GetDiskOfferingID, GetDiskOfferingByName, GetDiskOfferingByID.
 func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listDiskOfferings", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listDiskOfferings", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDiskOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listDiskOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListDiskOfferings, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *DiskOfferingService) StreamDiskOfferings(ctx context.Context, p *ListDiskOfferingsParams, fn func(*DiskOffering) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listDiskOfferings", p.URLValues(), "diskoffering", func(b json.RawMessage) error {
+		var v DiskOffering
+		if err := s.cs.unmarshalElement("listDiskOfferings", "diskoffering", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListDiskOfferingsResponse struct {
-	Count         int             `json:"count"`
-	DiskOfferings []*DiskOffering `json:"diskoffering"`
+	Count         int                        `json:"count" xml:"count"`
+	DiskOfferings []*DiskOffering            `json:"diskoffering" xml:"diskoffering"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type DiskOffering struct {
-	Created            string `json:"created,omitempty"`
-	DiskBytesReadRate  int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate   int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate  int64  `json:"diskIopsWriteRate,omitempty"`
-	Disksize           int64  `json:"disksize,omitempty"`
-	Displayoffering    bool   `json:"displayoffering,omitempty"`
-	Displaytext        string `json:"displaytext,omitempty"`
-	Domain             string `json:"domain,omitempty"`
-	Domainid           string `json:"domainid,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Iscustomized       bool   `json:"iscustomized,omitempty"`
-	Iscustomizediops   bool   `json:"iscustomizediops,omitempty"`
-	Maxiops            int64  `json:"maxiops,omitempty"`
-	Miniops            int64  `json:"miniops,omitempty"`
-	Name               string `json:"name,omitempty"`
-	Storagetype        string `json:"storagetype,omitempty"`
-	Tags               string `json:"tags,omitempty"`
+	Created            Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	DiskBytesReadRate  int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate   int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate  int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Disksize           int64                      `json:"disksize,omitempty" xml:"disksize,omitempty"`
+	Displayoffering    Bool                       `json:"displayoffering,omitempty" xml:"displayoffering,omitempty"`
+	Displaytext        string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscustomized       Bool                       `json:"iscustomized,omitempty" xml:"iscustomized,omitempty"`
+	Iscustomizediops   Bool                       `json:"iscustomizediops,omitempty" xml:"iscustomizediops,omitempty"`
+	Maxiops            int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops            int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name               string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Storagetype        string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags               string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateDiskOfferingParams struct {
+	Displayoffering *bool   `json:"displayoffering,omitempty" yaml:"displayoffering,omitempty"`
+	Displaytext     *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey         *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateDiskOfferingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Displayoffering; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("displayoffering", vv)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Sortkey; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("sortkey", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateDiskOfferingParams) Command() string {
+	return "updateDiskOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateDiskOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateDiskOfferingParams) ResponseType() interface{} {
+	return &UpdateDiskOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateDiskOfferingParams) Validate() error {
+	v := &validator{api: "updateDiskOffering"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateDiskOfferingParams) SetDisplayoffering(v bool) {
+	p.Displayoffering = &v
+	return
+}
+
+func (p *UpdateDiskOfferingParams) GetDisplayoffering() bool {
+	if p.Displayoffering == nil {
+		return false
+	}
+	return *p.Displayoffering
+}
+
+func (p *UpdateDiskOfferingParams) HasDisplayoffering() bool {
+	return p.Displayoffering != nil
+}
+
+func (p *UpdateDiskOfferingParams) ResetDisplayoffering() {
+	p.Displayoffering = nil
+}
+
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateDiskOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *UpdateDiskOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *UpdateDiskOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *UpdateDiskOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// This param is required.
+func (p *UpdateDiskOfferingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateDiskOfferingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateDiskOfferingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateDiskOfferingParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateDiskOfferingParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateDiskOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateDiskOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateDiskOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *UpdateDiskOfferingParams) SetSortkey(v int) {
+	p.Sortkey = &v
+	return
+}
+
+func (p *UpdateDiskOfferingParams) GetSortkey() int {
+	if p.Sortkey == nil {
+		return 0
+	}
+	return *p.Sortkey
+}
+
+func (p *UpdateDiskOfferingParams) HasSortkey() bool {
+	return p.Sortkey != nil
+}
+
+func (p *UpdateDiskOfferingParams) ResetSortkey() {
+	p.Sortkey = nil
+}
+
+// You should always use this function to get a new UpdateDiskOfferingParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams {
+	p := &UpdateDiskOfferingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates a disk offering.
+//
+// See also ListDiskOfferings, GetDiskOfferingID, GetDiskOfferingByName, GetDiskOfferingByID.
+func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateDiskOffering", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateDiskOfferingResponse
+	if err := s.cs.unmarshal("updateDiskOffering", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type UpdateDiskOfferingResponse struct {
+	Created            Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	DiskBytesReadRate  int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate   int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate  int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Disksize           int64                      `json:"disksize,omitempty" xml:"disksize,omitempty"`
+	Displayoffering    Bool                       `json:"displayoffering,omitempty" xml:"displayoffering,omitempty"`
+	Displaytext        string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscustomized       Bool                       `json:"iscustomized,omitempty" xml:"iscustomized,omitempty"`
+	Iscustomizediops   Bool                       `json:"iscustomizediops,omitempty" xml:"iscustomizediops,omitempty"`
+	Maxiops            int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops            int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name               string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Storagetype        string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags               string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
 }
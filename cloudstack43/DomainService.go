@@ -17,235 +17,92 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type CreateDomainParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateDomainParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
-	}
-	if v, found := p.p["parentdomainid"]; found {
-		u.Set("parentdomainid", v.(string))
-	}
-	return u
-}
 
-func (p *CreateDomainParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateDomainParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+type DomainService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateDomainParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
-	return
+func NewDomainService(cs *CloudStackClient) *DomainService {
+	return &DomainService{cs: cs}
 }
 
-func (p *CreateDomainParams) SetParentdomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["parentdomainid"] = v
-	return
-}
+type CreateDomainParams = cloudstackcommon.CreateDomainParams
+type CreateDomainResponse = cloudstackcommon.CreateDomainResponse
 
 // You should always use this function to get a new CreateDomainParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
 func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
 	p := &CreateDomainParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
 // Creates a domain
+//
+// See also ListDomains, GetDomainID, GetDomainByName, GetDomainByID.
 func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
-	resp, err := s.cs.newRequest("createDomain", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r CreateDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type CreateDomainResponse struct {
-	Haschild         bool   `json:"haschild,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Level            int    `json:"level,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Networkdomain    string `json:"networkdomain,omitempty"`
-	Parentdomainid   string `json:"parentdomainid,omitempty"`
-	Parentdomainname string `json:"parentdomainname,omitempty"`
-	Path             string `json:"path,omitempty"`
-}
-
-type UpdateDomainParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateDomainParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
-	}
-	return u
-}
-
-func (p *UpdateDomainParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UpdateDomainParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *UpdateDomainParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
-	return
-}
-
-// You should always use this function to get a new UpdateDomainParams instance,
-// as then you are sure you have configured all required params
-func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
-	p := &UpdateDomainParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Updates a domain with a new name
-func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
-	resp, err := s.cs.newRequest("updateDomain", p.toURLValues())
+	resp, err := s.cs.newRequest("createDomain", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateDomainResponse
+	if err := s.cs.unmarshal("createDomain", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateDomainResponse struct {
-	Haschild         bool   `json:"haschild,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Level            int    `json:"level,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Networkdomain    string `json:"networkdomain,omitempty"`
-	Parentdomainid   string `json:"parentdomainid,omitempty"`
-	Parentdomainname string `json:"parentdomainname,omitempty"`
-	Path             string `json:"path,omitempty"`
-}
-
-type DeleteDomainParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteDomainParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cleanup"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("cleanup", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteDomainParams) SetCleanup(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cleanup"] = v
-	return
-}
-
-func (p *DeleteDomainParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteDomainParams = cloudstackcommon.DeleteDomainParams
+type DeleteDomainResponse = cloudstackcommon.DeleteDomainResponse
 
 // You should always use this function to get a new DeleteDomainParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
 	p := &DeleteDomainParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a specified domain
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListDomains, GetDomainID, GetDomainByName, GetDomainByID.
 func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
-	resp, err := s.cs.newRequest("deleteDomain", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteDomain", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteDomain", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -261,126 +118,38 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteDomain", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteDomainResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListDomainsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDomainsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["level"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("level", vv)
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListDomainsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListDomainsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListDomainsParams) SetLevel(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["level"] = v
-	return
-}
-
-func (p *ListDomainsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListDomainsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListDomainsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListDomainsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListDomainsParams = cloudstackcommon.ListDomainsParams
+type ListDomainsResponse = cloudstackcommon.ListDomainsResponse
+type Domain = cloudstackcommon.Domain
 
 // You should always use this function to get a new ListDomainsParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewListDomainsParams() *ListDomainsParams {
 	p := &ListDomainsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DomainService) GetDomainID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListDomainsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListDomains(p)
 	if err != nil {
@@ -395,24 +164,36 @@ func (s *DomainService) GetDomainID(name string) (string, error) {
 		return l.Domains[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Domains {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Domain
+	for _, v := range l.Domains {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Domain", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DomainService) GetDomainByName(name string) (*Domain, int, error) {
-	id, err := s.GetDomainID(name)
+func (s *DomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error) {
+	id, err := s.GetDomainID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetDomainByID(id)
+	r, count, err := s.GetDomainByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -420,11 +201,16 @@ func (s *DomainService) GetDomainByName(name string) (*Domain, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DomainService) GetDomainByID(id string) (*Domain, int, error) {
+func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error) {
 	p := &ListDomainsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListDomains(p)
 	if err != nil {
@@ -447,142 +233,108 @@ func (s *DomainService) GetDomainByID(id string) (*Domain, int, error) {
 }
 
 // Lists domains and provides detailed information for listed domains
+//
+// See also GetDomainID, GetDomainByName, GetDomainByID.
 func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
-	resp, err := s.cs.newRequest("listDomains", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listDomains", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDomainsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listDomains", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListDomainsResponse struct {
-	Count   int       `json:"count"`
-	Domains []*Domain `json:"domain"`
-}
-
-type Domain struct {
-	Haschild         bool   `json:"haschild,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Level            int    `json:"level,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Networkdomain    string `json:"networkdomain,omitempty"`
-	Parentdomainid   string `json:"parentdomainid,omitempty"`
-	Parentdomainname string `json:"parentdomainname,omitempty"`
-	Path             string `json:"path,omitempty"`
-}
-
-type ListDomainChildrenParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDomainChildrenParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+// Lists the objects like ListDomains, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *DomainService) StreamDomains(ctx context.Context, p *ListDomainsParams, fn func(*Domain) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
-}
 
-func (p *ListDomainChildrenParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+	return s.cs.streamList(ctx, "listDomains", p.URLValues(), "domain", func(b json.RawMessage) error {
+		var v Domain
+		if err := s.cs.unmarshalElement("listDomains", "domain", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
+type UpdateDomainParams = cloudstackcommon.UpdateDomainParams
+type UpdateDomainResponse = cloudstackcommon.UpdateDomainResponse
 
-func (p *ListDomainChildrenParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// You should always use this function to get a new UpdateDomainParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
+	p := &UpdateDomainParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListDomainChildrenParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Updates a domain with a new name
+//
+// See also ListDomains, GetDomainID, GetDomainByName, GetDomainByID.
+func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["listall"] = v
-	return
-}
 
-func (p *ListDomainChildrenParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("updateDomain", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *ListDomainChildrenParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateDomainResponse
+	if err := s.cs.unmarshal("updateDomain", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListDomainChildrenParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListDomainChildrenParams = cloudstackcommon.ListDomainChildrenParams
+type ListDomainChildrenResponse = cloudstackcommon.ListDomainChildrenResponse
+type DomainChildren = cloudstackcommon.DomainChildren
 
 // You should always use this function to get a new ListDomainChildrenParams instance,
 // as then you are sure you have configured all required params
 func (s *DomainService) NewListDomainChildrenParams() *ListDomainChildrenParams {
 	p := &ListDomainChildrenParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DomainService) GetDomainChildrenID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListDomainChildrenParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListDomainChildren(p)
 	if err != nil {
@@ -597,24 +349,36 @@ func (s *DomainService) GetDomainChildrenID(name string) (string, error) {
 		return l.DomainChildren[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.DomainChildren {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*DomainChildren
+	for _, v := range l.DomainChildren {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "DomainChildren", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DomainService) GetDomainChildrenByName(name string) (*DomainChildren, int, error) {
-	id, err := s.GetDomainChildrenID(name)
+func (s *DomainService) GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	id, err := s.GetDomainChildrenID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetDomainChildrenByID(id)
+	r, count, err := s.GetDomainChildrenByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -622,11 +386,16 @@ func (s *DomainService) GetDomainChildrenByName(name string) (*DomainChildren, i
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *DomainService) GetDomainChildrenByID(id string) (*DomainChildren, int, error) {
+func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error) {
 	p := &ListDomainChildrenParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListDomainChildren(p)
 	if err != nil {
@@ -649,31 +418,42 @@ func (s *DomainService) GetDomainChildrenByID(id string) (*DomainChildren, int,
 }
 
 // Lists all children domains belonging to a specified domain
+//
+// See also GetDomainChildrenID, GetDomainChildrenByName, GetDomainChildrenByID.
 func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
-	resp, err := s.cs.newRequest("listDomainChildren", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listDomainChildren", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDomainChildrenResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listDomainChildren", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListDomainChildrenResponse struct {
-	Count          int               `json:"count"`
-	DomainChildren []*DomainChildren `json:"domainchildren"`
-}
+// Lists the objects like ListDomainChildren, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *DomainService) StreamDomainChildren(ctx context.Context, p *ListDomainChildrenParams, fn func(*DomainChildren) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type DomainChildren struct {
-	Haschild         bool   `json:"haschild,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Level            int    `json:"level,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Networkdomain    string `json:"networkdomain,omitempty"`
-	Parentdomainid   string `json:"parentdomainid,omitempty"`
-	Parentdomainname string `json:"parentdomainname,omitempty"`
-	Path             string `json:"path,omitempty"`
+	return s.cs.streamList(ctx, "listDomainChildren", p.URLValues(), "domainchildren", func(b json.RawMessage) error {
+		var v DomainChildren
+		if err := s.cs.unmarshalElement("listDomainChildren", "domainchildren", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
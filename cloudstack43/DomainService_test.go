@@ -0,0 +1,407 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateDomain(t *testing.T) {
+	params := url.Values{
+		"domainid":       {"test-domainid"},
+		"name":           {"test-name"},
+		"networkdomain":  {"test-networkdomain"},
+		"parentdomainid": {"test-parentdomainid"},
+	}
+	cs, done := newTestClient(t, "createDomain", params, `{"createdomainresponse":{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}}`)
+	defer done()
+
+	p := cs.Domain.NewCreateDomainParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetParentdomainid("test-parentdomainid")
+	checkURLValues(t, "createDomain", p.URLValues(), params)
+
+	r, err := cs.Domain.CreateDomain(p)
+	if err != nil {
+		t.Fatalf("Failed to call createDomain: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createDomain" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createDomain: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createDomain: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createDomain: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createDomain", params, `<createdomainresponse><haschild>true</haschild><id>test-id</id><level>1</level><name>test-name</name><networkdomain>test-networkdomain</networkdomain><parentdomainid>test-parentdomainid</parentdomainid><parentdomainname>test-parentdomainname</parentdomainname><path>test-path</path></createdomainresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Domain.CreateDomain(p)
+	if err != nil {
+		t.Fatalf("Failed to call createDomain using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteDomain(t *testing.T) {
+	params := url.Values{
+		"cleanup": {"true"},
+		"id":      {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteDomain", params, `{"deletedomainresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Domain.NewDeleteDomainParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCleanup(true)
+	p.SetId("test-id")
+	checkURLValues(t, "deleteDomain", p.URLValues(), params)
+
+	r, err := cs.Domain.DeleteDomain(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteDomain: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteDomain" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteDomain: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteDomain: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteDomain: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteDomain", params, `<deletedomainresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletedomainresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Domain.DeleteDomain(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteDomain using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListDomains(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"level":    {"3"},
+		"listall":  {"true"},
+		"name":     {"test-name"},
+		"page":     {"6"},
+		"pagesize": {"7"},
+	}
+	cs, done := newTestClient(t, "listDomains", params, `{"listdomainsresponse":{"count":1,"domain":[{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}]}}`)
+	defer done()
+
+	p := cs.Domain.NewListDomainsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetLevel(3)
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	checkURLValues(t, "listDomains", p.URLValues(), params)
+
+	r, err := cs.Domain.ListDomains(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDomains: %v", err)
+	}
+	if r.Domains[0].Id != "test-id" {
+		t.Errorf("Expected r.Domains[0].Id to be %v, got %v", "test-id", r.Domains[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDomains" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDomains: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDomains: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDomains: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Domain
+	count, err := cs.Domain.StreamDomains(context.Background(), p, func(v *Domain) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDomains: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Domains) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Domains)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDomains", params, `<listdomainsresponse><count>1</count><domain><haschild>true</haschild><id>test-id</id><level>1</level><name>test-name</name><networkdomain>test-networkdomain</networkdomain><parentdomainid>test-parentdomainid</parentdomainid><parentdomainname>test-parentdomainname</parentdomainname><path>test-path</path></domain></listdomainsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Domain.ListDomains(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDomains using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Domain.StreamDomains(context.Background(), p, func(v *Domain) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDomains using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Domains) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Domains)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateDomain(t *testing.T) {
+	params := url.Values{
+		"id":            {"test-id"},
+		"name":          {"test-name"},
+		"networkdomain": {"test-networkdomain"},
+	}
+	cs, done := newTestClient(t, "updateDomain", params, `{"updatedomainresponse":{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}}`)
+	defer done()
+
+	p := cs.Domain.NewUpdateDomainParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetNetworkdomain("test-networkdomain")
+	checkURLValues(t, "updateDomain", p.URLValues(), params)
+
+	r, err := cs.Domain.UpdateDomain(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateDomain: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateDomain" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateDomain: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateDomain: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateDomain: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateDomain", params, `<updatedomainresponse><haschild>true</haschild><id>test-id</id><level>1</level><name>test-name</name><networkdomain>test-networkdomain</networkdomain><parentdomainid>test-parentdomainid</parentdomainid><parentdomainname>test-parentdomainname</parentdomainname><path>test-path</path></updatedomainresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Domain.UpdateDomain(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateDomain using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListDomainChildren(t *testing.T) {
+	params := url.Values{
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"page":        {"6"},
+		"pagesize":    {"7"},
+	}
+	cs, done := newTestClient(t, "listDomainChildren", params, `{"listdomainchildrenresponse":{"count":1,"domainchildren":[{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}]}}`)
+	defer done()
+
+	p := cs.Domain.NewListDomainChildrenParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	checkURLValues(t, "listDomainChildren", p.URLValues(), params)
+
+	r, err := cs.Domain.ListDomainChildren(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDomainChildren: %v", err)
+	}
+	if r.DomainChildren[0].Id != "test-id" {
+		t.Errorf("Expected r.DomainChildren[0].Id to be %v, got %v", "test-id", r.DomainChildren[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDomainChildren" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDomainChildren: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDomainChildren: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDomainChildren: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DomainChildren
+	count, err := cs.Domain.StreamDomainChildren(context.Background(), p, func(v *DomainChildren) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDomainChildren: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DomainChildren) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DomainChildren)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDomainChildren", params, `<listdomainchildrenresponse><count>1</count><domainchildren><haschild>true</haschild><id>test-id</id><level>1</level><name>test-name</name><networkdomain>test-networkdomain</networkdomain><parentdomainid>test-parentdomainid</parentdomainid><parentdomainname>test-parentdomainname</parentdomainname><path>test-path</path></domainchildren></listdomainchildrenresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Domain.ListDomainChildren(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDomainChildren using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Domain.StreamDomainChildren(context.Background(), p, func(v *DomainChildren) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDomainChildren using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DomainChildren) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DomainChildren)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,210 +17,108 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type ListEventsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListEventsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["duration"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("duration", vv)
-	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
-	}
-	if v, found := p.p["entrytime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("entrytime", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["level"]; found {
-		u.Set("level", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
-}
 
-func (p *ListEventsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *ListEventsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+type EventService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListEventsParams) SetDuration(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["duration"] = v
-	return
+func NewEventService(cs *CloudStackClient) *EventService {
+	return &EventService{cs: cs}
 }
 
-func (p *ListEventsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
-}
+type ArchiveEventsParams = cloudstackcommon.ArchiveEventsParams
+type ArchiveEventsResponse = cloudstackcommon.ArchiveEventsResponse
 
-func (p *ListEventsParams) SetEntrytime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["entrytime"] = v
-	return
+// You should always use this function to get a new ArchiveEventsParams instance,
+// as then you are sure you have configured all required params
+func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
+	p := &ArchiveEventsParams{}
+	return p
 }
 
-func (p *ListEventsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Archive one or more events.
+//
+// See also ListEvents, GetEventByID.
+func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ListEventsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("archiveEvents", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["isrecursive"] = v
-	return
-}
 
-func (p *ListEventsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListEventsParams) SetLevel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ArchiveEventsResponse
+	if err := s.cs.unmarshal("archiveEvents", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["level"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListEventsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
+type DeleteEventsParams = cloudstackcommon.DeleteEventsParams
+type DeleteEventsResponse = cloudstackcommon.DeleteEventsResponse
 
-func (p *ListEventsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// You should always use this function to get a new DeleteEventsParams instance,
+// as then you are sure you have configured all required params
+func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
+	p := &DeleteEventsParams{}
+	return p
 }
 
-func (p *ListEventsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Delete one or more events.
+//
+// See also ListEvents, GetEventByID.
+func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListEventsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteEvents", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *ListEventsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteEventsResponse
+	if err := s.cs.unmarshal("deleteEvents", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["startdate"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListEventsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["eventType"] = v
-	return
-}
+type ListEventsParams = cloudstackcommon.ListEventsParams
+type ListEventsResponse = cloudstackcommon.ListEventsResponse
+type Event = cloudstackcommon.Event
 
 // You should always use this function to get a new ListEventsParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventsParams() *ListEventsParams {
 	p := &ListEventsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *EventService) GetEventByID(id string) (*Event, int, error) {
+func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
 	p := &ListEventsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListEvents(p)
 	if err != nil {
@@ -232,6 +130,20 @@ func (s *EventService) GetEventByID(id string) (*Event, int, error) {
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListEvents(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -243,247 +155,92 @@ func (s *EventService) GetEventByID(id string) (*Event, int, error) {
 }
 
 // A command to list events.
+//
+// See also GetEventByID.
 func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
-	resp, err := s.cs.newRequest("listEvents", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listEvents", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListEventsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listEvents", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListEventsResponse struct {
-	Count  int      `json:"count"`
-	Events []*Event `json:"event"`
-}
-
-type Event struct {
-	Account     string `json:"account,omitempty"`
-	Created     string `json:"created,omitempty"`
-	Description string `json:"description,omitempty"`
-	Domain      string `json:"domain,omitempty"`
-	Domainid    string `json:"domainid,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Level       string `json:"level,omitempty"`
-	Parentid    string `json:"parentid,omitempty"`
-	Project     string `json:"project,omitempty"`
-	Projectid   string `json:"projectid,omitempty"`
-	State       string `json:"state,omitempty"`
-	Type        string `json:"type,omitempty"`
-	Username    string `json:"username,omitempty"`
-}
+// Lists the objects like ListEvents, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *EventService) StreamEvents(ctx context.Context, p *ListEventsParams, fn func(*Event) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type ListEventTypesParams struct {
-	p map[string]interface{}
+	return s.cs.streamList(ctx, "listEvents", p.URLValues(), "event", func(b json.RawMessage) error {
+		var v Event
+		if err := s.cs.unmarshalElement("listEvents", "event", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListEventTypesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	return u
-}
+type ListEventTypesParams = cloudstackcommon.ListEventTypesParams
+type ListEventTypesResponse = cloudstackcommon.ListEventTypesResponse
+type EventType = cloudstackcommon.EventType
 
 // You should always use this function to get a new ListEventTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
 	p := &ListEventTypesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // List Event Types
 func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
-	resp, err := s.cs.newRequest("listEventTypes", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListEventTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListEventTypesResponse struct {
-	Count      int          `json:"count"`
-	EventTypes []*EventType `json:"eventtype"`
-}
-
-type EventType struct {
-	Name string `json:"name,omitempty"`
-}
-
-type ArchiveEventsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ArchiveEventsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
-	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("ids", vv)
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
-}
-
-func (p *ArchiveEventsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
-}
-
-func (p *ArchiveEventsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
-	return
-}
-
-func (p *ArchiveEventsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
-	return
-}
-
-func (p *ArchiveEventsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["eventType"] = v
-	return
-}
 
-// You should always use this function to get a new ArchiveEventsParams instance,
-// as then you are sure you have configured all required params
-func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
-	p := &ArchiveEventsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Archive one or more events.
-func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
-	resp, err := s.cs.newRequest("archiveEvents", p.toURLValues())
+	resp, err := s.cs.newRequest("listEventTypes", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ArchiveEventsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListEventTypesResponse
+	if err := s.cs.unmarshal("listEventTypes", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ArchiveEventsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type DeleteEventsParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteEventsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
-	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("ids", vv)
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteEventsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
-}
-
-func (p *DeleteEventsParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
-	return
-}
-
-func (p *DeleteEventsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
-	return
-}
-
-func (p *DeleteEventsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["eventType"] = v
-	return
-}
-
-// You should always use this function to get a new DeleteEventsParams instance,
-// as then you are sure you have configured all required params
-func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
-	p := &DeleteEventsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Delete one or more events.
-func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
-	resp, err := s.cs.newRequest("deleteEvents", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteEventsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Lists the objects like ListEventTypes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *EventService) StreamEventTypes(ctx context.Context, p *ListEventTypesParams, fn func(*EventType) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return &r, nil
-}
 
-type DeleteEventsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+	return s.cs.streamList(ctx, "listEventTypes", p.URLValues(), "eventtype", func(b json.RawMessage) error {
+		var v EventType
+		if err := s.cs.unmarshalElement("listEventTypes", "eventtype", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -17,52 +17,620 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
 )
 
-type DeleteCiscoNexusVSMParams struct {
-	p map[string]interface{}
+type ExternalDeviceService struct {
+	cs *CloudStackClient
+}
+
+func NewExternalDeviceService(cs *CloudStackClient) *ExternalDeviceService {
+	return &ExternalDeviceService{cs: cs}
 }
 
-func (p *DeleteCiscoNexusVSMParams) toURLValues() url.Values {
+type AddCiscoAsa1000vResourceParams struct {
+	Clusterid         *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Hostname          *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Insideportprofile *string `json:"insideportprofile,omitempty" yaml:"insideportprofile,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddCiscoAsa1000vResourceParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Insideportprofile; v != nil {
+		u.Set("insideportprofile", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
 	return u
 }
 
-func (p *DeleteCiscoNexusVSMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *AddCiscoAsa1000vResourceParams) Command() string {
+	return "addCiscoAsa1000vResource"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddCiscoAsa1000vResourceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddCiscoAsa1000vResourceParams) ResponseType() interface{} {
+	return &AddCiscoAsa1000vResourceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddCiscoAsa1000vResourceParams) Validate() error {
+	v := &validator{api: "addCiscoAsa1000vResource"}
+	v.required("clusterid", p.Clusterid != nil)
+	v.required("hostname", p.Hostname != nil)
+	v.required("insideportprofile", p.Insideportprofile != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster. This param is required.
+func (p *AddCiscoAsa1000vResourceParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *AddCiscoAsa1000vResourceParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *AddCiscoAsa1000vResourceParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// This param is required.
+func (p *AddCiscoAsa1000vResourceParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *AddCiscoAsa1000vResourceParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *AddCiscoAsa1000vResourceParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+// This param is required.
+func (p *AddCiscoAsa1000vResourceParams) SetInsideportprofile(v string) {
+	p.Insideportprofile = &v
+	return
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetInsideportprofile() string {
+	if p.Insideportprofile == nil {
+		return ""
+	}
+	return *p.Insideportprofile
+}
+
+func (p *AddCiscoAsa1000vResourceParams) HasInsideportprofile() bool {
+	return p.Insideportprofile != nil
+}
+
+func (p *AddCiscoAsa1000vResourceParams) ResetInsideportprofile() {
+	p.Insideportprofile = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddCiscoAsa1000vResourceParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddCiscoAsa1000vResourceParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddCiscoAsa1000vResourceParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddCiscoAsa1000vResourceParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// You should always use this function to get a new AddCiscoAsa1000vResourceParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - clusterid: the ID of the cluster
+//   - hostname
+//   - insideportprofile
+//   - physicalnetworkid: the ID of the physical network
+func (s *ExternalDeviceService) NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) *AddCiscoAsa1000vResourceParams {
+	p := &AddCiscoAsa1000vResourceParams{}
+	p.SetClusterid(clusterid)
+	p.SetHostname(hostname)
+	p.SetInsideportprofile(insideportprofile)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	return p
+}
+
+// Adds a Cisco Asa 1000v appliance
+//
+// See also ListCiscoAsa1000vResources.
+func (s *ExternalDeviceService) AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addCiscoAsa1000vResource", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddCiscoAsa1000vResourceResponse
+	if err := s.cs.unmarshal("addCiscoAsa1000vResource", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type AddCiscoAsa1000vResourceResponse struct {
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteCiscoAsa1000vResourceParams struct {
+	Resourceid *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteCiscoAsa1000vResourceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteCiscoAsa1000vResourceParams) Command() string {
+	return "deleteCiscoAsa1000vResource"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteCiscoAsa1000vResourceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteCiscoAsa1000vResourceParams) ResponseType() interface{} {
+	return &DeleteCiscoAsa1000vResourceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteCiscoAsa1000vResourceParams) Validate() error {
+	v := &validator{api: "deleteCiscoAsa1000vResource"}
+	v.required("resourceid", p.Resourceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteCiscoAsa1000vResourceParams) SetResourceid(v string) {
+	p.Resourceid = &v
+	return
+}
+
+func (p *DeleteCiscoAsa1000vResourceParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
+	}
+	return *p.Resourceid
+}
+
+func (p *DeleteCiscoAsa1000vResourceParams) HasResourceid() bool {
+	return p.Resourceid != nil
+}
+
+func (p *DeleteCiscoAsa1000vResourceParams) ResetResourceid() {
+	p.Resourceid = nil
+}
+
+// You should always use this function to get a new DeleteCiscoAsa1000vResourceParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - resourceid
+func (s *ExternalDeviceService) NewDeleteCiscoAsa1000vResourceParams(resourceid string) *DeleteCiscoAsa1000vResourceParams {
+	p := &DeleteCiscoAsa1000vResourceParams{}
+	p.SetResourceid(resourceid)
+	return p
+}
+
+// Deletes a Cisco ASA 1000v appliance
+//
+// See also ListCiscoAsa1000vResources.
+func (s *ExternalDeviceService) DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteCiscoAsa1000vResource", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCiscoAsa1000vResourceResponse
+	if err := s.cs.unmarshal("deleteCiscoAsa1000vResource", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type DeleteCiscoAsa1000vResourceResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListCiscoAsa1000vResourcesParams struct {
+	Hostname          *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Resourceid        *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListCiscoAsa1000vResourcesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListCiscoAsa1000vResourcesParams) Command() string {
+	return "listCiscoAsa1000vResources"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListCiscoAsa1000vResourcesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListCiscoAsa1000vResourcesParams) ResponseType() interface{} {
+	return &ListCiscoAsa1000vResourcesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListCiscoAsa1000vResourcesParams) Validate() error {
+	v := &validator{api: "listCiscoAsa1000vResources"}
+	return v.err()
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListCiscoAsa1000vResourcesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListCiscoAsa1000vResourcesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["id"] = v
+	return *p.Page
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListCiscoAsa1000vResourcesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
+func (p *ListCiscoAsa1000vResourcesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListCiscoAsa1000vResourcesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) SetResourceid(v string) {
+	p.Resourceid = &v
+	return
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
+	}
+	return *p.Resourceid
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) HasResourceid() bool {
+	return p.Resourceid != nil
+}
+
+func (p *ListCiscoAsa1000vResourcesParams) ResetResourceid() {
+	p.Resourceid = nil
+}
+
+// You should always use this function to get a new ListCiscoAsa1000vResourcesParams instance,
+// as then you are sure you have configured all required params
+func (s *ExternalDeviceService) NewListCiscoAsa1000vResourcesParams() *ListCiscoAsa1000vResourcesParams {
+	p := &ListCiscoAsa1000vResourcesParams{}
+	return p
+}
+
+// Lists Cisco ASA 1000v appliances
+func (s *ExternalDeviceService) ListCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listCiscoAsa1000vResources", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCiscoAsa1000vResourcesResponse
+	if err := s.cs.unmarshal("listCiscoAsa1000vResources", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListCiscoAsa1000vResources, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ExternalDeviceService) StreamCiscoAsa1000vResources(ctx context.Context, p *ListCiscoAsa1000vResourcesParams, fn func(*CiscoAsa1000vResource) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listCiscoAsa1000vResources", p.URLValues(), "ciscoasa1000vresource", func(b json.RawMessage) error {
+		var v CiscoAsa1000vResource
+		if err := s.cs.unmarshalElement("listCiscoAsa1000vResources", "ciscoasa1000vresource", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListCiscoAsa1000vResourcesResponse struct {
+	Count                  int                        `json:"count" xml:"count"`
+	CiscoAsa1000vResources []*CiscoAsa1000vResource   `json:"ciscoasa1000vresource" xml:"ciscoasa1000vresource"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CiscoAsa1000vResource struct {
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteCiscoNexusVSMParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteCiscoNexusVSMParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteCiscoNexusVSMParams) Command() string {
+	return "deleteCiscoNexusVSM"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteCiscoNexusVSMParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteCiscoNexusVSMParams) ResponseType() interface{} {
+	return &DeleteCiscoNexusVSMResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteCiscoNexusVSMParams) Validate() error {
+	v := &validator{api: "deleteCiscoNexusVSM"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteCiscoNexusVSMParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteCiscoNexusVSMParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteCiscoNexusVSMParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteCiscoNexusVSMParams) ResetId() {
+	p.Id = nil
+}
+
 // You should always use this function to get a new DeleteCiscoNexusVSMParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *ExternalDeviceService) NewDeleteCiscoNexusVSMParams(id string) *DeleteCiscoNexusVSMParams {
 	p := &DeleteCiscoNexusVSMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
-//  delete a Cisco Nexus VSM device
+//	delete a Cisco Nexus VSM device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListCiscoNexusVSMs.
 func (s *ExternalDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams) (*DeleteCiscoNexusVSMResponse, error) {
-	resp, err := s.cs.newRequest("deleteCiscoNexusVSM", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteCiscoNexusVSM", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteCiscoNexusVSMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteCiscoNexusVSM", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -78,7 +646,7 @@ func (s *ExternalDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteCiscoNexusVSM", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -86,52 +654,99 @@ func (s *ExternalDeviceService) DeleteCiscoNexusVSM(p *DeleteCiscoNexusVSMParams
 }
 
 type DeleteCiscoNexusVSMResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type EnableCiscoNexusVSMParams struct {
-	p map[string]interface{}
+type DisableCiscoNexusVSMParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *EnableCiscoNexusVSMParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DisableCiscoNexusVSMParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *EnableCiscoNexusVSMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *DisableCiscoNexusVSMParams) Command() string {
+	return "disableCiscoNexusVSM"
+}
+
+// Returns true if the API command starts an async job
+func (p *DisableCiscoNexusVSMParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DisableCiscoNexusVSMParams) ResponseType() interface{} {
+	return &DisableCiscoNexusVSMResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DisableCiscoNexusVSMParams) Validate() error {
+	v := &validator{api: "disableCiscoNexusVSM"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DisableCiscoNexusVSMParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new EnableCiscoNexusVSMParams instance,
+func (p *DisableCiscoNexusVSMParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DisableCiscoNexusVSMParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DisableCiscoNexusVSMParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new DisableCiscoNexusVSMParams instance,
 // as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewEnableCiscoNexusVSMParams(id string) *EnableCiscoNexusVSMParams {
-	p := &EnableCiscoNexusVSMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *ExternalDeviceService) NewDisableCiscoNexusVSMParams(id string) *DisableCiscoNexusVSMParams {
+	p := &DisableCiscoNexusVSMParams{}
+	p.SetId(id)
 	return p
 }
 
-// Enable a Cisco Nexus VSM device
-func (s *ExternalDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error) {
-	resp, err := s.cs.newRequest("enableCiscoNexusVSM", p.toURLValues())
+// disable a Cisco Nexus VSM device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListCiscoNexusVSMs.
+func (s *ExternalDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("disableCiscoNexusVSM", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r EnableCiscoNexusVSMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DisableCiscoNexusVSMResponse
+	if err := s.cs.unmarshal("disableCiscoNexusVSM", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -152,69 +767,116 @@ func (s *ExternalDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("disableCiscoNexusVSM", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type EnableCiscoNexusVSMResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Ipaddress        string `json:"ipaddress,omitempty"`
-	Vsmconfigmode    string `json:"vsmconfigmode,omitempty"`
-	Vsmconfigstate   string `json:"vsmconfigstate,omitempty"`
-	Vsmctrlvlanid    int    `json:"vsmctrlvlanid,omitempty"`
-	Vsmdeviceid      string `json:"vsmdeviceid,omitempty"`
-	Vsmdevicename    string `json:"vsmdevicename,omitempty"`
-	Vsmdevicestate   string `json:"vsmdevicestate,omitempty"`
-	Vsmdomainid      string `json:"vsmdomainid,omitempty"`
-	Vsmmgmtvlanid    string `json:"vsmmgmtvlanid,omitempty"`
-	Vsmpktvlanid     int    `json:"vsmpktvlanid,omitempty"`
-	Vsmstoragevlanid int    `json:"vsmstoragevlanid,omitempty"`
+type DisableCiscoNexusVSMResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Vsmconfigmode    string                     `json:"vsmconfigmode,omitempty" xml:"vsmconfigmode,omitempty"`
+	Vsmconfigstate   string                     `json:"vsmconfigstate,omitempty" xml:"vsmconfigstate,omitempty"`
+	Vsmctrlvlanid    int                        `json:"vsmctrlvlanid,omitempty" xml:"vsmctrlvlanid,omitempty"`
+	Vsmdeviceid      string                     `json:"vsmdeviceid,omitempty" xml:"vsmdeviceid,omitempty"`
+	Vsmdevicename    string                     `json:"vsmdevicename,omitempty" xml:"vsmdevicename,omitempty"`
+	Vsmdevicestate   string                     `json:"vsmdevicestate,omitempty" xml:"vsmdevicestate,omitempty"`
+	Vsmdomainid      string                     `json:"vsmdomainid,omitempty" xml:"vsmdomainid,omitempty"`
+	Vsmmgmtvlanid    string                     `json:"vsmmgmtvlanid,omitempty" xml:"vsmmgmtvlanid,omitempty"`
+	Vsmpktvlanid     int                        `json:"vsmpktvlanid,omitempty" xml:"vsmpktvlanid,omitempty"`
+	Vsmstoragevlanid int                        `json:"vsmstoragevlanid,omitempty" xml:"vsmstoragevlanid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type DisableCiscoNexusVSMParams struct {
-	p map[string]interface{}
+type EnableCiscoNexusVSMParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *DisableCiscoNexusVSMParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *EnableCiscoNexusVSMParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *DisableCiscoNexusVSMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *EnableCiscoNexusVSMParams) Command() string {
+	return "enableCiscoNexusVSM"
+}
+
+// Returns true if the API command starts an async job
+func (p *EnableCiscoNexusVSMParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *EnableCiscoNexusVSMParams) ResponseType() interface{} {
+	return &EnableCiscoNexusVSMResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *EnableCiscoNexusVSMParams) Validate() error {
+	v := &validator{api: "enableCiscoNexusVSM"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *EnableCiscoNexusVSMParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new DisableCiscoNexusVSMParams instance,
+func (p *EnableCiscoNexusVSMParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *EnableCiscoNexusVSMParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *EnableCiscoNexusVSMParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new EnableCiscoNexusVSMParams instance,
 // as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewDisableCiscoNexusVSMParams(id string) *DisableCiscoNexusVSMParams {
-	p := &DisableCiscoNexusVSMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *ExternalDeviceService) NewEnableCiscoNexusVSMParams(id string) *EnableCiscoNexusVSMParams {
+	p := &EnableCiscoNexusVSMParams{}
+	p.SetId(id)
 	return p
 }
 
-// disable a Cisco Nexus VSM device
-func (s *ExternalDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMParams) (*DisableCiscoNexusVSMResponse, error) {
-	resp, err := s.cs.newRequest("disableCiscoNexusVSM", p.toURLValues())
+// Enable a Cisco Nexus VSM device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListCiscoNexusVSMs.
+func (s *ExternalDeviceService) EnableCiscoNexusVSM(p *EnableCiscoNexusVSMParams) (*EnableCiscoNexusVSMResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("enableCiscoNexusVSM", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DisableCiscoNexusVSMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r EnableCiscoNexusVSMResponse
+	if err := s.cs.unmarshal("enableCiscoNexusVSM", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -235,615 +897,739 @@ func (s *ExternalDeviceService) DisableCiscoNexusVSM(p *DisableCiscoNexusVSMPara
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("enableCiscoNexusVSM", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DisableCiscoNexusVSMResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Ipaddress        string `json:"ipaddress,omitempty"`
-	Vsmconfigmode    string `json:"vsmconfigmode,omitempty"`
-	Vsmconfigstate   string `json:"vsmconfigstate,omitempty"`
-	Vsmctrlvlanid    int    `json:"vsmctrlvlanid,omitempty"`
-	Vsmdeviceid      string `json:"vsmdeviceid,omitempty"`
-	Vsmdevicename    string `json:"vsmdevicename,omitempty"`
-	Vsmdevicestate   string `json:"vsmdevicestate,omitempty"`
-	Vsmdomainid      string `json:"vsmdomainid,omitempty"`
-	Vsmmgmtvlanid    string `json:"vsmmgmtvlanid,omitempty"`
-	Vsmpktvlanid     int    `json:"vsmpktvlanid,omitempty"`
-	Vsmstoragevlanid int    `json:"vsmstoragevlanid,omitempty"`
+type EnableCiscoNexusVSMResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Vsmconfigmode    string                     `json:"vsmconfigmode,omitempty" xml:"vsmconfigmode,omitempty"`
+	Vsmconfigstate   string                     `json:"vsmconfigstate,omitempty" xml:"vsmconfigstate,omitempty"`
+	Vsmctrlvlanid    int                        `json:"vsmctrlvlanid,omitempty" xml:"vsmctrlvlanid,omitempty"`
+	Vsmdeviceid      string                     `json:"vsmdeviceid,omitempty" xml:"vsmdeviceid,omitempty"`
+	Vsmdevicename    string                     `json:"vsmdevicename,omitempty" xml:"vsmdevicename,omitempty"`
+	Vsmdevicestate   string                     `json:"vsmdevicestate,omitempty" xml:"vsmdevicestate,omitempty"`
+	Vsmdomainid      string                     `json:"vsmdomainid,omitempty" xml:"vsmdomainid,omitempty"`
+	Vsmmgmtvlanid    string                     `json:"vsmmgmtvlanid,omitempty" xml:"vsmmgmtvlanid,omitempty"`
+	Vsmpktvlanid     int                        `json:"vsmpktvlanid,omitempty" xml:"vsmpktvlanid,omitempty"`
+	Vsmstoragevlanid int                        `json:"vsmstoragevlanid,omitempty" xml:"vsmstoragevlanid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListCiscoNexusVSMsParams struct {
-	p map[string]interface{}
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *ListCiscoNexusVSMsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListCiscoNexusVSMsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListCiscoNexusVSMsParams) Command() string {
+	return "listCiscoNexusVSMs"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListCiscoNexusVSMsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListCiscoNexusVSMsParams) ResponseType() interface{} {
+	return &ListCiscoNexusVSMsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListCiscoNexusVSMsParams) Validate() error {
+	v := &validator{api: "listCiscoNexusVSMs"}
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
 func (p *ListCiscoNexusVSMsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	p.Clusterid = &v
+	return
+}
+
+func (p *ListCiscoNexusVSMsParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
 	}
-	p.p["clusterid"] = v
+	return *p.Clusterid
+}
+
+func (p *ListCiscoNexusVSMsParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListCiscoNexusVSMsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListCiscoNexusVSMsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+func (p *ListCiscoNexusVSMsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListCiscoNexusVSMsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListCiscoNexusVSMsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListCiscoNexusVSMsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListCiscoNexusVSMsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListCiscoNexusVSMsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListCiscoNexusVSMsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListCiscoNexusVSMsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListCiscoNexusVSMsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListCiscoNexusVSMsParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-func (p *ListCiscoNexusVSMsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListCiscoNexusVSMsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	p.p["page"] = v
-	return
+	return *p.Zoneid
 }
 
-func (p *ListCiscoNexusVSMsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListCiscoNexusVSMsParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-func (p *ListCiscoNexusVSMsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+func (p *ListCiscoNexusVSMsParams) ResetZoneid() {
+	p.Zoneid = nil
 }
 
 // You should always use this function to get a new ListCiscoNexusVSMsParams instance,
 // as then you are sure you have configured all required params
 func (s *ExternalDeviceService) NewListCiscoNexusVSMsParams() *ListCiscoNexusVSMsParams {
 	p := &ListCiscoNexusVSMsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Retrieves a Cisco Nexus 1000v Virtual Switch Manager device associated with a Cluster
 func (s *ExternalDeviceService) ListCiscoNexusVSMs(p *ListCiscoNexusVSMsParams) (*ListCiscoNexusVSMsResponse, error) {
-	resp, err := s.cs.newRequest("listCiscoNexusVSMs", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listCiscoNexusVSMs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCiscoNexusVSMsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listCiscoNexusVSMs", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListCiscoNexusVSMs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ExternalDeviceService) StreamCiscoNexusVSMs(ctx context.Context, p *ListCiscoNexusVSMsParams, fn func(*CiscoNexusVSM) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listCiscoNexusVSMs", p.URLValues(), "cisconexusvsm", func(b json.RawMessage) error {
+		var v CiscoNexusVSM
+		if err := s.cs.unmarshalElement("listCiscoNexusVSMs", "cisconexusvsm", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListCiscoNexusVSMsResponse struct {
-	Count          int              `json:"count"`
-	CiscoNexusVSMs []*CiscoNexusVSM `json:"cisconexusvsm"`
+	Count          int                        `json:"count" xml:"count"`
+	CiscoNexusVSMs []*CiscoNexusVSM           `json:"cisconexusvsm" xml:"cisconexusvsm"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type CiscoNexusVSM struct {
-	Ipaddress        string `json:"ipaddress,omitempty"`
-	Vsmconfigmode    string `json:"vsmconfigmode,omitempty"`
-	Vsmconfigstate   string `json:"vsmconfigstate,omitempty"`
-	Vsmctrlvlanid    int    `json:"vsmctrlvlanid,omitempty"`
-	Vsmdeviceid      string `json:"vsmdeviceid,omitempty"`
-	Vsmdevicename    string `json:"vsmdevicename,omitempty"`
-	Vsmdevicestate   string `json:"vsmdevicestate,omitempty"`
-	Vsmdomainid      string `json:"vsmdomainid,omitempty"`
-	Vsmmgmtvlanid    string `json:"vsmmgmtvlanid,omitempty"`
-	Vsmpktvlanid     int    `json:"vsmpktvlanid,omitempty"`
-	Vsmstoragevlanid int    `json:"vsmstoragevlanid,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Vsmconfigmode    string                     `json:"vsmconfigmode,omitempty" xml:"vsmconfigmode,omitempty"`
+	Vsmconfigstate   string                     `json:"vsmconfigstate,omitempty" xml:"vsmconfigstate,omitempty"`
+	Vsmctrlvlanid    int                        `json:"vsmctrlvlanid,omitempty" xml:"vsmctrlvlanid,omitempty"`
+	Vsmdeviceid      string                     `json:"vsmdeviceid,omitempty" xml:"vsmdeviceid,omitempty"`
+	Vsmdevicename    string                     `json:"vsmdevicename,omitempty" xml:"vsmdevicename,omitempty"`
+	Vsmdevicestate   string                     `json:"vsmdevicestate,omitempty" xml:"vsmdevicestate,omitempty"`
+	Vsmdomainid      string                     `json:"vsmdomainid,omitempty" xml:"vsmdomainid,omitempty"`
+	Vsmmgmtvlanid    string                     `json:"vsmmgmtvlanid,omitempty" xml:"vsmmgmtvlanid,omitempty"`
+	Vsmpktvlanid     int                        `json:"vsmpktvlanid,omitempty" xml:"vsmpktvlanid,omitempty"`
+	Vsmstoragevlanid int                        `json:"vsmstoragevlanid,omitempty" xml:"vsmstoragevlanid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type AddCiscoVnmcResourceParams struct {
-	p map[string]interface{}
+	Hostname          *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
 }
 
-func (p *AddCiscoVnmcResourceParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddCiscoVnmcResourceParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *AddCiscoVnmcResourceParams) Command() string {
+	return "addCiscoVnmcResource"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddCiscoVnmcResourceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddCiscoVnmcResourceParams) ResponseType() interface{} {
+	return &AddCiscoVnmcResourceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddCiscoVnmcResourceParams) Validate() error {
+	v := &validator{api: "addCiscoVnmcResource"}
+	v.required("hostname", p.Hostname != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// This param is required.
 func (p *AddCiscoVnmcResourceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
+	p.Hostname = &v
 	return
 }
 
-func (p *AddCiscoVnmcResourceParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddCiscoVnmcResourceParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
 	}
-	p.p["password"] = v
+	return *p.Hostname
+}
+
+func (p *AddCiscoVnmcResourceParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *AddCiscoVnmcResourceParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddCiscoVnmcResourceParams) SetPassword(v string) {
+	p.Password = &v
 	return
 }
 
-func (p *AddCiscoVnmcResourceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddCiscoVnmcResourceParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	p.p["physicalnetworkid"] = v
+	return *p.Password
+}
+
+func (p *AddCiscoVnmcResourceParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddCiscoVnmcResourceParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddCiscoVnmcResourceParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-func (p *AddCiscoVnmcResourceParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddCiscoVnmcResourceParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	p.p["username"] = v
+	return *p.Physicalnetworkid
+}
+
+func (p *AddCiscoVnmcResourceParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddCiscoVnmcResourceParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddCiscoVnmcResourceParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
+func (p *AddCiscoVnmcResourceParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddCiscoVnmcResourceParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddCiscoVnmcResourceParams) ResetUsername() {
+	p.Username = nil
+}
+
 // You should always use this function to get a new AddCiscoVnmcResourceParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hostname
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - username: the name of the user
 func (s *ExternalDeviceService) NewAddCiscoVnmcResourceParams(hostname string, password string, physicalnetworkid string, username string) *AddCiscoVnmcResourceParams {
 	p := &AddCiscoVnmcResourceParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["username"] = username
+	p.SetHostname(hostname)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUsername(username)
 	return p
 }
 
 // Adds a Cisco Vnmc Controller
+//
+// See also ListCiscoVnmcResources.
 func (s *ExternalDeviceService) AddCiscoVnmcResource(p *AddCiscoVnmcResourceParams) (*AddCiscoVnmcResourceResponse, error) {
-	resp, err := s.cs.newRequest("addCiscoVnmcResource", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addCiscoVnmcResource", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddCiscoVnmcResourceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addCiscoVnmcResource", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type AddCiscoVnmcResourceResponse struct {
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type DeleteCiscoVnmcResourceParams struct {
-	p map[string]interface{}
+	Resourceid *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
 }
 
-func (p *DeleteCiscoVnmcResourceParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteCiscoVnmcResourceParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *DeleteCiscoVnmcResourceParams) Command() string {
+	return "deleteCiscoVnmcResource"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteCiscoVnmcResourceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteCiscoVnmcResourceParams) ResponseType() interface{} {
+	return &DeleteCiscoVnmcResourceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteCiscoVnmcResourceParams) Validate() error {
+	v := &validator{api: "deleteCiscoVnmcResource"}
+	v.required("resourceid", p.Resourceid != nil)
+	return v.err()
+}
+
+// This param is required.
 func (p *DeleteCiscoVnmcResourceParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+	p.Resourceid = &v
 	return
 }
 
+func (p *DeleteCiscoVnmcResourceParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
+	}
+	return *p.Resourceid
+}
+
+func (p *DeleteCiscoVnmcResourceParams) HasResourceid() bool {
+	return p.Resourceid != nil
+}
+
+func (p *DeleteCiscoVnmcResourceParams) ResetResourceid() {
+	p.Resourceid = nil
+}
+
 // You should always use this function to get a new DeleteCiscoVnmcResourceParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - resourceid
 func (s *ExternalDeviceService) NewDeleteCiscoVnmcResourceParams(resourceid string) *DeleteCiscoVnmcResourceParams {
 	p := &DeleteCiscoVnmcResourceParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceid"] = resourceid
+	p.SetResourceid(resourceid)
 	return p
 }
 
 // Deletes a Cisco Vnmc controller
+//
+// See also ListCiscoVnmcResources.
 func (s *ExternalDeviceService) DeleteCiscoVnmcResource(p *DeleteCiscoVnmcResourceParams) (*DeleteCiscoVnmcResourceResponse, error) {
-	resp, err := s.cs.newRequest("deleteCiscoVnmcResource", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteCiscoVnmcResource", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteCiscoVnmcResourceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteCiscoVnmcResource", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type DeleteCiscoVnmcResourceResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListCiscoVnmcResourcesParams struct {
-	p map[string]interface{}
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Resourceid        *string `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
 }
 
-func (p *ListCiscoVnmcResourcesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListCiscoVnmcResourcesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
 	}
 	return u
 }
 
-func (p *ListCiscoVnmcResourcesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListCiscoVnmcResourcesParams) Command() string {
+	return "listCiscoVnmcResources"
 }
 
-func (p *ListCiscoVnmcResourcesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListCiscoVnmcResourcesParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListCiscoVnmcResourcesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListCiscoVnmcResourcesParams) ResponseType() interface{} {
+	return &ListCiscoVnmcResourcesResponse{}
 }
 
-func (p *ListCiscoVnmcResourcesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListCiscoVnmcResourcesParams) Validate() error {
+	v := &validator{api: "listCiscoVnmcResources"}
+	return v.err()
 }
 
-func (p *ListCiscoVnmcResourcesParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListCiscoVnmcResourcesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-// You should always use this function to get a new ListCiscoVnmcResourcesParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewListCiscoVnmcResourcesParams() *ListCiscoVnmcResourcesParams {
-	p := &ListCiscoVnmcResourcesParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Lists Cisco VNMC controllers
-func (s *ExternalDeviceService) ListCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
-	resp, err := s.cs.newRequest("listCiscoVnmcResources", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListCiscoVnmcResourcesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListCiscoVnmcResourcesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	return &r, nil
-}
-
-type ListCiscoVnmcResourcesResponse struct {
-	Count              int                  `json:"count"`
-	CiscoVnmcResources []*CiscoVnmcResource `json:"ciscovnmcresource"`
-}
-
-type CiscoVnmcResource struct {
+	return *p.Keyword
 }
 
-type AddCiscoAsa1000vResourceParams struct {
-	p map[string]interface{}
+func (p *ListCiscoVnmcResourcesParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *AddCiscoAsa1000vResourceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
-	}
-	if v, found := p.p["insideportprofile"]; found {
-		u.Set("insideportprofile", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	return u
+func (p *ListCiscoVnmcResourcesParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-func (p *AddCiscoAsa1000vResourceParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListCiscoVnmcResourcesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *AddCiscoAsa1000vResourceParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListCiscoVnmcResourcesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["hostname"] = v
-	return
+	return *p.Page
 }
 
-func (p *AddCiscoAsa1000vResourceParams) SetInsideportprofile(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["insideportprofile"] = v
-	return
+func (p *ListCiscoVnmcResourcesParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *AddCiscoAsa1000vResourceParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
+func (p *ListCiscoVnmcResourcesParams) ResetPage() {
+	p.Page = nil
 }
 
-// You should always use this function to get a new AddCiscoAsa1000vResourceParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewAddCiscoAsa1000vResourceParams(clusterid string, hostname string, insideportprofile string, physicalnetworkid string) *AddCiscoAsa1000vResourceParams {
-	p := &AddCiscoAsa1000vResourceParams{}
-	p.p = make(map[string]interface{})
-	p.p["clusterid"] = clusterid
-	p.p["hostname"] = hostname
-	p.p["insideportprofile"] = insideportprofile
-	p.p["physicalnetworkid"] = physicalnetworkid
-	return p
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListCiscoVnmcResourcesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-// Adds a Cisco Asa 1000v appliance
-func (s *ExternalDeviceService) AddCiscoAsa1000vResource(p *AddCiscoAsa1000vResourceParams) (*AddCiscoAsa1000vResourceResponse, error) {
-	resp, err := s.cs.newRequest("addCiscoAsa1000vResource", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r AddCiscoAsa1000vResourceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListCiscoVnmcResourcesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	return &r, nil
-}
-
-type AddCiscoAsa1000vResourceResponse struct {
+	return *p.Pagesize
 }
 
-type DeleteCiscoAsa1000vResourceParams struct {
-	p map[string]interface{}
+func (p *ListCiscoVnmcResourcesParams) HasPagesize() bool {
+	return p.Pagesize != nil
 }
 
-func (p *DeleteCiscoAsa1000vResourceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
-	}
-	return u
+func (p *ListCiscoVnmcResourcesParams) ResetPagesize() {
+	p.Pagesize = nil
 }
 
-func (p *DeleteCiscoAsa1000vResourceParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListCiscoVnmcResourcesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-// You should always use this function to get a new DeleteCiscoAsa1000vResourceParams instance,
-// as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewDeleteCiscoAsa1000vResourceParams(resourceid string) *DeleteCiscoAsa1000vResourceParams {
-	p := &DeleteCiscoAsa1000vResourceParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceid"] = resourceid
-	return p
-}
-
-// Deletes a Cisco ASA 1000v appliance
-func (s *ExternalDeviceService) DeleteCiscoAsa1000vResource(p *DeleteCiscoAsa1000vResourceParams) (*DeleteCiscoAsa1000vResourceResponse, error) {
-	resp, err := s.cs.newRequest("deleteCiscoAsa1000vResource", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteCiscoAsa1000vResourceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type DeleteCiscoAsa1000vResourceResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ListCiscoAsa1000vResourcesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListCiscoAsa1000vResourcesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+func (p *ListCiscoVnmcResourcesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
-	}
-	return u
+	return *p.Physicalnetworkid
 }
 
-func (p *ListCiscoAsa1000vResourcesParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
-	return
+func (p *ListCiscoVnmcResourcesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
 }
 
-func (p *ListCiscoAsa1000vResourcesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListCiscoVnmcResourcesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
 }
 
-func (p *ListCiscoAsa1000vResourcesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+func (p *ListCiscoVnmcResourcesParams) SetResourceid(v string) {
+	p.Resourceid = &v
 	return
 }
 
-func (p *ListCiscoAsa1000vResourcesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListCiscoVnmcResourcesParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Resourceid
 }
 
-func (p *ListCiscoAsa1000vResourcesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
+func (p *ListCiscoVnmcResourcesParams) HasResourceid() bool {
+	return p.Resourceid != nil
 }
 
-func (p *ListCiscoAsa1000vResourcesParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
-	return
+func (p *ListCiscoVnmcResourcesParams) ResetResourceid() {
+	p.Resourceid = nil
 }
 
-// You should always use this function to get a new ListCiscoAsa1000vResourcesParams instance,
+// You should always use this function to get a new ListCiscoVnmcResourcesParams instance,
 // as then you are sure you have configured all required params
-func (s *ExternalDeviceService) NewListCiscoAsa1000vResourcesParams() *ListCiscoAsa1000vResourcesParams {
-	p := &ListCiscoAsa1000vResourcesParams{}
-	p.p = make(map[string]interface{})
+func (s *ExternalDeviceService) NewListCiscoVnmcResourcesParams() *ListCiscoVnmcResourcesParams {
+	p := &ListCiscoVnmcResourcesParams{}
 	return p
 }
 
-// Lists Cisco ASA 1000v appliances
-func (s *ExternalDeviceService) ListCiscoAsa1000vResources(p *ListCiscoAsa1000vResourcesParams) (*ListCiscoAsa1000vResourcesResponse, error) {
-	resp, err := s.cs.newRequest("listCiscoAsa1000vResources", p.toURLValues())
+// Lists Cisco VNMC controllers
+func (s *ExternalDeviceService) ListCiscoVnmcResources(p *ListCiscoVnmcResourcesParams) (*ListCiscoVnmcResourcesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listCiscoVnmcResources", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListCiscoAsa1000vResourcesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListCiscoVnmcResourcesResponse
+	if err := s.cs.unmarshal("listCiscoVnmcResources", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListCiscoAsa1000vResourcesResponse struct {
-	Count                  int                      `json:"count"`
-	CiscoAsa1000vResources []*CiscoAsa1000vResource `json:"ciscoasa1000vresource"`
+// Lists the objects like ListCiscoVnmcResources, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ExternalDeviceService) StreamCiscoVnmcResources(ctx context.Context, p *ListCiscoVnmcResourcesParams, fn func(*CiscoVnmcResource) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listCiscoVnmcResources", p.URLValues(), "ciscovnmcresource", func(b json.RawMessage) error {
+		var v CiscoVnmcResource
+		if err := s.cs.unmarshalElement("listCiscoVnmcResources", "ciscovnmcresource", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type CiscoAsa1000vResource struct {
+type ListCiscoVnmcResourcesResponse struct {
+	Count              int                        `json:"count" xml:"count"`
+	CiscoVnmcResources []*CiscoVnmcResource       `json:"ciscovnmcresource" xml:"ciscovnmcresource"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CiscoVnmcResource struct {
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -17,411 +17,293 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type ListPortForwardingRulesParams struct {
-	p map[string]interface{}
+type FirewallService struct {
+	cs *CloudStackClient
+}
+
+func NewFirewallService(cs *CloudStackClient) *FirewallService {
+	return &FirewallService{cs: cs}
 }
 
-func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
+type CreateEgressFirewallRuleParams struct {
+	Cidrlist  []string  `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport   *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Icmpcode  *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype  *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Networkid *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Protocol  *Protocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Type      *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateEgressFirewallRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("endport", vv)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Icmpcode; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmpcode", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Icmptype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmptype", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("startport", vv)
 	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
 	}
 	return u
 }
 
-func (p *ListPortForwardingRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateEgressFirewallRuleParams) Command() string {
+	return "createEgressFirewallRule"
 }
 
-func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateEgressFirewallRuleParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListPortForwardingRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateEgressFirewallRuleParams) ResponseType() interface{} {
+	return &CreateEgressFirewallRuleResponse{}
 }
 
-func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateEgressFirewallRuleParams) Validate() error {
+	v := &validator{api: "createEgressFirewallRule"}
+	v.required("networkid", p.Networkid != nil)
+	v.required("protocol", p.Protocol != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
 	}
-	p.p["ipaddressid"] = v
-	return
+	return v.err()
 }
 
-func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
 	return
 }
 
-func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) GetCidrlist() []string {
+	return p.Cidrlist
 }
 
-func (p *ListPortForwardingRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
 }
 
-func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) ResetCidrlist() {
+	p.Cidrlist = nil
 }
 
-func (p *ListPortForwardingRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Sets the 'endport' param: the end of the port range.
+func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
+	p.Endport = &v
 	return
 }
 
-func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateEgressFirewallRuleParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Endport
 }
 
-func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) HasEndport() bool {
+	return p.Endport != nil
 }
 
-func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) ResetEndport() {
+	p.Endport = nil
 }
 
-// You should always use this function to get a new ListPortForwardingRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
-	p := &ListPortForwardingRulesParams{}
-	p.p = make(map[string]interface{})
-	return p
+// Sets the 'icmpcode' param: the ICMP code, for the icmp protocol.
+func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
+	p.Icmpcode = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *FirewallService) GetPortForwardingRuleByID(id string) (*PortForwardingRule, int, error) {
-	p := &ListPortForwardingRulesParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListPortForwardingRules(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *CreateEgressFirewallRuleParams) GetIcmpcode() int {
+	if p.Icmpcode == nil {
+		return 0
 	}
+	return *p.Icmpcode
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *CreateEgressFirewallRuleParams) HasIcmpcode() bool {
+	return p.Icmpcode != nil
+}
 
-	if l.Count == 1 {
-		return l.PortForwardingRules[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for PortForwardingRule UUID: %s!", id)
+func (p *CreateEgressFirewallRuleParams) ResetIcmpcode() {
+	p.Icmpcode = nil
 }
 
-// Lists all port forwarding rules for an IP address.
-func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listPortForwardingRules", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'icmptype' param: the ICMP type, for the icmp protocol.
+func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
+	p.Icmptype = &v
+	return
+}
 
-	var r ListPortForwardingRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateEgressFirewallRuleParams) GetIcmptype() int {
+	if p.Icmptype == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Icmptype
 }
 
-type ListPortForwardingRulesResponse struct {
-	Count               int                   `json:"count"`
-	PortForwardingRules []*PortForwardingRule `json:"portforwardingrule"`
+func (p *CreateEgressFirewallRuleParams) HasIcmptype() bool {
+	return p.Icmptype != nil
 }
 
-type PortForwardingRule struct {
-	Cidrlist       string `json:"cidrlist,omitempty"`
-	Id             string `json:"id,omitempty"`
-	Ipaddress      string `json:"ipaddress,omitempty"`
-	Ipaddressid    string `json:"ipaddressid,omitempty"`
-	Networkid      string `json:"networkid,omitempty"`
-	Privateendport string `json:"privateendport,omitempty"`
-	Privateport    string `json:"privateport,omitempty"`
-	Protocol       string `json:"protocol,omitempty"`
-	Publicendport  string `json:"publicendport,omitempty"`
-	Publicport     string `json:"publicport,omitempty"`
-	State          string `json:"state,omitempty"`
-	Tags           []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vmguestip                 string `json:"vmguestip,omitempty"`
+func (p *CreateEgressFirewallRuleParams) ResetIcmptype() {
+	p.Icmptype = nil
 }
 
-type CreatePortForwardingRuleParams struct {
-	p map[string]interface{}
+// Sets the 'networkid' param: the ID of the network. This param is required.
+func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
 }
 
-func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["openfirewall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("openfirewall", vv)
-	}
-	if v, found := p.p["privateendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateendport", vv)
-	}
-	if v, found := p.p["privateport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("privateport", vv)
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["publicendport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicendport", vv)
-	}
-	if v, found := p.p["publicport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("publicport", vv)
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	if v, found := p.p["vmguestip"]; found {
-		u.Set("vmguestip", v.(string))
+func (p *CreateEgressFirewallRuleParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	return u
+	return *p.Networkid
 }
 
-func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) HasNetworkid() bool {
+	return p.Networkid != nil
 }
 
-func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) ResetNetworkid() {
+	p.Networkid = nil
 }
 
-func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
+// This param is required.
+func (p *CreateEgressFirewallRuleParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
 	return
 }
 
-func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateEgressFirewallRuleParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	p.p["openfirewall"] = v
-	return
+	return *p.Protocol
 }
 
-func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateendport"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) HasProtocol() bool {
+	return p.Protocol != nil
 }
 
-func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) ResetProtocol() {
+	p.Protocol = nil
 }
 
-func (p *CreatePortForwardingRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+// Sets the 'startport' param: the start of the port range.
+func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
+	p.Startport = &v
 	return
 }
 
-func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateEgressFirewallRuleParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
 	}
-	p.p["publicendport"] = v
-	return
+	return *p.Startport
 }
 
-func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["publicport"] = v
-	return
+func (p *CreateEgressFirewallRuleParams) HasStartport() bool {
+	return p.Startport != nil
 }
 
-func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
+func (p *CreateEgressFirewallRuleParams) ResetStartport() {
+	p.Startport = nil
+}
+
+func (p *CreateEgressFirewallRuleParams) SetType(v string) {
+	p.Type = &v
 	return
 }
 
-func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateEgressFirewallRuleParams) GetType() string {
+	if p.Type == nil {
+		return ""
 	}
-	p.p["vmguestip"] = v
-	return
+	return *p.Type
 }
 
-// You should always use this function to get a new CreatePortForwardingRuleParams instance,
+func (p *CreateEgressFirewallRuleParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *CreateEgressFirewallRuleParams) ResetType() {
+	p.Type = nil
+}
+
+// You should always use this function to get a new CreateEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
-	p := &CreatePortForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["privateport"] = privateport
-	p.p["protocol"] = protocol
-	p.p["publicport"] = publicport
-	p.p["virtualmachineid"] = virtualmachineid
+//
+// The required params are:
+//
+//   - networkid: the ID of the network
+//   - protocol
+func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol Protocol) *CreateEgressFirewallRuleParams {
+	p := &CreateEgressFirewallRuleParams{}
+	p.SetNetworkid(networkid)
+	p.SetProtocol(protocol)
 	return p
 }
 
-// Creates a port forwarding rule
-func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("createPortForwardingRule", p.toURLValues())
+// Creates a egress firewall rule for a given network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListEgressFirewallRules, GetEgressFirewallRuleByID, GetEgressFirewallRulesByTags.
+func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createEgressFirewallRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreatePortForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateEgressFirewallRuleResponse
+	if err := s.cs.unmarshal("createEgressFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -442,85 +324,63 @@ func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRulePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createEgressFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreatePortForwardingRuleResponse struct {
-	JobID          string `json:"jobid,omitempty"`
-	Cidrlist       string `json:"cidrlist,omitempty"`
-	Id             string `json:"id,omitempty"`
-	Ipaddress      string `json:"ipaddress,omitempty"`
-	Ipaddressid    string `json:"ipaddressid,omitempty"`
-	Networkid      string `json:"networkid,omitempty"`
-	Privateendport string `json:"privateendport,omitempty"`
-	Privateport    string `json:"privateport,omitempty"`
-	Protocol       string `json:"protocol,omitempty"`
-	Publicendport  string `json:"publicendport,omitempty"`
-	Publicport     string `json:"publicport,omitempty"`
-	State          string `json:"state,omitempty"`
-	Tags           []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vmguestip                 string `json:"vmguestip,omitempty"`
-}
-
-type DeletePortForwardingRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeletePortForwardingRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type CreateEgressFirewallRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteEgressFirewallRuleParams = cloudstackcommon.DeleteEgressFirewallRuleParams
+type DeleteEgressFirewallRuleResponse = cloudstackcommon.DeleteEgressFirewallRuleResponse
 
-// You should always use this function to get a new DeletePortForwardingRuleParams instance,
+// You should always use this function to get a new DeleteEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
-	p := &DeletePortForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
+	p := &DeleteEgressFirewallRuleParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a port forwarding rule
-func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("deletePortForwardingRule", p.toURLValues())
+// Deletes an ggress firewall rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListEgressFirewallRules, GetEgressFirewallRuleByID, GetEgressFirewallRulesByTags.
+func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteEgressFirewallRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeletePortForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteEgressFirewallRuleResponse
+	if err := s.cs.unmarshal("deleteEgressFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -536,118 +396,765 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteEgressFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeletePortForwardingRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type UpdatePortForwardingRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
+type ListEgressFirewallRulesParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid *string           `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListEgressFirewallRulesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["privateip"]; found {
-		u.Set("privateip", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["privateport"]; found {
-		u.Set("privateport", v.(string))
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["publicport"]; found {
-		u.Set("publicport", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
 	}
 	return u
 }
 
-func (p *UpdatePortForwardingRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListEgressFirewallRulesParams) Command() string {
+	return "listEgressFirewallRules"
 }
 
-func (p *UpdatePortForwardingRuleParams) SetPrivateip(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateip"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListEgressFirewallRulesParams) IsAsync() bool {
+	return false
 }
 
-func (p *UpdatePortForwardingRuleParams) SetPrivateport(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["privateport"] = v
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListEgressFirewallRulesParams) ResponseType() interface{} {
+	return &ListEgressFirewallRulesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListEgressFirewallRulesParams) Validate() error {
+	v := &validator{api: "listEgressFirewallRules"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *UpdatePortForwardingRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListEgressFirewallRulesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["protocol"] = v
+	return *p.Account
+}
+
+func (p *ListEgressFirewallRulesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *UpdatePortForwardingRuleParams) SetPublicport(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListEgressFirewallRulesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["publicport"] = v
+	return *p.Domainid
+}
+
+func (p *ListEgressFirewallRulesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListEgressFirewallRulesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListEgressFirewallRulesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new UpdatePortForwardingRuleParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewUpdatePortForwardingRuleParams(ipaddressid string, privateport string, protocol string, publicport string) *UpdatePortForwardingRuleParams {
-	p := &UpdatePortForwardingRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["privateport"] = privateport
-	p.p["protocol"] = protocol
-	p.p["publicport"] = publicport
-	return p
+func (p *ListEgressFirewallRulesParams) HasId() bool {
+	return p.Id != nil
 }
 
-// Updates a port forwarding rule.  Only the private port and the virtual machine can be updated.
-func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newRequest("updatePortForwardingRule", p.toURLValues())
+func (p *ListEgressFirewallRulesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address.
+func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
+	}
+	return *p.Ipaddressid
+}
+
+func (p *ListEgressFirewallRulesParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListEgressFirewallRulesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListEgressFirewallRulesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListEgressFirewallRulesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListEgressFirewallRulesParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListEgressFirewallRulesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListEgressFirewallRulesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListEgressFirewallRulesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListEgressFirewallRulesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListEgressFirewallRulesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListEgressFirewallRulesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListEgressFirewallRulesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// You should always use this function to get a new ListEgressFirewallRulesParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
+	p := &ListEgressFirewallRulesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	p := &ListEgressFirewallRulesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListEgressFirewallRules(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListEgressFirewallRules(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.EgressFirewallRules[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for EgressFirewallRule UUID: %s!", id)
+}
+
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *FirewallService) GetEgressFirewallRulesByTags(tags map[string]string, opts ...OptionFunc) ([]*EgressFirewallRule, error) {
+	p := &ListEgressFirewallRulesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListEgressFirewallRules(p)
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdatePortForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListEgressFirewallRules(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.EgressFirewallRules, nil
+}
+
+// Lists all egress firewall rules for network id.
+//
+// See also GetEgressFirewallRuleByID, GetEgressFirewallRulesByTags.
+func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listEgressFirewallRules", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListEgressFirewallRulesResponse
+	if err := s.cs.unmarshal("listEgressFirewallRules", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListEgressFirewallRules, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *FirewallService) StreamEgressFirewallRules(ctx context.Context, p *ListEgressFirewallRulesParams, fn func(*EgressFirewallRule) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listEgressFirewallRules", p.URLValues(), "firewallrule", func(b json.RawMessage) error {
+		var v EgressFirewallRule
+		if err := s.cs.unmarshalElement("listEgressFirewallRules", "firewallrule", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListEgressFirewallRulesResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	EgressFirewallRules []*EgressFirewallRule      `json:"firewallrule" xml:"firewallrule"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type EgressFirewallRule struct {
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateFirewallRuleParams struct {
+	Cidrlist    []string  `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Endport     *int      `json:"endport,omitempty" yaml:"endport,omitempty"`
+	Icmpcode    *int      `json:"icmpcode,omitempty" yaml:"icmpcode,omitempty"`
+	Icmptype    *int      `json:"icmptype,omitempty" yaml:"icmptype,omitempty"`
+	Ipaddressid *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Protocol    *Protocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Startport   *int      `json:"startport,omitempty" yaml:"startport,omitempty"`
+	Type        *string   `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateFirewallRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
+	}
+	if v := p.Endport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("endport", vv)
+	}
+	if v := p.Icmpcode; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmpcode", vv)
+	}
+	if v := p.Icmptype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("icmptype", vv)
+	}
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
+	}
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
+	}
+	if v := p.Startport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("startport", vv)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateFirewallRuleParams) Command() string {
+	return "createFirewallRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateFirewallRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateFirewallRuleParams) ResponseType() interface{} {
+	return &CreateFirewallRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateFirewallRuleParams) Validate() error {
+	v := &validator{api: "createFirewallRule"}
+	v.required("ipaddressid", p.Ipaddressid != nil)
+	v.required("protocol", p.Protocol != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *CreateFirewallRuleParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'endport' param: the end of the port range.
+func (p *CreateFirewallRuleParams) SetEndport(v int) {
+	p.Endport = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetEndport() int {
+	if p.Endport == nil {
+		return 0
+	}
+	return *p.Endport
+}
+
+func (p *CreateFirewallRuleParams) HasEndport() bool {
+	return p.Endport != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetEndport() {
+	p.Endport = nil
+}
+
+// Sets the 'icmpcode' param: the ICMP code, for the icmp protocol.
+func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
+	p.Icmpcode = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetIcmpcode() int {
+	if p.Icmpcode == nil {
+		return 0
+	}
+	return *p.Icmpcode
+}
+
+func (p *CreateFirewallRuleParams) HasIcmpcode() bool {
+	return p.Icmpcode != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetIcmpcode() {
+	p.Icmpcode = nil
+}
+
+// Sets the 'icmptype' param: the ICMP type, for the icmp protocol.
+func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
+	p.Icmptype = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetIcmptype() int {
+	if p.Icmptype == nil {
+		return 0
+	}
+	return *p.Icmptype
+}
+
+func (p *CreateFirewallRuleParams) HasIcmptype() bool {
+	return p.Icmptype != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetIcmptype() {
+	p.Icmptype = nil
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address. This param is required.
+func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
+	}
+	return *p.Ipaddressid
+}
+
+func (p *CreateFirewallRuleParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+// This param is required.
+func (p *CreateFirewallRuleParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+func (p *CreateFirewallRuleParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+// Sets the 'startport' param: the start of the port range.
+func (p *CreateFirewallRuleParams) SetStartport(v int) {
+	p.Startport = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetStartport() int {
+	if p.Startport == nil {
+		return 0
+	}
+	return *p.Startport
+}
+
+func (p *CreateFirewallRuleParams) HasStartport() bool {
+	return p.Startport != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetStartport() {
+	p.Startport = nil
+}
+
+func (p *CreateFirewallRuleParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *CreateFirewallRuleParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *CreateFirewallRuleParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *CreateFirewallRuleParams) ResetType() {
+	p.Type = nil
+}
+
+// You should always use this function to get a new CreateFirewallRuleParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ipaddressid: the ID of the public IP address
+//   - protocol
+func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol Protocol) *CreateFirewallRuleParams {
+	p := &CreateFirewallRuleParams{}
+	p.SetIpaddressid(ipaddressid)
+	p.SetProtocol(protocol)
+	return p
+}
+
+// Creates a firewall rule for a given ip address
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListFirewallRules, GetFirewallRuleByID, GetFirewallRulesByTags.
+func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createFirewallRule", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateFirewallRuleResponse
+	if err := s.cs.unmarshal("createFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -668,168 +1175,609 @@ func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRulePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+		if err := s.cs.unmarshal("createFirewallRule", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type CreateFirewallRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteFirewallRuleParams = cloudstackcommon.DeleteFirewallRuleParams
+type DeleteFirewallRuleResponse = cloudstackcommon.DeleteFirewallRuleResponse
+
+// You should always use this function to get a new DeleteFirewallRuleParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
+	p := &DeleteFirewallRuleParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a firewall rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListFirewallRules, GetFirewallRuleByID, GetFirewallRulesByTags.
+func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteFirewallRule", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteFirewallRuleResponse
+	if err := s.cs.unmarshal("deleteFirewallRule", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteFirewallRule", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListFirewallRulesParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid *string           `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListFirewallRulesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListFirewallRulesParams) Command() string {
+	return "listFirewallRules"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListFirewallRulesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListFirewallRulesParams) ResponseType() interface{} {
+	return &ListFirewallRulesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListFirewallRulesParams) Validate() error {
+	v := &validator{api: "listFirewallRules"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListFirewallRulesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListFirewallRulesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListFirewallRulesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListFirewallRulesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListFirewallRulesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListFirewallRulesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListFirewallRulesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListFirewallRulesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListFirewallRulesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address.
+func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
+	}
+	return *p.Ipaddressid
+}
+
+func (p *ListFirewallRulesParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *ListFirewallRulesParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListFirewallRulesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListFirewallRulesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListFirewallRulesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListFirewallRulesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListFirewallRulesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListFirewallRulesParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListFirewallRulesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListFirewallRulesParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListFirewallRulesParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListFirewallRulesParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListFirewallRulesParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListFirewallRulesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListFirewallRulesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListFirewallRulesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListFirewallRulesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListFirewallRulesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListFirewallRulesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListFirewallRulesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Projectid
 }
 
-type UpdatePortForwardingRuleResponse struct {
-	JobID          string `json:"jobid,omitempty"`
-	Cidrlist       string `json:"cidrlist,omitempty"`
-	Id             string `json:"id,omitempty"`
-	Ipaddress      string `json:"ipaddress,omitempty"`
-	Ipaddressid    string `json:"ipaddressid,omitempty"`
-	Networkid      string `json:"networkid,omitempty"`
-	Privateendport string `json:"privateendport,omitempty"`
-	Privateport    string `json:"privateport,omitempty"`
-	Protocol       string `json:"protocol,omitempty"`
-	Publicendport  string `json:"publicendport,omitempty"`
-	Publicport     string `json:"publicport,omitempty"`
-	State          string `json:"state,omitempty"`
-	Tags           []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vmguestip                 string `json:"vmguestip,omitempty"`
+func (p *ListFirewallRulesParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-type CreateFirewallRuleParams struct {
-	p map[string]interface{}
+func (p *ListFirewallRulesParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-func (p *CreateFirewallRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
-	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
-	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListFirewallRulesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListFirewallRulesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListFirewallRulesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// You should always use this function to get a new ListFirewallRulesParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
+	p := &ListFirewallRulesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	p := &ListFirewallRulesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+
+	l, err := s.ListFirewallRules(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListFirewallRules(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+
+	if l.Count == 1 {
+		return l.FirewallRules[0], l.Count, nil
 	}
-	return u
+	return nil, l.Count, fmt.Errorf("There is more then one result for FirewallRule UUID: %s!", id)
 }
 
-func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *FirewallService) GetFirewallRulesByTags(tags map[string]string, opts ...OptionFunc) ([]*FirewallRule, error) {
+	p := &ListFirewallRulesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
-	p.p["cidrlist"] = v
-	return
-}
 
-func (p *CreateFirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListFirewallRules(p)
+	if err != nil {
+		return nil, err
 	}
-	p.p["endport"] = v
-	return
-}
 
-func (p *CreateFirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListFirewallRules(p)
+		if err != nil {
+			return nil, err
+		}
 	}
-	p.p["icmpcode"] = v
-	return
+
+	return l.FirewallRules, nil
 }
 
-func (p *CreateFirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists all firewall rules for an IP address.
+//
+// See also GetFirewallRuleByID, GetFirewallRulesByTags.
+func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["icmptype"] = v
-	return
-}
 
-func (p *CreateFirewallRuleParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listFirewallRules", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["ipaddressid"] = v
-	return
-}
 
-func (p *CreateFirewallRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListFirewallRulesResponse
+	if err := s.cs.unmarshal("listFirewallRules", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["protocol"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreateFirewallRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListFirewallRules, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *FirewallService) StreamFirewallRules(ctx context.Context, p *ListFirewallRulesParams, fn func(*FirewallRule) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["startport"] = v
-	return
+
+	return s.cs.streamList(ctx, "listFirewallRules", p.URLValues(), "firewallrule", func(b json.RawMessage) error {
+		var v FirewallRule
+		if err := s.cs.unmarshalElement("listFirewallRules", "firewallrule", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *CreateFirewallRuleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["firewallType"] = v
-	return
+type ListFirewallRulesResponse struct {
+	Count         int                        `json:"count" xml:"count"`
+	FirewallRules []*FirewallRule            `json:"firewallrule" xml:"firewallrule"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-// You should always use this function to get a new CreateFirewallRuleParams instance,
+type FirewallRule struct {
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Endport     string                     `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode    int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype    int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid   string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Protocol    Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Startport   string                     `json:"startport,omitempty" xml:"startport,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags        []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddPaloAltoFirewallParams = cloudstackcommon.AddPaloAltoFirewallParams
+type AddPaloAltoFirewallResponse = cloudstackcommon.AddPaloAltoFirewallResponse
+
+// You should always use this function to get a new AddPaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
-	p := &CreateFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["ipaddressid"] = ipaddressid
-	p.p["protocol"] = protocol
+//
+// The required params are:
+//
+//   - networkdevicetype
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - url
+//   - username: the name of the user
+func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams {
+	p := &AddPaloAltoFirewallParams{}
+	p.SetNetworkdevicetype(networkdevicetype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Creates a firewall rule for a given ip address
-func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createFirewallRule", p.toURLValues())
+// Adds a Palo Alto firewall device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPaloAltoFirewalls.
+func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addPaloAltoFirewall", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddPaloAltoFirewallResponse
+	if err := s.cs.unmarshal("addPaloAltoFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -850,81 +1798,106 @@ func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*Crea
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addPaloAltoFirewall", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateFirewallRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Endport     string `json:"endport,omitempty"`
-	Icmpcode    int    `json:"icmpcode,omitempty"`
-	Icmptype    int    `json:"icmptype,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipaddressid string `json:"ipaddressid,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Startport   string `json:"startport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-}
-
-type DeleteFirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+type ConfigurePaloAltoFirewallParams = cloudstackcommon.ConfigurePaloAltoFirewallParams
+type ConfigurePaloAltoFirewallResponse = cloudstackcommon.ConfigurePaloAltoFirewallResponse
+
+// You should always use this function to get a new ConfigurePaloAltoFirewallParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - fwdeviceid
+func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams {
+	p := &ConfigurePaloAltoFirewallParams{}
+	p.SetFwdeviceid(fwdeviceid)
+	return p
+}
+
+// Configures a Palo Alto firewall device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPaloAltoFirewalls.
+func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*ConfigurePaloAltoFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	resp, err := s.cs.newRequest("configurePaloAltoFirewall", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *DeleteFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ConfigurePaloAltoFirewallResponse
+	if err := s.cs.unmarshal("configurePaloAltoFirewall", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("configurePaloAltoFirewall", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
-// You should always use this function to get a new DeleteFirewallRuleParams instance,
+type DeletePaloAltoFirewallParams = cloudstackcommon.DeletePaloAltoFirewallParams
+type DeletePaloAltoFirewallResponse = cloudstackcommon.DeletePaloAltoFirewallResponse
+
+// You should always use this function to get a new DeletePaloAltoFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams {
-	p := &DeleteFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - fwdeviceid
+func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams {
+	p := &DeletePaloAltoFirewallParams{}
+	p.SetFwdeviceid(fwdeviceid)
 	return p
 }
 
-// Deletes a firewall rule
-func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteFirewallRule", p.toURLValues())
+//	delete a Palo Alto firewall device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPaloAltoFirewalls.
+func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deletePaloAltoFirewall", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeletePaloAltoFirewallResponse
+	if err := s.cs.unmarshal("deletePaloAltoFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -940,376 +1913,414 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deletePaloAltoFirewall", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteFirewallRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type ListPaloAltoFirewallsParams = cloudstackcommon.ListPaloAltoFirewallsParams
+type ListPaloAltoFirewallsResponse = cloudstackcommon.ListPaloAltoFirewallsResponse
+type PaloAltoFirewall = cloudstackcommon.PaloAltoFirewall
 
-type ListFirewallRulesParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListPaloAltoFirewallsParams instance,
+// as then you are sure you have configured all required params
+func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams {
+	p := &ListPaloAltoFirewallsParams{}
+	return p
 }
 
-func (p *ListFirewallRulesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// lists Palo Alto firewall devices in a physical network
+func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+
+	resp, err := s.cs.newRequest("listPaloAltoFirewalls", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+
+	var r ListPaloAltoFirewallsResponse
+	if err := s.cs.unmarshal("listPaloAltoFirewalls", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	return &r, nil
+}
+
+// Lists the objects like ListPaloAltoFirewalls, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *FirewallService) StreamPaloAltoFirewalls(ctx context.Context, p *ListPaloAltoFirewallsParams, fn func(*PaloAltoFirewall) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
+
+	return s.cs.streamList(ctx, "listPaloAltoFirewalls", p.URLValues(), "paloaltofirewall", func(b json.RawMessage) error {
+		var v PaloAltoFirewall
+		if err := s.cs.unmarshalElement("listPaloAltoFirewalls", "paloaltofirewall", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type CreatePortForwardingRuleParams struct {
+	Cidrlist         []string  `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Ipaddressid      *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Networkid        *string   `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Openfirewall     *bool     `json:"openfirewall,omitempty" yaml:"openfirewall,omitempty"`
+	Privateendport   *int      `json:"privateendport,omitempty" yaml:"privateendport,omitempty"`
+	Privateport      *int      `json:"privateport,omitempty" yaml:"privateport,omitempty"`
+	Protocol         *Protocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Publicendport    *int      `json:"publicendport,omitempty" yaml:"publicendport,omitempty"`
+	Publicport       *int      `json:"publicport,omitempty" yaml:"publicport,omitempty"`
+	Virtualmachineid *string   `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmguestip        *string   `json:"vmguestip,omitempty" yaml:"vmguestip,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreatePortForwardingRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Cidrlist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("cidrlist", vv)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Openfirewall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("openfirewall", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Privateendport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("privateendport", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Privateport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("privateport", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Publicendport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("publicendport", vv)
 	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Publicport; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("publicport", vv)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	if v := p.Vmguestip; v != nil {
+		u.Set("vmguestip", *v)
 	}
 	return u
 }
 
-func (p *ListFirewallRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreatePortForwardingRuleParams) Command() string {
+	return "createPortForwardingRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreatePortForwardingRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreatePortForwardingRuleParams) ResponseType() interface{} {
+	return &CreatePortForwardingRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreatePortForwardingRuleParams) Validate() error {
+	v := &validator{api: "createPortForwardingRule"}
+	v.required("ipaddressid", p.Ipaddressid != nil)
+	v.required("privateport", p.Privateport != nil)
+	v.required("protocol", p.Protocol != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
+	}
+	v.required("publicport", p.Publicport != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs.
+func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
+	p.Cidrlist = v
+	return
+}
+
+func (p *CreatePortForwardingRuleParams) GetCidrlist() []string {
+	return p.Cidrlist
+}
+
+func (p *CreatePortForwardingRuleParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *CreatePortForwardingRuleParams) ResetCidrlist() {
+	p.Cidrlist = nil
 }
 
-func (p *ListFirewallRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Sets the 'ipaddressid' param: the ID of the public IP address. This param is required.
+func (p *CreatePortForwardingRuleParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
 	return
 }
 
-func (p *ListFirewallRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Ipaddressid
 }
 
-func (p *ListFirewallRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
-	return
+func (p *CreatePortForwardingRuleParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
 }
 
-func (p *ListFirewallRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+func (p *CreatePortForwardingRuleParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
 }
 
-func (p *ListFirewallRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Sets the 'networkid' param: the ID of the network.
+func (p *CreatePortForwardingRuleParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *ListFirewallRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["listall"] = v
-	return
+	return *p.Networkid
 }
 
-func (p *ListFirewallRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+func (p *CreatePortForwardingRuleParams) HasNetworkid() bool {
+	return p.Networkid != nil
 }
 
-func (p *ListFirewallRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *CreatePortForwardingRuleParams) ResetNetworkid() {
+	p.Networkid = nil
 }
 
-func (p *ListFirewallRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *CreatePortForwardingRuleParams) SetOpenfirewall(v bool) {
+	p.Openfirewall = &v
 	return
 }
 
-func (p *ListFirewallRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetOpenfirewall() bool {
+	if p.Openfirewall == nil {
+		return false
 	}
-	p.p["projectid"] = v
+	return *p.Openfirewall
+}
+
+func (p *CreatePortForwardingRuleParams) HasOpenfirewall() bool {
+	return p.Openfirewall != nil
+}
+
+func (p *CreatePortForwardingRuleParams) ResetOpenfirewall() {
+	p.Openfirewall = nil
+}
+
+func (p *CreatePortForwardingRuleParams) SetPrivateendport(v int) {
+	p.Privateendport = &v
 	return
 }
 
-func (p *ListFirewallRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetPrivateendport() int {
+	if p.Privateendport == nil {
+		return 0
 	}
-	p.p["tags"] = v
-	return
+	return *p.Privateendport
 }
 
-// You should always use this function to get a new ListFirewallRulesParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams {
-	p := &ListFirewallRulesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *CreatePortForwardingRuleParams) HasPrivateendport() bool {
+	return p.Privateendport != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *FirewallService) GetFirewallRuleByID(id string) (*FirewallRule, int, error) {
-	p := &ListFirewallRulesParams{}
-	p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) ResetPrivateendport() {
+	p.Privateendport = nil
+}
 
-	p.p["id"] = id
+// This param is required.
+func (p *CreatePortForwardingRuleParams) SetPrivateport(v int) {
+	p.Privateport = &v
+	return
+}
 
-	l, err := s.ListFirewallRules(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *CreatePortForwardingRuleParams) GetPrivateport() int {
+	if p.Privateport == nil {
+		return 0
 	}
+	return *p.Privateport
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *CreatePortForwardingRuleParams) HasPrivateport() bool {
+	return p.Privateport != nil
+}
 
-	if l.Count == 1 {
-		return l.FirewallRules[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for FirewallRule UUID: %s!", id)
+func (p *CreatePortForwardingRuleParams) ResetPrivateport() {
+	p.Privateport = nil
 }
 
-// Lists all firewall rules for an IP address.
-func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listFirewallRules", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// This param is required.
+func (p *CreatePortForwardingRuleParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
+	return
+}
 
-	var r ListFirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreatePortForwardingRuleParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Protocol
 }
 
-type ListFirewallRulesResponse struct {
-	Count         int             `json:"count"`
-	FirewallRules []*FirewallRule `json:"firewallrule"`
+func (p *CreatePortForwardingRuleParams) HasProtocol() bool {
+	return p.Protocol != nil
 }
 
-type FirewallRule struct {
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Endport     string `json:"endport,omitempty"`
-	Icmpcode    int    `json:"icmpcode,omitempty"`
-	Icmptype    int    `json:"icmptype,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipaddressid string `json:"ipaddressid,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Startport   string `json:"startport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
+func (p *CreatePortForwardingRuleParams) ResetProtocol() {
+	p.Protocol = nil
 }
 
-type CreateEgressFirewallRuleParams struct {
-	p map[string]interface{}
+func (p *CreatePortForwardingRuleParams) SetPublicendport(v int) {
+	p.Publicendport = &v
+	return
 }
 
-func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cidrlist"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("cidrlist", vv)
-	}
-	if v, found := p.p["endport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("endport", vv)
-	}
-	if v, found := p.p["icmpcode"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmpcode", vv)
+func (p *CreatePortForwardingRuleParams) GetPublicendport() int {
+	if p.Publicendport == nil {
+		return 0
 	}
-	if v, found := p.p["icmptype"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("icmptype", vv)
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
-	}
-	if v, found := p.p["startport"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("startport", vv)
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
+	return *p.Publicendport
 }
 
-func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidrlist"] = v
-	return
+func (p *CreatePortForwardingRuleParams) HasPublicendport() bool {
+	return p.Publicendport != nil
 }
 
-func (p *CreateEgressFirewallRuleParams) SetEndport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endport"] = v
-	return
+func (p *CreatePortForwardingRuleParams) ResetPublicendport() {
+	p.Publicendport = nil
 }
 
-func (p *CreateEgressFirewallRuleParams) SetIcmpcode(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["icmpcode"] = v
+// This param is required.
+func (p *CreatePortForwardingRuleParams) SetPublicport(v int) {
+	p.Publicport = &v
 	return
 }
 
-func (p *CreateEgressFirewallRuleParams) SetIcmptype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetPublicport() int {
+	if p.Publicport == nil {
+		return 0
 	}
-	p.p["icmptype"] = v
-	return
+	return *p.Publicport
 }
 
-func (p *CreateEgressFirewallRuleParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+func (p *CreatePortForwardingRuleParams) HasPublicport() bool {
+	return p.Publicport != nil
 }
 
-func (p *CreateEgressFirewallRuleParams) SetProtocol(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["protocol"] = v
+func (p *CreatePortForwardingRuleParams) ResetPublicport() {
+	p.Publicport = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *CreatePortForwardingRuleParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *CreateEgressFirewallRuleParams) SetStartport(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["startport"] = v
+	return *p.Virtualmachineid
+}
+
+func (p *CreatePortForwardingRuleParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *CreatePortForwardingRuleParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+func (p *CreatePortForwardingRuleParams) SetVmguestip(v string) {
+	p.Vmguestip = &v
 	return
 }
 
-func (p *CreateEgressFirewallRuleParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreatePortForwardingRuleParams) GetVmguestip() string {
+	if p.Vmguestip == nil {
+		return ""
 	}
-	p.p["firewallType"] = v
-	return
+	return *p.Vmguestip
 }
 
-// You should always use this function to get a new CreateEgressFirewallRuleParams instance,
+func (p *CreatePortForwardingRuleParams) HasVmguestip() bool {
+	return p.Vmguestip != nil
+}
+
+func (p *CreatePortForwardingRuleParams) ResetVmguestip() {
+	p.Vmguestip = nil
+}
+
+// You should always use this function to get a new CreatePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
-	p := &CreateEgressFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkid"] = networkid
-	p.p["protocol"] = protocol
+//
+// The required params are:
+//
+//   - ipaddressid: the ID of the public IP address
+//   - privateport
+//   - protocol
+//   - publicport
+//   - virtualmachineid: the ID of the virtual machine
+func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol Protocol, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
+	p := &CreatePortForwardingRuleParams{}
+	p.SetIpaddressid(ipaddressid)
+	p.SetPrivateport(privateport)
+	p.SetProtocol(protocol)
+	p.SetPublicport(publicport)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Creates a egress firewall rule for a given network
-func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("createEgressFirewallRule", p.toURLValues())
+// Creates a port forwarding rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPortForwardingRules, GetPortForwardingRuleByID, GetPortForwardingRulesByTags.
+func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createPortForwardingRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateEgressFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreatePortForwardingRuleResponse
+	if err := s.cs.unmarshal("createPortForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1330,81 +2341,67 @@ func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRulePa
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createPortForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateEgressFirewallRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Endport     string `json:"endport,omitempty"`
-	Icmpcode    int    `json:"icmpcode,omitempty"`
-	Icmptype    int    `json:"icmptype,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipaddressid string `json:"ipaddressid,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Startport   string `json:"startport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-}
-
-type DeleteEgressFirewallRuleParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type CreatePortForwardingRuleResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Cidrlist                  string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid               string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateendport            string                     `json:"privateendport,omitempty" xml:"privateendport,omitempty"`
+	Privateport               string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Protocol                  Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicendport             string                     `json:"publicendport,omitempty" xml:"publicendport,omitempty"`
+	Publicport                string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vmguestip                 string                     `json:"vmguestip,omitempty" xml:"vmguestip,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePortForwardingRuleParams = cloudstackcommon.DeletePortForwardingRuleParams
+type DeletePortForwardingRuleResponse = cloudstackcommon.DeletePortForwardingRuleResponse
 
-// You should always use this function to get a new DeleteEgressFirewallRuleParams instance,
+// You should always use this function to get a new DeletePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams {
-	p := &DeleteEgressFirewallRuleParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams {
+	p := &DeletePortForwardingRuleParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes an ggress firewall rule
-func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newRequest("deleteEgressFirewallRule", p.toURLValues())
+// Deletes a port forwarding rule
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPortForwardingRules, GetPortForwardingRuleByID, GetPortForwardingRulesByTags.
+func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deletePortForwardingRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteEgressFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeletePortForwardingRuleResponse
+	if err := s.cs.unmarshal("deletePortForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1420,74 +2417,71 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deletePortForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteEgressFirewallRuleResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListEgressFirewallRulesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
+type ListPortForwardingRulesParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddressid *string           `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Networkid   *string           `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPortForwardingRulesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
 	}
-	if v, found := p.p["ipaddressid"]; found {
-		u.Set("ipaddressid", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
-	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
@@ -1496,118 +2490,296 @@ func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListPortForwardingRulesParams) Command() string {
+	return "listPortForwardingRules"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPortForwardingRulesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPortForwardingRulesParams) ResponseType() interface{} {
+	return &ListPortForwardingRulesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPortForwardingRulesParams) Validate() error {
+	v := &validator{api: "listPortForwardingRules"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListPortForwardingRulesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListPortForwardingRulesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListPortForwardingRulesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListPortForwardingRulesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListPortForwardingRulesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListPortForwardingRulesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListPortForwardingRulesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListPortForwardingRulesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListPortForwardingRulesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address.
+func (p *ListPortForwardingRulesParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
+	return
+}
+
+func (p *ListPortForwardingRulesParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
+	}
+	return *p.Ipaddressid
+}
+
+func (p *ListPortForwardingRulesParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListPortForwardingRulesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListPortForwardingRulesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListPortForwardingRulesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPortForwardingRulesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListPortForwardingRulesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListPortForwardingRulesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-func (p *ListEgressFirewallRulesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListPortForwardingRulesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListEgressFirewallRulesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPortForwardingRulesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["id"] = v
-	return
+	return *p.Listall
 }
 
-func (p *ListEgressFirewallRulesParams) SetIpaddressid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddressid"] = v
-	return
+func (p *ListPortForwardingRulesParams) HasListall() bool {
+	return p.Listall != nil
 }
 
-func (p *ListEgressFirewallRulesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+func (p *ListPortForwardingRulesParams) ResetListall() {
+	p.Listall = nil
 }
 
-func (p *ListEgressFirewallRulesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListPortForwardingRulesParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *ListEgressFirewallRulesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPortForwardingRulesParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["listall"] = v
-	return
+	return *p.Networkid
 }
 
-func (p *ListEgressFirewallRulesParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkid"] = v
-	return
+func (p *ListPortForwardingRulesParams) HasNetworkid() bool {
+	return p.Networkid != nil
 }
 
-func (p *ListEgressFirewallRulesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+func (p *ListPortForwardingRulesParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPortForwardingRulesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListEgressFirewallRulesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPortForwardingRulesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListPortForwardingRulesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPortForwardingRulesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListEgressFirewallRulesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPortForwardingRulesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListPortForwardingRulesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListPortForwardingRulesParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListEgressFirewallRulesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPortForwardingRulesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Projectid
+}
+
+func (p *ListPortForwardingRulesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListPortForwardingRulesParams) SetTags(v map[string]string) {
+	p.Tags = v
 	return
 }
 
-// You should always use this function to get a new ListEgressFirewallRulesParams instance,
+func (p *ListPortForwardingRulesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListPortForwardingRulesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListPortForwardingRulesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// You should always use this function to get a new ListPortForwardingRulesParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams {
-	p := &ListEgressFirewallRulesParams{}
-	p.p = make(map[string]interface{})
+func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwardingRulesParams {
+	p := &ListPortForwardingRulesParams{}
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *FirewallService) GetEgressFirewallRuleByID(id string) (*EgressFirewallRule, int, error) {
-	p := &ListEgressFirewallRulesParams{}
-	p.p = make(map[string]interface{})
+func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	p := &ListPortForwardingRulesParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListEgressFirewallRules(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListPortForwardingRules(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -1617,319 +2789,351 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string) (*EgressFirewallR
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListPortForwardingRules(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
-		return l.EgressFirewallRules[0], l.Count, nil
+		return l.PortForwardingRules[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for EgressFirewallRule UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for PortForwardingRule UUID: %s!", id)
 }
 
-// Lists all egress firewall rules for network id.
-func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listEgressFirewallRules", p.toURLValues())
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *FirewallService) GetPortForwardingRulesByTags(tags map[string]string, opts ...OptionFunc) ([]*PortForwardingRule, error) {
+	p := &ListPortForwardingRulesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListPortForwardingRules(p)
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListEgressFirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListPortForwardingRules(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.PortForwardingRules, nil
+}
+
+// Lists all port forwarding rules for an IP address.
+//
+// See also GetPortForwardingRuleByID, GetPortForwardingRulesByTags.
+func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listPortForwardingRules", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListPortForwardingRulesResponse
+	if err := s.cs.unmarshal("listPortForwardingRules", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListEgressFirewallRulesResponse struct {
-	Count               int                   `json:"count"`
-	EgressFirewallRules []*EgressFirewallRule `json:"firewallrule"`
+// Lists the objects like ListPortForwardingRules, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *FirewallService) StreamPortForwardingRules(ctx context.Context, p *ListPortForwardingRulesParams, fn func(*PortForwardingRule) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listPortForwardingRules", p.URLValues(), "portforwardingrule", func(b json.RawMessage) error {
+		var v PortForwardingRule
+		if err := s.cs.unmarshalElement("listPortForwardingRules", "portforwardingrule", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type EgressFirewallRule struct {
-	Cidrlist    string `json:"cidrlist,omitempty"`
-	Endport     string `json:"endport,omitempty"`
-	Icmpcode    int    `json:"icmpcode,omitempty"`
-	Icmptype    int    `json:"icmptype,omitempty"`
-	Id          string `json:"id,omitempty"`
-	Ipaddress   string `json:"ipaddress,omitempty"`
-	Ipaddressid string `json:"ipaddressid,omitempty"`
-	Networkid   string `json:"networkid,omitempty"`
-	Protocol    string `json:"protocol,omitempty"`
-	Startport   string `json:"startport,omitempty"`
-	State       string `json:"state,omitempty"`
-	Tags        []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
+type ListPortForwardingRulesResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	PortForwardingRules []*PortForwardingRule      `json:"portforwardingrule" xml:"portforwardingrule"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type AddSrxFirewallParams struct {
-	p map[string]interface{}
+type PortForwardingRule struct {
+	Cidrlist                  string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid               string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateendport            string                     `json:"privateendport,omitempty" xml:"privateendport,omitempty"`
+	Privateport               string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Protocol                  Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicendport             string                     `json:"publicendport,omitempty" xml:"publicendport,omitempty"`
+	Publicport                string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vmguestip                 string                     `json:"vmguestip,omitempty" xml:"vmguestip,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdatePortForwardingRuleParams struct {
+	Ipaddressid      *string   `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Privateip        *string   `json:"privateip,omitempty" yaml:"privateip,omitempty"`
+	Privateport      *string   `json:"privateport,omitempty" yaml:"privateport,omitempty"`
+	Protocol         *Protocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Publicport       *string   `json:"publicport,omitempty" yaml:"publicport,omitempty"`
+	Virtualmachineid *string   `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *AddSrxFirewallParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdatePortForwardingRuleParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
 	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
+	if v := p.Privateip; v != nil {
+		u.Set("privateip", *v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Privateport; v != nil {
+		u.Set("privateport", *v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", string(*v))
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Publicport; v != nil {
+		u.Set("publicport", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *AddSrxFirewallParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdevicetype"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdatePortForwardingRuleParams) Command() string {
+	return "updatePortForwardingRule"
 }
 
-func (p *AddSrxFirewallParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdatePortForwardingRuleParams) IsAsync() bool {
+	return true
 }
 
-func (p *AddSrxFirewallParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdatePortForwardingRuleParams) ResponseType() interface{} {
+	return &UpdatePortForwardingRuleResponse{}
 }
 
-func (p *AddSrxFirewallParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdatePortForwardingRuleParams) Validate() error {
+	v := &validator{api: "updatePortForwardingRule"}
+	v.required("ipaddressid", p.Ipaddressid != nil)
+	v.required("privateport", p.Privateport != nil)
+	v.required("protocol", p.Protocol != nil)
+	if p.Protocol != nil {
+		v.enum("protocol", string(*p.Protocol), p.Protocol.Known(), false)
 	}
-	p.p["url"] = v
+	v.required("publicport", p.Publicport != nil)
+	return v.err()
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address. This param is required.
+func (p *UpdatePortForwardingRuleParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
 	return
 }
 
-func (p *AddSrxFirewallParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdatePortForwardingRuleParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
 	}
-	p.p["username"] = v
-	return
+	return *p.Ipaddressid
 }
 
-// You should always use this function to get a new AddSrxFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddSrxFirewallParams {
-	p := &AddSrxFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
-	return p
+func (p *UpdatePortForwardingRuleParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
 }
 
-// Adds a SRX firewall device
-func (s *FirewallService) AddSrxFirewall(p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addSrxFirewall", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdatePortForwardingRuleParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
 
-	var r AddSrxFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdatePortForwardingRuleParams) SetPrivateip(v string) {
+	p.Privateip = &v
+	return
+}
+
+func (p *UpdatePortForwardingRuleParams) GetPrivateip() string {
+	if p.Privateip == nil {
+		return ""
 	}
+	return *p.Privateip
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UpdatePortForwardingRuleParams) HasPrivateip() bool {
+	return p.Privateip != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *UpdatePortForwardingRuleParams) ResetPrivateip() {
+	p.Privateip = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// This param is required.
+func (p *UpdatePortForwardingRuleParams) SetPrivateport(v string) {
+	p.Privateport = &v
+	return
 }
 
-type AddSrxFirewallResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity,omitempty"`
-	Fwdeviceid        string `json:"fwdeviceid,omitempty"`
-	Fwdevicename      string `json:"fwdevicename,omitempty"`
-	Fwdevicestate     string `json:"fwdevicestate,omitempty"`
-	Ipaddress         string `json:"ipaddress,omitempty"`
-	Numretries        string `json:"numretries,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Privateinterface  string `json:"privateinterface,omitempty"`
-	Privatezone       string `json:"privatezone,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Publicinterface   string `json:"publicinterface,omitempty"`
-	Publiczone        string `json:"publiczone,omitempty"`
-	Timeout           string `json:"timeout,omitempty"`
-	Usageinterface    string `json:"usageinterface,omitempty"`
-	Username          string `json:"username,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
+func (p *UpdatePortForwardingRuleParams) GetPrivateport() string {
+	if p.Privateport == nil {
+		return ""
+	}
+	return *p.Privateport
 }
 
-type DeleteSrxFirewallParams struct {
-	p map[string]interface{}
+func (p *UpdatePortForwardingRuleParams) HasPrivateport() bool {
+	return p.Privateport != nil
 }
 
-func (p *DeleteSrxFirewallParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
-	}
-	return u
+func (p *UpdatePortForwardingRuleParams) ResetPrivateport() {
+	p.Privateport = nil
 }
 
-func (p *DeleteSrxFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fwdeviceid"] = v
+// This param is required.
+func (p *UpdatePortForwardingRuleParams) SetProtocol(v Protocol) {
+	p.Protocol = &v
 	return
 }
 
-// You should always use this function to get a new DeleteSrxFirewallParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewDeleteSrxFirewallParams(fwdeviceid string) *DeleteSrxFirewallParams {
-	p := &DeleteSrxFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
-	return p
+func (p *UpdatePortForwardingRuleParams) GetProtocol() Protocol {
+	if p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
 }
 
-//  delete a SRX firewall device
-func (s *FirewallService) DeleteSrxFirewall(p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deleteSrxFirewall", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UpdatePortForwardingRuleParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
 
-	var r DeleteSrxFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *UpdatePortForwardingRuleParams) ResetProtocol() {
+	p.Protocol = nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+// This param is required.
+func (p *UpdatePortForwardingRuleParams) SetPublicport(v string) {
+	p.Publicport = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *UpdatePortForwardingRuleParams) GetPublicport() string {
+	if p.Publicport == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Publicport
 }
 
-type DeleteSrxFirewallResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *UpdatePortForwardingRuleParams) HasPublicport() bool {
+	return p.Publicport != nil
 }
 
-type ConfigureSrxFirewallParams struct {
-	p map[string]interface{}
+func (p *UpdatePortForwardingRuleParams) ResetPublicport() {
+	p.Publicport = nil
 }
 
-func (p *ConfigureSrxFirewallParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fwdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("fwdevicecapacity", vv)
-	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
-	}
-	return u
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *UpdatePortForwardingRuleParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
 }
 
-func (p *ConfigureSrxFirewallParams) SetFwdevicecapacity(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdatePortForwardingRuleParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["fwdevicecapacity"] = v
-	return
+	return *p.Virtualmachineid
 }
 
-func (p *ConfigureSrxFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fwdeviceid"] = v
-	return
+func (p *UpdatePortForwardingRuleParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
 }
 
-// You should always use this function to get a new ConfigureSrxFirewallParams instance,
+func (p *UpdatePortForwardingRuleParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// You should always use this function to get a new UpdatePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewConfigureSrxFirewallParams(fwdeviceid string) *ConfigureSrxFirewallParams {
-	p := &ConfigureSrxFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
+//
+// The required params are:
+//
+//   - ipaddressid: the ID of the public IP address
+//   - privateport
+//   - protocol
+//   - publicport
+func (s *FirewallService) NewUpdatePortForwardingRuleParams(ipaddressid string, privateport string, protocol Protocol, publicport string) *UpdatePortForwardingRuleParams {
+	p := &UpdatePortForwardingRuleParams{}
+	p.SetIpaddressid(ipaddressid)
+	p.SetPrivateport(privateport)
+	p.SetProtocol(protocol)
+	p.SetPublicport(publicport)
 	return p
 }
 
-// Configures a SRX firewall device
-func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*ConfigureSrxFirewallResponse, error) {
-	resp, err := s.cs.newRequest("configureSrxFirewall", p.toURLValues())
+// Updates a port forwarding rule.  Only the private port and the virtual machine can be updated.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPortForwardingRules, GetPortForwardingRuleByID, GetPortForwardingRulesByTags.
+func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updatePortForwardingRule", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ConfigureSrxFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdatePortForwardingRuleResponse
+	if err := s.cs.unmarshal("updatePortForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1950,237 +3154,233 @@ func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updatePortForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ConfigureSrxFirewallResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity,omitempty"`
-	Fwdeviceid        string `json:"fwdeviceid,omitempty"`
-	Fwdevicename      string `json:"fwdevicename,omitempty"`
-	Fwdevicestate     string `json:"fwdevicestate,omitempty"`
-	Ipaddress         string `json:"ipaddress,omitempty"`
-	Numretries        string `json:"numretries,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Privateinterface  string `json:"privateinterface,omitempty"`
-	Privatezone       string `json:"privatezone,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Publicinterface   string `json:"publicinterface,omitempty"`
-	Publiczone        string `json:"publiczone,omitempty"`
-	Timeout           string `json:"timeout,omitempty"`
-	Usageinterface    string `json:"usageinterface,omitempty"`
-	Username          string `json:"username,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
+type UpdatePortForwardingRuleResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Cidrlist                  string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipaddressid               string                     `json:"ipaddressid,omitempty" xml:"ipaddressid,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Privateendport            string                     `json:"privateendport,omitempty" xml:"privateendport,omitempty"`
+	Privateport               string                     `json:"privateport,omitempty" xml:"privateport,omitempty"`
+	Protocol                  Protocol                   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Publicendport             string                     `json:"publicendport,omitempty" xml:"publicendport,omitempty"`
+	Publicport                string                     `json:"publicport,omitempty" xml:"publicport,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vmguestip                 string                     `json:"vmguestip,omitempty" xml:"vmguestip,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListSrxFirewallsParams struct {
-	p map[string]interface{}
+type AddSrxFirewallParams struct {
+	Networkdevicetype *string `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
 }
 
-func (p *ListSrxFirewallsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddSrxFirewallParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Networkdevicetype; v != nil {
+		u.Set("networkdevicetype", *v)
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
 	}
 	return u
 }
 
-func (p *ListSrxFirewallsParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fwdeviceid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddSrxFirewallParams) Command() string {
+	return "addSrxFirewall"
 }
 
-func (p *ListSrxFirewallsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AddSrxFirewallParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListSrxFirewallsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddSrxFirewallParams) ResponseType() interface{} {
+	return &AddSrxFirewallResponse{}
 }
 
-func (p *ListSrxFirewallsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddSrxFirewallParams) Validate() error {
+	v := &validator{api: "addSrxFirewall"}
+	v.required("networkdevicetype", p.Networkdevicetype != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddSrxFirewallParams) SetNetworkdevicetype(v string) {
+	p.Networkdevicetype = &v
 	return
 }
 
-func (p *ListSrxFirewallsParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddSrxFirewallParams) GetNetworkdevicetype() string {
+	if p.Networkdevicetype == nil {
+		return ""
 	}
-	p.p["physicalnetworkid"] = v
-	return
+	return *p.Networkdevicetype
 }
 
-// You should always use this function to get a new ListSrxFirewallsParams instance,
-// as then you are sure you have configured all required params
-func (s *FirewallService) NewListSrxFirewallsParams() *ListSrxFirewallsParams {
-	p := &ListSrxFirewallsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *AddSrxFirewallParams) HasNetworkdevicetype() bool {
+	return p.Networkdevicetype != nil
 }
 
-// lists SRX firewall devices in a physical network
-func (s *FirewallService) ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listSrxFirewalls", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddSrxFirewallParams) ResetNetworkdevicetype() {
+	p.Networkdevicetype = nil
+}
 
-	var r ListSrxFirewallsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'password' param: the password. This param is required.
+func (p *AddSrxFirewallParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddSrxFirewallParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Password
 }
 
-type ListSrxFirewallsResponse struct {
-	Count        int            `json:"count"`
-	SrxFirewalls []*SrxFirewall `json:"srxfirewall"`
+func (p *AddSrxFirewallParams) HasPassword() bool {
+	return p.Password != nil
 }
 
-type SrxFirewall struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity,omitempty"`
-	Fwdeviceid        string `json:"fwdeviceid,omitempty"`
-	Fwdevicename      string `json:"fwdevicename,omitempty"`
-	Fwdevicestate     string `json:"fwdevicestate,omitempty"`
-	Ipaddress         string `json:"ipaddress,omitempty"`
-	Numretries        string `json:"numretries,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Privateinterface  string `json:"privateinterface,omitempty"`
-	Privatezone       string `json:"privatezone,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Publicinterface   string `json:"publicinterface,omitempty"`
-	Publiczone        string `json:"publiczone,omitempty"`
-	Timeout           string `json:"timeout,omitempty"`
-	Usageinterface    string `json:"usageinterface,omitempty"`
-	Username          string `json:"username,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
-}
-
-type AddPaloAltoFirewallParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["networkdevicetype"]; found {
-		u.Set("networkdevicetype", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
+func (p *AddSrxFirewallParams) ResetPassword() {
+	p.Password = nil
 }
 
-func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdevicetype"] = v
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddSrxFirewallParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddSrxFirewallParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	p.p["password"] = v
-	return
+	return *p.Physicalnetworkid
 }
 
-func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
+func (p *AddSrxFirewallParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddSrxFirewallParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddSrxFirewallParams) SetUrl(v string) {
+	p.Url = &v
 	return
 }
 
-func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddSrxFirewallParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
 	}
-	p.p["url"] = v
+	return *p.Url
+}
+
+func (p *AddSrxFirewallParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddSrxFirewallParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddSrxFirewallParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
-func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddSrxFirewallParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
 	}
-	p.p["username"] = v
-	return
+	return *p.Username
 }
 
-// You should always use this function to get a new AddPaloAltoFirewallParams instance,
+func (p *AddSrxFirewallParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddSrxFirewallParams) ResetUsername() {
+	p.Username = nil
+}
+
+// You should always use this function to get a new AddSrxFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams {
-	p := &AddPaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["networkdevicetype"] = networkdevicetype
-	p.p["password"] = password
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["url"] = url
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - networkdevicetype
+//   - password: the password
+//   - physicalnetworkid: the ID of the physical network
+//   - url
+//   - username: the name of the user
+func (s *FirewallService) NewAddSrxFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddSrxFirewallParams {
+	p := &AddSrxFirewallParams{}
+	p.SetNetworkdevicetype(networkdevicetype)
+	p.SetPassword(password)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetUrl(url)
+	p.SetUsername(username)
 	return p
 }
 
-// Adds a Palo Alto firewall device
-func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("addPaloAltoFirewall", p.toURLValues())
+// Adds a SRX firewall device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSrxFirewalls.
+func (s *FirewallService) AddSrxFirewall(p *AddSrxFirewallParams) (*AddSrxFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addSrxFirewall", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddPaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddSrxFirewallResponse
+	if err := s.cs.unmarshal("addSrxFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2201,74 +3401,146 @@ func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*Ad
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addSrxFirewall", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddPaloAltoFirewallResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity,omitempty"`
-	Fwdeviceid        string `json:"fwdeviceid,omitempty"`
-	Fwdevicename      string `json:"fwdevicename,omitempty"`
-	Fwdevicestate     string `json:"fwdevicestate,omitempty"`
-	Ipaddress         string `json:"ipaddress,omitempty"`
-	Numretries        string `json:"numretries,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Privateinterface  string `json:"privateinterface,omitempty"`
-	Privatezone       string `json:"privatezone,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Publicinterface   string `json:"publicinterface,omitempty"`
-	Publiczone        string `json:"publiczone,omitempty"`
-	Timeout           string `json:"timeout,omitempty"`
-	Usageinterface    string `json:"usageinterface,omitempty"`
-	Username          string `json:"username,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
+type AddSrxFirewallResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Fwdevicecapacity  int64                      `json:"fwdevicecapacity,omitempty" xml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid        string                     `json:"fwdeviceid,omitempty" xml:"fwdeviceid,omitempty"`
+	Fwdevicename      string                     `json:"fwdevicename,omitempty" xml:"fwdevicename,omitempty"`
+	Fwdevicestate     string                     `json:"fwdevicestate,omitempty" xml:"fwdevicestate,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries        string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone       string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone        string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout           string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface    string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username          string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type DeletePaloAltoFirewallParams struct {
-	p map[string]interface{}
+type ConfigureSrxFirewallParams struct {
+	Fwdevicecapacity *int64  `json:"fwdevicecapacity,omitempty" yaml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid       *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
 }
 
-func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigureSrxFirewallParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Fwdevicecapacity; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("fwdevicecapacity", vv)
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if v := p.Fwdeviceid; v != nil {
+		u.Set("fwdeviceid", *v)
 	}
 	return u
 }
 
-func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ConfigureSrxFirewallParams) Command() string {
+	return "configureSrxFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigureSrxFirewallParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigureSrxFirewallParams) ResponseType() interface{} {
+	return &ConfigureSrxFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigureSrxFirewallParams) Validate() error {
+	v := &validator{api: "configureSrxFirewall"}
+	v.required("fwdeviceid", p.Fwdeviceid != nil)
+	return v.err()
+}
+
+func (p *ConfigureSrxFirewallParams) SetFwdevicecapacity(v int64) {
+	p.Fwdevicecapacity = &v
+	return
+}
+
+func (p *ConfigureSrxFirewallParams) GetFwdevicecapacity() int64 {
+	if p.Fwdevicecapacity == nil {
+		return 0
 	}
-	p.p["fwdeviceid"] = v
+	return *p.Fwdevicecapacity
+}
+
+func (p *ConfigureSrxFirewallParams) HasFwdevicecapacity() bool {
+	return p.Fwdevicecapacity != nil
+}
+
+func (p *ConfigureSrxFirewallParams) ResetFwdevicecapacity() {
+	p.Fwdevicecapacity = nil
+}
+
+// This param is required.
+func (p *ConfigureSrxFirewallParams) SetFwdeviceid(v string) {
+	p.Fwdeviceid = &v
 	return
 }
 
-// You should always use this function to get a new DeletePaloAltoFirewallParams instance,
+func (p *ConfigureSrxFirewallParams) GetFwdeviceid() string {
+	if p.Fwdeviceid == nil {
+		return ""
+	}
+	return *p.Fwdeviceid
+}
+
+func (p *ConfigureSrxFirewallParams) HasFwdeviceid() bool {
+	return p.Fwdeviceid != nil
+}
+
+func (p *ConfigureSrxFirewallParams) ResetFwdeviceid() {
+	p.Fwdeviceid = nil
+}
+
+// You should always use this function to get a new ConfigureSrxFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams {
-	p := &DeletePaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
+//
+// The required params are:
+//
+//   - fwdeviceid
+func (s *FirewallService) NewConfigureSrxFirewallParams(fwdeviceid string) *ConfigureSrxFirewallParams {
+	p := &ConfigureSrxFirewallParams{}
+	p.SetFwdeviceid(fwdeviceid)
 	return p
 }
 
-//  delete a Palo Alto firewall device
-func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("deletePaloAltoFirewall", p.toURLValues())
+// Configures a SRX firewall device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSrxFirewalls.
+func (s *FirewallService) ConfigureSrxFirewall(p *ConfigureSrxFirewallParams) (*ConfigureSrxFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("configureSrxFirewall", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeletePaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ConfigureSrxFirewallResponse
+	if err := s.cs.unmarshal("configureSrxFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2284,72 +3556,126 @@ func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("configureSrxFirewall", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeletePaloAltoFirewallResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type ConfigureSrxFirewallResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Fwdevicecapacity  int64                      `json:"fwdevicecapacity,omitempty" xml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid        string                     `json:"fwdeviceid,omitempty" xml:"fwdeviceid,omitempty"`
+	Fwdevicename      string                     `json:"fwdevicename,omitempty" xml:"fwdevicename,omitempty"`
+	Fwdevicestate     string                     `json:"fwdevicestate,omitempty" xml:"fwdevicestate,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries        string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone       string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone        string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout           string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface    string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username          string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ConfigurePaloAltoFirewallParams struct {
-	p map[string]interface{}
+type DeleteSrxFirewallParams struct {
+	Fwdeviceid *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
 }
 
-func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSrxFirewallParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fwdevicecapacity"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("fwdevicecapacity", vv)
-	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if v := p.Fwdeviceid; v != nil {
+		u.Set("fwdeviceid", *v)
 	}
 	return u
 }
 
-func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fwdevicecapacity"] = v
+// Returns the name of the API command of these params
+func (p *DeleteSrxFirewallParams) Command() string {
+	return "deleteSrxFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSrxFirewallParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSrxFirewallParams) ResponseType() interface{} {
+	return &DeleteSrxFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSrxFirewallParams) Validate() error {
+	v := &validator{api: "deleteSrxFirewall"}
+	v.required("fwdeviceid", p.Fwdeviceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteSrxFirewallParams) SetFwdeviceid(v string) {
+	p.Fwdeviceid = &v
 	return
 }
 
-func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteSrxFirewallParams) GetFwdeviceid() string {
+	if p.Fwdeviceid == nil {
+		return ""
 	}
-	p.p["fwdeviceid"] = v
-	return
+	return *p.Fwdeviceid
 }
 
-// You should always use this function to get a new ConfigurePaloAltoFirewallParams instance,
+func (p *DeleteSrxFirewallParams) HasFwdeviceid() bool {
+	return p.Fwdeviceid != nil
+}
+
+func (p *DeleteSrxFirewallParams) ResetFwdeviceid() {
+	p.Fwdeviceid = nil
+}
+
+// You should always use this function to get a new DeleteSrxFirewallParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams {
-	p := &ConfigurePaloAltoFirewallParams{}
-	p.p = make(map[string]interface{})
-	p.p["fwdeviceid"] = fwdeviceid
+//
+// The required params are:
+//
+//   - fwdeviceid
+func (s *FirewallService) NewDeleteSrxFirewallParams(fwdeviceid string) *DeleteSrxFirewallParams {
+	p := &DeleteSrxFirewallParams{}
+	p.SetFwdeviceid(fwdeviceid)
 	return p
 }
 
-// Configures a Palo Alto firewall device
-func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*ConfigurePaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newRequest("configurePaloAltoFirewall", p.toURLValues())
+//	delete a SRX firewall device
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSrxFirewalls.
+func (s *FirewallService) DeleteSrxFirewall(p *DeleteSrxFirewallParams) (*DeleteSrxFirewallResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteSrxFirewall", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ConfigurePaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteSrxFirewallResponse
+	if err := s.cs.unmarshal("deleteSrxFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2365,149 +3691,245 @@ func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewall
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteSrxFirewall", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ConfigurePaloAltoFirewallResponse struct {
-	JobID             string `json:"jobid,omitempty"`
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity,omitempty"`
-	Fwdeviceid        string `json:"fwdeviceid,omitempty"`
-	Fwdevicename      string `json:"fwdevicename,omitempty"`
-	Fwdevicestate     string `json:"fwdevicestate,omitempty"`
-	Ipaddress         string `json:"ipaddress,omitempty"`
-	Numretries        string `json:"numretries,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Privateinterface  string `json:"privateinterface,omitempty"`
-	Privatezone       string `json:"privatezone,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Publicinterface   string `json:"publicinterface,omitempty"`
-	Publiczone        string `json:"publiczone,omitempty"`
-	Timeout           string `json:"timeout,omitempty"`
-	Usageinterface    string `json:"usageinterface,omitempty"`
-	Username          string `json:"username,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
+type DeleteSrxFirewallResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListPaloAltoFirewallsParams struct {
-	p map[string]interface{}
+type ListSrxFirewallsParams struct {
+	Fwdeviceid        *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
 }
 
-func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSrxFirewallsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Fwdeviceid; v != nil {
+		u.Set("fwdeviceid", *v)
 	}
-	if v, found := p.p["fwdeviceid"]; found {
-		u.Set("fwdeviceid", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
 	return u
 }
 
-func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fwdeviceid"] = v
+// Returns the name of the API command of these params
+func (p *ListSrxFirewallsParams) Command() string {
+	return "listSrxFirewalls"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSrxFirewallsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSrxFirewallsParams) ResponseType() interface{} {
+	return &ListSrxFirewallsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSrxFirewallsParams) Validate() error {
+	v := &validator{api: "listSrxFirewalls"}
+	return v.err()
+}
+
+func (p *ListSrxFirewallsParams) SetFwdeviceid(v string) {
+	p.Fwdeviceid = &v
 	return
 }
 
-func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallsParams) GetFwdeviceid() string {
+	if p.Fwdeviceid == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Fwdeviceid
+}
+
+func (p *ListSrxFirewallsParams) HasFwdeviceid() bool {
+	return p.Fwdeviceid != nil
+}
+
+func (p *ListSrxFirewallsParams) ResetFwdeviceid() {
+	p.Fwdeviceid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSrxFirewallsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Keyword
+}
+
+func (p *ListSrxFirewallsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSrxFirewallsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSrxFirewallsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListSrxFirewallsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSrxFirewallsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSrxFirewallsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSrxFirewallsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["physicalnetworkid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListSrxFirewallsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSrxFirewallsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListSrxFirewallsParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-// You should always use this function to get a new ListPaloAltoFirewallsParams instance,
+func (p *ListSrxFirewallsParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListSrxFirewallsParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListSrxFirewallsParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// You should always use this function to get a new ListSrxFirewallsParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams {
-	p := &ListPaloAltoFirewallsParams{}
-	p.p = make(map[string]interface{})
+func (s *FirewallService) NewListSrxFirewallsParams() *ListSrxFirewallsParams {
+	p := &ListSrxFirewallsParams{}
 	return p
 }
 
-// lists Palo Alto firewall devices in a physical network
-func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewalls", p.toURLValues())
+// lists SRX firewall devices in a physical network
+func (s *FirewallService) ListSrxFirewalls(p *ListSrxFirewallsParams) (*ListSrxFirewallsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSrxFirewalls", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListPaloAltoFirewallsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListSrxFirewallsResponse
+	if err := s.cs.unmarshal("listSrxFirewalls", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListPaloAltoFirewallsResponse struct {
-	Count             int                 `json:"count"`
-	PaloAltoFirewalls []*PaloAltoFirewall `json:"paloaltofirewall"`
-}
-
-type PaloAltoFirewall struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity,omitempty"`
-	Fwdeviceid        string `json:"fwdeviceid,omitempty"`
-	Fwdevicename      string `json:"fwdevicename,omitempty"`
-	Fwdevicestate     string `json:"fwdevicestate,omitempty"`
-	Ipaddress         string `json:"ipaddress,omitempty"`
-	Numretries        string `json:"numretries,omitempty"`
-	Physicalnetworkid string `json:"physicalnetworkid,omitempty"`
-	Privateinterface  string `json:"privateinterface,omitempty"`
-	Privatezone       string `json:"privatezone,omitempty"`
-	Provider          string `json:"provider,omitempty"`
-	Publicinterface   string `json:"publicinterface,omitempty"`
-	Publiczone        string `json:"publiczone,omitempty"`
-	Timeout           string `json:"timeout,omitempty"`
-	Usageinterface    string `json:"usageinterface,omitempty"`
-	Username          string `json:"username,omitempty"`
-	Zoneid            string `json:"zoneid,omitempty"`
+// Lists the objects like ListSrxFirewalls, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *FirewallService) StreamSrxFirewalls(ctx context.Context, p *ListSrxFirewallsParams, fn func(*SrxFirewall) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listSrxFirewalls", p.URLValues(), "srxfirewall", func(b json.RawMessage) error {
+		var v SrxFirewall
+		if err := s.cs.unmarshalElement("listSrxFirewalls", "srxfirewall", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListSrxFirewallsResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	SrxFirewalls []*SrxFirewall             `json:"srxfirewall" xml:"srxfirewall"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SrxFirewall struct {
+	Fwdevicecapacity  int64                      `json:"fwdevicecapacity,omitempty" xml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid        string                     `json:"fwdeviceid,omitempty" xml:"fwdeviceid,omitempty"`
+	Fwdevicename      string                     `json:"fwdevicename,omitempty" xml:"fwdevicename,omitempty"`
+	Fwdevicestate     string                     `json:"fwdevicestate,omitempty" xml:"fwdevicestate,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries        string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone       string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone        string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout           string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface    string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username          string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
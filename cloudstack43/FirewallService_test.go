@@ -0,0 +1,1402 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateEgressFirewallRule(t *testing.T) {
+	params := url.Values{
+		"cidrlist":  {"test-cidrlist1, test-cidrlist2"},
+		"endport":   {"2"},
+		"icmpcode":  {"3"},
+		"icmptype":  {"4"},
+		"networkid": {"test-networkid"},
+		"protocol":  {"all"},
+		"startport": {"7"},
+		"type":      {"test-type"},
+	}
+	cs, done := newTestClient(t, "createEgressFirewallRule", params, `{"createegressfirewallruleresponse":{"cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","jobid":"test-jobid","networkid":"test-networkid","protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewCreateEgressFirewallRuleParams("test-networkid", ProtocolAll)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetEndport(2)
+	p.SetIcmpcode(3)
+	p.SetIcmptype(4)
+	p.SetNetworkid("test-networkid")
+	p.SetProtocol(ProtocolAll)
+	p.SetStartport(7)
+	p.SetType("test-type")
+	checkURLValues(t, "createEgressFirewallRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.CreateEgressFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createEgressFirewallRule: %v", err)
+	}
+	if r.Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.Cidrlist to be %v, got %v", "test-cidrlist", r.Cidrlist)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createEgressFirewallRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createEgressFirewallRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createEgressFirewallRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createEgressFirewallRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createEgressFirewallRule", params, `<createegressfirewallruleresponse><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><jobid>test-jobid</jobid><networkid>test-networkid</networkid><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></createegressfirewallruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.CreateEgressFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createEgressFirewallRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteEgressFirewallRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteEgressFirewallRule", params, `{"deleteegressfirewallruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Firewall.NewDeleteEgressFirewallRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteEgressFirewallRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.DeleteEgressFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteEgressFirewallRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteEgressFirewallRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteEgressFirewallRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteEgressFirewallRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteEgressFirewallRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteEgressFirewallRule", params, `<deleteegressfirewallruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteegressfirewallruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.DeleteEgressFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteEgressFirewallRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListEgressFirewallRules(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"ipaddressid":   {"test-ipaddressid"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"networkid":     {"test-networkid"},
+		"page":          {"9"},
+		"pagesize":      {"10"},
+		"projectid":     {"test-projectid"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listEgressFirewallRules", params, `{"listegressfirewallrulesresponse":{"count":1,"firewallrule":[{"cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","networkid":"test-networkid","protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewListEgressFirewallRulesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetNetworkid("test-networkid")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listEgressFirewallRules", p.URLValues(), params)
+
+	r, err := cs.Firewall.ListEgressFirewallRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listEgressFirewallRules: %v", err)
+	}
+	if r.EgressFirewallRules[0].Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.EgressFirewallRules[0].Cidrlist to be %v, got %v", "test-cidrlist", r.EgressFirewallRules[0].Cidrlist)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listEgressFirewallRules" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listEgressFirewallRules: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listEgressFirewallRules: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listEgressFirewallRules: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*EgressFirewallRule
+	count, err := cs.Firewall.StreamEgressFirewallRules(context.Background(), p, func(v *EgressFirewallRule) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listEgressFirewallRules: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.EgressFirewallRules) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.EgressFirewallRules)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listEgressFirewallRules", params, `<listegressfirewallrulesresponse><count>1</count><firewallrule><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><networkid>test-networkid</networkid><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></firewallrule></listegressfirewallrulesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ListEgressFirewallRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listEgressFirewallRules using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Firewall.StreamEgressFirewallRules(context.Background(), p, func(v *EgressFirewallRule) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listEgressFirewallRules using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.EgressFirewallRules) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.EgressFirewallRules)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateFirewallRule(t *testing.T) {
+	params := url.Values{
+		"cidrlist":    {"test-cidrlist1, test-cidrlist2"},
+		"endport":     {"2"},
+		"icmpcode":    {"3"},
+		"icmptype":    {"4"},
+		"ipaddressid": {"test-ipaddressid"},
+		"protocol":    {"all"},
+		"startport":   {"7"},
+		"type":        {"test-type"},
+	}
+	cs, done := newTestClient(t, "createFirewallRule", params, `{"createfirewallruleresponse":{"cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","jobid":"test-jobid","networkid":"test-networkid","protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewCreateFirewallRuleParams("test-ipaddressid", ProtocolAll)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetEndport(2)
+	p.SetIcmpcode(3)
+	p.SetIcmptype(4)
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetProtocol(ProtocolAll)
+	p.SetStartport(7)
+	p.SetType("test-type")
+	checkURLValues(t, "createFirewallRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.CreateFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createFirewallRule: %v", err)
+	}
+	if r.Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.Cidrlist to be %v, got %v", "test-cidrlist", r.Cidrlist)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createFirewallRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createFirewallRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createFirewallRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createFirewallRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createFirewallRule", params, `<createfirewallruleresponse><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><jobid>test-jobid</jobid><networkid>test-networkid</networkid><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></createfirewallruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.CreateFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createFirewallRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteFirewallRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteFirewallRule", params, `{"deletefirewallruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Firewall.NewDeleteFirewallRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteFirewallRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.DeleteFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteFirewallRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteFirewallRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteFirewallRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteFirewallRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteFirewallRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteFirewallRule", params, `<deletefirewallruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletefirewallruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.DeleteFirewallRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteFirewallRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListFirewallRules(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"ipaddressid":   {"test-ipaddressid"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"networkid":     {"test-networkid"},
+		"page":          {"9"},
+		"pagesize":      {"10"},
+		"projectid":     {"test-projectid"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listFirewallRules", params, `{"listfirewallrulesresponse":{"count":1,"firewallrule":[{"cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","networkid":"test-networkid","protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewListFirewallRulesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetNetworkid("test-networkid")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listFirewallRules", p.URLValues(), params)
+
+	r, err := cs.Firewall.ListFirewallRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listFirewallRules: %v", err)
+	}
+	if r.FirewallRules[0].Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.FirewallRules[0].Cidrlist to be %v, got %v", "test-cidrlist", r.FirewallRules[0].Cidrlist)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listFirewallRules" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listFirewallRules: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listFirewallRules: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listFirewallRules: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*FirewallRule
+	count, err := cs.Firewall.StreamFirewallRules(context.Background(), p, func(v *FirewallRule) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listFirewallRules: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.FirewallRules) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.FirewallRules)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listFirewallRules", params, `<listfirewallrulesresponse><count>1</count><firewallrule><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><networkid>test-networkid</networkid><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></firewallrule></listfirewallrulesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ListFirewallRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listFirewallRules using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Firewall.StreamFirewallRules(context.Background(), p, func(v *FirewallRule) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listFirewallRules using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.FirewallRules) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.FirewallRules)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddPaloAltoFirewall(t *testing.T) {
+	params := url.Values{
+		"networkdevicetype": {"test-networkdevicetype"},
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+	cs, done := newTestClient(t, "addPaloAltoFirewall", params, `{"addpaloaltofirewallresponse":{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","jobid":"test-jobid","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Firewall.NewAddPaloAltoFirewallParams("test-networkdevicetype", "test-password", "test-physicalnetworkid", "test-url", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addPaloAltoFirewall", p.URLValues(), params)
+
+	r, err := cs.Firewall.AddPaloAltoFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call addPaloAltoFirewall: %v", err)
+	}
+	if r.Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.Fwdeviceid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addPaloAltoFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addPaloAltoFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addPaloAltoFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addPaloAltoFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addPaloAltoFirewall", params, `<addpaloaltofirewallresponse><fwdevicecapacity>1</fwdevicecapacity><fwdeviceid>test-fwdeviceid</fwdeviceid><fwdevicename>test-fwdevicename</fwdevicename><fwdevicestate>test-fwdevicestate</fwdevicestate><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><numretries>test-numretries</numretries><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></addpaloaltofirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.AddPaloAltoFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call addPaloAltoFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestConfigurePaloAltoFirewall(t *testing.T) {
+	params := url.Values{
+		"fwdevicecapacity": {"1"},
+		"fwdeviceid":       {"test-fwdeviceid"},
+	}
+	cs, done := newTestClient(t, "configurePaloAltoFirewall", params, `{"configurepaloaltofirewallresponse":{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","jobid":"test-jobid","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Firewall.NewConfigurePaloAltoFirewallParams("test-fwdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFwdevicecapacity(1)
+	p.SetFwdeviceid("test-fwdeviceid")
+	checkURLValues(t, "configurePaloAltoFirewall", p.URLValues(), params)
+
+	r, err := cs.Firewall.ConfigurePaloAltoFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call configurePaloAltoFirewall: %v", err)
+	}
+	if r.Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.Fwdeviceid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "configurePaloAltoFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute configurePaloAltoFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of configurePaloAltoFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of configurePaloAltoFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "configurePaloAltoFirewall", params, `<configurepaloaltofirewallresponse><fwdevicecapacity>1</fwdevicecapacity><fwdeviceid>test-fwdeviceid</fwdeviceid><fwdevicename>test-fwdevicename</fwdevicename><fwdevicestate>test-fwdevicestate</fwdevicestate><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><numretries>test-numretries</numretries><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></configurepaloaltofirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ConfigurePaloAltoFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call configurePaloAltoFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeletePaloAltoFirewall(t *testing.T) {
+	params := url.Values{
+		"fwdeviceid": {"test-fwdeviceid"},
+	}
+	cs, done := newTestClient(t, "deletePaloAltoFirewall", params, `{"deletepaloaltofirewallresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Firewall.NewDeletePaloAltoFirewallParams("test-fwdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFwdeviceid("test-fwdeviceid")
+	checkURLValues(t, "deletePaloAltoFirewall", p.URLValues(), params)
+
+	r, err := cs.Firewall.DeletePaloAltoFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePaloAltoFirewall: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deletePaloAltoFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deletePaloAltoFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deletePaloAltoFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deletePaloAltoFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deletePaloAltoFirewall", params, `<deletepaloaltofirewallresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletepaloaltofirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.DeletePaloAltoFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePaloAltoFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPaloAltoFirewalls(t *testing.T) {
+	params := url.Values{
+		"fwdeviceid":        {"test-fwdeviceid"},
+		"keyword":           {"test-keyword"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "listPaloAltoFirewalls", params, `{"listpaloaltofirewallsresponse":{"count":1,"paloaltofirewall":[{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewListPaloAltoFirewallsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFwdeviceid("test-fwdeviceid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "listPaloAltoFirewalls", p.URLValues(), params)
+
+	r, err := cs.Firewall.ListPaloAltoFirewalls(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPaloAltoFirewalls: %v", err)
+	}
+	if r.PaloAltoFirewalls[0].Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.PaloAltoFirewalls[0].Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.PaloAltoFirewalls[0].Fwdeviceid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPaloAltoFirewalls" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPaloAltoFirewalls: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPaloAltoFirewalls: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPaloAltoFirewalls: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PaloAltoFirewall
+	count, err := cs.Firewall.StreamPaloAltoFirewalls(context.Background(), p, func(v *PaloAltoFirewall) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPaloAltoFirewalls: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PaloAltoFirewalls) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PaloAltoFirewalls)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPaloAltoFirewalls", params, `<listpaloaltofirewallsresponse><count>1</count><paloaltofirewall><fwdevicecapacity>1</fwdevicecapacity><fwdeviceid>test-fwdeviceid</fwdeviceid><fwdevicename>test-fwdevicename</fwdevicename><fwdevicestate>test-fwdevicestate</fwdevicestate><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></paloaltofirewall></listpaloaltofirewallsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ListPaloAltoFirewalls(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPaloAltoFirewalls using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Firewall.StreamPaloAltoFirewalls(context.Background(), p, func(v *PaloAltoFirewall) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPaloAltoFirewalls using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PaloAltoFirewalls) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PaloAltoFirewalls)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreatePortForwardingRule(t *testing.T) {
+	params := url.Values{
+		"cidrlist":         {"test-cidrlist1, test-cidrlist2"},
+		"ipaddressid":      {"test-ipaddressid"},
+		"networkid":        {"test-networkid"},
+		"openfirewall":     {"true"},
+		"privateendport":   {"5"},
+		"privateport":      {"6"},
+		"protocol":         {"all"},
+		"publicendport":    {"8"},
+		"publicport":       {"9"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"vmguestip":        {"test-vmguestip"},
+	}
+	cs, done := newTestClient(t, "createPortForwardingRule", params, `{"createportforwardingruleresponse":{"cidrlist":"test-cidrlist","id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","jobid":"test-jobid","networkid":"test-networkid","privateendport":"test-privateendport","privateport":"test-privateport","protocol":"test-protocol","publicendport":"test-publicendport","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vmguestip":"test-vmguestip"}}`)
+	defer done()
+
+	p := cs.Firewall.NewCreatePortForwardingRuleParams("test-ipaddressid", 6, ProtocolAll, 9, "test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetNetworkid("test-networkid")
+	p.SetOpenfirewall(true)
+	p.SetPrivateendport(5)
+	p.SetPrivateport(6)
+	p.SetProtocol(ProtocolAll)
+	p.SetPublicendport(8)
+	p.SetPublicport(9)
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetVmguestip("test-vmguestip")
+	checkURLValues(t, "createPortForwardingRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.CreatePortForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPortForwardingRule: %v", err)
+	}
+	if r.Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.Cidrlist to be %v, got %v", "test-cidrlist", r.Cidrlist)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createPortForwardingRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createPortForwardingRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createPortForwardingRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createPortForwardingRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createPortForwardingRule", params, `<createportforwardingruleresponse><cidrlist>test-cidrlist</cidrlist><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><jobid>test-jobid</jobid><networkid>test-networkid</networkid><privateendport>test-privateendport</privateendport><privateport>test-privateport</privateport><protocol>test-protocol</protocol><publicendport>test-publicendport</publicendport><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vmguestip>test-vmguestip</vmguestip></createportforwardingruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.CreatePortForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPortForwardingRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeletePortForwardingRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deletePortForwardingRule", params, `{"deleteportforwardingruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Firewall.NewDeletePortForwardingRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deletePortForwardingRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.DeletePortForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePortForwardingRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deletePortForwardingRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deletePortForwardingRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deletePortForwardingRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deletePortForwardingRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deletePortForwardingRule", params, `<deleteportforwardingruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteportforwardingruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.DeletePortForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePortForwardingRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPortForwardingRules(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"ipaddressid":   {"test-ipaddressid"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"networkid":     {"test-networkid"},
+		"page":          {"9"},
+		"pagesize":      {"10"},
+		"projectid":     {"test-projectid"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listPortForwardingRules", params, `{"listportforwardingrulesresponse":{"count":1,"portforwardingrule":[{"cidrlist":"test-cidrlist","id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","networkid":"test-networkid","privateendport":"test-privateendport","privateport":"test-privateport","protocol":"test-protocol","publicendport":"test-publicendport","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vmguestip":"test-vmguestip"}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewListPortForwardingRulesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetNetworkid("test-networkid")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listPortForwardingRules", p.URLValues(), params)
+
+	r, err := cs.Firewall.ListPortForwardingRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPortForwardingRules: %v", err)
+	}
+	if r.PortForwardingRules[0].Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.PortForwardingRules[0].Cidrlist to be %v, got %v", "test-cidrlist", r.PortForwardingRules[0].Cidrlist)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPortForwardingRules" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPortForwardingRules: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPortForwardingRules: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPortForwardingRules: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PortForwardingRule
+	count, err := cs.Firewall.StreamPortForwardingRules(context.Background(), p, func(v *PortForwardingRule) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPortForwardingRules: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PortForwardingRules) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PortForwardingRules)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPortForwardingRules", params, `<listportforwardingrulesresponse><count>1</count><portforwardingrule><cidrlist>test-cidrlist</cidrlist><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><networkid>test-networkid</networkid><privateendport>test-privateendport</privateendport><privateport>test-privateport</privateport><protocol>test-protocol</protocol><publicendport>test-publicendport</publicendport><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vmguestip>test-vmguestip</vmguestip></portforwardingrule></listportforwardingrulesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ListPortForwardingRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPortForwardingRules using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Firewall.StreamPortForwardingRules(context.Background(), p, func(v *PortForwardingRule) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPortForwardingRules using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PortForwardingRules) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PortForwardingRules)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdatePortForwardingRule(t *testing.T) {
+	params := url.Values{
+		"ipaddressid":      {"test-ipaddressid"},
+		"privateip":        {"test-privateip"},
+		"privateport":      {"test-privateport"},
+		"protocol":         {"all"},
+		"publicport":       {"test-publicport"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "updatePortForwardingRule", params, `{"updateportforwardingruleresponse":{"cidrlist":"test-cidrlist","id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","jobid":"test-jobid","networkid":"test-networkid","privateendport":"test-privateendport","privateport":"test-privateport","protocol":"test-protocol","publicendport":"test-publicendport","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vmguestip":"test-vmguestip"}}`)
+	defer done()
+
+	p := cs.Firewall.NewUpdatePortForwardingRuleParams("test-ipaddressid", "test-privateport", ProtocolAll, "test-publicport")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetPrivateip("test-privateip")
+	p.SetPrivateport("test-privateport")
+	p.SetProtocol(ProtocolAll)
+	p.SetPublicport("test-publicport")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "updatePortForwardingRule", p.URLValues(), params)
+
+	r, err := cs.Firewall.UpdatePortForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call updatePortForwardingRule: %v", err)
+	}
+	if r.Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.Cidrlist to be %v, got %v", "test-cidrlist", r.Cidrlist)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updatePortForwardingRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updatePortForwardingRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updatePortForwardingRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updatePortForwardingRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updatePortForwardingRule", params, `<updateportforwardingruleresponse><cidrlist>test-cidrlist</cidrlist><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><jobid>test-jobid</jobid><networkid>test-networkid</networkid><privateendport>test-privateendport</privateendport><privateport>test-privateport</privateport><protocol>test-protocol</protocol><publicendport>test-publicendport</publicendport><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vmguestip>test-vmguestip</vmguestip></updateportforwardingruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.UpdatePortForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call updatePortForwardingRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddSrxFirewall(t *testing.T) {
+	params := url.Values{
+		"networkdevicetype": {"test-networkdevicetype"},
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+	cs, done := newTestClient(t, "addSrxFirewall", params, `{"addsrxfirewallresponse":{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","jobid":"test-jobid","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Firewall.NewAddSrxFirewallParams("test-networkdevicetype", "test-password", "test-physicalnetworkid", "test-url", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addSrxFirewall", p.URLValues(), params)
+
+	r, err := cs.Firewall.AddSrxFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call addSrxFirewall: %v", err)
+	}
+	if r.Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.Fwdeviceid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addSrxFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addSrxFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addSrxFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addSrxFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addSrxFirewall", params, `<addsrxfirewallresponse><fwdevicecapacity>1</fwdevicecapacity><fwdeviceid>test-fwdeviceid</fwdeviceid><fwdevicename>test-fwdevicename</fwdevicename><fwdevicestate>test-fwdevicestate</fwdevicestate><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><numretries>test-numretries</numretries><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></addsrxfirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.AddSrxFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call addSrxFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestConfigureSrxFirewall(t *testing.T) {
+	params := url.Values{
+		"fwdevicecapacity": {"1"},
+		"fwdeviceid":       {"test-fwdeviceid"},
+	}
+	cs, done := newTestClient(t, "configureSrxFirewall", params, `{"configuresrxfirewallresponse":{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","jobid":"test-jobid","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Firewall.NewConfigureSrxFirewallParams("test-fwdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFwdevicecapacity(1)
+	p.SetFwdeviceid("test-fwdeviceid")
+	checkURLValues(t, "configureSrxFirewall", p.URLValues(), params)
+
+	r, err := cs.Firewall.ConfigureSrxFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureSrxFirewall: %v", err)
+	}
+	if r.Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.Fwdeviceid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "configureSrxFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute configureSrxFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of configureSrxFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of configureSrxFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "configureSrxFirewall", params, `<configuresrxfirewallresponse><fwdevicecapacity>1</fwdevicecapacity><fwdeviceid>test-fwdeviceid</fwdeviceid><fwdevicename>test-fwdevicename</fwdevicename><fwdevicestate>test-fwdevicestate</fwdevicestate><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><numretries>test-numretries</numretries><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></configuresrxfirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ConfigureSrxFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureSrxFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSrxFirewall(t *testing.T) {
+	params := url.Values{
+		"fwdeviceid": {"test-fwdeviceid"},
+	}
+	cs, done := newTestClient(t, "deleteSrxFirewall", params, `{"deletesrxfirewallresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Firewall.NewDeleteSrxFirewallParams("test-fwdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFwdeviceid("test-fwdeviceid")
+	checkURLValues(t, "deleteSrxFirewall", p.URLValues(), params)
+
+	r, err := cs.Firewall.DeleteSrxFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSrxFirewall: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSrxFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSrxFirewall: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSrxFirewall: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSrxFirewall: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSrxFirewall", params, `<deletesrxfirewallresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletesrxfirewallresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.DeleteSrxFirewall(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSrxFirewall using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSrxFirewalls(t *testing.T) {
+	params := url.Values{
+		"fwdeviceid":        {"test-fwdeviceid"},
+		"keyword":           {"test-keyword"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "listSrxFirewalls", params, `{"listsrxfirewallsresponse":{"count":1,"srxfirewall":[{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Firewall.NewListSrxFirewallsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFwdeviceid("test-fwdeviceid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "listSrxFirewalls", p.URLValues(), params)
+
+	r, err := cs.Firewall.ListSrxFirewalls(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSrxFirewalls: %v", err)
+	}
+	if r.SrxFirewalls[0].Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.SrxFirewalls[0].Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.SrxFirewalls[0].Fwdeviceid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSrxFirewalls" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSrxFirewalls: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSrxFirewalls: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSrxFirewalls: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SrxFirewall
+	count, err := cs.Firewall.StreamSrxFirewalls(context.Background(), p, func(v *SrxFirewall) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSrxFirewalls: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SrxFirewalls) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SrxFirewalls)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSrxFirewalls", params, `<listsrxfirewallsresponse><count>1</count><srxfirewall><fwdevicecapacity>1</fwdevicecapacity><fwdeviceid>test-fwdeviceid</fwdeviceid><fwdevicename>test-fwdevicename</fwdevicename><fwdevicestate>test-fwdevicestate</fwdevicestate><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><privateinterface>test-privateinterface</privateinterface><privatezone>test-privatezone</privatezone><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface><publiczone>test-publiczone</publiczone><timeout>test-timeout</timeout><usageinterface>test-usageinterface</usageinterface><username>test-username</username><zoneid>test-zoneid</zoneid></srxfirewall></listsrxfirewallsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Firewall.ListSrxFirewalls(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSrxFirewalls using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Firewall.StreamSrxFirewalls(context.Background(), p, func(v *SrxFirewall) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSrxFirewalls using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SrxFirewalls) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SrxFirewalls)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,109 +17,112 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type ListOsTypesParams struct {
-	p map[string]interface{}
+type GuestOSService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListOsTypesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
+func NewGuestOSService(cs *CloudStackClient) *GuestOSService {
+	return &GuestOSService{cs: cs}
 }
 
-func (p *ListOsTypesParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
+type ListOsCategoriesParams = cloudstackcommon.ListOsCategoriesParams
+type ListOsCategoriesResponse = cloudstackcommon.ListOsCategoriesResponse
+type OsCategory = cloudstackcommon.OsCategory
+
+// You should always use this function to get a new ListOsCategoriesParams instance,
+// as then you are sure you have configured all required params
+func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
+	p := &ListOsCategoriesParams{}
+	return p
 }
 
-func (p *ListOsTypesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListOsCategoriesParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ListOsTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListOsCategories(p)
+	if err != nil {
+		return "", err
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListOsTypesParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
-	p.p["oscategoryid"] = v
-	return
-}
 
-func (p *ListOsTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.OsCategories[0].Id, nil
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListOsTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var matches []*OsCategory
+	for _, v := range l.OsCategories {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
 	}
-	p.p["pagesize"] = v
-	return
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "OsCategory", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-// You should always use this function to get a new ListOsTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
-	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
-	return p
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error) {
+	id, err := s.GetOsCategoryID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	r, count, err := s.GetOsCategoryByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsTypeByID(id string) (*OsType, int, error) {
-	p := &ListOsTypesParams{}
-	p.p = make(map[string]interface{})
+func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
+	p := &ListOsCategoriesParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListOsTypes(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListOsCategories(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -134,165 +137,252 @@ func (s *GuestOSService) GetOsTypeByID(id string) (*OsType, int, error) {
 	}
 
 	if l.Count == 1 {
-		return l.OsTypes[0], l.Count, nil
+		return l.OsCategories[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for OsType UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for OsCategory UUID: %s!", id)
 }
 
-// Lists all supported OS types for this cloud.
-func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
-	resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
+// Lists all supported OS categories for this cloud.
+//
+// See also GetOsCategoryID, GetOsCategoryByName, GetOsCategoryByID.
+func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listOsCategories", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListOsTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListOsCategoriesResponse
+	if err := s.cs.unmarshal("listOsCategories", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListOsTypesResponse struct {
-	Count   int       `json:"count"`
-	OsTypes []*OsType `json:"ostype"`
-}
+// Lists the objects like ListOsCategories, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *GuestOSService) StreamOsCategories(ctx context.Context, p *ListOsCategoriesParams, fn func(*OsCategory) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type OsType struct {
-	Description  string `json:"description,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Oscategoryid string `json:"oscategoryid,omitempty"`
+	return s.cs.streamList(ctx, "listOsCategories", p.URLValues(), "oscategory", func(b json.RawMessage) error {
+		var v OsCategory
+		if err := s.cs.unmarshalElement("listOsCategories", "oscategory", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type ListOsCategoriesParams struct {
-	p map[string]interface{}
+type ListOsTypesParams struct {
+	Description  *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Oscategoryid *string `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *ListOsCategoriesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListOsTypesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Oscategoryid; v != nil {
+		u.Set("oscategoryid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
 	return u
 }
 
-func (p *ListOsCategoriesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *ListOsTypesParams) Command() string {
+	return "listOsTypes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListOsTypesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListOsTypesParams) ResponseType() interface{} {
+	return &ListOsTypesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListOsTypesParams) Validate() error {
+	v := &validator{api: "listOsTypes"}
+	return v.err()
+}
+
+func (p *ListOsTypesParams) SetDescription(v string) {
+	p.Description = &v
 	return
 }
 
-func (p *ListOsCategoriesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOsTypesParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Description
+}
+
+func (p *ListOsTypesParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *ListOsTypesParams) ResetDescription() {
+	p.Description = nil
+}
+
+func (p *ListOsTypesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListOsCategoriesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOsTypesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Id
+}
+
+func (p *ListOsTypesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListOsTypesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListOsTypesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListOsCategoriesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOsTypesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Keyword
+}
+
+func (p *ListOsTypesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListOsTypesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListOsTypesParams) SetOscategoryid(v string) {
+	p.Oscategoryid = &v
 	return
 }
 
-func (p *ListOsCategoriesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOsTypesParams) GetOscategoryid() string {
+	if p.Oscategoryid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Oscategoryid
 }
 
-// You should always use this function to get a new ListOsCategoriesParams instance,
-// as then you are sure you have configured all required params
-func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
-	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListOsTypesParams) HasOscategoryid() bool {
+	return p.Oscategoryid != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsCategoryID(name string) (string, error) {
-	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
+func (p *ListOsTypesParams) ResetOscategoryid() {
+	p.Oscategoryid = nil
+}
 
-	p.p["name"] = name
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListOsTypesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
 
-	l, err := s.ListOsCategories(p)
-	if err != nil {
-		return "", err
+func (p *ListOsTypesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
+	return *p.Page
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *ListOsTypesParams) HasPage() bool {
+	return p.Page != nil
+}
 
-	if l.Count == 1 {
-		return l.OsCategories[0].Id, nil
-	}
+func (p *ListOsTypesParams) ResetPage() {
+	p.Page = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.OsCategories {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListOsTypesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsCategoryByName(name string) (*OsCategory, int, error) {
-	id, err := s.GetOsCategoryID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *ListOsTypesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
+	return *p.Pagesize
+}
 
-	r, count, err := s.GetOsCategoryByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *ListOsTypesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListOsTypesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// You should always use this function to get a new ListOsTypesParams instance,
+// as then you are sure you have configured all required params
+func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
+	p := &ListOsTypesParams{}
+	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *GuestOSService) GetOsCategoryByID(id string) (*OsCategory, int, error) {
-	p := &ListOsCategoriesParams{}
-	p.p = make(map[string]interface{})
+func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
+	p := &ListOsTypesParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListOsCategories(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListOsTypes(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -307,31 +397,61 @@ func (s *GuestOSService) GetOsCategoryByID(id string) (*OsCategory, int, error)
 	}
 
 	if l.Count == 1 {
-		return l.OsCategories[0], l.Count, nil
+		return l.OsTypes[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for OsCategory UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for OsType UUID: %s!", id)
 }
 
-// Lists all supported OS categories for this cloud.
-func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
-	resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
+// Lists all supported OS types for this cloud.
+//
+// See also GetOsTypeByID.
+func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listOsTypes", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListOsCategoriesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListOsTypesResponse
+	if err := s.cs.unmarshal("listOsTypes", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListOsCategoriesResponse struct {
-	Count        int           `json:"count"`
-	OsCategories []*OsCategory `json:"oscategory"`
+// Lists the objects like ListOsTypes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *GuestOSService) StreamOsTypes(ctx context.Context, p *ListOsTypesParams, fn func(*OsType) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listOsTypes", p.URLValues(), "ostype", func(b json.RawMessage) error {
+		var v OsType
+		if err := s.cs.unmarshalElement("listOsTypes", "ostype", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListOsTypesResponse struct {
+	Count   int                        `json:"count" xml:"count"`
+	OsTypes []*OsType                  `json:"ostype" xml:"ostype"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type OsCategory struct {
-	Id   string `json:"id,omitempty"`
-	Name string `json:"name,omitempty"`
+type OsType struct {
+	Description  string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Oscategoryid string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
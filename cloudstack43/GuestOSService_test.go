@@ -0,0 +1,212 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListOsCategories(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+	}
+	cs, done := newTestClient(t, "listOsCategories", params, `{"listoscategoriesresponse":{"count":1,"oscategory":[{"id":"test-id","name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.GuestOS.NewListOsCategoriesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	checkURLValues(t, "listOsCategories", p.URLValues(), params)
+
+	r, err := cs.GuestOS.ListOsCategories(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsCategories: %v", err)
+	}
+	if r.OsCategories[0].Id != "test-id" {
+		t.Errorf("Expected r.OsCategories[0].Id to be %v, got %v", "test-id", r.OsCategories[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listOsCategories" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listOsCategories: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listOsCategories: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listOsCategories: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*OsCategory
+	count, err := cs.GuestOS.StreamOsCategories(context.Background(), p, func(v *OsCategory) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listOsCategories: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.OsCategories) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.OsCategories)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listOsCategories", params, `<listoscategoriesresponse><count>1</count><oscategory><id>test-id</id><name>test-name</name></oscategory></listoscategoriesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.ListOsCategories(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsCategories using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.GuestOS.StreamOsCategories(context.Background(), p, func(v *OsCategory) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listOsCategories using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.OsCategories) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.OsCategories)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListOsTypes(t *testing.T) {
+	params := url.Values{
+		"description":  {"test-description"},
+		"id":           {"test-id"},
+		"keyword":      {"test-keyword"},
+		"oscategoryid": {"test-oscategoryid"},
+		"page":         {"5"},
+		"pagesize":     {"6"},
+	}
+	cs, done := newTestClient(t, "listOsTypes", params, `{"listostypesresponse":{"count":1,"ostype":[{"description":"test-description","id":"test-id","oscategoryid":"test-oscategoryid"}]}}`)
+	defer done()
+
+	p := cs.GuestOS.NewListOsTypesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetOscategoryid("test-oscategoryid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listOsTypes", p.URLValues(), params)
+
+	r, err := cs.GuestOS.ListOsTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsTypes: %v", err)
+	}
+	if r.OsTypes[0].Description != "test-description" {
+		t.Errorf("Expected r.OsTypes[0].Description to be %v, got %v", "test-description", r.OsTypes[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listOsTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listOsTypes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listOsTypes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listOsTypes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*OsType
+	count, err := cs.GuestOS.StreamOsTypes(context.Background(), p, func(v *OsType) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listOsTypes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.OsTypes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.OsTypes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listOsTypes", params, `<listostypesresponse><count>1</count><ostype><description>test-description</description><id>test-id</id><oscategoryid>test-oscategoryid</oscategoryid></ostype></listostypesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.ListOsTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsTypes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.GuestOS.StreamOsTypes(context.Background(), p, func(v *OsType) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listOsTypes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.OsTypes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.OsTypes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
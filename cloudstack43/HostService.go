@@ -17,252 +17,507 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddHostParams struct {
-	p map[string]interface{}
+type HostService struct {
+	cs *CloudStackClient
+}
+
+func NewHostService(cs *CloudStackClient) *HostService {
+	return &HostService{cs: cs}
 }
 
-func (p *AddHostParams) toURLValues() url.Values {
+type AddBaremetalHostParams struct {
+	Allocationstate *string         `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clusterid       *string         `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Clustername     *string         `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Hosttags        []string        `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Hypervisor      *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Ipaddress       *string         `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Password        *string         `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid           *string         `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Url             *string         `json:"url,omitempty" yaml:"url,omitempty"`
+	Username        *string         `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid          *string         `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddBaremetalHostParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
 	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
+	if v := p.Clustername; v != nil {
+		u.Set("clustername", *v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Hosttags; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("hosttags", vv)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *AddHostParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *AddBaremetalHostParams) Command() string {
+	return "addBaremetalHost"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddBaremetalHostParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddBaremetalHostParams) ResponseType() interface{} {
+	return &AddBaremetalHostResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddBaremetalHostParams) Validate() error {
+	v := &validator{api: "addBaremetalHost"}
+	v.required("hypervisor", p.Hypervisor != nil)
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
 	}
-	p.p["allocationstate"] = v
+	v.required("password", p.Password != nil)
+	v.required("podid", p.Podid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
 	return
 }
 
-func (p *AddHostParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
 	}
-	p.p["clusterid"] = v
+	return *p.Allocationstate
+}
+
+func (p *AddBaremetalHostParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *AddBaremetalHostParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *AddBaremetalHostParams) SetClusterid(v string) {
+	p.Clusterid = &v
 	return
 }
 
-func (p *AddHostParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
 	}
-	p.p["clustername"] = v
+	return *p.Clusterid
+}
+
+func (p *AddBaremetalHostParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *AddBaremetalHostParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+func (p *AddBaremetalHostParams) SetClustername(v string) {
+	p.Clustername = &v
 	return
 }
 
-func (p *AddHostParams) SetHosttags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetClustername() string {
+	if p.Clustername == nil {
+		return ""
 	}
-	p.p["hosttags"] = v
+	return *p.Clustername
+}
+
+func (p *AddBaremetalHostParams) HasClustername() bool {
+	return p.Clustername != nil
+}
+
+func (p *AddBaremetalHostParams) ResetClustername() {
+	p.Clustername = nil
+}
+
+func (p *AddBaremetalHostParams) SetHosttags(v []string) {
+	p.Hosttags = v
+	return
+}
+
+func (p *AddBaremetalHostParams) GetHosttags() []string {
+	return p.Hosttags
+}
+
+func (p *AddBaremetalHostParams) HasHosttags() bool {
+	return p.Hosttags != nil
+}
+
+func (p *AddBaremetalHostParams) ResetHosttags() {
+	p.Hosttags = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware. This param is required.
+func (p *AddBaremetalHostParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
 	return
 }
 
-func (p *AddHostParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
 	}
-	p.p["hypervisor"] = v
+	return *p.Hypervisor
+}
+
+func (p *AddBaremetalHostParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *AddBaremetalHostParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *AddBaremetalHostParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
 	return
 }
 
-func (p *AddHostParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
 	}
-	p.p["password"] = v
+	return *p.Ipaddress
+}
+
+func (p *AddBaremetalHostParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *AddBaremetalHostParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddBaremetalHostParams) SetPassword(v string) {
+	p.Password = &v
 	return
 }
 
-func (p *AddHostParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	p.p["podid"] = v
+	return *p.Password
+}
+
+func (p *AddBaremetalHostParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddBaremetalHostParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'podid' param: the ID of the pod. This param is required.
+func (p *AddBaremetalHostParams) SetPodid(v string) {
+	p.Podid = &v
 	return
 }
 
-func (p *AddHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
 	}
-	p.p["url"] = v
+	return *p.Podid
+}
+
+func (p *AddBaremetalHostParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *AddBaremetalHostParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// This param is required.
+func (p *AddBaremetalHostParams) SetUrl(v string) {
+	p.Url = &v
 	return
 }
 
-func (p *AddHostParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
 	}
-	p.p["username"] = v
+	return *p.Url
+}
+
+func (p *AddBaremetalHostParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddBaremetalHostParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddBaremetalHostParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
-func (p *AddHostParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddBaremetalHostParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Username
+}
+
+func (p *AddBaremetalHostParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddBaremetalHostParams) ResetUsername() {
+	p.Username = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddBaremetalHostParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-// You should always use this function to get a new AddHostParams instance,
+func (p *AddBaremetalHostParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddBaremetalHostParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddBaremetalHostParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new AddBaremetalHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewAddHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddHostParams {
-	p := &AddHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hypervisor"] = hypervisor
-	p.p["password"] = password
-	p.p["podid"] = podid
-	p.p["url"] = url
-	p.p["username"] = username
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - hypervisor: the hypervisor, like KVM, XenServer or VMware
+//   - password: the password
+//   - podid: the ID of the pod
+//   - url
+//   - username: the name of the user
+//   - zoneid: the ID of the zone
+func (s *HostService) NewAddBaremetalHostParams(hypervisor HypervisorType, password string, podid string, url string, username string, zoneid string) *AddBaremetalHostParams {
+	p := &AddBaremetalHostParams{}
+	p.SetHypervisor(hypervisor)
+	p.SetPassword(password)
+	p.SetPodid(podid)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Adds a new host.
-func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
-	resp, err := s.cs.newRequest("addHost", p.toURLValues())
+// add a baremetal host
+func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addBaremetalHost", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddBaremetalHostResponse
+	if err := s.cs.unmarshal("addBaremetalHost", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddHostResponse struct {
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
-}
+type AddBaremetalHostResponse struct {
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListDedicatedHostsParams = cloudstackcommon.ListDedicatedHostsParams
+type ListDedicatedHostsResponse = cloudstackcommon.ListDedicatedHostsResponse
+type DedicatedHost = cloudstackcommon.DedicatedHost
 
-type ReconnectHostParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListDedicatedHostsParams instance,
+// as then you are sure you have configured all required params
+func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
+	p := &ListDedicatedHostsParams{}
+	return p
 }
 
-func (p *ReconnectHostParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Lists dedicated hosts.
+func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listDedicatedHosts", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	var r ListDedicatedHostsResponse
+	if err := s.cs.unmarshal("listDedicatedHosts", resp, &r); err != nil {
+		return nil, err
 	}
-	return u
+	return &r, nil
 }
 
-func (p *ReconnectHostParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListDedicatedHosts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *HostService) StreamDedicatedHosts(ctx context.Context, p *ListDedicatedHostsParams, fn func(*DedicatedHost) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["id"] = v
-	return
+
+	return s.cs.streamList(ctx, "listDedicatedHosts", p.URLValues(), "dedicatedhost", func(b json.RawMessage) error {
+		var v DedicatedHost
+		if err := s.cs.unmarshalElement("listDedicatedHosts", "dedicatedhost", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new ReconnectHostParams instance,
+type ReleaseDedicatedHostParams = cloudstackcommon.ReleaseDedicatedHostParams
+type ReleaseDedicatedHostResponse = cloudstackcommon.ReleaseDedicatedHostResponse
+
+// You should always use this function to get a new ReleaseDedicatedHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
-	p := &ReconnectHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - hostid: the ID of the host
+func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams {
+	p := &ReleaseDedicatedHostParams{}
+	p.SetHostid(hostid)
 	return p
 }
 
-// Reconnects a host.
-func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
-	resp, err := s.cs.newRequest("reconnectHost", p.toURLValues())
+// Release the dedication for host
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListDedicatedHosts.
+func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("releaseDedicatedHost", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReconnectHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ReleaseDedicatedHostResponse
+	if err := s.cs.unmarshal("releaseDedicatedHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -278,436 +533,426 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("releaseDedicatedHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ReconnectHostResponse struct {
-	JobID                   string `json:"jobid,omitempty"`
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
-}
-
-type UpdateHostParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateHostParams) toURLValues() url.Values {
+type AddHostParams struct {
+	Allocationstate *string         `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clusterid       *string         `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Clustername     *string         `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Hosttags        []string        `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Hypervisor      *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Password        *string         `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid           *string         `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Url             *string         `json:"url,omitempty" yaml:"url,omitempty"`
+	Username        *string         `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid          *string         `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddHostParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
+	if v := p.Clustername; v != nil {
+		u.Set("clustername", *v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Hosttags; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("hosttags", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
 	}
-	if v, found := p.p["oscategoryid"]; found {
-		u.Set("oscategoryid", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *UpdateHostParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddHostParams) Command() string {
+	return "addHost"
 }
 
-func (p *UpdateHostParams) SetHosttags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns true if the API command starts an async job
+func (p *AddHostParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddHostParams) ResponseType() interface{} {
+	return &AddHostResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddHostParams) Validate() error {
+	v := &validator{api: "addHost"}
+	v.required("hypervisor", p.Hypervisor != nil)
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
 	}
-	p.p["hosttags"] = v
+	v.required("password", p.Password != nil)
+	v.required("podid", p.Podid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *AddHostParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
 	return
 }
 
-func (p *UpdateHostParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddHostParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Allocationstate
+}
+
+func (p *AddHostParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *AddHostParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *AddHostParams) SetClusterid(v string) {
+	p.Clusterid = &v
 	return
 }
 
-func (p *UpdateHostParams) SetOscategoryid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddHostParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
 	}
-	p.p["oscategoryid"] = v
+	return *p.Clusterid
+}
+
+func (p *AddHostParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *AddHostParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+func (p *AddHostParams) SetClustername(v string) {
+	p.Clustername = &v
 	return
 }
 
-func (p *UpdateHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddHostParams) GetClustername() string {
+	if p.Clustername == nil {
+		return ""
 	}
-	p.p["url"] = v
-	return
+	return *p.Clustername
 }
 
-// You should always use this function to get a new UpdateHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
-	p := &UpdateHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *AddHostParams) HasClustername() bool {
+	return p.Clustername != nil
 }
 
-// Updates a host.
-func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
-	resp, err := s.cs.newRequest("updateHost", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddHostParams) ResetClustername() {
+	p.Clustername = nil
+}
 
-	var r UpdateHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *AddHostParams) SetHosttags(v []string) {
+	p.Hosttags = v
+	return
 }
 
-type UpdateHostResponse struct {
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
-}
-
-type DeleteHostParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteHostParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
-	}
-	if v, found := p.p["forcedestroylocalstorage"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forcedestroylocalstorage", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *AddHostParams) GetHosttags() []string {
+	return p.Hosttags
 }
 
-func (p *DeleteHostParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
+func (p *AddHostParams) HasHosttags() bool {
+	return p.Hosttags != nil
+}
+
+func (p *AddHostParams) ResetHosttags() {
+	p.Hosttags = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware. This param is required.
+func (p *AddHostParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
 	return
 }
 
-func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddHostParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
 	}
-	p.p["forcedestroylocalstorage"] = v
+	return *p.Hypervisor
+}
+
+func (p *AddHostParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *AddHostParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddHostParams) SetPassword(v string) {
+	p.Password = &v
 	return
 }
 
-func (p *DeleteHostParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddHostParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Password
 }
 
-// You should always use this function to get a new DeleteHostParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
-	p := &DeleteHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *AddHostParams) HasPassword() bool {
+	return p.Password != nil
 }
 
-// Deletes a host.
-func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
-	resp, err := s.cs.newRequest("deleteHost", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddHostParams) ResetPassword() {
+	p.Password = nil
+}
 
-	var r DeleteHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'podid' param: the ID of the pod. This param is required.
+func (p *AddHostParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *AddHostParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Podid
 }
 
-type DeleteHostResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+func (p *AddHostParams) HasPodid() bool {
+	return p.Podid != nil
 }
 
-type PrepareHostForMaintenanceParams struct {
-	p map[string]interface{}
+func (p *AddHostParams) ResetPodid() {
+	p.Podid = nil
 }
 
-func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// This param is required.
+func (p *AddHostParams) SetUrl(v string) {
+	p.Url = &v
+	return
 }
 
-func (p *PrepareHostForMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddHostParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Url
 }
 
-// You should always use this function to get a new PrepareHostForMaintenanceParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams {
-	p := &PrepareHostForMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *AddHostParams) HasUrl() bool {
+	return p.Url != nil
 }
 
-// Prepares a host for maintenance.
-func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("prepareHostForMaintenance", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddHostParams) ResetUrl() {
+	p.Url = nil
+}
 
-	var r PrepareHostForMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddHostParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddHostParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
 	}
+	return *p.Username
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *AddHostParams) HasUsername() bool {
+	return p.Username != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *AddHostParams) ResetUsername() {
+	p.Username = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddHostParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddHostParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Zoneid
 }
 
-type PrepareHostForMaintenanceResponse struct {
-	JobID                   string `json:"jobid,omitempty"`
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
+func (p *AddHostParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-type CancelHostMaintenanceParams struct {
-	p map[string]interface{}
+func (p *AddHostParams) ResetZoneid() {
+	p.Zoneid = nil
 }
 
-func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// You should always use this function to get a new AddHostParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hypervisor: the hypervisor, like KVM, XenServer or VMware
+//   - password: the password
+//   - podid: the ID of the pod
+//   - url
+//   - username: the name of the user
+//   - zoneid: the ID of the zone
+func (s *HostService) NewAddHostParams(hypervisor HypervisorType, password string, podid string, url string, username string, zoneid string) *AddHostParams {
+	p := &AddHostParams{}
+	p.SetHypervisor(hypervisor)
+	p.SetPassword(password)
+	p.SetPodid(podid)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Adds a new host.
+//
+// See also ListHosts, GetHostID, GetHostByName, GetHostByID.
+func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	resp, err := s.cs.newRequest("addHost", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *CancelHostMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r AddHostResponse
+	if err := s.cs.unmarshal("addHost", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new CancelHostMaintenanceParams instance,
+type AddHostResponse struct {
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicateHostParams = cloudstackcommon.DedicateHostParams
+type DedicateHostResponse = cloudstackcommon.DedicateHostResponse
+
+// You should always use this function to get a new DedicateHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams {
-	p := &CancelHostMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - domainid: the ID of the domain
+//   - hostid: the ID of the host
+func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams {
+	p := &DedicateHostParams{}
+	p.SetDomainid(domainid)
+	p.SetHostid(hostid)
 	return p
 }
 
-// Cancels host maintenance.
-func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelHostMaintenance", p.toURLValues())
+// Dedicates a host.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListHosts, GetHostID, GetHostByName, GetHostByID.
+func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("dedicateHost", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CancelHostMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DedicateHostResponse
+	if err := s.cs.unmarshal("dedicateHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -728,256 +973,474 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("dedicateHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CancelHostMaintenanceResponse struct {
-	JobID                   string `json:"jobid,omitempty"`
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
+type DeleteHostParams = cloudstackcommon.DeleteHostParams
+type DeleteHostResponse = cloudstackcommon.DeleteHostResponse
+
+// You should always use this function to get a new DeleteHostParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
+	p := &DeleteHostParams{}
+	p.SetId(id)
+	return p
 }
 
-type ListHostsParams struct {
-	p map[string]interface{}
+// Deletes a host.
+//
+// See also ListHosts, GetHostID, GetHostByName, GetHostByID.
+func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteHost", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteHostResponse
+	if err := s.cs.unmarshal("deleteHost", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-func (p *ListHostsParams) toURLValues() url.Values {
+type ListHostsParams struct {
+	Clusterid        *string         `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details          []string        `json:"details,omitempty" yaml:"details,omitempty"`
+	Hahost           *bool           `json:"hahost,omitempty" yaml:"hahost,omitempty"`
+	Hypervisor       *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id               *string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword          *string         `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name             *string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int            `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int            `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid            *string         `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Resourcestate    *string         `json:"resourcestate,omitempty" yaml:"resourcestate,omitempty"`
+	State            *string         `json:"state,omitempty" yaml:"state,omitempty"`
+	Type             *string         `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid *string         `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string         `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListHostsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
 	}
-	if v, found := p.p["details"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Details; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("details", vv)
 	}
-	if v, found := p.p["hahost"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Hahost; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("hahost", vv)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["resourcestate"]; found {
-		u.Set("resourcestate", v.(string))
+	if v := p.Resourcestate; v != nil {
+		u.Set("resourcestate", *v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListHostsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ListHostsParams) Command() string {
+	return "listHosts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListHostsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListHostsParams) ResponseType() interface{} {
+	return &ListHostsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListHostsParams) Validate() error {
+	v := &validator{api: "listHosts"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *ListHostsParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *ListHostsParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *ListHostsParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ListHostsParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+func (p *ListHostsParams) SetDetails(v []string) {
+	p.Details = v
+	return
+}
+
+func (p *ListHostsParams) GetDetails() []string {
+	return p.Details
+}
+
+func (p *ListHostsParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *ListHostsParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *ListHostsParams) SetHahost(v bool) {
+	p.Hahost = &v
+	return
+}
+
+func (p *ListHostsParams) GetHahost() bool {
+	if p.Hahost == nil {
+		return false
+	}
+	return *p.Hahost
+}
+
+func (p *ListHostsParams) HasHahost() bool {
+	return p.Hahost != nil
+}
+
+func (p *ListHostsParams) ResetHahost() {
+	p.Hahost = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListHostsParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *ListHostsParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *ListHostsParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *ListHostsParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *ListHostsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListHostsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListHostsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListHostsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListHostsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListHostsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListHostsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListHostsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListHostsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListHostsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListHostsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListHostsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListHostsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListHostsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["clusterid"] = v
-	return
+	return *p.Page
 }
 
-func (p *ListHostsParams) SetDetails(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+func (p *ListHostsParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *ListHostsParams) SetHahost(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hahost"] = v
-	return
+func (p *ListHostsParams) ResetPage() {
+	p.Page = nil
 }
 
-func (p *ListHostsParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListHostsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListHostsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListHostsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["id"] = v
-	return
+	return *p.Pagesize
 }
 
-func (p *ListHostsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListHostsParams) HasPagesize() bool {
+	return p.Pagesize != nil
 }
 
-func (p *ListHostsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *ListHostsParams) ResetPagesize() {
+	p.Pagesize = nil
 }
 
-func (p *ListHostsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListHostsParams) SetPodid(v string) {
+	p.Podid = &v
 	return
 }
 
-func (p *ListHostsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListHostsParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Podid
 }
 
-func (p *ListHostsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
+func (p *ListHostsParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListHostsParams) ResetPodid() {
+	p.Podid = nil
 }
 
 func (p *ListHostsParams) SetResourcestate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcestate"] = v
+	p.Resourcestate = &v
 	return
 }
 
-func (p *ListHostsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListHostsParams) GetResourcestate() string {
+	if p.Resourcestate == nil {
+		return ""
 	}
-	p.p["state"] = v
+	return *p.Resourcestate
+}
+
+func (p *ListHostsParams) HasResourcestate() bool {
+	return p.Resourcestate != nil
+}
+
+func (p *ListHostsParams) ResetResourcestate() {
+	p.Resourcestate = nil
+}
+
+func (p *ListHostsParams) SetState(v string) {
+	p.State = &v
 	return
 }
 
-func (p *ListHostsParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListHostsParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
-	p.p["hostType"] = v
+	return *p.State
+}
+
+func (p *ListHostsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListHostsParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListHostsParams) SetType(v string) {
+	p.Type = &v
 	return
 }
 
-func (p *ListHostsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListHostsParams) GetType() string {
+	if p.Type == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Type
+}
+
+func (p *ListHostsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListHostsParams) ResetType() {
+	p.Type = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListHostsParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *ListHostsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListHostsParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Virtualmachineid
+}
+
+func (p *ListHostsParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListHostsParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListHostsParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *ListHostsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListHostsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListHostsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListHostsParams instance,
 // as then you are sure you have configured all required params
 func (s *HostService) NewListHostsParams() *ListHostsParams {
 	p := &ListHostsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *HostService) GetHostID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListHostsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListHosts(p)
 	if err != nil {
@@ -992,24 +1455,37 @@ func (s *HostService) GetHostID(name string) (string, error) {
 		return l.Hosts[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Hosts {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Host
+	for _, v := range l.Hosts {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Host", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *HostService) GetHostByName(name string) (*Host, int, error) {
-	id, err := s.GetHostID(name)
+func (s *HostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int, error) {
+	id, err := s.GetHostID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetHostByID(id)
+	r, count, err := s.GetHostByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1017,11 +1493,16 @@ func (s *HostService) GetHostByName(name string) (*Host, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *HostService) GetHostByID(id string) (*Host, int, error) {
+func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, error) {
 	p := &ListHostsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListHosts(p)
 	if err != nil {
@@ -1044,394 +1525,187 @@ func (s *HostService) GetHostByID(id string) (*Host, int, error) {
 }
 
 // Lists hosts.
+//
+// See also GetHostID, GetHostByName, GetHostByID.
 func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
-	resp, err := s.cs.newRequest("listHosts", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listHosts", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHostsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listHosts", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListHostsResponse struct {
-	Count int     `json:"count"`
-	Hosts []*Host `json:"host"`
-}
-
-type Host struct {
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
-}
-
-type FindHostsForMigrationParams struct {
-	p map[string]interface{}
-}
-
-func (p *FindHostsForMigrationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
-}
-
-func (p *FindHostsForMigrationParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *FindHostsForMigrationParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListHosts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *HostService) StreamHosts(ctx context.Context, p *ListHostsParams, fn func(*Host) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *FindHostsForMigrationParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+	return s.cs.streamList(ctx, "listHosts", p.URLValues(), "host", func(b json.RawMessage) error {
+		var v Host
+		if err := s.cs.unmarshalElement("listHosts", "host", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
+type ListHostsResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Hosts []*Host                    `json:"host" xml:"host"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-// You should always use this function to get a new FindHostsForMigrationParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams {
-	p := &FindHostsForMigrationParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
-	return p
+type Host struct {
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-// Find hosts suitable for migrating a virtual machine.
-func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findHostsForMigration", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r FindHostsForMigrationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+type ReconnectHostParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-type FindHostsForMigrationResponse struct {
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	RequiresStorageMotion   bool   `json:"requiresStorageMotion,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
-}
-
-type AddSecondaryStorageParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddSecondaryStorageParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReconnectHostParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *AddSecondaryStorageParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
-
-func (p *AddSecondaryStorageParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
-
-// You should always use this function to get a new AddSecondaryStorageParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
-	p := &AddSecondaryStorageParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
-	return p
-}
-
-// Adds secondary storage.
-func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
-	resp, err := s.cs.newRequest("addSecondaryStorage", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r AddSecondaryStorageResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// Returns the name of the API command of these params
+func (p *ReconnectHostParams) Command() string {
+	return "reconnectHost"
 }
 
-type AddSecondaryStorageResponse struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
+// Returns true if the API command starts an async job
+func (p *ReconnectHostParams) IsAsync() bool {
+	return true
 }
 
-type UpdateHostPasswordParams struct {
-	p map[string]interface{}
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReconnectHostParams) ResponseType() interface{} {
+	return &ReconnectHostResponse{}
 }
 
-func (p *UpdateHostPasswordParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReconnectHostParams) Validate() error {
+	v := &validator{api: "reconnectHost"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *UpdateHostPasswordParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+// This param is required.
+func (p *ReconnectHostParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateHostPasswordParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ReconnectHostParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["hostid"] = v
-	return
+	return *p.Id
 }
 
-func (p *UpdateHostPasswordParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
+func (p *ReconnectHostParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *UpdateHostPasswordParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
+func (p *ReconnectHostParams) ResetId() {
+	p.Id = nil
 }
 
-// You should always use this function to get a new UpdateHostPasswordParams instance,
+// You should always use this function to get a new ReconnectHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams {
-	p := &UpdateHostPasswordParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["username"] = username
+//
+// The required params are:
+//
+//   - id
+func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
+	p := &ReconnectHostParams{}
+	p.SetId(id)
 	return p
 }
 
-// Update password of a host/pool on management server.
-func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
-	resp, err := s.cs.newRequest("updateHostPassword", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r UpdateHostPasswordResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type UpdateHostPasswordResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ReleaseHostReservationParams struct {
-	p map[string]interface{}
-}
-
-func (p *ReleaseHostReservationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *ReleaseHostReservationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Reconnects a host.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListHosts, GetHostID, GetHostByName, GetHostByID.
+func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new ReleaseHostReservationParams instance,
-// as then you are sure you have configured all required params
-func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
-	p := &ReleaseHostReservationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
 
-// Releases host reservation.
-func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
-	resp, err := s.cs.newRequest("releaseHostReservation", p.toURLValues())
+	resp, err := s.cs.newRequest("reconnectHost", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReleaseHostReservationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ReconnectHostResponse
+	if err := s.cs.unmarshal("reconnectHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1447,292 +1721,387 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("reconnectHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ReleaseHostReservationResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type ReconnectHostResponse struct {
+	JobID                   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type AddBaremetalHostParams struct {
-	p map[string]interface{}
+type UpdateHostParams struct {
+	Allocationstate *string  `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Hosttags        []string `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Id              *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Oscategoryid    *string  `json:"oscategoryid,omitempty" yaml:"oscategoryid,omitempty"`
+	Url             *string  `json:"url,omitempty" yaml:"url,omitempty"`
 }
 
-func (p *AddBaremetalHostParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateHostParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
 	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["clustername"]; found {
-		u.Set("clustername", v.(string))
-	}
-	if v, found := p.p["hosttags"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Hosttags; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("hosttags", vv)
 	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Oscategoryid; v != nil {
+		u.Set("oscategoryid", *v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
 	}
 	return u
 }
 
-func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateHostParams) Command() string {
+	return "updateHost"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateHostParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateHostParams) ResponseType() interface{} {
+	return &UpdateHostResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateHostParams) Validate() error {
+	v := &validator{api: "updateHost"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *UpdateHostParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *UpdateHostParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *UpdateHostParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *UpdateHostParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+func (p *UpdateHostParams) SetHosttags(v []string) {
+	p.Hosttags = v
+	return
+}
+
+func (p *UpdateHostParams) GetHosttags() []string {
+	return p.Hosttags
+}
+
+func (p *UpdateHostParams) HasHosttags() bool {
+	return p.Hosttags != nil
 }
 
-func (p *AddBaremetalHostParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
+func (p *UpdateHostParams) ResetHosttags() {
+	p.Hosttags = nil
 }
 
-func (p *AddBaremetalHostParams) SetClustername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clustername"] = v
+// This param is required.
+func (p *UpdateHostParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *AddBaremetalHostParams) SetHosttags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateHostParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["hosttags"] = v
-	return
+	return *p.Id
 }
 
-func (p *AddBaremetalHostParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
-	return
+func (p *UpdateHostParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *AddBaremetalHostParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
-	return
+func (p *UpdateHostParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *AddBaremetalHostParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
+func (p *UpdateHostParams) SetOscategoryid(v string) {
+	p.Oscategoryid = &v
 	return
 }
 
-func (p *AddBaremetalHostParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateHostParams) GetOscategoryid() string {
+	if p.Oscategoryid == nil {
+		return ""
 	}
-	p.p["podid"] = v
-	return
+	return *p.Oscategoryid
 }
 
-func (p *AddBaremetalHostParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+func (p *UpdateHostParams) HasOscategoryid() bool {
+	return p.Oscategoryid != nil
 }
 
-func (p *AddBaremetalHostParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
+func (p *UpdateHostParams) ResetOscategoryid() {
+	p.Oscategoryid = nil
+}
+
+func (p *UpdateHostParams) SetUrl(v string) {
+	p.Url = &v
 	return
 }
 
-func (p *AddBaremetalHostParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateHostParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Url
 }
 
-// You should always use this function to get a new AddBaremetalHostParams instance,
+func (p *UpdateHostParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *UpdateHostParams) ResetUrl() {
+	p.Url = nil
+}
+
+// You should always use this function to get a new UpdateHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewAddBaremetalHostParams(hypervisor string, password string, podid string, url string, username string, zoneid string) *AddBaremetalHostParams {
-	p := &AddBaremetalHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hypervisor"] = hypervisor
-	p.p["password"] = password
-	p.p["podid"] = podid
-	p.p["url"] = url
-	p.p["username"] = username
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - id
+func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
+	p := &UpdateHostParams{}
+	p.SetId(id)
 	return p
 }
 
-// add a baremetal host
-func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
-	resp, err := s.cs.newRequest("addBaremetalHost", p.toURLValues())
+// Updates a host.
+//
+// See also ListHosts, GetHostID, GetHostByName, GetHostByID.
+func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateHost", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddBaremetalHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateHostResponse
+	if err := s.cs.unmarshal("updateHost", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddBaremetalHostResponse struct {
-	Averageload             int64  `json:"averageload,omitempty"`
-	Capabilities            string `json:"capabilities,omitempty"`
-	Clusterid               string `json:"clusterid,omitempty"`
-	Clustername             string `json:"clustername,omitempty"`
-	Clustertype             string `json:"clustertype,omitempty"`
-	Cpuallocated            string `json:"cpuallocated,omitempty"`
-	Cpunumber               int    `json:"cpunumber,omitempty"`
-	Cpusockets              int    `json:"cpusockets,omitempty"`
-	Cpuspeed                int64  `json:"cpuspeed,omitempty"`
-	Cpuused                 string `json:"cpuused,omitempty"`
-	Cpuwithoverprovisioning string `json:"cpuwithoverprovisioning,omitempty"`
-	Created                 string `json:"created,omitempty"`
-	Disconnected            string `json:"disconnected,omitempty"`
-	Disksizeallocated       int64  `json:"disksizeallocated,omitempty"`
-	Disksizetotal           int64  `json:"disksizetotal,omitempty"`
-	Events                  string `json:"events,omitempty"`
-	Hahost                  bool   `json:"hahost,omitempty"`
-	Hasenoughcapacity       bool   `json:"hasenoughcapacity,omitempty"`
-	Hosttags                string `json:"hosttags,omitempty"`
-	Hypervisor              string `json:"hypervisor,omitempty"`
-	Hypervisorversion       string `json:"hypervisorversion,omitempty"`
-	Id                      string `json:"id,omitempty"`
-	Ipaddress               string `json:"ipaddress,omitempty"`
-	Islocalstorageactive    bool   `json:"islocalstorageactive,omitempty"`
-	Lastpinged              string `json:"lastpinged,omitempty"`
-	Managementserverid      int64  `json:"managementserverid,omitempty"`
-	Memoryallocated         int64  `json:"memoryallocated,omitempty"`
-	Memorytotal             int64  `json:"memorytotal,omitempty"`
-	Memoryused              int64  `json:"memoryused,omitempty"`
-	Name                    string `json:"name,omitempty"`
-	Networkkbsread          int64  `json:"networkkbsread,omitempty"`
-	Networkkbswrite         int64  `json:"networkkbswrite,omitempty"`
-	Oscategoryid            string `json:"oscategoryid,omitempty"`
-	Oscategoryname          string `json:"oscategoryname,omitempty"`
-	Podid                   string `json:"podid,omitempty"`
-	Podname                 string `json:"podname,omitempty"`
-	Removed                 string `json:"removed,omitempty"`
-	Resourcestate           string `json:"resourcestate,omitempty"`
-	State                   string `json:"state,omitempty"`
-	Suitableformigration    bool   `json:"suitableformigration,omitempty"`
-	Type                    string `json:"type,omitempty"`
-	Version                 string `json:"version,omitempty"`
-	Zoneid                  string `json:"zoneid,omitempty"`
-	Zonename                string `json:"zonename,omitempty"`
-}
-
-type DedicateHostParams struct {
-	p map[string]interface{}
-}
-
-func (p *DedicateHostParams) toURLValues() url.Values {
+type UpdateHostResponse struct {
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PrepareHostForMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *PrepareHostForMaintenanceParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *DedicateHostParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *PrepareHostForMaintenanceParams) Command() string {
+	return "prepareHostForMaintenance"
 }
 
-func (p *DedicateHostParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
+// Returns true if the API command starts an async job
+func (p *PrepareHostForMaintenanceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *PrepareHostForMaintenanceParams) ResponseType() interface{} {
+	return &PrepareHostForMaintenanceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *PrepareHostForMaintenanceParams) Validate() error {
+	v := &validator{api: "prepareHostForMaintenance"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *PrepareHostForMaintenanceParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *DedicateHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *PrepareHostForMaintenanceParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["hostid"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new DedicateHostParams instance,
+func (p *PrepareHostForMaintenanceParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *PrepareHostForMaintenanceParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new PrepareHostForMaintenanceParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams {
-	p := &DedicateHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["hostid"] = hostid
+//
+// The required params are:
+//
+//   - id
+func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams {
+	p := &PrepareHostForMaintenanceParams{}
+	p.SetId(id)
 	return p
 }
 
-// Dedicates a host.
-func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
-	resp, err := s.cs.newRequest("dedicateHost", p.toURLValues())
+// Prepares a host for maintenance.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("prepareHostForMaintenance", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DedicateHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r PrepareHostForMaintenanceResponse
+	if err := s.cs.unmarshal("prepareHostForMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1753,64 +2122,148 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("prepareHostForMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DedicateHostResponse struct {
-	JobID           string `json:"jobid,omitempty"`
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Hostid          string `json:"hostid,omitempty"`
-	Hostname        string `json:"hostname,omitempty"`
-	Id              string `json:"id,omitempty"`
+type PrepareHostForMaintenanceResponse struct {
+	JobID                   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ReleaseDedicatedHostParams struct {
-	p map[string]interface{}
+type CancelHostMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CancelHostMaintenanceParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+// Returns the name of the API command of these params
+func (p *CancelHostMaintenanceParams) Command() string {
+	return "cancelHostMaintenance"
+}
+
+// Returns true if the API command starts an async job
+func (p *CancelHostMaintenanceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CancelHostMaintenanceParams) ResponseType() interface{} {
+	return &CancelHostMaintenanceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CancelHostMaintenanceParams) Validate() error {
+	v := &validator{api: "cancelHostMaintenance"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CancelHostMaintenanceParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new ReleaseDedicatedHostParams instance,
+func (p *CancelHostMaintenanceParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *CancelHostMaintenanceParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *CancelHostMaintenanceParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new CancelHostMaintenanceParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams {
-	p := &ReleaseDedicatedHostParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
+//
+// The required params are:
+//
+//   - id
+func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams {
+	p := &CancelHostMaintenanceParams{}
+	p.SetId(id)
 	return p
 }
 
-// Release the dedication for host
-func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedHost", p.toURLValues())
+// Cancels host maintenance.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("cancelHostMaintenance", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ReleaseDedicatedHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CancelHostMaintenanceResponse
+	if err := s.cs.unmarshal("cancelHostMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1826,142 +2279,224 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("cancelHostMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ReleaseDedicatedHostResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type CancelHostMaintenanceResponse struct {
+	JobID                   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpusockets              int                        `json:"cpusockets,omitempty" xml:"cpusockets,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateHostPasswordParams = cloudstackcommon.UpdateHostPasswordParams
+type UpdateHostPasswordResponse = cloudstackcommon.UpdateHostPasswordResponse
 
-type ListDedicatedHostsParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new UpdateHostPasswordParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - password: the password
+//   - username: the name of the user
+func (s *HostService) NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams {
+	p := &UpdateHostPasswordParams{}
+	p.SetPassword(password)
+	p.SetUsername(username)
+	return p
 }
 
-func (p *ListDedicatedHostsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+// Update password of a host/pool on management server.
+func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	return u
-}
 
-func (p *ListDedicatedHostsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("updateHostPassword", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["account"] = v
-	return
-}
 
-func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r UpdateHostPasswordResponse
+	if err := s.cs.unmarshal("updateHostPassword", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["affinitygroupid"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListDedicatedHostsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+type ReleaseHostReservationParams = cloudstackcommon.ReleaseHostReservationParams
+type ReleaseHostReservationResponse = cloudstackcommon.ReleaseHostReservationResponse
+
+// You should always use this function to get a new ReleaseHostReservationParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams {
+	p := &ReleaseHostReservationParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListDedicatedHostsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Releases host reservation.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["hostid"] = v
-	return
-}
 
-func (p *ListDedicatedHostsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("releaseHostReservation", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListDedicatedHostsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ReleaseHostReservationResponse
+	if err := s.cs.unmarshal("releaseHostReservation", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListDedicatedHostsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("releaseHostReservation", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pagesize"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new ListDedicatedHostsParams instance,
+type FindHostsForMigrationParams = cloudstackcommon.FindHostsForMigrationParams
+type FindHostsForMigrationResponse = cloudstackcommon.FindHostsForMigrationResponse
+
+// You should always use this function to get a new FindHostsForMigrationParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
-	p := &ListDedicatedHostsParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - virtualmachineid: the ID of the virtual machine
+func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams {
+	p := &FindHostsForMigrationParams{}
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
-// Lists dedicated hosts.
-func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedHosts", p.toURLValues())
+// Find hosts suitable for migrating a virtual machine.
+func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("findHostsForMigration", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListDedicatedHostsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r FindHostsForMigrationResponse
+	if err := s.cs.unmarshal("findHostsForMigration", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListDedicatedHostsResponse struct {
-	Count          int              `json:"count"`
-	DedicatedHosts []*DedicatedHost `json:"dedicatedhost"`
+type AddSecondaryStorageParams = cloudstackcommon.AddSecondaryStorageParams
+type AddSecondaryStorageResponse = cloudstackcommon.AddSecondaryStorageResponse
+
+// You should always use this function to get a new AddSecondaryStorageParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - url
+func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams {
+	p := &AddSecondaryStorageParams{}
+	p.SetUrl(url)
+	return p
 }
 
-type DedicatedHost struct {
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Hostid          string `json:"hostid,omitempty"`
-	Hostname        string `json:"hostname,omitempty"`
-	Id              string `json:"id,omitempty"`
+// Adds secondary storage.
+func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addSecondaryStorage", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r AddSecondaryStorageResponse
+	if err := s.cs.unmarshal("addSecondaryStorage", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
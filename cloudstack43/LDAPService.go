@@ -23,216 +23,356 @@ import (
 	"strconv"
 )
 
+type LDAPService struct {
+	cs *CloudStackClient
+}
+
+func NewLDAPService(cs *CloudStackClient) *LDAPService {
+	return &LDAPService{cs: cs}
+}
+
 type LdapCreateAccountParams struct {
-	p map[string]interface{}
+	Account        *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Accountid      *string           `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Accounttype    *int              `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid       *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Networkdomain  *string           `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Timezone       *string           `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userid         *string           `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Username       *string           `json:"username,omitempty" yaml:"username,omitempty"`
 }
 
-func (p *LdapCreateAccountParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *LdapCreateAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Accountdetails; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("accountdetails[0].%s", k), vv)
 		}
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if v := p.Accountid; v != nil {
+		u.Set("accountid", *v)
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Accounttype; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("accounttype", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if v := p.Timezone; v != nil {
+		u.Set("timezone", *v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if v := p.Userid; v != nil {
+		u.Set("userid", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *LdapCreateAccountParams) Command() string {
+	return "ldapCreateAccount"
+}
+
+// Returns true if the API command starts an async job
+func (p *LdapCreateAccountParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *LdapCreateAccountParams) ResponseType() interface{} {
+	return &LdapCreateAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *LdapCreateAccountParams) Validate() error {
+	v := &validator{api: "ldapCreateAccount"}
+	v.required("accounttype", p.Accounttype != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *LdapCreateAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["accountdetails"] = v
+	return *p.Account
+}
+
+func (p *LdapCreateAccountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *LdapCreateAccountParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *LdapCreateAccountParams) SetAccountdetails(v map[string]string) {
+	p.Accountdetails = v
 	return
 }
 
+func (p *LdapCreateAccountParams) GetAccountdetails() map[string]string {
+	return p.Accountdetails
+}
+
+func (p *LdapCreateAccountParams) HasAccountdetails() bool {
+	return p.Accountdetails != nil
+}
+
+func (p *LdapCreateAccountParams) ResetAccountdetails() {
+	p.Accountdetails = nil
+}
+
+// Sets the 'accountid' param: the ID of the account.
 func (p *LdapCreateAccountParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+	p.Accountid = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetAccountid() string {
+	if p.Accountid == nil {
+		return ""
 	}
-	p.p["accounttype"] = v
+	return *p.Accountid
+}
+
+func (p *LdapCreateAccountParams) HasAccountid() bool {
+	return p.Accountid != nil
+}
+
+func (p *LdapCreateAccountParams) ResetAccountid() {
+	p.Accountid = nil
+}
+
+// Sets the 'accounttype' param: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin. This param is required.
+func (p *LdapCreateAccountParams) SetAccounttype(v int) {
+	p.Accounttype = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetAccounttype() int {
+	if p.Accounttype == nil {
+		return 0
 	}
-	p.p["domainid"] = v
+	return *p.Accounttype
+}
+
+func (p *LdapCreateAccountParams) HasAccounttype() bool {
+	return p.Accounttype != nil
+}
+
+func (p *LdapCreateAccountParams) ResetAccounttype() {
+	p.Accounttype = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *LdapCreateAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["networkdomain"] = v
+	return *p.Domainid
+}
+
+func (p *LdapCreateAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *LdapCreateAccountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *LdapCreateAccountParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
 	}
-	p.p["timezone"] = v
+	return *p.Networkdomain
+}
+
+func (p *LdapCreateAccountParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *LdapCreateAccountParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+// Sets the 'timezone' param: the time zone, like America/New_York.
+func (p *LdapCreateAccountParams) SetTimezone(v string) {
+	p.Timezone = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetTimezone() string {
+	if p.Timezone == nil {
+		return ""
 	}
-	p.p["userid"] = v
+	return *p.Timezone
+}
+
+func (p *LdapCreateAccountParams) HasTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *LdapCreateAccountParams) ResetTimezone() {
+	p.Timezone = nil
+}
+
+func (p *LdapCreateAccountParams) SetUserid(v string) {
+	p.Userid = &v
 	return
 }
 
-func (p *LdapCreateAccountParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LdapCreateAccountParams) GetUserid() string {
+	if p.Userid == nil {
+		return ""
 	}
-	p.p["username"] = v
+	return *p.Userid
+}
+
+func (p *LdapCreateAccountParams) HasUserid() bool {
+	return p.Userid != nil
+}
+
+func (p *LdapCreateAccountParams) ResetUserid() {
+	p.Userid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *LdapCreateAccountParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
+func (p *LdapCreateAccountParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *LdapCreateAccountParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *LdapCreateAccountParams) ResetUsername() {
+	p.Username = nil
+}
+
 // You should always use this function to get a new LdapCreateAccountParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - accounttype: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin
+//   - username: the name of the user
 func (s *LDAPService) NewLdapCreateAccountParams(accounttype int, username string) *LdapCreateAccountParams {
 	p := &LdapCreateAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["accounttype"] = accounttype
-	p.p["username"] = username
+	p.SetAccounttype(accounttype)
+	p.SetUsername(username)
 	return p
 }
 
 // Creates an account from an LDAP user
 func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("ldapCreateAccount", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("ldapCreateAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LdapCreateAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("ldapCreateAccount", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type LdapCreateAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
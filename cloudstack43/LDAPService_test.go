@@ -0,0 +1,100 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLdapCreateAccount(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+		"accountdetails[0].test-accountdetailskey": {"test-accountdetailsvalue"},
+		"accountid":     {"test-accountid"},
+		"accounttype":   {"4"},
+		"domainid":      {"test-domainid"},
+		"networkdomain": {"test-networkdomain"},
+		"timezone":      {"test-timezone"},
+		"userid":        {"test-userid"},
+		"username":      {"test-username"},
+	}
+	cs, done := newTestClient(t, "ldapCreateAccount", params, `{"ldapcreateaccountresponse":{"accountdetails":{"key":"value"},"accounttype":1,"cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"defaultzoneid":"test-defaultzoneid","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"iscleanuprequired":true,"isdefault":true,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networkdomain":"test-networkdomain","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"projectavailable":"test-projectavailable","projectlimit":"test-projectlimit","projecttotal":1,"receivedbytes":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"sentbytes":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}],"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}}`)
+	defer done()
+
+	p := cs.LDAP.NewLdapCreateAccountParams(4, "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAccountdetails(map[string]string{"test-accountdetailskey": "test-accountdetailsvalue"})
+	p.SetAccountid("test-accountid")
+	p.SetAccounttype(4)
+	p.SetDomainid("test-domainid")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetTimezone("test-timezone")
+	p.SetUserid("test-userid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "ldapCreateAccount", p.URLValues(), params)
+
+	r, err := cs.LDAP.LdapCreateAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call ldapCreateAccount: %v", err)
+	}
+	if r.Cpuavailable != "test-cpuavailable" {
+		t.Errorf("Expected r.Cpuavailable to be %v, got %v", "test-cpuavailable", r.Cpuavailable)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "ldapCreateAccount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute ldapCreateAccount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of ldapCreateAccount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of ldapCreateAccount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "ldapCreateAccount", params, `<ldapcreateaccountresponse><accountdetails><key>value</key></accountdetails><accounttype>1</accounttype><cpuavailable>test-cpuavailable</cpuavailable><cpulimit>test-cpulimit</cpulimit><cputotal>1</cputotal><defaultzoneid>test-defaultzoneid</defaultzoneid><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><ipavailable>test-ipavailable</ipavailable><iplimit>test-iplimit</iplimit><iptotal>1</iptotal><iscleanuprequired>true</iscleanuprequired><isdefault>true</isdefault><memoryavailable>test-memoryavailable</memoryavailable><memorylimit>test-memorylimit</memorylimit><memorytotal>1</memorytotal><name>test-name</name><networkavailable>test-networkavailable</networkavailable><networkdomain>test-networkdomain</networkdomain><networklimit>test-networklimit</networklimit><networktotal>1</networktotal><primarystorageavailable>test-primarystorageavailable</primarystorageavailable><primarystoragelimit>test-primarystoragelimit</primarystoragelimit><primarystoragetotal>1</primarystoragetotal><projectavailable>test-projectavailable</projectavailable><projectlimit>test-projectlimit</projectlimit><projecttotal>1</projecttotal><receivedbytes>1</receivedbytes><secondarystorageavailable>test-secondarystorageavailable</secondarystorageavailable><secondarystoragelimit>test-secondarystoragelimit</secondarystoragelimit><secondarystoragetotal>1</secondarystoragetotal><sentbytes>1</sentbytes><snapshotavailable>test-snapshotavailable</snapshotavailable><snapshotlimit>test-snapshotlimit</snapshotlimit><snapshottotal>1</snapshottotal><state>test-state</state><templateavailable>test-templateavailable</templateavailable><templatelimit>test-templatelimit</templatelimit><templatetotal>1</templatetotal><user><account>test-account</account><accountid>test-accountid</accountid><accounttype>1</accounttype><apikey>test-apikey</apikey><created>2014-01-02T15:04:05+0100</created><domain>test-domain</domain><domainid>test-domainid</domainid><email>test-email</email><firstname>test-firstname</firstname><id>test-id</id><iscallerchilddomain>true</iscallerchilddomain><isdefault>true</isdefault><lastname>test-lastname</lastname><secretkey>test-secretkey</secretkey><state>test-state</state><timezone>test-timezone</timezone><username>test-username</username></user><vmavailable>test-vmavailable</vmavailable><vmlimit>test-vmlimit</vmlimit><vmrunning>1</vmrunning><vmstopped>1</vmstopped><vmtotal>1</vmtotal><volumeavailable>test-volumeavailable</volumeavailable><volumelimit>test-volumelimit</volumelimit><volumetotal>1</volumetotal><vpcavailable>test-vpcavailable</vpcavailable><vpclimit>test-vpclimit</vpclimit><vpctotal>1</vpctotal></ldapcreateaccountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LDAP.LdapCreateAccount(p)
+	if err != nil {
+		t.Fatalf("Failed to call ldapCreateAccount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,371 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetApiLimit(t *testing.T) {
+	params := url.Values{}
+	cs, done := newTestClient(t, "getApiLimit", params, `{"getapilimitresponse":{"account":"test-account","accountid":"test-accountid","apiAllowed":1,"apiIssued":1,"expireAfter":1}}`)
+	defer done()
+
+	p := cs.Limit.NewGetApiLimitParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	checkURLValues(t, "getApiLimit", p.URLValues(), params)
+
+	r, err := cs.Limit.GetApiLimit(p)
+	if err != nil {
+		t.Fatalf("Failed to call getApiLimit: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "getApiLimit" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute getApiLimit: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of getApiLimit: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of getApiLimit: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "getApiLimit", params, `<getapilimitresponse><account>test-account</account><accountid>test-accountid</accountid><apiAllowed>1</apiAllowed><apiIssued>1</apiIssued><expireAfter>1</expireAfter></getapilimitresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Limit.GetApiLimit(p)
+	if err != nil {
+		t.Fatalf("Failed to call getApiLimit using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestResetApiLimit(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+	}
+	cs, done := newTestClient(t, "resetApiLimit", params, `{"resetapilimitresponse":{"account":"test-account","accountid":"test-accountid","apiAllowed":1,"apiIssued":1,"expireAfter":1}}`)
+	defer done()
+
+	p := cs.Limit.NewResetApiLimitParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	checkURLValues(t, "resetApiLimit", p.URLValues(), params)
+
+	r, err := cs.Limit.ResetApiLimit(p)
+	if err != nil {
+		t.Fatalf("Failed to call resetApiLimit: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "resetApiLimit" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute resetApiLimit: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of resetApiLimit: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of resetApiLimit: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "resetApiLimit", params, `<resetapilimitresponse><account>test-account</account><accountid>test-accountid</accountid><apiAllowed>1</apiAllowed><apiIssued>1</apiIssued><expireAfter>1</expireAfter></resetapilimitresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Limit.ResetApiLimit(p)
+	if err != nil {
+		t.Fatalf("Failed to call resetApiLimit using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateResourceCount(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"projectid":    {"test-projectid"},
+		"resourcetype": {"4"},
+	}
+	cs, done := newTestClient(t, "updateResourceCount", params, `{"updateresourcecountresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","project":"test-project","projectid":"test-projectid","resourcecount":1,"resourcetype":"test-resourcetype"}}`)
+	defer done()
+
+	p := cs.Limit.NewUpdateResourceCountParams("test-domainid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetProjectid("test-projectid")
+	p.SetResourcetype(4)
+	checkURLValues(t, "updateResourceCount", p.URLValues(), params)
+
+	r, err := cs.Limit.UpdateResourceCount(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateResourceCount: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateResourceCount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateResourceCount: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateResourceCount: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateResourceCount: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateResourceCount", params, `<updateresourcecountresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><project>test-project</project><projectid>test-projectid</projectid><resourcecount>1</resourcecount><resourcetype>test-resourcetype</resourcetype></updateresourcecountresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Limit.UpdateResourceCount(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateResourceCount using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListResourceLimits(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"id":           {"3"},
+		"isrecursive":  {"true"},
+		"keyword":      {"test-keyword"},
+		"listall":      {"true"},
+		"page":         {"7"},
+		"pagesize":     {"8"},
+		"projectid":    {"test-projectid"},
+		"resourcetype": {"10"},
+	}
+	cs, done := newTestClient(t, "listResourceLimits", params, `{"listresourcelimitsresponse":{"count":1,"resourcelimit":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","max":1,"project":"test-project","projectid":"test-projectid","resourcetype":"test-resourcetype"}]}}`)
+	defer done()
+
+	p := cs.Limit.NewListResourceLimitsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId(3)
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetResourcetype(10)
+	checkURLValues(t, "listResourceLimits", p.URLValues(), params)
+
+	r, err := cs.Limit.ListResourceLimits(p)
+	if err != nil {
+		t.Fatalf("Failed to call listResourceLimits: %v", err)
+	}
+	if r.ResourceLimits[0].Account != "test-account" {
+		t.Errorf("Expected r.ResourceLimits[0].Account to be %v, got %v", "test-account", r.ResourceLimits[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listResourceLimits" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listResourceLimits: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listResourceLimits: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listResourceLimits: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ResourceLimit
+	count, err := cs.Limit.StreamResourceLimits(context.Background(), p, func(v *ResourceLimit) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listResourceLimits: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ResourceLimits) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ResourceLimits)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listResourceLimits", params, `<listresourcelimitsresponse><count>1</count><resourcelimit><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><max>1</max><project>test-project</project><projectid>test-projectid</projectid><resourcetype>test-resourcetype</resourcetype></resourcelimit></listresourcelimitsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Limit.ListResourceLimits(p)
+	if err != nil {
+		t.Fatalf("Failed to call listResourceLimits using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Limit.StreamResourceLimits(context.Background(), p, func(v *ResourceLimit) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listResourceLimits using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ResourceLimits) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ResourceLimits)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateResourceLimit(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"max":          {"3"},
+		"projectid":    {"test-projectid"},
+		"resourcetype": {"5"},
+	}
+	cs, done := newTestClient(t, "updateResourceLimit", params, `{"updateresourcelimitresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","max":1,"project":"test-project","projectid":"test-projectid","resourcetype":"test-resourcetype"}}`)
+	defer done()
+
+	p := cs.Limit.NewUpdateResourceLimitParams(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetMax(3)
+	p.SetProjectid("test-projectid")
+	p.SetResourcetype(5)
+	checkURLValues(t, "updateResourceLimit", p.URLValues(), params)
+
+	r, err := cs.Limit.UpdateResourceLimit(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateResourceLimit: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateResourceLimit" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateResourceLimit: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateResourceLimit: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateResourceLimit: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateResourceLimit", params, `<updateresourcelimitresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><max>1</max><project>test-project</project><projectid>test-projectid</projectid><resourcetype>test-resourcetype</resourcetype></updateresourcelimitresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Limit.UpdateResourceLimit(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateResourceLimit using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -15,3 +15,12 @@
 //
 
 package cloudstack43
+
+// Deprecated: This service has no methods, as its commands are not part of the API details of this version.
+type LoginService struct {
+	cs *CloudStackClient
+}
+
+func NewLoginService(cs *CloudStackClient) *LoginService {
+	return &LoginService{cs: cs}
+}
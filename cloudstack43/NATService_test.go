@@ -0,0 +1,387 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateIpForwardingRule(t *testing.T) {
+	params := url.Values{
+		"cidrlist":     {"test-cidrlist1, test-cidrlist2"},
+		"endport":      {"2"},
+		"ipaddressid":  {"test-ipaddressid"},
+		"openfirewall": {"true"},
+		"protocol":     {"all"},
+		"startport":    {"6"},
+	}
+	cs, done := newTestClient(t, "createIpForwardingRule", params, `{"createipforwardingruleresponse":{"cidrlist":"test-cidrlist","id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","jobid":"test-jobid","networkid":"test-networkid","privateendport":"test-privateendport","privateport":"test-privateport","protocol":"test-protocol","publicendport":"test-publicendport","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vmguestip":"test-vmguestip"}}`)
+	defer done()
+
+	p := cs.NAT.NewCreateIpForwardingRuleParams("test-ipaddressid", ProtocolAll, 6)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetEndport(2)
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetOpenfirewall(true)
+	p.SetProtocol(ProtocolAll)
+	p.SetStartport(6)
+	checkURLValues(t, "createIpForwardingRule", p.URLValues(), params)
+
+	r, err := cs.NAT.CreateIpForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createIpForwardingRule: %v", err)
+	}
+	if r.Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.Cidrlist to be %v, got %v", "test-cidrlist", r.Cidrlist)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createIpForwardingRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createIpForwardingRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createIpForwardingRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createIpForwardingRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createIpForwardingRule", params, `<createipforwardingruleresponse><cidrlist>test-cidrlist</cidrlist><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><jobid>test-jobid</jobid><networkid>test-networkid</networkid><privateendport>test-privateendport</privateendport><privateport>test-privateport</privateport><protocol>test-protocol</protocol><publicendport>test-publicendport</publicendport><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vmguestip>test-vmguestip</vmguestip></createipforwardingruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NAT.CreateIpForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createIpForwardingRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteIpForwardingRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteIpForwardingRule", params, `{"deleteipforwardingruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.NAT.NewDeleteIpForwardingRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteIpForwardingRule", p.URLValues(), params)
+
+	r, err := cs.NAT.DeleteIpForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteIpForwardingRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteIpForwardingRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteIpForwardingRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteIpForwardingRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteIpForwardingRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteIpForwardingRule", params, `<deleteipforwardingruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteipforwardingruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NAT.DeleteIpForwardingRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteIpForwardingRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListIpForwardingRules(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"domainid":         {"test-domainid"},
+		"id":               {"test-id"},
+		"ipaddressid":      {"test-ipaddressid"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"page":             {"8"},
+		"pagesize":         {"9"},
+		"projectid":        {"test-projectid"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "listIpForwardingRules", params, `{"listipforwardingrulesresponse":{"count":1,"ipforwardingrule":[{"cidrlist":"test-cidrlist","id":"test-id","ipaddress":"test-ipaddress","ipaddressid":"test-ipaddressid","networkid":"test-networkid","privateendport":"test-privateendport","privateport":"test-privateport","protocol":"test-protocol","publicendport":"test-publicendport","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vmguestip":"test-vmguestip"}]}}`)
+	defer done()
+
+	p := cs.NAT.NewListIpForwardingRulesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "listIpForwardingRules", p.URLValues(), params)
+
+	r, err := cs.NAT.ListIpForwardingRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listIpForwardingRules: %v", err)
+	}
+	if r.IpForwardingRules[0].Cidrlist != "test-cidrlist" {
+		t.Errorf("Expected r.IpForwardingRules[0].Cidrlist to be %v, got %v", "test-cidrlist", r.IpForwardingRules[0].Cidrlist)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listIpForwardingRules" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listIpForwardingRules: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listIpForwardingRules: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listIpForwardingRules: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*IpForwardingRule
+	count, err := cs.NAT.StreamIpForwardingRules(context.Background(), p, func(v *IpForwardingRule) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listIpForwardingRules: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.IpForwardingRules) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.IpForwardingRules)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listIpForwardingRules", params, `<listipforwardingrulesresponse><count>1</count><ipforwardingrule><cidrlist>test-cidrlist</cidrlist><id>test-id</id><ipaddress>test-ipaddress</ipaddress><ipaddressid>test-ipaddressid</ipaddressid><networkid>test-networkid</networkid><privateendport>test-privateendport</privateendport><privateport>test-privateport</privateport><protocol>test-protocol</protocol><publicendport>test-publicendport</publicendport><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vmguestip>test-vmguestip</vmguestip></ipforwardingrule></listipforwardingrulesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NAT.ListIpForwardingRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listIpForwardingRules using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.NAT.StreamIpForwardingRules(context.Background(), p, func(v *IpForwardingRule) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listIpForwardingRules using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.IpForwardingRules) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.IpForwardingRules)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisableStaticNat(t *testing.T) {
+	params := url.Values{
+		"ipaddressid": {"test-ipaddressid"},
+	}
+	cs, done := newTestClient(t, "disableStaticNat", params, `{"disablestaticnatresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.NAT.NewDisableStaticNatParams("test-ipaddressid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetIpaddressid("test-ipaddressid")
+	checkURLValues(t, "disableStaticNat", p.URLValues(), params)
+
+	r, err := cs.NAT.DisableStaticNat(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableStaticNat: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disableStaticNat" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disableStaticNat: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disableStaticNat: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disableStaticNat: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disableStaticNat", params, `<disablestaticnatresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></disablestaticnatresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NAT.DisableStaticNat(p)
+	if err != nil {
+		t.Fatalf("Failed to call disableStaticNat using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestEnableStaticNat(t *testing.T) {
+	params := url.Values{
+		"ipaddressid":      {"test-ipaddressid"},
+		"networkid":        {"test-networkid"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"vmguestip":        {"test-vmguestip"},
+	}
+	cs, done := newTestClient(t, "enableStaticNat", params, `{"enablestaticnatresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.NAT.NewEnableStaticNatParams("test-ipaddressid", "test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetIpaddressid("test-ipaddressid")
+	p.SetNetworkid("test-networkid")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetVmguestip("test-vmguestip")
+	checkURLValues(t, "enableStaticNat", p.URLValues(), params)
+
+	r, err := cs.NAT.EnableStaticNat(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableStaticNat: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "enableStaticNat" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute enableStaticNat: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of enableStaticNat: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of enableStaticNat: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "enableStaticNat", params, `<enablestaticnatresponse><displaytext>test-displaytext</displaytext><success>true</success></enablestaticnatresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NAT.EnableStaticNat(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableStaticNat using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,657 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateNetworkACL(t *testing.T) {
+	params := url.Values{
+		"aclid":       {"test-aclid"},
+		"action":      {"test-action"},
+		"cidrlist":    {"test-cidrlist1, test-cidrlist2"},
+		"endport":     {"4"},
+		"icmpcode":    {"5"},
+		"icmptype":    {"6"},
+		"networkid":   {"test-networkid"},
+		"number":      {"8"},
+		"protocol":    {"all"},
+		"startport":   {"10"},
+		"traffictype": {"Ingress"},
+	}
+	cs, done := newTestClient(t, "createNetworkACL", params, `{"createnetworkaclresponse":{"aclid":"test-aclid","action":"test-action","cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","jobid":"test-jobid","number":1,"protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype"}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewCreateNetworkACLParams(ProtocolAll)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAclid("test-aclid")
+	p.SetAction("test-action")
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetEndport(4)
+	p.SetIcmpcode(5)
+	p.SetIcmptype(6)
+	p.SetNetworkid("test-networkid")
+	p.SetNumber(8)
+	p.SetProtocol(ProtocolAll)
+	p.SetStartport(10)
+	p.SetTraffictype(ACLTrafficTypeIngress)
+	checkURLValues(t, "createNetworkACL", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.CreateNetworkACL(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetworkACL: %v", err)
+	}
+	if r.Aclid != "test-aclid" {
+		t.Errorf("Expected r.Aclid to be %v, got %v", "test-aclid", r.Aclid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createNetworkACL" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createNetworkACL: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createNetworkACL: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createNetworkACL: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createNetworkACL", params, `<createnetworkaclresponse><aclid>test-aclid</aclid><action>test-action</action><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><jobid>test-jobid</jobid><number>1</number><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype></createnetworkaclresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.CreateNetworkACL(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetworkACL using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetworkACL(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteNetworkACL", params, `{"deletenetworkaclresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewDeleteNetworkACLParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteNetworkACL", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.DeleteNetworkACL(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkACL: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkACL" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetworkACL: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetworkACL: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetworkACL: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetworkACL", params, `<deletenetworkaclresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletenetworkaclresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.DeleteNetworkACL(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkACL using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworkACLs(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"aclid":         {"test-aclid"},
+		"action":        {"test-action"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"networkid":     {"test-networkid"},
+		"page":          {"10"},
+		"pagesize":      {"11"},
+		"projectid":     {"test-projectid"},
+		"protocol":      {"all"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+		"traffictype":   {"Ingress"},
+	}
+	cs, done := newTestClient(t, "listNetworkACLs", params, `{"listnetworkaclsresponse":{"count":1,"networkacl":[{"aclid":"test-aclid","action":"test-action","cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","number":1,"protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype"}]}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewListNetworkACLsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAclid("test-aclid")
+	p.SetAction("test-action")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetNetworkid("test-networkid")
+	p.SetPage(10)
+	p.SetPagesize(11)
+	p.SetProjectid("test-projectid")
+	p.SetProtocol(ProtocolAll)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetTraffictype(ACLTrafficTypeIngress)
+	checkURLValues(t, "listNetworkACLs", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.ListNetworkACLs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkACLs: %v", err)
+	}
+	if r.NetworkACLs[0].Aclid != "test-aclid" {
+		t.Errorf("Expected r.NetworkACLs[0].Aclid to be %v, got %v", "test-aclid", r.NetworkACLs[0].Aclid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkACLs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworkACLs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworkACLs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworkACLs: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetworkACL
+	count, err := cs.NetworkACL.StreamNetworkACLs(context.Background(), p, func(v *NetworkACL) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworkACLs: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetworkACLs) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetworkACLs)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworkACLs", params, `<listnetworkaclsresponse><count>1</count><networkacl><aclid>test-aclid</aclid><action>test-action</action><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><number>1</number><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype></networkacl></listnetworkaclsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.ListNetworkACLs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkACLs using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.NetworkACL.StreamNetworkACLs(context.Background(), p, func(v *NetworkACL) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworkACLs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetworkACLs) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetworkACLs)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateNetworkACLItem(t *testing.T) {
+	params := url.Values{
+		"action":      {"test-action"},
+		"cidrlist":    {"test-cidrlist1, test-cidrlist2"},
+		"endport":     {"3"},
+		"icmpcode":    {"4"},
+		"icmptype":    {"5"},
+		"id":          {"test-id"},
+		"number":      {"7"},
+		"protocol":    {"all"},
+		"startport":   {"9"},
+		"traffictype": {"Ingress"},
+	}
+	cs, done := newTestClient(t, "updateNetworkACLItem", params, `{"updatenetworkaclitemresponse":{"aclid":"test-aclid","action":"test-action","cidrlist":"test-cidrlist","endport":"test-endport","icmpcode":1,"icmptype":1,"id":"test-id","jobid":"test-jobid","number":1,"protocol":"test-protocol","startport":"test-startport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype"}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewUpdateNetworkACLItemParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAction("test-action")
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetEndport(3)
+	p.SetIcmpcode(4)
+	p.SetIcmptype(5)
+	p.SetId("test-id")
+	p.SetNumber(7)
+	p.SetProtocol(ProtocolAll)
+	p.SetStartport(9)
+	p.SetTraffictype(ACLTrafficTypeIngress)
+	checkURLValues(t, "updateNetworkACLItem", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.UpdateNetworkACLItem(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetworkACLItem: %v", err)
+	}
+	if r.Aclid != "test-aclid" {
+		t.Errorf("Expected r.Aclid to be %v, got %v", "test-aclid", r.Aclid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateNetworkACLItem" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateNetworkACLItem: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateNetworkACLItem: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateNetworkACLItem: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateNetworkACLItem", params, `<updatenetworkaclitemresponse><aclid>test-aclid</aclid><action>test-action</action><cidrlist>test-cidrlist</cidrlist><endport>test-endport</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><id>test-id</id><jobid>test-jobid</jobid><number>1</number><protocol>test-protocol</protocol><startport>test-startport</startport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype></updatenetworkaclitemresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.UpdateNetworkACLItem(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetworkACLItem using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateNetworkACLList(t *testing.T) {
+	params := url.Values{
+		"description": {"test-description"},
+		"name":        {"test-name"},
+		"vpcid":       {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "createNetworkACLList", params, `{"createnetworkacllistresponse":{"description":"test-description","id":"test-id","jobid":"test-jobid","name":"test-name","vpcid":"test-vpcid"}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewCreateNetworkACLListParams("test-name", "test-vpcid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetName("test-name")
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "createNetworkACLList", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.CreateNetworkACLList(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetworkACLList: %v", err)
+	}
+	if r.Description != "test-description" {
+		t.Errorf("Expected r.Description to be %v, got %v", "test-description", r.Description)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createNetworkACLList" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createNetworkACLList: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createNetworkACLList: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createNetworkACLList: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createNetworkACLList", params, `<createnetworkacllistresponse><description>test-description</description><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><vpcid>test-vpcid</vpcid></createnetworkacllistresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.CreateNetworkACLList(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetworkACLList using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetworkACLList(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteNetworkACLList", params, `{"deletenetworkacllistresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewDeleteNetworkACLListParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteNetworkACLList", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.DeleteNetworkACLList(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkACLList: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkACLList" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetworkACLList: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetworkACLList: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetworkACLList: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetworkACLList", params, `<deletenetworkacllistresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletenetworkacllistresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.DeleteNetworkACLList(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkACLList using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworkACLLists(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"networkid":   {"test-networkid"},
+		"page":        {"9"},
+		"pagesize":    {"10"},
+		"projectid":   {"test-projectid"},
+		"vpcid":       {"test-vpcid"},
+	}
+	cs, done := newTestClient(t, "listNetworkACLLists", params, `{"listnetworkacllistsresponse":{"count":1,"networkacllist":[{"description":"test-description","id":"test-id","name":"test-name","vpcid":"test-vpcid"}]}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewListNetworkACLListsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetVpcid("test-vpcid")
+	checkURLValues(t, "listNetworkACLLists", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.ListNetworkACLLists(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkACLLists: %v", err)
+	}
+	if r.NetworkACLLists[0].Description != "test-description" {
+		t.Errorf("Expected r.NetworkACLLists[0].Description to be %v, got %v", "test-description", r.NetworkACLLists[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkACLLists" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworkACLLists: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworkACLLists: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworkACLLists: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetworkACLList
+	count, err := cs.NetworkACL.StreamNetworkACLLists(context.Background(), p, func(v *NetworkACLList) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworkACLLists: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetworkACLLists) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetworkACLLists)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworkACLLists", params, `<listnetworkacllistsresponse><count>1</count><networkacllist><description>test-description</description><id>test-id</id><name>test-name</name><vpcid>test-vpcid</vpcid></networkacllist></listnetworkacllistsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.ListNetworkACLLists(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkACLLists using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.NetworkACL.StreamNetworkACLLists(context.Background(), p, func(v *NetworkACLList) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworkACLLists using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetworkACLLists) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetworkACLLists)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReplaceNetworkACLList(t *testing.T) {
+	params := url.Values{
+		"aclid":     {"test-aclid"},
+		"gatewayid": {"test-gatewayid"},
+		"networkid": {"test-networkid"},
+	}
+	cs, done := newTestClient(t, "replaceNetworkACLList", params, `{"replacenetworkacllistresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.NetworkACL.NewReplaceNetworkACLListParams("test-aclid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAclid("test-aclid")
+	p.SetGatewayid("test-gatewayid")
+	p.SetNetworkid("test-networkid")
+	checkURLValues(t, "replaceNetworkACLList", p.URLValues(), params)
+
+	r, err := cs.NetworkACL.ReplaceNetworkACLList(p)
+	if err != nil {
+		t.Fatalf("Failed to call replaceNetworkACLList: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "replaceNetworkACLList" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute replaceNetworkACLList: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of replaceNetworkACLList: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of replaceNetworkACLList: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "replaceNetworkACLList", params, `<replacenetworkacllistresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></replacenetworkacllistresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkACL.ReplaceNetworkACLList(p)
+	if err != nil {
+		t.Fatalf("Failed to call replaceNetworkACLList using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
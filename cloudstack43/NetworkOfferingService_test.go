@@ -0,0 +1,375 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateNetworkOffering(t *testing.T) {
+	params := url.Values{
+		"availability":                     {"test-availability"},
+		"conservemode":                     {"true"},
+		"details[0].test-detailskey":       {"test-detailsvalue"},
+		"displaytext":                      {"test-displaytext"},
+		"egressdefaultpolicy":              {"true"},
+		"guestiptype":                      {"test-guestiptype"},
+		"ispersistent":                     {"true"},
+		"keepaliveenabled":                 {"true"},
+		"maxconnections":                   {"9"},
+		"name":                             {"test-name"},
+		"networkrate":                      {"11"},
+		"servicecapabilitylist[0].service": {"test-service"},
+		"servicecapabilitylist[0].capabilitytype":  {"test-capabilitytype"},
+		"servicecapabilitylist[0].capabilityvalue": {"test-capabilityvalue"},
+		"serviceofferingid":                        {"test-serviceofferingid"},
+		"serviceproviderlist[0].service":           {"test-service"},
+		"serviceproviderlist[0].provider":          {"test-provider"},
+		"specifyipranges":                          {"true"},
+		"specifyvlan":                              {"true"},
+		"supportedservices":                        {"test-supportedservices1, test-supportedservices2"},
+		"tags":                                     {"test-tags"},
+		"traffictype":                              {"Control"},
+	}
+	cs, done := newTestClient(t, "createNetworkOffering", params, `{"createnetworkofferingresponse":{"availability":"test-availability","conservemode":true,"created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"displaytext":"test-displaytext","egressdefaultpolicy":true,"forvpc":true,"guestiptype":"test-guestiptype","id":"test-id","isdefault":true,"ispersistent":true,"maxconnections":1,"name":"test-name","networkrate":1,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"serviceofferingid":"test-serviceofferingid","specifyipranges":true,"specifyvlan":true,"state":"test-state","tags":"test-tags","traffictype":"test-traffictype"}}`)
+	defer done()
+
+	p := cs.NetworkOffering.NewCreateNetworkOfferingParams("test-displaytext", "test-guestiptype", "test-name", []string{"test-supportedservices1", "test-supportedservices2"}, TrafficTypeControl)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAvailability("test-availability")
+	p.SetConservemode(true)
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetDisplaytext("test-displaytext")
+	p.SetEgressdefaultpolicy(true)
+	p.SetGuestiptype("test-guestiptype")
+	p.SetIspersistent(true)
+	p.SetKeepaliveenabled(true)
+	p.SetMaxconnections(9)
+	p.SetName("test-name")
+	p.SetNetworkrate(11)
+	p.SetServicecapabilitylist([]ServiceCapability{{Service: "test-service", Capabilitytype: "test-capabilitytype", Capabilityvalue: "test-capabilityvalue"}})
+	p.SetServiceofferingid("test-serviceofferingid")
+	p.SetServiceproviderlist([]ServiceProvider{{Service: "test-service", Provider: "test-provider"}})
+	p.SetSpecifyipranges(true)
+	p.SetSpecifyvlan(true)
+	p.SetSupportedservices([]string{"test-supportedservices1", "test-supportedservices2"})
+	p.SetTags("test-tags")
+	p.SetTraffictype(TrafficTypeControl)
+	checkURLValues(t, "createNetworkOffering", p.URLValues(), params)
+
+	r, err := cs.NetworkOffering.CreateNetworkOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetworkOffering: %v", err)
+	}
+	if r.Availability != "test-availability" {
+		t.Errorf("Expected r.Availability to be %v, got %v", "test-availability", r.Availability)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createNetworkOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createNetworkOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createNetworkOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createNetworkOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createNetworkOffering", params, `<createnetworkofferingresponse><availability>test-availability</availability><conservemode>true</conservemode><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><displaytext>test-displaytext</displaytext><egressdefaultpolicy>true</egressdefaultpolicy><forvpc>true</forvpc><guestiptype>test-guestiptype</guestiptype><id>test-id</id><isdefault>true</isdefault><ispersistent>true</ispersistent><maxconnections>1</maxconnections><name>test-name</name><networkrate>1</networkrate><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><serviceofferingid>test-serviceofferingid</serviceofferingid><specifyipranges>true</specifyipranges><specifyvlan>true</specifyvlan><state>test-state</state><tags>test-tags</tags><traffictype>test-traffictype</traffictype></createnetworkofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkOffering.CreateNetworkOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetworkOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetworkOffering(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteNetworkOffering", params, `{"deletenetworkofferingresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.NetworkOffering.NewDeleteNetworkOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteNetworkOffering", p.URLValues(), params)
+
+	r, err := cs.NetworkOffering.DeleteNetworkOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkOffering: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetworkOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetworkOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetworkOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetworkOffering", params, `<deletenetworkofferingresponse><displaytext>test-displaytext</displaytext><success>true</success></deletenetworkofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkOffering.DeleteNetworkOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworkOfferings(t *testing.T) {
+	params := url.Values{
+		"availability":       {"test-availability"},
+		"displaytext":        {"test-displaytext"},
+		"forvpc":             {"true"},
+		"guestiptype":        {"test-guestiptype"},
+		"id":                 {"test-id"},
+		"isdefault":          {"true"},
+		"istagged":           {"true"},
+		"keyword":            {"test-keyword"},
+		"name":               {"test-name"},
+		"networkid":          {"test-networkid"},
+		"page":               {"11"},
+		"pagesize":           {"12"},
+		"sourcenatsupported": {"true"},
+		"specifyipranges":    {"true"},
+		"specifyvlan":        {"true"},
+		"state":              {"test-state"},
+		"supportedservices":  {"test-supportedservices1, test-supportedservices2"},
+		"tags":               {"test-tags"},
+		"traffictype":        {"Control"},
+		"zoneid":             {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listNetworkOfferings", params, `{"listnetworkofferingsresponse":{"count":1,"networkoffering":[{"availability":"test-availability","conservemode":true,"created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"displaytext":"test-displaytext","egressdefaultpolicy":true,"forvpc":true,"guestiptype":"test-guestiptype","id":"test-id","isdefault":true,"ispersistent":true,"maxconnections":1,"name":"test-name","networkrate":1,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"serviceofferingid":"test-serviceofferingid","specifyipranges":true,"specifyvlan":true,"state":"test-state","tags":"test-tags","traffictype":"test-traffictype"}]}}`)
+	defer done()
+
+	p := cs.NetworkOffering.NewListNetworkOfferingsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAvailability("test-availability")
+	p.SetDisplaytext("test-displaytext")
+	p.SetForvpc(true)
+	p.SetGuestiptype("test-guestiptype")
+	p.SetId("test-id")
+	p.SetIsdefault(true)
+	p.SetIstagged(true)
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetSourcenatsupported(true)
+	p.SetSpecifyipranges(true)
+	p.SetSpecifyvlan(true)
+	p.SetState("test-state")
+	p.SetSupportedservices([]string{"test-supportedservices1", "test-supportedservices2"})
+	p.SetTags("test-tags")
+	p.SetTraffictype(TrafficTypeControl)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listNetworkOfferings", p.URLValues(), params)
+
+	r, err := cs.NetworkOffering.ListNetworkOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkOfferings: %v", err)
+	}
+	if r.NetworkOfferings[0].Availability != "test-availability" {
+		t.Errorf("Expected r.NetworkOfferings[0].Availability to be %v, got %v", "test-availability", r.NetworkOfferings[0].Availability)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkOfferings" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworkOfferings: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworkOfferings: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworkOfferings: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetworkOffering
+	count, err := cs.NetworkOffering.StreamNetworkOfferings(context.Background(), p, func(v *NetworkOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworkOfferings: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetworkOfferings) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetworkOfferings)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworkOfferings", params, `<listnetworkofferingsresponse><count>1</count><networkoffering><availability>test-availability</availability><conservemode>true</conservemode><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><displaytext>test-displaytext</displaytext><egressdefaultpolicy>true</egressdefaultpolicy><forvpc>true</forvpc><guestiptype>test-guestiptype</guestiptype><id>test-id</id><isdefault>true</isdefault><ispersistent>true</ispersistent><maxconnections>1</maxconnections><name>test-name</name><networkrate>1</networkrate><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><serviceofferingid>test-serviceofferingid</serviceofferingid><specifyipranges>true</specifyipranges><specifyvlan>true</specifyvlan><state>test-state</state><tags>test-tags</tags><traffictype>test-traffictype</traffictype></networkoffering></listnetworkofferingsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkOffering.ListNetworkOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkOfferings using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.NetworkOffering.StreamNetworkOfferings(context.Background(), p, func(v *NetworkOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworkOfferings using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetworkOfferings) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetworkOfferings)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateNetworkOffering(t *testing.T) {
+	params := url.Values{
+		"availability":     {"test-availability"},
+		"displaytext":      {"test-displaytext"},
+		"id":               {"test-id"},
+		"keepaliveenabled": {"true"},
+		"maxconnections":   {"5"},
+		"name":             {"test-name"},
+		"sortkey":          {"7"},
+		"state":            {"test-state"},
+	}
+	cs, done := newTestClient(t, "updateNetworkOffering", params, `{"updatenetworkofferingresponse":{"availability":"test-availability","conservemode":true,"created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"displaytext":"test-displaytext","egressdefaultpolicy":true,"forvpc":true,"guestiptype":"test-guestiptype","id":"test-id","isdefault":true,"ispersistent":true,"maxconnections":1,"name":"test-name","networkrate":1,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"serviceofferingid":"test-serviceofferingid","specifyipranges":true,"specifyvlan":true,"state":"test-state","tags":"test-tags","traffictype":"test-traffictype"}}`)
+	defer done()
+
+	p := cs.NetworkOffering.NewUpdateNetworkOfferingParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAvailability("test-availability")
+	p.SetDisplaytext("test-displaytext")
+	p.SetId("test-id")
+	p.SetKeepaliveenabled(true)
+	p.SetMaxconnections(5)
+	p.SetName("test-name")
+	p.SetSortkey(7)
+	p.SetState("test-state")
+	checkURLValues(t, "updateNetworkOffering", p.URLValues(), params)
+
+	r, err := cs.NetworkOffering.UpdateNetworkOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetworkOffering: %v", err)
+	}
+	if r.Availability != "test-availability" {
+		t.Errorf("Expected r.Availability to be %v, got %v", "test-availability", r.Availability)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateNetworkOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateNetworkOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateNetworkOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateNetworkOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateNetworkOffering", params, `<updatenetworkofferingresponse><availability>test-availability</availability><conservemode>true</conservemode><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><displaytext>test-displaytext</displaytext><egressdefaultpolicy>true</egressdefaultpolicy><forvpc>true</forvpc><guestiptype>test-guestiptype</guestiptype><id>test-id</id><isdefault>true</isdefault><ispersistent>true</ispersistent><maxconnections>1</maxconnections><name>test-name</name><networkrate>1</networkrate><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><serviceofferingid>test-serviceofferingid</serviceofferingid><specifyipranges>true</specifyipranges><specifyvlan>true</specifyvlan><state>test-state</state><tags>test-tags</tags><traffictype>test-traffictype</traffictype></updatenetworkofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkOffering.UpdateNetworkOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetworkOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,2091 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListF5LoadBalancerNetworks(t *testing.T) {
+	params := url.Values{
+		"keyword":    {"test-keyword"},
+		"lbdeviceid": {"test-lbdeviceid"},
+		"page":       {"3"},
+		"pagesize":   {"4"},
+	}
+	cs, done := newTestClient(t, "listF5LoadBalancerNetworks", params, `{"listf5loadbalancernetworksresponse":{"count":1,"f5loadbalancernetwork":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListF5LoadBalancerNetworksParams("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listF5LoadBalancerNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListF5LoadBalancerNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listF5LoadBalancerNetworks: %v", err)
+	}
+	if r.F5LoadBalancerNetworks[0].Account != "test-account" {
+		t.Errorf("Expected r.F5LoadBalancerNetworks[0].Account to be %v, got %v", "test-account", r.F5LoadBalancerNetworks[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listF5LoadBalancerNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listF5LoadBalancerNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listF5LoadBalancerNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listF5LoadBalancerNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*F5LoadBalancerNetwork
+	count, err := cs.Network.StreamF5LoadBalancerNetworks(context.Background(), p, func(v *F5LoadBalancerNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listF5LoadBalancerNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.F5LoadBalancerNetworks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.F5LoadBalancerNetworks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listF5LoadBalancerNetworks", params, `<listf5loadbalancernetworksresponse><count>1</count><f5loadbalancernetwork><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></f5loadbalancernetwork></listf5loadbalancernetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListF5LoadBalancerNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listF5LoadBalancerNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamF5LoadBalancerNetworks(context.Background(), p, func(v *F5LoadBalancerNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listF5LoadBalancerNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.F5LoadBalancerNetworks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.F5LoadBalancerNetworks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetscalerLoadBalancerNetworks(t *testing.T) {
+	params := url.Values{
+		"keyword":    {"test-keyword"},
+		"lbdeviceid": {"test-lbdeviceid"},
+		"page":       {"3"},
+		"pagesize":   {"4"},
+	}
+	cs, done := newTestClient(t, "listNetscalerLoadBalancerNetworks", params, `{"listnetscalerloadbalancernetworksresponse":{"count":1,"netscalerloadbalancernetwork":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListNetscalerLoadBalancerNetworksParams("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listNetscalerLoadBalancerNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListNetscalerLoadBalancerNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetscalerLoadBalancerNetworks: %v", err)
+	}
+	if r.NetscalerLoadBalancerNetworks[0].Account != "test-account" {
+		t.Errorf("Expected r.NetscalerLoadBalancerNetworks[0].Account to be %v, got %v", "test-account", r.NetscalerLoadBalancerNetworks[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetscalerLoadBalancerNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetscalerLoadBalancerNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetscalerLoadBalancerNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetscalerLoadBalancerNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetscalerLoadBalancerNetwork
+	count, err := cs.Network.StreamNetscalerLoadBalancerNetworks(context.Background(), p, func(v *NetscalerLoadBalancerNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetscalerLoadBalancerNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetscalerLoadBalancerNetworks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetscalerLoadBalancerNetworks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetscalerLoadBalancerNetworks", params, `<listnetscalerloadbalancernetworksresponse><count>1</count><netscalerloadbalancernetwork><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></netscalerloadbalancernetwork></listnetscalerloadbalancernetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListNetscalerLoadBalancerNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetscalerLoadBalancerNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamNetscalerLoadBalancerNetworks(context.Background(), p, func(v *NetscalerLoadBalancerNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetscalerLoadBalancerNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetscalerLoadBalancerNetworks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetscalerLoadBalancerNetworks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateNetwork(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"aclid":             {"test-aclid"},
+		"acltype":           {"test-acltype"},
+		"displaynetwork":    {"true"},
+		"displaytext":       {"test-displaytext"},
+		"domainid":          {"test-domainid"},
+		"endip":             {"test-endip"},
+		"endipv6":           {"test-endipv6"},
+		"gateway":           {"test-gateway"},
+		"ip6cidr":           {"test-ip6cidr"},
+		"ip6gateway":        {"test-ip6gateway"},
+		"isolatedpvlan":     {"test-isolatedpvlan"},
+		"name":              {"test-name"},
+		"netmask":           {"test-netmask"},
+		"networkdomain":     {"test-networkdomain"},
+		"networkofferingid": {"test-networkofferingid"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"projectid":         {"test-projectid"},
+		"startip":           {"test-startip"},
+		"startipv6":         {"test-startipv6"},
+		"subdomainaccess":   {"true"},
+		"vlan":              {"test-vlan"},
+		"vpcid":             {"test-vpcid"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createNetwork", params, `{"createnetworkresponse":{"createnetwork":{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}}`)
+	defer done()
+
+	p := cs.Network.NewCreateNetworkParams("test-displaytext", "test-name", "test-networkofferingid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAclid("test-aclid")
+	p.SetAcltype("test-acltype")
+	p.SetDisplaynetwork(true)
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetEndip("test-endip")
+	p.SetEndipv6("test-endipv6")
+	p.SetGateway("test-gateway")
+	p.SetIp6cidr("test-ip6cidr")
+	p.SetIp6gateway("test-ip6gateway")
+	p.SetIsolatedpvlan("test-isolatedpvlan")
+	p.SetName("test-name")
+	p.SetNetmask("test-netmask")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetNetworkofferingid("test-networkofferingid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetStartip("test-startip")
+	p.SetStartipv6("test-startipv6")
+	p.SetSubdomainaccess(true)
+	p.SetVlan("test-vlan")
+	p.SetVpcid("test-vpcid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.CreateNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetwork: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createNetwork" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createNetwork", params, `<createnetworkresponse><createnetwork><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createnetwork></createnetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.CreateNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call createNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetwork(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteNetwork", params, `{"deletenetworkresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Network.NewDeleteNetworkParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetForced(true)
+	p.SetId("test-id")
+	checkURLValues(t, "deleteNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.DeleteNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetwork: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetwork", params, `<deletenetworkresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletenetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.DeleteNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworks(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"acltype":           {"test-acltype"},
+		"canusefordeploy":   {"true"},
+		"domainid":          {"test-domainid"},
+		"forvpc":            {"true"},
+		"id":                {"test-id"},
+		"isrecursive":       {"true"},
+		"issystem":          {"true"},
+		"keyword":           {"test-keyword"},
+		"listall":           {"true"},
+		"page":              {"11"},
+		"pagesize":          {"12"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"projectid":         {"test-projectid"},
+		"restartrequired":   {"true"},
+		"specifyipranges":   {"true"},
+		"supportedservices": {"test-supportedservices1, test-supportedservices2"},
+		"tags[0].key":       {"test-tagskey"},
+		"tags[0].value":     {"test-tagsvalue"},
+		"traffictype":       {"Control"},
+		"type":              {"test-type"},
+		"vpcid":             {"test-vpcid"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listNetworks", params, `{"listnetworksresponse":{"count":1,"network":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListNetworksParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAcltype("test-acltype")
+	p.SetCanusefordeploy(true)
+	p.SetDomainid("test-domainid")
+	p.SetForvpc(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetIssystem(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetRestartrequired(true)
+	p.SetSpecifyipranges(true)
+	p.SetSupportedservices([]string{"test-supportedservices1", "test-supportedservices2"})
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetTraffictype(TrafficTypeControl)
+	p.SetType("test-type")
+	p.SetVpcid("test-vpcid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworks: %v", err)
+	}
+	if r.Networks[0].Account != "test-account" {
+		t.Errorf("Expected r.Networks[0].Account to be %v, got %v", "test-account", r.Networks[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Network
+	count, err := cs.Network.StreamNetworks(context.Background(), p, func(v *Network) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Networks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Networks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworks", params, `<listnetworksresponse><count>1</count><network><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></network></listnetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamNetworks(context.Background(), p, func(v *Network) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Networks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Networks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRestartNetwork(t *testing.T) {
+	params := url.Values{
+		"cleanup": {"true"},
+		"id":      {"test-id"},
+	}
+	cs, done := newTestClient(t, "restartNetwork", params, `{"restartnetworkresponse":{"account":"test-account","allocated":"test-allocated","associatednetworkid":"test-associatednetworkid","associatednetworkname":"test-associatednetworkname","domain":"test-domain","domainid":"test-domainid","forvirtualnetwork":true,"id":"test-id","ipaddress":"test-ipaddress","isportable":true,"issourcenat":true,"isstaticnat":true,"issystem":true,"jobid":"test-jobid","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","purpose":"test-purpose","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vlanid":"test-vlanid","vlanname":"test-vlanname","vmipaddress":"test-vmipaddress","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Network.NewRestartNetworkParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCleanup(true)
+	p.SetId("test-id")
+	checkURLValues(t, "restartNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.RestartNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call restartNetwork: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "restartNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute restartNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of restartNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of restartNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "restartNetwork", params, `<restartnetworkresponse><account>test-account</account><allocated>test-allocated</allocated><associatednetworkid>test-associatednetworkid</associatednetworkid><associatednetworkname>test-associatednetworkname</associatednetworkname><domain>test-domain</domain><domainid>test-domainid</domainid><forvirtualnetwork>true</forvirtualnetwork><id>test-id</id><ipaddress>test-ipaddress</ipaddress><isportable>true</isportable><issourcenat>true</issourcenat><isstaticnat>true</isstaticnat><issystem>true</issystem><jobid>test-jobid</jobid><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><purpose>test-purpose</purpose><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vlanid>test-vlanid</vlanid><vlanname>test-vlanname</vlanname><vmipaddress>test-vmipaddress</vmipaddress><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></restartnetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.RestartNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call restartNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateNetwork(t *testing.T) {
+	params := url.Values{
+		"changecidr":        {"true"},
+		"displaynetwork":    {"true"},
+		"displaytext":       {"test-displaytext"},
+		"guestvmcidr":       {"test-guestvmcidr"},
+		"id":                {"test-id"},
+		"name":              {"test-name"},
+		"networkdomain":     {"test-networkdomain"},
+		"networkofferingid": {"test-networkofferingid"},
+	}
+	cs, done := newTestClient(t, "updateNetwork", params, `{"updatenetworkresponse":{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"jobid":"test-jobid","name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Network.NewUpdateNetworkParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetChangecidr(true)
+	p.SetDisplaynetwork(true)
+	p.SetDisplaytext("test-displaytext")
+	p.SetGuestvmcidr("test-guestvmcidr")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetNetworkofferingid("test-networkofferingid")
+	checkURLValues(t, "updateNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.UpdateNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetwork: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateNetwork", params, `<updatenetworkresponse><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><jobid>test-jobid</jobid><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatenetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.UpdateNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworkIsolationMethods(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+	cs, done := newTestClient(t, "listNetworkIsolationMethods", params, `{"listnetworkisolationmethodsresponse":{"count":1,"networkisolationmethod":[{"name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListNetworkIsolationMethodsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	checkURLValues(t, "listNetworkIsolationMethods", p.URLValues(), params)
+
+	r, err := cs.Network.ListNetworkIsolationMethods(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkIsolationMethods: %v", err)
+	}
+	if r.NetworkIsolationMethods[0].Name != "test-name" {
+		t.Errorf("Expected r.NetworkIsolationMethods[0].Name to be %v, got %v", "test-name", r.NetworkIsolationMethods[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkIsolationMethods" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworkIsolationMethods: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworkIsolationMethods: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworkIsolationMethods: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetworkIsolationMethod
+	count, err := cs.Network.StreamNetworkIsolationMethods(context.Background(), p, func(v *NetworkIsolationMethod) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworkIsolationMethods: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetworkIsolationMethods) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetworkIsolationMethods)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworkIsolationMethods", params, `<listnetworkisolationmethodsresponse><count>1</count><networkisolationmethod><name>test-name</name></networkisolationmethod></listnetworkisolationmethodsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListNetworkIsolationMethods(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkIsolationMethods using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamNetworkIsolationMethods(context.Background(), p, func(v *NetworkIsolationMethod) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworkIsolationMethods using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetworkIsolationMethods) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetworkIsolationMethods)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddNetworkServiceProvider(t *testing.T) {
+	params := url.Values{
+		"destinationphysicalnetworkid": {"test-destinationphysicalnetworkid"},
+		"name":                         {"test-name"},
+		"physicalnetworkid":            {"test-physicalnetworkid"},
+		"servicelist":                  {"test-servicelist1, test-servicelist2"},
+	}
+	cs, done := newTestClient(t, "addNetworkServiceProvider", params, `{"addnetworkserviceproviderresponse":{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","jobid":"test-jobid","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}}`)
+	defer done()
+
+	p := cs.Network.NewAddNetworkServiceProviderParams("test-name", "test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDestinationphysicalnetworkid("test-destinationphysicalnetworkid")
+	p.SetName("test-name")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetServicelist([]string{"test-servicelist1", "test-servicelist2"})
+	checkURLValues(t, "addNetworkServiceProvider", p.URLValues(), params)
+
+	r, err := cs.Network.AddNetworkServiceProvider(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNetworkServiceProvider: %v", err)
+	}
+	if r.Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.Destinationphysicalnetworkid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addNetworkServiceProvider" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addNetworkServiceProvider: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addNetworkServiceProvider: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addNetworkServiceProvider: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addNetworkServiceProvider", params, `<addnetworkserviceproviderresponse><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></addnetworkserviceproviderresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.AddNetworkServiceProvider(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNetworkServiceProvider using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetworkServiceProvider(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteNetworkServiceProvider", params, `{"deletenetworkserviceproviderresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Network.NewDeleteNetworkServiceProviderParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteNetworkServiceProvider", p.URLValues(), params)
+
+	r, err := cs.Network.DeleteNetworkServiceProvider(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkServiceProvider: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkServiceProvider" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetworkServiceProvider: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetworkServiceProvider: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetworkServiceProvider: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetworkServiceProvider", params, `<deletenetworkserviceproviderresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletenetworkserviceproviderresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.DeleteNetworkServiceProvider(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkServiceProvider using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworkServiceProviders(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"name":              {"test-name"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"state":             {"test-state"},
+	}
+	cs, done := newTestClient(t, "listNetworkServiceProviders", params, `{"listnetworkserviceprovidersresponse":{"count":1,"networkserviceprovider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListNetworkServiceProvidersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetState("test-state")
+	checkURLValues(t, "listNetworkServiceProviders", p.URLValues(), params)
+
+	r, err := cs.Network.ListNetworkServiceProviders(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkServiceProviders: %v", err)
+	}
+	if r.NetworkServiceProviders[0].Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.NetworkServiceProviders[0].Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.NetworkServiceProviders[0].Destinationphysicalnetworkid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkServiceProviders" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworkServiceProviders: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworkServiceProviders: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworkServiceProviders: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetworkServiceProvider
+	count, err := cs.Network.StreamNetworkServiceProviders(context.Background(), p, func(v *NetworkServiceProvider) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworkServiceProviders: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetworkServiceProviders) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetworkServiceProviders)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworkServiceProviders", params, `<listnetworkserviceprovidersresponse><count>1</count><networkserviceprovider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></networkserviceprovider></listnetworkserviceprovidersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListNetworkServiceProviders(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkServiceProviders using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamNetworkServiceProviders(context.Background(), p, func(v *NetworkServiceProvider) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworkServiceProviders using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetworkServiceProviders) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetworkServiceProviders)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateNetworkServiceProvider(t *testing.T) {
+	params := url.Values{
+		"id":          {"test-id"},
+		"servicelist": {"test-servicelist1, test-servicelist2"},
+		"state":       {"test-state"},
+	}
+	cs, done := newTestClient(t, "updateNetworkServiceProvider", params, `{"updatenetworkserviceproviderresponse":{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","jobid":"test-jobid","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}}`)
+	defer done()
+
+	p := cs.Network.NewUpdateNetworkServiceProviderParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetServicelist([]string{"test-servicelist1", "test-servicelist2"})
+	p.SetState("test-state")
+	checkURLValues(t, "updateNetworkServiceProvider", p.URLValues(), params)
+
+	r, err := cs.Network.UpdateNetworkServiceProvider(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetworkServiceProvider: %v", err)
+	}
+	if r.Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.Destinationphysicalnetworkid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateNetworkServiceProvider" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateNetworkServiceProvider: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateNetworkServiceProvider: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateNetworkServiceProvider: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateNetworkServiceProvider", params, `<updatenetworkserviceproviderresponse><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></updatenetworkserviceproviderresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.UpdateNetworkServiceProvider(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateNetworkServiceProvider using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNiciraNvpDeviceNetworks(t *testing.T) {
+	params := url.Values{
+		"keyword":     {"test-keyword"},
+		"nvpdeviceid": {"test-nvpdeviceid"},
+		"page":        {"3"},
+		"pagesize":    {"4"},
+	}
+	cs, done := newTestClient(t, "listNiciraNvpDeviceNetworks", params, `{"listniciranvpdevicenetworksresponse":{"count":1,"niciranvpdevicenetwork":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListNiciraNvpDeviceNetworksParams("test-nvpdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetNvpdeviceid("test-nvpdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listNiciraNvpDeviceNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListNiciraNvpDeviceNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNiciraNvpDeviceNetworks: %v", err)
+	}
+	if r.NiciraNvpDeviceNetworks[0].Account != "test-account" {
+		t.Errorf("Expected r.NiciraNvpDeviceNetworks[0].Account to be %v, got %v", "test-account", r.NiciraNvpDeviceNetworks[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNiciraNvpDeviceNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNiciraNvpDeviceNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNiciraNvpDeviceNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNiciraNvpDeviceNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NiciraNvpDeviceNetwork
+	count, err := cs.Network.StreamNiciraNvpDeviceNetworks(context.Background(), p, func(v *NiciraNvpDeviceNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNiciraNvpDeviceNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NiciraNvpDeviceNetworks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NiciraNvpDeviceNetworks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNiciraNvpDeviceNetworks", params, `<listniciranvpdevicenetworksresponse><count>1</count><niciranvpdevicenetwork><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></niciranvpdevicenetwork></listniciranvpdevicenetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListNiciraNvpDeviceNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNiciraNvpDeviceNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamNiciraNvpDeviceNetworks(context.Background(), p, func(v *NiciraNvpDeviceNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNiciraNvpDeviceNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NiciraNvpDeviceNetworks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NiciraNvpDeviceNetworks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPaloAltoFirewallNetworks(t *testing.T) {
+	params := url.Values{
+		"keyword":    {"test-keyword"},
+		"lbdeviceid": {"test-lbdeviceid"},
+		"page":       {"3"},
+		"pagesize":   {"4"},
+	}
+	cs, done := newTestClient(t, "listPaloAltoFirewallNetworks", params, `{"listpaloaltofirewallnetworksresponse":{"count":1,"paloaltofirewallnetwork":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListPaloAltoFirewallNetworksParams("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listPaloAltoFirewallNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListPaloAltoFirewallNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPaloAltoFirewallNetworks: %v", err)
+	}
+	if r.PaloAltoFirewallNetworks[0].Account != "test-account" {
+		t.Errorf("Expected r.PaloAltoFirewallNetworks[0].Account to be %v, got %v", "test-account", r.PaloAltoFirewallNetworks[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPaloAltoFirewallNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPaloAltoFirewallNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPaloAltoFirewallNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPaloAltoFirewallNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PaloAltoFirewallNetwork
+	count, err := cs.Network.StreamPaloAltoFirewallNetworks(context.Background(), p, func(v *PaloAltoFirewallNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPaloAltoFirewallNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PaloAltoFirewallNetworks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PaloAltoFirewallNetworks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPaloAltoFirewallNetworks", params, `<listpaloaltofirewallnetworksresponse><count>1</count><paloaltofirewallnetwork><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></paloaltofirewallnetwork></listpaloaltofirewallnetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListPaloAltoFirewallNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPaloAltoFirewallNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamPaloAltoFirewallNetworks(context.Background(), p, func(v *PaloAltoFirewallNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPaloAltoFirewallNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PaloAltoFirewallNetworks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PaloAltoFirewallNetworks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreatePhysicalNetwork(t *testing.T) {
+	params := url.Values{
+		"broadcastdomainrange": {"test-broadcastdomainrange"},
+		"domainid":             {"test-domainid"},
+		"isolationmethods":     {"test-isolationmethods1, test-isolationmethods2"},
+		"name":                 {"test-name"},
+		"networkspeed":         {"test-networkspeed"},
+		"tags":                 {"test-tags1, test-tags2"},
+		"vlan":                 {"test-vlan"},
+		"zoneid":               {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createPhysicalNetwork", params, `{"createphysicalnetworkresponse":{"broadcastdomainrange":"test-broadcastdomainrange","domainid":"test-domainid","id":"test-id","isolationmethods":"test-isolationmethods","jobid":"test-jobid","name":"test-name","networkspeed":"test-networkspeed","state":"test-state","tags":"test-tags","vlan":"test-vlan","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Network.NewCreatePhysicalNetworkParams("test-name", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBroadcastdomainrange("test-broadcastdomainrange")
+	p.SetDomainid("test-domainid")
+	p.SetIsolationmethods([]string{"test-isolationmethods1", "test-isolationmethods2"})
+	p.SetName("test-name")
+	p.SetNetworkspeed("test-networkspeed")
+	p.SetTags([]string{"test-tags1", "test-tags2"})
+	p.SetVlan("test-vlan")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createPhysicalNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.CreatePhysicalNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPhysicalNetwork: %v", err)
+	}
+	if r.Broadcastdomainrange != "test-broadcastdomainrange" {
+		t.Errorf("Expected r.Broadcastdomainrange to be %v, got %v", "test-broadcastdomainrange", r.Broadcastdomainrange)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createPhysicalNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createPhysicalNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createPhysicalNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createPhysicalNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createPhysicalNetwork", params, `<createphysicalnetworkresponse><broadcastdomainrange>test-broadcastdomainrange</broadcastdomainrange><domainid>test-domainid</domainid><id>test-id</id><isolationmethods>test-isolationmethods</isolationmethods><jobid>test-jobid</jobid><name>test-name</name><networkspeed>test-networkspeed</networkspeed><state>test-state</state><tags>test-tags</tags><vlan>test-vlan</vlan><zoneid>test-zoneid</zoneid></createphysicalnetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.CreatePhysicalNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPhysicalNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeletePhysicalNetwork(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deletePhysicalNetwork", params, `{"deletephysicalnetworkresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Network.NewDeletePhysicalNetworkParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deletePhysicalNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.DeletePhysicalNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePhysicalNetwork: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deletePhysicalNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deletePhysicalNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deletePhysicalNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deletePhysicalNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deletePhysicalNetwork", params, `<deletephysicalnetworkresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletephysicalnetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.DeletePhysicalNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePhysicalNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPhysicalNetworks(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listPhysicalNetworks", params, `{"listphysicalnetworksresponse":{"count":1,"physicalnetwork":[{"broadcastdomainrange":"test-broadcastdomainrange","domainid":"test-domainid","id":"test-id","isolationmethods":"test-isolationmethods","name":"test-name","networkspeed":"test-networkspeed","state":"test-state","tags":"test-tags","vlan":"test-vlan","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListPhysicalNetworksParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listPhysicalNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListPhysicalNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPhysicalNetworks: %v", err)
+	}
+	if r.PhysicalNetworks[0].Broadcastdomainrange != "test-broadcastdomainrange" {
+		t.Errorf("Expected r.PhysicalNetworks[0].Broadcastdomainrange to be %v, got %v", "test-broadcastdomainrange", r.PhysicalNetworks[0].Broadcastdomainrange)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPhysicalNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPhysicalNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPhysicalNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPhysicalNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PhysicalNetwork
+	count, err := cs.Network.StreamPhysicalNetworks(context.Background(), p, func(v *PhysicalNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPhysicalNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PhysicalNetworks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PhysicalNetworks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPhysicalNetworks", params, `<listphysicalnetworksresponse><count>1</count><physicalnetwork><broadcastdomainrange>test-broadcastdomainrange</broadcastdomainrange><domainid>test-domainid</domainid><id>test-id</id><isolationmethods>test-isolationmethods</isolationmethods><name>test-name</name><networkspeed>test-networkspeed</networkspeed><state>test-state</state><tags>test-tags</tags><vlan>test-vlan</vlan><zoneid>test-zoneid</zoneid></physicalnetwork></listphysicalnetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListPhysicalNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPhysicalNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamPhysicalNetworks(context.Background(), p, func(v *PhysicalNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPhysicalNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PhysicalNetworks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PhysicalNetworks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdatePhysicalNetwork(t *testing.T) {
+	params := url.Values{
+		"id":           {"test-id"},
+		"networkspeed": {"test-networkspeed"},
+		"state":        {"test-state"},
+		"tags":         {"test-tags1, test-tags2"},
+		"vlan":         {"test-vlan"},
+	}
+	cs, done := newTestClient(t, "updatePhysicalNetwork", params, `{"updatephysicalnetworkresponse":{"broadcastdomainrange":"test-broadcastdomainrange","domainid":"test-domainid","id":"test-id","isolationmethods":"test-isolationmethods","jobid":"test-jobid","name":"test-name","networkspeed":"test-networkspeed","state":"test-state","tags":"test-tags","vlan":"test-vlan","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Network.NewUpdatePhysicalNetworkParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetNetworkspeed("test-networkspeed")
+	p.SetState("test-state")
+	p.SetTags([]string{"test-tags1", "test-tags2"})
+	p.SetVlan("test-vlan")
+	checkURLValues(t, "updatePhysicalNetwork", p.URLValues(), params)
+
+	r, err := cs.Network.UpdatePhysicalNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call updatePhysicalNetwork: %v", err)
+	}
+	if r.Broadcastdomainrange != "test-broadcastdomainrange" {
+		t.Errorf("Expected r.Broadcastdomainrange to be %v, got %v", "test-broadcastdomainrange", r.Broadcastdomainrange)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updatePhysicalNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updatePhysicalNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updatePhysicalNetwork: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updatePhysicalNetwork: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updatePhysicalNetwork", params, `<updatephysicalnetworkresponse><broadcastdomainrange>test-broadcastdomainrange</broadcastdomainrange><domainid>test-domainid</domainid><id>test-id</id><isolationmethods>test-isolationmethods</isolationmethods><jobid>test-jobid</jobid><name>test-name</name><networkspeed>test-networkspeed</networkspeed><state>test-state</state><tags>test-tags</tags><vlan>test-vlan</vlan><zoneid>test-zoneid</zoneid></updatephysicalnetworkresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.UpdatePhysicalNetwork(p)
+	if err != nil {
+		t.Fatalf("Failed to call updatePhysicalNetwork using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDedicatePublicIpRange(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"id":        {"test-id"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "dedicatePublicIpRange", params, `{"dedicatepubliciprangeresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","endip":"test-endip","endipv6":"test-endipv6","forvirtualnetwork":true,"gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","netmask":"test-netmask","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","podid":"test-podid","podname":"test-podname","project":"test-project","projectid":"test-projectid","startip":"test-startip","startipv6":"test-startipv6","vlan":"test-vlan","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Network.NewDedicatePublicIpRangeParams("test-account", "test-domainid", "test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "dedicatePublicIpRange", p.URLValues(), params)
+
+	r, err := cs.Network.DedicatePublicIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicatePublicIpRange: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "dedicatePublicIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute dedicatePublicIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of dedicatePublicIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of dedicatePublicIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "dedicatePublicIpRange", params, `<dedicatepubliciprangeresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><endip>test-endip</endip><endipv6>test-endipv6</endipv6><forvirtualnetwork>true</forvirtualnetwork><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><netmask>test-netmask</netmask><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><podid>test-podid</podid><podname>test-podname</podname><project>test-project</project><projectid>test-projectid</projectid><startip>test-startip</startip><startipv6>test-startipv6</startipv6><vlan>test-vlan</vlan><zoneid>test-zoneid</zoneid></dedicatepubliciprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.DedicatePublicIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicatePublicIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleasePublicIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "releasePublicIpRange", params, `{"releasepubliciprangeresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Network.NewReleasePublicIpRangeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "releasePublicIpRange", p.URLValues(), params)
+
+	r, err := cs.Network.ReleasePublicIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call releasePublicIpRange: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releasePublicIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releasePublicIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releasePublicIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releasePublicIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releasePublicIpRange", params, `<releasepubliciprangeresponse><displaytext>test-displaytext</displaytext><success>true</success></releasepubliciprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ReleasePublicIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call releasePublicIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSrxFirewallNetworks(t *testing.T) {
+	params := url.Values{
+		"keyword":    {"test-keyword"},
+		"lbdeviceid": {"test-lbdeviceid"},
+		"page":       {"3"},
+		"pagesize":   {"4"},
+	}
+	cs, done := newTestClient(t, "listSrxFirewallNetworks", params, `{"listsrxfirewallnetworksresponse":{"count":1,"srxfirewallnetwork":[{"account":"test-account","aclid":"test-aclid","acltype":"test-acltype","broadcastdomaintype":"test-broadcastdomaintype","broadcasturi":"test-broadcasturi","canusefordeploy":true,"cidr":"test-cidr","displaynetwork":true,"displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","isdefault":true,"ispersistent":true,"issystem":true,"name":"test-name","netmask":"test-netmask","networkcidr":"test-networkcidr","networkdomain":"test-networkdomain","networkofferingavailability":"test-networkofferingavailability","networkofferingconservemode":true,"networkofferingdisplaytext":"test-networkofferingdisplaytext","networkofferingid":"test-networkofferingid","networkofferingname":"test-networkofferingname","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","related":"test-related","reservediprange":"test-reservediprange","restartrequired":true,"service":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}],"specifyipranges":true,"state":"test-state","subdomainaccess":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"traffictype":"test-traffictype","type":"test-type","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListSrxFirewallNetworksParams("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "listSrxFirewallNetworks", p.URLValues(), params)
+
+	r, err := cs.Network.ListSrxFirewallNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSrxFirewallNetworks: %v", err)
+	}
+	if r.SrxFirewallNetworks[0].Account != "test-account" {
+		t.Errorf("Expected r.SrxFirewallNetworks[0].Account to be %v, got %v", "test-account", r.SrxFirewallNetworks[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSrxFirewallNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSrxFirewallNetworks: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSrxFirewallNetworks: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSrxFirewallNetworks: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SrxFirewallNetwork
+	count, err := cs.Network.StreamSrxFirewallNetworks(context.Background(), p, func(v *SrxFirewallNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSrxFirewallNetworks: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SrxFirewallNetworks) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SrxFirewallNetworks)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSrxFirewallNetworks", params, `<listsrxfirewallnetworksresponse><count>1</count><srxfirewallnetwork><account>test-account</account><aclid>test-aclid</aclid><acltype>test-acltype</acltype><broadcastdomaintype>test-broadcastdomaintype</broadcastdomaintype><broadcasturi>test-broadcasturi</broadcasturi><canusefordeploy>true</canusefordeploy><cidr>test-cidr</cidr><displaynetwork>true</displaynetwork><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><id>test-id</id><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><isdefault>true</isdefault><ispersistent>true</ispersistent><issystem>true</issystem><name>test-name</name><netmask>test-netmask</netmask><networkcidr>test-networkcidr</networkcidr><networkdomain>test-networkdomain</networkdomain><networkofferingavailability>test-networkofferingavailability</networkofferingavailability><networkofferingconservemode>true</networkofferingconservemode><networkofferingdisplaytext>test-networkofferingdisplaytext</networkofferingdisplaytext><networkofferingid>test-networkofferingid</networkofferingid><networkofferingname>test-networkofferingname</networkofferingname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><related>test-related</related><reservediprange>test-reservediprange</reservediprange><restartrequired>true</restartrequired><service><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></service><specifyipranges>true</specifyipranges><state>test-state</state><subdomainaccess>true</subdomainaccess><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><traffictype>test-traffictype</traffictype><type>test-type</type><vlan>test-vlan</vlan><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></srxfirewallnetwork></listsrxfirewallnetworksresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListSrxFirewallNetworks(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSrxFirewallNetworks using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamSrxFirewallNetworks(context.Background(), p, func(v *SrxFirewallNetwork) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSrxFirewallNetworks using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SrxFirewallNetworks) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SrxFirewallNetworks)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"endip":   {"test-endip"},
+		"gateway": {"test-gateway"},
+		"netmask": {"test-netmask"},
+		"podid":   {"test-podid"},
+		"startip": {"test-startip"},
+		"vlan":    {"6"},
+	}
+	cs, done := newTestClient(t, "createStorageNetworkIpRange", params, `{"createstoragenetworkiprangeresponse":{"endip":"test-endip","gateway":"test-gateway","id":"test-id","jobid":"test-jobid","netmask":"test-netmask","networkid":"test-networkid","podid":"test-podid","startip":"test-startip","vlan":1,"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Network.NewCreateStorageNetworkIpRangeParams("test-gateway", "test-netmask", "test-podid", "test-startip")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetNetmask("test-netmask")
+	p.SetPodid("test-podid")
+	p.SetStartip("test-startip")
+	p.SetVlan(6)
+	checkURLValues(t, "createStorageNetworkIpRange", p.URLValues(), params)
+
+	r, err := cs.Network.CreateStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call createStorageNetworkIpRange: %v", err)
+	}
+	if r.Endip != "test-endip" {
+		t.Errorf("Expected r.Endip to be %v, got %v", "test-endip", r.Endip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createStorageNetworkIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createStorageNetworkIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createStorageNetworkIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createStorageNetworkIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createStorageNetworkIpRange", params, `<createstoragenetworkiprangeresponse><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><jobid>test-jobid</jobid><netmask>test-netmask</netmask><networkid>test-networkid</networkid><podid>test-podid</podid><startip>test-startip</startip><vlan>1</vlan><zoneid>test-zoneid</zoneid></createstoragenetworkiprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.CreateStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call createStorageNetworkIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteStorageNetworkIpRange", params, `{"deletestoragenetworkiprangeresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Network.NewDeleteStorageNetworkIpRangeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteStorageNetworkIpRange", p.URLValues(), params)
+
+	r, err := cs.Network.DeleteStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteStorageNetworkIpRange: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteStorageNetworkIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteStorageNetworkIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteStorageNetworkIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteStorageNetworkIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteStorageNetworkIpRange", params, `<deletestoragenetworkiprangeresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletestoragenetworkiprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.DeleteStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteStorageNetworkIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"podid":    {"test-podid"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listStorageNetworkIpRange", params, `{"liststoragenetworkiprangeresponse":{"count":1,"storagenetworkiprange":[{"endip":"test-endip","gateway":"test-gateway","id":"test-id","netmask":"test-netmask","networkid":"test-networkid","podid":"test-podid","startip":"test-startip","vlan":1,"zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Network.NewListStorageNetworkIpRangeParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPodid("test-podid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listStorageNetworkIpRange", p.URLValues(), params)
+
+	r, err := cs.Network.ListStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStorageNetworkIpRange: %v", err)
+	}
+	if r.StorageNetworkIpRange[0].Endip != "test-endip" {
+		t.Errorf("Expected r.StorageNetworkIpRange[0].Endip to be %v, got %v", "test-endip", r.StorageNetworkIpRange[0].Endip)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listStorageNetworkIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listStorageNetworkIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listStorageNetworkIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listStorageNetworkIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*StorageNetworkIpRange
+	count, err := cs.Network.StreamStorageNetworkIpRange(context.Background(), p, func(v *StorageNetworkIpRange) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listStorageNetworkIpRange: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.StorageNetworkIpRange) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.StorageNetworkIpRange)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listStorageNetworkIpRange", params, `<liststoragenetworkiprangeresponse><count>1</count><storagenetworkiprange><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><netmask>test-netmask</netmask><networkid>test-networkid</networkid><podid>test-podid</podid><startip>test-startip</startip><vlan>1</vlan><zoneid>test-zoneid</zoneid></storagenetworkiprange></liststoragenetworkiprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStorageNetworkIpRange using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamStorageNetworkIpRange(context.Background(), p, func(v *StorageNetworkIpRange) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listStorageNetworkIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.StorageNetworkIpRange) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.StorageNetworkIpRange)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"endip":   {"test-endip"},
+		"id":      {"test-id"},
+		"netmask": {"test-netmask"},
+		"startip": {"test-startip"},
+		"vlan":    {"5"},
+	}
+	cs, done := newTestClient(t, "updateStorageNetworkIpRange", params, `{"updatestoragenetworkiprangeresponse":{"endip":"test-endip","gateway":"test-gateway","id":"test-id","jobid":"test-jobid","netmask":"test-netmask","networkid":"test-networkid","podid":"test-podid","startip":"test-startip","vlan":1,"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Network.NewUpdateStorageNetworkIpRangeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEndip("test-endip")
+	p.SetId("test-id")
+	p.SetNetmask("test-netmask")
+	p.SetStartip("test-startip")
+	p.SetVlan(5)
+	checkURLValues(t, "updateStorageNetworkIpRange", p.URLValues(), params)
+
+	r, err := cs.Network.UpdateStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateStorageNetworkIpRange: %v", err)
+	}
+	if r.Endip != "test-endip" {
+		t.Errorf("Expected r.Endip to be %v, got %v", "test-endip", r.Endip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateStorageNetworkIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateStorageNetworkIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateStorageNetworkIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateStorageNetworkIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateStorageNetworkIpRange", params, `<updatestoragenetworkiprangeresponse><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><jobid>test-jobid</jobid><netmask>test-netmask</netmask><networkid>test-networkid</networkid><podid>test-podid</podid><startip>test-startip</startip><vlan>1</vlan><zoneid>test-zoneid</zoneid></updatestoragenetworkiprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.UpdateStorageNetworkIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateStorageNetworkIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSupportedNetworkServices(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"provider": {"test-provider"},
+		"service":  {"test-service"},
+	}
+	cs, done := newTestClient(t, "listSupportedNetworkServices", params, `{"listsupportednetworkservicesresponse":{"count":1,"supportednetworkservice":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}]}}`)
+	defer done()
+
+	p := cs.Network.NewListSupportedNetworkServicesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetProvider("test-provider")
+	p.SetService("test-service")
+	checkURLValues(t, "listSupportedNetworkServices", p.URLValues(), params)
+
+	r, err := cs.Network.ListSupportedNetworkServices(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSupportedNetworkServices: %v", err)
+	}
+	if r.SupportedNetworkServices[0].Name != "test-name" {
+		t.Errorf("Expected r.SupportedNetworkServices[0].Name to be %v, got %v", "test-name", r.SupportedNetworkServices[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSupportedNetworkServices" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSupportedNetworkServices: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSupportedNetworkServices: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSupportedNetworkServices: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SupportedNetworkService
+	count, err := cs.Network.StreamSupportedNetworkServices(context.Background(), p, func(v *SupportedNetworkService) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSupportedNetworkServices: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SupportedNetworkServices) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SupportedNetworkServices)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSupportedNetworkServices", params, `<listsupportednetworkservicesresponse><count>1</count><supportednetworkservice><capability><canchooseservicecapability>true</canchooseservicecapability><name>test-name</name><value>test-value</value></capability><name>test-name</name><provider><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></provider></supportednetworkservice></listsupportednetworkservicesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Network.ListSupportedNetworkServices(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSupportedNetworkServices using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Network.StreamSupportedNetworkServices(context.Background(), p, func(v *SupportedNetworkService) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSupportedNetworkServices using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SupportedNetworkServices) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SupportedNetworkServices)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
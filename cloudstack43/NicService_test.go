@@ -0,0 +1,242 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRemoveIpFromNic(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "removeIpFromNic", params, `{"removeipfromnicresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Nic.NewRemoveIpFromNicParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "removeIpFromNic", p.URLValues(), params)
+
+	r, err := cs.Nic.RemoveIpFromNic(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeIpFromNic: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeIpFromNic" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeIpFromNic: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeIpFromNic: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeIpFromNic: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeIpFromNic", params, `<removeipfromnicresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removeipfromnicresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Nic.RemoveIpFromNic(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeIpFromNic using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddIpToNic(t *testing.T) {
+	params := url.Values{
+		"ipaddress": {"test-ipaddress"},
+		"nicid":     {"test-nicid"},
+	}
+	cs, done := newTestClient(t, "addIpToNic", params, `{"addiptonicresponse":{"id":"test-id","ipaddress":"test-ipaddress","jobid":"test-jobid","networkid":"test-networkid","nicid":"test-nicid","virtualmachineid":"test-virtualmachineid"}}`)
+	defer done()
+
+	p := cs.Nic.NewAddIpToNicParams("test-nicid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetIpaddress("test-ipaddress")
+	p.SetNicid("test-nicid")
+	checkURLValues(t, "addIpToNic", p.URLValues(), params)
+
+	r, err := cs.Nic.AddIpToNic(p)
+	if err != nil {
+		t.Fatalf("Failed to call addIpToNic: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addIpToNic" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addIpToNic: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addIpToNic: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addIpToNic: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addIpToNic", params, `<addiptonicresponse><id>test-id</id><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><networkid>test-networkid</networkid><nicid>test-nicid</nicid><virtualmachineid>test-virtualmachineid</virtualmachineid></addiptonicresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Nic.AddIpToNic(p)
+	if err != nil {
+		t.Fatalf("Failed to call addIpToNic using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNics(t *testing.T) {
+	params := url.Values{
+		"keyword":          {"test-keyword"},
+		"nicid":            {"test-nicid"},
+		"page":             {"3"},
+		"pagesize":         {"4"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "listNics", params, `{"listnicsresponse":{"count":1,"nic":[{"broadcasturi":"test-broadcasturi","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type"}]}}`)
+	defer done()
+
+	p := cs.Nic.NewListNicsParams("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetNicid("test-nicid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "listNics", p.URLValues(), params)
+
+	r, err := cs.Nic.ListNics(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNics: %v", err)
+	}
+	if r.Nics[0].Broadcasturi != "test-broadcasturi" {
+		t.Errorf("Expected r.Nics[0].Broadcasturi to be %v, got %v", "test-broadcasturi", r.Nics[0].Broadcasturi)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNics" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNics: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNics: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNics: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Nic
+	count, err := cs.Nic.StreamNics(context.Background(), p, func(v *Nic) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNics: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Nics) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Nics)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNics", params, `<listnicsresponse><count>1</count><nic><broadcasturi>test-broadcasturi</broadcasturi><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type></nic></listnicsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Nic.ListNics(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNics using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Nic.StreamNics(context.Background(), p, func(v *Nic) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNics using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Nics) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Nics)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
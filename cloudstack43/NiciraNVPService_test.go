@@ -0,0 +1,250 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddNiciraNvpDevice(t *testing.T) {
+	params := url.Values{
+		"hostname":             {"test-hostname"},
+		"l3gatewayserviceuuid": {"test-l3gatewayserviceuuid"},
+		"password":             {"test-password"},
+		"physicalnetworkid":    {"test-physicalnetworkid"},
+		"transportzoneuuid":    {"test-transportzoneuuid"},
+		"username":             {"test-username"},
+	}
+	cs, done := newTestClient(t, "addNiciraNvpDevice", params, `{"addniciranvpdeviceresponse":{"hostname":"test-hostname","jobid":"test-jobid","l3gatewayserviceuuid":"test-l3gatewayserviceuuid","niciradevicename":"test-niciradevicename","nvpdeviceid":"test-nvpdeviceid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","transportzoneuuid":"test-transportzoneuuid"}}`)
+	defer done()
+
+	p := cs.NiciraNVP.NewAddNiciraNvpDeviceParams("test-hostname", "test-password", "test-physicalnetworkid", "test-transportzoneuuid", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	p.SetL3gatewayserviceuuid("test-l3gatewayserviceuuid")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetTransportzoneuuid("test-transportzoneuuid")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addNiciraNvpDevice", p.URLValues(), params)
+
+	r, err := cs.NiciraNVP.AddNiciraNvpDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNiciraNvpDevice: %v", err)
+	}
+	if r.Hostname != "test-hostname" {
+		t.Errorf("Expected r.Hostname to be %v, got %v", "test-hostname", r.Hostname)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addNiciraNvpDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addNiciraNvpDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addNiciraNvpDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addNiciraNvpDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addNiciraNvpDevice", params, `<addniciranvpdeviceresponse><hostname>test-hostname</hostname><jobid>test-jobid</jobid><l3gatewayserviceuuid>test-l3gatewayserviceuuid</l3gatewayserviceuuid><niciradevicename>test-niciradevicename</niciradevicename><nvpdeviceid>test-nvpdeviceid</nvpdeviceid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><transportzoneuuid>test-transportzoneuuid</transportzoneuuid></addniciranvpdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NiciraNVP.AddNiciraNvpDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNiciraNvpDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNiciraNvpDevice(t *testing.T) {
+	params := url.Values{
+		"nvpdeviceid": {"test-nvpdeviceid"},
+	}
+	cs, done := newTestClient(t, "deleteNiciraNvpDevice", params, `{"deleteniciranvpdeviceresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.NiciraNVP.NewDeleteNiciraNvpDeviceParams("test-nvpdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetNvpdeviceid("test-nvpdeviceid")
+	checkURLValues(t, "deleteNiciraNvpDevice", p.URLValues(), params)
+
+	r, err := cs.NiciraNVP.DeleteNiciraNvpDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNiciraNvpDevice: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNiciraNvpDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNiciraNvpDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNiciraNvpDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNiciraNvpDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNiciraNvpDevice", params, `<deleteniciranvpdeviceresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteniciranvpdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NiciraNVP.DeleteNiciraNvpDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNiciraNvpDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNiciraNvpDevices(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"nvpdeviceid":       {"test-nvpdeviceid"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "listNiciraNvpDevices", params, `{"listniciranvpdevicesresponse":{"count":1,"niciranvpdevice":[{"hostname":"test-hostname","l3gatewayserviceuuid":"test-l3gatewayserviceuuid","niciradevicename":"test-niciradevicename","nvpdeviceid":"test-nvpdeviceid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","transportzoneuuid":"test-transportzoneuuid"}]}}`)
+	defer done()
+
+	p := cs.NiciraNVP.NewListNiciraNvpDevicesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetNvpdeviceid("test-nvpdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "listNiciraNvpDevices", p.URLValues(), params)
+
+	r, err := cs.NiciraNVP.ListNiciraNvpDevices(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNiciraNvpDevices: %v", err)
+	}
+	if r.NiciraNvpDevices[0].Hostname != "test-hostname" {
+		t.Errorf("Expected r.NiciraNvpDevices[0].Hostname to be %v, got %v", "test-hostname", r.NiciraNvpDevices[0].Hostname)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNiciraNvpDevices" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNiciraNvpDevices: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNiciraNvpDevices: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNiciraNvpDevices: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NiciraNvpDevice
+	count, err := cs.NiciraNVP.StreamNiciraNvpDevices(context.Background(), p, func(v *NiciraNvpDevice) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNiciraNvpDevices: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NiciraNvpDevices) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NiciraNvpDevices)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNiciraNvpDevices", params, `<listniciranvpdevicesresponse><count>1</count><niciranvpdevice><hostname>test-hostname</hostname><l3gatewayserviceuuid>test-l3gatewayserviceuuid</l3gatewayserviceuuid><niciradevicename>test-niciradevicename</niciradevicename><nvpdeviceid>test-nvpdeviceid</nvpdeviceid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><transportzoneuuid>test-transportzoneuuid</transportzoneuuid></niciranvpdevice></listniciranvpdevicesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NiciraNVP.ListNiciraNvpDevices(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNiciraNvpDevices using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.NiciraNVP.StreamNiciraNvpDevices(context.Background(), p, func(v *NiciraNvpDevice) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNiciraNvpDevices using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NiciraNvpDevices) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NiciraNvpDevices)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,400 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateStoragePool(t *testing.T) {
+	params := url.Values{
+		"capacitybytes":              {"1"},
+		"capacityiops":               {"2"},
+		"clusterid":                  {"test-clusterid"},
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"hypervisor":                 {"BareMetal"},
+		"managed":                    {"true"},
+		"name":                       {"test-name"},
+		"podid":                      {"test-podid"},
+		"provider":                   {"test-provider"},
+		"scope":                      {"test-scope"},
+		"tags":                       {"test-tags"},
+		"url":                        {"test-url"},
+		"zoneid":                     {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createStoragePool", params, `{"createstoragepoolresponse":{"capacityiops":1,"clusterid":"test-clusterid","clustername":"test-clustername","created":"2014-01-02T15:04:05+0100","disksizeallocated":1,"disksizetotal":1,"disksizeused":1,"hypervisor":"test-hypervisor","id":"test-id","ipaddress":"test-ipaddress","name":"test-name","path":"test-path","podid":"test-podid","podname":"test-podname","scope":"test-scope","state":"test-state","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"test-tags","type":"test-type","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Pool.NewCreateStoragePoolParams("test-name", "test-url", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCapacitybytes(1)
+	p.SetCapacityiops(2)
+	p.SetClusterid("test-clusterid")
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetManaged(true)
+	p.SetName("test-name")
+	p.SetPodid("test-podid")
+	p.SetProvider("test-provider")
+	p.SetScope("test-scope")
+	p.SetTags("test-tags")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createStoragePool", p.URLValues(), params)
+
+	r, err := cs.Pool.CreateStoragePool(p)
+	if err != nil {
+		t.Fatalf("Failed to call createStoragePool: %v", err)
+	}
+	if r.Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Clusterid to be %v, got %v", "test-clusterid", r.Clusterid)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createStoragePool" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createStoragePool: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createStoragePool: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createStoragePool: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createStoragePool", params, `<createstoragepoolresponse><capacityiops>1</capacityiops><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><created>2014-01-02T15:04:05+0100</created><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><disksizeused>1</disksizeused><hypervisor>test-hypervisor</hypervisor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><path>test-path</path><podid>test-podid</podid><podname>test-podname</podname><scope>test-scope</scope><state>test-state</state><storagecapabilities><key>value</key></storagecapabilities><suitableformigration>true</suitableformigration><tags>test-tags</tags><type>test-type</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createstoragepoolresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pool.CreateStoragePool(p)
+	if err != nil {
+		t.Fatalf("Failed to call createStoragePool using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteStoragePool(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteStoragePool", params, `{"deletestoragepoolresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Pool.NewDeleteStoragePoolParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetForced(true)
+	p.SetId("test-id")
+	checkURLValues(t, "deleteStoragePool", p.URLValues(), params)
+
+	r, err := cs.Pool.DeleteStoragePool(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteStoragePool: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteStoragePool" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteStoragePool: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteStoragePool: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteStoragePool: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteStoragePool", params, `<deletestoragepoolresponse><displaytext>test-displaytext</displaytext><success>true</success></deletestoragepoolresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pool.DeleteStoragePool(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteStoragePool using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListStoragePools(t *testing.T) {
+	params := url.Values{
+		"clusterid": {"test-clusterid"},
+		"id":        {"test-id"},
+		"ipaddress": {"test-ipaddress"},
+		"keyword":   {"test-keyword"},
+		"name":      {"test-name"},
+		"page":      {"6"},
+		"pagesize":  {"7"},
+		"path":      {"test-path"},
+		"podid":     {"test-podid"},
+		"scope":     {"test-scope"},
+		"zoneid":    {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listStoragePools", params, `{"liststoragepoolsresponse":{"count":1,"storagepool":[{"capacityiops":1,"clusterid":"test-clusterid","clustername":"test-clustername","created":"2014-01-02T15:04:05+0100","disksizeallocated":1,"disksizetotal":1,"disksizeused":1,"hypervisor":"test-hypervisor","id":"test-id","ipaddress":"test-ipaddress","name":"test-name","path":"test-path","podid":"test-podid","podname":"test-podname","scope":"test-scope","state":"test-state","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"test-tags","type":"test-type","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Pool.NewListStoragePoolsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	p.SetId("test-id")
+	p.SetIpaddress("test-ipaddress")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetPath("test-path")
+	p.SetPodid("test-podid")
+	p.SetScope("test-scope")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listStoragePools", p.URLValues(), params)
+
+	r, err := cs.Pool.ListStoragePools(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStoragePools: %v", err)
+	}
+	if r.StoragePools[0].Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.StoragePools[0].Clusterid to be %v, got %v", "test-clusterid", r.StoragePools[0].Clusterid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listStoragePools" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listStoragePools: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listStoragePools: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listStoragePools: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*StoragePool
+	count, err := cs.Pool.StreamStoragePools(context.Background(), p, func(v *StoragePool) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listStoragePools: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.StoragePools) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.StoragePools)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listStoragePools", params, `<liststoragepoolsresponse><count>1</count><storagepool><capacityiops>1</capacityiops><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><created>2014-01-02T15:04:05+0100</created><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><disksizeused>1</disksizeused><hypervisor>test-hypervisor</hypervisor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><path>test-path</path><podid>test-podid</podid><podname>test-podname</podname><scope>test-scope</scope><state>test-state</state><storagecapabilities><key>value</key></storagecapabilities><suitableformigration>true</suitableformigration><tags>test-tags</tags><type>test-type</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></storagepool></liststoragepoolsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pool.ListStoragePools(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStoragePools using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Pool.StreamStoragePools(context.Background(), p, func(v *StoragePool) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listStoragePools using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.StoragePools) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.StoragePools)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateStoragePool(t *testing.T) {
+	params := url.Values{
+		"capacitybytes": {"1"},
+		"capacityiops":  {"2"},
+		"id":            {"test-id"},
+		"tags":          {"test-tags1, test-tags2"},
+	}
+	cs, done := newTestClient(t, "updateStoragePool", params, `{"updatestoragepoolresponse":{"capacityiops":1,"clusterid":"test-clusterid","clustername":"test-clustername","created":"2014-01-02T15:04:05+0100","disksizeallocated":1,"disksizetotal":1,"disksizeused":1,"hypervisor":"test-hypervisor","id":"test-id","ipaddress":"test-ipaddress","name":"test-name","path":"test-path","podid":"test-podid","podname":"test-podname","scope":"test-scope","state":"test-state","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"test-tags","type":"test-type","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Pool.NewUpdateStoragePoolParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCapacitybytes(1)
+	p.SetCapacityiops(2)
+	p.SetId("test-id")
+	p.SetTags([]string{"test-tags1", "test-tags2"})
+	checkURLValues(t, "updateStoragePool", p.URLValues(), params)
+
+	r, err := cs.Pool.UpdateStoragePool(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateStoragePool: %v", err)
+	}
+	if r.Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Clusterid to be %v, got %v", "test-clusterid", r.Clusterid)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateStoragePool" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateStoragePool: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateStoragePool: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateStoragePool: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateStoragePool", params, `<updatestoragepoolresponse><capacityiops>1</capacityiops><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><created>2014-01-02T15:04:05+0100</created><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><disksizeused>1</disksizeused><hypervisor>test-hypervisor</hypervisor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><path>test-path</path><podid>test-podid</podid><podname>test-podname</podname><scope>test-scope</scope><state>test-state</state><storagecapabilities><key>value</key></storagecapabilities><suitableformigration>true</suitableformigration><tags>test-tags</tags><type>test-type</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatestoragepoolresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pool.UpdateStoragePool(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateStoragePool using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestFindStoragePoolsForMigration(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+	}
+	cs, done := newTestClient(t, "findStoragePoolsForMigration", params, `{"findstoragepoolsformigrationresponse":{"capacityiops":1,"clusterid":"test-clusterid","clustername":"test-clustername","created":"2014-01-02T15:04:05+0100","disksizeallocated":1,"disksizetotal":1,"disksizeused":1,"hypervisor":"test-hypervisor","id":"test-id","ipaddress":"test-ipaddress","name":"test-name","path":"test-path","podid":"test-podid","podname":"test-podname","scope":"test-scope","state":"test-state","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"test-tags","type":"test-type","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Pool.NewFindStoragePoolsForMigrationParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	checkURLValues(t, "findStoragePoolsForMigration", p.URLValues(), params)
+
+	r, err := cs.Pool.FindStoragePoolsForMigration(p)
+	if err != nil {
+		t.Fatalf("Failed to call findStoragePoolsForMigration: %v", err)
+	}
+	if r.Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Clusterid to be %v, got %v", "test-clusterid", r.Clusterid)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "findStoragePoolsForMigration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute findStoragePoolsForMigration: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of findStoragePoolsForMigration: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of findStoragePoolsForMigration: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "findStoragePoolsForMigration", params, `<findstoragepoolsformigrationresponse><capacityiops>1</capacityiops><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><created>2014-01-02T15:04:05+0100</created><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><disksizeused>1</disksizeused><hypervisor>test-hypervisor</hypervisor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><path>test-path</path><podid>test-podid</podid><podname>test-podname</podname><scope>test-scope</scope><state>test-state</state><storagecapabilities><key>value</key></storagecapabilities><suitableformigration>true</suitableformigration><tags>test-tags</tags><type>test-type</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></findstoragepoolsformigrationresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Pool.FindStoragePoolsForMigration(p)
+	if err != nil {
+		t.Fatalf("Failed to call findStoragePoolsForMigration using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,320 +17,181 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
-	"strconv"
-)
-
-type AddRegionParams struct {
-	p map[string]interface{}
-}
 
-func (p *AddRegionParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["endpoint"]; found {
-		u.Set("endpoint", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddRegionParams) SetEndpoint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endpoint"] = v
-	return
+type RegionService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddRegionParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func NewRegionService(cs *CloudStackClient) *RegionService {
+	return &RegionService{cs: cs}
 }
 
-func (p *AddRegionParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type AddRegionParams = cloudstackcommon.AddRegionParams
+type AddRegionResponse = cloudstackcommon.AddRegionResponse
 
 // You should always use this function to get a new AddRegionParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - endpoint
+//   - id
+//   - name
 func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams {
 	p := &AddRegionParams{}
-	p.p = make(map[string]interface{})
-	p.p["endpoint"] = endpoint
-	p.p["id"] = id
-	p.p["name"] = name
+	p.SetEndpoint(endpoint)
+	p.SetId(id)
+	p.SetName(name)
 	return p
 }
 
 // Adds a Region
+//
+// See also ListRegions.
 func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
-	resp, err := s.cs.newRequest("addRegion", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addRegion", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddRegionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addRegion", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddRegionResponse struct {
-	Endpoint                 string `json:"endpoint,omitempty"`
-	Gslbserviceenabled       bool   `json:"gslbserviceenabled,omitempty"`
-	Id                       int    `json:"id,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Portableipserviceenabled bool   `json:"portableipserviceenabled,omitempty"`
-}
-
-type UpdateRegionParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateRegionParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["endpoint"]; found {
-		u.Set("endpoint", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
-}
+type ListRegionsParams = cloudstackcommon.ListRegionsParams
+type ListRegionsResponse = cloudstackcommon.ListRegionsResponse
+type Region = cloudstackcommon.Region
 
-func (p *UpdateRegionParams) SetEndpoint(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["endpoint"] = v
-	return
-}
-
-func (p *UpdateRegionParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UpdateRegionParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-// You should always use this function to get a new UpdateRegionParams instance,
+// You should always use this function to get a new ListRegionsParams instance,
 // as then you are sure you have configured all required params
-func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
-	p := &UpdateRegionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
+	p := &ListRegionsParams{}
 	return p
 }
 
-// Updates a region
-func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
-	resp, err := s.cs.newRequest("updateRegion", p.toURLValues())
+// Lists Regions
+func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listRegions", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateRegionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListRegionsResponse
+	if err := s.cs.unmarshal("listRegions", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateRegionResponse struct {
-	Endpoint                 string `json:"endpoint,omitempty"`
-	Gslbserviceenabled       bool   `json:"gslbserviceenabled,omitempty"`
-	Id                       int    `json:"id,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Portableipserviceenabled bool   `json:"portableipserviceenabled,omitempty"`
-}
-
-type RemoveRegionParams struct {
-	p map[string]interface{}
-}
-
-func (p *RemoveRegionParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
+// Lists the objects like ListRegions, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *RegionService) StreamRegions(ctx context.Context, p *ListRegionsParams, fn func(*Region) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
-}
 
-func (p *RemoveRegionParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+	return s.cs.streamList(ctx, "listRegions", p.URLValues(), "region", func(b json.RawMessage) error {
+		var v Region
+		if err := s.cs.unmarshalElement("listRegions", "region", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
+type RemoveRegionParams = cloudstackcommon.RemoveRegionParams
+type RemoveRegionResponse = cloudstackcommon.RemoveRegionResponse
+
 // You should always use this function to get a new RemoveRegionParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
 	p := &RemoveRegionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Removes specified region
+//
+// See also ListRegions.
 func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
-	resp, err := s.cs.newRequest("removeRegion", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeRegion", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveRegionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("removeRegion", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type RemoveRegionResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type ListRegionsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListRegionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("id", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListRegionsParams) SetId(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListRegionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListRegionsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type UpdateRegionParams = cloudstackcommon.UpdateRegionParams
+type UpdateRegionResponse = cloudstackcommon.UpdateRegionResponse
 
-func (p *ListRegionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListRegionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-// You should always use this function to get a new ListRegionsParams instance,
+// You should always use this function to get a new UpdateRegionParams instance,
 // as then you are sure you have configured all required params
-func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
-	p := &ListRegionsParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - id
+func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
+	p := &UpdateRegionParams{}
+	p.SetId(id)
 	return p
 }
 
-// Lists Regions
-func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
-	resp, err := s.cs.newRequest("listRegions", p.toURLValues())
+// Updates a region
+//
+// See also ListRegions.
+func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateRegion", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListRegionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateRegionResponse
+	if err := s.cs.unmarshal("updateRegion", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type ListRegionsResponse struct {
-	Count   int       `json:"count"`
-	Regions []*Region `json:"region"`
-}
-
-type Region struct {
-	Endpoint                 string `json:"endpoint,omitempty"`
-	Gslbserviceenabled       bool   `json:"gslbserviceenabled,omitempty"`
-	Id                       int    `json:"id,omitempty"`
-	Name                     string `json:"name,omitempty"`
-	Portableipserviceenabled bool   `json:"portableipserviceenabled,omitempty"`
-}
@@ -0,0 +1,300 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddRegion(t *testing.T) {
+	params := url.Values{
+		"endpoint": {"test-endpoint"},
+		"id":       {"2"},
+		"name":     {"test-name"},
+	}
+	cs, done := newTestClient(t, "addRegion", params, `{"addregionresponse":{"endpoint":"test-endpoint","gslbserviceenabled":true,"id":1,"name":"test-name","portableipserviceenabled":true}}`)
+	defer done()
+
+	p := cs.Region.NewAddRegionParams("test-endpoint", 2, "test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEndpoint("test-endpoint")
+	p.SetId(2)
+	p.SetName("test-name")
+	checkURLValues(t, "addRegion", p.URLValues(), params)
+
+	r, err := cs.Region.AddRegion(p)
+	if err != nil {
+		t.Fatalf("Failed to call addRegion: %v", err)
+	}
+	if r.Endpoint != "test-endpoint" {
+		t.Errorf("Expected r.Endpoint to be %v, got %v", "test-endpoint", r.Endpoint)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addRegion" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addRegion: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addRegion: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addRegion: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addRegion", params, `<addregionresponse><endpoint>test-endpoint</endpoint><gslbserviceenabled>true</gslbserviceenabled><id>1</id><name>test-name</name><portableipserviceenabled>true</portableipserviceenabled></addregionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Region.AddRegion(p)
+	if err != nil {
+		t.Fatalf("Failed to call addRegion using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListRegions(t *testing.T) {
+	params := url.Values{
+		"id":       {"1"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+	}
+	cs, done := newTestClient(t, "listRegions", params, `{"listregionsresponse":{"count":1,"region":[{"endpoint":"test-endpoint","gslbserviceenabled":true,"id":1,"name":"test-name","portableipserviceenabled":true}]}}`)
+	defer done()
+
+	p := cs.Region.NewListRegionsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId(1)
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	checkURLValues(t, "listRegions", p.URLValues(), params)
+
+	r, err := cs.Region.ListRegions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listRegions: %v", err)
+	}
+	if r.Regions[0].Endpoint != "test-endpoint" {
+		t.Errorf("Expected r.Regions[0].Endpoint to be %v, got %v", "test-endpoint", r.Regions[0].Endpoint)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listRegions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listRegions: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listRegions: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listRegions: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Region
+	count, err := cs.Region.StreamRegions(context.Background(), p, func(v *Region) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listRegions: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Regions) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Regions)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listRegions", params, `<listregionsresponse><count>1</count><region><endpoint>test-endpoint</endpoint><gslbserviceenabled>true</gslbserviceenabled><id>1</id><name>test-name</name><portableipserviceenabled>true</portableipserviceenabled></region></listregionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Region.ListRegions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listRegions using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Region.StreamRegions(context.Background(), p, func(v *Region) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listRegions using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Regions) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Regions)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveRegion(t *testing.T) {
+	params := url.Values{
+		"id": {"1"},
+	}
+	cs, done := newTestClient(t, "removeRegion", params, `{"removeregionresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Region.NewRemoveRegionParams(1)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId(1)
+	checkURLValues(t, "removeRegion", p.URLValues(), params)
+
+	r, err := cs.Region.RemoveRegion(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeRegion: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeRegion" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeRegion: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeRegion: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeRegion: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeRegion", params, `<removeregionresponse><displaytext>test-displaytext</displaytext><success>true</success></removeregionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Region.RemoveRegion(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeRegion using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateRegion(t *testing.T) {
+	params := url.Values{
+		"endpoint": {"test-endpoint"},
+		"id":       {"2"},
+		"name":     {"test-name"},
+	}
+	cs, done := newTestClient(t, "updateRegion", params, `{"updateregionresponse":{"endpoint":"test-endpoint","gslbserviceenabled":true,"id":1,"name":"test-name","portableipserviceenabled":true}}`)
+	defer done()
+
+	p := cs.Region.NewUpdateRegionParams(2)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEndpoint("test-endpoint")
+	p.SetId(2)
+	p.SetName("test-name")
+	checkURLValues(t, "updateRegion", p.URLValues(), params)
+
+	r, err := cs.Region.UpdateRegion(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateRegion: %v", err)
+	}
+	if r.Endpoint != "test-endpoint" {
+		t.Errorf("Expected r.Endpoint to be %v, got %v", "test-endpoint", r.Endpoint)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateRegion" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateRegion: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateRegion: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateRegion: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateRegion", params, `<updateregionresponse><endpoint>test-endpoint</endpoint><gslbserviceenabled>true</gslbserviceenabled><id>1</id><name>test-name</name><portableipserviceenabled>true</portableipserviceenabled></updateregionresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Region.UpdateRegion(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateRegion using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
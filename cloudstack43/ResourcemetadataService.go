@@ -17,174 +17,171 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type ResourcemetadataService struct {
+	cs *CloudStackClient
+}
+
+func NewResourcemetadataService(cs *CloudStackClient) *ResourcemetadataService {
+	return &ResourcemetadataService{cs: cs}
+}
+
 type AddResourceDetailParams struct {
-	p map[string]interface{}
+	Details      map[string]string   `json:"details,omitempty" yaml:"details,omitempty"`
+	Resourceid   *string             `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *TaggedResourceType `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
 }
 
-func (p *AddResourceDetailParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddResourceDetailParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
+	if v := p.Details; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("details[%d].key", i), k)
 			u.Set(fmt.Sprintf("details[%d].value", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if v := p.Resourcetype; v != nil {
+		u.Set("resourcetype", string(*v))
 	}
 	return u
 }
 
-func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddResourceDetailParams) Command() string {
+	return "addResourceDetail"
 }
 
-func (p *AddResourceDetailParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AddResourceDetailParams) IsAsync() bool {
+	return true
 }
 
-func (p *AddResourceDetailParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddResourceDetailParams) ResponseType() interface{} {
+	return &AddResourceDetailResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddResourceDetailParams) Validate() error {
+	v := &validator{api: "addResourceDetail"}
+	v.required("details", p.Details != nil)
+	v.required("resourceid", p.Resourceid != nil)
+	v.required("resourcetype", p.Resourcetype != nil)
+	if p.Resourcetype != nil {
+		v.enum("resourcetype", string(*p.Resourcetype), p.Resourcetype.Known(), false)
 	}
-	p.p["resourcetype"] = v
+	return v.err()
+}
+
+// This param is required.
+func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
+	p.Details = v
 	return
 }
 
-// You should always use this function to get a new AddResourceDetailParams instance,
-// as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams {
-	p := &AddResourceDetailParams{}
-	p.p = make(map[string]interface{})
-	p.p["details"] = details
-	p.p["resourceid"] = resourceid
-	p.p["resourcetype"] = resourcetype
-	return p
+func (p *AddResourceDetailParams) GetDetails() map[string]string {
+	return p.Details
 }
 
-// Adds detail for the Resource.
-func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("addResourceDetail", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddResourceDetailParams) HasDetails() bool {
+	return p.Details != nil
+}
 
-	var r AddResourceDetailResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *AddResourceDetailParams) ResetDetails() {
+	p.Details = nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+// This param is required.
+func (p *AddResourceDetailParams) SetResourceid(v string) {
+	p.Resourceid = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *AddResourceDetailParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Resourceid
 }
 
-type AddResourceDetailResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *AddResourceDetailParams) HasResourceid() bool {
+	return p.Resourceid != nil
 }
 
-type RemoveResourceDetailParams struct {
-	p map[string]interface{}
+func (p *AddResourceDetailParams) ResetResourceid() {
+	p.Resourceid = nil
 }
 
-func (p *RemoveResourceDetailParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
-	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
-	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
-	}
-	return u
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume. This param is required.
+func (p *AddResourceDetailParams) SetResourcetype(v TaggedResourceType) {
+	p.Resourcetype = &v
+	return
 }
 
-func (p *RemoveResourceDetailParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddResourceDetailParams) GetResourcetype() TaggedResourceType {
+	if p.Resourcetype == nil {
+		return ""
 	}
-	p.p["key"] = v
-	return
+	return *p.Resourcetype
 }
 
-func (p *RemoveResourceDetailParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourceid"] = v
-	return
+func (p *AddResourceDetailParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
 }
 
-func (p *RemoveResourceDetailParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["resourcetype"] = v
-	return
+func (p *AddResourceDetailParams) ResetResourcetype() {
+	p.Resourcetype = nil
 }
 
-// You should always use this function to get a new RemoveResourceDetailParams instance,
+// You should always use this function to get a new AddResourceDetailParams instance,
 // as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams {
-	p := &RemoveResourceDetailParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceid"] = resourceid
-	p.p["resourcetype"] = resourcetype
+//
+// The required params are:
+//
+//   - details
+//   - resourceid
+//   - resourcetype: the type of the resource, like UserVm or Volume
+func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype TaggedResourceType) *AddResourceDetailParams {
+	p := &AddResourceDetailParams{}
+	p.SetDetails(details)
+	p.SetResourceid(resourceid)
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
-// Removes detail for the Resource.
-func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
-	resp, err := s.cs.newRequest("removeResourceDetail", p.toURLValues())
+// Adds detail for the Resource.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListResourceDetails.
+func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addResourceDetail", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RemoveResourceDetailResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddResourceDetailResponse
+	if err := s.cs.unmarshal("addResourceDetail", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -200,206 +197,486 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addResourceDetail", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RemoveResourceDetailResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type AddResourceDetailResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListResourceDetailsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListResourceDetailsParams) toURLValues() url.Values {
+	Account      *string             `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string             `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay   *bool               `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Isrecursive  *bool               `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Key          *string             `json:"key,omitempty" yaml:"key,omitempty"`
+	Keyword      *string             `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool               `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page         *int                `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int                `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string             `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourceid   *string             `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *TaggedResourceType `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListResourceDetailsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
+	if v := p.Key; v != nil {
+		u.Set("key", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["resourceid"]; found {
-		u.Set("resourceid", v.(string))
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
 	}
-	if v, found := p.p["resourcetype"]; found {
-		u.Set("resourcetype", v.(string))
+	if v := p.Resourcetype; v != nil {
+		u.Set("resourcetype", string(*v))
 	}
 	return u
 }
 
-func (p *ListResourceDetailsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *ListResourceDetailsParams) Command() string {
+	return "listResourceDetails"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListResourceDetailsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListResourceDetailsParams) ResponseType() interface{} {
+	return &ListResourceDetailsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListResourceDetailsParams) Validate() error {
+	v := &validator{api: "listResourceDetails"}
+	v.required("resourceid", p.Resourceid != nil)
+	v.required("resourcetype", p.Resourcetype != nil)
+	if p.Resourcetype != nil {
+		v.enum("resourcetype", string(*p.Resourcetype), p.Resourcetype.Known(), false)
 	}
-	p.p["account"] = v
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListResourceDetailsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *ListResourceDetailsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListResourceDetailsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListResourceDetailsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *ListResourceDetailsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListResourceDetailsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *ListResourceDetailsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["isrecursive"] = v
+	return *p.Fordisplay
+}
+
+func (p *ListResourceDetailsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListResourceDetailsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListResourceDetailsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["key"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListResourceDetailsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListResourceDetailsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+func (p *ListResourceDetailsParams) SetKey(v string) {
+	p.Key = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetKey() string {
+	if p.Key == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Key
+}
+
+func (p *ListResourceDetailsParams) HasKey() bool {
+	return p.Key != nil
+}
+
+func (p *ListResourceDetailsParams) ResetKey() {
+	p.Key = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListResourceDetailsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListResourceDetailsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListResourceDetailsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListResourceDetailsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["page"] = v
+	return *p.Listall
+}
+
+func (p *ListResourceDetailsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListResourceDetailsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListResourceDetailsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListResourceDetailsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListResourceDetailsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListResourceDetailsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["projectid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListResourceDetailsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListResourceDetailsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListResourceDetailsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetResourceid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["resourceid"] = v
+	return *p.Projectid
+}
+
+func (p *ListResourceDetailsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListResourceDetailsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// This param is required.
+func (p *ListResourceDetailsParams) SetResourceid(v string) {
+	p.Resourceid = &v
 	return
 }
 
-func (p *ListResourceDetailsParams) SetResourcetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListResourceDetailsParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
 	}
-	p.p["resourcetype"] = v
+	return *p.Resourceid
+}
+
+func (p *ListResourceDetailsParams) HasResourceid() bool {
+	return p.Resourceid != nil
+}
+
+func (p *ListResourceDetailsParams) ResetResourceid() {
+	p.Resourceid = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume. This param is required.
+func (p *ListResourceDetailsParams) SetResourcetype(v TaggedResourceType) {
+	p.Resourcetype = &v
 	return
 }
 
+func (p *ListResourceDetailsParams) GetResourcetype() TaggedResourceType {
+	if p.Resourcetype == nil {
+		return ""
+	}
+	return *p.Resourcetype
+}
+
+func (p *ListResourceDetailsParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *ListResourceDetailsParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
 // You should always use this function to get a new ListResourceDetailsParams instance,
 // as then you are sure you have configured all required params
-func (s *ResourcemetadataService) NewListResourceDetailsParams(resourceid string, resourcetype string) *ListResourceDetailsParams {
+//
+// The required params are:
+//
+//   - resourceid
+//   - resourcetype: the type of the resource, like UserVm or Volume
+func (s *ResourcemetadataService) NewListResourceDetailsParams(resourceid string, resourcetype TaggedResourceType) *ListResourceDetailsParams {
 	p := &ListResourceDetailsParams{}
-	p.p = make(map[string]interface{})
-	p.p["resourceid"] = resourceid
-	p.p["resourcetype"] = resourcetype
+	p.SetResourceid(resourceid)
+	p.SetResourcetype(resourcetype)
 	return p
 }
 
 // List resource detail(s)
 func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceDetails", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listResourceDetails", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListResourceDetailsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listResourceDetails", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListResourceDetails, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ResourcemetadataService) StreamResourceDetails(ctx context.Context, p *ListResourceDetailsParams, fn func(*ResourceDetail) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listResourceDetails", p.URLValues(), "resourcedetail", func(b json.RawMessage) error {
+		var v ResourceDetail
+		if err := s.cs.unmarshalElement("listResourceDetails", "resourcedetail", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListResourceDetailsResponse struct {
-	Count           int               `json:"count"`
-	ResourceDetails []*ResourceDetail `json:"resourcedetail"`
+	Count           int                        `json:"count" xml:"count"`
+	ResourceDetails []*ResourceDetail          `json:"resourcedetail" xml:"resourcedetail"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ResourceDetail struct {
-	Account      string `json:"account,omitempty"`
-	Customer     string `json:"customer,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Key          string `json:"key,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Resourceid   string `json:"resourceid,omitempty"`
-	Resourcetype string `json:"resourcetype,omitempty"`
-	Value        string `json:"value,omitempty"`
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Customer     string                     `json:"customer,omitempty" xml:"customer,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Key          string                     `json:"key,omitempty" xml:"key,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Resourceid   string                     `json:"resourceid,omitempty" xml:"resourceid,omitempty"`
+	Resourcetype TaggedResourceType         `json:"resourcetype,omitempty" xml:"resourcetype,omitempty"`
+	Value        string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RemoveResourceDetailParams = cloudstackcommon.RemoveResourceDetailParams
+type RemoveResourceDetailResponse = cloudstackcommon.RemoveResourceDetailResponse
+
+// You should always use this function to get a new RemoveResourceDetailParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - resourceid
+//   - resourcetype: the type of the resource, like UserVm or Volume
+func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid string, resourcetype TaggedResourceType) *RemoveResourceDetailParams {
+	p := &RemoveResourceDetailParams{}
+	p.SetResourceid(resourceid)
+	p.SetResourcetype(resourcetype)
+	return p
+}
+
+// Removes detail for the Resource.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListResourceDetails.
+func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeResourceDetail", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RemoveResourceDetailResponse
+	if err := s.cs.unmarshal("removeResourceDetail", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("removeResourceDetail", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
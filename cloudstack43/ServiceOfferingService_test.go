@@ -0,0 +1,346 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateServiceOffering(t *testing.T) {
+	params := url.Values{
+		"bytesreadrate":     {"1"},
+		"byteswriterate":    {"2"},
+		"cpunumber":         {"3"},
+		"cpuspeed":          {"4"},
+		"deploymentplanner": {"test-deploymentplanner"},
+		"displaytext":       {"test-displaytext"},
+		"domainid":          {"test-domainid"},
+		"hosttags":          {"test-hosttags"},
+		"iopsreadrate":      {"9"},
+		"iopswriterate":     {"10"},
+		"issystem":          {"true"},
+		"isvolatile":        {"true"},
+		"limitcpuuse":       {"true"},
+		"memory":            {"14"},
+		"name":              {"test-name"},
+		"networkrate":       {"16"},
+		"offerha":           {"true"},
+		"serviceofferingdetails[0].test-serviceofferingdetailskey": {"test-serviceofferingdetailsvalue"},
+		"storagetype":  {"test-storagetype"},
+		"systemvmtype": {"test-systemvmtype"},
+		"tags":         {"test-tags"},
+	}
+	cs, done := newTestClient(t, "createServiceOffering", params, `{"createserviceofferingresponse":{"cpunumber":1,"cpuspeed":1,"created":"2014-01-02T15:04:05+0100","defaultuse":true,"deploymentplanner":"test-deploymentplanner","diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","hosttags":"test-hosttags","id":"test-id","iscustomized":true,"issystem":true,"isvolatile":true,"limitcpuuse":true,"memory":1,"name":"test-name","networkrate":1,"offerha":true,"serviceofferingdetails":{"key":"value"},"storagetype":"test-storagetype","systemvmtype":"test-systemvmtype","tags":"test-tags"}}`)
+	defer done()
+
+	p := cs.ServiceOffering.NewCreateServiceOfferingParams("test-displaytext", "test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBytesreadrate(1)
+	p.SetByteswriterate(2)
+	p.SetCpunumber(3)
+	p.SetCpuspeed(4)
+	p.SetDeploymentplanner("test-deploymentplanner")
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetHosttags("test-hosttags")
+	p.SetIopsreadrate(9)
+	p.SetIopswriterate(10)
+	p.SetIssystem(true)
+	p.SetIsvolatile(true)
+	p.SetLimitcpuuse(true)
+	p.SetMemory(14)
+	p.SetName("test-name")
+	p.SetNetworkrate(16)
+	p.SetOfferha(true)
+	p.SetServiceofferingdetails(map[string]string{"test-serviceofferingdetailskey": "test-serviceofferingdetailsvalue"})
+	p.SetStoragetype("test-storagetype")
+	p.SetSystemvmtype("test-systemvmtype")
+	p.SetTags("test-tags")
+	checkURLValues(t, "createServiceOffering", p.URLValues(), params)
+
+	r, err := cs.ServiceOffering.CreateServiceOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createServiceOffering: %v", err)
+	}
+	if r.Deploymentplanner != "test-deploymentplanner" {
+		t.Errorf("Expected r.Deploymentplanner to be %v, got %v", "test-deploymentplanner", r.Deploymentplanner)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createServiceOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createServiceOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createServiceOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createServiceOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createServiceOffering", params, `<createserviceofferingresponse><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><created>2014-01-02T15:04:05+0100</created><defaultuse>true</defaultuse><deploymentplanner>test-deploymentplanner</deploymentplanner><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><hosttags>test-hosttags</hosttags><id>test-id</id><iscustomized>true</iscustomized><issystem>true</issystem><isvolatile>true</isvolatile><limitcpuuse>true</limitcpuuse><memory>1</memory><name>test-name</name><networkrate>1</networkrate><offerha>true</offerha><serviceofferingdetails><key>value</key></serviceofferingdetails><storagetype>test-storagetype</storagetype><systemvmtype>test-systemvmtype</systemvmtype><tags>test-tags</tags></createserviceofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ServiceOffering.CreateServiceOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call createServiceOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteServiceOffering(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteServiceOffering", params, `{"deleteserviceofferingresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ServiceOffering.NewDeleteServiceOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteServiceOffering", p.URLValues(), params)
+
+	r, err := cs.ServiceOffering.DeleteServiceOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteServiceOffering: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteServiceOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteServiceOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteServiceOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteServiceOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteServiceOffering", params, `<deleteserviceofferingresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteserviceofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ServiceOffering.DeleteServiceOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteServiceOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListServiceOfferings(t *testing.T) {
+	params := url.Values{
+		"domainid":         {"test-domainid"},
+		"id":               {"test-id"},
+		"issystem":         {"true"},
+		"keyword":          {"test-keyword"},
+		"name":             {"test-name"},
+		"page":             {"6"},
+		"pagesize":         {"7"},
+		"systemvmtype":     {"test-systemvmtype"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "listServiceOfferings", params, `{"listserviceofferingsresponse":{"count":1,"serviceoffering":[{"cpunumber":1,"cpuspeed":1,"created":"2014-01-02T15:04:05+0100","defaultuse":true,"deploymentplanner":"test-deploymentplanner","diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","hosttags":"test-hosttags","id":"test-id","iscustomized":true,"issystem":true,"isvolatile":true,"limitcpuuse":true,"memory":1,"name":"test-name","networkrate":1,"offerha":true,"serviceofferingdetails":{"key":"value"},"storagetype":"test-storagetype","systemvmtype":"test-systemvmtype","tags":"test-tags"}]}}`)
+	defer done()
+
+	p := cs.ServiceOffering.NewListServiceOfferingsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIssystem(true)
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetSystemvmtype("test-systemvmtype")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "listServiceOfferings", p.URLValues(), params)
+
+	r, err := cs.ServiceOffering.ListServiceOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listServiceOfferings: %v", err)
+	}
+	if r.ServiceOfferings[0].Deploymentplanner != "test-deploymentplanner" {
+		t.Errorf("Expected r.ServiceOfferings[0].Deploymentplanner to be %v, got %v", "test-deploymentplanner", r.ServiceOfferings[0].Deploymentplanner)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listServiceOfferings" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listServiceOfferings: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listServiceOfferings: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listServiceOfferings: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ServiceOffering
+	count, err := cs.ServiceOffering.StreamServiceOfferings(context.Background(), p, func(v *ServiceOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listServiceOfferings: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ServiceOfferings) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ServiceOfferings)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listServiceOfferings", params, `<listserviceofferingsresponse><count>1</count><serviceoffering><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><created>2014-01-02T15:04:05+0100</created><defaultuse>true</defaultuse><deploymentplanner>test-deploymentplanner</deploymentplanner><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><hosttags>test-hosttags</hosttags><id>test-id</id><iscustomized>true</iscustomized><issystem>true</issystem><isvolatile>true</isvolatile><limitcpuuse>true</limitcpuuse><memory>1</memory><name>test-name</name><networkrate>1</networkrate><offerha>true</offerha><serviceofferingdetails><key>value</key></serviceofferingdetails><storagetype>test-storagetype</storagetype><systemvmtype>test-systemvmtype</systemvmtype><tags>test-tags</tags></serviceoffering></listserviceofferingsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ServiceOffering.ListServiceOfferings(p)
+	if err != nil {
+		t.Fatalf("Failed to call listServiceOfferings using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ServiceOffering.StreamServiceOfferings(context.Background(), p, func(v *ServiceOffering) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listServiceOfferings using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ServiceOfferings) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ServiceOfferings)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateServiceOffering(t *testing.T) {
+	params := url.Values{
+		"displaytext": {"test-displaytext"},
+		"id":          {"test-id"},
+		"name":        {"test-name"},
+		"sortkey":     {"4"},
+	}
+	cs, done := newTestClient(t, "updateServiceOffering", params, `{"updateserviceofferingresponse":{"cpunumber":1,"cpuspeed":1,"created":"2014-01-02T15:04:05+0100","defaultuse":true,"deploymentplanner":"test-deploymentplanner","diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","hosttags":"test-hosttags","id":"test-id","iscustomized":true,"issystem":true,"isvolatile":true,"limitcpuuse":true,"memory":1,"name":"test-name","networkrate":1,"offerha":true,"serviceofferingdetails":{"key":"value"},"storagetype":"test-storagetype","systemvmtype":"test-systemvmtype","tags":"test-tags"}}`)
+	defer done()
+
+	p := cs.ServiceOffering.NewUpdateServiceOfferingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDisplaytext("test-displaytext")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetSortkey(4)
+	checkURLValues(t, "updateServiceOffering", p.URLValues(), params)
+
+	r, err := cs.ServiceOffering.UpdateServiceOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateServiceOffering: %v", err)
+	}
+	if r.Deploymentplanner != "test-deploymentplanner" {
+		t.Errorf("Expected r.Deploymentplanner to be %v, got %v", "test-deploymentplanner", r.Deploymentplanner)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateServiceOffering" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateServiceOffering: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateServiceOffering: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateServiceOffering: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateServiceOffering", params, `<updateserviceofferingresponse><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><created>2014-01-02T15:04:05+0100</created><defaultuse>true</defaultuse><deploymentplanner>test-deploymentplanner</deploymentplanner><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><hosttags>test-hosttags</hosttags><id>test-id</id><iscustomized>true</iscustomized><issystem>true</issystem><isvolatile>true</isvolatile><limitcpuuse>true</limitcpuuse><memory>1</memory><name>test-name</name><networkrate>1</networkrate><offerha>true</offerha><serviceofferingdetails><key>value</key></serviceofferingdetails><storagetype>test-storagetype</storagetype><systemvmtype>test-systemvmtype</systemvmtype><tags>test-tags</tags></updateserviceofferingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ServiceOffering.UpdateServiceOffering(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateServiceOffering using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
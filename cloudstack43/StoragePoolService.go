@@ -17,143 +17,107 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
-	"strconv"
-)
 
-type ListStorageProvidersParams struct {
-	p map[string]interface{}
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *ListStorageProvidersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
-	}
-	return u
+type StoragePoolService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListStorageProvidersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func NewStoragePoolService(cs *CloudStackClient) *StoragePoolService {
+	return &StoragePoolService{cs: cs}
 }
 
-func (p *ListStorageProvidersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+type CancelStorageMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *ListStorageProvidersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CancelStorageMaintenanceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	p.p["pagesize"] = v
-	return
+	return u
 }
 
-func (p *ListStorageProvidersParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagePoolType"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CancelStorageMaintenanceParams) Command() string {
+	return "cancelStorageMaintenance"
 }
 
-// You should always use this function to get a new ListStorageProvidersParams instance,
-// as then you are sure you have configured all required params
-func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams {
-	p := &ListStorageProvidersParams{}
-	p.p = make(map[string]interface{})
-	p.p["storagePoolType"] = storagePoolType
-	return p
+// Returns true if the API command starts an async job
+func (p *CancelStorageMaintenanceParams) IsAsync() bool {
+	return true
 }
 
-// Lists storage providers.
-func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listStorageProviders", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListStorageProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CancelStorageMaintenanceParams) ResponseType() interface{} {
+	return &CancelStorageMaintenanceResponse{}
 }
 
-type ListStorageProvidersResponse struct {
-	Count            int                `json:"count"`
-	StorageProviders []*StorageProvider `json:"storageprovider"`
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CancelStorageMaintenanceParams) Validate() error {
+	v := &validator{api: "cancelStorageMaintenance"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-type StorageProvider struct {
-	Name string `json:"name,omitempty"`
-	Type string `json:"type,omitempty"`
+// This param is required.
+func (p *CancelStorageMaintenanceParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-type EnableStorageMaintenanceParams struct {
-	p map[string]interface{}
+func (p *CancelStorageMaintenanceParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
 }
 
-func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *CancelStorageMaintenanceParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *EnableStorageMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CancelStorageMaintenanceParams) ResetId() {
+	p.Id = nil
 }
 
-// You should always use this function to get a new EnableStorageMaintenanceParams instance,
+// You should always use this function to get a new CancelStorageMaintenanceParams instance,
 // as then you are sure you have configured all required params
-func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams {
-	p := &EnableStorageMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams {
+	p := &CancelStorageMaintenanceParams{}
+	p.SetId(id)
 	return p
 }
 
-// Puts storage pool into maintenance state
-func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("enableStorageMaintenance", p.toURLValues())
+// Cancels maintenance for primary storage
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("cancelStorageMaintenance", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r EnableStorageMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CancelStorageMaintenanceResponse
+	if err := s.cs.unmarshal("cancelStorageMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -174,80 +138,126 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("cancelStorageMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type EnableStorageMaintenanceResponse struct {
-	JobID                string            `json:"jobid,omitempty"`
-	Capacityiops         int64             `json:"capacityiops,omitempty"`
-	Clusterid            string            `json:"clusterid,omitempty"`
-	Clustername          string            `json:"clustername,omitempty"`
-	Created              string            `json:"created,omitempty"`
-	Disksizeallocated    int64             `json:"disksizeallocated,omitempty"`
-	Disksizetotal        int64             `json:"disksizetotal,omitempty"`
-	Disksizeused         int64             `json:"disksizeused,omitempty"`
-	Hypervisor           string            `json:"hypervisor,omitempty"`
-	Id                   string            `json:"id,omitempty"`
-	Ipaddress            string            `json:"ipaddress,omitempty"`
-	Name                 string            `json:"name,omitempty"`
-	Path                 string            `json:"path,omitempty"`
-	Podid                string            `json:"podid,omitempty"`
-	Podname              string            `json:"podname,omitempty"`
-	Scope                string            `json:"scope,omitempty"`
-	State                string            `json:"state,omitempty"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities,omitempty"`
-	Suitableformigration bool              `json:"suitableformigration,omitempty"`
-	Tags                 string            `json:"tags,omitempty"`
-	Type                 string            `json:"type,omitempty"`
-	Zoneid               string            `json:"zoneid,omitempty"`
-	Zonename             string            `json:"zonename,omitempty"`
+type CancelStorageMaintenanceResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Capacityiops         int64                      `json:"capacityiops,omitempty" xml:"capacityiops,omitempty"`
+	Clusterid            string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername          string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disksizeallocated    Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal        Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Disksizeused         Size                       `json:"disksizeused,omitempty" xml:"disksizeused,omitempty"`
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress            string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                 string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname              string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Scope                string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities,omitempty" xml:"storagecapabilities,omitempty"`
+	Suitableformigration Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                 string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type CancelStorageMaintenanceParams struct {
-	p map[string]interface{}
+type EnableStorageMaintenanceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *EnableStorageMaintenanceParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *CancelStorageMaintenanceParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *EnableStorageMaintenanceParams) Command() string {
+	return "enableStorageMaintenance"
+}
+
+// Returns true if the API command starts an async job
+func (p *EnableStorageMaintenanceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *EnableStorageMaintenanceParams) ResponseType() interface{} {
+	return &EnableStorageMaintenanceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *EnableStorageMaintenanceParams) Validate() error {
+	v := &validator{api: "enableStorageMaintenance"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *EnableStorageMaintenanceParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new CancelStorageMaintenanceParams instance,
+func (p *EnableStorageMaintenanceParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *EnableStorageMaintenanceParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *EnableStorageMaintenanceParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new EnableStorageMaintenanceParams instance,
 // as then you are sure you have configured all required params
-func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams {
-	p := &CancelStorageMaintenanceParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams {
+	p := &EnableStorageMaintenanceParams{}
+	p.SetId(id)
 	return p
 }
 
-// Cancels maintenance for primary storage
-func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newRequest("cancelStorageMaintenance", p.toURLValues())
+// Puts storage pool into maintenance state
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("enableStorageMaintenance", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CancelStorageMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r EnableStorageMaintenanceResponse
+	if err := s.cs.unmarshal("enableStorageMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -268,35 +278,91 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("enableStorageMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CancelStorageMaintenanceResponse struct {
-	JobID                string            `json:"jobid,omitempty"`
-	Capacityiops         int64             `json:"capacityiops,omitempty"`
-	Clusterid            string            `json:"clusterid,omitempty"`
-	Clustername          string            `json:"clustername,omitempty"`
-	Created              string            `json:"created,omitempty"`
-	Disksizeallocated    int64             `json:"disksizeallocated,omitempty"`
-	Disksizetotal        int64             `json:"disksizetotal,omitempty"`
-	Disksizeused         int64             `json:"disksizeused,omitempty"`
-	Hypervisor           string            `json:"hypervisor,omitempty"`
-	Id                   string            `json:"id,omitempty"`
-	Ipaddress            string            `json:"ipaddress,omitempty"`
-	Name                 string            `json:"name,omitempty"`
-	Path                 string            `json:"path,omitempty"`
-	Podid                string            `json:"podid,omitempty"`
-	Podname              string            `json:"podname,omitempty"`
-	Scope                string            `json:"scope,omitempty"`
-	State                string            `json:"state,omitempty"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities,omitempty"`
-	Suitableformigration bool              `json:"suitableformigration,omitempty"`
-	Tags                 string            `json:"tags,omitempty"`
-	Type                 string            `json:"type,omitempty"`
-	Zoneid               string            `json:"zoneid,omitempty"`
-	Zonename             string            `json:"zonename,omitempty"`
+type EnableStorageMaintenanceResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Capacityiops         int64                      `json:"capacityiops,omitempty" xml:"capacityiops,omitempty"`
+	Clusterid            string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername          string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disksizeallocated    Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal        Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Disksizeused         Size                       `json:"disksizeused,omitempty" xml:"disksizeused,omitempty"`
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress            string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                 string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname              string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Scope                string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities,omitempty" xml:"storagecapabilities,omitempty"`
+	Suitableformigration Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                 string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListStorageProvidersParams = cloudstackcommon.ListStorageProvidersParams
+type ListStorageProvidersResponse = cloudstackcommon.ListStorageProvidersResponse
+type StorageProvider = cloudstackcommon.StorageProvider
+
+// You should always use this function to get a new ListStorageProvidersParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - type
+func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams {
+	p := &ListStorageProvidersParams{}
+	p.SetType(storagePoolType)
+	return p
+}
+
+// Lists storage providers.
+func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listStorageProviders", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListStorageProvidersResponse
+	if err := s.cs.unmarshal("listStorageProviders", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListStorageProviders, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *StoragePoolService) StreamStorageProviders(ctx context.Context, p *ListStorageProvidersParams, fn func(*StorageProvider) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listStorageProviders", p.URLValues(), "storageprovider", func(b json.RawMessage) error {
+		var v StorageProvider
+		if err := s.cs.unmarshalElement("listStorageProviders", "storageprovider", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
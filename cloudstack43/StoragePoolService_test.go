@@ -0,0 +1,238 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCancelStorageMaintenance(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "cancelStorageMaintenance", params, `{"cancelstoragemaintenanceresponse":{"capacityiops":1,"clusterid":"test-clusterid","clustername":"test-clustername","created":"2014-01-02T15:04:05+0100","disksizeallocated":1,"disksizetotal":1,"disksizeused":1,"hypervisor":"test-hypervisor","id":"test-id","ipaddress":"test-ipaddress","jobid":"test-jobid","name":"test-name","path":"test-path","podid":"test-podid","podname":"test-podname","scope":"test-scope","state":"test-state","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"test-tags","type":"test-type","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.StoragePool.NewCancelStorageMaintenanceParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "cancelStorageMaintenance", p.URLValues(), params)
+
+	r, err := cs.StoragePool.CancelStorageMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call cancelStorageMaintenance: %v", err)
+	}
+	if r.Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Clusterid to be %v, got %v", "test-clusterid", r.Clusterid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "cancelStorageMaintenance" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute cancelStorageMaintenance: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of cancelStorageMaintenance: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of cancelStorageMaintenance: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "cancelStorageMaintenance", params, `<cancelstoragemaintenanceresponse><capacityiops>1</capacityiops><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><created>2014-01-02T15:04:05+0100</created><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><disksizeused>1</disksizeused><hypervisor>test-hypervisor</hypervisor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><name>test-name</name><path>test-path</path><podid>test-podid</podid><podname>test-podname</podname><scope>test-scope</scope><state>test-state</state><storagecapabilities><key>value</key></storagecapabilities><suitableformigration>true</suitableformigration><tags>test-tags</tags><type>test-type</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></cancelstoragemaintenanceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.StoragePool.CancelStorageMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call cancelStorageMaintenance using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestEnableStorageMaintenance(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "enableStorageMaintenance", params, `{"enablestoragemaintenanceresponse":{"capacityiops":1,"clusterid":"test-clusterid","clustername":"test-clustername","created":"2014-01-02T15:04:05+0100","disksizeallocated":1,"disksizetotal":1,"disksizeused":1,"hypervisor":"test-hypervisor","id":"test-id","ipaddress":"test-ipaddress","jobid":"test-jobid","name":"test-name","path":"test-path","podid":"test-podid","podname":"test-podname","scope":"test-scope","state":"test-state","storagecapabilities":{"key":"value"},"suitableformigration":true,"tags":"test-tags","type":"test-type","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.StoragePool.NewEnableStorageMaintenanceParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "enableStorageMaintenance", p.URLValues(), params)
+
+	r, err := cs.StoragePool.EnableStorageMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableStorageMaintenance: %v", err)
+	}
+	if r.Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Clusterid to be %v, got %v", "test-clusterid", r.Clusterid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "enableStorageMaintenance" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute enableStorageMaintenance: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of enableStorageMaintenance: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of enableStorageMaintenance: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "enableStorageMaintenance", params, `<enablestoragemaintenanceresponse><capacityiops>1</capacityiops><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><created>2014-01-02T15:04:05+0100</created><disksizeallocated>1</disksizeallocated><disksizetotal>1</disksizetotal><disksizeused>1</disksizeused><hypervisor>test-hypervisor</hypervisor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><jobid>test-jobid</jobid><name>test-name</name><path>test-path</path><podid>test-podid</podid><podname>test-podname</podname><scope>test-scope</scope><state>test-state</state><storagecapabilities><key>value</key></storagecapabilities><suitableformigration>true</suitableformigration><tags>test-tags</tags><type>test-type</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></enablestoragemaintenanceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.StoragePool.EnableStorageMaintenance(p)
+	if err != nil {
+		t.Fatalf("Failed to call enableStorageMaintenance using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListStorageProviders(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"type":     {"test-type"},
+	}
+	cs, done := newTestClient(t, "listStorageProviders", params, `{"liststorageprovidersresponse":{"count":1,"storageprovider":[{"name":"test-name","type":"test-type"}]}}`)
+	defer done()
+
+	p := cs.StoragePool.NewListStorageProvidersParams("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetType("test-type")
+	checkURLValues(t, "listStorageProviders", p.URLValues(), params)
+
+	r, err := cs.StoragePool.ListStorageProviders(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStorageProviders: %v", err)
+	}
+	if r.StorageProviders[0].Name != "test-name" {
+		t.Errorf("Expected r.StorageProviders[0].Name to be %v, got %v", "test-name", r.StorageProviders[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listStorageProviders" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listStorageProviders: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listStorageProviders: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listStorageProviders: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*StorageProvider
+	count, err := cs.StoragePool.StreamStorageProviders(context.Background(), p, func(v *StorageProvider) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listStorageProviders: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.StorageProviders) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.StorageProviders)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listStorageProviders", params, `<liststorageprovidersresponse><count>1</count><storageprovider><name>test-name</name><type>test-type</type></storageprovider></liststorageprovidersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.StoragePool.ListStorageProviders(p)
+	if err != nil {
+		t.Fatalf("Failed to call listStorageProviders using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.StoragePool.StreamStorageProviders(context.Background(), p, func(v *StorageProvider) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listStorageProviders using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.StorageProviders) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.StorageProviders)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
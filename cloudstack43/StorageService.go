@@ -15,3 +15,12 @@
 //
 
 package cloudstack43
+
+// Deprecated: This service has no methods, as its commands are not part of the API details of this version.
+type StorageService struct {
+	cs *CloudStackClient
+}
+
+func NewStorageService(cs *CloudStackClient) *StorageService {
+	return &StorageService{cs: cs}
+}
@@ -0,0 +1,94 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddStratosphereSsp(t *testing.T) {
+	params := url.Values{
+		"name":       {"test-name"},
+		"password":   {"test-password"},
+		"tenantuuid": {"test-tenantuuid"},
+		"url":        {"test-url"},
+		"username":   {"test-username"},
+		"zoneid":     {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addStratosphereSsp", params, `{"addstratospheresspresponse":{"hostid":"test-hostid","name":"test-name","url":"test-url","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.StratosphereSSP.NewAddStratosphereSspParams("test-name", "test-url", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetName("test-name")
+	p.SetPassword("test-password")
+	p.SetTenantuuid("test-tenantuuid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addStratosphereSsp", p.URLValues(), params)
+
+	r, err := cs.StratosphereSSP.AddStratosphereSsp(p)
+	if err != nil {
+		t.Fatalf("Failed to call addStratosphereSsp: %v", err)
+	}
+	if r.Hostid != "test-hostid" {
+		t.Errorf("Expected r.Hostid to be %v, got %v", "test-hostid", r.Hostid)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addStratosphereSsp" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addStratosphereSsp: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addStratosphereSsp: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addStratosphereSsp: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addStratosphereSsp", params, `<addstratospheresspresponse><hostid>test-hostid</hostid><name>test-name</name><url>test-url</url><zoneid>test-zoneid</zoneid></addstratospheresspresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.StratosphereSSP.AddStratosphereSsp(p)
+	if err != nil {
+		t.Fatalf("Failed to call addStratosphereSsp using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,176 +17,83 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
-)
-
-type AddSwiftParams struct {
-	p map[string]interface{}
-}
 
-func (p *AddSwiftParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["key"]; found {
-		u.Set("key", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	return u
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *AddSwiftParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+type SwiftService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddSwiftParams) SetKey(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["key"] = v
-	return
+func NewSwiftService(cs *CloudStackClient) *SwiftService {
+	return &SwiftService{cs: cs}
 }
 
-func (p *AddSwiftParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
-}
-
-func (p *AddSwiftParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["username"] = v
-	return
-}
+type AddSwiftParams = cloudstackcommon.AddSwiftParams
+type AddSwiftResponse = cloudstackcommon.AddSwiftResponse
 
 // You should always use this function to get a new AddSwiftParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - url
 func (s *SwiftService) NewAddSwiftParams(url string) *AddSwiftParams {
 	p := &AddSwiftParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
+	p.SetUrl(url)
 	return p
 }
 
 // Adds Swift.
+//
+// See also ListSwifts, GetSwiftID.
 func (s *SwiftService) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
-	resp, err := s.cs.newRequest("addSwift", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addSwift", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddSwiftResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addSwift", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddSwiftResponse struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
-}
-
-type ListSwiftsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSwiftsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("id", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListSwiftsParams) SetId(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListSwiftsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListSwiftsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListSwiftsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListSwiftsParams = cloudstackcommon.ListSwiftsParams
+type ListSwiftsResponse = cloudstackcommon.ListSwiftsResponse
+type Swift = cloudstackcommon.Swift
 
 // You should always use this function to get a new ListSwiftsParams instance,
 // as then you are sure you have configured all required params
 func (s *SwiftService) NewListSwiftsParams() *ListSwiftsParams {
 	p := &ListSwiftsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SwiftService) GetSwiftID(keyword string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, error) {
 	p := &ListSwiftsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListSwifts(p)
 	if err != nil {
@@ -201,43 +108,66 @@ func (s *SwiftService) GetSwiftID(keyword string) (string, error) {
 		return l.Swifts[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Swifts {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*Swift
+	for _, v := range l.Swifts {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Swift", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // List Swift.
+//
+// See also GetSwiftID.
 func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
-	resp, err := s.cs.newRequest("listSwifts", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSwifts", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSwiftsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listSwifts", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListSwiftsResponse struct {
-	Count  int      `json:"count"`
-	Swifts []*Swift `json:"swift"`
-}
+// Lists the objects like ListSwifts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SwiftService) StreamSwifts(ctx context.Context, p *ListSwiftsParams, fn func(*Swift) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type Swift struct {
-	Details      []string `json:"details,omitempty"`
-	Id           string   `json:"id,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Protocol     string   `json:"protocol,omitempty"`
-	Providername string   `json:"providername,omitempty"`
-	Scope        string   `json:"scope,omitempty"`
-	Url          string   `json:"url,omitempty"`
-	Zoneid       string   `json:"zoneid,omitempty"`
-	Zonename     string   `json:"zonename,omitempty"`
+	return s.cs.streamList(ctx, "listSwifts", p.URLValues(), "swift", func(b json.RawMessage) error {
+		var v Swift
+		if err := s.cs.unmarshalElement("listSwifts", "swift", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -17,363 +17,92 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type StartSystemVmParams struct {
-	p map[string]interface{}
-}
-
-func (p *StartSystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *StartSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new StartSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams {
-	p := &StartSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Starts a system virtual machine.
-func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("startSystemVm", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r StartSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
-}
 
-type StartSystemVmResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-type RebootSystemVmParams struct {
-	p map[string]interface{}
+type SystemVMService struct {
+	cs *CloudStackClient
 }
 
-func (p *RebootSystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func NewSystemVMService(cs *CloudStackClient) *SystemVMService {
+	return &SystemVMService{cs: cs}
 }
 
-func (p *RebootSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type ChangeServiceForSystemVmParams = cloudstackcommon.ChangeServiceForSystemVmParams
+type ChangeServiceForSystemVmResponse = cloudstackcommon.ChangeServiceForSystemVmResponse
 
-// You should always use this function to get a new RebootSystemVmParams instance,
+// You should always use this function to get a new ChangeServiceForSystemVmParams instance,
 // as then you are sure you have configured all required params
-func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmParams {
-	p := &RebootSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+//   - serviceofferingid: the ID of the service offering
+func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams {
+	p := &ChangeServiceForSystemVmParams{}
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Reboots a system VM.
-func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("rebootSystemVm", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r RebootSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+// Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
+func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
-
-type RebootSystemVmResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
-
-type StopSystemVmParams struct {
-	p map[string]interface{}
-}
-
-func (p *StopSystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
 
-func (p *StopSystemVmParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
-	return
-}
-
-func (p *StopSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new StopSystemVmParams instance,
-// as then you are sure you have configured all required params
-func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
-	p := &StopSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Stops a system VM.
-func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("stopSystemVm", p.toURLValues())
+	resp, err := s.cs.newRequest("changeServiceForSystemVm", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r StopSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ChangeServiceForSystemVmResponse
+	if err := s.cs.unmarshal("changeServiceForSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
 	return &r, nil
 }
 
-type StopSystemVmResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
-
-type DestroySystemVmParams struct {
-	p map[string]interface{}
-}
-
-func (p *DestroySystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DestroySystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DestroySystemVmParams = cloudstackcommon.DestroySystemVmParams
+type DestroySystemVmResponse = cloudstackcommon.DestroySystemVmResponse
 
 // You should always use this function to get a new DestroySystemVmParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmParams {
 	p := &DestroySystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Destroyes a system virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSystemVms, GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
 func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
-	resp, err := s.cs.newRequest("destroySystemVm", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("destroySystemVm", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroySystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("destroySystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -394,193 +123,38 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("destroySystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DestroySystemVmResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
-
-type ListSystemVmsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSystemVmsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
-	if v, found := p.p["systemvmtype"]; found {
-		u.Set("systemvmtype", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListSystemVmsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["systemvmtype"] = v
-	return
-}
-
-func (p *ListSystemVmsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListSystemVmsParams = cloudstackcommon.ListSystemVmsParams
+type ListSystemVmsResponse = cloudstackcommon.ListSystemVmsResponse
+type SystemVm = cloudstackcommon.SystemVm
 
 // You should always use this function to get a new ListSystemVmsParams instance,
 // as then you are sure you have configured all required params
 func (s *SystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
 	p := &ListSystemVmsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SystemVMService) GetSystemVmID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListSystemVmsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListSystemVms(p)
 	if err != nil {
@@ -595,24 +169,37 @@ func (s *SystemVMService) GetSystemVmID(name string) (string, error) {
 		return l.SystemVms[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.SystemVms {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*SystemVm
+	for _, v := range l.SystemVms {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "SystemVm", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SystemVMService) GetSystemVmByName(name string) (*SystemVm, int, error) {
-	id, err := s.GetSystemVmID(name)
+func (s *SystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error) {
+	id, err := s.GetSystemVmID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetSystemVmByID(id)
+	r, count, err := s.GetSystemVmByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -620,11 +207,16 @@ func (s *SystemVMService) GetSystemVmByName(name string) (*SystemVm, int, error)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SystemVMService) GetSystemVmByID(id string) (*SystemVm, int, error) {
+func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error) {
 	p := &ListSystemVmsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListSystemVms(p)
 	if err != nil {
@@ -647,107 +239,81 @@ func (s *SystemVMService) GetSystemVmByID(id string) (*SystemVm, int, error) {
 }
 
 // List system virtual machines.
+//
+// See also GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
 func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
-	resp, err := s.cs.newRequest("listSystemVms", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSystemVms", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSystemVmsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listSystemVms", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListSystemVmsResponse struct {
-	Count     int         `json:"count"`
-	SystemVms []*SystemVm `json:"systemvm"`
-}
-
-type SystemVm struct {
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
-
-type MigrateSystemVmParams struct {
-	p map[string]interface{}
-}
-
-func (p *MigrateSystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+// Lists the objects like ListSystemVms, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SystemVMService) StreamSystemVms(ctx context.Context, p *ListSystemVmsParams, fn func(*SystemVm) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
-}
 
-func (p *MigrateSystemVmParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
+	return s.cs.streamList(ctx, "listSystemVms", p.URLValues(), "systemvm", func(b json.RawMessage) error {
+		var v SystemVm
+		if err := s.cs.unmarshalElement("listSystemVms", "systemvm", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
-}
+type MigrateSystemVmParams = cloudstackcommon.MigrateSystemVmParams
+type MigrateSystemVmResponse = cloudstackcommon.MigrateSystemVmResponse
 
 // You should always use this function to get a new MigrateSystemVmParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hostid: the ID of the host
+//   - virtualmachineid: the ID of the virtual machine
 func (s *SystemVMService) NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams {
 	p := &MigrateSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostid"] = hostid
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetHostid(hostid)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Attempts Migration of a system virtual machine to the host specified.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSystemVms, GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
 func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("migrateSystemVm", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("migrateSystemVm", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("migrateSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -768,217 +334,228 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("migrateSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type MigrateSystemVmResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
+type RebootSystemVmParams = cloudstackcommon.RebootSystemVmParams
+type RebootSystemVmResponse = cloudstackcommon.RebootSystemVmResponse
 
-type ChangeServiceForSystemVmParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new RebootSystemVmParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmParams {
+	p := &RebootSystemVmParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+// Reboots a system VM.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSystemVms, GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
+func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
-	}
-	return u
-}
 
-func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("rebootSystemVm", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["details"] = v
-	return
-}
 
-func (p *ChangeServiceForSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r RebootSystemVmResponse
+	if err := s.cs.unmarshal("rebootSystemVm", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("rebootSystemVm", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["serviceofferingid"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new ChangeServiceForSystemVmParams instance,
+type ScaleSystemVmParams = cloudstackcommon.ScaleSystemVmParams
+type ScaleSystemVmResponse = cloudstackcommon.ScaleSystemVmResponse
+
+// You should always use this function to get a new ScaleSystemVmParams instance,
 // as then you are sure you have configured all required params
-func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams {
-	p := &ChangeServiceForSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+//
+// The required params are:
+//
+//   - id
+//   - serviceofferingid: the ID of the service offering
+func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams {
+	p := &ScaleSystemVmParams{}
+	p.SetId(id)
+	p.SetServiceofferingid(serviceofferingid)
 	return p
 }
 
-// Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
-func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("changeServiceForSystemVm", p.toURLValues())
+// Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSystemVms, GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
+func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("scaleSystemVm", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ChangeServiceForSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ScaleSystemVmResponse
+	if err := s.cs.unmarshal("scaleSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("scaleSystemVm", b, &r); err != nil {
+			return nil, err
+		}
+	}
 	return &r, nil
 }
 
-type ChangeServiceForSystemVmResponse struct {
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
+type StartSystemVmParams = cloudstackcommon.StartSystemVmParams
+type StartSystemVmResponse = cloudstackcommon.StartSystemVmResponse
 
-type ScaleSystemVmParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new StartSystemVmParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams {
+	p := &StartSystemVmParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ScaleSystemVmParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+// Starts a system virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSystemVms, GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
+func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
-	}
-	return u
-}
 
-func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("startSystemVm", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["details"] = v
-	return
-}
 
-func (p *ScaleSystemVmParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r StartSystemVmResponse
+	if err := s.cs.unmarshal("startSystemVm", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
-}
 
-func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("startSystemVm", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["serviceofferingid"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new ScaleSystemVmParams instance,
+type StopSystemVmParams = cloudstackcommon.StopSystemVmParams
+type StopSystemVmResponse = cloudstackcommon.StopSystemVmResponse
+
+// You should always use this function to get a new StopSystemVmParams instance,
 // as then you are sure you have configured all required params
-func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams {
-	p := &ScaleSystemVmParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["serviceofferingid"] = serviceofferingid
+//
+// The required params are:
+//
+//   - id
+func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
+	p := &StopSystemVmParams{}
+	p.SetId(id)
 	return p
 }
 
-// Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
-func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
-	resp, err := s.cs.newRequest("scaleSystemVm", p.toURLValues())
+// Stops a system VM.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSystemVms, GetSystemVmID, GetSystemVmByName, GetSystemVmByID.
+func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("stopSystemVm", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ScaleSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r StopSystemVmResponse
+	if err := s.cs.unmarshal("stopSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -999,40 +576,9 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("stopSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type ScaleSystemVmResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Activeviewersessions int    `json:"activeviewersessions,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Dns1                 string `json:"dns1,omitempty"`
-	Dns2                 string `json:"dns2,omitempty"`
-	Gateway              string `json:"gateway,omitempty"`
-	Hostid               string `json:"hostid,omitempty"`
-	Hostname             string `json:"hostname,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Jobid                string `json:"jobid,omitempty"`
-	Jobstatus            int    `json:"jobstatus,omitempty"`
-	Linklocalip          string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress  string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask     string `json:"linklocalnetmask,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Networkdomain        string `json:"networkdomain,omitempty"`
-	Podid                string `json:"podid,omitempty"`
-	Privateip            string `json:"privateip,omitempty"`
-	Privatemacaddress    string `json:"privatemacaddress,omitempty"`
-	Privatenetmask       string `json:"privatenetmask,omitempty"`
-	Publicip             string `json:"publicip,omitempty"`
-	Publicmacaddress     string `json:"publicmacaddress,omitempty"`
-	Publicnetmask        string `json:"publicnetmask,omitempty"`
-	State                string `json:"state,omitempty"`
-	Systemvmtype         string `json:"systemvmtype,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
-	Zonename             string `json:"zonename,omitempty"`
-}
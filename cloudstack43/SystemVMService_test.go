@@ -0,0 +1,566 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChangeServiceForSystemVm(t *testing.T) {
+	params := url.Values{
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"id":                         {"test-id"},
+		"serviceofferingid":          {"test-serviceofferingid"},
+	}
+	cs, done := newTestClient(t, "changeServiceForSystemVm", params, `{"changeserviceforsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewChangeServiceForSystemVmParams("test-id", "test-serviceofferingid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetId("test-id")
+	p.SetServiceofferingid("test-serviceofferingid")
+	checkURLValues(t, "changeServiceForSystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.ChangeServiceForSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call changeServiceForSystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "changeServiceForSystemVm" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute changeServiceForSystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of changeServiceForSystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of changeServiceForSystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "changeServiceForSystemVm", params, `<changeserviceforsystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></changeserviceforsystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.ChangeServiceForSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call changeServiceForSystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDestroySystemVm(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "destroySystemVm", params, `{"destroysystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewDestroySystemVmParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "destroySystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.DestroySystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call destroySystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "destroySystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute destroySystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of destroySystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of destroySystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "destroySystemVm", params, `<destroysystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></destroysystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.DestroySystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call destroySystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSystemVms(t *testing.T) {
+	params := url.Values{
+		"hostid":       {"test-hostid"},
+		"id":           {"test-id"},
+		"keyword":      {"test-keyword"},
+		"name":         {"test-name"},
+		"page":         {"5"},
+		"pagesize":     {"6"},
+		"podid":        {"test-podid"},
+		"state":        {"test-state"},
+		"storageid":    {"test-storageid"},
+		"systemvmtype": {"test-systemvmtype"},
+		"zoneid":       {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listSystemVms", params, `{"listsystemvmsresponse":{"count":1,"systemvm":[{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.SystemVM.NewListSystemVmsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostid("test-hostid")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetPodid("test-podid")
+	p.SetState("test-state")
+	p.SetStorageid("test-storageid")
+	p.SetSystemvmtype("test-systemvmtype")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listSystemVms", p.URLValues(), params)
+
+	r, err := cs.SystemVM.ListSystemVms(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSystemVms: %v", err)
+	}
+	if r.SystemVms[0].Dns1 != "test-dns1" {
+		t.Errorf("Expected r.SystemVms[0].Dns1 to be %v, got %v", "test-dns1", r.SystemVms[0].Dns1)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSystemVms" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSystemVms: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSystemVms: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSystemVms: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SystemVm
+	count, err := cs.SystemVM.StreamSystemVms(context.Background(), p, func(v *SystemVm) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSystemVms: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SystemVms) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SystemVms)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSystemVms", params, `<listsystemvmsresponse><count>1</count><systemvm><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></systemvm></listsystemvmsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.ListSystemVms(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSystemVms using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.SystemVM.StreamSystemVms(context.Background(), p, func(v *SystemVm) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSystemVms using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SystemVms) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SystemVms)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestMigrateSystemVm(t *testing.T) {
+	params := url.Values{
+		"hostid":           {"test-hostid"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "migrateSystemVm", params, `{"migratesystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewMigrateSystemVmParams("test-hostid", "test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostid("test-hostid")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "migrateSystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.MigrateSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call migrateSystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "migrateSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute migrateSystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of migrateSystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of migrateSystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "migrateSystemVm", params, `<migratesystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></migratesystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.MigrateSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call migrateSystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRebootSystemVm(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "rebootSystemVm", params, `{"rebootsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewRebootSystemVmParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "rebootSystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.RebootSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call rebootSystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "rebootSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute rebootSystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of rebootSystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of rebootSystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "rebootSystemVm", params, `<rebootsystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></rebootsystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.RebootSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call rebootSystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestScaleSystemVm(t *testing.T) {
+	params := url.Values{
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"id":                         {"test-id"},
+		"serviceofferingid":          {"test-serviceofferingid"},
+	}
+	cs, done := newTestClient(t, "scaleSystemVm", params, `{"scalesystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewScaleSystemVmParams("test-id", "test-serviceofferingid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetId("test-id")
+	p.SetServiceofferingid("test-serviceofferingid")
+	checkURLValues(t, "scaleSystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.ScaleSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call scaleSystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "scaleSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute scaleSystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of scaleSystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of scaleSystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "scaleSystemVm", params, `<scalesystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></scalesystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.ScaleSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call scaleSystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestStartSystemVm(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "startSystemVm", params, `{"startsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewStartSystemVmParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "startSystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.StartSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call startSystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "startSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute startSystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of startSystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of startSystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "startSystemVm", params, `<startsystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></startsystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.StartSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call startSystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestStopSystemVm(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+	cs, done := newTestClient(t, "stopSystemVm", params, `{"stopsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.SystemVM.NewStopSystemVmParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetForced(true)
+	p.SetId("test-id")
+	checkURLValues(t, "stopSystemVm", p.URLValues(), params)
+
+	r, err := cs.SystemVM.StopSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call stopSystemVm: %v", err)
+	}
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "stopSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute stopSystemVm: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of stopSystemVm: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of stopSystemVm: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "stopSystemVm", params, `<stopsystemvmresponse><activeviewersessions>1</activeviewersessions><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><gateway>test-gateway</gateway><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><jobid>test-jobid</jobid><jobstatus>1</jobstatus><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><name>test-name</name><networkdomain>test-networkdomain</networkdomain><podid>test-podid</podid><privateip>test-privateip</privateip><privatemacaddress>test-privatemacaddress</privatemacaddress><privatenetmask>test-privatenetmask</privatenetmask><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><state>test-state</state><systemvmtype>test-systemvmtype</systemvmtype><templateid>test-templateid</templateid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></stopsystemvmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.SystemVM.StopSystemVm(p)
+	if err != nil {
+		t.Fatalf("Failed to call stopSystemVm using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
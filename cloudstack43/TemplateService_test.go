@@ -0,0 +1,1041 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpgradeRouterTemplate(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"clusterid": {"test-clusterid"},
+		"domainid":  {"test-domainid"},
+		"id":        {"test-id"},
+		"podid":     {"test-podid"},
+		"zoneid":    {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "upgradeRouterTemplate", params, `{"upgraderoutertemplateresponse":{"jobid":"test-jobid","jobstatus":1}}`)
+	defer done()
+
+	p := cs.Template.NewUpgradeRouterTemplateParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetClusterid("test-clusterid")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetPodid("test-podid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "upgradeRouterTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.UpgradeRouterTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call upgradeRouterTemplate: %v", err)
+	}
+	if r.Jobid != "test-jobid" {
+		t.Errorf("Expected r.Jobid to be %v, got %v", "test-jobid", r.Jobid)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "upgradeRouterTemplate" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute upgradeRouterTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of upgradeRouterTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of upgradeRouterTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "upgradeRouterTemplate", params, `<upgraderoutertemplateresponse><jobid>test-jobid</jobid><jobstatus>1</jobstatus></upgraderoutertemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.UpgradeRouterTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call upgradeRouterTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCopyTemplate(t *testing.T) {
+	params := url.Values{
+		"destzoneid":   {"test-destzoneid"},
+		"id":           {"test-id"},
+		"sourcezoneid": {"test-sourcezoneid"},
+	}
+	cs, done := newTestClient(t, "copyTemplate", params, `{"copytemplateresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"jobid":"test-jobid","name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Template.NewCopyTemplateParams("test-destzoneid", "test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDestzoneid("test-destzoneid")
+	p.SetId("test-id")
+	p.SetSourcezoneid("test-sourcezoneid")
+	checkURLValues(t, "copyTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.CopyTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call copyTemplate: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "copyTemplate" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute copyTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of copyTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of copyTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "copyTemplate", params, `<copytemplateresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><jobid>test-jobid</jobid><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></copytemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.CopyTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call copyTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateTemplate(t *testing.T) {
+	params := url.Values{
+		"bits":                       {"1"},
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"displaytext":                {"test-displaytext"},
+		"isdynamicallyscalable":      {"true"},
+		"isfeatured":                 {"true"},
+		"ispublic":                   {"true"},
+		"name":                       {"test-name"},
+		"ostypeid":                   {"test-ostypeid"},
+		"passwordenabled":            {"true"},
+		"requireshvm":                {"true"},
+		"snapshotid":                 {"test-snapshotid"},
+		"templatetag":                {"test-templatetag"},
+		"url":                        {"test-url"},
+		"virtualmachineid":           {"test-virtualmachineid"},
+		"volumeid":                   {"test-volumeid"},
+	}
+	cs, done := newTestClient(t, "createTemplate", params, `{"createtemplateresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"jobid":"test-jobid","name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Template.NewCreateTemplateParams("test-displaytext", "test-name", "test-ostypeid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBits(1)
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetDisplaytext("test-displaytext")
+	p.SetIsdynamicallyscalable(true)
+	p.SetIsfeatured(true)
+	p.SetIspublic(true)
+	p.SetName("test-name")
+	p.SetOstypeid("test-ostypeid")
+	p.SetPasswordenabled(true)
+	p.SetRequireshvm(true)
+	p.SetSnapshotid("test-snapshotid")
+	p.SetTemplatetag("test-templatetag")
+	p.SetUrl("test-url")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetVolumeid("test-volumeid")
+	checkURLValues(t, "createTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.CreateTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call createTemplate: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createTemplate" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createTemplate", params, `<createtemplateresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><jobid>test-jobid</jobid><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createtemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.CreateTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call createTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteTemplate(t *testing.T) {
+	params := url.Values{
+		"id":     {"test-id"},
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "deleteTemplate", params, `{"deletetemplateresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Template.NewDeleteTemplateParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "deleteTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.DeleteTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTemplate: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteTemplate" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteTemplate", params, `<deletetemplateresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletetemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.DeleteTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestExtractTemplate(t *testing.T) {
+	params := url.Values{
+		"id":     {"test-id"},
+		"mode":   {"test-mode"},
+		"url":    {"test-url"},
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "extractTemplate", params, `{"extracttemplateresponse":{"accountid":"test-accountid","created":"2014-01-02T15:04:05+0100","extractId":"test-extractId","extractMode":"test-extractMode","id":"test-id","jobid":"test-jobid","name":"test-name","resultstring":"test-resultstring","state":"test-state","status":"test-status","storagetype":"test-storagetype","uploadpercentage":1,"url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Template.NewExtractTemplateParams("test-id", "test-mode")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetMode("test-mode")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "extractTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.ExtractTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call extractTemplate: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "extractTemplate" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute extractTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of extractTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of extractTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "extractTemplate", params, `<extracttemplateresponse><accountid>test-accountid</accountid><created>2014-01-02T15:04:05+0100</created><extractId>test-extractId</extractId><extractMode>test-extractMode</extractMode><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><resultstring>test-resultstring</resultstring><state>test-state</state><status>test-status</status><storagetype>test-storagetype</storagetype><uploadpercentage>1</uploadpercentage><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></extracttemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.ExtractTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call extractTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	params := url.Values{
+		"account":        {"test-account"},
+		"domainid":       {"test-domainid"},
+		"hypervisor":     {"BareMetal"},
+		"id":             {"test-id"},
+		"isrecursive":    {"true"},
+		"keyword":        {"test-keyword"},
+		"listall":        {"true"},
+		"name":           {"test-name"},
+		"page":           {"9"},
+		"pagesize":       {"10"},
+		"projectid":      {"test-projectid"},
+		"showremoved":    {"true"},
+		"tags[0].key":    {"test-tagskey"},
+		"tags[0].value":  {"test-tagsvalue"},
+		"templatefilter": {"test-templatefilter"},
+		"zoneid":         {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listTemplates", params, `{"listtemplatesresponse":{"count":1,"template":[{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Template.NewListTemplatesParams("test-templatefilter")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetShowremoved(true)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetTemplatefilter("test-templatefilter")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listTemplates", p.URLValues(), params)
+
+	r, err := cs.Template.ListTemplates(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTemplates: %v", err)
+	}
+	if r.Templates[0].Account != "test-account" {
+		t.Errorf("Expected r.Templates[0].Account to be %v, got %v", "test-account", r.Templates[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listTemplates" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listTemplates: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listTemplates: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listTemplates: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Template
+	count, err := cs.Template.StreamTemplates(context.Background(), p, func(v *Template) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listTemplates: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Templates) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Templates)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listTemplates", params, `<listtemplatesresponse><count>1</count><template><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></template></listtemplatesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.ListTemplates(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTemplates using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Template.StreamTemplates(context.Background(), p, func(v *Template) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listTemplates using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Templates) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Templates)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestPrepareTemplate(t *testing.T) {
+	params := url.Values{
+		"templateid": {"test-templateid"},
+		"zoneid":     {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "prepareTemplate", params, `{"preparetemplateresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Template.NewPrepareTemplateParams("test-templateid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetTemplateid("test-templateid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "prepareTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.PrepareTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call prepareTemplate: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "prepareTemplate" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute prepareTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of prepareTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of prepareTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "prepareTemplate", params, `<preparetemplateresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></preparetemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.PrepareTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call prepareTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRegisterTemplate(t *testing.T) {
+	params := url.Values{
+		"account":                    {"test-account"},
+		"bits":                       {"2"},
+		"checksum":                   {"test-checksum"},
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"displaytext":                {"test-displaytext"},
+		"domainid":                   {"test-domainid"},
+		"format":                     {"test-format"},
+		"hypervisor":                 {"BareMetal"},
+		"isdynamicallyscalable":      {"true"},
+		"isextractable":              {"true"},
+		"isfeatured":                 {"true"},
+		"ispublic":                   {"true"},
+		"isrouting":                  {"true"},
+		"name":                       {"test-name"},
+		"ostypeid":                   {"test-ostypeid"},
+		"passwordenabled":            {"true"},
+		"projectid":                  {"test-projectid"},
+		"requireshvm":                {"true"},
+		"sshkeyenabled":              {"true"},
+		"templatetag":                {"test-templatetag"},
+		"url":                        {"test-url"},
+		"zoneid":                     {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "registerTemplate", params, `{"registertemplateresponse":{"count":1,"template":[{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Template.NewRegisterTemplateParams("test-displaytext", "test-format", HypervisorBareMetal, "test-name", "test-ostypeid", "test-url", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetBits(2)
+	p.SetChecksum("test-checksum")
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetFormat("test-format")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetIsdynamicallyscalable(true)
+	p.SetIsextractable(true)
+	p.SetIsfeatured(true)
+	p.SetIspublic(true)
+	p.SetIsrouting(true)
+	p.SetName("test-name")
+	p.SetOstypeid("test-ostypeid")
+	p.SetPasswordenabled(true)
+	p.SetProjectid("test-projectid")
+	p.SetRequireshvm(true)
+	p.SetSshkeyenabled(true)
+	p.SetTemplatetag("test-templatetag")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "registerTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.RegisterTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerTemplate: %v", err)
+	}
+	if r.RegisterTemplate[0].Account != "test-account" {
+		t.Errorf("Expected r.RegisterTemplate[0].Account to be %v, got %v", "test-account", r.RegisterTemplate[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "registerTemplate" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute registerTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of registerTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of registerTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "registerTemplate", params, `<registertemplateresponse><count>1</count><template><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></template></registertemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.RegisterTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateTemplate(t *testing.T) {
+	params := url.Values{
+		"bootable":              {"true"},
+		"displaytext":           {"test-displaytext"},
+		"format":                {"test-format"},
+		"id":                    {"test-id"},
+		"isdynamicallyscalable": {"true"},
+		"isrouting":             {"true"},
+		"name":                  {"test-name"},
+		"ostypeid":              {"test-ostypeid"},
+		"passwordenabled":       {"true"},
+		"sortkey":               {"10"},
+	}
+	cs, done := newTestClient(t, "updateTemplate", params, `{"updatetemplateresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Template.NewUpdateTemplateParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBootable(true)
+	p.SetDisplaytext("test-displaytext")
+	p.SetFormat("test-format")
+	p.SetId("test-id")
+	p.SetIsdynamicallyscalable(true)
+	p.SetIsrouting(true)
+	p.SetName("test-name")
+	p.SetOstypeid("test-ostypeid")
+	p.SetPasswordenabled(true)
+	p.SetSortkey(10)
+	checkURLValues(t, "updateTemplate", p.URLValues(), params)
+
+	r, err := cs.Template.UpdateTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateTemplate: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateTemplate" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateTemplate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateTemplate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateTemplate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateTemplate", params, `<updatetemplateresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatetemplateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.UpdateTemplate(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateTemplate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListTemplatePermissions(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "listTemplatePermissions", params, `{"listtemplatepermissionsresponse":{"count":1,"templatepermission":[{"account":["test-account"],"domainid":"test-domainid","id":"test-id","ispublic":true,"projectids":["test-projectids"]}]}}`)
+	defer done()
+
+	p := cs.Template.NewListTemplatePermissionsParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "listTemplatePermissions", p.URLValues(), params)
+
+	r, err := cs.Template.ListTemplatePermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTemplatePermissions: %v", err)
+	}
+	if r.TemplatePermissions[0].Domainid != "test-domainid" {
+		t.Errorf("Expected r.TemplatePermissions[0].Domainid to be %v, got %v", "test-domainid", r.TemplatePermissions[0].Domainid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listTemplatePermissions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listTemplatePermissions: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listTemplatePermissions: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listTemplatePermissions: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*TemplatePermission
+	count, err := cs.Template.StreamTemplatePermissions(context.Background(), p, func(v *TemplatePermission) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listTemplatePermissions: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.TemplatePermissions) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.TemplatePermissions)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listTemplatePermissions", params, `<listtemplatepermissionsresponse><count>1</count><templatepermission><account>test-account</account><domainid>test-domainid</domainid><id>test-id</id><ispublic>true</ispublic><projectids>test-projectids</projectids></templatepermission></listtemplatepermissionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.ListTemplatePermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTemplatePermissions using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Template.StreamTemplatePermissions(context.Background(), p, func(v *TemplatePermission) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listTemplatePermissions using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.TemplatePermissions) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.TemplatePermissions)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateTemplatePermissions(t *testing.T) {
+	params := url.Values{
+		"accounts":      {"test-accounts1, test-accounts2"},
+		"id":            {"test-id"},
+		"isextractable": {"true"},
+		"isfeatured":    {"true"},
+		"ispublic":      {"true"},
+		"op":            {"test-op"},
+		"projectids":    {"test-projectids1, test-projectids2"},
+	}
+	cs, done := newTestClient(t, "updateTemplatePermissions", params, `{"updatetemplatepermissionsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Template.NewUpdateTemplatePermissionsParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccounts([]string{"test-accounts1", "test-accounts2"})
+	p.SetId("test-id")
+	p.SetIsextractable(true)
+	p.SetIsfeatured(true)
+	p.SetIspublic(true)
+	p.SetOp("test-op")
+	p.SetProjectids([]string{"test-projectids1", "test-projectids2"})
+	checkURLValues(t, "updateTemplatePermissions", p.URLValues(), params)
+
+	r, err := cs.Template.UpdateTemplatePermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateTemplatePermissions: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateTemplatePermissions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateTemplatePermissions: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateTemplatePermissions: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateTemplatePermissions: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateTemplatePermissions", params, `<updatetemplatepermissionsresponse><displaytext>test-displaytext</displaytext><success>true</success></updatetemplatepermissionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.UpdateTemplatePermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateTemplatePermissions using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListUcsTemplates(t *testing.T) {
+	params := url.Values{
+		"keyword":      {"test-keyword"},
+		"page":         {"2"},
+		"pagesize":     {"3"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "listUcsTemplates", params, `{"listucstemplatesresponse":{"count":1,"ucstemplate":[{"ucsdn":"test-ucsdn"}]}}`)
+	defer done()
+
+	p := cs.Template.NewListUcsTemplatesParams("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "listUcsTemplates", p.URLValues(), params)
+
+	r, err := cs.Template.ListUcsTemplates(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsTemplates: %v", err)
+	}
+	if r.UcsTemplates[0].Ucsdn != "test-ucsdn" {
+		t.Errorf("Expected r.UcsTemplates[0].Ucsdn to be %v, got %v", "test-ucsdn", r.UcsTemplates[0].Ucsdn)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsTemplates" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUcsTemplates: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUcsTemplates: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUcsTemplates: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*UcsTemplate
+	count, err := cs.Template.StreamUcsTemplates(context.Background(), p, func(v *UcsTemplate) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUcsTemplates: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.UcsTemplates) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.UcsTemplates)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUcsTemplates", params, `<listucstemplatesresponse><count>1</count><ucstemplate><ucsdn>test-ucsdn</ucsdn></ucstemplate></listucstemplatesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.ListUcsTemplates(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsTemplates using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Template.StreamUcsTemplates(context.Background(), p, func(v *UcsTemplate) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUcsTemplates using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.UcsTemplates) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.UcsTemplates)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestInstantiateUcsTemplateAndAssocaciateToBlade(t *testing.T) {
+	params := url.Values{
+		"bladeid":      {"test-bladeid"},
+		"profilename":  {"test-profilename"},
+		"templatedn":   {"test-templatedn"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "instantiateUcsTemplateAndAssocaciateToBlade", params, `{"instantiateucstemplateandassocaciatetobladeresponse":{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","jobid":"test-jobid","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}}`)
+	defer done()
+
+	p := cs.Template.NewInstantiateUcsTemplateAndAssocaciateToBladeParams("test-bladeid", "test-templatedn", "test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBladeid("test-bladeid")
+	p.SetProfilename("test-profilename")
+	p.SetTemplatedn("test-templatedn")
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "instantiateUcsTemplateAndAssocaciateToBlade", p.URLValues(), params)
+
+	r, err := cs.Template.InstantiateUcsTemplateAndAssocaciateToBlade(p)
+	if err != nil {
+		t.Fatalf("Failed to call instantiateUcsTemplateAndAssocaciateToBlade: %v", err)
+	}
+	if r.Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.Bladedn to be %v, got %v", "test-bladedn", r.Bladedn)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "instantiateUcsTemplateAndAssocaciateToBlade" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute instantiateUcsTemplateAndAssocaciateToBlade: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of instantiateUcsTemplateAndAssocaciateToBlade: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of instantiateUcsTemplateAndAssocaciateToBlade: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "instantiateUcsTemplateAndAssocaciateToBlade", params, `<instantiateucstemplateandassocaciatetobladeresponse><bladedn>test-bladedn</bladedn><hostid>test-hostid</hostid><id>test-id</id><jobid>test-jobid</jobid><profiledn>test-profiledn</profiledn><ucsmanagerid>test-ucsmanagerid</ucsmanagerid></instantiateucstemplateandassocaciatetobladeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Template.InstantiateUcsTemplateAndAssocaciateToBlade(p)
+	if err != nil {
+		t.Fatalf("Failed to call instantiateUcsTemplateAndAssocaciateToBlade using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,196 +17,421 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddUcsManagerParams struct {
-	p map[string]interface{}
+type UCSService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddUcsManagerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func NewUCSService(cs *CloudStackClient) *UCSService {
+	return &UCSService{cs: cs}
+}
+
+type ListUcsBladesParams = cloudstackcommon.ListUcsBladesParams
+type ListUcsBladesResponse = cloudstackcommon.ListUcsBladesResponse
+type UcsBlade = cloudstackcommon.UcsBlade
+
+// You should always use this function to get a new ListUcsBladesParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ucsmanagerid
+func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
+	p := &ListUcsBladesParams{}
+	p.SetUcsmanagerid(ucsmanagerid)
+	return p
+}
+
+// List ucs blades
+func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+
+	resp, err := s.cs.newRequest("listUcsBlades", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+
+	var r ListUcsBladesResponse
+	if err := s.cs.unmarshal("listUcsBlades", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	return &r, nil
+}
+
+// Lists the objects like ListUcsBlades, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UCSService) StreamUcsBlades(ctx context.Context, p *ListUcsBladesParams, fn func(*UcsBlade) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+
+	return s.cs.streamList(ctx, "listUcsBlades", p.URLValues(), "ucsblade", func(b json.RawMessage) error {
+		var v UcsBlade
+		if err := s.cs.unmarshalElement("listUcsBlades", "ucsblade", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type RefreshUcsBladesParams struct {
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RefreshUcsBladesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
 	}
 	return u
 }
 
-func (p *AddUcsManagerParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Returns the name of the API command of these params
+func (p *RefreshUcsBladesParams) Command() string {
+	return "refreshUcsBlades"
+}
+
+// Returns true if the API command starts an async job
+func (p *RefreshUcsBladesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RefreshUcsBladesParams) ResponseType() interface{} {
+	return &RefreshUcsBladesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RefreshUcsBladesParams) Validate() error {
+	v := &validator{api: "refreshUcsBlades"}
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *RefreshUcsBladesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *AddUcsManagerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RefreshUcsBladesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["password"] = v
+	return *p.Keyword
+}
+
+func (p *RefreshUcsBladesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *RefreshUcsBladesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *RefreshUcsBladesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *AddUcsManagerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RefreshUcsBladesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["url"] = v
+	return *p.Page
+}
+
+func (p *RefreshUcsBladesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *RefreshUcsBladesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *RefreshUcsBladesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *AddUcsManagerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RefreshUcsBladesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["username"] = v
+	return *p.Pagesize
+}
+
+func (p *RefreshUcsBladesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *RefreshUcsBladesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// This param is required.
+func (p *RefreshUcsBladesParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
 	return
 }
 
-func (p *AddUcsManagerParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RefreshUcsBladesParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Ucsmanagerid
+}
+
+func (p *RefreshUcsBladesParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *RefreshUcsBladesParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+// You should always use this function to get a new RefreshUcsBladesParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ucsmanagerid
+func (s *UCSService) NewRefreshUcsBladesParams(ucsmanagerid string) *RefreshUcsBladesParams {
+	p := &RefreshUcsBladesParams{}
+	p.SetUcsmanagerid(ucsmanagerid)
+	return p
+}
+
+// refresh ucs blades to sync with UCS manager
+//
+// See also ListUcsBlades.
+func (s *UCSService) RefreshUcsBlades(p *RefreshUcsBladesParams) (*RefreshUcsBladesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("refreshUcsBlades", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r RefreshUcsBladesResponse
+	if err := s.cs.unmarshal("refreshUcsBlades", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type RefreshUcsBladesResponse struct {
+	Bladedn      string                     `json:"bladedn,omitempty" xml:"bladedn,omitempty"`
+	Hostid       string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Profiledn    string                     `json:"profiledn,omitempty" xml:"profiledn,omitempty"`
+	Ucsmanagerid string                     `json:"ucsmanagerid,omitempty" xml:"ucsmanagerid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
+type AddUcsManagerParams = cloudstackcommon.AddUcsManagerParams
+type AddUcsManagerResponse = cloudstackcommon.AddUcsManagerResponse
+
 // You should always use this function to get a new AddUcsManagerParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - password: the password
+//   - url
+//   - username: the name of the user
+//   - zoneid: the ID of the zone
 func (s *UCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams {
 	p := &AddUcsManagerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["url"] = url
-	p.p["username"] = username
-	p.p["zoneid"] = zoneid
+	p.SetPassword(password)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Adds a Ucs manager
+//
+// See also ListUcsManagers, GetUcsManagerID, GetUcsManagerByName, GetUcsManagerByID.
 func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("addUcsManager", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addUcsManager", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddUcsManagerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addUcsManager", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddUcsManagerResponse struct {
-	Id     string `json:"id,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Url    string `json:"url,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type ListUcsManagersParams struct {
-	p map[string]interface{}
+type DeleteUcsManagerParams struct {
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
 }
 
-func (p *ListUcsManagersParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteUcsManagerParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
 	}
 	return u
 }
 
-func (p *ListUcsManagersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DeleteUcsManagerParams) Command() string {
+	return "deleteUcsManager"
 }
 
-func (p *ListUcsManagersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *DeleteUcsManagerParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListUcsManagersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteUcsManagerParams) ResponseType() interface{} {
+	return &DeleteUcsManagerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteUcsManagerParams) Validate() error {
+	v := &validator{api: "deleteUcsManager"}
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
 	return
 }
 
-func (p *ListUcsManagersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DeleteUcsManagerParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
-	return
+	return *p.Ucsmanagerid
+}
+
+func (p *DeleteUcsManagerParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *DeleteUcsManagerParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+// You should always use this function to get a new DeleteUcsManagerParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ucsmanagerid
+func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams {
+	p := &DeleteUcsManagerParams{}
+	p.SetUcsmanagerid(ucsmanagerid)
+	return p
 }
 
-func (p *ListUcsManagersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Delete a Ucs manager
+//
+// See also ListUcsManagers, GetUcsManagerID, GetUcsManagerByName, GetUcsManagerByID.
+func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
+
+	resp, err := s.cs.newRequest("deleteUcsManager", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteUcsManagerResponse
+	if err := s.cs.unmarshal("deleteUcsManager", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
+type DeleteUcsManagerResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListUcsManagersParams = cloudstackcommon.ListUcsManagersParams
+type ListUcsManagersResponse = cloudstackcommon.ListUcsManagersResponse
+type UcsManager = cloudstackcommon.UcsManager
+
 // You should always use this function to get a new ListUcsManagersParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UCSService) GetUcsManagerID(keyword string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, error) {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListUcsManagers(p)
 	if err != nil {
@@ -221,24 +446,36 @@ func (s *UCSService) GetUcsManagerID(keyword string) (string, error) {
 		return l.UcsManagers[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.UcsManagers {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*UcsManager
+	for _, v := range l.UcsManagers {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "UcsManager", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UCSService) GetUcsManagerByName(name string) (*UcsManager, int, error) {
-	id, err := s.GetUcsManagerID(name)
+func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error) {
+	id, err := s.GetUcsManagerID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetUcsManagerByID(id)
+	r, count, err := s.GetUcsManagerByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -246,11 +483,16 @@ func (s *UCSService) GetUcsManagerByName(name string) (*UcsManager, int, error)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UCSService) GetUcsManagerByID(id string) (*UcsManager, int, error) {
+func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error) {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListUcsManagers(p)
 	if err != nil {
@@ -273,280 +515,212 @@ func (s *UCSService) GetUcsManagerByID(id string) (*UcsManager, int, error) {
 }
 
 // List ucs manager
+//
+// See also GetUcsManagerID, GetUcsManagerByName, GetUcsManagerByID.
 func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
-	resp, err := s.cs.newRequest("listUcsManagers", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listUcsManagers", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUcsManagersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listUcsManagers", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListUcsManagersResponse struct {
-	Count       int           `json:"count"`
-	UcsManagers []*UcsManager `json:"ucsmanager"`
-}
-
-type UcsManager struct {
-	Id     string `json:"id,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Url    string `json:"url,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type ListUcsProfilesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUcsProfilesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
-}
-
-func (p *ListUcsProfilesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListUcsManagers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UCSService) StreamUcsManagers(ctx context.Context, p *ListUcsManagersParams, fn func(*UcsManager) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListUcsProfilesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+	return s.cs.streamList(ctx, "listUcsManagers", p.URLValues(), "ucsmanager", func(b json.RawMessage) error {
+		var v UcsManager
+		if err := s.cs.unmarshalElement("listUcsManagers", "ucsmanager", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListUcsProfilesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
-}
+type ListUcsProfilesParams = cloudstackcommon.ListUcsProfilesParams
+type ListUcsProfilesResponse = cloudstackcommon.ListUcsProfilesResponse
+type UcsProfile = cloudstackcommon.UcsProfile
 
 // You should always use this function to get a new ListUcsProfilesParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ucsmanagerid
 func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams {
 	p := &ListUcsProfilesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
 // List profile in ucs manager
 func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsProfiles", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listUcsProfiles", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUcsProfilesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listUcsProfiles", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListUcsProfilesResponse struct {
-	Count       int           `json:"count"`
-	UcsProfiles []*UcsProfile `json:"ucsprofile"`
-}
+// Lists the objects like ListUcsProfiles, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UCSService) StreamUcsProfiles(ctx context.Context, p *ListUcsProfilesParams, fn func(*UcsProfile) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type UcsProfile struct {
-	Ucsdn string `json:"ucsdn,omitempty"`
+	return s.cs.streamList(ctx, "listUcsProfiles", p.URLValues(), "ucsprofile", func(b json.RawMessage) error {
+		var v UcsProfile
+		if err := s.cs.unmarshalElement("listUcsProfiles", "ucsprofile", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type ListUcsBladesParams struct {
-	p map[string]interface{}
+type DisassociateUcsProfileFromBladeParams struct {
+	Bladeid       *string `json:"bladeid,omitempty" yaml:"bladeid,omitempty"`
+	Deleteprofile *bool   `json:"deleteprofile,omitempty" yaml:"deleteprofile,omitempty"`
 }
 
-func (p *ListUcsBladesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DisassociateUcsProfileFromBladeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Bladeid; v != nil {
+		u.Set("bladeid", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
+	if v := p.Deleteprofile; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("deleteprofile", vv)
 	}
 	return u
 }
 
-func (p *ListUcsBladesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DisassociateUcsProfileFromBladeParams) Command() string {
+	return "disassociateUcsProfileFromBlade"
 }
 
-func (p *ListUcsBladesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *DisassociateUcsProfileFromBladeParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListUcsBladesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DisassociateUcsProfileFromBladeParams) ResponseType() interface{} {
+	return &DisassociateUcsProfileFromBladeResponse{}
 }
 
-func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DisassociateUcsProfileFromBladeParams) Validate() error {
+	v := &validator{api: "disassociateUcsProfileFromBlade"}
+	v.required("bladeid", p.Bladeid != nil)
+	return v.err()
 }
 
-// You should always use this function to get a new ListUcsBladesParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
-	p := &ListUcsBladesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
-	return p
+// This param is required.
+func (p *DisassociateUcsProfileFromBladeParams) SetBladeid(v string) {
+	p.Bladeid = &v
+	return
 }
 
-// List ucs blades
-func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsBlades", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListUcsBladesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *DisassociateUcsProfileFromBladeParams) GetBladeid() string {
+	if p.Bladeid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Bladeid
 }
 
-type ListUcsBladesResponse struct {
-	Count     int         `json:"count"`
-	UcsBlades []*UcsBlade `json:"ucsblade"`
+func (p *DisassociateUcsProfileFromBladeParams) HasBladeid() bool {
+	return p.Bladeid != nil
 }
 
-type UcsBlade struct {
-	Bladedn      string `json:"bladedn,omitempty"`
-	Hostid       string `json:"hostid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Profiledn    string `json:"profiledn,omitempty"`
-	Ucsmanagerid string `json:"ucsmanagerid,omitempty"`
+func (p *DisassociateUcsProfileFromBladeParams) ResetBladeid() {
+	p.Bladeid = nil
 }
 
-type AssociateUcsProfileToBladeParams struct {
-	p map[string]interface{}
-}
-
-func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bladeid"]; found {
-		u.Set("bladeid", v.(string))
-	}
-	if v, found := p.p["profiledn"]; found {
-		u.Set("profiledn", v.(string))
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
+func (p *DisassociateUcsProfileFromBladeParams) SetDeleteprofile(v bool) {
+	p.Deleteprofile = &v
+	return
 }
 
-func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DisassociateUcsProfileFromBladeParams) GetDeleteprofile() bool {
+	if p.Deleteprofile == nil {
+		return false
 	}
-	p.p["bladeid"] = v
-	return
+	return *p.Deleteprofile
 }
 
-func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["profiledn"] = v
-	return
+func (p *DisassociateUcsProfileFromBladeParams) HasDeleteprofile() bool {
+	return p.Deleteprofile != nil
 }
 
-func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
+func (p *DisassociateUcsProfileFromBladeParams) ResetDeleteprofile() {
+	p.Deleteprofile = nil
 }
 
-// You should always use this function to get a new AssociateUcsProfileToBladeParams instance,
+// You should always use this function to get a new DisassociateUcsProfileFromBladeParams instance,
 // as then you are sure you have configured all required params
-func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams {
-	p := &AssociateUcsProfileToBladeParams{}
-	p.p = make(map[string]interface{})
-	p.p["bladeid"] = bladeid
-	p.p["profiledn"] = profiledn
-	p.p["ucsmanagerid"] = ucsmanagerid
+//
+// The required params are:
+//
+//   - bladeid
+func (s *UCSService) NewDisassociateUcsProfileFromBladeParams(bladeid string) *DisassociateUcsProfileFromBladeParams {
+	p := &DisassociateUcsProfileFromBladeParams{}
+	p.SetBladeid(bladeid)
 	return p
 }
 
-// associate a profile to a blade
-func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
-	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.toURLValues())
+// disassociate a profile from a blade
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *UCSService) DisassociateUcsProfileFromBlade(p *DisassociateUcsProfileFromBladeParams) (*DisassociateUcsProfileFromBladeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("disassociateUcsProfileFromBlade", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AssociateUcsProfileToBladeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DisassociateUcsProfileFromBladeResponse
+	if err := s.cs.unmarshal("disassociateUcsProfileFromBlade", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -567,126 +741,59 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("disassociateUcsProfileFromBlade", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AssociateUcsProfileToBladeResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Bladedn      string `json:"bladedn,omitempty"`
-	Hostid       string `json:"hostid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Profiledn    string `json:"profiledn,omitempty"`
-	Ucsmanagerid string `json:"ucsmanagerid,omitempty"`
-}
-
-type DeleteUcsManagerParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteUcsManagerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
+type DisassociateUcsProfileFromBladeResponse struct {
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Bladedn      string                     `json:"bladedn,omitempty" xml:"bladedn,omitempty"`
+	Hostid       string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Profiledn    string                     `json:"profiledn,omitempty" xml:"profiledn,omitempty"`
+	Ucsmanagerid string                     `json:"ucsmanagerid,omitempty" xml:"ucsmanagerid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
-}
+type AssociateUcsProfileToBladeParams = cloudstackcommon.AssociateUcsProfileToBladeParams
+type AssociateUcsProfileToBladeResponse = cloudstackcommon.AssociateUcsProfileToBladeResponse
 
-// You should always use this function to get a new DeleteUcsManagerParams instance,
+// You should always use this function to get a new AssociateUcsProfileToBladeParams instance,
 // as then you are sure you have configured all required params
-func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams {
-	p := &DeleteUcsManagerParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
+//
+// The required params are:
+//
+//   - bladeid
+//   - profiledn
+//   - ucsmanagerid
+func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams {
+	p := &AssociateUcsProfileToBladeParams{}
+	p.SetBladeid(bladeid)
+	p.SetProfiledn(profiledn)
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
-// Delete a Ucs manager
-func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("deleteUcsManager", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteUcsManagerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type DeleteUcsManagerResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type DisassociateUcsProfileFromBladeParams struct {
-	p map[string]interface{}
-}
-
-func (p *DisassociateUcsProfileFromBladeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bladeid"]; found {
-		u.Set("bladeid", v.(string))
-	}
-	if v, found := p.p["deleteprofile"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("deleteprofile", vv)
-	}
-	return u
-}
-
-func (p *DisassociateUcsProfileFromBladeParams) SetBladeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bladeid"] = v
-	return
-}
-
-func (p *DisassociateUcsProfileFromBladeParams) SetDeleteprofile(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// associate a profile to a blade
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["deleteprofile"] = v
-	return
-}
-
-// You should always use this function to get a new DisassociateUcsProfileFromBladeParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewDisassociateUcsProfileFromBladeParams(bladeid string) *DisassociateUcsProfileFromBladeParams {
-	p := &DisassociateUcsProfileFromBladeParams{}
-	p.p = make(map[string]interface{})
-	p.p["bladeid"] = bladeid
-	return p
-}
 
-// disassociate a profile from a blade
-func (s *UCSService) DisassociateUcsProfileFromBlade(p *DisassociateUcsProfileFromBladeParams) (*DisassociateUcsProfileFromBladeResponse, error) {
-	resp, err := s.cs.newRequest("disassociateUcsProfileFromBlade", p.toURLValues())
+	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DisassociateUcsProfileFromBladeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AssociateUcsProfileToBladeResponse
+	if err := s.cs.unmarshal("associateUcsProfileToBlade", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -707,107 +814,9 @@ func (s *UCSService) DisassociateUcsProfileFromBlade(p *DisassociateUcsProfileFr
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("associateUcsProfileToBlade", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type DisassociateUcsProfileFromBladeResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Bladedn      string `json:"bladedn,omitempty"`
-	Hostid       string `json:"hostid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Profiledn    string `json:"profiledn,omitempty"`
-	Ucsmanagerid string `json:"ucsmanagerid,omitempty"`
-}
-
-type RefreshUcsBladesParams struct {
-	p map[string]interface{}
-}
-
-func (p *RefreshUcsBladesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
-}
-
-func (p *RefreshUcsBladesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *RefreshUcsBladesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *RefreshUcsBladesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *RefreshUcsBladesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
-}
-
-// You should always use this function to get a new RefreshUcsBladesParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewRefreshUcsBladesParams(ucsmanagerid string) *RefreshUcsBladesParams {
-	p := &RefreshUcsBladesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
-	return p
-}
-
-// refresh ucs blades to sync with UCS manager
-func (s *UCSService) RefreshUcsBlades(p *RefreshUcsBladesParams) (*RefreshUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("refreshUcsBlades", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r RefreshUcsBladesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type RefreshUcsBladesResponse struct {
-	Bladedn      string `json:"bladedn,omitempty"`
-	Hostid       string `json:"hostid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Profiledn    string `json:"profiledn,omitempty"`
-	Ucsmanagerid string `json:"ucsmanagerid,omitempty"`
-}
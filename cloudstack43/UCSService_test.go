@@ -0,0 +1,614 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListUcsBlades(t *testing.T) {
+	params := url.Values{
+		"keyword":      {"test-keyword"},
+		"page":         {"2"},
+		"pagesize":     {"3"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "listUcsBlades", params, `{"listucsbladesresponse":{"count":1,"ucsblade":[{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}]}}`)
+	defer done()
+
+	p := cs.UCS.NewListUcsBladesParams("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "listUcsBlades", p.URLValues(), params)
+
+	r, err := cs.UCS.ListUcsBlades(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsBlades: %v", err)
+	}
+	if r.UcsBlades[0].Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.UcsBlades[0].Bladedn to be %v, got %v", "test-bladedn", r.UcsBlades[0].Bladedn)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsBlades" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUcsBlades: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUcsBlades: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUcsBlades: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*UcsBlade
+	count, err := cs.UCS.StreamUcsBlades(context.Background(), p, func(v *UcsBlade) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUcsBlades: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.UcsBlades) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.UcsBlades)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUcsBlades", params, `<listucsbladesresponse><count>1</count><ucsblade><bladedn>test-bladedn</bladedn><hostid>test-hostid</hostid><id>test-id</id><profiledn>test-profiledn</profiledn><ucsmanagerid>test-ucsmanagerid</ucsmanagerid></ucsblade></listucsbladesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.ListUcsBlades(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsBlades using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.UCS.StreamUcsBlades(context.Background(), p, func(v *UcsBlade) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUcsBlades using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.UcsBlades) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.UcsBlades)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRefreshUcsBlades(t *testing.T) {
+	params := url.Values{
+		"keyword":      {"test-keyword"},
+		"page":         {"2"},
+		"pagesize":     {"3"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "refreshUcsBlades", params, `{"refreshucsbladesresponse":{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}}`)
+	defer done()
+
+	p := cs.UCS.NewRefreshUcsBladesParams("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "refreshUcsBlades", p.URLValues(), params)
+
+	r, err := cs.UCS.RefreshUcsBlades(p)
+	if err != nil {
+		t.Fatalf("Failed to call refreshUcsBlades: %v", err)
+	}
+	if r.Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.Bladedn to be %v, got %v", "test-bladedn", r.Bladedn)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "refreshUcsBlades" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute refreshUcsBlades: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of refreshUcsBlades: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of refreshUcsBlades: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "refreshUcsBlades", params, `<refreshucsbladesresponse><bladedn>test-bladedn</bladedn><hostid>test-hostid</hostid><id>test-id</id><profiledn>test-profiledn</profiledn><ucsmanagerid>test-ucsmanagerid</ucsmanagerid></refreshucsbladesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.RefreshUcsBlades(p)
+	if err != nil {
+		t.Fatalf("Failed to call refreshUcsBlades using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddUcsManager(t *testing.T) {
+	params := url.Values{
+		"name":     {"test-name"},
+		"password": {"test-password"},
+		"url":      {"test-url"},
+		"username": {"test-username"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addUcsManager", params, `{"adducsmanagerresponse":{"id":"test-id","name":"test-name","url":"test-url","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.UCS.NewAddUcsManagerParams("test-password", "test-url", "test-username", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetName("test-name")
+	p.SetPassword("test-password")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addUcsManager", p.URLValues(), params)
+
+	r, err := cs.UCS.AddUcsManager(p)
+	if err != nil {
+		t.Fatalf("Failed to call addUcsManager: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addUcsManager" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addUcsManager: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addUcsManager: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addUcsManager: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addUcsManager", params, `<adducsmanagerresponse><id>test-id</id><name>test-name</name><url>test-url</url><zoneid>test-zoneid</zoneid></adducsmanagerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.AddUcsManager(p)
+	if err != nil {
+		t.Fatalf("Failed to call addUcsManager using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteUcsManager(t *testing.T) {
+	params := url.Values{
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "deleteUcsManager", params, `{"deleteucsmanagerresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.UCS.NewDeleteUcsManagerParams("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "deleteUcsManager", p.URLValues(), params)
+
+	r, err := cs.UCS.DeleteUcsManager(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteUcsManager: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteUcsManager" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteUcsManager: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteUcsManager: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteUcsManager: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteUcsManager", params, `<deleteucsmanagerresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteucsmanagerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.DeleteUcsManager(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteUcsManager using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListUcsManagers(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listUcsManagers", params, `{"listucsmanagersresponse":{"count":1,"ucsmanager":[{"id":"test-id","name":"test-name","url":"test-url","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.UCS.NewListUcsManagersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listUcsManagers", p.URLValues(), params)
+
+	r, err := cs.UCS.ListUcsManagers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsManagers: %v", err)
+	}
+	if r.UcsManagers[0].Id != "test-id" {
+		t.Errorf("Expected r.UcsManagers[0].Id to be %v, got %v", "test-id", r.UcsManagers[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsManagers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUcsManagers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUcsManagers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUcsManagers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*UcsManager
+	count, err := cs.UCS.StreamUcsManagers(context.Background(), p, func(v *UcsManager) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUcsManagers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.UcsManagers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.UcsManagers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUcsManagers", params, `<listucsmanagersresponse><count>1</count><ucsmanager><id>test-id</id><name>test-name</name><url>test-url</url><zoneid>test-zoneid</zoneid></ucsmanager></listucsmanagersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.ListUcsManagers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsManagers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.UCS.StreamUcsManagers(context.Background(), p, func(v *UcsManager) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUcsManagers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.UcsManagers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.UcsManagers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListUcsProfiles(t *testing.T) {
+	params := url.Values{
+		"keyword":      {"test-keyword"},
+		"page":         {"2"},
+		"pagesize":     {"3"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "listUcsProfiles", params, `{"listucsprofilesresponse":{"count":1,"ucsprofile":[{"ucsdn":"test-ucsdn"}]}}`)
+	defer done()
+
+	p := cs.UCS.NewListUcsProfilesParams("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "listUcsProfiles", p.URLValues(), params)
+
+	r, err := cs.UCS.ListUcsProfiles(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsProfiles: %v", err)
+	}
+	if r.UcsProfiles[0].Ucsdn != "test-ucsdn" {
+		t.Errorf("Expected r.UcsProfiles[0].Ucsdn to be %v, got %v", "test-ucsdn", r.UcsProfiles[0].Ucsdn)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsProfiles" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUcsProfiles: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUcsProfiles: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUcsProfiles: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*UcsProfile
+	count, err := cs.UCS.StreamUcsProfiles(context.Background(), p, func(v *UcsProfile) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUcsProfiles: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.UcsProfiles) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.UcsProfiles)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUcsProfiles", params, `<listucsprofilesresponse><count>1</count><ucsprofile><ucsdn>test-ucsdn</ucsdn></ucsprofile></listucsprofilesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.ListUcsProfiles(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUcsProfiles using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.UCS.StreamUcsProfiles(context.Background(), p, func(v *UcsProfile) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUcsProfiles using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.UcsProfiles) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.UcsProfiles)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisassociateUcsProfileFromBlade(t *testing.T) {
+	params := url.Values{
+		"bladeid":       {"test-bladeid"},
+		"deleteprofile": {"true"},
+	}
+	cs, done := newTestClient(t, "disassociateUcsProfileFromBlade", params, `{"disassociateucsprofilefrombladeresponse":{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","jobid":"test-jobid","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}}`)
+	defer done()
+
+	p := cs.UCS.NewDisassociateUcsProfileFromBladeParams("test-bladeid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBladeid("test-bladeid")
+	p.SetDeleteprofile(true)
+	checkURLValues(t, "disassociateUcsProfileFromBlade", p.URLValues(), params)
+
+	r, err := cs.UCS.DisassociateUcsProfileFromBlade(p)
+	if err != nil {
+		t.Fatalf("Failed to call disassociateUcsProfileFromBlade: %v", err)
+	}
+	if r.Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.Bladedn to be %v, got %v", "test-bladedn", r.Bladedn)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disassociateUcsProfileFromBlade" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disassociateUcsProfileFromBlade: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disassociateUcsProfileFromBlade: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disassociateUcsProfileFromBlade: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disassociateUcsProfileFromBlade", params, `<disassociateucsprofilefrombladeresponse><bladedn>test-bladedn</bladedn><hostid>test-hostid</hostid><id>test-id</id><jobid>test-jobid</jobid><profiledn>test-profiledn</profiledn><ucsmanagerid>test-ucsmanagerid</ucsmanagerid></disassociateucsprofilefrombladeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.DisassociateUcsProfileFromBlade(p)
+	if err != nil {
+		t.Fatalf("Failed to call disassociateUcsProfileFromBlade using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAssociateUcsProfileToBlade(t *testing.T) {
+	params := url.Values{
+		"bladeid":      {"test-bladeid"},
+		"profiledn":    {"test-profiledn"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+	cs, done := newTestClient(t, "associateUcsProfileToBlade", params, `{"associateucsprofiletobladeresponse":{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","jobid":"test-jobid","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}}`)
+	defer done()
+
+	p := cs.UCS.NewAssociateUcsProfileToBladeParams("test-bladeid", "test-profiledn", "test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBladeid("test-bladeid")
+	p.SetProfiledn("test-profiledn")
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	checkURLValues(t, "associateUcsProfileToBlade", p.URLValues(), params)
+
+	r, err := cs.UCS.AssociateUcsProfileToBlade(p)
+	if err != nil {
+		t.Fatalf("Failed to call associateUcsProfileToBlade: %v", err)
+	}
+	if r.Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.Bladedn to be %v, got %v", "test-bladedn", r.Bladedn)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "associateUcsProfileToBlade" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute associateUcsProfileToBlade: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of associateUcsProfileToBlade: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of associateUcsProfileToBlade: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "associateUcsProfileToBlade", params, `<associateucsprofiletobladeresponse><bladedn>test-bladedn</bladedn><hostid>test-hostid</hostid><id>test-id</id><jobid>test-jobid</jobid><profiledn>test-profiledn</profiledn><ucsmanagerid>test-ucsmanagerid</ucsmanagerid></associateucsprofiletobladeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.UCS.AssociateUcsProfileToBlade(p)
+	if err != nil {
+		t.Fatalf("Failed to call associateUcsProfileToBlade using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,368 +17,136 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
-)
-
-type CreateInstanceGroupParams struct {
-	p map[string]interface{}
-}
 
-func (p *CreateInstanceGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateInstanceGroupParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateInstanceGroupParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+type VMGroupService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateInstanceGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func NewVMGroupService(cs *CloudStackClient) *VMGroupService {
+	return &VMGroupService{cs: cs}
 }
 
-func (p *CreateInstanceGroupParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
+type CreateInstanceGroupParams = cloudstackcommon.CreateInstanceGroupParams
+type CreateInstanceGroupResponse = cloudstackcommon.CreateInstanceGroupResponse
 
 // You should always use this function to get a new CreateInstanceGroupParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
 func (s *VMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams {
 	p := &CreateInstanceGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+	p.SetName(name)
 	return p
 }
 
 // Creates a vm group
+//
+// See also ListInstanceGroups, GetInstanceGroupID, GetInstanceGroupByName, GetInstanceGroupByID.
 func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("createInstanceGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createInstanceGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateInstanceGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createInstanceGroup", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type CreateInstanceGroupResponse struct {
-	Account   string `json:"account,omitempty"`
-	Created   string `json:"created,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-}
-
-type DeleteInstanceGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteInstanceGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteInstanceGroupParams = cloudstackcommon.DeleteInstanceGroupParams
+type DeleteInstanceGroupResponse = cloudstackcommon.DeleteInstanceGroupResponse
 
 // You should always use this function to get a new DeleteInstanceGroupParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *VMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams {
 	p := &DeleteInstanceGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a vm group
+//
+// See also ListInstanceGroups, GetInstanceGroupID, GetInstanceGroupByName, GetInstanceGroupByID.
 func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteInstanceGroup", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteInstanceGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type DeleteInstanceGroupResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type UpdateInstanceGroupParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
-}
-
-func (p *UpdateInstanceGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UpdateInstanceGroupParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["name"] = v
-	return
-}
 
-// You should always use this function to get a new UpdateInstanceGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams {
-	p := &UpdateInstanceGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Updates a vm group
-func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateInstanceGroup", p.toURLValues())
+	resp, err := s.cs.newRequest("deleteInstanceGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateInstanceGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteInstanceGroupResponse
+	if err := s.cs.unmarshal("deleteInstanceGroup", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateInstanceGroupResponse struct {
-	Account   string `json:"account,omitempty"`
-	Created   string `json:"created,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-}
-
-type ListInstanceGroupsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListInstanceGroupsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
-
-func (p *ListInstanceGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListInstanceGroupsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
+type ListInstanceGroupsParams = cloudstackcommon.ListInstanceGroupsParams
+type ListInstanceGroupsResponse = cloudstackcommon.ListInstanceGroupsResponse
+type InstanceGroup = cloudstackcommon.InstanceGroup
 
 // You should always use this function to get a new ListInstanceGroupsParams instance,
 // as then you are sure you have configured all required params
 func (s *VMGroupService) NewListInstanceGroupsParams() *ListInstanceGroupsParams {
 	p := &ListInstanceGroupsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VMGroupService) GetInstanceGroupID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListInstanceGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListInstanceGroups(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListInstanceGroups(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
@@ -387,24 +155,38 @@ func (s *VMGroupService) GetInstanceGroupID(name string) (string, error) {
 		return l.InstanceGroups[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.InstanceGroups {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*InstanceGroup
+	for _, v := range l.InstanceGroups {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "InstanceGroup", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VMGroupService) GetInstanceGroupByName(name string) (*InstanceGroup, int, error) {
-	id, err := s.GetInstanceGroupID(name)
+func (s *VMGroupService) GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	id, err := s.GetInstanceGroupID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetInstanceGroupByID(id)
+	r, count, err := s.GetInstanceGroupByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -412,11 +194,16 @@ func (s *VMGroupService) GetInstanceGroupByName(name string) (*InstanceGroup, in
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VMGroupService) GetInstanceGroupByID(id string) (*InstanceGroup, int, error) {
+func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
 	p := &ListInstanceGroupsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListInstanceGroups(p)
 	if err != nil {
@@ -428,6 +215,20 @@ func (s *VMGroupService) GetInstanceGroupByID(id string) (*InstanceGroup, int, e
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListInstanceGroups(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -439,31 +240,79 @@ func (s *VMGroupService) GetInstanceGroupByID(id string) (*InstanceGroup, int, e
 }
 
 // Lists vm groups
+//
+// See also GetInstanceGroupID, GetInstanceGroupByName, GetInstanceGroupByID.
 func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listInstanceGroups", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listInstanceGroups", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListInstanceGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listInstanceGroups", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListInstanceGroupsResponse struct {
-	Count          int              `json:"count"`
-	InstanceGroups []*InstanceGroup `json:"instancegroup"`
+// Lists the objects like ListInstanceGroups, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VMGroupService) StreamInstanceGroups(ctx context.Context, p *ListInstanceGroupsParams, fn func(*InstanceGroup) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listInstanceGroups", p.URLValues(), "instancegroup", func(b json.RawMessage) error {
+		var v InstanceGroup
+		if err := s.cs.unmarshalElement("listInstanceGroups", "instancegroup", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type InstanceGroup struct {
-	Account   string `json:"account,omitempty"`
-	Created   string `json:"created,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Name      string `json:"name,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
+type UpdateInstanceGroupParams = cloudstackcommon.UpdateInstanceGroupParams
+type UpdateInstanceGroupResponse = cloudstackcommon.UpdateInstanceGroupResponse
+
+// You should always use this function to get a new UpdateInstanceGroupParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams {
+	p := &UpdateInstanceGroupParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates a vm group
+//
+// See also ListInstanceGroups, GetInstanceGroupID, GetInstanceGroupByName, GetInstanceGroupByID.
+func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateInstanceGroup", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateInstanceGroupResponse
+	if err := s.cs.unmarshal("updateInstanceGroup", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
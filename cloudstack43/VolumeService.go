@@ -17,78 +17,164 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type VolumeService struct {
+	cs *CloudStackClient
+}
+
+func NewVolumeService(cs *CloudStackClient) *VolumeService {
+	return &VolumeService{cs: cs}
+}
+
 type AttachVolumeParams struct {
-	p map[string]interface{}
+	Deviceid         *int64  `json:"deviceid,omitempty" yaml:"deviceid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *AttachVolumeParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AttachVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["deviceid"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Deviceid; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("deviceid", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *AttachVolumeParams) Command() string {
+	return "attachVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *AttachVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AttachVolumeParams) ResponseType() interface{} {
+	return &AttachVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AttachVolumeParams) Validate() error {
+	v := &validator{api: "attachVolume"}
+	v.required("id", p.Id != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
 func (p *AttachVolumeParams) SetDeviceid(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deviceid"] = v
+	p.Deviceid = &v
 	return
 }
 
-func (p *AttachVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AttachVolumeParams) GetDeviceid() int64 {
+	if p.Deviceid == nil {
+		return 0
 	}
-	p.p["id"] = v
+	return *p.Deviceid
+}
+
+func (p *AttachVolumeParams) HasDeviceid() bool {
+	return p.Deviceid != nil
+}
+
+func (p *AttachVolumeParams) ResetDeviceid() {
+	p.Deviceid = nil
+}
+
+// This param is required.
+func (p *AttachVolumeParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AttachVolumeParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Id
+}
+
+func (p *AttachVolumeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *AttachVolumeParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *AttachVolumeParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *AttachVolumeParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *AttachVolumeParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *AttachVolumeParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new AttachVolumeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+//   - virtualmachineid: the ID of the virtual machine
 func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams {
 	p := &AttachVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetId(id)
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Attaches a disk volume to a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
 func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("attachVolume", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("attachVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AttachVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("attachVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +195,7 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("attachVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -117,196 +203,414 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 }
 
 type AttachVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
-}
-
-type UploadVolumeParams struct {
-	p map[string]interface{}
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *UploadVolumeParams) toURLValues() url.Values {
+type CreateVolumeParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Diskofferingid   *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Displayvolume    *bool   `json:"displayvolume,omitempty" yaml:"displayvolume,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Maxiops          *int64  `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Miniops          *int64  `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid        *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Size             *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+	Snapshotid       *string `json:"snapshotid,omitempty" yaml:"snapshotid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Diskofferingid; v != nil {
+		u.Set("diskofferingid", *v)
+	}
+	if v := p.Displayvolume; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("displayvolume", vv)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["checksum"]; found {
-		u.Set("checksum", v.(string))
+	if v := p.Maxiops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("maxiops", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Miniops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("miniops", vv)
 	}
-	if v, found := p.p["format"]; found {
-		u.Set("format", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["imagestoreuuid"]; found {
-		u.Set("imagestoreuuid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Size; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("size", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Snapshotid; v != nil {
+		u.Set("snapshotid", *v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *UploadVolumeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *CreateVolumeParams) Command() string {
+	return "createVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVolumeParams) ResponseType() interface{} {
+	return &CreateVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVolumeParams) Validate() error {
+	v := &validator{api: "createVolume"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateVolumeParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateVolumeParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["account"] = v
+	return *p.Account
+}
+
+func (p *CreateVolumeParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateVolumeParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *CreateVolumeParams) SetDiskofferingid(v string) {
+	p.Diskofferingid = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetChecksum(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetDiskofferingid() string {
+	if p.Diskofferingid == nil {
+		return ""
 	}
-	p.p["checksum"] = v
+	return *p.Diskofferingid
+}
+
+func (p *CreateVolumeParams) HasDiskofferingid() bool {
+	return p.Diskofferingid != nil
+}
+
+func (p *CreateVolumeParams) ResetDiskofferingid() {
+	p.Diskofferingid = nil
+}
+
+func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
+	p.Displayvolume = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetDisplayvolume() bool {
+	if p.Displayvolume == nil {
+		return false
 	}
-	p.p["domainid"] = v
+	return *p.Displayvolume
+}
+
+func (p *CreateVolumeParams) HasDisplayvolume() bool {
+	return p.Displayvolume != nil
+}
+
+func (p *CreateVolumeParams) ResetDisplayvolume() {
+	p.Displayvolume = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateVolumeParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetFormat(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["format"] = v
+	return *p.Domainid
+}
+
+func (p *CreateVolumeParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateVolumeParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreateVolumeParams) SetMaxiops(v int64) {
+	p.Maxiops = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetMaxiops() int64 {
+	if p.Maxiops == nil {
+		return 0
 	}
-	p.p["imagestoreuuid"] = v
+	return *p.Maxiops
+}
+
+func (p *CreateVolumeParams) HasMaxiops() bool {
+	return p.Maxiops != nil
+}
+
+func (p *CreateVolumeParams) ResetMaxiops() {
+	p.Maxiops = nil
+}
+
+func (p *CreateVolumeParams) SetMiniops(v int64) {
+	p.Miniops = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetMiniops() int64 {
+	if p.Miniops == nil {
+		return 0
 	}
-	p.p["name"] = v
+	return *p.Miniops
+}
+
+func (p *CreateVolumeParams) HasMiniops() bool {
+	return p.Miniops != nil
+}
+
+func (p *CreateVolumeParams) ResetMiniops() {
+	p.Miniops = nil
+}
+
+// This param is required.
+func (p *CreateVolumeParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Name
+}
+
+func (p *CreateVolumeParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateVolumeParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateVolumeParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *CreateVolumeParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateVolumeParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *CreateVolumeParams) SetSize(v int64) {
+	p.Size = &v
+	return
+}
+
+func (p *CreateVolumeParams) GetSize() int64 {
+	if p.Size == nil {
+		return 0
 	}
-	p.p["url"] = v
+	return *p.Size
+}
+
+func (p *CreateVolumeParams) HasSize() bool {
+	return p.Size != nil
+}
+
+func (p *CreateVolumeParams) ResetSize() {
+	p.Size = nil
+}
+
+func (p *CreateVolumeParams) SetSnapshotid(v string) {
+	p.Snapshotid = &v
 	return
 }
 
-func (p *UploadVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVolumeParams) GetSnapshotid() string {
+	if p.Snapshotid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Snapshotid
+}
+
+func (p *CreateVolumeParams) HasSnapshotid() bool {
+	return p.Snapshotid != nil
+}
+
+func (p *CreateVolumeParams) ResetSnapshotid() {
+	p.Snapshotid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-// You should always use this function to get a new UploadVolumeParams instance,
+func (p *CreateVolumeParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *CreateVolumeParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *CreateVolumeParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *CreateVolumeParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *CreateVolumeParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreateVolumeParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreateVolumeParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new CreateVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams {
-	p := &UploadVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["format"] = format
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - name
+func (s *VolumeService) NewCreateVolumeParams(name string) *CreateVolumeParams {
+	p := &CreateVolumeParams{}
+	p.SetName(name)
 	return p
 }
 
-// Uploads a data disk.
-func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
-	resp, err := s.cs.newRequest("uploadVolume", p.toURLValues())
+// Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UploadVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateVolumeResponse
+	if err := s.cs.unmarshal("createVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -327,135 +631,229 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UploadVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
+type CreateVolumeResponse struct {
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVolumeParams = cloudstackcommon.DeleteVolumeParams
+type DeleteVolumeResponse = cloudstackcommon.DeleteVolumeResponse
+
+// You should always use this function to get a new DeleteVolumeParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
+	p := &DeleteVolumeParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a detached disk volume.
+//
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVolume", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVolumeResponse
+	if err := s.cs.unmarshal("deleteVolume", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
 type DetachVolumeParams struct {
-	p map[string]interface{}
+	Deviceid         *int64  `json:"deviceid,omitempty" yaml:"deviceid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *DetachVolumeParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DetachVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["deviceid"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Deviceid; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("deviceid", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *DetachVolumeParams) Command() string {
+	return "detachVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *DetachVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DetachVolumeParams) ResponseType() interface{} {
+	return &DetachVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DetachVolumeParams) Validate() error {
+	v := &validator{api: "detachVolume"}
+	return v.err()
+}
+
 func (p *DetachVolumeParams) SetDeviceid(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["deviceid"] = v
+	p.Deviceid = &v
 	return
 }
 
-func (p *DetachVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DetachVolumeParams) GetDeviceid() int64 {
+	if p.Deviceid == nil {
+		return 0
 	}
-	p.p["id"] = v
+	return *p.Deviceid
+}
+
+func (p *DetachVolumeParams) HasDeviceid() bool {
+	return p.Deviceid != nil
+}
+
+func (p *DetachVolumeParams) ResetDeviceid() {
+	p.Deviceid = nil
+}
+
+func (p *DetachVolumeParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DetachVolumeParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Id
+}
+
+func (p *DetachVolumeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DetachVolumeParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *DetachVolumeParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *DetachVolumeParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *DetachVolumeParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *DetachVolumeParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new DetachVolumeParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
 	p := &DetachVolumeParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Detaches a disk volume from a virtual machine.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
 func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
-	resp, err := s.cs.newRequest("detachVolume", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("detachVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DetachVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("detachVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -476,7 +874,7 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("detachVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -484,230 +882,91 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 }
 
 type DetachVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
-}
-
-type CreateVolumeParams struct {
-	p map[string]interface{}
-}
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExtractVolumeParams = cloudstackcommon.ExtractVolumeParams
+type ExtractVolumeResponse = cloudstackcommon.ExtractVolumeResponse
 
-func (p *CreateVolumeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
-	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("maxiops", vv)
-	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("miniops", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
-	}
-	if v, found := p.p["snapshotid"]; found {
-		u.Set("snapshotid", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateVolumeParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetDisplayvolume(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displayvolume"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxiops"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["miniops"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["size"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetSnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["snapshotid"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
-}
-
-func (p *CreateVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
-
-// You should always use this function to get a new CreateVolumeParams instance,
+// You should always use this function to get a new ExtractVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewCreateVolumeParams(name string) *CreateVolumeParams {
-	p := &CreateVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+//
+// The required params are:
+//
+//   - id
+//   - mode
+//   - zoneid: the ID of the zone
+func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
+	p := &ExtractVolumeParams{}
+	p.SetId(id)
+	p.SetMode(mode)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Creates a disk volume from a disk offering. This disk volume must still be attached to a virtual machine to make use of it.
-func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("createVolume", p.toURLValues())
+// Extracts volume
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("extractVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ExtractVolumeResponse
+	if err := s.cs.unmarshal("extractVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -728,351 +987,507 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("extractVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
-}
-
-type DeleteVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteVolumeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new DeleteVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
-	p := &DeleteVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Deletes a detached disk volume.
-func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
-	resp, err := s.cs.newRequest("deleteVolume", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type DeleteVolumeResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
 type ListVolumesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVolumesParams) toURLValues() url.Values {
+	Account          *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid           *string           `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id               *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid            *string           `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid        *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Storageid        *string           `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Type             *string           `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid *string           `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Zoneid           *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVolumesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["type"]; found {
-		u.Set("type", v.(string))
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
 	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListVolumesParams) Command() string {
+	return "listVolumes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVolumesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVolumesParams) ResponseType() interface{} {
+	return &ListVolumesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVolumesParams) Validate() error {
+	v := &validator{api: "listVolumes"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ListVolumesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ListVolumesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *ListVolumesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListVolumesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVolumesParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListVolumesParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["hostid"] = v
+	return *p.Domainid
+}
+
+func (p *ListVolumesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVolumesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *ListVolumesParams) SetHostid(v string) {
+	p.Hostid = &v
 	return
 }
 
-func (p *ListVolumesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Hostid
+}
+
+func (p *ListVolumesParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ListVolumesParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+func (p *ListVolumesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListVolumesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
+	return *p.Id
+}
+
+func (p *ListVolumesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListVolumesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVolumesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListVolumesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListVolumesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListVolumesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVolumesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListVolumesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListVolumesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVolumesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVolumesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListVolumesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["name"] = v
+	return *p.Listall
+}
+
+func (p *ListVolumesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListVolumesParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListVolumesParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListVolumesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Name
+}
+
+func (p *ListVolumesParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListVolumesParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVolumesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListVolumesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListVolumesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVolumesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVolumesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListVolumesParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["podid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListVolumesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVolumesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListVolumesParams) SetPodid(v string) {
+	p.Podid = &v
 	return
 }
 
-func (p *ListVolumesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Podid
+}
+
+func (p *ListVolumesParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListVolumesParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVolumesParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListVolumesParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["storageid"] = v
+	return *p.Projectid
+}
+
+func (p *ListVolumesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVolumesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListVolumesParams) SetStorageid(v string) {
+	p.Storageid = &v
 	return
 }
 
-func (p *ListVolumesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Storageid
+}
+
+func (p *ListVolumesParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *ListVolumesParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListVolumesParams) SetTags(v map[string]string) {
+	p.Tags = v
 	return
 }
 
+func (p *ListVolumesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListVolumesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListVolumesParams) ResetTags() {
+	p.Tags = nil
+}
+
 func (p *ListVolumesParams) SetType(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeType"] = v
+	p.Type = &v
 	return
 }
 
-func (p *ListVolumesParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetType() string {
+	if p.Type == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Type
+}
+
+func (p *ListVolumesParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListVolumesParams) ResetType() {
+	p.Type = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListVolumesParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
-func (p *ListVolumesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVolumesParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Virtualmachineid
+}
+
+func (p *ListVolumesParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListVolumesParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListVolumesParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *ListVolumesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListVolumesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListVolumesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListVolumesParams instance,
 // as then you are sure you have configured all required params
 func (s *VolumeService) NewListVolumesParams() *ListVolumesParams {
 	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VolumeService) GetVolumeID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListVolumes(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVolumes(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
@@ -1081,24 +1496,39 @@ func (s *VolumeService) GetVolumeID(name string) (string, error) {
 		return l.Volumes[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Volumes {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Volume
+	for _, v := range l.Volumes {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Volume", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VolumeService) GetVolumeByName(name string) (*Volume, int, error) {
-	id, err := s.GetVolumeID(name)
+func (s *VolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error) {
+	id, err := s.GetVolumeID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetVolumeByID(id)
+	r, count, err := s.GetVolumeByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1106,11 +1536,16 @@ func (s *VolumeService) GetVolumeByName(name string) (*Volume, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VolumeService) GetVolumeByID(id string) (*Volume, int, error) {
+func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
 	p := &ListVolumesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListVolumes(p)
 	if err != nil {
@@ -1122,6 +1557,20 @@ func (s *VolumeService) GetVolumeByID(id string) (*Volume, int, error) {
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVolumes(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -1132,159 +1581,502 @@ func (s *VolumeService) GetVolumeByID(id string) (*Volume, int, error) {
 	return nil, l.Count, fmt.Errorf("There is more then one result for Volume UUID: %s!", id)
 }
 
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *VolumeService) GetVolumesByTags(tags map[string]string, opts ...OptionFunc) ([]*Volume, error) {
+	p := &ListVolumesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListVolumes(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVolumes(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.Volumes, nil
+}
+
 // Lists all volumes.
+//
+// See also GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
 func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
-	resp, err := s.cs.newRequest("listVolumes", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVolumes", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVolumesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listVolumes", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListVolumes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VolumeService) StreamVolumes(ctx context.Context, p *ListVolumesParams, fn func(*Volume) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listVolumes", p.URLValues(), "volume", func(b json.RawMessage) error {
+		var v Volume
+		if err := s.cs.unmarshalElement("listVolumes", "volume", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListVolumesResponse struct {
-	Count   int       `json:"count"`
-	Volumes []*Volume `json:"volume"`
+	Count   int                        `json:"count" xml:"count"`
+	Volumes []*Volume                  `json:"volume" xml:"volume"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type Volume struct {
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
-}
-
-type ExtractVolumeParams struct {
-	p map[string]interface{}
-}
-
-func (p *ExtractVolumeParams) toURLValues() url.Values {
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type MigrateVolumeParams struct {
+	Livemigrate *bool   `json:"livemigrate,omitempty" yaml:"livemigrate,omitempty"`
+	Storageid   *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Volumeid    *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *MigrateVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Livemigrate; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("livemigrate", vv)
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
+	}
+	if v := p.Volumeid; v != nil {
+		u.Set("volumeid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *MigrateVolumeParams) Command() string {
+	return "migrateVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *MigrateVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *MigrateVolumeParams) ResponseType() interface{} {
+	return &MigrateVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *MigrateVolumeParams) Validate() error {
+	v := &validator{api: "migrateVolume"}
+	v.required("storageid", p.Storageid != nil)
+	v.required("volumeid", p.Volumeid != nil)
+	return v.err()
+}
+
+func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
+	p.Livemigrate = &v
+	return
+}
+
+func (p *MigrateVolumeParams) GetLivemigrate() bool {
+	if p.Livemigrate == nil {
+		return false
+	}
+	return *p.Livemigrate
+}
+
+func (p *MigrateVolumeParams) HasLivemigrate() bool {
+	return p.Livemigrate != nil
+}
+
+func (p *MigrateVolumeParams) ResetLivemigrate() {
+	p.Livemigrate = nil
+}
+
+// This param is required.
+func (p *MigrateVolumeParams) SetStorageid(v string) {
+	p.Storageid = &v
+	return
+}
+
+func (p *MigrateVolumeParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *MigrateVolumeParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *MigrateVolumeParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+// Sets the 'volumeid' param: the ID of the volume. This param is required.
+func (p *MigrateVolumeParams) SetVolumeid(v string) {
+	p.Volumeid = &v
+	return
+}
+
+func (p *MigrateVolumeParams) GetVolumeid() string {
+	if p.Volumeid == nil {
+		return ""
+	}
+	return *p.Volumeid
+}
+
+func (p *MigrateVolumeParams) HasVolumeid() bool {
+	return p.Volumeid != nil
+}
+
+func (p *MigrateVolumeParams) ResetVolumeid() {
+	p.Volumeid = nil
+}
+
+// You should always use this function to get a new MigrateVolumeParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - storageid
+//   - volumeid: the ID of the volume
+func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
+	p := &MigrateVolumeParams{}
+	p.SetStorageid(storageid)
+	p.SetVolumeid(volumeid)
+	return p
+}
+
+// Migrate volume
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("migrateVolume", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MigrateVolumeResponse
+	if err := s.cs.unmarshal("migrateVolume", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("migrateVolume", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	return &r, nil
+}
+
+type MigrateVolumeResponse struct {
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ResizeVolumeParams struct {
+	Diskofferingid *string `json:"diskofferingid,omitempty" yaml:"diskofferingid,omitempty"`
+	Id             *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Shrinkok       *bool   `json:"shrinkok,omitempty" yaml:"shrinkok,omitempty"`
+	Size           *int64  `json:"size,omitempty" yaml:"size,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ResizeVolumeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Diskofferingid; v != nil {
+		u.Set("diskofferingid", *v)
 	}
-	if v, found := p.p["mode"]; found {
-		u.Set("mode", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
+	if v := p.Shrinkok; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("shrinkok", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Size; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("size", vv)
 	}
 	return u
 }
 
-func (p *ExtractVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns the name of the API command of these params
+func (p *ResizeVolumeParams) Command() string {
+	return "resizeVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *ResizeVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ResizeVolumeParams) ResponseType() interface{} {
+	return &ResizeVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ResizeVolumeParams) Validate() error {
+	v := &validator{api: "resizeVolume"}
+	return v.err()
+}
+
+func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
+	p.Diskofferingid = &v
+	return
+}
+
+func (p *ResizeVolumeParams) GetDiskofferingid() string {
+	if p.Diskofferingid == nil {
+		return ""
+	}
+	return *p.Diskofferingid
+}
+
+func (p *ResizeVolumeParams) HasDiskofferingid() bool {
+	return p.Diskofferingid != nil
+}
+
+func (p *ResizeVolumeParams) ResetDiskofferingid() {
+	p.Diskofferingid = nil
+}
+
+func (p *ResizeVolumeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ResizeVolumeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ResizeVolumeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ResizeVolumeParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ResizeVolumeParams) SetShrinkok(v bool) {
+	p.Shrinkok = &v
 	return
 }
 
-func (p *ExtractVolumeParams) SetMode(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResizeVolumeParams) GetShrinkok() bool {
+	if p.Shrinkok == nil {
+		return false
 	}
-	p.p["mode"] = v
-	return
+	return *p.Shrinkok
 }
 
-func (p *ExtractVolumeParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+func (p *ResizeVolumeParams) HasShrinkok() bool {
+	return p.Shrinkok != nil
+}
+
+func (p *ResizeVolumeParams) ResetShrinkok() {
+	p.Shrinkok = nil
+}
+
+func (p *ResizeVolumeParams) SetSize(v int64) {
+	p.Size = &v
 	return
 }
 
-func (p *ExtractVolumeParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ResizeVolumeParams) GetSize() int64 {
+	if p.Size == nil {
+		return 0
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Size
 }
 
-// You should always use this function to get a new ExtractVolumeParams instance,
+func (p *ResizeVolumeParams) HasSize() bool {
+	return p.Size != nil
+}
+
+func (p *ResizeVolumeParams) ResetSize() {
+	p.Size = nil
+}
+
+// You should always use this function to get a new ResizeVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams {
-	p := &ExtractVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	p.p["mode"] = mode
-	p.p["zoneid"] = zoneid
+func (s *VolumeService) NewResizeVolumeParams() *ResizeVolumeParams {
+	p := &ResizeVolumeParams{}
 	return p
 }
 
-// Extracts volume
-func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
-	resp, err := s.cs.newRequest("extractVolume", p.toURLValues())
+// Resizes a volume
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("resizeVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ExtractVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ResizeVolumeResponse
+	if err := s.cs.unmarshal("resizeVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1305,96 +2097,239 @@ func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeRes
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("resizeVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ExtractVolumeResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Accountid        string `json:"accountid,omitempty"`
-	Created          string `json:"created,omitempty"`
-	ExtractId        string `json:"extractId,omitempty"`
-	ExtractMode      string `json:"extractMode,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Resultstring     string `json:"resultstring,omitempty"`
-	State            string `json:"state,omitempty"`
-	Status           string `json:"status,omitempty"`
-	Storagetype      string `json:"storagetype,omitempty"`
-	Uploadpercentage int    `json:"uploadpercentage,omitempty"`
-	Url              string `json:"url,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
+type ResizeVolumeResponse struct {
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type MigrateVolumeParams struct {
-	p map[string]interface{}
+type UpdateVolumeParams struct {
+	Displayvolume *bool   `json:"displayvolume,omitempty" yaml:"displayvolume,omitempty"`
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Path          *string `json:"path,omitempty" yaml:"path,omitempty"`
+	State         *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid     *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
 }
 
-func (p *MigrateVolumeParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Displayvolume; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("displayvolume", vv)
 	}
-	if v, found := p.p["livemigrate"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("livemigrate", vv)
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
+	if v := p.Path; v != nil {
+		u.Set("path", *v)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
 	}
 	return u
 }
 
-func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *UpdateVolumeParams) Command() string {
+	return "updateVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVolumeParams) ResponseType() interface{} {
+	return &UpdateVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVolumeParams) Validate() error {
+	v := &validator{api: "updateVolume"}
+	return v.err()
+}
+
+func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
+	p.Displayvolume = &v
+	return
+}
+
+func (p *UpdateVolumeParams) GetDisplayvolume() bool {
+	if p.Displayvolume == nil {
+		return false
 	}
-	p.p["livemigrate"] = v
+	return *p.Displayvolume
+}
+
+func (p *UpdateVolumeParams) HasDisplayvolume() bool {
+	return p.Displayvolume != nil
+}
+
+func (p *UpdateVolumeParams) ResetDisplayvolume() {
+	p.Displayvolume = nil
+}
+
+func (p *UpdateVolumeParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *MigrateVolumeParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVolumeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateVolumeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateVolumeParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateVolumeParams) SetPath(v string) {
+	p.Path = &v
+	return
+}
+
+func (p *UpdateVolumeParams) GetPath() string {
+	if p.Path == nil {
+		return ""
 	}
-	p.p["storageid"] = v
+	return *p.Path
+}
+
+func (p *UpdateVolumeParams) HasPath() bool {
+	return p.Path != nil
+}
+
+func (p *UpdateVolumeParams) ResetPath() {
+	p.Path = nil
+}
+
+func (p *UpdateVolumeParams) SetState(v string) {
+	p.State = &v
 	return
 }
 
-func (p *MigrateVolumeParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVolumeParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
-	p.p["volumeid"] = v
+	return *p.State
+}
+
+func (p *UpdateVolumeParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *UpdateVolumeParams) ResetState() {
+	p.State = nil
+}
+
+func (p *UpdateVolumeParams) SetStorageid(v string) {
+	p.Storageid = &v
 	return
 }
 
-// You should always use this function to get a new MigrateVolumeParams instance,
+func (p *UpdateVolumeParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *UpdateVolumeParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *UpdateVolumeParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+// You should always use this function to get a new UpdateVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams {
-	p := &MigrateVolumeParams{}
-	p.p = make(map[string]interface{})
-	p.p["storageid"] = storageid
-	p.p["volumeid"] = volumeid
+func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
+	p := &UpdateVolumeParams{}
 	return p
 }
 
-// Migrate volume
-func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("migrateVolume", p.toURLValues())
+// Updates the volume.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r MigrateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateVolumeResponse
+	if err := s.cs.unmarshal("updateVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1415,318 +2350,356 @@ func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeRes
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type MigrateVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
-}
-
-type ResizeVolumeParams struct {
-	p map[string]interface{}
+type UpdateVolumeResponse struct {
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ResizeVolumeParams) toURLValues() url.Values {
+type UploadVolumeParams struct {
+	Account        *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Checksum       *string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Format         *string `json:"format,omitempty" yaml:"format,omitempty"`
+	Imagestoreuuid *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid      *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Url            *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid         *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UploadVolumeParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["diskofferingid"]; found {
-		u.Set("diskofferingid", v.(string))
+	if v := p.Checksum; v != nil {
+		u.Set("checksum", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["shrinkok"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("shrinkok", vv)
+	if v := p.Format; v != nil {
+		u.Set("format", *v)
 	}
-	if v, found := p.p["size"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("size", vv)
+	if v := p.Imagestoreuuid; v != nil {
+		u.Set("imagestoreuuid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ResizeVolumeParams) SetDiskofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["diskofferingid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UploadVolumeParams) Command() string {
+	return "uploadVolume"
 }
 
-func (p *ResizeVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Returns true if the API command starts an async job
+func (p *UploadVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UploadVolumeParams) ResponseType() interface{} {
+	return &UploadVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UploadVolumeParams) Validate() error {
+	v := &validator{api: "uploadVolume"}
+	v.required("format", p.Format != nil)
+	v.required("name", p.Name != nil)
+	v.required("url", p.Url != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UploadVolumeParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ResizeVolumeParams) SetShrinkok(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["shrinkok"] = v
+	return *p.Account
+}
+
+func (p *UploadVolumeParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UploadVolumeParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *UploadVolumeParams) SetChecksum(v string) {
+	p.Checksum = &v
 	return
 }
 
-func (p *ResizeVolumeParams) SetSize(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetChecksum() string {
+	if p.Checksum == nil {
+		return ""
 	}
-	p.p["size"] = v
-	return
+	return *p.Checksum
 }
 
-// You should always use this function to get a new ResizeVolumeParams instance,
-// as then you are sure you have configured all required params
-func (s *VolumeService) NewResizeVolumeParams() *ResizeVolumeParams {
-	p := &ResizeVolumeParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *UploadVolumeParams) HasChecksum() bool {
+	return p.Checksum != nil
 }
 
-// Resizes a volume
-func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
-	resp, err := s.cs.newRequest("resizeVolume", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *UploadVolumeParams) ResetChecksum() {
+	p.Checksum = nil
+}
 
-	var r ResizeVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'domainid' param: the ID of the domain.
+func (p *UploadVolumeParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *UploadVolumeParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
+	return *p.Domainid
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *UploadVolumeParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *UploadVolumeParams) ResetDomainid() {
+	p.Domainid = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// This param is required.
+func (p *UploadVolumeParams) SetFormat(v string) {
+	p.Format = &v
+	return
+}
+
+func (p *UploadVolumeParams) GetFormat() string {
+	if p.Format == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Format
 }
 
-type ResizeVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
+func (p *UploadVolumeParams) HasFormat() bool {
+	return p.Format != nil
 }
 
-type UpdateVolumeParams struct {
-	p map[string]interface{}
+func (p *UploadVolumeParams) ResetFormat() {
+	p.Format = nil
 }
 
-func (p *UpdateVolumeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["displayvolume"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("displayvolume", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["path"]; found {
-		u.Set("path", v.(string))
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
-	return u
+func (p *UploadVolumeParams) SetImagestoreuuid(v string) {
+	p.Imagestoreuuid = &v
+	return
 }
 
-func (p *UpdateVolumeParams) SetDisplayvolume(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetImagestoreuuid() string {
+	if p.Imagestoreuuid == nil {
+		return ""
 	}
-	p.p["displayvolume"] = v
+	return *p.Imagestoreuuid
+}
+
+func (p *UploadVolumeParams) HasImagestoreuuid() bool {
+	return p.Imagestoreuuid != nil
+}
+
+func (p *UploadVolumeParams) ResetImagestoreuuid() {
+	p.Imagestoreuuid = nil
+}
+
+// This param is required.
+func (p *UploadVolumeParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UpdateVolumeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Name
+}
+
+func (p *UploadVolumeParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UploadVolumeParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *UploadVolumeParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *UpdateVolumeParams) SetPath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["path"] = v
+	return *p.Projectid
+}
+
+func (p *UploadVolumeParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *UploadVolumeParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// This param is required.
+func (p *UploadVolumeParams) SetUrl(v string) {
+	p.Url = &v
 	return
 }
 
-func (p *UpdateVolumeParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
 	}
-	p.p["state"] = v
+	return *p.Url
+}
+
+func (p *UploadVolumeParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *UploadVolumeParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *UploadVolumeParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-func (p *UpdateVolumeParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UploadVolumeParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	p.p["storageid"] = v
-	return
+	return *p.Zoneid
 }
 
-// You should always use this function to get a new UpdateVolumeParams instance,
+func (p *UploadVolumeParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *UploadVolumeParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new UploadVolumeParams instance,
 // as then you are sure you have configured all required params
-func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
-	p := &UpdateVolumeParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - format
+//   - name
+//   - url
+//   - zoneid: the ID of the zone
+func (s *VolumeService) NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams {
+	p := &UploadVolumeParams{}
+	p.SetFormat(format)
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Updates the volume.
-func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
-	resp, err := s.cs.newRequest("updateVolume", p.toURLValues())
+// Uploads a data disk.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVolumes, GetVolumeID, GetVolumeByName, GetVolumeByID, GetVolumesByTags.
+func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("uploadVolume", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UploadVolumeResponse
+	if err := s.cs.unmarshal("uploadVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1747,68 +2720,58 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("uploadVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateVolumeResponse struct {
-	JobID                      string `json:"jobid,omitempty"`
-	Account                    string `json:"account,omitempty"`
-	Attached                   string `json:"attached,omitempty"`
-	Created                    string `json:"created,omitempty"`
-	Destroyed                  bool   `json:"destroyed,omitempty"`
-	Deviceid                   int64  `json:"deviceid,omitempty"`
-	DiskBytesReadRate          int64  `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate         int64  `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate           int64  `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate          int64  `json:"diskIopsWriteRate,omitempty"`
-	Diskofferingdisplaytext    string `json:"diskofferingdisplaytext,omitempty"`
-	Diskofferingid             string `json:"diskofferingid,omitempty"`
-	Diskofferingname           string `json:"diskofferingname,omitempty"`
-	Displayvolume              bool   `json:"displayvolume,omitempty"`
-	Domain                     string `json:"domain,omitempty"`
-	Domainid                   string `json:"domainid,omitempty"`
-	Hypervisor                 string `json:"hypervisor,omitempty"`
-	Id                         string `json:"id,omitempty"`
-	Isextractable              bool   `json:"isextractable,omitempty"`
-	Maxiops                    int64  `json:"maxiops,omitempty"`
-	Miniops                    int64  `json:"miniops,omitempty"`
-	Name                       string `json:"name,omitempty"`
-	Path                       string `json:"path,omitempty"`
-	Pcidevicepath              string `json:"pcidevicepath,omitempty"`
-	Project                    string `json:"project,omitempty"`
-	Projectid                  string `json:"projectid,omitempty"`
-	Quiescevm                  bool   `json:"quiescevm,omitempty"`
-	Serviceofferingdisplaytext string `json:"serviceofferingdisplaytext,omitempty"`
-	Serviceofferingid          string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname        string `json:"serviceofferingname,omitempty"`
-	Size                       int64  `json:"size,omitempty"`
-	Snapshotid                 string `json:"snapshotid,omitempty"`
-	State                      string `json:"state,omitempty"`
-	Status                     string `json:"status,omitempty"`
-	Storage                    string `json:"storage,omitempty"`
-	Storageid                  string `json:"storageid,omitempty"`
-	Storagetype                string `json:"storagetype,omitempty"`
-	Tags                       []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Vmdisplayname    string `json:"vmdisplayname,omitempty"`
-	Vmname           string `json:"vmname,omitempty"`
-	Vmstate          string `json:"vmstate,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-	Zonename         string `json:"zonename,omitempty"`
+type UploadVolumeResponse struct {
+	JobID                      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Attached                   string                     `json:"attached,omitempty" xml:"attached,omitempty"`
+	Created                    Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Destroyed                  Bool                       `json:"destroyed,omitempty" xml:"destroyed,omitempty"`
+	Deviceid                   int64                      `json:"deviceid,omitempty" xml:"deviceid,omitempty"`
+	DiskBytesReadRate          int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate         int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate           int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate          int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext,omitempty" xml:"diskofferingdisplaytext,omitempty"`
+	Diskofferingid             string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname           string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayvolume              Bool                       `json:"displayvolume,omitempty" xml:"displayvolume,omitempty"`
+	Domain                     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hypervisor                 HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isextractable              Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Maxiops                    int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Miniops                    int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Path                       string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Pcidevicepath              string                     `json:"pcidevicepath,omitempty" xml:"pcidevicepath,omitempty"`
+	Project                    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiescevm                  Bool                       `json:"quiescevm,omitempty" xml:"quiescevm,omitempty"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext,omitempty" xml:"serviceofferingdisplaytext,omitempty"`
+	Serviceofferingid          string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname        string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Size                       Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Snapshotid                 string                     `json:"snapshotid,omitempty" xml:"snapshotid,omitempty"`
+	State                      string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status                     string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storage                    string                     `json:"storage,omitempty" xml:"storage,omitempty"`
+	Storageid                  string                     `json:"storageid,omitempty" xml:"storageid,omitempty"`
+	Storagetype                string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Tags                       []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                       string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid           string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Vmdisplayname              string                     `json:"vmdisplayname,omitempty" xml:"vmdisplayname,omitempty"`
+	Vmname                     string                     `json:"vmname,omitempty" xml:"vmname,omitempty"`
+	Vmstate                    string                     `json:"vmstate,omitempty" xml:"vmstate,omitempty"`
+	Zoneid                     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                   string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -17,1191 +17,761 @@
 package cloudstack43
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateZoneParams struct {
-	p map[string]interface{}
+type ZoneService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateZoneParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
-	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
-	}
-	if v, found := p.p["domain"]; found {
-		u.Set("domain", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["guestcidraddress"]; found {
-		u.Set("guestcidraddress", v.(string))
-	}
-	if v, found := p.p["internaldns1"]; found {
-		u.Set("internaldns1", v.(string))
-	}
-	if v, found := p.p["internaldns2"]; found {
-		u.Set("internaldns2", v.(string))
-	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
-	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
-	}
-	if v, found := p.p["localstorageenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("localstorageenabled", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networktype"]; found {
-		u.Set("networktype", v.(string))
-	}
-	if v, found := p.p["securitygroupenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("securitygroupenabled", vv)
-	}
-	return u
+func NewZoneService(cs *CloudStackClient) *ZoneService {
+	return &ZoneService{cs: cs}
 }
 
-func (p *CreateZoneParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
-}
+type ListDedicatedZonesParams = cloudstackcommon.ListDedicatedZonesParams
+type ListDedicatedZonesResponse = cloudstackcommon.ListDedicatedZonesResponse
+type DedicatedZone = cloudstackcommon.DedicatedZone
 
-func (p *CreateZoneParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
-	return
+// You should always use this function to get a new ListDedicatedZonesParams instance,
+// as then you are sure you have configured all required params
+func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
+	p := &ListDedicatedZonesParams{}
+	return p
 }
 
-func (p *CreateZoneParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// List dedicated zones.
+func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["dns2"] = v
-	return
-}
 
-func (p *CreateZoneParams) SetDomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listDedicatedZones", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["domain"] = v
-	return
-}
 
-func (p *CreateZoneParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListDedicatedZonesResponse
+	if err := s.cs.unmarshal("listDedicatedZones", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["domainid"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreateZoneParams) SetGuestcidraddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListDedicatedZones, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ZoneService) StreamDedicatedZones(ctx context.Context, p *ListDedicatedZonesParams, fn func(*DedicatedZone) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["guestcidraddress"] = v
-	return
-}
 
-func (p *CreateZoneParams) SetInternaldns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns1"] = v
-	return
+	return s.cs.streamList(ctx, "listDedicatedZones", p.URLValues(), "dedicatedzone", func(b json.RawMessage) error {
+		var v DedicatedZone
+		if err := s.cs.unmarshalElement("listDedicatedZones", "dedicatedzone", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *CreateZoneParams) SetInternaldns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns2"] = v
-	return
-}
+type ReleaseDedicatedZoneParams = cloudstackcommon.ReleaseDedicatedZoneParams
+type ReleaseDedicatedZoneResponse = cloudstackcommon.ReleaseDedicatedZoneResponse
 
-func (p *CreateZoneParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
-	return
+// You should always use this function to get a new ReleaseDedicatedZoneParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - zoneid: the ID of the zone
+func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams {
+	p := &ReleaseDedicatedZoneParams{}
+	p.SetZoneid(zoneid)
+	return p
 }
 
-func (p *CreateZoneParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Release dedication of zone
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListDedicatedZones.
+func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["ip6dns2"] = v
-	return
-}
 
-func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("releaseDedicatedZone", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["localstorageenabled"] = v
-	return
-}
 
-func (p *CreateZoneParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ReleaseDedicatedZoneResponse
+	if err := s.cs.unmarshal("releaseDedicatedZone", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *CreateZoneParams) SetNetworktype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networktype"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("releaseDedicatedZone", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["securitygroupenabled"] = v
-	return
-}
-
-// You should always use this function to get a new CreateZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams {
-	p := &CreateZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["dns1"] = dns1
-	p.p["internaldns1"] = internaldns1
-	p.p["name"] = name
-	p.p["networktype"] = networktype
-	return p
+	return &r, nil
 }
 
-// Creates a Zone.
-func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
-	resp, err := s.cs.newRequest("createZone", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r CreateZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+type AddVmwareDcParams struct {
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Vcenter  *string `json:"vcenter,omitempty" yaml:"vcenter,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-type CreateZoneResponse struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Description           string            `json:"description,omitempty"`
-	Dhcpprovider          string            `json:"dhcpprovider,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Dns1                  string            `json:"dns1,omitempty"`
-	Dns2                  string            `json:"dns2,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Domainname            string            `json:"domainname,omitempty"`
-	Guestcidraddress      string            `json:"guestcidraddress,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Internaldns1          string            `json:"internaldns1,omitempty"`
-	Internaldns2          string            `json:"internaldns2,omitempty"`
-	Ip6dns1               string            `json:"ip6dns1,omitempty"`
-	Ip6dns2               string            `json:"ip6dns2,omitempty"`
-	Localstorageenabled   bool              `json:"localstorageenabled,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networktype           string            `json:"networktype,omitempty"`
-	Resourcedetails       map[string]string `json:"resourcedetails,omitempty"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vlan      string `json:"vlan,omitempty"`
-	Zonetoken string `json:"zonetoken,omitempty"`
-}
-
-type UpdateZoneParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateZoneParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddVmwareDcParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["allocationstate"]; found {
-		u.Set("allocationstate", v.(string))
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["dhcpprovider"]; found {
-		u.Set("dhcpprovider", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["dns1"]; found {
-		u.Set("dns1", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
 	}
-	if v, found := p.p["dns2"]; found {
-		u.Set("dns2", v.(string))
+	if v := p.Vcenter; v != nil {
+		u.Set("vcenter", *v)
 	}
-	if v, found := p.p["dnssearchorder"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("dnssearchorder", vv)
-	}
-	if v, found := p.p["domain"]; found {
-		u.Set("domain", v.(string))
-	}
-	if v, found := p.p["guestcidraddress"]; found {
-		u.Set("guestcidraddress", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["internaldns1"]; found {
-		u.Set("internaldns1", v.(string))
-	}
-	if v, found := p.p["internaldns2"]; found {
-		u.Set("internaldns2", v.(string))
-	}
-	if v, found := p.p["ip6dns1"]; found {
-		u.Set("ip6dns1", v.(string))
-	}
-	if v, found := p.p["ip6dns2"]; found {
-		u.Set("ip6dns2", v.(string))
-	}
-	if v, found := p.p["ispublic"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("ispublic", vv)
-	}
-	if v, found := p.p["localstorageenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("localstorageenabled", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *UpdateZoneParams) SetAllocationstate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["allocationstate"] = v
-	return
+// Returns the name of the API command of these params
+func (p *AddVmwareDcParams) Command() string {
+	return "addVmwareDc"
 }
 
-func (p *UpdateZoneParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *AddVmwareDcParams) IsAsync() bool {
+	return false
 }
 
-func (p *UpdateZoneParams) SetDhcpprovider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dhcpprovider"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddVmwareDcParams) ResponseType() interface{} {
+	return &AddVmwareDcResponse{}
 }
 
-func (p *UpdateZoneParams) SetDns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns1"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddVmwareDcParams) Validate() error {
+	v := &validator{api: "addVmwareDc"}
+	v.required("name", p.Name != nil)
+	v.required("vcenter", p.Vcenter != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
 }
 
-func (p *UpdateZoneParams) SetDns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["dns2"] = v
+// This param is required.
+func (p *AddVmwareDcParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddVmwareDcParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["dnssearchorder"] = v
-	return
+	return *p.Name
 }
 
-func (p *UpdateZoneParams) SetDomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domain"] = v
-	return
+func (p *AddVmwareDcParams) HasName() bool {
+	return p.Name != nil
 }
 
-func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["guestcidraddress"] = v
-	return
+func (p *AddVmwareDcParams) ResetName() {
+	p.Name = nil
 }
 
-func (p *UpdateZoneParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Sets the 'password' param: the password.
+func (p *AddVmwareDcParams) SetPassword(v string) {
+	p.Password = &v
 	return
 }
 
-func (p *UpdateZoneParams) SetInternaldns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddVmwareDcParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	p.p["internaldns1"] = v
-	return
+	return *p.Password
 }
 
-func (p *UpdateZoneParams) SetInternaldns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["internaldns2"] = v
-	return
+func (p *AddVmwareDcParams) HasPassword() bool {
+	return p.Password != nil
 }
 
-func (p *UpdateZoneParams) SetIp6dns1(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns1"] = v
-	return
+func (p *AddVmwareDcParams) ResetPassword() {
+	p.Password = nil
 }
 
-func (p *UpdateZoneParams) SetIp6dns2(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ip6dns2"] = v
+// Sets the 'username' param: the name of the user.
+func (p *AddVmwareDcParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
-func (p *UpdateZoneParams) SetIspublic(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddVmwareDcParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
 	}
-	p.p["ispublic"] = v
-	return
+	return *p.Username
 }
 
-func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["localstorageenabled"] = v
+func (p *AddVmwareDcParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddVmwareDcParams) ResetUsername() {
+	p.Username = nil
+}
+
+// This param is required.
+func (p *AddVmwareDcParams) SetVcenter(v string) {
+	p.Vcenter = &v
 	return
 }
 
-func (p *UpdateZoneParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AddVmwareDcParams) GetVcenter() string {
+	if p.Vcenter == nil {
+		return ""
 	}
-	p.p["name"] = v
-	return
+	return *p.Vcenter
 }
 
-// You should always use this function to get a new UpdateZoneParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
-	p := &UpdateZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *AddVmwareDcParams) HasVcenter() bool {
+	return p.Vcenter != nil
 }
 
-// Updates a Zone.
-func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
-	resp, err := s.cs.newRequest("updateZone", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *AddVmwareDcParams) ResetVcenter() {
+	p.Vcenter = nil
+}
 
-	var r UpdateZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddVmwareDcParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
 }
 
-type UpdateZoneResponse struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Description           string            `json:"description,omitempty"`
-	Dhcpprovider          string            `json:"dhcpprovider,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Dns1                  string            `json:"dns1,omitempty"`
-	Dns2                  string            `json:"dns2,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Domainname            string            `json:"domainname,omitempty"`
-	Guestcidraddress      string            `json:"guestcidraddress,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Internaldns1          string            `json:"internaldns1,omitempty"`
-	Internaldns2          string            `json:"internaldns2,omitempty"`
-	Ip6dns1               string            `json:"ip6dns1,omitempty"`
-	Ip6dns2               string            `json:"ip6dns2,omitempty"`
-	Localstorageenabled   bool              `json:"localstorageenabled,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networktype           string            `json:"networktype,omitempty"`
-	Resourcedetails       map[string]string `json:"resourcedetails,omitempty"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vlan      string `json:"vlan,omitempty"`
-	Zonetoken string `json:"zonetoken,omitempty"`
-}
-
-type DeleteZoneParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteZoneParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func (p *AddVmwareDcParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+	return *p.Zoneid
 }
 
-func (p *DeleteZoneParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *AddVmwareDcParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-// You should always use this function to get a new DeleteZoneParams instance,
+func (p *AddVmwareDcParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new AddVmwareDcParams instance,
 // as then you are sure you have configured all required params
-func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
-	p := &DeleteZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - name
+//   - vcenter
+//   - zoneid: the ID of the zone
+func (s *ZoneService) NewAddVmwareDcParams(name string, vcenter string, zoneid string) *AddVmwareDcParams {
+	p := &AddVmwareDcParams{}
+	p.SetName(name)
+	p.SetVcenter(vcenter)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Deletes a Zone.
-func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
-	resp, err := s.cs.newRequest("deleteZone", p.toURLValues())
+// Adds a VMware datacenter to specified zone
+//
+// See also ListVmwareDcs, GetVmwareDcID.
+func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addVmwareDc", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddVmwareDcResponse
+	if err := s.cs.unmarshal("addVmwareDc", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteZoneResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+type AddVmwareDcResponse struct {
+	Id      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Vcenter string                     `json:"vcenter,omitempty" xml:"vcenter,omitempty"`
+	Zoneid  int64                      `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListZonesParams struct {
-	p map[string]interface{}
+type ListVmwareDcsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *ListZonesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVmwareDcsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["available"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("available", vv)
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["networktype"]; found {
-		u.Set("networktype", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["showcapacities"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("showcapacities", vv)
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListZonesParams) SetAvailable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["available"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListVmwareDcsParams) Command() string {
+	return "listVmwareDcs"
 }
 
-func (p *ListZonesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListVmwareDcsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListZonesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVmwareDcsParams) ResponseType() interface{} {
+	return &ListVmwareDcsResponse{}
 }
 
-func (p *ListZonesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVmwareDcsParams) Validate() error {
+	v := &validator{api: "listVmwareDcs"}
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
 }
 
-func (p *ListZonesParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVmwareDcsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListZonesParams) SetNetworktype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVmwareDcsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["networktype"] = v
-	return
+	return *p.Keyword
 }
 
-func (p *ListZonesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListVmwareDcsParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *ListZonesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListVmwareDcsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-func (p *ListZonesParams) SetShowcapacities(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["showcapacities"] = v
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVmwareDcsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListZonesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVmwareDcsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["tags"] = v
-	return
+	return *p.Page
 }
 
-// You should always use this function to get a new ListZonesParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewListZonesParams() *ListZonesParams {
-	p := &ListZonesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListVmwareDcsParams) HasPage() bool {
+	return p.Page != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetZoneID(name string) (string, error) {
-	p := &ListZonesParams{}
-	p.p = make(map[string]interface{})
+func (p *ListVmwareDcsParams) ResetPage() {
+	p.Page = nil
+}
 
-	p.p["name"] = name
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVmwareDcsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
 
-	l, err := s.ListZones(p)
-	if err != nil {
-		return "", err
+func (p *ListVmwareDcsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
+	return *p.Pagesize
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *ListVmwareDcsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
 
-	if l.Count == 1 {
-		return l.Zones[0].Id, nil
-	}
+func (p *ListVmwareDcsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.Zones {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *ListVmwareDcsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetZoneByName(name string) (*Zone, int, error) {
-	id, err := s.GetZoneID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *ListVmwareDcsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
+	return *p.Zoneid
+}
 
-	r, count, err := s.GetZoneByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *ListVmwareDcsParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetZoneByID(id string) (*Zone, int, error) {
-	p := &ListZonesParams{}
-	p.p = make(map[string]interface{})
+func (p *ListVmwareDcsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListVmwareDcsParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - zoneid: the ID of the zone
+func (s *ZoneService) NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams {
+	p := &ListVmwareDcsParams{}
+	p.SetZoneid(zoneid)
+	return p
+}
 
-	p.p["id"] = id
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, error) {
+	p := &ListVmwareDcsParams{}
 
-	l, err := s.ListZones(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+	p.SetKeyword(keyword)
+	p.SetZoneid(zoneid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
 		}
-		return nil, -1, err
+	}
+
+	l, err := s.ListVmwareDcs(p)
+	if err != nil {
+		return "", err
 	}
 
 	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
 
 	if l.Count == 1 {
-		return l.Zones[0], l.Count, nil
+		return l.VmwareDcs[0].Id, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
+
+	var matches []*VmwareDc
+	for _, v := range l.VmwareDcs {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "VmwareDc", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
-// Lists zones
-func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
-	resp, err := s.cs.newRequest("listZones", p.toURLValues())
+// Retrieves VMware DC(s) associated with a zone.
+//
+// See also GetVmwareDcID.
+func (s *ZoneService) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVmwareDcs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListZonesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListVmwareDcsResponse
+	if err := s.cs.unmarshal("listVmwareDcs", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListZonesResponse struct {
-	Count int     `json:"count"`
-	Zones []*Zone `json:"zone"`
-}
-
-type Zone struct {
-	Allocationstate string `json:"allocationstate,omitempty"`
-	Capacity        []struct {
-		Capacitytotal int64  `json:"capacitytotal,omitempty"`
-		Capacityused  int64  `json:"capacityused,omitempty"`
-		Clusterid     string `json:"clusterid,omitempty"`
-		Clustername   string `json:"clustername,omitempty"`
-		Percentused   string `json:"percentused,omitempty"`
-		Podid         string `json:"podid,omitempty"`
-		Podname       string `json:"podname,omitempty"`
-		Type          int    `json:"type,omitempty"`
-		Zoneid        string `json:"zoneid,omitempty"`
-		Zonename      string `json:"zonename,omitempty"`
-	} `json:"capacity,omitempty"`
-	Description           string            `json:"description,omitempty"`
-	Dhcpprovider          string            `json:"dhcpprovider,omitempty"`
-	Displaytext           string            `json:"displaytext,omitempty"`
-	Dns1                  string            `json:"dns1,omitempty"`
-	Dns2                  string            `json:"dns2,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Domainname            string            `json:"domainname,omitempty"`
-	Guestcidraddress      string            `json:"guestcidraddress,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Internaldns1          string            `json:"internaldns1,omitempty"`
-	Internaldns2          string            `json:"internaldns2,omitempty"`
-	Ip6dns1               string            `json:"ip6dns1,omitempty"`
-	Ip6dns2               string            `json:"ip6dns2,omitempty"`
-	Localstorageenabled   bool              `json:"localstorageenabled,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networktype           string            `json:"networktype,omitempty"`
-	Resourcedetails       map[string]string `json:"resourcedetails,omitempty"`
-	Securitygroupsenabled bool              `json:"securitygroupsenabled,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vlan      string `json:"vlan,omitempty"`
-	Zonetoken string `json:"zonetoken,omitempty"`
-}
+// Lists the objects like ListVmwareDcs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ZoneService) StreamVmwareDcs(ctx context.Context, p *ListVmwareDcsParams, fn func(*VmwareDc) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type AddVmwareDcParams struct {
-	p map[string]interface{}
+	return s.cs.streamList(ctx, "listVmwareDcs", p.URLValues(), "vmwaredc", func(b json.RawMessage) error {
+		var v VmwareDc
+		if err := s.cs.unmarshalElement("listVmwareDcs", "vmwaredc", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *AddVmwareDcParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	if v, found := p.p["vcenter"]; found {
-		u.Set("vcenter", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+type ListVmwareDcsResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	VmwareDcs []*VmwareDc                `json:"vmwaredc" xml:"vmwaredc"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *AddVmwareDcParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+type VmwareDc struct {
+	Id      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Vcenter string                     `json:"vcenter,omitempty" xml:"vcenter,omitempty"`
+	Zoneid  int64                      `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *AddVmwareDcParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["password"] = v
-	return
+type RemoveVmwareDcParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *AddVmwareDcParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveVmwareDcParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
-	p.p["username"] = v
-	return
+	return u
 }
 
-func (p *AddVmwareDcParams) SetVcenter(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vcenter"] = v
-	return
+// Returns the name of the API command of these params
+func (p *RemoveVmwareDcParams) Command() string {
+	return "removeVmwareDc"
 }
 
-func (p *AddVmwareDcParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *RemoveVmwareDcParams) IsAsync() bool {
+	return false
 }
 
-// You should always use this function to get a new AddVmwareDcParams instance,
-// as then you are sure you have configured all required params
-func (s *ZoneService) NewAddVmwareDcParams(name string, vcenter string, zoneid string) *AddVmwareDcParams {
-	p := &AddVmwareDcParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["vcenter"] = vcenter
-	p.p["zoneid"] = zoneid
-	return p
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveVmwareDcParams) ResponseType() interface{} {
+	return &RemoveVmwareDcResponse{}
 }
 
-// Adds a VMware datacenter to specified zone
-func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
-	resp, err := s.cs.newRequest("addVmwareDc", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r AddVmwareDcResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveVmwareDcParams) Validate() error {
+	v := &validator{api: "removeVmwareDc"}
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
 }
 
-type AddVmwareDcResponse struct {
-	Id      string `json:"id,omitempty"`
-	Name    string `json:"name,omitempty"`
-	Vcenter string `json:"vcenter,omitempty"`
-	Zoneid  int64  `json:"zoneid,omitempty"`
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *RemoveVmwareDcParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
 }
 
-type RemoveVmwareDcParams struct {
-	p map[string]interface{}
+func (p *RemoveVmwareDcParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
 }
 
-func (p *RemoveVmwareDcParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func (p *RemoveVmwareDcParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-func (p *RemoveVmwareDcParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+func (p *RemoveVmwareDcParams) ResetZoneid() {
+	p.Zoneid = nil
 }
 
 // You should always use this function to get a new RemoveVmwareDcParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - zoneid: the ID of the zone
 func (s *ZoneService) NewRemoveVmwareDcParams(zoneid string) *RemoveVmwareDcParams {
 	p := &RemoveVmwareDcParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Remove a VMware datacenter from a zone.
+//
+// See also ListVmwareDcs, GetVmwareDcID.
 func (s *ZoneService) RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
-	resp, err := s.cs.newRequest("removeVmwareDc", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("removeVmwareDc", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveVmwareDcResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("removeVmwareDc", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type RemoveVmwareDcResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListVmwareDcsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVmwareDcsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListVmwareDcsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
+type CreateZoneParams = cloudstackcommon.CreateZoneParams
+type CreateZoneResponse = cloudstackcommon.CreateZoneResponse
 
-func (p *ListVmwareDcsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListVmwareDcsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListVmwareDcsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
-
-// You should always use this function to get a new ListVmwareDcsParams instance,
+// You should always use this function to get a new CreateZoneParams instance,
 // as then you are sure you have configured all required params
-func (s *ZoneService) NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams {
-	p := &ListVmwareDcsParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - dns1
+//   - internaldns1
+//   - name
+//   - networktype
+func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams {
+	p := &CreateZoneParams{}
+	p.SetDns1(dns1)
+	p.SetInternaldns1(internaldns1)
+	p.SetName(name)
+	p.SetNetworktype(networktype)
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string) (string, error) {
-	p := &ListVmwareDcsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["keyword"] = keyword
-	p.p["zoneid"] = zoneid
-
-	l, err := s.ListVmwareDcs(p)
-	if err != nil {
-		return "", err
-	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
-	}
-
-	if l.Count == 1 {
-		return l.VmwareDcs[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.VmwareDcs {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+// Creates a Zone.
+//
+// See also ListZones, GetZoneID, GetZoneByName, GetZoneByID, GetZonesByTags.
+func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
-}
 
-// Retrieves VMware DC(s) associated with a zone.
-func (s *ZoneService) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
-	resp, err := s.cs.newRequest("listVmwareDcs", p.toURLValues())
+	resp, err := s.cs.newRequest("createZone", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListVmwareDcsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateZoneResponse
+	if err := s.cs.unmarshal("createZone", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListVmwareDcsResponse struct {
-	Count     int         `json:"count"`
-	VmwareDcs []*VmwareDc `json:"vmwaredc"`
-}
-
-type VmwareDc struct {
-	Id      string `json:"id,omitempty"`
-	Name    string `json:"name,omitempty"`
-	Vcenter string `json:"vcenter,omitempty"`
-	Zoneid  int64  `json:"zoneid,omitempty"`
-}
-
-type DedicateZoneParams struct {
-	p map[string]interface{}
-}
-
-func (p *DedicateZoneParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *DedicateZoneParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *DedicateZoneParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *DedicateZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type DedicateZoneParams = cloudstackcommon.DedicateZoneParams
+type DedicateZoneResponse = cloudstackcommon.DedicateZoneResponse
 
 // You should always use this function to get a new DedicateZoneParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - domainid: the ID of the domain
+//   - zoneid: the ID of the zone
 func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams {
 	p := &DedicateZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["domainid"] = domainid
-	p.p["zoneid"] = zoneid
+	p.SetDomainid(domainid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Dedicates a zones.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListZones, GetZoneID, GetZoneByName, GetZoneByID, GetZonesByTags.
 func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
-	resp, err := s.cs.newRequest("dedicateZone", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("dedicateZone", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicateZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("dedicateZone", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1222,215 +792,254 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("dedicateZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DedicateZoneResponse struct {
-	JobID           string `json:"jobid,omitempty"`
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Id              string `json:"id,omitempty"`
-	Zoneid          string `json:"zoneid,omitempty"`
-	Zonename        string `json:"zonename,omitempty"`
-}
+type DeleteZoneParams = cloudstackcommon.DeleteZoneParams
+type DeleteZoneResponse = cloudstackcommon.DeleteZoneResponse
 
-type ReleaseDedicatedZoneParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new DeleteZoneParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
+	p := &DeleteZoneParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Deletes a Zone.
+//
+// See also ListZones, GetZoneID, GetZoneByName, GetZoneByID, GetZonesByTags.
+func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+
+	resp, err := s.cs.newRequest("deleteZone", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteZoneResponse
+	if err := s.cs.unmarshal("deleteZone", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["zoneid"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new ReleaseDedicatedZoneParams instance,
+type ListZonesParams = cloudstackcommon.ListZonesParams
+type ListZonesResponse = cloudstackcommon.ListZonesResponse
+type Zone = cloudstackcommon.Zone
+
+// You should always use this function to get a new ListZonesParams instance,
 // as then you are sure you have configured all required params
-func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams {
-	p := &ReleaseDedicatedZoneParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+func (s *ZoneService) NewListZonesParams() *ListZonesParams {
+	p := &ListZonesParams{}
 	return p
 }
 
-// Release dedication of zone
-func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
-	resp, err := s.cs.newRequest("releaseDedicatedZone", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListZonesParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListZones(p)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var r ReleaseDedicatedZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+	if l.Count == 1 {
+		return l.Zones[0].Id, nil
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	var matches []*Zone
+	for _, v := range l.Zones {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
-	return &r, nil
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Zone", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-type ReleaseDedicatedZoneResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
+	id, err := s.GetZoneID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
 
-type ListDedicatedZonesParams struct {
-	p map[string]interface{}
+	r, count, err := s.GetZoneByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
 }
 
-func (p *ListDedicatedZonesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["affinitygroupid"]; found {
-		u.Set("affinitygroupid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+	p := &ListZonesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+
+	l, err := s.ListZones(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	return u
-}
 
-func (p *ListDedicatedZonesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.Zones[0], l.Count, nil
 	}
-	p.p["account"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for Zone UUID: %s!", id)
 }
 
-func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *ZoneService) GetZonesByTags(tags map[string]string, opts ...OptionFunc) ([]*Zone, error) {
+	p := &ListZonesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
-	p.p["affinitygroupid"] = v
-	return
-}
 
-func (p *ListDedicatedZonesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListZones(p)
+	if err != nil {
+		return nil, err
 	}
-	p.p["domainid"] = v
-	return
+
+	return l.Zones, nil
 }
 
-func (p *ListDedicatedZonesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists zones
+//
+// See also GetZoneID, GetZoneByName, GetZoneByID, GetZonesByTags.
+func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListDedicatedZonesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listZones", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListDedicatedZonesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListZonesResponse
+	if err := s.cs.unmarshal("listZones", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["pagesize"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListDedicatedZonesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListZones, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ZoneService) StreamZones(ctx context.Context, p *ListZonesParams, fn func(*Zone) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
+
+	return s.cs.streamList(ctx, "listZones", p.URLValues(), "zone", func(b json.RawMessage) error {
+		var v Zone
+		if err := s.cs.unmarshalElement("listZones", "zone", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new ListDedicatedZonesParams instance,
+type UpdateZoneParams = cloudstackcommon.UpdateZoneParams
+type UpdateZoneResponse = cloudstackcommon.UpdateZoneResponse
+
+// You should always use this function to get a new UpdateZoneParams instance,
 // as then you are sure you have configured all required params
-func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
-	p := &ListDedicatedZonesParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - id
+func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
+	p := &UpdateZoneParams{}
+	p.SetId(id)
 	return p
 }
 
-// List dedicated zones.
-func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedZones", p.toURLValues())
+// Updates a Zone.
+//
+// See also ListZones, GetZoneID, GetZoneByName, GetZoneByID, GetZonesByTags.
+func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateZone", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListDedicatedZonesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateZoneResponse
+	if err := s.cs.unmarshal("updateZone", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
-
-type ListDedicatedZonesResponse struct {
-	Count          int              `json:"count"`
-	DedicatedZones []*DedicatedZone `json:"dedicatedzone"`
-}
-
-type DedicatedZone struct {
-	Accountid       string `json:"accountid,omitempty"`
-	Affinitygroupid string `json:"affinitygroupid,omitempty"`
-	Domainid        string `json:"domainid,omitempty"`
-	Id              string `json:"id,omitempty"`
-	Zoneid          string `json:"zoneid,omitempty"`
-	Zonename        string `json:"zonename,omitempty"`
-}
@@ -0,0 +1,126 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Returns a client using a test server, which checks that the given API is called with the expected
+// and correctly signed params and returns the given response. Fields in the response that are not part of the response type
+// are an error, so the response types are checked as well. Call the returned function when done.
+func newTestClient(t *testing.T, api string, params url.Values, response string) (*CloudStackClient, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse the request: %v", err)
+		}
+		if command := r.Form.Get("command"); command != api {
+			t.Errorf("Expected command %s, got %s", api, command)
+		}
+		for k, v := range params {
+			if !reflect.DeepEqual(r.Form[k], v) {
+				t.Errorf("Expected param %s to be %v, got %v", k, v, r.Form[k])
+			}
+		}
+		if signature := r.Form.Get("signature"); signature != testSignature(r.Form) {
+			t.Errorf("Invalid signature %s", signature)
+		}
+		fmt.Fprint(w, response)
+	}))
+
+	cs := NewClient(ts.URL, "apikey", "secret", false)
+	cs.UnknownFields(RejectUnknownFields)
+	return cs, ts.Close
+}
+
+// Returns the signature of the params, as calculated by CloudStack using the secret of the test client
+func testSignature(params url.Values) string {
+	p := url.Values{}
+	for k, v := range params {
+		if k != "signature" {
+			p[k] = v
+		}
+	}
+	mac := hmac.New(sha1.New, []byte("secret"))
+	mac.Write([]byte(strings.Replace(strings.ToLower(p.Encode()), "+", "%20", -1)))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestSignedURLExpiry(t *testing.T) {
+	cs := NewClient("https://cloudstack.example.com/client/api", "apikey", "secret", false)
+	p := cs.Zone.NewListZonesParams()
+
+	tests := []struct {
+		expiry  time.Duration
+		version string
+	}{
+		{0, ""},
+		{10 * time.Minute, "3"},
+	}
+
+	for _, tt := range tests {
+		now := time.Now()
+		s, err := cs.SignedURL(p, tt.expiry)
+		if err != nil {
+			t.Fatalf("Failed to sign the URL: %v", err)
+		}
+		u, err := url.Parse(s)
+		if err != nil {
+			t.Fatalf("Failed to parse the signed URL: %v", err)
+		}
+		q := u.Query()
+		if q.Get("command") != "listZones" || q.Get("apikey") != "apikey" || q.Get("response") != "json" {
+			t.Errorf("Unexpected params of the signed URL: %v", q)
+		}
+		if q.Get("signature") != testSignature(q) {
+			t.Errorf("Invalid signature of %s", s)
+		}
+		if q.Get("signatureVersion") != tt.version {
+			t.Errorf("Expected signature version %q, got %s", tt.version, s)
+		}
+		if tt.expiry == 0 {
+			if q.Get("expires") != "" {
+				t.Errorf("Expected a URL without expiry, got %s", s)
+			}
+			continue
+		}
+		expires, err := time.Parse(expiresLayout, q.Get("expires"))
+		if err != nil {
+			t.Fatalf("Failed to parse the expiry date of %s: %v", s, err)
+		}
+		if d := expires.Sub(now); d < tt.expiry-time.Minute || d > tt.expiry+time.Minute {
+			t.Errorf("Expected the URL to expire in %s, got %s", tt.expiry, d)
+		}
+	}
+}
+
+// Checks the URL values of the params of the given API
+func checkURLValues(t *testing.T, api string, got, want url.Values) {
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected URL values for %s:\n got: %v\nwant: %v", api, got, want)
+	}
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package cloudstack43 is a client for the API of CloudStack 4.3. Create a client with NewClient or
+// NewAsyncClient, and use the services of the client to call the API commands.
+//
+// # Services
+//
+// The API commands are grouped into the following services:
+//
+//   - APIDiscovery (APIDiscoveryService): ListApis
+//   - Account (AccountService): CreateAccount, DeleteAccount, DisableAccount, EnableAccount, ListAccounts, LockAccount, UpdateAccount, DeleteAccountFromProject, AddAccountToProject, MarkDefaultZoneForAccount, ListProjectAccounts
+//   - Address (AddressService): AssociateIpAddress, DisassociateIpAddress, ListPublicIpAddresses
+//   - AffinityGroup (AffinityGroupService): CreateAffinityGroup, DeleteAffinityGroup, ListAffinityGroups, ListAffinityGroupTypes, UpdateVMAffinityGroup
+//   - Alert (AlertService): ArchiveAlerts, DeleteAlerts, GenerateAlert, ListAlerts
+//   - Asyncjob (AsyncjobService): ListAsyncJobs, QueryAsyncJobResult
+//   - AutoScale (AutoScaleService): CreateAutoScalePolicy, DeleteAutoScalePolicy, ListAutoScalePolicies, UpdateAutoScalePolicy, CreateAutoScaleVmGroup, DeleteAutoScaleVmGroup, DisableAutoScaleVmGroup, EnableAutoScaleVmGroup, ListAutoScaleVmGroups, UpdateAutoScaleVmGroup, CreateAutoScaleVmProfile, DeleteAutoScaleVmProfile, ListAutoScaleVmProfiles, UpdateAutoScaleVmProfile, CreateCondition, DeleteCondition, ListConditions, CreateCounter, DeleteCounter, ListCounters
+//   - Baremetal (BaremetalService): AddBaremetalDhcp, ListBaremetalDhcp, AddBaremetalPxeKickStartServer, AddBaremetalPxePingServer, ListBaremetalPxeServers
+//   - BigSwitchVNS (BigSwitchVNSService): AddBigSwitchVnsDevice, DeleteBigSwitchVnsDevice, ListBigSwitchVnsDevices
+//   - Certificate (CertificateService): UploadCustomCertificate
+//   - CloudIdentifier (CloudIdentifierService): GetCloudIdentifier
+//   - Cluster (ClusterService): AddCluster, DedicateCluster, DeleteCluster, ListClusters, UpdateCluster, ListDedicatedClusters, ReleaseDedicatedCluster
+//   - Configuration (ConfigurationService): ListCapabilities, ListConfigurations, UpdateConfiguration, ListDeploymentPlanners, AddLdapConfiguration, DeleteLdapConfiguration, ListLdapConfigurations
+//   - DiskOffering (DiskOfferingService): CreateDiskOffering, DeleteDiskOffering, ListDiskOfferings, UpdateDiskOffering
+//   - Domain (DomainService): CreateDomain, DeleteDomain, ListDomains, UpdateDomain, ListDomainChildren
+//   - Event (EventService): ArchiveEvents, DeleteEvents, ListEvents, ListEventTypes
+//   - ExtFirewall (ExtFirewallService): AddExternalFirewall, DeleteExternalFirewall, ListExternalFirewalls
+//   - ExtLoadBalancer (ExtLoadBalancerService): AddExternalLoadBalancer, DeleteExternalLoadBalancer, ListExternalLoadBalancers
+//   - ExternalDevice (ExternalDeviceService): AddCiscoAsa1000vResource, DeleteCiscoAsa1000vResource, ListCiscoAsa1000vResources, DeleteCiscoNexusVSM, DisableCiscoNexusVSM, EnableCiscoNexusVSM, ListCiscoNexusVSMs, AddCiscoVnmcResource, DeleteCiscoVnmcResource, ListCiscoVnmcResources
+//   - Firewall (FirewallService): CreateEgressFirewallRule, DeleteEgressFirewallRule, ListEgressFirewallRules, CreateFirewallRule, DeleteFirewallRule, ListFirewallRules, AddPaloAltoFirewall, ConfigurePaloAltoFirewall, DeletePaloAltoFirewall, ListPaloAltoFirewalls, CreatePortForwardingRule, DeletePortForwardingRule, ListPortForwardingRules, UpdatePortForwardingRule, AddSrxFirewall, ConfigureSrxFirewall, DeleteSrxFirewall, ListSrxFirewalls
+//   - GuestOS (GuestOSService): ListOsCategories, ListOsTypes
+//   - Host (HostService): AddBaremetalHost, ListDedicatedHosts, ReleaseDedicatedHost, AddHost, DedicateHost, DeleteHost, ListHosts, ReconnectHost, UpdateHost, PrepareHostForMaintenance, CancelHostMaintenance, UpdateHostPassword, ReleaseHostReservation, FindHostsForMigration, AddSecondaryStorage
+//   - Hypervisor (HypervisorService): ListHypervisors, ListHypervisorCapabilities, UpdateHypervisorCapabilities
+//   - ISO (ISOService): AttachIso, CopyIso, DeleteIso, DetachIso, ExtractIso, ListIsos, RegisterIso, UpdateIso, ListIsoPermissions, UpdateIsoPermissions
+//   - ImageStore (ImageStoreService): UpdateCloudToUseObjectStore, AddImageStore, DeleteImageStore, ListImageStores, CreateSecondaryStagingStore, DeleteSecondaryStagingStore, ListSecondaryStagingStores
+//   - InternalLB (InternalLBService): ConfigureInternalLoadBalancerElement, CreateInternalLoadBalancerElement, ListInternalLoadBalancerElements, ListInternalLoadBalancerVMs, StartInternalLoadBalancerVM, StopInternalLoadBalancerVM
+//   - LDAP (LDAPService): LdapCreateAccount
+//   - Limit (LimitService): GetApiLimit, ResetApiLimit, UpdateResourceCount, ListResourceLimits, UpdateResourceLimit
+//   - LoadBalancer (LoadBalancerService): RemoveCertFromLoadBalancer, AssignCertToLoadBalancer, AddF5LoadBalancer, ConfigureF5LoadBalancer, DeleteF5LoadBalancer, ListF5LoadBalancers, RemoveFromGlobalLoadBalancerRule, RemoveFromLoadBalancerRule, CreateGlobalLoadBalancerRule, DeleteGlobalLoadBalancerRule, ListGlobalLoadBalancerRules, UpdateGlobalLoadBalancerRule, CreateLBHealthCheckPolicy, DeleteLBHealthCheckPolicy, ListLBHealthCheckPolicies, CreateLBStickinessPolicy, DeleteLBStickinessPolicy, ListLBStickinessPolicies, CreateLoadBalancer, DeleteLoadBalancer, ListLoadBalancers, CreateLoadBalancerRule, DeleteLoadBalancerRule, ListLoadBalancerRules, UpdateLoadBalancerRule, ListLoadBalancerRuleInstances, AddNetscalerLoadBalancer, ConfigureNetscalerLoadBalancer, DeleteNetscalerLoadBalancer, ListNetscalerLoadBalancers, DeleteSslCert, ListSslCerts, UploadSslCert, AssignToGlobalLoadBalancerRule, AssignToLoadBalancerRule
+//   - NAT (NATService): CreateIpForwardingRule, DeleteIpForwardingRule, ListIpForwardingRules, DisableStaticNat, EnableStaticNat
+//   - NetworkACL (NetworkACLService): CreateNetworkACL, DeleteNetworkACL, ListNetworkACLs, UpdateNetworkACLItem, CreateNetworkACLList, DeleteNetworkACLList, ListNetworkACLLists, ReplaceNetworkACLList
+//   - NetworkDevice (NetworkDeviceService): AddNetworkDevice, DeleteNetworkDevice, ListNetworkDevice
+//   - NetworkOffering (NetworkOfferingService): CreateNetworkOffering, DeleteNetworkOffering, ListNetworkOfferings, UpdateNetworkOffering
+//   - Network (NetworkService): ListF5LoadBalancerNetworks, ListNetscalerLoadBalancerNetworks, CreateNetwork, DeleteNetwork, ListNetworks, RestartNetwork, UpdateNetwork, ListNetworkIsolationMethods, AddNetworkServiceProvider, DeleteNetworkServiceProvider, ListNetworkServiceProviders, UpdateNetworkServiceProvider, ListNiciraNvpDeviceNetworks, ListPaloAltoFirewallNetworks, CreatePhysicalNetwork, DeletePhysicalNetwork, ListPhysicalNetworks, UpdatePhysicalNetwork, DedicatePublicIpRange, ReleasePublicIpRange, ListSrxFirewallNetworks, CreateStorageNetworkIpRange, DeleteStorageNetworkIpRange, ListStorageNetworkIpRange, UpdateStorageNetworkIpRange, ListSupportedNetworkServices
+//   - Nic (NicService): RemoveIpFromNic, AddIpToNic, ListNics
+//   - NiciraNVP (NiciraNVPService): AddNiciraNvpDevice, DeleteNiciraNvpDevice, ListNiciraNvpDevices
+//   - Pod (PodService): ListDedicatedPods, ReleaseDedicatedPod, CreatePod, DedicatePod, DeletePod, ListPods, UpdatePod
+//   - Pool (PoolService): CreateStoragePool, DeleteStoragePool, ListStoragePools, UpdateStoragePool, FindStoragePoolsForMigration
+//   - PortableIP (PortableIPService): CreatePortableIpRange, DeletePortableIpRange, ListPortableIpRanges
+//   - Project (ProjectService): ActivateProject, CreateProject, DeleteProject, ListProjects, SuspendProject, UpdateProject, DeleteProjectInvitation, ListProjectInvitations, UpdateProjectInvitation
+//   - Region (RegionService): AddRegion, ListRegions, RemoveRegion, UpdateRegion
+//   - Resourcemetadata (ResourcemetadataService): AddResourceDetail, ListResourceDetails, RemoveResourceDetail
+//   - Resourcetags (ResourcetagsService): CreateTags, DeleteTags, ListTags
+//   - Router (RouterService): DestroyRouter, ListRouters, RebootRouter, StartRouter, StopRouter, ChangeServiceForRouter, ConfigureVirtualRouterElement, CreateVirtualRouterElement, ListVirtualRouterElements
+//   - S3 (S3Service): AddS3, ListS3s
+//   - SSH (SSHService): ResetSSHKeyForVirtualMachine, CreateSSHKeyPair, DeleteSSHKeyPair, ListSSHKeyPairs, RegisterSSHKeyPair
+//   - SecurityGroup (SecurityGroupService): CreateSecurityGroup, DeleteSecurityGroup, ListSecurityGroups, AuthorizeSecurityGroupEgress, RevokeSecurityGroupEgress, AuthorizeSecurityGroupIngress, RevokeSecurityGroupIngress
+//   - ServiceOffering (ServiceOfferingService): CreateServiceOffering, DeleteServiceOffering, ListServiceOfferings, UpdateServiceOffering
+//   - Snapshot (SnapshotService): CreateSnapshot, DeleteSnapshot, ListSnapshots, RevertSnapshot, CreateSnapshotPolicy, DeleteSnapshotPolicies, ListSnapshotPolicies, RevertToVMSnapshot, CreateVMSnapshot, DeleteVMSnapshot, ListVMSnapshot
+//   - StoragePool (StoragePoolService): CancelStorageMaintenance, EnableStorageMaintenance, ListStorageProviders
+//   - StratosphereSSP (StratosphereSSPService): AddStratosphereSsp
+//   - Swift (SwiftService): AddSwift, ListSwifts
+//   - SystemCapacity (SystemCapacityService): ListCapacity
+//   - SystemVM (SystemVMService): ChangeServiceForSystemVm, DestroySystemVm, ListSystemVms, MigrateSystemVm, RebootSystemVm, ScaleSystemVm, StartSystemVm, StopSystemVm
+//   - Template (TemplateService): UpgradeRouterTemplate, CopyTemplate, CreateTemplate, DeleteTemplate, ExtractTemplate, ListTemplates, PrepareTemplate, RegisterTemplate, UpdateTemplate, ListTemplatePermissions, UpdateTemplatePermissions, ListUcsTemplates, InstantiateUcsTemplateAndAssocaciateToBlade
+//   - UCS (UCSService): ListUcsBlades, RefreshUcsBlades, AddUcsManager, DeleteUcsManager, ListUcsManagers, ListUcsProfiles, DisassociateUcsProfileFromBlade, AssociateUcsProfileToBlade
+//   - Usage (UsageService): AddTrafficMonitor, DeleteTrafficMonitor, ListTrafficMonitors, AddTrafficType, DeleteTrafficType, ListTrafficTypes, UpdateTrafficType, ListTrafficTypeImplementors, GenerateUsageRecords, ListUsageRecords, ListUsageTypes
+//   - User (UserService): ImportLdapUsers, ListLdapUsers, CreateUser, DeleteUser, DisableUser, EnableUser, GetUser, ListUsers, LockUser, UpdateUser, RegisterUserKeys
+//   - VLAN (VLANService): ListDedicatedGuestVlanRanges, ReleaseDedicatedGuestVlanRange, DedicateGuestVlanRange, CreateVlanIpRange, DeleteVlanIpRange, ListVlanIpRanges
+//   - VMGroup (VMGroupService): CreateInstanceGroup, DeleteInstanceGroup, ListInstanceGroups, UpdateInstanceGroup
+//   - VPC (VPCService): CreatePrivateGateway, DeletePrivateGateway, ListPrivateGateways, CreateStaticRoute, DeleteStaticRoute, ListStaticRoutes, CreateVPC, DeleteVPC, ListVPCs, RestartVPC, UpdateVPC, CreateVPCOffering, DeleteVPCOffering, ListVPCOfferings, UpdateVPCOffering
+//   - VPN (VPNService): CreateRemoteAccessVpn, DeleteRemoteAccessVpn, ListRemoteAccessVpns, CreateVpnConnection, DeleteVpnConnection, ListVpnConnections, ResetVpnConnection, CreateVpnCustomerGateway, DeleteVpnCustomerGateway, ListVpnCustomerGateways, UpdateVpnCustomerGateway, CreateVpnGateway, DeleteVpnGateway, ListVpnGateways, AddVpnUser, ListVpnUsers, RemoveVpnUser
+//   - VirtualMachine (VirtualMachineService): UpdateDefaultNicForVirtualMachine, RemoveNicFromVirtualMachine, AddNicToVirtualMachine, ResetPasswordForVirtualMachine, ChangeServiceForVirtualMachine, GetVMPassword, CleanVMReservations, AssignVirtualMachine, DeployVirtualMachine, DestroyVirtualMachine, ExpungeVirtualMachine, ListVirtualMachines, MigrateVirtualMachine, RebootVirtualMachine, RecoverVirtualMachine, RestoreVirtualMachine, ScaleVirtualMachine, StartVirtualMachine, StopVirtualMachine, UpdateVirtualMachine, MigrateVirtualMachineWithVolume
+//   - Volume (VolumeService): AttachVolume, CreateVolume, DeleteVolume, DetachVolume, ExtractVolume, ListVolumes, MigrateVolume, ResizeVolume, UpdateVolume, UploadVolume
+//   - Zone (ZoneService): ListDedicatedZones, ReleaseDedicatedZone, AddVmwareDc, ListVmwareDcs, RemoveVmwareDc, CreateZone, DedicateZone, DeleteZone, ListZones, UpdateZone
+package cloudstack43
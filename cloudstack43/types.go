@@ -0,0 +1,281 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack43
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
+
+type ValidationError = cloudstackcommon.ValidationError
+type FieldError = cloudstackcommon.FieldError
+
+// Collects the errors found while validating the params of an API call
+type validator struct {
+	api    string
+	errors []*FieldError
+}
+
+func (v *validator) fail(param string, format string, args ...interface{}) {
+	v.errors = append(v.errors, &FieldError{Param: param, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(param string, set bool) {
+	if !set {
+		v.fail(param, "is required")
+	}
+}
+
+func (v *validator) enum(param string, value string, known bool, numeric bool) {
+	if _, err := strconv.Atoi(value); known || numeric && err == nil {
+		return
+	}
+	v.fail(param, "is not a known value: %s", value)
+}
+
+func (v *validator) exclusive(param string, set bool, other string, otherSet bool) {
+	if set && otherSet {
+		v.fail(param, "can not be used together with %s", other)
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{API: v.api, Errors: v.errors}
+}
+
+type OptionFunc = cloudstackcommon.OptionFunc
+type ScopeError = cloudstackcommon.ScopeError
+type AmbiguousError = cloudstackcommon.AmbiguousError
+type Candidate = cloudstackcommon.Candidate
+
+// Only searches the objects of the project with the given ID, or of all projects if the ID is '-1'
+func WithProject(id string) OptionFunc {
+	return cloudstackcommon.WithProject(id)
+}
+
+// Only searches the objects of the domain with the given ID
+func WithDomain(id string) OptionFunc {
+	return cloudstackcommon.WithDomain(id)
+}
+
+// Only searches the objects in the zone with the given ID
+func WithZone(id string) OptionFunc {
+	return cloudstackcommon.WithZone(id)
+}
+
+// Searches the objects of all accounts the caller has access to, instead of only the objects of the caller
+func WithListAll(listall bool) OptionFunc {
+	return cloudstackcommon.WithListAll(listall)
+}
+
+type ACLTrafficType = cloudstackcommon.ACLTrafficType
+
+const (
+	ACLTrafficTypeIngress = cloudstackcommon.ACLTrafficTypeIngress
+	ACLTrafficTypeEgress  = cloudstackcommon.ACLTrafficTypeEgress
+)
+
+type HypervisorType = cloudstackcommon.HypervisorType
+
+const (
+	HypervisorBareMetal = cloudstackcommon.HypervisorBareMetal
+	HypervisorHyperV    = cloudstackcommon.HypervisorHyperV
+	HypervisorKVM       = cloudstackcommon.HypervisorKVM
+	HypervisorLXC       = cloudstackcommon.HypervisorLXC
+	HypervisorOvm       = cloudstackcommon.HypervisorOvm
+	HypervisorSimulator = cloudstackcommon.HypervisorSimulator
+	HypervisorVMware    = cloudstackcommon.HypervisorVMware
+	HypervisorXenServer = cloudstackcommon.HypervisorXenServer
+)
+
+type LBAlgorithm = cloudstackcommon.LBAlgorithm
+
+const (
+	LBAlgorithmLeastConn  = cloudstackcommon.LBAlgorithmLeastConn
+	LBAlgorithmRoundRobin = cloudstackcommon.LBAlgorithmRoundRobin
+	LBAlgorithmSource     = cloudstackcommon.LBAlgorithmSource
+)
+
+type Protocol = cloudstackcommon.Protocol
+
+const (
+	ProtocolAll      = cloudstackcommon.ProtocolAll
+	ProtocolICMP     = cloudstackcommon.ProtocolICMP
+	ProtocolTCP      = cloudstackcommon.ProtocolTCP
+	ProtocolTCPProxy = cloudstackcommon.ProtocolTCPProxy
+	ProtocolUDP      = cloudstackcommon.ProtocolUDP
+)
+
+type SnapshotInterval = cloudstackcommon.SnapshotInterval
+
+const (
+	SnapshotIntervalDaily   = cloudstackcommon.SnapshotIntervalDaily
+	SnapshotIntervalHourly  = cloudstackcommon.SnapshotIntervalHourly
+	SnapshotIntervalManual  = cloudstackcommon.SnapshotIntervalManual
+	SnapshotIntervalMonthly = cloudstackcommon.SnapshotIntervalMonthly
+	SnapshotIntervalWeekly  = cloudstackcommon.SnapshotIntervalWeekly
+)
+
+type TaggedResourceType = cloudstackcommon.TaggedResourceType
+
+const (
+	TaggedResourceTypeAutoScaleVmGroup   = cloudstackcommon.TaggedResourceTypeAutoScaleVmGroup
+	TaggedResourceTypeAutoScaleVmProfile = cloudstackcommon.TaggedResourceTypeAutoScaleVmProfile
+	TaggedResourceTypeCustomerGateway    = cloudstackcommon.TaggedResourceTypeCustomerGateway
+	TaggedResourceTypeDiskOffering       = cloudstackcommon.TaggedResourceTypeDiskOffering
+	TaggedResourceTypeFirewallRule       = cloudstackcommon.TaggedResourceTypeFirewallRule
+	TaggedResourceTypeISO                = cloudstackcommon.TaggedResourceTypeISO
+	TaggedResourceTypeLoadBalancer       = cloudstackcommon.TaggedResourceTypeLoadBalancer
+	TaggedResourceTypeNetwork            = cloudstackcommon.TaggedResourceTypeNetwork
+	TaggedResourceTypeNetworkACL         = cloudstackcommon.TaggedResourceTypeNetworkACL
+	TaggedResourceTypeNetworkACLList     = cloudstackcommon.TaggedResourceTypeNetworkACLList
+	TaggedResourceTypeNic                = cloudstackcommon.TaggedResourceTypeNic
+	TaggedResourceTypePortForwardingRule = cloudstackcommon.TaggedResourceTypePortForwardingRule
+	TaggedResourceTypePrivateGateway     = cloudstackcommon.TaggedResourceTypePrivateGateway
+	TaggedResourceTypeProject            = cloudstackcommon.TaggedResourceTypeProject
+	TaggedResourceTypePublicIpAddress    = cloudstackcommon.TaggedResourceTypePublicIpAddress
+	TaggedResourceTypeRemoteAccessVpn    = cloudstackcommon.TaggedResourceTypeRemoteAccessVpn
+	TaggedResourceTypeSecurityGroup      = cloudstackcommon.TaggedResourceTypeSecurityGroup
+	TaggedResourceTypeServiceOffering    = cloudstackcommon.TaggedResourceTypeServiceOffering
+	TaggedResourceTypeSnapshot           = cloudstackcommon.TaggedResourceTypeSnapshot
+	TaggedResourceTypeStaticRoute        = cloudstackcommon.TaggedResourceTypeStaticRoute
+	TaggedResourceTypeStorage            = cloudstackcommon.TaggedResourceTypeStorage
+	TaggedResourceTypeTemplate           = cloudstackcommon.TaggedResourceTypeTemplate
+	TaggedResourceTypeUser               = cloudstackcommon.TaggedResourceTypeUser
+	TaggedResourceTypeUserVm             = cloudstackcommon.TaggedResourceTypeUserVm
+	TaggedResourceTypeVMSnapshot         = cloudstackcommon.TaggedResourceTypeVMSnapshot
+	TaggedResourceTypeVolume             = cloudstackcommon.TaggedResourceTypeVolume
+	TaggedResourceTypeVpc                = cloudstackcommon.TaggedResourceTypeVpc
+	TaggedResourceTypeVpnConnection      = cloudstackcommon.TaggedResourceTypeVpnConnection
+	TaggedResourceTypeVpnGateway         = cloudstackcommon.TaggedResourceTypeVpnGateway
+	TaggedResourceTypeZone               = cloudstackcommon.TaggedResourceTypeZone
+)
+
+type NetworkTrafficType = cloudstackcommon.NetworkTrafficType
+
+const (
+	TrafficTypeControl    = cloudstackcommon.TrafficTypeControl
+	TrafficTypeGuest      = cloudstackcommon.TrafficTypeGuest
+	TrafficTypeManagement = cloudstackcommon.TrafficTypeManagement
+	TrafficTypePublic     = cloudstackcommon.TrafficTypePublic
+	TrafficTypeStorage    = cloudstackcommon.TrafficTypeStorage
+)
+
+type VMState = cloudstackcommon.VMState
+
+const (
+	VMStateDestroyed  = cloudstackcommon.VMStateDestroyed
+	VMStateError      = cloudstackcommon.VMStateError
+	VMStateExpunging  = cloudstackcommon.VMStateExpunging
+	VMStateMigrating  = cloudstackcommon.VMStateMigrating
+	VMStateRunning    = cloudstackcommon.VMStateRunning
+	VMStateShutdowned = cloudstackcommon.VMStateShutdowned
+	VMStateStarting   = cloudstackcommon.VMStateStarting
+	VMStateStopped    = cloudstackcommon.VMStateStopped
+	VMStateStopping   = cloudstackcommon.VMStateStopping
+	VMStateUnknown    = cloudstackcommon.VMStateUnknown
+)
+
+type Bool = cloudstackcommon.Bool
+type Date = cloudstackcommon.Date
+type Float = cloudstackcommon.Float
+type Percentage = cloudstackcommon.Percentage
+type Size = cloudstackcommon.Size
+
+type IPToNetwork struct {
+	Ip        string `json:"ip,omitempty" yaml:"ip,omitempty"`
+	Ipv6      string `json:"ipv6,omitempty" yaml:"ipv6,omitempty"`
+	Networkid string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+}
+
+type ServiceCapability struct {
+	Service         string `json:"service,omitempty" yaml:"service,omitempty"`
+	Capabilitytype  string `json:"capabilitytype,omitempty" yaml:"capabilitytype,omitempty"`
+	Capabilityvalue string `json:"capabilityvalue,omitempty" yaml:"capabilityvalue,omitempty"`
+}
+
+type ServiceProvider struct {
+	Service  string `json:"service,omitempty" yaml:"service,omitempty"`
+	Provider string `json:"provider,omitempty" yaml:"provider,omitempty"`
+}
+
+type HealthCheckPolicy struct {
+	Description             string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Healthcheckinterval     int                        `json:"healthcheckinterval,omitempty" xml:"healthcheckinterval,omitempty"`
+	Healthcheckthresshold   int                        `json:"healthcheckthresshold,omitempty" xml:"healthcheckthresshold,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Pingpath                string                     `json:"pingpath,omitempty" xml:"pingpath,omitempty"`
+	Responsetime            int                        `json:"responsetime,omitempty" xml:"responsetime,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Unhealthcheckthresshold int                        `json:"unhealthcheckthresshold,omitempty" xml:"unhealthcheckthresshold,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type InternalLoadBalancerRule struct {
+	Instanceport int                        `json:"instanceport,omitempty" xml:"instanceport,omitempty"`
+	Sourceport   int                        `json:"sourceport,omitempty" xml:"sourceport,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type LoadBalancerInstance struct {
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	State     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Provider = cloudstackcommon.Provider
+
+type SecurityGroupRule struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr              string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Endport           int                        `json:"endport,omitempty" xml:"endport,omitempty"`
+	Icmpcode          int                        `json:"icmpcode,omitempty" xml:"icmpcode,omitempty"`
+	Icmptype          int                        `json:"icmptype,omitempty" xml:"icmptype,omitempty"`
+	Protocol          string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Ruleid            string                     `json:"ruleid,omitempty" xml:"ruleid,omitempty"`
+	Securitygroupname string                     `json:"securitygroupname,omitempty" xml:"securitygroupname,omitempty"`
+	Startport         int                        `json:"startport,omitempty" xml:"startport,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Service struct {
+	Capability []ServiceCapabilityValue   `json:"capability,omitempty" xml:"capability,omitempty"`
+	Name       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Provider   []Provider                 `json:"provider,omitempty" xml:"provider,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ServiceCapabilityValue = cloudstackcommon.ServiceCapabilityValue
+
+type StickinessPolicy struct {
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Methodname  string                     `json:"methodname,omitempty" xml:"methodname,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Params      map[string]string          `json:"params,omitempty" xml:"params,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
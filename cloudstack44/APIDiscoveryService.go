@@ -17,80 +17,66 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
-	"net/url"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type ListApisParams struct {
-	p map[string]interface{}
+type APIDiscoveryService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListApisParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
+func NewAPIDiscoveryService(cs *CloudStackClient) *APIDiscoveryService {
+	return &APIDiscoveryService{cs: cs}
 }
 
-func (p *ListApisParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type ListApisParams = cloudstackcommon.ListApisParams
+type ListApisResponse = cloudstackcommon.ListApisResponse
+type Api = cloudstackcommon.Api
 
 // You should always use this function to get a new ListApisParams instance,
 // as then you are sure you have configured all required params
 func (s *APIDiscoveryService) NewListApisParams() *ListApisParams {
 	p := &ListApisParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // lists all available apis on the server, provided by the Api Discovery plugin
 func (s *APIDiscoveryService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
-	resp, err := s.cs.newRequest("listApis", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listApis", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListApisResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listApis", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListApisResponse struct {
-	Count int    `json:"count"`
-	Apis  []*Api `json:"api"`
-}
+// Lists the objects like ListApis, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *APIDiscoveryService) StreamApis(ctx context.Context, p *ListApisParams, fn func(*Api) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type Api struct {
-	Description string `json:"description,omitempty"`
-	Isasync     bool   `json:"isasync,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Params      []struct {
-		Description string `json:"description,omitempty"`
-		Length      int    `json:"length,omitempty"`
-		Name        string `json:"name,omitempty"`
-		Related     string `json:"related,omitempty"`
-		Required    bool   `json:"required,omitempty"`
-		Since       string `json:"since,omitempty"`
-		Type        string `json:"type,omitempty"`
-	} `json:"params,omitempty"`
-	Related  string `json:"related,omitempty"`
-	Response []struct {
-		Description string   `json:"description,omitempty"`
-		Name        string   `json:"name,omitempty"`
-		Response    []string `json:"response,omitempty"`
-		Type        string   `json:"type,omitempty"`
-	} `json:"response,omitempty"`
-	Since string `json:"since,omitempty"`
-	Type  string `json:"type,omitempty"`
+	return s.cs.streamList(ctx, "listApis", p.URLValues(), "api", func(b json.RawMessage) error {
+		var v Api
+		if err := s.cs.unmarshalElement("listApis", "api", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -17,317 +17,518 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateAccountParams struct {
-	p map[string]interface{}
+type AccountService struct {
+	cs *CloudStackClient
+}
+
+func NewAccountService(cs *CloudStackClient) *AccountService {
+	return &AccountService{cs: cs}
 }
 
-func (p *CreateAccountParams) toURLValues() url.Values {
+type CreateAccountParams struct {
+	Account        *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Accountid      *string           `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Accounttype    *int              `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid       *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Email          *string           `json:"email,omitempty" yaml:"email,omitempty"`
+	Firstname      *string           `json:"firstname,omitempty" yaml:"firstname,omitempty"`
+	Lastname       *string           `json:"lastname,omitempty" yaml:"lastname,omitempty"`
+	Networkdomain  *string           `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Password       *string           `json:"password,omitempty" yaml:"password,omitempty"`
+	Timezone       *string           `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userid         *string           `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Username       *string           `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Accountdetails; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("accountdetails[0].%s", k), vv)
 		}
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if v := p.Accountid; v != nil {
+		u.Set("accountid", *v)
 	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Accounttype; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("accounttype", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if v := p.Email; v != nil {
+		u.Set("email", *v)
 	}
-	if v, found := p.p["firstname"]; found {
-		u.Set("firstname", v.(string))
+	if v := p.Firstname; v != nil {
+		u.Set("firstname", *v)
 	}
-	if v, found := p.p["lastname"]; found {
-		u.Set("lastname", v.(string))
+	if v := p.Lastname; v != nil {
+		u.Set("lastname", *v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
 	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if v := p.Timezone; v != nil {
+		u.Set("timezone", *v)
 	}
-	if v, found := p.p["userid"]; found {
-		u.Set("userid", v.(string))
+	if v := p.Userid; v != nil {
+		u.Set("userid", *v)
 	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *CreateAccountParams) Command() string {
+	return "createAccount"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateAccountParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateAccountParams) ResponseType() interface{} {
+	return &CreateAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateAccountParams) Validate() error {
+	v := &validator{api: "createAccount"}
+	v.required("accounttype", p.Accounttype != nil)
+	v.required("email", p.Email != nil)
+	v.required("firstname", p.Firstname != nil)
+	v.required("lastname", p.Lastname != nil)
+	v.required("password", p.Password != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *CreateAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["accountdetails"] = v
+	return *p.Account
+}
+
+func (p *CreateAccountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateAccountParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *CreateAccountParams) SetAccountdetails(v map[string]string) {
+	p.Accountdetails = v
 	return
 }
 
+func (p *CreateAccountParams) GetAccountdetails() map[string]string {
+	return p.Accountdetails
+}
+
+func (p *CreateAccountParams) HasAccountdetails() bool {
+	return p.Accountdetails != nil
+}
+
+func (p *CreateAccountParams) ResetAccountdetails() {
+	p.Accountdetails = nil
+}
+
+// Sets the 'accountid' param: the ID of the account.
 func (p *CreateAccountParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
+	p.Accountid = &v
 	return
 }
 
-func (p *CreateAccountParams) SetAccounttype(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetAccountid() string {
+	if p.Accountid == nil {
+		return ""
 	}
-	p.p["accounttype"] = v
+	return *p.Accountid
+}
+
+func (p *CreateAccountParams) HasAccountid() bool {
+	return p.Accountid != nil
+}
+
+func (p *CreateAccountParams) ResetAccountid() {
+	p.Accountid = nil
+}
+
+// Sets the 'accounttype' param: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin. This param is required.
+func (p *CreateAccountParams) SetAccounttype(v int) {
+	p.Accounttype = &v
 	return
 }
 
-func (p *CreateAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetAccounttype() int {
+	if p.Accounttype == nil {
+		return 0
 	}
-	p.p["domainid"] = v
+	return *p.Accounttype
+}
+
+func (p *CreateAccountParams) HasAccounttype() bool {
+	return p.Accounttype != nil
+}
+
+func (p *CreateAccountParams) ResetAccounttype() {
+	p.Accounttype = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateAccountParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["email"] = v
+	return *p.Domainid
+}
+
+func (p *CreateAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateAccountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateAccountParams) SetEmail(v string) {
+	p.Email = &v
 	return
 }
 
-func (p *CreateAccountParams) SetFirstname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetEmail() string {
+	if p.Email == nil {
+		return ""
 	}
-	p.p["firstname"] = v
+	return *p.Email
+}
+
+func (p *CreateAccountParams) HasEmail() bool {
+	return p.Email != nil
+}
+
+func (p *CreateAccountParams) ResetEmail() {
+	p.Email = nil
+}
+
+// This param is required.
+func (p *CreateAccountParams) SetFirstname(v string) {
+	p.Firstname = &v
 	return
 }
 
-func (p *CreateAccountParams) SetLastname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetFirstname() string {
+	if p.Firstname == nil {
+		return ""
 	}
-	p.p["lastname"] = v
+	return *p.Firstname
+}
+
+func (p *CreateAccountParams) HasFirstname() bool {
+	return p.Firstname != nil
+}
+
+func (p *CreateAccountParams) ResetFirstname() {
+	p.Firstname = nil
+}
+
+// This param is required.
+func (p *CreateAccountParams) SetLastname(v string) {
+	p.Lastname = &v
 	return
 }
 
-func (p *CreateAccountParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetLastname() string {
+	if p.Lastname == nil {
+		return ""
 	}
-	p.p["networkdomain"] = v
+	return *p.Lastname
+}
+
+func (p *CreateAccountParams) HasLastname() bool {
+	return p.Lastname != nil
+}
+
+func (p *CreateAccountParams) ResetLastname() {
+	p.Lastname = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *CreateAccountParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
 	return
 }
 
-func (p *CreateAccountParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
 	}
-	p.p["password"] = v
+	return *p.Networkdomain
+}
+
+func (p *CreateAccountParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *CreateAccountParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *CreateAccountParams) SetPassword(v string) {
+	p.Password = &v
 	return
 }
 
-func (p *CreateAccountParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
 	}
-	p.p["timezone"] = v
+	return *p.Password
+}
+
+func (p *CreateAccountParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *CreateAccountParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'timezone' param: the time zone, like America/New_York.
+func (p *CreateAccountParams) SetTimezone(v string) {
+	p.Timezone = &v
 	return
 }
 
-func (p *CreateAccountParams) SetUserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetTimezone() string {
+	if p.Timezone == nil {
+		return ""
 	}
-	p.p["userid"] = v
+	return *p.Timezone
+}
+
+func (p *CreateAccountParams) HasTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *CreateAccountParams) ResetTimezone() {
+	p.Timezone = nil
+}
+
+func (p *CreateAccountParams) SetUserid(v string) {
+	p.Userid = &v
 	return
 }
 
-func (p *CreateAccountParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAccountParams) GetUserid() string {
+	if p.Userid == nil {
+		return ""
 	}
-	p.p["username"] = v
+	return *p.Userid
+}
+
+func (p *CreateAccountParams) HasUserid() bool {
+	return p.Userid != nil
+}
+
+func (p *CreateAccountParams) ResetUserid() {
+	p.Userid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *CreateAccountParams) SetUsername(v string) {
+	p.Username = &v
 	return
 }
 
+func (p *CreateAccountParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *CreateAccountParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *CreateAccountParams) ResetUsername() {
+	p.Username = nil
+}
+
 // You should always use this function to get a new CreateAccountParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - accounttype: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin
+//   - email
+//   - firstname
+//   - lastname
+//   - password: the password
+//   - username: the name of the user
 func (s *AccountService) NewCreateAccountParams(accounttype int, email string, firstname string, lastname string, password string, username string) *CreateAccountParams {
 	p := &CreateAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["accounttype"] = accounttype
-	p.p["email"] = email
-	p.p["firstname"] = firstname
-	p.p["lastname"] = lastname
-	p.p["password"] = password
-	p.p["username"] = username
+	p.SetAccounttype(accounttype)
+	p.SetEmail(email)
+	p.SetFirstname(firstname)
+	p.SetLastname(lastname)
+	p.SetPassword(password)
+	p.SetUsername(username)
 	return p
 }
 
 // Creates an account
+//
+// See also ListAccounts, GetAccountID, GetAccountByName, GetAccountByID.
 func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
-	resp, err := s.cs.newRequest("createAccount", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createAccount", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type CreateAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
-}
-
-type DeleteAccountParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteAccountParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAccountParams = cloudstackcommon.DeleteAccountParams
+type DeleteAccountResponse = cloudstackcommon.DeleteAccountResponse
 
 // You should always use this function to get a new DeleteAccountParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams {
 	p := &DeleteAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a account, and all users associated with this account
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAccounts, GetAccountID, GetAccountByName, GetAccountByID.
 func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccount", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -343,274 +544,175 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteAccount", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteAccountResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type UpdateAccountParams struct {
-	p map[string]interface{}
+type DisableAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Lock     *bool   `json:"lock,omitempty" yaml:"lock,omitempty"`
 }
 
-func (p *UpdateAccountParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DisableAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["accountdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("accountdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("accountdetails[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["newname"]; found {
-		u.Set("newname", v.(string))
+	if v := p.Lock; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("lock", vv)
 	}
 	return u
 }
 
-func (p *UpdateAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DisableAccountParams) Command() string {
+	return "disableAccount"
 }
 
-func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountdetails"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *DisableAccountParams) IsAsync() bool {
+	return true
 }
 
-func (p *UpdateAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DisableAccountParams) ResponseType() interface{} {
+	return &DisableAccountResponse{}
 }
 
-func (p *UpdateAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DisableAccountParams) Validate() error {
+	v := &validator{api: "disableAccount"}
+	v.required("lock", p.Lock != nil)
+	return v.err()
 }
 
-func (p *UpdateAccountParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["networkdomain"] = v
+// Sets the 'account' param: the name of the account.
+func (p *DisableAccountParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *UpdateAccountParams) SetNewname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DisableAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["newname"] = v
-	return
+	return *p.Account
 }
 
-// You should always use this function to get a new UpdateAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewUpdateAccountParams(newname string) *UpdateAccountParams {
-	p := &UpdateAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["newname"] = newname
-	return p
+func (p *DisableAccountParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-// Updates account information for the authenticated user
-func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
-	resp, err := s.cs.newRequest("updateAccount", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *DisableAccountParams) ResetAccount() {
+	p.Account = nil
+}
 
-	var r UpdateAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// Sets the 'domainid' param: the ID of the domain.
+func (p *DisableAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
 }
 
-type UpdateAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
+func (p *DisableAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
 }
 
-type DisableAccountParams struct {
-	p map[string]interface{}
+func (p *DisableAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-func (p *DisableAccountParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["lock"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("lock", vv)
-	}
-	return u
+func (p *DisableAccountParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-func (p *DisableAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+func (p *DisableAccountParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *DisableAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *DisableAccountParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["domainid"] = v
-	return
+	return *p.Id
 }
 
-func (p *DisableAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *DisableAccountParams) HasId() bool {
+	return p.Id != nil
 }
 
+func (p *DisableAccountParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
 func (p *DisableAccountParams) SetLock(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lock"] = v
+	p.Lock = &v
 	return
 }
 
+func (p *DisableAccountParams) GetLock() bool {
+	if p.Lock == nil {
+		return false
+	}
+	return *p.Lock
+}
+
+func (p *DisableAccountParams) HasLock() bool {
+	return p.Lock != nil
+}
+
+func (p *DisableAccountParams) ResetLock() {
+	p.Lock = nil
+}
+
 // You should always use this function to get a new DisableAccountParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lock
 func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParams {
 	p := &DisableAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["lock"] = lock
+	p.SetLock(lock)
 	return p
 }
 
 // Disables an account
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAccounts, GetAccountID, GetAccountByName, GetAccountByID.
 func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
-	resp, err := s.cs.newRequest("disableAccount", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("disableAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("disableAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -631,7 +733,7 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("disableAccount", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -639,506 +741,583 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 }
 
 type DisableAccountResponse struct {
-	JobID                     string            `json:"jobid,omitempty"`
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type EnableAccountParams struct {
-	p map[string]interface{}
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *EnableAccountParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *EnableAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *EnableAccountParams) Command() string {
+	return "enableAccount"
+}
+
+// Returns true if the API command starts an async job
+func (p *EnableAccountParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *EnableAccountParams) ResponseType() interface{} {
+	return &EnableAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *EnableAccountParams) Validate() error {
+	v := &validator{api: "enableAccount"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *EnableAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *EnableAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *EnableAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *EnableAccountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *EnableAccountParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *EnableAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *EnableAccountParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *EnableAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Domainid
+}
+
+func (p *EnableAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *EnableAccountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *EnableAccountParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
+func (p *EnableAccountParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *EnableAccountParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *EnableAccountParams) ResetId() {
+	p.Id = nil
+}
+
 // You should always use this function to get a new EnableAccountParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
 	p := &EnableAccountParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Enables an account
+//
+// See also ListAccounts, GetAccountID, GetAccountByName, GetAccountByID.
 func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
-	resp, err := s.cs.newRequest("enableAccount", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("enableAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("enableAccount", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type EnableAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
-}
-
-type LockAccountParams struct {
-	p map[string]interface{}
-}
-
-func (p *LockAccountParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	return u
-}
-
-func (p *LockAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *LockAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-// You should always use this function to get a new LockAccountParams instance,
-// as then you are sure you have configured all required params
-func (s *AccountService) NewLockAccountParams(account string, domainid string) *LockAccountParams {
-	p := &LockAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
-	return p
-}
-
-// Locks an account
-func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
-	resp, err := s.cs.newRequest("lockAccount", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r LockAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type LockAccountResponse struct {
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListAccountsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListAccountsParams) toURLValues() url.Values {
+	Accounttype       *int64  `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Iscleanuprequired *bool   `json:"iscleanuprequired,omitempty" yaml:"iscleanuprequired,omitempty"`
+	Isrecursive       *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name              *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	State             *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAccountsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accounttype"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Accounttype; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("accounttype", vv)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["iscleanuprequired"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Iscleanuprequired; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("iscleanuprequired", vv)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListAccountsParams) Command() string {
+	return "listAccounts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListAccountsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAccountsParams) ResponseType() interface{} {
+	return &ListAccountsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAccountsParams) Validate() error {
+	v := &validator{api: "listAccounts"}
+	return v.err()
+}
+
+// Sets the 'accounttype' param: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin.
 func (p *ListAccountsParams) SetAccounttype(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accounttype"] = v
+	p.Accounttype = &v
 	return
 }
 
-func (p *ListAccountsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetAccounttype() int64 {
+	if p.Accounttype == nil {
+		return 0
 	}
-	p.p["domainid"] = v
+	return *p.Accounttype
+}
+
+func (p *ListAccountsParams) HasAccounttype() bool {
+	return p.Accounttype != nil
+}
+
+func (p *ListAccountsParams) ResetAccounttype() {
+	p.Accounttype = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListAccountsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListAccountsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Domainid
+}
+
+func (p *ListAccountsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListAccountsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListAccountsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["iscleanuprequired"] = v
+	return *p.Id
+}
+
+func (p *ListAccountsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListAccountsParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListAccountsParams) SetIscleanuprequired(v bool) {
+	p.Iscleanuprequired = &v
 	return
 }
 
-func (p *ListAccountsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetIscleanuprequired() bool {
+	if p.Iscleanuprequired == nil {
+		return false
 	}
-	p.p["isrecursive"] = v
+	return *p.Iscleanuprequired
+}
+
+func (p *ListAccountsParams) HasIscleanuprequired() bool {
+	return p.Iscleanuprequired != nil
+}
+
+func (p *ListAccountsParams) ResetIscleanuprequired() {
+	p.Iscleanuprequired = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListAccountsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListAccountsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListAccountsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListAccountsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAccountsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListAccountsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListAccountsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAccountsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListAccountsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListAccountsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["name"] = v
+	return *p.Listall
+}
+
+func (p *ListAccountsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListAccountsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListAccountsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListAccountsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Name
+}
+
+func (p *ListAccountsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListAccountsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAccountsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListAccountsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListAccountsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAccountsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAccountsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListAccountsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAccountsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["state"] = v
+	return *p.Pagesize
+}
+
+func (p *ListAccountsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAccountsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListAccountsParams) SetState(v string) {
+	p.State = &v
 	return
 }
 
+func (p *ListAccountsParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListAccountsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListAccountsParams) ResetState() {
+	p.State = nil
+}
+
 // You should always use this function to get a new ListAccountsParams instance,
 // as then you are sure you have configured all required params
 func (s *AccountService) NewListAccountsParams() *ListAccountsParams {
 	p := &ListAccountsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AccountService) GetAccountID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListAccountsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListAccounts(p)
 	if err != nil {
@@ -1153,24 +1332,37 @@ func (s *AccountService) GetAccountID(name string) (string, error) {
 		return l.Accounts[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Accounts {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Account
+	for _, v := range l.Accounts {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Account", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AccountService) GetAccountByName(name string) (*Account, int, error) {
-	id, err := s.GetAccountID(name)
+func (s *AccountService) GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error) {
+	id, err := s.GetAccountID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetAccountByID(id)
+	r, count, err := s.GetAccountByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1178,11 +1370,16 @@ func (s *AccountService) GetAccountByName(name string) (*Account, int, error) {
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AccountService) GetAccountByID(id string) (*Account, int, error) {
+func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error) {
 	p := &ListAccountsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListAccounts(p)
 	if err != nil {
@@ -1205,328 +1402,589 @@ func (s *AccountService) GetAccountByID(id string) (*Account, int, error) {
 }
 
 // Lists accounts and provides detailed account information for listed accounts
+//
+// See also GetAccountID, GetAccountByName, GetAccountByID.
 func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listAccounts", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listAccounts", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAccountsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listAccounts", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListAccounts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AccountService) StreamAccounts(ctx context.Context, p *ListAccountsParams, fn func(*Account) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listAccounts", p.URLValues(), "account", func(b json.RawMessage) error {
+		var v Account
+		if err := s.cs.unmarshalElement("listAccounts", "account", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListAccountsResponse struct {
-	Count    int        `json:"count"`
-	Accounts []*Account `json:"account"`
+	Count    int                        `json:"count" xml:"count"`
+	Accounts []*Account                 `json:"account" xml:"account"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type Account struct {
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type MarkDefaultZoneForAccountParams struct {
-	p map[string]interface{}
+type LockAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
 }
 
-func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *LockAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
 	return u
 }
 
-func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Returns the name of the API command of these params
+func (p *LockAccountParams) Command() string {
+	return "lockAccount"
+}
+
+// Returns true if the API command starts an async job
+func (p *LockAccountParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *LockAccountParams) ResponseType() interface{} {
+	return &LockAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *LockAccountParams) Validate() error {
+	v := &validator{api: "lockAccount"}
+	v.required("account", p.Account != nil)
+	v.required("domainid", p.Domainid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *LockAccountParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LockAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *LockAccountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *LockAccountParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *LockAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *LockAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Domainid
 }
 
-// You should always use this function to get a new MarkDefaultZoneForAccountParams instance,
+func (p *LockAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *LockAccountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// You should always use this function to get a new LockAccountParams instance,
 // as then you are sure you have configured all required params
-func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams {
-	p := &MarkDefaultZoneForAccountParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["domainid"] = domainid
-	p.p["zoneid"] = zoneid
+//
+// The required params are:
+//
+//   - account: the name of the account
+//   - domainid: the ID of the domain
+func (s *AccountService) NewLockAccountParams(account string, domainid string) *LockAccountParams {
+	p := &LockAccountParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
 	return p
 }
 
-// Marks a default zone for this account
-func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
-	resp, err := s.cs.newRequest("markDefaultZoneForAccount", p.toURLValues())
+// Locks an account
+//
+// See also ListAccounts, GetAccountID, GetAccountByName, GetAccountByID.
+func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("lockAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r MarkDefaultZoneForAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r LockAccountResponse
+	if err := s.cs.unmarshal("lockAccount", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+type LockAccountResponse struct {
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+type UpdateAccountParams struct {
+	Account        *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Domainid       *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id             *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Networkdomain  *string           `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Newname        *string           `json:"newname,omitempty" yaml:"newname,omitempty"`
 }
 
-type MarkDefaultZoneForAccountResponse struct {
-	JobID                     string            `json:"jobid,omitempty"`
-	Accountdetails            map[string]string `json:"accountdetails,omitempty"`
-	Accounttype               int               `json:"accounttype,omitempty"`
-	Cpuavailable              string            `json:"cpuavailable,omitempty"`
-	Cpulimit                  string            `json:"cpulimit,omitempty"`
-	Cputotal                  int64             `json:"cputotal,omitempty"`
-	Defaultzoneid             string            `json:"defaultzoneid,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Groups                    []string          `json:"groups,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Ipavailable               string            `json:"ipavailable,omitempty"`
-	Iplimit                   string            `json:"iplimit,omitempty"`
-	Iptotal                   int64             `json:"iptotal,omitempty"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired,omitempty"`
-	Isdefault                 bool              `json:"isdefault,omitempty"`
-	Memoryavailable           string            `json:"memoryavailable,omitempty"`
-	Memorylimit               string            `json:"memorylimit,omitempty"`
-	Memorytotal               int64             `json:"memorytotal,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkavailable          string            `json:"networkavailable,omitempty"`
-	Networkdomain             string            `json:"networkdomain,omitempty"`
-	Networklimit              string            `json:"networklimit,omitempty"`
-	Networktotal              int64             `json:"networktotal,omitempty"`
-	Primarystorageavailable   string            `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string            `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal,omitempty"`
-	Projectavailable          string            `json:"projectavailable,omitempty"`
-	Projectlimit              string            `json:"projectlimit,omitempty"`
-	Projecttotal              int64             `json:"projecttotal,omitempty"`
-	Receivedbytes             int64             `json:"receivedbytes,omitempty"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64             `json:"secondarystoragetotal,omitempty"`
-	Sentbytes                 int64             `json:"sentbytes,omitempty"`
-	Snapshotavailable         string            `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string            `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64             `json:"snapshottotal,omitempty"`
-	State                     string            `json:"state,omitempty"`
-	Templateavailable         string            `json:"templateavailable,omitempty"`
-	Templatelimit             string            `json:"templatelimit,omitempty"`
-	Templatetotal             int64             `json:"templatetotal,omitempty"`
-	User                      []struct {
-		Account             string `json:"account,omitempty"`
-		Accountid           string `json:"accountid,omitempty"`
-		Accounttype         int    `json:"accounttype,omitempty"`
-		Apikey              string `json:"apikey,omitempty"`
-		Created             string `json:"created,omitempty"`
-		Domain              string `json:"domain,omitempty"`
-		Domainid            string `json:"domainid,omitempty"`
-		Email               string `json:"email,omitempty"`
-		Firstname           string `json:"firstname,omitempty"`
-		Id                  string `json:"id,omitempty"`
-		Iscallerchilddomain bool   `json:"iscallerchilddomain,omitempty"`
-		Isdefault           bool   `json:"isdefault,omitempty"`
-		Lastname            string `json:"lastname,omitempty"`
-		Secretkey           string `json:"secretkey,omitempty"`
-		State               string `json:"state,omitempty"`
-		Timezone            string `json:"timezone,omitempty"`
-		Username            string `json:"username,omitempty"`
-	} `json:"user,omitempty"`
-	Vmavailable     string `json:"vmavailable,omitempty"`
-	Vmlimit         string `json:"vmlimit,omitempty"`
-	Vmrunning       int    `json:"vmrunning,omitempty"`
-	Vmstopped       int    `json:"vmstopped,omitempty"`
-	Vmtotal         int64  `json:"vmtotal,omitempty"`
-	Volumeavailable string `json:"volumeavailable,omitempty"`
-	Volumelimit     string `json:"volumelimit,omitempty"`
-	Volumetotal     int64  `json:"volumetotal,omitempty"`
-	Vpcavailable    string `json:"vpcavailable,omitempty"`
-	Vpclimit        string `json:"vpclimit,omitempty"`
-	Vpctotal        int64  `json:"vpctotal,omitempty"`
-}
-
-type AddAccountToProjectParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddAccountToProjectParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Accountdetails; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("accountdetails[0].%s", k), vv)
+		}
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["email"]; found {
-		u.Set("email", v.(string))
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Newname; v != nil {
+		u.Set("newname", *v)
 	}
 	return u
 }
 
-func (p *AddAccountToProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *UpdateAccountParams) Command() string {
+	return "updateAccount"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateAccountParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateAccountParams) ResponseType() interface{} {
+	return &UpdateAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateAccountParams) Validate() error {
+	v := &validator{api: "updateAccount"}
+	v.required("newname", p.Newname != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpdateAccountParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpdateAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["account"] = v
+	return *p.Account
+}
+
+func (p *UpdateAccountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpdateAccountParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *UpdateAccountParams) SetAccountdetails(v map[string]string) {
+	p.Accountdetails = v
 	return
 }
 
-func (p *AddAccountToProjectParams) SetEmail(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAccountParams) GetAccountdetails() map[string]string {
+	return p.Accountdetails
+}
+
+func (p *UpdateAccountParams) HasAccountdetails() bool {
+	return p.Accountdetails != nil
+}
+
+func (p *UpdateAccountParams) ResetAccountdetails() {
+	p.Accountdetails = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *UpdateAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *UpdateAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["email"] = v
+	return *p.Domainid
+}
+
+func (p *UpdateAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *UpdateAccountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *UpdateAccountParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *AddAccountToProjectParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAccountParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Id
+}
+
+func (p *UpdateAccountParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateAccountParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *UpdateAccountParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
 	return
 }
 
-// You should always use this function to get a new AddAccountToProjectParams instance,
+func (p *UpdateAccountParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
+	}
+	return *p.Networkdomain
+}
+
+func (p *UpdateAccountParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *UpdateAccountParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+// This param is required.
+func (p *UpdateAccountParams) SetNewname(v string) {
+	p.Newname = &v
+	return
+}
+
+func (p *UpdateAccountParams) GetNewname() string {
+	if p.Newname == nil {
+		return ""
+	}
+	return *p.Newname
+}
+
+func (p *UpdateAccountParams) HasNewname() bool {
+	return p.Newname != nil
+}
+
+func (p *UpdateAccountParams) ResetNewname() {
+	p.Newname = nil
+}
+
+// You should always use this function to get a new UpdateAccountParams instance,
 // as then you are sure you have configured all required params
-func (s *AccountService) NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams {
-	p := &AddAccountToProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+//
+// The required params are:
+//
+//   - newname
+func (s *AccountService) NewUpdateAccountParams(newname string) *UpdateAccountParams {
+	p := &UpdateAccountParams{}
+	p.SetNewname(newname)
 	return p
 }
 
-// Adds acoount to a project
-func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
-	resp, err := s.cs.newRequest("addAccountToProject", p.toURLValues())
+// Updates account information for the authenticated user
+//
+// See also ListAccounts, GetAccountID, GetAccountByName, GetAccountByID.
+func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateAccount", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r AddAccountToProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateAccountResponse
+	if err := s.cs.unmarshal("updateAccount", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type UpdateAccountResponse struct {
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAccountFromProjectParams = cloudstackcommon.DeleteAccountFromProjectParams
+type DeleteAccountFromProjectResponse = cloudstackcommon.DeleteAccountFromProjectResponse
+
+// You should always use this function to get a new DeleteAccountFromProjectParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - account: the name of the account
+//   - projectid: the ID of the project
+func (s *AccountService) NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams {
+	p := &DeleteAccountFromProjectParams{}
+	p.SetAccount(account)
+	p.SetProjectid(projectid)
+	return p
+}
+
+// Deletes account from the project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteAccountFromProject", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteAccountFromProjectResponse
+	if err := s.cs.unmarshal("deleteAccountFromProject", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1542,72 +2000,45 @@ func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteAccountFromProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddAccountToProjectResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type DeleteAccountFromProjectParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
+type AddAccountToProjectParams = cloudstackcommon.AddAccountToProjectParams
+type AddAccountToProjectResponse = cloudstackcommon.AddAccountToProjectResponse
 
-func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
-}
-
-// You should always use this function to get a new DeleteAccountFromProjectParams instance,
+// You should always use this function to get a new AddAccountToProjectParams instance,
 // as then you are sure you have configured all required params
-func (s *AccountService) NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams {
-	p := &DeleteAccountFromProjectParams{}
-	p.p = make(map[string]interface{})
-	p.p["account"] = account
-	p.p["projectid"] = projectid
+//
+// The required params are:
+//
+//   - projectid: the ID of the project
+func (s *AccountService) NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams {
+	p := &AddAccountToProjectParams{}
+	p.SetProjectid(projectid)
 	return p
 }
 
-// Deletes account from the project
-func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
-	resp, err := s.cs.newRequest("deleteAccountFromProject", p.toURLValues())
+// Adds acoount to a project
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *AccountService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addAccountToProject", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteAccountFromProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r AddAccountToProjectResponse
+	if err := s.cs.unmarshal("addAccountToProject", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1623,121 +2054,286 @@ func (s *AccountService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addAccountToProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteAccountFromProjectResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListProjectAccountsParams struct {
-	p map[string]interface{}
+type MarkDefaultZoneForAccountParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *ListProjectAccountsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *MarkDefaultZoneForAccountParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["role"]; found {
-		u.Set("role", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListProjectAccountsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *MarkDefaultZoneForAccountParams) Command() string {
+	return "markDefaultZoneForAccount"
 }
 
-func (p *ListProjectAccountsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Returns true if the API command starts an async job
+func (p *MarkDefaultZoneForAccountParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *MarkDefaultZoneForAccountParams) ResponseType() interface{} {
+	return &MarkDefaultZoneForAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *MarkDefaultZoneForAccountParams) Validate() error {
+	v := &validator{api: "markDefaultZoneForAccount"}
+	v.required("account", p.Account != nil)
+	v.required("domainid", p.Domainid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListProjectAccountsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *MarkDefaultZoneForAccountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Account
+}
+
+func (p *MarkDefaultZoneForAccountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *MarkDefaultZoneForAccountParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *MarkDefaultZoneForAccountParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListProjectAccountsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *MarkDefaultZoneForAccountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
+	return *p.Domainid
+}
+
+func (p *MarkDefaultZoneForAccountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *MarkDefaultZoneForAccountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *MarkDefaultZoneForAccountParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-func (p *ListProjectAccountsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *MarkDefaultZoneForAccountParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Zoneid
+}
+
+func (p *MarkDefaultZoneForAccountParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *MarkDefaultZoneForAccountParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new MarkDefaultZoneForAccountParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - account: the name of the account
+//   - domainid: the ID of the domain
+//   - zoneid: the ID of the zone
+func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams {
+	p := &MarkDefaultZoneForAccountParams{}
+	p.SetAccount(account)
+	p.SetDomainid(domainid)
+	p.SetZoneid(zoneid)
+	return p
 }
 
-func (p *ListProjectAccountsParams) SetRole(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Marks a default zone for this account
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["role"] = v
-	return
+
+	resp, err := s.cs.newRequest("markDefaultZoneForAccount", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r MarkDefaultZoneForAccountResponse
+	if err := s.cs.unmarshal("markDefaultZoneForAccount", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("markDefaultZoneForAccount", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
+type MarkDefaultZoneForAccountResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountdetails            map[string]string          `json:"accountdetails,omitempty" xml:"accountdetails,omitempty"`
+	Accounttype               int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Defaultzoneid             string                     `json:"defaultzoneid,omitempty" xml:"defaultzoneid,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Groups                    []string                   `json:"groups,omitempty" xml:"groups,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Iscleanuprequired         Bool                       `json:"iscleanuprequired,omitempty" xml:"iscleanuprequired,omitempty"`
+	Isdefault                 Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networkdomain             string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Projectavailable          string                     `json:"projectavailable,omitempty" xml:"projectavailable,omitempty"`
+	Projectlimit              string                     `json:"projectlimit,omitempty" xml:"projectlimit,omitempty"`
+	Projecttotal              int64                      `json:"projecttotal,omitempty" xml:"projecttotal,omitempty"`
+	Receivedbytes             int64                      `json:"receivedbytes,omitempty" xml:"receivedbytes,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Sentbytes                 int64                      `json:"sentbytes,omitempty" xml:"sentbytes,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	User                      []User                     `json:"user,omitempty" xml:"user,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListProjectAccountsParams = cloudstackcommon.ListProjectAccountsParams
+type ListProjectAccountsResponse = cloudstackcommon.ListProjectAccountsResponse
+type ProjectAccount = cloudstackcommon.ProjectAccount
+
 // You should always use this function to get a new ListProjectAccountsParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - projectid: the ID of the project
 func (s *AccountService) NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams {
 	p := &ListProjectAccountsParams{}
-	p.p = make(map[string]interface{})
-	p.p["projectid"] = projectid
+	p.SetProjectid(projectid)
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AccountService) GetProjectAccountID(keyword string, projectid string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *AccountService) GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, error) {
 	p := &ListProjectAccountsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["projectid"] = projectid
+	p.SetKeyword(keyword)
+	p.SetProjectid(projectid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListProjectAccounts(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListProjectAccounts(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", keyword, l)
 	}
@@ -1746,88 +2342,66 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string) (
 		return l.ProjectAccounts[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.ProjectAccounts {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*ProjectAccount
+	for _, v := range l.ProjectAccounts {
+		if v.Name == keyword {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "ProjectAccount", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists project's accounts
+//
+// See also GetProjectAccountID.
 func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectAccounts", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listProjectAccounts", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectAccountsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listProjectAccounts", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListProjectAccountsResponse struct {
-	Count           int               `json:"count"`
-	ProjectAccounts []*ProjectAccount `json:"projectaccount"`
-}
-
-type ProjectAccount struct {
-	Account                   string `json:"account,omitempty"`
-	Cpuavailable              string `json:"cpuavailable,omitempty"`
-	Cpulimit                  string `json:"cpulimit,omitempty"`
-	Cputotal                  int64  `json:"cputotal,omitempty"`
-	Displaytext               string `json:"displaytext,omitempty"`
-	Domain                    string `json:"domain,omitempty"`
-	Domainid                  string `json:"domainid,omitempty"`
-	Id                        string `json:"id,omitempty"`
-	Ipavailable               string `json:"ipavailable,omitempty"`
-	Iplimit                   string `json:"iplimit,omitempty"`
-	Iptotal                   int64  `json:"iptotal,omitempty"`
-	Memoryavailable           string `json:"memoryavailable,omitempty"`
-	Memorylimit               string `json:"memorylimit,omitempty"`
-	Memorytotal               int64  `json:"memorytotal,omitempty"`
-	Name                      string `json:"name,omitempty"`
-	Networkavailable          string `json:"networkavailable,omitempty"`
-	Networklimit              string `json:"networklimit,omitempty"`
-	Networktotal              int64  `json:"networktotal,omitempty"`
-	Primarystorageavailable   string `json:"primarystorageavailable,omitempty"`
-	Primarystoragelimit       string `json:"primarystoragelimit,omitempty"`
-	Primarystoragetotal       int64  `json:"primarystoragetotal,omitempty"`
-	Secondarystorageavailable string `json:"secondarystorageavailable,omitempty"`
-	Secondarystoragelimit     string `json:"secondarystoragelimit,omitempty"`
-	Secondarystoragetotal     int64  `json:"secondarystoragetotal,omitempty"`
-	Snapshotavailable         string `json:"snapshotavailable,omitempty"`
-	Snapshotlimit             string `json:"snapshotlimit,omitempty"`
-	Snapshottotal             int64  `json:"snapshottotal,omitempty"`
-	State                     string `json:"state,omitempty"`
-	Tags                      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templateavailable string `json:"templateavailable,omitempty"`
-	Templatelimit     string `json:"templatelimit,omitempty"`
-	Templatetotal     int64  `json:"templatetotal,omitempty"`
-	Vmavailable       string `json:"vmavailable,omitempty"`
-	Vmlimit           string `json:"vmlimit,omitempty"`
-	Vmrunning         int    `json:"vmrunning,omitempty"`
-	Vmstopped         int    `json:"vmstopped,omitempty"`
-	Vmtotal           int64  `json:"vmtotal,omitempty"`
-	Volumeavailable   string `json:"volumeavailable,omitempty"`
-	Volumelimit       string `json:"volumelimit,omitempty"`
-	Volumetotal       int64  `json:"volumetotal,omitempty"`
-	Vpcavailable      string `json:"vpcavailable,omitempty"`
-	Vpclimit          string `json:"vpclimit,omitempty"`
-	Vpctotal          int64  `json:"vpctotal,omitempty"`
+// Lists the objects like ListProjectAccounts, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AccountService) StreamProjectAccounts(ctx context.Context, p *ListProjectAccountsParams, fn func(*ProjectAccount) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listProjectAccounts", p.URLValues(), "projectaccount", func(b json.RawMessage) error {
+		var v ProjectAccount
+		if err := s.cs.unmarshalElement("listProjectAccounts", "projectaccount", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
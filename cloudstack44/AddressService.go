@@ -17,144 +17,305 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type AddressService struct {
+	cs *CloudStackClient
+}
+
+func NewAddressService(cs *CloudStackClient) *AddressService {
+	return &AddressService{cs: cs}
+}
+
 type AssociateIpAddressParams struct {
-	p map[string]interface{}
+	Account    *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid   *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Isportable *bool   `json:"isportable,omitempty" yaml:"isportable,omitempty"`
+	Networkid  *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Projectid  *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Regionid   *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+	Vpcid      *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *AssociateIpAddressParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AssociateIpAddressParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["isportable"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isportable; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isportable", vv)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["regionid"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Regionid; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("regionid", vv)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *AssociateIpAddressParams) Command() string {
+	return "associateIpAddress"
+}
+
+// Returns true if the API command starts an async job
+func (p *AssociateIpAddressParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AssociateIpAddressParams) ResponseType() interface{} {
+	return &AssociateIpAddressResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AssociateIpAddressParams) Validate() error {
+	v := &validator{api: "associateIpAddress"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *AssociateIpAddressParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Account
+}
+
+func (p *AssociateIpAddressParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *AssociateIpAddressParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *AssociateIpAddressParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *AssociateIpAddressParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *AssociateIpAddressParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *AssociateIpAddressParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetIsportable(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["isportable"] = v
+	return *p.Fordisplay
+}
+
+func (p *AssociateIpAddressParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *AssociateIpAddressParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *AssociateIpAddressParams) SetIsportable(v bool) {
+	p.Isportable = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetIsportable() bool {
+	if p.Isportable == nil {
+		return false
 	}
-	p.p["networkid"] = v
+	return *p.Isportable
+}
+
+func (p *AssociateIpAddressParams) HasIsportable() bool {
+	return p.Isportable != nil
+}
+
+func (p *AssociateIpAddressParams) ResetIsportable() {
+	p.Isportable = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *AssociateIpAddressParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Networkid
+}
+
+func (p *AssociateIpAddressParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *AssociateIpAddressParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *AssociateIpAddressParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetRegionid(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["regionid"] = v
+	return *p.Projectid
+}
+
+func (p *AssociateIpAddressParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *AssociateIpAddressParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *AssociateIpAddressParams) SetRegionid(v int) {
+	p.Regionid = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetRegionid() int {
+	if p.Regionid == nil {
+		return 0
 	}
-	p.p["vpcid"] = v
+	return *p.Regionid
+}
+
+func (p *AssociateIpAddressParams) HasRegionid() bool {
+	return p.Regionid != nil
+}
+
+func (p *AssociateIpAddressParams) ResetRegionid() {
+	p.Regionid = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *AssociateIpAddressParams) SetVpcid(v string) {
+	p.Vpcid = &v
 	return
 }
 
-func (p *AssociateIpAddressParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *AssociateIpAddressParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Vpcid
+}
+
+func (p *AssociateIpAddressParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *AssociateIpAddressParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *AssociateIpAddressParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *AssociateIpAddressParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AssociateIpAddressParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AssociateIpAddressParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new AssociateIpAddressParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams {
 	p := &AssociateIpAddressParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Acquires and associates a public IP to an account.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("associateIpAddress", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("associateIpAddress", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssociateIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("associateIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -175,7 +336,7 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("associateIpAddress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -183,91 +344,231 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 }
 
 type AssociateIpAddressResponse struct {
-	JobID                 string `json:"jobid,omitempty"`
-	Account               string `json:"account,omitempty"`
-	Allocated             string `json:"allocated,omitempty"`
-	Associatednetworkid   string `json:"associatednetworkid,omitempty"`
-	Associatednetworkname string `json:"associatednetworkname,omitempty"`
-	Domain                string `json:"domain,omitempty"`
-	Domainid              string `json:"domainid,omitempty"`
-	Fordisplay            bool   `json:"fordisplay,omitempty"`
-	Forvirtualnetwork     bool   `json:"forvirtualnetwork,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Ipaddress             string `json:"ipaddress,omitempty"`
-	Isportable            bool   `json:"isportable,omitempty"`
-	Issourcenat           bool   `json:"issourcenat,omitempty"`
-	Isstaticnat           bool   `json:"isstaticnat,omitempty"`
-	Issystem              bool   `json:"issystem,omitempty"`
-	Networkid             string `json:"networkid,omitempty"`
-	Physicalnetworkid     string `json:"physicalnetworkid,omitempty"`
-	Project               string `json:"project,omitempty"`
-	Projectid             string `json:"projectid,omitempty"`
-	Purpose               string `json:"purpose,omitempty"`
-	State                 string `json:"state,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vlanid                    string `json:"vlanid,omitempty"`
-	Vlanname                  string `json:"vlanname,omitempty"`
-	Vmipaddress               string `json:"vmipaddress,omitempty"`
-	Vpcid                     string `json:"vpcid,omitempty"`
-	Zoneid                    string `json:"zoneid,omitempty"`
-	Zonename                  string `json:"zonename,omitempty"`
-}
-
-type DisassociateIpAddressParams struct {
-	p map[string]interface{}
-}
-
-func (p *DisassociateIpAddressParams) toURLValues() url.Values {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Allocated                 string                     `json:"allocated,omitempty" xml:"allocated,omitempty"`
+	Associatednetworkid       string                     `json:"associatednetworkid,omitempty" xml:"associatednetworkid,omitempty"`
+	Associatednetworkname     string                     `json:"associatednetworkname,omitempty" xml:"associatednetworkname,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay                Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Forvirtualnetwork         Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isportable                Bool                       `json:"isportable,omitempty" xml:"isportable,omitempty"`
+	Issourcenat               Bool                       `json:"issourcenat,omitempty" xml:"issourcenat,omitempty"`
+	Isstaticnat               Bool                       `json:"isstaticnat,omitempty" xml:"isstaticnat,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                 string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Purpose                   string                     `json:"purpose,omitempty" xml:"purpose,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vlanid                    string                     `json:"vlanid,omitempty" xml:"vlanid,omitempty"`
+	Vlanname                  string                     `json:"vlanname,omitempty" xml:"vlanname,omitempty"`
+	Vmipaddress               string                     `json:"vmipaddress,omitempty" xml:"vmipaddress,omitempty"`
+	Vpcid                     string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                  string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DisassociateIpAddressParams = cloudstackcommon.DisassociateIpAddressParams
+type DisassociateIpAddressResponse = cloudstackcommon.DisassociateIpAddressResponse
+
+// You should always use this function to get a new DisassociateIpAddressParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *AddressService) NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams {
+	p := &DisassociateIpAddressParams{}
+	p.SetId(id)
+	return p
+}
+
+// Disassociates an ip address from the account.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("disassociateIpAddress", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DisassociateIpAddressResponse
+	if err := s.cs.unmarshal("disassociateIpAddress", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("disassociateIpAddress", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type UpdateIpAddressParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateIpAddressParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *DisassociateIpAddressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *UpdateIpAddressParams) Command() string {
+	return "updateIpAddress"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateIpAddressParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateIpAddressParams) ResponseType() interface{} {
+	return &UpdateIpAddressResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateIpAddressParams) Validate() error {
+	v := &validator{api: "updateIpAddress"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateIpAddressParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateIpAddressParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Customid
+}
+
+func (p *UpdateIpAddressParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateIpAddressParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-// You should always use this function to get a new DisassociateIpAddressParams instance,
+func (p *UpdateIpAddressParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateIpAddressParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateIpAddressParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateIpAddressParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateIpAddressParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateIpAddressParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateIpAddressParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateIpAddressParams instance,
 // as then you are sure you have configured all required params
-func (s *AddressService) NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams {
-	p := &DisassociateIpAddressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressParams {
+	p := &UpdateIpAddressParams{}
+	p.SetId(id)
 	return p
 }
 
-// Disassociates an ip address from the account.
-func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("disassociateIpAddress", p.toURLValues())
+// Updates an ip address
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateIpAddress", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DisassociateIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateIpAddressResponse
+	if err := s.cs.unmarshal("updateIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -283,302 +584,655 @@ func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateIpAddress", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DisassociateIpAddressResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type UpdateIpAddressResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Allocated                 string                     `json:"allocated,omitempty" xml:"allocated,omitempty"`
+	Associatednetworkid       string                     `json:"associatednetworkid,omitempty" xml:"associatednetworkid,omitempty"`
+	Associatednetworkname     string                     `json:"associatednetworkname,omitempty" xml:"associatednetworkname,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay                Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Forvirtualnetwork         Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isportable                Bool                       `json:"isportable,omitempty" xml:"isportable,omitempty"`
+	Issourcenat               Bool                       `json:"issourcenat,omitempty" xml:"issourcenat,omitempty"`
+	Isstaticnat               Bool                       `json:"isstaticnat,omitempty" xml:"isstaticnat,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                 string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Purpose                   string                     `json:"purpose,omitempty" xml:"purpose,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vlanid                    string                     `json:"vlanid,omitempty" xml:"vlanid,omitempty"`
+	Vlanname                  string                     `json:"vlanname,omitempty" xml:"vlanname,omitempty"`
+	Vmipaddress               string                     `json:"vmipaddress,omitempty" xml:"vmipaddress,omitempty"`
+	Vpcid                     string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                  string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListPublicIpAddressesParams struct {
-	p map[string]interface{}
+	Account             *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Allocatedonly       *bool             `json:"allocatedonly,omitempty" yaml:"allocatedonly,omitempty"`
+	Associatednetworkid *string           `json:"associatednetworkid,omitempty" yaml:"associatednetworkid,omitempty"`
+	Domainid            *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay          *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Forloadbalancing    *bool             `json:"forloadbalancing,omitempty" yaml:"forloadbalancing,omitempty"`
+	Forvirtualnetwork   *bool             `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Id                  *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress           *string           `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Isrecursive         *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Issourcenat         *bool             `json:"issourcenat,omitempty" yaml:"issourcenat,omitempty"`
+	Isstaticnat         *bool             `json:"isstaticnat,omitempty" yaml:"isstaticnat,omitempty"`
+	Keyword             *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall             *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page                *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize            *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid   *string           `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid           *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags                map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vlanid              *string           `json:"vlanid,omitempty" yaml:"vlanid,omitempty"`
+	Vpcid               *string           `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid              *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
 }
 
-func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPublicIpAddressesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["allocatedonly"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Allocatedonly; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("allocatedonly", vv)
 	}
-	if v, found := p.p["associatednetworkid"]; found {
-		u.Set("associatednetworkid", v.(string))
+	if v := p.Associatednetworkid; v != nil {
+		u.Set("associatednetworkid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["forloadbalancing"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Forloadbalancing; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("forloadbalancing", vv)
 	}
-	if v, found := p.p["forvirtualnetwork"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Forvirtualnetwork; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("forvirtualnetwork", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["issourcenat"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Issourcenat; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("issourcenat", vv)
 	}
-	if v, found := p.p["isstaticnat"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isstaticnat; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isstaticnat", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["vlanid"]; found {
-		u.Set("vlanid", v.(string))
+	if v := p.Vlanid; v != nil {
+		u.Set("vlanid", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListPublicIpAddressesParams) Command() string {
+	return "listPublicIpAddresses"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPublicIpAddressesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPublicIpAddressesParams) ResponseType() interface{} {
+	return &ListPublicIpAddressesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPublicIpAddressesParams) Validate() error {
+	v := &validator{api: "listPublicIpAddresses"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ListPublicIpAddressesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["allocatedonly"] = v
+	return *p.Account
+}
+
+func (p *ListPublicIpAddressesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetAllocatedonly(v bool) {
+	p.Allocatedonly = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetAllocatedonly() bool {
+	if p.Allocatedonly == nil {
+		return false
 	}
-	p.p["associatednetworkid"] = v
+	return *p.Allocatedonly
+}
+
+func (p *ListPublicIpAddressesParams) HasAllocatedonly() bool {
+	return p.Allocatedonly != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetAllocatedonly() {
+	p.Allocatedonly = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetAssociatednetworkid(v string) {
+	p.Associatednetworkid = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetAssociatednetworkid() string {
+	if p.Associatednetworkid == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Associatednetworkid
+}
+
+func (p *ListPublicIpAddressesParams) HasAssociatednetworkid() bool {
+	return p.Associatednetworkid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetAssociatednetworkid() {
+	p.Associatednetworkid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListPublicIpAddressesParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *ListPublicIpAddressesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *ListPublicIpAddressesParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["forloadbalancing"] = v
+	return *p.Fordisplay
+}
+
+func (p *ListPublicIpAddressesParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetForloadbalancing(v bool) {
+	p.Forloadbalancing = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetForloadbalancing() bool {
+	if p.Forloadbalancing == nil {
+		return false
 	}
-	p.p["forvirtualnetwork"] = v
+	return *p.Forloadbalancing
+}
+
+func (p *ListPublicIpAddressesParams) HasForloadbalancing() bool {
+	return p.Forloadbalancing != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetForloadbalancing() {
+	p.Forloadbalancing = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetForvirtualnetwork(v bool) {
+	p.Forvirtualnetwork = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetForvirtualnetwork() bool {
+	if p.Forvirtualnetwork == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Forvirtualnetwork
+}
+
+func (p *ListPublicIpAddressesParams) HasForvirtualnetwork() bool {
+	return p.Forvirtualnetwork != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetForvirtualnetwork() {
+	p.Forvirtualnetwork = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["ipaddress"] = v
+	return *p.Id
+}
+
+func (p *ListPublicIpAddressesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
+	return *p.Ipaddress
+}
+
+func (p *ListPublicIpAddressesParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListPublicIpAddressesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["issourcenat"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListPublicIpAddressesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetIssourcenat(v bool) {
+	p.Issourcenat = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetIssourcenat() bool {
+	if p.Issourcenat == nil {
+		return false
 	}
-	p.p["isstaticnat"] = v
+	return *p.Issourcenat
+}
+
+func (p *ListPublicIpAddressesParams) HasIssourcenat() bool {
+	return p.Issourcenat != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetIssourcenat() {
+	p.Issourcenat = nil
+}
+
+func (p *ListPublicIpAddressesParams) SetIsstaticnat(v bool) {
+	p.Isstaticnat = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetIsstaticnat() bool {
+	if p.Isstaticnat == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Isstaticnat
+}
+
+func (p *ListPublicIpAddressesParams) HasIsstaticnat() bool {
+	return p.Isstaticnat != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetIsstaticnat() {
+	p.Isstaticnat = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPublicIpAddressesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["listall"] = v
+	return *p.Keyword
+}
+
+func (p *ListPublicIpAddressesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListPublicIpAddressesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["page"] = v
+	return *p.Listall
+}
+
+func (p *ListPublicIpAddressesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPublicIpAddressesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListPublicIpAddressesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPublicIpAddressesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["physicalnetworkid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListPublicIpAddressesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListPublicIpAddressesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Physicalnetworkid
+}
+
+func (p *ListPublicIpAddressesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListPublicIpAddressesParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Projectid
+}
+
+func (p *ListPublicIpAddressesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListPublicIpAddressesParams) SetTags(v map[string]string) {
+	p.Tags = v
 	return
 }
 
+func (p *ListPublicIpAddressesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListPublicIpAddressesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetTags() {
+	p.Tags = nil
+}
+
 func (p *ListPublicIpAddressesParams) SetVlanid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlanid"] = v
+	p.Vlanid = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetVlanid() string {
+	if p.Vlanid == nil {
+		return ""
 	}
-	p.p["vpcid"] = v
+	return *p.Vlanid
+}
+
+func (p *ListPublicIpAddressesParams) HasVlanid() bool {
+	return p.Vlanid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetVlanid() {
+	p.Vlanid = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListPublicIpAddressesParams) SetVpcid(v string) {
+	p.Vpcid = &v
 	return
 }
 
-func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListPublicIpAddressesParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Vpcid
+}
+
+func (p *ListPublicIpAddressesParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListPublicIpAddressesParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *ListPublicIpAddressesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListPublicIpAddressesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListPublicIpAddressesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListPublicIpAddressesParams instance,
 // as then you are sure you have configured all required params
 func (s *AddressService) NewListPublicIpAddressesParams() *ListPublicIpAddressesParams {
 	p := &ListPublicIpAddressesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AddressService) GetPublicIpAddressByID(id string) (*PublicIpAddress, int, error) {
+func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
 	p := &ListPublicIpAddressesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListPublicIpAddresses(p)
 	if err != nil {
@@ -590,6 +1244,20 @@ func (s *AddressService) GetPublicIpAddressByID(id string) (*PublicIpAddress, in
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListPublicIpAddresses(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -600,201 +1268,114 @@ func (s *AddressService) GetPublicIpAddressByID(id string) (*PublicIpAddress, in
 	return nil, l.Count, fmt.Errorf("There is more then one result for PublicIpAddress UUID: %s!", id)
 }
 
-// Lists all public ip addresses
-func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
-	resp, err := s.cs.newRequest("listPublicIpAddresses", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListPublicIpAddressesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListPublicIpAddressesResponse struct {
-	Count             int                `json:"count"`
-	PublicIpAddresses []*PublicIpAddress `json:"publicipaddress"`
-}
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *AddressService) GetPublicIpAddressesByTags(tags map[string]string, opts ...OptionFunc) ([]*PublicIpAddress, error) {
+	p := &ListPublicIpAddressesParams{}
 
-type PublicIpAddress struct {
-	Account               string `json:"account,omitempty"`
-	Allocated             string `json:"allocated,omitempty"`
-	Associatednetworkid   string `json:"associatednetworkid,omitempty"`
-	Associatednetworkname string `json:"associatednetworkname,omitempty"`
-	Domain                string `json:"domain,omitempty"`
-	Domainid              string `json:"domainid,omitempty"`
-	Fordisplay            bool   `json:"fordisplay,omitempty"`
-	Forvirtualnetwork     bool   `json:"forvirtualnetwork,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Ipaddress             string `json:"ipaddress,omitempty"`
-	Isportable            bool   `json:"isportable,omitempty"`
-	Issourcenat           bool   `json:"issourcenat,omitempty"`
-	Isstaticnat           bool   `json:"isstaticnat,omitempty"`
-	Issystem              bool   `json:"issystem,omitempty"`
-	Networkid             string `json:"networkid,omitempty"`
-	Physicalnetworkid     string `json:"physicalnetworkid,omitempty"`
-	Project               string `json:"project,omitempty"`
-	Projectid             string `json:"projectid,omitempty"`
-	Purpose               string `json:"purpose,omitempty"`
-	State                 string `json:"state,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vlanid                    string `json:"vlanid,omitempty"`
-	Vlanname                  string `json:"vlanname,omitempty"`
-	Vmipaddress               string `json:"vmipaddress,omitempty"`
-	Vpcid                     string `json:"vpcid,omitempty"`
-	Zoneid                    string `json:"zoneid,omitempty"`
-	Zonename                  string `json:"zonename,omitempty"`
-}
+	p.SetTags(tags)
 
-type UpdateIpAddressParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateIpAddressParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
-	return u
-}
 
-func (p *UpdateIpAddressParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListPublicIpAddresses(p)
+	if err != nil {
+		return nil, err
 	}
-	p.p["customid"] = v
-	return
-}
 
-func (p *UpdateIpAddressParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListPublicIpAddresses(p)
+		if err != nil {
+			return nil, err
+		}
 	}
-	p.p["fordisplay"] = v
-	return
-}
 
-func (p *UpdateIpAddressParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+	return l.PublicIpAddresses, nil
 }
 
-// You should always use this function to get a new UpdateIpAddressParams instance,
-// as then you are sure you have configured all required params
-func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressParams {
-	p := &UpdateIpAddressParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
+// Lists all public ip addresses
+//
+// See also GetPublicIpAddressByID, GetPublicIpAddressesByTags.
+func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
-// Updates an ip address
-func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
-	resp, err := s.cs.newRequest("updateIpAddress", p.toURLValues())
+	resp, err := s.cs.newRequest("listPublicIpAddresses", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListPublicIpAddressesResponse
+	if err := s.cs.unmarshal("listPublicIpAddresses", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+// Lists the objects like ListPublicIpAddresses, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AddressService) StreamPublicIpAddresses(ctx context.Context, p *ListPublicIpAddressesParams, fn func(*PublicIpAddress) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
+	return s.cs.streamList(ctx, "listPublicIpAddresses", p.URLValues(), "publicipaddress", func(b json.RawMessage) error {
+		var v PublicIpAddress
+		if err := s.cs.unmarshalElement("listPublicIpAddresses", "publicipaddress", b, &v); err != nil {
+			return err
 		}
+		return fn(&v)
+	})
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+type ListPublicIpAddressesResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	PublicIpAddresses []*PublicIpAddress         `json:"publicipaddress" xml:"publicipaddress"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type UpdateIpAddressResponse struct {
-	JobID                 string `json:"jobid,omitempty"`
-	Account               string `json:"account,omitempty"`
-	Allocated             string `json:"allocated,omitempty"`
-	Associatednetworkid   string `json:"associatednetworkid,omitempty"`
-	Associatednetworkname string `json:"associatednetworkname,omitempty"`
-	Domain                string `json:"domain,omitempty"`
-	Domainid              string `json:"domainid,omitempty"`
-	Fordisplay            bool   `json:"fordisplay,omitempty"`
-	Forvirtualnetwork     bool   `json:"forvirtualnetwork,omitempty"`
-	Id                    string `json:"id,omitempty"`
-	Ipaddress             string `json:"ipaddress,omitempty"`
-	Isportable            bool   `json:"isportable,omitempty"`
-	Issourcenat           bool   `json:"issourcenat,omitempty"`
-	Isstaticnat           bool   `json:"isstaticnat,omitempty"`
-	Issystem              bool   `json:"issystem,omitempty"`
-	Networkid             string `json:"networkid,omitempty"`
-	Physicalnetworkid     string `json:"physicalnetworkid,omitempty"`
-	Project               string `json:"project,omitempty"`
-	Projectid             string `json:"projectid,omitempty"`
-	Purpose               string `json:"purpose,omitempty"`
-	State                 string `json:"state,omitempty"`
-	Tags                  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname,omitempty"`
-	Virtualmachineid          string `json:"virtualmachineid,omitempty"`
-	Virtualmachinename        string `json:"virtualmachinename,omitempty"`
-	Vlanid                    string `json:"vlanid,omitempty"`
-	Vlanname                  string `json:"vlanname,omitempty"`
-	Vmipaddress               string `json:"vmipaddress,omitempty"`
-	Vpcid                     string `json:"vpcid,omitempty"`
-	Zoneid                    string `json:"zoneid,omitempty"`
-	Zonename                  string `json:"zonename,omitempty"`
+type PublicIpAddress struct {
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Allocated                 string                     `json:"allocated,omitempty" xml:"allocated,omitempty"`
+	Associatednetworkid       string                     `json:"associatednetworkid,omitempty" xml:"associatednetworkid,omitempty"`
+	Associatednetworkname     string                     `json:"associatednetworkname,omitempty" xml:"associatednetworkname,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay                Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Forvirtualnetwork         Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress                 string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isportable                Bool                       `json:"isportable,omitempty" xml:"isportable,omitempty"`
+	Issourcenat               Bool                       `json:"issourcenat,omitempty" xml:"issourcenat,omitempty"`
+	Isstaticnat               Bool                       `json:"isstaticnat,omitempty" xml:"isstaticnat,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Networkid                 string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project                   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid                 string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Purpose                   string                     `json:"purpose,omitempty" xml:"purpose,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname,omitempty" xml:"virtualmachinedisplayname,omitempty"`
+	Virtualmachineid          string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualmachinename        string                     `json:"virtualmachinename,omitempty" xml:"virtualmachinename,omitempty"`
+	Vlanid                    string                     `json:"vlanid,omitempty" xml:"vlanid,omitempty"`
+	Vlanname                  string                     `json:"vlanname,omitempty" xml:"vlanname,omitempty"`
+	Vmipaddress               string                     `json:"vmipaddress,omitempty" xml:"vmipaddress,omitempty"`
+	Vpcid                     string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid                    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                  string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
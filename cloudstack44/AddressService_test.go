@@ -0,0 +1,356 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAssociateIpAddress(t *testing.T) {
+	params := url.Values{
+		"account":    {"test-account"},
+		"domainid":   {"test-domainid"},
+		"fordisplay": {"true"},
+		"isportable": {"true"},
+		"networkid":  {"test-networkid"},
+		"projectid":  {"test-projectid"},
+		"regionid":   {"7"},
+		"vpcid":      {"test-vpcid"},
+		"zoneid":     {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "associateIpAddress", params, `{"associateipaddressresponse":{"account":"test-account","allocated":"test-allocated","associatednetworkid":"test-associatednetworkid","associatednetworkname":"test-associatednetworkname","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"forvirtualnetwork":true,"id":"test-id","ipaddress":"test-ipaddress","isportable":true,"issourcenat":true,"isstaticnat":true,"issystem":true,"jobid":"test-jobid","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","purpose":"test-purpose","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vlanid":"test-vlanid","vlanname":"test-vlanname","vmipaddress":"test-vmipaddress","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Address.NewAssociateIpAddressParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetIsportable(true)
+	p.SetNetworkid("test-networkid")
+	p.SetProjectid("test-projectid")
+	p.SetRegionid(7)
+	p.SetVpcid("test-vpcid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "associateIpAddress", p.URLValues(), params)
+
+	r, err := cs.Address.AssociateIpAddress(p)
+	if err != nil {
+		t.Fatalf("Failed to call associateIpAddress: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "associateIpAddress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute associateIpAddress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of associateIpAddress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of associateIpAddress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "associateIpAddress", params, `<associateipaddressresponse><account>test-account</account><allocated>test-allocated</allocated><associatednetworkid>test-associatednetworkid</associatednetworkid><associatednetworkname>test-associatednetworkname</associatednetworkname><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><forvirtualnetwork>true</forvirtualnetwork><id>test-id</id><ipaddress>test-ipaddress</ipaddress><isportable>true</isportable><issourcenat>true</issourcenat><isstaticnat>true</isstaticnat><issystem>true</issystem><jobid>test-jobid</jobid><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><purpose>test-purpose</purpose><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vlanid>test-vlanid</vlanid><vlanname>test-vlanname</vlanname><vmipaddress>test-vmipaddress</vmipaddress><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></associateipaddressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Address.AssociateIpAddress(p)
+	if err != nil {
+		t.Fatalf("Failed to call associateIpAddress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDisassociateIpAddress(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "disassociateIpAddress", params, `{"disassociateipaddressresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Address.NewDisassociateIpAddressParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "disassociateIpAddress", p.URLValues(), params)
+
+	r, err := cs.Address.DisassociateIpAddress(p)
+	if err != nil {
+		t.Fatalf("Failed to call disassociateIpAddress: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "disassociateIpAddress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute disassociateIpAddress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of disassociateIpAddress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of disassociateIpAddress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "disassociateIpAddress", params, `<disassociateipaddressresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></disassociateipaddressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Address.DisassociateIpAddress(p)
+	if err != nil {
+		t.Fatalf("Failed to call disassociateIpAddress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateIpAddress(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateIpAddress", params, `{"updateipaddressresponse":{"account":"test-account","allocated":"test-allocated","associatednetworkid":"test-associatednetworkid","associatednetworkname":"test-associatednetworkname","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"forvirtualnetwork":true,"id":"test-id","ipaddress":"test-ipaddress","isportable":true,"issourcenat":true,"isstaticnat":true,"issystem":true,"jobid":"test-jobid","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","purpose":"test-purpose","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vlanid":"test-vlanid","vlanname":"test-vlanname","vmipaddress":"test-vmipaddress","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Address.NewUpdateIpAddressParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateIpAddress", p.URLValues(), params)
+
+	r, err := cs.Address.UpdateIpAddress(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateIpAddress: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateIpAddress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateIpAddress: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateIpAddress: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateIpAddress: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateIpAddress", params, `<updateipaddressresponse><account>test-account</account><allocated>test-allocated</allocated><associatednetworkid>test-associatednetworkid</associatednetworkid><associatednetworkname>test-associatednetworkname</associatednetworkname><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><forvirtualnetwork>true</forvirtualnetwork><id>test-id</id><ipaddress>test-ipaddress</ipaddress><isportable>true</isportable><issourcenat>true</issourcenat><isstaticnat>true</isstaticnat><issystem>true</issystem><jobid>test-jobid</jobid><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><purpose>test-purpose</purpose><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vlanid>test-vlanid</vlanid><vlanname>test-vlanname</vlanname><vmipaddress>test-vmipaddress</vmipaddress><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updateipaddressresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Address.UpdateIpAddress(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateIpAddress using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPublicIpAddresses(t *testing.T) {
+	params := url.Values{
+		"account":             {"test-account"},
+		"allocatedonly":       {"true"},
+		"associatednetworkid": {"test-associatednetworkid"},
+		"domainid":            {"test-domainid"},
+		"fordisplay":          {"true"},
+		"forloadbalancing":    {"true"},
+		"forvirtualnetwork":   {"true"},
+		"id":                  {"test-id"},
+		"ipaddress":           {"test-ipaddress"},
+		"isrecursive":         {"true"},
+		"issourcenat":         {"true"},
+		"isstaticnat":         {"true"},
+		"keyword":             {"test-keyword"},
+		"listall":             {"true"},
+		"page":                {"15"},
+		"pagesize":            {"16"},
+		"physicalnetworkid":   {"test-physicalnetworkid"},
+		"projectid":           {"test-projectid"},
+		"tags[0].key":         {"test-tagskey"},
+		"tags[0].value":       {"test-tagsvalue"},
+		"vlanid":              {"test-vlanid"},
+		"vpcid":               {"test-vpcid"},
+		"zoneid":              {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listPublicIpAddresses", params, `{"listpublicipaddressesresponse":{"count":1,"publicipaddress":[{"account":"test-account","allocated":"test-allocated","associatednetworkid":"test-associatednetworkid","associatednetworkname":"test-associatednetworkname","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"forvirtualnetwork":true,"id":"test-id","ipaddress":"test-ipaddress","isportable":true,"issourcenat":true,"isstaticnat":true,"issystem":true,"networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","purpose":"test-purpose","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"virtualmachinedisplayname":"test-virtualmachinedisplayname","virtualmachineid":"test-virtualmachineid","virtualmachinename":"test-virtualmachinename","vlanid":"test-vlanid","vlanname":"test-vlanname","vmipaddress":"test-vmipaddress","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Address.NewListPublicIpAddressesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAllocatedonly(true)
+	p.SetAssociatednetworkid("test-associatednetworkid")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetForloadbalancing(true)
+	p.SetForvirtualnetwork(true)
+	p.SetId("test-id")
+	p.SetIpaddress("test-ipaddress")
+	p.SetIsrecursive(true)
+	p.SetIssourcenat(true)
+	p.SetIsstaticnat(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(15)
+	p.SetPagesize(16)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVlanid("test-vlanid")
+	p.SetVpcid("test-vpcid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listPublicIpAddresses", p.URLValues(), params)
+
+	r, err := cs.Address.ListPublicIpAddresses(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPublicIpAddresses: %v", err)
+	}
+	if r.PublicIpAddresses[0].Account != "test-account" {
+		t.Errorf("Expected r.PublicIpAddresses[0].Account to be %v, got %v", "test-account", r.PublicIpAddresses[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPublicIpAddresses" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPublicIpAddresses: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPublicIpAddresses: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPublicIpAddresses: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PublicIpAddress
+	count, err := cs.Address.StreamPublicIpAddresses(context.Background(), p, func(v *PublicIpAddress) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPublicIpAddresses: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PublicIpAddresses) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PublicIpAddresses)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPublicIpAddresses", params, `<listpublicipaddressesresponse><count>1</count><publicipaddress><account>test-account</account><allocated>test-allocated</allocated><associatednetworkid>test-associatednetworkid</associatednetworkid><associatednetworkname>test-associatednetworkname</associatednetworkname><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><forvirtualnetwork>true</forvirtualnetwork><id>test-id</id><ipaddress>test-ipaddress</ipaddress><isportable>true</isportable><issourcenat>true</issourcenat><isstaticnat>true</isstaticnat><issystem>true</issystem><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><project>test-project</project><projectid>test-projectid</projectid><purpose>test-purpose</purpose><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><virtualmachinedisplayname>test-virtualmachinedisplayname</virtualmachinedisplayname><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualmachinename>test-virtualmachinename</virtualmachinename><vlanid>test-vlanid</vlanid><vlanname>test-vlanname</vlanname><vmipaddress>test-vmipaddress</vmipaddress><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></publicipaddress></listpublicipaddressesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Address.ListPublicIpAddresses(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPublicIpAddresses using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Address.StreamPublicIpAddresses(context.Background(), p, func(v *PublicIpAddress) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPublicIpAddresses using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PublicIpAddresses) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PublicIpAddresses)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
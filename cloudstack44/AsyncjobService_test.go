@@ -0,0 +1,191 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListAsyncJobs(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"6"},
+		"pagesize":    {"7"},
+		"startdate":   {"test-startdate"},
+	}
+	cs, done := newTestClient(t, "listAsyncJobs", params, `{"listasyncjobsresponse":{"asyncjob":[{"accountid":"test-accountid","cmd":"test-cmd","created":"2014-01-02T15:04:05+0100","jobinstanceid":"test-jobinstanceid","jobinstancetype":"test-jobinstancetype","jobprocstatus":1,"jobresult":{},"jobresultcode":1,"jobresulttype":"test-jobresulttype","jobstatus":1,"userid":"test-userid"}],"count":1}}`)
+	defer done()
+
+	p := cs.Asyncjob.NewListAsyncJobsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetStartdate("test-startdate")
+	checkURLValues(t, "listAsyncJobs", p.URLValues(), params)
+
+	r, err := cs.Asyncjob.ListAsyncJobs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAsyncJobs: %v", err)
+	}
+	if r.AsyncJobs[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.AsyncJobs[0].Accountid to be %v, got %v", "test-accountid", r.AsyncJobs[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listAsyncJobs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listAsyncJobs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listAsyncJobs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listAsyncJobs: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*AsyncJob
+	count, err := cs.Asyncjob.StreamAsyncJobs(context.Background(), p, func(v *AsyncJob) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listAsyncJobs: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.AsyncJobs) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.AsyncJobs)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listAsyncJobs", params, `<listasyncjobsresponse><asyncjob><accountid>test-accountid</accountid><cmd>test-cmd</cmd><created>2014-01-02T15:04:05+0100</created><jobinstanceid>test-jobinstanceid</jobinstanceid><jobinstancetype>test-jobinstancetype</jobinstancetype><jobprocstatus>1</jobprocstatus><jobresult></jobresult><jobresultcode>1</jobresultcode><jobresulttype>test-jobresulttype</jobresulttype><jobstatus>1</jobstatus><userid>test-userid</userid></asyncjob><count>1</count></listasyncjobsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Asyncjob.ListAsyncJobs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listAsyncJobs using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Asyncjob.StreamAsyncJobs(context.Background(), p, func(v *AsyncJob) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listAsyncJobs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.AsyncJobs) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.AsyncJobs)
+	}
+	if !strings.HasPrefix(string(xr.AsyncJobs[0].Jobresult), "<jobresult") {
+		t.Errorf("Expected xr.AsyncJobs[0].Jobresult to contain the XML of its element, got %s", xr.AsyncJobs[0].Jobresult)
+	}
+	xr.AsyncJobs[0].Jobresult = r.AsyncJobs[0].Jobresult
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestQueryAsyncJobResult(t *testing.T) {
+	params := url.Values{
+		"jobid": {"test-jobid"},
+	}
+	cs, done := newTestClient(t, "queryAsyncJobResult", params, `{"queryasyncjobresultresponse":{"accountid":"test-accountid","cmd":"test-cmd","created":"2014-01-02T15:04:05+0100","jobinstanceid":"test-jobinstanceid","jobinstancetype":"test-jobinstancetype","jobprocstatus":1,"jobresult":{},"jobresultcode":1,"jobresulttype":"test-jobresulttype","jobstatus":1,"userid":"test-userid"}}`)
+	defer done()
+
+	p := cs.Asyncjob.NewQueryAsyncJobResultParams("test-jobid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetJobid("test-jobid")
+	checkURLValues(t, "queryAsyncJobResult", p.URLValues(), params)
+
+	r, err := cs.Asyncjob.QueryAsyncJobResult(p)
+	if err != nil {
+		t.Fatalf("Failed to call queryAsyncJobResult: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "queryAsyncJobResult" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute queryAsyncJobResult: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of queryAsyncJobResult: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of queryAsyncJobResult: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "queryAsyncJobResult", params, `<queryasyncjobresultresponse><accountid>test-accountid</accountid><cmd>test-cmd</cmd><created>2014-01-02T15:04:05+0100</created><jobinstanceid>test-jobinstanceid</jobinstanceid><jobinstancetype>test-jobinstancetype</jobinstancetype><jobprocstatus>1</jobprocstatus><jobresult></jobresult><jobresultcode>1</jobresultcode><jobresulttype>test-jobresulttype</jobresulttype><jobstatus>1</jobstatus><userid>test-userid</userid></queryasyncjobresultresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Asyncjob.QueryAsyncJobResult(p)
+	if err != nil {
+		t.Fatalf("Failed to call queryAsyncJobResult using XML: %v", err)
+	}
+	if !strings.HasPrefix(string(xr.Jobresult), "<jobresult") {
+		t.Errorf("Expected xr.Jobresult to contain the XML of its element, got %s", xr.Jobresult)
+	}
+	xr.Jobresult = r.Jobresult
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
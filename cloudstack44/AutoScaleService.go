@@ -17,78 +17,61 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
-)
-
-type CreateCounterParams struct {
-	p map[string]interface{}
-}
 
-func (p *CreateCounterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["source"]; found {
-		u.Set("source", v.(string))
-	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
-	}
-	return u
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateCounterParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+type AutoScaleService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateCounterParams) SetSource(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["source"] = v
-	return
+func NewAutoScaleService(cs *CloudStackClient) *AutoScaleService {
+	return &AutoScaleService{cs: cs}
 }
 
-func (p *CreateCounterParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
-	return
-}
+type CreateAutoScalePolicyParams = cloudstackcommon.CreateAutoScalePolicyParams
+type CreateAutoScalePolicyResponse = cloudstackcommon.CreateAutoScalePolicyResponse
 
-// You should always use this function to get a new CreateCounterParams instance,
+// You should always use this function to get a new CreateAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateCounterParams(name string, source string, value string) *CreateCounterParams {
-	p := &CreateCounterParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["source"] = source
-	p.p["value"] = value
+//
+// The required params are:
+//
+//   - action
+//   - conditionids
+//   - duration
+func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams {
+	p := &CreateAutoScalePolicyParams{}
+	p.SetAction(action)
+	p.SetConditionids(conditionids)
+	p.SetDuration(duration)
 	return p
 }
 
-// Adds metric counter
-func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
-	resp, err := s.cs.newRequest("createCounter", p.toURLValues())
+// Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale vm group.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScalePolicies, GetAutoScalePolicyByID.
+func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createAutoScalePolicy", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateCounterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateAutoScalePolicyResponse
+	if err := s.cs.unmarshal("createAutoScalePolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -109,110 +92,46 @@ func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounter
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createAutoScalePolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateCounterResponse struct {
-	JobID  string `json:"jobid,omitempty"`
-	Id     string `json:"id,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Source string `json:"source,omitempty"`
-	Value  string `json:"value,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type CreateConditionParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateConditionParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["counterid"]; found {
-		u.Set("counterid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["relationaloperator"]; found {
-		u.Set("relationaloperator", v.(string))
-	}
-	if v, found := p.p["threshold"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("threshold", vv)
-	}
-	return u
-}
-
-func (p *CreateConditionParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *CreateConditionParams) SetCounterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterid"] = v
-	return
-}
-
-func (p *CreateConditionParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *CreateConditionParams) SetRelationaloperator(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["relationaloperator"] = v
-	return
-}
-
-func (p *CreateConditionParams) SetThreshold(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["threshold"] = v
-	return
-}
+type DeleteAutoScalePolicyParams = cloudstackcommon.DeleteAutoScalePolicyParams
+type DeleteAutoScalePolicyResponse = cloudstackcommon.DeleteAutoScalePolicyResponse
 
-// You should always use this function to get a new CreateConditionParams instance,
+// You should always use this function to get a new DeleteAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams {
-	p := &CreateConditionParams{}
-	p.p = make(map[string]interface{})
-	p.p["counterid"] = counterid
-	p.p["relationaloperator"] = relationaloperator
-	p.p["threshold"] = threshold
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams {
+	p := &DeleteAutoScalePolicyParams{}
+	p.SetId(id)
 	return p
 }
 
-// Creates a condition
-func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
-	resp, err := s.cs.newRequest("createCondition", p.toURLValues())
+// Deletes a autoscale policy.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScalePolicies, GetAutoScalePolicyByID.
+func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteAutoScalePolicy", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateConditionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteAutoScalePolicyResponse
+	if err := s.cs.unmarshal("deleteAutoScalePolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -228,111 +147,130 @@ func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateCon
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteAutoScalePolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateConditionResponse struct {
-	JobID              string   `json:"jobid,omitempty"`
-	Account            string   `json:"account,omitempty"`
-	Counter            []string `json:"counter,omitempty"`
-	Domain             string   `json:"domain,omitempty"`
-	Domainid           string   `json:"domainid,omitempty"`
-	Id                 string   `json:"id,omitempty"`
-	Project            string   `json:"project,omitempty"`
-	Projectid          string   `json:"projectid,omitempty"`
-	Relationaloperator string   `json:"relationaloperator,omitempty"`
-	Threshold          int64    `json:"threshold,omitempty"`
-	Zoneid             string   `json:"zoneid,omitempty"`
-}
+type ListAutoScalePoliciesParams = cloudstackcommon.ListAutoScalePoliciesParams
+type ListAutoScalePoliciesResponse = cloudstackcommon.ListAutoScalePoliciesResponse
+type AutoScalePolicy = cloudstackcommon.AutoScalePolicy
 
-type CreateAutoScalePolicyParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListAutoScalePoliciesParams instance,
+// as then you are sure you have configured all required params
+func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams {
+	p := &ListAutoScalePoliciesParams{}
+	return p
 }
 
-func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
+	p := &ListAutoScalePoliciesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	if v, found := p.p["conditionids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("conditionids", vv)
+
+	l, err := s.ListAutoScalePolicies(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
 	}
-	if v, found := p.p["duration"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("duration", vv)
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	if v, found := p.p["quiettime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("quiettime", vv)
+
+	if l.Count == 1 {
+		return l.AutoScalePolicies[0], l.Count, nil
 	}
-	return u
+	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScalePolicy UUID: %s!", id)
 }
 
-func (p *CreateAutoScalePolicyParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists autoscale policies.
+//
+// See also GetAutoScalePolicyByID.
+func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["action"] = v
-	return
-}
 
-func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listAutoScalePolicies", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["conditionids"] = v
-	return
-}
 
-func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListAutoScalePoliciesResponse
+	if err := s.cs.unmarshal("listAutoScalePolicies", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["duration"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListAutoScalePolicies, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AutoScaleService) StreamAutoScalePolicies(ctx context.Context, p *ListAutoScalePoliciesParams, fn func(*AutoScalePolicy) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["quiettime"] = v
-	return
+
+	return s.cs.streamList(ctx, "listAutoScalePolicies", p.URLValues(), "autoscalepolicy", func(b json.RawMessage) error {
+		var v AutoScalePolicy
+		if err := s.cs.unmarshalElement("listAutoScalePolicies", "autoscalepolicy", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new CreateAutoScalePolicyParams instance,
+type UpdateAutoScalePolicyParams = cloudstackcommon.UpdateAutoScalePolicyParams
+type UpdateAutoScalePolicyResponse = cloudstackcommon.UpdateAutoScalePolicyResponse
+
+// You should always use this function to get a new UpdateAutoScalePolicyParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams {
-	p := &CreateAutoScalePolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["action"] = action
-	p.p["conditionids"] = conditionids
-	p.p["duration"] = duration
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams {
+	p := &UpdateAutoScalePolicyParams{}
+	p.SetId(id)
 	return p
 }
 
-// Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale vm group.
-func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScalePolicy", p.toURLValues())
+// Updates an existing autoscale policy.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScalePolicies, GetAutoScalePolicyByID.
+func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateAutoScalePolicy", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateAutoScalePolicyResponse
+	if err := s.cs.unmarshal("updateAutoScalePolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -353,327 +291,289 @@ func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams)
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateAutoScalePolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateAutoScalePolicyResponse struct {
-	JobID      string   `json:"jobid,omitempty"`
-	Account    string   `json:"account,omitempty"`
-	Action     string   `json:"action,omitempty"`
-	Conditions []string `json:"conditions,omitempty"`
-	Domain     string   `json:"domain,omitempty"`
-	Domainid   string   `json:"domainid,omitempty"`
-	Duration   int      `json:"duration,omitempty"`
-	Id         string   `json:"id,omitempty"`
-	Project    string   `json:"project,omitempty"`
-	Projectid  string   `json:"projectid,omitempty"`
-	Quiettime  int      `json:"quiettime,omitempty"`
-}
-
-type CreateAutoScaleVmProfileParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
+type CreateAutoScaleVmGroupParams struct {
+	Fordisplay         *bool    `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Interval           *int     `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Lbruleid           *string  `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Maxmembers         *int     `json:"maxmembers,omitempty" yaml:"maxmembers,omitempty"`
+	Minmembers         *int     `json:"minmembers,omitempty" yaml:"minmembers,omitempty"`
+	Scaledownpolicyids []string `json:"scaledownpolicyids,omitempty" yaml:"scaledownpolicyids,omitempty"`
+	Scaleuppolicyids   []string `json:"scaleuppolicyids,omitempty" yaml:"scaleuppolicyids,omitempty"`
+	Vmprofileid        *string  `json:"vmprofileid,omitempty" yaml:"vmprofileid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateAutoScaleVmGroupParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["autoscaleuserid"]; found {
-		u.Set("autoscaleuserid", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["counterparam"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
-			u.Set(fmt.Sprintf("counterparam[%d].value", i), vv)
-			i++
-		}
+	if v := p.Interval; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("interval", vv)
 	}
-	if v, found := p.p["destroyvmgraceperiod"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("destroyvmgraceperiod", vv)
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if v := p.Maxmembers; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("maxmembers", vv)
 	}
-	if v, found := p.p["otherdeployparams"]; found {
-		u.Set("otherdeployparams", v.(string))
+	if v := p.Minmembers; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("minmembers", vv)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if v := p.Scaledownpolicyids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("scaledownpolicyids", vv)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if v := p.Scaleuppolicyids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("scaleuppolicyids", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Vmprofileid; v != nil {
+		u.Set("vmprofileid", *v)
 	}
 	return u
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["autoscaleuserid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateAutoScaleVmGroupParams) Command() string {
+	return "createAutoScaleVmGroup"
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterparam"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateAutoScaleVmGroupParams) IsAsync() bool {
+	return true
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destroyvmgraceperiod"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateAutoScaleVmGroupParams) ResponseType() interface{} {
+	return &CreateAutoScaleVmGroupResponse{}
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateAutoScaleVmGroupParams) Validate() error {
+	v := &validator{api: "createAutoScaleVmGroup"}
+	v.required("lbruleid", p.Lbruleid != nil)
+	v.required("maxmembers", p.Maxmembers != nil)
+	v.required("minmembers", p.Minmembers != nil)
+	v.required("scaledownpolicyids", p.Scaledownpolicyids != nil)
+	v.required("scaleuppolicyids", p.Scaleuppolicyids != nil)
+	v.required("vmprofileid", p.Vmprofileid != nil)
+	return v.err()
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["otherdeployparams"] = v
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmGroupParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["serviceofferingid"] = v
-	return
+	return *p.Fordisplay
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
+func (p *CreateAutoScaleVmGroupParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateAutoScaleVmGroupParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
+	p.Interval = &v
 	return
 }
 
-func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmGroupParams) GetInterval() int {
+	if p.Interval == nil {
+		return 0
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Interval
 }
 
-// You should always use this function to get a new CreateAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams {
-	p := &CreateAutoScaleVmProfileParams{}
-	p.p = make(map[string]interface{})
-	p.p["serviceofferingid"] = serviceofferingid
-	p.p["templateid"] = templateid
-	p.p["zoneid"] = zoneid
-	return p
+func (p *CreateAutoScaleVmGroupParams) HasInterval() bool {
+	return p.Interval != nil
 }
 
-// Creates a profile that contains information about the virtual machine which will be provisioned automatically by autoscale feature.
-func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmProfile", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateAutoScaleVmGroupParams) ResetInterval() {
+	p.Interval = nil
+}
 
-	var r CreateAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// This param is required.
+func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
 	}
+	return *p.Lbruleid
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *CreateAutoScaleVmGroupParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *CreateAutoScaleVmGroupParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// This param is required.
+func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
+	p.Maxmembers = &v
+	return
+}
+
+func (p *CreateAutoScaleVmGroupParams) GetMaxmembers() int {
+	if p.Maxmembers == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Maxmembers
 }
 
-type CreateAutoScaleVmProfileResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Autoscaleuserid      string `json:"autoscaleuserid,omitempty"`
-	Destroyvmgraceperiod int    `json:"destroyvmgraceperiod,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Otherdeployparams    string `json:"otherdeployparams,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Serviceofferingid    string `json:"serviceofferingid,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
+func (p *CreateAutoScaleVmGroupParams) HasMaxmembers() bool {
+	return p.Maxmembers != nil
 }
 
-type CreateAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+func (p *CreateAutoScaleVmGroupParams) ResetMaxmembers() {
+	p.Maxmembers = nil
 }
 
-func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["interval"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("interval", vv)
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["maxmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxmembers", vv)
-	}
-	if v, found := p.p["minmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("minmembers", vv)
-	}
-	if v, found := p.p["scaledownpolicyids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("scaledownpolicyids", vv)
-	}
-	if v, found := p.p["scaleuppolicyids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("scaleuppolicyids", vv)
-	}
-	if v, found := p.p["vmprofileid"]; found {
-		u.Set("vmprofileid", v.(string))
-	}
-	return u
+// This param is required.
+func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
+	p.Minmembers = &v
+	return
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmGroupParams) GetMinmembers() int {
+	if p.Minmembers == nil {
+		return 0
 	}
-	p.p["fordisplay"] = v
-	return
+	return *p.Minmembers
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetInterval(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["interval"] = v
-	return
+func (p *CreateAutoScaleVmGroupParams) HasMinmembers() bool {
+	return p.Minmembers != nil
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
-	return
+func (p *CreateAutoScaleVmGroupParams) ResetMinmembers() {
+	p.Minmembers = nil
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetMaxmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxmembers"] = v
+// This param is required.
+func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
+	p.Scaledownpolicyids = v
 	return
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetMinmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["minmembers"] = v
-	return
+func (p *CreateAutoScaleVmGroupParams) GetScaledownpolicyids() []string {
+	return p.Scaledownpolicyids
 }
 
-func (p *CreateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scaledownpolicyids"] = v
-	return
+func (p *CreateAutoScaleVmGroupParams) HasScaledownpolicyids() bool {
+	return p.Scaledownpolicyids != nil
 }
 
+func (p *CreateAutoScaleVmGroupParams) ResetScaledownpolicyids() {
+	p.Scaledownpolicyids = nil
+}
+
+// This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["scaleuppolicyids"] = v
+	p.Scaleuppolicyids = v
 	return
 }
 
+func (p *CreateAutoScaleVmGroupParams) GetScaleuppolicyids() []string {
+	return p.Scaleuppolicyids
+}
+
+func (p *CreateAutoScaleVmGroupParams) HasScaleuppolicyids() bool {
+	return p.Scaleuppolicyids != nil
+}
+
+func (p *CreateAutoScaleVmGroupParams) ResetScaleuppolicyids() {
+	p.Scaleuppolicyids = nil
+}
+
+// This param is required.
 func (p *CreateAutoScaleVmGroupParams) SetVmprofileid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmprofileid"] = v
+	p.Vmprofileid = &v
 	return
 }
 
+func (p *CreateAutoScaleVmGroupParams) GetVmprofileid() string {
+	if p.Vmprofileid == nil {
+		return ""
+	}
+	return *p.Vmprofileid
+}
+
+func (p *CreateAutoScaleVmGroupParams) HasVmprofileid() bool {
+	return p.Vmprofileid != nil
+}
+
+func (p *CreateAutoScaleVmGroupParams) ResetVmprofileid() {
+	p.Vmprofileid = nil
+}
+
 // You should always use this function to get a new CreateAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - lbruleid
+//   - maxmembers
+//   - minmembers
+//   - scaledownpolicyids
+//   - scaleuppolicyids
+//   - vmprofileid
 func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams {
 	p := &CreateAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["lbruleid"] = lbruleid
-	p.p["maxmembers"] = maxmembers
-	p.p["minmembers"] = minmembers
-	p.p["scaledownpolicyids"] = scaledownpolicyids
-	p.p["scaleuppolicyids"] = scaleuppolicyids
-	p.p["vmprofileid"] = vmprofileid
+	p.SetLbruleid(lbruleid)
+	p.SetMaxmembers(maxmembers)
+	p.SetMinmembers(minmembers)
+	p.SetScaledownpolicyids(scaledownpolicyids)
+	p.SetScaleuppolicyids(scaleuppolicyids)
+	p.SetVmprofileid(vmprofileid)
 	return p
 }
 
 // Creates and automatically starts a virtual machine based on a service offering, disk offering, and template.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmGroups, GetAutoScaleVmGroupByID.
 func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("createAutoScaleVmGroup", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createAutoScaleVmGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -694,7 +594,7 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -702,65 +602,58 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 }
 
 type CreateAutoScaleVmGroupResponse struct {
-	JobID             string   `json:"jobid,omitempty"`
-	Account           string   `json:"account,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Fordisplay        bool     `json:"fordisplay,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Interval          int      `json:"interval,omitempty"`
-	Lbruleid          string   `json:"lbruleid,omitempty"`
-	Maxmembers        int      `json:"maxmembers,omitempty"`
-	Minmembers        int      `json:"minmembers,omitempty"`
-	Project           string   `json:"project,omitempty"`
-	Projectid         string   `json:"projectid,omitempty"`
-	Scaledownpolicies []string `json:"scaledownpolicies,omitempty"`
-	Scaleuppolicies   []string `json:"scaleuppolicies,omitempty"`
-	State             string   `json:"state,omitempty"`
-	Vmprofileid       string   `json:"vmprofileid,omitempty"`
-}
-
-type DeleteCounterParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteCounterParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteCounterParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay        Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Interval          int                        `json:"interval,omitempty" xml:"interval,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Maxmembers        int                        `json:"maxmembers,omitempty" xml:"maxmembers,omitempty"`
+	Minmembers        int                        `json:"minmembers,omitempty" xml:"minmembers,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Scaledownpolicies []string                   `json:"scaledownpolicies,omitempty" xml:"scaledownpolicies,omitempty"`
+	Scaleuppolicies   []string                   `json:"scaleuppolicies,omitempty" xml:"scaleuppolicies,omitempty"`
+	State             string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vmprofileid       string                     `json:"vmprofileid,omitempty" xml:"vmprofileid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAutoScaleVmGroupParams = cloudstackcommon.DeleteAutoScaleVmGroupParams
+type DeleteAutoScaleVmGroupResponse = cloudstackcommon.DeleteAutoScaleVmGroupResponse
 
-// You should always use this function to get a new DeleteCounterParams instance,
+// You should always use this function to get a new DeleteAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParams {
-	p := &DeleteCounterParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams {
+	p := &DeleteAutoScaleVmGroupParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a counter
-func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
-	resp, err := s.cs.newRequest("deleteCounter", p.toURLValues())
+// Deletes a autoscale vm group.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmGroups, GetAutoScaleVmGroupByID.
+func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteAutoScaleVmGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteCounterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteAutoScaleVmGroupResponse
+	if err := s.cs.unmarshal("deleteAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -776,129 +669,100 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteCounterResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type DeleteConditionParams struct {
-	p map[string]interface{}
+type DisableAutoScaleVmGroupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *DeleteConditionParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DisableAutoScaleVmGroupParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *DeleteConditionParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *DisableAutoScaleVmGroupParams) Command() string {
+	return "disableAutoScaleVmGroup"
 }
 
-// You should always use this function to get a new DeleteConditionParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionParams {
-	p := &DeleteConditionParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Returns true if the API command starts an async job
+func (p *DisableAutoScaleVmGroupParams) IsAsync() bool {
+	return true
 }
 
-// Removes a condition
-func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
-	resp, err := s.cs.newRequest("deleteCondition", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteConditionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DisableAutoScaleVmGroupParams) ResponseType() interface{} {
+	return &DisableAutoScaleVmGroupResponse{}
 }
 
-type DeleteConditionResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DisableAutoScaleVmGroupParams) Validate() error {
+	v := &validator{api: "disableAutoScaleVmGroup"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-type DeleteAutoScalePolicyParams struct {
-	p map[string]interface{}
+// This param is required.
+func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func (p *DisableAutoScaleVmGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+	return *p.Id
 }
 
-func (p *DeleteAutoScalePolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *DisableAutoScaleVmGroupParams) HasId() bool {
+	return p.Id != nil
 }
 
-// You should always use this function to get a new DeleteAutoScalePolicyParams instance,
+func (p *DisableAutoScaleVmGroupParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new DisableAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams {
-	p := &DeleteAutoScalePolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams {
+	p := &DisableAutoScaleVmGroupParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a autoscale policy.
-func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScalePolicy", p.toURLValues())
+// Disables an AutoScale Vm Group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmGroups, GetAutoScaleVmGroupByID.
+func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("disableAutoScaleVmGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DisableAutoScaleVmGroupResponse
+	if err := s.cs.unmarshal("disableAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -914,129 +778,125 @@ func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams)
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("disableAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteAutoScalePolicyResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type DisableAutoScaleVmGroupResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay        Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Interval          int                        `json:"interval,omitempty" xml:"interval,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Maxmembers        int                        `json:"maxmembers,omitempty" xml:"maxmembers,omitempty"`
+	Minmembers        int                        `json:"minmembers,omitempty" xml:"minmembers,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Scaledownpolicies []string                   `json:"scaledownpolicies,omitempty" xml:"scaledownpolicies,omitempty"`
+	Scaleuppolicies   []string                   `json:"scaleuppolicies,omitempty" xml:"scaleuppolicies,omitempty"`
+	State             string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vmprofileid       string                     `json:"vmprofileid,omitempty" xml:"vmprofileid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type DeleteAutoScaleVmProfileParams struct {
-	p map[string]interface{}
+type EnableAutoScaleVmGroupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *EnableAutoScaleVmGroupParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *EnableAutoScaleVmGroupParams) Command() string {
+	return "enableAutoScaleVmGroup"
 }
 
-// You should always use this function to get a new DeleteAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams {
-	p := &DeleteAutoScaleVmProfileParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Returns true if the API command starts an async job
+func (p *EnableAutoScaleVmGroupParams) IsAsync() bool {
+	return true
 }
 
-// Deletes a autoscale vm profile.
-func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmProfile", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *EnableAutoScaleVmGroupParams) ResponseType() interface{} {
+	return &EnableAutoScaleVmGroupResponse{}
 }
 
-type DeleteAutoScaleVmProfileResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *EnableAutoScaleVmGroupParams) Validate() error {
+	v := &validator{api: "enableAutoScaleVmGroup"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-type DeleteAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+// This param is required.
+func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+func (p *EnableAutoScaleVmGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return u
+	return *p.Id
 }
 
-func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *EnableAutoScaleVmGroupParams) HasId() bool {
+	return p.Id != nil
 }
 
-// You should always use this function to get a new DeleteAutoScaleVmGroupParams instance,
+func (p *EnableAutoScaleVmGroupParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new EnableAutoScaleVmGroupParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams {
-	p := &DeleteAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams {
+	p := &EnableAutoScaleVmGroupParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a autoscale vm group.
-func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("deleteAutoScaleVmGroup", p.toURLValues())
+// Enables an AutoScale Vm Group
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmGroups, GetAutoScaleVmGroupByID.
+func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("enableAutoScaleVmGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r EnableAutoScaleVmGroupResponse
+	if err := s.cs.unmarshal("enableAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1052,551 +912,440 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("enableAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteAutoScaleVmGroupResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListCountersParams struct {
-	p map[string]interface{}
+type EnableAutoScaleVmGroupResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay        Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Interval          int                        `json:"interval,omitempty" xml:"interval,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Maxmembers        int                        `json:"maxmembers,omitempty" xml:"maxmembers,omitempty"`
+	Minmembers        int                        `json:"minmembers,omitempty" xml:"minmembers,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Scaledownpolicies []string                   `json:"scaledownpolicies,omitempty" xml:"scaledownpolicies,omitempty"`
+	Scaleuppolicies   []string                   `json:"scaleuppolicies,omitempty" xml:"scaleuppolicies,omitempty"`
+	State             string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vmprofileid       string                     `json:"vmprofileid,omitempty" xml:"vmprofileid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListCountersParams) toURLValues() url.Values {
+type ListAutoScaleVmGroupsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbruleid    *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Policyid    *string `json:"policyid,omitempty" yaml:"policyid,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vmprofileid *string `json:"vmprofileid,omitempty" yaml:"vmprofileid,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAutoScaleVmGroupsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["source"]; found {
-		u.Set("source", v.(string))
+	if v := p.Policyid; v != nil {
+		u.Set("policyid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Vmprofileid; v != nil {
+		u.Set("vmprofileid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListCountersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListAutoScaleVmGroupsParams) Command() string {
+	return "listAutoScaleVmGroups"
 }
 
-func (p *ListCountersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListAutoScaleVmGroupsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListCountersParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAutoScaleVmGroupsParams) ResponseType() interface{} {
+	return &ListAutoScaleVmGroupsResponse{}
 }
 
-func (p *ListCountersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAutoScaleVmGroupsParams) Validate() error {
+	v := &validator{api: "listAutoScaleVmGroups"}
+	return v.err()
 }
 
-func (p *ListCountersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListCountersParams) SetSource(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["source"] = v
-	return
+	return *p.Account
 }
 
-// You should always use this function to get a new ListCountersParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
-	p := &ListCountersParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListAutoScaleVmGroupsParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetCounterID(name string) (string, error) {
-	p := &ListCountersParams{}
-	p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) ResetAccount() {
+	p.Account = nil
+}
 
-	p.p["name"] = name
-
-	l, err := s.ListCounters(p)
-	if err != nil {
-		return "", err
-	}
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+func (p *ListAutoScaleVmGroupsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
+	return *p.Domainid
+}
 
-	if l.Count == 1 {
-		return l.Counters[0].Id, nil
-	}
+func (p *ListAutoScaleVmGroupsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.Counters {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+func (p *ListAutoScaleVmGroupsParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetCounterByName(name string) (*Counter, int, error) {
-	id, err := s.GetCounterID(name)
-	if err != nil {
-		return nil, -1, err
-	}
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
 
-	r, count, err := s.GetCounterByID(id)
-	if err != nil {
-		return nil, count, err
+func (p *ListAutoScaleVmGroupsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	return r, count, nil
+	return *p.Fordisplay
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetCounterByID(id string) (*Counter, int, error) {
-	p := &ListCountersParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
+func (p *ListAutoScaleVmGroupsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
 
-	l, err := s.ListCounters(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
+func (p *ListAutoScaleVmGroupsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	if l.Count == 1 {
-		return l.Counters[0], l.Count, nil
+func (p *ListAutoScaleVmGroupsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Counter UUID: %s!", id)
+	return *p.Id
 }
 
-// List the counters
-func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
-	resp, err := s.cs.newRequest("listCounters", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListAutoScaleVmGroupsParams) HasId() bool {
+	return p.Id != nil
+}
 
-	var r ListCountersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *ListAutoScaleVmGroupsParams) ResetId() {
+	p.Id = nil
 }
 
-type ListCountersResponse struct {
-	Count    int        `json:"count"`
-	Counters []*Counter `json:"counter"`
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
 }
 
-type Counter struct {
-	Id     string `json:"id,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Source string `json:"source,omitempty"`
-	Value  string `json:"value,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
+func (p *ListAutoScaleVmGroupsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
 }
 
-type ListConditionsParams struct {
-	p map[string]interface{}
+func (p *ListAutoScaleVmGroupsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
 }
 
-func (p *ListConditionsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["counterid"]; found {
-		u.Set("counterid", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["policyid"]; found {
-		u.Set("policyid", v.(string))
-	}
-	return u
+func (p *ListAutoScaleVmGroupsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
 }
 
-func (p *ListConditionsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListConditionsParams) SetCounterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["counterid"] = v
-	return
+	return *p.Keyword
 }
 
-func (p *ListConditionsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *ListConditionsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-func (p *ListConditionsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
 	return
 }
 
-func (p *ListConditionsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Lbruleid
 }
 
-func (p *ListConditionsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
 }
 
-func (p *ListConditionsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) ResetLbruleid() {
+	p.Lbruleid = nil
 }
 
-func (p *ListConditionsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *ListConditionsParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["policyid"] = v
-	return
+	return *p.Listall
 }
 
-// You should always use this function to get a new ListConditionsParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
-	p := &ListConditionsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListAutoScaleVmGroupsParams) HasListall() bool {
+	return p.Listall != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetConditionByID(id string) (*Condition, int, error) {
-	p := &ListConditionsParams{}
-	p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) ResetListall() {
+	p.Listall = nil
+}
 
-	p.p["id"] = id
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
 
-	l, err := s.ListConditions(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *ListAutoScaleVmGroupsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
+	return *p.Page
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *ListAutoScaleVmGroupsParams) HasPage() bool {
+	return p.Page != nil
+}
 
-	if l.Count == 1 {
-		return l.Conditions[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for Condition UUID: %s!", id)
+func (p *ListAutoScaleVmGroupsParams) ResetPage() {
+	p.Page = nil
 }
 
-// List Conditions for the specific user
-func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
-	resp, err := s.cs.newRequest("listConditions", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
 
-	var r ListConditionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListAutoScaleVmGroupsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Pagesize
 }
 
-type ListConditionsResponse struct {
-	Count      int          `json:"count"`
-	Conditions []*Condition `json:"condition"`
+func (p *ListAutoScaleVmGroupsParams) HasPagesize() bool {
+	return p.Pagesize != nil
 }
 
-type Condition struct {
-	Account            string   `json:"account,omitempty"`
-	Counter            []string `json:"counter,omitempty"`
-	Domain             string   `json:"domain,omitempty"`
-	Domainid           string   `json:"domainid,omitempty"`
-	Id                 string   `json:"id,omitempty"`
-	Project            string   `json:"project,omitempty"`
-	Projectid          string   `json:"projectid,omitempty"`
-	Relationaloperator string   `json:"relationaloperator,omitempty"`
-	Threshold          int64    `json:"threshold,omitempty"`
-	Zoneid             string   `json:"zoneid,omitempty"`
+func (p *ListAutoScaleVmGroupsParams) ResetPagesize() {
+	p.Pagesize = nil
 }
 
-type ListAutoScalePoliciesParams struct {
-	p map[string]interface{}
+func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
+	p.Policyid = &v
+	return
 }
 
-func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["action"]; found {
-		u.Set("action", v.(string))
-	}
-	if v, found := p.p["conditionid"]; found {
-		u.Set("conditionid", v.(string))
+func (p *ListAutoScaleVmGroupsParams) GetPolicyid() string {
+	if p.Policyid == nil {
+		return ""
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["vmgroupid"]; found {
-		u.Set("vmgroupid", v.(string))
-	}
-	return u
+	return *p.Policyid
 }
 
-func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) HasPolicyid() bool {
+	return p.Policyid != nil
 }
 
-func (p *ListAutoScalePoliciesParams) SetAction(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["action"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) ResetPolicyid() {
+	p.Policyid = nil
 }
 
-func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["conditionid"] = v
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["domainid"] = v
-	return
+	return *p.Projectid
 }
 
-func (p *ListAutoScalePoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
+	p.Vmprofileid = &v
 	return
 }
 
-func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetVmprofileid() string {
+	if p.Vmprofileid == nil {
+		return ""
 	}
-	p.p["listall"] = v
-	return
+	return *p.Vmprofileid
 }
 
-func (p *ListAutoScalePoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListAutoScaleVmGroupsParams) HasVmprofileid() bool {
+	return p.Vmprofileid != nil
 }
 
-func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *ListAutoScaleVmGroupsParams) ResetVmprofileid() {
+	p.Vmprofileid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmGroupsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-	p.p["vmgroupid"] = v
-	return
+	return *p.Zoneid
 }
 
-// You should always use this function to get a new ListAutoScalePoliciesParams instance,
+func (p *ListAutoScaleVmGroupsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListAutoScaleVmGroupsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListAutoScaleVmGroupsParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams {
-	p := &ListAutoScalePoliciesParams{}
-	p.p = make(map[string]interface{})
+func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams {
+	p := &ListAutoScaleVmGroupsParams{}
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetAutoScalePolicyByID(id string) (*AutoScalePolicy, int, error) {
-	p := &ListAutoScalePoliciesParams{}
-	p.p = make(map[string]interface{})
+func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	p := &ListAutoScaleVmGroupsParams{}
 
-	p.p["id"] = id
+	p.SetId(id)
 
-	l, err := s.ListAutoScalePolicies(p)
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListAutoScaleVmGroups(p)
 	if err != nil {
 		if strings.Contains(err.Error(), fmt.Sprintf(
 			"Invalid parameter id value=%s due to incorrect long value format, "+
@@ -1606,660 +1355,668 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string) (*AutoScalePolicy,
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListAutoScaleVmGroups(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
 
 	if l.Count == 1 {
-		return l.AutoScalePolicies[0], l.Count, nil
+		return l.AutoScaleVmGroups[0], l.Count, nil
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScalePolicy UUID: %s!", id)
+	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScaleVmGroup UUID: %s!", id)
 }
 
-// Lists autoscale policies.
-func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScalePolicies", p.toURLValues())
+// Lists autoscale vm groups.
+//
+// See also GetAutoScaleVmGroupByID.
+func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listAutoScaleVmGroups", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListAutoScalePoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListAutoScaleVmGroupsResponse
+	if err := s.cs.unmarshal("listAutoScaleVmGroups", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListAutoScalePoliciesResponse struct {
-	Count             int                `json:"count"`
-	AutoScalePolicies []*AutoScalePolicy `json:"autoscalepolicy"`
+// Lists the objects like ListAutoScaleVmGroups, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AutoScaleService) StreamAutoScaleVmGroups(ctx context.Context, p *ListAutoScaleVmGroupsParams, fn func(*AutoScaleVmGroup) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listAutoScaleVmGroups", p.URLValues(), "autoscalevmgroup", func(b json.RawMessage) error {
+		var v AutoScaleVmGroup
+		if err := s.cs.unmarshalElement("listAutoScaleVmGroups", "autoscalevmgroup", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-type AutoScalePolicy struct {
-	Account    string   `json:"account,omitempty"`
-	Action     string   `json:"action,omitempty"`
-	Conditions []string `json:"conditions,omitempty"`
-	Domain     string   `json:"domain,omitempty"`
-	Domainid   string   `json:"domainid,omitempty"`
-	Duration   int      `json:"duration,omitempty"`
-	Id         string   `json:"id,omitempty"`
-	Project    string   `json:"project,omitempty"`
-	Projectid  string   `json:"projectid,omitempty"`
-	Quiettime  int      `json:"quiettime,omitempty"`
+type ListAutoScaleVmGroupsResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	AutoScaleVmGroups []*AutoScaleVmGroup        `json:"autoscalevmgroup" xml:"autoscalevmgroup"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListAutoScaleVmProfilesParams struct {
-	p map[string]interface{}
+type AutoScaleVmGroup struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay        Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Interval          int                        `json:"interval,omitempty" xml:"interval,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Maxmembers        int                        `json:"maxmembers,omitempty" xml:"maxmembers,omitempty"`
+	Minmembers        int                        `json:"minmembers,omitempty" xml:"minmembers,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Scaledownpolicies []string                   `json:"scaledownpolicies,omitempty" xml:"scaledownpolicies,omitempty"`
+	Scaleuppolicies   []string                   `json:"scaleuppolicies,omitempty" xml:"scaleuppolicies,omitempty"`
+	State             string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vmprofileid       string                     `json:"vmprofileid,omitempty" xml:"vmprofileid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
+type UpdateAutoScaleVmGroupParams struct {
+	Customid           *string  `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay         *bool    `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                 *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Interval           *int     `json:"interval,omitempty" yaml:"interval,omitempty"`
+	Maxmembers         *int     `json:"maxmembers,omitempty" yaml:"maxmembers,omitempty"`
+	Minmembers         *int     `json:"minmembers,omitempty" yaml:"minmembers,omitempty"`
+	Scaledownpolicyids []string `json:"scaledownpolicyids,omitempty" yaml:"scaledownpolicyids,omitempty"`
+	Scaleuppolicyids   []string `json:"scaleuppolicyids,omitempty" yaml:"scaleuppolicyids,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateAutoScaleVmGroupParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["otherdeployparams"]; found {
-		u.Set("otherdeployparams", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
+	if v := p.Interval; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("interval", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Maxmembers; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("maxmembers", vv)
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if v := p.Minmembers; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("minmembers", vv)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if v := p.Scaledownpolicyids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("scaledownpolicyids", vv)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Scaleuppolicyids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("scaleuppolicyids", vv)
 	}
 	return u
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateAutoScaleVmGroupParams) Command() string {
+	return "updateAutoScaleVmGroup"
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateAutoScaleVmGroupParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateAutoScaleVmGroupParams) ResponseType() interface{} {
+	return &UpdateAutoScaleVmGroupResponse{}
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateAutoScaleVmGroupParams) Validate() error {
+	v := &validator{api: "updateAutoScaleVmGroup"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
+	p.Customid = &v
 	return
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Customid
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *UpdateAutoScaleVmGroupParams) HasCustomid() bool {
+	return p.Customid != nil
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["otherdeployparams"] = v
-	return
+func (p *UpdateAutoScaleVmGroupParams) ResetCustomid() {
+	p.Customid = nil
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["pagesize"] = v
+	return *p.Fordisplay
+}
+
+func (p *UpdateAutoScaleVmGroupParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Id
+}
+
+func (p *UpdateAutoScaleVmGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
+	p.Interval = &v
 	return
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) GetInterval() int {
+	if p.Interval == nil {
+		return 0
 	}
-	p.p["serviceofferingid"] = v
+	return *p.Interval
+}
+
+func (p *UpdateAutoScaleVmGroupParams) HasInterval() bool {
+	return p.Interval != nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) ResetInterval() {
+	p.Interval = nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
+	p.Maxmembers = &v
 	return
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) GetMaxmembers() int {
+	if p.Maxmembers == nil {
+		return 0
 	}
-	p.p["templateid"] = v
+	return *p.Maxmembers
+}
+
+func (p *UpdateAutoScaleVmGroupParams) HasMaxmembers() bool {
+	return p.Maxmembers != nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) ResetMaxmembers() {
+	p.Maxmembers = nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
+	p.Minmembers = &v
 	return
 }
 
-func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) GetMinmembers() int {
+	if p.Minmembers == nil {
+		return 0
 	}
-	p.p["zoneid"] = v
+	return *p.Minmembers
+}
+
+func (p *UpdateAutoScaleVmGroupParams) HasMinmembers() bool {
+	return p.Minmembers != nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) ResetMinmembers() {
+	p.Minmembers = nil
+}
+
+func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
+	p.Scaledownpolicyids = v
 	return
 }
 
-// You should always use this function to get a new ListAutoScaleVmProfilesParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams {
-	p := &ListAutoScaleVmProfilesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *UpdateAutoScaleVmGroupParams) GetScaledownpolicyids() []string {
+	return p.Scaledownpolicyids
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string) (*AutoScaleVmProfile, int, error) {
-	p := &ListAutoScaleVmProfilesParams{}
-	p.p = make(map[string]interface{})
+func (p *UpdateAutoScaleVmGroupParams) HasScaledownpolicyids() bool {
+	return p.Scaledownpolicyids != nil
+}
 
-	p.p["id"] = id
+func (p *UpdateAutoScaleVmGroupParams) ResetScaledownpolicyids() {
+	p.Scaledownpolicyids = nil
+}
 
-	l, err := s.ListAutoScaleVmProfiles(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
-	}
+func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
+	p.Scaleuppolicyids = v
+	return
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *UpdateAutoScaleVmGroupParams) GetScaleuppolicyids() []string {
+	return p.Scaleuppolicyids
+}
 
-	if l.Count == 1 {
-		return l.AutoScaleVmProfiles[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScaleVmProfile UUID: %s!", id)
+func (p *UpdateAutoScaleVmGroupParams) HasScaleuppolicyids() bool {
+	return p.Scaleuppolicyids != nil
 }
 
-// Lists autoscale vm profiles.
-func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", p.toURLValues())
+func (p *UpdateAutoScaleVmGroupParams) ResetScaleuppolicyids() {
+	p.Scaleuppolicyids = nil
+}
+
+// You should always use this function to get a new UpdateAutoScaleVmGroupParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams {
+	p := &UpdateAutoScaleVmGroupParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates an existing autoscale vm group.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmGroups, GetAutoScaleVmGroupByID.
+func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateAutoScaleVmGroup", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListAutoScaleVmProfilesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateAutoScaleVmGroupResponse
+	if err := s.cs.unmarshal("updateAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
 
-type ListAutoScaleVmProfilesResponse struct {
-	Count               int                   `json:"count"`
-	AutoScaleVmProfiles []*AutoScaleVmProfile `json:"autoscalevmprofile"`
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-type AutoScaleVmProfile struct {
-	Account              string `json:"account,omitempty"`
-	Autoscaleuserid      string `json:"autoscaleuserid,omitempty"`
-	Destroyvmgraceperiod int    `json:"destroyvmgraceperiod,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Otherdeployparams    string `json:"otherdeployparams,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Serviceofferingid    string `json:"serviceofferingid,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateAutoScaleVmGroup", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
-type ListAutoScaleVmGroupsParams struct {
-	p map[string]interface{}
+type UpdateAutoScaleVmGroupResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay        Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Interval          int                        `json:"interval,omitempty" xml:"interval,omitempty"`
+	Lbruleid          string                     `json:"lbruleid,omitempty" xml:"lbruleid,omitempty"`
+	Maxmembers        int                        `json:"maxmembers,omitempty" xml:"maxmembers,omitempty"`
+	Minmembers        int                        `json:"minmembers,omitempty" xml:"minmembers,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Scaledownpolicies []string                   `json:"scaledownpolicies,omitempty" xml:"scaledownpolicies,omitempty"`
+	Scaleuppolicies   []string                   `json:"scaleuppolicies,omitempty" xml:"scaleuppolicies,omitempty"`
+	State             string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vmprofileid       string                     `json:"vmprofileid,omitempty" xml:"vmprofileid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
+type CreateAutoScaleVmProfileParams struct {
+	Autoscaleuserid      *string           `json:"autoscaleuserid,omitempty" yaml:"autoscaleuserid,omitempty"`
+	Counterparam         map[string]string `json:"counterparam,omitempty" yaml:"counterparam,omitempty"`
+	Destroyvmgraceperiod *int              `json:"destroyvmgraceperiod,omitempty" yaml:"destroyvmgraceperiod,omitempty"`
+	Fordisplay           *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Otherdeployparams    *string           `json:"otherdeployparams,omitempty" yaml:"otherdeployparams,omitempty"`
+	Serviceofferingid    *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Templateid           *string           `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Zoneid               *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateAutoScaleVmProfileParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Autoscaleuserid; v != nil {
+		u.Set("autoscaleuserid", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Counterparam; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("counterparam[%d].name", i), k)
+			u.Set(fmt.Sprintf("counterparam[%d].value", i), vv)
+			i++
+		}
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Destroyvmgraceperiod; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("destroyvmgraceperiod", vv)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["lbruleid"]; found {
-		u.Set("lbruleid", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["policyid"]; found {
-		u.Set("policyid", v.(string))
+	if v := p.Otherdeployparams; v != nil {
+		u.Set("otherdeployparams", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
 	}
-	if v, found := p.p["vmprofileid"]; found {
-		u.Set("vmprofileid", v.(string))
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateAutoScaleVmProfileParams) Command() string {
+	return "createAutoScaleVmProfile"
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateAutoScaleVmProfileParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateAutoScaleVmProfileParams) ResponseType() interface{} {
+	return &CreateAutoScaleVmProfileResponse{}
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateAutoScaleVmProfileParams) Validate() error {
+	v := &validator{api: "createAutoScaleVmProfile"}
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	v.required("templateid", p.Templateid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+func (p *CreateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
+	p.Autoscaleuserid = &v
 	return
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmProfileParams) GetAutoscaleuserid() string {
+	if p.Autoscaleuserid == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Autoscaleuserid
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetLbruleid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["lbruleid"] = v
-	return
+func (p *CreateAutoScaleVmProfileParams) HasAutoscaleuserid() bool {
+	return p.Autoscaleuserid != nil
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *CreateAutoScaleVmProfileParams) ResetAutoscaleuserid() {
+	p.Autoscaleuserid = nil
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+func (p *CreateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
+	p.Counterparam = v
 	return
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *CreateAutoScaleVmProfileParams) GetCounterparam() map[string]string {
+	return p.Counterparam
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["policyid"] = v
-	return
+func (p *CreateAutoScaleVmProfileParams) HasCounterparam() bool {
+	return p.Counterparam != nil
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+func (p *CreateAutoScaleVmProfileParams) ResetCounterparam() {
+	p.Counterparam = nil
+}
+
+func (p *CreateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
+	p.Destroyvmgraceperiod = &v
 	return
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetVmprofileid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmProfileParams) GetDestroyvmgraceperiod() int {
+	if p.Destroyvmgraceperiod == nil {
+		return 0
 	}
-	p.p["vmprofileid"] = v
+	return *p.Destroyvmgraceperiod
+}
+
+func (p *CreateAutoScaleVmProfileParams) HasDestroyvmgraceperiod() bool {
+	return p.Destroyvmgraceperiod != nil
+}
+
+func (p *CreateAutoScaleVmProfileParams) ResetDestroyvmgraceperiod() {
+	p.Destroyvmgraceperiod = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *CreateAutoScaleVmProfileParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListAutoScaleVmGroupsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmProfileParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Fordisplay
 }
 
-// You should always use this function to get a new ListAutoScaleVmGroupsParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams {
-	p := &ListAutoScaleVmGroupsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *CreateAutoScaleVmProfileParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string) (*AutoScaleVmGroup, int, error) {
-	p := &ListAutoScaleVmGroupsParams{}
-	p.p = make(map[string]interface{})
+func (p *CreateAutoScaleVmProfileParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
 
-	p.p["id"] = id
+func (p *CreateAutoScaleVmProfileParams) SetOtherdeployparams(v string) {
+	p.Otherdeployparams = &v
+	return
+}
 
-	l, err := s.ListAutoScaleVmGroups(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *CreateAutoScaleVmProfileParams) GetOtherdeployparams() string {
+	if p.Otherdeployparams == nil {
+		return ""
 	}
+	return *p.Otherdeployparams
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *CreateAutoScaleVmProfileParams) HasOtherdeployparams() bool {
+	return p.Otherdeployparams != nil
+}
 
-	if l.Count == 1 {
-		return l.AutoScaleVmGroups[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScaleVmGroup UUID: %s!", id)
+func (p *CreateAutoScaleVmProfileParams) ResetOtherdeployparams() {
+	p.Otherdeployparams = nil
 }
 
-// Lists autoscale vm groups.
-func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmGroups", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *CreateAutoScaleVmProfileParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
 
-	var r ListAutoScaleVmGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateAutoScaleVmProfileParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Serviceofferingid
 }
 
-type ListAutoScaleVmGroupsResponse struct {
-	Count             int                 `json:"count"`
-	AutoScaleVmGroups []*AutoScaleVmGroup `json:"autoscalevmgroup"`
+func (p *CreateAutoScaleVmProfileParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
 }
 
-type AutoScaleVmGroup struct {
-	Account           string   `json:"account,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Fordisplay        bool     `json:"fordisplay,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Interval          int      `json:"interval,omitempty"`
-	Lbruleid          string   `json:"lbruleid,omitempty"`
-	Maxmembers        int      `json:"maxmembers,omitempty"`
-	Minmembers        int      `json:"minmembers,omitempty"`
-	Project           string   `json:"project,omitempty"`
-	Projectid         string   `json:"projectid,omitempty"`
-	Scaledownpolicies []string `json:"scaledownpolicies,omitempty"`
-	Scaleuppolicies   []string `json:"scaleuppolicies,omitempty"`
-	State             string   `json:"state,omitempty"`
-	Vmprofileid       string   `json:"vmprofileid,omitempty"`
+func (p *CreateAutoScaleVmProfileParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
 }
 
-type EnableAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+// Sets the 'templateid' param: the ID of the template. This param is required.
+func (p *CreateAutoScaleVmProfileParams) SetTemplateid(v string) {
+	p.Templateid = &v
+	return
 }
 
-func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func (p *CreateAutoScaleVmProfileParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+	return *p.Templateid
 }
 
-func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CreateAutoScaleVmProfileParams) HasTemplateid() bool {
+	return p.Templateid != nil
 }
 
-// You should always use this function to get a new EnableAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams {
-	p := &EnableAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *CreateAutoScaleVmProfileParams) ResetTemplateid() {
+	p.Templateid = nil
 }
 
-// Enables an AutoScale Vm Group
-func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("enableAutoScaleVmGroup", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *CreateAutoScaleVmProfileParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
 
-	var r EnableAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *CreateAutoScaleVmProfileParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
 	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
-}
-
-type EnableAutoScaleVmGroupResponse struct {
-	JobID             string   `json:"jobid,omitempty"`
-	Account           string   `json:"account,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Fordisplay        bool     `json:"fordisplay,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Interval          int      `json:"interval,omitempty"`
-	Lbruleid          string   `json:"lbruleid,omitempty"`
-	Maxmembers        int      `json:"maxmembers,omitempty"`
-	Minmembers        int      `json:"minmembers,omitempty"`
-	Project           string   `json:"project,omitempty"`
-	Projectid         string   `json:"projectid,omitempty"`
-	Scaledownpolicies []string `json:"scaledownpolicies,omitempty"`
-	Scaleuppolicies   []string `json:"scaleuppolicies,omitempty"`
-	State             string   `json:"state,omitempty"`
-	Vmprofileid       string   `json:"vmprofileid,omitempty"`
-}
-
-type DisableAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+	return *p.Zoneid
 }
 
-func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *CreateAutoScaleVmProfileParams) HasZoneid() bool {
+	return p.Zoneid != nil
 }
 
-func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CreateAutoScaleVmProfileParams) ResetZoneid() {
+	p.Zoneid = nil
 }
 
-// You should always use this function to get a new DisableAutoScaleVmGroupParams instance,
+// You should always use this function to get a new CreateAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams {
-	p := &DisableAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - serviceofferingid: the ID of the service offering
+//   - templateid: the ID of the template
+//   - zoneid: the ID of the zone
+func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams {
+	p := &CreateAutoScaleVmProfileParams{}
+	p.SetServiceofferingid(serviceofferingid)
+	p.SetTemplateid(templateid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
-// Disables an AutoScale Vm Group
-func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("disableAutoScaleVmGroup", p.toURLValues())
+// Creates a profile that contains information about the virtual machine which will be provisioned automatically by autoscale feature.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmProfiles, GetAutoScaleVmProfileByID.
+func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createAutoScaleVmProfile", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DisableAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateAutoScaleVmProfileResponse
+	if err := s.cs.unmarshal("createAutoScaleVmProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2280,109 +2037,64 @@ func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupPar
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createAutoScaleVmProfile", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DisableAutoScaleVmGroupResponse struct {
-	JobID             string   `json:"jobid,omitempty"`
-	Account           string   `json:"account,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Fordisplay        bool     `json:"fordisplay,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Interval          int      `json:"interval,omitempty"`
-	Lbruleid          string   `json:"lbruleid,omitempty"`
-	Maxmembers        int      `json:"maxmembers,omitempty"`
-	Minmembers        int      `json:"minmembers,omitempty"`
-	Project           string   `json:"project,omitempty"`
-	Projectid         string   `json:"projectid,omitempty"`
-	Scaledownpolicies []string `json:"scaledownpolicies,omitempty"`
-	Scaleuppolicies   []string `json:"scaleuppolicies,omitempty"`
-	State             string   `json:"state,omitempty"`
-	Vmprofileid       string   `json:"vmprofileid,omitempty"`
-}
-
-type UpdateAutoScalePolicyParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["conditionids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("conditionids", vv)
-	}
-	if v, found := p.p["duration"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("duration", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["quiettime"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("quiettime", vv)
-	}
-	return u
-}
-
-func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["conditionids"] = v
-	return
-}
-
-func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["duration"] = v
-	return
-}
-
-func (p *UpdateAutoScalePolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiettime"] = v
-	return
-}
+type CreateAutoScaleVmProfileResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Autoscaleuserid      string                     `json:"autoscaleuserid,omitempty" xml:"autoscaleuserid,omitempty"`
+	Destroyvmgraceperiod int                        `json:"destroyvmgraceperiod,omitempty" xml:"destroyvmgraceperiod,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Otherdeployparams    string                     `json:"otherdeployparams,omitempty" xml:"otherdeployparams,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Serviceofferingid    string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAutoScaleVmProfileParams = cloudstackcommon.DeleteAutoScaleVmProfileParams
+type DeleteAutoScaleVmProfileResponse = cloudstackcommon.DeleteAutoScaleVmProfileResponse
 
-// You should always use this function to get a new UpdateAutoScalePolicyParams instance,
+// You should always use this function to get a new DeleteAutoScaleVmProfileParams instance,
 // as then you are sure you have configured all required params
-func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams {
-	p := &UpdateAutoScalePolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams {
+	p := &DeleteAutoScaleVmProfileParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates an existing autoscale policy.
-func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScalePolicy", p.toURLValues())
+// Deletes a autoscale vm profile.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmProfiles, GetAutoScaleVmProfileByID.
+func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteAutoScaleVmProfile", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r DeleteAutoScaleVmProfileResponse
+	if err := s.cs.unmarshal("deleteAutoScaleVmProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2398,315 +2110,1038 @@ func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams)
 			return &r, warn
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteAutoScaleVmProfile", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateAutoScalePolicyResponse struct {
-	JobID      string   `json:"jobid,omitempty"`
-	Account    string   `json:"account,omitempty"`
-	Action     string   `json:"action,omitempty"`
-	Conditions []string `json:"conditions,omitempty"`
-	Domain     string   `json:"domain,omitempty"`
-	Domainid   string   `json:"domainid,omitempty"`
-	Duration   int      `json:"duration,omitempty"`
-	Id         string   `json:"id,omitempty"`
-	Project    string   `json:"project,omitempty"`
-	Projectid  string   `json:"projectid,omitempty"`
-	Quiettime  int      `json:"quiettime,omitempty"`
-}
-
-type UpdateAutoScaleVmProfileParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
+type ListAutoScaleVmProfilesParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay        *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Otherdeployparams *string `json:"otherdeployparams,omitempty" yaml:"otherdeployparams,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Serviceofferingid *string `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Templateid        *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAutoScaleVmProfilesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["autoscaleuserid"]; found {
-		u.Set("autoscaleuserid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["counterparam"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("counterparam[%d].key", i), k)
-			u.Set(fmt.Sprintf("counterparam[%d].value", i), vv)
-			i++
-		}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["destroyvmgraceperiod"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("destroyvmgraceperiod", vv)
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Otherdeployparams; v != nil {
+		u.Set("otherdeployparams", *v)
 	}
-	if v, found := p.p["templateid"]; found {
-		u.Set("templateid", v.(string))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
+	}
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["autoscaleuserid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListAutoScaleVmProfilesParams) Command() string {
+	return "listAutoScaleVmProfiles"
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["counterparam"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListAutoScaleVmProfilesParams) IsAsync() bool {
+	return false
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAutoScaleVmProfilesParams) ResponseType() interface{} {
+	return &ListAutoScaleVmProfilesResponse{}
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["destroyvmgraceperiod"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAutoScaleVmProfilesParams) Validate() error {
+	v := &validator{api: "listAutoScaleVmProfiles"}
+	return v.err()
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmProfilesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Account
 }
 
-func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["templateid"] = v
-	return
+func (p *ListAutoScaleVmProfilesParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-// You should always use this function to get a new UpdateAutoScaleVmProfileParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams {
-	p := &UpdateAutoScaleVmProfileParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListAutoScaleVmProfilesParams) ResetAccount() {
+	p.Account = nil
 }
 
-// Updates an existing autoscale vm profile.
-func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmProfile", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListAutoScaleVmProfilesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
 
-	var r UpdateAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListAutoScaleVmProfilesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
+	return *p.Domainid
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *ListAutoScaleVmProfilesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ListAutoScaleVmProfilesParams) ResetDomainid() {
+	p.Domainid = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *ListAutoScaleVmProfilesParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
 }
 
-type UpdateAutoScaleVmProfileResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Autoscaleuserid      string `json:"autoscaleuserid,omitempty"`
-	Destroyvmgraceperiod int    `json:"destroyvmgraceperiod,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Otherdeployparams    string `json:"otherdeployparams,omitempty"`
-	Project              string `json:"project,omitempty"`
-	Projectid            string `json:"projectid,omitempty"`
-	Serviceofferingid    string `json:"serviceofferingid,omitempty"`
-	Templateid           string `json:"templateid,omitempty"`
-	Zoneid               string `json:"zoneid,omitempty"`
+func (p *ListAutoScaleVmProfilesParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
 }
 
-type UpdateAutoScaleVmGroupParams struct {
-	p map[string]interface{}
+func (p *ListAutoScaleVmProfilesParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["interval"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("interval", vv)
-	}
-	if v, found := p.p["maxmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxmembers", vv)
-	}
-	if v, found := p.p["minmembers"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("minmembers", vv)
-	}
-	if v, found := p.p["scaledownpolicyids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("scaledownpolicyids", vv)
-	}
-	if v, found := p.p["scaleuppolicyids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("scaleuppolicyids", vv)
-	}
-	return u
+func (p *ListAutoScaleVmProfilesParams) ResetFordisplay() {
+	p.Fordisplay = nil
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+func (p *ListAutoScaleVmProfilesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmProfilesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
-	return
+	return *p.Id
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListAutoScaleVmProfilesParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetInterval(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["interval"] = v
-	return
+func (p *ListAutoScaleVmProfilesParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetMaxmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxmembers"] = v
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListAutoScaleVmProfilesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
 	return
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetMinmembers(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmProfilesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
-	p.p["minmembers"] = v
+	return *p.Isrecursive
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAutoScaleVmProfilesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetScaledownpolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmProfilesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["scaledownpolicyids"] = v
+	return *p.Keyword
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListAutoScaleVmProfilesParams) SetListall(v bool) {
+	p.Listall = &v
 	return
 }
 
-func (p *UpdateAutoScaleVmGroupParams) SetScaleuppolicyids(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListAutoScaleVmProfilesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	p.p["scaleuppolicyids"] = v
-	return
+	return *p.Listall
 }
 
-// You should always use this function to get a new UpdateAutoScaleVmGroupParams instance,
-// as then you are sure you have configured all required params
-func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams {
-	p := &UpdateAutoScaleVmGroupParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListAutoScaleVmProfilesParams) HasListall() bool {
+	return p.Listall != nil
 }
 
-// Updates an existing autoscale vm group.
-func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newRequest("updateAutoScaleVmGroup", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListAutoScaleVmProfilesParams) ResetListall() {
+	p.Listall = nil
+}
 
-	var r UpdateAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListAutoScaleVmProfilesParams) SetOtherdeployparams(v string) {
+	p.Otherdeployparams = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetOtherdeployparams() string {
+	if p.Otherdeployparams == nil {
+		return ""
+	}
+	return *p.Otherdeployparams
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasOtherdeployparams() bool {
+	return p.Otherdeployparams != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetOtherdeployparams() {
+	p.Otherdeployparams = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAutoScaleVmProfilesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAutoScaleVmProfilesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListAutoScaleVmProfilesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering.
+func (p *ListAutoScaleVmProfilesParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
+	}
+	return *p.Serviceofferingid
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+// Sets the 'templateid' param: the ID of the template.
+func (p *ListAutoScaleVmProfilesParams) SetTemplateid(v string) {
+	p.Templateid = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
+	}
+	return *p.Templateid
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasTemplateid() bool {
+	return p.Templateid != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetTemplateid() {
+	p.Templateid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListAutoScaleVmProfilesParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListAutoScaleVmProfilesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListAutoScaleVmProfilesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListAutoScaleVmProfilesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListAutoScaleVmProfilesParams instance,
+// as then you are sure you have configured all required params
+func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams {
+	p := &ListAutoScaleVmProfilesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
+	p := &ListAutoScaleVmProfilesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListAutoScaleVmProfiles(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListAutoScaleVmProfiles(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.AutoScaleVmProfiles[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for AutoScaleVmProfile UUID: %s!", id)
+}
+
+// Lists autoscale vm profiles.
+//
+// See also GetAutoScaleVmProfileByID.
+func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListAutoScaleVmProfilesResponse
+	if err := s.cs.unmarshal("listAutoScaleVmProfiles", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListAutoScaleVmProfiles, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AutoScaleService) StreamAutoScaleVmProfiles(ctx context.Context, p *ListAutoScaleVmProfilesParams, fn func(*AutoScaleVmProfile) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listAutoScaleVmProfiles", p.URLValues(), "autoscalevmprofile", func(b json.RawMessage) error {
+		var v AutoScaleVmProfile
+		if err := s.cs.unmarshalElement("listAutoScaleVmProfiles", "autoscalevmprofile", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type ListAutoScaleVmProfilesResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	AutoScaleVmProfiles []*AutoScaleVmProfile      `json:"autoscalevmprofile" xml:"autoscalevmprofile"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AutoScaleVmProfile struct {
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Autoscaleuserid      string                     `json:"autoscaleuserid,omitempty" xml:"autoscaleuserid,omitempty"`
+	Destroyvmgraceperiod int                        `json:"destroyvmgraceperiod,omitempty" xml:"destroyvmgraceperiod,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Otherdeployparams    string                     `json:"otherdeployparams,omitempty" xml:"otherdeployparams,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Serviceofferingid    string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateAutoScaleVmProfileParams struct {
+	Autoscaleuserid      *string           `json:"autoscaleuserid,omitempty" yaml:"autoscaleuserid,omitempty"`
+	Counterparam         map[string]string `json:"counterparam,omitempty" yaml:"counterparam,omitempty"`
+	Customid             *string           `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Destroyvmgraceperiod *int              `json:"destroyvmgraceperiod,omitempty" yaml:"destroyvmgraceperiod,omitempty"`
+	Fordisplay           *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                   *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Templateid           *string           `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateAutoScaleVmProfileParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Autoscaleuserid; v != nil {
+		u.Set("autoscaleuserid", *v)
+	}
+	if v := p.Counterparam; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("counterparam[%d].name", i), k)
+			u.Set(fmt.Sprintf("counterparam[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
+	}
+	if v := p.Destroyvmgraceperiod; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("destroyvmgraceperiod", vv)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateAutoScaleVmProfileParams) Command() string {
+	return "updateAutoScaleVmProfile"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateAutoScaleVmProfileParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateAutoScaleVmProfileParams) ResponseType() interface{} {
+	return &UpdateAutoScaleVmProfileResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateAutoScaleVmProfileParams) Validate() error {
+	v := &validator{api: "updateAutoScaleVmProfile"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
+	p.Autoscaleuserid = &v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetAutoscaleuserid() string {
+	if p.Autoscaleuserid == nil {
+		return ""
+	}
+	return *p.Autoscaleuserid
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasAutoscaleuserid() bool {
+	return p.Autoscaleuserid != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetAutoscaleuserid() {
+	p.Autoscaleuserid = nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) SetCounterparam(v map[string]string) {
+	p.Counterparam = v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetCounterparam() map[string]string {
+	return p.Counterparam
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasCounterparam() bool {
+	return p.Counterparam != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetCounterparam() {
+	p.Counterparam = nil
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateAutoScaleVmProfileParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
+	}
+	return *p.Customid
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) SetDestroyvmgraceperiod(v int) {
+	p.Destroyvmgraceperiod = &v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetDestroyvmgraceperiod() int {
+	if p.Destroyvmgraceperiod == nil {
+		return 0
+	}
+	return *p.Destroyvmgraceperiod
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasDestroyvmgraceperiod() bool {
+	return p.Destroyvmgraceperiod != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetDestroyvmgraceperiod() {
+	p.Destroyvmgraceperiod = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateAutoScaleVmProfileParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *UpdateAutoScaleVmProfileParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'templateid' param: the ID of the template.
+func (p *UpdateAutoScaleVmProfileParams) SetTemplateid(v string) {
+	p.Templateid = &v
+	return
+}
+
+func (p *UpdateAutoScaleVmProfileParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
+	}
+	return *p.Templateid
+}
+
+func (p *UpdateAutoScaleVmProfileParams) HasTemplateid() bool {
+	return p.Templateid != nil
+}
+
+func (p *UpdateAutoScaleVmProfileParams) ResetTemplateid() {
+	p.Templateid = nil
+}
+
+// You should always use this function to get a new UpdateAutoScaleVmProfileParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams {
+	p := &UpdateAutoScaleVmProfileParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates an existing autoscale vm profile.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListAutoScaleVmProfiles, GetAutoScaleVmProfileByID.
+func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateAutoScaleVmProfile", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateAutoScaleVmProfileResponse
+	if err := s.cs.unmarshal("updateAutoScaleVmProfile", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateAutoScaleVmProfile", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type UpdateAutoScaleVmProfileResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Autoscaleuserid      string                     `json:"autoscaleuserid,omitempty" xml:"autoscaleuserid,omitempty"`
+	Destroyvmgraceperiod int                        `json:"destroyvmgraceperiod,omitempty" xml:"destroyvmgraceperiod,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Otherdeployparams    string                     `json:"otherdeployparams,omitempty" xml:"otherdeployparams,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Serviceofferingid    string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateConditionParams = cloudstackcommon.CreateConditionParams
+type CreateConditionResponse = cloudstackcommon.CreateConditionResponse
+
+// You should always use this function to get a new CreateConditionParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - counterid
+//   - relationaloperator
+//   - threshold
+func (s *AutoScaleService) NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams {
+	p := &CreateConditionParams{}
+	p.SetCounterid(counterid)
+	p.SetRelationaloperator(relationaloperator)
+	p.SetThreshold(threshold)
+	return p
+}
+
+// Creates a condition
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListConditions, GetConditionByID.
+func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createCondition", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateConditionResponse
+	if err := s.cs.unmarshal("createCondition", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createCondition", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type DeleteConditionParams = cloudstackcommon.DeleteConditionParams
+type DeleteConditionResponse = cloudstackcommon.DeleteConditionResponse
+
+// You should always use this function to get a new DeleteConditionParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionParams {
+	p := &DeleteConditionParams{}
+	p.SetId(id)
+	return p
+}
+
+// Removes a condition
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListConditions, GetConditionByID.
+func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteCondition", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteConditionResponse
+	if err := s.cs.unmarshal("deleteCondition", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteCondition", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListConditionsParams = cloudstackcommon.ListConditionsParams
+type ListConditionsResponse = cloudstackcommon.ListConditionsResponse
+type Condition = cloudstackcommon.Condition
+
+// You should always use this function to get a new ListConditionsParams instance,
+// as then you are sure you have configured all required params
+func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
+	p := &ListConditionsParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
+	p := &ListConditionsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListConditions(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Conditions[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Condition UUID: %s!", id)
+}
+
+// List Conditions for the specific user
+//
+// See also GetConditionByID.
+func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listConditions", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListConditionsResponse
+	if err := s.cs.unmarshal("listConditions", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListConditions, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AutoScaleService) StreamConditions(ctx context.Context, p *ListConditionsParams, fn func(*Condition) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listConditions", p.URLValues(), "condition", func(b json.RawMessage) error {
+		var v Condition
+		if err := s.cs.unmarshalElement("listConditions", "condition", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type CreateCounterParams = cloudstackcommon.CreateCounterParams
+type CreateCounterResponse = cloudstackcommon.CreateCounterResponse
+
+// You should always use this function to get a new CreateCounterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
+//   - source
+//   - value
+func (s *AutoScaleService) NewCreateCounterParams(name string, source string, value string) *CreateCounterParams {
+	p := &CreateCounterParams{}
+	p.SetName(name)
+	p.SetSource(source)
+	p.SetValue(value)
+	return p
+}
+
+// Adds metric counter
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListCounters, GetCounterID, GetCounterByName, GetCounterByID.
+func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createCounter", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateCounterResponse
+	if err := s.cs.unmarshal("createCounter", resp, &r); err != nil {
+		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
@@ -2726,28 +3161,212 @@ func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParam
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createCounter", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateAutoScaleVmGroupResponse struct {
-	JobID             string   `json:"jobid,omitempty"`
-	Account           string   `json:"account,omitempty"`
-	Domain            string   `json:"domain,omitempty"`
-	Domainid          string   `json:"domainid,omitempty"`
-	Fordisplay        bool     `json:"fordisplay,omitempty"`
-	Id                string   `json:"id,omitempty"`
-	Interval          int      `json:"interval,omitempty"`
-	Lbruleid          string   `json:"lbruleid,omitempty"`
-	Maxmembers        int      `json:"maxmembers,omitempty"`
-	Minmembers        int      `json:"minmembers,omitempty"`
-	Project           string   `json:"project,omitempty"`
-	Projectid         string   `json:"projectid,omitempty"`
-	Scaledownpolicies []string `json:"scaledownpolicies,omitempty"`
-	Scaleuppolicies   []string `json:"scaleuppolicies,omitempty"`
-	State             string   `json:"state,omitempty"`
-	Vmprofileid       string   `json:"vmprofileid,omitempty"`
+type DeleteCounterParams = cloudstackcommon.DeleteCounterParams
+type DeleteCounterResponse = cloudstackcommon.DeleteCounterResponse
+
+// You should always use this function to get a new DeleteCounterParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParams {
+	p := &DeleteCounterParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a counter
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListCounters, GetCounterID, GetCounterByName, GetCounterByID.
+func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteCounter", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteCounterResponse
+	if err := s.cs.unmarshal("deleteCounter", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteCounter", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListCountersParams = cloudstackcommon.ListCountersParams
+type ListCountersResponse = cloudstackcommon.ListCountersResponse
+type Counter = cloudstackcommon.Counter
+
+// You should always use this function to get a new ListCountersParams instance,
+// as then you are sure you have configured all required params
+func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
+	p := &ListCountersParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListCountersParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListCounters(p)
+	if err != nil {
+		return "", err
+	}
+
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
+
+	if l.Count == 1 {
+		return l.Counters[0].Id, nil
+	}
+
+	var matches []*Counter
+	for _, v := range l.Counters {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Counter", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *AutoScaleService) GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error) {
+	id, err := s.GetCounterID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
+
+	r, count, err := s.GetCounterByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error) {
+	p := &ListCountersParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListCounters(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.Counters[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for Counter UUID: %s!", id)
+}
+
+// List the counters
+//
+// See also GetCounterID, GetCounterByName, GetCounterByID.
+func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listCounters", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListCountersResponse
+	if err := s.cs.unmarshal("listCounters", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListCounters, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *AutoScaleService) StreamCounters(ctx context.Context, p *ListCountersParams, fn func(*Counter) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listCounters", p.URLValues(), "counter", func(b json.RawMessage) error {
+		var v Counter
+		if err := s.cs.unmarshalElement("listCounters", "counter", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
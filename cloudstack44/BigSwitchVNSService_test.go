@@ -0,0 +1,242 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddBigSwitchVnsDevice(t *testing.T) {
+	params := url.Values{
+		"hostname":          {"test-hostname"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "addBigSwitchVnsDevice", params, `{"addbigswitchvnsdeviceresponse":{"bigswitchdevicename":"test-bigswitchdevicename","hostname":"test-hostname","jobid":"test-jobid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","vnsdeviceid":"test-vnsdeviceid"}}`)
+	defer done()
+
+	p := cs.BigSwitchVNS.NewAddBigSwitchVnsDeviceParams("test-hostname", "test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHostname("test-hostname")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "addBigSwitchVnsDevice", p.URLValues(), params)
+
+	r, err := cs.BigSwitchVNS.AddBigSwitchVnsDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBigSwitchVnsDevice: %v", err)
+	}
+	if r.Bigswitchdevicename != "test-bigswitchdevicename" {
+		t.Errorf("Expected r.Bigswitchdevicename to be %v, got %v", "test-bigswitchdevicename", r.Bigswitchdevicename)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addBigSwitchVnsDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addBigSwitchVnsDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addBigSwitchVnsDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addBigSwitchVnsDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addBigSwitchVnsDevice", params, `<addbigswitchvnsdeviceresponse><bigswitchdevicename>test-bigswitchdevicename</bigswitchdevicename><hostname>test-hostname</hostname><jobid>test-jobid</jobid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><vnsdeviceid>test-vnsdeviceid</vnsdeviceid></addbigswitchvnsdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.BigSwitchVNS.AddBigSwitchVnsDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call addBigSwitchVnsDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteBigSwitchVnsDevice(t *testing.T) {
+	params := url.Values{
+		"vnsdeviceid": {"test-vnsdeviceid"},
+	}
+	cs, done := newTestClient(t, "deleteBigSwitchVnsDevice", params, `{"deletebigswitchvnsdeviceresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.BigSwitchVNS.NewDeleteBigSwitchVnsDeviceParams("test-vnsdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetVnsdeviceid("test-vnsdeviceid")
+	checkURLValues(t, "deleteBigSwitchVnsDevice", p.URLValues(), params)
+
+	r, err := cs.BigSwitchVNS.DeleteBigSwitchVnsDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteBigSwitchVnsDevice: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteBigSwitchVnsDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteBigSwitchVnsDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteBigSwitchVnsDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteBigSwitchVnsDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteBigSwitchVnsDevice", params, `<deletebigswitchvnsdeviceresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletebigswitchvnsdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.BigSwitchVNS.DeleteBigSwitchVnsDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteBigSwitchVnsDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListBigSwitchVnsDevices(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"page":              {"2"},
+		"pagesize":          {"3"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"vnsdeviceid":       {"test-vnsdeviceid"},
+	}
+	cs, done := newTestClient(t, "listBigSwitchVnsDevices", params, `{"listbigswitchvnsdevicesresponse":{"bigswitchvnsdevice":[{"bigswitchdevicename":"test-bigswitchdevicename","hostname":"test-hostname","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","vnsdeviceid":"test-vnsdeviceid"}],"count":1}}`)
+	defer done()
+
+	p := cs.BigSwitchVNS.NewListBigSwitchVnsDevicesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetVnsdeviceid("test-vnsdeviceid")
+	checkURLValues(t, "listBigSwitchVnsDevices", p.URLValues(), params)
+
+	r, err := cs.BigSwitchVNS.ListBigSwitchVnsDevices(p)
+	if err != nil {
+		t.Fatalf("Failed to call listBigSwitchVnsDevices: %v", err)
+	}
+	if r.BigSwitchVnsDevices[0].Bigswitchdevicename != "test-bigswitchdevicename" {
+		t.Errorf("Expected r.BigSwitchVnsDevices[0].Bigswitchdevicename to be %v, got %v", "test-bigswitchdevicename", r.BigSwitchVnsDevices[0].Bigswitchdevicename)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listBigSwitchVnsDevices" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listBigSwitchVnsDevices: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listBigSwitchVnsDevices: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listBigSwitchVnsDevices: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*BigSwitchVnsDevice
+	count, err := cs.BigSwitchVNS.StreamBigSwitchVnsDevices(context.Background(), p, func(v *BigSwitchVnsDevice) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listBigSwitchVnsDevices: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.BigSwitchVnsDevices) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.BigSwitchVnsDevices)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listBigSwitchVnsDevices", params, `<listbigswitchvnsdevicesresponse><bigswitchvnsdevice><bigswitchdevicename>test-bigswitchdevicename</bigswitchdevicename><hostname>test-hostname</hostname><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><provider>test-provider</provider><vnsdeviceid>test-vnsdeviceid</vnsdeviceid></bigswitchvnsdevice><count>1</count></listbigswitchvnsdevicesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.BigSwitchVNS.ListBigSwitchVnsDevices(p)
+	if err != nil {
+		t.Fatalf("Failed to call listBigSwitchVnsDevices using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.BigSwitchVNS.StreamBigSwitchVnsDevices(context.Background(), p, func(v *BigSwitchVnsDevice) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listBigSwitchVnsDevices using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.BigSwitchVnsDevices) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.BigSwitchVnsDevices)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
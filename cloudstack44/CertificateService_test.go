@@ -0,0 +1,95 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUploadCustomCertificate(t *testing.T) {
+	params := url.Values{
+		"certificate":  {"test-certificate"},
+		"domainsuffix": {"test-domainsuffix"},
+		"id":           {"3"},
+		"name":         {"test-name"},
+		"privatekey":   {"test-privatekey"},
+	}
+	cs, done := newTestClient(t, "uploadCustomCertificate", params, `{"uploadcustomcertificateresponse":{"jobid":"test-jobid","message":"test-message"}}`)
+	defer done()
+
+	p := cs.Certificate.NewUploadCustomCertificateParams("test-certificate", "test-domainsuffix")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCertificate("test-certificate")
+	p.SetDomainsuffix("test-domainsuffix")
+	p.SetId(3)
+	p.SetName("test-name")
+	p.SetPrivatekey("test-privatekey")
+	checkURLValues(t, "uploadCustomCertificate", p.URLValues(), params)
+
+	r, err := cs.Certificate.UploadCustomCertificate(p)
+	if err != nil {
+		t.Fatalf("Failed to call uploadCustomCertificate: %v", err)
+	}
+	if r.Message != "test-message" {
+		t.Errorf("Expected r.Message to be %v, got %v", "test-message", r.Message)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "uploadCustomCertificate" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute uploadCustomCertificate: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of uploadCustomCertificate: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of uploadCustomCertificate: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "uploadCustomCertificate", params, `<uploadcustomcertificateresponse><jobid>test-jobid</jobid><message>test-message</message></uploadcustomcertificateresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Certificate.UploadCustomCertificate(p)
+	if err != nil {
+		t.Fatalf("Failed to call uploadCustomCertificate using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,84 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetCloudIdentifier(t *testing.T) {
+	params := url.Values{
+		"userid": {"test-userid"},
+	}
+	cs, done := newTestClient(t, "getCloudIdentifier", params, `{"getcloudidentifierresponse":{"cloudidentifier":"test-cloudidentifier","signature":"test-signature","userid":"test-userid"}}`)
+	defer done()
+
+	p := cs.CloudIdentifier.NewGetCloudIdentifierParams("test-userid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetUserid("test-userid")
+	checkURLValues(t, "getCloudIdentifier", p.URLValues(), params)
+
+	r, err := cs.CloudIdentifier.GetCloudIdentifier(p)
+	if err != nil {
+		t.Fatalf("Failed to call getCloudIdentifier: %v", err)
+	}
+	if r.Cloudidentifier != "test-cloudidentifier" {
+		t.Errorf("Expected r.Cloudidentifier to be %v, got %v", "test-cloudidentifier", r.Cloudidentifier)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "getCloudIdentifier" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute getCloudIdentifier: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of getCloudIdentifier: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of getCloudIdentifier: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "getCloudIdentifier", params, `<getcloudidentifierresponse><cloudidentifier>test-cloudidentifier</cloudidentifier><signature>test-signature</signature><userid>test-userid</userid></getcloudidentifierresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.CloudIdentifier.GetCloudIdentifier(p)
+	if err != nil {
+		t.Fatalf("Failed to call getCloudIdentifier using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
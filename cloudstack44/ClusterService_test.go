@@ -0,0 +1,568 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddCluster(t *testing.T) {
+	params := url.Values{
+		"allocationstate":   {"test-allocationstate"},
+		"clustername":       {"test-clustername"},
+		"clustertype":       {"test-clustertype"},
+		"guestvswitchname":  {"test-guestvswitchname"},
+		"guestvswitchtype":  {"test-guestvswitchtype"},
+		"hypervisor":        {"BareMetal"},
+		"password":          {"test-password"},
+		"podid":             {"test-podid"},
+		"publicvswitchname": {"test-publicvswitchname"},
+		"publicvswitchtype": {"test-publicvswitchtype"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+		"vsmipaddress":      {"test-vsmipaddress"},
+		"vsmpassword":       {"test-vsmpassword"},
+		"vsmusername":       {"test-vsmusername"},
+		"zoneid":            {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addCluster", params, `{"addclusterresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"clustertype":"test-clustertype","cpuovercommitratio":"test-cpuovercommitratio","hypervisortype":"test-hypervisortype","id":"test-id","managedstate":"test-managedstate","memoryovercommitratio":"test-memoryovercommitratio","name":"test-name","podid":"test-podid","podname":"test-podname","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Cluster.NewAddClusterParams("test-clustername", "test-clustertype", HypervisorBareMetal, "test-podid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClustername("test-clustername")
+	p.SetClustertype("test-clustertype")
+	p.SetGuestvswitchname("test-guestvswitchname")
+	p.SetGuestvswitchtype("test-guestvswitchtype")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetPassword("test-password")
+	p.SetPodid("test-podid")
+	p.SetPublicvswitchname("test-publicvswitchname")
+	p.SetPublicvswitchtype("test-publicvswitchtype")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetVsmipaddress("test-vsmipaddress")
+	p.SetVsmpassword("test-vsmpassword")
+	p.SetVsmusername("test-vsmusername")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addCluster", p.URLValues(), params)
+
+	r, err := cs.Cluster.AddCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call addCluster: %v", err)
+	}
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addCluster" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addCluster: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addCluster: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addCluster: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addCluster", params, `<addclusterresponse><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><clustertype>test-clustertype</clustertype><cpuovercommitratio>test-cpuovercommitratio</cpuovercommitratio><hypervisortype>test-hypervisortype</hypervisortype><id>test-id</id><managedstate>test-managedstate</managedstate><memoryovercommitratio>test-memoryovercommitratio</memoryovercommitratio><name>test-name</name><podid>test-podid</podid><podname>test-podname</podname><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></addclusterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.AddCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call addCluster using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDedicateCluster(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"clusterid": {"test-clusterid"},
+		"domainid":  {"test-domainid"},
+	}
+	cs, done := newTestClient(t, "dedicateCluster", params, `{"dedicateclusterresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","clusterid":"test-clusterid","clustername":"test-clustername","domainid":"test-domainid","id":"test-id","jobid":"test-jobid"}}`)
+	defer done()
+
+	p := cs.Cluster.NewDedicateClusterParams("test-clusterid", "test-domainid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetClusterid("test-clusterid")
+	p.SetDomainid("test-domainid")
+	checkURLValues(t, "dedicateCluster", p.URLValues(), params)
+
+	r, err := cs.Cluster.DedicateCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateCluster: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateCluster" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute dedicateCluster: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of dedicateCluster: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of dedicateCluster: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "dedicateCluster", params, `<dedicateclusterresponse><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid></dedicateclusterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.DedicateCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateCluster using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteCluster(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteCluster", params, `{"deleteclusterresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Cluster.NewDeleteClusterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteCluster", p.URLValues(), params)
+
+	r, err := cs.Cluster.DeleteCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCluster: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCluster" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteCluster: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteCluster: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteCluster: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteCluster", params, `<deleteclusterresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteclusterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.DeleteCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteCluster using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListClusters(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"clustertype":     {"test-clustertype"},
+		"hypervisor":      {"BareMetal"},
+		"id":              {"test-id"},
+		"keyword":         {"test-keyword"},
+		"managedstate":    {"test-managedstate"},
+		"name":            {"test-name"},
+		"page":            {"8"},
+		"pagesize":        {"9"},
+		"podid":           {"test-podid"},
+		"showcapacities":  {"true"},
+		"zoneid":          {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listClusters", params, `{"listclustersresponse":{"cluster":[{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"clustertype":"test-clustertype","cpuovercommitratio":"test-cpuovercommitratio","hypervisortype":"test-hypervisortype","id":"test-id","managedstate":"test-managedstate","memoryovercommitratio":"test-memoryovercommitratio","name":"test-name","podid":"test-podid","podname":"test-podname","zoneid":"test-zoneid","zonename":"test-zonename"}],"count":1}}`)
+	defer done()
+
+	p := cs.Cluster.NewListClustersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClustertype("test-clustertype")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetManagedstate("test-managedstate")
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetPodid("test-podid")
+	p.SetShowcapacities(true)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listClusters", p.URLValues(), params)
+
+	r, err := cs.Cluster.ListClusters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listClusters: %v", err)
+	}
+	if r.Clusters[0].Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Clusters[0].Allocationstate to be %v, got %v", "test-allocationstate", r.Clusters[0].Allocationstate)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listClusters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listClusters: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listClusters: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listClusters: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Cluster
+	count, err := cs.Cluster.StreamClusters(context.Background(), p, func(v *Cluster) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listClusters: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Clusters) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Clusters)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listClusters", params, `<listclustersresponse><cluster><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><clustertype>test-clustertype</clustertype><cpuovercommitratio>test-cpuovercommitratio</cpuovercommitratio><hypervisortype>test-hypervisortype</hypervisortype><id>test-id</id><managedstate>test-managedstate</managedstate><memoryovercommitratio>test-memoryovercommitratio</memoryovercommitratio><name>test-name</name><podid>test-podid</podid><podname>test-podname</podname><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></cluster><count>1</count></listclustersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.ListClusters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listClusters using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Cluster.StreamClusters(context.Background(), p, func(v *Cluster) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listClusters using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Clusters) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Clusters)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateCluster(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"clustername":     {"test-clustername"},
+		"clustertype":     {"test-clustertype"},
+		"hypervisor":      {"BareMetal"},
+		"id":              {"test-id"},
+		"managedstate":    {"test-managedstate"},
+	}
+	cs, done := newTestClient(t, "updateCluster", params, `{"updateclusterresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"clustertype":"test-clustertype","cpuovercommitratio":"test-cpuovercommitratio","hypervisortype":"test-hypervisortype","id":"test-id","managedstate":"test-managedstate","memoryovercommitratio":"test-memoryovercommitratio","name":"test-name","podid":"test-podid","podname":"test-podname","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Cluster.NewUpdateClusterParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClustername("test-clustername")
+	p.SetClustertype("test-clustertype")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetManagedstate("test-managedstate")
+	checkURLValues(t, "updateCluster", p.URLValues(), params)
+
+	r, err := cs.Cluster.UpdateCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateCluster: %v", err)
+	}
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateCluster" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateCluster: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateCluster: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateCluster: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateCluster", params, `<updateclusterresponse><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><clustertype>test-clustertype</clustertype><cpuovercommitratio>test-cpuovercommitratio</cpuovercommitratio><hypervisortype>test-hypervisortype</hypervisortype><id>test-id</id><managedstate>test-managedstate</managedstate><memoryovercommitratio>test-memoryovercommitratio</memoryovercommitratio><name>test-name</name><podid>test-podid</podid><podname>test-podname</podname><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updateclusterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.UpdateCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateCluster using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListDedicatedClusters(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"clusterid":       {"test-clusterid"},
+		"domainid":        {"test-domainid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"6"},
+		"pagesize":        {"7"},
+	}
+	cs, done := newTestClient(t, "listDedicatedClusters", params, `{"listdedicatedclustersresponse":{"count":1,"dedicatedcluster":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","clusterid":"test-clusterid","clustername":"test-clustername","domainid":"test-domainid","id":"test-id"}]}}`)
+	defer done()
+
+	p := cs.Cluster.NewListDedicatedClustersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetClusterid("test-clusterid")
+	p.SetDomainid("test-domainid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	checkURLValues(t, "listDedicatedClusters", p.URLValues(), params)
+
+	r, err := cs.Cluster.ListDedicatedClusters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedClusters: %v", err)
+	}
+	if r.DedicatedClusters[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedClusters[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedClusters[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedClusters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDedicatedClusters: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDedicatedClusters: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDedicatedClusters: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DedicatedCluster
+	count, err := cs.Cluster.StreamDedicatedClusters(context.Background(), p, func(v *DedicatedCluster) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDedicatedClusters: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DedicatedClusters) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DedicatedClusters)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDedicatedClusters", params, `<listdedicatedclustersresponse><count>1</count><dedicatedcluster><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><domainid>test-domainid</domainid><id>test-id</id></dedicatedcluster></listdedicatedclustersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.ListDedicatedClusters(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedClusters using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Cluster.StreamDedicatedClusters(context.Background(), p, func(v *DedicatedCluster) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDedicatedClusters using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DedicatedClusters) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DedicatedClusters)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleaseDedicatedCluster(t *testing.T) {
+	params := url.Values{
+		"clusterid": {"test-clusterid"},
+	}
+	cs, done := newTestClient(t, "releaseDedicatedCluster", params, `{"releasededicatedclusterresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Cluster.NewReleaseDedicatedClusterParams("test-clusterid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetClusterid("test-clusterid")
+	checkURLValues(t, "releaseDedicatedCluster", p.URLValues(), params)
+
+	r, err := cs.Cluster.ReleaseDedicatedCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedCluster: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedCluster" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releaseDedicatedCluster: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releaseDedicatedCluster: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releaseDedicatedCluster: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releaseDedicatedCluster", params, `<releasededicatedclusterresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></releasededicatedclusterresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Cluster.ReleaseDedicatedCluster(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedCluster using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,615 +17,379 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
-	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type UpdateConfigurationParams struct {
-	p map[string]interface{}
+type ConfigurationService struct {
+	cs *CloudStackClient
 }
 
-func (p *UpdateConfigurationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
-	if v, found := p.p["value"]; found {
-		u.Set("value", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func NewConfigurationService(cs *CloudStackClient) *ConfigurationService {
+	return &ConfigurationService{cs: cs}
 }
 
-func (p *UpdateConfigurationParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
-	return
+type ListCapabilitiesParams struct {
 }
 
-func (p *UpdateConfigurationParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListCapabilitiesParams) URLValues() url.Values {
+	u := url.Values{}
+	return u
 }
 
-func (p *UpdateConfigurationParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListCapabilitiesParams) Command() string {
+	return "listCapabilities"
 }
 
-func (p *UpdateConfigurationParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListCapabilitiesParams) IsAsync() bool {
+	return false
 }
 
-func (p *UpdateConfigurationParams) SetValue(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["value"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListCapabilitiesParams) ResponseType() interface{} {
+	return &ListCapabilitiesResponse{}
 }
 
-func (p *UpdateConfigurationParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListCapabilitiesParams) Validate() error {
+	v := &validator{api: "listCapabilities"}
+	return v.err()
 }
 
-// You should always use this function to get a new UpdateConfigurationParams instance,
+// You should always use this function to get a new ListCapabilitiesParams instance,
 // as then you are sure you have configured all required params
-func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *UpdateConfigurationParams {
-	p := &UpdateConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
+func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
+	p := &ListCapabilitiesParams{}
 	return p
 }
 
-// Updates a configuration.
-func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("updateConfiguration", p.toURLValues())
+// Lists capabilities
+func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listCapabilities", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListCapabilitiesResponse
+	if err := s.cs.unmarshal("listCapabilities", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateConfigurationResponse struct {
-	Category    string `json:"category,omitempty"`
-	Description string `json:"description,omitempty"`
-	Id          int64  `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Scope       string `json:"scope,omitempty"`
-	Value       string `json:"value,omitempty"`
-}
-
-type ListConfigurationsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListConfigurationsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
-	}
-	if v, found := p.p["category"]; found {
-		u.Set("category", v.(string))
-	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["storageid"]; found {
-		u.Set("storageid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListConfigurationsParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["accountid"] = v
-	return
-}
-
-func (p *ListConfigurationsParams) SetCategory(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["category"] = v
-	return
-}
-
-func (p *ListConfigurationsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
-}
-
-func (p *ListConfigurationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListConfigurationsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListCapabilities, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ConfigurationService) StreamCapabilities(ctx context.Context, p *ListCapabilitiesParams, fn func(*Capability) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *ListConfigurationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+	return s.cs.streamList(ctx, "listCapabilities", p.URLValues(), "capability", func(b json.RawMessage) error {
+		var v Capability
+		if err := s.cs.unmarshalElement("listCapabilities", "capability", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListConfigurationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListConfigurationsParams) SetStorageid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storageid"] = v
-	return
+type ListCapabilitiesResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	Capabilities []*Capability              `json:"capability" xml:"capability"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListConfigurationsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type Capability struct {
+	Allowusercreateprojects   Bool                       `json:"allowusercreateprojects,omitempty" xml:"allowusercreateprojects,omitempty"`
+	Apilimitinterval          int                        `json:"apilimitinterval,omitempty" xml:"apilimitinterval,omitempty"`
+	Apilimitmax               int                        `json:"apilimitmax,omitempty" xml:"apilimitmax,omitempty"`
+	Cloudstackversion         string                     `json:"cloudstackversion,omitempty" xml:"cloudstackversion,omitempty"`
+	Customdiskofferingmaxsize int64                      `json:"customdiskofferingmaxsize,omitempty" xml:"customdiskofferingmaxsize,omitempty"`
+	Customdiskofferingminsize int64                      `json:"customdiskofferingminsize,omitempty" xml:"customdiskofferingminsize,omitempty"`
+	Kvmsnapshotenabled        Bool                       `json:"kvmsnapshotenabled,omitempty" xml:"kvmsnapshotenabled,omitempty"`
+	Projectinviterequired     Bool                       `json:"projectinviterequired,omitempty" xml:"projectinviterequired,omitempty"`
+	Regionsecondaryenabled    Bool                       `json:"regionsecondaryenabled,omitempty" xml:"regionsecondaryenabled,omitempty"`
+	Securitygroupsenabled     Bool                       `json:"securitygroupsenabled,omitempty" xml:"securitygroupsenabled,omitempty"`
+	SupportELB                string                     `json:"supportELB,omitempty" xml:"supportELB,omitempty"`
+	Userpublictemplateenabled Bool                       `json:"userpublictemplateenabled,omitempty" xml:"userpublictemplateenabled,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListConfigurationsParams = cloudstackcommon.ListConfigurationsParams
+type ListConfigurationsResponse = cloudstackcommon.ListConfigurationsResponse
+type Configuration = cloudstackcommon.Configuration
 
 // You should always use this function to get a new ListConfigurationsParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurationsParams {
 	p := &ListConfigurationsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all configurations.
 func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listConfigurations", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listConfigurations", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListConfigurationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listConfigurations", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListConfigurationsResponse struct {
-	Count          int              `json:"count"`
-	Configurations []*Configuration `json:"configuration"`
-}
-
-type Configuration struct {
-	Category    string `json:"category,omitempty"`
-	Description string `json:"description,omitempty"`
-	Id          int64  `json:"id,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Scope       string `json:"scope,omitempty"`
-	Value       string `json:"value,omitempty"`
-}
+// Lists the objects like ListConfigurations, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ConfigurationService) StreamConfigurations(ctx context.Context, p *ListConfigurationsParams, fn func(*Configuration) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type ListCapabilitiesParams struct {
-	p map[string]interface{}
+	return s.cs.streamList(ctx, "listConfigurations", p.URLValues(), "configuration", func(b json.RawMessage) error {
+		var v Configuration
+		if err := s.cs.unmarshalElement("listConfigurations", "configuration", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListCapabilitiesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	return u
-}
+type UpdateConfigurationParams = cloudstackcommon.UpdateConfigurationParams
+type UpdateConfigurationResponse = cloudstackcommon.UpdateConfigurationResponse
 
-// You should always use this function to get a new ListCapabilitiesParams instance,
+// You should always use this function to get a new UpdateConfigurationParams instance,
 // as then you are sure you have configured all required params
-func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
-	p := &ListCapabilitiesParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - name
+func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *UpdateConfigurationParams {
+	p := &UpdateConfigurationParams{}
+	p.SetName(name)
 	return p
 }
 
-// Lists capabilities
-func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listCapabilities", p.toURLValues())
+// Updates a configuration.
+//
+// See also ListConfigurations.
+func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateConfiguration", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateConfigurationResponse
+	if err := s.cs.unmarshal("updateConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListCapabilitiesResponse struct {
-	Count        int           `json:"count"`
-	Capabilities []*Capability `json:"capability"`
-}
-
-type Capability struct {
-	Allowusercreateprojects   bool   `json:"allowusercreateprojects,omitempty"`
-	Apilimitinterval          int    `json:"apilimitinterval,omitempty"`
-	Apilimitmax               int    `json:"apilimitmax,omitempty"`
-	Cloudstackversion         string `json:"cloudstackversion,omitempty"`
-	Customdiskofferingmaxsize int64  `json:"customdiskofferingmaxsize,omitempty"`
-	Customdiskofferingminsize int64  `json:"customdiskofferingminsize,omitempty"`
-	Kvmsnapshotenabled        bool   `json:"kvmsnapshotenabled,omitempty"`
-	Projectinviterequired     bool   `json:"projectinviterequired,omitempty"`
-	Regionsecondaryenabled    bool   `json:"regionsecondaryenabled,omitempty"`
-	Securitygroupsenabled     bool   `json:"securitygroupsenabled,omitempty"`
-	SupportELB                string `json:"supportELB,omitempty"`
-	Userpublictemplateenabled bool   `json:"userpublictemplateenabled,omitempty"`
-}
-
-type ListDeploymentPlannersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListDeploymentPlannersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListDeploymentPlannersParams = cloudstackcommon.ListDeploymentPlannersParams
+type ListDeploymentPlannersResponse = cloudstackcommon.ListDeploymentPlannersResponse
+type DeploymentPlanner = cloudstackcommon.DeploymentPlanner
 
 // You should always use this function to get a new ListDeploymentPlannersParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeploymentPlannersParams {
 	p := &ListDeploymentPlannersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists all DeploymentPlanners available.
 func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
-	resp, err := s.cs.newRequest("listDeploymentPlanners", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListDeploymentPlannersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListDeploymentPlannersResponse struct {
-	Count              int                  `json:"count"`
-	DeploymentPlanners []*DeploymentPlanner `json:"deploymentplanner"`
-}
-
-type DeploymentPlanner struct {
-	Name string `json:"name,omitempty"`
-}
-
-type ListLdapConfigurationsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["port"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("port", vv)
-	}
-	return u
-}
 
-func (p *ListLdapConfigurationsParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
-	return
-}
-
-func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListLdapConfigurationsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListLdapConfigurationsParams) SetPort(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
-	return
-}
-
-// You should always use this function to get a new ListLdapConfigurationsParams instance,
-// as then you are sure you have configured all required params
-func (s *ConfigurationService) NewListLdapConfigurationsParams() *ListLdapConfigurationsParams {
-	p := &ListLdapConfigurationsParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// Lists all LDAP configurations
-func (s *ConfigurationService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listLdapConfigurations", p.toURLValues())
+	resp, err := s.cs.newRequest("listDeploymentPlanners", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListLdapConfigurationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListDeploymentPlannersResponse
+	if err := s.cs.unmarshal("listDeploymentPlanners", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListLdapConfigurationsResponse struct {
-	Count              int                  `json:"count"`
-	LdapConfigurations []*LdapConfiguration `json:"ldapconfiguration"`
-}
-
-type LdapConfiguration struct {
-	Hostname string `json:"hostname,omitempty"`
-	Port     int    `json:"port,omitempty"`
-}
-
-type AddLdapConfigurationParams struct {
-	p map[string]interface{}
-}
-
-func (p *AddLdapConfigurationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Lists the objects like ListDeploymentPlanners, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ConfigurationService) StreamDeploymentPlanners(ctx context.Context, p *ListDeploymentPlannersParams, fn func(*DeploymentPlanner) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
-	}
-	if v, found := p.p["port"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("port", vv)
-	}
-	return u
-}
 
-func (p *AddLdapConfigurationParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostname"] = v
-	return
+	return s.cs.streamList(ctx, "listDeploymentPlanners", p.URLValues(), "deploymentplanner", func(b json.RawMessage) error {
+		var v DeploymentPlanner
+		if err := s.cs.unmarshalElement("listDeploymentPlanners", "deploymentplanner", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *AddLdapConfigurationParams) SetPort(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["port"] = v
-	return
-}
+type AddLdapConfigurationParams = cloudstackcommon.AddLdapConfigurationParams
+type AddLdapConfigurationResponse = cloudstackcommon.AddLdapConfigurationResponse
 
 // You should always use this function to get a new AddLdapConfigurationParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hostname
+//   - port
 func (s *ConfigurationService) NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams {
 	p := &AddLdapConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
-	p.p["port"] = port
+	p.SetHostname(hostname)
+	p.SetPort(port)
 	return p
 }
 
 // Add a new Ldap Configuration
+//
+// See also ListLdapConfigurations.
 func (s *ConfigurationService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("addLdapConfiguration", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addLdapConfiguration", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddLdapConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addLdapConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddLdapConfigurationResponse struct {
-	Hostname string `json:"hostname,omitempty"`
-	Port     int    `json:"port,omitempty"`
-}
+type DeleteLdapConfigurationParams = cloudstackcommon.DeleteLdapConfigurationParams
+type DeleteLdapConfigurationResponse = cloudstackcommon.DeleteLdapConfigurationResponse
 
-type DeleteLdapConfigurationParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new DeleteLdapConfigurationParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - hostname
+func (s *ConfigurationService) NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams {
+	p := &DeleteLdapConfigurationParams{}
+	p.SetHostname(hostname)
+	return p
 }
 
-func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// Remove an Ldap Configuration
+//
+// See also ListLdapConfigurations.
+func (s *ConfigurationService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["hostname"]; found {
-		u.Set("hostname", v.(string))
+
+	resp, err := s.cs.newRequest("deleteLdapConfiguration", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteLdapConfigurationResponse
+	if err := s.cs.unmarshal("deleteLdapConfiguration", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["hostname"] = v
-	return
+	return &r, nil
 }
 
-// You should always use this function to get a new DeleteLdapConfigurationParams instance,
+type ListLdapConfigurationsParams = cloudstackcommon.ListLdapConfigurationsParams
+type ListLdapConfigurationsResponse = cloudstackcommon.ListLdapConfigurationsResponse
+type LdapConfiguration = cloudstackcommon.LdapConfiguration
+
+// You should always use this function to get a new ListLdapConfigurationsParams instance,
 // as then you are sure you have configured all required params
-func (s *ConfigurationService) NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams {
-	p := &DeleteLdapConfigurationParams{}
-	p.p = make(map[string]interface{})
-	p.p["hostname"] = hostname
+func (s *ConfigurationService) NewListLdapConfigurationsParams() *ListLdapConfigurationsParams {
+	p := &ListLdapConfigurationsParams{}
 	return p
 }
 
-// Remove an Ldap Configuration
-func (s *ConfigurationService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("deleteLdapConfiguration", p.toURLValues())
+// Lists all LDAP configurations
+func (s *ConfigurationService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listLdapConfigurations", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteLdapConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListLdapConfigurationsResponse
+	if err := s.cs.unmarshal("listLdapConfigurations", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteLdapConfigurationResponse struct {
-	Hostname string `json:"hostname,omitempty"`
-	Port     int    `json:"port,omitempty"`
+// Lists the objects like ListLdapConfigurations, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ConfigurationService) StreamLdapConfigurations(ctx context.Context, p *ListLdapConfigurationsParams, fn func(*LdapConfiguration) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listLdapConfigurations", p.URLValues(), "ldapconfiguration", func(b json.RawMessage) error {
+		var v LdapConfiguration
+		if err := s.cs.unmarshalElement("listLdapConfigurations", "ldapconfiguration", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -0,0 +1,690 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddGuestOs(t *testing.T) {
+	params := url.Values{
+		"name":          {"test-name"},
+		"oscategoryid":  {"test-oscategoryid"},
+		"osdisplayname": {"test-osdisplayname"},
+	}
+	cs, done := newTestClient(t, "addGuestOs", params, `{"addguestosresponse":{"description":"test-description","id":"test-id","isuserdefined":"test-isuserdefined","jobid":"test-jobid","oscategoryid":"test-oscategoryid"}}`)
+	defer done()
+
+	p := cs.GuestOS.NewAddGuestOsParams("test-oscategoryid", "test-osdisplayname")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetName("test-name")
+	p.SetOscategoryid("test-oscategoryid")
+	p.SetOsdisplayname("test-osdisplayname")
+	checkURLValues(t, "addGuestOs", p.URLValues(), params)
+
+	r, err := cs.GuestOS.AddGuestOs(p)
+	if err != nil {
+		t.Fatalf("Failed to call addGuestOs: %v", err)
+	}
+	if r.Description != "test-description" {
+		t.Errorf("Expected r.Description to be %v, got %v", "test-description", r.Description)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addGuestOs" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addGuestOs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addGuestOs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addGuestOs: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addGuestOs", params, `<addguestosresponse><description>test-description</description><id>test-id</id><isuserdefined>test-isuserdefined</isuserdefined><jobid>test-jobid</jobid><oscategoryid>test-oscategoryid</oscategoryid></addguestosresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.AddGuestOs(p)
+	if err != nil {
+		t.Fatalf("Failed to call addGuestOs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveGuestOs(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "removeGuestOs", params, `{"removeguestosresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.GuestOS.NewRemoveGuestOsParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "removeGuestOs", p.URLValues(), params)
+
+	r, err := cs.GuestOS.RemoveGuestOs(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeGuestOs: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeGuestOs" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeGuestOs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeGuestOs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeGuestOs: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeGuestOs", params, `<removeguestosresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removeguestosresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.RemoveGuestOs(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeGuestOs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateGuestOs(t *testing.T) {
+	params := url.Values{
+		"id":            {"test-id"},
+		"osdisplayname": {"test-osdisplayname"},
+	}
+	cs, done := newTestClient(t, "updateGuestOs", params, `{"updateguestosresponse":{"description":"test-description","id":"test-id","isuserdefined":"test-isuserdefined","jobid":"test-jobid","oscategoryid":"test-oscategoryid"}}`)
+	defer done()
+
+	p := cs.GuestOS.NewUpdateGuestOsParams("test-id", "test-osdisplayname")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetOsdisplayname("test-osdisplayname")
+	checkURLValues(t, "updateGuestOs", p.URLValues(), params)
+
+	r, err := cs.GuestOS.UpdateGuestOs(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateGuestOs: %v", err)
+	}
+	if r.Description != "test-description" {
+		t.Errorf("Expected r.Description to be %v, got %v", "test-description", r.Description)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateGuestOs" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateGuestOs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateGuestOs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateGuestOs: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateGuestOs", params, `<updateguestosresponse><description>test-description</description><id>test-id</id><isuserdefined>test-isuserdefined</isuserdefined><jobid>test-jobid</jobid><oscategoryid>test-oscategoryid</oscategoryid></updateguestosresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.UpdateGuestOs(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateGuestOs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddGuestOsMapping(t *testing.T) {
+	params := url.Values{
+		"hypervisor":          {"BareMetal"},
+		"hypervisorversion":   {"test-hypervisorversion"},
+		"osdisplayname":       {"test-osdisplayname"},
+		"osnameforhypervisor": {"test-osnameforhypervisor"},
+		"ostypeid":            {"test-ostypeid"},
+	}
+	cs, done := newTestClient(t, "addGuestOsMapping", params, `{"addguestosmappingresponse":{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","isuserdefined":"test-isuserdefined","jobid":"test-jobid","osdisplayname":"test-osdisplayname","osnameforhypervisor":"test-osnameforhypervisor","ostypeid":"test-ostypeid"}}`)
+	defer done()
+
+	p := cs.GuestOS.NewAddGuestOsMappingParams(HypervisorBareMetal, "test-hypervisorversion", "test-osnameforhypervisor")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetHypervisorversion("test-hypervisorversion")
+	p.SetOsdisplayname("test-osdisplayname")
+	p.SetOsnameforhypervisor("test-osnameforhypervisor")
+	p.SetOstypeid("test-ostypeid")
+	checkURLValues(t, "addGuestOsMapping", p.URLValues(), params)
+
+	r, err := cs.GuestOS.AddGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call addGuestOsMapping: %v", err)
+	}
+	if r.Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.Hypervisorversion)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addGuestOsMapping" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addGuestOsMapping: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addGuestOsMapping: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addGuestOsMapping: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addGuestOsMapping", params, `<addguestosmappingresponse><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><isuserdefined>test-isuserdefined</isuserdefined><jobid>test-jobid</jobid><osdisplayname>test-osdisplayname</osdisplayname><osnameforhypervisor>test-osnameforhypervisor</osnameforhypervisor><ostypeid>test-ostypeid</ostypeid></addguestosmappingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.AddGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call addGuestOsMapping using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListGuestOsMapping(t *testing.T) {
+	params := url.Values{
+		"hypervisor":        {"BareMetal"},
+		"hypervisorversion": {"test-hypervisorversion"},
+		"id":                {"test-id"},
+		"keyword":           {"test-keyword"},
+		"ostypeid":          {"test-ostypeid"},
+		"page":              {"6"},
+		"pagesize":          {"7"},
+	}
+	cs, done := newTestClient(t, "listGuestOsMapping", params, `{"listguestosmappingresponse":{"count":1,"guestosmapping":[{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","isuserdefined":"test-isuserdefined","osdisplayname":"test-osdisplayname","osnameforhypervisor":"test-osnameforhypervisor","ostypeid":"test-ostypeid"}]}}`)
+	defer done()
+
+	p := cs.GuestOS.NewListGuestOsMappingParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetHypervisorversion("test-hypervisorversion")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetOstypeid("test-ostypeid")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	checkURLValues(t, "listGuestOsMapping", p.URLValues(), params)
+
+	r, err := cs.GuestOS.ListGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call listGuestOsMapping: %v", err)
+	}
+	if r.GuestOsMapping[0].Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.GuestOsMapping[0].Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.GuestOsMapping[0].Hypervisorversion)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listGuestOsMapping" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listGuestOsMapping: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listGuestOsMapping: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listGuestOsMapping: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*GuestOsMapping
+	count, err := cs.GuestOS.StreamGuestOsMapping(context.Background(), p, func(v *GuestOsMapping) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listGuestOsMapping: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.GuestOsMapping) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.GuestOsMapping)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listGuestOsMapping", params, `<listguestosmappingresponse><count>1</count><guestosmapping><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><isuserdefined>test-isuserdefined</isuserdefined><osdisplayname>test-osdisplayname</osdisplayname><osnameforhypervisor>test-osnameforhypervisor</osnameforhypervisor><ostypeid>test-ostypeid</ostypeid></guestosmapping></listguestosmappingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.ListGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call listGuestOsMapping using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.GuestOS.StreamGuestOsMapping(context.Background(), p, func(v *GuestOsMapping) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listGuestOsMapping using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.GuestOsMapping) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.GuestOsMapping)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveGuestOsMapping(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "removeGuestOsMapping", params, `{"removeguestosmappingresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.GuestOS.NewRemoveGuestOsMappingParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "removeGuestOsMapping", p.URLValues(), params)
+
+	r, err := cs.GuestOS.RemoveGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeGuestOsMapping: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeGuestOsMapping" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeGuestOsMapping: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeGuestOsMapping: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeGuestOsMapping: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeGuestOsMapping", params, `<removeguestosmappingresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removeguestosmappingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.RemoveGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeGuestOsMapping using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateGuestOsMapping(t *testing.T) {
+	params := url.Values{
+		"id":                  {"test-id"},
+		"osnameforhypervisor": {"test-osnameforhypervisor"},
+	}
+	cs, done := newTestClient(t, "updateGuestOsMapping", params, `{"updateguestosmappingresponse":{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","isuserdefined":"test-isuserdefined","jobid":"test-jobid","osdisplayname":"test-osdisplayname","osnameforhypervisor":"test-osnameforhypervisor","ostypeid":"test-ostypeid"}}`)
+	defer done()
+
+	p := cs.GuestOS.NewUpdateGuestOsMappingParams("test-id", "test-osnameforhypervisor")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetOsnameforhypervisor("test-osnameforhypervisor")
+	checkURLValues(t, "updateGuestOsMapping", p.URLValues(), params)
+
+	r, err := cs.GuestOS.UpdateGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateGuestOsMapping: %v", err)
+	}
+	if r.Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.Hypervisorversion)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateGuestOsMapping" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateGuestOsMapping: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateGuestOsMapping: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateGuestOsMapping: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateGuestOsMapping", params, `<updateguestosmappingresponse><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><isuserdefined>test-isuserdefined</isuserdefined><jobid>test-jobid</jobid><osdisplayname>test-osdisplayname</osdisplayname><osnameforhypervisor>test-osnameforhypervisor</osnameforhypervisor><ostypeid>test-ostypeid</ostypeid></updateguestosmappingresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.UpdateGuestOsMapping(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateGuestOsMapping using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListOsCategories(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+	}
+	cs, done := newTestClient(t, "listOsCategories", params, `{"listoscategoriesresponse":{"count":1,"oscategory":[{"id":"test-id","name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.GuestOS.NewListOsCategoriesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	checkURLValues(t, "listOsCategories", p.URLValues(), params)
+
+	r, err := cs.GuestOS.ListOsCategories(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsCategories: %v", err)
+	}
+	if r.OsCategories[0].Id != "test-id" {
+		t.Errorf("Expected r.OsCategories[0].Id to be %v, got %v", "test-id", r.OsCategories[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listOsCategories" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listOsCategories: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listOsCategories: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listOsCategories: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*OsCategory
+	count, err := cs.GuestOS.StreamOsCategories(context.Background(), p, func(v *OsCategory) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listOsCategories: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.OsCategories) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.OsCategories)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listOsCategories", params, `<listoscategoriesresponse><count>1</count><oscategory><id>test-id</id><name>test-name</name></oscategory></listoscategoriesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.ListOsCategories(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsCategories using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.GuestOS.StreamOsCategories(context.Background(), p, func(v *OsCategory) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listOsCategories using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.OsCategories) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.OsCategories)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListOsTypes(t *testing.T) {
+	params := url.Values{
+		"description":  {"test-description"},
+		"id":           {"test-id"},
+		"keyword":      {"test-keyword"},
+		"oscategoryid": {"test-oscategoryid"},
+		"page":         {"5"},
+		"pagesize":     {"6"},
+	}
+	cs, done := newTestClient(t, "listOsTypes", params, `{"listostypesresponse":{"count":1,"ostype":[{"description":"test-description","id":"test-id","isuserdefined":"test-isuserdefined","oscategoryid":"test-oscategoryid"}]}}`)
+	defer done()
+
+	p := cs.GuestOS.NewListOsTypesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetOscategoryid("test-oscategoryid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listOsTypes", p.URLValues(), params)
+
+	r, err := cs.GuestOS.ListOsTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsTypes: %v", err)
+	}
+	if r.OsTypes[0].Description != "test-description" {
+		t.Errorf("Expected r.OsTypes[0].Description to be %v, got %v", "test-description", r.OsTypes[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listOsTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listOsTypes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listOsTypes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listOsTypes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*OsType
+	count, err := cs.GuestOS.StreamOsTypes(context.Background(), p, func(v *OsType) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listOsTypes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.OsTypes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.OsTypes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listOsTypes", params, `<listostypesresponse><count>1</count><ostype><description>test-description</description><id>test-id</id><isuserdefined>test-isuserdefined</isuserdefined><oscategoryid>test-oscategoryid</oscategoryid></ostype></listostypesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.GuestOS.ListOsTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOsTypes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.GuestOS.StreamOsTypes(context.Background(), p, func(v *OsType) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listOsTypes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.OsTypes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.OsTypes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
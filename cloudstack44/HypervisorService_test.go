@@ -0,0 +1,264 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListHypervisors(t *testing.T) {
+	params := url.Values{
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listHypervisors", params, `{"listhypervisorsresponse":{"count":1,"hypervisor":[{"name":"test-name"}]}}`)
+	defer done()
+
+	p := cs.Hypervisor.NewListHypervisorsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listHypervisors", p.URLValues(), params)
+
+	r, err := cs.Hypervisor.ListHypervisors(p)
+	if err != nil {
+		t.Fatalf("Failed to call listHypervisors: %v", err)
+	}
+	if r.Hypervisors[0].Name != "test-name" {
+		t.Errorf("Expected r.Hypervisors[0].Name to be %v, got %v", "test-name", r.Hypervisors[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listHypervisors" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listHypervisors: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listHypervisors: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listHypervisors: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Hypervisor
+	count, err := cs.Hypervisor.StreamHypervisors(context.Background(), p, func(v *Hypervisor) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listHypervisors: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Hypervisors) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Hypervisors)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listHypervisors", params, `<listhypervisorsresponse><count>1</count><hypervisor><name>test-name</name></hypervisor></listhypervisorsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Hypervisor.ListHypervisors(p)
+	if err != nil {
+		t.Fatalf("Failed to call listHypervisors using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Hypervisor.StreamHypervisors(context.Background(), p, func(v *Hypervisor) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listHypervisors using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Hypervisors) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Hypervisors)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListHypervisorCapabilities(t *testing.T) {
+	params := url.Values{
+		"hypervisor": {"BareMetal"},
+		"id":         {"test-id"},
+		"keyword":    {"test-keyword"},
+		"page":       {"4"},
+		"pagesize":   {"5"},
+	}
+	cs, done := newTestClient(t, "listHypervisorCapabilities", params, `{"listhypervisorcapabilitiesresponse":{"count":1,"hypervisorcapability":[{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","maxdatavolumeslimit":1,"maxguestslimit":1,"maxhostspercluster":1,"securitygroupenabled":true,"storagemotionenabled":true}]}}`)
+	defer done()
+
+	p := cs.Hypervisor.NewListHypervisorCapabilitiesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	checkURLValues(t, "listHypervisorCapabilities", p.URLValues(), params)
+
+	r, err := cs.Hypervisor.ListHypervisorCapabilities(p)
+	if err != nil {
+		t.Fatalf("Failed to call listHypervisorCapabilities: %v", err)
+	}
+	if r.HypervisorCapabilities[0].Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.HypervisorCapabilities[0].Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.HypervisorCapabilities[0].Hypervisorversion)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listHypervisorCapabilities" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listHypervisorCapabilities: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listHypervisorCapabilities: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listHypervisorCapabilities: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*HypervisorCapability
+	count, err := cs.Hypervisor.StreamHypervisorCapabilities(context.Background(), p, func(v *HypervisorCapability) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listHypervisorCapabilities: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.HypervisorCapabilities) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.HypervisorCapabilities)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listHypervisorCapabilities", params, `<listhypervisorcapabilitiesresponse><count>1</count><hypervisorcapability><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><maxdatavolumeslimit>1</maxdatavolumeslimit><maxguestslimit>1</maxguestslimit><maxhostspercluster>1</maxhostspercluster><securitygroupenabled>true</securitygroupenabled><storagemotionenabled>true</storagemotionenabled></hypervisorcapability></listhypervisorcapabilitiesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Hypervisor.ListHypervisorCapabilities(p)
+	if err != nil {
+		t.Fatalf("Failed to call listHypervisorCapabilities using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Hypervisor.StreamHypervisorCapabilities(context.Background(), p, func(v *HypervisorCapability) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listHypervisorCapabilities using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.HypervisorCapabilities) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.HypervisorCapabilities)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateHypervisorCapabilities(t *testing.T) {
+	params := url.Values{
+		"id":                   {"test-id"},
+		"maxguestslimit":       {"2"},
+		"securitygroupenabled": {"true"},
+	}
+	cs, done := newTestClient(t, "updateHypervisorCapabilities", params, `{"updatehypervisorcapabilitiesresponse":{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","maxdatavolumeslimit":1,"maxguestslimit":1,"maxhostspercluster":1,"securitygroupenabled":true,"storagemotionenabled":true}}`)
+	defer done()
+
+	p := cs.Hypervisor.NewUpdateHypervisorCapabilitiesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetMaxguestslimit(2)
+	p.SetSecuritygroupenabled(true)
+	checkURLValues(t, "updateHypervisorCapabilities", p.URLValues(), params)
+
+	r, err := cs.Hypervisor.UpdateHypervisorCapabilities(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateHypervisorCapabilities: %v", err)
+	}
+	if r.Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.Hypervisorversion)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateHypervisorCapabilities" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateHypervisorCapabilities: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateHypervisorCapabilities: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateHypervisorCapabilities: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateHypervisorCapabilities", params, `<updatehypervisorcapabilitiesresponse><hypervisor>test-hypervisor</hypervisor><hypervisorversion>test-hypervisorversion</hypervisorversion><id>test-id</id><maxdatavolumeslimit>1</maxdatavolumeslimit><maxguestslimit>1</maxguestslimit><maxhostspercluster>1</maxhostspercluster><securitygroupenabled>true</securitygroupenabled><storagemotionenabled>true</storagemotionenabled></updatehypervisorcapabilitiesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Hypervisor.UpdateHypervisorCapabilities(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateHypervisorCapabilities using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
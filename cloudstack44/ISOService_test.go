@@ -0,0 +1,782 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAttachIso(t *testing.T) {
+	params := url.Values{
+		"id":               {"test-id"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "attachIso", params, `{"attachisoresponse":{"account":"test-account","affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"diskioread":1,"diskiowrite":1,"diskkbsread":1,"diskkbswrite":1,"diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayname":"test-displayname","displayvm":true,"domain":"test-domain","domainid":"test-domainid","forvirtualnetwork":true,"group":"test-group","groupid":"test-groupid","guestosid":"test-guestosid","haenable":true,"hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","instancename":"test-instancename","isdynamicallyscalable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","keypair":"test-keypair","memory":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"ostypeid":1,"password":"test-password","passwordenabled":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","rootdeviceid":1,"rootdevicetype":"test-rootdevicetype","securitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","servicestate":"test-servicestate","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","vgpu":"test-vgpu","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ISO.NewAttachIsoParams("test-id", "test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "attachIso", p.URLValues(), params)
+
+	r, err := cs.ISO.AttachIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call attachIso: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "attachIso" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute attachIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of attachIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of attachIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "attachIso", params, `<attachisoresponse><account>test-account</account><affinitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></affinitygroup><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><diskioread>1</diskioread><diskiowrite>1</diskiowrite><diskkbsread>1</diskkbsread><diskkbswrite>1</diskkbswrite><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayname>test-displayname</displayname><displayvm>true</displayvm><domain>test-domain</domain><domainid>test-domainid</domainid><forvirtualnetwork>true</forvirtualnetwork><group>test-group</group><groupid>test-groupid</groupid><guestosid>test-guestosid</guestosid><haenable>true</haenable><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><instancename>test-instancename</instancename><isdynamicallyscalable>true</isdynamicallyscalable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><keypair>test-keypair</keypair><memory>1</memory><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><ostypeid>1</ostypeid><password>test-password</password><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><rootdeviceid>1</rootdeviceid><rootdevicetype>test-rootdevicetype</rootdevicetype><securitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></securitygroup><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><servicestate>test-servicestate</servicestate><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><vgpu>test-vgpu</vgpu><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></attachisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.AttachIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call attachIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCopyIso(t *testing.T) {
+	params := url.Values{
+		"destzoneid":   {"test-destzoneid"},
+		"id":           {"test-id"},
+		"sourcezoneid": {"test-sourcezoneid"},
+	}
+	cs, done := newTestClient(t, "copyIso", params, `{"copyisoresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"jobid":"test-jobid","name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ISO.NewCopyIsoParams("test-destzoneid", "test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDestzoneid("test-destzoneid")
+	p.SetId("test-id")
+	p.SetSourcezoneid("test-sourcezoneid")
+	checkURLValues(t, "copyIso", p.URLValues(), params)
+
+	r, err := cs.ISO.CopyIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call copyIso: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "copyIso" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute copyIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of copyIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of copyIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "copyIso", params, `<copyisoresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><jobid>test-jobid</jobid><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></copyisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.CopyIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call copyIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteIso(t *testing.T) {
+	params := url.Values{
+		"id":     {"test-id"},
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "deleteIso", params, `{"deleteisoresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.ISO.NewDeleteIsoParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "deleteIso", p.URLValues(), params)
+
+	r, err := cs.ISO.DeleteIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteIso: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteIso" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteIso", params, `<deleteisoresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.DeleteIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDetachIso(t *testing.T) {
+	params := url.Values{
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "detachIso", params, `{"detachisoresponse":{"account":"test-account","affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","created":"2014-01-02T15:04:05+0100","details":{"key":"value"},"diskioread":1,"diskiowrite":1,"diskkbsread":1,"diskkbswrite":1,"diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayname":"test-displayname","displayvm":true,"domain":"test-domain","domainid":"test-domainid","forvirtualnetwork":true,"group":"test-group","groupid":"test-groupid","guestosid":"test-guestosid","haenable":true,"hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","instancename":"test-instancename","isdynamicallyscalable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","keypair":"test-keypair","memory":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"ostypeid":1,"password":"test-password","passwordenabled":true,"project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicipid":"test-publicipid","rootdeviceid":1,"rootdevicetype":"test-rootdevicetype","securitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","egressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"id":"test-id","ingressrule":[{"account":"test-account","cidr":"test-cidr","endport":1,"icmpcode":1,"icmptype":1,"protocol":"test-protocol","ruleid":"test-ruleid","securitygroupname":"test-securitygroupname","startport":1,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"name":"test-name","project":"test-project","projectid":"test-projectid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}],"serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","servicestate":"test-servicestate","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","vgpu":"test-vgpu","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ISO.NewDetachIsoParams("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "detachIso", p.URLValues(), params)
+
+	r, err := cs.ISO.DetachIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call detachIso: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "detachIso" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute detachIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of detachIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of detachIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "detachIso", params, `<detachisoresponse><account>test-account</account><affinitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><id>test-id</id><name>test-name</name><type>test-type</type><virtualmachineIds>test-virtualmachineIds</virtualmachineIds></affinitygroup><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><cpuused>12.5%</cpuused><created>2014-01-02T15:04:05+0100</created><details><key>value</key></details><diskioread>1</diskioread><diskiowrite>1</diskiowrite><diskkbsread>1</diskkbsread><diskkbswrite>1</diskkbswrite><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayname>test-displayname</displayname><displayvm>true</displayvm><domain>test-domain</domain><domainid>test-domainid</domainid><forvirtualnetwork>true</forvirtualnetwork><group>test-group</group><groupid>test-groupid</groupid><guestosid>test-guestosid</guestosid><haenable>true</haenable><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><instancename>test-instancename</instancename><isdynamicallyscalable>true</isdynamicallyscalable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><keypair>test-keypair</keypair><memory>1</memory><name>test-name</name><networkkbsread>1</networkkbsread><networkkbswrite>1</networkkbswrite><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><ostypeid>1</ostypeid><password>test-password</password><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><rootdeviceid>1</rootdeviceid><rootdevicetype>test-rootdevicetype</rootdevicetype><securitygroup><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><egressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></egressrule><id>test-id</id><ingressrule><account>test-account</account><cidr>test-cidr</cidr><endport>1</endport><icmpcode>1</icmpcode><icmptype>1</icmptype><protocol>test-protocol</protocol><ruleid>test-ruleid</ruleid><securitygroupname>test-securitygroupname</securitygroupname><startport>1</startport><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></ingressrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></securitygroup><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><servicestate>test-servicestate</servicestate><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><vgpu>test-vgpu</vgpu><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></detachisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.DetachIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call detachIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestExtractIso(t *testing.T) {
+	params := url.Values{
+		"id":     {"test-id"},
+		"mode":   {"test-mode"},
+		"url":    {"test-url"},
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "extractIso", params, `{"extractisoresponse":{"accountid":"test-accountid","created":"2014-01-02T15:04:05+0100","extractId":"test-extractId","extractMode":"test-extractMode","id":"test-id","jobid":"test-jobid","name":"test-name","resultstring":"test-resultstring","state":"test-state","status":"test-status","storagetype":"test-storagetype","uploadpercentage":1,"url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ISO.NewExtractIsoParams("test-id", "test-mode")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetMode("test-mode")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "extractIso", p.URLValues(), params)
+
+	r, err := cs.ISO.ExtractIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call extractIso: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "extractIso" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute extractIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of extractIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of extractIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "extractIso", params, `<extractisoresponse><accountid>test-accountid</accountid><created>2014-01-02T15:04:05+0100</created><extractId>test-extractId</extractId><extractMode>test-extractMode</extractMode><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><resultstring>test-resultstring</resultstring><state>test-state</state><status>test-status</status><storagetype>test-storagetype</storagetype><uploadpercentage>1</uploadpercentage><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></extractisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.ExtractIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call extractIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListIsos(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"bootable":      {"true"},
+		"domainid":      {"test-domainid"},
+		"hypervisor":    {"BareMetal"},
+		"id":            {"test-id"},
+		"isofilter":     {"test-isofilter"},
+		"ispublic":      {"true"},
+		"isready":       {"true"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"name":          {"test-name"},
+		"page":          {"13"},
+		"pagesize":      {"14"},
+		"projectid":     {"test-projectid"},
+		"showremoved":   {"true"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+		"zoneid":        {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listIsos", params, `{"listisosresponse":{"count":1,"iso":[{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.ISO.NewListIsosParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetBootable(true)
+	p.SetDomainid("test-domainid")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetIsofilter("test-isofilter")
+	p.SetIspublic(true)
+	p.SetIsready(true)
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(13)
+	p.SetPagesize(14)
+	p.SetProjectid("test-projectid")
+	p.SetShowremoved(true)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listIsos", p.URLValues(), params)
+
+	r, err := cs.ISO.ListIsos(p)
+	if err != nil {
+		t.Fatalf("Failed to call listIsos: %v", err)
+	}
+	if r.Isos[0].Account != "test-account" {
+		t.Errorf("Expected r.Isos[0].Account to be %v, got %v", "test-account", r.Isos[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listIsos" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listIsos: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listIsos: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listIsos: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Iso
+	count, err := cs.ISO.StreamIsos(context.Background(), p, func(v *Iso) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listIsos: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Isos) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Isos)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listIsos", params, `<listisosresponse><count>1</count><iso><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></iso></listisosresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.ListIsos(p)
+	if err != nil {
+		t.Fatalf("Failed to call listIsos using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ISO.StreamIsos(context.Background(), p, func(v *Iso) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listIsos using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Isos) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Isos)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRegisterIso(t *testing.T) {
+	params := url.Values{
+		"account":               {"test-account"},
+		"bootable":              {"true"},
+		"checksum":              {"test-checksum"},
+		"displaytext":           {"test-displaytext"},
+		"domainid":              {"test-domainid"},
+		"imagestoreuuid":        {"test-imagestoreuuid"},
+		"isdynamicallyscalable": {"true"},
+		"isextractable":         {"true"},
+		"isfeatured":            {"true"},
+		"ispublic":              {"true"},
+		"name":                  {"test-name"},
+		"ostypeid":              {"test-ostypeid"},
+		"projectid":             {"test-projectid"},
+		"url":                   {"test-url"},
+		"zoneid":                {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "registerIso", params, `{"registerisoresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ISO.NewRegisterIsoParams("test-displaytext", "test-name", "test-url", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetBootable(true)
+	p.SetChecksum("test-checksum")
+	p.SetDisplaytext("test-displaytext")
+	p.SetDomainid("test-domainid")
+	p.SetImagestoreuuid("test-imagestoreuuid")
+	p.SetIsdynamicallyscalable(true)
+	p.SetIsextractable(true)
+	p.SetIsfeatured(true)
+	p.SetIspublic(true)
+	p.SetName("test-name")
+	p.SetOstypeid("test-ostypeid")
+	p.SetProjectid("test-projectid")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "registerIso", p.URLValues(), params)
+
+	r, err := cs.ISO.RegisterIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerIso: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "registerIso" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute registerIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of registerIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of registerIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "registerIso", params, `<registerisoresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></registerisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.RegisterIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call registerIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateIso(t *testing.T) {
+	params := url.Values{
+		"bootable":                   {"true"},
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"displaytext":                {"test-displaytext"},
+		"format":                     {"test-format"},
+		"id":                         {"test-id"},
+		"isdynamicallyscalable":      {"true"},
+		"isrouting":                  {"true"},
+		"name":                       {"test-name"},
+		"ostypeid":                   {"test-ostypeid"},
+		"passwordenabled":            {"true"},
+		"sortkey":                    {"11"},
+	}
+	cs, done := newTestClient(t, "updateIso", params, `{"updateisoresponse":{"account":"test-account","accountid":"test-accountid","bootable":true,"checksum":"test-checksum","created":"2014-01-02T15:04:05+0100","crossZones":true,"details":{"key":"value"},"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","format":"test-format","hostid":"test-hostid","hostname":"test-hostname","hypervisor":"test-hypervisor","id":"test-id","isdynamicallyscalable":true,"isextractable":true,"isfeatured":true,"ispublic":true,"isready":true,"name":"test-name","ostypeid":"test-ostypeid","ostypename":"test-ostypename","passwordenabled":true,"project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100","size":1,"sourcetemplateid":"test-sourcetemplateid","sshkeyenabled":true,"status":"test-status","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatetag":"test-templatetag","templatetype":"test-templatetype","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ISO.NewUpdateIsoParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetBootable(true)
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetDisplaytext("test-displaytext")
+	p.SetFormat("test-format")
+	p.SetId("test-id")
+	p.SetIsdynamicallyscalable(true)
+	p.SetIsrouting(true)
+	p.SetName("test-name")
+	p.SetOstypeid("test-ostypeid")
+	p.SetPasswordenabled(true)
+	p.SetSortkey(11)
+	checkURLValues(t, "updateIso", p.URLValues(), params)
+
+	r, err := cs.ISO.UpdateIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateIso: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateIso" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateIso: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateIso: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateIso: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateIso", params, `<updateisoresponse><account>test-account</account><accountid>test-accountid</accountid><bootable>true</bootable><checksum>test-checksum</checksum><created>2014-01-02T15:04:05+0100</created><crossZones>true</crossZones><details><key>value</key></details><displaytext>test-displaytext</displaytext><domain>test-domain</domain><domainid>test-domainid</domainid><format>test-format</format><hostid>test-hostid</hostid><hostname>test-hostname</hostname><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isdynamicallyscalable>true</isdynamicallyscalable><isextractable>true</isextractable><isfeatured>true</isfeatured><ispublic>true</ispublic><isready>true</isready><name>test-name</name><ostypeid>test-ostypeid</ostypeid><ostypename>test-ostypename</ostypename><passwordenabled>true</passwordenabled><project>test-project</project><projectid>test-projectid</projectid><removed>2014-01-02T15:04:05+0100</removed><size>1</size><sourcetemplateid>test-sourcetemplateid</sourcetemplateid><sshkeyenabled>true</sshkeyenabled><status>test-status</status><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatetag>test-templatetag</templatetag><templatetype>test-templatetype</templatetype><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updateisoresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.UpdateIso(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateIso using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListIsoPermissions(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "listIsoPermissions", params, `{"listisopermissionsresponse":{"count":1,"isopermission":[{"account":["test-account"],"domainid":"test-domainid","id":"test-id","ispublic":true,"projectids":["test-projectids"]}]}}`)
+	defer done()
+
+	p := cs.ISO.NewListIsoPermissionsParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "listIsoPermissions", p.URLValues(), params)
+
+	r, err := cs.ISO.ListIsoPermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listIsoPermissions: %v", err)
+	}
+	if r.IsoPermissions[0].Domainid != "test-domainid" {
+		t.Errorf("Expected r.IsoPermissions[0].Domainid to be %v, got %v", "test-domainid", r.IsoPermissions[0].Domainid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listIsoPermissions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listIsoPermissions: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listIsoPermissions: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listIsoPermissions: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*IsoPermission
+	count, err := cs.ISO.StreamIsoPermissions(context.Background(), p, func(v *IsoPermission) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listIsoPermissions: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.IsoPermissions) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.IsoPermissions)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listIsoPermissions", params, `<listisopermissionsresponse><count>1</count><isopermission><account>test-account</account><domainid>test-domainid</domainid><id>test-id</id><ispublic>true</ispublic><projectids>test-projectids</projectids></isopermission></listisopermissionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.ListIsoPermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call listIsoPermissions using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ISO.StreamIsoPermissions(context.Background(), p, func(v *IsoPermission) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listIsoPermissions using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.IsoPermissions) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.IsoPermissions)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateIsoPermissions(t *testing.T) {
+	params := url.Values{
+		"accounts":      {"test-accounts1, test-accounts2"},
+		"id":            {"test-id"},
+		"isextractable": {"true"},
+		"isfeatured":    {"true"},
+		"ispublic":      {"true"},
+		"op":            {"test-op"},
+		"projectids":    {"test-projectids1, test-projectids2"},
+	}
+	cs, done := newTestClient(t, "updateIsoPermissions", params, `{"updateisopermissionsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ISO.NewUpdateIsoPermissionsParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccounts([]string{"test-accounts1", "test-accounts2"})
+	p.SetId("test-id")
+	p.SetIsextractable(true)
+	p.SetIsfeatured(true)
+	p.SetIspublic(true)
+	p.SetOp("test-op")
+	p.SetProjectids([]string{"test-projectids1", "test-projectids2"})
+	checkURLValues(t, "updateIsoPermissions", p.URLValues(), params)
+
+	r, err := cs.ISO.UpdateIsoPermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateIsoPermissions: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateIsoPermissions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateIsoPermissions: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateIsoPermissions: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateIsoPermissions: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateIsoPermissions", params, `<updateisopermissionsresponse><displaytext>test-displaytext</displaytext><success>true</success></updateisopermissionsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ISO.UpdateIsoPermissions(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateIsoPermissions using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
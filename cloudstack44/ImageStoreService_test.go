@@ -0,0 +1,537 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUpdateCloudToUseObjectStore(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"name":             {"test-name"},
+		"provider":         {"test-provider"},
+		"url":              {"test-url"},
+	}
+	cs, done := newTestClient(t, "updateCloudToUseObjectStore", params, `{"updatecloudtouseobjectstoreresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ImageStore.NewUpdateCloudToUseObjectStoreParams("test-provider")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetName("test-name")
+	p.SetProvider("test-provider")
+	p.SetUrl("test-url")
+	checkURLValues(t, "updateCloudToUseObjectStore", p.URLValues(), params)
+
+	r, err := cs.ImageStore.UpdateCloudToUseObjectStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateCloudToUseObjectStore: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateCloudToUseObjectStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateCloudToUseObjectStore: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateCloudToUseObjectStore: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateCloudToUseObjectStore: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateCloudToUseObjectStore", params, `<updatecloudtouseobjectstoreresponse><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatecloudtouseobjectstoreresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.UpdateCloudToUseObjectStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateCloudToUseObjectStore using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddImageStore(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"name":             {"test-name"},
+		"provider":         {"test-provider"},
+		"url":              {"test-url"},
+		"zoneid":           {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addImageStore", params, `{"addimagestoreresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ImageStore.NewAddImageStoreParams("test-provider")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetName("test-name")
+	p.SetProvider("test-provider")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addImageStore", p.URLValues(), params)
+
+	r, err := cs.ImageStore.AddImageStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call addImageStore: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addImageStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addImageStore: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addImageStore: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addImageStore: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addImageStore", params, `<addimagestoreresponse><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></addimagestoreresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.AddImageStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call addImageStore using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteImageStore(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteImageStore", params, `{"deleteimagestoreresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ImageStore.NewDeleteImageStoreParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteImageStore", p.URLValues(), params)
+
+	r, err := cs.ImageStore.DeleteImageStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteImageStore: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteImageStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteImageStore: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteImageStore: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteImageStore: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteImageStore", params, `<deleteimagestoreresponse><displaytext>test-displaytext</displaytext><success>true</success></deleteimagestoreresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.DeleteImageStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteImageStore using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListImageStores(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"protocol": {"test-protocol"},
+		"provider": {"test-provider"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listImageStores", params, `{"listimagestoresresponse":{"count":1,"imagestore":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.ImageStore.NewListImageStoresParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetProtocol("test-protocol")
+	p.SetProvider("test-provider")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listImageStores", p.URLValues(), params)
+
+	r, err := cs.ImageStore.ListImageStores(p)
+	if err != nil {
+		t.Fatalf("Failed to call listImageStores: %v", err)
+	}
+	if r.ImageStores[0].Id != "test-id" {
+		t.Errorf("Expected r.ImageStores[0].Id to be %v, got %v", "test-id", r.ImageStores[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listImageStores" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listImageStores: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listImageStores: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listImageStores: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ImageStore
+	count, err := cs.ImageStore.StreamImageStores(context.Background(), p, func(v *ImageStore) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listImageStores: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ImageStores) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ImageStores)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listImageStores", params, `<listimagestoresresponse><count>1</count><imagestore><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></imagestore></listimagestoresresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.ListImageStores(p)
+	if err != nil {
+		t.Fatalf("Failed to call listImageStores using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ImageStore.StreamImageStores(context.Background(), p, func(v *ImageStore) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listImageStores using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ImageStores) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ImageStores)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateSecondaryStagingStore(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"provider":         {"test-provider"},
+		"scope":            {"test-scope"},
+		"url":              {"test-url"},
+		"zoneid":           {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createSecondaryStagingStore", params, `{"createsecondarystagingstoreresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.ImageStore.NewCreateSecondaryStagingStoreParams("test-url")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetProvider("test-provider")
+	p.SetScope("test-scope")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createSecondaryStagingStore", p.URLValues(), params)
+
+	r, err := cs.ImageStore.CreateSecondaryStagingStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSecondaryStagingStore: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createSecondaryStagingStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createSecondaryStagingStore: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createSecondaryStagingStore: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createSecondaryStagingStore: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createSecondaryStagingStore", params, `<createsecondarystagingstoreresponse><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createsecondarystagingstoreresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.CreateSecondaryStagingStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call createSecondaryStagingStore using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSecondaryStagingStore(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteSecondaryStagingStore", params, `{"deletesecondarystagingstoreresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.ImageStore.NewDeleteSecondaryStagingStoreParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteSecondaryStagingStore", p.URLValues(), params)
+
+	r, err := cs.ImageStore.DeleteSecondaryStagingStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSecondaryStagingStore: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSecondaryStagingStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSecondaryStagingStore: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSecondaryStagingStore: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSecondaryStagingStore: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSecondaryStagingStore", params, `<deletesecondarystagingstoreresponse><displaytext>test-displaytext</displaytext><success>true</success></deletesecondarystagingstoreresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.DeleteSecondaryStagingStore(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSecondaryStagingStore using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSecondaryStagingStores(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"protocol": {"test-protocol"},
+		"provider": {"test-provider"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listSecondaryStagingStores", params, `{"listsecondarystagingstoresresponse":{"count":1,"secondarystagingstore":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.ImageStore.NewListSecondaryStagingStoresParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetProtocol("test-protocol")
+	p.SetProvider("test-provider")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listSecondaryStagingStores", p.URLValues(), params)
+
+	r, err := cs.ImageStore.ListSecondaryStagingStores(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSecondaryStagingStores: %v", err)
+	}
+	if r.SecondaryStagingStores[0].Id != "test-id" {
+		t.Errorf("Expected r.SecondaryStagingStores[0].Id to be %v, got %v", "test-id", r.SecondaryStagingStores[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSecondaryStagingStores" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSecondaryStagingStores: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSecondaryStagingStores: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSecondaryStagingStores: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SecondaryStagingStore
+	count, err := cs.ImageStore.StreamSecondaryStagingStores(context.Background(), p, func(v *SecondaryStagingStore) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSecondaryStagingStores: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SecondaryStagingStores) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SecondaryStagingStores)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSecondaryStagingStores", params, `<listsecondarystagingstoresresponse><count>1</count><secondarystagingstore><details>test-details</details><id>test-id</id><name>test-name</name><protocol>test-protocol</protocol><providername>test-providername</providername><scope>test-scope</scope><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></secondarystagingstore></listsecondarystagingstoresresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.ImageStore.ListSecondaryStagingStores(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSecondaryStagingStores using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.ImageStore.StreamSecondaryStagingStores(context.Background(), p, func(v *SecondaryStagingStore) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSecondaryStagingStores using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SecondaryStagingStores) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SecondaryStagingStores)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,67 +17,59 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
-)
 
-type ConfigureInternalLoadBalancerElementParams struct {
-	p map[string]interface{}
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+type InternalLBService struct {
+	cs *CloudStackClient
 }
 
-func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabled"] = v
-	return
+func NewInternalLBService(cs *CloudStackClient) *InternalLBService {
+	return &InternalLBService{cs: cs}
 }
 
-func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type ConfigureInternalLoadBalancerElementParams = cloudstackcommon.ConfigureInternalLoadBalancerElementParams
+type ConfigureInternalLoadBalancerElementResponse = cloudstackcommon.ConfigureInternalLoadBalancerElementResponse
 
 // You should always use this function to get a new ConfigureInternalLoadBalancerElementParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - enabled
+//   - id
 func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams {
 	p := &ConfigureInternalLoadBalancerElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["enabled"] = enabled
-	p.p["id"] = id
+	p.SetEnabled(enabled)
+	p.SetId(id)
 	return p
 }
 
 // Configures an Internal Load Balancer element.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListInternalLoadBalancerElements, GetInternalLoadBalancerElementByID.
 func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*ConfigureInternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("configureInternalLoadBalancerElement", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("configureInternalLoadBalancerElement", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ConfigureInternalLoadBalancerElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("configureInternalLoadBalancerElement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -98,61 +90,46 @@ func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInt
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("configureInternalLoadBalancerElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type ConfigureInternalLoadBalancerElementResponse struct {
-	JobID   string `json:"jobid,omitempty"`
-	Enabled bool   `json:"enabled,omitempty"`
-	Id      string `json:"id,omitempty"`
-	Nspid   string `json:"nspid,omitempty"`
-}
-
-type CreateInternalLoadBalancerElementParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
-	return
-}
+type CreateInternalLoadBalancerElementParams = cloudstackcommon.CreateInternalLoadBalancerElementParams
+type CreateInternalLoadBalancerElementResponse = cloudstackcommon.CreateInternalLoadBalancerElementResponse
 
 // You should always use this function to get a new CreateInternalLoadBalancerElementParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - nspid
 func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams {
 	p := &CreateInternalLoadBalancerElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["nspid"] = nspid
+	p.SetNspid(nspid)
 	return p
 }
 
 // Create an Internal Load Balancer element.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListInternalLoadBalancerElements, GetInternalLoadBalancerElementByID.
 func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newRequest("createInternalLoadBalancerElement", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createInternalLoadBalancerElement", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateInternalLoadBalancerElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createInternalLoadBalancerElement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -173,115 +150,35 @@ func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalL
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createInternalLoadBalancerElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateInternalLoadBalancerElementResponse struct {
-	JobID   string `json:"jobid,omitempty"`
-	Enabled bool   `json:"enabled,omitempty"`
-	Id      string `json:"id,omitempty"`
-	Nspid   string `json:"nspid,omitempty"`
-}
-
-type ListInternalLoadBalancerElementsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("enabled", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
-}
-
-func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabled"] = v
-	return
-}
-
-func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["nspid"] = v
-	return
-}
-
-func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
+type ListInternalLoadBalancerElementsParams = cloudstackcommon.ListInternalLoadBalancerElementsParams
+type ListInternalLoadBalancerElementsResponse = cloudstackcommon.ListInternalLoadBalancerElementsResponse
+type InternalLoadBalancerElement = cloudstackcommon.InternalLoadBalancerElement
 
 // You should always use this function to get a new ListInternalLoadBalancerElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams {
 	p := &ListInternalLoadBalancerElementsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string) (*InternalLoadBalancerElement, int, error) {
+func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
 	p := &ListInternalLoadBalancerElementsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListInternalLoadBalancerElements(p)
 	if err != nil {
@@ -304,534 +201,539 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string) (*Inte
 }
 
 // Lists all available Internal Load Balancer elements.
+//
+// See also GetInternalLoadBalancerElementByID.
 func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListInternalLoadBalancerElementsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListInternalLoadBalancerElementsResponse struct {
-	Count                        int                            `json:"count"`
-	InternalLoadBalancerElements []*InternalLoadBalancerElement `json:"internalloadbalancerelement"`
-}
-
-type InternalLoadBalancerElement struct {
-	Enabled bool   `json:"enabled,omitempty"`
-	Id      string `json:"id,omitempty"`
-	Nspid   string `json:"nspid,omitempty"`
-}
-
-type StopInternalLoadBalancerVMParams struct {
-	p map[string]interface{}
-}
-
-func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
-	return
-}
-
-func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new StopInternalLoadBalancerVMParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams {
-	p := &StopInternalLoadBalancerVMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
-
-// Stops an Internal LB vm.
-func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("stopInternalLoadBalancerVM", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r StopInternalLoadBalancerVMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
-	return &r, nil
-}
-
-type StopInternalLoadBalancerVMResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
-}
-
-type StartInternalLoadBalancerVMParams struct {
-	p map[string]interface{}
-}
-
-func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-// You should always use this function to get a new StartInternalLoadBalancerVMParams instance,
-// as then you are sure you have configured all required params
-func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams {
-	p := &StartInternalLoadBalancerVMParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
-}
 
-// Starts an existing internal lb vm.
-func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newRequest("startInternalLoadBalancerVM", p.toURLValues())
+	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r StartInternalLoadBalancerVMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListInternalLoadBalancerElementsResponse
+	if err := s.cs.unmarshal("listInternalLoadBalancerElements", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+// Lists the objects like ListInternalLoadBalancerElements, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *InternalLBService) StreamInternalLoadBalancerElements(ctx context.Context, p *ListInternalLoadBalancerElementsParams, fn func(*InternalLoadBalancerElement) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
 	}
-	return &r, nil
-}
 
-type StartInternalLoadBalancerVMResponse struct {
-	JobID               string `json:"jobid,omitempty"`
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+	return s.cs.streamList(ctx, "listInternalLoadBalancerElements", p.URLValues(), "internalloadbalancerelement", func(b json.RawMessage) error {
+		var v InternalLoadBalancerElement
+		if err := s.cs.unmarshalElement("listInternalLoadBalancerElements", "internalloadbalancerelement", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
 type ListInternalLoadBalancerVMsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvpc      *bool   `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Hostid      *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid   *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid       *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListInternalLoadBalancerVMsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Forvpc; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("forvpc", vv)
 	}
-	if v, found := p.p["hostid"]; found {
-		u.Set("hostid", v.(string))
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListInternalLoadBalancerVMsParams) Command() string {
+	return "listInternalLoadBalancerVMs"
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListInternalLoadBalancerVMsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListInternalLoadBalancerVMsParams) ResponseType() interface{} {
+	return &ListInternalLoadBalancerVMsResponse{}
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListInternalLoadBalancerVMsParams) Validate() error {
+	v := &validator{api: "listInternalLoadBalancerVMs"}
+	return v.err()
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListInternalLoadBalancerVMsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["isrecursive"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListInternalLoadBalancerVMsParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *ListInternalLoadBalancerVMsParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListInternalLoadBalancerVMsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListInternalLoadBalancerVMsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["networkid"] = v
-	return
+	return *p.Domainid
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListInternalLoadBalancerVMsParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListInternalLoadBalancerVMsParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
+func (p *ListInternalLoadBalancerVMsParams) SetForvpc(v bool) {
+	p.Forvpc = &v
 	return
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListInternalLoadBalancerVMsParams) GetForvpc() bool {
+	if p.Forvpc == nil {
+		return false
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Forvpc
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
+func (p *ListInternalLoadBalancerVMsParams) HasForvpc() bool {
+	return p.Forvpc != nil
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
-	return
+func (p *ListInternalLoadBalancerVMsParams) ResetForvpc() {
+	p.Forvpc = nil
 }
 
-func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Sets the 'hostid' param: the ID of the host.
+func (p *ListInternalLoadBalancerVMsParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListInternalLoadBalancerVMsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListInternalLoadBalancerVMsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListInternalLoadBalancerVMsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListInternalLoadBalancerVMsParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListInternalLoadBalancerVMsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListInternalLoadBalancerVMsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListInternalLoadBalancerVMsParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListInternalLoadBalancerVMsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Projectid
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) SetState(v string) {
+	p.State = &v
 	return
 }
 
+func (p *ListInternalLoadBalancerVMsParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetState() {
+	p.State = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListInternalLoadBalancerVMsParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListInternalLoadBalancerVMsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerVMsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListInternalLoadBalancerVMsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListInternalLoadBalancerVMsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListInternalLoadBalancerVMsParams instance,
 // as then you are sure you have configured all required params
 func (s *InternalLBService) NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams {
 	p := &ListInternalLoadBalancerVMsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *InternalLBService) GetInternalLoadBalancerVMID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListInternalLoadBalancerVMs(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListInternalLoadBalancerVMs(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
@@ -840,24 +742,39 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string) (string, er
 		return l.InternalLoadBalancerVMs[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.InternalLoadBalancerVMs {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*InternalLoadBalancerVM
+	for _, v := range l.InternalLoadBalancerVMs {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "InternalLoadBalancerVM", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string) (*InternalLoadBalancerVM, int, error) {
-	id, err := s.GetInternalLoadBalancerVMID(name)
+func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	id, err := s.GetInternalLoadBalancerVMID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetInternalLoadBalancerVMByID(id)
+	r, count, err := s.GetInternalLoadBalancerVMByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -865,11 +782,16 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string) (*Inter
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string) (*InternalLoadBalancerVM, int, error) {
+func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListInternalLoadBalancerVMs(p)
 	if err != nil {
@@ -881,6 +803,20 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string) (*InternalL
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListInternalLoadBalancerVMs(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -892,85 +828,445 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string) (*InternalL
 }
 
 // List internal LB VMs.
+//
+// See also GetInternalLoadBalancerVMID, GetInternalLoadBalancerVMByName, GetInternalLoadBalancerVMByID.
 func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListInternalLoadBalancerVMsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listInternalLoadBalancerVMs", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListInternalLoadBalancerVMs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *InternalLBService) StreamInternalLoadBalancerVMs(ctx context.Context, p *ListInternalLoadBalancerVMsParams, fn func(*InternalLoadBalancerVM) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listInternalLoadBalancerVMs", p.URLValues(), "internalloadbalancervm", func(b json.RawMessage) error {
+		var v InternalLoadBalancerVM
+		if err := s.cs.unmarshalElement("listInternalLoadBalancerVMs", "internalloadbalancervm", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListInternalLoadBalancerVMsResponse struct {
-	Count                   int                       `json:"count"`
-	InternalLoadBalancerVMs []*InternalLoadBalancerVM `json:"internalloadbalancervm"`
+	Count                   int                        `json:"count" xml:"count"`
+	InternalLoadBalancerVMs []*InternalLoadBalancerVM  `json:"internalloadbalancervm" xml:"internalloadbalancervm"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type InternalLoadBalancerVM struct {
-	Account             string `json:"account,omitempty"`
-	Created             string `json:"created,omitempty"`
-	Dns1                string `json:"dns1,omitempty"`
-	Dns2                string `json:"dns2,omitempty"`
-	Domain              string `json:"domain,omitempty"`
-	Domainid            string `json:"domainid,omitempty"`
-	Gateway             string `json:"gateway,omitempty"`
-	Guestipaddress      string `json:"guestipaddress,omitempty"`
-	Guestmacaddress     string `json:"guestmacaddress,omitempty"`
-	Guestnetmask        string `json:"guestnetmask,omitempty"`
-	Guestnetworkid      string `json:"guestnetworkid,omitempty"`
-	Hostid              string `json:"hostid,omitempty"`
-	Hostname            string `json:"hostname,omitempty"`
-	Id                  string `json:"id,omitempty"`
-	Ip6dns1             string `json:"ip6dns1,omitempty"`
-	Ip6dns2             string `json:"ip6dns2,omitempty"`
-	Isredundantrouter   bool   `json:"isredundantrouter,omitempty"`
-	Linklocalip         string `json:"linklocalip,omitempty"`
-	Linklocalmacaddress string `json:"linklocalmacaddress,omitempty"`
-	Linklocalnetmask    string `json:"linklocalnetmask,omitempty"`
-	Linklocalnetworkid  string `json:"linklocalnetworkid,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Networkdomain       string `json:"networkdomain,omitempty"`
-	Nic                 []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Podid               string `json:"podid,omitempty"`
-	Project             string `json:"project,omitempty"`
-	Projectid           string `json:"projectid,omitempty"`
-	Publicip            string `json:"publicip,omitempty"`
-	Publicmacaddress    string `json:"publicmacaddress,omitempty"`
-	Publicnetmask       string `json:"publicnetmask,omitempty"`
-	Publicnetworkid     string `json:"publicnetworkid,omitempty"`
-	Redundantstate      string `json:"redundantstate,omitempty"`
-	Requiresupgrade     bool   `json:"requiresupgrade,omitempty"`
-	Role                string `json:"role,omitempty"`
-	Scriptsversion      string `json:"scriptsversion,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	State               string `json:"state,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Version             string `json:"version,omitempty"`
-	Vpcid               string `json:"vpcid,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StartInternalLoadBalancerVMParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StartInternalLoadBalancerVMParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *StartInternalLoadBalancerVMParams) Command() string {
+	return "startInternalLoadBalancerVM"
+}
+
+// Returns true if the API command starts an async job
+func (p *StartInternalLoadBalancerVMParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StartInternalLoadBalancerVMParams) ResponseType() interface{} {
+	return &StartInternalLoadBalancerVMResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StartInternalLoadBalancerVMParams) Validate() error {
+	v := &validator{api: "startInternalLoadBalancerVM"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *StartInternalLoadBalancerVMParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *StartInternalLoadBalancerVMParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *StartInternalLoadBalancerVMParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new StartInternalLoadBalancerVMParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams {
+	p := &StartInternalLoadBalancerVMParams{}
+	p.SetId(id)
+	return p
+}
+
+// Starts an existing internal lb vm.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListInternalLoadBalancerVMs, GetInternalLoadBalancerVMID, GetInternalLoadBalancerVMByName, GetInternalLoadBalancerVMByID.
+func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("startInternalLoadBalancerVM", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StartInternalLoadBalancerVMResponse
+	if err := s.cs.unmarshal("startInternalLoadBalancerVM", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("startInternalLoadBalancerVM", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type StartInternalLoadBalancerVMResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StopInternalLoadBalancerVMParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StopInternalLoadBalancerVMParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *StopInternalLoadBalancerVMParams) Command() string {
+	return "stopInternalLoadBalancerVM"
+}
+
+// Returns true if the API command starts an async job
+func (p *StopInternalLoadBalancerVMParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StopInternalLoadBalancerVMParams) ResponseType() interface{} {
+	return &StopInternalLoadBalancerVMResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StopInternalLoadBalancerVMParams) Validate() error {
+	v := &validator{api: "stopInternalLoadBalancerVM"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
+	p.Forced = &v
+	return
+}
+
+func (p *StopInternalLoadBalancerVMParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
+	}
+	return *p.Forced
+}
+
+func (p *StopInternalLoadBalancerVMParams) HasForced() bool {
+	return p.Forced != nil
+}
+
+func (p *StopInternalLoadBalancerVMParams) ResetForced() {
+	p.Forced = nil
+}
+
+// This param is required.
+func (p *StopInternalLoadBalancerVMParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *StopInternalLoadBalancerVMParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *StopInternalLoadBalancerVMParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *StopInternalLoadBalancerVMParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new StopInternalLoadBalancerVMParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams {
+	p := &StopInternalLoadBalancerVMParams{}
+	p.SetId(id)
+	return p
+}
+
+// Stops an Internal LB vm.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListInternalLoadBalancerVMs, GetInternalLoadBalancerVMID, GetInternalLoadBalancerVMByName, GetInternalLoadBalancerVMByID.
+func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("stopInternalLoadBalancerVM", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r StopInternalLoadBalancerVMResponse
+	if err := s.cs.unmarshal("stopInternalLoadBalancerVM", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("stopInternalLoadBalancerVM", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type StopInternalLoadBalancerVMResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway             string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Guestipaddress      string                     `json:"guestipaddress,omitempty" xml:"guestipaddress,omitempty"`
+	Guestmacaddress     string                     `json:"guestmacaddress,omitempty" xml:"guestmacaddress,omitempty"`
+	Guestnetmask        string                     `json:"guestnetmask,omitempty" xml:"guestnetmask,omitempty"`
+	Guestnetworkid      string                     `json:"guestnetworkid,omitempty" xml:"guestnetworkid,omitempty"`
+	Hostid              string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6dns1             string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2             string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Isredundantrouter   Bool                       `json:"isredundantrouter,omitempty" xml:"isredundantrouter,omitempty"`
+	Linklocalip         string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask    string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Linklocalnetworkid  string                     `json:"linklocalnetworkid,omitempty" xml:"linklocalnetworkid,omitempty"`
+	Name                string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain       string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Nic                 []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Podid               string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Project             string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid           string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip            string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress    string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask       string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	Publicnetworkid     string                     `json:"publicnetworkid,omitempty" xml:"publicnetworkid,omitempty"`
+	Redundantstate      string                     `json:"redundantstate,omitempty" xml:"redundantstate,omitempty"`
+	Requiresupgrade     Bool                       `json:"requiresupgrade,omitempty" xml:"requiresupgrade,omitempty"`
+	Role                string                     `json:"role,omitempty" xml:"role,omitempty"`
+	Scriptsversion      string                     `json:"scriptsversion,omitempty" xml:"scriptsversion,omitempty"`
+	Serviceofferingid   string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Templateid          string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Version             string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Vpcid               string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid              string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename            string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -0,0 +1,484 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfigureInternalLoadBalancerElement(t *testing.T) {
+	params := url.Values{
+		"enabled": {"true"},
+		"id":      {"test-id"},
+	}
+	cs, done := newTestClient(t, "configureInternalLoadBalancerElement", params, `{"configureinternalloadbalancerelementresponse":{"enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid"}}`)
+	defer done()
+
+	p := cs.InternalLB.NewConfigureInternalLoadBalancerElementParams(true, "test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	checkURLValues(t, "configureInternalLoadBalancerElement", p.URLValues(), params)
+
+	r, err := cs.InternalLB.ConfigureInternalLoadBalancerElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureInternalLoadBalancerElement: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "configureInternalLoadBalancerElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute configureInternalLoadBalancerElement: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of configureInternalLoadBalancerElement: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of configureInternalLoadBalancerElement: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "configureInternalLoadBalancerElement", params, `<configureinternalloadbalancerelementresponse><enabled>true</enabled><id>test-id</id><jobid>test-jobid</jobid><nspid>test-nspid</nspid></configureinternalloadbalancerelementresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.InternalLB.ConfigureInternalLoadBalancerElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureInternalLoadBalancerElement using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateInternalLoadBalancerElement(t *testing.T) {
+	params := url.Values{
+		"nspid": {"test-nspid"},
+	}
+	cs, done := newTestClient(t, "createInternalLoadBalancerElement", params, `{"createinternalloadbalancerelementresponse":{"enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid"}}`)
+	defer done()
+
+	p := cs.InternalLB.NewCreateInternalLoadBalancerElementParams("test-nspid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetNspid("test-nspid")
+	checkURLValues(t, "createInternalLoadBalancerElement", p.URLValues(), params)
+
+	r, err := cs.InternalLB.CreateInternalLoadBalancerElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call createInternalLoadBalancerElement: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createInternalLoadBalancerElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createInternalLoadBalancerElement: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createInternalLoadBalancerElement: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createInternalLoadBalancerElement: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createInternalLoadBalancerElement", params, `<createinternalloadbalancerelementresponse><enabled>true</enabled><id>test-id</id><jobid>test-jobid</jobid><nspid>test-nspid</nspid></createinternalloadbalancerelementresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.InternalLB.CreateInternalLoadBalancerElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call createInternalLoadBalancerElement using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListInternalLoadBalancerElements(t *testing.T) {
+	params := url.Values{
+		"enabled":  {"true"},
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"nspid":    {"test-nspid"},
+		"page":     {"5"},
+		"pagesize": {"6"},
+	}
+	cs, done := newTestClient(t, "listInternalLoadBalancerElements", params, `{"listinternalloadbalancerelementsresponse":{"count":1,"internalloadbalancerelement":[{"enabled":true,"id":"test-id","nspid":"test-nspid"}]}}`)
+	defer done()
+
+	p := cs.InternalLB.NewListInternalLoadBalancerElementsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetNspid("test-nspid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listInternalLoadBalancerElements", p.URLValues(), params)
+
+	r, err := cs.InternalLB.ListInternalLoadBalancerElements(p)
+	if err != nil {
+		t.Fatalf("Failed to call listInternalLoadBalancerElements: %v", err)
+	}
+	if r.InternalLoadBalancerElements[0].Id != "test-id" {
+		t.Errorf("Expected r.InternalLoadBalancerElements[0].Id to be %v, got %v", "test-id", r.InternalLoadBalancerElements[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listInternalLoadBalancerElements" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listInternalLoadBalancerElements: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listInternalLoadBalancerElements: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listInternalLoadBalancerElements: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*InternalLoadBalancerElement
+	count, err := cs.InternalLB.StreamInternalLoadBalancerElements(context.Background(), p, func(v *InternalLoadBalancerElement) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listInternalLoadBalancerElements: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.InternalLoadBalancerElements) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.InternalLoadBalancerElements)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listInternalLoadBalancerElements", params, `<listinternalloadbalancerelementsresponse><count>1</count><internalloadbalancerelement><enabled>true</enabled><id>test-id</id><nspid>test-nspid</nspid></internalloadbalancerelement></listinternalloadbalancerelementsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.InternalLB.ListInternalLoadBalancerElements(p)
+	if err != nil {
+		t.Fatalf("Failed to call listInternalLoadBalancerElements using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.InternalLB.StreamInternalLoadBalancerElements(context.Background(), p, func(v *InternalLoadBalancerElement) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listInternalLoadBalancerElements using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.InternalLoadBalancerElements) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.InternalLoadBalancerElements)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListInternalLoadBalancerVMs(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"forvpc":      {"true"},
+		"hostid":      {"test-hostid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"networkid":   {"test-networkid"},
+		"page":        {"11"},
+		"pagesize":    {"12"},
+		"podid":       {"test-podid"},
+		"projectid":   {"test-projectid"},
+		"state":       {"test-state"},
+		"vpcid":       {"test-vpcid"},
+		"zoneid":      {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listInternalLoadBalancerVMs", params, `{"listinternalloadbalancervmsresponse":{"count":1,"internalloadbalancervm":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.InternalLB.NewListInternalLoadBalancerVMsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetForvpc(true)
+	p.SetHostid("test-hostid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	p.SetVpcid("test-vpcid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listInternalLoadBalancerVMs", p.URLValues(), params)
+
+	r, err := cs.InternalLB.ListInternalLoadBalancerVMs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listInternalLoadBalancerVMs: %v", err)
+	}
+	if r.InternalLoadBalancerVMs[0].Account != "test-account" {
+		t.Errorf("Expected r.InternalLoadBalancerVMs[0].Account to be %v, got %v", "test-account", r.InternalLoadBalancerVMs[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listInternalLoadBalancerVMs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listInternalLoadBalancerVMs: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listInternalLoadBalancerVMs: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listInternalLoadBalancerVMs: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*InternalLoadBalancerVM
+	count, err := cs.InternalLB.StreamInternalLoadBalancerVMs(context.Background(), p, func(v *InternalLoadBalancerVM) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listInternalLoadBalancerVMs: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.InternalLoadBalancerVMs) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.InternalLoadBalancerVMs)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listInternalLoadBalancerVMs", params, `<listinternalloadbalancervmsresponse><count>1</count><internalloadbalancervm><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></internalloadbalancervm></listinternalloadbalancervmsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.InternalLB.ListInternalLoadBalancerVMs(p)
+	if err != nil {
+		t.Fatalf("Failed to call listInternalLoadBalancerVMs using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.InternalLB.StreamInternalLoadBalancerVMs(context.Background(), p, func(v *InternalLoadBalancerVM) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listInternalLoadBalancerVMs using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.InternalLoadBalancerVMs) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.InternalLoadBalancerVMs)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestStartInternalLoadBalancerVM(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "startInternalLoadBalancerVM", params, `{"startinternalloadbalancervmresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"jobid":"test-jobid","linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.InternalLB.NewStartInternalLoadBalancerVMParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "startInternalLoadBalancerVM", p.URLValues(), params)
+
+	r, err := cs.InternalLB.StartInternalLoadBalancerVM(p)
+	if err != nil {
+		t.Fatalf("Failed to call startInternalLoadBalancerVM: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "startInternalLoadBalancerVM" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute startInternalLoadBalancerVM: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of startInternalLoadBalancerVM: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of startInternalLoadBalancerVM: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "startInternalLoadBalancerVM", params, `<startinternalloadbalancervmresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><jobid>test-jobid</jobid><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></startinternalloadbalancervmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.InternalLB.StartInternalLoadBalancerVM(p)
+	if err != nil {
+		t.Fatalf("Failed to call startInternalLoadBalancerVM using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestStopInternalLoadBalancerVM(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+	cs, done := newTestClient(t, "stopInternalLoadBalancerVM", params, `{"stopinternalloadbalancervmresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","guestipaddress":"test-guestipaddress","guestmacaddress":"test-guestmacaddress","guestnetmask":"test-guestnetmask","guestnetworkid":"test-guestnetworkid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","isredundantrouter":true,"jobid":"test-jobid","linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","linklocalnetworkid":"test-linklocalnetworkid","name":"test-name","networkdomain":"test-networkdomain","nic":[{"broadcasturi":"test-broadcasturi","deviceid":"test-deviceid","gateway":"test-gateway","id":"test-id","ip6address":"test-ip6address","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","ipaddress":"test-ipaddress","isdefault":true,"isolationuri":"test-isolationuri","macaddress":"test-macaddress","netmask":"test-netmask","networkid":"test-networkid","networkname":"test-networkname","secondaryip":["test-secondaryip"],"traffictype":"test-traffictype","type":"test-type","virtualmachineid":"test-virtualmachineid"}],"podid":"test-podid","project":"test-project","projectid":"test-projectid","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","publicnetworkid":"test-publicnetworkid","redundantstate":"test-redundantstate","requiresupgrade":true,"role":"test-role","scriptsversion":"test-scriptsversion","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","state":"test-state","templateid":"test-templateid","version":"test-version","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.InternalLB.NewStopInternalLoadBalancerVMParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetForced(true)
+	p.SetId("test-id")
+	checkURLValues(t, "stopInternalLoadBalancerVM", p.URLValues(), params)
+
+	r, err := cs.InternalLB.StopInternalLoadBalancerVM(p)
+	if err != nil {
+		t.Fatalf("Failed to call stopInternalLoadBalancerVM: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "stopInternalLoadBalancerVM" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute stopInternalLoadBalancerVM: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of stopInternalLoadBalancerVM: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of stopInternalLoadBalancerVM: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "stopInternalLoadBalancerVM", params, `<stopinternalloadbalancervmresponse><account>test-account</account><created>2014-01-02T15:04:05+0100</created><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><gateway>test-gateway</gateway><guestipaddress>test-guestipaddress</guestipaddress><guestmacaddress>test-guestmacaddress</guestmacaddress><guestnetmask>test-guestnetmask</guestnetmask><guestnetworkid>test-guestnetworkid</guestnetworkid><hostid>test-hostid</hostid><hostname>test-hostname</hostname><id>test-id</id><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><isredundantrouter>true</isredundantrouter><jobid>test-jobid</jobid><linklocalip>test-linklocalip</linklocalip><linklocalmacaddress>test-linklocalmacaddress</linklocalmacaddress><linklocalnetmask>test-linklocalnetmask</linklocalnetmask><linklocalnetworkid>test-linklocalnetworkid</linklocalnetworkid><name>test-name</name><networkdomain>test-networkdomain</networkdomain><nic><broadcasturi>test-broadcasturi</broadcasturi><deviceid>test-deviceid</deviceid><gateway>test-gateway</gateway><id>test-id</id><ip6address>test-ip6address</ip6address><ip6cidr>test-ip6cidr</ip6cidr><ip6gateway>test-ip6gateway</ip6gateway><ipaddress>test-ipaddress</ipaddress><isdefault>true</isdefault><isolationuri>test-isolationuri</isolationuri><macaddress>test-macaddress</macaddress><netmask>test-netmask</netmask><networkid>test-networkid</networkid><networkname>test-networkname</networkname><secondaryip>test-secondaryip</secondaryip><traffictype>test-traffictype</traffictype><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid></nic><podid>test-podid</podid><project>test-project</project><projectid>test-projectid</projectid><publicip>test-publicip</publicip><publicmacaddress>test-publicmacaddress</publicmacaddress><publicnetmask>test-publicnetmask</publicnetmask><publicnetworkid>test-publicnetworkid</publicnetworkid><redundantstate>test-redundantstate</redundantstate><requiresupgrade>true</requiresupgrade><role>test-role</role><scriptsversion>test-scriptsversion</scriptsversion><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><state>test-state</state><templateid>test-templateid</templateid><version>test-version</version><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></stopinternalloadbalancervmresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.InternalLB.StopInternalLoadBalancerVM(p)
+	if err != nil {
+		t.Fatalf("Failed to call stopInternalLoadBalancerVM using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,2573 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRemoveCertFromLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"lbruleid": {"test-lbruleid"},
+	}
+	cs, done := newTestClient(t, "removeCertFromLoadBalancer", params, `{"removecertfromloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewRemoveCertFromLoadBalancerParams("test-lbruleid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetLbruleid("test-lbruleid")
+	checkURLValues(t, "removeCertFromLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.RemoveCertFromLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeCertFromLoadBalancer: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeCertFromLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeCertFromLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeCertFromLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeCertFromLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeCertFromLoadBalancer", params, `<removecertfromloadbalancerresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removecertfromloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.RemoveCertFromLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeCertFromLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAssignCertToLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"certid":   {"test-certid"},
+		"lbruleid": {"test-lbruleid"},
+	}
+	cs, done := newTestClient(t, "assignCertToLoadBalancer", params, `{"assigncerttoloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewAssignCertToLoadBalancerParams("test-certid", "test-lbruleid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCertid("test-certid")
+	p.SetLbruleid("test-lbruleid")
+	checkURLValues(t, "assignCertToLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.AssignCertToLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call assignCertToLoadBalancer: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "assignCertToLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute assignCertToLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of assignCertToLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of assignCertToLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "assignCertToLoadBalancer", params, `<assigncerttoloadbalancerresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></assigncerttoloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.AssignCertToLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call assignCertToLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveFromGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id":                   {"test-id"},
+		"loadbalancerrulelist": {"test-loadbalancerrulelist1, test-loadbalancerrulelist2"},
+	}
+	cs, done := newTestClient(t, "removeFromGlobalLoadBalancerRule", params, `{"removefromgloballoadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewRemoveFromGlobalLoadBalancerRuleParams("test-id", []string{"test-loadbalancerrulelist1", "test-loadbalancerrulelist2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetLoadbalancerrulelist([]string{"test-loadbalancerrulelist1", "test-loadbalancerrulelist2"})
+	checkURLValues(t, "removeFromGlobalLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.RemoveFromGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeFromGlobalLoadBalancerRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeFromGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeFromGlobalLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeFromGlobalLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeFromGlobalLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeFromGlobalLoadBalancerRule", params, `<removefromgloballoadbalancerruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removefromgloballoadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.RemoveFromGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeFromGlobalLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveFromLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id":                {"test-id"},
+		"virtualmachineids": {"test-virtualmachineids1, test-virtualmachineids2"},
+		"vmidipmap[0].vmid": {"test-vmid"},
+		"vmidipmap[0].vmip": {"test-vmip"},
+	}
+	cs, done := newTestClient(t, "removeFromLoadBalancerRule", params, `{"removefromloadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewRemoveFromLoadBalancerRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetVirtualmachineids([]string{"test-virtualmachineids1", "test-virtualmachineids2"})
+	p.SetVmidipmap([]VMIPMapping{{Vmid: "test-vmid", Vmip: "test-vmip"}})
+	checkURLValues(t, "removeFromLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.RemoveFromLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeFromLoadBalancerRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeFromLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeFromLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeFromLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeFromLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeFromLoadBalancerRule", params, `<removefromloadbalancerruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removefromloadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.RemoveFromLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeFromLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"account":                     {"test-account"},
+		"description":                 {"test-description"},
+		"domainid":                    {"test-domainid"},
+		"gslbdomainname":              {"test-gslbdomainname"},
+		"gslblbmethod":                {"test-gslblbmethod"},
+		"gslbservicetype":             {"test-gslbservicetype"},
+		"gslbstickysessionmethodname": {"test-gslbstickysessionmethodname"},
+		"name":                        {"test-name"},
+		"regionid":                    {"9"},
+	}
+	cs, done := newTestClient(t, "createGlobalLoadBalancerRule", params, `{"creategloballoadbalancerruleresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","gslbdomainname":"test-gslbdomainname","gslblbmethod":"test-gslblbmethod","gslbservicetype":"test-gslbservicetype","gslbstickysessionmethodname":"test-gslbstickysessionmethodname","id":"test-id","jobid":"test-jobid","loadbalancerrule":[{"account":"test-account","algorithm":"test-algorithm","cidrlist":"test-cidrlist","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","name":"test-name","networkid":"test-networkid","privateport":"test-privateport","project":"test-project","projectid":"test-projectid","protocol":"test-protocol","publicip":"test-publicip","publicipid":"test-publicipid","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"zoneid":"test-zoneid"}],"name":"test-name","project":"test-project","projectid":"test-projectid","regionid":1}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewCreateGlobalLoadBalancerRuleParams("test-gslbdomainname", "test-gslbservicetype", "test-name", 9)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDescription("test-description")
+	p.SetDomainid("test-domainid")
+	p.SetGslbdomainname("test-gslbdomainname")
+	p.SetGslblbmethod("test-gslblbmethod")
+	p.SetGslbservicetype("test-gslbservicetype")
+	p.SetGslbstickysessionmethodname("test-gslbstickysessionmethodname")
+	p.SetName("test-name")
+	p.SetRegionid(9)
+	checkURLValues(t, "createGlobalLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.CreateGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createGlobalLoadBalancerRule: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createGlobalLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createGlobalLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createGlobalLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createGlobalLoadBalancerRule", params, `<creategloballoadbalancerruleresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><gslbdomainname>test-gslbdomainname</gslbdomainname><gslblbmethod>test-gslblbmethod</gslblbmethod><gslbservicetype>test-gslbservicetype</gslbservicetype><gslbstickysessionmethodname>test-gslbstickysessionmethodname</gslbstickysessionmethodname><id>test-id</id><jobid>test-jobid</jobid><loadbalancerrule><account>test-account</account><algorithm>test-algorithm</algorithm><cidrlist>test-cidrlist</cidrlist><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><name>test-name</name><networkid>test-networkid</networkid><privateport>test-privateport</privateport><project>test-project</project><projectid>test-projectid</projectid><protocol>test-protocol</protocol><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><zoneid>test-zoneid</zoneid></loadbalancerrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><regionid>1</regionid></creategloballoadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.CreateGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createGlobalLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteGlobalLoadBalancerRule", params, `{"deletegloballoadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteGlobalLoadBalancerRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteGlobalLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteGlobalLoadBalancerRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteGlobalLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteGlobalLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteGlobalLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteGlobalLoadBalancerRule", params, `<deletegloballoadbalancerruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletegloballoadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteGlobalLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListGlobalLoadBalancerRules(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"page":          {"7"},
+		"pagesize":      {"8"},
+		"projectid":     {"test-projectid"},
+		"regionid":      {"10"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listGlobalLoadBalancerRules", params, `{"listgloballoadbalancerrulesresponse":{"count":1,"globalloadbalancerrule":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","gslbdomainname":"test-gslbdomainname","gslblbmethod":"test-gslblbmethod","gslbservicetype":"test-gslbservicetype","gslbstickysessionmethodname":"test-gslbstickysessionmethodname","id":"test-id","loadbalancerrule":[{"account":"test-account","algorithm":"test-algorithm","cidrlist":"test-cidrlist","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","name":"test-name","networkid":"test-networkid","privateport":"test-privateport","project":"test-project","projectid":"test-projectid","protocol":"test-protocol","publicip":"test-publicip","publicipid":"test-publicipid","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"zoneid":"test-zoneid"}],"name":"test-name","project":"test-project","projectid":"test-projectid","regionid":1}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListGlobalLoadBalancerRulesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetRegionid(10)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listGlobalLoadBalancerRules", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListGlobalLoadBalancerRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listGlobalLoadBalancerRules: %v", err)
+	}
+	if r.GlobalLoadBalancerRules[0].Account != "test-account" {
+		t.Errorf("Expected r.GlobalLoadBalancerRules[0].Account to be %v, got %v", "test-account", r.GlobalLoadBalancerRules[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listGlobalLoadBalancerRules" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listGlobalLoadBalancerRules: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listGlobalLoadBalancerRules: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listGlobalLoadBalancerRules: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*GlobalLoadBalancerRule
+	count, err := cs.LoadBalancer.StreamGlobalLoadBalancerRules(context.Background(), p, func(v *GlobalLoadBalancerRule) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listGlobalLoadBalancerRules: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.GlobalLoadBalancerRules) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.GlobalLoadBalancerRules)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listGlobalLoadBalancerRules", params, `<listgloballoadbalancerrulesresponse><count>1</count><globalloadbalancerrule><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><gslbdomainname>test-gslbdomainname</gslbdomainname><gslblbmethod>test-gslblbmethod</gslblbmethod><gslbservicetype>test-gslbservicetype</gslbservicetype><gslbstickysessionmethodname>test-gslbstickysessionmethodname</gslbstickysessionmethodname><id>test-id</id><loadbalancerrule><account>test-account</account><algorithm>test-algorithm</algorithm><cidrlist>test-cidrlist</cidrlist><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><name>test-name</name><networkid>test-networkid</networkid><privateport>test-privateport</privateport><project>test-project</project><projectid>test-projectid</projectid><protocol>test-protocol</protocol><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><zoneid>test-zoneid</zoneid></loadbalancerrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><regionid>1</regionid></globalloadbalancerrule></listgloballoadbalancerrulesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListGlobalLoadBalancerRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listGlobalLoadBalancerRules using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamGlobalLoadBalancerRules(context.Background(), p, func(v *GlobalLoadBalancerRule) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listGlobalLoadBalancerRules using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.GlobalLoadBalancerRules) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.GlobalLoadBalancerRules)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"description":                 {"test-description"},
+		"gslblbmethod":                {"test-gslblbmethod"},
+		"gslbstickysessionmethodname": {"test-gslbstickysessionmethodname"},
+		"id":                          {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateGlobalLoadBalancerRule", params, `{"updategloballoadbalancerruleresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","gslbdomainname":"test-gslbdomainname","gslblbmethod":"test-gslblbmethod","gslbservicetype":"test-gslbservicetype","gslbstickysessionmethodname":"test-gslbstickysessionmethodname","id":"test-id","jobid":"test-jobid","loadbalancerrule":[{"account":"test-account","algorithm":"test-algorithm","cidrlist":"test-cidrlist","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","name":"test-name","networkid":"test-networkid","privateport":"test-privateport","project":"test-project","projectid":"test-projectid","protocol":"test-protocol","publicip":"test-publicip","publicipid":"test-publicipid","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"zoneid":"test-zoneid"}],"name":"test-name","project":"test-project","projectid":"test-projectid","regionid":1}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewUpdateGlobalLoadBalancerRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetGslblbmethod("test-gslblbmethod")
+	p.SetGslbstickysessionmethodname("test-gslbstickysessionmethodname")
+	p.SetId("test-id")
+	checkURLValues(t, "updateGlobalLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.UpdateGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateGlobalLoadBalancerRule: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateGlobalLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateGlobalLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateGlobalLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateGlobalLoadBalancerRule", params, `<updategloballoadbalancerruleresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><gslbdomainname>test-gslbdomainname</gslbdomainname><gslblbmethod>test-gslblbmethod</gslblbmethod><gslbservicetype>test-gslbservicetype</gslbservicetype><gslbstickysessionmethodname>test-gslbstickysessionmethodname</gslbstickysessionmethodname><id>test-id</id><jobid>test-jobid</jobid><loadbalancerrule><account>test-account</account><algorithm>test-algorithm</algorithm><cidrlist>test-cidrlist</cidrlist><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><name>test-name</name><networkid>test-networkid</networkid><privateport>test-privateport</privateport><project>test-project</project><projectid>test-projectid</projectid><protocol>test-protocol</protocol><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><zoneid>test-zoneid</zoneid></loadbalancerrule><name>test-name</name><project>test-project</project><projectid>test-projectid</projectid><regionid>1</regionid></updategloballoadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.UpdateGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateGlobalLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateLBHealthCheckPolicy(t *testing.T) {
+	params := url.Values{
+		"description":        {"test-description"},
+		"fordisplay":         {"true"},
+		"healthythreshold":   {"3"},
+		"intervaltime":       {"4"},
+		"lbruleid":           {"test-lbruleid"},
+		"pingpath":           {"test-pingpath"},
+		"responsetimeout":    {"7"},
+		"unhealthythreshold": {"8"},
+	}
+	cs, done := newTestClient(t, "createLBHealthCheckPolicy", params, `{"createlbhealthcheckpolicyresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","healthcheckpolicy":[{"description":"test-description","fordisplay":true,"healthcheckinterval":1,"healthcheckthresshold":1,"id":"test-id","pingpath":"test-pingpath","responsetime":1,"state":"test-state","unhealthcheckthresshold":1}],"jobid":"test-jobid","lbruleid":"test-lbruleid","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewCreateLBHealthCheckPolicyParams("test-lbruleid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetFordisplay(true)
+	p.SetHealthythreshold(3)
+	p.SetIntervaltime(4)
+	p.SetLbruleid("test-lbruleid")
+	p.SetPingpath("test-pingpath")
+	p.SetResponsetimeout(7)
+	p.SetUnhealthythreshold(8)
+	checkURLValues(t, "createLBHealthCheckPolicy", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.CreateLBHealthCheckPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLBHealthCheckPolicy: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createLBHealthCheckPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createLBHealthCheckPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createLBHealthCheckPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createLBHealthCheckPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createLBHealthCheckPolicy", params, `<createlbhealthcheckpolicyresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><healthcheckpolicy><description>test-description</description><fordisplay>true</fordisplay><healthcheckinterval>1</healthcheckinterval><healthcheckthresshold>1</healthcheckthresshold><id>test-id</id><pingpath>test-pingpath</pingpath><responsetime>1</responsetime><state>test-state</state><unhealthcheckthresshold>1</unhealthcheckthresshold></healthcheckpolicy><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><zoneid>test-zoneid</zoneid></createlbhealthcheckpolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.CreateLBHealthCheckPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLBHealthCheckPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteLBHealthCheckPolicy(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteLBHealthCheckPolicy", params, `{"deletelbhealthcheckpolicyresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteLBHealthCheckPolicyParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteLBHealthCheckPolicy", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteLBHealthCheckPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLBHealthCheckPolicy: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLBHealthCheckPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteLBHealthCheckPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteLBHealthCheckPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteLBHealthCheckPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteLBHealthCheckPolicy", params, `<deletelbhealthcheckpolicyresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletelbhealthcheckpolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteLBHealthCheckPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLBHealthCheckPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLBHealthCheckPolicies(t *testing.T) {
+	params := url.Values{
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+		"keyword":    {"test-keyword"},
+		"lbruleid":   {"test-lbruleid"},
+		"page":       {"5"},
+		"pagesize":   {"6"},
+	}
+	cs, done := newTestClient(t, "listLBHealthCheckPolicies", params, `{"listlbhealthcheckpoliciesresponse":{"count":1,"lbhealthcheckpolicy":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","healthcheckpolicy":[{"description":"test-description","fordisplay":true,"healthcheckinterval":1,"healthcheckthresshold":1,"id":"test-id","pingpath":"test-pingpath","responsetime":1,"state":"test-state","unhealthcheckthresshold":1}],"lbruleid":"test-lbruleid","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListLBHealthCheckPoliciesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetLbruleid("test-lbruleid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listLBHealthCheckPolicies", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListLBHealthCheckPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLBHealthCheckPolicies: %v", err)
+	}
+	if r.LBHealthCheckPolicies[0].Account != "test-account" {
+		t.Errorf("Expected r.LBHealthCheckPolicies[0].Account to be %v, got %v", "test-account", r.LBHealthCheckPolicies[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLBHealthCheckPolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLBHealthCheckPolicies: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLBHealthCheckPolicies: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLBHealthCheckPolicies: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LBHealthCheckPolicy
+	count, err := cs.LoadBalancer.StreamLBHealthCheckPolicies(context.Background(), p, func(v *LBHealthCheckPolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLBHealthCheckPolicies: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LBHealthCheckPolicies) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LBHealthCheckPolicies)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLBHealthCheckPolicies", params, `<listlbhealthcheckpoliciesresponse><count>1</count><lbhealthcheckpolicy><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><healthcheckpolicy><description>test-description</description><fordisplay>true</fordisplay><healthcheckinterval>1</healthcheckinterval><healthcheckthresshold>1</healthcheckthresshold><id>test-id</id><pingpath>test-pingpath</pingpath><responsetime>1</responsetime><state>test-state</state><unhealthcheckthresshold>1</unhealthcheckthresshold></healthcheckpolicy><lbruleid>test-lbruleid</lbruleid><zoneid>test-zoneid</zoneid></lbhealthcheckpolicy></listlbhealthcheckpoliciesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListLBHealthCheckPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLBHealthCheckPolicies using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamLBHealthCheckPolicies(context.Background(), p, func(v *LBHealthCheckPolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLBHealthCheckPolicies using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LBHealthCheckPolicies) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LBHealthCheckPolicies)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateLBHealthCheckPolicy(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateLBHealthCheckPolicy", params, `{"updatelbhealthcheckpolicyresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","healthcheckpolicy":[{"description":"test-description","fordisplay":true,"healthcheckinterval":1,"healthcheckthresshold":1,"id":"test-id","pingpath":"test-pingpath","responsetime":1,"state":"test-state","unhealthcheckthresshold":1}],"jobid":"test-jobid","lbruleid":"test-lbruleid","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewUpdateLBHealthCheckPolicyParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateLBHealthCheckPolicy", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.UpdateLBHealthCheckPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLBHealthCheckPolicy: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateLBHealthCheckPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateLBHealthCheckPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateLBHealthCheckPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateLBHealthCheckPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateLBHealthCheckPolicy", params, `<updatelbhealthcheckpolicyresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><healthcheckpolicy><description>test-description</description><fordisplay>true</fordisplay><healthcheckinterval>1</healthcheckinterval><healthcheckthresshold>1</healthcheckthresshold><id>test-id</id><pingpath>test-pingpath</pingpath><responsetime>1</responsetime><state>test-state</state><unhealthcheckthresshold>1</unhealthcheckthresshold></healthcheckpolicy><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><zoneid>test-zoneid</zoneid></updatelbhealthcheckpolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.UpdateLBHealthCheckPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLBHealthCheckPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateLBStickinessPolicy(t *testing.T) {
+	params := url.Values{
+		"description":    {"test-description"},
+		"fordisplay":     {"true"},
+		"lbruleid":       {"test-lbruleid"},
+		"methodname":     {"test-methodname"},
+		"name":           {"test-name"},
+		"param[0].name":  {"test-paramkey"},
+		"param[0].value": {"test-paramvalue"},
+	}
+	cs, done := newTestClient(t, "createLBStickinessPolicy", params, `{"createlbstickinesspolicyresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","jobid":"test-jobid","lbruleid":"test-lbruleid","name":"test-name","state":"test-state","stickinesspolicy":[{"description":"test-description","fordisplay":true,"id":"test-id","methodname":"test-methodname","name":"test-name","params":{"key":"value"},"state":"test-state"}],"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewCreateLBStickinessPolicyParams("test-lbruleid", "test-methodname", "test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDescription("test-description")
+	p.SetFordisplay(true)
+	p.SetLbruleid("test-lbruleid")
+	p.SetMethodname("test-methodname")
+	p.SetName("test-name")
+	p.SetParam(map[string]string{"test-paramkey": "test-paramvalue"})
+	checkURLValues(t, "createLBStickinessPolicy", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.CreateLBStickinessPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLBStickinessPolicy: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createLBStickinessPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createLBStickinessPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createLBStickinessPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createLBStickinessPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createLBStickinessPolicy", params, `<createlbstickinesspolicyresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><name>test-name</name><state>test-state</state><stickinesspolicy><description>test-description</description><fordisplay>true</fordisplay><id>test-id</id><methodname>test-methodname</methodname><name>test-name</name><params><key>value</key></params><state>test-state</state></stickinesspolicy><zoneid>test-zoneid</zoneid></createlbstickinesspolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.CreateLBStickinessPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLBStickinessPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteLBStickinessPolicy(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteLBStickinessPolicy", params, `{"deletelbstickinesspolicyresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteLBStickinessPolicyParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteLBStickinessPolicy", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteLBStickinessPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLBStickinessPolicy: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLBStickinessPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteLBStickinessPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteLBStickinessPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteLBStickinessPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteLBStickinessPolicy", params, `<deletelbstickinesspolicyresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletelbstickinesspolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteLBStickinessPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLBStickinessPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLBStickinessPolicies(t *testing.T) {
+	params := url.Values{
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+		"keyword":    {"test-keyword"},
+		"lbruleid":   {"test-lbruleid"},
+		"page":       {"5"},
+		"pagesize":   {"6"},
+	}
+	cs, done := newTestClient(t, "listLBStickinessPolicies", params, `{"listlbstickinesspoliciesresponse":{"count":1,"lbstickinesspolicy":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","lbruleid":"test-lbruleid","name":"test-name","state":"test-state","stickinesspolicy":[{"description":"test-description","fordisplay":true,"id":"test-id","methodname":"test-methodname","name":"test-name","params":{"key":"value"},"state":"test-state"}],"zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListLBStickinessPoliciesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetLbruleid("test-lbruleid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listLBStickinessPolicies", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListLBStickinessPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLBStickinessPolicies: %v", err)
+	}
+	if r.LBStickinessPolicies[0].Account != "test-account" {
+		t.Errorf("Expected r.LBStickinessPolicies[0].Account to be %v, got %v", "test-account", r.LBStickinessPolicies[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLBStickinessPolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLBStickinessPolicies: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLBStickinessPolicies: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLBStickinessPolicies: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LBStickinessPolicy
+	count, err := cs.LoadBalancer.StreamLBStickinessPolicies(context.Background(), p, func(v *LBStickinessPolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLBStickinessPolicies: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LBStickinessPolicies) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LBStickinessPolicies)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLBStickinessPolicies", params, `<listlbstickinesspoliciesresponse><count>1</count><lbstickinesspolicy><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><lbruleid>test-lbruleid</lbruleid><name>test-name</name><state>test-state</state><stickinesspolicy><description>test-description</description><fordisplay>true</fordisplay><id>test-id</id><methodname>test-methodname</methodname><name>test-name</name><params><key>value</key></params><state>test-state</state></stickinesspolicy><zoneid>test-zoneid</zoneid></lbstickinesspolicy></listlbstickinesspoliciesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListLBStickinessPolicies(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLBStickinessPolicies using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamLBStickinessPolicies(context.Background(), p, func(v *LBStickinessPolicy) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLBStickinessPolicies using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LBStickinessPolicies) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LBStickinessPolicies)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateLBStickinessPolicy(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateLBStickinessPolicy", params, `{"updatelbstickinesspolicyresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","jobid":"test-jobid","lbruleid":"test-lbruleid","name":"test-name","state":"test-state","stickinesspolicy":[{"description":"test-description","fordisplay":true,"id":"test-id","methodname":"test-methodname","name":"test-name","params":{"key":"value"},"state":"test-state"}],"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewUpdateLBStickinessPolicyParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateLBStickinessPolicy", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.UpdateLBStickinessPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLBStickinessPolicy: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateLBStickinessPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateLBStickinessPolicy: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateLBStickinessPolicy: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateLBStickinessPolicy: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateLBStickinessPolicy", params, `<updatelbstickinesspolicyresponse><account>test-account</account><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><jobid>test-jobid</jobid><lbruleid>test-lbruleid</lbruleid><name>test-name</name><state>test-state</state><stickinesspolicy><description>test-description</description><fordisplay>true</fordisplay><id>test-id</id><methodname>test-methodname</methodname><name>test-name</name><params><key>value</key></params><state>test-state</state></stickinesspolicy><zoneid>test-zoneid</zoneid></updatelbstickinesspolicyresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.UpdateLBStickinessPolicy(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLBStickinessPolicy using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"algorithm":                {"leastconn"},
+		"description":              {"test-description"},
+		"fordisplay":               {"true"},
+		"instanceport":             {"4"},
+		"name":                     {"test-name"},
+		"networkid":                {"test-networkid"},
+		"scheme":                   {"test-scheme"},
+		"sourceipaddress":          {"test-sourceipaddress"},
+		"sourceipaddressnetworkid": {"test-sourceipaddressnetworkid"},
+		"sourceport":               {"10"},
+	}
+	cs, done := newTestClient(t, "createLoadBalancer", params, `{"createloadbalancerresponse":{"account":"test-account","algorithm":"test-algorithm","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","loadbalancerinstance":[{"id":"test-id","ipaddress":"test-ipaddress","name":"test-name","state":"test-state"}],"loadbalancerrule":[{"instanceport":1,"sourceport":1,"state":"test-state"}],"name":"test-name","networkid":"test-networkid","project":"test-project","projectid":"test-projectid","sourceipaddress":"test-sourceipaddress","sourceipaddressnetworkid":"test-sourceipaddressnetworkid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewCreateLoadBalancerParams(LBAlgorithmLeastConn, 4, "test-name", "test-networkid", "test-scheme", "test-sourceipaddressnetworkid", 10)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAlgorithm(LBAlgorithmLeastConn)
+	p.SetDescription("test-description")
+	p.SetFordisplay(true)
+	p.SetInstanceport(4)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetScheme("test-scheme")
+	p.SetSourceipaddress("test-sourceipaddress")
+	p.SetSourceipaddressnetworkid("test-sourceipaddressnetworkid")
+	p.SetSourceport(10)
+	checkURLValues(t, "createLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.CreateLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLoadBalancer: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createLoadBalancer", params, `<createloadbalancerresponse><account>test-account</account><algorithm>test-algorithm</algorithm><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><loadbalancerinstance><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><state>test-state</state></loadbalancerinstance><loadbalancerrule><instanceport>1</instanceport><sourceport>1</sourceport><state>test-state</state></loadbalancerrule><name>test-name</name><networkid>test-networkid</networkid><project>test-project</project><projectid>test-projectid</projectid><sourceipaddress>test-sourceipaddress</sourceipaddress><sourceipaddressnetworkid>test-sourceipaddressnetworkid</sourceipaddressnetworkid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></createloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.CreateLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteLoadBalancer", params, `{"deleteloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteLoadBalancerParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLoadBalancer: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteLoadBalancer", params, `<deleteloadbalancerresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLoadBalancers(t *testing.T) {
+	params := url.Values{
+		"account":                  {"test-account"},
+		"domainid":                 {"test-domainid"},
+		"fordisplay":               {"true"},
+		"id":                       {"test-id"},
+		"isrecursive":              {"true"},
+		"keyword":                  {"test-keyword"},
+		"listall":                  {"true"},
+		"name":                     {"test-name"},
+		"networkid":                {"test-networkid"},
+		"page":                     {"10"},
+		"pagesize":                 {"11"},
+		"projectid":                {"test-projectid"},
+		"scheme":                   {"test-scheme"},
+		"sourceipaddress":          {"test-sourceipaddress"},
+		"sourceipaddressnetworkid": {"test-sourceipaddressnetworkid"},
+		"tags[0].key":              {"test-tagskey"},
+		"tags[0].value":            {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listLoadBalancers", params, `{"listloadbalancersresponse":{"count":1,"loadbalancer":[{"account":"test-account","algorithm":"test-algorithm","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","loadbalancerinstance":[{"id":"test-id","ipaddress":"test-ipaddress","name":"test-name","state":"test-state"}],"loadbalancerrule":[{"instanceport":1,"sourceport":1,"state":"test-state"}],"name":"test-name","networkid":"test-networkid","project":"test-project","projectid":"test-projectid","sourceipaddress":"test-sourceipaddress","sourceipaddressnetworkid":"test-sourceipaddressnetworkid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListLoadBalancersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(10)
+	p.SetPagesize(11)
+	p.SetProjectid("test-projectid")
+	p.SetScheme("test-scheme")
+	p.SetSourceipaddress("test-sourceipaddress")
+	p.SetSourceipaddressnetworkid("test-sourceipaddressnetworkid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listLoadBalancers", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListLoadBalancers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLoadBalancers: %v", err)
+	}
+	if r.LoadBalancers[0].Account != "test-account" {
+		t.Errorf("Expected r.LoadBalancers[0].Account to be %v, got %v", "test-account", r.LoadBalancers[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLoadBalancers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLoadBalancers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLoadBalancers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLoadBalancers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LoadBalancer
+	count, err := cs.LoadBalancer.StreamLoadBalancers(context.Background(), p, func(v *LoadBalancer) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLoadBalancers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LoadBalancers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LoadBalancers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLoadBalancers", params, `<listloadbalancersresponse><count>1</count><loadbalancer><account>test-account</account><algorithm>test-algorithm</algorithm><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><loadbalancerinstance><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><state>test-state</state></loadbalancerinstance><loadbalancerrule><instanceport>1</instanceport><sourceport>1</sourceport><state>test-state</state></loadbalancerrule><name>test-name</name><networkid>test-networkid</networkid><project>test-project</project><projectid>test-projectid</projectid><sourceipaddress>test-sourceipaddress</sourceipaddress><sourceipaddressnetworkid>test-sourceipaddressnetworkid</sourceipaddressnetworkid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></loadbalancer></listloadbalancersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListLoadBalancers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLoadBalancers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamLoadBalancers(context.Background(), p, func(v *LoadBalancer) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLoadBalancers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LoadBalancers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LoadBalancers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"customid":   {"test-customid"},
+		"fordisplay": {"true"},
+		"id":         {"test-id"},
+	}
+	cs, done := newTestClient(t, "updateLoadBalancer", params, `{"updateloadbalancerresponse":{"account":"test-account","algorithm":"test-algorithm","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","loadbalancerinstance":[{"id":"test-id","ipaddress":"test-ipaddress","name":"test-name","state":"test-state"}],"loadbalancerrule":[{"instanceport":1,"sourceport":1,"state":"test-state"}],"name":"test-name","networkid":"test-networkid","project":"test-project","projectid":"test-projectid","sourceipaddress":"test-sourceipaddress","sourceipaddressnetworkid":"test-sourceipaddressnetworkid","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewUpdateLoadBalancerParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomid("test-customid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	checkURLValues(t, "updateLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.UpdateLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLoadBalancer: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateLoadBalancer", params, `<updateloadbalancerresponse><account>test-account</account><algorithm>test-algorithm</algorithm><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><loadbalancerinstance><id>test-id</id><ipaddress>test-ipaddress</ipaddress><name>test-name</name><state>test-state</state></loadbalancerinstance><loadbalancerrule><instanceport>1</instanceport><sourceport>1</sourceport><state>test-state</state></loadbalancerrule><name>test-name</name><networkid>test-networkid</networkid><project>test-project</project><projectid>test-projectid</projectid><sourceipaddress>test-sourceipaddress</sourceipaddress><sourceipaddressnetworkid>test-sourceipaddressnetworkid</sourceipaddressnetworkid><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags></updateloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.UpdateLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"algorithm":    {"leastconn"},
+		"cidrlist":     {"test-cidrlist1, test-cidrlist2"},
+		"description":  {"test-description"},
+		"domainid":     {"test-domainid"},
+		"fordisplay":   {"true"},
+		"name":         {"test-name"},
+		"networkid":    {"test-networkid"},
+		"openfirewall": {"true"},
+		"privateport":  {"10"},
+		"protocol":     {"all"},
+		"publicipid":   {"test-publicipid"},
+		"publicport":   {"13"},
+		"zoneid":       {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createLoadBalancerRule", params, `{"createloadbalancerruleresponse":{"account":"test-account","algorithm":"test-algorithm","cidrlist":"test-cidrlist","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","name":"test-name","networkid":"test-networkid","privateport":"test-privateport","project":"test-project","projectid":"test-projectid","protocol":"test-protocol","publicip":"test-publicip","publicipid":"test-publicipid","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewCreateLoadBalancerRuleParams(LBAlgorithmLeastConn, "test-name", 10, 13)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAlgorithm(LBAlgorithmLeastConn)
+	p.SetCidrlist([]string{"test-cidrlist1", "test-cidrlist2"})
+	p.SetDescription("test-description")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetOpenfirewall(true)
+	p.SetPrivateport(10)
+	p.SetProtocol(ProtocolAll)
+	p.SetPublicipid("test-publicipid")
+	p.SetPublicport(13)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.CreateLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLoadBalancerRule: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createLoadBalancerRule", params, `<createloadbalancerruleresponse><account>test-account</account><algorithm>test-algorithm</algorithm><cidrlist>test-cidrlist</cidrlist><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><networkid>test-networkid</networkid><privateport>test-privateport</privateport><project>test-project</project><projectid>test-projectid</projectid><protocol>test-protocol</protocol><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><zoneid>test-zoneid</zoneid></createloadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.CreateLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call createLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteLoadBalancerRule", params, `{"deleteloadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteLoadBalancerRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLoadBalancerRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteLoadBalancerRule", params, `<deleteloadbalancerruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteloadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLoadBalancerRules(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"domainid":         {"test-domainid"},
+		"fordisplay":       {"true"},
+		"id":               {"test-id"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"name":             {"test-name"},
+		"networkid":        {"test-networkid"},
+		"page":             {"10"},
+		"pagesize":         {"11"},
+		"projectid":        {"test-projectid"},
+		"publicipid":       {"test-publicipid"},
+		"tags[0].key":      {"test-tagskey"},
+		"tags[0].value":    {"test-tagsvalue"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"zoneid":           {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listLoadBalancerRules", params, `{"listloadbalancerrulesresponse":{"count":1,"loadbalancerrule":[{"account":"test-account","algorithm":"test-algorithm","cidrlist":"test-cidrlist","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","name":"test-name","networkid":"test-networkid","privateport":"test-privateport","project":"test-project","projectid":"test-projectid","protocol":"test-protocol","publicip":"test-publicip","publicipid":"test-publicipid","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListLoadBalancerRulesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(10)
+	p.SetPagesize(11)
+	p.SetProjectid("test-projectid")
+	p.SetPublicipid("test-publicipid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listLoadBalancerRules", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListLoadBalancerRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLoadBalancerRules: %v", err)
+	}
+	if r.LoadBalancerRules[0].Account != "test-account" {
+		t.Errorf("Expected r.LoadBalancerRules[0].Account to be %v, got %v", "test-account", r.LoadBalancerRules[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLoadBalancerRules" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLoadBalancerRules: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLoadBalancerRules: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLoadBalancerRules: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LoadBalancerRule
+	count, err := cs.LoadBalancer.StreamLoadBalancerRules(context.Background(), p, func(v *LoadBalancerRule) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLoadBalancerRules: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LoadBalancerRules) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LoadBalancerRules)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLoadBalancerRules", params, `<listloadbalancerrulesresponse><count>1</count><loadbalancerrule><account>test-account</account><algorithm>test-algorithm</algorithm><cidrlist>test-cidrlist</cidrlist><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><name>test-name</name><networkid>test-networkid</networkid><privateport>test-privateport</privateport><project>test-project</project><projectid>test-projectid</projectid><protocol>test-protocol</protocol><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><zoneid>test-zoneid</zoneid></loadbalancerrule></listloadbalancerrulesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListLoadBalancerRules(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLoadBalancerRules using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamLoadBalancerRules(context.Background(), p, func(v *LoadBalancerRule) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLoadBalancerRules using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LoadBalancerRules) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LoadBalancerRules)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"algorithm":   {"leastconn"},
+		"customid":    {"test-customid"},
+		"description": {"test-description"},
+		"fordisplay":  {"true"},
+		"id":          {"test-id"},
+		"name":        {"test-name"},
+	}
+	cs, done := newTestClient(t, "updateLoadBalancerRule", params, `{"updateloadbalancerruleresponse":{"account":"test-account","algorithm":"test-algorithm","cidrlist":"test-cidrlist","description":"test-description","domain":"test-domain","domainid":"test-domainid","fordisplay":true,"id":"test-id","jobid":"test-jobid","name":"test-name","networkid":"test-networkid","privateport":"test-privateport","project":"test-project","projectid":"test-projectid","protocol":"test-protocol","publicip":"test-publicip","publicipid":"test-publicipid","publicport":"test-publicport","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewUpdateLoadBalancerRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAlgorithm(LBAlgorithmLeastConn)
+	p.SetCustomid("test-customid")
+	p.SetDescription("test-description")
+	p.SetFordisplay(true)
+	p.SetId("test-id")
+	p.SetName("test-name")
+	checkURLValues(t, "updateLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.UpdateLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLoadBalancerRule: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateLoadBalancerRule", params, `<updateloadbalancerruleresponse><account>test-account</account><algorithm>test-algorithm</algorithm><cidrlist>test-cidrlist</cidrlist><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><fordisplay>true</fordisplay><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><networkid>test-networkid</networkid><privateport>test-privateport</privateport><project>test-project</project><projectid>test-projectid</projectid><protocol>test-protocol</protocol><publicip>test-publicip</publicip><publicipid>test-publicipid</publicipid><publicport>test-publicport</publicport><state>test-state</state><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><zoneid>test-zoneid</zoneid></updateloadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.UpdateLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListLoadBalancerRuleInstances(t *testing.T) {
+	params := url.Values{
+		"applied":  {"true"},
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"lbvmips":  {"true"},
+		"page":     {"5"},
+		"pagesize": {"6"},
+	}
+	cs, done := newTestClient(t, "listLoadBalancerRuleInstances", params, `{"listloadbalancerruleinstancesresponse":{"count":1,"loadbalancerruleinstance":[{"lbvmipaddresses":["test-lbvmipaddresses"],"loadbalancerruleinstance":"test-loadbalancerruleinstance"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListLoadBalancerRuleInstancesParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetApplied(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetLbvmips(true)
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listLoadBalancerRuleInstances", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListLoadBalancerRuleInstances(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLoadBalancerRuleInstances: %v", err)
+	}
+	if r.LoadBalancerRuleInstances[0].Loadbalancerruleinstance != "test-loadbalancerruleinstance" {
+		t.Errorf("Expected r.LoadBalancerRuleInstances[0].Loadbalancerruleinstance to be %v, got %v", "test-loadbalancerruleinstance", r.LoadBalancerRuleInstances[0].Loadbalancerruleinstance)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listLoadBalancerRuleInstances" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listLoadBalancerRuleInstances: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listLoadBalancerRuleInstances: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listLoadBalancerRuleInstances: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*LoadBalancerRuleInstance
+	count, err := cs.LoadBalancer.StreamLoadBalancerRuleInstances(context.Background(), p, func(v *LoadBalancerRuleInstance) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listLoadBalancerRuleInstances: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.LoadBalancerRuleInstances) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.LoadBalancerRuleInstances)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listLoadBalancerRuleInstances", params, `<listloadbalancerruleinstancesresponse><count>1</count><loadbalancerruleinstance><lbvmipaddresses>test-lbvmipaddresses</lbvmipaddresses><loadbalancerruleinstance>test-loadbalancerruleinstance</loadbalancerruleinstance></loadbalancerruleinstance></listloadbalancerruleinstancesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListLoadBalancerRuleInstances(p)
+	if err != nil {
+		t.Fatalf("Failed to call listLoadBalancerRuleInstances using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamLoadBalancerRuleInstances(context.Background(), p, func(v *LoadBalancerRuleInstance) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listLoadBalancerRuleInstances using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.LoadBalancerRuleInstances) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.LoadBalancerRuleInstances)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddNetscalerLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"gslbprovider":            {"true"},
+		"gslbproviderprivateip":   {"test-gslbproviderprivateip"},
+		"gslbproviderpublicip":    {"test-gslbproviderpublicip"},
+		"isexclusivegslbprovider": {"true"},
+		"networkdevicetype":       {"test-networkdevicetype"},
+		"password":                {"test-password"},
+		"physicalnetworkid":       {"test-physicalnetworkid"},
+		"url":                     {"test-url"},
+		"username":                {"test-username"},
+	}
+	cs, done := newTestClient(t, "addNetscalerLoadBalancer", params, `{"addnetscalerloadbalancerresponse":{"gslbprovider":true,"gslbproviderprivateip":"test-gslbproviderprivateip","gslbproviderpublicip":"test-gslbproviderpublicip","ipaddress":"test-ipaddress","isexclusivegslbprovider":true,"jobid":"test-jobid","lbdevicecapacity":1,"lbdevicededicated":true,"lbdeviceid":"test-lbdeviceid","lbdevicename":"test-lbdevicename","lbdevicestate":"test-lbdevicestate","physicalnetworkid":"test-physicalnetworkid","podids":["test-podids"],"privateinterface":"test-privateinterface","provider":"test-provider","publicinterface":"test-publicinterface"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewAddNetscalerLoadBalancerParams("test-networkdevicetype", "test-password", "test-physicalnetworkid", "test-url", "test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetGslbprovider(true)
+	p.SetGslbproviderprivateip("test-gslbproviderprivateip")
+	p.SetGslbproviderpublicip("test-gslbproviderpublicip")
+	p.SetIsexclusivegslbprovider(true)
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	checkURLValues(t, "addNetscalerLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.AddNetscalerLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNetscalerLoadBalancer: %v", err)
+	}
+	if r.Gslbproviderprivateip != "test-gslbproviderprivateip" {
+		t.Errorf("Expected r.Gslbproviderprivateip to be %v, got %v", "test-gslbproviderprivateip", r.Gslbproviderprivateip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addNetscalerLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addNetscalerLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addNetscalerLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addNetscalerLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addNetscalerLoadBalancer", params, `<addnetscalerloadbalancerresponse><gslbprovider>true</gslbprovider><gslbproviderprivateip>test-gslbproviderprivateip</gslbproviderprivateip><gslbproviderpublicip>test-gslbproviderpublicip</gslbproviderpublicip><ipaddress>test-ipaddress</ipaddress><isexclusivegslbprovider>true</isexclusivegslbprovider><jobid>test-jobid</jobid><lbdevicecapacity>1</lbdevicecapacity><lbdevicededicated>true</lbdevicededicated><lbdeviceid>test-lbdeviceid</lbdeviceid><lbdevicename>test-lbdevicename</lbdevicename><lbdevicestate>test-lbdevicestate</lbdevicestate><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><podids>test-podids</podids><privateinterface>test-privateinterface</privateinterface><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface></addnetscalerloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.AddNetscalerLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNetscalerLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestConfigureNetscalerLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"inline":            {"true"},
+		"lbdevicecapacity":  {"2"},
+		"lbdevicededicated": {"true"},
+		"lbdeviceid":        {"test-lbdeviceid"},
+		"podids":            {"test-podids1, test-podids2"},
+	}
+	cs, done := newTestClient(t, "configureNetscalerLoadBalancer", params, `{"configurenetscalerloadbalancerresponse":{"gslbprovider":true,"gslbproviderprivateip":"test-gslbproviderprivateip","gslbproviderpublicip":"test-gslbproviderpublicip","ipaddress":"test-ipaddress","isexclusivegslbprovider":true,"jobid":"test-jobid","lbdevicecapacity":1,"lbdevicededicated":true,"lbdeviceid":"test-lbdeviceid","lbdevicename":"test-lbdevicename","lbdevicestate":"test-lbdevicestate","physicalnetworkid":"test-physicalnetworkid","podids":["test-podids"],"privateinterface":"test-privateinterface","provider":"test-provider","publicinterface":"test-publicinterface"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewConfigureNetscalerLoadBalancerParams("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetInline(true)
+	p.SetLbdevicecapacity(2)
+	p.SetLbdevicededicated(true)
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPodids([]string{"test-podids1", "test-podids2"})
+	checkURLValues(t, "configureNetscalerLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ConfigureNetscalerLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureNetscalerLoadBalancer: %v", err)
+	}
+	if r.Gslbproviderprivateip != "test-gslbproviderprivateip" {
+		t.Errorf("Expected r.Gslbproviderprivateip to be %v, got %v", "test-gslbproviderprivateip", r.Gslbproviderprivateip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "configureNetscalerLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute configureNetscalerLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of configureNetscalerLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of configureNetscalerLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "configureNetscalerLoadBalancer", params, `<configurenetscalerloadbalancerresponse><gslbprovider>true</gslbprovider><gslbproviderprivateip>test-gslbproviderprivateip</gslbproviderprivateip><gslbproviderpublicip>test-gslbproviderpublicip</gslbproviderpublicip><ipaddress>test-ipaddress</ipaddress><isexclusivegslbprovider>true</isexclusivegslbprovider><jobid>test-jobid</jobid><lbdevicecapacity>1</lbdevicecapacity><lbdevicededicated>true</lbdevicededicated><lbdeviceid>test-lbdeviceid</lbdeviceid><lbdevicename>test-lbdevicename</lbdevicename><lbdevicestate>test-lbdevicestate</lbdevicestate><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><podids>test-podids</podids><privateinterface>test-privateinterface</privateinterface><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface></configurenetscalerloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ConfigureNetscalerLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureNetscalerLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetscalerLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"lbdeviceid": {"test-lbdeviceid"},
+	}
+	cs, done := newTestClient(t, "deleteNetscalerLoadBalancer", params, `{"deletenetscalerloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteNetscalerLoadBalancerParams("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetLbdeviceid("test-lbdeviceid")
+	checkURLValues(t, "deleteNetscalerLoadBalancer", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteNetscalerLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetscalerLoadBalancer: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetscalerLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetscalerLoadBalancer: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetscalerLoadBalancer: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetscalerLoadBalancer: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetscalerLoadBalancer", params, `<deletenetscalerloadbalancerresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletenetscalerloadbalancerresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteNetscalerLoadBalancer(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetscalerLoadBalancer using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetscalerLoadBalancers(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"lbdeviceid":        {"test-lbdeviceid"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "listNetscalerLoadBalancers", params, `{"listnetscalerloadbalancersresponse":{"count":1,"netscalerloadbalancer":[{"gslbprovider":true,"gslbproviderprivateip":"test-gslbproviderprivateip","gslbproviderpublicip":"test-gslbproviderpublicip","ipaddress":"test-ipaddress","isexclusivegslbprovider":true,"lbdevicecapacity":1,"lbdevicededicated":true,"lbdeviceid":"test-lbdeviceid","lbdevicename":"test-lbdevicename","lbdevicestate":"test-lbdevicestate","physicalnetworkid":"test-physicalnetworkid","podids":["test-podids"],"privateinterface":"test-privateinterface","provider":"test-provider","publicinterface":"test-publicinterface"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListNetscalerLoadBalancersParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "listNetscalerLoadBalancers", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListNetscalerLoadBalancers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetscalerLoadBalancers: %v", err)
+	}
+	if r.NetscalerLoadBalancers[0].Gslbproviderprivateip != "test-gslbproviderprivateip" {
+		t.Errorf("Expected r.NetscalerLoadBalancers[0].Gslbproviderprivateip to be %v, got %v", "test-gslbproviderprivateip", r.NetscalerLoadBalancers[0].Gslbproviderprivateip)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetscalerLoadBalancers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetscalerLoadBalancers: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetscalerLoadBalancers: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetscalerLoadBalancers: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetscalerLoadBalancer
+	count, err := cs.LoadBalancer.StreamNetscalerLoadBalancers(context.Background(), p, func(v *NetscalerLoadBalancer) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetscalerLoadBalancers: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetscalerLoadBalancers) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetscalerLoadBalancers)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetscalerLoadBalancers", params, `<listnetscalerloadbalancersresponse><count>1</count><netscalerloadbalancer><gslbprovider>true</gslbprovider><gslbproviderprivateip>test-gslbproviderprivateip</gslbproviderprivateip><gslbproviderpublicip>test-gslbproviderpublicip</gslbproviderpublicip><ipaddress>test-ipaddress</ipaddress><isexclusivegslbprovider>true</isexclusivegslbprovider><lbdevicecapacity>1</lbdevicecapacity><lbdevicededicated>true</lbdevicededicated><lbdeviceid>test-lbdeviceid</lbdeviceid><lbdevicename>test-lbdevicename</lbdevicename><lbdevicestate>test-lbdevicestate</lbdevicestate><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><podids>test-podids</podids><privateinterface>test-privateinterface</privateinterface><provider>test-provider</provider><publicinterface>test-publicinterface</publicinterface></netscalerloadbalancer></listnetscalerloadbalancersresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListNetscalerLoadBalancers(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetscalerLoadBalancers using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamNetscalerLoadBalancers(context.Background(), p, func(v *NetscalerLoadBalancer) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetscalerLoadBalancers using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetscalerLoadBalancers) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetscalerLoadBalancers)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteSslCert(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteSslCert", params, `{"deletesslcertresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewDeleteSslCertParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteSslCert", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.DeleteSslCert(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSslCert: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSslCert" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteSslCert: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteSslCert: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteSslCert: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteSslCert", params, `<deletesslcertresponse><displaytext>test-displaytext</displaytext><success>true</success></deletesslcertresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.DeleteSslCert(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteSslCert using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListSslCerts(t *testing.T) {
+	params := url.Values{
+		"accountid": {"test-accountid"},
+		"certid":    {"test-certid"},
+		"lbruleid":  {"test-lbruleid"},
+		"projectid": {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "listSslCerts", params, `{"listsslcertsresponse":{"count":1,"sslcert":[{"account":"test-account","certchain":"test-certchain","certificate":"test-certificate","domain":"test-domain","domainid":"test-domainid","fingerprint":"test-fingerprint","id":"test-id","loadbalancerrulelist":["test-loadbalancerrulelist"],"project":"test-project","projectid":"test-projectid"}]}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewListSslCertsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccountid("test-accountid")
+	p.SetCertid("test-certid")
+	p.SetLbruleid("test-lbruleid")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "listSslCerts", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.ListSslCerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSslCerts: %v", err)
+	}
+	if r.SslCerts[0].Account != "test-account" {
+		t.Errorf("Expected r.SslCerts[0].Account to be %v, got %v", "test-account", r.SslCerts[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listSslCerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listSslCerts: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listSslCerts: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listSslCerts: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*SslCert
+	count, err := cs.LoadBalancer.StreamSslCerts(context.Background(), p, func(v *SslCert) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listSslCerts: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.SslCerts) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.SslCerts)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listSslCerts", params, `<listsslcertsresponse><count>1</count><sslcert><account>test-account</account><certchain>test-certchain</certchain><certificate>test-certificate</certificate><domain>test-domain</domain><domainid>test-domainid</domainid><fingerprint>test-fingerprint</fingerprint><id>test-id</id><loadbalancerrulelist>test-loadbalancerrulelist</loadbalancerrulelist><project>test-project</project><projectid>test-projectid</projectid></sslcert></listsslcertsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.ListSslCerts(p)
+	if err != nil {
+		t.Fatalf("Failed to call listSslCerts using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.LoadBalancer.StreamSslCerts(context.Background(), p, func(v *SslCert) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listSslCerts using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.SslCerts) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.SslCerts)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUploadSslCert(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"certchain":   {"test-certchain"},
+		"certificate": {"test-certificate"},
+		"domainid":    {"test-domainid"},
+		"password":    {"test-password"},
+		"privatekey":  {"test-privatekey"},
+		"projectid":   {"test-projectid"},
+	}
+	cs, done := newTestClient(t, "uploadSslCert", params, `{"uploadsslcertresponse":{"account":"test-account","certchain":"test-certchain","certificate":"test-certificate","domain":"test-domain","domainid":"test-domainid","fingerprint":"test-fingerprint","id":"test-id","loadbalancerrulelist":["test-loadbalancerrulelist"],"project":"test-project","projectid":"test-projectid"}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewUploadSslCertParams("test-certificate", "test-privatekey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCertchain("test-certchain")
+	p.SetCertificate("test-certificate")
+	p.SetDomainid("test-domainid")
+	p.SetPassword("test-password")
+	p.SetPrivatekey("test-privatekey")
+	p.SetProjectid("test-projectid")
+	checkURLValues(t, "uploadSslCert", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.UploadSslCert(p)
+	if err != nil {
+		t.Fatalf("Failed to call uploadSslCert: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "uploadSslCert" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute uploadSslCert: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of uploadSslCert: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of uploadSslCert: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "uploadSslCert", params, `<uploadsslcertresponse><account>test-account</account><certchain>test-certchain</certchain><certificate>test-certificate</certificate><domain>test-domain</domain><domainid>test-domainid</domainid><fingerprint>test-fingerprint</fingerprint><id>test-id</id><loadbalancerrulelist>test-loadbalancerrulelist</loadbalancerrulelist><project>test-project</project><projectid>test-projectid</projectid></uploadsslcertresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.UploadSslCert(p)
+	if err != nil {
+		t.Fatalf("Failed to call uploadSslCert using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAssignToGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"gslblbruleweightsmap[0].loadbalancerid": {"test-gslblbruleweightsmapkey"},
+		"gslblbruleweightsmap[0].weight":         {"test-gslblbruleweightsmapvalue"},
+		"id":                                     {"test-id"},
+		"loadbalancerrulelist":                   {"test-loadbalancerrulelist1, test-loadbalancerrulelist2"},
+	}
+	cs, done := newTestClient(t, "assignToGlobalLoadBalancerRule", params, `{"assigntogloballoadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewAssignToGlobalLoadBalancerRuleParams("test-id", []string{"test-loadbalancerrulelist1", "test-loadbalancerrulelist2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetGslblbruleweightsmap(map[string]string{"test-gslblbruleweightsmapkey": "test-gslblbruleweightsmapvalue"})
+	p.SetId("test-id")
+	p.SetLoadbalancerrulelist([]string{"test-loadbalancerrulelist1", "test-loadbalancerrulelist2"})
+	checkURLValues(t, "assignToGlobalLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.AssignToGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call assignToGlobalLoadBalancerRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "assignToGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute assignToGlobalLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of assignToGlobalLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of assignToGlobalLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "assignToGlobalLoadBalancerRule", params, `<assigntogloballoadbalancerruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></assigntogloballoadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.AssignToGlobalLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call assignToGlobalLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAssignToLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id":                {"test-id"},
+		"virtualmachineids": {"test-virtualmachineids1, test-virtualmachineids2"},
+		"vmidipmap[0].vmid": {"test-vmid"},
+		"vmidipmap[0].vmip": {"test-vmip"},
+	}
+	cs, done := newTestClient(t, "assignToLoadBalancerRule", params, `{"assigntoloadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.LoadBalancer.NewAssignToLoadBalancerRuleParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetVirtualmachineids([]string{"test-virtualmachineids1", "test-virtualmachineids2"})
+	p.SetVmidipmap([]VMIPMapping{{Vmid: "test-vmid", Vmip: "test-vmip"}})
+	checkURLValues(t, "assignToLoadBalancerRule", p.URLValues(), params)
+
+	r, err := cs.LoadBalancer.AssignToLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call assignToLoadBalancerRule: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "assignToLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute assignToLoadBalancerRule: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of assignToLoadBalancerRule: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of assignToLoadBalancerRule: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "assignToLoadBalancerRule", params, `<assigntoloadbalancerruleresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></assigntoloadbalancerruleresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.LoadBalancer.AssignToLoadBalancerRule(p)
+	if err != nil {
+		t.Fatalf("Failed to call assignToLoadBalancerRule using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -15,3 +15,12 @@
 //
 
 package cloudstack44
+
+// Deprecated: This service has no methods, as its commands are not part of the API details of this version.
+type LogoutService struct {
+	cs *CloudStackClient
+}
+
+func NewLogoutService(cs *CloudStackClient) *LogoutService {
+	return &LogoutService{cs: cs}
+}
@@ -0,0 +1,236 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddNetworkDevice(t *testing.T) {
+	params := url.Values{
+		"networkdeviceparameterlist[0].test-networkdeviceparameterlistkey": {"test-networkdeviceparameterlistvalue"},
+		"networkdevicetype": {"test-networkdevicetype"},
+	}
+	cs, done := newTestClient(t, "addNetworkDevice", params, `{"addnetworkdeviceresponse":{"id":"test-id"}}`)
+	defer done()
+
+	p := cs.NetworkDevice.NewAddNetworkDeviceParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetNetworkdeviceparameterlist(map[string]string{"test-networkdeviceparameterlistkey": "test-networkdeviceparameterlistvalue"})
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	checkURLValues(t, "addNetworkDevice", p.URLValues(), params)
+
+	r, err := cs.NetworkDevice.AddNetworkDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNetworkDevice: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addNetworkDevice" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addNetworkDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addNetworkDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addNetworkDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addNetworkDevice", params, `<addnetworkdeviceresponse><id>test-id</id></addnetworkdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkDevice.AddNetworkDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call addNetworkDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteNetworkDevice(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteNetworkDevice", params, `{"deletenetworkdeviceresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.NetworkDevice.NewDeleteNetworkDeviceParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteNetworkDevice", p.URLValues(), params)
+
+	r, err := cs.NetworkDevice.DeleteNetworkDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkDevice: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkDevice" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteNetworkDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteNetworkDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteNetworkDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteNetworkDevice", params, `<deletenetworkdeviceresponse><displaytext>test-displaytext</displaytext><success>true</success></deletenetworkdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkDevice.DeleteNetworkDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteNetworkDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListNetworkDevice(t *testing.T) {
+	params := url.Values{
+		"keyword": {"test-keyword"},
+		"networkdeviceparameterlist[0].test-networkdeviceparameterlistkey": {"test-networkdeviceparameterlistvalue"},
+		"networkdevicetype": {"test-networkdevicetype"},
+		"page":              {"4"},
+		"pagesize":          {"5"},
+	}
+	cs, done := newTestClient(t, "listNetworkDevice", params, `{"listnetworkdeviceresponse":{"count":1,"networkdevice":[{"id":"test-id"}]}}`)
+	defer done()
+
+	p := cs.NetworkDevice.NewListNetworkDeviceParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetNetworkdeviceparameterlist(map[string]string{"test-networkdeviceparameterlistkey": "test-networkdeviceparameterlistvalue"})
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	checkURLValues(t, "listNetworkDevice", p.URLValues(), params)
+
+	r, err := cs.NetworkDevice.ListNetworkDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkDevice: %v", err)
+	}
+	if r.NetworkDevice[0].Id != "test-id" {
+		t.Errorf("Expected r.NetworkDevice[0].Id to be %v, got %v", "test-id", r.NetworkDevice[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkDevice" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listNetworkDevice: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listNetworkDevice: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listNetworkDevice: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*NetworkDevice
+	count, err := cs.NetworkDevice.StreamNetworkDevice(context.Background(), p, func(v *NetworkDevice) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listNetworkDevice: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.NetworkDevice) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.NetworkDevice)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listNetworkDevice", params, `<listnetworkdeviceresponse><count>1</count><networkdevice><id>test-id</id></networkdevice></listnetworkdeviceresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.NetworkDevice.ListNetworkDevice(p)
+	if err != nil {
+		t.Fatalf("Failed to call listNetworkDevice using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.NetworkDevice.StreamNetworkDevice(context.Background(), p, func(v *NetworkDevice) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listNetworkDevice using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.NetworkDevice) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.NetworkDevice)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,798 +17,1195 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateNetworkOfferingParams struct {
-	p map[string]interface{}
+type NetworkOfferingService struct {
+	cs *CloudStackClient
+}
+
+func NewNetworkOfferingService(cs *CloudStackClient) *NetworkOfferingService {
+	return &NetworkOfferingService{cs: cs}
 }
 
-func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
+type CreateNetworkOfferingParams struct {
+	Availability          *string             `json:"availability,omitempty" yaml:"availability,omitempty"`
+	Conservemode          *bool               `json:"conservemode,omitempty" yaml:"conservemode,omitempty"`
+	Details               map[string]string   `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string             `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Egressdefaultpolicy   *bool               `json:"egressdefaultpolicy,omitempty" yaml:"egressdefaultpolicy,omitempty"`
+	Guestiptype           *string             `json:"guestiptype,omitempty" yaml:"guestiptype,omitempty"`
+	Ispersistent          *bool               `json:"ispersistent,omitempty" yaml:"ispersistent,omitempty"`
+	Keepaliveenabled      *bool               `json:"keepaliveenabled,omitempty" yaml:"keepaliveenabled,omitempty"`
+	Maxconnections        *int                `json:"maxconnections,omitempty" yaml:"maxconnections,omitempty"`
+	Name                  *string             `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkrate           *int                `json:"networkrate,omitempty" yaml:"networkrate,omitempty"`
+	Servicecapabilitylist []ServiceCapability `json:"servicecapabilitylist,omitempty" yaml:"servicecapabilitylist,omitempty"`
+	Serviceofferingid     *string             `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Serviceproviderlist   []ServiceProvider   `json:"serviceproviderlist,omitempty" yaml:"serviceproviderlist,omitempty"`
+	Specifyipranges       *bool               `json:"specifyipranges,omitempty" yaml:"specifyipranges,omitempty"`
+	Specifyvlan           *bool               `json:"specifyvlan,omitempty" yaml:"specifyvlan,omitempty"`
+	Supportedservices     []string            `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags                  *string             `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype           *NetworkTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateNetworkOfferingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["availability"]; found {
-		u.Set("availability", v.(string))
+	if v := p.Availability; v != nil {
+		u.Set("availability", *v)
 	}
-	if v, found := p.p["conservemode"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Conservemode; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("conservemode", vv)
 	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
 		}
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["egressdefaultpolicy"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Egressdefaultpolicy; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("egressdefaultpolicy", vv)
 	}
-	if v, found := p.p["guestiptype"]; found {
-		u.Set("guestiptype", v.(string))
+	if v := p.Guestiptype; v != nil {
+		u.Set("guestiptype", *v)
 	}
-	if v, found := p.p["ispersistent"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Ispersistent; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("ispersistent", vv)
 	}
-	if v, found := p.p["keepaliveenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Keepaliveenabled; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("keepaliveenabled", vv)
 	}
-	if v, found := p.p["maxconnections"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Maxconnections; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("maxconnections", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkrate"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Networkrate; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("networkrate", vv)
 	}
-	if v, found := p.p["servicecapabilitylist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), vv)
-			i++
+	if v := p.Servicecapabilitylist; v != nil {
+		for i, vv := range v {
+			if vv.Service != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].service", i), vv.Service)
+			}
+			if vv.Capabilitytype != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilitytype", i), vv.Capabilitytype)
+			}
+			if vv.Capabilityvalue != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilityvalue", i), vv.Capabilityvalue)
+			}
 		}
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
 	}
-	if v, found := p.p["serviceproviderlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].value", i), vv)
-			i++
+	if v := p.Serviceproviderlist; v != nil {
+		for i, vv := range v {
+			if vv.Service != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), vv.Service)
+			}
+			if vv.Provider != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), vv.Provider)
+			}
 		}
 	}
-	if v, found := p.p["specifyipranges"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Specifyipranges; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("specifyipranges", vv)
 	}
-	if v, found := p.p["specifyvlan"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Specifyvlan; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("specifyvlan", vv)
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Supportedservices; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("supportedservices", vv)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if v := p.Tags; v != nil {
+		u.Set("tags", *v)
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
 	}
 	return u
 }
 
-func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *CreateNetworkOfferingParams) Command() string {
+	return "createNetworkOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateNetworkOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateNetworkOfferingParams) ResponseType() interface{} {
+	return &CreateNetworkOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateNetworkOfferingParams) Validate() error {
+	v := &validator{api: "createNetworkOffering"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("guestiptype", p.Guestiptype != nil)
+	v.required("name", p.Name != nil)
+	v.required("supportedservices", p.Supportedservices != nil)
+	v.required("traffictype", p.Traffictype != nil)
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
 	}
-	p.p["availability"] = v
+	return v.err()
+}
+
+func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
+	p.Availability = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetAvailability() string {
+	if p.Availability == nil {
+		return ""
 	}
-	p.p["conservemode"] = v
+	return *p.Availability
+}
+
+func (p *CreateNetworkOfferingParams) HasAvailability() bool {
+	return p.Availability != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetAvailability() {
+	p.Availability = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetConservemode(v bool) {
+	p.Conservemode = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetConservemode() bool {
+	if p.Conservemode == nil {
+		return false
 	}
-	p.p["details"] = v
+	return *p.Conservemode
+}
+
+func (p *CreateNetworkOfferingParams) HasConservemode() bool {
+	return p.Conservemode != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetConservemode() {
+	p.Conservemode = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetDetails(v map[string]string) {
+	p.Details = v
 	return
 }
 
+func (p *CreateNetworkOfferingParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *CreateNetworkOfferingParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetDetails() {
+	p.Details = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
 func (p *CreateNetworkOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.Displaytext = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["egressdefaultpolicy"] = v
+	return *p.Displaytext
+}
+
+func (p *CreateNetworkOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetEgressdefaultpolicy(v bool) {
+	p.Egressdefaultpolicy = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetEgressdefaultpolicy() bool {
+	if p.Egressdefaultpolicy == nil {
+		return false
 	}
-	p.p["guestiptype"] = v
+	return *p.Egressdefaultpolicy
+}
+
+func (p *CreateNetworkOfferingParams) HasEgressdefaultpolicy() bool {
+	return p.Egressdefaultpolicy != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetEgressdefaultpolicy() {
+	p.Egressdefaultpolicy = nil
+}
+
+// This param is required.
+func (p *CreateNetworkOfferingParams) SetGuestiptype(v string) {
+	p.Guestiptype = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetGuestiptype() string {
+	if p.Guestiptype == nil {
+		return ""
 	}
-	p.p["ispersistent"] = v
+	return *p.Guestiptype
+}
+
+func (p *CreateNetworkOfferingParams) HasGuestiptype() bool {
+	return p.Guestiptype != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetGuestiptype() {
+	p.Guestiptype = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetIspersistent(v bool) {
+	p.Ispersistent = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetIspersistent() bool {
+	if p.Ispersistent == nil {
+		return false
 	}
-	p.p["keepaliveenabled"] = v
+	return *p.Ispersistent
+}
+
+func (p *CreateNetworkOfferingParams) HasIspersistent() bool {
+	return p.Ispersistent != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetIspersistent() {
+	p.Ispersistent = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
+	p.Keepaliveenabled = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetKeepaliveenabled() bool {
+	if p.Keepaliveenabled == nil {
+		return false
 	}
-	p.p["maxconnections"] = v
+	return *p.Keepaliveenabled
+}
+
+func (p *CreateNetworkOfferingParams) HasKeepaliveenabled() bool {
+	return p.Keepaliveenabled != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetKeepaliveenabled() {
+	p.Keepaliveenabled = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetMaxconnections(v int) {
+	p.Maxconnections = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetMaxconnections() int {
+	if p.Maxconnections == nil {
+		return 0
 	}
-	p.p["name"] = v
+	return *p.Maxconnections
+}
+
+func (p *CreateNetworkOfferingParams) HasMaxconnections() bool {
+	return p.Maxconnections != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetMaxconnections() {
+	p.Maxconnections = nil
+}
+
+// This param is required.
+func (p *CreateNetworkOfferingParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["networkrate"] = v
+	return *p.Name
+}
+
+func (p *CreateNetworkOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetNetworkrate(v int) {
+	p.Networkrate = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetNetworkrate() int {
+	if p.Networkrate == nil {
+		return 0
 	}
-	p.p["servicecapabilitylist"] = v
+	return *p.Networkrate
+}
+
+func (p *CreateNetworkOfferingParams) HasNetworkrate() bool {
+	return p.Networkrate != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetNetworkrate() {
+	p.Networkrate = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetServicecapabilitylist(v []ServiceCapability) {
+	p.Servicecapabilitylist = v
 	return
 }
 
+func (p *CreateNetworkOfferingParams) GetServicecapabilitylist() []ServiceCapability {
+	return p.Servicecapabilitylist
+}
+
+func (p *CreateNetworkOfferingParams) HasServicecapabilitylist() bool {
+	return p.Servicecapabilitylist != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetServicecapabilitylist() {
+	p.Servicecapabilitylist = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering.
 func (p *CreateNetworkOfferingParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["serviceofferingid"] = v
+	p.Serviceofferingid = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
 	}
-	p.p["serviceproviderlist"] = v
+	return *p.Serviceofferingid
+}
+
+func (p *CreateNetworkOfferingParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetServiceproviderlist(v []ServiceProvider) {
+	p.Serviceproviderlist = v
 	return
 }
 
+func (p *CreateNetworkOfferingParams) GetServiceproviderlist() []ServiceProvider {
+	return p.Serviceproviderlist
+}
+
+func (p *CreateNetworkOfferingParams) HasServiceproviderlist() bool {
+	return p.Serviceproviderlist != nil
+}
+
+func (p *CreateNetworkOfferingParams) ResetServiceproviderlist() {
+	p.Serviceproviderlist = nil
+}
+
 func (p *CreateNetworkOfferingParams) SetSpecifyipranges(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["specifyipranges"] = v
+	p.Specifyipranges = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetSpecifyipranges() bool {
+	if p.Specifyipranges == nil {
+		return false
 	}
-	p.p["specifyvlan"] = v
-	return
+	return *p.Specifyipranges
 }
 
-func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
-	return
+func (p *CreateNetworkOfferingParams) HasSpecifyipranges() bool {
+	return p.Specifyipranges != nil
 }
 
-func (p *CreateNetworkOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+func (p *CreateNetworkOfferingParams) ResetSpecifyipranges() {
+	p.Specifyipranges = nil
+}
+
+func (p *CreateNetworkOfferingParams) SetSpecifyvlan(v bool) {
+	p.Specifyvlan = &v
 	return
 }
 
-func (p *CreateNetworkOfferingParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetSpecifyvlan() bool {
+	if p.Specifyvlan == nil {
+		return false
 	}
-	p.p["traffictype"] = v
-	return
+	return *p.Specifyvlan
 }
 
-// You should always use this function to get a new CreateNetworkOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype string) *CreateNetworkOfferingParams {
-	p := &CreateNetworkOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["guestiptype"] = guestiptype
-	p.p["name"] = name
-	p.p["supportedservices"] = supportedservices
-	p.p["traffictype"] = traffictype
-	return p
+func (p *CreateNetworkOfferingParams) HasSpecifyvlan() bool {
+	return p.Specifyvlan != nil
 }
 
-// Creates a network offering.
-func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createNetworkOffering", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateNetworkOfferingParams) ResetSpecifyvlan() {
+	p.Specifyvlan = nil
+}
 
-	var r CreateNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// This param is required.
+func (p *CreateNetworkOfferingParams) SetSupportedservices(v []string) {
+	p.Supportedservices = v
+	return
 }
 
-type CreateNetworkOfferingResponse struct {
-	Availability        string            `json:"availability,omitempty"`
-	Conservemode        bool              `json:"conservemode,omitempty"`
-	Created             string            `json:"created,omitempty"`
-	Details             map[string]string `json:"details,omitempty"`
-	Displaytext         string            `json:"displaytext,omitempty"`
-	Egressdefaultpolicy bool              `json:"egressdefaultpolicy,omitempty"`
-	Forvpc              bool              `json:"forvpc,omitempty"`
-	Guestiptype         string            `json:"guestiptype,omitempty"`
-	Id                  string            `json:"id,omitempty"`
-	Isdefault           bool              `json:"isdefault,omitempty"`
-	Ispersistent        bool              `json:"ispersistent,omitempty"`
-	Maxconnections      int               `json:"maxconnections,omitempty"`
-	Name                string            `json:"name,omitempty"`
-	Networkrate         int               `json:"networkrate,omitempty"`
-	Service             []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Serviceofferingid        string `json:"serviceofferingid,omitempty"`
-	Specifyipranges          bool   `json:"specifyipranges,omitempty"`
-	Specifyvlan              bool   `json:"specifyvlan,omitempty"`
-	State                    string `json:"state,omitempty"`
-	Supportsstrechedl2subnet bool   `json:"supportsstrechedl2subnet,omitempty"`
-	Tags                     string `json:"tags,omitempty"`
-	Traffictype              string `json:"traffictype,omitempty"`
+func (p *CreateNetworkOfferingParams) GetSupportedservices() []string {
+	return p.Supportedservices
 }
 
-type UpdateNetworkOfferingParams struct {
-	p map[string]interface{}
+func (p *CreateNetworkOfferingParams) HasSupportedservices() bool {
+	return p.Supportedservices != nil
 }
 
-func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["availability"]; found {
-		u.Set("availability", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keepaliveenabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("keepaliveenabled", vv)
-	}
-	if v, found := p.p["maxconnections"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("maxconnections", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	return u
+func (p *CreateNetworkOfferingParams) ResetSupportedservices() {
+	p.Supportedservices = nil
 }
 
-func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["availability"] = v
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *CreateNetworkOfferingParams) SetTags(v string) {
+	p.Tags = &v
 	return
 }
 
-func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetTags() string {
+	if p.Tags == nil {
+		return ""
 	}
-	p.p["displaytext"] = v
-	return
+	return *p.Tags
 }
 
-func (p *UpdateNetworkOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *CreateNetworkOfferingParams) HasTags() bool {
+	return p.Tags != nil
 }
 
-func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keepaliveenabled"] = v
-	return
+func (p *CreateNetworkOfferingParams) ResetTags() {
+	p.Tags = nil
 }
 
-func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxconnections"] = v
+// This param is required.
+func (p *CreateNetworkOfferingParams) SetTraffictype(v NetworkTrafficType) {
+	p.Traffictype = &v
 	return
 }
 
-func (p *UpdateNetworkOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateNetworkOfferingParams) GetTraffictype() NetworkTrafficType {
+	if p.Traffictype == nil {
+		return ""
 	}
-	p.p["name"] = v
-	return
+	return *p.Traffictype
 }
 
-func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
-	return
+func (p *CreateNetworkOfferingParams) HasTraffictype() bool {
+	return p.Traffictype != nil
 }
 
-func (p *UpdateNetworkOfferingParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
+func (p *CreateNetworkOfferingParams) ResetTraffictype() {
+	p.Traffictype = nil
 }
 
-// You should always use this function to get a new UpdateNetworkOfferingParams instance,
+// You should always use this function to get a new CreateNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams {
-	p := &UpdateNetworkOfferingParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - guestiptype
+//   - name
+//   - supportedservices
+//   - traffictype
+func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, supportedservices []string, traffictype NetworkTrafficType) *CreateNetworkOfferingParams {
+	p := &CreateNetworkOfferingParams{}
+	p.SetDisplaytext(displaytext)
+	p.SetGuestiptype(guestiptype)
+	p.SetName(name)
+	p.SetSupportedservices(supportedservices)
+	p.SetTraffictype(traffictype)
 	return p
 }
 
-// Updates a network offering.
-func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateNetworkOffering", p.toURLValues())
+// Creates a network offering.
+//
+// See also ListNetworkOfferings, GetNetworkOfferingID, GetNetworkOfferingByName, GetNetworkOfferingByID.
+func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createNetworkOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateNetworkOfferingResponse
+	if err := s.cs.unmarshal("createNetworkOffering", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type UpdateNetworkOfferingResponse struct {
-	Availability        string            `json:"availability,omitempty"`
-	Conservemode        bool              `json:"conservemode,omitempty"`
-	Created             string            `json:"created,omitempty"`
-	Details             map[string]string `json:"details,omitempty"`
-	Displaytext         string            `json:"displaytext,omitempty"`
-	Egressdefaultpolicy bool              `json:"egressdefaultpolicy,omitempty"`
-	Forvpc              bool              `json:"forvpc,omitempty"`
-	Guestiptype         string            `json:"guestiptype,omitempty"`
-	Id                  string            `json:"id,omitempty"`
-	Isdefault           bool              `json:"isdefault,omitempty"`
-	Ispersistent        bool              `json:"ispersistent,omitempty"`
-	Maxconnections      int               `json:"maxconnections,omitempty"`
-	Name                string            `json:"name,omitempty"`
-	Networkrate         int               `json:"networkrate,omitempty"`
-	Service             []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Serviceofferingid        string `json:"serviceofferingid,omitempty"`
-	Specifyipranges          bool   `json:"specifyipranges,omitempty"`
-	Specifyvlan              bool   `json:"specifyvlan,omitempty"`
-	State                    string `json:"state,omitempty"`
-	Supportsstrechedl2subnet bool   `json:"supportsstrechedl2subnet,omitempty"`
-	Tags                     string `json:"tags,omitempty"`
-	Traffictype              string `json:"traffictype,omitempty"`
-}
-
-type DeleteNetworkOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteNetworkOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type CreateNetworkOfferingResponse struct {
+	Availability             string                     `json:"availability,omitempty" xml:"availability,omitempty"`
+	Conservemode             Bool                       `json:"conservemode,omitempty" xml:"conservemode,omitempty"`
+	Created                  Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details                  map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext              string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Egressdefaultpolicy      Bool                       `json:"egressdefaultpolicy,omitempty" xml:"egressdefaultpolicy,omitempty"`
+	Forvpc                   Bool                       `json:"forvpc,omitempty" xml:"forvpc,omitempty"`
+	Guestiptype              string                     `json:"guestiptype,omitempty" xml:"guestiptype,omitempty"`
+	Id                       string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdefault                Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent             Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Maxconnections           int                        `json:"maxconnections,omitempty" xml:"maxconnections,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkrate              int                        `json:"networkrate,omitempty" xml:"networkrate,omitempty"`
+	Service                  []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Serviceofferingid        string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Specifyipranges          Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	Specifyvlan              Bool                       `json:"specifyvlan,omitempty" xml:"specifyvlan,omitempty"`
+	State                    string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Supportsstrechedl2subnet Bool                       `json:"supportsstrechedl2subnet,omitempty" xml:"supportsstrechedl2subnet,omitempty"`
+	Tags                     string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype              NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetworkOfferingParams = cloudstackcommon.DeleteNetworkOfferingParams
+type DeleteNetworkOfferingResponse = cloudstackcommon.DeleteNetworkOfferingResponse
 
 // You should always use this function to get a new DeleteNetworkOfferingParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams {
 	p := &DeleteNetworkOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a network offering.
+//
+// See also ListNetworkOfferings, GetNetworkOfferingID, GetNetworkOfferingByName, GetNetworkOfferingByID.
 func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteNetworkOffering", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteNetworkOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteNetworkOffering", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteNetworkOfferingResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
 type ListNetworkOfferingsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
+	Availability       *string             `json:"availability,omitempty" yaml:"availability,omitempty"`
+	Displaytext        *string             `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Forvpc             *bool               `json:"forvpc,omitempty" yaml:"forvpc,omitempty"`
+	Guestiptype        *string             `json:"guestiptype,omitempty" yaml:"guestiptype,omitempty"`
+	Id                 *string             `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdefault          *bool               `json:"isdefault,omitempty" yaml:"isdefault,omitempty"`
+	Istagged           *bool               `json:"istagged,omitempty" yaml:"istagged,omitempty"`
+	Keyword            *string             `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name               *string             `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkid          *string             `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page               *int                `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize           *int                `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Sourcenatsupported *bool               `json:"sourcenatsupported,omitempty" yaml:"sourcenatsupported,omitempty"`
+	Specifyipranges    *bool               `json:"specifyipranges,omitempty" yaml:"specifyipranges,omitempty"`
+	Specifyvlan        *bool               `json:"specifyvlan,omitempty" yaml:"specifyvlan,omitempty"`
+	State              *string             `json:"state,omitempty" yaml:"state,omitempty"`
+	Supportedservices  []string            `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags               *string             `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Traffictype        *NetworkTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Zoneid             *string             `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworkOfferingsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Availability; v != nil {
+		u.Set("availability", *v)
 	}
-	if v, found := p.p["availability"]; found {
-		u.Set("availability", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["forvpc"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Forvpc; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("forvpc", vv)
 	}
-	if v, found := p.p["guestiptype"]; found {
-		u.Set("guestiptype", v.(string))
+	if v := p.Guestiptype; v != nil {
+		u.Set("guestiptype", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isdefault"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isdefault; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isdefault", vv)
 	}
-	if v, found := p.p["istagged"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Istagged; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("istagged", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkid"]; found {
-		u.Set("networkid", v.(string))
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["sourcenatsupported"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Sourcenatsupported; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("sourcenatsupported", vv)
 	}
-	if v, found := p.p["specifyipranges"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Specifyipranges; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("specifyipranges", vv)
 	}
-	if v, found := p.p["specifyvlan"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Specifyvlan; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("specifyvlan", vv)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Supportedservices; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("supportedservices", vv)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if v := p.Tags; v != nil {
+		u.Set("tags", *v)
 	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["availability"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListNetworkOfferingsParams) Command() string {
+	return "listNetworkOfferings"
 }
 
-func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListNetworkOfferingsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forvpc"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworkOfferingsParams) ResponseType() interface{} {
+	return &ListNetworkOfferingsResponse{}
 }
 
-func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworkOfferingsParams) Validate() error {
+	v := &validator{api: "listNetworkOfferings"}
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
 	}
-	p.p["guestiptype"] = v
-	return
+	return v.err()
 }
 
-func (p *ListNetworkOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
+	p.Availability = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetAvailability() string {
+	if p.Availability == nil {
+		return ""
 	}
-	p.p["isdefault"] = v
-	return
+	return *p.Availability
 }
 
-func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["istagged"] = v
-	return
+func (p *ListNetworkOfferingsParams) HasAvailability() bool {
+	return p.Availability != nil
 }
 
-func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListNetworkOfferingsParams) ResetAvailability() {
+	p.Availability = nil
 }
 
-func (p *ListNetworkOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *ListNetworkOfferingsParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["networkid"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *ListNetworkOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetForvpc(v bool) {
+	p.Forvpc = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetForvpc() bool {
+	if p.Forvpc == nil {
+		return false
 	}
-	p.p["page"] = v
+	return *p.Forvpc
+}
+
+func (p *ListNetworkOfferingsParams) HasForvpc() bool {
+	return p.Forvpc != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetForvpc() {
+	p.Forvpc = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetGuestiptype(v string) {
+	p.Guestiptype = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetGuestiptype() string {
+	if p.Guestiptype == nil {
+		return ""
 	}
-	p.p["pagesize"] = v
+	return *p.Guestiptype
+}
+
+func (p *ListNetworkOfferingsParams) HasGuestiptype() bool {
+	return p.Guestiptype != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetGuestiptype() {
+	p.Guestiptype = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["sourcenatsupported"] = v
+	return *p.Id
+}
+
+func (p *ListNetworkOfferingsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetIsdefault(v bool) {
+	p.Isdefault = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetIsdefault() bool {
+	if p.Isdefault == nil {
+		return false
 	}
-	p.p["specifyipranges"] = v
+	return *p.Isdefault
+}
+
+func (p *ListNetworkOfferingsParams) HasIsdefault() bool {
+	return p.Isdefault != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetIsdefault() {
+	p.Isdefault = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetIstagged(v bool) {
+	p.Istagged = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetIstagged() bool {
+	if p.Istagged == nil {
+		return false
 	}
-	p.p["specifyvlan"] = v
+	return *p.Istagged
+}
+
+func (p *ListNetworkOfferingsParams) HasIstagged() bool {
+	return p.Istagged != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetIstagged() {
+	p.Istagged = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworkOfferingsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["state"] = v
+	return *p.Keyword
+}
+
+func (p *ListNetworkOfferingsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["supportedservices"] = v
+	return *p.Name
+}
+
+func (p *ListNetworkOfferingsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListNetworkOfferingsParams) SetNetworkid(v string) {
+	p.Networkid = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Networkid
+}
+
+func (p *ListNetworkOfferingsParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworkOfferingsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["traffictype"] = v
+	return *p.Page
+}
+
+func (p *ListNetworkOfferingsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworkOfferingsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListNetworkOfferingsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetworkOfferingsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetSourcenatsupported(v bool) {
+	p.Sourcenatsupported = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetSourcenatsupported() bool {
+	if p.Sourcenatsupported == nil {
+		return false
+	}
+	return *p.Sourcenatsupported
+}
+
+func (p *ListNetworkOfferingsParams) HasSourcenatsupported() bool {
+	return p.Sourcenatsupported != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetSourcenatsupported() {
+	p.Sourcenatsupported = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetSpecifyipranges(v bool) {
+	p.Specifyipranges = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetSpecifyipranges() bool {
+	if p.Specifyipranges == nil {
+		return false
+	}
+	return *p.Specifyipranges
+}
+
+func (p *ListNetworkOfferingsParams) HasSpecifyipranges() bool {
+	return p.Specifyipranges != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetSpecifyipranges() {
+	p.Specifyipranges = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetSpecifyvlan(v bool) {
+	p.Specifyvlan = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetSpecifyvlan() bool {
+	if p.Specifyvlan == nil {
+		return false
+	}
+	return *p.Specifyvlan
+}
+
+func (p *ListNetworkOfferingsParams) HasSpecifyvlan() bool {
+	return p.Specifyvlan != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetSpecifyvlan() {
+	p.Specifyvlan = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.State
+}
+
+func (p *ListNetworkOfferingsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetSupportedservices(v []string) {
+	p.Supportedservices = v
 	return
 }
 
+func (p *ListNetworkOfferingsParams) GetSupportedservices() []string {
+	return p.Supportedservices
+}
+
+func (p *ListNetworkOfferingsParams) HasSupportedservices() bool {
+	return p.Supportedservices != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetSupportedservices() {
+	p.Supportedservices = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListNetworkOfferingsParams) SetTags(v string) {
+	p.Tags = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetTags() string {
+	if p.Tags == nil {
+		return ""
+	}
+	return *p.Tags
+}
+
+func (p *ListNetworkOfferingsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetTags() {
+	p.Tags = nil
+}
+
+func (p *ListNetworkOfferingsParams) SetTraffictype(v NetworkTrafficType) {
+	p.Traffictype = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetTraffictype() NetworkTrafficType {
+	if p.Traffictype == nil {
+		return ""
+	}
+	return *p.Traffictype
+}
+
+func (p *ListNetworkOfferingsParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListNetworkOfferingsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListNetworkOfferingsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListNetworkOfferingsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListNetworkOfferingsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListNetworkOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *NetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOfferingsParams {
 	p := &ListNetworkOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkOfferingService) GetNetworkOfferingID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListNetworkOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListNetworkOfferings(p)
 	if err != nil {
@@ -823,24 +1220,36 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string) (string, erro
 		return l.NetworkOfferings[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.NetworkOfferings {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*NetworkOffering
+	for _, v := range l.NetworkOfferings {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "NetworkOffering", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkOfferingService) GetNetworkOfferingByName(name string) (*NetworkOffering, int, error) {
-	id, err := s.GetNetworkOfferingID(name)
+func (s *NetworkOfferingService) GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	id, err := s.GetNetworkOfferingID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetNetworkOfferingByID(id)
+	r, count, err := s.GetNetworkOfferingByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -848,11 +1257,16 @@ func (s *NetworkOfferingService) GetNetworkOfferingByName(name string) (*Network
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *NetworkOfferingService) GetNetworkOfferingByID(id string) (*NetworkOffering, int, error) {
+func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
 	p := &ListNetworkOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListNetworkOfferings(p)
 	if err != nil {
@@ -875,61 +1289,356 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string) (*NetworkOffe
 }
 
 // Lists all available network offerings.
+//
+// See also GetNetworkOfferingID, GetNetworkOfferingByName, GetNetworkOfferingByID.
 func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkOfferings", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listNetworkOfferings", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listNetworkOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListNetworkOfferings, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *NetworkOfferingService) StreamNetworkOfferings(ctx context.Context, p *ListNetworkOfferingsParams, fn func(*NetworkOffering) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listNetworkOfferings", p.URLValues(), "networkoffering", func(b json.RawMessage) error {
+		var v NetworkOffering
+		if err := s.cs.unmarshalElement("listNetworkOfferings", "networkoffering", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListNetworkOfferingsResponse struct {
-	Count            int                `json:"count"`
-	NetworkOfferings []*NetworkOffering `json:"networkoffering"`
+	Count            int                        `json:"count" xml:"count"`
+	NetworkOfferings []*NetworkOffering         `json:"networkoffering" xml:"networkoffering"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type NetworkOffering struct {
-	Availability        string            `json:"availability,omitempty"`
-	Conservemode        bool              `json:"conservemode,omitempty"`
-	Created             string            `json:"created,omitempty"`
-	Details             map[string]string `json:"details,omitempty"`
-	Displaytext         string            `json:"displaytext,omitempty"`
-	Egressdefaultpolicy bool              `json:"egressdefaultpolicy,omitempty"`
-	Forvpc              bool              `json:"forvpc,omitempty"`
-	Guestiptype         string            `json:"guestiptype,omitempty"`
-	Id                  string            `json:"id,omitempty"`
-	Isdefault           bool              `json:"isdefault,omitempty"`
-	Ispersistent        bool              `json:"ispersistent,omitempty"`
-	Maxconnections      int               `json:"maxconnections,omitempty"`
-	Name                string            `json:"name,omitempty"`
-	Networkrate         int               `json:"networkrate,omitempty"`
-	Service             []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	Serviceofferingid        string `json:"serviceofferingid,omitempty"`
-	Specifyipranges          bool   `json:"specifyipranges,omitempty"`
-	Specifyvlan              bool   `json:"specifyvlan,omitempty"`
-	State                    string `json:"state,omitempty"`
-	Supportsstrechedl2subnet bool   `json:"supportsstrechedl2subnet,omitempty"`
-	Tags                     string `json:"tags,omitempty"`
-	Traffictype              string `json:"traffictype,omitempty"`
+	Availability             string                     `json:"availability,omitempty" xml:"availability,omitempty"`
+	Conservemode             Bool                       `json:"conservemode,omitempty" xml:"conservemode,omitempty"`
+	Created                  Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details                  map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext              string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Egressdefaultpolicy      Bool                       `json:"egressdefaultpolicy,omitempty" xml:"egressdefaultpolicy,omitempty"`
+	Forvpc                   Bool                       `json:"forvpc,omitempty" xml:"forvpc,omitempty"`
+	Guestiptype              string                     `json:"guestiptype,omitempty" xml:"guestiptype,omitempty"`
+	Id                       string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdefault                Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent             Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Maxconnections           int                        `json:"maxconnections,omitempty" xml:"maxconnections,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkrate              int                        `json:"networkrate,omitempty" xml:"networkrate,omitempty"`
+	Service                  []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Serviceofferingid        string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Specifyipranges          Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	Specifyvlan              Bool                       `json:"specifyvlan,omitempty" xml:"specifyvlan,omitempty"`
+	State                    string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Supportsstrechedl2subnet Bool                       `json:"supportsstrechedl2subnet,omitempty" xml:"supportsstrechedl2subnet,omitempty"`
+	Tags                     string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype              NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateNetworkOfferingParams struct {
+	Availability     *string `json:"availability,omitempty" yaml:"availability,omitempty"`
+	Displaytext      *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keepaliveenabled *bool   `json:"keepaliveenabled,omitempty" yaml:"keepaliveenabled,omitempty"`
+	Maxconnections   *int    `json:"maxconnections,omitempty" yaml:"maxconnections,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey          *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+	State            *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateNetworkOfferingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Availability; v != nil {
+		u.Set("availability", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keepaliveenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("keepaliveenabled", vv)
+	}
+	if v := p.Maxconnections; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("maxconnections", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Sortkey; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("sortkey", vv)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateNetworkOfferingParams) Command() string {
+	return "updateNetworkOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateNetworkOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateNetworkOfferingParams) ResponseType() interface{} {
+	return &UpdateNetworkOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateNetworkOfferingParams) Validate() error {
+	v := &validator{api: "updateNetworkOffering"}
+	return v.err()
+}
+
+func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
+	p.Availability = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetAvailability() string {
+	if p.Availability == nil {
+		return ""
+	}
+	return *p.Availability
+}
+
+func (p *UpdateNetworkOfferingParams) HasAvailability() bool {
+	return p.Availability != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetAvailability() {
+	p.Availability = nil
+}
+
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateNetworkOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *UpdateNetworkOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+func (p *UpdateNetworkOfferingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateNetworkOfferingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateNetworkOfferingParams) SetKeepaliveenabled(v bool) {
+	p.Keepaliveenabled = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetKeepaliveenabled() bool {
+	if p.Keepaliveenabled == nil {
+		return false
+	}
+	return *p.Keepaliveenabled
+}
+
+func (p *UpdateNetworkOfferingParams) HasKeepaliveenabled() bool {
+	return p.Keepaliveenabled != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetKeepaliveenabled() {
+	p.Keepaliveenabled = nil
+}
+
+func (p *UpdateNetworkOfferingParams) SetMaxconnections(v int) {
+	p.Maxconnections = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetMaxconnections() int {
+	if p.Maxconnections == nil {
+		return 0
+	}
+	return *p.Maxconnections
+}
+
+func (p *UpdateNetworkOfferingParams) HasMaxconnections() bool {
+	return p.Maxconnections != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetMaxconnections() {
+	p.Maxconnections = nil
+}
+
+func (p *UpdateNetworkOfferingParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateNetworkOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *UpdateNetworkOfferingParams) SetSortkey(v int) {
+	p.Sortkey = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetSortkey() int {
+	if p.Sortkey == nil {
+		return 0
+	}
+	return *p.Sortkey
+}
+
+func (p *UpdateNetworkOfferingParams) HasSortkey() bool {
+	return p.Sortkey != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetSortkey() {
+	p.Sortkey = nil
+}
+
+func (p *UpdateNetworkOfferingParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *UpdateNetworkOfferingParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *UpdateNetworkOfferingParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *UpdateNetworkOfferingParams) ResetState() {
+	p.State = nil
+}
+
+// You should always use this function to get a new UpdateNetworkOfferingParams instance,
+// as then you are sure you have configured all required params
+func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams {
+	p := &UpdateNetworkOfferingParams{}
+	return p
+}
+
+// Updates a network offering.
+//
+// See also ListNetworkOfferings, GetNetworkOfferingID, GetNetworkOfferingByName, GetNetworkOfferingByID.
+func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateNetworkOffering", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateNetworkOfferingResponse
+	if err := s.cs.unmarshal("updateNetworkOffering", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type UpdateNetworkOfferingResponse struct {
+	Availability             string                     `json:"availability,omitempty" xml:"availability,omitempty"`
+	Conservemode             Bool                       `json:"conservemode,omitempty" xml:"conservemode,omitempty"`
+	Created                  Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details                  map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext              string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Egressdefaultpolicy      Bool                       `json:"egressdefaultpolicy,omitempty" xml:"egressdefaultpolicy,omitempty"`
+	Forvpc                   Bool                       `json:"forvpc,omitempty" xml:"forvpc,omitempty"`
+	Guestiptype              string                     `json:"guestiptype,omitempty" xml:"guestiptype,omitempty"`
+	Id                       string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdefault                Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Ispersistent             Bool                       `json:"ispersistent,omitempty" xml:"ispersistent,omitempty"`
+	Maxconnections           int                        `json:"maxconnections,omitempty" xml:"maxconnections,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkrate              int                        `json:"networkrate,omitempty" xml:"networkrate,omitempty"`
+	Service                  []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	Serviceofferingid        string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Specifyipranges          Bool                       `json:"specifyipranges,omitempty" xml:"specifyipranges,omitempty"`
+	Specifyvlan              Bool                       `json:"specifyvlan,omitempty" xml:"specifyvlan,omitempty"`
+	State                    string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Supportsstrechedl2subnet Bool                       `json:"supportsstrechedl2subnet,omitempty" xml:"supportsstrechedl2subnet,omitempty"`
+	Tags                     string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Traffictype              NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
 }
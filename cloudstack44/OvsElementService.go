@@ -17,6 +17,7 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -24,60 +25,130 @@ import (
 	"strings"
 )
 
+type OvsElementService struct {
+	cs *CloudStackClient
+}
+
+func NewOvsElementService(cs *CloudStackClient) *OvsElementService {
+	return &OvsElementService{cs: cs}
+}
+
 type ConfigureOvsElementParams struct {
-	p map[string]interface{}
+	Enabled *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *ConfigureOvsElementParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigureOvsElementParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Enabled; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("enabled", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ConfigureOvsElementParams) Command() string {
+	return "configureOvsElement"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigureOvsElementParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigureOvsElementParams) ResponseType() interface{} {
+	return &ConfigureOvsElementResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigureOvsElementParams) Validate() error {
+	v := &validator{api: "configureOvsElement"}
+	v.required("enabled", p.Enabled != nil)
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
 func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabled"] = v
+	p.Enabled = &v
 	return
 }
 
-func (p *ConfigureOvsElementParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ConfigureOvsElementParams) GetEnabled() bool {
+	if p.Enabled == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Enabled
+}
+
+func (p *ConfigureOvsElementParams) HasEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ConfigureOvsElementParams) ResetEnabled() {
+	p.Enabled = nil
+}
+
+// This param is required.
+func (p *ConfigureOvsElementParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
+func (p *ConfigureOvsElementParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ConfigureOvsElementParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ConfigureOvsElementParams) ResetId() {
+	p.Id = nil
+}
+
 // You should always use this function to get a new ConfigureOvsElementParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - enabled
+//   - id
 func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams {
 	p := &ConfigureOvsElementParams{}
-	p.p = make(map[string]interface{})
-	p.p["enabled"] = enabled
-	p.p["id"] = id
+	p.SetEnabled(enabled)
+	p.SetId(id)
 	return p
 }
 
 // Configures an ovs element.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListOvsElements, GetOvsElementByID.
 func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*ConfigureOvsElementResponse, error) {
-	resp, err := s.cs.newRequest("configureOvsElement", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("configureOvsElement", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ConfigureOvsElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("configureOvsElement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -98,7 +169,7 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("configureOvsElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -106,112 +177,217 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 }
 
 type ConfigureOvsElementResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Enabled   bool   `json:"enabled,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Nspid     string `json:"nspid,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Enabled   Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid     string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ListOvsElementsParams struct {
-	p map[string]interface{}
+	Enabled  *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nspid    *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
 }
 
-func (p *ListOvsElementsParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListOvsElementsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["enabled"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Enabled; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("enabled", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["nspid"]; found {
-		u.Set("nspid", v.(string))
+	if v := p.Nspid; v != nil {
+		u.Set("nspid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListOvsElementsParams) Command() string {
+	return "listOvsElements"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListOvsElementsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListOvsElementsParams) ResponseType() interface{} {
+	return &ListOvsElementsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListOvsElementsParams) Validate() error {
+	v := &validator{api: "listOvsElements"}
+	return v.err()
+}
+
 func (p *ListOvsElementsParams) SetEnabled(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enabled"] = v
+	p.Enabled = &v
 	return
 }
 
-func (p *ListOvsElementsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOvsElementsParams) GetEnabled() bool {
+	if p.Enabled == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Enabled
+}
+
+func (p *ListOvsElementsParams) HasEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ListOvsElementsParams) ResetEnabled() {
+	p.Enabled = nil
+}
+
+func (p *ListOvsElementsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListOvsElementsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOvsElementsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Id
+}
+
+func (p *ListOvsElementsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListOvsElementsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListOvsElementsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListOvsElementsParams) SetNspid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOvsElementsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["nspid"] = v
+	return *p.Keyword
+}
+
+func (p *ListOvsElementsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListOvsElementsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListOvsElementsParams) SetNspid(v string) {
+	p.Nspid = &v
 	return
 }
 
-func (p *ListOvsElementsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOvsElementsParams) GetNspid() string {
+	if p.Nspid == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Nspid
+}
+
+func (p *ListOvsElementsParams) HasNspid() bool {
+	return p.Nspid != nil
+}
+
+func (p *ListOvsElementsParams) ResetNspid() {
+	p.Nspid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListOvsElementsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListOvsElementsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListOvsElementsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListOvsElementsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListOvsElementsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListOvsElementsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
+func (p *ListOvsElementsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListOvsElementsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListOvsElementsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
 // You should always use this function to get a new ListOvsElementsParams instance,
 // as then you are sure you have configured all required params
 func (s *OvsElementService) NewListOvsElementsParams() *ListOvsElementsParams {
 	p := &ListOvsElementsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *OvsElementService) GetOvsElementByID(id string) (*OvsElement, int, error) {
+func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error) {
 	p := &ListOvsElementsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListOvsElements(p)
 	if err != nil {
@@ -234,31 +410,60 @@ func (s *OvsElementService) GetOvsElementByID(id string) (*OvsElement, int, erro
 }
 
 // Lists all available ovs elements.
+//
+// See also GetOvsElementByID.
 func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
-	resp, err := s.cs.newRequest("listOvsElements", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listOvsElements", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListOvsElementsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listOvsElements", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListOvsElements, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *OvsElementService) StreamOvsElements(ctx context.Context, p *ListOvsElementsParams, fn func(*OvsElement) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listOvsElements", p.URLValues(), "ovselement", func(b json.RawMessage) error {
+		var v OvsElement
+		if err := s.cs.unmarshalElement("listOvsElements", "ovselement", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListOvsElementsResponse struct {
-	Count       int           `json:"count"`
-	OvsElements []*OvsElement `json:"ovselement"`
+	Count       int                        `json:"count" xml:"count"`
+	OvsElements []*OvsElement              `json:"ovselement" xml:"ovselement"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type OvsElement struct {
-	Account   string `json:"account,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Enabled   bool   `json:"enabled,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Nspid     string `json:"nspid,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Enabled   Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid     string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
 }
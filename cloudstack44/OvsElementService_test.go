@@ -0,0 +1,183 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConfigureOvsElement(t *testing.T) {
+	params := url.Values{
+		"enabled": {"true"},
+		"id":      {"test-id"},
+	}
+	cs, done := newTestClient(t, "configureOvsElement", params, `{"configureovselementresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid","project":"test-project","projectid":"test-projectid"}}`)
+	defer done()
+
+	p := cs.OvsElement.NewConfigureOvsElementParams(true, "test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	checkURLValues(t, "configureOvsElement", p.URLValues(), params)
+
+	r, err := cs.OvsElement.ConfigureOvsElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureOvsElement: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "configureOvsElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute configureOvsElement: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of configureOvsElement: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of configureOvsElement: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "configureOvsElement", params, `<configureovselementresponse><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><enabled>true</enabled><id>test-id</id><jobid>test-jobid</jobid><nspid>test-nspid</nspid><project>test-project</project><projectid>test-projectid</projectid></configureovselementresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.OvsElement.ConfigureOvsElement(p)
+	if err != nil {
+		t.Fatalf("Failed to call configureOvsElement using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListOvsElements(t *testing.T) {
+	params := url.Values{
+		"enabled":  {"true"},
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"nspid":    {"test-nspid"},
+		"page":     {"5"},
+		"pagesize": {"6"},
+	}
+	cs, done := newTestClient(t, "listOvsElements", params, `{"listovselementsresponse":{"count":1,"ovselement":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","enabled":true,"id":"test-id","nspid":"test-nspid","project":"test-project","projectid":"test-projectid"}]}}`)
+	defer done()
+
+	p := cs.OvsElement.NewListOvsElementsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetNspid("test-nspid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	checkURLValues(t, "listOvsElements", p.URLValues(), params)
+
+	r, err := cs.OvsElement.ListOvsElements(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOvsElements: %v", err)
+	}
+	if r.OvsElements[0].Account != "test-account" {
+		t.Errorf("Expected r.OvsElements[0].Account to be %v, got %v", "test-account", r.OvsElements[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listOvsElements" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listOvsElements: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listOvsElements: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listOvsElements: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*OvsElement
+	count, err := cs.OvsElement.StreamOvsElements(context.Background(), p, func(v *OvsElement) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listOvsElements: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.OvsElements) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.OvsElements)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listOvsElements", params, `<listovselementsresponse><count>1</count><ovselement><account>test-account</account><domain>test-domain</domain><domainid>test-domainid</domainid><enabled>true</enabled><id>test-id</id><nspid>test-nspid</nspid><project>test-project</project><projectid>test-projectid</projectid></ovselement></listovselementsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.OvsElement.ListOvsElements(p)
+	if err != nil {
+		t.Fatalf("Failed to call listOvsElements using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.OvsElement.StreamOvsElements(context.Background(), p, func(v *OvsElement) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listOvsElements using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.OvsElements) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.OvsElements)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,162 +17,808 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type ListStoragePoolsParams struct {
-	p map[string]interface{}
+type PoolService struct {
+	cs *CloudStackClient
 }
 
-func (p *ListStoragePoolsParams) toURLValues() url.Values {
+func NewPoolService(cs *CloudStackClient) *PoolService {
+	return &PoolService{cs: cs}
+}
+
+type CreateStoragePoolParams struct {
+	Capacitybytes *int64            `json:"capacitybytes,omitempty" yaml:"capacitybytes,omitempty"`
+	Capacityiops  *int64            `json:"capacityiops,omitempty" yaml:"capacityiops,omitempty"`
+	Clusterid     *string           `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Details       map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Hypervisor    *HypervisorType   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Managed       *bool             `json:"managed,omitempty" yaml:"managed,omitempty"`
+	Name          *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Podid         *string           `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Provider      *string           `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Scope         *string           `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Tags          *string           `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Url           *string           `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid        *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateStoragePoolParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Capacitybytes; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("capacitybytes", vv)
+	}
+	if v := p.Capacityiops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("capacityiops", vv)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Managed; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("managed", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Scope; v != nil {
+		u.Set("scope", *v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if v := p.Tags; v != nil {
+		u.Set("tags", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateStoragePoolParams) Command() string {
+	return "createStoragePool"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateStoragePoolParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateStoragePoolParams) ResponseType() interface{} {
+	return &CreateStoragePoolResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateStoragePoolParams) Validate() error {
+	v := &validator{api: "createStoragePool"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	v.required("name", p.Name != nil)
+	v.required("url", p.Url != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
+	p.Capacitybytes = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetCapacitybytes() int64 {
+	if p.Capacitybytes == nil {
+		return 0
+	}
+	return *p.Capacitybytes
+}
+
+func (p *CreateStoragePoolParams) HasCapacitybytes() bool {
+	return p.Capacitybytes != nil
+}
+
+func (p *CreateStoragePoolParams) ResetCapacitybytes() {
+	p.Capacitybytes = nil
+}
+
+func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
+	p.Capacityiops = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetCapacityiops() int64 {
+	if p.Capacityiops == nil {
+		return 0
+	}
+	return *p.Capacityiops
+}
+
+func (p *CreateStoragePoolParams) HasCapacityiops() bool {
+	return p.Capacityiops != nil
+}
+
+func (p *CreateStoragePoolParams) ResetCapacityiops() {
+	p.Capacityiops = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *CreateStoragePoolParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *CreateStoragePoolParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *CreateStoragePoolParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *CreateStoragePoolParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *CreateStoragePoolParams) ResetDetails() {
+	p.Details = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *CreateStoragePoolParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *CreateStoragePoolParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *CreateStoragePoolParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *CreateStoragePoolParams) SetManaged(v bool) {
+	p.Managed = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetManaged() bool {
+	if p.Managed == nil {
+		return false
+	}
+	return *p.Managed
+}
+
+func (p *CreateStoragePoolParams) HasManaged() bool {
+	return p.Managed != nil
+}
+
+func (p *CreateStoragePoolParams) ResetManaged() {
+	p.Managed = nil
+}
+
+// This param is required.
+func (p *CreateStoragePoolParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateStoragePoolParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateStoragePoolParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *CreateStoragePoolParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *CreateStoragePoolParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *CreateStoragePoolParams) ResetPodid() {
+	p.Podid = nil
+}
+
+func (p *CreateStoragePoolParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *CreateStoragePoolParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *CreateStoragePoolParams) ResetProvider() {
+	p.Provider = nil
+}
+
+func (p *CreateStoragePoolParams) SetScope(v string) {
+	p.Scope = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetScope() string {
+	if p.Scope == nil {
+		return ""
+	}
+	return *p.Scope
+}
+
+func (p *CreateStoragePoolParams) HasScope() bool {
+	return p.Scope != nil
+}
+
+func (p *CreateStoragePoolParams) ResetScope() {
+	p.Scope = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *CreateStoragePoolParams) SetTags(v string) {
+	p.Tags = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetTags() string {
+	if p.Tags == nil {
+		return ""
+	}
+	return *p.Tags
+}
+
+func (p *CreateStoragePoolParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *CreateStoragePoolParams) ResetTags() {
+	p.Tags = nil
+}
+
+// This param is required.
+func (p *CreateStoragePoolParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *CreateStoragePoolParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *CreateStoragePoolParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *CreateStoragePoolParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *CreateStoragePoolParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreateStoragePoolParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreateStoragePoolParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new CreateStoragePoolParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - name
+//   - url
+//   - zoneid: the ID of the zone
+func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams {
+	p := &CreateStoragePoolParams{}
+	p.SetName(name)
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Creates a storage pool.
+//
+// See also ListStoragePools, GetStoragePoolID, GetStoragePoolByName, GetStoragePoolByID.
+func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createStoragePool", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreateStoragePoolResponse
+	if err := s.cs.unmarshal("createStoragePool", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type CreateStoragePoolResponse struct {
+	Capacityiops         int64                      `json:"capacityiops,omitempty" xml:"capacityiops,omitempty"`
+	Clusterid            string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername          string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disksizeallocated    Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal        Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Disksizeused         Size                       `json:"disksizeused,omitempty" xml:"disksizeused,omitempty"`
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress            string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor,omitempty" xml:"overprovisionfactor,omitempty"`
+	Path                 string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname              string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Scope                string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities,omitempty" xml:"storagecapabilities,omitempty"`
+	Suitableformigration Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                 string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteStoragePoolParams = cloudstackcommon.DeleteStoragePoolParams
+type DeleteStoragePoolResponse = cloudstackcommon.DeleteStoragePoolResponse
+
+// You should always use this function to get a new DeleteStoragePoolParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams {
+	p := &DeleteStoragePoolParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a storage pool.
+//
+// See also ListStoragePools, GetStoragePoolID, GetStoragePoolByName, GetStoragePoolByID.
+func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteStoragePool", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteStoragePoolResponse
+	if err := s.cs.unmarshal("deleteStoragePool", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type ListStoragePoolsParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Path      *string `json:"path,omitempty" yaml:"path,omitempty"`
+	Podid     *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Scope     *string `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListStoragePoolsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["path"]; found {
-		u.Set("path", v.(string))
+	if v := p.Path; v != nil {
+		u.Set("path", *v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
 	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
+	if v := p.Scope; v != nil {
+		u.Set("scope", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListStoragePoolsParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
+// Returns the name of the API command of these params
+func (p *ListStoragePoolsParams) Command() string {
+	return "listStoragePools"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListStoragePoolsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListStoragePoolsParams) ResponseType() interface{} {
+	return &ListStoragePoolsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListStoragePoolsParams) Validate() error {
+	v := &validator{api: "listStoragePools"}
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *ListStoragePoolsParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *ListStoragePoolsParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *ListStoragePoolsParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ListStoragePoolsParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+func (p *ListStoragePoolsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListStoragePoolsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListStoragePoolsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListStoragePoolsParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListStoragePoolsParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
+	return
+}
+
+func (p *ListStoragePoolsParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
+	}
+	return *p.Ipaddress
+}
+
+func (p *ListStoragePoolsParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *ListStoragePoolsParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListStoragePoolsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Keyword
 }
 
-func (p *ListStoragePoolsParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
-	return
+func (p *ListStoragePoolsParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-func (p *ListStoragePoolsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListStoragePoolsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
 func (p *ListStoragePoolsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+	p.Name = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Name
+}
+
+func (p *ListStoragePoolsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListStoragePoolsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListStoragePoolsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListStoragePoolsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListStoragePoolsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListStoragePoolsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetPath(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["path"] = v
+	return *p.Pagesize
+}
+
+func (p *ListStoragePoolsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListStoragePoolsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListStoragePoolsParams) SetPath(v string) {
+	p.Path = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetPath() string {
+	if p.Path == nil {
+		return ""
 	}
-	p.p["podid"] = v
+	return *p.Path
+}
+
+func (p *ListStoragePoolsParams) HasPath() bool {
+	return p.Path != nil
+}
+
+func (p *ListStoragePoolsParams) ResetPath() {
+	p.Path = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListStoragePoolsParams) SetPodid(v string) {
+	p.Podid = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetScope(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
 	}
-	p.p["scope"] = v
+	return *p.Podid
+}
+
+func (p *ListStoragePoolsParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListStoragePoolsParams) ResetPodid() {
+	p.Podid = nil
+}
+
+func (p *ListStoragePoolsParams) SetScope(v string) {
+	p.Scope = &v
 	return
 }
 
-func (p *ListStoragePoolsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListStoragePoolsParams) GetScope() string {
+	if p.Scope == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Scope
+}
+
+func (p *ListStoragePoolsParams) HasScope() bool {
+	return p.Scope != nil
+}
+
+func (p *ListStoragePoolsParams) ResetScope() {
+	p.Scope = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListStoragePoolsParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *ListStoragePoolsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListStoragePoolsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListStoragePoolsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new ListStoragePoolsParams instance,
 // as then you are sure you have configured all required params
 func (s *PoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
 	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PoolService) GetStoragePoolID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListStoragePools(p)
 	if err != nil {
@@ -187,24 +833,37 @@ func (s *PoolService) GetStoragePoolID(name string) (string, error) {
 		return l.StoragePools[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.StoragePools {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*StoragePool
+	for _, v := range l.StoragePools {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "StoragePool", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+				Zone: v.Zonename,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PoolService) GetStoragePoolByName(name string) (*StoragePool, int, error) {
-	id, err := s.GetStoragePoolID(name)
+func (s *PoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error) {
+	id, err := s.GetStoragePoolID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetStoragePoolByID(id)
+	r, count, err := s.GetStoragePoolByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -212,11 +871,16 @@ func (s *PoolService) GetStoragePoolByName(name string) (*StoragePool, int, erro
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *PoolService) GetStoragePoolByID(id string) (*StoragePool, int, error) {
+func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
 	p := &ListStoragePoolsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListStoragePools(p)
 	if err != nil {
@@ -239,538 +903,459 @@ func (s *PoolService) GetStoragePoolByID(id string) (*StoragePool, int, error) {
 }
 
 // Lists storage pools.
+//
+// See also GetStoragePoolID, GetStoragePoolByName, GetStoragePoolByID.
 func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePools", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listStoragePools", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStoragePoolsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listStoragePools", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListStoragePools, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *PoolService) StreamStoragePools(ctx context.Context, p *ListStoragePoolsParams, fn func(*StoragePool) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listStoragePools", p.URLValues(), "storagepool", func(b json.RawMessage) error {
+		var v StoragePool
+		if err := s.cs.unmarshalElement("listStoragePools", "storagepool", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListStoragePoolsResponse struct {
-	Count        int            `json:"count"`
-	StoragePools []*StoragePool `json:"storagepool"`
+	Count        int                        `json:"count" xml:"count"`
+	StoragePools []*StoragePool             `json:"storagepool" xml:"storagepool"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type StoragePool struct {
-	Capacityiops         int64             `json:"capacityiops,omitempty"`
-	Clusterid            string            `json:"clusterid,omitempty"`
-	Clustername          string            `json:"clustername,omitempty"`
-	Created              string            `json:"created,omitempty"`
-	Disksizeallocated    int64             `json:"disksizeallocated,omitempty"`
-	Disksizetotal        int64             `json:"disksizetotal,omitempty"`
-	Disksizeused         int64             `json:"disksizeused,omitempty"`
-	Hypervisor           string            `json:"hypervisor,omitempty"`
-	Id                   string            `json:"id,omitempty"`
-	Ipaddress            string            `json:"ipaddress,omitempty"`
-	Name                 string            `json:"name,omitempty"`
-	Overprovisionfactor  string            `json:"overprovisionfactor,omitempty"`
-	Path                 string            `json:"path,omitempty"`
-	Podid                string            `json:"podid,omitempty"`
-	Podname              string            `json:"podname,omitempty"`
-	Scope                string            `json:"scope,omitempty"`
-	State                string            `json:"state,omitempty"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities,omitempty"`
-	Suitableformigration bool              `json:"suitableformigration,omitempty"`
-	Tags                 string            `json:"tags,omitempty"`
-	Type                 string            `json:"type,omitempty"`
-	Zoneid               string            `json:"zoneid,omitempty"`
-	Zonename             string            `json:"zonename,omitempty"`
+	Capacityiops         int64                      `json:"capacityiops,omitempty" xml:"capacityiops,omitempty"`
+	Clusterid            string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername          string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disksizeallocated    Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal        Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Disksizeused         Size                       `json:"disksizeused,omitempty" xml:"disksizeused,omitempty"`
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress            string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor,omitempty" xml:"overprovisionfactor,omitempty"`
+	Path                 string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname              string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Scope                string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities,omitempty" xml:"storagecapabilities,omitempty"`
+	Suitableformigration Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                 string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type CreateStoragePoolParams struct {
-	p map[string]interface{}
+type UpdateStoragePoolParams struct {
+	Capacitybytes *int64   `json:"capacitybytes,omitempty" yaml:"capacitybytes,omitempty"`
+	Capacityiops  *int64   `json:"capacityiops,omitempty" yaml:"capacityiops,omitempty"`
+	Id            *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Tags          []string `json:"tags,omitempty" yaml:"tags,omitempty"`
 }
 
-func (p *CreateStoragePoolParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateStoragePoolParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["capacitybytes"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Capacitybytes; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("capacitybytes", vv)
 	}
-	if v, found := p.p["capacityiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Capacityiops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("capacityiops", vv)
 	}
-	if v, found := p.p["clusterid"]; found {
-		u.Set("clusterid", v.(string))
-	}
-	if v, found := p.p["details"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("details[%d].key", i), k)
-			u.Set(fmt.Sprintf("details[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
-	}
-	if v, found := p.p["managed"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("managed", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["podid"]; found {
-		u.Set("podid", v.(string))
-	}
-	if v, found := p.p["provider"]; found {
-		u.Set("provider", v.(string))
-	}
-	if v, found := p.p["scope"]; found {
-		u.Set("scope", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Tags; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("tags", vv)
 	}
 	return u
 }
 
-func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["capacitybytes"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateStoragePoolParams) Command() string {
+	return "updateStoragePool"
 }
 
-func (p *CreateStoragePoolParams) SetCapacityiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["capacityiops"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateStoragePoolParams) IsAsync() bool {
+	return false
 }
 
-func (p *CreateStoragePoolParams) SetClusterid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["clusterid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateStoragePoolParams) ResponseType() interface{} {
+	return &UpdateStoragePoolResponse{}
 }
 
-func (p *CreateStoragePoolParams) SetDetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["details"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateStoragePoolParams) Validate() error {
+	v := &validator{api: "updateStoragePool"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *CreateStoragePoolParams) SetHypervisor(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervisor"] = v
+func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
+	p.Capacitybytes = &v
 	return
 }
 
-func (p *CreateStoragePoolParams) SetManaged(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateStoragePoolParams) GetCapacitybytes() int64 {
+	if p.Capacitybytes == nil {
+		return 0
 	}
-	p.p["managed"] = v
-	return
+	return *p.Capacitybytes
 }
 
-func (p *CreateStoragePoolParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *UpdateStoragePoolParams) HasCapacitybytes() bool {
+	return p.Capacitybytes != nil
 }
 
-func (p *CreateStoragePoolParams) SetPodid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["podid"] = v
-	return
+func (p *UpdateStoragePoolParams) ResetCapacitybytes() {
+	p.Capacitybytes = nil
 }
 
-func (p *CreateStoragePoolParams) SetProvider(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["provider"] = v
+func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
+	p.Capacityiops = &v
 	return
 }
 
-func (p *CreateStoragePoolParams) SetScope(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateStoragePoolParams) GetCapacityiops() int64 {
+	if p.Capacityiops == nil {
+		return 0
 	}
-	p.p["scope"] = v
-	return
+	return *p.Capacityiops
 }
 
-func (p *CreateStoragePoolParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
+func (p *UpdateStoragePoolParams) HasCapacityiops() bool {
+	return p.Capacityiops != nil
 }
 
-func (p *CreateStoragePoolParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
-	return
+func (p *UpdateStoragePoolParams) ResetCapacityiops() {
+	p.Capacityiops = nil
 }
 
-func (p *CreateStoragePoolParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
+// This param is required.
+func (p *UpdateStoragePoolParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-// You should always use this function to get a new CreateStoragePoolParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams {
-	p := &CreateStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["name"] = name
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
-	return p
-}
-
-// Creates a storage pool.
-func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("createStoragePool", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r CreateStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *UpdateStoragePoolParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	return &r, nil
-}
-
-type CreateStoragePoolResponse struct {
-	Capacityiops         int64             `json:"capacityiops,omitempty"`
-	Clusterid            string            `json:"clusterid,omitempty"`
-	Clustername          string            `json:"clustername,omitempty"`
-	Created              string            `json:"created,omitempty"`
-	Disksizeallocated    int64             `json:"disksizeallocated,omitempty"`
-	Disksizetotal        int64             `json:"disksizetotal,omitempty"`
-	Disksizeused         int64             `json:"disksizeused,omitempty"`
-	Hypervisor           string            `json:"hypervisor,omitempty"`
-	Id                   string            `json:"id,omitempty"`
-	Ipaddress            string            `json:"ipaddress,omitempty"`
-	Name                 string            `json:"name,omitempty"`
-	Overprovisionfactor  string            `json:"overprovisionfactor,omitempty"`
-	Path                 string            `json:"path,omitempty"`
-	Podid                string            `json:"podid,omitempty"`
-	Podname              string            `json:"podname,omitempty"`
-	Scope                string            `json:"scope,omitempty"`
-	State                string            `json:"state,omitempty"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities,omitempty"`
-	Suitableformigration bool              `json:"suitableformigration,omitempty"`
-	Tags                 string            `json:"tags,omitempty"`
-	Type                 string            `json:"type,omitempty"`
-	Zoneid               string            `json:"zoneid,omitempty"`
-	Zonename             string            `json:"zonename,omitempty"`
+	return *p.Id
 }
 
-type UpdateStoragePoolParams struct {
-	p map[string]interface{}
+func (p *UpdateStoragePoolParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *UpdateStoragePoolParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["capacitybytes"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("capacitybytes", vv)
-	}
-	if v, found := p.p["capacityiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
-		u.Set("capacityiops", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("tags", vv)
-	}
-	return u
+func (p *UpdateStoragePoolParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["capacitybytes"] = v
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *UpdateStoragePoolParams) SetTags(v []string) {
+	p.Tags = v
 	return
 }
 
-func (p *UpdateStoragePoolParams) SetCapacityiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["capacityiops"] = v
-	return
+func (p *UpdateStoragePoolParams) GetTags() []string {
+	return p.Tags
 }
 
-func (p *UpdateStoragePoolParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateStoragePoolParams) HasTags() bool {
+	return p.Tags != nil
 }
 
-func (p *UpdateStoragePoolParams) SetTags(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
+func (p *UpdateStoragePoolParams) ResetTags() {
+	p.Tags = nil
 }
 
 // You should always use this function to get a new UpdateStoragePoolParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *PoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams {
 	p := &UpdateStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates a storage pool.
+//
+// See also ListStoragePools, GetStoragePoolID, GetStoragePoolByName, GetStoragePoolByID.
 func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("updateStoragePool", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateStoragePool", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateStoragePool", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type UpdateStoragePoolResponse struct {
-	Capacityiops         int64             `json:"capacityiops,omitempty"`
-	Clusterid            string            `json:"clusterid,omitempty"`
-	Clustername          string            `json:"clustername,omitempty"`
-	Created              string            `json:"created,omitempty"`
-	Disksizeallocated    int64             `json:"disksizeallocated,omitempty"`
-	Disksizetotal        int64             `json:"disksizetotal,omitempty"`
-	Disksizeused         int64             `json:"disksizeused,omitempty"`
-	Hypervisor           string            `json:"hypervisor,omitempty"`
-	Id                   string            `json:"id,omitempty"`
-	Ipaddress            string            `json:"ipaddress,omitempty"`
-	Name                 string            `json:"name,omitempty"`
-	Overprovisionfactor  string            `json:"overprovisionfactor,omitempty"`
-	Path                 string            `json:"path,omitempty"`
-	Podid                string            `json:"podid,omitempty"`
-	Podname              string            `json:"podname,omitempty"`
-	Scope                string            `json:"scope,omitempty"`
-	State                string            `json:"state,omitempty"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities,omitempty"`
-	Suitableformigration bool              `json:"suitableformigration,omitempty"`
-	Tags                 string            `json:"tags,omitempty"`
-	Type                 string            `json:"type,omitempty"`
-	Zoneid               string            `json:"zoneid,omitempty"`
-	Zonename             string            `json:"zonename,omitempty"`
-}
-
-type DeleteStoragePoolParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteStoragePoolParams) toURLValues() url.Values {
+	Capacityiops         int64                      `json:"capacityiops,omitempty" xml:"capacityiops,omitempty"`
+	Clusterid            string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername          string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disksizeallocated    Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal        Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Disksizeused         Size                       `json:"disksizeused,omitempty" xml:"disksizeused,omitempty"`
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress            string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor,omitempty" xml:"overprovisionfactor,omitempty"`
+	Path                 string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname              string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Scope                string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities,omitempty" xml:"storagecapabilities,omitempty"`
+	Suitableformigration Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                 string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type FindStoragePoolsForMigrationParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *FindStoragePoolsForMigrationParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["forced"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("forced", vv)
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
 	}
 	return u
 }
 
-func (p *DeleteStoragePoolParams) SetForced(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["forced"] = v
-	return
+// Returns the name of the API command of these params
+func (p *FindStoragePoolsForMigrationParams) Command() string {
+	return "findStoragePoolsForMigration"
 }
 
-func (p *DeleteStoragePoolParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *FindStoragePoolsForMigrationParams) IsAsync() bool {
+	return false
 }
 
-// You should always use this function to get a new DeleteStoragePoolParams instance,
-// as then you are sure you have configured all required params
-func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams {
-	p := &DeleteStoragePoolParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *FindStoragePoolsForMigrationParams) ResponseType() interface{} {
+	return &FindStoragePoolsForMigrationResponse{}
 }
 
-// Deletes a storage pool.
-func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
-	resp, err := s.cs.newRequest("deleteStoragePool", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *FindStoragePoolsForMigrationParams) Validate() error {
+	v := &validator{api: "findStoragePoolsForMigration"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
 
-	var r DeleteStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// This param is required.
+func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-type DeleteStoragePoolResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+func (p *FindStoragePoolsForMigrationParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
 }
 
-type FindStoragePoolsForMigrationParams struct {
-	p map[string]interface{}
+func (p *FindStoragePoolsForMigrationParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	return u
+func (p *FindStoragePoolsForMigrationParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *FindStoragePoolsForMigrationParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *FindStoragePoolsForMigrationParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Keyword
+}
+
+func (p *FindStoragePoolsForMigrationParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *FindStoragePoolsForMigrationParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
+// Sets the 'page' param: the page to list, starting at 1.
 func (p *FindStoragePoolsForMigrationParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
+	p.Page = &v
 	return
 }
 
-func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *FindStoragePoolsForMigrationParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *FindStoragePoolsForMigrationParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *FindStoragePoolsForMigrationParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *FindStoragePoolsForMigrationParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
+func (p *FindStoragePoolsForMigrationParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *FindStoragePoolsForMigrationParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *FindStoragePoolsForMigrationParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
 // You should always use this function to get a new FindStoragePoolsForMigrationParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams {
 	p := &FindStoragePoolsForMigrationParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Lists storage pools available for migration of a volume.
 func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r FindStoragePoolsForMigrationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("findStoragePoolsForMigration", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type FindStoragePoolsForMigrationResponse struct {
-	Capacityiops         int64             `json:"capacityiops,omitempty"`
-	Clusterid            string            `json:"clusterid,omitempty"`
-	Clustername          string            `json:"clustername,omitempty"`
-	Created              string            `json:"created,omitempty"`
-	Disksizeallocated    int64             `json:"disksizeallocated,omitempty"`
-	Disksizetotal        int64             `json:"disksizetotal,omitempty"`
-	Disksizeused         int64             `json:"disksizeused,omitempty"`
-	Hypervisor           string            `json:"hypervisor,omitempty"`
-	Id                   string            `json:"id,omitempty"`
-	Ipaddress            string            `json:"ipaddress,omitempty"`
-	Name                 string            `json:"name,omitempty"`
-	Overprovisionfactor  string            `json:"overprovisionfactor,omitempty"`
-	Path                 string            `json:"path,omitempty"`
-	Podid                string            `json:"podid,omitempty"`
-	Podname              string            `json:"podname,omitempty"`
-	Scope                string            `json:"scope,omitempty"`
-	State                string            `json:"state,omitempty"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities,omitempty"`
-	Suitableformigration bool              `json:"suitableformigration,omitempty"`
-	Tags                 string            `json:"tags,omitempty"`
-	Type                 string            `json:"type,omitempty"`
-	Zoneid               string            `json:"zoneid,omitempty"`
-	Zonename             string            `json:"zonename,omitempty"`
+	Capacityiops         int64                      `json:"capacityiops,omitempty" xml:"capacityiops,omitempty"`
+	Clusterid            string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername          string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disksizeallocated    Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal        Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Disksizeused         Size                       `json:"disksizeused,omitempty" xml:"disksizeused,omitempty"`
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress            string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor,omitempty" xml:"overprovisionfactor,omitempty"`
+	Path                 string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname              string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Scope                string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities,omitempty" xml:"storagecapabilities,omitempty"`
+	Suitableformigration Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Type                 string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
 }
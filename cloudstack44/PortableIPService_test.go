@@ -0,0 +1,250 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreatePortableIpRange(t *testing.T) {
+	params := url.Values{
+		"endip":    {"test-endip"},
+		"gateway":  {"test-gateway"},
+		"netmask":  {"test-netmask"},
+		"regionid": {"4"},
+		"startip":  {"test-startip"},
+		"vlan":     {"test-vlan"},
+	}
+	cs, done := newTestClient(t, "createPortableIpRange", params, `{"createportableiprangeresponse":{"endip":"test-endip","gateway":"test-gateway","id":"test-id","jobid":"test-jobid","netmask":"test-netmask","portableipaddress":[{"accountid":"test-accountid","allocated":"test-allocated","domainid":"test-domainid","ipaddress":"test-ipaddress","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","regionid":1,"state":"test-state","vpcid":"test-vpcid","zoneid":"test-zoneid"}],"regionid":1,"startip":"test-startip","vlan":"test-vlan"}}`)
+	defer done()
+
+	p := cs.PortableIP.NewCreatePortableIpRangeParams("test-endip", "test-gateway", "test-netmask", 4, "test-startip")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetNetmask("test-netmask")
+	p.SetRegionid(4)
+	p.SetStartip("test-startip")
+	p.SetVlan("test-vlan")
+	checkURLValues(t, "createPortableIpRange", p.URLValues(), params)
+
+	r, err := cs.PortableIP.CreatePortableIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPortableIpRange: %v", err)
+	}
+	if r.Endip != "test-endip" {
+		t.Errorf("Expected r.Endip to be %v, got %v", "test-endip", r.Endip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createPortableIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createPortableIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createPortableIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createPortableIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createPortableIpRange", params, `<createportableiprangeresponse><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><jobid>test-jobid</jobid><netmask>test-netmask</netmask><portableipaddress><accountid>test-accountid</accountid><allocated>test-allocated</allocated><domainid>test-domainid</domainid><ipaddress>test-ipaddress</ipaddress><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><regionid>1</regionid><state>test-state</state><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid></portableipaddress><regionid>1</regionid><startip>test-startip</startip><vlan>test-vlan</vlan></createportableiprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.PortableIP.CreatePortableIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call createPortableIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeletePortableIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deletePortableIpRange", params, `{"deleteportableiprangeresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.PortableIP.NewDeletePortableIpRangeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deletePortableIpRange", p.URLValues(), params)
+
+	r, err := cs.PortableIP.DeletePortableIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePortableIpRange: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deletePortableIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deletePortableIpRange: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deletePortableIpRange: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deletePortableIpRange: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deletePortableIpRange", params, `<deleteportableiprangeresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deleteportableiprangeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.PortableIP.DeletePortableIpRange(p)
+	if err != nil {
+		t.Fatalf("Failed to call deletePortableIpRange using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListPortableIpRanges(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"regionid": {"5"},
+	}
+	cs, done := newTestClient(t, "listPortableIpRanges", params, `{"listportableiprangesresponse":{"count":1,"portableiprange":[{"endip":"test-endip","gateway":"test-gateway","id":"test-id","netmask":"test-netmask","portableipaddress":[{"accountid":"test-accountid","allocated":"test-allocated","domainid":"test-domainid","ipaddress":"test-ipaddress","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","regionid":1,"state":"test-state","vpcid":"test-vpcid","zoneid":"test-zoneid"}],"regionid":1,"startip":"test-startip","vlan":"test-vlan"}]}}`)
+	defer done()
+
+	p := cs.PortableIP.NewListPortableIpRangesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetRegionid(5)
+	checkURLValues(t, "listPortableIpRanges", p.URLValues(), params)
+
+	r, err := cs.PortableIP.ListPortableIpRanges(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPortableIpRanges: %v", err)
+	}
+	if r.PortableIpRanges[0].Endip != "test-endip" {
+		t.Errorf("Expected r.PortableIpRanges[0].Endip to be %v, got %v", "test-endip", r.PortableIpRanges[0].Endip)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listPortableIpRanges" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listPortableIpRanges: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listPortableIpRanges: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listPortableIpRanges: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*PortableIpRange
+	count, err := cs.PortableIP.StreamPortableIpRanges(context.Background(), p, func(v *PortableIpRange) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listPortableIpRanges: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.PortableIpRanges) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.PortableIpRanges)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listPortableIpRanges", params, `<listportableiprangesresponse><count>1</count><portableiprange><endip>test-endip</endip><gateway>test-gateway</gateway><id>test-id</id><netmask>test-netmask</netmask><portableipaddress><accountid>test-accountid</accountid><allocated>test-allocated</allocated><domainid>test-domainid</domainid><ipaddress>test-ipaddress</ipaddress><networkid>test-networkid</networkid><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><regionid>1</regionid><state>test-state</state><vpcid>test-vpcid</vpcid><zoneid>test-zoneid</zoneid></portableipaddress><regionid>1</regionid><startip>test-startip</startip><vlan>test-vlan</vlan></portableiprange></listportableiprangesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.PortableIP.ListPortableIpRanges(p)
+	if err != nil {
+		t.Fatalf("Failed to call listPortableIpRanges using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.PortableIP.StreamPortableIpRanges(context.Background(), p, func(v *PortableIpRange) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listPortableIpRanges using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.PortableIpRanges) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.PortableIpRanges)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,267 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddResourceDetail(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"fordisplay":       {"true"},
+		"resourceid":       {"test-resourceid"},
+		"resourcetype":     {"AutoScaleVmGroup"},
+	}
+	cs, done := newTestClient(t, "addResourceDetail", params, `{"addresourcedetailresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Resourcemetadata.NewAddResourceDetailParams(map[string]string{"test-detailskey": "test-detailsvalue"}, "test-resourceid", TaggedResourceTypeAutoScaleVmGroup)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetFordisplay(true)
+	p.SetResourceid("test-resourceid")
+	p.SetResourcetype(TaggedResourceTypeAutoScaleVmGroup)
+	checkURLValues(t, "addResourceDetail", p.URLValues(), params)
+
+	r, err := cs.Resourcemetadata.AddResourceDetail(p)
+	if err != nil {
+		t.Fatalf("Failed to call addResourceDetail: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addResourceDetail" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addResourceDetail: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addResourceDetail: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addResourceDetail: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addResourceDetail", params, `<addresourcedetailresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></addresourcedetailresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Resourcemetadata.AddResourceDetail(p)
+	if err != nil {
+		t.Fatalf("Failed to call addResourceDetail using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListResourceDetails(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"fordisplay":   {"true"},
+		"isrecursive":  {"true"},
+		"key":          {"test-key"},
+		"keyword":      {"test-keyword"},
+		"listall":      {"true"},
+		"page":         {"8"},
+		"pagesize":     {"9"},
+		"projectid":    {"test-projectid"},
+		"resourceid":   {"test-resourceid"},
+		"resourcetype": {"AutoScaleVmGroup"},
+		"value":        {"test-value"},
+	}
+	cs, done := newTestClient(t, "listResourceDetails", params, `{"listresourcedetailsresponse":{"count":1,"resourcedetail":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.Resourcemetadata.NewListResourceDetailsParams(TaggedResourceTypeAutoScaleVmGroup)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFordisplay(true)
+	p.SetIsrecursive(true)
+	p.SetKey("test-key")
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetResourceid("test-resourceid")
+	p.SetResourcetype(TaggedResourceTypeAutoScaleVmGroup)
+	p.SetValue("test-value")
+	checkURLValues(t, "listResourceDetails", p.URLValues(), params)
+
+	r, err := cs.Resourcemetadata.ListResourceDetails(p)
+	if err != nil {
+		t.Fatalf("Failed to call listResourceDetails: %v", err)
+	}
+	if r.ResourceDetails[0].Account != "test-account" {
+		t.Errorf("Expected r.ResourceDetails[0].Account to be %v, got %v", "test-account", r.ResourceDetails[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listResourceDetails" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listResourceDetails: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listResourceDetails: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listResourceDetails: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*ResourceDetail
+	count, err := cs.Resourcemetadata.StreamResourceDetails(context.Background(), p, func(v *ResourceDetail) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listResourceDetails: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.ResourceDetails) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.ResourceDetails)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listResourceDetails", params, `<listresourcedetailsresponse><count>1</count><resourcedetail><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></resourcedetail></listresourcedetailsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Resourcemetadata.ListResourceDetails(p)
+	if err != nil {
+		t.Fatalf("Failed to call listResourceDetails using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Resourcemetadata.StreamResourceDetails(context.Background(), p, func(v *ResourceDetail) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listResourceDetails using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.ResourceDetails) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.ResourceDetails)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestRemoveResourceDetail(t *testing.T) {
+	params := url.Values{
+		"key":          {"test-key"},
+		"resourceid":   {"test-resourceid"},
+		"resourcetype": {"AutoScaleVmGroup"},
+	}
+	cs, done := newTestClient(t, "removeResourceDetail", params, `{"removeresourcedetailresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Resourcemetadata.NewRemoveResourceDetailParams("test-resourceid", TaggedResourceTypeAutoScaleVmGroup)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKey("test-key")
+	p.SetResourceid("test-resourceid")
+	p.SetResourcetype(TaggedResourceTypeAutoScaleVmGroup)
+	checkURLValues(t, "removeResourceDetail", p.URLValues(), params)
+
+	r, err := cs.Resourcemetadata.RemoveResourceDetail(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeResourceDetail: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "removeResourceDetail" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute removeResourceDetail: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of removeResourceDetail: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of removeResourceDetail: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "removeResourceDetail", params, `<removeresourcedetailresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></removeresourcedetailresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Resourcemetadata.RemoveResourceDetail(p)
+	if err != nil {
+		t.Fatalf("Failed to call removeResourceDetail using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -0,0 +1,268 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCreateTags(t *testing.T) {
+	params := url.Values{
+		"customer":      {"test-customer"},
+		"resourceids":   {"test-resourceids1, test-resourceids2"},
+		"resourcetype":  {"AutoScaleVmGroup"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "createTags", params, `{"createtagsresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Resourcetags.NewCreateTagsParams([]string{"test-resourceids1", "test-resourceids2"}, TaggedResourceTypeAutoScaleVmGroup, map[string]string{"test-tagskey": "test-tagsvalue"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetCustomer("test-customer")
+	p.SetResourceids([]string{"test-resourceids1", "test-resourceids2"})
+	p.SetResourcetype(TaggedResourceTypeAutoScaleVmGroup)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "createTags", p.URLValues(), params)
+
+	r, err := cs.Resourcetags.CreateTags(p)
+	if err != nil {
+		t.Fatalf("Failed to call createTags: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createTags" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createTags: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createTags: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createTags: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createTags", params, `<createtagsresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></createtagsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Resourcetags.CreateTags(p)
+	if err != nil {
+		t.Fatalf("Failed to call createTags using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteTags(t *testing.T) {
+	params := url.Values{
+		"resourceids":   {"test-resourceids1, test-resourceids2"},
+		"resourcetype":  {"AutoScaleVmGroup"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "deleteTags", params, `{"deletetagsresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Resourcetags.NewDeleteTagsParams([]string{"test-resourceids1", "test-resourceids2"}, TaggedResourceTypeAutoScaleVmGroup)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetResourceids([]string{"test-resourceids1", "test-resourceids2"})
+	p.SetResourcetype(TaggedResourceTypeAutoScaleVmGroup)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "deleteTags", p.URLValues(), params)
+
+	r, err := cs.Resourcetags.DeleteTags(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTags: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteTags" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteTags: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteTags: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteTags: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteTags", params, `<deletetagsresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletetagsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Resourcetags.DeleteTags(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTags using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListTags(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"customer":     {"test-customer"},
+		"domainid":     {"test-domainid"},
+		"isrecursive":  {"true"},
+		"key":          {"test-key"},
+		"keyword":      {"test-keyword"},
+		"listall":      {"true"},
+		"page":         {"8"},
+		"pagesize":     {"9"},
+		"projectid":    {"test-projectid"},
+		"resourceid":   {"test-resourceid"},
+		"resourcetype": {"AutoScaleVmGroup"},
+		"value":        {"test-value"},
+	}
+	cs, done := newTestClient(t, "listTags", params, `{"listtagsresponse":{"count":1,"tag":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}]}}`)
+	defer done()
+
+	p := cs.Resourcetags.NewListTagsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCustomer("test-customer")
+	p.SetDomainid("test-domainid")
+	p.SetIsrecursive(true)
+	p.SetKey("test-key")
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetResourceid("test-resourceid")
+	p.SetResourcetype(TaggedResourceTypeAutoScaleVmGroup)
+	p.SetValue("test-value")
+	checkURLValues(t, "listTags", p.URLValues(), params)
+
+	r, err := cs.Resourcetags.ListTags(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTags: %v", err)
+	}
+	if r.Tags[0].Account != "test-account" {
+		t.Errorf("Expected r.Tags[0].Account to be %v, got %v", "test-account", r.Tags[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listTags" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listTags: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listTags: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listTags: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Tag
+	count, err := cs.Resourcetags.StreamTags(context.Background(), p, func(v *Tag) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listTags: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Tags) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Tags)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listTags", params, `<listtagsresponse><count>1</count><tag><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tag></listtagsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Resourcetags.ListTags(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTags using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Resourcetags.StreamTags(context.Background(), p, func(v *Tag) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listTags using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Tags) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Tags)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
@@ -17,670 +17,1059 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
+type ServiceOfferingService struct {
+	cs *CloudStackClient
+}
+
+func NewServiceOfferingService(cs *CloudStackClient) *ServiceOfferingService {
+	return &ServiceOfferingService{cs: cs}
+}
+
 type CreateServiceOfferingParams struct {
-	p map[string]interface{}
+	Bytesreadrate             *int64            `json:"bytesreadrate,omitempty" yaml:"bytesreadrate,omitempty"`
+	Byteswriterate            *int64            `json:"byteswriterate,omitempty" yaml:"byteswriterate,omitempty"`
+	Cpunumber                 *int              `json:"cpunumber,omitempty" yaml:"cpunumber,omitempty"`
+	Cpuspeed                  *int              `json:"cpuspeed,omitempty" yaml:"cpuspeed,omitempty"`
+	Customizediops            *bool             `json:"customizediops,omitempty" yaml:"customizediops,omitempty"`
+	Deploymentplanner         *string           `json:"deploymentplanner,omitempty" yaml:"deploymentplanner,omitempty"`
+	Displaytext               *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid                  *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hosttags                  *string           `json:"hosttags,omitempty" yaml:"hosttags,omitempty"`
+	Hypervisorsnapshotreserve *int              `json:"hypervisorsnapshotreserve,omitempty" yaml:"hypervisorsnapshotreserve,omitempty"`
+	Iopsreadrate              *int64            `json:"iopsreadrate,omitempty" yaml:"iopsreadrate,omitempty"`
+	Iopswriterate             *int64            `json:"iopswriterate,omitempty" yaml:"iopswriterate,omitempty"`
+	Issystem                  *bool             `json:"issystem,omitempty" yaml:"issystem,omitempty"`
+	Isvolatile                *bool             `json:"isvolatile,omitempty" yaml:"isvolatile,omitempty"`
+	Limitcpuuse               *bool             `json:"limitcpuuse,omitempty" yaml:"limitcpuuse,omitempty"`
+	Maxiops                   *int64            `json:"maxiops,omitempty" yaml:"maxiops,omitempty"`
+	Memory                    *int              `json:"memory,omitempty" yaml:"memory,omitempty"`
+	Miniops                   *int64            `json:"miniops,omitempty" yaml:"miniops,omitempty"`
+	Name                      *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkrate               *int              `json:"networkrate,omitempty" yaml:"networkrate,omitempty"`
+	Offerha                   *bool             `json:"offerha,omitempty" yaml:"offerha,omitempty"`
+	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails,omitempty" yaml:"serviceofferingdetails,omitempty"`
+	Storagetype               *string           `json:"storagetype,omitempty" yaml:"storagetype,omitempty"`
+	Systemvmtype              *string           `json:"systemvmtype,omitempty" yaml:"systemvmtype,omitempty"`
+	Tags                      *string           `json:"tags,omitempty" yaml:"tags,omitempty"`
 }
 
-func (p *CreateServiceOfferingParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateServiceOfferingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bytesreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Bytesreadrate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("bytesreadrate", vv)
 	}
-	if v, found := p.p["byteswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Byteswriterate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("byteswriterate", vv)
 	}
-	if v, found := p.p["cpunumber"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Cpunumber; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("cpunumber", vv)
 	}
-	if v, found := p.p["cpuspeed"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Cpuspeed; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("cpuspeed", vv)
 	}
-	if v, found := p.p["customizediops"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Customizediops; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("customizediops", vv)
 	}
-	if v, found := p.p["deploymentplanner"]; found {
-		u.Set("deploymentplanner", v.(string))
+	if v := p.Deploymentplanner; v != nil {
+		u.Set("deploymentplanner", *v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["hosttags"]; found {
-		u.Set("hosttags", v.(string))
+	if v := p.Hosttags; v != nil {
+		u.Set("hosttags", *v)
 	}
-	if v, found := p.p["hypervisorsnapshotreserve"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Hypervisorsnapshotreserve; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("hypervisorsnapshotreserve", vv)
 	}
-	if v, found := p.p["iopsreadrate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Iopsreadrate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("iopsreadrate", vv)
 	}
-	if v, found := p.p["iopswriterate"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Iopswriterate; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("iopswriterate", vv)
 	}
-	if v, found := p.p["issystem"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Issystem; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("issystem", vv)
 	}
-	if v, found := p.p["isvolatile"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isvolatile; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isvolatile", vv)
 	}
-	if v, found := p.p["limitcpuuse"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Limitcpuuse; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("limitcpuuse", vv)
 	}
-	if v, found := p.p["maxiops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Maxiops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("maxiops", vv)
 	}
-	if v, found := p.p["memory"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Memory; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("memory", vv)
 	}
-	if v, found := p.p["miniops"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Miniops; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("miniops", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkrate"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Networkrate; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("networkrate", vv)
 	}
-	if v, found := p.p["offerha"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Offerha; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("offerha", vv)
 	}
-	if v, found := p.p["serviceofferingdetails"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("serviceofferingdetails[%d].key", i), k)
-			u.Set(fmt.Sprintf("serviceofferingdetails[%d].value", i), vv)
-			i++
+	if v := p.Serviceofferingdetails; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("serviceofferingdetails[0].%s", k), vv)
 		}
 	}
-	if v, found := p.p["storagetype"]; found {
-		u.Set("storagetype", v.(string))
+	if v := p.Storagetype; v != nil {
+		u.Set("storagetype", *v)
 	}
-	if v, found := p.p["systemvmtype"]; found {
-		u.Set("systemvmtype", v.(string))
+	if v := p.Systemvmtype; v != nil {
+		u.Set("systemvmtype", *v)
 	}
-	if v, found := p.p["tags"]; found {
-		u.Set("tags", v.(string))
+	if v := p.Tags; v != nil {
+		u.Set("tags", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *CreateServiceOfferingParams) Command() string {
+	return "createServiceOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateServiceOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateServiceOfferingParams) ResponseType() interface{} {
+	return &CreateServiceOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateServiceOfferingParams) Validate() error {
+	v := &validator{api: "createServiceOffering"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
 func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["bytesreadrate"] = v
+	p.Bytesreadrate = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetBytesreadrate() int64 {
+	if p.Bytesreadrate == nil {
+		return 0
 	}
-	p.p["byteswriterate"] = v
+	return *p.Bytesreadrate
+}
+
+func (p *CreateServiceOfferingParams) HasBytesreadrate() bool {
+	return p.Bytesreadrate != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetBytesreadrate() {
+	p.Bytesreadrate = nil
+}
+
+func (p *CreateServiceOfferingParams) SetByteswriterate(v int64) {
+	p.Byteswriterate = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetByteswriterate() int64 {
+	if p.Byteswriterate == nil {
+		return 0
 	}
-	p.p["cpunumber"] = v
+	return *p.Byteswriterate
+}
+
+func (p *CreateServiceOfferingParams) HasByteswriterate() bool {
+	return p.Byteswriterate != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetByteswriterate() {
+	p.Byteswriterate = nil
+}
+
+func (p *CreateServiceOfferingParams) SetCpunumber(v int) {
+	p.Cpunumber = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetCpunumber() int {
+	if p.Cpunumber == nil {
+		return 0
 	}
-	p.p["cpuspeed"] = v
+	return *p.Cpunumber
+}
+
+func (p *CreateServiceOfferingParams) HasCpunumber() bool {
+	return p.Cpunumber != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetCpunumber() {
+	p.Cpunumber = nil
+}
+
+func (p *CreateServiceOfferingParams) SetCpuspeed(v int) {
+	p.Cpuspeed = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetCpuspeed() int {
+	if p.Cpuspeed == nil {
+		return 0
 	}
-	p.p["customizediops"] = v
+	return *p.Cpuspeed
+}
+
+func (p *CreateServiceOfferingParams) HasCpuspeed() bool {
+	return p.Cpuspeed != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetCpuspeed() {
+	p.Cpuspeed = nil
+}
+
+func (p *CreateServiceOfferingParams) SetCustomizediops(v bool) {
+	p.Customizediops = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetCustomizediops() bool {
+	if p.Customizediops == nil {
+		return false
 	}
-	p.p["deploymentplanner"] = v
+	return *p.Customizediops
+}
+
+func (p *CreateServiceOfferingParams) HasCustomizediops() bool {
+	return p.Customizediops != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetCustomizediops() {
+	p.Customizediops = nil
+}
+
+func (p *CreateServiceOfferingParams) SetDeploymentplanner(v string) {
+	p.Deploymentplanner = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetDeploymentplanner() string {
+	if p.Deploymentplanner == nil {
+		return ""
 	}
-	p.p["displaytext"] = v
+	return *p.Deploymentplanner
+}
+
+func (p *CreateServiceOfferingParams) HasDeploymentplanner() bool {
+	return p.Deploymentplanner != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetDeploymentplanner() {
+	p.Deploymentplanner = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *CreateServiceOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Displaytext
+}
+
+func (p *CreateServiceOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateServiceOfferingParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetHosttags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["hosttags"] = v
+	return *p.Domainid
+}
+
+func (p *CreateServiceOfferingParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreateServiceOfferingParams) SetHosttags(v string) {
+	p.Hosttags = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetHosttags() string {
+	if p.Hosttags == nil {
+		return ""
 	}
-	p.p["hypervisorsnapshotreserve"] = v
+	return *p.Hosttags
+}
+
+func (p *CreateServiceOfferingParams) HasHosttags() bool {
+	return p.Hosttags != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetHosttags() {
+	p.Hosttags = nil
+}
+
+func (p *CreateServiceOfferingParams) SetHypervisorsnapshotreserve(v int) {
+	p.Hypervisorsnapshotreserve = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetHypervisorsnapshotreserve() int {
+	if p.Hypervisorsnapshotreserve == nil {
+		return 0
 	}
-	p.p["iopsreadrate"] = v
+	return *p.Hypervisorsnapshotreserve
+}
+
+func (p *CreateServiceOfferingParams) HasHypervisorsnapshotreserve() bool {
+	return p.Hypervisorsnapshotreserve != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetHypervisorsnapshotreserve() {
+	p.Hypervisorsnapshotreserve = nil
+}
+
+func (p *CreateServiceOfferingParams) SetIopsreadrate(v int64) {
+	p.Iopsreadrate = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetIopsreadrate() int64 {
+	if p.Iopsreadrate == nil {
+		return 0
 	}
-	p.p["iopswriterate"] = v
+	return *p.Iopsreadrate
+}
+
+func (p *CreateServiceOfferingParams) HasIopsreadrate() bool {
+	return p.Iopsreadrate != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetIopsreadrate() {
+	p.Iopsreadrate = nil
+}
+
+func (p *CreateServiceOfferingParams) SetIopswriterate(v int64) {
+	p.Iopswriterate = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetIopswriterate() int64 {
+	if p.Iopswriterate == nil {
+		return 0
 	}
-	p.p["issystem"] = v
+	return *p.Iopswriterate
+}
+
+func (p *CreateServiceOfferingParams) HasIopswriterate() bool {
+	return p.Iopswriterate != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetIopswriterate() {
+	p.Iopswriterate = nil
+}
+
+func (p *CreateServiceOfferingParams) SetIssystem(v bool) {
+	p.Issystem = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetIssystem() bool {
+	if p.Issystem == nil {
+		return false
 	}
-	p.p["isvolatile"] = v
+	return *p.Issystem
+}
+
+func (p *CreateServiceOfferingParams) HasIssystem() bool {
+	return p.Issystem != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetIssystem() {
+	p.Issystem = nil
+}
+
+func (p *CreateServiceOfferingParams) SetIsvolatile(v bool) {
+	p.Isvolatile = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetIsvolatile() bool {
+	if p.Isvolatile == nil {
+		return false
 	}
-	p.p["limitcpuuse"] = v
+	return *p.Isvolatile
+}
+
+func (p *CreateServiceOfferingParams) HasIsvolatile() bool {
+	return p.Isvolatile != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetIsvolatile() {
+	p.Isvolatile = nil
+}
+
+func (p *CreateServiceOfferingParams) SetLimitcpuuse(v bool) {
+	p.Limitcpuuse = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetLimitcpuuse() bool {
+	if p.Limitcpuuse == nil {
+		return false
 	}
-	p.p["maxiops"] = v
+	return *p.Limitcpuuse
+}
+
+func (p *CreateServiceOfferingParams) HasLimitcpuuse() bool {
+	return p.Limitcpuuse != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetLimitcpuuse() {
+	p.Limitcpuuse = nil
+}
+
+func (p *CreateServiceOfferingParams) SetMaxiops(v int64) {
+	p.Maxiops = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetMemory(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetMaxiops() int64 {
+	if p.Maxiops == nil {
+		return 0
 	}
-	p.p["memory"] = v
+	return *p.Maxiops
+}
+
+func (p *CreateServiceOfferingParams) HasMaxiops() bool {
+	return p.Maxiops != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetMaxiops() {
+	p.Maxiops = nil
+}
+
+func (p *CreateServiceOfferingParams) SetMemory(v int) {
+	p.Memory = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetMemory() int {
+	if p.Memory == nil {
+		return 0
 	}
-	p.p["miniops"] = v
+	return *p.Memory
+}
+
+func (p *CreateServiceOfferingParams) HasMemory() bool {
+	return p.Memory != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetMemory() {
+	p.Memory = nil
+}
+
+func (p *CreateServiceOfferingParams) SetMiniops(v int64) {
+	p.Miniops = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetMiniops() int64 {
+	if p.Miniops == nil {
+		return 0
 	}
-	p.p["name"] = v
+	return *p.Miniops
+}
+
+func (p *CreateServiceOfferingParams) HasMiniops() bool {
+	return p.Miniops != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetMiniops() {
+	p.Miniops = nil
+}
+
+// This param is required.
+func (p *CreateServiceOfferingParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["networkrate"] = v
+	return *p.Name
+}
+
+func (p *CreateServiceOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreateServiceOfferingParams) SetNetworkrate(v int) {
+	p.Networkrate = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetNetworkrate() int {
+	if p.Networkrate == nil {
+		return 0
 	}
-	p.p["offerha"] = v
+	return *p.Networkrate
+}
+
+func (p *CreateServiceOfferingParams) HasNetworkrate() bool {
+	return p.Networkrate != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetNetworkrate() {
+	p.Networkrate = nil
+}
+
+func (p *CreateServiceOfferingParams) SetOfferha(v bool) {
+	p.Offerha = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetOfferha() bool {
+	if p.Offerha == nil {
+		return false
 	}
-	p.p["serviceofferingdetails"] = v
+	return *p.Offerha
+}
+
+func (p *CreateServiceOfferingParams) HasOfferha() bool {
+	return p.Offerha != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetOfferha() {
+	p.Offerha = nil
+}
+
+func (p *CreateServiceOfferingParams) SetServiceofferingdetails(v map[string]string) {
+	p.Serviceofferingdetails = v
 	return
 }
 
+func (p *CreateServiceOfferingParams) GetServiceofferingdetails() map[string]string {
+	return p.Serviceofferingdetails
+}
+
+func (p *CreateServiceOfferingParams) HasServiceofferingdetails() bool {
+	return p.Serviceofferingdetails != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetServiceofferingdetails() {
+	p.Serviceofferingdetails = nil
+}
+
 func (p *CreateServiceOfferingParams) SetStoragetype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["storagetype"] = v
+	p.Storagetype = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetStoragetype() string {
+	if p.Storagetype == nil {
+		return ""
 	}
-	p.p["systemvmtype"] = v
+	return *p.Storagetype
+}
+
+func (p *CreateServiceOfferingParams) HasStoragetype() bool {
+	return p.Storagetype != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetStoragetype() {
+	p.Storagetype = nil
+}
+
+func (p *CreateServiceOfferingParams) SetSystemvmtype(v string) {
+	p.Systemvmtype = &v
 	return
 }
 
-func (p *CreateServiceOfferingParams) SetTags(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateServiceOfferingParams) GetSystemvmtype() string {
+	if p.Systemvmtype == nil {
+		return ""
 	}
-	p.p["tags"] = v
+	return *p.Systemvmtype
+}
+
+func (p *CreateServiceOfferingParams) HasSystemvmtype() bool {
+	return p.Systemvmtype != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetSystemvmtype() {
+	p.Systemvmtype = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *CreateServiceOfferingParams) SetTags(v string) {
+	p.Tags = &v
 	return
 }
 
+func (p *CreateServiceOfferingParams) GetTags() string {
+	if p.Tags == nil {
+		return ""
+	}
+	return *p.Tags
+}
+
+func (p *CreateServiceOfferingParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *CreateServiceOfferingParams) ResetTags() {
+	p.Tags = nil
+}
+
 // You should always use this function to get a new CreateServiceOfferingParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
 func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams {
 	p := &CreateServiceOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
 	return p
 }
 
 // Creates a service offering.
+//
+// See also ListServiceOfferings, GetServiceOfferingID, GetServiceOfferingByName, GetServiceOfferingByID.
 func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createServiceOffering", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createServiceOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createServiceOffering", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
 type CreateServiceOfferingResponse struct {
-	Cpunumber                 int               `json:"cpunumber,omitempty"`
-	Cpuspeed                  int               `json:"cpuspeed,omitempty"`
-	Created                   string            `json:"created,omitempty"`
-	Defaultuse                bool              `json:"defaultuse,omitempty"`
-	Deploymentplanner         string            `json:"deploymentplanner,omitempty"`
-	DiskBytesReadRate         int64             `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate        int64             `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate          int64             `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate         int64             `json:"diskIopsWriteRate,omitempty"`
-	Displaytext               string            `json:"displaytext,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Hosttags                  string            `json:"hosttags,omitempty"`
-	Hypervisorsnapshotreserve int               `json:"hypervisorsnapshotreserve,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Iscustomized              bool              `json:"iscustomized,omitempty"`
-	Iscustomizediops          bool              `json:"iscustomizediops,omitempty"`
-	Issystem                  bool              `json:"issystem,omitempty"`
-	Isvolatile                bool              `json:"isvolatile,omitempty"`
-	Limitcpuuse               bool              `json:"limitcpuuse,omitempty"`
-	Maxiops                   int64             `json:"maxiops,omitempty"`
-	Memory                    int               `json:"memory,omitempty"`
-	Miniops                   int64             `json:"miniops,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkrate               int               `json:"networkrate,omitempty"`
-	Offerha                   bool              `json:"offerha,omitempty"`
-	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails,omitempty"`
-	Storagetype               string            `json:"storagetype,omitempty"`
-	Systemvmtype              string            `json:"systemvmtype,omitempty"`
-	Tags                      string            `json:"tags,omitempty"`
-}
-
-type DeleteServiceOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+	Cpunumber                 int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed                  int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Created                   Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Defaultuse                Bool                       `json:"defaultuse,omitempty" xml:"defaultuse,omitempty"`
+	Deploymentplanner         string                     `json:"deploymentplanner,omitempty" xml:"deploymentplanner,omitempty"`
+	DiskBytesReadRate         int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate        int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate          int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate         int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hosttags                  string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisorsnapshotreserve int                        `json:"hypervisorsnapshotreserve,omitempty" xml:"hypervisorsnapshotreserve,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscustomized              Bool                       `json:"iscustomized,omitempty" xml:"iscustomized,omitempty"`
+	Iscustomizediops          Bool                       `json:"iscustomizediops,omitempty" xml:"iscustomizediops,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Isvolatile                Bool                       `json:"isvolatile,omitempty" xml:"isvolatile,omitempty"`
+	Limitcpuuse               Bool                       `json:"limitcpuuse,omitempty" xml:"limitcpuuse,omitempty"`
+	Maxiops                   int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Memory                    int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Miniops                   int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkrate               int                        `json:"networkrate,omitempty" xml:"networkrate,omitempty"`
+	Offerha                   Bool                       `json:"offerha,omitempty" xml:"offerha,omitempty"`
+	Serviceofferingdetails    map[string]string          `json:"serviceofferingdetails,omitempty" xml:"serviceofferingdetails,omitempty"`
+	Storagetype               string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Systemvmtype              string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Tags                      string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *DeleteServiceOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteServiceOfferingParams = cloudstackcommon.DeleteServiceOfferingParams
+type DeleteServiceOfferingResponse = cloudstackcommon.DeleteServiceOfferingResponse
 
 // You should always use this function to get a new DeleteServiceOfferingParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams {
 	p := &DeleteServiceOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes a service offering.
+//
+// See also ListServiceOfferings, GetServiceOfferingID, GetServiceOfferingByName, GetServiceOfferingByID.
 func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteServiceOffering", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteServiceOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteServiceOffering", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteServiceOfferingResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
-type UpdateServiceOfferingParams struct {
-	p map[string]interface{}
+type ListServiceOfferingsParams struct {
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Issystem         *bool   `json:"issystem,omitempty" yaml:"issystem,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Systemvmtype     *string `json:"systemvmtype,omitempty" yaml:"systemvmtype,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
 }
 
-func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListServiceOfferingsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Issystem; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("issystem", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["sortkey"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("sortkey", vv)
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Systemvmtype; v != nil {
+		u.Set("systemvmtype", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
 	}
 	return u
 }
 
-func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListServiceOfferingsParams) Command() string {
+	return "listServiceOfferings"
 }
 
-func (p *UpdateServiceOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListServiceOfferingsParams) IsAsync() bool {
+	return false
 }
 
-func (p *UpdateServiceOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListServiceOfferingsParams) ResponseType() interface{} {
+	return &ListServiceOfferingsResponse{}
 }
 
-func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sortkey"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListServiceOfferingsParams) Validate() error {
+	v := &validator{api: "listServiceOfferings"}
+	return v.err()
 }
 
-// You should always use this function to get a new UpdateServiceOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams {
-	p := &UpdateServiceOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListServiceOfferingsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
 }
 
-// Updates a service offering.
-func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateServiceOffering", p.toURLValues())
-	if err != nil {
-		return nil, err
+func (p *ListServiceOfferingsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
+	return *p.Domainid
+}
 
-	var r UpdateServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *ListServiceOfferingsParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-type UpdateServiceOfferingResponse struct {
-	Cpunumber                 int               `json:"cpunumber,omitempty"`
-	Cpuspeed                  int               `json:"cpuspeed,omitempty"`
-	Created                   string            `json:"created,omitempty"`
-	Defaultuse                bool              `json:"defaultuse,omitempty"`
-	Deploymentplanner         string            `json:"deploymentplanner,omitempty"`
-	DiskBytesReadRate         int64             `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate        int64             `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate          int64             `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate         int64             `json:"diskIopsWriteRate,omitempty"`
-	Displaytext               string            `json:"displaytext,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Hosttags                  string            `json:"hosttags,omitempty"`
-	Hypervisorsnapshotreserve int               `json:"hypervisorsnapshotreserve,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Iscustomized              bool              `json:"iscustomized,omitempty"`
-	Iscustomizediops          bool              `json:"iscustomizediops,omitempty"`
-	Issystem                  bool              `json:"issystem,omitempty"`
-	Isvolatile                bool              `json:"isvolatile,omitempty"`
-	Limitcpuuse               bool              `json:"limitcpuuse,omitempty"`
-	Maxiops                   int64             `json:"maxiops,omitempty"`
-	Memory                    int               `json:"memory,omitempty"`
-	Miniops                   int64             `json:"miniops,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkrate               int               `json:"networkrate,omitempty"`
-	Offerha                   bool              `json:"offerha,omitempty"`
-	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails,omitempty"`
-	Storagetype               string            `json:"storagetype,omitempty"`
-	Systemvmtype              string            `json:"systemvmtype,omitempty"`
-	Tags                      string            `json:"tags,omitempty"`
+func (p *ListServiceOfferingsParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-type ListServiceOfferingsParams struct {
-	p map[string]interface{}
+func (p *ListServiceOfferingsParams) SetId(v string) {
+	p.Id = &v
+	return
 }
 
-func (p *ListServiceOfferingsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["issystem"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("issystem", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+func (p *ListServiceOfferingsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["systemvmtype"]; found {
-		u.Set("systemvmtype", v.(string))
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
+	return *p.Id
 }
 
-func (p *ListServiceOfferingsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+func (p *ListServiceOfferingsParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *ListServiceOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListServiceOfferingsParams) ResetId() {
+	p.Id = nil
 }
 
 func (p *ListServiceOfferingsParams) SetIssystem(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["issystem"] = v
+	p.Issystem = &v
 	return
 }
 
-func (p *ListServiceOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListServiceOfferingsParams) GetIssystem() bool {
+	if p.Issystem == nil {
+		return false
 	}
-	p.p["keyword"] = v
+	return *p.Issystem
+}
+
+func (p *ListServiceOfferingsParams) HasIssystem() bool {
+	return p.Issystem != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetIssystem() {
+	p.Issystem = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListServiceOfferingsParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListServiceOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListServiceOfferingsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.Keyword
+}
+
+func (p *ListServiceOfferingsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListServiceOfferingsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListServiceOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListServiceOfferingsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Name
+}
+
+func (p *ListServiceOfferingsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListServiceOfferingsParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListServiceOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListServiceOfferingsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListServiceOfferingsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListServiceOfferingsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListServiceOfferingsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["systemvmtype"] = v
+	return *p.Pagesize
+}
+
+func (p *ListServiceOfferingsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListServiceOfferingsParams) SetSystemvmtype(v string) {
+	p.Systemvmtype = &v
 	return
 }
 
-func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListServiceOfferingsParams) GetSystemvmtype() string {
+	if p.Systemvmtype == nil {
+		return ""
 	}
-	p.p["virtualmachineid"] = v
+	return *p.Systemvmtype
+}
+
+func (p *ListServiceOfferingsParams) HasSystemvmtype() bool {
+	return p.Systemvmtype != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetSystemvmtype() {
+	p.Systemvmtype = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListServiceOfferingsParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
 	return
 }
 
+func (p *ListServiceOfferingsParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *ListServiceOfferingsParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListServiceOfferingsParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
 // You should always use this function to get a new ListServiceOfferingsParams instance,
 // as then you are sure you have configured all required params
 func (s *ServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOfferingsParams {
 	p := &ListServiceOfferingsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ServiceOfferingService) GetServiceOfferingID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListServiceOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListServiceOfferings(p)
 	if err != nil {
@@ -695,24 +1084,37 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string) (string, erro
 		return l.ServiceOfferings[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.ServiceOfferings {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*ServiceOffering
+	for _, v := range l.ServiceOfferings {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "ServiceOffering", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:     v.Id,
+				Name:   v.Name,
+				Domain: v.Domain,
+			})
+		}
+		return "", e
+	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ServiceOfferingService) GetServiceOfferingByName(name string) (*ServiceOffering, int, error) {
-	id, err := s.GetServiceOfferingID(name)
+func (s *ServiceOfferingService) GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	id, err := s.GetServiceOfferingID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetServiceOfferingByID(id)
+	r, count, err := s.GetServiceOfferingByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -720,11 +1122,16 @@ func (s *ServiceOfferingService) GetServiceOfferingByName(name string) (*Service
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *ServiceOfferingService) GetServiceOfferingByID(id string) (*ServiceOffering, int, error) {
+func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
 	p := &ListServiceOfferingsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListServiceOfferings(p)
 	if err != nil {
@@ -747,53 +1154,281 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string) (*ServiceOffe
 }
 
 // Lists all available service offerings.
+//
+// See also GetServiceOfferingID, GetServiceOfferingByName, GetServiceOfferingByID.
 func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listServiceOfferings", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listServiceOfferings", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListServiceOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listServiceOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListServiceOfferings, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *ServiceOfferingService) StreamServiceOfferings(ctx context.Context, p *ListServiceOfferingsParams, fn func(*ServiceOffering) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listServiceOfferings", p.URLValues(), "serviceoffering", func(b json.RawMessage) error {
+		var v ServiceOffering
+		if err := s.cs.unmarshalElement("listServiceOfferings", "serviceoffering", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListServiceOfferingsResponse struct {
-	Count            int                `json:"count"`
-	ServiceOfferings []*ServiceOffering `json:"serviceoffering"`
+	Count            int                        `json:"count" xml:"count"`
+	ServiceOfferings []*ServiceOffering         `json:"serviceoffering" xml:"serviceoffering"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type ServiceOffering struct {
-	Cpunumber                 int               `json:"cpunumber,omitempty"`
-	Cpuspeed                  int               `json:"cpuspeed,omitempty"`
-	Created                   string            `json:"created,omitempty"`
-	Defaultuse                bool              `json:"defaultuse,omitempty"`
-	Deploymentplanner         string            `json:"deploymentplanner,omitempty"`
-	DiskBytesReadRate         int64             `json:"diskBytesReadRate,omitempty"`
-	DiskBytesWriteRate        int64             `json:"diskBytesWriteRate,omitempty"`
-	DiskIopsReadRate          int64             `json:"diskIopsReadRate,omitempty"`
-	DiskIopsWriteRate         int64             `json:"diskIopsWriteRate,omitempty"`
-	Displaytext               string            `json:"displaytext,omitempty"`
-	Domain                    string            `json:"domain,omitempty"`
-	Domainid                  string            `json:"domainid,omitempty"`
-	Hosttags                  string            `json:"hosttags,omitempty"`
-	Hypervisorsnapshotreserve int               `json:"hypervisorsnapshotreserve,omitempty"`
-	Id                        string            `json:"id,omitempty"`
-	Iscustomized              bool              `json:"iscustomized,omitempty"`
-	Iscustomizediops          bool              `json:"iscustomizediops,omitempty"`
-	Issystem                  bool              `json:"issystem,omitempty"`
-	Isvolatile                bool              `json:"isvolatile,omitempty"`
-	Limitcpuuse               bool              `json:"limitcpuuse,omitempty"`
-	Maxiops                   int64             `json:"maxiops,omitempty"`
-	Memory                    int               `json:"memory,omitempty"`
-	Miniops                   int64             `json:"miniops,omitempty"`
-	Name                      string            `json:"name,omitempty"`
-	Networkrate               int               `json:"networkrate,omitempty"`
-	Offerha                   bool              `json:"offerha,omitempty"`
-	Serviceofferingdetails    map[string]string `json:"serviceofferingdetails,omitempty"`
-	Storagetype               string            `json:"storagetype,omitempty"`
-	Systemvmtype              string            `json:"systemvmtype,omitempty"`
-	Tags                      string            `json:"tags,omitempty"`
+	Cpunumber                 int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed                  int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Created                   Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Defaultuse                Bool                       `json:"defaultuse,omitempty" xml:"defaultuse,omitempty"`
+	Deploymentplanner         string                     `json:"deploymentplanner,omitempty" xml:"deploymentplanner,omitempty"`
+	DiskBytesReadRate         int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate        int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate          int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate         int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hosttags                  string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisorsnapshotreserve int                        `json:"hypervisorsnapshotreserve,omitempty" xml:"hypervisorsnapshotreserve,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscustomized              Bool                       `json:"iscustomized,omitempty" xml:"iscustomized,omitempty"`
+	Iscustomizediops          Bool                       `json:"iscustomizediops,omitempty" xml:"iscustomizediops,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Isvolatile                Bool                       `json:"isvolatile,omitempty" xml:"isvolatile,omitempty"`
+	Limitcpuuse               Bool                       `json:"limitcpuuse,omitempty" xml:"limitcpuuse,omitempty"`
+	Maxiops                   int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Memory                    int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Miniops                   int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkrate               int                        `json:"networkrate,omitempty" xml:"networkrate,omitempty"`
+	Offerha                   Bool                       `json:"offerha,omitempty" xml:"offerha,omitempty"`
+	Serviceofferingdetails    map[string]string          `json:"serviceofferingdetails,omitempty" xml:"serviceofferingdetails,omitempty"`
+	Storagetype               string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Systemvmtype              string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Tags                      string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateServiceOfferingParams struct {
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sortkey     *int    `json:"sortkey,omitempty" yaml:"sortkey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateServiceOfferingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Sortkey; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("sortkey", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateServiceOfferingParams) Command() string {
+	return "updateServiceOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateServiceOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateServiceOfferingParams) ResponseType() interface{} {
+	return &UpdateServiceOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateServiceOfferingParams) Validate() error {
+	v := &validator{api: "updateServiceOffering"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateServiceOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *UpdateServiceOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *UpdateServiceOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *UpdateServiceOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// This param is required.
+func (p *UpdateServiceOfferingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateServiceOfferingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateServiceOfferingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateServiceOfferingParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateServiceOfferingParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateServiceOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateServiceOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateServiceOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *UpdateServiceOfferingParams) SetSortkey(v int) {
+	p.Sortkey = &v
+	return
+}
+
+func (p *UpdateServiceOfferingParams) GetSortkey() int {
+	if p.Sortkey == nil {
+		return 0
+	}
+	return *p.Sortkey
+}
+
+func (p *UpdateServiceOfferingParams) HasSortkey() bool {
+	return p.Sortkey != nil
+}
+
+func (p *UpdateServiceOfferingParams) ResetSortkey() {
+	p.Sortkey = nil
+}
+
+// You should always use this function to get a new UpdateServiceOfferingParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams {
+	p := &UpdateServiceOfferingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates a service offering.
+//
+// See also ListServiceOfferings, GetServiceOfferingID, GetServiceOfferingByName, GetServiceOfferingByID.
+func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateServiceOffering", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r UpdateServiceOfferingResponse
+	if err := s.cs.unmarshal("updateServiceOffering", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type UpdateServiceOfferingResponse struct {
+	Cpunumber                 int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed                  int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Created                   Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Defaultuse                Bool                       `json:"defaultuse,omitempty" xml:"defaultuse,omitempty"`
+	Deploymentplanner         string                     `json:"deploymentplanner,omitempty" xml:"deploymentplanner,omitempty"`
+	DiskBytesReadRate         int64                      `json:"diskBytesReadRate,omitempty" xml:"diskBytesReadRate,omitempty"`
+	DiskBytesWriteRate        int64                      `json:"diskBytesWriteRate,omitempty" xml:"diskBytesWriteRate,omitempty"`
+	DiskIopsReadRate          int64                      `json:"diskIopsReadRate,omitempty" xml:"diskIopsReadRate,omitempty"`
+	DiskIopsWriteRate         int64                      `json:"diskIopsWriteRate,omitempty" xml:"diskIopsWriteRate,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hosttags                  string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisorsnapshotreserve int                        `json:"hypervisorsnapshotreserve,omitempty" xml:"hypervisorsnapshotreserve,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscustomized              Bool                       `json:"iscustomized,omitempty" xml:"iscustomized,omitempty"`
+	Iscustomizediops          Bool                       `json:"iscustomizediops,omitempty" xml:"iscustomizediops,omitempty"`
+	Issystem                  Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Isvolatile                Bool                       `json:"isvolatile,omitempty" xml:"isvolatile,omitempty"`
+	Limitcpuuse               Bool                       `json:"limitcpuuse,omitempty" xml:"limitcpuuse,omitempty"`
+	Maxiops                   int64                      `json:"maxiops,omitempty" xml:"maxiops,omitempty"`
+	Memory                    int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Miniops                   int64                      `json:"miniops,omitempty" xml:"miniops,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkrate               int                        `json:"networkrate,omitempty" xml:"networkrate,omitempty"`
+	Offerha                   Bool                       `json:"offerha,omitempty" xml:"offerha,omitempty"`
+	Serviceofferingdetails    map[string]string          `json:"serviceofferingdetails,omitempty" xml:"serviceofferingdetails,omitempty"`
+	Storagetype               string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Systemvmtype              string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Tags                      string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
 }
@@ -17,99 +17,57 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
-)
-
-type CreateSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["policyid"]; found {
-		u.Set("policyid", v.(string))
-	}
-	if v, found := p.p["quiescevm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("quiescevm", vv)
-	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
-	}
-	return u
-}
-
-func (p *CreateSnapshotParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
 
-func (p *CreateSnapshotParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
+)
 
-func (p *CreateSnapshotParams) SetPolicyid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["policyid"] = v
-	return
+type SnapshotService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiescevm"] = v
-	return
+func NewSnapshotService(cs *CloudStackClient) *SnapshotService {
+	return &SnapshotService{cs: cs}
 }
 
-func (p *CreateSnapshotParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["volumeid"] = v
-	return
-}
+type CreateSnapshotParams = cloudstackcommon.CreateSnapshotParams
+type CreateSnapshotResponse = cloudstackcommon.CreateSnapshotResponse
 
 // You should always use this function to get a new CreateSnapshotParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - volumeid: the ID of the volume
 func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams {
 	p := &CreateSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["volumeid"] = volumeid
+	p.SetVolumeid(volumeid)
 	return p
 }
 
 // Creates an instant snapshot of a volume.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSnapshots, GetSnapshotID, GetSnapshotByName, GetSnapshotByID, GetSnapshotsByTags.
 func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshot", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createSnapshot", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -130,251 +88,108 @@ func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapsh
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateSnapshotResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Account      string `json:"account,omitempty"`
-	Created      string `json:"created,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Intervaltype string `json:"intervaltype,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Revertable   bool   `json:"revertable,omitempty"`
-	Snapshottype string `json:"snapshottype,omitempty"`
-	State        string `json:"state,omitempty"`
-	Tags         []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Volumeid   string `json:"volumeid,omitempty"`
-	Volumename string `json:"volumename,omitempty"`
-	Volumetype string `json:"volumetype,omitempty"`
-	Zoneid     string `json:"zoneid,omitempty"`
-}
-
-type ListSnapshotsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListSnapshotsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["snapshottype"]; found {
-		u.Set("snapshottype", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListSnapshotsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetIntervaltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["intervaltype"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
-}
-
-func (p *ListSnapshotsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+type DeleteSnapshotParams = cloudstackcommon.DeleteSnapshotParams
+type DeleteSnapshotResponse = cloudstackcommon.DeleteSnapshotResponse
 
-func (p *ListSnapshotsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// You should always use this function to get a new DeleteSnapshotParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotParams {
+	p := &DeleteSnapshotParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *ListSnapshotsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Deletes a snapshot of a disk volume.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSnapshots, GetSnapshotID, GetSnapshotByName, GetSnapshotByID, GetSnapshotsByTags.
+func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["pagesize"] = v
-	return
-}
 
-func (p *ListSnapshotsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteSnapshot", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["projectid"] = v
-	return
-}
 
-func (p *ListSnapshotsParams) SetSnapshottype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteSnapshotResponse
+	if err := s.cs.unmarshal("deleteSnapshot", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["snapshottype"] = v
-	return
-}
 
-func (p *ListSnapshotsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *ListSnapshotsParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("deleteSnapshot", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["volumeid"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListSnapshotsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListSnapshotsParams = cloudstackcommon.ListSnapshotsParams
+type ListSnapshotsResponse = cloudstackcommon.ListSnapshotsResponse
+type Snapshot = cloudstackcommon.Snapshot
 
 // You should always use this function to get a new ListSnapshotsParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
 	p := &ListSnapshotsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SnapshotService) GetSnapshotID(name string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string, error) {
 	p := &ListSnapshotsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["name"] = name
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListSnapshots(p)
 	if err != nil {
 		return "", err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListSnapshots(p)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	if l.Count == 0 {
 		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
@@ -383,24 +198,38 @@ func (s *SnapshotService) GetSnapshotID(name string) (string, error) {
 		return l.Snapshots[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.Snapshots {
-			if v.Name == name {
-				return v.Id, nil
-			}
+	var matches []*Snapshot
+	for _, v := range l.Snapshots {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "Snapshot", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SnapshotService) GetSnapshotByName(name string) (*Snapshot, int, error) {
-	id, err := s.GetSnapshotID(name)
+func (s *SnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error) {
+	id, err := s.GetSnapshotID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetSnapshotByID(id)
+	r, count, err := s.GetSnapshotByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -408,11 +237,16 @@ func (s *SnapshotService) GetSnapshotByName(name string) (*Snapshot, int, error)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SnapshotService) GetSnapshotByID(id string) (*Snapshot, int, error) {
+func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error) {
 	p := &ListSnapshotsParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListSnapshots(p)
 	if err != nil {
@@ -424,6 +258,20 @@ func (s *SnapshotService) GetSnapshotByID(id string) (*Snapshot, int, error) {
 		return nil, -1, err
 	}
 
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListSnapshots(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
 	if l.Count == 0 {
 		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
@@ -434,97 +282,111 @@ func (s *SnapshotService) GetSnapshotByID(id string) (*Snapshot, int, error) {
 	return nil, l.Count, fmt.Errorf("There is more then one result for Snapshot UUID: %s!", id)
 }
 
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *SnapshotService) GetSnapshotsByTags(tags map[string]string, opts ...OptionFunc) ([]*Snapshot, error) {
+	p := &ListSnapshotsParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListSnapshots(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListSnapshots(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.Snapshots, nil
+}
+
 // Lists all available snapshots for the account.
+//
+// See also GetSnapshotID, GetSnapshotByName, GetSnapshotByID, GetSnapshotsByTags.
 func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshots", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSnapshots", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSnapshotsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listSnapshots", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListSnapshotsResponse struct {
-	Count     int         `json:"count"`
-	Snapshots []*Snapshot `json:"snapshot"`
-}
-
-type Snapshot struct {
-	Account      string `json:"account,omitempty"`
-	Created      string `json:"created,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Intervaltype string `json:"intervaltype,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Revertable   bool   `json:"revertable,omitempty"`
-	Snapshottype string `json:"snapshottype,omitempty"`
-	State        string `json:"state,omitempty"`
-	Tags         []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Volumeid   string `json:"volumeid,omitempty"`
-	Volumename string `json:"volumename,omitempty"`
-	Volumetype string `json:"volumetype,omitempty"`
-	Zoneid     string `json:"zoneid,omitempty"`
-}
-
-type DeleteSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// Lists the objects like ListSnapshots, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SnapshotService) StreamSnapshots(ctx context.Context, p *ListSnapshotsParams, fn func(*Snapshot) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	return u
-}
 
-func (p *DeleteSnapshotParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+	return s.cs.streamList(ctx, "listSnapshots", p.URLValues(), "snapshot", func(b json.RawMessage) error {
+		var v Snapshot
+		if err := s.cs.unmarshalElement("listSnapshots", "snapshot", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new DeleteSnapshotParams instance,
+type RevertSnapshotParams = cloudstackcommon.RevertSnapshotParams
+type RevertSnapshotResponse = cloudstackcommon.RevertSnapshotResponse
+
+// You should always use this function to get a new RevertSnapshotParams instance,
 // as then you are sure you have configured all required params
-func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotParams {
-	p := &DeleteSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotParams {
+	p := &RevertSnapshotParams{}
+	p.SetId(id)
 	return p
 }
 
-// Deletes a snapshot of a disk volume.
-func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshot", p.toURLValues())
+// revert a volume snapshot.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSnapshots, GetSnapshotID, GetSnapshotByName, GetSnapshotByID, GetSnapshotsByTags.
+func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("revertSnapshot", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RevertSnapshotResponse
+	if err := s.cs.unmarshal("revertSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -540,394 +402,495 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("revertSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteSnapshotResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
 type CreateSnapshotPolicyParams struct {
-	p map[string]interface{}
+	Fordisplay   *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Intervaltype *SnapshotInterval `json:"intervaltype,omitempty" yaml:"intervaltype,omitempty"`
+	Maxsnaps     *int              `json:"maxsnaps,omitempty" yaml:"maxsnaps,omitempty"`
+	Schedule     *string           `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Timezone     *string           `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Volumeid     *string           `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
 }
 
-func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateSnapshotPolicyParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+	if v := p.Intervaltype; v != nil {
+		u.Set("intervaltype", string(*v))
 	}
-	if v, found := p.p["maxsnaps"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Maxsnaps; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("maxsnaps", vv)
 	}
-	if v, found := p.p["schedule"]; found {
-		u.Set("schedule", v.(string))
+	if v := p.Schedule; v != nil {
+		u.Set("schedule", *v)
 	}
-	if v, found := p.p["timezone"]; found {
-		u.Set("timezone", v.(string))
+	if v := p.Timezone; v != nil {
+		u.Set("timezone", *v)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if v := p.Volumeid; v != nil {
+		u.Set("volumeid", *v)
 	}
 	return u
 }
 
-func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateSnapshotPolicyParams) Command() string {
+	return "createSnapshotPolicy"
 }
 
-func (p *CreateSnapshotPolicyParams) SetIntervaltype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["intervaltype"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateSnapshotPolicyParams) IsAsync() bool {
+	return false
 }
 
-func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["maxsnaps"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateSnapshotPolicyParams) ResponseType() interface{} {
+	return &CreateSnapshotPolicyResponse{}
 }
 
-func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateSnapshotPolicyParams) Validate() error {
+	v := &validator{api: "createSnapshotPolicy"}
+	v.required("intervaltype", p.Intervaltype != nil)
+	if p.Intervaltype != nil {
+		v.enum("intervaltype", string(*p.Intervaltype), p.Intervaltype.Known(), false)
 	}
-	p.p["schedule"] = v
-	return
+	v.required("maxsnaps", p.Maxsnaps != nil)
+	v.required("schedule", p.Schedule != nil)
+	v.required("timezone", p.Timezone != nil)
+	v.required("volumeid", p.Volumeid != nil)
+	return v.err()
 }
 
-func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["timezone"] = v
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSnapshotPolicyParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["volumeid"] = v
-	return
+	return *p.Fordisplay
 }
 
-// You should always use this function to get a new CreateSnapshotPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
-	p := &CreateSnapshotPolicyParams{}
-	p.p = make(map[string]interface{})
-	p.p["intervaltype"] = intervaltype
-	p.p["maxsnaps"] = maxsnaps
-	p.p["schedule"] = schedule
-	p.p["timezone"] = timezone
-	p.p["volumeid"] = volumeid
-	return p
+func (p *CreateSnapshotPolicyParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-// Creates a snapshot policy for the account.
-func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("createSnapshotPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateSnapshotPolicyParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
 
-	var r CreateSnapshotPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+// This param is required.
+func (p *CreateSnapshotPolicyParams) SetIntervaltype(v SnapshotInterval) {
+	p.Intervaltype = &v
+	return
 }
 
-type CreateSnapshotPolicyResponse struct {
-	Fordisplay   bool   `json:"fordisplay,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Intervaltype int    `json:"intervaltype,omitempty"`
-	Maxsnaps     int    `json:"maxsnaps,omitempty"`
-	Schedule     string `json:"schedule,omitempty"`
-	Timezone     string `json:"timezone,omitempty"`
-	Volumeid     string `json:"volumeid,omitempty"`
+func (p *CreateSnapshotPolicyParams) GetIntervaltype() SnapshotInterval {
+	if p.Intervaltype == nil {
+		return ""
+	}
+	return *p.Intervaltype
 }
 
-type UpdateSnapshotPolicyParams struct {
-	p map[string]interface{}
+func (p *CreateSnapshotPolicyParams) HasIntervaltype() bool {
+	return p.Intervaltype != nil
 }
 
-func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *CreateSnapshotPolicyParams) ResetIntervaltype() {
+	p.Intervaltype = nil
 }
 
-func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["customid"] = v
+// This param is required.
+func (p *CreateSnapshotPolicyParams) SetMaxsnaps(v int) {
+	p.Maxsnaps = &v
 	return
 }
 
-func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSnapshotPolicyParams) GetMaxsnaps() int {
+	if p.Maxsnaps == nil {
+		return 0
 	}
-	p.p["fordisplay"] = v
+	return *p.Maxsnaps
+}
+
+func (p *CreateSnapshotPolicyParams) HasMaxsnaps() bool {
+	return p.Maxsnaps != nil
+}
+
+func (p *CreateSnapshotPolicyParams) ResetMaxsnaps() {
+	p.Maxsnaps = nil
+}
+
+// This param is required.
+func (p *CreateSnapshotPolicyParams) SetSchedule(v string) {
+	p.Schedule = &v
 	return
 }
 
-func (p *UpdateSnapshotPolicyParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateSnapshotPolicyParams) GetSchedule() string {
+	if p.Schedule == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Schedule
 }
 
-// You should always use this function to get a new UpdateSnapshotPolicyParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams {
-	p := &UpdateSnapshotPolicyParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *CreateSnapshotPolicyParams) HasSchedule() bool {
+	return p.Schedule != nil
 }
 
-// Updates the snapshot policy.
-func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newRequest("updateSnapshotPolicy", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *CreateSnapshotPolicyParams) ResetSchedule() {
+	p.Schedule = nil
+}
 
-	var r UpdateSnapshotPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'timezone' param: the time zone, like America/New_York. This param is required.
+func (p *CreateSnapshotPolicyParams) SetTimezone(v string) {
+	p.Timezone = &v
+	return
+}
+
+func (p *CreateSnapshotPolicyParams) GetTimezone() string {
+	if p.Timezone == nil {
+		return ""
 	}
+	return *p.Timezone
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+func (p *CreateSnapshotPolicyParams) HasTimezone() bool {
+	return p.Timezone != nil
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *CreateSnapshotPolicyParams) ResetTimezone() {
+	p.Timezone = nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// Sets the 'volumeid' param: the ID of the volume. This param is required.
+func (p *CreateSnapshotPolicyParams) SetVolumeid(v string) {
+	p.Volumeid = &v
+	return
+}
+
+func (p *CreateSnapshotPolicyParams) GetVolumeid() string {
+	if p.Volumeid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Volumeid
 }
 
-type UpdateSnapshotPolicyResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Fordisplay   bool   `json:"fordisplay,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Intervaltype int    `json:"intervaltype,omitempty"`
-	Maxsnaps     int    `json:"maxsnaps,omitempty"`
-	Schedule     string `json:"schedule,omitempty"`
-	Timezone     string `json:"timezone,omitempty"`
-	Volumeid     string `json:"volumeid,omitempty"`
+func (p *CreateSnapshotPolicyParams) HasVolumeid() bool {
+	return p.Volumeid != nil
 }
 
-type DeleteSnapshotPoliciesParams struct {
-	p map[string]interface{}
+func (p *CreateSnapshotPolicyParams) ResetVolumeid() {
+	p.Volumeid = nil
 }
 
-func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+// You should always use this function to get a new CreateSnapshotPolicyParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - intervaltype
+//   - maxsnaps
+//   - schedule
+//   - timezone: the time zone, like America/New_York
+//   - volumeid: the ID of the volume
+func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype SnapshotInterval, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
+	p := &CreateSnapshotPolicyParams{}
+	p.SetIntervaltype(intervaltype)
+	p.SetMaxsnaps(maxsnaps)
+	p.SetSchedule(schedule)
+	p.SetTimezone(timezone)
+	p.SetVolumeid(volumeid)
+	return p
+}
+
+// Creates a snapshot policy for the account.
+//
+// See also ListSnapshotPolicies, GetSnapshotPolicyByID.
+func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["ids"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("ids", vv)
+
+	resp, err := s.cs.newRequest("createSnapshotPolicy", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	return u
-}
 
-func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r CreateSnapshotPolicyResponse
+	if err := s.cs.unmarshal("createSnapshotPolicy", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["id"] = v
-	return
+	return &r, nil
 }
 
-func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ids"] = v
-	return
+type CreateSnapshotPolicyResponse struct {
+	Fordisplay   Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Intervaltype int                        `json:"intervaltype,omitempty" xml:"intervaltype,omitempty"`
+	Maxsnaps     int                        `json:"maxsnaps,omitempty" xml:"maxsnaps,omitempty"`
+	Schedule     string                     `json:"schedule,omitempty" xml:"schedule,omitempty"`
+	Timezone     string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Volumeid     string                     `json:"volumeid,omitempty" xml:"volumeid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
+type DeleteSnapshotPoliciesParams = cloudstackcommon.DeleteSnapshotPoliciesParams
+type DeleteSnapshotPoliciesResponse = cloudstackcommon.DeleteSnapshotPoliciesResponse
+
 // You should always use this function to get a new DeleteSnapshotPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams {
 	p := &DeleteSnapshotPoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Deletes snapshot policies for the account.
+//
+// See also ListSnapshotPolicies, GetSnapshotPolicyByID.
 func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("deleteSnapshotPolicies", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteSnapshotPolicies", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSnapshotPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteSnapshotPolicies", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type DeleteSnapshotPoliciesResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
 type ListSnapshotPoliciesParams struct {
-	p map[string]interface{}
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page       *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Volumeid   *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
 }
 
-func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSnapshotPoliciesParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["volumeid"]; found {
-		u.Set("volumeid", v.(string))
+	if v := p.Volumeid; v != nil {
+		u.Set("volumeid", *v)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListSnapshotPoliciesParams) Command() string {
+	return "listSnapshotPolicies"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSnapshotPoliciesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSnapshotPoliciesParams) ResponseType() interface{} {
+	return &ListSnapshotPoliciesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSnapshotPoliciesParams) Validate() error {
+	v := &validator{api: "listSnapshotPolicies"}
+	return v.err()
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
 func (p *ListSnapshotPoliciesParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListSnapshotPoliciesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSnapshotPoliciesParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Fordisplay
+}
+
+func (p *ListSnapshotPoliciesParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *ListSnapshotPoliciesParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *ListSnapshotPoliciesParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSnapshotPoliciesParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["keyword"] = v
+	return *p.Id
+}
+
+func (p *ListSnapshotPoliciesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListSnapshotPoliciesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSnapshotPoliciesParams) SetKeyword(v string) {
+	p.Keyword = &v
 	return
 }
 
-func (p *ListSnapshotPoliciesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSnapshotPoliciesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	p.p["page"] = v
+	return *p.Keyword
+}
+
+func (p *ListSnapshotPoliciesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSnapshotPoliciesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSnapshotPoliciesParams) SetPage(v int) {
+	p.Page = &v
 	return
 }
 
-func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSnapshotPoliciesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	p.p["pagesize"] = v
+	return *p.Page
+}
+
+func (p *ListSnapshotPoliciesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSnapshotPoliciesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSnapshotPoliciesParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListSnapshotPoliciesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["volumeid"] = v
+	return *p.Pagesize
+}
+
+func (p *ListSnapshotPoliciesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSnapshotPoliciesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'volumeid' param: the ID of the volume.
+func (p *ListSnapshotPoliciesParams) SetVolumeid(v string) {
+	p.Volumeid = &v
 	return
 }
 
+func (p *ListSnapshotPoliciesParams) GetVolumeid() string {
+	if p.Volumeid == nil {
+		return ""
+	}
+	return *p.Volumeid
+}
+
+func (p *ListSnapshotPoliciesParams) HasVolumeid() bool {
+	return p.Volumeid != nil
+}
+
+func (p *ListSnapshotPoliciesParams) ResetVolumeid() {
+	p.Volumeid = nil
+}
+
 // You should always use this function to get a new ListSnapshotPoliciesParams instance,
 // as then you are sure you have configured all required params
 func (s *SnapshotService) NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams {
 	p := &ListSnapshotPoliciesParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SnapshotService) GetSnapshotPolicyByID(id string) (*SnapshotPolicy, int, error) {
+func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
 	p := &ListSnapshotPoliciesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListSnapshotPolicies(p)
 	if err != nil {
@@ -950,75 +913,194 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string) (*SnapshotPolicy, int
 }
 
 // Lists snapshot policies.
+//
+// See also GetSnapshotPolicyByID.
 func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshotPolicies", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listSnapshotPolicies", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSnapshotPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listSnapshotPolicies", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
+// Lists the objects like ListSnapshotPolicies, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SnapshotService) StreamSnapshotPolicies(ctx context.Context, p *ListSnapshotPoliciesParams, fn func(*SnapshotPolicy) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listSnapshotPolicies", p.URLValues(), "snapshotpolicy", func(b json.RawMessage) error {
+		var v SnapshotPolicy
+		if err := s.cs.unmarshalElement("listSnapshotPolicies", "snapshotpolicy", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
 type ListSnapshotPoliciesResponse struct {
-	Count            int               `json:"count"`
-	SnapshotPolicies []*SnapshotPolicy `json:"snapshotpolicy"`
+	Count            int                        `json:"count" xml:"count"`
+	SnapshotPolicies []*SnapshotPolicy          `json:"snapshotpolicy" xml:"snapshotpolicy"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
 type SnapshotPolicy struct {
-	Fordisplay   bool   `json:"fordisplay,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Intervaltype int    `json:"intervaltype,omitempty"`
-	Maxsnaps     int    `json:"maxsnaps,omitempty"`
-	Schedule     string `json:"schedule,omitempty"`
-	Timezone     string `json:"timezone,omitempty"`
-	Volumeid     string `json:"volumeid,omitempty"`
+	Fordisplay   Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Intervaltype int                        `json:"intervaltype,omitempty" xml:"intervaltype,omitempty"`
+	Maxsnaps     int                        `json:"maxsnaps,omitempty" xml:"maxsnaps,omitempty"`
+	Schedule     string                     `json:"schedule,omitempty" xml:"schedule,omitempty"`
+	Timezone     string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Volumeid     string                     `json:"volumeid,omitempty" xml:"volumeid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type RevertSnapshotParams struct {
-	p map[string]interface{}
+type UpdateSnapshotPolicyParams struct {
+	Customid   *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Fordisplay *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id         *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *RevertSnapshotParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateSnapshotPolicyParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *RevertSnapshotParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the name of the API command of these params
+func (p *UpdateSnapshotPolicyParams) Command() string {
+	return "updateSnapshotPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateSnapshotPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateSnapshotPolicyParams) ResponseType() interface{} {
+	return &UpdateSnapshotPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateSnapshotPolicyParams) Validate() error {
+	v := &validator{api: "updateSnapshotPolicy"}
+	return v.err()
+}
+
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
+}
+
+func (p *UpdateSnapshotPolicyParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	p.p["id"] = v
+	return *p.Customid
+}
+
+func (p *UpdateSnapshotPolicyParams) HasCustomid() bool {
+	return p.Customid != nil
+}
+
+func (p *UpdateSnapshotPolicyParams) ResetCustomid() {
+	p.Customid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateSnapshotPolicyParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-// You should always use this function to get a new RevertSnapshotParams instance,
+func (p *UpdateSnapshotPolicyParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
+	}
+	return *p.Fordisplay
+}
+
+func (p *UpdateSnapshotPolicyParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *UpdateSnapshotPolicyParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+func (p *UpdateSnapshotPolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateSnapshotPolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateSnapshotPolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateSnapshotPolicyParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new UpdateSnapshotPolicyParams instance,
 // as then you are sure you have configured all required params
-func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotParams {
-	p := &RevertSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams {
+	p := &UpdateSnapshotPolicyParams{}
 	return p
 }
 
-// revert a volume snapshot.
-func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertSnapshot", p.toURLValues())
+// Updates the snapshot policy.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListSnapshotPolicies, GetSnapshotPolicyByID.
+func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateSnapshotPolicy", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r RevertSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateSnapshotPolicyResponse
+	if err := s.cs.unmarshal("updateSnapshotPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1039,373 +1121,234 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateSnapshotPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type RevertSnapshotResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Account      string `json:"account,omitempty"`
-	Created      string `json:"created,omitempty"`
-	Domain       string `json:"domain,omitempty"`
-	Domainid     string `json:"domainid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Intervaltype string `json:"intervaltype,omitempty"`
-	Name         string `json:"name,omitempty"`
-	Project      string `json:"project,omitempty"`
-	Projectid    string `json:"projectid,omitempty"`
-	Revertable   bool   `json:"revertable,omitempty"`
-	Snapshottype string `json:"snapshottype,omitempty"`
-	State        string `json:"state,omitempty"`
-	Tags         []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Volumeid   string `json:"volumeid,omitempty"`
-	Volumename string `json:"volumename,omitempty"`
-	Volumetype string `json:"volumetype,omitempty"`
-	Zoneid     string `json:"zoneid,omitempty"`
-}
-
-type ListVMSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListVMSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
-	}
-	return u
-}
-
-func (p *ListVMSnapshotParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
-}
-
-func (p *ListVMSnapshotParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
-}
-
-func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+type UpdateSnapshotPolicyResponse struct {
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Fordisplay   Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Intervaltype int                        `json:"intervaltype,omitempty" xml:"intervaltype,omitempty"`
+	Maxsnaps     int                        `json:"maxsnaps,omitempty" xml:"maxsnaps,omitempty"`
+	Schedule     string                     `json:"schedule,omitempty" xml:"schedule,omitempty"`
+	Timezone     string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Volumeid     string                     `json:"volumeid,omitempty" xml:"volumeid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-func (p *ListVMSnapshotParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+type RevertToVMSnapshotParams struct {
+	Vmsnapshotid *string `json:"vmsnapshotid,omitempty" yaml:"vmsnapshotid,omitempty"`
 }
 
-func (p *ListVMSnapshotParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RevertToVMSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Vmsnapshotid; v != nil {
+		u.Set("vmsnapshotid", *v)
 	}
-	p.p["listall"] = v
-	return
+	return u
 }
 
-func (p *ListVMSnapshotParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+// Returns the name of the API command of these params
+func (p *RevertToVMSnapshotParams) Command() string {
+	return "revertToVMSnapshot"
 }
 
-func (p *ListVMSnapshotParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *RevertToVMSnapshotParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListVMSnapshotParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RevertToVMSnapshotParams) ResponseType() interface{} {
+	return &RevertToVMSnapshotResponse{}
 }
 
-func (p *ListVMSnapshotParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RevertToVMSnapshotParams) Validate() error {
+	v := &validator{api: "revertToVMSnapshot"}
+	v.required("vmsnapshotid", p.Vmsnapshotid != nil)
+	return v.err()
 }
 
-func (p *ListVMSnapshotParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
+// This param is required.
+func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
+	p.Vmsnapshotid = &v
 	return
 }
 
-func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RevertToVMSnapshotParams) GetVmsnapshotid() string {
+	if p.Vmsnapshotid == nil {
+		return ""
 	}
-	p.p["tags"] = v
-	return
+	return *p.Vmsnapshotid
 }
 
-func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
+func (p *RevertToVMSnapshotParams) HasVmsnapshotid() bool {
+	return p.Vmsnapshotid != nil
 }
 
-func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmsnapshotid"] = v
-	return
+func (p *RevertToVMSnapshotParams) ResetVmsnapshotid() {
+	p.Vmsnapshotid = nil
 }
 
-// You should always use this function to get a new ListVMSnapshotParams instance,
+// You should always use this function to get a new RevertToVMSnapshotParams instance,
 // as then you are sure you have configured all required params
-func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
-	p := &ListVMSnapshotParams{}
-	p.p = make(map[string]interface{})
+//
+// The required params are:
+//
+//   - vmsnapshotid
+func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams {
+	p := &RevertToVMSnapshotParams{}
+	p.SetVmsnapshotid(vmsnapshotid)
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *SnapshotService) GetVMSnapshotID(name string) (string, error) {
-	p := &ListVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
-
-	l, err := s.ListVMSnapshot(p)
-	if err != nil {
-		return "", err
-	}
-
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
-
-	if l.Count == 1 {
-		return l.VMSnapshot[0].Id, nil
-	}
-
-	if l.Count > 1 {
-		for _, v := range l.VMSnapshot {
-			if v.Name == name {
-				return v.Id, nil
-			}
+// Revert VM from a vmsnapshot.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
 	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
-}
 
-// List virtual machine snapshot by conditions
-func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("listVMSnapshot", p.toURLValues())
+	resp, err := s.cs.newRequest("revertToVMSnapshot", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RevertToVMSnapshotResponse
+	if err := s.cs.unmarshal("revertToVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
-	return &r, nil
-}
-
-type ListVMSnapshotResponse struct {
-	Count      int           `json:"count"`
-	VMSnapshot []*VMSnapshot `json:"vmsnapshot"`
-}
-
-type VMSnapshot struct {
-	Account          string `json:"account,omitempty"`
-	Created          string `json:"created,omitempty"`
-	Current          bool   `json:"current,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Displayname      string `json:"displayname,omitempty"`
-	Domain           string `json:"domain,omitempty"`
-	Domainid         string `json:"domainid,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Parent           string `json:"parent,omitempty"`
-	ParentName       string `json:"parentName,omitempty"`
-	Project          string `json:"project,omitempty"`
-	Projectid        string `json:"projectid,omitempty"`
-	State            string `json:"state,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-}
-
-type CreateVMSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreateVMSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["description"]; found {
-		u.Set("description", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["quiescevm"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("quiescevm", vv)
-	}
-	if v, found := p.p["snapshotmemory"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("snapshotmemory", vv)
-	}
-	if v, found := p.p["virtualmachineid"]; found {
-		u.Set("virtualmachineid", v.(string))
-	}
-	return u
-}
 
-func (p *CreateVMSnapshotParams) SetDescription(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["description"] = v
-	return
-}
-
-func (p *CreateVMSnapshotParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
-}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
 
-func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["quiescevm"] = v
-	return
-}
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+		if err := s.cs.unmarshal("revertToVMSnapshot", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	p.p["snapshotmemory"] = v
-	return
+	return &r, nil
 }
 
-func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["virtualmachineid"] = v
-	return
-}
+type RevertToVMSnapshotResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Affinitygroup         []AffinityGroup            `json:"affinitygroup,omitempty" xml:"affinitygroup,omitempty"`
+	Cpunumber             int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed              int                        `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused               Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Diskioread            int64                      `json:"diskioread,omitempty" xml:"diskioread,omitempty"`
+	Diskiowrite           int64                      `json:"diskiowrite,omitempty" xml:"diskiowrite,omitempty"`
+	Diskkbsread           int64                      `json:"diskkbsread,omitempty" xml:"diskkbsread,omitempty"`
+	Diskkbswrite          int64                      `json:"diskkbswrite,omitempty" xml:"diskkbswrite,omitempty"`
+	Diskofferingid        string                     `json:"diskofferingid,omitempty" xml:"diskofferingid,omitempty"`
+	Diskofferingname      string                     `json:"diskofferingname,omitempty" xml:"diskofferingname,omitempty"`
+	Displayname           string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Displayvm             Bool                       `json:"displayvm,omitempty" xml:"displayvm,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Forvirtualnetwork     Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Group                 string                     `json:"group,omitempty" xml:"group,omitempty"`
+	Groupid               string                     `json:"groupid,omitempty" xml:"groupid,omitempty"`
+	Guestosid             string                     `json:"guestosid,omitempty" xml:"guestosid,omitempty"`
+	Haenable              Bool                       `json:"haenable,omitempty" xml:"haenable,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Instancename          string                     `json:"instancename,omitempty" xml:"instancename,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isodisplaytext        string                     `json:"isodisplaytext,omitempty" xml:"isodisplaytext,omitempty"`
+	Isoid                 string                     `json:"isoid,omitempty" xml:"isoid,omitempty"`
+	Isoname               string                     `json:"isoname,omitempty" xml:"isoname,omitempty"`
+	Keypair               string                     `json:"keypair,omitempty" xml:"keypair,omitempty"`
+	Memory                int                        `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread        int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite       int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Nic                   []Nic                      `json:"nic,omitempty" xml:"nic,omitempty"`
+	Ostypeid              int64                      `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Password              string                     `json:"password,omitempty" xml:"password,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Publicip              string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicipid            string                     `json:"publicipid,omitempty" xml:"publicipid,omitempty"`
+	Rootdeviceid          int64                      `json:"rootdeviceid,omitempty" xml:"rootdeviceid,omitempty"`
+	Rootdevicetype        string                     `json:"rootdevicetype,omitempty" xml:"rootdevicetype,omitempty"`
+	Securitygroup         []SecurityGroup            `json:"securitygroup,omitempty" xml:"securitygroup,omitempty"`
+	Serviceofferingid     string                     `json:"serviceofferingid,omitempty" xml:"serviceofferingid,omitempty"`
+	Serviceofferingname   string                     `json:"serviceofferingname,omitempty" xml:"serviceofferingname,omitempty"`
+	Servicestate          string                     `json:"servicestate,omitempty" xml:"servicestate,omitempty"`
+	State                 VMState                    `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatedisplaytext   string                     `json:"templatedisplaytext,omitempty" xml:"templatedisplaytext,omitempty"`
+	Templateid            string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Templatename          string                     `json:"templatename,omitempty" xml:"templatename,omitempty"`
+	Vgpu                  string                     `json:"vgpu,omitempty" xml:"vgpu,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVMSnapshotParams = cloudstackcommon.CreateVMSnapshotParams
+type CreateVMSnapshotResponse = cloudstackcommon.CreateVMSnapshotResponse
 
 // You should always use this function to get a new CreateVMSnapshotParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - virtualmachineid: the ID of the virtual machine
 func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams {
 	p := &CreateVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["virtualmachineid"] = virtualmachineid
+	p.SetVirtualmachineid(virtualmachineid)
 	return p
 }
 
 // Creates snapshot for a vm.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVMSnapshot, GetVMSnapshotID, GetVMSnapshotByTags.
 func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("createVMSnapshot", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVMSnapshot", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1426,75 +1369,46 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVMSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreateVMSnapshotResponse struct {
-	JobID            string `json:"jobid,omitempty"`
-	Account          string `json:"account,omitempty"`
-	Created          string `json:"created,omitempty"`
-	Current          bool   `json:"current,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Displayname      string `json:"displayname,omitempty"`
-	Domain           string `json:"domain,omitempty"`
-	Domainid         string `json:"domainid,omitempty"`
-	Id               string `json:"id,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Parent           string `json:"parent,omitempty"`
-	ParentName       string `json:"parentName,omitempty"`
-	Project          string `json:"project,omitempty"`
-	Projectid        string `json:"projectid,omitempty"`
-	State            string `json:"state,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-}
-
-type DeleteVMSnapshotParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmsnapshotid"] = v
-	return
-}
+type DeleteVMSnapshotParams = cloudstackcommon.DeleteVMSnapshotParams
+type DeleteVMSnapshotResponse = cloudstackcommon.DeleteVMSnapshotResponse
 
 // You should always use this function to get a new DeleteVMSnapshotParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - vmsnapshotid
 func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams {
 	p := &DeleteVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["vmsnapshotid"] = vmsnapshotid
+	p.SetVmsnapshotid(vmsnapshotid)
 	return p
 }
 
 // Deletes a vmsnapshot.
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVMSnapshot, GetVMSnapshotID, GetVMSnapshotByTags.
 func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("deleteVMSnapshot", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVMSnapshot", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1510,251 +1424,153 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteVMSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteVMSnapshotResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
+type ListVMSnapshotParams = cloudstackcommon.ListVMSnapshotParams
+type ListVMSnapshotResponse = cloudstackcommon.ListVMSnapshotResponse
+type VMSnapshot = cloudstackcommon.VMSnapshot
 
-type RevertToVMSnapshotParams struct {
-	p map[string]interface{}
+// You should always use this function to get a new ListVMSnapshotParams instance,
+// as then you are sure you have configured all required params
+func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
+	p := &ListVMSnapshotParams{}
+	return p
 }
 
-func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListVMSnapshotParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
-	if v, found := p.p["vmsnapshotid"]; found {
-		u.Set("vmsnapshotid", v.(string))
+
+	l, err := s.ListVMSnapshot(p)
+	if err != nil {
+		return "", err
 	}
-	return u
-}
 
-func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVMSnapshot(p)
+		if err != nil {
+			return "", err
+		}
 	}
-	p.p["vmsnapshotid"] = v
-	return
-}
 
-// You should always use this function to get a new RevertToVMSnapshotParams instance,
-// as then you are sure you have configured all required params
-func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams {
-	p := &RevertToVMSnapshotParams{}
-	p.p = make(map[string]interface{})
-	p.p["vmsnapshotid"] = vmsnapshotid
-	return p
-}
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
 
-// Revert VM from a vmsnapshot.
-func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("revertToVMSnapshot", p.toURLValues())
-	if err != nil {
-		return nil, err
+	if l.Count == 1 {
+		return l.VMSnapshot[0].Id, nil
 	}
 
-	var r RevertToVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	var matches []*VMSnapshot
+	for _, v := range l.VMSnapshot {
+		if v.Name == name {
+			matches = append(matches, v)
+		}
 	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "VMSnapshot", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *SnapshotService) GetVMSnapshotByTags(tags map[string]string, opts ...OptionFunc) ([]*VMSnapshot, error) {
+	p := &ListVMSnapshotParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
 			return nil, err
 		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+	}
 
-		b, err = getRawValue(b)
+	l, err := s.ListVMSnapshot(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVMSnapshot(p)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+	return l.VMSnapshot, nil
+}
+
+// List virtual machine snapshot by conditions
+//
+// See also GetVMSnapshotID, GetVMSnapshotByTags.
+func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
 			return nil, err
 		}
 	}
+
+	resp, err := s.cs.newRequest("listVMSnapshot", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListVMSnapshotResponse
+	if err := s.cs.unmarshal("listVMSnapshot", resp, &r); err != nil {
+		return nil, err
+	}
 	return &r, nil
 }
 
-type RevertToVMSnapshotResponse struct {
-	JobID         string `json:"jobid,omitempty"`
-	Account       string `json:"account,omitempty"`
-	Affinitygroup []struct {
-		Account           string   `json:"account,omitempty"`
-		Description       string   `json:"description,omitempty"`
-		Domain            string   `json:"domain,omitempty"`
-		Domainid          string   `json:"domainid,omitempty"`
-		Id                string   `json:"id,omitempty"`
-		Name              string   `json:"name,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		VirtualmachineIds []string `json:"virtualmachineIds,omitempty"`
-	} `json:"affinitygroup,omitempty"`
-	Cpunumber             int               `json:"cpunumber,omitempty"`
-	Cpuspeed              int               `json:"cpuspeed,omitempty"`
-	Cpuused               string            `json:"cpuused,omitempty"`
-	Created               string            `json:"created,omitempty"`
-	Details               map[string]string `json:"details,omitempty"`
-	Diskioread            int64             `json:"diskioread,omitempty"`
-	Diskiowrite           int64             `json:"diskiowrite,omitempty"`
-	Diskkbsread           int64             `json:"diskkbsread,omitempty"`
-	Diskkbswrite          int64             `json:"diskkbswrite,omitempty"`
-	Diskofferingid        string            `json:"diskofferingid,omitempty"`
-	Diskofferingname      string            `json:"diskofferingname,omitempty"`
-	Displayname           string            `json:"displayname,omitempty"`
-	Displayvm             bool              `json:"displayvm,omitempty"`
-	Domain                string            `json:"domain,omitempty"`
-	Domainid              string            `json:"domainid,omitempty"`
-	Forvirtualnetwork     bool              `json:"forvirtualnetwork,omitempty"`
-	Group                 string            `json:"group,omitempty"`
-	Groupid               string            `json:"groupid,omitempty"`
-	Guestosid             string            `json:"guestosid,omitempty"`
-	Haenable              bool              `json:"haenable,omitempty"`
-	Hostid                string            `json:"hostid,omitempty"`
-	Hostname              string            `json:"hostname,omitempty"`
-	Hypervisor            string            `json:"hypervisor,omitempty"`
-	Id                    string            `json:"id,omitempty"`
-	Instancename          string            `json:"instancename,omitempty"`
-	Isdynamicallyscalable bool              `json:"isdynamicallyscalable,omitempty"`
-	Isodisplaytext        string            `json:"isodisplaytext,omitempty"`
-	Isoid                 string            `json:"isoid,omitempty"`
-	Isoname               string            `json:"isoname,omitempty"`
-	Keypair               string            `json:"keypair,omitempty"`
-	Memory                int               `json:"memory,omitempty"`
-	Name                  string            `json:"name,omitempty"`
-	Networkkbsread        int64             `json:"networkkbsread,omitempty"`
-	Networkkbswrite       int64             `json:"networkkbswrite,omitempty"`
-	Nic                   []struct {
-		Broadcasturi     string   `json:"broadcasturi,omitempty"`
-		Deviceid         string   `json:"deviceid,omitempty"`
-		Gateway          string   `json:"gateway,omitempty"`
-		Id               string   `json:"id,omitempty"`
-		Ip6address       string   `json:"ip6address,omitempty"`
-		Ip6cidr          string   `json:"ip6cidr,omitempty"`
-		Ip6gateway       string   `json:"ip6gateway,omitempty"`
-		Ipaddress        string   `json:"ipaddress,omitempty"`
-		Isdefault        bool     `json:"isdefault,omitempty"`
-		Isolationuri     string   `json:"isolationuri,omitempty"`
-		Macaddress       string   `json:"macaddress,omitempty"`
-		Netmask          string   `json:"netmask,omitempty"`
-		Networkid        string   `json:"networkid,omitempty"`
-		Networkname      string   `json:"networkname,omitempty"`
-		Secondaryip      []string `json:"secondaryip,omitempty"`
-		Traffictype      string   `json:"traffictype,omitempty"`
-		Type             string   `json:"type,omitempty"`
-		Virtualmachineid string   `json:"virtualmachineid,omitempty"`
-	} `json:"nic,omitempty"`
-	Ostypeid        int64  `json:"ostypeid,omitempty"`
-	Password        string `json:"password,omitempty"`
-	Passwordenabled bool   `json:"passwordenabled,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Publicip        string `json:"publicip,omitempty"`
-	Publicipid      string `json:"publicipid,omitempty"`
-	Rootdeviceid    int64  `json:"rootdeviceid,omitempty"`
-	Rootdevicetype  string `json:"rootdevicetype,omitempty"`
-	Securitygroup   []struct {
-		Account     string `json:"account,omitempty"`
-		Description string `json:"description,omitempty"`
-		Domain      string `json:"domain,omitempty"`
-		Domainid    string `json:"domainid,omitempty"`
-		Egressrule  []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"egressrule,omitempty"`
-		Id          string `json:"id,omitempty"`
-		Ingressrule []struct {
-			Account           string `json:"account,omitempty"`
-			Cidr              string `json:"cidr,omitempty"`
-			Endport           int    `json:"endport,omitempty"`
-			Icmpcode          int    `json:"icmpcode,omitempty"`
-			Icmptype          int    `json:"icmptype,omitempty"`
-			Protocol          string `json:"protocol,omitempty"`
-			Ruleid            string `json:"ruleid,omitempty"`
-			Securitygroupname string `json:"securitygroupname,omitempty"`
-			Startport         int    `json:"startport,omitempty"`
-			Tags              []struct {
-				Account      string `json:"account,omitempty"`
-				Customer     string `json:"customer,omitempty"`
-				Domain       string `json:"domain,omitempty"`
-				Domainid     string `json:"domainid,omitempty"`
-				Key          string `json:"key,omitempty"`
-				Project      string `json:"project,omitempty"`
-				Projectid    string `json:"projectid,omitempty"`
-				Resourceid   string `json:"resourceid,omitempty"`
-				Resourcetype string `json:"resourcetype,omitempty"`
-				Value        string `json:"value,omitempty"`
-			} `json:"tags,omitempty"`
-		} `json:"ingressrule,omitempty"`
-		Name      string `json:"name,omitempty"`
-		Project   string `json:"project,omitempty"`
-		Projectid string `json:"projectid,omitempty"`
-		Tags      []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-	} `json:"securitygroup,omitempty"`
-	Serviceofferingid   string `json:"serviceofferingid,omitempty"`
-	Serviceofferingname string `json:"serviceofferingname,omitempty"`
-	Servicestate        string `json:"servicestate,omitempty"`
-	State               string `json:"state,omitempty"`
-	Tags                []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Templatedisplaytext string `json:"templatedisplaytext,omitempty"`
-	Templateid          string `json:"templateid,omitempty"`
-	Templatename        string `json:"templatename,omitempty"`
-	Vgpu                string `json:"vgpu,omitempty"`
-	Zoneid              string `json:"zoneid,omitempty"`
-	Zonename            string `json:"zonename,omitempty"`
+// Lists the objects like ListVMSnapshot, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *SnapshotService) StreamVMSnapshot(ctx context.Context, p *ListVMSnapshotParams, fn func(*VMSnapshot) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listVMSnapshot", p.URLValues(), "vmsnapshot", func(b json.RawMessage) error {
+		var v VMSnapshot
+		if err := s.cs.unmarshalElement("listVMSnapshot", "vmsnapshot", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
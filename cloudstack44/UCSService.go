@@ -17,196 +17,145 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
-	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddUcsManagerParams struct {
-	p map[string]interface{}
+type UCSService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddUcsManagerParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["password"]; found {
-		u.Set("password", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["username"]; found {
-		u.Set("username", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+func NewUCSService(cs *CloudStackClient) *UCSService {
+	return &UCSService{cs: cs}
 }
 
-func (p *AddUcsManagerParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+type ListUcsBladesParams = cloudstackcommon.ListUcsBladesParams
+type ListUcsBladesResponse = cloudstackcommon.ListUcsBladesResponse
+type UcsBlade = cloudstackcommon.UcsBlade
+
+// You should always use this function to get a new ListUcsBladesParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ucsmanagerid
+func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
+	p := &ListUcsBladesParams{}
+	p.SetUcsmanagerid(ucsmanagerid)
+	return p
 }
 
-func (p *AddUcsManagerParams) SetPassword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// List ucs blades
+func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["password"] = v
-	return
-}
 
-func (p *AddUcsManagerParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listUcsBlades", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["url"] = v
-	return
-}
 
-func (p *AddUcsManagerParams) SetUsername(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListUcsBladesResponse
+	if err := s.cs.unmarshal("listUcsBlades", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["username"] = v
-	return
+	return &r, nil
 }
 
-func (p *AddUcsManagerParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListUcsBlades, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UCSService) StreamUcsBlades(ctx context.Context, p *ListUcsBladesParams, fn func(*UcsBlade) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
+
+	return s.cs.streamList(ctx, "listUcsBlades", p.URLValues(), "ucsblade", func(b json.RawMessage) error {
+		var v UcsBlade
+		if err := s.cs.unmarshalElement("listUcsBlades", "ucsblade", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
+type AddUcsManagerParams = cloudstackcommon.AddUcsManagerParams
+type AddUcsManagerResponse = cloudstackcommon.AddUcsManagerResponse
+
 // You should always use this function to get a new AddUcsManagerParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - password: the password
+//   - url
+//   - username: the name of the user
+//   - zoneid: the ID of the zone
 func (s *UCSService) NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams {
 	p := &AddUcsManagerParams{}
-	p.p = make(map[string]interface{})
-	p.p["password"] = password
-	p.p["url"] = url
-	p.p["username"] = username
-	p.p["zoneid"] = zoneid
+	p.SetPassword(password)
+	p.SetUrl(url)
+	p.SetUsername(username)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Adds a Ucs manager
+//
+// See also ListUcsManagers, GetUcsManagerID, GetUcsManagerByName, GetUcsManagerByID.
 func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
-	resp, err := s.cs.newRequest("addUcsManager", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addUcsManager", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddUcsManagerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addUcsManager", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type AddUcsManagerResponse struct {
-	Id     string `json:"id,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Url    string `json:"url,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type ListUcsManagersParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUcsManagersParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
-}
-
-func (p *ListUcsManagersParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *ListUcsManagersParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListUcsManagersParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListUcsManagersParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListUcsManagersParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["zoneid"] = v
-	return
-}
+type ListUcsManagersParams = cloudstackcommon.ListUcsManagersParams
+type ListUcsManagersResponse = cloudstackcommon.ListUcsManagersResponse
+type UcsManager = cloudstackcommon.UcsManager
 
 // You should always use this function to get a new ListUcsManagersParams instance,
 // as then you are sure you have configured all required params
 func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UCSService) GetUcsManagerID(keyword string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, error) {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
+	p.SetKeyword(keyword)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListUcsManagers(p)
 	if err != nil {
@@ -221,24 +170,36 @@ func (s *UCSService) GetUcsManagerID(keyword string) (string, error) {
 		return l.UcsManagers[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.UcsManagers {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*UcsManager
+	for _, v := range l.UcsManagers {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "UcsManager", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UCSService) GetUcsManagerByName(name string) (*UcsManager, int, error) {
-	id, err := s.GetUcsManagerID(name)
+func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error) {
+	id, err := s.GetUcsManagerID(name, opts...)
 	if err != nil {
 		return nil, -1, err
 	}
 
-	r, count, err := s.GetUcsManagerByID(id)
+	r, count, err := s.GetUcsManagerByID(id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -246,11 +207,16 @@ func (s *UCSService) GetUcsManagerByName(name string) (*UcsManager, int, error)
 }
 
 // This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UCSService) GetUcsManagerByID(id string) (*UcsManager, int, error) {
+func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error) {
 	p := &ListUcsManagersParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["id"] = id
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
 
 	l, err := s.ListUcsManagers(p)
 	if err != nil {
@@ -273,280 +239,137 @@ func (s *UCSService) GetUcsManagerByID(id string) (*UcsManager, int, error) {
 }
 
 // List ucs manager
+//
+// See also GetUcsManagerID, GetUcsManagerByName, GetUcsManagerByID.
 func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
-	resp, err := s.cs.newRequest("listUcsManagers", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listUcsManagers", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUcsManagersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listUcsManagers", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListUcsManagersResponse struct {
-	Count       int           `json:"count"`
-	UcsManagers []*UcsManager `json:"ucsmanager"`
-}
-
-type UcsManager struct {
-	Id     string `json:"id,omitempty"`
-	Name   string `json:"name,omitempty"`
-	Url    string `json:"url,omitempty"`
-	Zoneid string `json:"zoneid,omitempty"`
-}
-
-type ListUcsProfilesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUcsProfilesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
-}
-
-func (p *ListUcsProfilesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListUcsProfilesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListUcsManagers, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UCSService) StreamUcsManagers(ctx context.Context, p *ListUcsManagersParams, fn func(*UcsManager) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListUcsProfilesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+	return s.cs.streamList(ctx, "listUcsManagers", p.URLValues(), "ucsmanager", func(b json.RawMessage) error {
+		var v UcsManager
+		if err := s.cs.unmarshalElement("listUcsManagers", "ucsmanager", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
-}
+type ListUcsProfilesParams = cloudstackcommon.ListUcsProfilesParams
+type ListUcsProfilesResponse = cloudstackcommon.ListUcsProfilesResponse
+type UcsProfile = cloudstackcommon.UcsProfile
 
 // You should always use this function to get a new ListUcsProfilesParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - ucsmanagerid
 func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams {
 	p := &ListUcsProfilesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
 // List profile in ucs manager
 func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsProfiles", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListUcsProfilesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
-}
-
-type ListUcsProfilesResponse struct {
-	Count       int           `json:"count"`
-	UcsProfiles []*UcsProfile `json:"ucsprofile"`
-}
-
-type UcsProfile struct {
-	Ucsdn string `json:"ucsdn,omitempty"`
-}
-
-type ListUcsBladesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUcsBladesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
-}
-
-func (p *ListUcsBladesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListUcsBladesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListUcsBladesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["ucsmanagerid"] = v
-	return
-}
-
-// You should always use this function to get a new ListUcsBladesParams instance,
-// as then you are sure you have configured all required params
-func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams {
-	p := &ListUcsBladesParams{}
-	p.p = make(map[string]interface{})
-	p.p["ucsmanagerid"] = ucsmanagerid
-	return p
-}
 
-// List ucs blades
-func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsBlades", p.toURLValues())
+	resp, err := s.cs.newRequest("listUcsProfiles", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListUcsBladesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListUcsProfilesResponse
+	if err := s.cs.unmarshal("listUcsProfiles", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListUcsBladesResponse struct {
-	Count     int         `json:"count"`
-	UcsBlades []*UcsBlade `json:"ucsblade"`
-}
-
-type UcsBlade struct {
-	Bladedn      string `json:"bladedn,omitempty"`
-	Hostid       string `json:"hostid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Profiledn    string `json:"profiledn,omitempty"`
-	Ucsmanagerid string `json:"ucsmanagerid,omitempty"`
-}
-
-type AssociateUcsProfileToBladeParams struct {
-	p map[string]interface{}
-}
-
-func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["bladeid"]; found {
-		u.Set("bladeid", v.(string))
-	}
-	if v, found := p.p["profiledn"]; found {
-		u.Set("profiledn", v.(string))
-	}
-	if v, found := p.p["ucsmanagerid"]; found {
-		u.Set("ucsmanagerid", v.(string))
-	}
-	return u
-}
-
-func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListUcsProfiles, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UCSService) StreamUcsProfiles(ctx context.Context, p *ListUcsProfilesParams, fn func(*UcsProfile) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["bladeid"] = v
-	return
-}
 
-func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["profiledn"] = v
-	return
+	return s.cs.streamList(ctx, "listUcsProfiles", p.URLValues(), "ucsprofile", func(b json.RawMessage) error {
+		var v UcsProfile
+		if err := s.cs.unmarshalElement("listUcsProfiles", "ucsprofile", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ucsmanagerid"] = v
-	return
-}
+type AssociateUcsProfileToBladeParams = cloudstackcommon.AssociateUcsProfileToBladeParams
+type AssociateUcsProfileToBladeResponse = cloudstackcommon.AssociateUcsProfileToBladeResponse
 
 // You should always use this function to get a new AssociateUcsProfileToBladeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - bladeid
+//   - profiledn
+//   - ucsmanagerid
 func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams {
 	p := &AssociateUcsProfileToBladeParams{}
-	p.p = make(map[string]interface{})
-	p.p["bladeid"] = bladeid
-	p.p["profiledn"] = profiledn
-	p.p["ucsmanagerid"] = ucsmanagerid
+	p.SetBladeid(bladeid)
+	p.SetProfiledn(profiledn)
+	p.SetUcsmanagerid(ucsmanagerid)
 	return p
 }
 
 // associate a profile to a blade
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
 func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
-	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("associateUcsProfileToBlade", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssociateUcsProfileToBladeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("associateUcsProfileToBlade", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -567,18 +390,9 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("associateUcsProfileToBlade", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
-
-type AssociateUcsProfileToBladeResponse struct {
-	JobID        string `json:"jobid,omitempty"`
-	Bladedn      string `json:"bladedn,omitempty"`
-	Hostid       string `json:"hostid,omitempty"`
-	Id           string `json:"id,omitempty"`
-	Profiledn    string `json:"profiledn,omitempty"`
-	Ucsmanagerid string `json:"ucsmanagerid,omitempty"`
-}
@@ -17,131 +17,189 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type AddTrafficTypeParams struct {
-	p map[string]interface{}
+type UsageService struct {
+	cs *CloudStackClient
 }
 
-func (p *AddTrafficTypeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hypervnetworklabel"]; found {
-		u.Set("hypervnetworklabel", v.(string))
-	}
-	if v, found := p.p["isolationmethod"]; found {
-		u.Set("isolationmethod", v.(string))
-	}
-	if v, found := p.p["kvmnetworklabel"]; found {
-		u.Set("kvmnetworklabel", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
-	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+func NewUsageService(cs *CloudStackClient) *UsageService {
+	return &UsageService{cs: cs}
+}
+
+type AddTrafficMonitorParams = cloudstackcommon.AddTrafficMonitorParams
+type AddTrafficMonitorResponse = cloudstackcommon.AddTrafficMonitorResponse
+
+// You should always use this function to get a new AddTrafficMonitorParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - url
+//   - zoneid: the ID of the zone
+func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams {
+	p := &AddTrafficMonitorParams{}
+	p.SetUrl(url)
+	p.SetZoneid(zoneid)
+	return p
+}
+
+// Adds Traffic Monitor Host for Direct Network Usage
+//
+// See also ListTrafficMonitors.
+func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["vmwarenetworklabel"]; found {
-		u.Set("vmwarenetworklabel", v.(string))
+
+	resp, err := s.cs.newRequest("addTrafficMonitor", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["xennetworklabel"]; found {
-		u.Set("xennetworklabel", v.(string))
+
+	var r AddTrafficMonitorResponse
+	if err := s.cs.unmarshal("addTrafficMonitor", resp, &r); err != nil {
+		return nil, err
 	}
-	return u
+	return &r, nil
 }
 
-func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervnetworklabel"] = v
-	return
+type DeleteTrafficMonitorParams = cloudstackcommon.DeleteTrafficMonitorParams
+type DeleteTrafficMonitorResponse = cloudstackcommon.DeleteTrafficMonitorResponse
+
+// You should always use this function to get a new DeleteTrafficMonitorParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams {
+	p := &DeleteTrafficMonitorParams{}
+	p.SetId(id)
+	return p
 }
 
-func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Deletes an traffic monitor host.
+//
+// See also ListTrafficMonitors.
+func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["isolationmethod"] = v
-	return
-}
 
-func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("deleteTrafficMonitor", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["kvmnetworklabel"] = v
-	return
-}
 
-func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r DeleteTrafficMonitorResponse
+	if err := s.cs.unmarshal("deleteTrafficMonitor", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["physicalnetworkid"] = v
-	return
+	return &r, nil
 }
 
-func (p *AddTrafficTypeParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
-	return
+type ListTrafficMonitorsParams = cloudstackcommon.ListTrafficMonitorsParams
+type ListTrafficMonitorsResponse = cloudstackcommon.ListTrafficMonitorsResponse
+type TrafficMonitor = cloudstackcommon.TrafficMonitor
+
+// You should always use this function to get a new ListTrafficMonitorsParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - zoneid: the ID of the zone
+func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams {
+	p := &ListTrafficMonitorsParams{}
+	p.SetZoneid(zoneid)
+	return p
 }
 
-func (p *AddTrafficTypeParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// List traffic monitor Hosts.
+func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["vlan"] = v
-	return
-}
 
-func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listTrafficMonitors", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["vmwarenetworklabel"] = v
-	return
+
+	var r ListTrafficMonitorsResponse
+	if err := s.cs.unmarshal("listTrafficMonitors", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
 }
 
-func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListTrafficMonitors, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UsageService) StreamTrafficMonitors(ctx context.Context, p *ListTrafficMonitorsParams, fn func(*TrafficMonitor) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["xennetworklabel"] = v
-	return
+
+	return s.cs.streamList(ctx, "listTrafficMonitors", p.URLValues(), "trafficmonitor", func(b json.RawMessage) error {
+		var v TrafficMonitor
+		if err := s.cs.unmarshalElement("listTrafficMonitors", "trafficmonitor", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
+type AddTrafficTypeParams = cloudstackcommon.AddTrafficTypeParams
+type AddTrafficTypeResponse = cloudstackcommon.AddTrafficTypeResponse
+
 // You should always use this function to get a new AddTrafficTypeParams instance,
 // as then you are sure you have configured all required params
-func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams {
+//
+// The required params are:
+//
+//   - physicalnetworkid: the ID of the physical network
+//   - traffictype
+func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffictype NetworkTrafficType) *AddTrafficTypeParams {
 	p := &AddTrafficTypeParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
-	p.p["traffictype"] = traffictype
+	p.SetPhysicalnetworkid(physicalnetworkid)
+	p.SetTraffictype(traffictype)
 	return p
 }
 
 // Adds traffic type to a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTrafficTypes, GetTrafficTypeID.
 func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficType", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("addTrafficType", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddTrafficTypeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("addTrafficType", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -162,65 +220,46 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("addTrafficType", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type AddTrafficTypeResponse struct {
-	JobID              string `json:"jobid,omitempty"`
-	Hypervnetworklabel string `json:"hypervnetworklabel,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Kvmnetworklabel    string `json:"kvmnetworklabel,omitempty"`
-	Physicalnetworkid  string `json:"physicalnetworkid,omitempty"`
-	Traffictype        string `json:"traffictype,omitempty"`
-	Vmwarenetworklabel string `json:"vmwarenetworklabel,omitempty"`
-	Xennetworklabel    string `json:"xennetworklabel,omitempty"`
-}
-
-type DeleteTrafficTypeParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
-}
-
-func (p *DeleteTrafficTypeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
+type DeleteTrafficTypeParams = cloudstackcommon.DeleteTrafficTypeParams
+type DeleteTrafficTypeResponse = cloudstackcommon.DeleteTrafficTypeResponse
 
 // You should always use this function to get a new DeleteTrafficTypeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams {
 	p := &DeleteTrafficTypeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Deletes traffic type of a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTrafficTypes, GetTrafficTypeID.
 func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficType", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteTrafficType", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteTrafficTypeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("deleteTrafficType", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -236,93 +275,44 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 			return &r, warn
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("deleteTrafficType", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type DeleteTrafficTypeResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
-}
-
-type ListTrafficTypesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListTrafficTypesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
-	}
-	return u
-}
-
-func (p *ListTrafficTypesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListTrafficTypesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListTrafficTypesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["physicalnetworkid"] = v
-	return
-}
+type ListTrafficTypesParams = cloudstackcommon.ListTrafficTypesParams
+type ListTrafficTypesResponse = cloudstackcommon.ListTrafficTypesResponse
+type TrafficType = cloudstackcommon.TrafficType
 
 // You should always use this function to get a new ListTrafficTypesParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - physicalnetworkid: the ID of the physical network
 func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams {
 	p := &ListTrafficTypesParams{}
-	p.p = make(map[string]interface{})
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetPhysicalnetworkid(physicalnetworkid)
 	return p
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string) (string, error) {
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, error) {
 	p := &ListTrafficTypesParams{}
-	p.p = make(map[string]interface{})
 
-	p.p["keyword"] = keyword
-	p.p["physicalnetworkid"] = physicalnetworkid
+	p.SetKeyword(keyword)
+	p.SetPhysicalnetworkid(physicalnetworkid)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
 
 	l, err := s.ListTrafficTypes(p)
 	if err != nil {
@@ -337,130 +327,102 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 		return l.TrafficTypes[0].Id, nil
 	}
 
-	if l.Count > 1 {
-		for _, v := range l.TrafficTypes {
-			if v.Name == keyword {
-				return v.Id, nil
-			}
+	var matches []*TrafficType
+	for _, v := range l.TrafficTypes {
+		if v.Name == keyword {
+			matches = append(matches, v)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "TrafficType", Name: keyword}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
 		}
+		return "", e
 	}
 	return "", fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
 }
 
 // Lists traffic types of a given physical network.
+//
+// See also GetTrafficTypeID.
 func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypes", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listTrafficTypes", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTrafficTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listTrafficTypes", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListTrafficTypesResponse struct {
-	Count        int            `json:"count"`
-	TrafficTypes []*TrafficType `json:"traffictype"`
-}
-
-type TrafficType struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-	Id                           string   `json:"id,omitempty"`
-	Name                         string   `json:"name,omitempty"`
-	Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-	Servicelist                  []string `json:"servicelist,omitempty"`
-	State                        string   `json:"state,omitempty"`
-}
-
-type UpdateTrafficTypeParams struct {
-	p map[string]interface{}
-}
-
-func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["hypervnetworklabel"]; found {
-		u.Set("hypervnetworklabel", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["kvmnetworklabel"]; found {
-		u.Set("kvmnetworklabel", v.(string))
-	}
-	if v, found := p.p["vmwarenetworklabel"]; found {
-		u.Set("vmwarenetworklabel", v.(string))
-	}
-	if v, found := p.p["xennetworklabel"]; found {
-		u.Set("xennetworklabel", v.(string))
-	}
-	return u
-}
-
-func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hypervnetworklabel"] = v
-	return
-}
-
-func (p *UpdateTrafficTypeParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
-}
-
-func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListTrafficTypes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UsageService) StreamTrafficTypes(ctx context.Context, p *ListTrafficTypesParams, fn func(*TrafficType) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["kvmnetworklabel"] = v
-	return
-}
 
-func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vmwarenetworklabel"] = v
-	return
+	return s.cs.streamList(ctx, "listTrafficTypes", p.URLValues(), "traffictype", func(b json.RawMessage) error {
+		var v TrafficType
+		if err := s.cs.unmarshalElement("listTrafficTypes", "traffictype", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["xennetworklabel"] = v
-	return
-}
+type UpdateTrafficTypeParams = cloudstackcommon.UpdateTrafficTypeParams
+type UpdateTrafficTypeResponse = cloudstackcommon.UpdateTrafficTypeResponse
 
 // You should always use this function to get a new UpdateTrafficTypeParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
 func (s *UsageService) NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams {
 	p := &UpdateTrafficTypeParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+	p.SetId(id)
 	return p
 }
 
 // Updates traffic type of a physical network
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListTrafficTypes, GetTrafficTypeID.
 func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
-	resp, err := s.cs.newRequest("updateTrafficType", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateTrafficType", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateTrafficTypeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("updateTrafficType", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -481,646 +443,524 @@ func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTra
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("updateTrafficType", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateTrafficTypeResponse struct {
-	JobID              string `json:"jobid,omitempty"`
-	Hypervnetworklabel string `json:"hypervnetworklabel,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Kvmnetworklabel    string `json:"kvmnetworklabel,omitempty"`
-	Physicalnetworkid  string `json:"physicalnetworkid,omitempty"`
-	Traffictype        string `json:"traffictype,omitempty"`
-	Vmwarenetworklabel string `json:"vmwarenetworklabel,omitempty"`
-	Xennetworklabel    string `json:"xennetworklabel,omitempty"`
-}
-
-type ListTrafficTypeImplementorsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["traffictype"]; found {
-		u.Set("traffictype", v.(string))
-	}
-	return u
-}
-
-func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
-}
-
-func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
-}
-
-func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
-}
-
-func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["traffictype"] = v
-	return
-}
+type ListTrafficTypeImplementorsParams = cloudstackcommon.ListTrafficTypeImplementorsParams
+type ListTrafficTypeImplementorsResponse = cloudstackcommon.ListTrafficTypeImplementorsResponse
+type TrafficTypeImplementor = cloudstackcommon.TrafficTypeImplementor
 
 // You should always use this function to get a new ListTrafficTypeImplementorsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams {
 	p := &ListTrafficTypeImplementorsParams{}
-	p.p = make(map[string]interface{})
 	return p
 }
 
 // Lists implementors of implementor of a network traffic type or implementors of all network traffic types
 func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypeImplementors", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listTrafficTypeImplementors", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTrafficTypeImplementorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("listTrafficTypeImplementors", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListTrafficTypeImplementorsResponse struct {
-	Count                   int                       `json:"count"`
-	TrafficTypeImplementors []*TrafficTypeImplementor `json:"traffictypeimplementor"`
-}
-
-type TrafficTypeImplementor struct {
-	Traffictype            string `json:"traffictype,omitempty"`
-	Traffictypeimplementor string `json:"traffictypeimplementor,omitempty"`
-}
-
-type GenerateUsageRecordsParams struct {
-	p map[string]interface{}
-}
-
-func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
-	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
-	}
-	return u
-}
-
-func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListTrafficTypeImplementors, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UsageService) StreamTrafficTypeImplementors(ctx context.Context, p *ListTrafficTypeImplementorsParams, fn func(*TrafficTypeImplementor) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["domainid"] = v
-	return
-}
 
-func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
+	return s.cs.streamList(ctx, "listTrafficTypeImplementors", p.URLValues(), "traffictypeimplementor", func(b json.RawMessage) error {
+		var v TrafficTypeImplementor
+		if err := s.cs.unmarshalElement("listTrafficTypeImplementors", "traffictypeimplementor", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
-	return
-}
+type GenerateUsageRecordsParams = cloudstackcommon.GenerateUsageRecordsParams
+type GenerateUsageRecordsResponse = cloudstackcommon.GenerateUsageRecordsResponse
 
 // You should always use this function to get a new GenerateUsageRecordsParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - enddate: the end date, in the format yyyy-MM-dd
+//   - startdate: the start date, in the format yyyy-MM-dd
 func (s *UsageService) NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams {
 	p := &GenerateUsageRecordsParams{}
-	p.p = make(map[string]interface{})
-	p.p["enddate"] = enddate
-	p.p["startdate"] = startdate
+	p.SetEnddate(enddate)
+	p.SetStartdate(startdate)
 	return p
 }
 
 // Generates usage records. This will generate records only if there any records to be generated, i.e if the scheduled usage job was not run or failed
+//
+// See also ListUsageRecords.
 func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("generateUsageRecords", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("generateUsageRecords", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GenerateUsageRecordsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("generateUsageRecords", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type GenerateUsageRecordsResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
-}
-
 type ListUsageRecordsParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUsageRecordsParams) toURLValues() url.Values {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enddate   *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Startdate *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *int64  `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUsageRecordsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["accountid"]; found {
-		u.Set("accountid", v.(string))
+	if v := p.Accountid; v != nil {
+		u.Set("accountid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["enddate"]; found {
-		u.Set("enddate", v.(string))
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["startdate"]; found {
-		u.Set("startdate", v.(string))
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
 	}
-	if v, found := p.p["type"]; found {
-		vv := strconv.FormatInt(v.(int64), 10)
+	if v := p.Type; v != nil {
+		vv := strconv.FormatInt(*v, 10)
 		u.Set("type", vv)
 	}
 	return u
 }
 
+// Returns the name of the API command of these params
+func (p *ListUsageRecordsParams) Command() string {
+	return "listUsageRecords"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUsageRecordsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUsageRecordsParams) ResponseType() interface{} {
+	return &ListUsageRecordsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUsageRecordsParams) Validate() error {
+	v := &validator{api: "listUsageRecords"}
+	v.required("enddate", p.Enddate != nil)
+	v.required("startdate", p.Startdate != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
 func (p *ListUsageRecordsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+	p.Account = &v
 	return
 }
 
-func (p *ListUsageRecordsParams) SetAccountid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUsageRecordsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["accountid"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListUsageRecordsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+func (p *ListUsageRecordsParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListUsageRecordsParams) SetEnddate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["enddate"] = v
-	return
+func (p *ListUsageRecordsParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListUsageRecordsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
+// Sets the 'accountid' param: the ID of the account.
+func (p *ListUsageRecordsParams) SetAccountid(v string) {
+	p.Accountid = &v
 	return
 }
 
-func (p *ListUsageRecordsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUsageRecordsParams) GetAccountid() string {
+	if p.Accountid == nil {
+		return ""
 	}
-	p.p["page"] = v
-	return
+	return *p.Accountid
 }
 
-func (p *ListUsageRecordsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListUsageRecordsParams) HasAccountid() bool {
+	return p.Accountid != nil
 }
 
-func (p *ListUsageRecordsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
-	return
+func (p *ListUsageRecordsParams) ResetAccountid() {
+	p.Accountid = nil
 }
 
-func (p *ListUsageRecordsParams) SetStartdate(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["startdate"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListUsageRecordsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListUsageRecordsParams) SetType(v int64) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUsageRecordsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["usageType"] = v
-	return
+	return *p.Domainid
 }
 
-// You should always use this function to get a new ListUsageRecordsParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams {
-	p := &ListUsageRecordsParams{}
-	p.p = make(map[string]interface{})
-	p.p["enddate"] = enddate
-	p.p["startdate"] = startdate
-	return p
+func (p *ListUsageRecordsParams) HasDomainid() bool {
+	return p.Domainid != nil
 }
 
-// Lists usage records for accounts
-func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageRecords", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r ListUsageRecordsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-	return &r, nil
+func (p *ListUsageRecordsParams) ResetDomainid() {
+	p.Domainid = nil
 }
 
-type ListUsageRecordsResponse struct {
-	Count        int            `json:"count"`
-	UsageRecords []*UsageRecord `json:"usagerecord"`
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd. This param is required.
+func (p *ListUsageRecordsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
 }
 
-type UsageRecord struct {
-	Account          string `json:"account,omitempty"`
-	Accountid        string `json:"accountid,omitempty"`
-	Cpunumber        int64  `json:"cpunumber,omitempty"`
-	Cpuspeed         int64  `json:"cpuspeed,omitempty"`
-	Description      string `json:"description,omitempty"`
-	Domain           string `json:"domain,omitempty"`
-	Domainid         string `json:"domainid,omitempty"`
-	Enddate          string `json:"enddate,omitempty"`
-	Isdefault        bool   `json:"isdefault,omitempty"`
-	Issourcenat      bool   `json:"issourcenat,omitempty"`
-	Issystem         bool   `json:"issystem,omitempty"`
-	Memory           int64  `json:"memory,omitempty"`
-	Name             string `json:"name,omitempty"`
-	Networkid        string `json:"networkid,omitempty"`
-	Offeringid       string `json:"offeringid,omitempty"`
-	Project          string `json:"project,omitempty"`
-	Projectid        string `json:"projectid,omitempty"`
-	Rawusage         string `json:"rawusage,omitempty"`
-	Size             int64  `json:"size,omitempty"`
-	Startdate        string `json:"startdate,omitempty"`
-	Templateid       string `json:"templateid,omitempty"`
-	Type             string `json:"type,omitempty"`
-	Usage            string `json:"usage,omitempty"`
-	Usageid          string `json:"usageid,omitempty"`
-	Usagetype        int    `json:"usagetype,omitempty"`
-	Virtualmachineid string `json:"virtualmachineid,omitempty"`
-	Virtualsize      int64  `json:"virtualsize,omitempty"`
-	Zoneid           string `json:"zoneid,omitempty"`
-}
-
-type ListUsageTypesParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListUsageTypesParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func (p *ListUsageRecordsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
 	}
-	return u
+	return *p.Enddate
 }
 
-// You should always use this function to get a new ListUsageTypesParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
-	p := &ListUsageTypesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListUsageRecordsParams) HasEnddate() bool {
+	return p.Enddate != nil
 }
 
-// List Usage Types
-func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
-	resp, err := s.cs.newRequest("listUsageTypes", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListUsageRecordsParams) ResetEnddate() {
+	p.Enddate = nil
+}
 
-	var r ListUsageTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListUsageRecordsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListUsageRecordsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Keyword
 }
 
-type ListUsageTypesResponse struct {
-	Count      int          `json:"count"`
-	UsageTypes []*UsageType `json:"usagetype"`
+func (p *ListUsageRecordsParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-type UsageType struct {
-	Description string `json:"description,omitempty"`
-	Usagetypeid int    `json:"usagetypeid,omitempty"`
+func (p *ListUsageRecordsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-type AddTrafficMonitorParams struct {
-	p map[string]interface{}
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListUsageRecordsParams) SetPage(v int) {
+	p.Page = &v
+	return
 }
 
-func (p *AddTrafficMonitorParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["excludezones"]; found {
-		u.Set("excludezones", v.(string))
+func (p *ListUsageRecordsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	if v, found := p.p["includezones"]; found {
-		u.Set("includezones", v.(string))
-	}
-	if v, found := p.p["url"]; found {
-		u.Set("url", v.(string))
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+	return *p.Page
 }
 
-func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["excludezones"] = v
-	return
+func (p *ListUsageRecordsParams) HasPage() bool {
+	return p.Page != nil
 }
 
-func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["includezones"] = v
-	return
+func (p *ListUsageRecordsParams) ResetPage() {
+	p.Page = nil
 }
 
-func (p *AddTrafficMonitorParams) SetUrl(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["url"] = v
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListUsageRecordsParams) SetPagesize(v int) {
+	p.Pagesize = &v
 	return
 }
 
-func (p *AddTrafficMonitorParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUsageRecordsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Pagesize
 }
 
-// You should always use this function to get a new AddTrafficMonitorParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams {
-	p := &AddTrafficMonitorParams{}
-	p.p = make(map[string]interface{})
-	p.p["url"] = url
-	p.p["zoneid"] = zoneid
-	return p
+func (p *ListUsageRecordsParams) HasPagesize() bool {
+	return p.Pagesize != nil
 }
 
-// Adds Traffic Monitor Host for Direct Network Usage
-func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("addTrafficMonitor", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListUsageRecordsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
 
-	var r AddTrafficMonitorResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListUsageRecordsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListUsageRecordsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Projectid
 }
 
-type AddTrafficMonitorResponse struct {
-	Id         string `json:"id,omitempty"`
-	Ipaddress  string `json:"ipaddress,omitempty"`
-	Numretries string `json:"numretries,omitempty"`
-	Timeout    string `json:"timeout,omitempty"`
-	Zoneid     string `json:"zoneid,omitempty"`
+func (p *ListUsageRecordsParams) HasProjectid() bool {
+	return p.Projectid != nil
 }
 
-type DeleteTrafficMonitorParams struct {
-	p map[string]interface{}
+func (p *ListUsageRecordsParams) ResetProjectid() {
+	p.Projectid = nil
 }
 
-func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd. This param is required.
+func (p *ListUsageRecordsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
 }
 
-func (p *DeleteTrafficMonitorParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListUsageRecordsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Startdate
 }
 
-// You should always use this function to get a new DeleteTrafficMonitorParams instance,
-// as then you are sure you have configured all required params
-func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams {
-	p := &DeleteTrafficMonitorParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListUsageRecordsParams) HasStartdate() bool {
+	return p.Startdate != nil
 }
 
-// Deletes an traffic monitor host.
-func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
-	resp, err := s.cs.newRequest("deleteTrafficMonitor", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListUsageRecordsParams) ResetStartdate() {
+	p.Startdate = nil
+}
 
-	var r DeleteTrafficMonitorResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListUsageRecordsParams) SetType(v int64) {
+	p.Type = &v
+	return
+}
+
+func (p *ListUsageRecordsParams) GetType() int64 {
+	if p.Type == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Type
 }
 
-type DeleteTrafficMonitorResponse struct {
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     string `json:"success,omitempty"`
+func (p *ListUsageRecordsParams) HasType() bool {
+	return p.Type != nil
 }
 
-type ListTrafficMonitorsParams struct {
-	p map[string]interface{}
+func (p *ListUsageRecordsParams) ResetType() {
+	p.Type = nil
 }
 
-func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
-	}
-	return u
+// You should always use this function to get a new ListUsageRecordsParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - enddate: the end date, in the format yyyy-MM-dd
+//   - startdate: the start date, in the format yyyy-MM-dd
+func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams {
+	p := &ListUsageRecordsParams{}
+	p.SetEnddate(enddate)
+	p.SetStartdate(startdate)
+	return p
 }
 
-func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists usage records for accounts
+func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	p.p["keyword"] = v
-	return
-}
 
-func (p *ListTrafficMonitorsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	resp, err := s.cs.newRequest("listUsageRecords", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	p.p["page"] = v
-	return
-}
 
-func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	var r ListUsageRecordsResponse
+	if err := s.cs.unmarshal("listUsageRecords", resp, &r); err != nil {
+		return nil, err
 	}
-	p.p["pagesize"] = v
-	return
+	return &r, nil
 }
 
-func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// Lists the objects like ListUsageRecords, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UsageService) StreamUsageRecords(ctx context.Context, p *ListUsageRecordsParams, fn func(*UsageRecord) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
 	}
-	p.p["zoneid"] = v
-	return
+
+	return s.cs.streamList(ctx, "listUsageRecords", p.URLValues(), "usagerecord", func(b json.RawMessage) error {
+		var v UsageRecord
+		if err := s.cs.unmarshalElement("listUsageRecords", "usagerecord", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
 
-// You should always use this function to get a new ListTrafficMonitorsParams instance,
+type ListUsageRecordsResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	UsageRecords []*UsageRecord             `json:"usagerecord" xml:"usagerecord"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UsageRecord struct {
+	Account          string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid        string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Cpunumber        int64                      `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed         int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Description      string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain           string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid         string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Enddate          Date                       `json:"enddate,omitempty" xml:"enddate,omitempty"`
+	Isdefault        Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Issourcenat      Bool                       `json:"issourcenat,omitempty" xml:"issourcenat,omitempty"`
+	Issystem         Bool                       `json:"issystem,omitempty" xml:"issystem,omitempty"`
+	Memory           int64                      `json:"memory,omitempty" xml:"memory,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkid        string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Offeringid       string                     `json:"offeringid,omitempty" xml:"offeringid,omitempty"`
+	Project          string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid        string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Rawusage         Float                      `json:"rawusage,omitempty" xml:"rawusage,omitempty"`
+	Size             Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Startdate        Date                       `json:"startdate,omitempty" xml:"startdate,omitempty"`
+	Templateid       string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Type             string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Usage            string                     `json:"usage,omitempty" xml:"usage,omitempty"`
+	Usageid          string                     `json:"usageid,omitempty" xml:"usageid,omitempty"`
+	Usagetype        int                        `json:"usagetype,omitempty" xml:"usagetype,omitempty"`
+	Virtualmachineid string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Virtualsize      Size                       `json:"virtualsize,omitempty" xml:"virtualsize,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListUsageTypesParams = cloudstackcommon.ListUsageTypesParams
+type ListUsageTypesResponse = cloudstackcommon.ListUsageTypesResponse
+type UsageType = cloudstackcommon.UsageType
+
+// You should always use this function to get a new ListUsageTypesParams instance,
 // as then you are sure you have configured all required params
-func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams {
-	p := &ListTrafficMonitorsParams{}
-	p.p = make(map[string]interface{})
-	p.p["zoneid"] = zoneid
+func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
+	p := &ListUsageTypesParams{}
 	return p
 }
 
-// List traffic monitor Hosts.
-func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficMonitors", p.toURLValues())
+// List Usage Types
+func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listUsageTypes", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListTrafficMonitorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListUsageTypesResponse
+	if err := s.cs.unmarshal("listUsageTypes", resp, &r); err != nil {
 		return nil, err
 	}
 	return &r, nil
 }
 
-type ListTrafficMonitorsResponse struct {
-	Count           int               `json:"count"`
-	TrafficMonitors []*TrafficMonitor `json:"trafficmonitor"`
-}
+// Lists the objects like ListUsageTypes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *UsageService) StreamUsageTypes(ctx context.Context, p *ListUsageTypesParams, fn func(*UsageType) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
 
-type TrafficMonitor struct {
-	Id         string `json:"id,omitempty"`
-	Ipaddress  string `json:"ipaddress,omitempty"`
-	Numretries string `json:"numretries,omitempty"`
-	Timeout    string `json:"timeout,omitempty"`
-	Zoneid     string `json:"zoneid,omitempty"`
+	return s.cs.streamList(ctx, "listUsageTypes", p.URLValues(), "usagetype", func(b json.RawMessage) error {
+		var v UsageType
+		if err := s.cs.unmarshalElement("listUsageTypes", "usagetype", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
 }
@@ -0,0 +1,868 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddTrafficMonitor(t *testing.T) {
+	params := url.Values{
+		"excludezones": {"test-excludezones"},
+		"includezones": {"test-includezones"},
+		"url":          {"test-url"},
+		"zoneid":       {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "addTrafficMonitor", params, `{"addtrafficmonitorresponse":{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","timeout":"test-timeout","zoneid":"test-zoneid"}}`)
+	defer done()
+
+	p := cs.Usage.NewAddTrafficMonitorParams("test-url", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetExcludezones("test-excludezones")
+	p.SetIncludezones("test-includezones")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "addTrafficMonitor", p.URLValues(), params)
+
+	r, err := cs.Usage.AddTrafficMonitor(p)
+	if err != nil {
+		t.Fatalf("Failed to call addTrafficMonitor: %v", err)
+	}
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addTrafficMonitor" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addTrafficMonitor: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addTrafficMonitor: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addTrafficMonitor: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addTrafficMonitor", params, `<addtrafficmonitorresponse><id>test-id</id><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><timeout>test-timeout</timeout><zoneid>test-zoneid</zoneid></addtrafficmonitorresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.AddTrafficMonitor(p)
+	if err != nil {
+		t.Fatalf("Failed to call addTrafficMonitor using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteTrafficMonitor(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteTrafficMonitor", params, `{"deletetrafficmonitorresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Usage.NewDeleteTrafficMonitorParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteTrafficMonitor", p.URLValues(), params)
+
+	r, err := cs.Usage.DeleteTrafficMonitor(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTrafficMonitor: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteTrafficMonitor" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteTrafficMonitor: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteTrafficMonitor: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteTrafficMonitor: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteTrafficMonitor", params, `<deletetrafficmonitorresponse><displaytext>test-displaytext</displaytext><success>true</success></deletetrafficmonitorresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.DeleteTrafficMonitor(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTrafficMonitor using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListTrafficMonitors(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listTrafficMonitors", params, `{"listtrafficmonitorsresponse":{"count":1,"trafficmonitor":[{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","timeout":"test-timeout","zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Usage.NewListTrafficMonitorsParams("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listTrafficMonitors", p.URLValues(), params)
+
+	r, err := cs.Usage.ListTrafficMonitors(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTrafficMonitors: %v", err)
+	}
+	if r.TrafficMonitors[0].Id != "test-id" {
+		t.Errorf("Expected r.TrafficMonitors[0].Id to be %v, got %v", "test-id", r.TrafficMonitors[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listTrafficMonitors" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listTrafficMonitors: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listTrafficMonitors: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listTrafficMonitors: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*TrafficMonitor
+	count, err := cs.Usage.StreamTrafficMonitors(context.Background(), p, func(v *TrafficMonitor) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listTrafficMonitors: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.TrafficMonitors) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.TrafficMonitors)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listTrafficMonitors", params, `<listtrafficmonitorsresponse><count>1</count><trafficmonitor><id>test-id</id><ipaddress>test-ipaddress</ipaddress><numretries>test-numretries</numretries><timeout>test-timeout</timeout><zoneid>test-zoneid</zoneid></trafficmonitor></listtrafficmonitorsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.ListTrafficMonitors(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTrafficMonitors using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Usage.StreamTrafficMonitors(context.Background(), p, func(v *TrafficMonitor) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listTrafficMonitors using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.TrafficMonitors) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.TrafficMonitors)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestAddTrafficType(t *testing.T) {
+	params := url.Values{
+		"hypervnetworklabel": {"test-hypervnetworklabel"},
+		"isolationmethod":    {"test-isolationmethod"},
+		"kvmnetworklabel":    {"test-kvmnetworklabel"},
+		"physicalnetworkid":  {"test-physicalnetworkid"},
+		"traffictype":        {"Control"},
+		"vlan":               {"test-vlan"},
+		"vmwarenetworklabel": {"test-vmwarenetworklabel"},
+		"xennetworklabel":    {"test-xennetworklabel"},
+	}
+	cs, done := newTestClient(t, "addTrafficType", params, `{"addtraffictyperesponse":{"hypervnetworklabel":"test-hypervnetworklabel","id":"test-id","jobid":"test-jobid","kvmnetworklabel":"test-kvmnetworklabel","physicalnetworkid":"test-physicalnetworkid","traffictype":"test-traffictype","vmwarenetworklabel":"test-vmwarenetworklabel","xennetworklabel":"test-xennetworklabel"}}`)
+	defer done()
+
+	p := cs.Usage.NewAddTrafficTypeParams("test-physicalnetworkid", TrafficTypeControl)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHypervnetworklabel("test-hypervnetworklabel")
+	p.SetIsolationmethod("test-isolationmethod")
+	p.SetKvmnetworklabel("test-kvmnetworklabel")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetTraffictype(TrafficTypeControl)
+	p.SetVlan("test-vlan")
+	p.SetVmwarenetworklabel("test-vmwarenetworklabel")
+	p.SetXennetworklabel("test-xennetworklabel")
+	checkURLValues(t, "addTrafficType", p.URLValues(), params)
+
+	r, err := cs.Usage.AddTrafficType(p)
+	if err != nil {
+		t.Fatalf("Failed to call addTrafficType: %v", err)
+	}
+	if r.Hypervnetworklabel != "test-hypervnetworklabel" {
+		t.Errorf("Expected r.Hypervnetworklabel to be %v, got %v", "test-hypervnetworklabel", r.Hypervnetworklabel)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "addTrafficType" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute addTrafficType: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of addTrafficType: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of addTrafficType: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "addTrafficType", params, `<addtraffictyperesponse><hypervnetworklabel>test-hypervnetworklabel</hypervnetworklabel><id>test-id</id><jobid>test-jobid</jobid><kvmnetworklabel>test-kvmnetworklabel</kvmnetworklabel><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><traffictype>test-traffictype</traffictype><vmwarenetworklabel>test-vmwarenetworklabel</vmwarenetworklabel><xennetworklabel>test-xennetworklabel</xennetworklabel></addtraffictyperesponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.AddTrafficType(p)
+	if err != nil {
+		t.Fatalf("Failed to call addTrafficType using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteTrafficType(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteTrafficType", params, `{"deletetraffictyperesponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Usage.NewDeleteTrafficTypeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteTrafficType", p.URLValues(), params)
+
+	r, err := cs.Usage.DeleteTrafficType(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTrafficType: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteTrafficType" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteTrafficType: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteTrafficType: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteTrafficType: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteTrafficType", params, `<deletetraffictyperesponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></deletetraffictyperesponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.DeleteTrafficType(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteTrafficType using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListTrafficTypes(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"page":              {"2"},
+		"pagesize":          {"3"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+	cs, done := newTestClient(t, "listTrafficTypes", params, `{"listtraffictypesresponse":{"count":1,"traffictype":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}}`)
+	defer done()
+
+	p := cs.Usage.NewListTrafficTypesParams("test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	checkURLValues(t, "listTrafficTypes", p.URLValues(), params)
+
+	r, err := cs.Usage.ListTrafficTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTrafficTypes: %v", err)
+	}
+	if r.TrafficTypes[0].Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.TrafficTypes[0].Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.TrafficTypes[0].Destinationphysicalnetworkid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listTrafficTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listTrafficTypes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listTrafficTypes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listTrafficTypes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*TrafficType
+	count, err := cs.Usage.StreamTrafficTypes(context.Background(), p, func(v *TrafficType) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listTrafficTypes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.TrafficTypes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.TrafficTypes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listTrafficTypes", params, `<listtraffictypesresponse><count>1</count><traffictype><canenableindividualservice>true</canenableindividualservice><destinationphysicalnetworkid>test-destinationphysicalnetworkid</destinationphysicalnetworkid><id>test-id</id><name>test-name</name><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><servicelist>test-servicelist</servicelist><state>test-state</state></traffictype></listtraffictypesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.ListTrafficTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTrafficTypes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Usage.StreamTrafficTypes(context.Background(), p, func(v *TrafficType) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listTrafficTypes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.TrafficTypes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.TrafficTypes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateTrafficType(t *testing.T) {
+	params := url.Values{
+		"hypervnetworklabel": {"test-hypervnetworklabel"},
+		"id":                 {"test-id"},
+		"kvmnetworklabel":    {"test-kvmnetworklabel"},
+		"vmwarenetworklabel": {"test-vmwarenetworklabel"},
+		"xennetworklabel":    {"test-xennetworklabel"},
+	}
+	cs, done := newTestClient(t, "updateTrafficType", params, `{"updatetraffictyperesponse":{"hypervnetworklabel":"test-hypervnetworklabel","id":"test-id","jobid":"test-jobid","kvmnetworklabel":"test-kvmnetworklabel","physicalnetworkid":"test-physicalnetworkid","traffictype":"test-traffictype","vmwarenetworklabel":"test-vmwarenetworklabel","xennetworklabel":"test-xennetworklabel"}}`)
+	defer done()
+
+	p := cs.Usage.NewUpdateTrafficTypeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetHypervnetworklabel("test-hypervnetworklabel")
+	p.SetId("test-id")
+	p.SetKvmnetworklabel("test-kvmnetworklabel")
+	p.SetVmwarenetworklabel("test-vmwarenetworklabel")
+	p.SetXennetworklabel("test-xennetworklabel")
+	checkURLValues(t, "updateTrafficType", p.URLValues(), params)
+
+	r, err := cs.Usage.UpdateTrafficType(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateTrafficType: %v", err)
+	}
+	if r.Hypervnetworklabel != "test-hypervnetworklabel" {
+		t.Errorf("Expected r.Hypervnetworklabel to be %v, got %v", "test-hypervnetworklabel", r.Hypervnetworklabel)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateTrafficType" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateTrafficType: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateTrafficType: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateTrafficType: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateTrafficType", params, `<updatetraffictyperesponse><hypervnetworklabel>test-hypervnetworklabel</hypervnetworklabel><id>test-id</id><jobid>test-jobid</jobid><kvmnetworklabel>test-kvmnetworklabel</kvmnetworklabel><physicalnetworkid>test-physicalnetworkid</physicalnetworkid><traffictype>test-traffictype</traffictype><vmwarenetworklabel>test-vmwarenetworklabel</vmwarenetworklabel><xennetworklabel>test-xennetworklabel</xennetworklabel></updatetraffictyperesponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.UpdateTrafficType(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateTrafficType using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListTrafficTypeImplementors(t *testing.T) {
+	params := url.Values{
+		"keyword":     {"test-keyword"},
+		"page":        {"2"},
+		"pagesize":    {"3"},
+		"traffictype": {"Control"},
+	}
+	cs, done := newTestClient(t, "listTrafficTypeImplementors", params, `{"listtraffictypeimplementorsresponse":{"count":1,"traffictypeimplementor":[{"traffictype":"test-traffictype","traffictypeimplementor":"test-traffictypeimplementor"}]}}`)
+	defer done()
+
+	p := cs.Usage.NewListTrafficTypeImplementorsParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetTraffictype(TrafficTypeControl)
+	checkURLValues(t, "listTrafficTypeImplementors", p.URLValues(), params)
+
+	r, err := cs.Usage.ListTrafficTypeImplementors(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTrafficTypeImplementors: %v", err)
+	}
+	if r.TrafficTypeImplementors[0].Traffictypeimplementor != "test-traffictypeimplementor" {
+		t.Errorf("Expected r.TrafficTypeImplementors[0].Traffictypeimplementor to be %v, got %v", "test-traffictypeimplementor", r.TrafficTypeImplementors[0].Traffictypeimplementor)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listTrafficTypeImplementors" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listTrafficTypeImplementors: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listTrafficTypeImplementors: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listTrafficTypeImplementors: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*TrafficTypeImplementor
+	count, err := cs.Usage.StreamTrafficTypeImplementors(context.Background(), p, func(v *TrafficTypeImplementor) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listTrafficTypeImplementors: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.TrafficTypeImplementors) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.TrafficTypeImplementors)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listTrafficTypeImplementors", params, `<listtraffictypeimplementorsresponse><count>1</count><traffictypeimplementor><traffictype>test-traffictype</traffictype><traffictypeimplementor>test-traffictypeimplementor</traffictypeimplementor></traffictypeimplementor></listtraffictypeimplementorsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.ListTrafficTypeImplementors(p)
+	if err != nil {
+		t.Fatalf("Failed to call listTrafficTypeImplementors using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Usage.StreamTrafficTypeImplementors(context.Background(), p, func(v *TrafficTypeImplementor) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listTrafficTypeImplementors using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.TrafficTypeImplementors) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.TrafficTypeImplementors)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestGenerateUsageRecords(t *testing.T) {
+	params := url.Values{
+		"domainid":  {"test-domainid"},
+		"enddate":   {"test-enddate"},
+		"startdate": {"test-startdate"},
+	}
+	cs, done := newTestClient(t, "generateUsageRecords", params, `{"generateusagerecordsresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Usage.NewGenerateUsageRecordsParams("test-enddate", "test-startdate")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDomainid("test-domainid")
+	p.SetEnddate("test-enddate")
+	p.SetStartdate("test-startdate")
+	checkURLValues(t, "generateUsageRecords", p.URLValues(), params)
+
+	r, err := cs.Usage.GenerateUsageRecords(p)
+	if err != nil {
+		t.Fatalf("Failed to call generateUsageRecords: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "generateUsageRecords" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute generateUsageRecords: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of generateUsageRecords: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of generateUsageRecords: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "generateUsageRecords", params, `<generateusagerecordsresponse><displaytext>test-displaytext</displaytext><success>true</success></generateusagerecordsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.GenerateUsageRecords(p)
+	if err != nil {
+		t.Fatalf("Failed to call generateUsageRecords using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListUsageRecords(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"accountid": {"test-accountid"},
+		"domainid":  {"test-domainid"},
+		"enddate":   {"test-enddate"},
+		"keyword":   {"test-keyword"},
+		"page":      {"6"},
+		"pagesize":  {"7"},
+		"projectid": {"test-projectid"},
+		"startdate": {"test-startdate"},
+		"type":      {"10"},
+	}
+	cs, done := newTestClient(t, "listUsageRecords", params, `{"listusagerecordsresponse":{"count":1,"usagerecord":[{"account":"test-account","accountid":"test-accountid","cpunumber":1,"cpuspeed":1,"description":"test-description","domain":"test-domain","domainid":"test-domainid","enddate":"2014-01-02T15:04:05+0100","isdefault":true,"issourcenat":true,"issystem":true,"memory":1,"name":"test-name","networkid":"test-networkid","offeringid":"test-offeringid","project":"test-project","projectid":"test-projectid","rawusage":"1.5","size":1,"startdate":"2014-01-02T15:04:05+0100","templateid":"test-templateid","type":"test-type","usage":"test-usage","usageid":"test-usageid","usagetype":1,"virtualmachineid":"test-virtualmachineid","virtualsize":1,"zoneid":"test-zoneid"}]}}`)
+	defer done()
+
+	p := cs.Usage.NewListUsageRecordsParams("test-enddate", "test-startdate")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAccountid("test-accountid")
+	p.SetDomainid("test-domainid")
+	p.SetEnddate("test-enddate")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetProjectid("test-projectid")
+	p.SetStartdate("test-startdate")
+	p.SetType(10)
+	checkURLValues(t, "listUsageRecords", p.URLValues(), params)
+
+	r, err := cs.Usage.ListUsageRecords(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUsageRecords: %v", err)
+	}
+	if r.UsageRecords[0].Account != "test-account" {
+		t.Errorf("Expected r.UsageRecords[0].Account to be %v, got %v", "test-account", r.UsageRecords[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUsageRecords" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUsageRecords: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUsageRecords: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUsageRecords: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*UsageRecord
+	count, err := cs.Usage.StreamUsageRecords(context.Background(), p, func(v *UsageRecord) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUsageRecords: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.UsageRecords) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.UsageRecords)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUsageRecords", params, `<listusagerecordsresponse><count>1</count><usagerecord><account>test-account</account><accountid>test-accountid</accountid><cpunumber>1</cpunumber><cpuspeed>1</cpuspeed><description>test-description</description><domain>test-domain</domain><domainid>test-domainid</domainid><enddate>2014-01-02T15:04:05+0100</enddate><isdefault>true</isdefault><issourcenat>true</issourcenat><issystem>true</issystem><memory>1</memory><name>test-name</name><networkid>test-networkid</networkid><offeringid>test-offeringid</offeringid><project>test-project</project><projectid>test-projectid</projectid><rawusage>1.5</rawusage><size>1</size><startdate>2014-01-02T15:04:05+0100</startdate><templateid>test-templateid</templateid><type>test-type</type><usage>test-usage</usage><usageid>test-usageid</usageid><usagetype>1</usagetype><virtualmachineid>test-virtualmachineid</virtualmachineid><virtualsize>1</virtualsize><zoneid>test-zoneid</zoneid></usagerecord></listusagerecordsresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.ListUsageRecords(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUsageRecords using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Usage.StreamUsageRecords(context.Background(), p, func(v *UsageRecord) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUsageRecords using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.UsageRecords) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.UsageRecords)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListUsageTypes(t *testing.T) {
+	params := url.Values{}
+	cs, done := newTestClient(t, "listUsageTypes", params, `{"listusagetypesresponse":{"count":1,"usagetype":[{"description":"test-description","usagetypeid":1}]}}`)
+	defer done()
+
+	p := cs.Usage.NewListUsageTypesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listUsageTypes", p.URLValues(), params)
+
+	r, err := cs.Usage.ListUsageTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUsageTypes: %v", err)
+	}
+	if r.UsageTypes[0].Description != "test-description" {
+		t.Errorf("Expected r.UsageTypes[0].Description to be %v, got %v", "test-description", r.UsageTypes[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listUsageTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listUsageTypes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listUsageTypes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listUsageTypes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*UsageType
+	count, err := cs.Usage.StreamUsageTypes(context.Background(), p, func(v *UsageType) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listUsageTypes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.UsageTypes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.UsageTypes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listUsageTypes", params, `<listusagetypesresponse><count>1</count><usagetype><description>test-description</description><usagetypeid>1</usagetypeid></usagetype></listusagetypesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Usage.ListUsageTypes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listUsageTypes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Usage.StreamUsageTypes(context.Background(), p, func(v *UsageType) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listUsageTypes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.UsageTypes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.UsageTypes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
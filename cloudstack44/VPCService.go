@@ -17,170 +17,841 @@
 package cloudstack44
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/xanzy/go-cloudstack/cloudstackcommon"
 )
 
-type CreateVPCParams struct {
-	p map[string]interface{}
+type VPCService struct {
+	cs *CloudStackClient
 }
 
-func (p *CreateVPCParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+func NewVPCService(cs *CloudStackClient) *VPCService {
+	return &VPCService{cs: cs}
+}
+
+type CreatePrivateGatewayParams = cloudstackcommon.CreatePrivateGatewayParams
+type CreatePrivateGatewayResponse = cloudstackcommon.CreatePrivateGatewayResponse
+
+// You should always use this function to get a new CreatePrivateGatewayParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - gateway
+//   - ipaddress
+//   - netmask: the netmask
+//   - vlan
+//   - vpcid: the ID of the VPC
+func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams {
+	p := &CreatePrivateGatewayParams{}
+	p.SetGateway(gateway)
+	p.SetIpaddress(ipaddress)
+	p.SetNetmask(netmask)
+	p.SetVlan(vlan)
+	p.SetVpcid(vpcid)
+	return p
+}
+
+// Creates a private gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPrivateGateways, GetPrivateGatewayByID.
+func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createPrivateGateway", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r CreatePrivateGatewayResponse
+	if err := s.cs.unmarshal("createPrivateGateway", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createPrivateGateway", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type DeletePrivateGatewayParams = cloudstackcommon.DeletePrivateGatewayParams
+type DeletePrivateGatewayResponse = cloudstackcommon.DeletePrivateGatewayResponse
+
+// You should always use this function to get a new DeletePrivateGatewayParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams {
+	p := &DeletePrivateGatewayParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a Private gateway
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListPrivateGateways, GetPrivateGatewayByID.
+func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deletePrivateGateway", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeletePrivateGatewayResponse
+	if err := s.cs.unmarshal("deletePrivateGateway", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deletePrivateGateway", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListPrivateGatewaysParams = cloudstackcommon.ListPrivateGatewaysParams
+type ListPrivateGatewaysResponse = cloudstackcommon.ListPrivateGatewaysResponse
+type PrivateGateway = cloudstackcommon.PrivateGateway
+
+// You should always use this function to get a new ListPrivateGatewaysParams instance,
+// as then you are sure you have configured all required params
+func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
+	p := &ListPrivateGatewaysParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
+	p := &ListPrivateGatewaysParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListPrivateGateways(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListPrivateGateways(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.PrivateGateways[0], l.Count, nil
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	return nil, l.Count, fmt.Errorf("There is more then one result for PrivateGateway UUID: %s!", id)
+}
+
+// List private gateways
+//
+// See also GetPrivateGatewayByID.
+func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listPrivateGateways", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["cidr"]; found {
-		u.Set("cidr", v.(string))
+
+	var r ListPrivateGatewaysResponse
+	if err := s.cs.unmarshal("listPrivateGateways", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	return &r, nil
+}
+
+// Lists the objects like ListPrivateGateways, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPCService) StreamPrivateGateways(ctx context.Context, p *ListPrivateGatewaysParams, fn func(*PrivateGateway) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listPrivateGateways", p.URLValues(), "privategateway", func(b json.RawMessage) error {
+		var v PrivateGateway
+		if err := s.cs.unmarshalElement("listPrivateGateways", "privategateway", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type CreateStaticRouteParams = cloudstackcommon.CreateStaticRouteParams
+type CreateStaticRouteResponse = cloudstackcommon.CreateStaticRouteResponse
+
+// You should always use this function to get a new CreateStaticRouteParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - cidr
+//   - gatewayid
+func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams {
+	p := &CreateStaticRouteParams{}
+	p.SetCidr(cidr)
+	p.SetGatewayid(gatewayid)
+	return p
+}
+
+// Creates a static route
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListStaticRoutes, GetStaticRouteByID, GetStaticRoutesByTags.
+func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+
+	resp, err := s.cs.newRequest("createStaticRoute", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+
+	var r CreateStaticRouteResponse
+	if err := s.cs.unmarshal("createStaticRoute", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("createStaticRoute", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type DeleteStaticRouteParams = cloudstackcommon.DeleteStaticRouteParams
+type DeleteStaticRouteResponse = cloudstackcommon.DeleteStaticRouteResponse
+
+// You should always use this function to get a new DeleteStaticRouteParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams {
+	p := &DeleteStaticRouteParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes a static route
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListStaticRoutes, GetStaticRouteByID, GetStaticRoutesByTags.
+func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteStaticRoute", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteStaticRouteResponse
+	if err := s.cs.unmarshal("deleteStaticRoute", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteStaticRoute", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
+}
+
+type ListStaticRoutesParams = cloudstackcommon.ListStaticRoutesParams
+type ListStaticRoutesResponse = cloudstackcommon.ListStaticRoutesResponse
+type StaticRoute = cloudstackcommon.StaticRoute
+
+// You should always use this function to get a new ListStaticRoutesParams instance,
+// as then you are sure you have configured all required params
+func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
+	p := &ListStaticRoutesParams{}
+	return p
+}
+
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
+	p := &ListStaticRoutesParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
+	}
+
+	l, err := s.ListStaticRoutes(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListStaticRoutes(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.StaticRoutes[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for StaticRoute UUID: %s!", id)
+}
+
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *VPCService) GetStaticRoutesByTags(tags map[string]string, opts ...OptionFunc) ([]*StaticRoute, error) {
+	p := &ListStaticRoutesParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := s.ListStaticRoutes(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListStaticRoutes(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return l.StaticRoutes, nil
+}
+
+// Lists all static routes
+//
+// See also GetStaticRouteByID, GetStaticRoutesByTags.
+func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listStaticRoutes", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r ListStaticRoutesResponse
+	if err := s.cs.unmarshal("listStaticRoutes", resp, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// Lists the objects like ListStaticRoutes, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPCService) StreamStaticRoutes(ctx context.Context, p *ListStaticRoutesParams, fn func(*StaticRoute) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	return s.cs.streamList(ctx, "listStaticRoutes", p.URLValues(), "staticroute", func(b json.RawMessage) error {
+		var v StaticRoute
+		if err := s.cs.unmarshalElement("listStaticRoutes", "staticroute", b, &v); err != nil {
+			return err
+		}
+		return fn(&v)
+	})
+}
+
+type CreateVPCParams struct {
+	Account       *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidr          *string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+	Displaytext   *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid      *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay    *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Name          *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Projectid     *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Start         *bool   `json:"start,omitempty" yaml:"start,omitempty"`
+	Vpcofferingid *string `json:"vpcofferingid,omitempty" yaml:"vpcofferingid,omitempty"`
+	Zoneid        *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVPCParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Cidr; v != nil {
+		u.Set("cidr", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["networkdomain"]; found {
-		u.Set("networkdomain", v.(string))
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["start"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Start; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("start", vv)
 	}
-	if v, found := p.p["vpcofferingid"]; found {
-		u.Set("vpcofferingid", v.(string))
+	if v := p.Vpcofferingid; v != nil {
+		u.Set("vpcofferingid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *CreateVPCParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
+// Returns the name of the API command of these params
+func (p *CreateVPCParams) Command() string {
+	return "createVPC"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVPCParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVPCParams) ResponseType() interface{} {
+	return &CreateVPCResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVPCParams) Validate() error {
+	v := &validator{api: "createVPC"}
+	v.required("cidr", p.Cidr != nil)
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	v.required("vpcofferingid", p.Vpcofferingid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateVPCParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateVPCParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateVPCParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateVPCParams) ResetAccount() {
+	p.Account = nil
+}
+
+// This param is required.
+func (p *CreateVPCParams) SetCidr(v string) {
+	p.Cidr = &v
 	return
 }
 
-func (p *CreateVPCParams) SetCidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetCidr() string {
+	if p.Cidr == nil {
+		return ""
 	}
-	p.p["cidr"] = v
-	return
+	return *p.Cidr
+}
+
+func (p *CreateVPCParams) HasCidr() bool {
+	return p.Cidr != nil
 }
 
+func (p *CreateVPCParams) ResetCidr() {
+	p.Cidr = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
 func (p *CreateVPCParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+	p.Displaytext = &v
 	return
 }
 
-func (p *CreateVPCParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["domainid"] = v
+	return *p.Displaytext
+}
+
+func (p *CreateVPCParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateVPCParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateVPCParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *CreateVPCParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Domainid
+}
+
+func (p *CreateVPCParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateVPCParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *CreateVPCParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *CreateVPCParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["name"] = v
+	return *p.Fordisplay
+}
+
+func (p *CreateVPCParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
+}
+
+func (p *CreateVPCParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
+
+// This param is required.
+func (p *CreateVPCParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *CreateVPCParams) SetNetworkdomain(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["networkdomain"] = v
+	return *p.Name
+}
+
+func (p *CreateVPCParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateVPCParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *CreateVPCParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
 	return
 }
 
-func (p *CreateVPCParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
 	}
-	p.p["projectid"] = v
+	return *p.Networkdomain
+}
+
+func (p *CreateVPCParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *CreateVPCParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateVPCParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *CreateVPCParams) SetStart(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["start"] = v
+	return *p.Projectid
+}
+
+func (p *CreateVPCParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateVPCParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *CreateVPCParams) SetStart(v bool) {
+	p.Start = &v
 	return
 }
 
-func (p *CreateVPCParams) SetVpcofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetStart() bool {
+	if p.Start == nil {
+		return false
 	}
-	p.p["vpcofferingid"] = v
+	return *p.Start
+}
+
+func (p *CreateVPCParams) HasStart() bool {
+	return p.Start != nil
+}
+
+func (p *CreateVPCParams) ResetStart() {
+	p.Start = nil
+}
+
+// This param is required.
+func (p *CreateVPCParams) SetVpcofferingid(v string) {
+	p.Vpcofferingid = &v
 	return
 }
 
-func (p *CreateVPCParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCParams) GetVpcofferingid() string {
+	if p.Vpcofferingid == nil {
+		return ""
 	}
-	p.p["zoneid"] = v
+	return *p.Vpcofferingid
+}
+
+func (p *CreateVPCParams) HasVpcofferingid() bool {
+	return p.Vpcofferingid != nil
+}
+
+func (p *CreateVPCParams) ResetVpcofferingid() {
+	p.Vpcofferingid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *CreateVPCParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
+func (p *CreateVPCParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreateVPCParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreateVPCParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
 // You should always use this function to get a new CreateVPCParams instance,
 // as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - cidr
+//   - displaytext: the display text
+//   - name
+//   - vpcofferingid
+//   - zoneid: the ID of the zone
 func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams {
 	p := &CreateVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidr"] = cidr
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["vpcofferingid"] = vpcofferingid
-	p.p["zoneid"] = zoneid
+	p.SetCidr(cidr)
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetVpcofferingid(vpcofferingid)
+	p.SetZoneid(zoneid)
 	return p
 }
 
 // Creates a VPC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCs, GetVPCID, GetVPCByName, GetVPCByID, GetVPCsByTags.
 func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
-	resp, err := s.cs.newRequest("createVPC", p.toURLValues())
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVPC", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.unmarshal("createVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -201,7 +872,7 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -209,1340 +880,912 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 }
 
 type CreateVPCResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Cidr                 string `json:"cidr,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Network              []struct {
-		Account                     string `json:"account,omitempty"`
-		Aclid                       string `json:"aclid,omitempty"`
-		Acltype                     string `json:"acltype,omitempty"`
-		Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-		Broadcasturi                string `json:"broadcasturi,omitempty"`
-		Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-		Cidr                        string `json:"cidr,omitempty"`
-		Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-		Displaytext                 string `json:"displaytext,omitempty"`
-		Dns1                        string `json:"dns1,omitempty"`
-		Dns2                        string `json:"dns2,omitempty"`
-		Domain                      string `json:"domain,omitempty"`
-		Domainid                    string `json:"domainid,omitempty"`
-		Gateway                     string `json:"gateway,omitempty"`
-		Id                          string `json:"id,omitempty"`
-		Ip6cidr                     string `json:"ip6cidr,omitempty"`
-		Ip6gateway                  string `json:"ip6gateway,omitempty"`
-		Isdefault                   bool   `json:"isdefault,omitempty"`
-		Ispersistent                bool   `json:"ispersistent,omitempty"`
-		Issystem                    bool   `json:"issystem,omitempty"`
-		Name                        string `json:"name,omitempty"`
-		Netmask                     string `json:"netmask,omitempty"`
-		Networkcidr                 string `json:"networkcidr,omitempty"`
-		Networkdomain               string `json:"networkdomain,omitempty"`
-		Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-		Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-		Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-		Networkofferingid           string `json:"networkofferingid,omitempty"`
-		Networkofferingname         string `json:"networkofferingname,omitempty"`
-		Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-		Project                     string `json:"project,omitempty"`
-		Projectid                   string `json:"projectid,omitempty"`
-		Related                     string `json:"related,omitempty"`
-		Reservediprange             string `json:"reservediprange,omitempty"`
-		Restartrequired             bool   `json:"restartrequired,omitempty"`
-		Service                     []struct {
-			Capability []struct {
-				Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-				Name                       string `json:"name,omitempty"`
-				Value                      string `json:"value,omitempty"`
-			} `json:"capability,omitempty"`
-			Name     string `json:"name,omitempty"`
-			Provider []struct {
-				Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-				Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-				Id                           string   `json:"id,omitempty"`
-				Name                         string   `json:"name,omitempty"`
-				Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-				Servicelist                  []string `json:"servicelist,omitempty"`
-				State                        string   `json:"state,omitempty"`
-			} `json:"provider,omitempty"`
-		} `json:"service,omitempty"`
-		Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-		State            string `json:"state,omitempty"`
-		Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-		Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-		Tags             []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Traffictype       string   `json:"traffictype,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		Vlan              string   `json:"vlan,omitempty"`
-		Vpcid             string   `json:"vpcid,omitempty"`
-		Zoneid            string   `json:"zoneid,omitempty"`
-		Zonename          string   `json:"zonename,omitempty"`
-		Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-	} `json:"network,omitempty"`
-	Networkdomain   string `json:"networkdomain,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Regionlevelvpc  bool   `json:"regionlevelvpc,omitempty"`
-	Restartrequired bool   `json:"restartrequired,omitempty"`
-	Service         []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State string `json:"state,omitempty"`
-	Tags  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vpcofferingid string `json:"vpcofferingid,omitempty"`
-	Zoneid        string `json:"zoneid,omitempty"`
-	Zonename      string `json:"zonename,omitempty"`
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr                 string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext          string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Network              []Network                  `json:"network,omitempty" xml:"network,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionlevelvpc       Bool                       `json:"regionlevelvpc,omitempty" xml:"regionlevelvpc,omitempty"`
+	Restartrequired      Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service              []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vpcofferingid        string                     `json:"vpcofferingid,omitempty" xml:"vpcofferingid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVPCParams = cloudstackcommon.DeleteVPCParams
+type DeleteVPCResponse = cloudstackcommon.DeleteVPCResponse
+
+// You should always use this function to get a new DeleteVPCParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
+	p := &DeleteVPCParams{}
+	p.SetId(id)
+	return p
 }
 
-type ListVPCsParams struct {
-	p map[string]interface{}
+// Deletes a VPC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCs, GetVPCID, GetVPCByName, GetVPCByID, GetVPCsByTags.
+func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVPC", p.URLValues())
+	if err != nil {
+		return nil, err
+	}
+
+	var r DeleteVPCResponse
+	if err := s.cs.unmarshal("deleteVPC", resp, &r); err != nil {
+		return nil, err
+	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteVPC", b, &r); err != nil {
+			return nil, err
+		}
+	}
+	return &r, nil
 }
 
-func (p *ListVPCsParams) toURLValues() url.Values {
+type ListVPCsParams struct {
+	Account           *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidr              *string           `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+	Displaytext       *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid          *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fordisplay        *bool             `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive       *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword           *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall           *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name              *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid         *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Restartrequired   *bool             `json:"restartrequired,omitempty" yaml:"restartrequired,omitempty"`
+	State             *string           `json:"state,omitempty" yaml:"state,omitempty"`
+	Supportedservices []string          `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vpcofferingid     *string           `json:"vpcofferingid,omitempty" yaml:"vpcofferingid,omitempty"`
+	Zoneid            *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVPCsParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Cidr; v != nil {
+		u.Set("cidr", *v)
 	}
-	if v, found := p.p["cidr"]; found {
-		u.Set("cidr", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("fordisplay", vv)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("isrecursive", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("listall", vv)
 	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
 	}
-	if v, found := p.p["restartrequired"]; found {
-		vv := strconv.FormatBool(v.(bool))
+	if v := p.Restartrequired; v != nil {
+		vv := strconv.FormatBool(*v)
 		u.Set("restartrequired", vv)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ", ")
+	if v := p.Supportedservices; v != nil {
+		vv := strings.Join(v, ", ")
 		u.Set("supportedservices", vv)
 	}
-	if v, found := p.p["tags"]; found {
+	if v := p.Tags; v != nil {
 		i := 0
-		for k, vv := range v.(map[string]string) {
+		for k, vv := range v {
 			u.Set(fmt.Sprintf("tags[%d].key", i), k)
 			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
 			i++
 		}
 	}
-	if v, found := p.p["vpcofferingid"]; found {
-		u.Set("vpcofferingid", v.(string))
+	if v := p.Vpcofferingid; v != nil {
+		u.Set("vpcofferingid", *v)
 	}
-	if v, found := p.p["zoneid"]; found {
-		u.Set("zoneid", v.(string))
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
 	}
 	return u
 }
 
-func (p *ListVPCsParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListVPCsParams) Command() string {
+	return "listVPCs"
 }
 
-func (p *ListVPCsParams) SetCidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["cidr"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListVPCsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListVPCsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVPCsParams) ResponseType() interface{} {
+	return &ListVPCsResponse{}
 }
 
-func (p *ListVPCsParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVPCsParams) Validate() error {
+	v := &validator{api: "listVPCs"}
+	return v.err()
 }
 
-func (p *ListVPCsParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["fordisplay"] = v
+// Sets the 'account' param: the name of the account.
+func (p *ListVPCsParams) SetAccount(v string) {
+	p.Account = &v
 	return
 }
 
-func (p *ListVPCsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
 	}
-	p.p["id"] = v
-	return
+	return *p.Account
 }
 
-func (p *ListVPCsParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
-	return
+func (p *ListVPCsParams) HasAccount() bool {
+	return p.Account != nil
 }
 
-func (p *ListVPCsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["keyword"] = v
-	return
+func (p *ListVPCsParams) ResetAccount() {
+	p.Account = nil
 }
 
-func (p *ListVPCsParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
+func (p *ListVPCsParams) SetCidr(v string) {
+	p.Cidr = &v
 	return
 }
 
-func (p *ListVPCsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetCidr() string {
+	if p.Cidr == nil {
+		return ""
 	}
-	p.p["name"] = v
-	return
+	return *p.Cidr
 }
 
-func (p *ListVPCsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListVPCsParams) HasCidr() bool {
+	return p.Cidr != nil
 }
 
-func (p *ListVPCsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
-	return
+func (p *ListVPCsParams) ResetCidr() {
+	p.Cidr = nil
 }
 
-func (p *ListVPCsParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["projectid"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *ListVPCsParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *ListVPCsParams) SetRestartrequired(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["restartrequired"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *ListVPCsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
+func (p *ListVPCsParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
 }
 
-func (p *ListVPCsParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
-	return
+func (p *ListVPCsParams) ResetDisplaytext() {
+	p.Displaytext = nil
 }
 
-func (p *ListVPCsParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVPCsParams) SetDomainid(v string) {
+	p.Domainid = &v
 	return
 }
 
-func (p *ListVPCsParams) SetVpcofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
 	}
-	p.p["vpcofferingid"] = v
+	return *p.Domainid
+}
+
+func (p *ListVPCsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVPCsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *ListVPCsParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListVPCsParams) SetZoneid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["zoneid"] = v
-	return
+	return *p.Fordisplay
 }
 
-// You should always use this function to get a new ListVPCsParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
-	p := &ListVPCsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *ListVPCsParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetVPCID(name string) (string, error) {
-	p := &ListVPCsParams{}
-	p.p = make(map[string]interface{})
+func (p *ListVPCsParams) ResetFordisplay() {
+	p.Fordisplay = nil
+}
 
-	p.p["name"] = name
+func (p *ListVPCsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	l, err := s.ListVPCs(p)
-	if err != nil {
-		return "", err
+func (p *ListVPCsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
+	return *p.Id
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *ListVPCsParams) HasId() bool {
+	return p.Id != nil
+}
 
-	if l.Count == 1 {
-		return l.VPCs[0].Id, nil
-	}
+func (p *ListVPCsParams) ResetId() {
+	p.Id = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.VPCs {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVPCsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetVPCByName(name string) (*VPC, int, error) {
-	id, err := s.GetVPCID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *ListVPCsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
 	}
+	return *p.Isrecursive
+}
 
-	r, count, err := s.GetVPCByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *ListVPCsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetVPCByID(id string) (*VPC, int, error) {
-	p := &ListVPCsParams{}
-	p.p = make(map[string]interface{})
+func (p *ListVPCsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
 
-	p.p["id"] = id
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVPCsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
 
-	l, err := s.ListVPCs(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *ListVPCsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
+	return *p.Keyword
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *ListVPCsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
 
-	if l.Count == 1 {
-		return l.VPCs[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VPC UUID: %s!", id)
+func (p *ListVPCsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-// Lists VPCs
-func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCs", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVPCsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
 
-	var r ListVPCsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListVPCsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
 	}
-	return &r, nil
+	return *p.Listall
 }
 
-type ListVPCsResponse struct {
-	Count int    `json:"count"`
-	VPCs  []*VPC `json:"vpc"`
+func (p *ListVPCsParams) HasListall() bool {
+	return p.Listall != nil
 }
 
-type VPC struct {
-	Account              string `json:"account,omitempty"`
-	Cidr                 string `json:"cidr,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Network              []struct {
-		Account                     string `json:"account,omitempty"`
-		Aclid                       string `json:"aclid,omitempty"`
-		Acltype                     string `json:"acltype,omitempty"`
-		Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-		Broadcasturi                string `json:"broadcasturi,omitempty"`
-		Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-		Cidr                        string `json:"cidr,omitempty"`
-		Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-		Displaytext                 string `json:"displaytext,omitempty"`
-		Dns1                        string `json:"dns1,omitempty"`
-		Dns2                        string `json:"dns2,omitempty"`
-		Domain                      string `json:"domain,omitempty"`
-		Domainid                    string `json:"domainid,omitempty"`
-		Gateway                     string `json:"gateway,omitempty"`
-		Id                          string `json:"id,omitempty"`
-		Ip6cidr                     string `json:"ip6cidr,omitempty"`
-		Ip6gateway                  string `json:"ip6gateway,omitempty"`
-		Isdefault                   bool   `json:"isdefault,omitempty"`
-		Ispersistent                bool   `json:"ispersistent,omitempty"`
-		Issystem                    bool   `json:"issystem,omitempty"`
-		Name                        string `json:"name,omitempty"`
-		Netmask                     string `json:"netmask,omitempty"`
-		Networkcidr                 string `json:"networkcidr,omitempty"`
-		Networkdomain               string `json:"networkdomain,omitempty"`
-		Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-		Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-		Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-		Networkofferingid           string `json:"networkofferingid,omitempty"`
-		Networkofferingname         string `json:"networkofferingname,omitempty"`
-		Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-		Project                     string `json:"project,omitempty"`
-		Projectid                   string `json:"projectid,omitempty"`
-		Related                     string `json:"related,omitempty"`
-		Reservediprange             string `json:"reservediprange,omitempty"`
-		Restartrequired             bool   `json:"restartrequired,omitempty"`
-		Service                     []struct {
-			Capability []struct {
-				Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-				Name                       string `json:"name,omitempty"`
-				Value                      string `json:"value,omitempty"`
-			} `json:"capability,omitempty"`
-			Name     string `json:"name,omitempty"`
-			Provider []struct {
-				Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-				Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-				Id                           string   `json:"id,omitempty"`
-				Name                         string   `json:"name,omitempty"`
-				Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-				Servicelist                  []string `json:"servicelist,omitempty"`
-				State                        string   `json:"state,omitempty"`
-			} `json:"provider,omitempty"`
-		} `json:"service,omitempty"`
-		Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-		State            string `json:"state,omitempty"`
-		Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-		Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-		Tags             []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Traffictype       string   `json:"traffictype,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		Vlan              string   `json:"vlan,omitempty"`
-		Vpcid             string   `json:"vpcid,omitempty"`
-		Zoneid            string   `json:"zoneid,omitempty"`
-		Zonename          string   `json:"zonename,omitempty"`
-		Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-	} `json:"network,omitempty"`
-	Networkdomain   string `json:"networkdomain,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Regionlevelvpc  bool   `json:"regionlevelvpc,omitempty"`
-	Restartrequired bool   `json:"restartrequired,omitempty"`
-	Service         []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State string `json:"state,omitempty"`
-	Tags  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vpcofferingid string `json:"vpcofferingid,omitempty"`
-	Zoneid        string `json:"zoneid,omitempty"`
-	Zonename      string `json:"zonename,omitempty"`
-}
-
-type DeleteVPCParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteVPCParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	return u
+func (p *ListVPCsParams) ResetListall() {
+	p.Listall = nil
 }
 
-func (p *DeleteVPCParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+func (p *ListVPCsParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-// You should always use this function to get a new DeleteVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
-	p := &DeleteVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListVPCsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
 }
 
-// Deletes a VPC
-func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPC", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListVPCsParams) HasName() bool {
+	return p.Name != nil
+}
 
-	var r DeleteVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *ListVPCsParams) ResetName() {
+	p.Name = nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVPCsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *ListVPCsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Page
 }
 
-type DeleteVPCResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *ListVPCsParams) HasPage() bool {
+	return p.Page != nil
 }
 
-type UpdateVPCParams struct {
-	p map[string]interface{}
+func (p *ListVPCsParams) ResetPage() {
+	p.Page = nil
 }
 
-func (p *UpdateVPCParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["customid"]; found {
-		u.Set("customid", v.(string))
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["fordisplay"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("fordisplay", vv)
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	return u
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVPCsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-func (p *UpdateVPCParams) SetCustomid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["customid"] = v
-	return
+	return *p.Pagesize
 }
 
-func (p *UpdateVPCParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
+func (p *ListVPCsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVPCsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVPCsParams) SetProjectid(v string) {
+	p.Projectid = &v
 	return
 }
 
-func (p *UpdateVPCParams) SetFordisplay(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
 	}
-	p.p["fordisplay"] = v
+	return *p.Projectid
+}
+
+func (p *ListVPCsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVPCsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListVPCsParams) SetRestartrequired(v bool) {
+	p.Restartrequired = &v
 	return
 }
 
-func (p *UpdateVPCParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetRestartrequired() bool {
+	if p.Restartrequired == nil {
+		return false
 	}
-	p.p["id"] = v
+	return *p.Restartrequired
+}
+
+func (p *ListVPCsParams) HasRestartrequired() bool {
+	return p.Restartrequired != nil
+}
+
+func (p *ListVPCsParams) ResetRestartrequired() {
+	p.Restartrequired = nil
+}
+
+func (p *ListVPCsParams) SetState(v string) {
+	p.State = &v
 	return
 }
 
-func (p *UpdateVPCParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCsParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
-	p.p["name"] = v
+	return *p.State
+}
+
+func (p *ListVPCsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListVPCsParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListVPCsParams) SetSupportedservices(v []string) {
+	p.Supportedservices = v
 	return
 }
 
-// You should always use this function to get a new UpdateVPCParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
-	p := &UpdateVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListVPCsParams) GetSupportedservices() []string {
+	return p.Supportedservices
 }
 
-// Updates a VPC
-func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
-	resp, err := s.cs.newRequest("updateVPC", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+func (p *ListVPCsParams) HasSupportedservices() bool {
+	return p.Supportedservices != nil
+}
 
-	var r UpdateVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+func (p *ListVPCsParams) ResetSupportedservices() {
+	p.Supportedservices = nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListVPCsParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+func (p *ListVPCsParams) GetTags() map[string]string {
+	return p.Tags
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+func (p *ListVPCsParams) HasTags() bool {
+	return p.Tags != nil
 }
 
-type UpdateVPCResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Cidr                 string `json:"cidr,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Network              []struct {
-		Account                     string `json:"account,omitempty"`
-		Aclid                       string `json:"aclid,omitempty"`
-		Acltype                     string `json:"acltype,omitempty"`
-		Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-		Broadcasturi                string `json:"broadcasturi,omitempty"`
-		Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-		Cidr                        string `json:"cidr,omitempty"`
-		Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-		Displaytext                 string `json:"displaytext,omitempty"`
-		Dns1                        string `json:"dns1,omitempty"`
-		Dns2                        string `json:"dns2,omitempty"`
-		Domain                      string `json:"domain,omitempty"`
-		Domainid                    string `json:"domainid,omitempty"`
-		Gateway                     string `json:"gateway,omitempty"`
-		Id                          string `json:"id,omitempty"`
-		Ip6cidr                     string `json:"ip6cidr,omitempty"`
-		Ip6gateway                  string `json:"ip6gateway,omitempty"`
-		Isdefault                   bool   `json:"isdefault,omitempty"`
-		Ispersistent                bool   `json:"ispersistent,omitempty"`
-		Issystem                    bool   `json:"issystem,omitempty"`
-		Name                        string `json:"name,omitempty"`
-		Netmask                     string `json:"netmask,omitempty"`
-		Networkcidr                 string `json:"networkcidr,omitempty"`
-		Networkdomain               string `json:"networkdomain,omitempty"`
-		Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-		Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-		Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-		Networkofferingid           string `json:"networkofferingid,omitempty"`
-		Networkofferingname         string `json:"networkofferingname,omitempty"`
-		Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-		Project                     string `json:"project,omitempty"`
-		Projectid                   string `json:"projectid,omitempty"`
-		Related                     string `json:"related,omitempty"`
-		Reservediprange             string `json:"reservediprange,omitempty"`
-		Restartrequired             bool   `json:"restartrequired,omitempty"`
-		Service                     []struct {
-			Capability []struct {
-				Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-				Name                       string `json:"name,omitempty"`
-				Value                      string `json:"value,omitempty"`
-			} `json:"capability,omitempty"`
-			Name     string `json:"name,omitempty"`
-			Provider []struct {
-				Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-				Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-				Id                           string   `json:"id,omitempty"`
-				Name                         string   `json:"name,omitempty"`
-				Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-				Servicelist                  []string `json:"servicelist,omitempty"`
-				State                        string   `json:"state,omitempty"`
-			} `json:"provider,omitempty"`
-		} `json:"service,omitempty"`
-		Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-		State            string `json:"state,omitempty"`
-		Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-		Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-		Tags             []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Traffictype       string   `json:"traffictype,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		Vlan              string   `json:"vlan,omitempty"`
-		Vpcid             string   `json:"vpcid,omitempty"`
-		Zoneid            string   `json:"zoneid,omitempty"`
-		Zonename          string   `json:"zonename,omitempty"`
-		Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-	} `json:"network,omitempty"`
-	Networkdomain   string `json:"networkdomain,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Regionlevelvpc  bool   `json:"regionlevelvpc,omitempty"`
-	Restartrequired bool   `json:"restartrequired,omitempty"`
-	Service         []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State string `json:"state,omitempty"`
-	Tags  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vpcofferingid string `json:"vpcofferingid,omitempty"`
-	Zoneid        string `json:"zoneid,omitempty"`
-	Zonename      string `json:"zonename,omitempty"`
+func (p *ListVPCsParams) ResetTags() {
+	p.Tags = nil
 }
 
-type RestartVPCParams struct {
-	p map[string]interface{}
+func (p *ListVPCsParams) SetVpcofferingid(v string) {
+	p.Vpcofferingid = &v
+	return
 }
 
-func (p *RestartVPCParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+func (p *ListVPCsParams) GetVpcofferingid() string {
+	if p.Vpcofferingid == nil {
+		return ""
 	}
-	return u
+	return *p.Vpcofferingid
 }
 
-func (p *RestartVPCParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
+func (p *ListVPCsParams) HasVpcofferingid() bool {
+	return p.Vpcofferingid != nil
+}
+
+func (p *ListVPCsParams) ResetVpcofferingid() {
+	p.Vpcofferingid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListVPCsParams) SetZoneid(v string) {
+	p.Zoneid = &v
 	return
 }
 
-// You should always use this function to get a new RestartVPCParams instance,
+func (p *ListVPCsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListVPCsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListVPCsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+// You should always use this function to get a new ListVPCsParams instance,
 // as then you are sure you have configured all required params
-func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
-	p := &RestartVPCParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
+	p := &ListVPCsParams{}
 	return p
 }
 
-// Restarts a VPC
-func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
-	resp, err := s.cs.newRequest("restartVPC", p.toURLValues())
-	if err != nil {
-		return nil, err
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListVPCsParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
 	}
 
-	var r RestartVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	l, err := s.ListVPCs(p)
+	if err != nil {
+		return "", err
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVPCs(p)
 		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+			return "", err
 		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	if l.Count == 1 {
+		return l.VPCs[0].Id, nil
+	}
+
+	var matches []*VPC
+	for _, v := range l.VPCs {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
 	}
-	return &r, nil
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "VPC", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:      v.Id,
+				Name:    v.Name,
+				Zone:    v.Zonename,
+				Project: v.Project,
+				Domain:  v.Domain,
+			})
+		}
+		return "", e
+	}
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-type RestartVPCResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Account              string `json:"account,omitempty"`
-	Cidr                 string `json:"cidr,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Domain               string `json:"domain,omitempty"`
-	Domainid             string `json:"domainid,omitempty"`
-	Fordisplay           bool   `json:"fordisplay,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Network              []struct {
-		Account                     string `json:"account,omitempty"`
-		Aclid                       string `json:"aclid,omitempty"`
-		Acltype                     string `json:"acltype,omitempty"`
-		Broadcastdomaintype         string `json:"broadcastdomaintype,omitempty"`
-		Broadcasturi                string `json:"broadcasturi,omitempty"`
-		Canusefordeploy             bool   `json:"canusefordeploy,omitempty"`
-		Cidr                        string `json:"cidr,omitempty"`
-		Displaynetwork              bool   `json:"displaynetwork,omitempty"`
-		Displaytext                 string `json:"displaytext,omitempty"`
-		Dns1                        string `json:"dns1,omitempty"`
-		Dns2                        string `json:"dns2,omitempty"`
-		Domain                      string `json:"domain,omitempty"`
-		Domainid                    string `json:"domainid,omitempty"`
-		Gateway                     string `json:"gateway,omitempty"`
-		Id                          string `json:"id,omitempty"`
-		Ip6cidr                     string `json:"ip6cidr,omitempty"`
-		Ip6gateway                  string `json:"ip6gateway,omitempty"`
-		Isdefault                   bool   `json:"isdefault,omitempty"`
-		Ispersistent                bool   `json:"ispersistent,omitempty"`
-		Issystem                    bool   `json:"issystem,omitempty"`
-		Name                        string `json:"name,omitempty"`
-		Netmask                     string `json:"netmask,omitempty"`
-		Networkcidr                 string `json:"networkcidr,omitempty"`
-		Networkdomain               string `json:"networkdomain,omitempty"`
-		Networkofferingavailability string `json:"networkofferingavailability,omitempty"`
-		Networkofferingconservemode bool   `json:"networkofferingconservemode,omitempty"`
-		Networkofferingdisplaytext  string `json:"networkofferingdisplaytext,omitempty"`
-		Networkofferingid           string `json:"networkofferingid,omitempty"`
-		Networkofferingname         string `json:"networkofferingname,omitempty"`
-		Physicalnetworkid           string `json:"physicalnetworkid,omitempty"`
-		Project                     string `json:"project,omitempty"`
-		Projectid                   string `json:"projectid,omitempty"`
-		Related                     string `json:"related,omitempty"`
-		Reservediprange             string `json:"reservediprange,omitempty"`
-		Restartrequired             bool   `json:"restartrequired,omitempty"`
-		Service                     []struct {
-			Capability []struct {
-				Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-				Name                       string `json:"name,omitempty"`
-				Value                      string `json:"value,omitempty"`
-			} `json:"capability,omitempty"`
-			Name     string `json:"name,omitempty"`
-			Provider []struct {
-				Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-				Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-				Id                           string   `json:"id,omitempty"`
-				Name                         string   `json:"name,omitempty"`
-				Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-				Servicelist                  []string `json:"servicelist,omitempty"`
-				State                        string   `json:"state,omitempty"`
-			} `json:"provider,omitempty"`
-		} `json:"service,omitempty"`
-		Specifyipranges  bool   `json:"specifyipranges,omitempty"`
-		State            string `json:"state,omitempty"`
-		Strechedl2subnet bool   `json:"strechedl2subnet,omitempty"`
-		Subdomainaccess  bool   `json:"subdomainaccess,omitempty"`
-		Tags             []struct {
-			Account      string `json:"account,omitempty"`
-			Customer     string `json:"customer,omitempty"`
-			Domain       string `json:"domain,omitempty"`
-			Domainid     string `json:"domainid,omitempty"`
-			Key          string `json:"key,omitempty"`
-			Project      string `json:"project,omitempty"`
-			Projectid    string `json:"projectid,omitempty"`
-			Resourceid   string `json:"resourceid,omitempty"`
-			Resourcetype string `json:"resourcetype,omitempty"`
-			Value        string `json:"value,omitempty"`
-		} `json:"tags,omitempty"`
-		Traffictype       string   `json:"traffictype,omitempty"`
-		Type              string   `json:"type,omitempty"`
-		Vlan              string   `json:"vlan,omitempty"`
-		Vpcid             string   `json:"vpcid,omitempty"`
-		Zoneid            string   `json:"zoneid,omitempty"`
-		Zonename          string   `json:"zonename,omitempty"`
-		Zonesnetworkspans []string `json:"zonesnetworkspans,omitempty"`
-	} `json:"network,omitempty"`
-	Networkdomain   string `json:"networkdomain,omitempty"`
-	Project         string `json:"project,omitempty"`
-	Projectid       string `json:"projectid,omitempty"`
-	Regionlevelvpc  bool   `json:"regionlevelvpc,omitempty"`
-	Restartrequired bool   `json:"restartrequired,omitempty"`
-	Service         []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State string `json:"state,omitempty"`
-	Tags  []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vpcofferingid string `json:"vpcofferingid,omitempty"`
-	Zoneid        string `json:"zoneid,omitempty"`
-	Zonename      string `json:"zonename,omitempty"`
-}
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error) {
+	id, err := s.GetVPCID(name, opts...)
+	if err != nil {
+		return nil, -1, err
+	}
 
-type CreateVPCOfferingParams struct {
-	p map[string]interface{}
+	r, count, err := s.GetVPCByID(id, opts...)
+	if err != nil {
+		return nil, count, err
+	}
+	return r, count, nil
 }
 
-func (p *CreateVPCOfferingParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["servicecapabilitylist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].key", i), k)
-			u.Set(fmt.Sprintf("servicecapabilitylist[%d].value", i), vv)
-			i++
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error) {
+	p := &ListVPCsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
 		}
 	}
-	if v, found := p.p["serviceofferingid"]; found {
-		u.Set("serviceofferingid", v.(string))
-	}
-	if v, found := p.p["serviceproviderlist"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].key", i), k)
-			u.Set(fmt.Sprintf("serviceproviderlist[%d].value", i), vv)
-			i++
+
+	l, err := s.ListVPCs(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
+		return nil, -1, err
 	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("supportedservices", vv)
-	}
-	return u
-}
 
-func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVPCs(p)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(
+				"Invalid parameter id value=%s due to incorrect long value format, "+
+					"or entity does not exist", id)) {
+				return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+			}
+			return nil, -1, err
+		}
 	}
-	p.p["displaytext"] = v
-	return
-}
 
-func (p *CreateVPCOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
 	}
-	p.p["name"] = v
-	return
-}
 
-func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 1 {
+		return l.VPCs[0], l.Count, nil
 	}
-	p.p["servicecapabilitylist"] = v
-	return
+	return nil, l.Count, fmt.Errorf("There is more then one result for VPC UUID: %s!", id)
 }
 
-func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which returns all objects that have all of the given tags. The
+// search can be limited using options like WithZone or WithProject. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *VPCService) GetVPCsByTags(tags map[string]string, opts ...OptionFunc) ([]*VPC, error) {
+	p := &ListVPCsParams{}
+
+	p.SetTags(tags)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, err
+		}
 	}
-	p.p["serviceofferingid"] = v
-	return
-}
 
-func (p *CreateVPCOfferingParams) SetServiceproviderlist(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	l, err := s.ListVPCs(p)
+	if err != nil {
+		return nil, err
 	}
-	p.p["serviceproviderlist"] = v
-	return
-}
 
-func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+	if l.Count == 0 && p.Projectid == nil {
+		// look	inside projects
+		p.SetProjectid("-1")
+		l, err = s.ListVPCs(p)
+		if err != nil {
+			return nil, err
+		}
 	}
-	p.p["supportedservices"] = v
-	return
-}
 
-// You should always use this function to get a new CreateVPCOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
-	p := &CreateVPCOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["displaytext"] = displaytext
-	p.p["name"] = name
-	p.p["supportedservices"] = supportedservices
-	return p
+	return l.VPCs, nil
 }
 
-// Creates VPC offering
-func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("createVPCOffering", p.toURLValues())
+// Lists VPCs
+//
+// See also GetVPCID, GetVPCByName, GetVPCByID, GetVPCsByTags.
+func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVPCs", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreateVPCOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListVPCsResponse
+	if err := s.cs.unmarshal("listVPCs", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+// Lists the objects like ListVPCs, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPCService) StreamVPCs(ctx context.Context, p *ListVPCsParams, fn func(*VPC) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
+	return s.cs.streamList(ctx, "listVPCs", p.URLValues(), "vpc", func(b json.RawMessage) error {
+		var v VPC
+		if err := s.cs.unmarshalElement("listVPCs", "vpc", b, &v); err != nil {
+			return err
 		}
+		return fn(&v)
+	})
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+type ListVPCsResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	VPCs  []*VPC                     `json:"vpc" xml:"vpc"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type CreateVPCOfferingResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Isdefault            bool   `json:"isdefault,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Service              []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State                  string `json:"state,omitempty"`
-	SupportsregionLevelvpc bool   `json:"supportsregionLevelvpc,omitempty"`
+type VPC struct {
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr                 string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext          string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Network              []Network                  `json:"network,omitempty" xml:"network,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionlevelvpc       Bool                       `json:"regionlevelvpc,omitempty" xml:"regionlevelvpc,omitempty"`
+	Restartrequired      Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service              []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vpcofferingid        string                     `json:"vpcofferingid,omitempty" xml:"vpcofferingid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type UpdateVPCOfferingParams struct {
-	p map[string]interface{}
+type RestartVPCParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
 }
 
-func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RestartVPCParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
 	return u
 }
 
-func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+// Returns the name of the API command of these params
+func (p *RestartVPCParams) Command() string {
+	return "restartVPC"
 }
 
-func (p *UpdateVPCOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *RestartVPCParams) IsAsync() bool {
+	return true
 }
 
-func (p *UpdateVPCOfferingParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RestartVPCParams) ResponseType() interface{} {
+	return &RestartVPCResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RestartVPCParams) Validate() error {
+	v := &validator{api: "restartVPC"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RestartVPCParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *UpdateVPCOfferingParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *RestartVPCParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["state"] = v
-	return
+	return *p.Id
 }
 
-// You should always use this function to get a new UpdateVPCOfferingParams instance,
+func (p *RestartVPCParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RestartVPCParams) ResetId() {
+	p.Id = nil
+}
+
+// You should always use this function to get a new RestartVPCParams instance,
 // as then you are sure you have configured all required params
-func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams {
-	p := &UpdateVPCOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
+	p := &RestartVPCParams{}
+	p.SetId(id)
 	return p
 }
 
-// Updates VPC offering
-func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("updateVPCOffering", p.toURLValues())
+// Restarts a VPC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCs, GetVPCID, GetVPCByName, GetVPCByID, GetVPCsByTags.
+func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("restartVPC", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r UpdateVPCOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r RestartVPCResponse
+	if err := s.cs.unmarshal("restartVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1563,486 +1806,506 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("restartVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type UpdateVPCOfferingResponse struct {
-	JobID                string `json:"jobid,omitempty"`
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Isdefault            bool   `json:"isdefault,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Service              []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State                  string `json:"state,omitempty"`
-	SupportsregionLevelvpc bool   `json:"supportsregionLevelvpc,omitempty"`
-}
-
-type DeleteVPCOfferingParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
+type RestartVPCResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr                 string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext          string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Network              []Network                  `json:"network,omitempty" xml:"network,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionlevelvpc       Bool                       `json:"regionlevelvpc,omitempty" xml:"regionlevelvpc,omitempty"`
+	Restartrequired      Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service              []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vpcofferingid        string                     `json:"vpcofferingid,omitempty" xml:"vpcofferingid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateVPCParams struct {
+	Customid    *string `json:"customid,omitempty" yaml:"customid,omitempty"`
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Fordisplay  *bool   `json:"fordisplay,omitempty" yaml:"fordisplay,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVPCParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
+	if v := p.Customid; v != nil {
+		u.Set("customid", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	if v := p.Fordisplay; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fordisplay", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
 	return u
 }
 
-func (p *DeleteVPCOfferingParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateVPCParams) Command() string {
+	return "updateVPC"
 }
 
-// You should always use this function to get a new DeleteVPCOfferingParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams {
-	p := &DeleteVPCOfferingParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+// Returns true if the API command starts an async job
+func (p *UpdateVPCParams) IsAsync() bool {
+	return true
 }
 
-// Deletes VPC offering
-func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
-	resp, err := s.cs.newRequest("deleteVPCOffering", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeleteVPCOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
-	}
-	return &r, nil
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVPCParams) ResponseType() interface{} {
+	return &UpdateVPCResponse{}
 }
 
-type DeleteVPCOfferingResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVPCParams) Validate() error {
+	v := &validator{api: "updateVPC"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-type ListVPCOfferingsParams struct {
-	p map[string]interface{}
+// Sets the 'customid' param: an optional field, in case you want to set a custom ID to the resource; allowed to root admins only.
+func (p *UpdateVPCParams) SetCustomid(v string) {
+	p.Customid = &v
+	return
 }
 
-func (p *ListVPCOfferingsParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["displaytext"]; found {
-		u.Set("displaytext", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isdefault"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isdefault", vv)
+func (p *UpdateVPCParams) GetCustomid() string {
+	if p.Customid == nil {
+		return ""
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["name"]; found {
-		u.Set("name", v.(string))
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["supportedservices"]; found {
-		vv := strings.Join(v.([]string), ", ")
-		u.Set("supportedservices", vv)
-	}
-	return u
+	return *p.Customid
 }
 
-func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["displaytext"] = v
-	return
+func (p *UpdateVPCParams) HasCustomid() bool {
+	return p.Customid != nil
 }
 
-func (p *ListVPCOfferingsParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *UpdateVPCParams) ResetCustomid() {
+	p.Customid = nil
 }
 
-func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isdefault"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateVPCParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *ListVPCOfferingsParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVPCParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *ListVPCOfferingsParams) SetName(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["name"] = v
-	return
+func (p *UpdateVPCParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
 }
 
-func (p *ListVPCOfferingsParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *UpdateVPCParams) ResetDisplaytext() {
+	p.Displaytext = nil
 }
 
-func (p *ListVPCOfferingsParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// Sets the 'fordisplay' param: whether the resource is displayed to the end user; only root admins can change this.
+func (p *UpdateVPCParams) SetFordisplay(v bool) {
+	p.Fordisplay = &v
 	return
 }
 
-func (p *ListVPCOfferingsParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVPCParams) GetFordisplay() bool {
+	if p.Fordisplay == nil {
+		return false
 	}
-	p.p["state"] = v
-	return
+	return *p.Fordisplay
 }
 
-func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["supportedservices"] = v
-	return
+func (p *UpdateVPCParams) HasFordisplay() bool {
+	return p.Fordisplay != nil
 }
 
-// You should always use this function to get a new ListVPCOfferingsParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
-	p := &ListVPCOfferingsParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *UpdateVPCParams) ResetFordisplay() {
+	p.Fordisplay = nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetVPCOfferingID(name string) (string, error) {
-	p := &ListVPCOfferingsParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["name"] = name
+// This param is required.
+func (p *UpdateVPCParams) SetId(v string) {
+	p.Id = &v
+	return
+}
 
-	l, err := s.ListVPCOfferings(p)
-	if err != nil {
-		return "", err
+func (p *UpdateVPCParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
+	return *p.Id
+}
 
-	if l.Count == 0 {
-		return "", fmt.Errorf("No match found for %s: %+v", name, l)
-	}
+func (p *UpdateVPCParams) HasId() bool {
+	return p.Id != nil
+}
 
-	if l.Count == 1 {
-		return l.VPCOfferings[0].Id, nil
-	}
+func (p *UpdateVPCParams) ResetId() {
+	p.Id = nil
+}
 
-	if l.Count > 1 {
-		for _, v := range l.VPCOfferings {
-			if v.Name == name {
-				return v.Id, nil
-			}
-		}
-	}
-	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
+func (p *UpdateVPCParams) SetName(v string) {
+	p.Name = &v
+	return
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetVPCOfferingByName(name string) (*VPCOffering, int, error) {
-	id, err := s.GetVPCOfferingID(name)
-	if err != nil {
-		return nil, -1, err
+func (p *UpdateVPCParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
+	return *p.Name
+}
 
-	r, count, err := s.GetVPCOfferingByID(id)
-	if err != nil {
-		return nil, count, err
-	}
-	return r, count, nil
+func (p *UpdateVPCParams) HasName() bool {
+	return p.Name != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetVPCOfferingByID(id string) (*VPCOffering, int, error) {
-	p := &ListVPCOfferingsParams{}
-	p.p = make(map[string]interface{})
+func (p *UpdateVPCParams) ResetName() {
+	p.Name = nil
+}
 
-	p.p["id"] = id
+// You should always use this function to get a new UpdateVPCParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
+	p := &UpdateVPCParams{}
+	p.SetId(id)
+	return p
+}
 
-	l, err := s.ListVPCOfferings(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+// Updates a VPC
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCs, GetVPCID, GetVPCByName, GetVPCByID, GetVPCsByTags.
+func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
 		}
-		return nil, -1, err
 	}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	resp, err := s.cs.newRequest("updateVPC", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
 
-	if l.Count == 1 {
-		return l.VPCOfferings[0], l.Count, nil
+	var r UpdateVPCResponse
+	if err := s.cs.unmarshal("updateVPC", resp, &r); err != nil {
+		return nil, err
 	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for VPCOffering UUID: %s!", id)
-}
 
-// Lists VPC offerings
-func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCOfferings", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
 
-	var r ListVPCOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+		if err := s.cs.unmarshal("updateVPC", b, &r); err != nil {
+			return nil, err
+		}
 	}
 	return &r, nil
 }
 
-type ListVPCOfferingsResponse struct {
-	Count        int            `json:"count"`
-	VPCOfferings []*VPCOffering `json:"vpcoffering"`
+type UpdateVPCResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account              string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr                 string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext          string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Domain               string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Fordisplay           Bool                       `json:"fordisplay,omitempty" xml:"fordisplay,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Network              []Network                  `json:"network,omitempty" xml:"network,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Project              string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid            string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Regionlevelvpc       Bool                       `json:"regionlevelvpc,omitempty" xml:"regionlevelvpc,omitempty"`
+	Restartrequired      Bool                       `json:"restartrequired,omitempty" xml:"restartrequired,omitempty"`
+	Service              []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vpcofferingid        string                     `json:"vpcofferingid,omitempty" xml:"vpcofferingid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type VPCOffering struct {
-	Created              string `json:"created,omitempty"`
-	Displaytext          string `json:"displaytext,omitempty"`
-	Distributedvpcrouter bool   `json:"distributedvpcrouter,omitempty"`
-	Id                   string `json:"id,omitempty"`
-	Isdefault            bool   `json:"isdefault,omitempty"`
-	Name                 string `json:"name,omitempty"`
-	Service              []struct {
-		Capability []struct {
-			Canchooseservicecapability bool   `json:"canchooseservicecapability,omitempty"`
-			Name                       string `json:"name,omitempty"`
-			Value                      string `json:"value,omitempty"`
-		} `json:"capability,omitempty"`
-		Name     string `json:"name,omitempty"`
-		Provider []struct {
-			Canenableindividualservice   bool     `json:"canenableindividualservice,omitempty"`
-			Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid,omitempty"`
-			Id                           string   `json:"id,omitempty"`
-			Name                         string   `json:"name,omitempty"`
-			Physicalnetworkid            string   `json:"physicalnetworkid,omitempty"`
-			Servicelist                  []string `json:"servicelist,omitempty"`
-			State                        string   `json:"state,omitempty"`
-		} `json:"provider,omitempty"`
-	} `json:"service,omitempty"`
-	State                  string `json:"state,omitempty"`
-	SupportsregionLevelvpc bool   `json:"supportsregionLevelvpc,omitempty"`
-}
-
-type CreatePrivateGatewayParams struct {
-	p map[string]interface{}
-}
-
-func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
+type CreateVPCOfferingParams struct {
+	Displaytext           *string             `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Name                  *string             `json:"name,omitempty" yaml:"name,omitempty"`
+	Servicecapabilitylist []ServiceCapability `json:"servicecapabilitylist,omitempty" yaml:"servicecapabilitylist,omitempty"`
+	Serviceofferingid     *string             `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+	Serviceproviderlist   []ServiceProvider   `json:"serviceproviderlist,omitempty" yaml:"serviceproviderlist,omitempty"`
+	Supportedservices     []string            `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVPCOfferingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["aclid"]; found {
-		u.Set("aclid", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["gateway"]; found {
-		u.Set("gateway", v.(string))
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
-	}
-	if v, found := p.p["netmask"]; found {
-		u.Set("netmask", v.(string))
-	}
-	if v, found := p.p["networkofferingid"]; found {
-		u.Set("networkofferingid", v.(string))
-	}
-	if v, found := p.p["physicalnetworkid"]; found {
-		u.Set("physicalnetworkid", v.(string))
+	if v := p.Servicecapabilitylist; v != nil {
+		for i, vv := range v {
+			if vv.Service != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].service", i), vv.Service)
+			}
+			if vv.Capabilitytype != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilitytype", i), vv.Capabilitytype)
+			}
+			if vv.Capabilityvalue != "" {
+				u.Set(fmt.Sprintf("servicecapabilitylist[%d].capabilityvalue", i), vv.Capabilityvalue)
+			}
+		}
 	}
-	if v, found := p.p["sourcenatsupported"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("sourcenatsupported", vv)
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
 	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
+	if v := p.Serviceproviderlist; v != nil {
+		for i, vv := range v {
+			if vv.Service != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].service", i), vv.Service)
+			}
+			if vv.Provider != "" {
+				u.Set(fmt.Sprintf("serviceproviderlist[%d].provider", i), vv.Provider)
+			}
+		}
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Supportedservices; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("supportedservices", vv)
 	}
 	return u
 }
 
-func (p *CreatePrivateGatewayParams) SetAclid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["aclid"] = v
-	return
+// Returns the name of the API command of these params
+func (p *CreateVPCOfferingParams) Command() string {
+	return "createVPCOffering"
 }
 
-func (p *CreatePrivateGatewayParams) SetGateway(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gateway"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *CreateVPCOfferingParams) IsAsync() bool {
+	return true
 }
 
-func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVPCOfferingParams) ResponseType() interface{} {
+	return &CreateVPCOfferingResponse{}
 }
 
-func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["netmask"] = v
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVPCOfferingParams) Validate() error {
+	v := &validator{api: "createVPCOffering"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	v.required("supportedservices", p.Supportedservices != nil)
+	return v.err()
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["networkofferingid"] = v
+	return *p.Displaytext
+}
+
+func (p *CreateVPCOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateVPCOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// This param is required.
+func (p *CreateVPCOfferingParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["physicalnetworkid"] = v
+	return *p.Name
+}
+
+func (p *CreateVPCOfferingParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateVPCOfferingParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreateVPCOfferingParams) SetServicecapabilitylist(v []ServiceCapability) {
+	p.Servicecapabilitylist = v
 	return
 }
 
-func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["sourcenatsupported"] = v
+func (p *CreateVPCOfferingParams) GetServicecapabilitylist() []ServiceCapability {
+	return p.Servicecapabilitylist
+}
+
+func (p *CreateVPCOfferingParams) HasServicecapabilitylist() bool {
+	return p.Servicecapabilitylist != nil
+}
+
+func (p *CreateVPCOfferingParams) ResetServicecapabilitylist() {
+	p.Servicecapabilitylist = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering.
+func (p *CreateVPCOfferingParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
 	return
 }
 
-func (p *CreatePrivateGatewayParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *CreateVPCOfferingParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
 	}
-	p.p["vlan"] = v
+	return *p.Serviceofferingid
+}
+
+func (p *CreateVPCOfferingParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *CreateVPCOfferingParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+func (p *CreateVPCOfferingParams) SetServiceproviderlist(v []ServiceProvider) {
+	p.Serviceproviderlist = v
 	return
 }
 
-func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+func (p *CreateVPCOfferingParams) GetServiceproviderlist() []ServiceProvider {
+	return p.Serviceproviderlist
+}
+
+func (p *CreateVPCOfferingParams) HasServiceproviderlist() bool {
+	return p.Serviceproviderlist != nil
+}
+
+func (p *CreateVPCOfferingParams) ResetServiceproviderlist() {
+	p.Serviceproviderlist = nil
+}
+
+// This param is required.
+func (p *CreateVPCOfferingParams) SetSupportedservices(v []string) {
+	p.Supportedservices = v
 	return
 }
 
-// You should always use this function to get a new CreatePrivateGatewayParams instance,
+func (p *CreateVPCOfferingParams) GetSupportedservices() []string {
+	return p.Supportedservices
+}
+
+func (p *CreateVPCOfferingParams) HasSupportedservices() bool {
+	return p.Supportedservices != nil
+}
+
+func (p *CreateVPCOfferingParams) ResetSupportedservices() {
+	p.Supportedservices = nil
+}
+
+// You should always use this function to get a new CreateVPCOfferingParams instance,
 // as then you are sure you have configured all required params
-func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams {
-	p := &CreatePrivateGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["gateway"] = gateway
-	p.p["ipaddress"] = ipaddress
-	p.p["netmask"] = netmask
-	p.p["vlan"] = vlan
-	p.p["vpcid"] = vpcid
+//
+// The required params are:
+//
+//   - displaytext: the display text
+//   - name
+//   - supportedservices
+func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string, supportedservices []string) *CreateVPCOfferingParams {
+	p := &CreateVPCOfferingParams{}
+	p.SetDisplaytext(displaytext)
+	p.SetName(name)
+	p.SetSupportedservices(supportedservices)
 	return p
 }
 
-// Creates a private gateway
-func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("createPrivateGateway", p.toURLValues())
+// Creates VPC offering
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCOfferings, GetVPCOfferingID, GetVPCOfferingByName, GetVPCOfferingByID.
+func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("createVPCOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r CreatePrivateGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r CreateVPCOfferingResponse
+	if err := s.cs.unmarshal("createVPCOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2063,739 +2326,694 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.unmarshal("createVPCOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
 	return &r, nil
 }
 
-type CreatePrivateGatewayResponse struct {
-	JobID              string `json:"jobid,omitempty"`
-	Account            string `json:"account,omitempty"`
-	Aclid              string `json:"aclid,omitempty"`
-	Domain             string `json:"domain,omitempty"`
-	Domainid           string `json:"domainid,omitempty"`
-	Gateway            string `json:"gateway,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Ipaddress          string `json:"ipaddress,omitempty"`
-	Netmask            string `json:"netmask,omitempty"`
-	Physicalnetworkid  string `json:"physicalnetworkid,omitempty"`
-	Project            string `json:"project,omitempty"`
-	Projectid          string `json:"projectid,omitempty"`
-	Sourcenatsupported bool   `json:"sourcenatsupported,omitempty"`
-	State              string `json:"state,omitempty"`
-	Vlan               string `json:"vlan,omitempty"`
-	Vpcid              string `json:"vpcid,omitempty"`
-	Zoneid             string `json:"zoneid,omitempty"`
-	Zonename           string `json:"zonename,omitempty"`
-}
-
-type ListPrivateGatewaysParams struct {
-	p map[string]interface{}
-}
-
-func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+type CreateVPCOfferingResponse struct {
+	JobID                  string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Created                Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext            string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter   Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Id                     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdefault              Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Name                   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Service                []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                  string                     `json:"state,omitempty" xml:"state,omitempty"`
+	SupportsregionLevelvpc Bool                       `json:"supportsregionLevelvpc,omitempty" xml:"supportsregionLevelvpc,omitempty"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVPCOfferingParams = cloudstackcommon.DeleteVPCOfferingParams
+type DeleteVPCOfferingResponse = cloudstackcommon.DeleteVPCOfferingResponse
+
+// You should always use this function to get a new DeleteVPCOfferingParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams {
+	p := &DeleteVPCOfferingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Deletes VPC offering
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCOfferings, GetVPCOfferingID, GetVPCOfferingByName, GetVPCOfferingByID.
+func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("deleteVPCOffering", p.URLValues())
+	if err != nil {
+		return nil, err
 	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+
+	var r DeleteVPCOfferingResponse
+	if err := s.cs.unmarshal("deleteVPCOffering", resp, &r); err != nil {
+		return nil, err
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		if err := s.cs.unmarshal("deleteVPCOffering", b, &r); err != nil {
+			return nil, err
+		}
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+	return &r, nil
+}
+
+type ListVPCOfferingsParams struct {
+	Displaytext       *string  `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id                *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Isdefault         *bool    `json:"isdefault,omitempty" yaml:"isdefault,omitempty"`
+	Keyword           *string  `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name              *string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int     `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int     `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	State             *string  `json:"state,omitempty" yaml:"state,omitempty"`
+	Supportedservices []string `json:"supportedservices,omitempty" yaml:"supportedservices,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVPCOfferingsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["ipaddress"]; found {
-		u.Set("ipaddress", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
+	if v := p.Isdefault; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isdefault", vv)
 	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
 	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("page", vv)
 	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
 		u.Set("pagesize", vv)
 	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
-	if v, found := p.p["state"]; found {
-		u.Set("state", v.(string))
-	}
-	if v, found := p.p["vlan"]; found {
-		u.Set("vlan", v.(string))
-	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.Supportedservices; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("supportedservices", vv)
 	}
 	return u
 }
 
-func (p *ListPrivateGatewaysParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *ListVPCOfferingsParams) Command() string {
+	return "listVPCOfferings"
 }
 
-func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *ListVPCOfferingsParams) IsAsync() bool {
+	return false
 }
 
-func (p *ListPrivateGatewaysParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVPCOfferingsParams) ResponseType() interface{} {
+	return &ListVPCOfferingsResponse{}
 }
 
-func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["ipaddress"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVPCOfferingsParams) Validate() error {
+	v := &validator{api: "listVPCOfferings"}
+	return v.err()
 }
 
-func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCOfferingsParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *ListPrivateGatewaysParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *ListVPCOfferingsParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
 }
 
-func (p *ListPrivateGatewaysParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *ListVPCOfferingsParams) ResetDisplaytext() {
+	p.Displaytext = nil
 }
 
-func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+func (p *ListVPCOfferingsParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCOfferingsParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Id
 }
 
-func (p *ListPrivateGatewaysParams) SetState(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["state"] = v
-	return
+func (p *ListVPCOfferingsParams) HasId() bool {
+	return p.Id != nil
 }
 
-func (p *ListPrivateGatewaysParams) SetVlan(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vlan"] = v
-	return
+func (p *ListVPCOfferingsParams) ResetId() {
+	p.Id = nil
 }
 
-func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["vpcid"] = v
+func (p *ListVPCOfferingsParams) SetIsdefault(v bool) {
+	p.Isdefault = &v
 	return
 }
 
-// You should always use this function to get a new ListPrivateGatewaysParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
-	p := &ListPrivateGatewaysParams{}
-	p.p = make(map[string]interface{})
-	return p
-}
-
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetPrivateGatewayByID(id string) (*PrivateGateway, int, error) {
-	p := &ListPrivateGatewaysParams{}
-	p.p = make(map[string]interface{})
-
-	p.p["id"] = id
-
-	l, err := s.ListPrivateGateways(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *ListVPCOfferingsParams) GetIsdefault() bool {
+	if p.Isdefault == nil {
+		return false
 	}
+	return *p.Isdefault
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *ListVPCOfferingsParams) HasIsdefault() bool {
+	return p.Isdefault != nil
+}
 
-	if l.Count == 1 {
-		return l.PrivateGateways[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for PrivateGateway UUID: %s!", id)
+func (p *ListVPCOfferingsParams) ResetIsdefault() {
+	p.Isdefault = nil
 }
 
-// List private gateways
-func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listPrivateGateways", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVPCOfferingsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
 
-	var r ListPrivateGatewaysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+func (p *ListVPCOfferingsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
 	}
-	return &r, nil
+	return *p.Keyword
 }
 
-type ListPrivateGatewaysResponse struct {
-	Count           int               `json:"count"`
-	PrivateGateways []*PrivateGateway `json:"privategateway"`
+func (p *ListVPCOfferingsParams) HasKeyword() bool {
+	return p.Keyword != nil
 }
 
-type PrivateGateway struct {
-	Account            string `json:"account,omitempty"`
-	Aclid              string `json:"aclid,omitempty"`
-	Domain             string `json:"domain,omitempty"`
-	Domainid           string `json:"domainid,omitempty"`
-	Gateway            string `json:"gateway,omitempty"`
-	Id                 string `json:"id,omitempty"`
-	Ipaddress          string `json:"ipaddress,omitempty"`
-	Netmask            string `json:"netmask,omitempty"`
-	Physicalnetworkid  string `json:"physicalnetworkid,omitempty"`
-	Project            string `json:"project,omitempty"`
-	Projectid          string `json:"projectid,omitempty"`
-	Sourcenatsupported bool   `json:"sourcenatsupported,omitempty"`
-	State              string `json:"state,omitempty"`
-	Vlan               string `json:"vlan,omitempty"`
-	Vpcid              string `json:"vpcid,omitempty"`
-	Zoneid             string `json:"zoneid,omitempty"`
-	Zonename           string `json:"zonename,omitempty"`
+func (p *ListVPCOfferingsParams) ResetKeyword() {
+	p.Keyword = nil
 }
 
-type DeletePrivateGatewayParams struct {
-	p map[string]interface{}
+func (p *ListVPCOfferingsParams) SetName(v string) {
+	p.Name = &v
+	return
 }
 
-func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+func (p *ListVPCOfferingsParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	return u
+	return *p.Name
 }
 
-func (p *DeletePrivateGatewayParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+func (p *ListVPCOfferingsParams) HasName() bool {
+	return p.Name != nil
 }
 
-// You should always use this function to get a new DeletePrivateGatewayParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams {
-	p := &DeletePrivateGatewayParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+func (p *ListVPCOfferingsParams) ResetName() {
+	p.Name = nil
 }
 
-// Deletes a Private gateway
-func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
-	resp, err := s.cs.newRequest("deletePrivateGateway", p.toURLValues())
-	if err != nil {
-		return nil, err
-	}
-
-	var r DeletePrivateGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
-
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVPCOfferingsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+func (p *ListVPCOfferingsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
 	}
-	return &r, nil
+	return *p.Page
 }
 
-type DeletePrivateGatewayResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+func (p *ListVPCOfferingsParams) HasPage() bool {
+	return p.Page != nil
 }
 
-type CreateStaticRouteParams struct {
-	p map[string]interface{}
+func (p *ListVPCOfferingsParams) ResetPage() {
+	p.Page = nil
 }
 
-func (p *CreateStaticRouteParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["cidr"]; found {
-		u.Set("cidr", v.(string))
-	}
-	if v, found := p.p["gatewayid"]; found {
-		u.Set("gatewayid", v.(string))
-	}
-	return u
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVPCOfferingsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
 }
 
-func (p *CreateStaticRouteParams) SetCidr(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCOfferingsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
 	}
-	p.p["cidr"] = v
+	return *p.Pagesize
+}
+
+func (p *ListVPCOfferingsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVPCOfferingsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListVPCOfferingsParams) SetState(v string) {
+	p.State = &v
 	return
 }
 
-func (p *CreateStaticRouteParams) SetGatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *ListVPCOfferingsParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
-	p.p["gatewayid"] = v
+	return *p.State
+}
+
+func (p *ListVPCOfferingsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListVPCOfferingsParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListVPCOfferingsParams) SetSupportedservices(v []string) {
+	p.Supportedservices = v
 	return
 }
 
-// You should always use this function to get a new CreateStaticRouteParams instance,
+func (p *ListVPCOfferingsParams) GetSupportedservices() []string {
+	return p.Supportedservices
+}
+
+func (p *ListVPCOfferingsParams) HasSupportedservices() bool {
+	return p.Supportedservices != nil
+}
+
+func (p *ListVPCOfferingsParams) ResetSupportedservices() {
+	p.Supportedservices = nil
+}
+
+// You should always use this function to get a new ListVPCOfferingsParams instance,
 // as then you are sure you have configured all required params
-func (s *VPCService) NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams {
-	p := &CreateStaticRouteParams{}
-	p.p = make(map[string]interface{})
-	p.p["cidr"] = cidr
-	p.p["gatewayid"] = gatewayid
+func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
+	p := &ListVPCOfferingsParams{}
 	return p
 }
 
-// Creates a static route
-func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("createStaticRoute", p.toURLValues())
+// This is a courtesy helper function, which in some cases may not work as expected! The search can be
+// limited using options like WithZone or WithProject. If nothing is found and no project is given, the
+// objects of all projects are searched as well. If multiple objects have the given name, an *AmbiguousError
+// listing all of them is returned.
+func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string, error) {
+	p := &ListVPCOfferingsParams{}
+
+	p.SetName(name)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return "", err
+		}
+	}
+
+	l, err := s.ListVPCOfferings(p)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var r CreateStaticRouteResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
+	if l.Count == 0 {
+		return "", fmt.Errorf("No match found for %s: %+v", name, l)
 	}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
-		}
+	if l.Count == 1 {
+		return l.VPCOfferings[0].Id, nil
+	}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
+	var matches []*VPCOffering
+	for _, v := range l.VPCOfferings {
+		if v.Name == name {
+			matches = append(matches, v)
 		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	}
+	if len(matches) == 1 {
+		return matches[0].Id, nil
+	}
+	if len(matches) > 1 {
+		e := &AmbiguousError{Type: "VPCOffering", Name: name}
+		for _, v := range matches {
+			e.Candidates = append(e.Candidates, &Candidate{
+				ID:   v.Id,
+				Name: v.Name,
+			})
 		}
+		return "", e
 	}
-	return &r, nil
+	return "", fmt.Errorf("Could not find an exact match for %s: %+v", name, l)
 }
 
-type CreateStaticRouteResponse struct {
-	JobID     string `json:"jobid,omitempty"`
-	Account   string `json:"account,omitempty"`
-	Cidr      string `json:"cidr,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Gatewayid string `json:"gatewayid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	State     string `json:"state,omitempty"`
-	Tags      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vpcid string `json:"vpcid,omitempty"`
-}
-
-type DeleteStaticRouteParams struct {
-	p map[string]interface{}
-}
-
-func (p *DeleteStaticRouteParams) toURLValues() url.Values {
-	u := url.Values{}
-	if p.p == nil {
-		return u
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	id, err := s.GetVPCOfferingID(name, opts...)
+	if err != nil {
+		return nil, -1, err
 	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
+
+	r, count, err := s.GetVPCOfferingByID(id, opts...)
+	if err != nil {
+		return nil, count, err
 	}
-	return u
+	return r, count, nil
 }
 
-func (p *DeleteStaticRouteParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+// This is a courtesy helper function, which in some cases may not work as expected!
+func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	p := &ListVPCOfferingsParams{}
+
+	p.SetId(id)
+
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			return nil, -1, err
+		}
 	}
-	p.p["id"] = v
-	return
-}
 
-// You should always use this function to get a new DeleteStaticRouteParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams {
-	p := &DeleteStaticRouteParams{}
-	p.p = make(map[string]interface{})
-	p.p["id"] = id
-	return p
+	l, err := s.ListVPCOfferings(p)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf(
+			"Invalid parameter id value=%s due to incorrect long value format, "+
+				"or entity does not exist", id)) {
+			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
+		}
+		return nil, -1, err
+	}
+
+	if l.Count == 0 {
+		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
+	}
+
+	if l.Count == 1 {
+		return l.VPCOfferings[0], l.Count, nil
+	}
+	return nil, l.Count, fmt.Errorf("There is more then one result for VPCOffering UUID: %s!", id)
 }
 
-// Deletes a static route
-func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
-	resp, err := s.cs.newRequest("deleteStaticRoute", p.toURLValues())
+// Lists VPC offerings
+//
+// See also GetVPCOfferingID, GetVPCOfferingByName, GetVPCOfferingByID.
+func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("listVPCOfferings", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r DeleteStaticRouteResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r ListVPCOfferingsResponse
+	if err := s.cs.unmarshal("listVPCOfferings", resp, &r); err != nil {
 		return nil, err
 	}
+	return &r, nil
+}
 
-	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
-		if err != nil {
-			return nil, err
-		}
-		// If 'warn' has a value it means the job is running longer than the configured
-		// timeout, the resonse will contain the jobid of the running async job
-		if warn != nil {
-			return &r, warn
+// Lists the objects like ListVPCOfferings, but passes them to fn one by one while the response is read,
+// so any number of objects can be listed using a bounded amount of memory. If fn returns an error, the
+// listing stops and the error is returned. Returns the count of the response.
+func (s *VPCService) StreamVPCOfferings(ctx context.Context, p *ListVPCOfferingsParams, fn func(*VPCOffering) error) (int, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return 0, err
 		}
+	}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
+	return s.cs.streamList(ctx, "listVPCOfferings", p.URLValues(), "vpcoffering", func(b json.RawMessage) error {
+		var v VPCOffering
+		if err := s.cs.unmarshalElement("listVPCOfferings", "vpcoffering", b, &v); err != nil {
+			return err
 		}
-	}
-	return &r, nil
+		return fn(&v)
+	})
+}
+
+type ListVPCOfferingsResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	VPCOfferings []*VPCOffering             `json:"vpcoffering" xml:"vpcoffering"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type DeleteStaticRouteResponse struct {
-	JobID       string `json:"jobid,omitempty"`
-	Displaytext string `json:"displaytext,omitempty"`
-	Success     bool   `json:"success,omitempty"`
+type VPCOffering struct {
+	Created                Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext            string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter   Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Id                     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdefault              Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Name                   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Service                []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                  string                     `json:"state,omitempty" xml:"state,omitempty"`
+	SupportsregionLevelvpc Bool                       `json:"supportsregionLevelvpc,omitempty" xml:"supportsregionLevelvpc,omitempty"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
 }
 
-type ListStaticRoutesParams struct {
-	p map[string]interface{}
+type UpdateVPCOfferingParams struct {
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
 }
 
-func (p *ListStaticRoutesParams) toURLValues() url.Values {
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVPCOfferingParams) URLValues() url.Values {
 	u := url.Values{}
-	if p.p == nil {
-		return u
-	}
-	if v, found := p.p["account"]; found {
-		u.Set("account", v.(string))
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
 	}
-	if v, found := p.p["domainid"]; found {
-		u.Set("domainid", v.(string))
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
 	}
-	if v, found := p.p["gatewayid"]; found {
-		u.Set("gatewayid", v.(string))
-	}
-	if v, found := p.p["id"]; found {
-		u.Set("id", v.(string))
-	}
-	if v, found := p.p["isrecursive"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("isrecursive", vv)
-	}
-	if v, found := p.p["keyword"]; found {
-		u.Set("keyword", v.(string))
-	}
-	if v, found := p.p["listall"]; found {
-		vv := strconv.FormatBool(v.(bool))
-		u.Set("listall", vv)
-	}
-	if v, found := p.p["page"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("page", vv)
-	}
-	if v, found := p.p["pagesize"]; found {
-		vv := strconv.Itoa(v.(int))
-		u.Set("pagesize", vv)
-	}
-	if v, found := p.p["projectid"]; found {
-		u.Set("projectid", v.(string))
-	}
-	if v, found := p.p["tags"]; found {
-		i := 0
-		for k, vv := range v.(map[string]string) {
-			u.Set(fmt.Sprintf("tags[%d].key", i), k)
-			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
-			i++
-		}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
 	}
-	if v, found := p.p["vpcid"]; found {
-		u.Set("vpcid", v.(string))
+	if v := p.State; v != nil {
+		u.Set("state", *v)
 	}
 	return u
 }
 
-func (p *ListStaticRoutesParams) SetAccount(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["account"] = v
-	return
+// Returns the name of the API command of these params
+func (p *UpdateVPCOfferingParams) Command() string {
+	return "updateVPCOffering"
 }
 
-func (p *ListStaticRoutesParams) SetDomainid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["domainid"] = v
-	return
+// Returns true if the API command starts an async job
+func (p *UpdateVPCOfferingParams) IsAsync() bool {
+	return true
 }
 
-func (p *ListStaticRoutesParams) SetGatewayid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["gatewayid"] = v
-	return
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVPCOfferingParams) ResponseType() interface{} {
+	return &UpdateVPCOfferingResponse{}
 }
 
-func (p *ListStaticRoutesParams) SetId(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["id"] = v
-	return
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVPCOfferingParams) Validate() error {
+	v := &validator{api: "updateVPCOffering"}
+	v.required("id", p.Id != nil)
+	return v.err()
 }
 
-func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["isrecursive"] = v
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
 	return
 }
 
-func (p *ListStaticRoutesParams) SetKeyword(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVPCOfferingParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
 	}
-	p.p["keyword"] = v
-	return
+	return *p.Displaytext
 }
 
-func (p *ListStaticRoutesParams) SetListall(v bool) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["listall"] = v
-	return
+func (p *UpdateVPCOfferingParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
 }
 
-func (p *ListStaticRoutesParams) SetPage(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["page"] = v
-	return
+func (p *UpdateVPCOfferingParams) ResetDisplaytext() {
+	p.Displaytext = nil
 }
 
-func (p *ListStaticRoutesParams) SetPagesize(v int) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["pagesize"] = v
+// This param is required.
+func (p *UpdateVPCOfferingParams) SetId(v string) {
+	p.Id = &v
 	return
 }
 
-func (p *ListStaticRoutesParams) SetProjectid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVPCOfferingParams) GetId() string {
+	if p.Id == nil {
+		return ""
 	}
-	p.p["projectid"] = v
-	return
+	return *p.Id
 }
 
-func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["tags"] = v
+func (p *UpdateVPCOfferingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateVPCOfferingParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateVPCOfferingParams) SetName(v string) {
+	p.Name = &v
 	return
 }
 
-func (p *ListStaticRoutesParams) SetVpcid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
+func (p *UpdateVPCOfferingParams) GetName() string {
+	if p.Name == nil {
+		return ""
 	}
-	p.p["vpcid"] = v
-	return
+	return *p.Name
 }
 
-// You should always use this function to get a new ListStaticRoutesParams instance,
-// as then you are sure you have configured all required params
-func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
-	p := &ListStaticRoutesParams{}
-	p.p = make(map[string]interface{})
-	return p
+func (p *UpdateVPCOfferingParams) HasName() bool {
+	return p.Name != nil
 }
 
-// This is a courtesy helper function, which in some cases may not work as expected!
-func (s *VPCService) GetStaticRouteByID(id string) (*StaticRoute, int, error) {
-	p := &ListStaticRoutesParams{}
-	p.p = make(map[string]interface{})
+func (p *UpdateVPCOfferingParams) ResetName() {
+	p.Name = nil
+}
 
-	p.p["id"] = id
+func (p *UpdateVPCOfferingParams) SetState(v string) {
+	p.State = &v
+	return
+}
 
-	l, err := s.ListStaticRoutes(p)
-	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
-			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
-		}
-		return nil, -1, err
+func (p *UpdateVPCOfferingParams) GetState() string {
+	if p.State == nil {
+		return ""
 	}
+	return *p.State
+}
 
-	if l.Count == 0 {
-		return nil, l.Count, fmt.Errorf("No match found for %s: %+v", id, l)
-	}
+func (p *UpdateVPCOfferingParams) HasState() bool {
+	return p.State != nil
+}
 
-	if l.Count == 1 {
-		return l.StaticRoutes[0], l.Count, nil
-	}
-	return nil, l.Count, fmt.Errorf("There is more then one result for StaticRoute UUID: %s!", id)
+func (p *UpdateVPCOfferingParams) ResetState() {
+	p.State = nil
 }
 
-// Lists all static routes
-func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
-	resp, err := s.cs.newRequest("listStaticRoutes", p.toURLValues())
+// You should always use this function to get a new UpdateVPCOfferingParams instance,
+// as then you are sure you have configured all required params
+//
+// The required params are:
+//
+//   - id
+func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams {
+	p := &UpdateVPCOfferingParams{}
+	p.SetId(id)
+	return p
+}
+
+// Updates VPC offering
+//
+// This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.
+// See also ListVPCOfferings, GetVPCOfferingID, GetVPCOfferingByName, GetVPCOfferingByID.
+func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	if s.cs.validate {
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.cs.newRequest("updateVPCOffering", p.URLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListStaticRoutesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	var r UpdateVPCOfferingResponse
+	if err := s.cs.unmarshal("updateVPCOffering", resp, &r); err != nil {
 		return nil, err
 	}
+
+	// If we have a async client, we need to wait for the async result
+	if s.cs.async {
+		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+		if err != nil {
+			return nil, err
+		}
+		// If 'warn' has a value it means the job is running longer than the configured
+		// timeout, the resonse will contain the jobid of the running async job
+		if warn != nil {
+			return &r, warn
+		}
+
+		b, err = getRawValue(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.cs.unmarshal("updateVPCOffering", b, &r); err != nil {
+			return nil, err
+		}
+	}
 	return &r, nil
 }
 
-type ListStaticRoutesResponse struct {
-	Count        int            `json:"count"`
-	StaticRoutes []*StaticRoute `json:"staticroute"`
-}
-
-type StaticRoute struct {
-	Account   string `json:"account,omitempty"`
-	Cidr      string `json:"cidr,omitempty"`
-	Domain    string `json:"domain,omitempty"`
-	Domainid  string `json:"domainid,omitempty"`
-	Gatewayid string `json:"gatewayid,omitempty"`
-	Id        string `json:"id,omitempty"`
-	Project   string `json:"project,omitempty"`
-	Projectid string `json:"projectid,omitempty"`
-	State     string `json:"state,omitempty"`
-	Tags      []struct {
-		Account      string `json:"account,omitempty"`
-		Customer     string `json:"customer,omitempty"`
-		Domain       string `json:"domain,omitempty"`
-		Domainid     string `json:"domainid,omitempty"`
-		Key          string `json:"key,omitempty"`
-		Project      string `json:"project,omitempty"`
-		Projectid    string `json:"projectid,omitempty"`
-		Resourceid   string `json:"resourceid,omitempty"`
-		Resourcetype string `json:"resourcetype,omitempty"`
-		Value        string `json:"value,omitempty"`
-	} `json:"tags,omitempty"`
-	Vpcid string `json:"vpcid,omitempty"`
+type UpdateVPCOfferingResponse struct {
+	JobID                  string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Created                Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Displaytext            string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Distributedvpcrouter   Bool                       `json:"distributedvpcrouter,omitempty" xml:"distributedvpcrouter,omitempty"`
+	Id                     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdefault              Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Name                   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Service                []Service                  `json:"service,omitempty" xml:"service,omitempty"`
+	State                  string                     `json:"state,omitempty" xml:"state,omitempty"`
+	SupportsregionLevelvpc Bool                       `json:"supportsregionLevelvpc,omitempty" xml:"supportsregionLevelvpc,omitempty"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
 }
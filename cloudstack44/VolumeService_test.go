@@ -0,0 +1,771 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAttachVolume(t *testing.T) {
+	params := url.Values{
+		"deviceid":         {"1"},
+		"id":               {"test-id"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "attachVolume", params, `{"attachvolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewAttachVolumeParams("test-id", "test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDeviceid(1)
+	p.SetId("test-id")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "attachVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.AttachVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call attachVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "attachVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute attachVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of attachVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of attachVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "attachVolume", params, `<attachvolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></attachvolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.AttachVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call attachVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateVolume(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"customid":         {"test-customid"},
+		"diskofferingid":   {"test-diskofferingid"},
+		"displayvolume":    {"true"},
+		"domainid":         {"test-domainid"},
+		"maxiops":          {"6"},
+		"miniops":          {"7"},
+		"name":             {"test-name"},
+		"projectid":        {"test-projectid"},
+		"size":             {"10"},
+		"snapshotid":       {"test-snapshotid"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"zoneid":           {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "createVolume", params, `{"createvolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewCreateVolumeParams("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetCustomid("test-customid")
+	p.SetDiskofferingid("test-diskofferingid")
+	p.SetDisplayvolume(true)
+	p.SetDomainid("test-domainid")
+	p.SetMaxiops(6)
+	p.SetMiniops(7)
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	p.SetSize(10)
+	p.SetSnapshotid("test-snapshotid")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "createVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.CreateVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createVolume", params, `<createvolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></createvolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.CreateVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call createVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteVolume(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteVolume", params, `{"deletevolumeresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Volume.NewDeleteVolumeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.DeleteVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVolume: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVolume" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteVolume", params, `<deletevolumeresponse><displaytext>test-displaytext</displaytext><success>true</success></deletevolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.DeleteVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDetachVolume(t *testing.T) {
+	params := url.Values{
+		"deviceid":         {"1"},
+		"id":               {"test-id"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+	cs, done := newTestClient(t, "detachVolume", params, `{"detachvolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewDetachVolumeParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDeviceid(1)
+	p.SetId("test-id")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	checkURLValues(t, "detachVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.DetachVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call detachVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "detachVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute detachVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of detachVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of detachVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "detachVolume", params, `<detachvolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></detachvolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.DetachVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call detachVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestExtractVolume(t *testing.T) {
+	params := url.Values{
+		"id":     {"test-id"},
+		"mode":   {"test-mode"},
+		"url":    {"test-url"},
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "extractVolume", params, `{"extractvolumeresponse":{"accountid":"test-accountid","created":"2014-01-02T15:04:05+0100","extractId":"test-extractId","extractMode":"test-extractMode","id":"test-id","jobid":"test-jobid","name":"test-name","resultstring":"test-resultstring","state":"test-state","status":"test-status","storagetype":"test-storagetype","uploadpercentage":1,"url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewExtractVolumeParams("test-id", "test-mode", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	p.SetMode("test-mode")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "extractVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.ExtractVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call extractVolume: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "extractVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute extractVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of extractVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of extractVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "extractVolume", params, `<extractvolumeresponse><accountid>test-accountid</accountid><created>2014-01-02T15:04:05+0100</created><extractId>test-extractId</extractId><extractMode>test-extractMode</extractMode><id>test-id</id><jobid>test-jobid</jobid><name>test-name</name><resultstring>test-resultstring</resultstring><state>test-state</state><status>test-status</status><storagetype>test-storagetype</storagetype><uploadpercentage>1</uploadpercentage><url>test-url</url><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></extractvolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.ExtractVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call extractVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListVolumes(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"diskofferingid":   {"test-diskofferingid"},
+		"displayvolume":    {"true"},
+		"domainid":         {"test-domainid"},
+		"hostid":           {"test-hostid"},
+		"id":               {"test-id"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"name":             {"test-name"},
+		"page":             {"11"},
+		"pagesize":         {"12"},
+		"podid":            {"test-podid"},
+		"projectid":        {"test-projectid"},
+		"storageid":        {"test-storageid"},
+		"tags[0].key":      {"test-tagskey"},
+		"tags[0].value":    {"test-tagsvalue"},
+		"type":             {"test-type"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"zoneid":           {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listVolumes", params, `{"listvolumesresponse":{"count":1,"volume":[{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Volume.NewListVolumesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDiskofferingid("test-diskofferingid")
+	p.SetDisplayvolume(true)
+	p.SetDomainid("test-domainid")
+	p.SetHostid("test-hostid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetStorageid("test-storageid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetType("test-type")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listVolumes", p.URLValues(), params)
+
+	r, err := cs.Volume.ListVolumes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVolumes: %v", err)
+	}
+	if r.Volumes[0].Account != "test-account" {
+		t.Errorf("Expected r.Volumes[0].Account to be %v, got %v", "test-account", r.Volumes[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listVolumes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listVolumes: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listVolumes: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listVolumes: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Volume
+	count, err := cs.Volume.StreamVolumes(context.Background(), p, func(v *Volume) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listVolumes: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Volumes) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Volumes)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listVolumes", params, `<listvolumesresponse><count>1</count><volume><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></volume></listvolumesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.ListVolumes(p)
+	if err != nil {
+		t.Fatalf("Failed to call listVolumes using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Volume.StreamVolumes(context.Background(), p, func(v *Volume) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listVolumes using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Volumes) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Volumes)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestMigrateVolume(t *testing.T) {
+	params := url.Values{
+		"livemigrate": {"true"},
+		"storageid":   {"test-storageid"},
+		"volumeid":    {"test-volumeid"},
+	}
+	cs, done := newTestClient(t, "migrateVolume", params, `{"migratevolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewMigrateVolumeParams("test-storageid", "test-volumeid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetLivemigrate(true)
+	p.SetStorageid("test-storageid")
+	p.SetVolumeid("test-volumeid")
+	checkURLValues(t, "migrateVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.MigrateVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call migrateVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "migrateVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute migrateVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of migrateVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of migrateVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "migrateVolume", params, `<migratevolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></migratevolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.MigrateVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call migrateVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestResizeVolume(t *testing.T) {
+	params := url.Values{
+		"diskofferingid": {"test-diskofferingid"},
+		"id":             {"test-id"},
+		"shrinkok":       {"true"},
+		"size":           {"4"},
+	}
+	cs, done := newTestClient(t, "resizeVolume", params, `{"resizevolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewResizeVolumeParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetDiskofferingid("test-diskofferingid")
+	p.SetId("test-id")
+	p.SetShrinkok(true)
+	p.SetSize(4)
+	checkURLValues(t, "resizeVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.ResizeVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call resizeVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "resizeVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute resizeVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of resizeVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of resizeVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "resizeVolume", params, `<resizevolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></resizevolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.ResizeVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call resizeVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateVolume(t *testing.T) {
+	params := url.Values{
+		"chaininfo":     {"test-chaininfo"},
+		"customid":      {"test-customid"},
+		"displayvolume": {"true"},
+		"id":            {"test-id"},
+		"path":          {"test-path"},
+		"state":         {"test-state"},
+		"storageid":     {"test-storageid"},
+	}
+	cs, done := newTestClient(t, "updateVolume", params, `{"updatevolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewUpdateVolumeParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetChaininfo("test-chaininfo")
+	p.SetCustomid("test-customid")
+	p.SetDisplayvolume(true)
+	p.SetId("test-id")
+	p.SetPath("test-path")
+	p.SetState("test-state")
+	p.SetStorageid("test-storageid")
+	checkURLValues(t, "updateVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.UpdateVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateVolume", params, `<updatevolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></updatevolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.UpdateVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUploadVolume(t *testing.T) {
+	params := url.Values{
+		"account":        {"test-account"},
+		"checksum":       {"test-checksum"},
+		"diskofferingid": {"test-diskofferingid"},
+		"domainid":       {"test-domainid"},
+		"format":         {"test-format"},
+		"imagestoreuuid": {"test-imagestoreuuid"},
+		"name":           {"test-name"},
+		"projectid":      {"test-projectid"},
+		"url":            {"test-url"},
+		"zoneid":         {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "uploadVolume", params, `{"uploadvolumeresponse":{"account":"test-account","attached":"test-attached","chaininfo":"test-chaininfo","created":"2014-01-02T15:04:05+0100","destroyed":true,"deviceid":1,"diskBytesReadRate":1,"diskBytesWriteRate":1,"diskIopsReadRate":1,"diskIopsWriteRate":1,"diskofferingdisplaytext":"test-diskofferingdisplaytext","diskofferingid":"test-diskofferingid","diskofferingname":"test-diskofferingname","displayvolume":true,"domain":"test-domain","domainid":"test-domainid","hypervisor":"test-hypervisor","id":"test-id","isextractable":true,"isodisplaytext":"test-isodisplaytext","isoid":"test-isoid","isoname":"test-isoname","jobid":"test-jobid","maxiops":1,"miniops":1,"name":"test-name","path":"test-path","project":"test-project","projectid":"test-projectid","quiescevm":true,"serviceofferingdisplaytext":"test-serviceofferingdisplaytext","serviceofferingid":"test-serviceofferingid","serviceofferingname":"test-serviceofferingname","size":1,"snapshotid":"test-snapshotid","state":"test-state","status":"test-status","storage":"test-storage","storageid":"test-storageid","storagetype":"test-storagetype","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templatedisplaytext":"test-templatedisplaytext","templateid":"test-templateid","templatename":"test-templatename","type":"test-type","virtualmachineid":"test-virtualmachineid","vmdisplayname":"test-vmdisplayname","vmname":"test-vmname","vmstate":"test-vmstate","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Volume.NewUploadVolumeParams("test-format", "test-name", "test-url", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetChecksum("test-checksum")
+	p.SetDiskofferingid("test-diskofferingid")
+	p.SetDomainid("test-domainid")
+	p.SetFormat("test-format")
+	p.SetImagestoreuuid("test-imagestoreuuid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "uploadVolume", p.URLValues(), params)
+
+	r, err := cs.Volume.UploadVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call uploadVolume: %v", err)
+	}
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "uploadVolume" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute uploadVolume: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of uploadVolume: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of uploadVolume: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "uploadVolume", params, `<uploadvolumeresponse><account>test-account</account><attached>test-attached</attached><chaininfo>test-chaininfo</chaininfo><created>2014-01-02T15:04:05+0100</created><destroyed>true</destroyed><deviceid>1</deviceid><diskBytesReadRate>1</diskBytesReadRate><diskBytesWriteRate>1</diskBytesWriteRate><diskIopsReadRate>1</diskIopsReadRate><diskIopsWriteRate>1</diskIopsWriteRate><diskofferingdisplaytext>test-diskofferingdisplaytext</diskofferingdisplaytext><diskofferingid>test-diskofferingid</diskofferingid><diskofferingname>test-diskofferingname</diskofferingname><displayvolume>true</displayvolume><domain>test-domain</domain><domainid>test-domainid</domainid><hypervisor>test-hypervisor</hypervisor><id>test-id</id><isextractable>true</isextractable><isodisplaytext>test-isodisplaytext</isodisplaytext><isoid>test-isoid</isoid><isoname>test-isoname</isoname><jobid>test-jobid</jobid><maxiops>1</maxiops><miniops>1</miniops><name>test-name</name><path>test-path</path><project>test-project</project><projectid>test-projectid</projectid><quiescevm>true</quiescevm><serviceofferingdisplaytext>test-serviceofferingdisplaytext</serviceofferingdisplaytext><serviceofferingid>test-serviceofferingid</serviceofferingid><serviceofferingname>test-serviceofferingname</serviceofferingname><size>1</size><snapshotid>test-snapshotid</snapshotid><state>test-state</state><status>test-status</status><storage>test-storage</storage><storageid>test-storageid</storageid><storagetype>test-storagetype</storagetype><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><templatedisplaytext>test-templatedisplaytext</templatedisplaytext><templateid>test-templateid</templateid><templatename>test-templatename</templatename><type>test-type</type><virtualmachineid>test-virtualmachineid</virtualmachineid><vmdisplayname>test-vmdisplayname</vmdisplayname><vmname>test-vmname</vmname><vmstate>test-vmstate</vmstate><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></uploadvolumeresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Volume.UploadVolume(p)
+	if err != nil {
+		t.Fatalf("Failed to call uploadVolume using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
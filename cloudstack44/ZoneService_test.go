@@ -0,0 +1,581 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListDedicatedZones(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"domainid":        {"test-domainid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"5"},
+		"pagesize":        {"6"},
+		"zoneid":          {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "listDedicatedZones", params, `{"listdedicatedzonesresponse":{"count":1,"dedicatedzone":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`)
+	defer done()
+
+	p := cs.Zone.NewListDedicatedZonesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetDomainid("test-domainid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "listDedicatedZones", p.URLValues(), params)
+
+	r, err := cs.Zone.ListDedicatedZones(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedZones: %v", err)
+	}
+	if r.DedicatedZones[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedZones[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedZones[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedZones" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listDedicatedZones: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listDedicatedZones: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listDedicatedZones: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*DedicatedZone
+	count, err := cs.Zone.StreamDedicatedZones(context.Background(), p, func(v *DedicatedZone) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listDedicatedZones: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.DedicatedZones) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.DedicatedZones)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listDedicatedZones", params, `<listdedicatedzonesresponse><count>1</count><dedicatedzone><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><domainid>test-domainid</domainid><id>test-id</id><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></dedicatedzone></listdedicatedzonesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.ListDedicatedZones(p)
+	if err != nil {
+		t.Fatalf("Failed to call listDedicatedZones using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Zone.StreamDedicatedZones(context.Background(), p, func(v *DedicatedZone) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listDedicatedZones using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.DedicatedZones) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.DedicatedZones)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestReleaseDedicatedZone(t *testing.T) {
+	params := url.Values{
+		"zoneid": {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "releaseDedicatedZone", params, `{"releasededicatedzoneresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`)
+	defer done()
+
+	p := cs.Zone.NewReleaseDedicatedZoneParams("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "releaseDedicatedZone", p.URLValues(), params)
+
+	r, err := cs.Zone.ReleaseDedicatedZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedZone: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedZone" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute releaseDedicatedZone: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of releaseDedicatedZone: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of releaseDedicatedZone: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "releaseDedicatedZone", params, `<releasededicatedzoneresponse><displaytext>test-displaytext</displaytext><jobid>test-jobid</jobid><success>true</success></releasededicatedzoneresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.ReleaseDedicatedZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call releaseDedicatedZone using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestCreateZone(t *testing.T) {
+	params := url.Values{
+		"allocationstate":      {"test-allocationstate"},
+		"dns1":                 {"test-dns1"},
+		"dns2":                 {"test-dns2"},
+		"domain":               {"test-domain"},
+		"domainid":             {"test-domainid"},
+		"guestcidraddress":     {"test-guestcidraddress"},
+		"internaldns1":         {"test-internaldns1"},
+		"internaldns2":         {"test-internaldns2"},
+		"ip6dns1":              {"test-ip6dns1"},
+		"ip6dns2":              {"test-ip6dns2"},
+		"localstorageenabled":  {"true"},
+		"name":                 {"test-name"},
+		"networktype":          {"test-networktype"},
+		"securitygroupenabled": {"true"},
+	}
+	cs, done := newTestClient(t, "createZone", params, `{"createzoneresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"description":"test-description","dhcpprovider":"test-dhcpprovider","displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","domainname":"test-domainname","guestcidraddress":"test-guestcidraddress","id":"test-id","internaldns1":"test-internaldns1","internaldns2":"test-internaldns2","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","localstorageenabled":true,"name":"test-name","networktype":"test-networktype","resourcedetails":{"key":"value"},"securitygroupsenabled":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vlan":"test-vlan","zonetoken":"test-zonetoken"}}`)
+	defer done()
+
+	p := cs.Zone.NewCreateZoneParams("test-dns1", "test-internaldns1", "test-name", "test-networktype")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetDns1("test-dns1")
+	p.SetDns2("test-dns2")
+	p.SetDomain("test-domain")
+	p.SetDomainid("test-domainid")
+	p.SetGuestcidraddress("test-guestcidraddress")
+	p.SetInternaldns1("test-internaldns1")
+	p.SetInternaldns2("test-internaldns2")
+	p.SetIp6dns1("test-ip6dns1")
+	p.SetIp6dns2("test-ip6dns2")
+	p.SetLocalstorageenabled(true)
+	p.SetName("test-name")
+	p.SetNetworktype("test-networktype")
+	p.SetSecuritygroupenabled(true)
+	checkURLValues(t, "createZone", p.URLValues(), params)
+
+	r, err := cs.Zone.CreateZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call createZone: %v", err)
+	}
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "createZone" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute createZone: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of createZone: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of createZone: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "createZone", params, `<createzoneresponse><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><description>test-description</description><dhcpprovider>test-dhcpprovider</dhcpprovider><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><domainname>test-domainname</domainname><guestcidraddress>test-guestcidraddress</guestcidraddress><id>test-id</id><internaldns1>test-internaldns1</internaldns1><internaldns2>test-internaldns2</internaldns2><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><localstorageenabled>true</localstorageenabled><name>test-name</name><networktype>test-networktype</networktype><resourcedetails><key>value</key></resourcedetails><securitygroupsenabled>true</securitygroupsenabled><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vlan>test-vlan</vlan><zonetoken>test-zonetoken</zonetoken></createzoneresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.CreateZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call createZone using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDedicateZone(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"zoneid":   {"test-zoneid"},
+	}
+	cs, done := newTestClient(t, "dedicateZone", params, `{"dedicatezoneresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","zoneid":"test-zoneid","zonename":"test-zonename"}}`)
+	defer done()
+
+	p := cs.Zone.NewDedicateZoneParams("test-domainid", "test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetZoneid("test-zoneid")
+	checkURLValues(t, "dedicateZone", p.URLValues(), params)
+
+	r, err := cs.Zone.DedicateZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateZone: %v", err)
+	}
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateZone" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute dedicateZone: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of dedicateZone: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of dedicateZone: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "dedicateZone", params, `<dedicatezoneresponse><accountid>test-accountid</accountid><affinitygroupid>test-affinitygroupid</affinitygroupid><domainid>test-domainid</domainid><id>test-id</id><jobid>test-jobid</jobid><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></dedicatezoneresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.DedicateZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call dedicateZone using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestDeleteZone(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+	cs, done := newTestClient(t, "deleteZone", params, `{"deletezoneresponse":{"displaytext":"test-displaytext","success":true}}`)
+	defer done()
+
+	p := cs.Zone.NewDeleteZoneParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetId("test-id")
+	checkURLValues(t, "deleteZone", p.URLValues(), params)
+
+	r, err := cs.Zone.DeleteZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteZone: %v", err)
+	}
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "deleteZone" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute deleteZone: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of deleteZone: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of deleteZone: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "deleteZone", params, `<deletezoneresponse><displaytext>test-displaytext</displaytext><success>true</success></deletezoneresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.DeleteZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call deleteZone using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestListZones(t *testing.T) {
+	params := url.Values{
+		"available":      {"true"},
+		"domainid":       {"test-domainid"},
+		"id":             {"test-id"},
+		"keyword":        {"test-keyword"},
+		"name":           {"test-name"},
+		"networktype":    {"test-networktype"},
+		"page":           {"7"},
+		"pagesize":       {"8"},
+		"showcapacities": {"true"},
+		"tags[0].key":    {"test-tagskey"},
+		"tags[0].value":  {"test-tagsvalue"},
+	}
+	cs, done := newTestClient(t, "listZones", params, `{"listzonesresponse":{"count":1,"zone":[{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"description":"test-description","dhcpprovider":"test-dhcpprovider","displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","domainname":"test-domainname","guestcidraddress":"test-guestcidraddress","id":"test-id","internaldns1":"test-internaldns1","internaldns2":"test-internaldns2","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","localstorageenabled":true,"name":"test-name","networktype":"test-networktype","resourcedetails":{"key":"value"},"securitygroupsenabled":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vlan":"test-vlan","zonetoken":"test-zonetoken"}]}}`)
+	defer done()
+
+	p := cs.Zone.NewListZonesParams()
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAvailable(true)
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetNetworktype("test-networktype")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetShowcapacities(true)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	checkURLValues(t, "listZones", p.URLValues(), params)
+
+	r, err := cs.Zone.ListZones(p)
+	if err != nil {
+		t.Fatalf("Failed to call listZones: %v", err)
+	}
+	if r.Zones[0].Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Zones[0].Allocationstate to be %v, got %v", "test-allocationstate", r.Zones[0].Allocationstate)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "listZones" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute listZones: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of listZones: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of listZones: %v", err)
+	}
+	resp.Body.Close()
+
+	var streamed []*Zone
+	count, err := cs.Zone.StreamZones(context.Background(), p, func(v *Zone) error {
+		streamed = append(streamed, v)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to stream listZones: %v", err)
+	}
+	if count != r.Count || !reflect.DeepEqual(streamed, r.Zones) {
+		t.Errorf("Streamed %d objects %+v, expected %d objects %+v", count, streamed, r.Count, r.Zones)
+	}
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "listZones", params, `<listzonesresponse><count>1</count><zone><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><description>test-description</description><dhcpprovider>test-dhcpprovider</dhcpprovider><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><domainname>test-domainname</domainname><guestcidraddress>test-guestcidraddress</guestcidraddress><id>test-id</id><internaldns1>test-internaldns1</internaldns1><internaldns2>test-internaldns2</internaldns2><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><localstorageenabled>true</localstorageenabled><name>test-name</name><networktype>test-networktype</networktype><resourcedetails><key>value</key></resourcedetails><securitygroupsenabled>true</securitygroupsenabled><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vlan>test-vlan</vlan><zonetoken>test-zonetoken</zonetoken></zone></listzonesresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.ListZones(p)
+	if err != nil {
+		t.Fatalf("Failed to call listZones using XML: %v", err)
+	}
+
+	streamed = nil
+	if _, err := xcs.Zone.StreamZones(context.Background(), p, func(v *Zone) error {
+		streamed = append(streamed, v)
+		return nil
+	}); err != nil {
+		t.Fatalf("Failed to stream listZones using XML: %v", err)
+	}
+	if !reflect.DeepEqual(streamed, xr.Zones) {
+		t.Errorf("Streamed %+v using XML, expected %+v", streamed, xr.Zones)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
+
+func TestUpdateZone(t *testing.T) {
+	params := url.Values{
+		"allocationstate":            {"test-allocationstate"},
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"dhcpprovider":               {"test-dhcpprovider"},
+		"dns1":                       {"test-dns1"},
+		"dns2":                       {"test-dns2"},
+		"dnssearchorder":             {"test-dnssearchorder1, test-dnssearchorder2"},
+		"domain":                     {"test-domain"},
+		"guestcidraddress":           {"test-guestcidraddress"},
+		"id":                         {"test-id"},
+		"internaldns1":               {"test-internaldns1"},
+		"internaldns2":               {"test-internaldns2"},
+		"ip6dns1":                    {"test-ip6dns1"},
+		"ip6dns2":                    {"test-ip6dns2"},
+		"ispublic":                   {"true"},
+		"localstorageenabled":        {"true"},
+		"name":                       {"test-name"},
+	}
+	cs, done := newTestClient(t, "updateZone", params, `{"updatezoneresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"description":"test-description","dhcpprovider":"test-dhcpprovider","displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","domainname":"test-domainname","guestcidraddress":"test-guestcidraddress","id":"test-id","internaldns1":"test-internaldns1","internaldns2":"test-internaldns2","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","localstorageenabled":true,"name":"test-name","networktype":"test-networktype","resourcedetails":{"key":"value"},"securitygroupsenabled":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vlan":"test-vlan","zonetoken":"test-zonetoken"}}`)
+	defer done()
+
+	p := cs.Zone.NewUpdateZoneParams("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with only the required params set are invalid: %v", err)
+	}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetDhcpprovider("test-dhcpprovider")
+	p.SetDns1("test-dns1")
+	p.SetDns2("test-dns2")
+	p.SetDnssearchorder([]string{"test-dnssearchorder1", "test-dnssearchorder2"})
+	p.SetDomain("test-domain")
+	p.SetGuestcidraddress("test-guestcidraddress")
+	p.SetId("test-id")
+	p.SetInternaldns1("test-internaldns1")
+	p.SetInternaldns2("test-internaldns2")
+	p.SetIp6dns1("test-ip6dns1")
+	p.SetIp6dns2("test-ip6dns2")
+	p.SetIspublic(true)
+	p.SetLocalstorageenabled(true)
+	p.SetName("test-name")
+	checkURLValues(t, "updateZone", p.URLValues(), params)
+
+	r, err := cs.Zone.UpdateZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateZone: %v", err)
+	}
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+
+	var cmd Command = p
+	if cmd.Command() != "updateZone" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	out := cmd.ResponseType()
+	if err := cs.Execute(context.Background(), cmd, out); err != nil {
+		t.Fatalf("Failed to execute updateZone: %v", err)
+	}
+	if !reflect.DeepEqual(out, r) {
+		t.Errorf("Execute decoded %+v, expected %+v", out, r)
+	}
+
+	u, err := cs.SignedURL(cmd, time.Minute)
+	if err != nil {
+		t.Fatalf("Failed to sign the URL of updateZone: %v", err)
+	}
+	resp, err := http.Get(u)
+	if err != nil {
+		t.Fatalf("Failed to call the signed URL of updateZone: %v", err)
+	}
+	resp.Body.Close()
+
+	// The same response as XML
+	xcs, xdone := newTestClient(t, "updateZone", params, `<updatezoneresponse><allocationstate>test-allocationstate</allocationstate><capacity><capacitytotal>1</capacitytotal><capacityused>1</capacityused><clusterid>test-clusterid</clusterid><clustername>test-clustername</clustername><percentused>12.5%</percentused><podid>test-podid</podid><podname>test-podname</podname><type>1</type><zoneid>test-zoneid</zoneid><zonename>test-zonename</zonename></capacity><description>test-description</description><dhcpprovider>test-dhcpprovider</dhcpprovider><displaytext>test-displaytext</displaytext><dns1>test-dns1</dns1><dns2>test-dns2</dns2><domain>test-domain</domain><domainid>test-domainid</domainid><domainname>test-domainname</domainname><guestcidraddress>test-guestcidraddress</guestcidraddress><id>test-id</id><internaldns1>test-internaldns1</internaldns1><internaldns2>test-internaldns2</internaldns2><ip6dns1>test-ip6dns1</ip6dns1><ip6dns2>test-ip6dns2</ip6dns2><localstorageenabled>true</localstorageenabled><name>test-name</name><networktype>test-networktype</networktype><resourcedetails><key>value</key></resourcedetails><securitygroupsenabled>true</securitygroupsenabled><tags><account>test-account</account><customer>test-customer</customer><domain>test-domain</domain><domainid>test-domainid</domainid><key>test-key</key><project>test-project</project><projectid>test-projectid</projectid><resourceid>test-resourceid</resourceid><resourcetype>test-resourcetype</resourcetype><value>test-value</value></tags><vlan>test-vlan</vlan><zonetoken>test-zonetoken</zonetoken></updatezoneresponse>`)
+	defer xdone()
+	xcs.ResponseFormat(XMLResponse)
+
+	xr, err := xcs.Zone.UpdateZone(p)
+	if err != nil {
+		t.Fatalf("Failed to call updateZone using XML: %v", err)
+	}
+	if !reflect.DeepEqual(xr, r) {
+		t.Errorf("Decoded the XML response as %+v, expected %+v", xr, r)
+	}
+}
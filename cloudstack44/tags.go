@@ -0,0 +1,386 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstack44
+
+import (
+	"fmt"
+	"sort"
+)
+
+// The objects found by FindByTags, by their type. The IDs of the objects of other resource types
+// (like disk offerings), which can not be listed by their tags, are added to Other.
+type TaggedObjects struct {
+	VirtualMachines     []*VirtualMachine
+	Volumes             []*Volume
+	Snapshots           []*Snapshot
+	VMSnapshot          []*VMSnapshot
+	Isos                []*Iso
+	Networks            []*Network
+	VPCs                []*VPC
+	PublicIpAddresses   []*PublicIpAddress
+	FirewallRules       []*FirewallRule
+	EgressFirewallRules []*EgressFirewallRule
+	PortForwardingRules []*PortForwardingRule
+	LoadBalancerRules   []*LoadBalancerRule
+	NetworkACLs         []*NetworkACL
+	StaticRoutes        []*StaticRoute
+	SecurityGroups      []*SecurityGroup
+	Projects            []*Project
+	Zones               []*Zone
+	Other               map[TaggedResourceType][]string
+}
+
+// Returns the number of objects found
+func (o *TaggedObjects) Count() int {
+	n := 0
+	n += len(o.VirtualMachines)
+	n += len(o.Volumes)
+	n += len(o.Snapshots)
+	n += len(o.VMSnapshot)
+	n += len(o.Isos)
+	n += len(o.Networks)
+	n += len(o.VPCs)
+	n += len(o.PublicIpAddresses)
+	n += len(o.FirewallRules)
+	n += len(o.EgressFirewallRules)
+	n += len(o.PortForwardingRules)
+	n += len(o.LoadBalancerRules)
+	n += len(o.NetworkACLs)
+	n += len(o.StaticRoutes)
+	n += len(o.SecurityGroups)
+	n += len(o.Projects)
+	n += len(o.Zones)
+	for _, ids := range o.Other {
+		n += len(ids)
+	}
+	return n
+}
+
+// This is a courtesy helper function, which returns the objects of all resource types that have all
+// of the given tags. The tagged resources are found using ListTags, after which their objects are
+// listed using the 'list' API of their type (like GetVirtualMachinesByTags). The search can be limited
+// using options like WithZone or WithProject. Options which a 'list' API can not be scoped by exclude
+// the objects listed by that API, like projects when using WithZone. If nothing is found and no project
+// is given, the objects of all projects are searched as well.
+func (s *ResourcetagsService) FindByTags(tags map[string]string, opts ...OptionFunc) (*TaggedObjects, error) {
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("No tags given to search for")
+	}
+
+	p := &ListTagsParams{}
+	if err := applyTagsScope(p, opts); err != nil {
+		return nil, err
+	}
+
+	o, err := s.findByTags(tags, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Count() == 0 && p.Projectid == nil {
+		// look	inside projects
+		return s.findByTags(tags, append(append([]OptionFunc{}, opts...), inAllProjects))
+	}
+	return o, nil
+}
+
+func (s *ResourcetagsService) findByTags(tags map[string]string, opts []OptionFunc) (*TaggedObjects, error) {
+	// The IDs of the resources having all tags, by resource type
+	var found map[TaggedResourceType]map[string]bool
+	for k, v := range tags {
+		p := &ListTagsParams{}
+		p.SetKey(k)
+		p.SetValue(v)
+		if err := applyTagsScope(p, opts); err != nil {
+			return nil, err
+		}
+
+		l, err := s.ListTags(p)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make(map[TaggedResourceType]map[string]bool)
+		for _, t := range l.Tags {
+			if found != nil && !found[t.Resourcetype][t.Resourceid] {
+				continue
+			}
+			if ids[t.Resourcetype] == nil {
+				ids[t.Resourcetype] = make(map[string]bool)
+			}
+			ids[t.Resourcetype][t.Resourceid] = true
+		}
+		found = ids
+	}
+
+	o := &TaggedObjects{Other: make(map[TaggedResourceType][]string)}
+	listed := make(map[TaggedResourceType]bool)
+
+	if ids := found[TaggedResourceTypeUserVm]; len(ids) > 0 {
+		l, err := s.cs.VirtualMachine.GetVirtualMachinesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.VirtualMachines = append(o.VirtualMachines, v)
+			}
+		}
+		listed[TaggedResourceTypeUserVm] = true
+	}
+
+	if ids := found[TaggedResourceTypeVolume]; len(ids) > 0 {
+		l, err := s.cs.Volume.GetVolumesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.Volumes = append(o.Volumes, v)
+			}
+		}
+		listed[TaggedResourceTypeVolume] = true
+	}
+
+	if ids := found[TaggedResourceTypeSnapshot]; len(ids) > 0 {
+		l, err := s.cs.Snapshot.GetSnapshotsByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.Snapshots = append(o.Snapshots, v)
+			}
+		}
+		listed[TaggedResourceTypeSnapshot] = true
+	}
+
+	if ids := found[TaggedResourceTypeVMSnapshot]; len(ids) > 0 {
+		l, err := s.cs.Snapshot.GetVMSnapshotByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.VMSnapshot = append(o.VMSnapshot, v)
+			}
+		}
+		listed[TaggedResourceTypeVMSnapshot] = true
+	}
+
+	if ids := found[TaggedResourceTypeISO]; len(ids) > 0 {
+		l, err := s.cs.ISO.GetIsosByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.Isos = append(o.Isos, v)
+			}
+		}
+		listed[TaggedResourceTypeISO] = true
+	}
+
+	if ids := found[TaggedResourceTypeNetwork]; len(ids) > 0 {
+		l, err := s.cs.Network.GetNetworksByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.Networks = append(o.Networks, v)
+			}
+		}
+		listed[TaggedResourceTypeNetwork] = true
+	}
+
+	if ids := found[TaggedResourceTypeVpc]; len(ids) > 0 {
+		l, err := s.cs.VPC.GetVPCsByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.VPCs = append(o.VPCs, v)
+			}
+		}
+		listed[TaggedResourceTypeVpc] = true
+	}
+
+	if ids := found[TaggedResourceTypePublicIpAddress]; len(ids) > 0 {
+		l, err := s.cs.Address.GetPublicIpAddressesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.PublicIpAddresses = append(o.PublicIpAddresses, v)
+			}
+		}
+		listed[TaggedResourceTypePublicIpAddress] = true
+	}
+
+	if ids := found[TaggedResourceTypeFirewallRule]; len(ids) > 0 {
+		l, err := s.cs.Firewall.GetFirewallRulesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.FirewallRules = append(o.FirewallRules, v)
+			}
+		}
+		listed[TaggedResourceTypeFirewallRule] = true
+	}
+
+	if ids := found[TaggedResourceTypeFirewallRule]; len(ids) > 0 {
+		l, err := s.cs.Firewall.GetEgressFirewallRulesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.EgressFirewallRules = append(o.EgressFirewallRules, v)
+			}
+		}
+		listed[TaggedResourceTypeFirewallRule] = true
+	}
+
+	if ids := found[TaggedResourceTypePortForwardingRule]; len(ids) > 0 {
+		l, err := s.cs.Firewall.GetPortForwardingRulesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.PortForwardingRules = append(o.PortForwardingRules, v)
+			}
+		}
+		listed[TaggedResourceTypePortForwardingRule] = true
+	}
+
+	if ids := found[TaggedResourceTypeLoadBalancer]; len(ids) > 0 {
+		l, err := s.cs.LoadBalancer.GetLoadBalancerRulesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.LoadBalancerRules = append(o.LoadBalancerRules, v)
+			}
+		}
+		listed[TaggedResourceTypeLoadBalancer] = true
+	}
+
+	if ids := found[TaggedResourceTypeNetworkACL]; len(ids) > 0 {
+		l, err := s.cs.NetworkACL.GetNetworkACLsByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.NetworkACLs = append(o.NetworkACLs, v)
+			}
+		}
+		listed[TaggedResourceTypeNetworkACL] = true
+	}
+
+	if ids := found[TaggedResourceTypeStaticRoute]; len(ids) > 0 {
+		l, err := s.cs.VPC.GetStaticRoutesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.StaticRoutes = append(o.StaticRoutes, v)
+			}
+		}
+		listed[TaggedResourceTypeStaticRoute] = true
+	}
+
+	if ids := found[TaggedResourceTypeSecurityGroup]; len(ids) > 0 {
+		l, err := s.cs.SecurityGroup.GetSecurityGroupsByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.SecurityGroups = append(o.SecurityGroups, v)
+			}
+		}
+		listed[TaggedResourceTypeSecurityGroup] = true
+	}
+
+	if ids := found[TaggedResourceTypeProject]; len(ids) > 0 {
+		l, err := s.cs.Project.GetProjectsByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.Projects = append(o.Projects, v)
+			}
+		}
+		listed[TaggedResourceTypeProject] = true
+	}
+
+	if ids := found[TaggedResourceTypeZone]; len(ids) > 0 {
+		l, err := s.cs.Zone.GetZonesByTags(tags, opts...)
+		if _, ok := err.(*ScopeError); err != nil && !ok {
+			return nil, err
+		}
+		for _, v := range l {
+			if ids[v.Id] {
+				o.Zones = append(o.Zones, v)
+			}
+		}
+		listed[TaggedResourceTypeZone] = true
+	}
+
+	for rt, ids := range found {
+		if listed[rt] {
+			continue
+		}
+		for id := range ids {
+			o.Other[rt] = append(o.Other[rt], id)
+		}
+		sort.Strings(o.Other[rt])
+	}
+	return o, nil
+}
+
+// Like WithProject("-1"), but the objects of the 'list' APIs that can not be scoped by project (like
+// listZones) are still listed
+func inAllProjects(p interface{}) error {
+	if ps, ok := p.(interface{ SetProjectid(string) }); ok {
+		ps.SetProjectid("-1")
+	}
+	return nil
+}
+
+// Applies the options to the params of ListTags. Options listTags can not be scoped by (like WithZone)
+// are only applied when listing the objects.
+func applyTagsScope(p *ListTagsParams, opts []OptionFunc) error {
+	for _, fn := range opts {
+		if err := fn(p); err != nil {
+			if _, ok := err.(*ScopeError); !ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
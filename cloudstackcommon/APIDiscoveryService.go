@@ -0,0 +1,103 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+type ListApisParams struct {
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListApisParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListApisParams) Command() string {
+	return "listApis"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListApisParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListApisParams) ResponseType() interface{} {
+	return &ListApisResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListApisParams) Validate() error {
+	v := &validator{api: "listApis"}
+	return v.err()
+}
+
+func (p *ListApisParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListApisParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListApisParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListApisParams) ResetName() {
+	p.Name = nil
+}
+
+type ListApisResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Apis  []*Api                     `json:"api" xml:"api"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Api struct {
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Isasync     Bool                       `json:"isasync,omitempty" xml:"isasync,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Params      []ApiParam                 `json:"params,omitempty" xml:"params,omitempty"`
+	Related     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Response    []ApiResponse              `json:"response,omitempty" xml:"response,omitempty"`
+	Since       string                     `json:"since,omitempty" xml:"since,omitempty"`
+	Type        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the APIDiscoveryService that is the same for all versions
+type APIDiscoveryService interface {
+	NewListApisParams() *ListApisParams
+	ListApis(p *ListApisParams) (*ListApisResponse, error)
+	StreamApis(ctx context.Context, p *ListApisParams, fn func(*Api) error) (int, error)
+}
@@ -0,0 +1,51 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListApis(t *testing.T) {
+	params := url.Values{
+		"name": {"test-name"},
+	}
+
+	p := &ListApisParams{}
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listApis", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listApis" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListApisResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listApis", `{"listapisresponse":{"api":[{"description":"test-description","isasync":true,"name":"test-name","params":[{"description":"test-description","length":1,"name":"test-name","related":"test-related","required":true,"since":"test-since","type":"test-type"}],"related":"test-related","response":[{"description":"test-description","name":"test-name","response":["test-response"],"type":"test-type"}],"since":"test-since","type":"test-type"}],"count":1}}`, false, r)
+	if r.Apis[0].Description != "test-description" {
+		t.Errorf("Expected r.Apis[0].Description to be %v, got %v", "test-description", r.Apis[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,541 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type DeleteAccountParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAccountParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAccountParams) Command() string {
+	return "deleteAccount"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAccountParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAccountParams) ResponseType() interface{} {
+	return &DeleteAccountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAccountParams) Validate() error {
+	v := &validator{api: "deleteAccount"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteAccountParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteAccountParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteAccountParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteAccountParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteAccountResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAccountFromProjectParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAccountFromProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAccountFromProjectParams) Command() string {
+	return "deleteAccountFromProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAccountFromProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAccountFromProjectParams) ResponseType() interface{} {
+	return &DeleteAccountFromProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAccountFromProjectParams) Validate() error {
+	v := &validator{api: "deleteAccountFromProject"}
+	v.required("account", p.Account != nil)
+	v.required("projectid", p.Projectid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DeleteAccountFromProjectParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DeleteAccountFromProjectParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DeleteAccountFromProjectParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'projectid' param: the ID of the project. This param is required.
+func (p *DeleteAccountFromProjectParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *DeleteAccountFromProjectParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *DeleteAccountFromProjectParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *DeleteAccountFromProjectParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type DeleteAccountFromProjectResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddAccountToProjectParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Email     *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddAccountToProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Email; v != nil {
+		u.Set("email", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddAccountToProjectParams) Command() string {
+	return "addAccountToProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddAccountToProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddAccountToProjectParams) ResponseType() interface{} {
+	return &AddAccountToProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddAccountToProjectParams) Validate() error {
+	v := &validator{api: "addAccountToProject"}
+	v.required("projectid", p.Projectid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *AddAccountToProjectParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *AddAccountToProjectParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *AddAccountToProjectParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *AddAccountToProjectParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *AddAccountToProjectParams) SetEmail(v string) {
+	p.Email = &v
+	return
+}
+
+func (p *AddAccountToProjectParams) GetEmail() string {
+	if p.Email == nil {
+		return ""
+	}
+	return *p.Email
+}
+
+func (p *AddAccountToProjectParams) HasEmail() bool {
+	return p.Email != nil
+}
+
+func (p *AddAccountToProjectParams) ResetEmail() {
+	p.Email = nil
+}
+
+// Sets the 'projectid' param: the ID of the project. This param is required.
+func (p *AddAccountToProjectParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *AddAccountToProjectParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *AddAccountToProjectParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *AddAccountToProjectParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type AddAccountToProjectResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListProjectAccountsParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Role      *string `json:"role,omitempty" yaml:"role,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListProjectAccountsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Role; v != nil {
+		u.Set("role", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListProjectAccountsParams) Command() string {
+	return "listProjectAccounts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListProjectAccountsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListProjectAccountsParams) ResponseType() interface{} {
+	return &ListProjectAccountsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListProjectAccountsParams) Validate() error {
+	v := &validator{api: "listProjectAccounts"}
+	v.required("projectid", p.Projectid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListProjectAccountsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListProjectAccountsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListProjectAccountsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListProjectAccountsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListProjectAccountsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListProjectAccountsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListProjectAccountsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListProjectAccountsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListProjectAccountsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListProjectAccountsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListProjectAccountsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListProjectAccountsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListProjectAccountsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListProjectAccountsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListProjectAccountsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListProjectAccountsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project. This param is required.
+func (p *ListProjectAccountsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListProjectAccountsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListProjectAccountsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListProjectAccountsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListProjectAccountsParams) SetRole(v string) {
+	p.Role = &v
+	return
+}
+
+func (p *ListProjectAccountsParams) GetRole() string {
+	if p.Role == nil {
+		return ""
+	}
+	return *p.Role
+}
+
+func (p *ListProjectAccountsParams) HasRole() bool {
+	return p.Role != nil
+}
+
+func (p *ListProjectAccountsParams) ResetRole() {
+	p.Role = nil
+}
+
+type ListProjectAccountsResponse struct {
+	Count           int                        `json:"count" xml:"count"`
+	ProjectAccounts []*ProjectAccount          `json:"projectaccount" xml:"projectaccount"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ProjectAccount struct {
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the AccountService that is the same for all versions
+type AccountService interface {
+	NewDeleteAccountParams(id string) *DeleteAccountParams
+	DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams
+	DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams
+	AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams
+	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, error)
+	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	StreamProjectAccounts(ctx context.Context, p *ListProjectAccountsParams, fn func(*ProjectAccount) error) (int, error)
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteAccountParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAccount", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAccount" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAccountResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAccount", `{"deleteaccountresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteAccountFromProject(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"projectid": {"test-projectid"},
+	}
+
+	p := &DeleteAccountFromProjectParams{}
+	p.SetAccount("test-account")
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAccountFromProject", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAccountFromProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAccountFromProjectResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAccountFromProject", `{"deleteaccountfromprojectresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestAddAccountToProject(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"email":     {"test-email"},
+		"projectid": {"test-projectid"},
+	}
+
+	p := &AddAccountToProjectParams{}
+	p.SetAccount("test-account")
+	p.SetEmail("test-email")
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addAccountToProject", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addAccountToProject" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddAccountToProjectResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addAccountToProject", `{"addaccounttoprojectresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListProjectAccounts(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"keyword":   {"test-keyword"},
+		"page":      {"3"},
+		"pagesize":  {"4"},
+		"projectid": {"test-projectid"},
+		"role":      {"test-role"},
+	}
+
+	p := &ListProjectAccountsParams{}
+	p.SetAccount("test-account")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetProjectid("test-projectid")
+	p.SetRole("test-role")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listProjectAccounts", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listProjectAccounts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListProjectAccountsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listProjectAccounts", `{"listprojectaccountsresponse":{"count":1,"projectaccount":[{"account":"test-account","cpuavailable":"test-cpuavailable","cpulimit":"test-cpulimit","cputotal":1,"displaytext":"test-displaytext","domain":"test-domain","domainid":"test-domainid","id":"test-id","ipavailable":"test-ipavailable","iplimit":"test-iplimit","iptotal":1,"memoryavailable":"test-memoryavailable","memorylimit":"test-memorylimit","memorytotal":1,"name":"test-name","networkavailable":"test-networkavailable","networklimit":"test-networklimit","networktotal":1,"primarystorageavailable":"test-primarystorageavailable","primarystoragelimit":"test-primarystoragelimit","primarystoragetotal":1,"secondarystorageavailable":"test-secondarystorageavailable","secondarystoragelimit":"test-secondarystoragelimit","secondarystoragetotal":1,"snapshotavailable":"test-snapshotavailable","snapshotlimit":"test-snapshotlimit","snapshottotal":1,"state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"templateavailable":"test-templateavailable","templatelimit":"test-templatelimit","templatetotal":1,"vmavailable":"test-vmavailable","vmlimit":"test-vmlimit","vmrunning":1,"vmstopped":1,"vmtotal":1,"volumeavailable":"test-volumeavailable","volumelimit":"test-volumelimit","volumetotal":1,"vpcavailable":"test-vpcavailable","vpclimit":"test-vpclimit","vpctotal":1}]}}`, false, r)
+	if r.ProjectAccounts[0].Account != "test-account" {
+		t.Errorf("Expected r.ProjectAccounts[0].Account to be %v, got %v", "test-account", r.ProjectAccounts[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,51 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDisassociateIpAddress(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DisassociateIpAddressParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "disassociateIpAddress", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "disassociateIpAddress" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DisassociateIpAddressResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "disassociateIpAddress", `{"disassociateipaddressresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
@@ -0,0 +1,791 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type CreateAffinityGroupParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Type        *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateAffinityGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateAffinityGroupParams) Command() string {
+	return "createAffinityGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateAffinityGroupParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateAffinityGroupParams) ResponseType() interface{} {
+	return &CreateAffinityGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateAffinityGroupParams) Validate() error {
+	v := &validator{api: "createAffinityGroup"}
+	v.required("name", p.Name != nil)
+	v.required("type", p.Type != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateAffinityGroupParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateAffinityGroupParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateAffinityGroupParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateAffinityGroupParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *CreateAffinityGroupParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateAffinityGroupParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *CreateAffinityGroupParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateAffinityGroupParams) ResetDescription() {
+	p.Description = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateAffinityGroupParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateAffinityGroupParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateAffinityGroupParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateAffinityGroupParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateAffinityGroupParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateAffinityGroupParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateAffinityGroupParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateAffinityGroupParams) ResetName() {
+	p.Name = nil
+}
+
+// This param is required.
+func (p *CreateAffinityGroupParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *CreateAffinityGroupParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *CreateAffinityGroupParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *CreateAffinityGroupParams) ResetType() {
+	p.Type = nil
+}
+
+type CreateAffinityGroupResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description       string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name              string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Type              string                     `json:"type,omitempty" xml:"type,omitempty"`
+	VirtualmachineIds []string                   `json:"virtualmachineIds,omitempty" xml:"virtualmachineIds,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAffinityGroupParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAffinityGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAffinityGroupParams) Command() string {
+	return "deleteAffinityGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAffinityGroupParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAffinityGroupParams) ResponseType() interface{} {
+	return &DeleteAffinityGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAffinityGroupParams) Validate() error {
+	v := &validator{api: "deleteAffinityGroup"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DeleteAffinityGroupParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DeleteAffinityGroupParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DeleteAffinityGroupParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DeleteAffinityGroupParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *DeleteAffinityGroupParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DeleteAffinityGroupParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DeleteAffinityGroupParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DeleteAffinityGroupParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *DeleteAffinityGroupParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteAffinityGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteAffinityGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteAffinityGroupParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *DeleteAffinityGroupParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *DeleteAffinityGroupParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *DeleteAffinityGroupParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *DeleteAffinityGroupParams) ResetName() {
+	p.Name = nil
+}
+
+type DeleteAffinityGroupResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListAffinityGroupsParams struct {
+	Account          *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id               *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive      *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Type             *string `json:"type,omitempty" yaml:"type,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAffinityGroupsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListAffinityGroupsParams) Command() string {
+	return "listAffinityGroups"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListAffinityGroupsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAffinityGroupsParams) ResponseType() interface{} {
+	return &ListAffinityGroupsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAffinityGroupsParams) Validate() error {
+	v := &validator{api: "listAffinityGroups"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListAffinityGroupsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListAffinityGroupsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListAffinityGroupsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListAffinityGroupsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListAffinityGroupsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListAffinityGroupsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListAffinityGroupsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListAffinityGroupsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAffinityGroupsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListAffinityGroupsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListAffinityGroupsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListAffinityGroupsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListAffinityGroupsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListAffinityGroupsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAffinityGroupsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListAffinityGroupsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAffinityGroupsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListAffinityGroupsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListAffinityGroupsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ListAffinityGroupsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetType() {
+	p.Type = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListAffinityGroupsParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *ListAffinityGroupsParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *ListAffinityGroupsParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListAffinityGroupsParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+type ListAffinityGroupsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	AffinityGroups []*AffinityGroup           `json:"affinitygroup" xml:"affinitygroup"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AffinityGroup struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description       string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name              string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Type              string                     `json:"type,omitempty" xml:"type,omitempty"`
+	VirtualmachineIds []string                   `json:"virtualmachineIds,omitempty" xml:"virtualmachineIds,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListAffinityGroupTypesParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAffinityGroupTypesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListAffinityGroupTypesParams) Command() string {
+	return "listAffinityGroupTypes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListAffinityGroupTypesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAffinityGroupTypesParams) ResponseType() interface{} {
+	return &ListAffinityGroupTypesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAffinityGroupTypesParams) Validate() error {
+	v := &validator{api: "listAffinityGroupTypes"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListAffinityGroupTypesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListAffinityGroupTypesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAffinityGroupTypesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAffinityGroupTypesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListAffinityGroupTypesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListAffinityGroupTypesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAffinityGroupTypesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAffinityGroupTypesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListAffinityGroupTypesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListAffinityGroupTypesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAffinityGroupTypesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListAffinityGroupTypesResponse struct {
+	Count              int                        `json:"count" xml:"count"`
+	AffinityGroupTypes []*AffinityGroupType       `json:"affinitygrouptype" xml:"affinitygrouptype"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AffinityGroupType struct {
+	Type  string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the AffinityGroupService that is the same for all versions
+type AffinityGroupService interface {
+	NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams
+	CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams
+	DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	NewListAffinityGroupsParams() *ListAffinityGroupsParams
+	GetAffinityGroupID(name string, opts ...OptionFunc) (string, error)
+	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	StreamAffinityGroups(ctx context.Context, p *ListAffinityGroupsParams, fn func(*AffinityGroup) error) (int, error)
+	NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams
+	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	StreamAffinityGroupTypes(ctx context.Context, p *ListAffinityGroupTypesParams, fn func(*AffinityGroupType) error) (int, error)
+}
@@ -0,0 +1,176 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateAffinityGroup(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"description": {"test-description"},
+		"domainid":    {"test-domainid"},
+		"name":        {"test-name"},
+		"type":        {"test-type"},
+	}
+
+	p := &CreateAffinityGroupParams{}
+	p.SetAccount("test-account")
+	p.SetDescription("test-description")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createAffinityGroup", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createAffinityGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateAffinityGroupResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createAffinityGroup", `{"createaffinitygroupresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteAffinityGroup(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"id":       {"test-id"},
+		"name":     {"test-name"},
+	}
+
+	p := &DeleteAffinityGroupParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAffinityGroup", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAffinityGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAffinityGroupResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAffinityGroup", `{"deleteaffinitygroupresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListAffinityGroups(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"domainid":         {"test-domainid"},
+		"id":               {"test-id"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"name":             {"test-name"},
+		"page":             {"8"},
+		"pagesize":         {"9"},
+		"type":             {"test-type"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+
+	p := &ListAffinityGroupsParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetType("test-type")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listAffinityGroups", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listAffinityGroups" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListAffinityGroupsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listAffinityGroups", `{"listaffinitygroupsresponse":{"affinitygroup":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","type":"test-type","virtualmachineIds":["test-virtualmachineIds"]}],"count":1}}`, false, r)
+	if r.AffinityGroups[0].Account != "test-account" {
+		t.Errorf("Expected r.AffinityGroups[0].Account to be %v, got %v", "test-account", r.AffinityGroups[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestListAffinityGroupTypes(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+
+	p := &ListAffinityGroupTypesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listAffinityGroupTypes", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listAffinityGroupTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListAffinityGroupTypesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listAffinityGroupTypes", `{"listaffinitygrouptypesresponse":{"affinitygrouptype":[{"type":"test-type"}],"count":1}}`, false, r)
+	if r.AffinityGroupTypes[0].Type != "test-type" {
+		t.Errorf("Expected r.AffinityGroupTypes[0].Type to be %v, got %v", "test-type", r.AffinityGroupTypes[0].Type)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
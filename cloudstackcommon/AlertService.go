@@ -0,0 +1,671 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ArchiveAlertsParams struct {
+	Enddate   *string  `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       []string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string  `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string  `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ArchiveAlertsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
+	}
+	if v := p.Ids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("ids", vv)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ArchiveAlertsParams) Command() string {
+	return "archiveAlerts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ArchiveAlertsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ArchiveAlertsParams) ResponseType() interface{} {
+	return &ArchiveAlertsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ArchiveAlertsParams) Validate() error {
+	v := &validator{api: "archiveAlerts"}
+	return v.err()
+}
+
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd.
+func (p *ArchiveAlertsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
+}
+
+func (p *ArchiveAlertsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
+	}
+	return *p.Enddate
+}
+
+func (p *ArchiveAlertsParams) HasEnddate() bool {
+	return p.Enddate != nil
+}
+
+func (p *ArchiveAlertsParams) ResetEnddate() {
+	p.Enddate = nil
+}
+
+// Sets the 'ids' param: the IDs of the resources.
+func (p *ArchiveAlertsParams) SetIds(v []string) {
+	p.Ids = v
+	return
+}
+
+func (p *ArchiveAlertsParams) GetIds() []string {
+	return p.Ids
+}
+
+func (p *ArchiveAlertsParams) HasIds() bool {
+	return p.Ids != nil
+}
+
+func (p *ArchiveAlertsParams) ResetIds() {
+	p.Ids = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd.
+func (p *ArchiveAlertsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *ArchiveAlertsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *ArchiveAlertsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *ArchiveAlertsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+func (p *ArchiveAlertsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ArchiveAlertsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ArchiveAlertsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ArchiveAlertsParams) ResetType() {
+	p.Type = nil
+}
+
+type ArchiveAlertsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAlertsParams struct {
+	Enddate   *string  `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       []string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string  `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string  `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAlertsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
+	}
+	if v := p.Ids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("ids", vv)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAlertsParams) Command() string {
+	return "deleteAlerts"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAlertsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAlertsParams) ResponseType() interface{} {
+	return &DeleteAlertsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAlertsParams) Validate() error {
+	v := &validator{api: "deleteAlerts"}
+	return v.err()
+}
+
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd.
+func (p *DeleteAlertsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
+}
+
+func (p *DeleteAlertsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
+	}
+	return *p.Enddate
+}
+
+func (p *DeleteAlertsParams) HasEnddate() bool {
+	return p.Enddate != nil
+}
+
+func (p *DeleteAlertsParams) ResetEnddate() {
+	p.Enddate = nil
+}
+
+// Sets the 'ids' param: the IDs of the resources.
+func (p *DeleteAlertsParams) SetIds(v []string) {
+	p.Ids = v
+	return
+}
+
+func (p *DeleteAlertsParams) GetIds() []string {
+	return p.Ids
+}
+
+func (p *DeleteAlertsParams) HasIds() bool {
+	return p.Ids != nil
+}
+
+func (p *DeleteAlertsParams) ResetIds() {
+	p.Ids = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd.
+func (p *DeleteAlertsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *DeleteAlertsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *DeleteAlertsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *DeleteAlertsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+func (p *DeleteAlertsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *DeleteAlertsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *DeleteAlertsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *DeleteAlertsParams) ResetType() {
+	p.Type = nil
+}
+
+type DeleteAlertsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type GenerateAlertParams struct {
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Podid       *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Type        *int    `json:"type,omitempty" yaml:"type,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *GenerateAlertParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Type; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("type", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *GenerateAlertParams) Command() string {
+	return "generateAlert"
+}
+
+// Returns true if the API command starts an async job
+func (p *GenerateAlertParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *GenerateAlertParams) ResponseType() interface{} {
+	return &GenerateAlertResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *GenerateAlertParams) Validate() error {
+	v := &validator{api: "generateAlert"}
+	v.required("description", p.Description != nil)
+	v.required("name", p.Name != nil)
+	v.required("type", p.Type != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *GenerateAlertParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *GenerateAlertParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *GenerateAlertParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *GenerateAlertParams) ResetDescription() {
+	p.Description = nil
+}
+
+// This param is required.
+func (p *GenerateAlertParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *GenerateAlertParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *GenerateAlertParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *GenerateAlertParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *GenerateAlertParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *GenerateAlertParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *GenerateAlertParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *GenerateAlertParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// This param is required.
+func (p *GenerateAlertParams) SetType(v int) {
+	p.Type = &v
+	return
+}
+
+func (p *GenerateAlertParams) GetType() int {
+	if p.Type == nil {
+		return 0
+	}
+	return *p.Type
+}
+
+func (p *GenerateAlertParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *GenerateAlertParams) ResetType() {
+	p.Type = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *GenerateAlertParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *GenerateAlertParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *GenerateAlertParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *GenerateAlertParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type GenerateAlertResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListAlertsParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Type     *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAlertsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListAlertsParams) Command() string {
+	return "listAlerts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListAlertsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAlertsParams) ResponseType() interface{} {
+	return &ListAlertsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAlertsParams) Validate() error {
+	v := &validator{api: "listAlerts"}
+	return v.err()
+}
+
+func (p *ListAlertsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListAlertsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListAlertsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListAlertsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAlertsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListAlertsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListAlertsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAlertsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListAlertsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListAlertsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListAlertsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListAlertsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAlertsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListAlertsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListAlertsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAlertsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAlertsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListAlertsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListAlertsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAlertsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListAlertsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ListAlertsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ListAlertsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListAlertsParams) ResetType() {
+	p.Type = nil
+}
+
+type ListAlertsResponse struct {
+	Count  int                        `json:"count" xml:"count"`
+	Alerts []*Alert                   `json:"alert" xml:"alert"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Alert struct {
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Sent        string                     `json:"sent,omitempty" xml:"sent,omitempty"`
+	Type        int                        `json:"type,omitempty" xml:"type,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the AlertService that is the same for all versions
+type AlertService interface {
+	NewArchiveAlertsParams() *ArchiveAlertsParams
+	ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	NewDeleteAlertsParams() *DeleteAlertsParams
+	DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams
+	GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	NewListAlertsParams() *ListAlertsParams
+	GetAlertID(name string, opts ...OptionFunc) (string, error)
+	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error)
+	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+	StreamAlerts(ctx context.Context, p *ListAlertsParams, fn func(*Alert) error) (int, error)
+}
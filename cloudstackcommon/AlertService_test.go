@@ -0,0 +1,162 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestArchiveAlerts(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+
+	p := &ArchiveAlertsParams{}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "archiveAlerts", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "archiveAlerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ArchiveAlertsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "archiveAlerts", `{"archivealertsresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestDeleteAlerts(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+
+	p := &DeleteAlertsParams{}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAlerts", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAlerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAlertsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAlerts", `{"deletealertsresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestGenerateAlert(t *testing.T) {
+	params := url.Values{
+		"description": {"test-description"},
+		"name":        {"test-name"},
+		"podid":       {"test-podid"},
+		"type":        {"4"},
+		"zoneid":      {"test-zoneid"},
+	}
+
+	p := &GenerateAlertParams{}
+	p.SetDescription("test-description")
+	p.SetName("test-name")
+	p.SetPodid("test-podid")
+	p.SetType(4)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "generateAlert", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "generateAlert" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*GenerateAlertResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "generateAlert", `{"generatealertresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListAlerts(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"type":     {"test-type"},
+	}
+
+	p := &ListAlertsParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listAlerts", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listAlerts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListAlertsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listAlerts", `{"listalertsresponse":{"alert":[{"description":"test-description","id":"test-id","name":"test-name","sent":"test-sent","type":1}],"count":1}}`, false, r)
+	if r.Alerts[0].Description != "test-description" {
+		t.Errorf("Expected r.Alerts[0].Description to be %v, got %v", "test-description", r.Alerts[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
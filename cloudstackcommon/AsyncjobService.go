@@ -0,0 +1,361 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListAsyncJobsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Startdate   *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAsyncJobsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListAsyncJobsParams) Command() string {
+	return "listAsyncJobs"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListAsyncJobsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAsyncJobsParams) ResponseType() interface{} {
+	return &ListAsyncJobsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAsyncJobsParams) Validate() error {
+	v := &validator{api: "listAsyncJobs"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListAsyncJobsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListAsyncJobsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListAsyncJobsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListAsyncJobsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListAsyncJobsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListAsyncJobsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListAsyncJobsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListAsyncJobsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListAsyncJobsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAsyncJobsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListAsyncJobsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAsyncJobsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListAsyncJobsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListAsyncJobsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListAsyncJobsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAsyncJobsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListAsyncJobsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAsyncJobsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAsyncJobsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListAsyncJobsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAsyncJobsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd.
+func (p *ListAsyncJobsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *ListAsyncJobsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *ListAsyncJobsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *ListAsyncJobsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+type ListAsyncJobsResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	AsyncJobs []*AsyncJob                `json:"asyncjob" xml:"asyncjob"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AsyncJob struct {
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Cmd             string                     `json:"cmd,omitempty" xml:"cmd,omitempty"`
+	Created         Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Jobinstanceid   string                     `json:"jobinstanceid,omitempty" xml:"jobinstanceid,omitempty"`
+	Jobinstancetype string                     `json:"jobinstancetype,omitempty" xml:"jobinstancetype,omitempty"`
+	Jobprocstatus   int                        `json:"jobprocstatus,omitempty" xml:"jobprocstatus,omitempty"`
+	Jobresult       json.RawMessage            `json:"jobresult,omitempty" xml:"jobresult,omitempty"`
+	Jobresultcode   int                        `json:"jobresultcode,omitempty" xml:"jobresultcode,omitempty"`
+	Jobresulttype   string                     `json:"jobresulttype,omitempty" xml:"jobresulttype,omitempty"`
+	Jobstatus       int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Userid          string                     `json:"userid,omitempty" xml:"userid,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type QueryAsyncJobResultParams struct {
+	Jobid *string `json:"jobid,omitempty" yaml:"jobid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *QueryAsyncJobResultParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Jobid; v != nil {
+		u.Set("jobid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *QueryAsyncJobResultParams) Command() string {
+	return "queryAsyncJobResult"
+}
+
+// Returns true if the API command starts an async job
+func (p *QueryAsyncJobResultParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *QueryAsyncJobResultParams) ResponseType() interface{} {
+	return &QueryAsyncJobResultResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *QueryAsyncJobResultParams) Validate() error {
+	v := &validator{api: "queryAsyncJobResult"}
+	v.required("jobid", p.Jobid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *QueryAsyncJobResultParams) SetJobid(v string) {
+	p.Jobid = &v
+	return
+}
+
+func (p *QueryAsyncJobResultParams) GetJobid() string {
+	if p.Jobid == nil {
+		return ""
+	}
+	return *p.Jobid
+}
+
+func (p *QueryAsyncJobResultParams) HasJobid() bool {
+	return p.Jobid != nil
+}
+
+func (p *QueryAsyncJobResultParams) ResetJobid() {
+	p.Jobid = nil
+}
+
+type QueryAsyncJobResultResponse struct {
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Cmd             string                     `json:"cmd,omitempty" xml:"cmd,omitempty"`
+	Created         Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Jobinstanceid   string                     `json:"jobinstanceid,omitempty" xml:"jobinstanceid,omitempty"`
+	Jobinstancetype string                     `json:"jobinstancetype,omitempty" xml:"jobinstancetype,omitempty"`
+	Jobprocstatus   int                        `json:"jobprocstatus,omitempty" xml:"jobprocstatus,omitempty"`
+	Jobresult       json.RawMessage            `json:"jobresult,omitempty" xml:"jobresult,omitempty"`
+	Jobresultcode   int                        `json:"jobresultcode,omitempty" xml:"jobresultcode,omitempty"`
+	Jobresulttype   string                     `json:"jobresulttype,omitempty" xml:"jobresulttype,omitempty"`
+	Jobstatus       int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Userid          string                     `json:"userid,omitempty" xml:"userid,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the AsyncjobService that is the same for all versions
+type AsyncjobService interface {
+	NewListAsyncJobsParams() *ListAsyncJobsParams
+	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	StreamAsyncJobs(ctx context.Context, p *ListAsyncJobsParams, fn func(*AsyncJob) error) (int, error)
+	NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams
+	QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+}
@@ -0,0 +1,91 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListAsyncJobs(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"6"},
+		"pagesize":    {"7"},
+		"startdate":   {"test-startdate"},
+	}
+
+	p := &ListAsyncJobsParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetStartdate("test-startdate")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listAsyncJobs", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listAsyncJobs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListAsyncJobsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listAsyncJobs", `{"listasyncjobsresponse":{"asyncjob":[{"accountid":"test-accountid","cmd":"test-cmd","created":"2014-01-02T15:04:05+0100","jobinstanceid":"test-jobinstanceid","jobinstancetype":"test-jobinstancetype","jobprocstatus":1,"jobresult":{},"jobresultcode":1,"jobresulttype":"test-jobresulttype","jobstatus":1,"userid":"test-userid"}],"count":1}}`, false, r)
+	if r.AsyncJobs[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.AsyncJobs[0].Accountid to be %v, got %v", "test-accountid", r.AsyncJobs[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestQueryAsyncJobResult(t *testing.T) {
+	params := url.Values{
+		"jobid": {"test-jobid"},
+	}
+
+	p := &QueryAsyncJobResultParams{}
+	p.SetJobid("test-jobid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "queryAsyncJobResult", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "queryAsyncJobResult" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*QueryAsyncJobResultResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "queryAsyncJobResult", `{"queryasyncjobresultresponse":{"accountid":"test-accountid","cmd":"test-cmd","created":"2014-01-02T15:04:05+0100","jobinstanceid":"test-jobinstanceid","jobinstancetype":"test-jobinstancetype","jobprocstatus":1,"jobresult":{},"jobresultcode":1,"jobresulttype":"test-jobresulttype","jobstatus":1,"userid":"test-userid"}}`, false, r)
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+}
@@ -0,0 +1,1790 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type CreateAutoScalePolicyParams struct {
+	Action       *string  `json:"action,omitempty" yaml:"action,omitempty"`
+	Conditionids []string `json:"conditionids,omitempty" yaml:"conditionids,omitempty"`
+	Duration     *int     `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Quiettime    *int     `json:"quiettime,omitempty" yaml:"quiettime,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateAutoScalePolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Action; v != nil {
+		u.Set("action", *v)
+	}
+	if v := p.Conditionids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("conditionids", vv)
+	}
+	if v := p.Duration; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("duration", vv)
+	}
+	if v := p.Quiettime; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("quiettime", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateAutoScalePolicyParams) Command() string {
+	return "createAutoScalePolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateAutoScalePolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateAutoScalePolicyParams) ResponseType() interface{} {
+	return &CreateAutoScalePolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateAutoScalePolicyParams) Validate() error {
+	v := &validator{api: "createAutoScalePolicy"}
+	v.required("action", p.Action != nil)
+	v.required("conditionids", p.Conditionids != nil)
+	v.required("duration", p.Duration != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CreateAutoScalePolicyParams) SetAction(v string) {
+	p.Action = &v
+	return
+}
+
+func (p *CreateAutoScalePolicyParams) GetAction() string {
+	if p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+func (p *CreateAutoScalePolicyParams) HasAction() bool {
+	return p.Action != nil
+}
+
+func (p *CreateAutoScalePolicyParams) ResetAction() {
+	p.Action = nil
+}
+
+// This param is required.
+func (p *CreateAutoScalePolicyParams) SetConditionids(v []string) {
+	p.Conditionids = v
+	return
+}
+
+func (p *CreateAutoScalePolicyParams) GetConditionids() []string {
+	return p.Conditionids
+}
+
+func (p *CreateAutoScalePolicyParams) HasConditionids() bool {
+	return p.Conditionids != nil
+}
+
+func (p *CreateAutoScalePolicyParams) ResetConditionids() {
+	p.Conditionids = nil
+}
+
+// This param is required.
+func (p *CreateAutoScalePolicyParams) SetDuration(v int) {
+	p.Duration = &v
+	return
+}
+
+func (p *CreateAutoScalePolicyParams) GetDuration() int {
+	if p.Duration == nil {
+		return 0
+	}
+	return *p.Duration
+}
+
+func (p *CreateAutoScalePolicyParams) HasDuration() bool {
+	return p.Duration != nil
+}
+
+func (p *CreateAutoScalePolicyParams) ResetDuration() {
+	p.Duration = nil
+}
+
+func (p *CreateAutoScalePolicyParams) SetQuiettime(v int) {
+	p.Quiettime = &v
+	return
+}
+
+func (p *CreateAutoScalePolicyParams) GetQuiettime() int {
+	if p.Quiettime == nil {
+		return 0
+	}
+	return *p.Quiettime
+}
+
+func (p *CreateAutoScalePolicyParams) HasQuiettime() bool {
+	return p.Quiettime != nil
+}
+
+func (p *CreateAutoScalePolicyParams) ResetQuiettime() {
+	p.Quiettime = nil
+}
+
+type CreateAutoScalePolicyResponse struct {
+	JobID      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Action     string                     `json:"action,omitempty" xml:"action,omitempty"`
+	Conditions []string                   `json:"conditions,omitempty" xml:"conditions,omitempty"`
+	Domain     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Duration   int                        `json:"duration,omitempty" xml:"duration,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiettime  int                        `json:"quiettime,omitempty" xml:"quiettime,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAutoScalePolicyParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAutoScalePolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAutoScalePolicyParams) Command() string {
+	return "deleteAutoScalePolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAutoScalePolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAutoScalePolicyParams) ResponseType() interface{} {
+	return &DeleteAutoScalePolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAutoScalePolicyParams) Validate() error {
+	v := &validator{api: "deleteAutoScalePolicy"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteAutoScalePolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteAutoScalePolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteAutoScalePolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteAutoScalePolicyParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteAutoScalePolicyResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListAutoScalePoliciesParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Action      *string `json:"action,omitempty" yaml:"action,omitempty"`
+	Conditionid *string `json:"conditionid,omitempty" yaml:"conditionid,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Vmgroupid   *string `json:"vmgroupid,omitempty" yaml:"vmgroupid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListAutoScalePoliciesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Action; v != nil {
+		u.Set("action", *v)
+	}
+	if v := p.Conditionid; v != nil {
+		u.Set("conditionid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Vmgroupid; v != nil {
+		u.Set("vmgroupid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListAutoScalePoliciesParams) Command() string {
+	return "listAutoScalePolicies"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListAutoScalePoliciesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListAutoScalePoliciesParams) ResponseType() interface{} {
+	return &ListAutoScalePoliciesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListAutoScalePoliciesParams) Validate() error {
+	v := &validator{api: "listAutoScalePolicies"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListAutoScalePoliciesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListAutoScalePoliciesParams) SetAction(v string) {
+	p.Action = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetAction() string {
+	if p.Action == nil {
+		return ""
+	}
+	return *p.Action
+}
+
+func (p *ListAutoScalePoliciesParams) HasAction() bool {
+	return p.Action != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetAction() {
+	p.Action = nil
+}
+
+func (p *ListAutoScalePoliciesParams) SetConditionid(v string) {
+	p.Conditionid = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetConditionid() string {
+	if p.Conditionid == nil {
+		return ""
+	}
+	return *p.Conditionid
+}
+
+func (p *ListAutoScalePoliciesParams) HasConditionid() bool {
+	return p.Conditionid != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetConditionid() {
+	p.Conditionid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListAutoScalePoliciesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListAutoScalePoliciesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListAutoScalePoliciesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListAutoScalePoliciesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListAutoScalePoliciesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListAutoScalePoliciesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListAutoScalePoliciesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListAutoScalePoliciesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListAutoScalePoliciesParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListAutoScalePoliciesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListAutoScalePoliciesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListAutoScalePoliciesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListAutoScalePoliciesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListAutoScalePoliciesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListAutoScalePoliciesParams) SetVmgroupid(v string) {
+	p.Vmgroupid = &v
+	return
+}
+
+func (p *ListAutoScalePoliciesParams) GetVmgroupid() string {
+	if p.Vmgroupid == nil {
+		return ""
+	}
+	return *p.Vmgroupid
+}
+
+func (p *ListAutoScalePoliciesParams) HasVmgroupid() bool {
+	return p.Vmgroupid != nil
+}
+
+func (p *ListAutoScalePoliciesParams) ResetVmgroupid() {
+	p.Vmgroupid = nil
+}
+
+type ListAutoScalePoliciesResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	AutoScalePolicies []*AutoScalePolicy         `json:"autoscalepolicy" xml:"autoscalepolicy"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AutoScalePolicy struct {
+	Account    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Action     string                     `json:"action,omitempty" xml:"action,omitempty"`
+	Conditions []string                   `json:"conditions,omitempty" xml:"conditions,omitempty"`
+	Domain     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Duration   int                        `json:"duration,omitempty" xml:"duration,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiettime  int                        `json:"quiettime,omitempty" xml:"quiettime,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateAutoScalePolicyParams struct {
+	Conditionids []string `json:"conditionids,omitempty" yaml:"conditionids,omitempty"`
+	Duration     *int     `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Id           *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Quiettime    *int     `json:"quiettime,omitempty" yaml:"quiettime,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateAutoScalePolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Conditionids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("conditionids", vv)
+	}
+	if v := p.Duration; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("duration", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Quiettime; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("quiettime", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateAutoScalePolicyParams) Command() string {
+	return "updateAutoScalePolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateAutoScalePolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateAutoScalePolicyParams) ResponseType() interface{} {
+	return &UpdateAutoScalePolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateAutoScalePolicyParams) Validate() error {
+	v := &validator{api: "updateAutoScalePolicy"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
+	p.Conditionids = v
+	return
+}
+
+func (p *UpdateAutoScalePolicyParams) GetConditionids() []string {
+	return p.Conditionids
+}
+
+func (p *UpdateAutoScalePolicyParams) HasConditionids() bool {
+	return p.Conditionids != nil
+}
+
+func (p *UpdateAutoScalePolicyParams) ResetConditionids() {
+	p.Conditionids = nil
+}
+
+func (p *UpdateAutoScalePolicyParams) SetDuration(v int) {
+	p.Duration = &v
+	return
+}
+
+func (p *UpdateAutoScalePolicyParams) GetDuration() int {
+	if p.Duration == nil {
+		return 0
+	}
+	return *p.Duration
+}
+
+func (p *UpdateAutoScalePolicyParams) HasDuration() bool {
+	return p.Duration != nil
+}
+
+func (p *UpdateAutoScalePolicyParams) ResetDuration() {
+	p.Duration = nil
+}
+
+// This param is required.
+func (p *UpdateAutoScalePolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateAutoScalePolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateAutoScalePolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateAutoScalePolicyParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateAutoScalePolicyParams) SetQuiettime(v int) {
+	p.Quiettime = &v
+	return
+}
+
+func (p *UpdateAutoScalePolicyParams) GetQuiettime() int {
+	if p.Quiettime == nil {
+		return 0
+	}
+	return *p.Quiettime
+}
+
+func (p *UpdateAutoScalePolicyParams) HasQuiettime() bool {
+	return p.Quiettime != nil
+}
+
+func (p *UpdateAutoScalePolicyParams) ResetQuiettime() {
+	p.Quiettime = nil
+}
+
+type UpdateAutoScalePolicyResponse struct {
+	JobID      string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account    string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Action     string                     `json:"action,omitempty" xml:"action,omitempty"`
+	Conditions []string                   `json:"conditions,omitempty" xml:"conditions,omitempty"`
+	Domain     string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Duration   int                        `json:"duration,omitempty" xml:"duration,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project    string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid  string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Quiettime  int                        `json:"quiettime,omitempty" xml:"quiettime,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAutoScaleVmGroupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAutoScaleVmGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAutoScaleVmGroupParams) Command() string {
+	return "deleteAutoScaleVmGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAutoScaleVmGroupParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAutoScaleVmGroupParams) ResponseType() interface{} {
+	return &DeleteAutoScaleVmGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAutoScaleVmGroupParams) Validate() error {
+	v := &validator{api: "deleteAutoScaleVmGroup"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteAutoScaleVmGroupParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteAutoScaleVmGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteAutoScaleVmGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteAutoScaleVmGroupParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteAutoScaleVmGroupResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteAutoScaleVmProfileParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteAutoScaleVmProfileParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteAutoScaleVmProfileParams) Command() string {
+	return "deleteAutoScaleVmProfile"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteAutoScaleVmProfileParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteAutoScaleVmProfileParams) ResponseType() interface{} {
+	return &DeleteAutoScaleVmProfileResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteAutoScaleVmProfileParams) Validate() error {
+	v := &validator{api: "deleteAutoScaleVmProfile"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteAutoScaleVmProfileParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteAutoScaleVmProfileParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteAutoScaleVmProfileParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteAutoScaleVmProfileResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateConditionParams struct {
+	Account            *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Counterid          *string `json:"counterid,omitempty" yaml:"counterid,omitempty"`
+	Domainid           *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Relationaloperator *string `json:"relationaloperator,omitempty" yaml:"relationaloperator,omitempty"`
+	Threshold          *int64  `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateConditionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Counterid; v != nil {
+		u.Set("counterid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Relationaloperator; v != nil {
+		u.Set("relationaloperator", *v)
+	}
+	if v := p.Threshold; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("threshold", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateConditionParams) Command() string {
+	return "createCondition"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateConditionParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateConditionParams) ResponseType() interface{} {
+	return &CreateConditionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateConditionParams) Validate() error {
+	v := &validator{api: "createCondition"}
+	v.required("counterid", p.Counterid != nil)
+	v.required("relationaloperator", p.Relationaloperator != nil)
+	v.required("threshold", p.Threshold != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateConditionParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateConditionParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateConditionParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateConditionParams) ResetAccount() {
+	p.Account = nil
+}
+
+// This param is required.
+func (p *CreateConditionParams) SetCounterid(v string) {
+	p.Counterid = &v
+	return
+}
+
+func (p *CreateConditionParams) GetCounterid() string {
+	if p.Counterid == nil {
+		return ""
+	}
+	return *p.Counterid
+}
+
+func (p *CreateConditionParams) HasCounterid() bool {
+	return p.Counterid != nil
+}
+
+func (p *CreateConditionParams) ResetCounterid() {
+	p.Counterid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateConditionParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateConditionParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateConditionParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateConditionParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateConditionParams) SetRelationaloperator(v string) {
+	p.Relationaloperator = &v
+	return
+}
+
+func (p *CreateConditionParams) GetRelationaloperator() string {
+	if p.Relationaloperator == nil {
+		return ""
+	}
+	return *p.Relationaloperator
+}
+
+func (p *CreateConditionParams) HasRelationaloperator() bool {
+	return p.Relationaloperator != nil
+}
+
+func (p *CreateConditionParams) ResetRelationaloperator() {
+	p.Relationaloperator = nil
+}
+
+// This param is required.
+func (p *CreateConditionParams) SetThreshold(v int64) {
+	p.Threshold = &v
+	return
+}
+
+func (p *CreateConditionParams) GetThreshold() int64 {
+	if p.Threshold == nil {
+		return 0
+	}
+	return *p.Threshold
+}
+
+func (p *CreateConditionParams) HasThreshold() bool {
+	return p.Threshold != nil
+}
+
+func (p *CreateConditionParams) ResetThreshold() {
+	p.Threshold = nil
+}
+
+type CreateConditionResponse struct {
+	JobID              string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account            string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Counter            []string                   `json:"counter,omitempty" xml:"counter,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project            string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid          string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Relationaloperator string                     `json:"relationaloperator,omitempty" xml:"relationaloperator,omitempty"`
+	Threshold          int64                      `json:"threshold,omitempty" xml:"threshold,omitempty"`
+	Zoneid             string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteConditionParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteConditionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteConditionParams) Command() string {
+	return "deleteCondition"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteConditionParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteConditionParams) ResponseType() interface{} {
+	return &DeleteConditionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteConditionParams) Validate() error {
+	v := &validator{api: "deleteCondition"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteConditionParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteConditionParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteConditionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteConditionParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteConditionResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListConditionsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Counterid   *string `json:"counterid,omitempty" yaml:"counterid,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Policyid    *string `json:"policyid,omitempty" yaml:"policyid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListConditionsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Counterid; v != nil {
+		u.Set("counterid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Policyid; v != nil {
+		u.Set("policyid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListConditionsParams) Command() string {
+	return "listConditions"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListConditionsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListConditionsParams) ResponseType() interface{} {
+	return &ListConditionsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListConditionsParams) Validate() error {
+	v := &validator{api: "listConditions"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListConditionsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListConditionsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListConditionsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListConditionsParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListConditionsParams) SetCounterid(v string) {
+	p.Counterid = &v
+	return
+}
+
+func (p *ListConditionsParams) GetCounterid() string {
+	if p.Counterid == nil {
+		return ""
+	}
+	return *p.Counterid
+}
+
+func (p *ListConditionsParams) HasCounterid() bool {
+	return p.Counterid != nil
+}
+
+func (p *ListConditionsParams) ResetCounterid() {
+	p.Counterid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListConditionsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListConditionsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListConditionsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListConditionsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListConditionsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListConditionsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListConditionsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListConditionsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListConditionsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListConditionsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListConditionsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListConditionsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListConditionsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListConditionsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListConditionsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListConditionsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListConditionsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListConditionsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListConditionsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListConditionsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListConditionsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListConditionsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListConditionsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListConditionsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListConditionsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListConditionsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListConditionsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListConditionsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListConditionsParams) SetPolicyid(v string) {
+	p.Policyid = &v
+	return
+}
+
+func (p *ListConditionsParams) GetPolicyid() string {
+	if p.Policyid == nil {
+		return ""
+	}
+	return *p.Policyid
+}
+
+func (p *ListConditionsParams) HasPolicyid() bool {
+	return p.Policyid != nil
+}
+
+func (p *ListConditionsParams) ResetPolicyid() {
+	p.Policyid = nil
+}
+
+type ListConditionsResponse struct {
+	Count      int                        `json:"count" xml:"count"`
+	Conditions []*Condition               `json:"condition" xml:"condition"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Condition struct {
+	Account            string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Counter            []string                   `json:"counter,omitempty" xml:"counter,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project            string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid          string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Relationaloperator string                     `json:"relationaloperator,omitempty" xml:"relationaloperator,omitempty"`
+	Threshold          int64                      `json:"threshold,omitempty" xml:"threshold,omitempty"`
+	Zoneid             string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateCounterParams struct {
+	Name   *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Source *string `json:"source,omitempty" yaml:"source,omitempty"`
+	Value  *string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateCounterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Source; v != nil {
+		u.Set("source", *v)
+	}
+	if v := p.Value; v != nil {
+		u.Set("value", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateCounterParams) Command() string {
+	return "createCounter"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateCounterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateCounterParams) ResponseType() interface{} {
+	return &CreateCounterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateCounterParams) Validate() error {
+	v := &validator{api: "createCounter"}
+	v.required("name", p.Name != nil)
+	v.required("source", p.Source != nil)
+	v.required("value", p.Value != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CreateCounterParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateCounterParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateCounterParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateCounterParams) ResetName() {
+	p.Name = nil
+}
+
+// This param is required.
+func (p *CreateCounterParams) SetSource(v string) {
+	p.Source = &v
+	return
+}
+
+func (p *CreateCounterParams) GetSource() string {
+	if p.Source == nil {
+		return ""
+	}
+	return *p.Source
+}
+
+func (p *CreateCounterParams) HasSource() bool {
+	return p.Source != nil
+}
+
+func (p *CreateCounterParams) ResetSource() {
+	p.Source = nil
+}
+
+// This param is required.
+func (p *CreateCounterParams) SetValue(v string) {
+	p.Value = &v
+	return
+}
+
+func (p *CreateCounterParams) GetValue() string {
+	if p.Value == nil {
+		return ""
+	}
+	return *p.Value
+}
+
+func (p *CreateCounterParams) HasValue() bool {
+	return p.Value != nil
+}
+
+func (p *CreateCounterParams) ResetValue() {
+	p.Value = nil
+}
+
+type CreateCounterResponse struct {
+	JobID  string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Id     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Source string                     `json:"source,omitempty" xml:"source,omitempty"`
+	Value  string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Zoneid string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteCounterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteCounterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteCounterParams) Command() string {
+	return "deleteCounter"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteCounterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteCounterParams) ResponseType() interface{} {
+	return &DeleteCounterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteCounterParams) Validate() error {
+	v := &validator{api: "deleteCounter"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteCounterParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteCounterParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteCounterParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteCounterParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteCounterResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListCountersParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Source   *string `json:"source,omitempty" yaml:"source,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListCountersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Source; v != nil {
+		u.Set("source", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListCountersParams) Command() string {
+	return "listCounters"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListCountersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListCountersParams) ResponseType() interface{} {
+	return &ListCountersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListCountersParams) Validate() error {
+	v := &validator{api: "listCounters"}
+	return v.err()
+}
+
+func (p *ListCountersParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListCountersParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListCountersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListCountersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListCountersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListCountersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListCountersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListCountersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListCountersParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListCountersParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListCountersParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListCountersParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListCountersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListCountersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListCountersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListCountersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListCountersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListCountersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListCountersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListCountersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListCountersParams) SetSource(v string) {
+	p.Source = &v
+	return
+}
+
+func (p *ListCountersParams) GetSource() string {
+	if p.Source == nil {
+		return ""
+	}
+	return *p.Source
+}
+
+func (p *ListCountersParams) HasSource() bool {
+	return p.Source != nil
+}
+
+func (p *ListCountersParams) ResetSource() {
+	p.Source = nil
+}
+
+type ListCountersResponse struct {
+	Count    int                        `json:"count" xml:"count"`
+	Counters []*Counter                 `json:"counter" xml:"counter"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Counter struct {
+	Id     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Source string                     `json:"source,omitempty" xml:"source,omitempty"`
+	Value  string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Zoneid string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the AutoScaleService that is the same for all versions
+type AutoScaleService interface {
+	NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams
+	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams
+	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams
+	GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	StreamAutoScalePolicies(ctx context.Context, p *ListAutoScalePoliciesParams, fn func(*AutoScalePolicy) error) (int, error)
+	NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams
+	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams
+	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams
+	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams
+	CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error)
+	NewDeleteConditionParams(id string) *DeleteConditionParams
+	DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	NewListConditionsParams() *ListConditionsParams
+	GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error)
+	ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error)
+	StreamConditions(ctx context.Context, p *ListConditionsParams, fn func(*Condition) error) (int, error)
+	NewCreateCounterParams(name string, source string, value string) *CreateCounterParams
+	CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error)
+	NewDeleteCounterParams(id string) *DeleteCounterParams
+	DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	NewListCountersParams() *ListCountersParams
+	GetCounterID(name string, opts ...OptionFunc) (string, error)
+	GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error)
+	ListCounters(p *ListCountersParams) (*ListCountersResponse, error)
+	StreamCounters(ctx context.Context, p *ListCountersParams, fn func(*Counter) error) (int, error)
+}
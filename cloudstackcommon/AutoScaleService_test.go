@@ -0,0 +1,442 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateAutoScalePolicy(t *testing.T) {
+	params := url.Values{
+		"action":       {"test-action"},
+		"conditionids": {"test-conditionids1, test-conditionids2"},
+		"duration":     {"3"},
+		"quiettime":    {"4"},
+	}
+
+	p := &CreateAutoScalePolicyParams{}
+	p.SetAction("test-action")
+	p.SetConditionids([]string{"test-conditionids1", "test-conditionids2"})
+	p.SetDuration(3)
+	p.SetQuiettime(4)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createAutoScalePolicy", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createAutoScalePolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateAutoScalePolicyResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createAutoScalePolicy", `{"createautoscalepolicyresponse":{"account":"test-account","action":"test-action","conditions":["test-conditions"],"domain":"test-domain","domainid":"test-domainid","duration":1,"id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","quiettime":1}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteAutoScalePolicy(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteAutoScalePolicyParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAutoScalePolicy", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAutoScalePolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAutoScalePolicyResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAutoScalePolicy", `{"deleteautoscalepolicyresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListAutoScalePolicies(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"action":      {"test-action"},
+		"conditionid": {"test-conditionid"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"9"},
+		"pagesize":    {"10"},
+		"vmgroupid":   {"test-vmgroupid"},
+	}
+
+	p := &ListAutoScalePoliciesParams{}
+	p.SetAccount("test-account")
+	p.SetAction("test-action")
+	p.SetConditionid("test-conditionid")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetVmgroupid("test-vmgroupid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listAutoScalePolicies", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listAutoScalePolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListAutoScalePoliciesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listAutoScalePolicies", `{"listautoscalepoliciesresponse":{"autoscalepolicy":[{"account":"test-account","action":"test-action","conditions":["test-conditions"],"domain":"test-domain","domainid":"test-domainid","duration":1,"id":"test-id","project":"test-project","projectid":"test-projectid","quiettime":1}],"count":1}}`, false, r)
+	if r.AutoScalePolicies[0].Account != "test-account" {
+		t.Errorf("Expected r.AutoScalePolicies[0].Account to be %v, got %v", "test-account", r.AutoScalePolicies[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateAutoScalePolicy(t *testing.T) {
+	params := url.Values{
+		"conditionids": {"test-conditionids1, test-conditionids2"},
+		"duration":     {"2"},
+		"id":           {"test-id"},
+		"quiettime":    {"4"},
+	}
+
+	p := &UpdateAutoScalePolicyParams{}
+	p.SetConditionids([]string{"test-conditionids1", "test-conditionids2"})
+	p.SetDuration(2)
+	p.SetId("test-id")
+	p.SetQuiettime(4)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateAutoScalePolicy", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateAutoScalePolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateAutoScalePolicyResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateAutoScalePolicy", `{"updateautoscalepolicyresponse":{"account":"test-account","action":"test-action","conditions":["test-conditions"],"domain":"test-domain","domainid":"test-domainid","duration":1,"id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","quiettime":1}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteAutoScaleVmGroup(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteAutoScaleVmGroupParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAutoScaleVmGroup", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAutoScaleVmGroup" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAutoScaleVmGroupResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAutoScaleVmGroup", `{"deleteautoscalevmgroupresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteAutoScaleVmProfile(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteAutoScaleVmProfileParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteAutoScaleVmProfile", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteAutoScaleVmProfile" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteAutoScaleVmProfileResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteAutoScaleVmProfile", `{"deleteautoscalevmprofileresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestCreateCondition(t *testing.T) {
+	params := url.Values{
+		"account":            {"test-account"},
+		"counterid":          {"test-counterid"},
+		"domainid":           {"test-domainid"},
+		"relationaloperator": {"test-relationaloperator"},
+		"threshold":          {"5"},
+	}
+
+	p := &CreateConditionParams{}
+	p.SetAccount("test-account")
+	p.SetCounterid("test-counterid")
+	p.SetDomainid("test-domainid")
+	p.SetRelationaloperator("test-relationaloperator")
+	p.SetThreshold(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createCondition", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createCondition" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateConditionResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createCondition", `{"createconditionresponse":{"account":"test-account","counter":["test-counter"],"domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","relationaloperator":"test-relationaloperator","threshold":1,"zoneid":"test-zoneid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteCondition(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteConditionParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteCondition", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCondition" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteConditionResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteCondition", `{"deleteconditionresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListConditions(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"counterid":   {"test-counterid"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"policyid":    {"test-policyid"},
+	}
+
+	p := &ListConditionsParams{}
+	p.SetAccount("test-account")
+	p.SetCounterid("test-counterid")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetPolicyid("test-policyid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listConditions", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listConditions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListConditionsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listConditions", `{"listconditionsresponse":{"condition":[{"account":"test-account","counter":["test-counter"],"domain":"test-domain","domainid":"test-domainid","id":"test-id","project":"test-project","projectid":"test-projectid","relationaloperator":"test-relationaloperator","threshold":1,"zoneid":"test-zoneid"}],"count":1}}`, false, r)
+	if r.Conditions[0].Account != "test-account" {
+		t.Errorf("Expected r.Conditions[0].Account to be %v, got %v", "test-account", r.Conditions[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestCreateCounter(t *testing.T) {
+	params := url.Values{
+		"name":   {"test-name"},
+		"source": {"test-source"},
+		"value":  {"test-value"},
+	}
+
+	p := &CreateCounterParams{}
+	p.SetName("test-name")
+	p.SetSource("test-source")
+	p.SetValue("test-value")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createCounter", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createCounter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateCounterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createCounter", `{"createcounterresponse":{"id":"test-id","jobid":"test-jobid","name":"test-name","source":"test-source","value":"test-value","zoneid":"test-zoneid"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteCounter(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteCounterParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteCounter", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCounter" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteCounterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteCounter", `{"deletecounterresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListCounters(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"source":   {"test-source"},
+	}
+
+	p := &ListCountersParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetSource("test-source")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listCounters", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listCounters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListCountersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listCounters", `{"listcountersresponse":{"count":1,"counter":[{"id":"test-id","name":"test-name","source":"test-source","value":"test-value","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.Counters[0].Id != "test-id" {
+		t.Errorf("Expected r.Counters[0].Id to be %v, got %v", "test-id", r.Counters[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,1068 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddBaremetalDhcpParams struct {
+	Dhcpservertype    *string `json:"dhcpservertype,omitempty" yaml:"dhcpservertype,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddBaremetalDhcpParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Dhcpservertype; v != nil {
+		u.Set("dhcpservertype", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddBaremetalDhcpParams) Command() string {
+	return "addBaremetalDhcp"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddBaremetalDhcpParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddBaremetalDhcpParams) ResponseType() interface{} {
+	return &AddBaremetalDhcpResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddBaremetalDhcpParams) Validate() error {
+	v := &validator{api: "addBaremetalDhcp"}
+	v.required("dhcpservertype", p.Dhcpservertype != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
+	p.Dhcpservertype = &v
+	return
+}
+
+func (p *AddBaremetalDhcpParams) GetDhcpservertype() string {
+	if p.Dhcpservertype == nil {
+		return ""
+	}
+	return *p.Dhcpservertype
+}
+
+func (p *AddBaremetalDhcpParams) HasDhcpservertype() bool {
+	return p.Dhcpservertype != nil
+}
+
+func (p *AddBaremetalDhcpParams) ResetDhcpservertype() {
+	p.Dhcpservertype = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddBaremetalDhcpParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddBaremetalDhcpParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddBaremetalDhcpParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddBaremetalDhcpParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddBaremetalDhcpParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddBaremetalDhcpParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddBaremetalDhcpParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddBaremetalDhcpParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddBaremetalDhcpParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddBaremetalDhcpParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddBaremetalDhcpParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddBaremetalDhcpParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddBaremetalDhcpParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddBaremetalDhcpParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddBaremetalDhcpParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddBaremetalDhcpParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddBaremetalDhcpResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Dhcpservertype    string                     `json:"dhcpservertype,omitempty" xml:"dhcpservertype,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Url               string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListBaremetalDhcpParams struct {
+	Dhcpservertype *string `json:"dhcpservertype,omitempty" yaml:"dhcpservertype,omitempty"`
+	Id             *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword        *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page           *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListBaremetalDhcpParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Dhcpservertype; v != nil {
+		u.Set("dhcpservertype", *v)
+	}
+	if v := p.Id; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("id", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListBaremetalDhcpParams) Command() string {
+	return "listBaremetalDhcp"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListBaremetalDhcpParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListBaremetalDhcpParams) ResponseType() interface{} {
+	return &ListBaremetalDhcpResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListBaremetalDhcpParams) Validate() error {
+	v := &validator{api: "listBaremetalDhcp"}
+	return v.err()
+}
+
+func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
+	p.Dhcpservertype = &v
+	return
+}
+
+func (p *ListBaremetalDhcpParams) GetDhcpservertype() string {
+	if p.Dhcpservertype == nil {
+		return ""
+	}
+	return *p.Dhcpservertype
+}
+
+func (p *ListBaremetalDhcpParams) HasDhcpservertype() bool {
+	return p.Dhcpservertype != nil
+}
+
+func (p *ListBaremetalDhcpParams) ResetDhcpservertype() {
+	p.Dhcpservertype = nil
+}
+
+func (p *ListBaremetalDhcpParams) SetId(v int64) {
+	p.Id = &v
+	return
+}
+
+func (p *ListBaremetalDhcpParams) GetId() int64 {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *ListBaremetalDhcpParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListBaremetalDhcpParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListBaremetalDhcpParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListBaremetalDhcpParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListBaremetalDhcpParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListBaremetalDhcpParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListBaremetalDhcpParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListBaremetalDhcpParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListBaremetalDhcpParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListBaremetalDhcpParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListBaremetalDhcpParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListBaremetalDhcpParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListBaremetalDhcpParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListBaremetalDhcpParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListBaremetalDhcpResponse struct {
+	Count         int                        `json:"count" xml:"count"`
+	BaremetalDhcp []*BaremetalDhcp           `json:"baremetaldhcp" xml:"baremetaldhcp"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type BaremetalDhcp struct {
+	Dhcpservertype    string                     `json:"dhcpservertype,omitempty" xml:"dhcpservertype,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Url               string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddBaremetalPxeKickStartServerParams struct {
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Pxeservertype     *string `json:"pxeservertype,omitempty" yaml:"pxeservertype,omitempty"`
+	Tftpdir           *string `json:"tftpdir,omitempty" yaml:"tftpdir,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddBaremetalPxeKickStartServerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Pxeservertype; v != nil {
+		u.Set("pxeservertype", *v)
+	}
+	if v := p.Tftpdir; v != nil {
+		u.Set("tftpdir", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddBaremetalPxeKickStartServerParams) Command() string {
+	return "addBaremetalPxeKickStartServer"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddBaremetalPxeKickStartServerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddBaremetalPxeKickStartServerParams) ResponseType() interface{} {
+	return &AddBaremetalPxeKickStartServerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddBaremetalPxeKickStartServerParams) Validate() error {
+	v := &validator{api: "addBaremetalPxeKickStartServer"}
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("pxeservertype", p.Pxeservertype != nil)
+	v.required("tftpdir", p.Tftpdir != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *AddBaremetalPxeKickStartServerParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetPxeservertype(v string) {
+	p.Pxeservertype = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetPxeservertype() string {
+	if p.Pxeservertype == nil {
+		return ""
+	}
+	return *p.Pxeservertype
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasPxeservertype() bool {
+	return p.Pxeservertype != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetPxeservertype() {
+	p.Pxeservertype = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetTftpdir(v string) {
+	p.Tftpdir = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetTftpdir() string {
+	if p.Tftpdir == nil {
+		return ""
+	}
+	return *p.Tftpdir
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasTftpdir() bool {
+	return p.Tftpdir != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetTftpdir() {
+	p.Tftpdir = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddBaremetalPxeKickStartServerParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddBaremetalPxeKickStartServerParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddBaremetalPxeKickStartServerResponse struct {
+	JobID   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Tftpdir string                     `json:"tftpdir,omitempty" xml:"tftpdir,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddBaremetalPxePingServerParams struct {
+	Password            *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid   *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Pingcifspassword    *string `json:"pingcifspassword,omitempty" yaml:"pingcifspassword,omitempty"`
+	Pingcifsusername    *string `json:"pingcifsusername,omitempty" yaml:"pingcifsusername,omitempty"`
+	Pingdir             *string `json:"pingdir,omitempty" yaml:"pingdir,omitempty"`
+	Pingstorageserverip *string `json:"pingstorageserverip,omitempty" yaml:"pingstorageserverip,omitempty"`
+	Podid               *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Pxeservertype       *string `json:"pxeservertype,omitempty" yaml:"pxeservertype,omitempty"`
+	Tftpdir             *string `json:"tftpdir,omitempty" yaml:"tftpdir,omitempty"`
+	Url                 *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username            *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddBaremetalPxePingServerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Pingcifspassword; v != nil {
+		u.Set("pingcifspassword", *v)
+	}
+	if v := p.Pingcifsusername; v != nil {
+		u.Set("pingcifsusername", *v)
+	}
+	if v := p.Pingdir; v != nil {
+		u.Set("pingdir", *v)
+	}
+	if v := p.Pingstorageserverip; v != nil {
+		u.Set("pingstorageserverip", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Pxeservertype; v != nil {
+		u.Set("pxeservertype", *v)
+	}
+	if v := p.Tftpdir; v != nil {
+		u.Set("tftpdir", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddBaremetalPxePingServerParams) Command() string {
+	return "addBaremetalPxePingServer"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddBaremetalPxePingServerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddBaremetalPxePingServerParams) ResponseType() interface{} {
+	return &AddBaremetalPxePingServerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddBaremetalPxePingServerParams) Validate() error {
+	v := &validator{api: "addBaremetalPxePingServer"}
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("pingdir", p.Pingdir != nil)
+	v.required("pingstorageserverip", p.Pingstorageserverip != nil)
+	v.required("pxeservertype", p.Pxeservertype != nil)
+	v.required("tftpdir", p.Tftpdir != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddBaremetalPxePingServerParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+func (p *AddBaremetalPxePingServerParams) SetPingcifspassword(v string) {
+	p.Pingcifspassword = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingcifspassword() string {
+	if p.Pingcifspassword == nil {
+		return ""
+	}
+	return *p.Pingcifspassword
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPingcifspassword() bool {
+	return p.Pingcifspassword != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPingcifspassword() {
+	p.Pingcifspassword = nil
+}
+
+func (p *AddBaremetalPxePingServerParams) SetPingcifsusername(v string) {
+	p.Pingcifsusername = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingcifsusername() string {
+	if p.Pingcifsusername == nil {
+		return ""
+	}
+	return *p.Pingcifsusername
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPingcifsusername() bool {
+	return p.Pingcifsusername != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPingcifsusername() {
+	p.Pingcifsusername = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxePingServerParams) SetPingdir(v string) {
+	p.Pingdir = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingdir() string {
+	if p.Pingdir == nil {
+		return ""
+	}
+	return *p.Pingdir
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPingdir() bool {
+	return p.Pingdir != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPingdir() {
+	p.Pingdir = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxePingServerParams) SetPingstorageserverip(v string) {
+	p.Pingstorageserverip = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPingstorageserverip() string {
+	if p.Pingstorageserverip == nil {
+		return ""
+	}
+	return *p.Pingstorageserverip
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPingstorageserverip() bool {
+	return p.Pingstorageserverip != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPingstorageserverip() {
+	p.Pingstorageserverip = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *AddBaremetalPxePingServerParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxePingServerParams) SetPxeservertype(v string) {
+	p.Pxeservertype = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetPxeservertype() string {
+	if p.Pxeservertype == nil {
+		return ""
+	}
+	return *p.Pxeservertype
+}
+
+func (p *AddBaremetalPxePingServerParams) HasPxeservertype() bool {
+	return p.Pxeservertype != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetPxeservertype() {
+	p.Pxeservertype = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxePingServerParams) SetTftpdir(v string) {
+	p.Tftpdir = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetTftpdir() string {
+	if p.Tftpdir == nil {
+		return ""
+	}
+	return *p.Tftpdir
+}
+
+func (p *AddBaremetalPxePingServerParams) HasTftpdir() bool {
+	return p.Tftpdir != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetTftpdir() {
+	p.Tftpdir = nil
+}
+
+// This param is required.
+func (p *AddBaremetalPxePingServerParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddBaremetalPxePingServerParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddBaremetalPxePingServerParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddBaremetalPxePingServerParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddBaremetalPxePingServerParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddBaremetalPxePingServerParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddBaremetalPxePingServerResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Pingdir             string                     `json:"pingdir,omitempty" xml:"pingdir,omitempty"`
+	Pingstorageserverip string                     `json:"pingstorageserverip,omitempty" xml:"pingstorageserverip,omitempty"`
+	Tftpdir             string                     `json:"tftpdir,omitempty" xml:"tftpdir,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListBaremetalPxeServersParams struct {
+	Id       *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListBaremetalPxeServersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("id", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListBaremetalPxeServersParams) Command() string {
+	return "listBaremetalPxeServers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListBaremetalPxeServersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListBaremetalPxeServersParams) ResponseType() interface{} {
+	return &ListBaremetalPxeServersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListBaremetalPxeServersParams) Validate() error {
+	v := &validator{api: "listBaremetalPxeServers"}
+	return v.err()
+}
+
+func (p *ListBaremetalPxeServersParams) SetId(v int64) {
+	p.Id = &v
+	return
+}
+
+func (p *ListBaremetalPxeServersParams) GetId() int64 {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *ListBaremetalPxeServersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListBaremetalPxeServersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListBaremetalPxeServersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListBaremetalPxeServersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListBaremetalPxeServersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListBaremetalPxeServersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListBaremetalPxeServersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListBaremetalPxeServersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListBaremetalPxeServersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListBaremetalPxeServersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListBaremetalPxeServersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListBaremetalPxeServersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListBaremetalPxeServersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListBaremetalPxeServersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListBaremetalPxeServersResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	BaremetalPxeServers []*BaremetalPxeServer      `json:"baremetalpxeserver" xml:"baremetalpxeserver"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type BaremetalPxeServer struct {
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Url               string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the BaremetalService that is the same for all versions
+type BaremetalService interface {
+	NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams
+	AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	NewListBaremetalDhcpParams() *ListBaremetalDhcpParams
+	ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	StreamBaremetalDhcp(ctx context.Context, p *ListBaremetalDhcpParams, fn func(*BaremetalDhcp) error) (int, error)
+	NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams
+	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams
+	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	NewListBaremetalPxeServersParams() *ListBaremetalPxeServersParams
+	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	StreamBaremetalPxeServers(ctx context.Context, p *ListBaremetalPxeServersParams, fn func(*BaremetalPxeServer) error) (int, error)
+}
@@ -0,0 +1,221 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddBaremetalDhcp(t *testing.T) {
+	params := url.Values{
+		"dhcpservertype":    {"test-dhcpservertype"},
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+
+	p := &AddBaremetalDhcpParams{}
+	p.SetDhcpservertype("test-dhcpservertype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addBaremetalDhcp", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalDhcp" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddBaremetalDhcpResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addBaremetalDhcp", `{"addbaremetaldhcpresponse":{"dhcpservertype":"test-dhcpservertype","id":"test-id","jobid":"test-jobid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","url":"test-url"}}`, false, r)
+	if r.Dhcpservertype != "test-dhcpservertype" {
+		t.Errorf("Expected r.Dhcpservertype to be %v, got %v", "test-dhcpservertype", r.Dhcpservertype)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListBaremetalDhcp(t *testing.T) {
+	params := url.Values{
+		"dhcpservertype": {"test-dhcpservertype"},
+		"id":             {"2"},
+		"keyword":        {"test-keyword"},
+		"page":           {"4"},
+		"pagesize":       {"5"},
+	}
+
+	p := &ListBaremetalDhcpParams{}
+	p.SetDhcpservertype("test-dhcpservertype")
+	p.SetId(2)
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listBaremetalDhcp", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listBaremetalDhcp" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListBaremetalDhcpResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listBaremetalDhcp", `{"listbaremetaldhcpresponse":{"baremetaldhcp":[{"dhcpservertype":"test-dhcpservertype","id":"test-id","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","url":"test-url"}],"count":1}}`, false, r)
+	if r.BaremetalDhcp[0].Dhcpservertype != "test-dhcpservertype" {
+		t.Errorf("Expected r.BaremetalDhcp[0].Dhcpservertype to be %v, got %v", "test-dhcpservertype", r.BaremetalDhcp[0].Dhcpservertype)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestAddBaremetalPxeKickStartServer(t *testing.T) {
+	params := url.Values{
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"podid":             {"test-podid"},
+		"pxeservertype":     {"test-pxeservertype"},
+		"tftpdir":           {"test-tftpdir"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+
+	p := &AddBaremetalPxeKickStartServerParams{}
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPodid("test-podid")
+	p.SetPxeservertype("test-pxeservertype")
+	p.SetTftpdir("test-tftpdir")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addBaremetalPxeKickStartServer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalPxeKickStartServer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddBaremetalPxeKickStartServerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addBaremetalPxeKickStartServer", `{"addbaremetalpxekickstartserverresponse":{"jobid":"test-jobid","tftpdir":"test-tftpdir"}}`, false, r)
+	if r.Tftpdir != "test-tftpdir" {
+		t.Errorf("Expected r.Tftpdir to be %v, got %v", "test-tftpdir", r.Tftpdir)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestAddBaremetalPxePingServer(t *testing.T) {
+	params := url.Values{
+		"password":            {"test-password"},
+		"physicalnetworkid":   {"test-physicalnetworkid"},
+		"pingcifspassword":    {"test-pingcifspassword"},
+		"pingcifsusername":    {"test-pingcifsusername"},
+		"pingdir":             {"test-pingdir"},
+		"pingstorageserverip": {"test-pingstorageserverip"},
+		"podid":               {"test-podid"},
+		"pxeservertype":       {"test-pxeservertype"},
+		"tftpdir":             {"test-tftpdir"},
+		"url":                 {"test-url"},
+		"username":            {"test-username"},
+	}
+
+	p := &AddBaremetalPxePingServerParams{}
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPingcifspassword("test-pingcifspassword")
+	p.SetPingcifsusername("test-pingcifsusername")
+	p.SetPingdir("test-pingdir")
+	p.SetPingstorageserverip("test-pingstorageserverip")
+	p.SetPodid("test-podid")
+	p.SetPxeservertype("test-pxeservertype")
+	p.SetTftpdir("test-tftpdir")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addBaremetalPxePingServer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addBaremetalPxePingServer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddBaremetalPxePingServerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addBaremetalPxePingServer", `{"addbaremetalpxepingserverresponse":{"jobid":"test-jobid","pingdir":"test-pingdir","pingstorageserverip":"test-pingstorageserverip","tftpdir":"test-tftpdir"}}`, false, r)
+	if r.Pingdir != "test-pingdir" {
+		t.Errorf("Expected r.Pingdir to be %v, got %v", "test-pingdir", r.Pingdir)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListBaremetalPxeServers(t *testing.T) {
+	params := url.Values{
+		"id":       {"1"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+	}
+
+	p := &ListBaremetalPxeServersParams{}
+	p.SetId(1)
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listBaremetalPxeServers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listBaremetalPxeServers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListBaremetalPxeServersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listBaremetalPxeServers", `{"listbaremetalpxeserversresponse":{"baremetalpxeserver":[{"id":"test-id","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","url":"test-url"}],"count":1}}`, false, r)
+	if r.BaremetalPxeServers[0].Id != "test-id" {
+		t.Errorf("Expected r.BaremetalPxeServers[0].Id to be %v, got %v", "test-id", r.BaremetalPxeServers[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,364 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddBigSwitchVnsDeviceParams struct {
+	Hostname          *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddBigSwitchVnsDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddBigSwitchVnsDeviceParams) Command() string {
+	return "addBigSwitchVnsDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddBigSwitchVnsDeviceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddBigSwitchVnsDeviceParams) ResponseType() interface{} {
+	return &AddBigSwitchVnsDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddBigSwitchVnsDeviceParams) Validate() error {
+	v := &validator{api: "addBigSwitchVnsDevice"}
+	v.required("hostname", p.Hostname != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddBigSwitchVnsDeviceParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *AddBigSwitchVnsDeviceParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *AddBigSwitchVnsDeviceParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *AddBigSwitchVnsDeviceParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddBigSwitchVnsDeviceParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddBigSwitchVnsDeviceParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddBigSwitchVnsDeviceParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddBigSwitchVnsDeviceParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+type AddBigSwitchVnsDeviceResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Bigswitchdevicename string                     `json:"bigswitchdevicename,omitempty" xml:"bigswitchdevicename,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider            string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Vnsdeviceid         string                     `json:"vnsdeviceid,omitempty" xml:"vnsdeviceid,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteBigSwitchVnsDeviceParams struct {
+	Vnsdeviceid *string `json:"vnsdeviceid,omitempty" yaml:"vnsdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteBigSwitchVnsDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Vnsdeviceid; v != nil {
+		u.Set("vnsdeviceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteBigSwitchVnsDeviceParams) Command() string {
+	return "deleteBigSwitchVnsDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteBigSwitchVnsDeviceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteBigSwitchVnsDeviceParams) ResponseType() interface{} {
+	return &DeleteBigSwitchVnsDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteBigSwitchVnsDeviceParams) Validate() error {
+	v := &validator{api: "deleteBigSwitchVnsDevice"}
+	v.required("vnsdeviceid", p.Vnsdeviceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteBigSwitchVnsDeviceParams) SetVnsdeviceid(v string) {
+	p.Vnsdeviceid = &v
+	return
+}
+
+func (p *DeleteBigSwitchVnsDeviceParams) GetVnsdeviceid() string {
+	if p.Vnsdeviceid == nil {
+		return ""
+	}
+	return *p.Vnsdeviceid
+}
+
+func (p *DeleteBigSwitchVnsDeviceParams) HasVnsdeviceid() bool {
+	return p.Vnsdeviceid != nil
+}
+
+func (p *DeleteBigSwitchVnsDeviceParams) ResetVnsdeviceid() {
+	p.Vnsdeviceid = nil
+}
+
+type DeleteBigSwitchVnsDeviceResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListBigSwitchVnsDevicesParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Vnsdeviceid       *string `json:"vnsdeviceid,omitempty" yaml:"vnsdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListBigSwitchVnsDevicesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Vnsdeviceid; v != nil {
+		u.Set("vnsdeviceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListBigSwitchVnsDevicesParams) Command() string {
+	return "listBigSwitchVnsDevices"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListBigSwitchVnsDevicesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListBigSwitchVnsDevicesParams) ResponseType() interface{} {
+	return &ListBigSwitchVnsDevicesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListBigSwitchVnsDevicesParams) Validate() error {
+	v := &validator{api: "listBigSwitchVnsDevices"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListBigSwitchVnsDevicesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListBigSwitchVnsDevicesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListBigSwitchVnsDevicesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListBigSwitchVnsDevicesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListBigSwitchVnsDevicesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListBigSwitchVnsDevicesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListBigSwitchVnsDevicesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListBigSwitchVnsDevicesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListBigSwitchVnsDevicesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListBigSwitchVnsDevicesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListBigSwitchVnsDevicesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListBigSwitchVnsDevicesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListBigSwitchVnsDevicesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListBigSwitchVnsDevicesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListBigSwitchVnsDevicesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListBigSwitchVnsDevicesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+func (p *ListBigSwitchVnsDevicesParams) SetVnsdeviceid(v string) {
+	p.Vnsdeviceid = &v
+	return
+}
+
+func (p *ListBigSwitchVnsDevicesParams) GetVnsdeviceid() string {
+	if p.Vnsdeviceid == nil {
+		return ""
+	}
+	return *p.Vnsdeviceid
+}
+
+func (p *ListBigSwitchVnsDevicesParams) HasVnsdeviceid() bool {
+	return p.Vnsdeviceid != nil
+}
+
+func (p *ListBigSwitchVnsDevicesParams) ResetVnsdeviceid() {
+	p.Vnsdeviceid = nil
+}
+
+type ListBigSwitchVnsDevicesResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	BigSwitchVnsDevices []*BigSwitchVnsDevice      `json:"bigswitchvnsdevice" xml:"bigswitchvnsdevice"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type BigSwitchVnsDevice struct {
+	Bigswitchdevicename string                     `json:"bigswitchdevicename,omitempty" xml:"bigswitchdevicename,omitempty"`
+	Hostname            string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider            string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Vnsdeviceid         string                     `json:"vnsdeviceid,omitempty" xml:"vnsdeviceid,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the BigSwitchVNSService that is the same for all versions
+type BigSwitchVNSService interface {
+	NewAddBigSwitchVnsDeviceParams(hostname string, physicalnetworkid string) *AddBigSwitchVnsDeviceParams
+	AddBigSwitchVnsDevice(p *AddBigSwitchVnsDeviceParams) (*AddBigSwitchVnsDeviceResponse, error)
+	NewDeleteBigSwitchVnsDeviceParams(vnsdeviceid string) *DeleteBigSwitchVnsDeviceParams
+	DeleteBigSwitchVnsDevice(p *DeleteBigSwitchVnsDeviceParams) (*DeleteBigSwitchVnsDeviceResponse, error)
+	NewListBigSwitchVnsDevicesParams() *ListBigSwitchVnsDevicesParams
+	ListBigSwitchVnsDevices(p *ListBigSwitchVnsDevicesParams) (*ListBigSwitchVnsDevicesResponse, error)
+	StreamBigSwitchVnsDevices(ctx context.Context, p *ListBigSwitchVnsDevicesParams, fn func(*BigSwitchVnsDevice) error) (int, error)
+}
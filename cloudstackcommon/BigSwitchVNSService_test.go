@@ -0,0 +1,119 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddBigSwitchVnsDevice(t *testing.T) {
+	params := url.Values{
+		"hostname":          {"test-hostname"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+
+	p := &AddBigSwitchVnsDeviceParams{}
+	p.SetHostname("test-hostname")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addBigSwitchVnsDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addBigSwitchVnsDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddBigSwitchVnsDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addBigSwitchVnsDevice", `{"addbigswitchvnsdeviceresponse":{"bigswitchdevicename":"test-bigswitchdevicename","hostname":"test-hostname","jobid":"test-jobid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","vnsdeviceid":"test-vnsdeviceid"}}`, false, r)
+	if r.Bigswitchdevicename != "test-bigswitchdevicename" {
+		t.Errorf("Expected r.Bigswitchdevicename to be %v, got %v", "test-bigswitchdevicename", r.Bigswitchdevicename)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteBigSwitchVnsDevice(t *testing.T) {
+	params := url.Values{
+		"vnsdeviceid": {"test-vnsdeviceid"},
+	}
+
+	p := &DeleteBigSwitchVnsDeviceParams{}
+	p.SetVnsdeviceid("test-vnsdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteBigSwitchVnsDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteBigSwitchVnsDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteBigSwitchVnsDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteBigSwitchVnsDevice", `{"deletebigswitchvnsdeviceresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListBigSwitchVnsDevices(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"page":              {"2"},
+		"pagesize":          {"3"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"vnsdeviceid":       {"test-vnsdeviceid"},
+	}
+
+	p := &ListBigSwitchVnsDevicesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetVnsdeviceid("test-vnsdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listBigSwitchVnsDevices", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listBigSwitchVnsDevices" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListBigSwitchVnsDevicesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listBigSwitchVnsDevices", `{"listbigswitchvnsdevicesresponse":{"bigswitchvnsdevice":[{"bigswitchdevicename":"test-bigswitchdevicename","hostname":"test-hostname","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","vnsdeviceid":"test-vnsdeviceid"}],"count":1}}`, false, r)
+	if r.BigSwitchVnsDevices[0].Bigswitchdevicename != "test-bigswitchdevicename" {
+		t.Errorf("Expected r.BigSwitchVnsDevices[0].Bigswitchdevicename to be %v, got %v", "test-bigswitchdevicename", r.BigSwitchVnsDevices[0].Bigswitchdevicename)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,191 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type UploadCustomCertificateParams struct {
+	Certificate  *string `json:"certificate,omitempty" yaml:"certificate,omitempty"`
+	Domainsuffix *string `json:"domainsuffix,omitempty" yaml:"domainsuffix,omitempty"`
+	Id           *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name         *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Privatekey   *string `json:"privatekey,omitempty" yaml:"privatekey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UploadCustomCertificateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Certificate; v != nil {
+		u.Set("certificate", *v)
+	}
+	if v := p.Domainsuffix; v != nil {
+		u.Set("domainsuffix", *v)
+	}
+	if v := p.Id; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("id", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Privatekey; v != nil {
+		u.Set("privatekey", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UploadCustomCertificateParams) Command() string {
+	return "uploadCustomCertificate"
+}
+
+// Returns true if the API command starts an async job
+func (p *UploadCustomCertificateParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UploadCustomCertificateParams) ResponseType() interface{} {
+	return &UploadCustomCertificateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UploadCustomCertificateParams) Validate() error {
+	v := &validator{api: "uploadCustomCertificate"}
+	v.required("certificate", p.Certificate != nil)
+	v.required("domainsuffix", p.Domainsuffix != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UploadCustomCertificateParams) SetCertificate(v string) {
+	p.Certificate = &v
+	return
+}
+
+func (p *UploadCustomCertificateParams) GetCertificate() string {
+	if p.Certificate == nil {
+		return ""
+	}
+	return *p.Certificate
+}
+
+func (p *UploadCustomCertificateParams) HasCertificate() bool {
+	return p.Certificate != nil
+}
+
+func (p *UploadCustomCertificateParams) ResetCertificate() {
+	p.Certificate = nil
+}
+
+// This param is required.
+func (p *UploadCustomCertificateParams) SetDomainsuffix(v string) {
+	p.Domainsuffix = &v
+	return
+}
+
+func (p *UploadCustomCertificateParams) GetDomainsuffix() string {
+	if p.Domainsuffix == nil {
+		return ""
+	}
+	return *p.Domainsuffix
+}
+
+func (p *UploadCustomCertificateParams) HasDomainsuffix() bool {
+	return p.Domainsuffix != nil
+}
+
+func (p *UploadCustomCertificateParams) ResetDomainsuffix() {
+	p.Domainsuffix = nil
+}
+
+func (p *UploadCustomCertificateParams) SetId(v int) {
+	p.Id = &v
+	return
+}
+
+func (p *UploadCustomCertificateParams) GetId() int {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *UploadCustomCertificateParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UploadCustomCertificateParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UploadCustomCertificateParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UploadCustomCertificateParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UploadCustomCertificateParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UploadCustomCertificateParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *UploadCustomCertificateParams) SetPrivatekey(v string) {
+	p.Privatekey = &v
+	return
+}
+
+func (p *UploadCustomCertificateParams) GetPrivatekey() string {
+	if p.Privatekey == nil {
+		return ""
+	}
+	return *p.Privatekey
+}
+
+func (p *UploadCustomCertificateParams) HasPrivatekey() bool {
+	return p.Privatekey != nil
+}
+
+func (p *UploadCustomCertificateParams) ResetPrivatekey() {
+	p.Privatekey = nil
+}
+
+type UploadCustomCertificateResponse struct {
+	JobID   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Message string                     `json:"message,omitempty" xml:"message,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the CertificateService that is the same for all versions
+type CertificateService interface {
+	NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams
+	UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+}
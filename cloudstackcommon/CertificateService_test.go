@@ -0,0 +1,59 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestUploadCustomCertificate(t *testing.T) {
+	params := url.Values{
+		"certificate":  {"test-certificate"},
+		"domainsuffix": {"test-domainsuffix"},
+		"id":           {"3"},
+		"name":         {"test-name"},
+		"privatekey":   {"test-privatekey"},
+	}
+
+	p := &UploadCustomCertificateParams{}
+	p.SetCertificate("test-certificate")
+	p.SetDomainsuffix("test-domainsuffix")
+	p.SetId(3)
+	p.SetName("test-name")
+	p.SetPrivatekey("test-privatekey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "uploadCustomCertificate", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "uploadCustomCertificate" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UploadCustomCertificateResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "uploadCustomCertificate", `{"uploadcustomcertificateresponse":{"jobid":"test-jobid","message":"test-message"}}`, false, r)
+	if r.Message != "test-message" {
+		t.Errorf("Expected r.Message to be %v, got %v", "test-message", r.Message)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
@@ -0,0 +1,92 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type GetCloudIdentifierParams struct {
+	Userid *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *GetCloudIdentifierParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Userid; v != nil {
+		u.Set("userid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *GetCloudIdentifierParams) Command() string {
+	return "getCloudIdentifier"
+}
+
+// Returns true if the API command starts an async job
+func (p *GetCloudIdentifierParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *GetCloudIdentifierParams) ResponseType() interface{} {
+	return &GetCloudIdentifierResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *GetCloudIdentifierParams) Validate() error {
+	v := &validator{api: "getCloudIdentifier"}
+	v.required("userid", p.Userid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *GetCloudIdentifierParams) SetUserid(v string) {
+	p.Userid = &v
+	return
+}
+
+func (p *GetCloudIdentifierParams) GetUserid() string {
+	if p.Userid == nil {
+		return ""
+	}
+	return *p.Userid
+}
+
+func (p *GetCloudIdentifierParams) HasUserid() bool {
+	return p.Userid != nil
+}
+
+func (p *GetCloudIdentifierParams) ResetUserid() {
+	p.Userid = nil
+}
+
+type GetCloudIdentifierResponse struct {
+	Cloudidentifier string                     `json:"cloudidentifier,omitempty" xml:"cloudidentifier,omitempty"`
+	Signature       string                     `json:"signature,omitempty" xml:"signature,omitempty"`
+	Userid          string                     `json:"userid,omitempty" xml:"userid,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the CloudIdentifierService that is the same for all versions
+type CloudIdentifierService interface {
+	NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams
+	GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+}
@@ -0,0 +1,48 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetCloudIdentifier(t *testing.T) {
+	params := url.Values{
+		"userid": {"test-userid"},
+	}
+
+	p := &GetCloudIdentifierParams{}
+	p.SetUserid("test-userid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "getCloudIdentifier", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "getCloudIdentifier" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*GetCloudIdentifierResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "getCloudIdentifier", `{"getcloudidentifierresponse":{"cloudidentifier":"test-cloudidentifier","signature":"test-signature","userid":"test-userid"}}`, false, r)
+	if r.Cloudidentifier != "test-cloudidentifier" {
+		t.Errorf("Expected r.Cloudidentifier to be %v, got %v", "test-cloudidentifier", r.Cloudidentifier)
+	}
+}
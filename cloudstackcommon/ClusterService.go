@@ -0,0 +1,1518 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddClusterParams struct {
+	Allocationstate   *string         `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustername       *string         `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Clustertype       *string         `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Guestvswitchname  *string         `json:"guestvswitchname,omitempty" yaml:"guestvswitchname,omitempty"`
+	Guestvswitchtype  *string         `json:"guestvswitchtype,omitempty" yaml:"guestvswitchtype,omitempty"`
+	Hypervisor        *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Password          *string         `json:"password,omitempty" yaml:"password,omitempty"`
+	Podid             *string         `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Publicvswitchname *string         `json:"publicvswitchname,omitempty" yaml:"publicvswitchname,omitempty"`
+	Publicvswitchtype *string         `json:"publicvswitchtype,omitempty" yaml:"publicvswitchtype,omitempty"`
+	Url               *string         `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string         `json:"username,omitempty" yaml:"username,omitempty"`
+	Vsmipaddress      *string         `json:"vsmipaddress,omitempty" yaml:"vsmipaddress,omitempty"`
+	Vsmpassword       *string         `json:"vsmpassword,omitempty" yaml:"vsmpassword,omitempty"`
+	Vsmusername       *string         `json:"vsmusername,omitempty" yaml:"vsmusername,omitempty"`
+	Zoneid            *string         `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddClusterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Clustername; v != nil {
+		u.Set("clustername", *v)
+	}
+	if v := p.Clustertype; v != nil {
+		u.Set("clustertype", *v)
+	}
+	if v := p.Guestvswitchname; v != nil {
+		u.Set("guestvswitchname", *v)
+	}
+	if v := p.Guestvswitchtype; v != nil {
+		u.Set("guestvswitchtype", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Publicvswitchname; v != nil {
+		u.Set("publicvswitchname", *v)
+	}
+	if v := p.Publicvswitchtype; v != nil {
+		u.Set("publicvswitchtype", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Vsmipaddress; v != nil {
+		u.Set("vsmipaddress", *v)
+	}
+	if v := p.Vsmpassword; v != nil {
+		u.Set("vsmpassword", *v)
+	}
+	if v := p.Vsmusername; v != nil {
+		u.Set("vsmusername", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddClusterParams) Command() string {
+	return "addCluster"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddClusterParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddClusterParams) ResponseType() interface{} {
+	return &AddClusterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddClusterParams) Validate() error {
+	v := &validator{api: "addCluster"}
+	v.required("clustername", p.Clustername != nil)
+	v.required("clustertype", p.Clustertype != nil)
+	v.required("hypervisor", p.Hypervisor != nil)
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	v.required("podid", p.Podid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *AddClusterParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *AddClusterParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *AddClusterParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *AddClusterParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+// This param is required.
+func (p *AddClusterParams) SetClustername(v string) {
+	p.Clustername = &v
+	return
+}
+
+func (p *AddClusterParams) GetClustername() string {
+	if p.Clustername == nil {
+		return ""
+	}
+	return *p.Clustername
+}
+
+func (p *AddClusterParams) HasClustername() bool {
+	return p.Clustername != nil
+}
+
+func (p *AddClusterParams) ResetClustername() {
+	p.Clustername = nil
+}
+
+// This param is required.
+func (p *AddClusterParams) SetClustertype(v string) {
+	p.Clustertype = &v
+	return
+}
+
+func (p *AddClusterParams) GetClustertype() string {
+	if p.Clustertype == nil {
+		return ""
+	}
+	return *p.Clustertype
+}
+
+func (p *AddClusterParams) HasClustertype() bool {
+	return p.Clustertype != nil
+}
+
+func (p *AddClusterParams) ResetClustertype() {
+	p.Clustertype = nil
+}
+
+func (p *AddClusterParams) SetGuestvswitchname(v string) {
+	p.Guestvswitchname = &v
+	return
+}
+
+func (p *AddClusterParams) GetGuestvswitchname() string {
+	if p.Guestvswitchname == nil {
+		return ""
+	}
+	return *p.Guestvswitchname
+}
+
+func (p *AddClusterParams) HasGuestvswitchname() bool {
+	return p.Guestvswitchname != nil
+}
+
+func (p *AddClusterParams) ResetGuestvswitchname() {
+	p.Guestvswitchname = nil
+}
+
+func (p *AddClusterParams) SetGuestvswitchtype(v string) {
+	p.Guestvswitchtype = &v
+	return
+}
+
+func (p *AddClusterParams) GetGuestvswitchtype() string {
+	if p.Guestvswitchtype == nil {
+		return ""
+	}
+	return *p.Guestvswitchtype
+}
+
+func (p *AddClusterParams) HasGuestvswitchtype() bool {
+	return p.Guestvswitchtype != nil
+}
+
+func (p *AddClusterParams) ResetGuestvswitchtype() {
+	p.Guestvswitchtype = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware. This param is required.
+func (p *AddClusterParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *AddClusterParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *AddClusterParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *AddClusterParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+// Sets the 'password' param: the password.
+func (p *AddClusterParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddClusterParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddClusterParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddClusterParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'podid' param: the ID of the pod. This param is required.
+func (p *AddClusterParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *AddClusterParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *AddClusterParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *AddClusterParams) ResetPodid() {
+	p.Podid = nil
+}
+
+func (p *AddClusterParams) SetPublicvswitchname(v string) {
+	p.Publicvswitchname = &v
+	return
+}
+
+func (p *AddClusterParams) GetPublicvswitchname() string {
+	if p.Publicvswitchname == nil {
+		return ""
+	}
+	return *p.Publicvswitchname
+}
+
+func (p *AddClusterParams) HasPublicvswitchname() bool {
+	return p.Publicvswitchname != nil
+}
+
+func (p *AddClusterParams) ResetPublicvswitchname() {
+	p.Publicvswitchname = nil
+}
+
+func (p *AddClusterParams) SetPublicvswitchtype(v string) {
+	p.Publicvswitchtype = &v
+	return
+}
+
+func (p *AddClusterParams) GetPublicvswitchtype() string {
+	if p.Publicvswitchtype == nil {
+		return ""
+	}
+	return *p.Publicvswitchtype
+}
+
+func (p *AddClusterParams) HasPublicvswitchtype() bool {
+	return p.Publicvswitchtype != nil
+}
+
+func (p *AddClusterParams) ResetPublicvswitchtype() {
+	p.Publicvswitchtype = nil
+}
+
+func (p *AddClusterParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddClusterParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddClusterParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddClusterParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user.
+func (p *AddClusterParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddClusterParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddClusterParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddClusterParams) ResetUsername() {
+	p.Username = nil
+}
+
+func (p *AddClusterParams) SetVsmipaddress(v string) {
+	p.Vsmipaddress = &v
+	return
+}
+
+func (p *AddClusterParams) GetVsmipaddress() string {
+	if p.Vsmipaddress == nil {
+		return ""
+	}
+	return *p.Vsmipaddress
+}
+
+func (p *AddClusterParams) HasVsmipaddress() bool {
+	return p.Vsmipaddress != nil
+}
+
+func (p *AddClusterParams) ResetVsmipaddress() {
+	p.Vsmipaddress = nil
+}
+
+func (p *AddClusterParams) SetVsmpassword(v string) {
+	p.Vsmpassword = &v
+	return
+}
+
+func (p *AddClusterParams) GetVsmpassword() string {
+	if p.Vsmpassword == nil {
+		return ""
+	}
+	return *p.Vsmpassword
+}
+
+func (p *AddClusterParams) HasVsmpassword() bool {
+	return p.Vsmpassword != nil
+}
+
+func (p *AddClusterParams) ResetVsmpassword() {
+	p.Vsmpassword = nil
+}
+
+func (p *AddClusterParams) SetVsmusername(v string) {
+	p.Vsmusername = &v
+	return
+}
+
+func (p *AddClusterParams) GetVsmusername() string {
+	if p.Vsmusername == nil {
+		return ""
+	}
+	return *p.Vsmusername
+}
+
+func (p *AddClusterParams) HasVsmusername() bool {
+	return p.Vsmusername != nil
+}
+
+func (p *AddClusterParams) ResetVsmusername() {
+	p.Vsmusername = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddClusterParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddClusterParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddClusterParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddClusterParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type AddClusterResponse struct {
+	Allocationstate       string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity              []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Clustertype           string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuovercommitratio    string                     `json:"cpuovercommitratio,omitempty" xml:"cpuovercommitratio,omitempty"`
+	Hypervisortype        string                     `json:"hypervisortype,omitempty" xml:"hypervisortype,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Managedstate          string                     `json:"managedstate,omitempty" xml:"managedstate,omitempty"`
+	Memoryovercommitratio string                     `json:"memoryovercommitratio,omitempty" xml:"memoryovercommitratio,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Podid                 string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname               string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicateClusterParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DedicateClusterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DedicateClusterParams) Command() string {
+	return "dedicateCluster"
+}
+
+// Returns true if the API command starts an async job
+func (p *DedicateClusterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DedicateClusterParams) ResponseType() interface{} {
+	return &DedicateClusterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DedicateClusterParams) Validate() error {
+	v := &validator{api: "dedicateCluster"}
+	v.required("clusterid", p.Clusterid != nil)
+	v.required("domainid", p.Domainid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DedicateClusterParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DedicateClusterParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DedicateClusterParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DedicateClusterParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster. This param is required.
+func (p *DedicateClusterParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *DedicateClusterParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *DedicateClusterParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *DedicateClusterParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *DedicateClusterParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DedicateClusterParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DedicateClusterParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DedicateClusterParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+type DedicateClusterResponse struct {
+	JobID           string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Clusterid       string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername     string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteClusterParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteClusterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteClusterParams) Command() string {
+	return "deleteCluster"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteClusterParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteClusterParams) ResponseType() interface{} {
+	return &DeleteClusterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteClusterParams) Validate() error {
+	v := &validator{api: "deleteCluster"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteClusterParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteClusterParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteClusterParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteClusterParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteClusterResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListClustersParams struct {
+	Allocationstate *string         `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustertype     *string         `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Hypervisor      *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id              *string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword         *string         `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Managedstate    *string         `json:"managedstate,omitempty" yaml:"managedstate,omitempty"`
+	Name            *string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Page            *int            `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int            `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid           *string         `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Showcapacities  *bool           `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Zoneid          *string         `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListClustersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Clustertype; v != nil {
+		u.Set("clustertype", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Managedstate; v != nil {
+		u.Set("managedstate", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Showcapacities; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("showcapacities", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListClustersParams) Command() string {
+	return "listClusters"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListClustersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListClustersParams) ResponseType() interface{} {
+	return &ListClustersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListClustersParams) Validate() error {
+	v := &validator{api: "listClusters"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *ListClustersParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *ListClustersParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *ListClustersParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *ListClustersParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+func (p *ListClustersParams) SetClustertype(v string) {
+	p.Clustertype = &v
+	return
+}
+
+func (p *ListClustersParams) GetClustertype() string {
+	if p.Clustertype == nil {
+		return ""
+	}
+	return *p.Clustertype
+}
+
+func (p *ListClustersParams) HasClustertype() bool {
+	return p.Clustertype != nil
+}
+
+func (p *ListClustersParams) ResetClustertype() {
+	p.Clustertype = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListClustersParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *ListClustersParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *ListClustersParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *ListClustersParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *ListClustersParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListClustersParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListClustersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListClustersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListClustersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListClustersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListClustersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListClustersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListClustersParams) SetManagedstate(v string) {
+	p.Managedstate = &v
+	return
+}
+
+func (p *ListClustersParams) GetManagedstate() string {
+	if p.Managedstate == nil {
+		return ""
+	}
+	return *p.Managedstate
+}
+
+func (p *ListClustersParams) HasManagedstate() bool {
+	return p.Managedstate != nil
+}
+
+func (p *ListClustersParams) ResetManagedstate() {
+	p.Managedstate = nil
+}
+
+func (p *ListClustersParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListClustersParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListClustersParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListClustersParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListClustersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListClustersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListClustersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListClustersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListClustersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListClustersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListClustersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListClustersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListClustersParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListClustersParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListClustersParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListClustersParams) ResetPodid() {
+	p.Podid = nil
+}
+
+func (p *ListClustersParams) SetShowcapacities(v bool) {
+	p.Showcapacities = &v
+	return
+}
+
+func (p *ListClustersParams) GetShowcapacities() bool {
+	if p.Showcapacities == nil {
+		return false
+	}
+	return *p.Showcapacities
+}
+
+func (p *ListClustersParams) HasShowcapacities() bool {
+	return p.Showcapacities != nil
+}
+
+func (p *ListClustersParams) ResetShowcapacities() {
+	p.Showcapacities = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListClustersParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListClustersParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListClustersParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListClustersParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListClustersResponse struct {
+	Count    int                        `json:"count" xml:"count"`
+	Clusters []*Cluster                 `json:"cluster" xml:"cluster"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Cluster struct {
+	Allocationstate       string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity              []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Clustertype           string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuovercommitratio    string                     `json:"cpuovercommitratio,omitempty" xml:"cpuovercommitratio,omitempty"`
+	Hypervisortype        string                     `json:"hypervisortype,omitempty" xml:"hypervisortype,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Managedstate          string                     `json:"managedstate,omitempty" xml:"managedstate,omitempty"`
+	Memoryovercommitratio string                     `json:"memoryovercommitratio,omitempty" xml:"memoryovercommitratio,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Podid                 string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname               string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateClusterParams struct {
+	Allocationstate *string         `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Clustername     *string         `json:"clustername,omitempty" yaml:"clustername,omitempty"`
+	Clustertype     *string         `json:"clustertype,omitempty" yaml:"clustertype,omitempty"`
+	Hypervisor      *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id              *string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Managedstate    *string         `json:"managedstate,omitempty" yaml:"managedstate,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateClusterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Clustername; v != nil {
+		u.Set("clustername", *v)
+	}
+	if v := p.Clustertype; v != nil {
+		u.Set("clustertype", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Managedstate; v != nil {
+		u.Set("managedstate", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateClusterParams) Command() string {
+	return "updateCluster"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateClusterParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateClusterParams) ResponseType() interface{} {
+	return &UpdateClusterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateClusterParams) Validate() error {
+	v := &validator{api: "updateCluster"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *UpdateClusterParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *UpdateClusterParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *UpdateClusterParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *UpdateClusterParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+func (p *UpdateClusterParams) SetClustername(v string) {
+	p.Clustername = &v
+	return
+}
+
+func (p *UpdateClusterParams) GetClustername() string {
+	if p.Clustername == nil {
+		return ""
+	}
+	return *p.Clustername
+}
+
+func (p *UpdateClusterParams) HasClustername() bool {
+	return p.Clustername != nil
+}
+
+func (p *UpdateClusterParams) ResetClustername() {
+	p.Clustername = nil
+}
+
+func (p *UpdateClusterParams) SetClustertype(v string) {
+	p.Clustertype = &v
+	return
+}
+
+func (p *UpdateClusterParams) GetClustertype() string {
+	if p.Clustertype == nil {
+		return ""
+	}
+	return *p.Clustertype
+}
+
+func (p *UpdateClusterParams) HasClustertype() bool {
+	return p.Clustertype != nil
+}
+
+func (p *UpdateClusterParams) ResetClustertype() {
+	p.Clustertype = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *UpdateClusterParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *UpdateClusterParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *UpdateClusterParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *UpdateClusterParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+// This param is required.
+func (p *UpdateClusterParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateClusterParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateClusterParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateClusterParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateClusterParams) SetManagedstate(v string) {
+	p.Managedstate = &v
+	return
+}
+
+func (p *UpdateClusterParams) GetManagedstate() string {
+	if p.Managedstate == nil {
+		return ""
+	}
+	return *p.Managedstate
+}
+
+func (p *UpdateClusterParams) HasManagedstate() bool {
+	return p.Managedstate != nil
+}
+
+func (p *UpdateClusterParams) ResetManagedstate() {
+	p.Managedstate = nil
+}
+
+type UpdateClusterResponse struct {
+	Allocationstate       string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity              []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Clustertype           string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuovercommitratio    string                     `json:"cpuovercommitratio,omitempty" xml:"cpuovercommitratio,omitempty"`
+	Hypervisortype        string                     `json:"hypervisortype,omitempty" xml:"hypervisortype,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Managedstate          string                     `json:"managedstate,omitempty" xml:"managedstate,omitempty"`
+	Memoryovercommitratio string                     `json:"memoryovercommitratio,omitempty" xml:"memoryovercommitratio,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Podid                 string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname               string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListDedicatedClustersParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Clusterid       *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDedicatedClustersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Affinitygroupid; v != nil {
+		u.Set("affinitygroupid", *v)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDedicatedClustersParams) Command() string {
+	return "listDedicatedClusters"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDedicatedClustersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDedicatedClustersParams) ResponseType() interface{} {
+	return &ListDedicatedClustersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDedicatedClustersParams) Validate() error {
+	v := &validator{api: "listDedicatedClusters"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListDedicatedClustersParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListDedicatedClustersParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListDedicatedClustersParams) SetAffinitygroupid(v string) {
+	p.Affinitygroupid = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetAffinitygroupid() string {
+	if p.Affinitygroupid == nil {
+		return ""
+	}
+	return *p.Affinitygroupid
+}
+
+func (p *ListDedicatedClustersParams) HasAffinitygroupid() bool {
+	return p.Affinitygroupid != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetAffinitygroupid() {
+	p.Affinitygroupid = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *ListDedicatedClustersParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *ListDedicatedClustersParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListDedicatedClustersParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListDedicatedClustersParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDedicatedClustersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDedicatedClustersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDedicatedClustersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDedicatedClustersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDedicatedClustersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDedicatedClustersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDedicatedClustersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDedicatedClustersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListDedicatedClustersResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	DedicatedClusters []*DedicatedCluster        `json:"dedicatedcluster" xml:"dedicatedcluster"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatedCluster struct {
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Clusterid       string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername     string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleaseDedicatedClusterParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleaseDedicatedClusterParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleaseDedicatedClusterParams) Command() string {
+	return "releaseDedicatedCluster"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleaseDedicatedClusterParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleaseDedicatedClusterParams) ResponseType() interface{} {
+	return &ReleaseDedicatedClusterResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleaseDedicatedClusterParams) Validate() error {
+	v := &validator{api: "releaseDedicatedCluster"}
+	v.required("clusterid", p.Clusterid != nil)
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster. This param is required.
+func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *ReleaseDedicatedClusterParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *ReleaseDedicatedClusterParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ReleaseDedicatedClusterParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+type ReleaseDedicatedClusterResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ClusterService that is the same for all versions
+type ClusterService interface {
+	NewAddClusterParams(clustername string, clustertype string, hypervisor HypervisorType, podid string, zoneid string) *AddClusterParams
+	AddCluster(p *AddClusterParams) (*AddClusterResponse, error)
+	NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams
+	DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	NewDeleteClusterParams(id string) *DeleteClusterParams
+	DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	NewListClustersParams() *ListClustersParams
+	GetClusterID(name string, opts ...OptionFunc) (string, error)
+	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
+	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	StreamClusters(ctx context.Context, p *ListClustersParams, fn func(*Cluster) error) (int, error)
+	NewUpdateClusterParams(id string) *UpdateClusterParams
+	UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	NewListDedicatedClustersParams() *ListDedicatedClustersParams
+	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	StreamDedicatedClusters(ctx context.Context, p *ListDedicatedClustersParams, fn func(*DedicatedCluster) error) (int, error)
+	NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams
+	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+}
@@ -0,0 +1,294 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddCluster(t *testing.T) {
+	params := url.Values{
+		"allocationstate":   {"test-allocationstate"},
+		"clustername":       {"test-clustername"},
+		"clustertype":       {"test-clustertype"},
+		"guestvswitchname":  {"test-guestvswitchname"},
+		"guestvswitchtype":  {"test-guestvswitchtype"},
+		"hypervisor":        {"BareMetal"},
+		"password":          {"test-password"},
+		"podid":             {"test-podid"},
+		"publicvswitchname": {"test-publicvswitchname"},
+		"publicvswitchtype": {"test-publicvswitchtype"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+		"vsmipaddress":      {"test-vsmipaddress"},
+		"vsmpassword":       {"test-vsmpassword"},
+		"vsmusername":       {"test-vsmusername"},
+		"zoneid":            {"test-zoneid"},
+	}
+
+	p := &AddClusterParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClustername("test-clustername")
+	p.SetClustertype("test-clustertype")
+	p.SetGuestvswitchname("test-guestvswitchname")
+	p.SetGuestvswitchtype("test-guestvswitchtype")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetPassword("test-password")
+	p.SetPodid("test-podid")
+	p.SetPublicvswitchname("test-publicvswitchname")
+	p.SetPublicvswitchtype("test-publicvswitchtype")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetVsmipaddress("test-vsmipaddress")
+	p.SetVsmpassword("test-vsmpassword")
+	p.SetVsmusername("test-vsmusername")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addCluster", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addCluster" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddClusterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addCluster", `{"addclusterresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"clustertype":"test-clustertype","cpuovercommitratio":"test-cpuovercommitratio","hypervisortype":"test-hypervisortype","id":"test-id","managedstate":"test-managedstate","memoryovercommitratio":"test-memoryovercommitratio","name":"test-name","podid":"test-podid","podname":"test-podname","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+}
+
+func TestDedicateCluster(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"clusterid": {"test-clusterid"},
+		"domainid":  {"test-domainid"},
+	}
+
+	p := &DedicateClusterParams{}
+	p.SetAccount("test-account")
+	p.SetClusterid("test-clusterid")
+	p.SetDomainid("test-domainid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "dedicateCluster", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateCluster" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DedicateClusterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "dedicateCluster", `{"dedicateclusterresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","clusterid":"test-clusterid","clustername":"test-clustername","domainid":"test-domainid","id":"test-id","jobid":"test-jobid"}}`, false, r)
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteCluster(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteClusterParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteCluster", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteCluster" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteClusterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteCluster", `{"deleteclusterresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListClusters(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"clustertype":     {"test-clustertype"},
+		"hypervisor":      {"BareMetal"},
+		"id":              {"test-id"},
+		"keyword":         {"test-keyword"},
+		"managedstate":    {"test-managedstate"},
+		"name":            {"test-name"},
+		"page":            {"8"},
+		"pagesize":        {"9"},
+		"podid":           {"test-podid"},
+		"showcapacities":  {"true"},
+		"zoneid":          {"test-zoneid"},
+	}
+
+	p := &ListClustersParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClustertype("test-clustertype")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetManagedstate("test-managedstate")
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetPodid("test-podid")
+	p.SetShowcapacities(true)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listClusters", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listClusters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListClustersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listClusters", `{"listclustersresponse":{"cluster":[{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"clustertype":"test-clustertype","cpuovercommitratio":"test-cpuovercommitratio","hypervisortype":"test-hypervisortype","id":"test-id","managedstate":"test-managedstate","memoryovercommitratio":"test-memoryovercommitratio","name":"test-name","podid":"test-podid","podname":"test-podname","zoneid":"test-zoneid","zonename":"test-zonename"}],"count":1}}`, false, r)
+	if r.Clusters[0].Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Clusters[0].Allocationstate to be %v, got %v", "test-allocationstate", r.Clusters[0].Allocationstate)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateCluster(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"clustername":     {"test-clustername"},
+		"clustertype":     {"test-clustertype"},
+		"hypervisor":      {"BareMetal"},
+		"id":              {"test-id"},
+		"managedstate":    {"test-managedstate"},
+	}
+
+	p := &UpdateClusterParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetClustername("test-clustername")
+	p.SetClustertype("test-clustertype")
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetManagedstate("test-managedstate")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateCluster", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateCluster" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateClusterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateCluster", `{"updateclusterresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"clustertype":"test-clustertype","cpuovercommitratio":"test-cpuovercommitratio","hypervisortype":"test-hypervisortype","id":"test-id","managedstate":"test-managedstate","memoryovercommitratio":"test-memoryovercommitratio","name":"test-name","podid":"test-podid","podname":"test-podname","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+}
+
+func TestListDedicatedClusters(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"clusterid":       {"test-clusterid"},
+		"domainid":        {"test-domainid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"6"},
+		"pagesize":        {"7"},
+	}
+
+	p := &ListDedicatedClustersParams{}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetClusterid("test-clusterid")
+	p.SetDomainid("test-domainid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDedicatedClusters", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedClusters" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDedicatedClustersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDedicatedClusters", `{"listdedicatedclustersresponse":{"count":1,"dedicatedcluster":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","clusterid":"test-clusterid","clustername":"test-clustername","domainid":"test-domainid","id":"test-id"}]}}`, false, r)
+	if r.DedicatedClusters[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedClusters[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedClusters[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestReleaseDedicatedCluster(t *testing.T) {
+	params := url.Values{
+		"clusterid": {"test-clusterid"},
+	}
+
+	p := &ReleaseDedicatedClusterParams{}
+	p.SetClusterid("test-clusterid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releaseDedicatedCluster", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedCluster" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleaseDedicatedClusterResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releaseDedicatedCluster", `{"releasededicatedclusterresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
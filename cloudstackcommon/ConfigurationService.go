@@ -0,0 +1,945 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListConfigurationsParams struct {
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Category  *string `json:"category,omitempty" yaml:"category,omitempty"`
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Keyword   *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page      *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize  *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Storageid *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListConfigurationsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accountid; v != nil {
+		u.Set("accountid", *v)
+	}
+	if v := p.Category; v != nil {
+		u.Set("category", *v)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListConfigurationsParams) Command() string {
+	return "listConfigurations"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListConfigurationsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListConfigurationsParams) ResponseType() interface{} {
+	return &ListConfigurationsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListConfigurationsParams) Validate() error {
+	v := &validator{api: "listConfigurations"}
+	return v.err()
+}
+
+// Sets the 'accountid' param: the ID of the account.
+func (p *ListConfigurationsParams) SetAccountid(v string) {
+	p.Accountid = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetAccountid() string {
+	if p.Accountid == nil {
+		return ""
+	}
+	return *p.Accountid
+}
+
+func (p *ListConfigurationsParams) HasAccountid() bool {
+	return p.Accountid != nil
+}
+
+func (p *ListConfigurationsParams) ResetAccountid() {
+	p.Accountid = nil
+}
+
+func (p *ListConfigurationsParams) SetCategory(v string) {
+	p.Category = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetCategory() string {
+	if p.Category == nil {
+		return ""
+	}
+	return *p.Category
+}
+
+func (p *ListConfigurationsParams) HasCategory() bool {
+	return p.Category != nil
+}
+
+func (p *ListConfigurationsParams) ResetCategory() {
+	p.Category = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *ListConfigurationsParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *ListConfigurationsParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ListConfigurationsParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListConfigurationsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListConfigurationsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListConfigurationsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListConfigurationsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListConfigurationsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListConfigurationsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListConfigurationsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListConfigurationsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListConfigurationsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListConfigurationsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListConfigurationsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListConfigurationsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListConfigurationsParams) SetStorageid(v string) {
+	p.Storageid = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *ListConfigurationsParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *ListConfigurationsParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListConfigurationsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListConfigurationsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListConfigurationsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListConfigurationsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListConfigurationsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	Configurations []*Configuration           `json:"configuration" xml:"configuration"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Configuration struct {
+	Category    string                     `json:"category,omitempty" xml:"category,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id          int64                      `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Scope       string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Value       string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateConfigurationParams struct {
+	Accountid *string `json:"accountid,omitempty" yaml:"accountid,omitempty"`
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Storageid *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Value     *string `json:"value,omitempty" yaml:"value,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateConfigurationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accountid; v != nil {
+		u.Set("accountid", *v)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
+	}
+	if v := p.Value; v != nil {
+		u.Set("value", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateConfigurationParams) Command() string {
+	return "updateConfiguration"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateConfigurationParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateConfigurationParams) ResponseType() interface{} {
+	return &UpdateConfigurationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateConfigurationParams) Validate() error {
+	v := &validator{api: "updateConfiguration"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'accountid' param: the ID of the account.
+func (p *UpdateConfigurationParams) SetAccountid(v string) {
+	p.Accountid = &v
+	return
+}
+
+func (p *UpdateConfigurationParams) GetAccountid() string {
+	if p.Accountid == nil {
+		return ""
+	}
+	return *p.Accountid
+}
+
+func (p *UpdateConfigurationParams) HasAccountid() bool {
+	return p.Accountid != nil
+}
+
+func (p *UpdateConfigurationParams) ResetAccountid() {
+	p.Accountid = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *UpdateConfigurationParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *UpdateConfigurationParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *UpdateConfigurationParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *UpdateConfigurationParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// This param is required.
+func (p *UpdateConfigurationParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateConfigurationParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateConfigurationParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateConfigurationParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *UpdateConfigurationParams) SetStorageid(v string) {
+	p.Storageid = &v
+	return
+}
+
+func (p *UpdateConfigurationParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *UpdateConfigurationParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *UpdateConfigurationParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+func (p *UpdateConfigurationParams) SetValue(v string) {
+	p.Value = &v
+	return
+}
+
+func (p *UpdateConfigurationParams) GetValue() string {
+	if p.Value == nil {
+		return ""
+	}
+	return *p.Value
+}
+
+func (p *UpdateConfigurationParams) HasValue() bool {
+	return p.Value != nil
+}
+
+func (p *UpdateConfigurationParams) ResetValue() {
+	p.Value = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *UpdateConfigurationParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *UpdateConfigurationParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *UpdateConfigurationParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *UpdateConfigurationParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type UpdateConfigurationResponse struct {
+	Category    string                     `json:"category,omitempty" xml:"category,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Id          int64                      `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Scope       string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Value       string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListDeploymentPlannersParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDeploymentPlannersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDeploymentPlannersParams) Command() string {
+	return "listDeploymentPlanners"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDeploymentPlannersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDeploymentPlannersParams) ResponseType() interface{} {
+	return &ListDeploymentPlannersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDeploymentPlannersParams) Validate() error {
+	v := &validator{api: "listDeploymentPlanners"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDeploymentPlannersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDeploymentPlannersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDeploymentPlannersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDeploymentPlannersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDeploymentPlannersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDeploymentPlannersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDeploymentPlannersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDeploymentPlannersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDeploymentPlannersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDeploymentPlannersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDeploymentPlannersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListDeploymentPlannersResponse struct {
+	Count              int                        `json:"count" xml:"count"`
+	DeploymentPlanners []*DeploymentPlanner       `json:"deploymentplanner" xml:"deploymentplanner"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeploymentPlanner struct {
+	Name  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddLdapConfigurationParams struct {
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Port     *int    `json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddLdapConfigurationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
+	}
+	if v := p.Port; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("port", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddLdapConfigurationParams) Command() string {
+	return "addLdapConfiguration"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddLdapConfigurationParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddLdapConfigurationParams) ResponseType() interface{} {
+	return &AddLdapConfigurationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddLdapConfigurationParams) Validate() error {
+	v := &validator{api: "addLdapConfiguration"}
+	v.required("hostname", p.Hostname != nil)
+	v.required("port", p.Port != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddLdapConfigurationParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *AddLdapConfigurationParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *AddLdapConfigurationParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *AddLdapConfigurationParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+// This param is required.
+func (p *AddLdapConfigurationParams) SetPort(v int) {
+	p.Port = &v
+	return
+}
+
+func (p *AddLdapConfigurationParams) GetPort() int {
+	if p.Port == nil {
+		return 0
+	}
+	return *p.Port
+}
+
+func (p *AddLdapConfigurationParams) HasPort() bool {
+	return p.Port != nil
+}
+
+func (p *AddLdapConfigurationParams) ResetPort() {
+	p.Port = nil
+}
+
+type AddLdapConfigurationResponse struct {
+	Hostname string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Port     int                        `json:"port,omitempty" xml:"port,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLdapConfigurationParams struct {
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteLdapConfigurationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteLdapConfigurationParams) Command() string {
+	return "deleteLdapConfiguration"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteLdapConfigurationParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteLdapConfigurationParams) ResponseType() interface{} {
+	return &DeleteLdapConfigurationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteLdapConfigurationParams) Validate() error {
+	v := &validator{api: "deleteLdapConfiguration"}
+	v.required("hostname", p.Hostname != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteLdapConfigurationParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *DeleteLdapConfigurationParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *DeleteLdapConfigurationParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *DeleteLdapConfigurationParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+type DeleteLdapConfigurationResponse struct {
+	Hostname string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Port     int                        `json:"port,omitempty" xml:"port,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListLdapConfigurationsParams struct {
+	Hostname *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Port     *int    `json:"port,omitempty" yaml:"port,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLdapConfigurationsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Port; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("port", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListLdapConfigurationsParams) Command() string {
+	return "listLdapConfigurations"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListLdapConfigurationsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLdapConfigurationsParams) ResponseType() interface{} {
+	return &ListLdapConfigurationsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLdapConfigurationsParams) Validate() error {
+	v := &validator{api: "listLdapConfigurations"}
+	return v.err()
+}
+
+func (p *ListLdapConfigurationsParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *ListLdapConfigurationsParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *ListLdapConfigurationsParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *ListLdapConfigurationsParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLdapConfigurationsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListLdapConfigurationsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListLdapConfigurationsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLdapConfigurationsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLdapConfigurationsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListLdapConfigurationsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListLdapConfigurationsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListLdapConfigurationsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLdapConfigurationsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListLdapConfigurationsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListLdapConfigurationsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListLdapConfigurationsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListLdapConfigurationsParams) SetPort(v int) {
+	p.Port = &v
+	return
+}
+
+func (p *ListLdapConfigurationsParams) GetPort() int {
+	if p.Port == nil {
+		return 0
+	}
+	return *p.Port
+}
+
+func (p *ListLdapConfigurationsParams) HasPort() bool {
+	return p.Port != nil
+}
+
+func (p *ListLdapConfigurationsParams) ResetPort() {
+	p.Port = nil
+}
+
+type ListLdapConfigurationsResponse struct {
+	Count              int                        `json:"count" xml:"count"`
+	LdapConfigurations []*LdapConfiguration       `json:"ldapconfiguration" xml:"ldapconfiguration"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type LdapConfiguration struct {
+	Hostname string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Port     int                        `json:"port,omitempty" xml:"port,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ConfigurationService that is the same for all versions
+type ConfigurationService interface {
+	NewListConfigurationsParams() *ListConfigurationsParams
+	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	StreamConfigurations(ctx context.Context, p *ListConfigurationsParams, fn func(*Configuration) error) (int, error)
+	NewUpdateConfigurationParams(name string) *UpdateConfigurationParams
+	UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	NewListDeploymentPlannersParams() *ListDeploymentPlannersParams
+	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	StreamDeploymentPlanners(ctx context.Context, p *ListDeploymentPlannersParams, fn func(*DeploymentPlanner) error) (int, error)
+	NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams
+	AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	NewDeleteLdapConfigurationParams(hostname string) *DeleteLdapConfigurationParams
+	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	NewListLdapConfigurationsParams() *ListLdapConfigurationsParams
+	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	StreamLdapConfigurations(ctx context.Context, p *ListLdapConfigurationsParams, fn func(*LdapConfiguration) error) (int, error)
+}
@@ -0,0 +1,227 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListConfigurations(t *testing.T) {
+	params := url.Values{
+		"accountid": {"test-accountid"},
+		"category":  {"test-category"},
+		"clusterid": {"test-clusterid"},
+		"keyword":   {"test-keyword"},
+		"name":      {"test-name"},
+		"page":      {"6"},
+		"pagesize":  {"7"},
+		"storageid": {"test-storageid"},
+		"zoneid":    {"test-zoneid"},
+	}
+
+	p := &ListConfigurationsParams{}
+	p.SetAccountid("test-accountid")
+	p.SetCategory("test-category")
+	p.SetClusterid("test-clusterid")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetStorageid("test-storageid")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listConfigurations", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listConfigurations" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListConfigurationsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listConfigurations", `{"listconfigurationsresponse":{"configuration":[{"category":"test-category","description":"test-description","id":1,"name":"test-name","scope":"test-scope","value":"test-value"}],"count":1}}`, false, r)
+	if r.Configurations[0].Category != "test-category" {
+		t.Errorf("Expected r.Configurations[0].Category to be %v, got %v", "test-category", r.Configurations[0].Category)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateConfiguration(t *testing.T) {
+	params := url.Values{
+		"accountid": {"test-accountid"},
+		"clusterid": {"test-clusterid"},
+		"name":      {"test-name"},
+		"storageid": {"test-storageid"},
+		"value":     {"test-value"},
+		"zoneid":    {"test-zoneid"},
+	}
+
+	p := &UpdateConfigurationParams{}
+	p.SetAccountid("test-accountid")
+	p.SetClusterid("test-clusterid")
+	p.SetName("test-name")
+	p.SetStorageid("test-storageid")
+	p.SetValue("test-value")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateConfiguration", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateConfiguration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateConfigurationResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateConfiguration", `{"updateconfigurationresponse":{"category":"test-category","description":"test-description","id":1,"name":"test-name","scope":"test-scope","value":"test-value"}}`, false, r)
+	if r.Category != "test-category" {
+		t.Errorf("Expected r.Category to be %v, got %v", "test-category", r.Category)
+	}
+}
+
+func TestListDeploymentPlanners(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+
+	p := &ListDeploymentPlannersParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDeploymentPlanners", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDeploymentPlanners" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDeploymentPlannersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDeploymentPlanners", `{"listdeploymentplannersresponse":{"count":1,"deploymentplanner":[{"name":"test-name"}]}}`, false, r)
+	if r.DeploymentPlanners[0].Name != "test-name" {
+		t.Errorf("Expected r.DeploymentPlanners[0].Name to be %v, got %v", "test-name", r.DeploymentPlanners[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestAddLdapConfiguration(t *testing.T) {
+	params := url.Values{
+		"hostname": {"test-hostname"},
+		"port":     {"2"},
+	}
+
+	p := &AddLdapConfigurationParams{}
+	p.SetHostname("test-hostname")
+	p.SetPort(2)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addLdapConfiguration", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addLdapConfiguration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddLdapConfigurationResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addLdapConfiguration", `{"addldapconfigurationresponse":{"hostname":"test-hostname","port":1}}`, false, r)
+	if r.Hostname != "test-hostname" {
+		t.Errorf("Expected r.Hostname to be %v, got %v", "test-hostname", r.Hostname)
+	}
+}
+
+func TestDeleteLdapConfiguration(t *testing.T) {
+	params := url.Values{
+		"hostname": {"test-hostname"},
+	}
+
+	p := &DeleteLdapConfigurationParams{}
+	p.SetHostname("test-hostname")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteLdapConfiguration", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLdapConfiguration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteLdapConfigurationResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteLdapConfiguration", `{"deleteldapconfigurationresponse":{"hostname":"test-hostname","port":1}}`, false, r)
+	if r.Hostname != "test-hostname" {
+		t.Errorf("Expected r.Hostname to be %v, got %v", "test-hostname", r.Hostname)
+	}
+}
+
+func TestListLdapConfigurations(t *testing.T) {
+	params := url.Values{
+		"hostname": {"test-hostname"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"port":     {"5"},
+	}
+
+	p := &ListLdapConfigurationsParams{}
+	p.SetHostname("test-hostname")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPort(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listLdapConfigurations", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listLdapConfigurations" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListLdapConfigurationsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listLdapConfigurations", `{"listldapconfigurationsresponse":{"count":1,"ldapconfiguration":[{"hostname":"test-hostname","port":1}]}}`, false, r)
+	if r.LdapConfigurations[0].Hostname != "test-hostname" {
+		t.Errorf("Expected r.LdapConfigurations[0].Hostname to be %v, got %v", "test-hostname", r.LdapConfigurations[0].Hostname)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
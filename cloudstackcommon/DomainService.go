@@ -0,0 +1,847 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type CreateDomainParams struct {
+	Domainid       *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name           *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain  *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+	Parentdomainid *string `json:"parentdomainid,omitempty" yaml:"parentdomainid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateDomainParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
+	}
+	if v := p.Parentdomainid; v != nil {
+		u.Set("parentdomainid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateDomainParams) Command() string {
+	return "createDomain"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateDomainParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateDomainParams) ResponseType() interface{} {
+	return &CreateDomainResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateDomainParams) Validate() error {
+	v := &validator{api: "createDomain"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateDomainParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateDomainParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateDomainParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateDomainParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateDomainParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateDomainParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateDomainParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateDomainParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *CreateDomainParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
+	return
+}
+
+func (p *CreateDomainParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
+	}
+	return *p.Networkdomain
+}
+
+func (p *CreateDomainParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *CreateDomainParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+func (p *CreateDomainParams) SetParentdomainid(v string) {
+	p.Parentdomainid = &v
+	return
+}
+
+func (p *CreateDomainParams) GetParentdomainid() string {
+	if p.Parentdomainid == nil {
+		return ""
+	}
+	return *p.Parentdomainid
+}
+
+func (p *CreateDomainParams) HasParentdomainid() bool {
+	return p.Parentdomainid != nil
+}
+
+func (p *CreateDomainParams) ResetParentdomainid() {
+	p.Parentdomainid = nil
+}
+
+type CreateDomainResponse struct {
+	Haschild         Bool                       `json:"haschild,omitempty" xml:"haschild,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Level            int                        `json:"level,omitempty" xml:"level,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain    string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Parentdomainid   string                     `json:"parentdomainid,omitempty" xml:"parentdomainid,omitempty"`
+	Parentdomainname string                     `json:"parentdomainname,omitempty" xml:"parentdomainname,omitempty"`
+	Path             string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteDomainParams struct {
+	Cleanup *bool   `json:"cleanup,omitempty" yaml:"cleanup,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteDomainParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Cleanup; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("cleanup", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteDomainParams) Command() string {
+	return "deleteDomain"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteDomainParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteDomainParams) ResponseType() interface{} {
+	return &DeleteDomainResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteDomainParams) Validate() error {
+	v := &validator{api: "deleteDomain"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *DeleteDomainParams) SetCleanup(v bool) {
+	p.Cleanup = &v
+	return
+}
+
+func (p *DeleteDomainParams) GetCleanup() bool {
+	if p.Cleanup == nil {
+		return false
+	}
+	return *p.Cleanup
+}
+
+func (p *DeleteDomainParams) HasCleanup() bool {
+	return p.Cleanup != nil
+}
+
+func (p *DeleteDomainParams) ResetCleanup() {
+	p.Cleanup = nil
+}
+
+// This param is required.
+func (p *DeleteDomainParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteDomainParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteDomainParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteDomainParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteDomainResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListDomainsParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Level    *int    `json:"level,omitempty" yaml:"level,omitempty"`
+	Listall  *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDomainsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Level; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("level", vv)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDomainsParams) Command() string {
+	return "listDomains"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDomainsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDomainsParams) ResponseType() interface{} {
+	return &ListDomainsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDomainsParams) Validate() error {
+	v := &validator{api: "listDomains"}
+	return v.err()
+}
+
+func (p *ListDomainsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListDomainsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListDomainsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListDomainsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDomainsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDomainsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDomainsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDomainsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListDomainsParams) SetLevel(v int) {
+	p.Level = &v
+	return
+}
+
+func (p *ListDomainsParams) GetLevel() int {
+	if p.Level == nil {
+		return 0
+	}
+	return *p.Level
+}
+
+func (p *ListDomainsParams) HasLevel() bool {
+	return p.Level != nil
+}
+
+func (p *ListDomainsParams) ResetLevel() {
+	p.Level = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListDomainsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListDomainsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListDomainsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListDomainsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListDomainsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListDomainsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListDomainsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListDomainsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDomainsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDomainsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDomainsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDomainsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDomainsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDomainsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDomainsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDomainsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListDomainsResponse struct {
+	Count   int                        `json:"count" xml:"count"`
+	Domains []*Domain                  `json:"domain" xml:"domain"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Domain struct {
+	Haschild         Bool                       `json:"haschild,omitempty" xml:"haschild,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Level            int                        `json:"level,omitempty" xml:"level,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain    string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Parentdomainid   string                     `json:"parentdomainid,omitempty" xml:"parentdomainid,omitempty"`
+	Parentdomainname string                     `json:"parentdomainname,omitempty" xml:"parentdomainname,omitempty"`
+	Path             string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateDomainParams struct {
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name          *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkdomain *string `json:"networkdomain,omitempty" yaml:"networkdomain,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateDomainParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networkdomain; v != nil {
+		u.Set("networkdomain", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateDomainParams) Command() string {
+	return "updateDomain"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateDomainParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateDomainParams) ResponseType() interface{} {
+	return &UpdateDomainResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateDomainParams) Validate() error {
+	v := &validator{api: "updateDomain"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UpdateDomainParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateDomainParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateDomainParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateDomainParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateDomainParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateDomainParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateDomainParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateDomainParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'networkdomain' param: the network domain.
+func (p *UpdateDomainParams) SetNetworkdomain(v string) {
+	p.Networkdomain = &v
+	return
+}
+
+func (p *UpdateDomainParams) GetNetworkdomain() string {
+	if p.Networkdomain == nil {
+		return ""
+	}
+	return *p.Networkdomain
+}
+
+func (p *UpdateDomainParams) HasNetworkdomain() bool {
+	return p.Networkdomain != nil
+}
+
+func (p *UpdateDomainParams) ResetNetworkdomain() {
+	p.Networkdomain = nil
+}
+
+type UpdateDomainResponse struct {
+	Haschild         Bool                       `json:"haschild,omitempty" xml:"haschild,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Level            int                        `json:"level,omitempty" xml:"level,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain    string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Parentdomainid   string                     `json:"parentdomainid,omitempty" xml:"parentdomainid,omitempty"`
+	Parentdomainname string                     `json:"parentdomainname,omitempty" xml:"parentdomainname,omitempty"`
+	Path             string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListDomainChildrenParams struct {
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDomainChildrenParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDomainChildrenParams) Command() string {
+	return "listDomainChildren"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDomainChildrenParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDomainChildrenParams) ResponseType() interface{} {
+	return &ListDomainChildrenResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDomainChildrenParams) Validate() error {
+	v := &validator{api: "listDomainChildren"}
+	return v.err()
+}
+
+func (p *ListDomainChildrenParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListDomainChildrenParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListDomainChildrenParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListDomainChildrenParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListDomainChildrenParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListDomainChildrenParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDomainChildrenParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDomainChildrenParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDomainChildrenParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListDomainChildrenParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListDomainChildrenParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListDomainChildrenParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListDomainChildrenParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListDomainChildrenParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListDomainChildrenParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDomainChildrenParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDomainChildrenParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDomainChildrenParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDomainChildrenParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDomainChildrenParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDomainChildrenParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDomainChildrenParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListDomainChildrenResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	DomainChildren []*DomainChildren          `json:"domainchildren" xml:"domainchildren"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DomainChildren struct {
+	Haschild         Bool                       `json:"haschild,omitempty" xml:"haschild,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Level            int                        `json:"level,omitempty" xml:"level,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain    string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Parentdomainid   string                     `json:"parentdomainid,omitempty" xml:"parentdomainid,omitempty"`
+	Parentdomainname string                     `json:"parentdomainname,omitempty" xml:"parentdomainname,omitempty"`
+	Path             string                     `json:"path,omitempty" xml:"path,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the DomainService that is the same for all versions
+type DomainService interface {
+	NewCreateDomainParams(name string) *CreateDomainParams
+	CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error)
+	NewDeleteDomainParams(id string) *DeleteDomainParams
+	DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	NewListDomainsParams() *ListDomainsParams
+	GetDomainID(name string, opts ...OptionFunc) (string, error)
+	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
+	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	StreamDomains(ctx context.Context, p *ListDomainsParams, fn func(*Domain) error) (int, error)
+	NewUpdateDomainParams(id string) *UpdateDomainParams
+	UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	NewListDomainChildrenParams() *ListDomainChildrenParams
+	GetDomainChildrenID(name string, opts ...OptionFunc) (string, error)
+	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	StreamDomainChildren(ctx context.Context, p *ListDomainChildrenParams, fn func(*DomainChildren) error) (int, error)
+}
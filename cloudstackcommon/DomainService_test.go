@@ -0,0 +1,197 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateDomain(t *testing.T) {
+	params := url.Values{
+		"domainid":       {"test-domainid"},
+		"name":           {"test-name"},
+		"networkdomain":  {"test-networkdomain"},
+		"parentdomainid": {"test-parentdomainid"},
+	}
+
+	p := &CreateDomainParams{}
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetNetworkdomain("test-networkdomain")
+	p.SetParentdomainid("test-parentdomainid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createDomain", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createDomain" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateDomainResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createDomain", `{"createdomainresponse":{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestDeleteDomain(t *testing.T) {
+	params := url.Values{
+		"cleanup": {"true"},
+		"id":      {"test-id"},
+	}
+
+	p := &DeleteDomainParams{}
+	p.SetCleanup(true)
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteDomain", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteDomain" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteDomainResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteDomain", `{"deletedomainresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListDomains(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"level":    {"3"},
+		"listall":  {"true"},
+		"name":     {"test-name"},
+		"page":     {"6"},
+		"pagesize": {"7"},
+	}
+
+	p := &ListDomainsParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetLevel(3)
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDomains", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDomains" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDomainsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDomains", `{"listdomainsresponse":{"count":1,"domain":[{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}]}}`, false, r)
+	if r.Domains[0].Id != "test-id" {
+		t.Errorf("Expected r.Domains[0].Id to be %v, got %v", "test-id", r.Domains[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateDomain(t *testing.T) {
+	params := url.Values{
+		"id":            {"test-id"},
+		"name":          {"test-name"},
+		"networkdomain": {"test-networkdomain"},
+	}
+
+	p := &UpdateDomainParams{}
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetNetworkdomain("test-networkdomain")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateDomain", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateDomain" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateDomainResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateDomain", `{"updatedomainresponse":{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestListDomainChildren(t *testing.T) {
+	params := url.Values{
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"page":        {"6"},
+		"pagesize":    {"7"},
+	}
+
+	p := &ListDomainChildrenParams{}
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDomainChildren", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDomainChildren" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDomainChildrenResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDomainChildren", `{"listdomainchildrenresponse":{"count":1,"domainchildren":[{"haschild":true,"id":"test-id","level":1,"name":"test-name","networkdomain":"test-networkdomain","parentdomainid":"test-parentdomainid","parentdomainname":"test-parentdomainname","path":"test-path"}]}}`, false, r)
+	if r.DomainChildren[0].Id != "test-id" {
+		t.Errorf("Expected r.DomainChildren[0].Id to be %v, got %v", "test-id", r.DomainChildren[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
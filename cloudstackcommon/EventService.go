@@ -0,0 +1,780 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ArchiveEventsParams struct {
+	Enddate   *string  `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       []string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string  `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string  `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ArchiveEventsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
+	}
+	if v := p.Ids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("ids", vv)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ArchiveEventsParams) Command() string {
+	return "archiveEvents"
+}
+
+// Returns true if the API command starts an async job
+func (p *ArchiveEventsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ArchiveEventsParams) ResponseType() interface{} {
+	return &ArchiveEventsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ArchiveEventsParams) Validate() error {
+	v := &validator{api: "archiveEvents"}
+	return v.err()
+}
+
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd.
+func (p *ArchiveEventsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
+}
+
+func (p *ArchiveEventsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
+	}
+	return *p.Enddate
+}
+
+func (p *ArchiveEventsParams) HasEnddate() bool {
+	return p.Enddate != nil
+}
+
+func (p *ArchiveEventsParams) ResetEnddate() {
+	p.Enddate = nil
+}
+
+// Sets the 'ids' param: the IDs of the resources.
+func (p *ArchiveEventsParams) SetIds(v []string) {
+	p.Ids = v
+	return
+}
+
+func (p *ArchiveEventsParams) GetIds() []string {
+	return p.Ids
+}
+
+func (p *ArchiveEventsParams) HasIds() bool {
+	return p.Ids != nil
+}
+
+func (p *ArchiveEventsParams) ResetIds() {
+	p.Ids = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd.
+func (p *ArchiveEventsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *ArchiveEventsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *ArchiveEventsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *ArchiveEventsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+func (p *ArchiveEventsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ArchiveEventsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ArchiveEventsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ArchiveEventsParams) ResetType() {
+	p.Type = nil
+}
+
+type ArchiveEventsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteEventsParams struct {
+	Enddate   *string  `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Ids       []string `json:"ids,omitempty" yaml:"ids,omitempty"`
+	Startdate *string  `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type      *string  `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteEventsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
+	}
+	if v := p.Ids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("ids", vv)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteEventsParams) Command() string {
+	return "deleteEvents"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteEventsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteEventsParams) ResponseType() interface{} {
+	return &DeleteEventsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteEventsParams) Validate() error {
+	v := &validator{api: "deleteEvents"}
+	return v.err()
+}
+
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd.
+func (p *DeleteEventsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
+}
+
+func (p *DeleteEventsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
+	}
+	return *p.Enddate
+}
+
+func (p *DeleteEventsParams) HasEnddate() bool {
+	return p.Enddate != nil
+}
+
+func (p *DeleteEventsParams) ResetEnddate() {
+	p.Enddate = nil
+}
+
+// Sets the 'ids' param: the IDs of the resources.
+func (p *DeleteEventsParams) SetIds(v []string) {
+	p.Ids = v
+	return
+}
+
+func (p *DeleteEventsParams) GetIds() []string {
+	return p.Ids
+}
+
+func (p *DeleteEventsParams) HasIds() bool {
+	return p.Ids != nil
+}
+
+func (p *DeleteEventsParams) ResetIds() {
+	p.Ids = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd.
+func (p *DeleteEventsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *DeleteEventsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *DeleteEventsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *DeleteEventsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+func (p *DeleteEventsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *DeleteEventsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *DeleteEventsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *DeleteEventsParams) ResetType() {
+	p.Type = nil
+}
+
+type DeleteEventsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListEventsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Duration    *int    `json:"duration,omitempty" yaml:"duration,omitempty"`
+	Enddate     *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Entrytime   *int    `json:"entrytime,omitempty" yaml:"entrytime,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Level       *string `json:"level,omitempty" yaml:"level,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Startdate   *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+	Type        *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListEventsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Duration; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("duration", vv)
+	}
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
+	}
+	if v := p.Entrytime; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("entrytime", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Level; v != nil {
+		u.Set("level", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListEventsParams) Command() string {
+	return "listEvents"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListEventsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListEventsParams) ResponseType() interface{} {
+	return &ListEventsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListEventsParams) Validate() error {
+	v := &validator{api: "listEvents"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListEventsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListEventsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListEventsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListEventsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListEventsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListEventsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListEventsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListEventsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListEventsParams) SetDuration(v int) {
+	p.Duration = &v
+	return
+}
+
+func (p *ListEventsParams) GetDuration() int {
+	if p.Duration == nil {
+		return 0
+	}
+	return *p.Duration
+}
+
+func (p *ListEventsParams) HasDuration() bool {
+	return p.Duration != nil
+}
+
+func (p *ListEventsParams) ResetDuration() {
+	p.Duration = nil
+}
+
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd.
+func (p *ListEventsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
+}
+
+func (p *ListEventsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
+	}
+	return *p.Enddate
+}
+
+func (p *ListEventsParams) HasEnddate() bool {
+	return p.Enddate != nil
+}
+
+func (p *ListEventsParams) ResetEnddate() {
+	p.Enddate = nil
+}
+
+func (p *ListEventsParams) SetEntrytime(v int) {
+	p.Entrytime = &v
+	return
+}
+
+func (p *ListEventsParams) GetEntrytime() int {
+	if p.Entrytime == nil {
+		return 0
+	}
+	return *p.Entrytime
+}
+
+func (p *ListEventsParams) HasEntrytime() bool {
+	return p.Entrytime != nil
+}
+
+func (p *ListEventsParams) ResetEntrytime() {
+	p.Entrytime = nil
+}
+
+func (p *ListEventsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListEventsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListEventsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListEventsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListEventsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListEventsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListEventsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListEventsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListEventsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListEventsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListEventsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListEventsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListEventsParams) SetLevel(v string) {
+	p.Level = &v
+	return
+}
+
+func (p *ListEventsParams) GetLevel() string {
+	if p.Level == nil {
+		return ""
+	}
+	return *p.Level
+}
+
+func (p *ListEventsParams) HasLevel() bool {
+	return p.Level != nil
+}
+
+func (p *ListEventsParams) ResetLevel() {
+	p.Level = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListEventsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListEventsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListEventsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListEventsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListEventsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListEventsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListEventsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListEventsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListEventsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListEventsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListEventsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListEventsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListEventsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListEventsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListEventsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListEventsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd.
+func (p *ListEventsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *ListEventsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *ListEventsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *ListEventsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+func (p *ListEventsParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ListEventsParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ListEventsParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListEventsParams) ResetType() {
+	p.Type = nil
+}
+
+type ListEventsResponse struct {
+	Count  int                        `json:"count" xml:"count"`
+	Events []*Event                   `json:"event" xml:"event"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Event struct {
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created     Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Level       string                     `json:"level,omitempty" xml:"level,omitempty"`
+	Parentid    string                     `json:"parentid,omitempty" xml:"parentid,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State       string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Type        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Username    string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListEventTypesParams struct {
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListEventTypesParams) URLValues() url.Values {
+	u := url.Values{}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListEventTypesParams) Command() string {
+	return "listEventTypes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListEventTypesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListEventTypesParams) ResponseType() interface{} {
+	return &ListEventTypesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListEventTypesParams) Validate() error {
+	v := &validator{api: "listEventTypes"}
+	return v.err()
+}
+
+type ListEventTypesResponse struct {
+	Count      int                        `json:"count" xml:"count"`
+	EventTypes []*EventType               `json:"eventtype" xml:"eventtype"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type EventType struct {
+	Name  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the EventService that is the same for all versions
+type EventService interface {
+	NewArchiveEventsParams() *ArchiveEventsParams
+	ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	NewDeleteEventsParams() *DeleteEventsParams
+	DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	NewListEventsParams() *ListEventsParams
+	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
+	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+	StreamEvents(ctx context.Context, p *ListEventsParams, fn func(*Event) error) (int, error)
+	NewListEventTypesParams() *ListEventTypesParams
+	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	StreamEventTypes(ctx context.Context, p *ListEventTypesParams, fn func(*EventType) error) (int, error)
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestArchiveEvents(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+
+	p := &ArchiveEventsParams{}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "archiveEvents", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "archiveEvents" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ArchiveEventsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "archiveEvents", `{"archiveeventsresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestDeleteEvents(t *testing.T) {
+	params := url.Values{
+		"enddate":   {"test-enddate"},
+		"ids":       {"test-ids1, test-ids2"},
+		"startdate": {"test-startdate"},
+		"type":      {"test-type"},
+	}
+
+	p := &DeleteEventsParams{}
+	p.SetEnddate("test-enddate")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteEvents", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteEvents" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteEventsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteEvents", `{"deleteeventsresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListEvents(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"duration":    {"3"},
+		"enddate":     {"test-enddate"},
+		"entrytime":   {"5"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"level":       {"test-level"},
+		"listall":     {"true"},
+		"page":        {"11"},
+		"pagesize":    {"12"},
+		"projectid":   {"test-projectid"},
+		"startdate":   {"test-startdate"},
+		"type":        {"test-type"},
+	}
+
+	p := &ListEventsParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetDuration(3)
+	p.SetEnddate("test-enddate")
+	p.SetEntrytime(5)
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetLevel("test-level")
+	p.SetListall(true)
+	p.SetPage(11)
+	p.SetPagesize(12)
+	p.SetProjectid("test-projectid")
+	p.SetStartdate("test-startdate")
+	p.SetType("test-type")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listEvents", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listEvents" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListEventsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listEvents", `{"listeventsresponse":{"count":1,"event":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","description":"test-description","domain":"test-domain","domainid":"test-domainid","id":"test-id","level":"test-level","parentid":"test-parentid","project":"test-project","projectid":"test-projectid","state":"test-state","type":"test-type","username":"test-username"}]}}`, false, r)
+	if r.Events[0].Account != "test-account" {
+		t.Errorf("Expected r.Events[0].Account to be %v, got %v", "test-account", r.Events[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestListEventTypes(t *testing.T) {
+	params := url.Values{}
+
+	p := &ListEventTypesParams{}
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listEventTypes", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listEventTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListEventTypesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listEventTypes", `{"listeventtypesresponse":{"count":1,"eventtype":[{"name":"test-name"}]}}`, false, r)
+	if r.EventTypes[0].Name != "test-name" {
+		t.Errorf("Expected r.EventTypes[0].Name to be %v, got %v", "test-name", r.EventTypes[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,767 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type DeleteEgressFirewallRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteEgressFirewallRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteEgressFirewallRuleParams) Command() string {
+	return "deleteEgressFirewallRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteEgressFirewallRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteEgressFirewallRuleParams) ResponseType() interface{} {
+	return &DeleteEgressFirewallRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteEgressFirewallRuleParams) Validate() error {
+	v := &validator{api: "deleteEgressFirewallRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteEgressFirewallRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteEgressFirewallRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteEgressFirewallRuleParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteEgressFirewallRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteFirewallRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteFirewallRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteFirewallRuleParams) Command() string {
+	return "deleteFirewallRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteFirewallRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteFirewallRuleParams) ResponseType() interface{} {
+	return &DeleteFirewallRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteFirewallRuleParams) Validate() error {
+	v := &validator{api: "deleteFirewallRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteFirewallRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteFirewallRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteFirewallRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteFirewallRuleParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteFirewallRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddPaloAltoFirewallParams struct {
+	Networkdevicetype *string `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Password          *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url               *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username          *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddPaloAltoFirewallParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Networkdevicetype; v != nil {
+		u.Set("networkdevicetype", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddPaloAltoFirewallParams) Command() string {
+	return "addPaloAltoFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddPaloAltoFirewallParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddPaloAltoFirewallParams) ResponseType() interface{} {
+	return &AddPaloAltoFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddPaloAltoFirewallParams) Validate() error {
+	v := &validator{api: "addPaloAltoFirewall"}
+	v.required("networkdevicetype", p.Networkdevicetype != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
+	p.Networkdevicetype = &v
+	return
+}
+
+func (p *AddPaloAltoFirewallParams) GetNetworkdevicetype() string {
+	if p.Networkdevicetype == nil {
+		return ""
+	}
+	return *p.Networkdevicetype
+}
+
+func (p *AddPaloAltoFirewallParams) HasNetworkdevicetype() bool {
+	return p.Networkdevicetype != nil
+}
+
+func (p *AddPaloAltoFirewallParams) ResetNetworkdevicetype() {
+	p.Networkdevicetype = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddPaloAltoFirewallParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddPaloAltoFirewallParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddPaloAltoFirewallParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddPaloAltoFirewallParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddPaloAltoFirewallParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddPaloAltoFirewallParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddPaloAltoFirewallParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddPaloAltoFirewallParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddPaloAltoFirewallParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddPaloAltoFirewallParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddPaloAltoFirewallParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddPaloAltoFirewallParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddPaloAltoFirewallParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddPaloAltoFirewallParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddPaloAltoFirewallParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddPaloAltoFirewallParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddPaloAltoFirewallResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Fwdevicecapacity  int64                      `json:"fwdevicecapacity,omitempty" xml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid        string                     `json:"fwdeviceid,omitempty" xml:"fwdeviceid,omitempty"`
+	Fwdevicename      string                     `json:"fwdevicename,omitempty" xml:"fwdevicename,omitempty"`
+	Fwdevicestate     string                     `json:"fwdevicestate,omitempty" xml:"fwdevicestate,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries        string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone       string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone        string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout           string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface    string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username          string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ConfigurePaloAltoFirewallParams struct {
+	Fwdevicecapacity *int64  `json:"fwdevicecapacity,omitempty" yaml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid       *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigurePaloAltoFirewallParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Fwdevicecapacity; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("fwdevicecapacity", vv)
+	}
+	if v := p.Fwdeviceid; v != nil {
+		u.Set("fwdeviceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ConfigurePaloAltoFirewallParams) Command() string {
+	return "configurePaloAltoFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigurePaloAltoFirewallParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigurePaloAltoFirewallParams) ResponseType() interface{} {
+	return &ConfigurePaloAltoFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigurePaloAltoFirewallParams) Validate() error {
+	v := &validator{api: "configurePaloAltoFirewall"}
+	v.required("fwdeviceid", p.Fwdeviceid != nil)
+	return v.err()
+}
+
+func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
+	p.Fwdevicecapacity = &v
+	return
+}
+
+func (p *ConfigurePaloAltoFirewallParams) GetFwdevicecapacity() int64 {
+	if p.Fwdevicecapacity == nil {
+		return 0
+	}
+	return *p.Fwdevicecapacity
+}
+
+func (p *ConfigurePaloAltoFirewallParams) HasFwdevicecapacity() bool {
+	return p.Fwdevicecapacity != nil
+}
+
+func (p *ConfigurePaloAltoFirewallParams) ResetFwdevicecapacity() {
+	p.Fwdevicecapacity = nil
+}
+
+// This param is required.
+func (p *ConfigurePaloAltoFirewallParams) SetFwdeviceid(v string) {
+	p.Fwdeviceid = &v
+	return
+}
+
+func (p *ConfigurePaloAltoFirewallParams) GetFwdeviceid() string {
+	if p.Fwdeviceid == nil {
+		return ""
+	}
+	return *p.Fwdeviceid
+}
+
+func (p *ConfigurePaloAltoFirewallParams) HasFwdeviceid() bool {
+	return p.Fwdeviceid != nil
+}
+
+func (p *ConfigurePaloAltoFirewallParams) ResetFwdeviceid() {
+	p.Fwdeviceid = nil
+}
+
+type ConfigurePaloAltoFirewallResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Fwdevicecapacity  int64                      `json:"fwdevicecapacity,omitempty" xml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid        string                     `json:"fwdeviceid,omitempty" xml:"fwdeviceid,omitempty"`
+	Fwdevicename      string                     `json:"fwdevicename,omitempty" xml:"fwdevicename,omitempty"`
+	Fwdevicestate     string                     `json:"fwdevicestate,omitempty" xml:"fwdevicestate,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries        string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone       string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone        string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout           string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface    string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username          string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePaloAltoFirewallParams struct {
+	Fwdeviceid *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeletePaloAltoFirewallParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Fwdeviceid; v != nil {
+		u.Set("fwdeviceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeletePaloAltoFirewallParams) Command() string {
+	return "deletePaloAltoFirewall"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeletePaloAltoFirewallParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeletePaloAltoFirewallParams) ResponseType() interface{} {
+	return &DeletePaloAltoFirewallResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeletePaloAltoFirewallParams) Validate() error {
+	v := &validator{api: "deletePaloAltoFirewall"}
+	v.required("fwdeviceid", p.Fwdeviceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
+	p.Fwdeviceid = &v
+	return
+}
+
+func (p *DeletePaloAltoFirewallParams) GetFwdeviceid() string {
+	if p.Fwdeviceid == nil {
+		return ""
+	}
+	return *p.Fwdeviceid
+}
+
+func (p *DeletePaloAltoFirewallParams) HasFwdeviceid() bool {
+	return p.Fwdeviceid != nil
+}
+
+func (p *DeletePaloAltoFirewallParams) ResetFwdeviceid() {
+	p.Fwdeviceid = nil
+}
+
+type DeletePaloAltoFirewallResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListPaloAltoFirewallsParams struct {
+	Fwdeviceid        *string `json:"fwdeviceid,omitempty" yaml:"fwdeviceid,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPaloAltoFirewallsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Fwdeviceid; v != nil {
+		u.Set("fwdeviceid", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListPaloAltoFirewallsParams) Command() string {
+	return "listPaloAltoFirewalls"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPaloAltoFirewallsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPaloAltoFirewallsParams) ResponseType() interface{} {
+	return &ListPaloAltoFirewallsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPaloAltoFirewallsParams) Validate() error {
+	v := &validator{api: "listPaloAltoFirewalls"}
+	return v.err()
+}
+
+func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
+	p.Fwdeviceid = &v
+	return
+}
+
+func (p *ListPaloAltoFirewallsParams) GetFwdeviceid() string {
+	if p.Fwdeviceid == nil {
+		return ""
+	}
+	return *p.Fwdeviceid
+}
+
+func (p *ListPaloAltoFirewallsParams) HasFwdeviceid() bool {
+	return p.Fwdeviceid != nil
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetFwdeviceid() {
+	p.Fwdeviceid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPaloAltoFirewallsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListPaloAltoFirewallsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListPaloAltoFirewallsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPaloAltoFirewallsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListPaloAltoFirewallsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListPaloAltoFirewallsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPaloAltoFirewallsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListPaloAltoFirewallsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListPaloAltoFirewallsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListPaloAltoFirewallsParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListPaloAltoFirewallsParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListPaloAltoFirewallsParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListPaloAltoFirewallsParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+type ListPaloAltoFirewallsResponse struct {
+	Count             int                        `json:"count" xml:"count"`
+	PaloAltoFirewalls []*PaloAltoFirewall        `json:"paloaltofirewall" xml:"paloaltofirewall"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PaloAltoFirewall struct {
+	Fwdevicecapacity  int64                      `json:"fwdevicecapacity,omitempty" xml:"fwdevicecapacity,omitempty"`
+	Fwdeviceid        string                     `json:"fwdeviceid,omitempty" xml:"fwdeviceid,omitempty"`
+	Fwdevicename      string                     `json:"fwdevicename,omitempty" xml:"fwdevicename,omitempty"`
+	Fwdevicestate     string                     `json:"fwdevicestate,omitempty" xml:"fwdevicestate,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries        string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Privateinterface  string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Privatezone       string                     `json:"privatezone,omitempty" xml:"privatezone,omitempty"`
+	Provider          string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface   string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Publiczone        string                     `json:"publiczone,omitempty" xml:"publiczone,omitempty"`
+	Timeout           string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Usageinterface    string                     `json:"usageinterface,omitempty" xml:"usageinterface,omitempty"`
+	Username          string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePortForwardingRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeletePortForwardingRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeletePortForwardingRuleParams) Command() string {
+	return "deletePortForwardingRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeletePortForwardingRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeletePortForwardingRuleParams) ResponseType() interface{} {
+	return &DeletePortForwardingRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeletePortForwardingRuleParams) Validate() error {
+	v := &validator{api: "deletePortForwardingRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeletePortForwardingRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeletePortForwardingRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeletePortForwardingRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeletePortForwardingRuleParams) ResetId() {
+	p.Id = nil
+}
+
+type DeletePortForwardingRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the FirewallService that is the same for all versions
+type FirewallService interface {
+	NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams
+	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams
+	DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams
+	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams
+	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*ConfigurePaloAltoFirewallResponse, error)
+	NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams
+	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams
+	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	StreamPaloAltoFirewalls(ctx context.Context, p *ListPaloAltoFirewallsParams, fn func(*PaloAltoFirewall) error) (int, error)
+	NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams
+	DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+}
@@ -0,0 +1,243 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDeleteEgressFirewallRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteEgressFirewallRuleParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteEgressFirewallRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteEgressFirewallRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteEgressFirewallRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteEgressFirewallRule", `{"deleteegressfirewallruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteFirewallRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteFirewallRuleParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteFirewallRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteFirewallRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteFirewallRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteFirewallRule", `{"deletefirewallruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestAddPaloAltoFirewall(t *testing.T) {
+	params := url.Values{
+		"networkdevicetype": {"test-networkdevicetype"},
+		"password":          {"test-password"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"url":               {"test-url"},
+		"username":          {"test-username"},
+	}
+
+	p := &AddPaloAltoFirewallParams{}
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addPaloAltoFirewall", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addPaloAltoFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddPaloAltoFirewallResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addPaloAltoFirewall", `{"addpaloaltofirewallresponse":{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","jobid":"test-jobid","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`, false, r)
+	if r.Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.Fwdeviceid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestConfigurePaloAltoFirewall(t *testing.T) {
+	params := url.Values{
+		"fwdevicecapacity": {"1"},
+		"fwdeviceid":       {"test-fwdeviceid"},
+	}
+
+	p := &ConfigurePaloAltoFirewallParams{}
+	p.SetFwdevicecapacity(1)
+	p.SetFwdeviceid("test-fwdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "configurePaloAltoFirewall", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "configurePaloAltoFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ConfigurePaloAltoFirewallResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "configurePaloAltoFirewall", `{"configurepaloaltofirewallresponse":{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","jobid":"test-jobid","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}}`, false, r)
+	if r.Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.Fwdeviceid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeletePaloAltoFirewall(t *testing.T) {
+	params := url.Values{
+		"fwdeviceid": {"test-fwdeviceid"},
+	}
+
+	p := &DeletePaloAltoFirewallParams{}
+	p.SetFwdeviceid("test-fwdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deletePaloAltoFirewall", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deletePaloAltoFirewall" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeletePaloAltoFirewallResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deletePaloAltoFirewall", `{"deletepaloaltofirewallresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListPaloAltoFirewalls(t *testing.T) {
+	params := url.Values{
+		"fwdeviceid":        {"test-fwdeviceid"},
+		"keyword":           {"test-keyword"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+
+	p := &ListPaloAltoFirewallsParams{}
+	p.SetFwdeviceid("test-fwdeviceid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listPaloAltoFirewalls", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listPaloAltoFirewalls" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListPaloAltoFirewallsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listPaloAltoFirewalls", `{"listpaloaltofirewallsresponse":{"count":1,"paloaltofirewall":[{"fwdevicecapacity":1,"fwdeviceid":"test-fwdeviceid","fwdevicename":"test-fwdevicename","fwdevicestate":"test-fwdevicestate","ipaddress":"test-ipaddress","numretries":"test-numretries","physicalnetworkid":"test-physicalnetworkid","privateinterface":"test-privateinterface","privatezone":"test-privatezone","provider":"test-provider","publicinterface":"test-publicinterface","publiczone":"test-publiczone","timeout":"test-timeout","usageinterface":"test-usageinterface","username":"test-username","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.PaloAltoFirewalls[0].Fwdeviceid != "test-fwdeviceid" {
+		t.Errorf("Expected r.PaloAltoFirewalls[0].Fwdeviceid to be %v, got %v", "test-fwdeviceid", r.PaloAltoFirewalls[0].Fwdeviceid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestDeletePortForwardingRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeletePortForwardingRuleParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deletePortForwardingRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deletePortForwardingRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeletePortForwardingRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deletePortForwardingRule", `{"deleteportforwardingruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
@@ -0,0 +1,202 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListOsCategoriesParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListOsCategoriesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListOsCategoriesParams) Command() string {
+	return "listOsCategories"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListOsCategoriesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListOsCategoriesParams) ResponseType() interface{} {
+	return &ListOsCategoriesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListOsCategoriesParams) Validate() error {
+	v := &validator{api: "listOsCategories"}
+	return v.err()
+}
+
+func (p *ListOsCategoriesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListOsCategoriesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListOsCategoriesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListOsCategoriesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListOsCategoriesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListOsCategoriesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListOsCategoriesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListOsCategoriesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListOsCategoriesParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListOsCategoriesParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListOsCategoriesParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListOsCategoriesParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListOsCategoriesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListOsCategoriesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListOsCategoriesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListOsCategoriesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListOsCategoriesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListOsCategoriesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListOsCategoriesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListOsCategoriesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListOsCategoriesResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	OsCategories []*OsCategory              `json:"oscategory" xml:"oscategory"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type OsCategory struct {
+	Id    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the GuestOSService that is the same for all versions
+type GuestOSService interface {
+	NewListOsCategoriesParams() *ListOsCategoriesParams
+	GetOsCategoryID(name string, opts ...OptionFunc) (string, error)
+	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	StreamOsCategories(ctx context.Context, p *ListOsCategoriesParams, fn func(*OsCategory) error) (int, error)
+}
@@ -0,0 +1,1045 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListDedicatedHostsParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid          *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDedicatedHostsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Affinitygroupid; v != nil {
+		u.Set("affinitygroupid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDedicatedHostsParams) Command() string {
+	return "listDedicatedHosts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDedicatedHostsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDedicatedHostsParams) ResponseType() interface{} {
+	return &ListDedicatedHostsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDedicatedHostsParams) Validate() error {
+	v := &validator{api: "listDedicatedHosts"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListDedicatedHostsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListDedicatedHostsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListDedicatedHostsParams) SetAffinitygroupid(v string) {
+	p.Affinitygroupid = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetAffinitygroupid() string {
+	if p.Affinitygroupid == nil {
+		return ""
+	}
+	return *p.Affinitygroupid
+}
+
+func (p *ListDedicatedHostsParams) HasAffinitygroupid() bool {
+	return p.Affinitygroupid != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetAffinitygroupid() {
+	p.Affinitygroupid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListDedicatedHostsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListDedicatedHostsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *ListDedicatedHostsParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *ListDedicatedHostsParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDedicatedHostsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDedicatedHostsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDedicatedHostsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDedicatedHostsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDedicatedHostsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDedicatedHostsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDedicatedHostsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDedicatedHostsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListDedicatedHostsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	DedicatedHosts []*DedicatedHost           `json:"dedicatedhost" xml:"dedicatedhost"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatedHost struct {
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hostid          string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname        string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleaseDedicatedHostParams struct {
+	Hostid *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleaseDedicatedHostParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleaseDedicatedHostParams) Command() string {
+	return "releaseDedicatedHost"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleaseDedicatedHostParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleaseDedicatedHostParams) ResponseType() interface{} {
+	return &ReleaseDedicatedHostResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleaseDedicatedHostParams) Validate() error {
+	v := &validator{api: "releaseDedicatedHost"}
+	v.required("hostid", p.Hostid != nil)
+	return v.err()
+}
+
+// Sets the 'hostid' param: the ID of the host. This param is required.
+func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *ReleaseDedicatedHostParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *ReleaseDedicatedHostParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ReleaseDedicatedHostParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+type ReleaseDedicatedHostResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicateHostParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hostid   *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DedicateHostParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DedicateHostParams) Command() string {
+	return "dedicateHost"
+}
+
+// Returns true if the API command starts an async job
+func (p *DedicateHostParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DedicateHostParams) ResponseType() interface{} {
+	return &DedicateHostResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DedicateHostParams) Validate() error {
+	v := &validator{api: "dedicateHost"}
+	v.required("domainid", p.Domainid != nil)
+	v.required("hostid", p.Hostid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DedicateHostParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DedicateHostParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DedicateHostParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DedicateHostParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *DedicateHostParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DedicateHostParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DedicateHostParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DedicateHostParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'hostid' param: the ID of the host. This param is required.
+func (p *DedicateHostParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *DedicateHostParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *DedicateHostParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *DedicateHostParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+type DedicateHostResponse struct {
+	JobID           string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Hostid          string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname        string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteHostParams struct {
+	Forced                   *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Forcedestroylocalstorage *bool   `json:"forcedestroylocalstorage,omitempty" yaml:"forcedestroylocalstorage,omitempty"`
+	Id                       *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteHostParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
+	}
+	if v := p.Forcedestroylocalstorage; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forcedestroylocalstorage", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteHostParams) Command() string {
+	return "deleteHost"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteHostParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteHostParams) ResponseType() interface{} {
+	return &DeleteHostResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteHostParams) Validate() error {
+	v := &validator{api: "deleteHost"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *DeleteHostParams) SetForced(v bool) {
+	p.Forced = &v
+	return
+}
+
+func (p *DeleteHostParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
+	}
+	return *p.Forced
+}
+
+func (p *DeleteHostParams) HasForced() bool {
+	return p.Forced != nil
+}
+
+func (p *DeleteHostParams) ResetForced() {
+	p.Forced = nil
+}
+
+func (p *DeleteHostParams) SetForcedestroylocalstorage(v bool) {
+	p.Forcedestroylocalstorage = &v
+	return
+}
+
+func (p *DeleteHostParams) GetForcedestroylocalstorage() bool {
+	if p.Forcedestroylocalstorage == nil {
+		return false
+	}
+	return *p.Forcedestroylocalstorage
+}
+
+func (p *DeleteHostParams) HasForcedestroylocalstorage() bool {
+	return p.Forcedestroylocalstorage != nil
+}
+
+func (p *DeleteHostParams) ResetForcedestroylocalstorage() {
+	p.Forcedestroylocalstorage = nil
+}
+
+// This param is required.
+func (p *DeleteHostParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteHostParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteHostParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteHostParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteHostResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateHostPasswordParams struct {
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Hostid    *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Password  *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateHostPasswordParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateHostPasswordParams) Command() string {
+	return "updateHostPassword"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateHostPasswordParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateHostPasswordParams) ResponseType() interface{} {
+	return &UpdateHostPasswordResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateHostPasswordParams) Validate() error {
+	v := &validator{api: "updateHostPassword"}
+	v.required("password", p.Password != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *UpdateHostPasswordParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *UpdateHostPasswordParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *UpdateHostPasswordParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *UpdateHostPasswordParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *UpdateHostPasswordParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *UpdateHostPasswordParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *UpdateHostPasswordParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *UpdateHostPasswordParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *UpdateHostPasswordParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *UpdateHostPasswordParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *UpdateHostPasswordParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *UpdateHostPasswordParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *UpdateHostPasswordParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *UpdateHostPasswordParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *UpdateHostPasswordParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *UpdateHostPasswordParams) ResetUsername() {
+	p.Username = nil
+}
+
+type UpdateHostPasswordResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleaseHostReservationParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleaseHostReservationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleaseHostReservationParams) Command() string {
+	return "releaseHostReservation"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleaseHostReservationParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleaseHostReservationParams) ResponseType() interface{} {
+	return &ReleaseHostReservationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleaseHostReservationParams) Validate() error {
+	v := &validator{api: "releaseHostReservation"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ReleaseHostReservationParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ReleaseHostReservationParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ReleaseHostReservationParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ReleaseHostReservationParams) ResetId() {
+	p.Id = nil
+}
+
+type ReleaseHostReservationResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type FindHostsForMigrationParams struct {
+	Keyword          *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page             *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *FindHostsForMigrationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *FindHostsForMigrationParams) Command() string {
+	return "findHostsForMigration"
+}
+
+// Returns true if the API command starts an async job
+func (p *FindHostsForMigrationParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *FindHostsForMigrationParams) ResponseType() interface{} {
+	return &FindHostsForMigrationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *FindHostsForMigrationParams) Validate() error {
+	v := &validator{api: "findHostsForMigration"}
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *FindHostsForMigrationParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *FindHostsForMigrationParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *FindHostsForMigrationParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *FindHostsForMigrationParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *FindHostsForMigrationParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *FindHostsForMigrationParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *FindHostsForMigrationParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *FindHostsForMigrationParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *FindHostsForMigrationParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *FindHostsForMigrationParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *FindHostsForMigrationParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *FindHostsForMigrationParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *FindHostsForMigrationParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *FindHostsForMigrationParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *FindHostsForMigrationParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *FindHostsForMigrationParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+type FindHostsForMigrationResponse struct {
+	Averageload             int64                      `json:"averageload,omitempty" xml:"averageload,omitempty"`
+	Capabilities            string                     `json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	Clusterid               string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername             string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Clustertype             string                     `json:"clustertype,omitempty" xml:"clustertype,omitempty"`
+	Cpuallocated            Percentage                 `json:"cpuallocated,omitempty" xml:"cpuallocated,omitempty"`
+	Cpunumber               int                        `json:"cpunumber,omitempty" xml:"cpunumber,omitempty"`
+	Cpuspeed                int64                      `json:"cpuspeed,omitempty" xml:"cpuspeed,omitempty"`
+	Cpuused                 Percentage                 `json:"cpuused,omitempty" xml:"cpuused,omitempty"`
+	Cpuwithoverprovisioning string                     `json:"cpuwithoverprovisioning,omitempty" xml:"cpuwithoverprovisioning,omitempty"`
+	Created                 Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Disconnected            string                     `json:"disconnected,omitempty" xml:"disconnected,omitempty"`
+	Disksizeallocated       Size                       `json:"disksizeallocated,omitempty" xml:"disksizeallocated,omitempty"`
+	Disksizetotal           Size                       `json:"disksizetotal,omitempty" xml:"disksizetotal,omitempty"`
+	Events                  string                     `json:"events,omitempty" xml:"events,omitempty"`
+	Hahost                  Bool                       `json:"hahost,omitempty" xml:"hahost,omitempty"`
+	Hasenoughcapacity       Bool                       `json:"hasenoughcapacity,omitempty" xml:"hasenoughcapacity,omitempty"`
+	Hosttags                string                     `json:"hosttags,omitempty" xml:"hosttags,omitempty"`
+	Hypervisor              HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion       string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Islocalstorageactive    Bool                       `json:"islocalstorageactive,omitempty" xml:"islocalstorageactive,omitempty"`
+	Lastpinged              string                     `json:"lastpinged,omitempty" xml:"lastpinged,omitempty"`
+	Managementserverid      int64                      `json:"managementserverid,omitempty" xml:"managementserverid,omitempty"`
+	Memoryallocated         int64                      `json:"memoryallocated,omitempty" xml:"memoryallocated,omitempty"`
+	Memorytotal             int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Memoryused              int64                      `json:"memoryused,omitempty" xml:"memoryused,omitempty"`
+	Name                    string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkkbsread          int64                      `json:"networkkbsread,omitempty" xml:"networkkbsread,omitempty"`
+	Networkkbswrite         int64                      `json:"networkkbswrite,omitempty" xml:"networkkbswrite,omitempty"`
+	Oscategoryid            string                     `json:"oscategoryid,omitempty" xml:"oscategoryid,omitempty"`
+	Oscategoryname          string                     `json:"oscategoryname,omitempty" xml:"oscategoryname,omitempty"`
+	Podid                   string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname                 string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Removed                 Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	RequiresStorageMotion   Bool                       `json:"requiresStorageMotion,omitempty" xml:"requiresStorageMotion,omitempty"`
+	Resourcestate           string                     `json:"resourcestate,omitempty" xml:"resourcestate,omitempty"`
+	State                   string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Suitableformigration    Bool                       `json:"suitableformigration,omitempty" xml:"suitableformigration,omitempty"`
+	Type                    string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Version                 string                     `json:"version,omitempty" xml:"version,omitempty"`
+	Zoneid                  string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename                string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddSecondaryStorageParams struct {
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddSecondaryStorageParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddSecondaryStorageParams) Command() string {
+	return "addSecondaryStorage"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddSecondaryStorageParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddSecondaryStorageParams) ResponseType() interface{} {
+	return &AddSecondaryStorageResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddSecondaryStorageParams) Validate() error {
+	v := &validator{api: "addSecondaryStorage"}
+	v.required("url", p.Url != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddSecondaryStorageParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddSecondaryStorageParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddSecondaryStorageParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddSecondaryStorageParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *AddSecondaryStorageParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddSecondaryStorageParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddSecondaryStorageParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddSecondaryStorageParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type AddSecondaryStorageResponse struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the HostService that is the same for all versions
+type HostService interface {
+	NewListDedicatedHostsParams() *ListDedicatedHostsParams
+	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	StreamDedicatedHosts(ctx context.Context, p *ListDedicatedHostsParams, fn func(*DedicatedHost) error) (int, error)
+	NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams
+	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams
+	DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error)
+	NewDeleteHostParams(id string) *DeleteHostParams
+	DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error)
+	NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams
+	UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams
+	ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams
+	FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams
+	AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+}
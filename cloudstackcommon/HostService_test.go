@@ -0,0 +1,276 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListDedicatedHosts(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"domainid":        {"test-domainid"},
+		"hostid":          {"test-hostid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"6"},
+		"pagesize":        {"7"},
+	}
+
+	p := &ListDedicatedHostsParams{}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetDomainid("test-domainid")
+	p.SetHostid("test-hostid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDedicatedHosts", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedHosts" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDedicatedHostsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDedicatedHosts", `{"listdedicatedhostsresponse":{"count":1,"dedicatedhost":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id"}]}}`, false, r)
+	if r.DedicatedHosts[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedHosts[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedHosts[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestReleaseDedicatedHost(t *testing.T) {
+	params := url.Values{
+		"hostid": {"test-hostid"},
+	}
+
+	p := &ReleaseDedicatedHostParams{}
+	p.SetHostid("test-hostid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releaseDedicatedHost", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedHost" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleaseDedicatedHostResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releaseDedicatedHost", `{"releasededicatedhostresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDedicateHost(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"hostid":   {"test-hostid"},
+	}
+
+	p := &DedicateHostParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetHostid("test-hostid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "dedicateHost", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateHost" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DedicateHostResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "dedicateHost", `{"dedicatehostresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid"}}`, false, r)
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteHost(t *testing.T) {
+	params := url.Values{
+		"forced":                   {"true"},
+		"forcedestroylocalstorage": {"true"},
+		"id":                       {"test-id"},
+	}
+
+	p := &DeleteHostParams{}
+	p.SetForced(true)
+	p.SetForcedestroylocalstorage(true)
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteHost", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteHost" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteHostResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteHost", `{"deletehostresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestUpdateHostPassword(t *testing.T) {
+	params := url.Values{
+		"clusterid": {"test-clusterid"},
+		"hostid":    {"test-hostid"},
+		"password":  {"test-password"},
+		"username":  {"test-username"},
+	}
+
+	p := &UpdateHostPasswordParams{}
+	p.SetClusterid("test-clusterid")
+	p.SetHostid("test-hostid")
+	p.SetPassword("test-password")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateHostPassword", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateHostPassword" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateHostPasswordResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateHostPassword", `{"updatehostpasswordresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestReleaseHostReservation(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &ReleaseHostReservationParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releaseHostReservation", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releaseHostReservation" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleaseHostReservationResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releaseHostReservation", `{"releasehostreservationresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestFindHostsForMigration(t *testing.T) {
+	params := url.Values{
+		"keyword":          {"test-keyword"},
+		"page":             {"2"},
+		"pagesize":         {"3"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+
+	p := &FindHostsForMigrationParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetVirtualmachineid("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "findHostsForMigration", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "findHostsForMigration" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*FindHostsForMigrationResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "findHostsForMigration", `{"findhostsformigrationresponse":{"averageload":1,"capabilities":"test-capabilities","clusterid":"test-clusterid","clustername":"test-clustername","clustertype":"test-clustertype","cpuallocated":"12.5%","cpunumber":1,"cpuspeed":1,"cpuused":"12.5%","cpuwithoverprovisioning":"test-cpuwithoverprovisioning","created":"2014-01-02T15:04:05+0100","disconnected":"test-disconnected","disksizeallocated":1,"disksizetotal":1,"events":"test-events","hahost":true,"hasenoughcapacity":true,"hosttags":"test-hosttags","hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","ipaddress":"test-ipaddress","islocalstorageactive":true,"lastpinged":"test-lastpinged","managementserverid":1,"memoryallocated":1,"memorytotal":1,"memoryused":1,"name":"test-name","networkkbsread":1,"networkkbswrite":1,"oscategoryid":"test-oscategoryid","oscategoryname":"test-oscategoryname","podid":"test-podid","podname":"test-podname","removed":"2014-01-02T15:04:05+0100","requiresStorageMotion":true,"resourcestate":"test-resourcestate","state":"test-state","suitableformigration":true,"type":"test-type","version":"test-version","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Capabilities != "test-capabilities" {
+		t.Errorf("Expected r.Capabilities to be %v, got %v", "test-capabilities", r.Capabilities)
+	}
+}
+
+func TestAddSecondaryStorage(t *testing.T) {
+	params := url.Values{
+		"url":    {"test-url"},
+		"zoneid": {"test-zoneid"},
+	}
+
+	p := &AddSecondaryStorageParams{}
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addSecondaryStorage", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addSecondaryStorage" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddSecondaryStorageResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addSecondaryStorage", `{"addsecondarystorageresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
@@ -0,0 +1,399 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListHypervisorsParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListHypervisorsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListHypervisorsParams) Command() string {
+	return "listHypervisors"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListHypervisorsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListHypervisorsParams) ResponseType() interface{} {
+	return &ListHypervisorsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListHypervisorsParams) Validate() error {
+	v := &validator{api: "listHypervisors"}
+	return v.err()
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListHypervisorsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListHypervisorsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListHypervisorsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListHypervisorsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListHypervisorsResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	Hypervisors []*Hypervisor              `json:"hypervisor" xml:"hypervisor"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Hypervisor struct {
+	Name  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListHypervisorCapabilitiesParams struct {
+	Hypervisor *HypervisorType `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id         *string         `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword    *string         `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page       *int            `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize   *int            `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListHypervisorCapabilitiesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListHypervisorCapabilitiesParams) Command() string {
+	return "listHypervisorCapabilities"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListHypervisorCapabilitiesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListHypervisorCapabilitiesParams) ResponseType() interface{} {
+	return &ListHypervisorCapabilitiesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListHypervisorCapabilitiesParams) Validate() error {
+	v := &validator{api: "listHypervisorCapabilities"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *ListHypervisorCapabilitiesParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *ListHypervisorCapabilitiesParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *ListHypervisorCapabilitiesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListHypervisorCapabilitiesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListHypervisorCapabilitiesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListHypervisorCapabilitiesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListHypervisorCapabilitiesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListHypervisorCapabilitiesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListHypervisorCapabilitiesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListHypervisorCapabilitiesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListHypervisorCapabilitiesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListHypervisorCapabilitiesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListHypervisorCapabilitiesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListHypervisorCapabilitiesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListHypervisorCapabilitiesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListHypervisorCapabilitiesResponse struct {
+	Count                  int                        `json:"count" xml:"count"`
+	HypervisorCapabilities []*HypervisorCapability    `json:"hypervisorcapability" xml:"hypervisorcapability"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type HypervisorCapability struct {
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion    string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Maxdatavolumeslimit  int                        `json:"maxdatavolumeslimit,omitempty" xml:"maxdatavolumeslimit,omitempty"`
+	Maxguestslimit       int64                      `json:"maxguestslimit,omitempty" xml:"maxguestslimit,omitempty"`
+	Maxhostspercluster   int                        `json:"maxhostspercluster,omitempty" xml:"maxhostspercluster,omitempty"`
+	Securitygroupenabled Bool                       `json:"securitygroupenabled,omitempty" xml:"securitygroupenabled,omitempty"`
+	Storagemotionenabled Bool                       `json:"storagemotionenabled,omitempty" xml:"storagemotionenabled,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateHypervisorCapabilitiesParams struct {
+	Id                   *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Maxguestslimit       *int64  `json:"maxguestslimit,omitempty" yaml:"maxguestslimit,omitempty"`
+	Securitygroupenabled *bool   `json:"securitygroupenabled,omitempty" yaml:"securitygroupenabled,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateHypervisorCapabilitiesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Maxguestslimit; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("maxguestslimit", vv)
+	}
+	if v := p.Securitygroupenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("securitygroupenabled", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateHypervisorCapabilitiesParams) Command() string {
+	return "updateHypervisorCapabilities"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateHypervisorCapabilitiesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateHypervisorCapabilitiesParams) ResponseType() interface{} {
+	return &UpdateHypervisorCapabilitiesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateHypervisorCapabilitiesParams) Validate() error {
+	v := &validator{api: "updateHypervisorCapabilities"}
+	return v.err()
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) SetMaxguestslimit(v int64) {
+	p.Maxguestslimit = &v
+	return
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) GetMaxguestslimit() int64 {
+	if p.Maxguestslimit == nil {
+		return 0
+	}
+	return *p.Maxguestslimit
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) HasMaxguestslimit() bool {
+	return p.Maxguestslimit != nil
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) ResetMaxguestslimit() {
+	p.Maxguestslimit = nil
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) SetSecuritygroupenabled(v bool) {
+	p.Securitygroupenabled = &v
+	return
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) GetSecuritygroupenabled() bool {
+	if p.Securitygroupenabled == nil {
+		return false
+	}
+	return *p.Securitygroupenabled
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) HasSecuritygroupenabled() bool {
+	return p.Securitygroupenabled != nil
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) ResetSecuritygroupenabled() {
+	p.Securitygroupenabled = nil
+}
+
+type UpdateHypervisorCapabilitiesResponse struct {
+	Hypervisor           HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Hypervisorversion    string                     `json:"hypervisorversion,omitempty" xml:"hypervisorversion,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Maxdatavolumeslimit  int                        `json:"maxdatavolumeslimit,omitempty" xml:"maxdatavolumeslimit,omitempty"`
+	Maxguestslimit       int64                      `json:"maxguestslimit,omitempty" xml:"maxguestslimit,omitempty"`
+	Maxhostspercluster   int                        `json:"maxhostspercluster,omitempty" xml:"maxhostspercluster,omitempty"`
+	Securitygroupenabled Bool                       `json:"securitygroupenabled,omitempty" xml:"securitygroupenabled,omitempty"`
+	Storagemotionenabled Bool                       `json:"storagemotionenabled,omitempty" xml:"storagemotionenabled,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the HypervisorService that is the same for all versions
+type HypervisorService interface {
+	NewListHypervisorsParams() *ListHypervisorsParams
+	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	StreamHypervisors(ctx context.Context, p *ListHypervisorsParams, fn func(*Hypervisor) error) (int, error)
+	NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams
+	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	StreamHypervisorCapabilities(ctx context.Context, p *ListHypervisorCapabilitiesParams, fn func(*HypervisorCapability) error) (int, error)
+	NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams
+	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListHypervisors(t *testing.T) {
+	params := url.Values{
+		"zoneid": {"test-zoneid"},
+	}
+
+	p := &ListHypervisorsParams{}
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listHypervisors", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listHypervisors" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListHypervisorsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listHypervisors", `{"listhypervisorsresponse":{"count":1,"hypervisor":[{"name":"test-name"}]}}`, false, r)
+	if r.Hypervisors[0].Name != "test-name" {
+		t.Errorf("Expected r.Hypervisors[0].Name to be %v, got %v", "test-name", r.Hypervisors[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestListHypervisorCapabilities(t *testing.T) {
+	params := url.Values{
+		"hypervisor": {"BareMetal"},
+		"id":         {"test-id"},
+		"keyword":    {"test-keyword"},
+		"page":       {"4"},
+		"pagesize":   {"5"},
+	}
+
+	p := &ListHypervisorCapabilitiesParams{}
+	p.SetHypervisor(HypervisorBareMetal)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listHypervisorCapabilities", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listHypervisorCapabilities" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListHypervisorCapabilitiesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listHypervisorCapabilities", `{"listhypervisorcapabilitiesresponse":{"count":1,"hypervisorcapability":[{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","maxdatavolumeslimit":1,"maxguestslimit":1,"maxhostspercluster":1,"securitygroupenabled":true,"storagemotionenabled":true}]}}`, false, r)
+	if r.HypervisorCapabilities[0].Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.HypervisorCapabilities[0].Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.HypervisorCapabilities[0].Hypervisorversion)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateHypervisorCapabilities(t *testing.T) {
+	params := url.Values{
+		"id":                   {"test-id"},
+		"maxguestslimit":       {"2"},
+		"securitygroupenabled": {"true"},
+	}
+
+	p := &UpdateHypervisorCapabilitiesParams{}
+	p.SetId("test-id")
+	p.SetMaxguestslimit(2)
+	p.SetSecuritygroupenabled(true)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateHypervisorCapabilities", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateHypervisorCapabilities" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateHypervisorCapabilitiesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateHypervisorCapabilities", `{"updatehypervisorcapabilitiesresponse":{"hypervisor":"test-hypervisor","hypervisorversion":"test-hypervisorversion","id":"test-id","maxdatavolumeslimit":1,"maxguestslimit":1,"maxhostspercluster":1,"securitygroupenabled":true,"storagemotionenabled":true}}`, false, r)
+	if r.Hypervisorversion != "test-hypervisorversion" {
+		t.Errorf("Expected r.Hypervisorversion to be %v, got %v", "test-hypervisorversion", r.Hypervisorversion)
+	}
+}
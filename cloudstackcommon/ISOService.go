@@ -0,0 +1,1698 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type CopyIsoParams struct {
+	Destzoneid   *string `json:"destzoneid,omitempty" yaml:"destzoneid,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Sourcezoneid *string `json:"sourcezoneid,omitempty" yaml:"sourcezoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CopyIsoParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Destzoneid; v != nil {
+		u.Set("destzoneid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Sourcezoneid; v != nil {
+		u.Set("sourcezoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CopyIsoParams) Command() string {
+	return "copyIso"
+}
+
+// Returns true if the API command starts an async job
+func (p *CopyIsoParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CopyIsoParams) ResponseType() interface{} {
+	return &CopyIsoResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CopyIsoParams) Validate() error {
+	v := &validator{api: "copyIso"}
+	v.required("destzoneid", p.Destzoneid != nil)
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CopyIsoParams) SetDestzoneid(v string) {
+	p.Destzoneid = &v
+	return
+}
+
+func (p *CopyIsoParams) GetDestzoneid() string {
+	if p.Destzoneid == nil {
+		return ""
+	}
+	return *p.Destzoneid
+}
+
+func (p *CopyIsoParams) HasDestzoneid() bool {
+	return p.Destzoneid != nil
+}
+
+func (p *CopyIsoParams) ResetDestzoneid() {
+	p.Destzoneid = nil
+}
+
+// This param is required.
+func (p *CopyIsoParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *CopyIsoParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *CopyIsoParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *CopyIsoParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *CopyIsoParams) SetSourcezoneid(v string) {
+	p.Sourcezoneid = &v
+	return
+}
+
+func (p *CopyIsoParams) GetSourcezoneid() string {
+	if p.Sourcezoneid == nil {
+		return ""
+	}
+	return *p.Sourcezoneid
+}
+
+func (p *CopyIsoParams) HasSourcezoneid() bool {
+	return p.Sourcezoneid != nil
+}
+
+func (p *CopyIsoParams) ResetSourcezoneid() {
+	p.Sourcezoneid = nil
+}
+
+type CopyIsoResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteIsoParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteIsoParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteIsoParams) Command() string {
+	return "deleteIso"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteIsoParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteIsoParams) ResponseType() interface{} {
+	return &DeleteIsoResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteIsoParams) Validate() error {
+	v := &validator{api: "deleteIso"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteIsoParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteIsoParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteIsoParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteIsoParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *DeleteIsoParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *DeleteIsoParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *DeleteIsoParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *DeleteIsoParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type DeleteIsoResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExtractIsoParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Mode   *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ExtractIsoParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Mode; v != nil {
+		u.Set("mode", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ExtractIsoParams) Command() string {
+	return "extractIso"
+}
+
+// Returns true if the API command starts an async job
+func (p *ExtractIsoParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ExtractIsoParams) ResponseType() interface{} {
+	return &ExtractIsoResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ExtractIsoParams) Validate() error {
+	v := &validator{api: "extractIso"}
+	v.required("id", p.Id != nil)
+	v.required("mode", p.Mode != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ExtractIsoParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ExtractIsoParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ExtractIsoParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ExtractIsoParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *ExtractIsoParams) SetMode(v string) {
+	p.Mode = &v
+	return
+}
+
+func (p *ExtractIsoParams) GetMode() string {
+	if p.Mode == nil {
+		return ""
+	}
+	return *p.Mode
+}
+
+func (p *ExtractIsoParams) HasMode() bool {
+	return p.Mode != nil
+}
+
+func (p *ExtractIsoParams) ResetMode() {
+	p.Mode = nil
+}
+
+func (p *ExtractIsoParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *ExtractIsoParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *ExtractIsoParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *ExtractIsoParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ExtractIsoParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ExtractIsoParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ExtractIsoParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ExtractIsoParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ExtractIsoResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid        string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Created          Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	ExtractId        string                     `json:"extractId,omitempty" xml:"extractId,omitempty"`
+	ExtractMode      string                     `json:"extractMode,omitempty" xml:"extractMode,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Resultstring     string                     `json:"resultstring,omitempty" xml:"resultstring,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status           string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storagetype      string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Uploadpercentage int                        `json:"uploadpercentage,omitempty" xml:"uploadpercentage,omitempty"`
+	Url              string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename         string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListIsosParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Bootable    *bool             `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hypervisor  *HypervisorType   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isofilter   *string           `json:"isofilter,omitempty" yaml:"isofilter,omitempty"`
+	Ispublic    *bool             `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Isready     *bool             `json:"isready,omitempty" yaml:"isready,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Showremoved *bool             `json:"showremoved,omitempty" yaml:"showremoved,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Zoneid      *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListIsosParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Bootable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("bootable", vv)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isofilter; v != nil {
+		u.Set("isofilter", *v)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Isready; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isready", vv)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Showremoved; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("showremoved", vv)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListIsosParams) Command() string {
+	return "listIsos"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListIsosParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListIsosParams) ResponseType() interface{} {
+	return &ListIsosResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListIsosParams) Validate() error {
+	v := &validator{api: "listIsos"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListIsosParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListIsosParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListIsosParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListIsosParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListIsosParams) SetBootable(v bool) {
+	p.Bootable = &v
+	return
+}
+
+func (p *ListIsosParams) GetBootable() bool {
+	if p.Bootable == nil {
+		return false
+	}
+	return *p.Bootable
+}
+
+func (p *ListIsosParams) HasBootable() bool {
+	return p.Bootable != nil
+}
+
+func (p *ListIsosParams) ResetBootable() {
+	p.Bootable = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListIsosParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListIsosParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListIsosParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListIsosParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListIsosParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *ListIsosParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *ListIsosParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *ListIsosParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *ListIsosParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListIsosParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListIsosParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListIsosParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListIsosParams) SetIsofilter(v string) {
+	p.Isofilter = &v
+	return
+}
+
+func (p *ListIsosParams) GetIsofilter() string {
+	if p.Isofilter == nil {
+		return ""
+	}
+	return *p.Isofilter
+}
+
+func (p *ListIsosParams) HasIsofilter() bool {
+	return p.Isofilter != nil
+}
+
+func (p *ListIsosParams) ResetIsofilter() {
+	p.Isofilter = nil
+}
+
+func (p *ListIsosParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *ListIsosParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *ListIsosParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *ListIsosParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+func (p *ListIsosParams) SetIsready(v bool) {
+	p.Isready = &v
+	return
+}
+
+func (p *ListIsosParams) GetIsready() bool {
+	if p.Isready == nil {
+		return false
+	}
+	return *p.Isready
+}
+
+func (p *ListIsosParams) HasIsready() bool {
+	return p.Isready != nil
+}
+
+func (p *ListIsosParams) ResetIsready() {
+	p.Isready = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListIsosParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListIsosParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListIsosParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListIsosParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListIsosParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListIsosParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListIsosParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListIsosParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListIsosParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListIsosParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListIsosParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListIsosParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListIsosParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListIsosParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListIsosParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListIsosParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListIsosParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListIsosParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListIsosParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListIsosParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListIsosParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListIsosParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListIsosParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListIsosParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListIsosParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListIsosParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListIsosParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListIsosParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListIsosParams) SetShowremoved(v bool) {
+	p.Showremoved = &v
+	return
+}
+
+func (p *ListIsosParams) GetShowremoved() bool {
+	if p.Showremoved == nil {
+		return false
+	}
+	return *p.Showremoved
+}
+
+func (p *ListIsosParams) HasShowremoved() bool {
+	return p.Showremoved != nil
+}
+
+func (p *ListIsosParams) ResetShowremoved() {
+	p.Showremoved = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListIsosParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListIsosParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListIsosParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListIsosParams) ResetTags() {
+	p.Tags = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListIsosParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListIsosParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListIsosParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListIsosParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListIsosResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Isos  []*Iso                     `json:"iso" xml:"iso"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Iso struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RegisterIsoParams struct {
+	Account               *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Bootable              *bool   `json:"bootable,omitempty" yaml:"bootable,omitempty"`
+	Checksum              *string `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Displaytext           *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Imagestoreuuid        *string `json:"imagestoreuuid,omitempty" yaml:"imagestoreuuid,omitempty"`
+	Isdynamicallyscalable *bool   `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isextractable         *bool   `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured            *bool   `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool   `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name                  *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Projectid             *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Url                   *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid                *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RegisterIsoParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Bootable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("bootable", vv)
+	}
+	if v := p.Checksum; v != nil {
+		u.Set("checksum", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Imagestoreuuid; v != nil {
+		u.Set("imagestoreuuid", *v)
+	}
+	if v := p.Isdynamicallyscalable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isdynamicallyscalable", vv)
+	}
+	if v := p.Isextractable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isextractable", vv)
+	}
+	if v := p.Isfeatured; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isfeatured", vv)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RegisterIsoParams) Command() string {
+	return "registerIso"
+}
+
+// Returns true if the API command starts an async job
+func (p *RegisterIsoParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RegisterIsoParams) ResponseType() interface{} {
+	return &RegisterIsoResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RegisterIsoParams) Validate() error {
+	v := &validator{api: "registerIso"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	v.required("url", p.Url != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *RegisterIsoParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *RegisterIsoParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *RegisterIsoParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *RegisterIsoParams) SetBootable(v bool) {
+	p.Bootable = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetBootable() bool {
+	if p.Bootable == nil {
+		return false
+	}
+	return *p.Bootable
+}
+
+func (p *RegisterIsoParams) HasBootable() bool {
+	return p.Bootable != nil
+}
+
+func (p *RegisterIsoParams) ResetBootable() {
+	p.Bootable = nil
+}
+
+func (p *RegisterIsoParams) SetChecksum(v string) {
+	p.Checksum = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetChecksum() string {
+	if p.Checksum == nil {
+		return ""
+	}
+	return *p.Checksum
+}
+
+func (p *RegisterIsoParams) HasChecksum() bool {
+	return p.Checksum != nil
+}
+
+func (p *RegisterIsoParams) ResetChecksum() {
+	p.Checksum = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *RegisterIsoParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *RegisterIsoParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *RegisterIsoParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *RegisterIsoParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *RegisterIsoParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *RegisterIsoParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *RegisterIsoParams) SetImagestoreuuid(v string) {
+	p.Imagestoreuuid = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetImagestoreuuid() string {
+	if p.Imagestoreuuid == nil {
+		return ""
+	}
+	return *p.Imagestoreuuid
+}
+
+func (p *RegisterIsoParams) HasImagestoreuuid() bool {
+	return p.Imagestoreuuid != nil
+}
+
+func (p *RegisterIsoParams) ResetImagestoreuuid() {
+	p.Imagestoreuuid = nil
+}
+
+func (p *RegisterIsoParams) SetIsdynamicallyscalable(v bool) {
+	p.Isdynamicallyscalable = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetIsdynamicallyscalable() bool {
+	if p.Isdynamicallyscalable == nil {
+		return false
+	}
+	return *p.Isdynamicallyscalable
+}
+
+func (p *RegisterIsoParams) HasIsdynamicallyscalable() bool {
+	return p.Isdynamicallyscalable != nil
+}
+
+func (p *RegisterIsoParams) ResetIsdynamicallyscalable() {
+	p.Isdynamicallyscalable = nil
+}
+
+func (p *RegisterIsoParams) SetIsextractable(v bool) {
+	p.Isextractable = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetIsextractable() bool {
+	if p.Isextractable == nil {
+		return false
+	}
+	return *p.Isextractable
+}
+
+func (p *RegisterIsoParams) HasIsextractable() bool {
+	return p.Isextractable != nil
+}
+
+func (p *RegisterIsoParams) ResetIsextractable() {
+	p.Isextractable = nil
+}
+
+func (p *RegisterIsoParams) SetIsfeatured(v bool) {
+	p.Isfeatured = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetIsfeatured() bool {
+	if p.Isfeatured == nil {
+		return false
+	}
+	return *p.Isfeatured
+}
+
+func (p *RegisterIsoParams) HasIsfeatured() bool {
+	return p.Isfeatured != nil
+}
+
+func (p *RegisterIsoParams) ResetIsfeatured() {
+	p.Isfeatured = nil
+}
+
+func (p *RegisterIsoParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *RegisterIsoParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *RegisterIsoParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+// This param is required.
+func (p *RegisterIsoParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *RegisterIsoParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *RegisterIsoParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'ostypeid' param: the ID of the OS type.
+func (p *RegisterIsoParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
+	}
+	return *p.Ostypeid
+}
+
+func (p *RegisterIsoParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
+
+func (p *RegisterIsoParams) ResetOstypeid() {
+	p.Ostypeid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *RegisterIsoParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *RegisterIsoParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *RegisterIsoParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// This param is required.
+func (p *RegisterIsoParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *RegisterIsoParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *RegisterIsoParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *RegisterIsoParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *RegisterIsoParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *RegisterIsoParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *RegisterIsoParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type RegisterIsoResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListIsoPermissionsParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListIsoPermissionsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListIsoPermissionsParams) Command() string {
+	return "listIsoPermissions"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListIsoPermissionsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListIsoPermissionsParams) ResponseType() interface{} {
+	return &ListIsoPermissionsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListIsoPermissionsParams) Validate() error {
+	v := &validator{api: "listIsoPermissions"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ListIsoPermissionsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListIsoPermissionsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListIsoPermissionsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListIsoPermissionsParams) ResetId() {
+	p.Id = nil
+}
+
+type ListIsoPermissionsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	IsoPermissions []*IsoPermission           `json:"isopermission" xml:"isopermission"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type IsoPermission struct {
+	Account    []string                   `json:"account,omitempty" xml:"account,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ispublic   Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Projectids []string                   `json:"projectids,omitempty" xml:"projectids,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateIsoPermissionsParams struct {
+	Accounts      []string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	Id            *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Isextractable *bool    `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured    *bool    `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic      *bool    `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Op            *string  `json:"op,omitempty" yaml:"op,omitempty"`
+	Projectids    []string `json:"projectids,omitempty" yaml:"projectids,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateIsoPermissionsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accounts; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("accounts", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isextractable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isextractable", vv)
+	}
+	if v := p.Isfeatured; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isfeatured", vv)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Op; v != nil {
+		u.Set("op", *v)
+	}
+	if v := p.Projectids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("projectids", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateIsoPermissionsParams) Command() string {
+	return "updateIsoPermissions"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateIsoPermissionsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateIsoPermissionsParams) ResponseType() interface{} {
+	return &UpdateIsoPermissionsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateIsoPermissionsParams) Validate() error {
+	v := &validator{api: "updateIsoPermissions"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
+	p.Accounts = v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetAccounts() []string {
+	return p.Accounts
+}
+
+func (p *UpdateIsoPermissionsParams) HasAccounts() bool {
+	return p.Accounts != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetAccounts() {
+	p.Accounts = nil
+}
+
+// This param is required.
+func (p *UpdateIsoPermissionsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateIsoPermissionsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateIsoPermissionsParams) SetIsextractable(v bool) {
+	p.Isextractable = &v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetIsextractable() bool {
+	if p.Isextractable == nil {
+		return false
+	}
+	return *p.Isextractable
+}
+
+func (p *UpdateIsoPermissionsParams) HasIsextractable() bool {
+	return p.Isextractable != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetIsextractable() {
+	p.Isextractable = nil
+}
+
+func (p *UpdateIsoPermissionsParams) SetIsfeatured(v bool) {
+	p.Isfeatured = &v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetIsfeatured() bool {
+	if p.Isfeatured == nil {
+		return false
+	}
+	return *p.Isfeatured
+}
+
+func (p *UpdateIsoPermissionsParams) HasIsfeatured() bool {
+	return p.Isfeatured != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetIsfeatured() {
+	p.Isfeatured = nil
+}
+
+func (p *UpdateIsoPermissionsParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *UpdateIsoPermissionsParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+func (p *UpdateIsoPermissionsParams) SetOp(v string) {
+	p.Op = &v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetOp() string {
+	if p.Op == nil {
+		return ""
+	}
+	return *p.Op
+}
+
+func (p *UpdateIsoPermissionsParams) HasOp() bool {
+	return p.Op != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetOp() {
+	p.Op = nil
+}
+
+func (p *UpdateIsoPermissionsParams) SetProjectids(v []string) {
+	p.Projectids = v
+	return
+}
+
+func (p *UpdateIsoPermissionsParams) GetProjectids() []string {
+	return p.Projectids
+}
+
+func (p *UpdateIsoPermissionsParams) HasProjectids() bool {
+	return p.Projectids != nil
+}
+
+func (p *UpdateIsoPermissionsParams) ResetProjectids() {
+	p.Projectids = nil
+}
+
+type UpdateIsoPermissionsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ISOService that is the same for all versions
+type ISOService interface {
+	NewCopyIsoParams(destzoneid string, id string) *CopyIsoParams
+	CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error)
+	NewDeleteIsoParams(id string) *DeleteIsoParams
+	DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	NewExtractIsoParams(id string, mode string) *ExtractIsoParams
+	ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	NewListIsosParams() *ListIsosParams
+	GetIsoID(name string, opts ...OptionFunc) (string, error)
+	GetIsoByName(name string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsosByTags(tags map[string]string, opts ...OptionFunc) ([]*Iso, error)
+	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	StreamIsos(ctx context.Context, p *ListIsosParams, fn func(*Iso) error) (int, error)
+	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams
+	RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams
+	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	StreamIsoPermissions(ctx context.Context, p *ListIsoPermissionsParams, fn func(*IsoPermission) error) (int, error)
+	NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams
+	UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+}
@@ -0,0 +1,1155 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type UpdateCloudToUseObjectStoreParams struct {
+	Details  map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Name     *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Provider *string           `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Url      *string           `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateCloudToUseObjectStoreParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Details; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[%d].key", i), k)
+			u.Set(fmt.Sprintf("details[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateCloudToUseObjectStoreParams) Command() string {
+	return "updateCloudToUseObjectStore"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateCloudToUseObjectStoreParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateCloudToUseObjectStoreParams) ResponseType() interface{} {
+	return &UpdateCloudToUseObjectStoreResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateCloudToUseObjectStoreParams) Validate() error {
+	v := &validator{api: "updateCloudToUseObjectStore"}
+	v.required("provider", p.Provider != nil)
+	return v.err()
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) ResetName() {
+	p.Name = nil
+}
+
+// This param is required.
+func (p *UpdateCloudToUseObjectStoreParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) ResetProvider() {
+	p.Provider = nil
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *UpdateCloudToUseObjectStoreParams) ResetUrl() {
+	p.Url = nil
+}
+
+type UpdateCloudToUseObjectStoreResponse struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddImageStoreParams struct {
+	Details  map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Name     *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Provider *string           `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Url      *string           `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid   *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddImageStoreParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Details; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[%d].key", i), k)
+			u.Set(fmt.Sprintf("details[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddImageStoreParams) Command() string {
+	return "addImageStore"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddImageStoreParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddImageStoreParams) ResponseType() interface{} {
+	return &AddImageStoreResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddImageStoreParams) Validate() error {
+	v := &validator{api: "addImageStore"}
+	v.required("provider", p.Provider != nil)
+	return v.err()
+}
+
+func (p *AddImageStoreParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *AddImageStoreParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *AddImageStoreParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *AddImageStoreParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *AddImageStoreParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *AddImageStoreParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *AddImageStoreParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *AddImageStoreParams) ResetName() {
+	p.Name = nil
+}
+
+// This param is required.
+func (p *AddImageStoreParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *AddImageStoreParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *AddImageStoreParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *AddImageStoreParams) ResetProvider() {
+	p.Provider = nil
+}
+
+func (p *AddImageStoreParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddImageStoreParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddImageStoreParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddImageStoreParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *AddImageStoreParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddImageStoreParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddImageStoreParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddImageStoreParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type AddImageStoreResponse struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteImageStoreParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteImageStoreParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteImageStoreParams) Command() string {
+	return "deleteImageStore"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteImageStoreParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteImageStoreParams) ResponseType() interface{} {
+	return &DeleteImageStoreResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteImageStoreParams) Validate() error {
+	v := &validator{api: "deleteImageStore"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteImageStoreParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteImageStoreParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteImageStoreParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteImageStoreParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteImageStoreResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListImageStoresParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Protocol *string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListImageStoresParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", *v)
+	}
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListImageStoresParams) Command() string {
+	return "listImageStores"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListImageStoresParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListImageStoresParams) ResponseType() interface{} {
+	return &ListImageStoresResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListImageStoresParams) Validate() error {
+	v := &validator{api: "listImageStores"}
+	return v.err()
+}
+
+func (p *ListImageStoresParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListImageStoresParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListImageStoresParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListImageStoresParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListImageStoresParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListImageStoresParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListImageStoresParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListImageStoresParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListImageStoresParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListImageStoresParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListImageStoresParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListImageStoresParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListImageStoresParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListImageStoresParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListImageStoresParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListImageStoresParams) SetProtocol(v string) {
+	p.Protocol = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetProtocol() string {
+	if p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+func (p *ListImageStoresParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *ListImageStoresParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+func (p *ListImageStoresParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *ListImageStoresParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *ListImageStoresParams) ResetProvider() {
+	p.Provider = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListImageStoresParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListImageStoresParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListImageStoresParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListImageStoresParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListImageStoresResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	ImageStores []*ImageStore              `json:"imagestore" xml:"imagestore"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ImageStore struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateSecondaryStagingStoreParams struct {
+	Details  map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Provider *string           `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Scope    *string           `json:"scope,omitempty" yaml:"scope,omitempty"`
+	Url      *string           `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid   *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateSecondaryStagingStoreParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Details; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[%d].key", i), k)
+			u.Set(fmt.Sprintf("details[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
+	}
+	if v := p.Scope; v != nil {
+		u.Set("scope", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateSecondaryStagingStoreParams) Command() string {
+	return "createSecondaryStagingStore"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateSecondaryStagingStoreParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateSecondaryStagingStoreParams) ResponseType() interface{} {
+	return &CreateSecondaryStagingStoreResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateSecondaryStagingStoreParams) Validate() error {
+	v := &validator{api: "createSecondaryStagingStore"}
+	v.required("url", p.Url != nil)
+	return v.err()
+}
+
+func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *CreateSecondaryStagingStoreParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *CreateSecondaryStagingStoreParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) ResetProvider() {
+	p.Provider = nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) SetScope(v string) {
+	p.Scope = &v
+	return
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetScope() string {
+	if p.Scope == nil {
+		return ""
+	}
+	return *p.Scope
+}
+
+func (p *CreateSecondaryStagingStoreParams) HasScope() bool {
+	return p.Scope != nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) ResetScope() {
+	p.Scope = nil
+}
+
+// This param is required.
+func (p *CreateSecondaryStagingStoreParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *CreateSecondaryStagingStoreParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *CreateSecondaryStagingStoreParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *CreateSecondaryStagingStoreParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreateSecondaryStagingStoreParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreateSecondaryStagingStoreParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type CreateSecondaryStagingStoreResponse struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteSecondaryStagingStoreParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSecondaryStagingStoreParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteSecondaryStagingStoreParams) Command() string {
+	return "deleteSecondaryStagingStore"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSecondaryStagingStoreParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSecondaryStagingStoreParams) ResponseType() interface{} {
+	return &DeleteSecondaryStagingStoreResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSecondaryStagingStoreParams) Validate() error {
+	v := &validator{api: "deleteSecondaryStagingStore"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteSecondaryStagingStoreParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteSecondaryStagingStoreParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteSecondaryStagingStoreParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteSecondaryStagingStoreResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListSecondaryStagingStoresParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Protocol *string `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSecondaryStagingStoresParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Protocol; v != nil {
+		u.Set("protocol", *v)
+	}
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSecondaryStagingStoresParams) Command() string {
+	return "listSecondaryStagingStores"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSecondaryStagingStoresParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSecondaryStagingStoresParams) ResponseType() interface{} {
+	return &ListSecondaryStagingStoresResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSecondaryStagingStoresParams) Validate() error {
+	v := &validator{api: "listSecondaryStagingStores"}
+	return v.err()
+}
+
+func (p *ListSecondaryStagingStoresParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListSecondaryStagingStoresParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSecondaryStagingStoresParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListSecondaryStagingStoresParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListSecondaryStagingStoresParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListSecondaryStagingStoresParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSecondaryStagingStoresParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListSecondaryStagingStoresParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSecondaryStagingStoresParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListSecondaryStagingStoresParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListSecondaryStagingStoresParams) SetProtocol(v string) {
+	p.Protocol = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetProtocol() string {
+	if p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+func (p *ListSecondaryStagingStoresParams) HasProtocol() bool {
+	return p.Protocol != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetProtocol() {
+	p.Protocol = nil
+}
+
+func (p *ListSecondaryStagingStoresParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *ListSecondaryStagingStoresParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetProvider() {
+	p.Provider = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListSecondaryStagingStoresParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListSecondaryStagingStoresParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListSecondaryStagingStoresParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListSecondaryStagingStoresParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListSecondaryStagingStoresResponse struct {
+	Count                  int                        `json:"count" xml:"count"`
+	SecondaryStagingStores []*SecondaryStagingStore   `json:"secondarystagingstore" xml:"secondarystagingstore"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SecondaryStagingStore struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ImageStoreService that is the same for all versions
+type ImageStoreService interface {
+	NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams
+	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
+	NewAddImageStoreParams(provider string) *AddImageStoreParams
+	AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	NewDeleteImageStoreParams(id string) *DeleteImageStoreParams
+	DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	NewListImageStoresParams() *ListImageStoresParams
+	GetImageStoreID(name string, opts ...OptionFunc) (string, error)
+	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	StreamImageStores(ctx context.Context, p *ListImageStoresParams, fn func(*ImageStore) error) (int, error)
+	NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams
+	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams
+	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams
+	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, error)
+	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	StreamSecondaryStagingStores(ctx context.Context, p *ListSecondaryStagingStoresParams, fn func(*SecondaryStagingStore) error) (int, error)
+}
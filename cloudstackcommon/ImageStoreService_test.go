@@ -0,0 +1,263 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestUpdateCloudToUseObjectStore(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"name":             {"test-name"},
+		"provider":         {"test-provider"},
+		"url":              {"test-url"},
+	}
+
+	p := &UpdateCloudToUseObjectStoreParams{}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetName("test-name")
+	p.SetProvider("test-provider")
+	p.SetUrl("test-url")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateCloudToUseObjectStore", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateCloudToUseObjectStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateCloudToUseObjectStoreResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateCloudToUseObjectStore", `{"updatecloudtouseobjectstoreresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestAddImageStore(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"name":             {"test-name"},
+		"provider":         {"test-provider"},
+		"url":              {"test-url"},
+		"zoneid":           {"test-zoneid"},
+	}
+
+	p := &AddImageStoreParams{}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetName("test-name")
+	p.SetProvider("test-provider")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addImageStore", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addImageStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddImageStoreResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addImageStore", `{"addimagestoreresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestDeleteImageStore(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteImageStoreParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteImageStore", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteImageStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteImageStoreResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteImageStore", `{"deleteimagestoreresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListImageStores(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"protocol": {"test-protocol"},
+		"provider": {"test-provider"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &ListImageStoresParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetProtocol("test-protocol")
+	p.SetProvider("test-provider")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listImageStores", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listImageStores" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListImageStoresResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listImageStores", `{"listimagestoresresponse":{"count":1,"imagestore":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.ImageStores[0].Id != "test-id" {
+		t.Errorf("Expected r.ImageStores[0].Id to be %v, got %v", "test-id", r.ImageStores[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestCreateSecondaryStagingStore(t *testing.T) {
+	params := url.Values{
+		"details[0].key":   {"test-detailskey"},
+		"details[0].value": {"test-detailsvalue"},
+		"provider":         {"test-provider"},
+		"scope":            {"test-scope"},
+		"url":              {"test-url"},
+		"zoneid":           {"test-zoneid"},
+	}
+
+	p := &CreateSecondaryStagingStoreParams{}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetProvider("test-provider")
+	p.SetScope("test-scope")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createSecondaryStagingStore", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createSecondaryStagingStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateSecondaryStagingStoreResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createSecondaryStagingStore", `{"createsecondarystagingstoreresponse":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestDeleteSecondaryStagingStore(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteSecondaryStagingStoreParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteSecondaryStagingStore", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSecondaryStagingStore" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteSecondaryStagingStoreResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteSecondaryStagingStore", `{"deletesecondarystagingstoreresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListSecondaryStagingStores(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"protocol": {"test-protocol"},
+		"provider": {"test-provider"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &ListSecondaryStagingStoresParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetProtocol("test-protocol")
+	p.SetProvider("test-provider")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listSecondaryStagingStores", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listSecondaryStagingStores" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListSecondaryStagingStoresResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listSecondaryStagingStores", `{"listsecondarystagingstoresresponse":{"count":1,"secondarystagingstore":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.SecondaryStagingStores[0].Id != "test-id" {
+		t.Errorf("Expected r.SecondaryStagingStores[0].Id to be %v, got %v", "test-id", r.SecondaryStagingStores[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
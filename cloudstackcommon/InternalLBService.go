@@ -0,0 +1,387 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ConfigureInternalLoadBalancerElementParams struct {
+	Enabled *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigureInternalLoadBalancerElementParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("enabled", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ConfigureInternalLoadBalancerElementParams) Command() string {
+	return "configureInternalLoadBalancerElement"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigureInternalLoadBalancerElementParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigureInternalLoadBalancerElementParams) ResponseType() interface{} {
+	return &ConfigureInternalLoadBalancerElementResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigureInternalLoadBalancerElementParams) Validate() error {
+	v := &validator{api: "configureInternalLoadBalancerElement"}
+	v.required("enabled", p.Enabled != nil)
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
+	p.Enabled = &v
+	return
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) GetEnabled() bool {
+	if p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) HasEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) ResetEnabled() {
+	p.Enabled = nil
+}
+
+// This param is required.
+func (p *ConfigureInternalLoadBalancerElementParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ConfigureInternalLoadBalancerElementParams) ResetId() {
+	p.Id = nil
+}
+
+type ConfigureInternalLoadBalancerElementResponse struct {
+	JobID   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Enabled Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid   string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateInternalLoadBalancerElementParams struct {
+	Nspid *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateInternalLoadBalancerElementParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Nspid; v != nil {
+		u.Set("nspid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateInternalLoadBalancerElementParams) Command() string {
+	return "createInternalLoadBalancerElement"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateInternalLoadBalancerElementParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateInternalLoadBalancerElementParams) ResponseType() interface{} {
+	return &CreateInternalLoadBalancerElementResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateInternalLoadBalancerElementParams) Validate() error {
+	v := &validator{api: "createInternalLoadBalancerElement"}
+	v.required("nspid", p.Nspid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
+	p.Nspid = &v
+	return
+}
+
+func (p *CreateInternalLoadBalancerElementParams) GetNspid() string {
+	if p.Nspid == nil {
+		return ""
+	}
+	return *p.Nspid
+}
+
+func (p *CreateInternalLoadBalancerElementParams) HasNspid() bool {
+	return p.Nspid != nil
+}
+
+func (p *CreateInternalLoadBalancerElementParams) ResetNspid() {
+	p.Nspid = nil
+}
+
+type CreateInternalLoadBalancerElementResponse struct {
+	JobID   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Enabled Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid   string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListInternalLoadBalancerElementsParams struct {
+	Enabled  *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nspid    *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListInternalLoadBalancerElementsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("enabled", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Nspid; v != nil {
+		u.Set("nspid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListInternalLoadBalancerElementsParams) Command() string {
+	return "listInternalLoadBalancerElements"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListInternalLoadBalancerElementsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListInternalLoadBalancerElementsParams) ResponseType() interface{} {
+	return &ListInternalLoadBalancerElementsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListInternalLoadBalancerElementsParams) Validate() error {
+	v := &validator{api: "listInternalLoadBalancerElements"}
+	return v.err()
+}
+
+func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
+	p.Enabled = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetEnabled() bool {
+	if p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+func (p *ListInternalLoadBalancerElementsParams) HasEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) ResetEnabled() {
+	p.Enabled = nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListInternalLoadBalancerElementsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListInternalLoadBalancerElementsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListInternalLoadBalancerElementsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) SetNspid(v string) {
+	p.Nspid = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetNspid() string {
+	if p.Nspid == nil {
+		return ""
+	}
+	return *p.Nspid
+}
+
+func (p *ListInternalLoadBalancerElementsParams) HasNspid() bool {
+	return p.Nspid != nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) ResetNspid() {
+	p.Nspid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListInternalLoadBalancerElementsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListInternalLoadBalancerElementsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListInternalLoadBalancerElementsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListInternalLoadBalancerElementsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListInternalLoadBalancerElementsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListInternalLoadBalancerElementsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListInternalLoadBalancerElementsResponse struct {
+	Count                        int                            `json:"count" xml:"count"`
+	InternalLoadBalancerElements []*InternalLoadBalancerElement `json:"internalloadbalancerelement" xml:"internalloadbalancerelement"`
+	Extra                        map[string]json.RawMessage     `json:"-" xml:"-"`
+}
+
+type InternalLoadBalancerElement struct {
+	Enabled Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id      string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid   string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the InternalLBService that is the same for all versions
+type InternalLBService interface {
+	NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams
+	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*ConfigureInternalLoadBalancerElementResponse, error)
+	NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams
+	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams
+	GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
+	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	StreamInternalLoadBalancerElements(ctx context.Context, p *ListInternalLoadBalancerElementsParams, fn func(*InternalLoadBalancerElement) error) (int, error)
+}
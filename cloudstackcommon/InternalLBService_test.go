@@ -0,0 +1,121 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestConfigureInternalLoadBalancerElement(t *testing.T) {
+	params := url.Values{
+		"enabled": {"true"},
+		"id":      {"test-id"},
+	}
+
+	p := &ConfigureInternalLoadBalancerElementParams{}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "configureInternalLoadBalancerElement", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "configureInternalLoadBalancerElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ConfigureInternalLoadBalancerElementResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "configureInternalLoadBalancerElement", `{"configureinternalloadbalancerelementresponse":{"enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestCreateInternalLoadBalancerElement(t *testing.T) {
+	params := url.Values{
+		"nspid": {"test-nspid"},
+	}
+
+	p := &CreateInternalLoadBalancerElementParams{}
+	p.SetNspid("test-nspid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createInternalLoadBalancerElement", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createInternalLoadBalancerElement" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateInternalLoadBalancerElementResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createInternalLoadBalancerElement", `{"createinternalloadbalancerelementresponse":{"enabled":true,"id":"test-id","jobid":"test-jobid","nspid":"test-nspid"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListInternalLoadBalancerElements(t *testing.T) {
+	params := url.Values{
+		"enabled":  {"true"},
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"nspid":    {"test-nspid"},
+		"page":     {"5"},
+		"pagesize": {"6"},
+	}
+
+	p := &ListInternalLoadBalancerElementsParams{}
+	p.SetEnabled(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetNspid("test-nspid")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listInternalLoadBalancerElements", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listInternalLoadBalancerElements" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListInternalLoadBalancerElementsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listInternalLoadBalancerElements", `{"listinternalloadbalancerelementsresponse":{"count":1,"internalloadbalancerelement":[{"enabled":true,"id":"test-id","nspid":"test-nspid"}]}}`, false, r)
+	if r.InternalLoadBalancerElements[0].Id != "test-id" {
+		t.Errorf("Expected r.InternalLoadBalancerElements[0].Id to be %v, got %v", "test-id", r.InternalLoadBalancerElements[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
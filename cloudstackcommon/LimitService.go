@@ -0,0 +1,760 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type GetApiLimitParams struct {
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *GetApiLimitParams) URLValues() url.Values {
+	u := url.Values{}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *GetApiLimitParams) Command() string {
+	return "getApiLimit"
+}
+
+// Returns true if the API command starts an async job
+func (p *GetApiLimitParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *GetApiLimitParams) ResponseType() interface{} {
+	return &GetApiLimitResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *GetApiLimitParams) Validate() error {
+	v := &validator{api: "getApiLimit"}
+	return v.err()
+}
+
+type GetApiLimitResponse struct {
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid   string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	ApiAllowed  int                        `json:"apiAllowed,omitempty" xml:"apiAllowed,omitempty"`
+	ApiIssued   int                        `json:"apiIssued,omitempty" xml:"apiIssued,omitempty"`
+	ExpireAfter int64                      `json:"expireAfter,omitempty" xml:"expireAfter,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ResetApiLimitParams struct {
+	Account *string `json:"account,omitempty" yaml:"account,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ResetApiLimitParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ResetApiLimitParams) Command() string {
+	return "resetApiLimit"
+}
+
+// Returns true if the API command starts an async job
+func (p *ResetApiLimitParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ResetApiLimitParams) ResponseType() interface{} {
+	return &ResetApiLimitResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ResetApiLimitParams) Validate() error {
+	v := &validator{api: "resetApiLimit"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ResetApiLimitParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ResetApiLimitParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ResetApiLimitParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ResetApiLimitParams) ResetAccount() {
+	p.Account = nil
+}
+
+type ResetApiLimitResponse struct {
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid   string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	ApiAllowed  int                        `json:"apiAllowed,omitempty" xml:"apiAllowed,omitempty"`
+	ApiIssued   int                        `json:"apiIssued,omitempty" xml:"apiIssued,omitempty"`
+	ExpireAfter int64                      `json:"expireAfter,omitempty" xml:"expireAfter,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateResourceCountParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourcetype *int    `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateResourceCountParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Resourcetype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("resourcetype", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateResourceCountParams) Command() string {
+	return "updateResourceCount"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateResourceCountParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateResourceCountParams) ResponseType() interface{} {
+	return &UpdateResourceCountResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateResourceCountParams) Validate() error {
+	v := &validator{api: "updateResourceCount"}
+	v.required("domainid", p.Domainid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpdateResourceCountParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpdateResourceCountParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *UpdateResourceCountParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpdateResourceCountParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *UpdateResourceCountParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *UpdateResourceCountParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *UpdateResourceCountParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *UpdateResourceCountParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *UpdateResourceCountParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *UpdateResourceCountParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *UpdateResourceCountParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *UpdateResourceCountParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume.
+func (p *UpdateResourceCountParams) SetResourcetype(v int) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *UpdateResourceCountParams) GetResourcetype() int {
+	if p.Resourcetype == nil {
+		return 0
+	}
+	return *p.Resourcetype
+}
+
+func (p *UpdateResourceCountParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *UpdateResourceCountParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+type UpdateResourceCountResponse struct {
+	Account       string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain        string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid      string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Project       string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid     string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Resourcecount int64                      `json:"resourcecount,omitempty" xml:"resourcecount,omitempty"`
+	Resourcetype  string                     `json:"resourcetype,omitempty" xml:"resourcetype,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListResourceLimitsParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id           *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive  *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourcetype *int    `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListResourceLimitsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("id", vv)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Resourcetype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("resourcetype", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListResourceLimitsParams) Command() string {
+	return "listResourceLimits"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListResourceLimitsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListResourceLimitsParams) ResponseType() interface{} {
+	return &ListResourceLimitsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListResourceLimitsParams) Validate() error {
+	v := &validator{api: "listResourceLimits"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListResourceLimitsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListResourceLimitsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListResourceLimitsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListResourceLimitsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListResourceLimitsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListResourceLimitsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListResourceLimitsParams) SetId(v int64) {
+	p.Id = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetId() int64 {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *ListResourceLimitsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListResourceLimitsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListResourceLimitsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListResourceLimitsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListResourceLimitsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListResourceLimitsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListResourceLimitsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListResourceLimitsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListResourceLimitsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListResourceLimitsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListResourceLimitsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListResourceLimitsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListResourceLimitsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListResourceLimitsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListResourceLimitsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListResourceLimitsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListResourceLimitsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListResourceLimitsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListResourceLimitsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListResourceLimitsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume.
+func (p *ListResourceLimitsParams) SetResourcetype(v int) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *ListResourceLimitsParams) GetResourcetype() int {
+	if p.Resourcetype == nil {
+		return 0
+	}
+	return *p.Resourcetype
+}
+
+func (p *ListResourceLimitsParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *ListResourceLimitsParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+type ListResourceLimitsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	ResourceLimits []*ResourceLimit           `json:"resourcelimit" xml:"resourcelimit"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ResourceLimit struct {
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Max          int64                      `json:"max,omitempty" xml:"max,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Resourcetype string                     `json:"resourcetype,omitempty" xml:"resourcetype,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateResourceLimitParams struct {
+	Account      *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Max          *int64  `json:"max,omitempty" yaml:"max,omitempty"`
+	Projectid    *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourcetype *int    `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateResourceLimitParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Max; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("max", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Resourcetype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("resourcetype", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateResourceLimitParams) Command() string {
+	return "updateResourceLimit"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateResourceLimitParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateResourceLimitParams) ResponseType() interface{} {
+	return &UpdateResourceLimitResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateResourceLimitParams) Validate() error {
+	v := &validator{api: "updateResourceLimit"}
+	v.required("resourcetype", p.Resourcetype != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpdateResourceLimitParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpdateResourceLimitParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *UpdateResourceLimitParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpdateResourceLimitParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *UpdateResourceLimitParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *UpdateResourceLimitParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *UpdateResourceLimitParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *UpdateResourceLimitParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *UpdateResourceLimitParams) SetMax(v int64) {
+	p.Max = &v
+	return
+}
+
+func (p *UpdateResourceLimitParams) GetMax() int64 {
+	if p.Max == nil {
+		return 0
+	}
+	return *p.Max
+}
+
+func (p *UpdateResourceLimitParams) HasMax() bool {
+	return p.Max != nil
+}
+
+func (p *UpdateResourceLimitParams) ResetMax() {
+	p.Max = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *UpdateResourceLimitParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *UpdateResourceLimitParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *UpdateResourceLimitParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *UpdateResourceLimitParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume. This param is required.
+func (p *UpdateResourceLimitParams) SetResourcetype(v int) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *UpdateResourceLimitParams) GetResourcetype() int {
+	if p.Resourcetype == nil {
+		return 0
+	}
+	return *p.Resourcetype
+}
+
+func (p *UpdateResourceLimitParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *UpdateResourceLimitParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+type UpdateResourceLimitResponse struct {
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Max          int64                      `json:"max,omitempty" xml:"max,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Resourcetype string                     `json:"resourcetype,omitempty" xml:"resourcetype,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the LimitService that is the same for all versions
+type LimitService interface {
+	NewGetApiLimitParams() *GetApiLimitParams
+	GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	NewResetApiLimitParams() *ResetApiLimitParams
+	ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams
+	UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	NewListResourceLimitsParams() *ListResourceLimitsParams
+	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	StreamResourceLimits(ctx context.Context, p *ListResourceLimitsParams, fn func(*ResourceLimit) error) (int, error)
+	NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams
+	UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+}
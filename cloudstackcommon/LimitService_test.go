@@ -0,0 +1,184 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestGetApiLimit(t *testing.T) {
+	params := url.Values{}
+
+	p := &GetApiLimitParams{}
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "getApiLimit", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "getApiLimit" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*GetApiLimitResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "getApiLimit", `{"getapilimitresponse":{"account":"test-account","accountid":"test-accountid","apiAllowed":1,"apiIssued":1,"expireAfter":1}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestResetApiLimit(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+	}
+
+	p := &ResetApiLimitParams{}
+	p.SetAccount("test-account")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "resetApiLimit", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "resetApiLimit" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ResetApiLimitResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "resetApiLimit", `{"resetapilimitresponse":{"account":"test-account","accountid":"test-accountid","apiAllowed":1,"apiIssued":1,"expireAfter":1}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestUpdateResourceCount(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"projectid":    {"test-projectid"},
+		"resourcetype": {"4"},
+	}
+
+	p := &UpdateResourceCountParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetProjectid("test-projectid")
+	p.SetResourcetype(4)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateResourceCount", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateResourceCount" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateResourceCountResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateResourceCount", `{"updateresourcecountresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","project":"test-project","projectid":"test-projectid","resourcecount":1,"resourcetype":"test-resourcetype"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestListResourceLimits(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"id":           {"3"},
+		"isrecursive":  {"true"},
+		"keyword":      {"test-keyword"},
+		"listall":      {"true"},
+		"page":         {"7"},
+		"pagesize":     {"8"},
+		"projectid":    {"test-projectid"},
+		"resourcetype": {"10"},
+	}
+
+	p := &ListResourceLimitsParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId(3)
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetResourcetype(10)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listResourceLimits", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listResourceLimits" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListResourceLimitsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listResourceLimits", `{"listresourcelimitsresponse":{"count":1,"resourcelimit":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","max":1,"project":"test-project","projectid":"test-projectid","resourcetype":"test-resourcetype"}]}}`, false, r)
+	if r.ResourceLimits[0].Account != "test-account" {
+		t.Errorf("Expected r.ResourceLimits[0].Account to be %v, got %v", "test-account", r.ResourceLimits[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateResourceLimit(t *testing.T) {
+	params := url.Values{
+		"account":      {"test-account"},
+		"domainid":     {"test-domainid"},
+		"max":          {"3"},
+		"projectid":    {"test-projectid"},
+		"resourcetype": {"5"},
+	}
+
+	p := &UpdateResourceLimitParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetMax(3)
+	p.SetProjectid("test-projectid")
+	p.SetResourcetype(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateResourceLimit", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateResourceLimit" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateResourceLimitResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateResourceLimit", `{"updateresourcelimitresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","max":1,"project":"test-project","projectid":"test-projectid","resourcetype":"test-resourcetype"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
@@ -0,0 +1,1497 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type RemoveCertFromLoadBalancerParams struct {
+	Lbruleid *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveCertFromLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveCertFromLoadBalancerParams) Command() string {
+	return "removeCertFromLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveCertFromLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveCertFromLoadBalancerParams) ResponseType() interface{} {
+	return &RemoveCertFromLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveCertFromLoadBalancerParams) Validate() error {
+	v := &validator{api: "removeCertFromLoadBalancer"}
+	v.required("lbruleid", p.Lbruleid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *RemoveCertFromLoadBalancerParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
+	}
+	return *p.Lbruleid
+}
+
+func (p *RemoveCertFromLoadBalancerParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *RemoveCertFromLoadBalancerParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+type RemoveCertFromLoadBalancerResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AssignCertToLoadBalancerParams struct {
+	Certid   *string `json:"certid,omitempty" yaml:"certid,omitempty"`
+	Lbruleid *string `json:"lbruleid,omitempty" yaml:"lbruleid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AssignCertToLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Certid; v != nil {
+		u.Set("certid", *v)
+	}
+	if v := p.Lbruleid; v != nil {
+		u.Set("lbruleid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AssignCertToLoadBalancerParams) Command() string {
+	return "assignCertToLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *AssignCertToLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AssignCertToLoadBalancerParams) ResponseType() interface{} {
+	return &AssignCertToLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AssignCertToLoadBalancerParams) Validate() error {
+	v := &validator{api: "assignCertToLoadBalancer"}
+	v.required("certid", p.Certid != nil)
+	v.required("lbruleid", p.Lbruleid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
+	p.Certid = &v
+	return
+}
+
+func (p *AssignCertToLoadBalancerParams) GetCertid() string {
+	if p.Certid == nil {
+		return ""
+	}
+	return *p.Certid
+}
+
+func (p *AssignCertToLoadBalancerParams) HasCertid() bool {
+	return p.Certid != nil
+}
+
+func (p *AssignCertToLoadBalancerParams) ResetCertid() {
+	p.Certid = nil
+}
+
+// This param is required.
+func (p *AssignCertToLoadBalancerParams) SetLbruleid(v string) {
+	p.Lbruleid = &v
+	return
+}
+
+func (p *AssignCertToLoadBalancerParams) GetLbruleid() string {
+	if p.Lbruleid == nil {
+		return ""
+	}
+	return *p.Lbruleid
+}
+
+func (p *AssignCertToLoadBalancerParams) HasLbruleid() bool {
+	return p.Lbruleid != nil
+}
+
+func (p *AssignCertToLoadBalancerParams) ResetLbruleid() {
+	p.Lbruleid = nil
+}
+
+type AssignCertToLoadBalancerResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RemoveFromGlobalLoadBalancerRuleParams struct {
+	Id                   *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Loadbalancerrulelist []string `json:"loadbalancerrulelist,omitempty" yaml:"loadbalancerrulelist,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveFromGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Loadbalancerrulelist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("loadbalancerrulelist", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Command() string {
+	return "removeFromGlobalLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveFromGlobalLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ResponseType() interface{} {
+	return &RemoveFromGlobalLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "removeFromGlobalLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	v.required("loadbalancerrulelist", p.Loadbalancerrulelist != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
+	p.Loadbalancerrulelist = v
+	return
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) GetLoadbalancerrulelist() []string {
+	return p.Loadbalancerrulelist
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) HasLoadbalancerrulelist() bool {
+	return p.Loadbalancerrulelist != nil
+}
+
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ResetLoadbalancerrulelist() {
+	p.Loadbalancerrulelist = nil
+}
+
+type RemoveFromGlobalLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteGlobalLoadBalancerRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteGlobalLoadBalancerRuleParams) Command() string {
+	return "deleteGlobalLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteGlobalLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteGlobalLoadBalancerRuleParams) ResponseType() interface{} {
+	return &DeleteGlobalLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteGlobalLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "deleteGlobalLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteGlobalLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteGlobalLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLBHealthCheckPolicyParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteLBHealthCheckPolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteLBHealthCheckPolicyParams) Command() string {
+	return "deleteLBHealthCheckPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteLBHealthCheckPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteLBHealthCheckPolicyParams) ResponseType() interface{} {
+	return &DeleteLBHealthCheckPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteLBHealthCheckPolicyParams) Validate() error {
+	v := &validator{api: "deleteLBHealthCheckPolicy"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteLBHealthCheckPolicyParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteLBHealthCheckPolicyResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLBStickinessPolicyParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteLBStickinessPolicyParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteLBStickinessPolicyParams) Command() string {
+	return "deleteLBStickinessPolicy"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteLBStickinessPolicyParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteLBStickinessPolicyParams) ResponseType() interface{} {
+	return &DeleteLBStickinessPolicyResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteLBStickinessPolicyParams) Validate() error {
+	v := &validator{api: "deleteLBStickinessPolicy"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteLBStickinessPolicyParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteLBStickinessPolicyParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteLBStickinessPolicyParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteLBStickinessPolicyResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLoadBalancerParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteLoadBalancerParams) Command() string {
+	return "deleteLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteLoadBalancerParams) ResponseType() interface{} {
+	return &DeleteLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteLoadBalancerParams) Validate() error {
+	v := &validator{api: "deleteLoadBalancer"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteLoadBalancerParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteLoadBalancerParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteLoadBalancerParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteLoadBalancerParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteLoadBalancerResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteLoadBalancerRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteLoadBalancerRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteLoadBalancerRuleParams) Command() string {
+	return "deleteLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteLoadBalancerRuleParams) ResponseType() interface{} {
+	return &DeleteLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "deleteLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddNetscalerLoadBalancerParams struct {
+	Gslbprovider            *bool   `json:"gslbprovider,omitempty" yaml:"gslbprovider,omitempty"`
+	Gslbproviderprivateip   *string `json:"gslbproviderprivateip,omitempty" yaml:"gslbproviderprivateip,omitempty"`
+	Gslbproviderpublicip    *string `json:"gslbproviderpublicip,omitempty" yaml:"gslbproviderpublicip,omitempty"`
+	Isexclusivegslbprovider *bool   `json:"isexclusivegslbprovider,omitempty" yaml:"isexclusivegslbprovider,omitempty"`
+	Networkdevicetype       *string `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Password                *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid       *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Url                     *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username                *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddNetscalerLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Gslbprovider; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("gslbprovider", vv)
+	}
+	if v := p.Gslbproviderprivateip; v != nil {
+		u.Set("gslbproviderprivateip", *v)
+	}
+	if v := p.Gslbproviderpublicip; v != nil {
+		u.Set("gslbproviderpublicip", *v)
+	}
+	if v := p.Isexclusivegslbprovider; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isexclusivegslbprovider", vv)
+	}
+	if v := p.Networkdevicetype; v != nil {
+		u.Set("networkdevicetype", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddNetscalerLoadBalancerParams) Command() string {
+	return "addNetscalerLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddNetscalerLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddNetscalerLoadBalancerParams) ResponseType() interface{} {
+	return &AddNetscalerLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddNetscalerLoadBalancerParams) Validate() error {
+	v := &validator{api: "addNetscalerLoadBalancer"}
+	v.required("networkdevicetype", p.Networkdevicetype != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
+	p.Gslbprovider = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetGslbprovider() bool {
+	if p.Gslbprovider == nil {
+		return false
+	}
+	return *p.Gslbprovider
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasGslbprovider() bool {
+	return p.Gslbprovider != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetGslbprovider() {
+	p.Gslbprovider = nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderprivateip(v string) {
+	p.Gslbproviderprivateip = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetGslbproviderprivateip() string {
+	if p.Gslbproviderprivateip == nil {
+		return ""
+	}
+	return *p.Gslbproviderprivateip
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasGslbproviderprivateip() bool {
+	return p.Gslbproviderprivateip != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetGslbproviderprivateip() {
+	p.Gslbproviderprivateip = nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetGslbproviderpublicip(v string) {
+	p.Gslbproviderpublicip = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetGslbproviderpublicip() string {
+	if p.Gslbproviderpublicip == nil {
+		return ""
+	}
+	return *p.Gslbproviderpublicip
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasGslbproviderpublicip() bool {
+	return p.Gslbproviderpublicip != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetGslbproviderpublicip() {
+	p.Gslbproviderpublicip = nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) SetIsexclusivegslbprovider(v bool) {
+	p.Isexclusivegslbprovider = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetIsexclusivegslbprovider() bool {
+	if p.Isexclusivegslbprovider == nil {
+		return false
+	}
+	return *p.Isexclusivegslbprovider
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasIsexclusivegslbprovider() bool {
+	return p.Isexclusivegslbprovider != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetIsexclusivegslbprovider() {
+	p.Isexclusivegslbprovider = nil
+}
+
+// This param is required.
+func (p *AddNetscalerLoadBalancerParams) SetNetworkdevicetype(v string) {
+	p.Networkdevicetype = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetNetworkdevicetype() string {
+	if p.Networkdevicetype == nil {
+		return ""
+	}
+	return *p.Networkdevicetype
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasNetworkdevicetype() bool {
+	return p.Networkdevicetype != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetNetworkdevicetype() {
+	p.Networkdevicetype = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddNetscalerLoadBalancerParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddNetscalerLoadBalancerParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddNetscalerLoadBalancerParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddNetscalerLoadBalancerParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddNetscalerLoadBalancerParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddNetscalerLoadBalancerParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddNetscalerLoadBalancerParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddNetscalerLoadBalancerResponse struct {
+	JobID                   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Gslbprovider            Bool                       `json:"gslbprovider,omitempty" xml:"gslbprovider,omitempty"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip,omitempty" xml:"gslbproviderprivateip,omitempty"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip,omitempty" xml:"gslbproviderpublicip,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isexclusivegslbprovider Bool                       `json:"isexclusivegslbprovider,omitempty" xml:"isexclusivegslbprovider,omitempty"`
+	Lbdevicecapacity        int64                      `json:"lbdevicecapacity,omitempty" xml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated       Bool                       `json:"lbdevicededicated,omitempty" xml:"lbdevicededicated,omitempty"`
+	Lbdeviceid              string                     `json:"lbdeviceid,omitempty" xml:"lbdeviceid,omitempty"`
+	Lbdevicename            string                     `json:"lbdevicename,omitempty" xml:"lbdevicename,omitempty"`
+	Lbdevicestate           string                     `json:"lbdevicestate,omitempty" xml:"lbdevicestate,omitempty"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Podids                  []string                   `json:"podids,omitempty" xml:"podids,omitempty"`
+	Privateinterface        string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Provider                string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface         string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ConfigureNetscalerLoadBalancerParams struct {
+	Inline            *bool    `json:"inline,omitempty" yaml:"inline,omitempty"`
+	Lbdevicecapacity  *int64   `json:"lbdevicecapacity,omitempty" yaml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated *bool    `json:"lbdevicededicated,omitempty" yaml:"lbdevicededicated,omitempty"`
+	Lbdeviceid        *string  `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Podids            []string `json:"podids,omitempty" yaml:"podids,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigureNetscalerLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Inline; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("inline", vv)
+	}
+	if v := p.Lbdevicecapacity; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("lbdevicecapacity", vv)
+	}
+	if v := p.Lbdevicededicated; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("lbdevicededicated", vv)
+	}
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
+	}
+	if v := p.Podids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("podids", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ConfigureNetscalerLoadBalancerParams) Command() string {
+	return "configureNetscalerLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigureNetscalerLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigureNetscalerLoadBalancerParams) ResponseType() interface{} {
+	return &ConfigureNetscalerLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigureNetscalerLoadBalancerParams) Validate() error {
+	v := &validator{api: "configureNetscalerLoadBalancer"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
+	p.Inline = &v
+	return
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetInline() bool {
+	if p.Inline == nil {
+		return false
+	}
+	return *p.Inline
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) HasInline() bool {
+	return p.Inline != nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) ResetInline() {
+	p.Inline = nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicecapacity(v int64) {
+	p.Lbdevicecapacity = &v
+	return
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetLbdevicecapacity() int64 {
+	if p.Lbdevicecapacity == nil {
+		return 0
+	}
+	return *p.Lbdevicecapacity
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) HasLbdevicecapacity() bool {
+	return p.Lbdevicecapacity != nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdevicecapacity() {
+	p.Lbdevicecapacity = nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdevicededicated(v bool) {
+	p.Lbdevicededicated = &v
+	return
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetLbdevicededicated() bool {
+	if p.Lbdevicededicated == nil {
+		return false
+	}
+	return *p.Lbdevicededicated
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) HasLbdevicededicated() bool {
+	return p.Lbdevicededicated != nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdevicededicated() {
+	p.Lbdevicededicated = nil
+}
+
+// This param is required.
+func (p *ConfigureNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
+	return
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
+	}
+	return *p.Lbdeviceid
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) SetPodids(v []string) {
+	p.Podids = v
+	return
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) GetPodids() []string {
+	return p.Podids
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) HasPodids() bool {
+	return p.Podids != nil
+}
+
+func (p *ConfigureNetscalerLoadBalancerParams) ResetPodids() {
+	p.Podids = nil
+}
+
+type ConfigureNetscalerLoadBalancerResponse struct {
+	JobID                   string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Gslbprovider            Bool                       `json:"gslbprovider,omitempty" xml:"gslbprovider,omitempty"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip,omitempty" xml:"gslbproviderprivateip,omitempty"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip,omitempty" xml:"gslbproviderpublicip,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isexclusivegslbprovider Bool                       `json:"isexclusivegslbprovider,omitempty" xml:"isexclusivegslbprovider,omitempty"`
+	Lbdevicecapacity        int64                      `json:"lbdevicecapacity,omitempty" xml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated       Bool                       `json:"lbdevicededicated,omitempty" xml:"lbdevicededicated,omitempty"`
+	Lbdeviceid              string                     `json:"lbdeviceid,omitempty" xml:"lbdeviceid,omitempty"`
+	Lbdevicename            string                     `json:"lbdevicename,omitempty" xml:"lbdevicename,omitempty"`
+	Lbdevicestate           string                     `json:"lbdevicestate,omitempty" xml:"lbdevicestate,omitempty"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Podids                  []string                   `json:"podids,omitempty" xml:"podids,omitempty"`
+	Privateinterface        string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Provider                string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface         string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetscalerLoadBalancerParams struct {
+	Lbdeviceid *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetscalerLoadBalancerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetscalerLoadBalancerParams) Command() string {
+	return "deleteNetscalerLoadBalancer"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetscalerLoadBalancerParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetscalerLoadBalancerParams) ResponseType() interface{} {
+	return &DeleteNetscalerLoadBalancerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetscalerLoadBalancerParams) Validate() error {
+	v := &validator{api: "deleteNetscalerLoadBalancer"}
+	v.required("lbdeviceid", p.Lbdeviceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
+	return
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
+	}
+	return *p.Lbdeviceid
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *DeleteNetscalerLoadBalancerParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+type DeleteNetscalerLoadBalancerResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNetscalerLoadBalancersParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Lbdeviceid        *string `json:"lbdeviceid,omitempty" yaml:"lbdeviceid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetscalerLoadBalancersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Lbdeviceid; v != nil {
+		u.Set("lbdeviceid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListNetscalerLoadBalancersParams) Command() string {
+	return "listNetscalerLoadBalancers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNetscalerLoadBalancersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetscalerLoadBalancersParams) ResponseType() interface{} {
+	return &ListNetscalerLoadBalancersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetscalerLoadBalancersParams) Validate() error {
+	v := &validator{api: "listNetscalerLoadBalancers"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListNetscalerLoadBalancersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetscalerLoadBalancersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListNetscalerLoadBalancersParams) SetLbdeviceid(v string) {
+	p.Lbdeviceid = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetLbdeviceid() string {
+	if p.Lbdeviceid == nil {
+		return ""
+	}
+	return *p.Lbdeviceid
+}
+
+func (p *ListNetscalerLoadBalancersParams) HasLbdeviceid() bool {
+	return p.Lbdeviceid != nil
+}
+
+func (p *ListNetscalerLoadBalancersParams) ResetLbdeviceid() {
+	p.Lbdeviceid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetscalerLoadBalancersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNetscalerLoadBalancersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetscalerLoadBalancersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetscalerLoadBalancersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetscalerLoadBalancersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetscalerLoadBalancersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListNetscalerLoadBalancersParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListNetscalerLoadBalancersParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListNetscalerLoadBalancersParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListNetscalerLoadBalancersParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+type ListNetscalerLoadBalancersResponse struct {
+	Count                  int                        `json:"count" xml:"count"`
+	NetscalerLoadBalancers []*NetscalerLoadBalancer   `json:"netscalerloadbalancer" xml:"netscalerloadbalancer"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type NetscalerLoadBalancer struct {
+	Gslbprovider            Bool                       `json:"gslbprovider,omitempty" xml:"gslbprovider,omitempty"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip,omitempty" xml:"gslbproviderprivateip,omitempty"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip,omitempty" xml:"gslbproviderpublicip,omitempty"`
+	Ipaddress               string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Isexclusivegslbprovider Bool                       `json:"isexclusivegslbprovider,omitempty" xml:"isexclusivegslbprovider,omitempty"`
+	Lbdevicecapacity        int64                      `json:"lbdevicecapacity,omitempty" xml:"lbdevicecapacity,omitempty"`
+	Lbdevicededicated       Bool                       `json:"lbdevicededicated,omitempty" xml:"lbdevicededicated,omitempty"`
+	Lbdeviceid              string                     `json:"lbdeviceid,omitempty" xml:"lbdeviceid,omitempty"`
+	Lbdevicename            string                     `json:"lbdevicename,omitempty" xml:"lbdevicename,omitempty"`
+	Lbdevicestate           string                     `json:"lbdevicestate,omitempty" xml:"lbdevicestate,omitempty"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Podids                  []string                   `json:"podids,omitempty" xml:"podids,omitempty"`
+	Privateinterface        string                     `json:"privateinterface,omitempty" xml:"privateinterface,omitempty"`
+	Provider                string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Publicinterface         string                     `json:"publicinterface,omitempty" xml:"publicinterface,omitempty"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteSslCertParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSslCertParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteSslCertParams) Command() string {
+	return "deleteSslCert"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSslCertParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSslCertParams) ResponseType() interface{} {
+	return &DeleteSslCertResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSslCertParams) Validate() error {
+	v := &validator{api: "deleteSslCert"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteSslCertParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteSslCertParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteSslCertParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteSslCertParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteSslCertResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AssignToGlobalLoadBalancerRuleParams struct {
+	Gslblbruleweightsmap map[string]string `json:"gslblbruleweightsmap,omitempty" yaml:"gslblbruleweightsmap,omitempty"`
+	Id                   *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Loadbalancerrulelist []string          `json:"loadbalancerrulelist,omitempty" yaml:"loadbalancerrulelist,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AssignToGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Gslblbruleweightsmap; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].loadbalancerid", i), k)
+			u.Set(fmt.Sprintf("gslblbruleweightsmap[%d].weight", i), vv)
+			i++
+		}
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Loadbalancerrulelist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("loadbalancerrulelist", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AssignToGlobalLoadBalancerRuleParams) Command() string {
+	return "assignToGlobalLoadBalancerRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *AssignToGlobalLoadBalancerRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AssignToGlobalLoadBalancerRuleParams) ResponseType() interface{} {
+	return &AssignToGlobalLoadBalancerRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AssignToGlobalLoadBalancerRuleParams) Validate() error {
+	v := &validator{api: "assignToGlobalLoadBalancerRule"}
+	v.required("id", p.Id != nil)
+	v.required("loadbalancerrulelist", p.Loadbalancerrulelist != nil)
+	return v.err()
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
+	p.Gslblbruleweightsmap = v
+	return
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) GetGslblbruleweightsmap() map[string]string {
+	return p.Gslblbruleweightsmap
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) HasGslblbruleweightsmap() bool {
+	return p.Gslblbruleweightsmap != nil
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) ResetGslblbruleweightsmap() {
+	p.Gslblbruleweightsmap = nil
+}
+
+// This param is required.
+func (p *AssignToGlobalLoadBalancerRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *AssignToGlobalLoadBalancerRuleParams) SetLoadbalancerrulelist(v []string) {
+	p.Loadbalancerrulelist = v
+	return
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) GetLoadbalancerrulelist() []string {
+	return p.Loadbalancerrulelist
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) HasLoadbalancerrulelist() bool {
+	return p.Loadbalancerrulelist != nil
+}
+
+func (p *AssignToGlobalLoadBalancerRuleParams) ResetLoadbalancerrulelist() {
+	p.Loadbalancerrulelist = nil
+}
+
+type AssignToGlobalLoadBalancerRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the LoadBalancerService that is the same for all versions
+type LoadBalancerService interface {
+	NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams
+	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams
+	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams
+	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams
+	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams
+	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams
+	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams
+	DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams
+	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams
+	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams
+	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*ConfigureNetscalerLoadBalancerResponse, error)
+	NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams
+	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams
+	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	StreamNetscalerLoadBalancers(ctx context.Context, p *ListNetscalerLoadBalancersParams, fn func(*NetscalerLoadBalancer) error) (int, error)
+	NewDeleteSslCertParams(id string) *DeleteSslCertParams
+	DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams
+	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+}
@@ -0,0 +1,466 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestRemoveCertFromLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"lbruleid": {"test-lbruleid"},
+	}
+
+	p := &RemoveCertFromLoadBalancerParams{}
+	p.SetLbruleid("test-lbruleid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "removeCertFromLoadBalancer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "removeCertFromLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RemoveCertFromLoadBalancerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "removeCertFromLoadBalancer", `{"removecertfromloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestAssignCertToLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"certid":   {"test-certid"},
+		"lbruleid": {"test-lbruleid"},
+	}
+
+	p := &AssignCertToLoadBalancerParams{}
+	p.SetCertid("test-certid")
+	p.SetLbruleid("test-lbruleid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "assignCertToLoadBalancer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "assignCertToLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AssignCertToLoadBalancerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "assignCertToLoadBalancer", `{"assigncerttoloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestRemoveFromGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id":                   {"test-id"},
+		"loadbalancerrulelist": {"test-loadbalancerrulelist1, test-loadbalancerrulelist2"},
+	}
+
+	p := &RemoveFromGlobalLoadBalancerRuleParams{}
+	p.SetId("test-id")
+	p.SetLoadbalancerrulelist([]string{"test-loadbalancerrulelist1", "test-loadbalancerrulelist2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "removeFromGlobalLoadBalancerRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "removeFromGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RemoveFromGlobalLoadBalancerRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "removeFromGlobalLoadBalancerRule", `{"removefromgloballoadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteGlobalLoadBalancerRuleParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteGlobalLoadBalancerRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteGlobalLoadBalancerRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteGlobalLoadBalancerRule", `{"deletegloballoadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteLBHealthCheckPolicy(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteLBHealthCheckPolicyParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteLBHealthCheckPolicy", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLBHealthCheckPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteLBHealthCheckPolicyResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteLBHealthCheckPolicy", `{"deletelbhealthcheckpolicyresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteLBStickinessPolicy(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteLBStickinessPolicyParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteLBStickinessPolicy", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLBStickinessPolicy" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteLBStickinessPolicyResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteLBStickinessPolicy", `{"deletelbstickinesspolicyresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteLoadBalancerParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteLoadBalancer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteLoadBalancerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteLoadBalancer", `{"deleteloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteLoadBalancerRuleParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteLoadBalancerRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteLoadBalancerRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteLoadBalancerRule", `{"deleteloadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestAddNetscalerLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"gslbprovider":            {"true"},
+		"gslbproviderprivateip":   {"test-gslbproviderprivateip"},
+		"gslbproviderpublicip":    {"test-gslbproviderpublicip"},
+		"isexclusivegslbprovider": {"true"},
+		"networkdevicetype":       {"test-networkdevicetype"},
+		"password":                {"test-password"},
+		"physicalnetworkid":       {"test-physicalnetworkid"},
+		"url":                     {"test-url"},
+		"username":                {"test-username"},
+	}
+
+	p := &AddNetscalerLoadBalancerParams{}
+	p.SetGslbprovider(true)
+	p.SetGslbproviderprivateip("test-gslbproviderprivateip")
+	p.SetGslbproviderpublicip("test-gslbproviderpublicip")
+	p.SetIsexclusivegslbprovider(true)
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addNetscalerLoadBalancer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addNetscalerLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddNetscalerLoadBalancerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addNetscalerLoadBalancer", `{"addnetscalerloadbalancerresponse":{"gslbprovider":true,"gslbproviderprivateip":"test-gslbproviderprivateip","gslbproviderpublicip":"test-gslbproviderpublicip","ipaddress":"test-ipaddress","isexclusivegslbprovider":true,"jobid":"test-jobid","lbdevicecapacity":1,"lbdevicededicated":true,"lbdeviceid":"test-lbdeviceid","lbdevicename":"test-lbdevicename","lbdevicestate":"test-lbdevicestate","physicalnetworkid":"test-physicalnetworkid","podids":["test-podids"],"privateinterface":"test-privateinterface","provider":"test-provider","publicinterface":"test-publicinterface"}}`, false, r)
+	if r.Gslbproviderprivateip != "test-gslbproviderprivateip" {
+		t.Errorf("Expected r.Gslbproviderprivateip to be %v, got %v", "test-gslbproviderprivateip", r.Gslbproviderprivateip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestConfigureNetscalerLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"inline":            {"true"},
+		"lbdevicecapacity":  {"2"},
+		"lbdevicededicated": {"true"},
+		"lbdeviceid":        {"test-lbdeviceid"},
+		"podids":            {"test-podids1, test-podids2"},
+	}
+
+	p := &ConfigureNetscalerLoadBalancerParams{}
+	p.SetInline(true)
+	p.SetLbdevicecapacity(2)
+	p.SetLbdevicededicated(true)
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPodids([]string{"test-podids1", "test-podids2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "configureNetscalerLoadBalancer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "configureNetscalerLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ConfigureNetscalerLoadBalancerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "configureNetscalerLoadBalancer", `{"configurenetscalerloadbalancerresponse":{"gslbprovider":true,"gslbproviderprivateip":"test-gslbproviderprivateip","gslbproviderpublicip":"test-gslbproviderpublicip","ipaddress":"test-ipaddress","isexclusivegslbprovider":true,"jobid":"test-jobid","lbdevicecapacity":1,"lbdevicededicated":true,"lbdeviceid":"test-lbdeviceid","lbdevicename":"test-lbdevicename","lbdevicestate":"test-lbdevicestate","physicalnetworkid":"test-physicalnetworkid","podids":["test-podids"],"privateinterface":"test-privateinterface","provider":"test-provider","publicinterface":"test-publicinterface"}}`, false, r)
+	if r.Gslbproviderprivateip != "test-gslbproviderprivateip" {
+		t.Errorf("Expected r.Gslbproviderprivateip to be %v, got %v", "test-gslbproviderprivateip", r.Gslbproviderprivateip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteNetscalerLoadBalancer(t *testing.T) {
+	params := url.Values{
+		"lbdeviceid": {"test-lbdeviceid"},
+	}
+
+	p := &DeleteNetscalerLoadBalancerParams{}
+	p.SetLbdeviceid("test-lbdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteNetscalerLoadBalancer", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetscalerLoadBalancer" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteNetscalerLoadBalancerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteNetscalerLoadBalancer", `{"deletenetscalerloadbalancerresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListNetscalerLoadBalancers(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"lbdeviceid":        {"test-lbdeviceid"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+
+	p := &ListNetscalerLoadBalancersParams{}
+	p.SetKeyword("test-keyword")
+	p.SetLbdeviceid("test-lbdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listNetscalerLoadBalancers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listNetscalerLoadBalancers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListNetscalerLoadBalancersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listNetscalerLoadBalancers", `{"listnetscalerloadbalancersresponse":{"count":1,"netscalerloadbalancer":[{"gslbprovider":true,"gslbproviderprivateip":"test-gslbproviderprivateip","gslbproviderpublicip":"test-gslbproviderpublicip","ipaddress":"test-ipaddress","isexclusivegslbprovider":true,"lbdevicecapacity":1,"lbdevicededicated":true,"lbdeviceid":"test-lbdeviceid","lbdevicename":"test-lbdevicename","lbdevicestate":"test-lbdevicestate","physicalnetworkid":"test-physicalnetworkid","podids":["test-podids"],"privateinterface":"test-privateinterface","provider":"test-provider","publicinterface":"test-publicinterface"}]}}`, false, r)
+	if r.NetscalerLoadBalancers[0].Gslbproviderprivateip != "test-gslbproviderprivateip" {
+		t.Errorf("Expected r.NetscalerLoadBalancers[0].Gslbproviderprivateip to be %v, got %v", "test-gslbproviderprivateip", r.NetscalerLoadBalancers[0].Gslbproviderprivateip)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestDeleteSslCert(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteSslCertParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteSslCert", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSslCert" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteSslCertResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteSslCert", `{"deletesslcertresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestAssignToGlobalLoadBalancerRule(t *testing.T) {
+	params := url.Values{
+		"gslblbruleweightsmap[0].loadbalancerid": {"test-gslblbruleweightsmapkey"},
+		"gslblbruleweightsmap[0].weight":         {"test-gslblbruleweightsmapvalue"},
+		"id":                                     {"test-id"},
+		"loadbalancerrulelist":                   {"test-loadbalancerrulelist1, test-loadbalancerrulelist2"},
+	}
+
+	p := &AssignToGlobalLoadBalancerRuleParams{}
+	p.SetGslblbruleweightsmap(map[string]string{"test-gslblbruleweightsmapkey": "test-gslblbruleweightsmapvalue"})
+	p.SetId("test-id")
+	p.SetLoadbalancerrulelist([]string{"test-loadbalancerrulelist1", "test-loadbalancerrulelist2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "assignToGlobalLoadBalancerRule", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "assignToGlobalLoadBalancerRule" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AssignToGlobalLoadBalancerRuleResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "assignToGlobalLoadBalancerRule", `{"assigntogloballoadbalancerruleresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
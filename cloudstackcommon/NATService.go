@@ -0,0 +1,298 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type DeleteIpForwardingRuleParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteIpForwardingRuleParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteIpForwardingRuleParams) Command() string {
+	return "deleteIpForwardingRule"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteIpForwardingRuleParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteIpForwardingRuleParams) ResponseType() interface{} {
+	return &DeleteIpForwardingRuleResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteIpForwardingRuleParams) Validate() error {
+	v := &validator{api: "deleteIpForwardingRule"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteIpForwardingRuleParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteIpForwardingRuleParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteIpForwardingRuleParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteIpForwardingRuleParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteIpForwardingRuleResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DisableStaticNatParams struct {
+	Ipaddressid *string `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DisableStaticNatParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DisableStaticNatParams) Command() string {
+	return "disableStaticNat"
+}
+
+// Returns true if the API command starts an async job
+func (p *DisableStaticNatParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DisableStaticNatParams) ResponseType() interface{} {
+	return &DisableStaticNatResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DisableStaticNatParams) Validate() error {
+	v := &validator{api: "disableStaticNat"}
+	v.required("ipaddressid", p.Ipaddressid != nil)
+	return v.err()
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address. This param is required.
+func (p *DisableStaticNatParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
+	return
+}
+
+func (p *DisableStaticNatParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
+	}
+	return *p.Ipaddressid
+}
+
+func (p *DisableStaticNatParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *DisableStaticNatParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+type DisableStaticNatResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type EnableStaticNatParams struct {
+	Ipaddressid      *string `json:"ipaddressid,omitempty" yaml:"ipaddressid,omitempty"`
+	Networkid        *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmguestip        *string `json:"vmguestip,omitempty" yaml:"vmguestip,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *EnableStaticNatParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Ipaddressid; v != nil {
+		u.Set("ipaddressid", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	if v := p.Vmguestip; v != nil {
+		u.Set("vmguestip", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *EnableStaticNatParams) Command() string {
+	return "enableStaticNat"
+}
+
+// Returns true if the API command starts an async job
+func (p *EnableStaticNatParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *EnableStaticNatParams) ResponseType() interface{} {
+	return &EnableStaticNatResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *EnableStaticNatParams) Validate() error {
+	v := &validator{api: "enableStaticNat"}
+	v.required("ipaddressid", p.Ipaddressid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'ipaddressid' param: the ID of the public IP address. This param is required.
+func (p *EnableStaticNatParams) SetIpaddressid(v string) {
+	p.Ipaddressid = &v
+	return
+}
+
+func (p *EnableStaticNatParams) GetIpaddressid() string {
+	if p.Ipaddressid == nil {
+		return ""
+	}
+	return *p.Ipaddressid
+}
+
+func (p *EnableStaticNatParams) HasIpaddressid() bool {
+	return p.Ipaddressid != nil
+}
+
+func (p *EnableStaticNatParams) ResetIpaddressid() {
+	p.Ipaddressid = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *EnableStaticNatParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *EnableStaticNatParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *EnableStaticNatParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *EnableStaticNatParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *EnableStaticNatParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *EnableStaticNatParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *EnableStaticNatParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *EnableStaticNatParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+func (p *EnableStaticNatParams) SetVmguestip(v string) {
+	p.Vmguestip = &v
+	return
+}
+
+func (p *EnableStaticNatParams) GetVmguestip() string {
+	if p.Vmguestip == nil {
+		return ""
+	}
+	return *p.Vmguestip
+}
+
+func (p *EnableStaticNatParams) HasVmguestip() bool {
+	return p.Vmguestip != nil
+}
+
+func (p *EnableStaticNatParams) ResetVmguestip() {
+	p.Vmguestip = nil
+}
+
+type EnableStaticNatResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NATService that is the same for all versions
+type NATService interface {
+	NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams
+	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams
+	DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams
+	EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+}
@@ -0,0 +1,273 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type DeleteNetworkACLParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetworkACLParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetworkACLParams) Command() string {
+	return "deleteNetworkACL"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetworkACLParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetworkACLParams) ResponseType() interface{} {
+	return &DeleteNetworkACLResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetworkACLParams) Validate() error {
+	v := &validator{api: "deleteNetworkACL"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNetworkACLParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteNetworkACLParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteNetworkACLParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteNetworkACLParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteNetworkACLResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetworkACLListParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetworkACLListParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetworkACLListParams) Command() string {
+	return "deleteNetworkACLList"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetworkACLListParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetworkACLListParams) ResponseType() interface{} {
+	return &DeleteNetworkACLListResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetworkACLListParams) Validate() error {
+	v := &validator{api: "deleteNetworkACLList"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNetworkACLListParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteNetworkACLListParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteNetworkACLListParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteNetworkACLListParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteNetworkACLListResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReplaceNetworkACLListParams struct {
+	Aclid     *string `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Gatewayid *string `json:"gatewayid,omitempty" yaml:"gatewayid,omitempty"`
+	Networkid *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReplaceNetworkACLListParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Aclid; v != nil {
+		u.Set("aclid", *v)
+	}
+	if v := p.Gatewayid; v != nil {
+		u.Set("gatewayid", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReplaceNetworkACLListParams) Command() string {
+	return "replaceNetworkACLList"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReplaceNetworkACLListParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReplaceNetworkACLListParams) ResponseType() interface{} {
+	return &ReplaceNetworkACLListResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReplaceNetworkACLListParams) Validate() error {
+	v := &validator{api: "replaceNetworkACLList"}
+	v.required("aclid", p.Aclid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
+	p.Aclid = &v
+	return
+}
+
+func (p *ReplaceNetworkACLListParams) GetAclid() string {
+	if p.Aclid == nil {
+		return ""
+	}
+	return *p.Aclid
+}
+
+func (p *ReplaceNetworkACLListParams) HasAclid() bool {
+	return p.Aclid != nil
+}
+
+func (p *ReplaceNetworkACLListParams) ResetAclid() {
+	p.Aclid = nil
+}
+
+func (p *ReplaceNetworkACLListParams) SetGatewayid(v string) {
+	p.Gatewayid = &v
+	return
+}
+
+func (p *ReplaceNetworkACLListParams) GetGatewayid() string {
+	if p.Gatewayid == nil {
+		return ""
+	}
+	return *p.Gatewayid
+}
+
+func (p *ReplaceNetworkACLListParams) HasGatewayid() bool {
+	return p.Gatewayid != nil
+}
+
+func (p *ReplaceNetworkACLListParams) ResetGatewayid() {
+	p.Gatewayid = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ReplaceNetworkACLListParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ReplaceNetworkACLListParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ReplaceNetworkACLListParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ReplaceNetworkACLListParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+type ReplaceNetworkACLListResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NetworkACLService that is the same for all versions
+type NetworkACLService interface {
+	NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams
+	DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams
+	DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams
+	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+}
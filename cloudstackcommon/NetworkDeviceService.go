@@ -0,0 +1,348 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type AddNetworkDeviceParams struct {
+	Networkdeviceparameterlist map[string]string `json:"networkdeviceparameterlist,omitempty" yaml:"networkdeviceparameterlist,omitempty"`
+	Networkdevicetype          *string           `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddNetworkDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Networkdeviceparameterlist; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("networkdeviceparameterlist[0].%s", k), vv)
+		}
+	}
+	if v := p.Networkdevicetype; v != nil {
+		u.Set("networkdevicetype", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddNetworkDeviceParams) Command() string {
+	return "addNetworkDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddNetworkDeviceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddNetworkDeviceParams) ResponseType() interface{} {
+	return &AddNetworkDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddNetworkDeviceParams) Validate() error {
+	v := &validator{api: "addNetworkDevice"}
+	return v.err()
+}
+
+func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
+	p.Networkdeviceparameterlist = v
+	return
+}
+
+func (p *AddNetworkDeviceParams) GetNetworkdeviceparameterlist() map[string]string {
+	return p.Networkdeviceparameterlist
+}
+
+func (p *AddNetworkDeviceParams) HasNetworkdeviceparameterlist() bool {
+	return p.Networkdeviceparameterlist != nil
+}
+
+func (p *AddNetworkDeviceParams) ResetNetworkdeviceparameterlist() {
+	p.Networkdeviceparameterlist = nil
+}
+
+func (p *AddNetworkDeviceParams) SetNetworkdevicetype(v string) {
+	p.Networkdevicetype = &v
+	return
+}
+
+func (p *AddNetworkDeviceParams) GetNetworkdevicetype() string {
+	if p.Networkdevicetype == nil {
+		return ""
+	}
+	return *p.Networkdevicetype
+}
+
+func (p *AddNetworkDeviceParams) HasNetworkdevicetype() bool {
+	return p.Networkdevicetype != nil
+}
+
+func (p *AddNetworkDeviceParams) ResetNetworkdevicetype() {
+	p.Networkdevicetype = nil
+}
+
+type AddNetworkDeviceResponse struct {
+	Id    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetworkDeviceParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetworkDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetworkDeviceParams) Command() string {
+	return "deleteNetworkDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetworkDeviceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetworkDeviceParams) ResponseType() interface{} {
+	return &DeleteNetworkDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetworkDeviceParams) Validate() error {
+	v := &validator{api: "deleteNetworkDevice"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNetworkDeviceParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteNetworkDeviceParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteNetworkDeviceParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteNetworkDeviceParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteNetworkDeviceResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNetworkDeviceParams struct {
+	Keyword                    *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Networkdeviceparameterlist map[string]string `json:"networkdeviceparameterlist,omitempty" yaml:"networkdeviceparameterlist,omitempty"`
+	Networkdevicetype          *string           `json:"networkdevicetype,omitempty" yaml:"networkdevicetype,omitempty"`
+	Page                       *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize                   *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworkDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Networkdeviceparameterlist; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("networkdeviceparameterlist[0].%s", k), vv)
+		}
+	}
+	if v := p.Networkdevicetype; v != nil {
+		u.Set("networkdevicetype", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListNetworkDeviceParams) Command() string {
+	return "listNetworkDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNetworkDeviceParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworkDeviceParams) ResponseType() interface{} {
+	return &ListNetworkDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworkDeviceParams) Validate() error {
+	v := &validator{api: "listNetworkDevice"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworkDeviceParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNetworkDeviceParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListNetworkDeviceParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworkDeviceParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
+	p.Networkdeviceparameterlist = v
+	return
+}
+
+func (p *ListNetworkDeviceParams) GetNetworkdeviceparameterlist() map[string]string {
+	return p.Networkdeviceparameterlist
+}
+
+func (p *ListNetworkDeviceParams) HasNetworkdeviceparameterlist() bool {
+	return p.Networkdeviceparameterlist != nil
+}
+
+func (p *ListNetworkDeviceParams) ResetNetworkdeviceparameterlist() {
+	p.Networkdeviceparameterlist = nil
+}
+
+func (p *ListNetworkDeviceParams) SetNetworkdevicetype(v string) {
+	p.Networkdevicetype = &v
+	return
+}
+
+func (p *ListNetworkDeviceParams) GetNetworkdevicetype() string {
+	if p.Networkdevicetype == nil {
+		return ""
+	}
+	return *p.Networkdevicetype
+}
+
+func (p *ListNetworkDeviceParams) HasNetworkdevicetype() bool {
+	return p.Networkdevicetype != nil
+}
+
+func (p *ListNetworkDeviceParams) ResetNetworkdevicetype() {
+	p.Networkdevicetype = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworkDeviceParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNetworkDeviceParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNetworkDeviceParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetworkDeviceParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworkDeviceParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNetworkDeviceParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetworkDeviceParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworkDeviceParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListNetworkDeviceResponse struct {
+	Count         int                        `json:"count" xml:"count"`
+	NetworkDevice []*NetworkDevice           `json:"networkdevice" xml:"networkdevice"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type NetworkDevice struct {
+	Id    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NetworkDeviceService that is the same for all versions
+type NetworkDeviceService interface {
+	NewAddNetworkDeviceParams() *AddNetworkDeviceParams
+	AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams
+	DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	NewListNetworkDeviceParams() *ListNetworkDeviceParams
+	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	StreamNetworkDevice(ctx context.Context, p *ListNetworkDeviceParams, fn func(*NetworkDevice) error) (int, error)
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddNetworkDevice(t *testing.T) {
+	params := url.Values{
+		"networkdeviceparameterlist[0].test-networkdeviceparameterlistkey": {"test-networkdeviceparameterlistvalue"},
+		"networkdevicetype": {"test-networkdevicetype"},
+	}
+
+	p := &AddNetworkDeviceParams{}
+	p.SetNetworkdeviceparameterlist(map[string]string{"test-networkdeviceparameterlistkey": "test-networkdeviceparameterlistvalue"})
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addNetworkDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addNetworkDevice" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddNetworkDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addNetworkDevice", `{"addnetworkdeviceresponse":{"id":"test-id"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestDeleteNetworkDevice(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteNetworkDeviceParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteNetworkDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkDevice" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteNetworkDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteNetworkDevice", `{"deletenetworkdeviceresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListNetworkDevice(t *testing.T) {
+	params := url.Values{
+		"keyword": {"test-keyword"},
+		"networkdeviceparameterlist[0].test-networkdeviceparameterlistkey": {"test-networkdeviceparameterlistvalue"},
+		"networkdevicetype": {"test-networkdevicetype"},
+		"page":              {"4"},
+		"pagesize":          {"5"},
+	}
+
+	p := &ListNetworkDeviceParams{}
+	p.SetKeyword("test-keyword")
+	p.SetNetworkdeviceparameterlist(map[string]string{"test-networkdeviceparameterlistkey": "test-networkdeviceparameterlistvalue"})
+	p.SetNetworkdevicetype("test-networkdevicetype")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listNetworkDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkDevice" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListNetworkDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listNetworkDevice", `{"listnetworkdeviceresponse":{"count":1,"networkdevice":[{"id":"test-id"}]}}`, false, r)
+	if r.NetworkDevice[0].Id != "test-id" {
+		t.Errorf("Expected r.NetworkDevice[0].Id to be %v, got %v", "test-id", r.NetworkDevice[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
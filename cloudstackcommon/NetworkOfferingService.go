@@ -0,0 +1,91 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type DeleteNetworkOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetworkOfferingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetworkOfferingParams) Command() string {
+	return "deleteNetworkOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetworkOfferingParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetworkOfferingParams) ResponseType() interface{} {
+	return &DeleteNetworkOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetworkOfferingParams) Validate() error {
+	v := &validator{api: "deleteNetworkOffering"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNetworkOfferingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteNetworkOfferingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteNetworkOfferingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteNetworkOfferingParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteNetworkOfferingResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NetworkOfferingService that is the same for all versions
+type NetworkOfferingService interface {
+	NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams
+	DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+}
@@ -0,0 +1,2499 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type DeleteNetworkParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetworkParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetworkParams) Command() string {
+	return "deleteNetwork"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetworkParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetworkParams) ResponseType() interface{} {
+	return &DeleteNetworkResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetworkParams) Validate() error {
+	v := &validator{api: "deleteNetwork"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *DeleteNetworkParams) SetForced(v bool) {
+	p.Forced = &v
+	return
+}
+
+func (p *DeleteNetworkParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
+	}
+	return *p.Forced
+}
+
+func (p *DeleteNetworkParams) HasForced() bool {
+	return p.Forced != nil
+}
+
+func (p *DeleteNetworkParams) ResetForced() {
+	p.Forced = nil
+}
+
+// This param is required.
+func (p *DeleteNetworkParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteNetworkParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteNetworkParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteNetworkParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteNetworkResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNetworkIsolationMethodsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworkIsolationMethodsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListNetworkIsolationMethodsParams) Command() string {
+	return "listNetworkIsolationMethods"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNetworkIsolationMethodsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworkIsolationMethodsParams) ResponseType() interface{} {
+	return &ListNetworkIsolationMethodsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworkIsolationMethodsParams) Validate() error {
+	v := &validator{api: "listNetworkIsolationMethods"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNetworkIsolationMethodsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListNetworkIsolationMethodsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworkIsolationMethodsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworkIsolationMethodsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNetworkIsolationMethodsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNetworkIsolationMethodsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetworkIsolationMethodsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworkIsolationMethodsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNetworkIsolationMethodsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetworkIsolationMethodsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworkIsolationMethodsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListNetworkIsolationMethodsResponse struct {
+	Count                   int                        `json:"count" xml:"count"`
+	NetworkIsolationMethods []*NetworkIsolationMethod  `json:"networkisolationmethod" xml:"networkisolationmethod"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type NetworkIsolationMethod struct {
+	Name  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddNetworkServiceProviderParams struct {
+	Destinationphysicalnetworkid *string  `json:"destinationphysicalnetworkid,omitempty" yaml:"destinationphysicalnetworkid,omitempty"`
+	Name                         *string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Physicalnetworkid            *string  `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Servicelist                  []string `json:"servicelist,omitempty" yaml:"servicelist,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddNetworkServiceProviderParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Destinationphysicalnetworkid; v != nil {
+		u.Set("destinationphysicalnetworkid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Servicelist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("servicelist", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddNetworkServiceProviderParams) Command() string {
+	return "addNetworkServiceProvider"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddNetworkServiceProviderParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddNetworkServiceProviderParams) ResponseType() interface{} {
+	return &AddNetworkServiceProviderResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddNetworkServiceProviderParams) Validate() error {
+	v := &validator{api: "addNetworkServiceProvider"}
+	v.required("name", p.Name != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	return v.err()
+}
+
+func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
+	p.Destinationphysicalnetworkid = &v
+	return
+}
+
+func (p *AddNetworkServiceProviderParams) GetDestinationphysicalnetworkid() string {
+	if p.Destinationphysicalnetworkid == nil {
+		return ""
+	}
+	return *p.Destinationphysicalnetworkid
+}
+
+func (p *AddNetworkServiceProviderParams) HasDestinationphysicalnetworkid() bool {
+	return p.Destinationphysicalnetworkid != nil
+}
+
+func (p *AddNetworkServiceProviderParams) ResetDestinationphysicalnetworkid() {
+	p.Destinationphysicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddNetworkServiceProviderParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *AddNetworkServiceProviderParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *AddNetworkServiceProviderParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *AddNetworkServiceProviderParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddNetworkServiceProviderParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddNetworkServiceProviderParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddNetworkServiceProviderParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddNetworkServiceProviderParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+func (p *AddNetworkServiceProviderParams) SetServicelist(v []string) {
+	p.Servicelist = v
+	return
+}
+
+func (p *AddNetworkServiceProviderParams) GetServicelist() []string {
+	return p.Servicelist
+}
+
+func (p *AddNetworkServiceProviderParams) HasServicelist() bool {
+	return p.Servicelist != nil
+}
+
+func (p *AddNetworkServiceProviderParams) ResetServicelist() {
+	p.Servicelist = nil
+}
+
+type AddNetworkServiceProviderResponse struct {
+	JobID                        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Canenableindividualservice   Bool                       `json:"canenableindividualservice,omitempty" xml:"canenableindividualservice,omitempty"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid,omitempty" xml:"destinationphysicalnetworkid,omitempty"`
+	Id                           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Servicelist                  []string                   `json:"servicelist,omitempty" xml:"servicelist,omitempty"`
+	State                        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNetworkServiceProviderParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNetworkServiceProviderParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNetworkServiceProviderParams) Command() string {
+	return "deleteNetworkServiceProvider"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNetworkServiceProviderParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNetworkServiceProviderParams) ResponseType() interface{} {
+	return &DeleteNetworkServiceProviderResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNetworkServiceProviderParams) Validate() error {
+	v := &validator{api: "deleteNetworkServiceProvider"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteNetworkServiceProviderParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteNetworkServiceProviderParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteNetworkServiceProviderParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteNetworkServiceProviderResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNetworkServiceProvidersParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name              *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	State             *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNetworkServiceProvidersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListNetworkServiceProvidersParams) Command() string {
+	return "listNetworkServiceProviders"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNetworkServiceProvidersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNetworkServiceProvidersParams) ResponseType() interface{} {
+	return &ListNetworkServiceProvidersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNetworkServiceProvidersParams) Validate() error {
+	v := &validator{api: "listNetworkServiceProviders"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNetworkServiceProvidersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListNetworkServiceProvidersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNetworkServiceProvidersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListNetworkServiceProvidersParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListNetworkServiceProvidersParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListNetworkServiceProvidersParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListNetworkServiceProvidersParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNetworkServiceProvidersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNetworkServiceProvidersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNetworkServiceProvidersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNetworkServiceProvidersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNetworkServiceProvidersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNetworkServiceProvidersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNetworkServiceProvidersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNetworkServiceProvidersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListNetworkServiceProvidersParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListNetworkServiceProvidersParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListNetworkServiceProvidersParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListNetworkServiceProvidersParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+func (p *ListNetworkServiceProvidersParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListNetworkServiceProvidersParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListNetworkServiceProvidersParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListNetworkServiceProvidersParams) ResetState() {
+	p.State = nil
+}
+
+type ListNetworkServiceProvidersResponse struct {
+	Count                   int                        `json:"count" xml:"count"`
+	NetworkServiceProviders []*NetworkServiceProvider  `json:"networkserviceprovider" xml:"networkserviceprovider"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type NetworkServiceProvider struct {
+	Canenableindividualservice   Bool                       `json:"canenableindividualservice,omitempty" xml:"canenableindividualservice,omitempty"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid,omitempty" xml:"destinationphysicalnetworkid,omitempty"`
+	Id                           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Servicelist                  []string                   `json:"servicelist,omitempty" xml:"servicelist,omitempty"`
+	State                        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateNetworkServiceProviderParams struct {
+	Id          *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Servicelist []string `json:"servicelist,omitempty" yaml:"servicelist,omitempty"`
+	State       *string  `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateNetworkServiceProviderParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Servicelist; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("servicelist", vv)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateNetworkServiceProviderParams) Command() string {
+	return "updateNetworkServiceProvider"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateNetworkServiceProviderParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateNetworkServiceProviderParams) ResponseType() interface{} {
+	return &UpdateNetworkServiceProviderResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateNetworkServiceProviderParams) Validate() error {
+	v := &validator{api: "updateNetworkServiceProvider"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateNetworkServiceProviderParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateNetworkServiceProviderParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateNetworkServiceProviderParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateNetworkServiceProviderParams) SetServicelist(v []string) {
+	p.Servicelist = v
+	return
+}
+
+func (p *UpdateNetworkServiceProviderParams) GetServicelist() []string {
+	return p.Servicelist
+}
+
+func (p *UpdateNetworkServiceProviderParams) HasServicelist() bool {
+	return p.Servicelist != nil
+}
+
+func (p *UpdateNetworkServiceProviderParams) ResetServicelist() {
+	p.Servicelist = nil
+}
+
+func (p *UpdateNetworkServiceProviderParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *UpdateNetworkServiceProviderParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *UpdateNetworkServiceProviderParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *UpdateNetworkServiceProviderParams) ResetState() {
+	p.State = nil
+}
+
+type UpdateNetworkServiceProviderResponse struct {
+	JobID                        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Canenableindividualservice   Bool                       `json:"canenableindividualservice,omitempty" xml:"canenableindividualservice,omitempty"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid,omitempty" xml:"destinationphysicalnetworkid,omitempty"`
+	Id                           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Servicelist                  []string                   `json:"servicelist,omitempty" xml:"servicelist,omitempty"`
+	State                        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreatePhysicalNetworkParams struct {
+	Broadcastdomainrange *string  `json:"broadcastdomainrange,omitempty" yaml:"broadcastdomainrange,omitempty"`
+	Domainid             *string  `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isolationmethods     []string `json:"isolationmethods,omitempty" yaml:"isolationmethods,omitempty"`
+	Name                 *string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Networkspeed         *string  `json:"networkspeed,omitempty" yaml:"networkspeed,omitempty"`
+	Tags                 []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vlan                 *string  `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Zoneid               *string  `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreatePhysicalNetworkParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Broadcastdomainrange; v != nil {
+		u.Set("broadcastdomainrange", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Isolationmethods; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("isolationmethods", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networkspeed; v != nil {
+		u.Set("networkspeed", *v)
+	}
+	if v := p.Tags; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("tags", vv)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreatePhysicalNetworkParams) Command() string {
+	return "createPhysicalNetwork"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreatePhysicalNetworkParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreatePhysicalNetworkParams) ResponseType() interface{} {
+	return &CreatePhysicalNetworkResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreatePhysicalNetworkParams) Validate() error {
+	v := &validator{api: "createPhysicalNetwork"}
+	v.required("name", p.Name != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
+	p.Broadcastdomainrange = &v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetBroadcastdomainrange() string {
+	if p.Broadcastdomainrange == nil {
+		return ""
+	}
+	return *p.Broadcastdomainrange
+}
+
+func (p *CreatePhysicalNetworkParams) HasBroadcastdomainrange() bool {
+	return p.Broadcastdomainrange != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetBroadcastdomainrange() {
+	p.Broadcastdomainrange = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreatePhysicalNetworkParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreatePhysicalNetworkParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreatePhysicalNetworkParams) SetIsolationmethods(v []string) {
+	p.Isolationmethods = v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetIsolationmethods() []string {
+	return p.Isolationmethods
+}
+
+func (p *CreatePhysicalNetworkParams) HasIsolationmethods() bool {
+	return p.Isolationmethods != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetIsolationmethods() {
+	p.Isolationmethods = nil
+}
+
+// This param is required.
+func (p *CreatePhysicalNetworkParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreatePhysicalNetworkParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreatePhysicalNetworkParams) SetNetworkspeed(v string) {
+	p.Networkspeed = &v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetNetworkspeed() string {
+	if p.Networkspeed == nil {
+		return ""
+	}
+	return *p.Networkspeed
+}
+
+func (p *CreatePhysicalNetworkParams) HasNetworkspeed() bool {
+	return p.Networkspeed != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetNetworkspeed() {
+	p.Networkspeed = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *CreatePhysicalNetworkParams) SetTags(v []string) {
+	p.Tags = v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetTags() []string {
+	return p.Tags
+}
+
+func (p *CreatePhysicalNetworkParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetTags() {
+	p.Tags = nil
+}
+
+func (p *CreatePhysicalNetworkParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *CreatePhysicalNetworkParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *CreatePhysicalNetworkParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *CreatePhysicalNetworkParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreatePhysicalNetworkParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreatePhysicalNetworkParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type CreatePhysicalNetworkResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Broadcastdomainrange string                     `json:"broadcastdomainrange,omitempty" xml:"broadcastdomainrange,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isolationmethods     string                     `json:"isolationmethods,omitempty" xml:"isolationmethods,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkspeed         string                     `json:"networkspeed,omitempty" xml:"networkspeed,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vlan                 string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePhysicalNetworkParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeletePhysicalNetworkParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeletePhysicalNetworkParams) Command() string {
+	return "deletePhysicalNetwork"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeletePhysicalNetworkParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeletePhysicalNetworkParams) ResponseType() interface{} {
+	return &DeletePhysicalNetworkResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeletePhysicalNetworkParams) Validate() error {
+	v := &validator{api: "deletePhysicalNetwork"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeletePhysicalNetworkParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeletePhysicalNetworkParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeletePhysicalNetworkParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeletePhysicalNetworkParams) ResetId() {
+	p.Id = nil
+}
+
+type DeletePhysicalNetworkResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListPhysicalNetworksParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPhysicalNetworksParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListPhysicalNetworksParams) Command() string {
+	return "listPhysicalNetworks"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPhysicalNetworksParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPhysicalNetworksParams) ResponseType() interface{} {
+	return &ListPhysicalNetworksResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPhysicalNetworksParams) Validate() error {
+	v := &validator{api: "listPhysicalNetworks"}
+	return v.err()
+}
+
+func (p *ListPhysicalNetworksParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListPhysicalNetworksParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListPhysicalNetworksParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListPhysicalNetworksParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPhysicalNetworksParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListPhysicalNetworksParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListPhysicalNetworksParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPhysicalNetworksParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListPhysicalNetworksParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListPhysicalNetworksParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListPhysicalNetworksParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListPhysicalNetworksParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPhysicalNetworksParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListPhysicalNetworksParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListPhysicalNetworksParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPhysicalNetworksParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPhysicalNetworksParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListPhysicalNetworksParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListPhysicalNetworksParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPhysicalNetworksParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListPhysicalNetworksParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListPhysicalNetworksParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListPhysicalNetworksParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListPhysicalNetworksParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListPhysicalNetworksResponse struct {
+	Count            int                        `json:"count" xml:"count"`
+	PhysicalNetworks []*PhysicalNetwork         `json:"physicalnetwork" xml:"physicalnetwork"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PhysicalNetwork struct {
+	Broadcastdomainrange string                     `json:"broadcastdomainrange,omitempty" xml:"broadcastdomainrange,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isolationmethods     string                     `json:"isolationmethods,omitempty" xml:"isolationmethods,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkspeed         string                     `json:"networkspeed,omitempty" xml:"networkspeed,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vlan                 string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdatePhysicalNetworkParams struct {
+	Id           *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Networkspeed *string  `json:"networkspeed,omitempty" yaml:"networkspeed,omitempty"`
+	State        *string  `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags         []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vlan         *string  `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdatePhysicalNetworkParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Networkspeed; v != nil {
+		u.Set("networkspeed", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Tags; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("tags", vv)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdatePhysicalNetworkParams) Command() string {
+	return "updatePhysicalNetwork"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdatePhysicalNetworkParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdatePhysicalNetworkParams) ResponseType() interface{} {
+	return &UpdatePhysicalNetworkResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdatePhysicalNetworkParams) Validate() error {
+	v := &validator{api: "updatePhysicalNetwork"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UpdatePhysicalNetworkParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdatePhysicalNetworkParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdatePhysicalNetworkParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdatePhysicalNetworkParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdatePhysicalNetworkParams) SetNetworkspeed(v string) {
+	p.Networkspeed = &v
+	return
+}
+
+func (p *UpdatePhysicalNetworkParams) GetNetworkspeed() string {
+	if p.Networkspeed == nil {
+		return ""
+	}
+	return *p.Networkspeed
+}
+
+func (p *UpdatePhysicalNetworkParams) HasNetworkspeed() bool {
+	return p.Networkspeed != nil
+}
+
+func (p *UpdatePhysicalNetworkParams) ResetNetworkspeed() {
+	p.Networkspeed = nil
+}
+
+func (p *UpdatePhysicalNetworkParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *UpdatePhysicalNetworkParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *UpdatePhysicalNetworkParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *UpdatePhysicalNetworkParams) ResetState() {
+	p.State = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *UpdatePhysicalNetworkParams) SetTags(v []string) {
+	p.Tags = v
+	return
+}
+
+func (p *UpdatePhysicalNetworkParams) GetTags() []string {
+	return p.Tags
+}
+
+func (p *UpdatePhysicalNetworkParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *UpdatePhysicalNetworkParams) ResetTags() {
+	p.Tags = nil
+}
+
+func (p *UpdatePhysicalNetworkParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *UpdatePhysicalNetworkParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *UpdatePhysicalNetworkParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *UpdatePhysicalNetworkParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+type UpdatePhysicalNetworkResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Broadcastdomainrange string                     `json:"broadcastdomainrange,omitempty" xml:"broadcastdomainrange,omitempty"`
+	Domainid             string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isolationmethods     string                     `json:"isolationmethods,omitempty" xml:"isolationmethods,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkspeed         string                     `json:"networkspeed,omitempty" xml:"networkspeed,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                 string                     `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vlan                 string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatePublicIpRangeParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DedicatePublicIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DedicatePublicIpRangeParams) Command() string {
+	return "dedicatePublicIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *DedicatePublicIpRangeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DedicatePublicIpRangeParams) ResponseType() interface{} {
+	return &DedicatePublicIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DedicatePublicIpRangeParams) Validate() error {
+	v := &validator{api: "dedicatePublicIpRange"}
+	v.required("account", p.Account != nil)
+	v.required("domainid", p.Domainid != nil)
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DedicatePublicIpRangeParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DedicatePublicIpRangeParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DedicatePublicIpRangeParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *DedicatePublicIpRangeParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DedicatePublicIpRangeParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DedicatePublicIpRangeParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DedicatePublicIpRangeParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *DedicatePublicIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DedicatePublicIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DedicatePublicIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DedicatePublicIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *DedicatePublicIpRangeParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *DedicatePublicIpRangeParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *DedicatePublicIpRangeParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *DedicatePublicIpRangeParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type DedicatePublicIpRangeResponse struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description       string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Endip             string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Endipv6           string                     `json:"endipv6,omitempty" xml:"endipv6,omitempty"`
+	Forvirtualnetwork Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Gateway           string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr           string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway        string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Netmask           string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid         string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Podid             string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname           string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Startip           string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Startipv6         string                     `json:"startipv6,omitempty" xml:"startipv6,omitempty"`
+	Vlan              string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleasePublicIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleasePublicIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleasePublicIpRangeParams) Command() string {
+	return "releasePublicIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleasePublicIpRangeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleasePublicIpRangeParams) ResponseType() interface{} {
+	return &ReleasePublicIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleasePublicIpRangeParams) Validate() error {
+	v := &validator{api: "releasePublicIpRange"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ReleasePublicIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ReleasePublicIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ReleasePublicIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ReleasePublicIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+type ReleasePublicIpRangeResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateStorageNetworkIpRangeParams struct {
+	Endip   *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Netmask *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Podid   *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Startip *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Vlan    *int    `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateStorageNetworkIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
+	}
+	if v := p.Vlan; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("vlan", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateStorageNetworkIpRangeParams) Command() string {
+	return "createStorageNetworkIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateStorageNetworkIpRangeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateStorageNetworkIpRangeParams) ResponseType() interface{} {
+	return &CreateStorageNetworkIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateStorageNetworkIpRangeParams) Validate() error {
+	v := &validator{api: "createStorageNetworkIpRange"}
+	v.required("gateway", p.Gateway != nil)
+	v.required("netmask", p.Netmask != nil)
+	v.required("podid", p.Podid != nil)
+	v.required("startip", p.Startip != nil)
+	return v.err()
+}
+
+// Sets the 'endip' param: the last IP address of the range.
+func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
+	}
+	return *p.Endip
+}
+
+func (p *CreateStorageNetworkIpRangeParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) ResetEndip() {
+	p.Endip = nil
+}
+
+// This param is required.
+func (p *CreateStorageNetworkIpRangeParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreateStorageNetworkIpRangeParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+// Sets the 'netmask' param: the netmask. This param is required.
+func (p *CreateStorageNetworkIpRangeParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *CreateStorageNetworkIpRangeParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// Sets the 'podid' param: the ID of the pod. This param is required.
+func (p *CreateStorageNetworkIpRangeParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *CreateStorageNetworkIpRangeParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range. This param is required.
+func (p *CreateStorageNetworkIpRangeParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *CreateStorageNetworkIpRangeParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) ResetStartip() {
+	p.Startip = nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) SetVlan(v int) {
+	p.Vlan = &v
+	return
+}
+
+func (p *CreateStorageNetworkIpRangeParams) GetVlan() int {
+	if p.Vlan == nil {
+		return 0
+	}
+	return *p.Vlan
+}
+
+func (p *CreateStorageNetworkIpRangeParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *CreateStorageNetworkIpRangeParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+type CreateStorageNetworkIpRangeResponse struct {
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Endip     string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway   string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Netmask   string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Podid     string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Startip   string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Vlan      int                        `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteStorageNetworkIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteStorageNetworkIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteStorageNetworkIpRangeParams) Command() string {
+	return "deleteStorageNetworkIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteStorageNetworkIpRangeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteStorageNetworkIpRangeParams) ResponseType() interface{} {
+	return &DeleteStorageNetworkIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteStorageNetworkIpRangeParams) Validate() error {
+	v := &validator{api: "deleteStorageNetworkIpRange"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteStorageNetworkIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteStorageNetworkIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteStorageNetworkIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteStorageNetworkIpRangeResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListStorageNetworkIpRangeParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid    *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListStorageNetworkIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListStorageNetworkIpRangeParams) Command() string {
+	return "listStorageNetworkIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListStorageNetworkIpRangeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListStorageNetworkIpRangeParams) ResponseType() interface{} {
+	return &ListStorageNetworkIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListStorageNetworkIpRangeParams) Validate() error {
+	v := &validator{api: "listStorageNetworkIpRange"}
+	return v.err()
+}
+
+func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListStorageNetworkIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListStorageNetworkIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListStorageNetworkIpRangeParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListStorageNetworkIpRangeParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListStorageNetworkIpRangeParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListStorageNetworkIpRangeParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListStorageNetworkIpRangeParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListStorageNetworkIpRangeParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListStorageNetworkIpRangeParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListStorageNetworkIpRangeParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListStorageNetworkIpRangeParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListStorageNetworkIpRangeParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListStorageNetworkIpRangeParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListStorageNetworkIpRangeParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListStorageNetworkIpRangeParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListStorageNetworkIpRangeParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListStorageNetworkIpRangeParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListStorageNetworkIpRangeParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListStorageNetworkIpRangeResponse struct {
+	Count                 int                        `json:"count" xml:"count"`
+	StorageNetworkIpRange []*StorageNetworkIpRange   `json:"storagenetworkiprange" xml:"storagenetworkiprange"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StorageNetworkIpRange struct {
+	Endip     string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway   string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Netmask   string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Podid     string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Startip   string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Vlan      int                        `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateStorageNetworkIpRangeParams struct {
+	Endip   *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Netmask *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Vlan    *int    `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateStorageNetworkIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
+	}
+	if v := p.Vlan; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("vlan", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateStorageNetworkIpRangeParams) Command() string {
+	return "updateStorageNetworkIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateStorageNetworkIpRangeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateStorageNetworkIpRangeParams) ResponseType() interface{} {
+	return &UpdateStorageNetworkIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateStorageNetworkIpRangeParams) Validate() error {
+	v := &validator{api: "updateStorageNetworkIpRange"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'endip' param: the last IP address of the range.
+func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
+	}
+	return *p.Endip
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) ResetEndip() {
+	p.Endip = nil
+}
+
+// This param is required.
+func (p *UpdateStorageNetworkIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'netmask' param: the netmask.
+func (p *UpdateStorageNetworkIpRangeParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range.
+func (p *UpdateStorageNetworkIpRangeParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) ResetStartip() {
+	p.Startip = nil
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) SetVlan(v int) {
+	p.Vlan = &v
+	return
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) GetVlan() int {
+	if p.Vlan == nil {
+		return 0
+	}
+	return *p.Vlan
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *UpdateStorageNetworkIpRangeParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+type UpdateStorageNetworkIpRangeResponse struct {
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Endip     string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway   string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Netmask   string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Podid     string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Startip   string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Vlan      int                        `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid    string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListSupportedNetworkServicesParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Provider *string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	Service  *string `json:"service,omitempty" yaml:"service,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSupportedNetworkServicesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Provider; v != nil {
+		u.Set("provider", *v)
+	}
+	if v := p.Service; v != nil {
+		u.Set("service", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSupportedNetworkServicesParams) Command() string {
+	return "listSupportedNetworkServices"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSupportedNetworkServicesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSupportedNetworkServicesParams) ResponseType() interface{} {
+	return &ListSupportedNetworkServicesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSupportedNetworkServicesParams) Validate() error {
+	v := &validator{api: "listSupportedNetworkServices"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListSupportedNetworkServicesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListSupportedNetworkServicesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSupportedNetworkServicesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSupportedNetworkServicesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListSupportedNetworkServicesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListSupportedNetworkServicesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSupportedNetworkServicesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSupportedNetworkServicesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListSupportedNetworkServicesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListSupportedNetworkServicesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSupportedNetworkServicesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListSupportedNetworkServicesParams) SetProvider(v string) {
+	p.Provider = &v
+	return
+}
+
+func (p *ListSupportedNetworkServicesParams) GetProvider() string {
+	if p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+func (p *ListSupportedNetworkServicesParams) HasProvider() bool {
+	return p.Provider != nil
+}
+
+func (p *ListSupportedNetworkServicesParams) ResetProvider() {
+	p.Provider = nil
+}
+
+func (p *ListSupportedNetworkServicesParams) SetService(v string) {
+	p.Service = &v
+	return
+}
+
+func (p *ListSupportedNetworkServicesParams) GetService() string {
+	if p.Service == nil {
+		return ""
+	}
+	return *p.Service
+}
+
+func (p *ListSupportedNetworkServicesParams) HasService() bool {
+	return p.Service != nil
+}
+
+func (p *ListSupportedNetworkServicesParams) ResetService() {
+	p.Service = nil
+}
+
+type ListSupportedNetworkServicesResponse struct {
+	Count                    int                        `json:"count" xml:"count"`
+	SupportedNetworkServices []*SupportedNetworkService `json:"supportednetworkservice" xml:"supportednetworkservice"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SupportedNetworkService struct {
+	Capability []ServiceCapabilityValue   `json:"capability,omitempty" xml:"capability,omitempty"`
+	Name       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Provider   []Provider                 `json:"provider,omitempty" xml:"provider,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NetworkService that is the same for all versions
+type NetworkService interface {
+	NewDeleteNetworkParams(id string) *DeleteNetworkParams
+	DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams
+	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	StreamNetworkIsolationMethods(ctx context.Context, p *ListNetworkIsolationMethodsParams, fn func(*NetworkIsolationMethod) error) (int, error)
+	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams
+	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams
+	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams
+	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, error)
+	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	StreamNetworkServiceProviders(ctx context.Context, p *ListNetworkServiceProvidersParams, fn func(*NetworkServiceProvider) error) (int, error)
+	NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams
+	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams
+	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams
+	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	NewListPhysicalNetworksParams() *ListPhysicalNetworksParams
+	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, error)
+	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	StreamPhysicalNetworks(ctx context.Context, p *ListPhysicalNetworksParams, fn func(*PhysicalNetwork) error) (int, error)
+	NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams
+	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	NewDedicatePublicIpRangeParams(account string, domainid string, id string) *DedicatePublicIpRangeParams
+	DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams
+	ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams
+	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams
+	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams
+	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	StreamStorageNetworkIpRange(ctx context.Context, p *ListStorageNetworkIpRangeParams, fn func(*StorageNetworkIpRange) error) (int, error)
+	NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams
+	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams
+	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	StreamSupportedNetworkServices(ctx context.Context, p *ListSupportedNetworkServicesParams, fn func(*SupportedNetworkService) error) (int, error)
+}
@@ -0,0 +1,609 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDeleteNetwork(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+
+	p := &DeleteNetworkParams{}
+	p.SetForced(true)
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteNetwork", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteNetworkResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteNetwork", `{"deletenetworkresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListNetworkIsolationMethods(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+
+	p := &ListNetworkIsolationMethodsParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listNetworkIsolationMethods", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkIsolationMethods" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListNetworkIsolationMethodsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listNetworkIsolationMethods", `{"listnetworkisolationmethodsresponse":{"count":1,"networkisolationmethod":[{"name":"test-name"}]}}`, false, r)
+	if r.NetworkIsolationMethods[0].Name != "test-name" {
+		t.Errorf("Expected r.NetworkIsolationMethods[0].Name to be %v, got %v", "test-name", r.NetworkIsolationMethods[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestAddNetworkServiceProvider(t *testing.T) {
+	params := url.Values{
+		"destinationphysicalnetworkid": {"test-destinationphysicalnetworkid"},
+		"name":                         {"test-name"},
+		"physicalnetworkid":            {"test-physicalnetworkid"},
+		"servicelist":                  {"test-servicelist1, test-servicelist2"},
+	}
+
+	p := &AddNetworkServiceProviderParams{}
+	p.SetDestinationphysicalnetworkid("test-destinationphysicalnetworkid")
+	p.SetName("test-name")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetServicelist([]string{"test-servicelist1", "test-servicelist2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addNetworkServiceProvider", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addNetworkServiceProvider" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddNetworkServiceProviderResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addNetworkServiceProvider", `{"addnetworkserviceproviderresponse":{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","jobid":"test-jobid","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}}`, false, r)
+	if r.Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.Destinationphysicalnetworkid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteNetworkServiceProvider(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteNetworkServiceProviderParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteNetworkServiceProvider", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNetworkServiceProvider" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteNetworkServiceProviderResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteNetworkServiceProvider", `{"deletenetworkserviceproviderresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListNetworkServiceProviders(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"name":              {"test-name"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"state":             {"test-state"},
+	}
+
+	p := &ListNetworkServiceProvidersParams{}
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetState("test-state")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listNetworkServiceProviders", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listNetworkServiceProviders" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListNetworkServiceProvidersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listNetworkServiceProviders", `{"listnetworkserviceprovidersresponse":{"count":1,"networkserviceprovider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}}`, false, r)
+	if r.NetworkServiceProviders[0].Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.NetworkServiceProviders[0].Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.NetworkServiceProviders[0].Destinationphysicalnetworkid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateNetworkServiceProvider(t *testing.T) {
+	params := url.Values{
+		"id":          {"test-id"},
+		"servicelist": {"test-servicelist1, test-servicelist2"},
+		"state":       {"test-state"},
+	}
+
+	p := &UpdateNetworkServiceProviderParams{}
+	p.SetId("test-id")
+	p.SetServicelist([]string{"test-servicelist1", "test-servicelist2"})
+	p.SetState("test-state")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateNetworkServiceProvider", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateNetworkServiceProvider" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateNetworkServiceProviderResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateNetworkServiceProvider", `{"updatenetworkserviceproviderresponse":{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","jobid":"test-jobid","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}}`, false, r)
+	if r.Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.Destinationphysicalnetworkid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestCreatePhysicalNetwork(t *testing.T) {
+	params := url.Values{
+		"broadcastdomainrange": {"test-broadcastdomainrange"},
+		"domainid":             {"test-domainid"},
+		"isolationmethods":     {"test-isolationmethods1, test-isolationmethods2"},
+		"name":                 {"test-name"},
+		"networkspeed":         {"test-networkspeed"},
+		"tags":                 {"test-tags1, test-tags2"},
+		"vlan":                 {"test-vlan"},
+		"zoneid":               {"test-zoneid"},
+	}
+
+	p := &CreatePhysicalNetworkParams{}
+	p.SetBroadcastdomainrange("test-broadcastdomainrange")
+	p.SetDomainid("test-domainid")
+	p.SetIsolationmethods([]string{"test-isolationmethods1", "test-isolationmethods2"})
+	p.SetName("test-name")
+	p.SetNetworkspeed("test-networkspeed")
+	p.SetTags([]string{"test-tags1", "test-tags2"})
+	p.SetVlan("test-vlan")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createPhysicalNetwork", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createPhysicalNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreatePhysicalNetworkResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createPhysicalNetwork", `{"createphysicalnetworkresponse":{"broadcastdomainrange":"test-broadcastdomainrange","domainid":"test-domainid","id":"test-id","isolationmethods":"test-isolationmethods","jobid":"test-jobid","name":"test-name","networkspeed":"test-networkspeed","state":"test-state","tags":"test-tags","vlan":"test-vlan","zoneid":"test-zoneid"}}`, false, r)
+	if r.Broadcastdomainrange != "test-broadcastdomainrange" {
+		t.Errorf("Expected r.Broadcastdomainrange to be %v, got %v", "test-broadcastdomainrange", r.Broadcastdomainrange)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeletePhysicalNetwork(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeletePhysicalNetworkParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deletePhysicalNetwork", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deletePhysicalNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeletePhysicalNetworkResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deletePhysicalNetwork", `{"deletephysicalnetworkresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListPhysicalNetworks(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &ListPhysicalNetworksParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listPhysicalNetworks", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listPhysicalNetworks" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListPhysicalNetworksResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listPhysicalNetworks", `{"listphysicalnetworksresponse":{"count":1,"physicalnetwork":[{"broadcastdomainrange":"test-broadcastdomainrange","domainid":"test-domainid","id":"test-id","isolationmethods":"test-isolationmethods","name":"test-name","networkspeed":"test-networkspeed","state":"test-state","tags":"test-tags","vlan":"test-vlan","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.PhysicalNetworks[0].Broadcastdomainrange != "test-broadcastdomainrange" {
+		t.Errorf("Expected r.PhysicalNetworks[0].Broadcastdomainrange to be %v, got %v", "test-broadcastdomainrange", r.PhysicalNetworks[0].Broadcastdomainrange)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdatePhysicalNetwork(t *testing.T) {
+	params := url.Values{
+		"id":           {"test-id"},
+		"networkspeed": {"test-networkspeed"},
+		"state":        {"test-state"},
+		"tags":         {"test-tags1, test-tags2"},
+		"vlan":         {"test-vlan"},
+	}
+
+	p := &UpdatePhysicalNetworkParams{}
+	p.SetId("test-id")
+	p.SetNetworkspeed("test-networkspeed")
+	p.SetState("test-state")
+	p.SetTags([]string{"test-tags1", "test-tags2"})
+	p.SetVlan("test-vlan")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updatePhysicalNetwork", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updatePhysicalNetwork" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdatePhysicalNetworkResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updatePhysicalNetwork", `{"updatephysicalnetworkresponse":{"broadcastdomainrange":"test-broadcastdomainrange","domainid":"test-domainid","id":"test-id","isolationmethods":"test-isolationmethods","jobid":"test-jobid","name":"test-name","networkspeed":"test-networkspeed","state":"test-state","tags":"test-tags","vlan":"test-vlan","zoneid":"test-zoneid"}}`, false, r)
+	if r.Broadcastdomainrange != "test-broadcastdomainrange" {
+		t.Errorf("Expected r.Broadcastdomainrange to be %v, got %v", "test-broadcastdomainrange", r.Broadcastdomainrange)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDedicatePublicIpRange(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"id":        {"test-id"},
+		"projectid": {"test-projectid"},
+	}
+
+	p := &DedicatePublicIpRangeParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "dedicatePublicIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "dedicatePublicIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DedicatePublicIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "dedicatePublicIpRange", `{"dedicatepubliciprangeresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","endip":"test-endip","endipv6":"test-endipv6","forvirtualnetwork":true,"gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","netmask":"test-netmask","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","podid":"test-podid","podname":"test-podname","project":"test-project","projectid":"test-projectid","startip":"test-startip","startipv6":"test-startipv6","vlan":"test-vlan","zoneid":"test-zoneid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestReleasePublicIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &ReleasePublicIpRangeParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releasePublicIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releasePublicIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleasePublicIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releasePublicIpRange", `{"releasepubliciprangeresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestCreateStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"endip":   {"test-endip"},
+		"gateway": {"test-gateway"},
+		"netmask": {"test-netmask"},
+		"podid":   {"test-podid"},
+		"startip": {"test-startip"},
+		"vlan":    {"6"},
+	}
+
+	p := &CreateStorageNetworkIpRangeParams{}
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetNetmask("test-netmask")
+	p.SetPodid("test-podid")
+	p.SetStartip("test-startip")
+	p.SetVlan(6)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createStorageNetworkIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createStorageNetworkIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateStorageNetworkIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createStorageNetworkIpRange", `{"createstoragenetworkiprangeresponse":{"endip":"test-endip","gateway":"test-gateway","id":"test-id","jobid":"test-jobid","netmask":"test-netmask","networkid":"test-networkid","podid":"test-podid","startip":"test-startip","vlan":1,"zoneid":"test-zoneid"}}`, false, r)
+	if r.Endip != "test-endip" {
+		t.Errorf("Expected r.Endip to be %v, got %v", "test-endip", r.Endip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteStorageNetworkIpRangeParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteStorageNetworkIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteStorageNetworkIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteStorageNetworkIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteStorageNetworkIpRange", `{"deletestoragenetworkiprangeresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"podid":    {"test-podid"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &ListStorageNetworkIpRangeParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPodid("test-podid")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listStorageNetworkIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listStorageNetworkIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListStorageNetworkIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listStorageNetworkIpRange", `{"liststoragenetworkiprangeresponse":{"count":1,"storagenetworkiprange":[{"endip":"test-endip","gateway":"test-gateway","id":"test-id","netmask":"test-netmask","networkid":"test-networkid","podid":"test-podid","startip":"test-startip","vlan":1,"zoneid":"test-zoneid"}]}}`, false, r)
+	if r.StorageNetworkIpRange[0].Endip != "test-endip" {
+		t.Errorf("Expected r.StorageNetworkIpRange[0].Endip to be %v, got %v", "test-endip", r.StorageNetworkIpRange[0].Endip)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateStorageNetworkIpRange(t *testing.T) {
+	params := url.Values{
+		"endip":   {"test-endip"},
+		"id":      {"test-id"},
+		"netmask": {"test-netmask"},
+		"startip": {"test-startip"},
+		"vlan":    {"5"},
+	}
+
+	p := &UpdateStorageNetworkIpRangeParams{}
+	p.SetEndip("test-endip")
+	p.SetId("test-id")
+	p.SetNetmask("test-netmask")
+	p.SetStartip("test-startip")
+	p.SetVlan(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateStorageNetworkIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateStorageNetworkIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateStorageNetworkIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateStorageNetworkIpRange", `{"updatestoragenetworkiprangeresponse":{"endip":"test-endip","gateway":"test-gateway","id":"test-id","jobid":"test-jobid","netmask":"test-netmask","networkid":"test-networkid","podid":"test-podid","startip":"test-startip","vlan":1,"zoneid":"test-zoneid"}}`, false, r)
+	if r.Endip != "test-endip" {
+		t.Errorf("Expected r.Endip to be %v, got %v", "test-endip", r.Endip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListSupportedNetworkServices(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"provider": {"test-provider"},
+		"service":  {"test-service"},
+	}
+
+	p := &ListSupportedNetworkServicesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetProvider("test-provider")
+	p.SetService("test-service")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listSupportedNetworkServices", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listSupportedNetworkServices" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListSupportedNetworkServicesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listSupportedNetworkServices", `{"listsupportednetworkservicesresponse":{"count":1,"supportednetworkservice":[{"capability":[{"canchooseservicecapability":true,"name":"test-name","value":"test-value"}],"name":"test-name","provider":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}]}}`, false, r)
+	if r.SupportedNetworkServices[0].Name != "test-name" {
+		t.Errorf("Expected r.SupportedNetworkServices[0].Name to be %v, got %v", "test-name", r.SupportedNetworkServices[0].Name)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,185 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type RemoveIpFromNicParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveIpFromNicParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveIpFromNicParams) Command() string {
+	return "removeIpFromNic"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveIpFromNicParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveIpFromNicParams) ResponseType() interface{} {
+	return &RemoveIpFromNicResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveIpFromNicParams) Validate() error {
+	v := &validator{api: "removeIpFromNic"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveIpFromNicParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RemoveIpFromNicParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RemoveIpFromNicParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RemoveIpFromNicParams) ResetId() {
+	p.Id = nil
+}
+
+type RemoveIpFromNicResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddIpToNicParams struct {
+	Ipaddress *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Nicid     *string `json:"nicid,omitempty" yaml:"nicid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddIpToNicParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
+	}
+	if v := p.Nicid; v != nil {
+		u.Set("nicid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddIpToNicParams) Command() string {
+	return "addIpToNic"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddIpToNicParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddIpToNicParams) ResponseType() interface{} {
+	return &AddIpToNicResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddIpToNicParams) Validate() error {
+	v := &validator{api: "addIpToNic"}
+	v.required("nicid", p.Nicid != nil)
+	return v.err()
+}
+
+func (p *AddIpToNicParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
+	return
+}
+
+func (p *AddIpToNicParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
+	}
+	return *p.Ipaddress
+}
+
+func (p *AddIpToNicParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *AddIpToNicParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// This param is required.
+func (p *AddIpToNicParams) SetNicid(v string) {
+	p.Nicid = &v
+	return
+}
+
+func (p *AddIpToNicParams) GetNicid() string {
+	if p.Nicid == nil {
+		return ""
+	}
+	return *p.Nicid
+}
+
+func (p *AddIpToNicParams) HasNicid() bool {
+	return p.Nicid != nil
+}
+
+func (p *AddIpToNicParams) ResetNicid() {
+	p.Nicid = nil
+}
+
+type AddIpToNicResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress        string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Networkid        string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Nicid            string                     `json:"nicid,omitempty" xml:"nicid,omitempty"`
+	Virtualmachineid string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NicService that is the same for all versions
+type NicService interface {
+	NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
+	RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	NewAddIpToNicParams(nicid string) *AddIpToNicParams
+	AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error)
+}
@@ -0,0 +1,470 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddNiciraNvpDeviceParams struct {
+	Hostname             *string `json:"hostname,omitempty" yaml:"hostname,omitempty"`
+	L3gatewayserviceuuid *string `json:"l3gatewayserviceuuid,omitempty" yaml:"l3gatewayserviceuuid,omitempty"`
+	Password             *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Physicalnetworkid    *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Transportzoneuuid    *string `json:"transportzoneuuid,omitempty" yaml:"transportzoneuuid,omitempty"`
+	Username             *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddNiciraNvpDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostname; v != nil {
+		u.Set("hostname", *v)
+	}
+	if v := p.L3gatewayserviceuuid; v != nil {
+		u.Set("l3gatewayserviceuuid", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Transportzoneuuid; v != nil {
+		u.Set("transportzoneuuid", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddNiciraNvpDeviceParams) Command() string {
+	return "addNiciraNvpDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddNiciraNvpDeviceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddNiciraNvpDeviceParams) ResponseType() interface{} {
+	return &AddNiciraNvpDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddNiciraNvpDeviceParams) Validate() error {
+	v := &validator{api: "addNiciraNvpDevice"}
+	v.required("hostname", p.Hostname != nil)
+	v.required("password", p.Password != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("transportzoneuuid", p.Transportzoneuuid != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
+	p.Hostname = &v
+	return
+}
+
+func (p *AddNiciraNvpDeviceParams) GetHostname() string {
+	if p.Hostname == nil {
+		return ""
+	}
+	return *p.Hostname
+}
+
+func (p *AddNiciraNvpDeviceParams) HasHostname() bool {
+	return p.Hostname != nil
+}
+
+func (p *AddNiciraNvpDeviceParams) ResetHostname() {
+	p.Hostname = nil
+}
+
+func (p *AddNiciraNvpDeviceParams) SetL3gatewayserviceuuid(v string) {
+	p.L3gatewayserviceuuid = &v
+	return
+}
+
+func (p *AddNiciraNvpDeviceParams) GetL3gatewayserviceuuid() string {
+	if p.L3gatewayserviceuuid == nil {
+		return ""
+	}
+	return *p.L3gatewayserviceuuid
+}
+
+func (p *AddNiciraNvpDeviceParams) HasL3gatewayserviceuuid() bool {
+	return p.L3gatewayserviceuuid != nil
+}
+
+func (p *AddNiciraNvpDeviceParams) ResetL3gatewayserviceuuid() {
+	p.L3gatewayserviceuuid = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddNiciraNvpDeviceParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddNiciraNvpDeviceParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddNiciraNvpDeviceParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddNiciraNvpDeviceParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddNiciraNvpDeviceParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddNiciraNvpDeviceParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddNiciraNvpDeviceParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddNiciraNvpDeviceParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddNiciraNvpDeviceParams) SetTransportzoneuuid(v string) {
+	p.Transportzoneuuid = &v
+	return
+}
+
+func (p *AddNiciraNvpDeviceParams) GetTransportzoneuuid() string {
+	if p.Transportzoneuuid == nil {
+		return ""
+	}
+	return *p.Transportzoneuuid
+}
+
+func (p *AddNiciraNvpDeviceParams) HasTransportzoneuuid() bool {
+	return p.Transportzoneuuid != nil
+}
+
+func (p *AddNiciraNvpDeviceParams) ResetTransportzoneuuid() {
+	p.Transportzoneuuid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddNiciraNvpDeviceParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddNiciraNvpDeviceParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddNiciraNvpDeviceParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddNiciraNvpDeviceParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddNiciraNvpDeviceResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	L3gatewayserviceuuid string                     `json:"l3gatewayserviceuuid,omitempty" xml:"l3gatewayserviceuuid,omitempty"`
+	Niciradevicename     string                     `json:"niciradevicename,omitempty" xml:"niciradevicename,omitempty"`
+	Nvpdeviceid          string                     `json:"nvpdeviceid,omitempty" xml:"nvpdeviceid,omitempty"`
+	Physicalnetworkid    string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider             string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Transportzoneuuid    string                     `json:"transportzoneuuid,omitempty" xml:"transportzoneuuid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteNiciraNvpDeviceParams struct {
+	Nvpdeviceid *string `json:"nvpdeviceid,omitempty" yaml:"nvpdeviceid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteNiciraNvpDeviceParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Nvpdeviceid; v != nil {
+		u.Set("nvpdeviceid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteNiciraNvpDeviceParams) Command() string {
+	return "deleteNiciraNvpDevice"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteNiciraNvpDeviceParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteNiciraNvpDeviceParams) ResponseType() interface{} {
+	return &DeleteNiciraNvpDeviceResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteNiciraNvpDeviceParams) Validate() error {
+	v := &validator{api: "deleteNiciraNvpDevice"}
+	v.required("nvpdeviceid", p.Nvpdeviceid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
+	p.Nvpdeviceid = &v
+	return
+}
+
+func (p *DeleteNiciraNvpDeviceParams) GetNvpdeviceid() string {
+	if p.Nvpdeviceid == nil {
+		return ""
+	}
+	return *p.Nvpdeviceid
+}
+
+func (p *DeleteNiciraNvpDeviceParams) HasNvpdeviceid() bool {
+	return p.Nvpdeviceid != nil
+}
+
+func (p *DeleteNiciraNvpDeviceParams) ResetNvpdeviceid() {
+	p.Nvpdeviceid = nil
+}
+
+type DeleteNiciraNvpDeviceResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListNiciraNvpDevicesParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nvpdeviceid       *string `json:"nvpdeviceid,omitempty" yaml:"nvpdeviceid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListNiciraNvpDevicesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Nvpdeviceid; v != nil {
+		u.Set("nvpdeviceid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListNiciraNvpDevicesParams) Command() string {
+	return "listNiciraNvpDevices"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListNiciraNvpDevicesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListNiciraNvpDevicesParams) ResponseType() interface{} {
+	return &ListNiciraNvpDevicesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListNiciraNvpDevicesParams) Validate() error {
+	v := &validator{api: "listNiciraNvpDevices"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListNiciraNvpDevicesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListNiciraNvpDevicesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListNiciraNvpDevicesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListNiciraNvpDevicesParams) SetNvpdeviceid(v string) {
+	p.Nvpdeviceid = &v
+	return
+}
+
+func (p *ListNiciraNvpDevicesParams) GetNvpdeviceid() string {
+	if p.Nvpdeviceid == nil {
+		return ""
+	}
+	return *p.Nvpdeviceid
+}
+
+func (p *ListNiciraNvpDevicesParams) HasNvpdeviceid() bool {
+	return p.Nvpdeviceid != nil
+}
+
+func (p *ListNiciraNvpDevicesParams) ResetNvpdeviceid() {
+	p.Nvpdeviceid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListNiciraNvpDevicesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListNiciraNvpDevicesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListNiciraNvpDevicesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListNiciraNvpDevicesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListNiciraNvpDevicesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListNiciraNvpDevicesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListNiciraNvpDevicesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListNiciraNvpDevicesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListNiciraNvpDevicesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListNiciraNvpDevicesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListNiciraNvpDevicesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListNiciraNvpDevicesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+type ListNiciraNvpDevicesResponse struct {
+	Count            int                        `json:"count" xml:"count"`
+	NiciraNvpDevices []*NiciraNvpDevice         `json:"niciranvpdevice" xml:"niciranvpdevice"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type NiciraNvpDevice struct {
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	L3gatewayserviceuuid string                     `json:"l3gatewayserviceuuid,omitempty" xml:"l3gatewayserviceuuid,omitempty"`
+	Niciradevicename     string                     `json:"niciradevicename,omitempty" xml:"niciradevicename,omitempty"`
+	Nvpdeviceid          string                     `json:"nvpdeviceid,omitempty" xml:"nvpdeviceid,omitempty"`
+	Physicalnetworkid    string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Provider             string                     `json:"provider,omitempty" xml:"provider,omitempty"`
+	Transportzoneuuid    string                     `json:"transportzoneuuid,omitempty" xml:"transportzoneuuid,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the NiciraNVPService that is the same for all versions
+type NiciraNVPService interface {
+	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams
+	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams
+	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams
+	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	StreamNiciraNvpDevices(ctx context.Context, p *ListNiciraNvpDevicesParams, fn func(*NiciraNvpDevice) error) (int, error)
+}
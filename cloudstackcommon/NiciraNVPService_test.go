@@ -0,0 +1,127 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddNiciraNvpDevice(t *testing.T) {
+	params := url.Values{
+		"hostname":             {"test-hostname"},
+		"l3gatewayserviceuuid": {"test-l3gatewayserviceuuid"},
+		"password":             {"test-password"},
+		"physicalnetworkid":    {"test-physicalnetworkid"},
+		"transportzoneuuid":    {"test-transportzoneuuid"},
+		"username":             {"test-username"},
+	}
+
+	p := &AddNiciraNvpDeviceParams{}
+	p.SetHostname("test-hostname")
+	p.SetL3gatewayserviceuuid("test-l3gatewayserviceuuid")
+	p.SetPassword("test-password")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetTransportzoneuuid("test-transportzoneuuid")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addNiciraNvpDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addNiciraNvpDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddNiciraNvpDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addNiciraNvpDevice", `{"addniciranvpdeviceresponse":{"hostname":"test-hostname","jobid":"test-jobid","l3gatewayserviceuuid":"test-l3gatewayserviceuuid","niciradevicename":"test-niciradevicename","nvpdeviceid":"test-nvpdeviceid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","transportzoneuuid":"test-transportzoneuuid"}}`, false, r)
+	if r.Hostname != "test-hostname" {
+		t.Errorf("Expected r.Hostname to be %v, got %v", "test-hostname", r.Hostname)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteNiciraNvpDevice(t *testing.T) {
+	params := url.Values{
+		"nvpdeviceid": {"test-nvpdeviceid"},
+	}
+
+	p := &DeleteNiciraNvpDeviceParams{}
+	p.SetNvpdeviceid("test-nvpdeviceid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteNiciraNvpDevice", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteNiciraNvpDevice" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteNiciraNvpDeviceResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteNiciraNvpDevice", `{"deleteniciranvpdeviceresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListNiciraNvpDevices(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"nvpdeviceid":       {"test-nvpdeviceid"},
+		"page":              {"3"},
+		"pagesize":          {"4"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+
+	p := &ListNiciraNvpDevicesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetNvpdeviceid("test-nvpdeviceid")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listNiciraNvpDevices", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listNiciraNvpDevices" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListNiciraNvpDevicesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listNiciraNvpDevices", `{"listniciranvpdevicesresponse":{"count":1,"niciranvpdevice":[{"hostname":"test-hostname","l3gatewayserviceuuid":"test-l3gatewayserviceuuid","niciradevicename":"test-niciradevicename","nvpdeviceid":"test-nvpdeviceid","physicalnetworkid":"test-physicalnetworkid","provider":"test-provider","transportzoneuuid":"test-transportzoneuuid"}]}}`, false, r)
+	if r.NiciraNvpDevices[0].Hostname != "test-hostname" {
+		t.Errorf("Expected r.NiciraNvpDevices[0].Hostname to be %v, got %v", "test-hostname", r.NiciraNvpDevices[0].Hostname)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
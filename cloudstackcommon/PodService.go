@@ -0,0 +1,1211 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListDedicatedPodsParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid           *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDedicatedPodsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Affinitygroupid; v != nil {
+		u.Set("affinitygroupid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDedicatedPodsParams) Command() string {
+	return "listDedicatedPods"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDedicatedPodsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDedicatedPodsParams) ResponseType() interface{} {
+	return &ListDedicatedPodsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDedicatedPodsParams) Validate() error {
+	v := &validator{api: "listDedicatedPods"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListDedicatedPodsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListDedicatedPodsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListDedicatedPodsParams) SetAffinitygroupid(v string) {
+	p.Affinitygroupid = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetAffinitygroupid() string {
+	if p.Affinitygroupid == nil {
+		return ""
+	}
+	return *p.Affinitygroupid
+}
+
+func (p *ListDedicatedPodsParams) HasAffinitygroupid() bool {
+	return p.Affinitygroupid != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetAffinitygroupid() {
+	p.Affinitygroupid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListDedicatedPodsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListDedicatedPodsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDedicatedPodsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDedicatedPodsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDedicatedPodsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDedicatedPodsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDedicatedPodsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDedicatedPodsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListDedicatedPodsParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListDedicatedPodsParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListDedicatedPodsParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListDedicatedPodsParams) ResetPodid() {
+	p.Podid = nil
+}
+
+type ListDedicatedPodsResponse struct {
+	Count         int                        `json:"count" xml:"count"`
+	DedicatedPods []*DedicatedPod            `json:"dedicatedpod" xml:"dedicatedpod"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatedPod struct {
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Podid           string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname         string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleaseDedicatedPodParams struct {
+	Podid *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleaseDedicatedPodParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleaseDedicatedPodParams) Command() string {
+	return "releaseDedicatedPod"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleaseDedicatedPodParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleaseDedicatedPodParams) ResponseType() interface{} {
+	return &ReleaseDedicatedPodResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleaseDedicatedPodParams) Validate() error {
+	v := &validator{api: "releaseDedicatedPod"}
+	v.required("podid", p.Podid != nil)
+	return v.err()
+}
+
+// Sets the 'podid' param: the ID of the pod. This param is required.
+func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ReleaseDedicatedPodParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ReleaseDedicatedPodParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ReleaseDedicatedPodParams) ResetPodid() {
+	p.Podid = nil
+}
+
+type ReleaseDedicatedPodResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreatePodParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Endip           *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway         *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Netmask         *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip         *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreatePodParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreatePodParams) Command() string {
+	return "createPod"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreatePodParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreatePodParams) ResponseType() interface{} {
+	return &CreatePodResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreatePodParams) Validate() error {
+	v := &validator{api: "createPod"}
+	v.required("gateway", p.Gateway != nil)
+	v.required("name", p.Name != nil)
+	v.required("netmask", p.Netmask != nil)
+	v.required("startip", p.Startip != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *CreatePodParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *CreatePodParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *CreatePodParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *CreatePodParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+// Sets the 'endip' param: the last IP address of the range.
+func (p *CreatePodParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *CreatePodParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
+	}
+	return *p.Endip
+}
+
+func (p *CreatePodParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *CreatePodParams) ResetEndip() {
+	p.Endip = nil
+}
+
+// This param is required.
+func (p *CreatePodParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreatePodParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreatePodParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreatePodParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+// This param is required.
+func (p *CreatePodParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreatePodParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreatePodParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreatePodParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'netmask' param: the netmask. This param is required.
+func (p *CreatePodParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *CreatePodParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *CreatePodParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *CreatePodParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range. This param is required.
+func (p *CreatePodParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *CreatePodParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *CreatePodParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *CreatePodParams) ResetStartip() {
+	p.Startip = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *CreatePodParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *CreatePodParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreatePodParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreatePodParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type CreatePodResponse struct {
+	Allocationstate string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity        []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Endip           string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway         string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name            string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask         string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Startip         string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Zoneid          string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename        string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatePodParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Podid    *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DedicatePodParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DedicatePodParams) Command() string {
+	return "dedicatePod"
+}
+
+// Returns true if the API command starts an async job
+func (p *DedicatePodParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DedicatePodParams) ResponseType() interface{} {
+	return &DedicatePodResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DedicatePodParams) Validate() error {
+	v := &validator{api: "dedicatePod"}
+	v.required("domainid", p.Domainid != nil)
+	v.required("podid", p.Podid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DedicatePodParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DedicatePodParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DedicatePodParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DedicatePodParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *DedicatePodParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DedicatePodParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DedicatePodParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DedicatePodParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'podid' param: the ID of the pod. This param is required.
+func (p *DedicatePodParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *DedicatePodParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *DedicatePodParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *DedicatePodParams) ResetPodid() {
+	p.Podid = nil
+}
+
+type DedicatePodResponse struct {
+	JobID           string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Podid           string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname         string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePodParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeletePodParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeletePodParams) Command() string {
+	return "deletePod"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeletePodParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeletePodParams) ResponseType() interface{} {
+	return &DeletePodResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeletePodParams) Validate() error {
+	v := &validator{api: "deletePod"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeletePodParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeletePodParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeletePodParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeletePodParams) ResetId() {
+	p.Id = nil
+}
+
+type DeletePodResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListPodsParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showcapacities  *bool   `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPodsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Showcapacities; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("showcapacities", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListPodsParams) Command() string {
+	return "listPods"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPodsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPodsParams) ResponseType() interface{} {
+	return &ListPodsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPodsParams) Validate() error {
+	v := &validator{api: "listPods"}
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *ListPodsParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *ListPodsParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *ListPodsParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *ListPodsParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+func (p *ListPodsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListPodsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListPodsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListPodsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPodsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListPodsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListPodsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPodsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListPodsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListPodsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListPodsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListPodsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPodsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListPodsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListPodsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPodsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPodsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListPodsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListPodsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPodsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListPodsParams) SetShowcapacities(v bool) {
+	p.Showcapacities = &v
+	return
+}
+
+func (p *ListPodsParams) GetShowcapacities() bool {
+	if p.Showcapacities == nil {
+		return false
+	}
+	return *p.Showcapacities
+}
+
+func (p *ListPodsParams) HasShowcapacities() bool {
+	return p.Showcapacities != nil
+}
+
+func (p *ListPodsParams) ResetShowcapacities() {
+	p.Showcapacities = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListPodsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListPodsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListPodsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListPodsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListPodsResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Pods  []*Pod                     `json:"pod" xml:"pod"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Pod struct {
+	Allocationstate string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity        []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Endip           string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway         string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name            string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask         string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Startip         string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Zoneid          string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename        string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdatePodParams struct {
+	Allocationstate *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Endip           *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway         *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Id              *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name            *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Netmask         *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Startip         *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdatePodParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdatePodParams) Command() string {
+	return "updatePod"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdatePodParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdatePodParams) ResponseType() interface{} {
+	return &UpdatePodResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdatePodParams) Validate() error {
+	v := &validator{api: "updatePod"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *UpdatePodParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *UpdatePodParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *UpdatePodParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *UpdatePodParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+// Sets the 'endip' param: the last IP address of the range.
+func (p *UpdatePodParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *UpdatePodParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
+	}
+	return *p.Endip
+}
+
+func (p *UpdatePodParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *UpdatePodParams) ResetEndip() {
+	p.Endip = nil
+}
+
+func (p *UpdatePodParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *UpdatePodParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *UpdatePodParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *UpdatePodParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+// This param is required.
+func (p *UpdatePodParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdatePodParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdatePodParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdatePodParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdatePodParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdatePodParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdatePodParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdatePodParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'netmask' param: the netmask.
+func (p *UpdatePodParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *UpdatePodParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *UpdatePodParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *UpdatePodParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range.
+func (p *UpdatePodParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *UpdatePodParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *UpdatePodParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *UpdatePodParams) ResetStartip() {
+	p.Startip = nil
+}
+
+type UpdatePodResponse struct {
+	Allocationstate string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity        []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Endip           string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway         string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name            string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Netmask         string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Startip         string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Zoneid          string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename        string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the PodService that is the same for all versions
+type PodService interface {
+	NewListDedicatedPodsParams() *ListDedicatedPodsParams
+	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	StreamDedicatedPods(ctx context.Context, p *ListDedicatedPodsParams, fn func(*DedicatedPod) error) (int, error)
+	NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams
+	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	NewCreatePodParams(gateway string, name string, netmask string, startip string, zoneid string) *CreatePodParams
+	CreatePod(p *CreatePodParams) (*CreatePodResponse, error)
+	NewDedicatePodParams(domainid string, podid string) *DedicatePodParams
+	DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error)
+	NewDeletePodParams(id string) *DeletePodParams
+	DeletePod(p *DeletePodParams) (*DeletePodResponse, error)
+	NewListPodsParams() *ListPodsParams
+	GetPodID(name string, opts ...OptionFunc) (string, error)
+	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
+	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	StreamPods(ctx context.Context, p *ListPodsParams, fn func(*Pod) error) (int, error)
+	NewUpdatePodParams(id string) *UpdatePodParams
+	UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error)
+}
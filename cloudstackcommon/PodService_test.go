@@ -0,0 +1,270 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListDedicatedPods(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"domainid":        {"test-domainid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"5"},
+		"pagesize":        {"6"},
+		"podid":           {"test-podid"},
+	}
+
+	p := &ListDedicatedPodsParams{}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetDomainid("test-domainid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetPodid("test-podid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDedicatedPods", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedPods" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDedicatedPodsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDedicatedPods", `{"listdedicatedpodsresponse":{"count":1,"dedicatedpod":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","podid":"test-podid","podname":"test-podname"}]}}`, false, r)
+	if r.DedicatedPods[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedPods[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedPods[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestReleaseDedicatedPod(t *testing.T) {
+	params := url.Values{
+		"podid": {"test-podid"},
+	}
+
+	p := &ReleaseDedicatedPodParams{}
+	p.SetPodid("test-podid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releaseDedicatedPod", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedPod" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleaseDedicatedPodResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releaseDedicatedPod", `{"releasededicatedpodresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestCreatePod(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"endip":           {"test-endip"},
+		"gateway":         {"test-gateway"},
+		"name":            {"test-name"},
+		"netmask":         {"test-netmask"},
+		"startip":         {"test-startip"},
+		"zoneid":          {"test-zoneid"},
+	}
+
+	p := &CreatePodParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetName("test-name")
+	p.SetNetmask("test-netmask")
+	p.SetStartip("test-startip")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createPod", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createPod" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreatePodResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createPod", `{"createpodresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"endip":"test-endip","gateway":"test-gateway","id":"test-id","name":"test-name","netmask":"test-netmask","startip":"test-startip","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+}
+
+func TestDedicatePod(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"podid":    {"test-podid"},
+	}
+
+	p := &DedicatePodParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPodid("test-podid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "dedicatePod", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "dedicatePod" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DedicatePodResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "dedicatePod", `{"dedicatepodresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","podid":"test-podid","podname":"test-podname"}}`, false, r)
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeletePod(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeletePodParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deletePod", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deletePod" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeletePodResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deletePod", `{"deletepodresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListPods(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"id":              {"test-id"},
+		"keyword":         {"test-keyword"},
+		"name":            {"test-name"},
+		"page":            {"5"},
+		"pagesize":        {"6"},
+		"showcapacities":  {"true"},
+		"zoneid":          {"test-zoneid"},
+	}
+
+	p := &ListPodsParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetShowcapacities(true)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listPods", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listPods" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListPodsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listPods", `{"listpodsresponse":{"count":1,"pod":[{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"endip":"test-endip","gateway":"test-gateway","id":"test-id","name":"test-name","netmask":"test-netmask","startip":"test-startip","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.Pods[0].Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Pods[0].Allocationstate to be %v, got %v", "test-allocationstate", r.Pods[0].Allocationstate)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdatePod(t *testing.T) {
+	params := url.Values{
+		"allocationstate": {"test-allocationstate"},
+		"endip":           {"test-endip"},
+		"gateway":         {"test-gateway"},
+		"id":              {"test-id"},
+		"name":            {"test-name"},
+		"netmask":         {"test-netmask"},
+		"startip":         {"test-startip"},
+	}
+
+	p := &UpdatePodParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetId("test-id")
+	p.SetName("test-name")
+	p.SetNetmask("test-netmask")
+	p.SetStartip("test-startip")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updatePod", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updatePod" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdatePodResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updatePod", `{"updatepodresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"endip":"test-endip","gateway":"test-gateway","id":"test-id","name":"test-name","netmask":"test-netmask","startip":"test-startip","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type DeleteStoragePoolParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteStoragePoolParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteStoragePoolParams) Command() string {
+	return "deleteStoragePool"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteStoragePoolParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteStoragePoolParams) ResponseType() interface{} {
+	return &DeleteStoragePoolResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteStoragePoolParams) Validate() error {
+	v := &validator{api: "deleteStoragePool"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *DeleteStoragePoolParams) SetForced(v bool) {
+	p.Forced = &v
+	return
+}
+
+func (p *DeleteStoragePoolParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
+	}
+	return *p.Forced
+}
+
+func (p *DeleteStoragePoolParams) HasForced() bool {
+	return p.Forced != nil
+}
+
+func (p *DeleteStoragePoolParams) ResetForced() {
+	p.Forced = nil
+}
+
+// This param is required.
+func (p *DeleteStoragePoolParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteStoragePoolParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteStoragePoolParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteStoragePoolParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteStoragePoolResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the PoolService that is the same for all versions
+type PoolService interface {
+	NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams
+	DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+}
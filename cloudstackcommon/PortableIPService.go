@@ -0,0 +1,474 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type CreatePortableIpRangeParams struct {
+	Endip    *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Gateway  *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Netmask  *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Regionid *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+	Startip  *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Vlan     *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreatePortableIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Regionid; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("regionid", vv)
+	}
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreatePortableIpRangeParams) Command() string {
+	return "createPortableIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreatePortableIpRangeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreatePortableIpRangeParams) ResponseType() interface{} {
+	return &CreatePortableIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreatePortableIpRangeParams) Validate() error {
+	v := &validator{api: "createPortableIpRange"}
+	v.required("endip", p.Endip != nil)
+	v.required("gateway", p.Gateway != nil)
+	v.required("netmask", p.Netmask != nil)
+	v.required("regionid", p.Regionid != nil)
+	v.required("startip", p.Startip != nil)
+	return v.err()
+}
+
+// Sets the 'endip' param: the last IP address of the range. This param is required.
+func (p *CreatePortableIpRangeParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *CreatePortableIpRangeParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
+	}
+	return *p.Endip
+}
+
+func (p *CreatePortableIpRangeParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *CreatePortableIpRangeParams) ResetEndip() {
+	p.Endip = nil
+}
+
+// This param is required.
+func (p *CreatePortableIpRangeParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreatePortableIpRangeParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreatePortableIpRangeParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreatePortableIpRangeParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+// Sets the 'netmask' param: the netmask. This param is required.
+func (p *CreatePortableIpRangeParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *CreatePortableIpRangeParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *CreatePortableIpRangeParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *CreatePortableIpRangeParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// This param is required.
+func (p *CreatePortableIpRangeParams) SetRegionid(v int) {
+	p.Regionid = &v
+	return
+}
+
+func (p *CreatePortableIpRangeParams) GetRegionid() int {
+	if p.Regionid == nil {
+		return 0
+	}
+	return *p.Regionid
+}
+
+func (p *CreatePortableIpRangeParams) HasRegionid() bool {
+	return p.Regionid != nil
+}
+
+func (p *CreatePortableIpRangeParams) ResetRegionid() {
+	p.Regionid = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range. This param is required.
+func (p *CreatePortableIpRangeParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *CreatePortableIpRangeParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *CreatePortableIpRangeParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *CreatePortableIpRangeParams) ResetStartip() {
+	p.Startip = nil
+}
+
+func (p *CreatePortableIpRangeParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *CreatePortableIpRangeParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *CreatePortableIpRangeParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *CreatePortableIpRangeParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+type CreatePortableIpRangeResponse struct {
+	JobID             string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Endip             string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway           string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Netmask           string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Portableipaddress []PortableIpAddress        `json:"portableipaddress,omitempty" xml:"portableipaddress,omitempty"`
+	Regionid          int                        `json:"regionid,omitempty" xml:"regionid,omitempty"`
+	Startip           string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Vlan              string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePortableIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeletePortableIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeletePortableIpRangeParams) Command() string {
+	return "deletePortableIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeletePortableIpRangeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeletePortableIpRangeParams) ResponseType() interface{} {
+	return &DeletePortableIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeletePortableIpRangeParams) Validate() error {
+	v := &validator{api: "deletePortableIpRange"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeletePortableIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeletePortableIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeletePortableIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeletePortableIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+type DeletePortableIpRangeResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListPortableIpRangesParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Regionid *int    `json:"regionid,omitempty" yaml:"regionid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPortableIpRangesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Regionid; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("regionid", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListPortableIpRangesParams) Command() string {
+	return "listPortableIpRanges"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPortableIpRangesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPortableIpRangesParams) ResponseType() interface{} {
+	return &ListPortableIpRangesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPortableIpRangesParams) Validate() error {
+	v := &validator{api: "listPortableIpRanges"}
+	return v.err()
+}
+
+func (p *ListPortableIpRangesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListPortableIpRangesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListPortableIpRangesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListPortableIpRangesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPortableIpRangesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListPortableIpRangesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListPortableIpRangesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPortableIpRangesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPortableIpRangesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListPortableIpRangesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListPortableIpRangesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPortableIpRangesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPortableIpRangesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListPortableIpRangesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListPortableIpRangesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPortableIpRangesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListPortableIpRangesParams) SetRegionid(v int) {
+	p.Regionid = &v
+	return
+}
+
+func (p *ListPortableIpRangesParams) GetRegionid() int {
+	if p.Regionid == nil {
+		return 0
+	}
+	return *p.Regionid
+}
+
+func (p *ListPortableIpRangesParams) HasRegionid() bool {
+	return p.Regionid != nil
+}
+
+func (p *ListPortableIpRangesParams) ResetRegionid() {
+	p.Regionid = nil
+}
+
+type ListPortableIpRangesResponse struct {
+	Count            int                        `json:"count" xml:"count"`
+	PortableIpRanges []*PortableIpRange         `json:"portableiprange" xml:"portableiprange"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PortableIpRange struct {
+	Endip             string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Gateway           string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Netmask           string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Portableipaddress []PortableIpAddress        `json:"portableipaddress,omitempty" xml:"portableipaddress,omitempty"`
+	Regionid          int                        `json:"regionid,omitempty" xml:"regionid,omitempty"`
+	Startip           string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Vlan              string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the PortableIPService that is the same for all versions
+type PortableIPService interface {
+	NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams
+	CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams
+	DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	NewListPortableIpRangesParams() *ListPortableIpRangesParams
+	GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error)
+	ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	StreamPortableIpRanges(ctx context.Context, p *ListPortableIpRangesParams, fn func(*PortableIpRange) error) (int, error)
+}
@@ -0,0 +1,127 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreatePortableIpRange(t *testing.T) {
+	params := url.Values{
+		"endip":    {"test-endip"},
+		"gateway":  {"test-gateway"},
+		"netmask":  {"test-netmask"},
+		"regionid": {"4"},
+		"startip":  {"test-startip"},
+		"vlan":     {"test-vlan"},
+	}
+
+	p := &CreatePortableIpRangeParams{}
+	p.SetEndip("test-endip")
+	p.SetGateway("test-gateway")
+	p.SetNetmask("test-netmask")
+	p.SetRegionid(4)
+	p.SetStartip("test-startip")
+	p.SetVlan("test-vlan")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createPortableIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createPortableIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreatePortableIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createPortableIpRange", `{"createportableiprangeresponse":{"endip":"test-endip","gateway":"test-gateway","id":"test-id","jobid":"test-jobid","netmask":"test-netmask","portableipaddress":[{"accountid":"test-accountid","allocated":"test-allocated","domainid":"test-domainid","ipaddress":"test-ipaddress","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","regionid":1,"state":"test-state","vpcid":"test-vpcid","zoneid":"test-zoneid"}],"regionid":1,"startip":"test-startip","vlan":"test-vlan"}}`, false, r)
+	if r.Endip != "test-endip" {
+		t.Errorf("Expected r.Endip to be %v, got %v", "test-endip", r.Endip)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeletePortableIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeletePortableIpRangeParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deletePortableIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deletePortableIpRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeletePortableIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deletePortableIpRange", `{"deleteportableiprangeresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListPortableIpRanges(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"regionid": {"5"},
+	}
+
+	p := &ListPortableIpRangesParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetRegionid(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listPortableIpRanges", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listPortableIpRanges" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListPortableIpRangesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listPortableIpRanges", `{"listportableiprangesresponse":{"count":1,"portableiprange":[{"endip":"test-endip","gateway":"test-gateway","id":"test-id","netmask":"test-netmask","portableipaddress":[{"accountid":"test-accountid","allocated":"test-allocated","domainid":"test-domainid","ipaddress":"test-ipaddress","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","regionid":1,"state":"test-state","vpcid":"test-vpcid","zoneid":"test-zoneid"}],"regionid":1,"startip":"test-startip","vlan":"test-vlan"}]}}`, false, r)
+	if r.PortableIpRanges[0].Endip != "test-endip" {
+		t.Errorf("Expected r.PortableIpRanges[0].Endip to be %v, got %v", "test-endip", r.PortableIpRanges[0].Endip)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
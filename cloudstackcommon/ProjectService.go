@@ -0,0 +1,1579 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type ActivateProjectParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ActivateProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ActivateProjectParams) Command() string {
+	return "activateProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *ActivateProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ActivateProjectParams) ResponseType() interface{} {
+	return &ActivateProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ActivateProjectParams) Validate() error {
+	v := &validator{api: "activateProject"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ActivateProjectParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ActivateProjectParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ActivateProjectParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ActivateProjectParams) ResetId() {
+	p.Id = nil
+}
+
+type ActivateProjectResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateProjectParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateProjectParams) Command() string {
+	return "createProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateProjectParams) ResponseType() interface{} {
+	return &CreateProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateProjectParams) Validate() error {
+	v := &validator{api: "createProject"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateProjectParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateProjectParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateProjectParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateProjectParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *CreateProjectParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *CreateProjectParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *CreateProjectParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateProjectParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateProjectParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateProjectParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateProjectParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateProjectParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateProjectParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateProjectParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateProjectParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateProjectParams) ResetName() {
+	p.Name = nil
+}
+
+type CreateProjectResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteProjectParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteProjectParams) Command() string {
+	return "deleteProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteProjectParams) ResponseType() interface{} {
+	return &DeleteProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteProjectParams) Validate() error {
+	v := &validator{api: "deleteProject"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteProjectParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteProjectParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteProjectParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteProjectParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteProjectResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListProjectsParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Displaytext *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	State       *string           `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListProjectsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListProjectsParams) Command() string {
+	return "listProjects"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListProjectsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListProjectsParams) ResponseType() interface{} {
+	return &ListProjectsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListProjectsParams) Validate() error {
+	v := &validator{api: "listProjects"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListProjectsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListProjectsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListProjectsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListProjectsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'displaytext' param: the display text.
+func (p *ListProjectsParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *ListProjectsParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *ListProjectsParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *ListProjectsParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListProjectsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListProjectsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListProjectsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListProjectsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListProjectsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListProjectsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListProjectsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListProjectsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListProjectsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListProjectsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListProjectsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListProjectsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListProjectsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListProjectsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListProjectsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListProjectsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListProjectsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListProjectsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListProjectsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListProjectsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListProjectsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListProjectsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListProjectsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListProjectsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListProjectsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListProjectsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListProjectsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListProjectsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListProjectsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListProjectsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListProjectsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListProjectsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListProjectsParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListProjectsParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListProjectsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListProjectsParams) ResetState() {
+	p.State = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListProjectsParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListProjectsParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListProjectsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListProjectsParams) ResetTags() {
+	p.Tags = nil
+}
+
+type ListProjectsResponse struct {
+	Count    int                        `json:"count" xml:"count"`
+	Projects []*Project                 `json:"project" xml:"project"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Project struct {
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SuspendProjectParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *SuspendProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *SuspendProjectParams) Command() string {
+	return "suspendProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *SuspendProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *SuspendProjectParams) ResponseType() interface{} {
+	return &SuspendProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *SuspendProjectParams) Validate() error {
+	v := &validator{api: "suspendProject"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *SuspendProjectParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *SuspendProjectParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *SuspendProjectParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *SuspendProjectParams) ResetId() {
+	p.Id = nil
+}
+
+type SuspendProjectResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateProjectParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Displaytext *string `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateProjectParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateProjectParams) Command() string {
+	return "updateProject"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateProjectParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateProjectParams) ResponseType() interface{} {
+	return &UpdateProjectResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateProjectParams) Validate() error {
+	v := &validator{api: "updateProject"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpdateProjectParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpdateProjectParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *UpdateProjectParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpdateProjectParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'displaytext' param: the display text.
+func (p *UpdateProjectParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *UpdateProjectParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *UpdateProjectParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *UpdateProjectParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// This param is required.
+func (p *UpdateProjectParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateProjectParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateProjectParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateProjectParams) ResetId() {
+	p.Id = nil
+}
+
+type UpdateProjectResponse struct {
+	JobID                     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account                   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cpuavailable              string                     `json:"cpuavailable,omitempty" xml:"cpuavailable,omitempty"`
+	Cpulimit                  string                     `json:"cpulimit,omitempty" xml:"cpulimit,omitempty"`
+	Cputotal                  int64                      `json:"cputotal,omitempty" xml:"cputotal,omitempty"`
+	Displaytext               string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid                  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id                        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipavailable               string                     `json:"ipavailable,omitempty" xml:"ipavailable,omitempty"`
+	Iplimit                   string                     `json:"iplimit,omitempty" xml:"iplimit,omitempty"`
+	Iptotal                   int64                      `json:"iptotal,omitempty" xml:"iptotal,omitempty"`
+	Memoryavailable           string                     `json:"memoryavailable,omitempty" xml:"memoryavailable,omitempty"`
+	Memorylimit               string                     `json:"memorylimit,omitempty" xml:"memorylimit,omitempty"`
+	Memorytotal               int64                      `json:"memorytotal,omitempty" xml:"memorytotal,omitempty"`
+	Name                      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkavailable          string                     `json:"networkavailable,omitempty" xml:"networkavailable,omitempty"`
+	Networklimit              string                     `json:"networklimit,omitempty" xml:"networklimit,omitempty"`
+	Networktotal              int64                      `json:"networktotal,omitempty" xml:"networktotal,omitempty"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable,omitempty" xml:"primarystorageavailable,omitempty"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit,omitempty" xml:"primarystoragelimit,omitempty"`
+	Primarystoragetotal       int64                      `json:"primarystoragetotal,omitempty" xml:"primarystoragetotal,omitempty"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable,omitempty" xml:"secondarystorageavailable,omitempty"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit,omitempty" xml:"secondarystoragelimit,omitempty"`
+	Secondarystoragetotal     int64                      `json:"secondarystoragetotal,omitempty" xml:"secondarystoragetotal,omitempty"`
+	Snapshotavailable         string                     `json:"snapshotavailable,omitempty" xml:"snapshotavailable,omitempty"`
+	Snapshotlimit             string                     `json:"snapshotlimit,omitempty" xml:"snapshotlimit,omitempty"`
+	Snapshottotal             int64                      `json:"snapshottotal,omitempty" xml:"snapshottotal,omitempty"`
+	State                     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags                      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templateavailable         string                     `json:"templateavailable,omitempty" xml:"templateavailable,omitempty"`
+	Templatelimit             string                     `json:"templatelimit,omitempty" xml:"templatelimit,omitempty"`
+	Templatetotal             int64                      `json:"templatetotal,omitempty" xml:"templatetotal,omitempty"`
+	Vmavailable               string                     `json:"vmavailable,omitempty" xml:"vmavailable,omitempty"`
+	Vmlimit                   string                     `json:"vmlimit,omitempty" xml:"vmlimit,omitempty"`
+	Vmrunning                 int                        `json:"vmrunning,omitempty" xml:"vmrunning,omitempty"`
+	Vmstopped                 int                        `json:"vmstopped,omitempty" xml:"vmstopped,omitempty"`
+	Vmtotal                   int64                      `json:"vmtotal,omitempty" xml:"vmtotal,omitempty"`
+	Volumeavailable           string                     `json:"volumeavailable,omitempty" xml:"volumeavailable,omitempty"`
+	Volumelimit               string                     `json:"volumelimit,omitempty" xml:"volumelimit,omitempty"`
+	Volumetotal               int64                      `json:"volumetotal,omitempty" xml:"volumetotal,omitempty"`
+	Vpcavailable              string                     `json:"vpcavailable,omitempty" xml:"vpcavailable,omitempty"`
+	Vpclimit                  string                     `json:"vpclimit,omitempty" xml:"vpclimit,omitempty"`
+	Vpctotal                  int64                      `json:"vpctotal,omitempty" xml:"vpctotal,omitempty"`
+	Extra                     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteProjectInvitationParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteProjectInvitationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteProjectInvitationParams) Command() string {
+	return "deleteProjectInvitation"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteProjectInvitationParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteProjectInvitationParams) ResponseType() interface{} {
+	return &DeleteProjectInvitationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteProjectInvitationParams) Validate() error {
+	v := &validator{api: "deleteProjectInvitation"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteProjectInvitationParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteProjectInvitationParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteProjectInvitationParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteProjectInvitationParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteProjectInvitationResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListProjectInvitationsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Activeonly  *bool   `json:"activeonly,omitempty" yaml:"activeonly,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListProjectInvitationsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Activeonly; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("activeonly", vv)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListProjectInvitationsParams) Command() string {
+	return "listProjectInvitations"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListProjectInvitationsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListProjectInvitationsParams) ResponseType() interface{} {
+	return &ListProjectInvitationsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListProjectInvitationsParams) Validate() error {
+	v := &validator{api: "listProjectInvitations"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListProjectInvitationsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListProjectInvitationsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListProjectInvitationsParams) SetActiveonly(v bool) {
+	p.Activeonly = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetActiveonly() bool {
+	if p.Activeonly == nil {
+		return false
+	}
+	return *p.Activeonly
+}
+
+func (p *ListProjectInvitationsParams) HasActiveonly() bool {
+	return p.Activeonly != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetActiveonly() {
+	p.Activeonly = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListProjectInvitationsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListProjectInvitationsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListProjectInvitationsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListProjectInvitationsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListProjectInvitationsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListProjectInvitationsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListProjectInvitationsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListProjectInvitationsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListProjectInvitationsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListProjectInvitationsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListProjectInvitationsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListProjectInvitationsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListProjectInvitationsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListProjectInvitationsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListProjectInvitationsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListProjectInvitationsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListProjectInvitationsParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListProjectInvitationsParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListProjectInvitationsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListProjectInvitationsParams) ResetState() {
+	p.State = nil
+}
+
+type ListProjectInvitationsResponse struct {
+	Count              int                        `json:"count" xml:"count"`
+	ProjectInvitations []*ProjectInvitation       `json:"projectinvitation" xml:"projectinvitation"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ProjectInvitation struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email     string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateProjectInvitationParams struct {
+	Accept    *bool   `json:"accept,omitempty" yaml:"accept,omitempty"`
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Token     *string `json:"token,omitempty" yaml:"token,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateProjectInvitationParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accept; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("accept", vv)
+	}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Token; v != nil {
+		u.Set("token", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateProjectInvitationParams) Command() string {
+	return "updateProjectInvitation"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateProjectInvitationParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateProjectInvitationParams) ResponseType() interface{} {
+	return &UpdateProjectInvitationResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateProjectInvitationParams) Validate() error {
+	v := &validator{api: "updateProjectInvitation"}
+	v.required("projectid", p.Projectid != nil)
+	return v.err()
+}
+
+func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
+	p.Accept = &v
+	return
+}
+
+func (p *UpdateProjectInvitationParams) GetAccept() bool {
+	if p.Accept == nil {
+		return false
+	}
+	return *p.Accept
+}
+
+func (p *UpdateProjectInvitationParams) HasAccept() bool {
+	return p.Accept != nil
+}
+
+func (p *UpdateProjectInvitationParams) ResetAccept() {
+	p.Accept = nil
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpdateProjectInvitationParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpdateProjectInvitationParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *UpdateProjectInvitationParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpdateProjectInvitationParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'projectid' param: the ID of the project. This param is required.
+func (p *UpdateProjectInvitationParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *UpdateProjectInvitationParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *UpdateProjectInvitationParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *UpdateProjectInvitationParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *UpdateProjectInvitationParams) SetToken(v string) {
+	p.Token = &v
+	return
+}
+
+func (p *UpdateProjectInvitationParams) GetToken() string {
+	if p.Token == nil {
+		return ""
+	}
+	return *p.Token
+}
+
+func (p *UpdateProjectInvitationParams) HasToken() bool {
+	return p.Token != nil
+}
+
+func (p *UpdateProjectInvitationParams) ResetToken() {
+	p.Token = nil
+}
+
+type UpdateProjectInvitationResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ProjectService that is the same for all versions
+type ProjectService interface {
+	NewActivateProjectParams(id string) *ActivateProjectParams
+	ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	NewCreateProjectParams(displaytext string, name string) *CreateProjectParams
+	CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error)
+	NewDeleteProjectParams(id string) *DeleteProjectParams
+	DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	NewListProjectsParams() *ListProjectsParams
+	GetProjectID(name string, opts ...OptionFunc) (string, error)
+	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectsByTags(tags map[string]string, opts ...OptionFunc) ([]*Project, error)
+	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	StreamProjects(ctx context.Context, p *ListProjectsParams, fn func(*Project) error) (int, error)
+	NewSuspendProjectParams(id string) *SuspendProjectParams
+	SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	NewUpdateProjectParams(id string) *UpdateProjectParams
+	UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams
+	DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	NewListProjectInvitationsParams() *ListProjectInvitationsParams
+	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	StreamProjectInvitations(ctx context.Context, p *ListProjectInvitationsParams, fn func(*ProjectInvitation) error) (int, error)
+	NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams
+	UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+}
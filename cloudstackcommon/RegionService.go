@@ -0,0 +1,507 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddRegionParams struct {
+	Endpoint *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Id       *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddRegionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Endpoint; v != nil {
+		u.Set("endpoint", *v)
+	}
+	if v := p.Id; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("id", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddRegionParams) Command() string {
+	return "addRegion"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddRegionParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddRegionParams) ResponseType() interface{} {
+	return &AddRegionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddRegionParams) Validate() error {
+	v := &validator{api: "addRegion"}
+	v.required("endpoint", p.Endpoint != nil)
+	v.required("id", p.Id != nil)
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddRegionParams) SetEndpoint(v string) {
+	p.Endpoint = &v
+	return
+}
+
+func (p *AddRegionParams) GetEndpoint() string {
+	if p.Endpoint == nil {
+		return ""
+	}
+	return *p.Endpoint
+}
+
+func (p *AddRegionParams) HasEndpoint() bool {
+	return p.Endpoint != nil
+}
+
+func (p *AddRegionParams) ResetEndpoint() {
+	p.Endpoint = nil
+}
+
+// This param is required.
+func (p *AddRegionParams) SetId(v int) {
+	p.Id = &v
+	return
+}
+
+func (p *AddRegionParams) GetId() int {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *AddRegionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *AddRegionParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *AddRegionParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *AddRegionParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *AddRegionParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *AddRegionParams) ResetName() {
+	p.Name = nil
+}
+
+type AddRegionResponse struct {
+	Endpoint                 string                     `json:"endpoint,omitempty" xml:"endpoint,omitempty"`
+	Gslbserviceenabled       Bool                       `json:"gslbserviceenabled,omitempty" xml:"gslbserviceenabled,omitempty"`
+	Id                       int                        `json:"id,omitempty" xml:"id,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Portableipserviceenabled Bool                       `json:"portableipserviceenabled,omitempty" xml:"portableipserviceenabled,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListRegionsParams struct {
+	Id       *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListRegionsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("id", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListRegionsParams) Command() string {
+	return "listRegions"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListRegionsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListRegionsParams) ResponseType() interface{} {
+	return &ListRegionsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListRegionsParams) Validate() error {
+	v := &validator{api: "listRegions"}
+	return v.err()
+}
+
+func (p *ListRegionsParams) SetId(v int) {
+	p.Id = &v
+	return
+}
+
+func (p *ListRegionsParams) GetId() int {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *ListRegionsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListRegionsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListRegionsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListRegionsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListRegionsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListRegionsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListRegionsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListRegionsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListRegionsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListRegionsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListRegionsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListRegionsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListRegionsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListRegionsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListRegionsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListRegionsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListRegionsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListRegionsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListRegionsResponse struct {
+	Count   int                        `json:"count" xml:"count"`
+	Regions []*Region                  `json:"region" xml:"region"`
+	Extra   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Region struct {
+	Endpoint                 string                     `json:"endpoint,omitempty" xml:"endpoint,omitempty"`
+	Gslbserviceenabled       Bool                       `json:"gslbserviceenabled,omitempty" xml:"gslbserviceenabled,omitempty"`
+	Id                       int                        `json:"id,omitempty" xml:"id,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Portableipserviceenabled Bool                       `json:"portableipserviceenabled,omitempty" xml:"portableipserviceenabled,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RemoveRegionParams struct {
+	Id *int `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveRegionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("id", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveRegionParams) Command() string {
+	return "removeRegion"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveRegionParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveRegionParams) ResponseType() interface{} {
+	return &RemoveRegionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveRegionParams) Validate() error {
+	v := &validator{api: "removeRegion"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RemoveRegionParams) SetId(v int) {
+	p.Id = &v
+	return
+}
+
+func (p *RemoveRegionParams) GetId() int {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *RemoveRegionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RemoveRegionParams) ResetId() {
+	p.Id = nil
+}
+
+type RemoveRegionResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateRegionParams struct {
+	Endpoint *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Id       *int    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateRegionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Endpoint; v != nil {
+		u.Set("endpoint", *v)
+	}
+	if v := p.Id; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("id", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateRegionParams) Command() string {
+	return "updateRegion"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateRegionParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateRegionParams) ResponseType() interface{} {
+	return &UpdateRegionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateRegionParams) Validate() error {
+	v := &validator{api: "updateRegion"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateRegionParams) SetEndpoint(v string) {
+	p.Endpoint = &v
+	return
+}
+
+func (p *UpdateRegionParams) GetEndpoint() string {
+	if p.Endpoint == nil {
+		return ""
+	}
+	return *p.Endpoint
+}
+
+func (p *UpdateRegionParams) HasEndpoint() bool {
+	return p.Endpoint != nil
+}
+
+func (p *UpdateRegionParams) ResetEndpoint() {
+	p.Endpoint = nil
+}
+
+// This param is required.
+func (p *UpdateRegionParams) SetId(v int) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateRegionParams) GetId() int {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *UpdateRegionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateRegionParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateRegionParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateRegionParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateRegionParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateRegionParams) ResetName() {
+	p.Name = nil
+}
+
+type UpdateRegionResponse struct {
+	Endpoint                 string                     `json:"endpoint,omitempty" xml:"endpoint,omitempty"`
+	Gslbserviceenabled       Bool                       `json:"gslbserviceenabled,omitempty" xml:"gslbserviceenabled,omitempty"`
+	Id                       int                        `json:"id,omitempty" xml:"id,omitempty"`
+	Name                     string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Portableipserviceenabled Bool                       `json:"portableipserviceenabled,omitempty" xml:"portableipserviceenabled,omitempty"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the RegionService that is the same for all versions
+type RegionService interface {
+	NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams
+	AddRegion(p *AddRegionParams) (*AddRegionResponse, error)
+	NewListRegionsParams() *ListRegionsParams
+	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	StreamRegions(ctx context.Context, p *ListRegionsParams, fn func(*Region) error) (int, error)
+	NewRemoveRegionParams(id int) *RemoveRegionParams
+	RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	NewUpdateRegionParams(id int) *UpdateRegionParams
+	UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error)
+}
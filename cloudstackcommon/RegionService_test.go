@@ -0,0 +1,145 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddRegion(t *testing.T) {
+	params := url.Values{
+		"endpoint": {"test-endpoint"},
+		"id":       {"2"},
+		"name":     {"test-name"},
+	}
+
+	p := &AddRegionParams{}
+	p.SetEndpoint("test-endpoint")
+	p.SetId(2)
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addRegion", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addRegion" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddRegionResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addRegion", `{"addregionresponse":{"endpoint":"test-endpoint","gslbserviceenabled":true,"id":1,"name":"test-name","portableipserviceenabled":true}}`, false, r)
+	if r.Endpoint != "test-endpoint" {
+		t.Errorf("Expected r.Endpoint to be %v, got %v", "test-endpoint", r.Endpoint)
+	}
+}
+
+func TestListRegions(t *testing.T) {
+	params := url.Values{
+		"id":       {"1"},
+		"keyword":  {"test-keyword"},
+		"name":     {"test-name"},
+		"page":     {"4"},
+		"pagesize": {"5"},
+	}
+
+	p := &ListRegionsParams{}
+	p.SetId(1)
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listRegions", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listRegions" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListRegionsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listRegions", `{"listregionsresponse":{"count":1,"region":[{"endpoint":"test-endpoint","gslbserviceenabled":true,"id":1,"name":"test-name","portableipserviceenabled":true}]}}`, false, r)
+	if r.Regions[0].Endpoint != "test-endpoint" {
+		t.Errorf("Expected r.Regions[0].Endpoint to be %v, got %v", "test-endpoint", r.Regions[0].Endpoint)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestRemoveRegion(t *testing.T) {
+	params := url.Values{
+		"id": {"1"},
+	}
+
+	p := &RemoveRegionParams{}
+	p.SetId(1)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "removeRegion", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "removeRegion" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RemoveRegionResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "removeRegion", `{"removeregionresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestUpdateRegion(t *testing.T) {
+	params := url.Values{
+		"endpoint": {"test-endpoint"},
+		"id":       {"2"},
+		"name":     {"test-name"},
+	}
+
+	p := &UpdateRegionParams{}
+	p.SetEndpoint("test-endpoint")
+	p.SetId(2)
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateRegion", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateRegion" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateRegionResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateRegion", `{"updateregionresponse":{"endpoint":"test-endpoint","gslbserviceenabled":true,"id":1,"name":"test-name","portableipserviceenabled":true}}`, false, r)
+	if r.Endpoint != "test-endpoint" {
+		t.Errorf("Expected r.Endpoint to be %v, got %v", "test-endpoint", r.Endpoint)
+	}
+}
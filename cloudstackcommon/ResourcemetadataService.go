@@ -0,0 +1,145 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type RemoveResourceDetailParams struct {
+	Key          *string             `json:"key,omitempty" yaml:"key,omitempty"`
+	Resourceid   *string             `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *TaggedResourceType `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveResourceDetailParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Key; v != nil {
+		u.Set("key", *v)
+	}
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
+	}
+	if v := p.Resourcetype; v != nil {
+		u.Set("resourcetype", string(*v))
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveResourceDetailParams) Command() string {
+	return "removeResourceDetail"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveResourceDetailParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveResourceDetailParams) ResponseType() interface{} {
+	return &RemoveResourceDetailResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveResourceDetailParams) Validate() error {
+	v := &validator{api: "removeResourceDetail"}
+	v.required("resourceid", p.Resourceid != nil)
+	v.required("resourcetype", p.Resourcetype != nil)
+	if p.Resourcetype != nil {
+		v.enum("resourcetype", string(*p.Resourcetype), p.Resourcetype.Known(), false)
+	}
+	return v.err()
+}
+
+func (p *RemoveResourceDetailParams) SetKey(v string) {
+	p.Key = &v
+	return
+}
+
+func (p *RemoveResourceDetailParams) GetKey() string {
+	if p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+func (p *RemoveResourceDetailParams) HasKey() bool {
+	return p.Key != nil
+}
+
+func (p *RemoveResourceDetailParams) ResetKey() {
+	p.Key = nil
+}
+
+// This param is required.
+func (p *RemoveResourceDetailParams) SetResourceid(v string) {
+	p.Resourceid = &v
+	return
+}
+
+func (p *RemoveResourceDetailParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
+	}
+	return *p.Resourceid
+}
+
+func (p *RemoveResourceDetailParams) HasResourceid() bool {
+	return p.Resourceid != nil
+}
+
+func (p *RemoveResourceDetailParams) ResetResourceid() {
+	p.Resourceid = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume. This param is required.
+func (p *RemoveResourceDetailParams) SetResourcetype(v TaggedResourceType) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *RemoveResourceDetailParams) GetResourcetype() TaggedResourceType {
+	if p.Resourcetype == nil {
+		return ""
+	}
+	return *p.Resourcetype
+}
+
+func (p *RemoveResourceDetailParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *RemoveResourceDetailParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+type RemoveResourceDetailResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ResourcemetadataService that is the same for all versions
+type ResourcemetadataService interface {
+	NewRemoveResourceDetailParams(resourceid string, resourcetype TaggedResourceType) *RemoveResourceDetailParams
+	RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+}
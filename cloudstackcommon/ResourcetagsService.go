@@ -0,0 +1,677 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type CreateTagsParams struct {
+	Customer     *string             `json:"customer,omitempty" yaml:"customer,omitempty"`
+	Resourceids  []string            `json:"resourceids,omitempty" yaml:"resourceids,omitempty"`
+	Resourcetype *TaggedResourceType `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Tags         map[string]string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateTagsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Customer; v != nil {
+		u.Set("customer", *v)
+	}
+	if v := p.Resourceids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("resourceids", vv)
+	}
+	if v := p.Resourcetype; v != nil {
+		u.Set("resourcetype", string(*v))
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateTagsParams) Command() string {
+	return "createTags"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateTagsParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateTagsParams) ResponseType() interface{} {
+	return &CreateTagsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateTagsParams) Validate() error {
+	v := &validator{api: "createTags"}
+	v.required("resourceids", p.Resourceids != nil)
+	v.required("resourcetype", p.Resourcetype != nil)
+	if p.Resourcetype != nil {
+		v.enum("resourcetype", string(*p.Resourcetype), p.Resourcetype.Known(), false)
+	}
+	v.required("tags", p.Tags != nil)
+	return v.err()
+}
+
+func (p *CreateTagsParams) SetCustomer(v string) {
+	p.Customer = &v
+	return
+}
+
+func (p *CreateTagsParams) GetCustomer() string {
+	if p.Customer == nil {
+		return ""
+	}
+	return *p.Customer
+}
+
+func (p *CreateTagsParams) HasCustomer() bool {
+	return p.Customer != nil
+}
+
+func (p *CreateTagsParams) ResetCustomer() {
+	p.Customer = nil
+}
+
+// This param is required.
+func (p *CreateTagsParams) SetResourceids(v []string) {
+	p.Resourceids = v
+	return
+}
+
+func (p *CreateTagsParams) GetResourceids() []string {
+	return p.Resourceids
+}
+
+func (p *CreateTagsParams) HasResourceids() bool {
+	return p.Resourceids != nil
+}
+
+func (p *CreateTagsParams) ResetResourceids() {
+	p.Resourceids = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume. This param is required.
+func (p *CreateTagsParams) SetResourcetype(v TaggedResourceType) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *CreateTagsParams) GetResourcetype() TaggedResourceType {
+	if p.Resourcetype == nil {
+		return ""
+	}
+	return *p.Resourcetype
+}
+
+func (p *CreateTagsParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *CreateTagsParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs. This param is required.
+func (p *CreateTagsParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *CreateTagsParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *CreateTagsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *CreateTagsParams) ResetTags() {
+	p.Tags = nil
+}
+
+type CreateTagsResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteTagsParams struct {
+	Resourceids  []string            `json:"resourceids,omitempty" yaml:"resourceids,omitempty"`
+	Resourcetype *TaggedResourceType `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Tags         map[string]string   `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteTagsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Resourceids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("resourceids", vv)
+	}
+	if v := p.Resourcetype; v != nil {
+		u.Set("resourcetype", string(*v))
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteTagsParams) Command() string {
+	return "deleteTags"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteTagsParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteTagsParams) ResponseType() interface{} {
+	return &DeleteTagsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteTagsParams) Validate() error {
+	v := &validator{api: "deleteTags"}
+	v.required("resourceids", p.Resourceids != nil)
+	v.required("resourcetype", p.Resourcetype != nil)
+	if p.Resourcetype != nil {
+		v.enum("resourcetype", string(*p.Resourcetype), p.Resourcetype.Known(), false)
+	}
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteTagsParams) SetResourceids(v []string) {
+	p.Resourceids = v
+	return
+}
+
+func (p *DeleteTagsParams) GetResourceids() []string {
+	return p.Resourceids
+}
+
+func (p *DeleteTagsParams) HasResourceids() bool {
+	return p.Resourceids != nil
+}
+
+func (p *DeleteTagsParams) ResetResourceids() {
+	p.Resourceids = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume. This param is required.
+func (p *DeleteTagsParams) SetResourcetype(v TaggedResourceType) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *DeleteTagsParams) GetResourcetype() TaggedResourceType {
+	if p.Resourcetype == nil {
+		return ""
+	}
+	return *p.Resourcetype
+}
+
+func (p *DeleteTagsParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *DeleteTagsParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *DeleteTagsParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *DeleteTagsParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *DeleteTagsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *DeleteTagsParams) ResetTags() {
+	p.Tags = nil
+}
+
+type DeleteTagsResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTagsParams struct {
+	Account      *string             `json:"account,omitempty" yaml:"account,omitempty"`
+	Customer     *string             `json:"customer,omitempty" yaml:"customer,omitempty"`
+	Domainid     *string             `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isrecursive  *bool               `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Key          *string             `json:"key,omitempty" yaml:"key,omitempty"`
+	Keyword      *string             `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool               `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page         *int                `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int                `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string             `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Resourceid   *string             `json:"resourceid,omitempty" yaml:"resourceid,omitempty"`
+	Resourcetype *TaggedResourceType `json:"resourcetype,omitempty" yaml:"resourcetype,omitempty"`
+	Value        *string             `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListTagsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Customer; v != nil {
+		u.Set("customer", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Key; v != nil {
+		u.Set("key", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Resourceid; v != nil {
+		u.Set("resourceid", *v)
+	}
+	if v := p.Resourcetype; v != nil {
+		u.Set("resourcetype", string(*v))
+	}
+	if v := p.Value; v != nil {
+		u.Set("value", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListTagsParams) Command() string {
+	return "listTags"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListTagsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListTagsParams) ResponseType() interface{} {
+	return &ListTagsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListTagsParams) Validate() error {
+	v := &validator{api: "listTags"}
+	if p.Resourcetype != nil {
+		v.enum("resourcetype", string(*p.Resourcetype), p.Resourcetype.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListTagsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListTagsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListTagsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListTagsParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListTagsParams) SetCustomer(v string) {
+	p.Customer = &v
+	return
+}
+
+func (p *ListTagsParams) GetCustomer() string {
+	if p.Customer == nil {
+		return ""
+	}
+	return *p.Customer
+}
+
+func (p *ListTagsParams) HasCustomer() bool {
+	return p.Customer != nil
+}
+
+func (p *ListTagsParams) ResetCustomer() {
+	p.Customer = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListTagsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListTagsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListTagsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListTagsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListTagsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListTagsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListTagsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListTagsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+func (p *ListTagsParams) SetKey(v string) {
+	p.Key = &v
+	return
+}
+
+func (p *ListTagsParams) GetKey() string {
+	if p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+func (p *ListTagsParams) HasKey() bool {
+	return p.Key != nil
+}
+
+func (p *ListTagsParams) ResetKey() {
+	p.Key = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListTagsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListTagsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListTagsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListTagsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListTagsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListTagsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListTagsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListTagsParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListTagsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListTagsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListTagsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListTagsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListTagsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListTagsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListTagsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListTagsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListTagsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListTagsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListTagsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListTagsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListTagsParams) SetResourceid(v string) {
+	p.Resourceid = &v
+	return
+}
+
+func (p *ListTagsParams) GetResourceid() string {
+	if p.Resourceid == nil {
+		return ""
+	}
+	return *p.Resourceid
+}
+
+func (p *ListTagsParams) HasResourceid() bool {
+	return p.Resourceid != nil
+}
+
+func (p *ListTagsParams) ResetResourceid() {
+	p.Resourceid = nil
+}
+
+// Sets the 'resourcetype' param: the type of the resource, like UserVm or Volume.
+func (p *ListTagsParams) SetResourcetype(v TaggedResourceType) {
+	p.Resourcetype = &v
+	return
+}
+
+func (p *ListTagsParams) GetResourcetype() TaggedResourceType {
+	if p.Resourcetype == nil {
+		return ""
+	}
+	return *p.Resourcetype
+}
+
+func (p *ListTagsParams) HasResourcetype() bool {
+	return p.Resourcetype != nil
+}
+
+func (p *ListTagsParams) ResetResourcetype() {
+	p.Resourcetype = nil
+}
+
+func (p *ListTagsParams) SetValue(v string) {
+	p.Value = &v
+	return
+}
+
+func (p *ListTagsParams) GetValue() string {
+	if p.Value == nil {
+		return ""
+	}
+	return *p.Value
+}
+
+func (p *ListTagsParams) HasValue() bool {
+	return p.Value != nil
+}
+
+func (p *ListTagsParams) ResetValue() {
+	p.Value = nil
+}
+
+type ListTagsResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Tags  []*Tag                     `json:"tag" xml:"tag"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Tag struct {
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Customer     string                     `json:"customer,omitempty" xml:"customer,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Key          string                     `json:"key,omitempty" xml:"key,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Resourceid   string                     `json:"resourceid,omitempty" xml:"resourceid,omitempty"`
+	Resourcetype TaggedResourceType         `json:"resourcetype,omitempty" xml:"resourcetype,omitempty"`
+	Value        string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ResourcetagsService that is the same for all versions
+type ResourcetagsService interface {
+	NewCreateTagsParams(resourceids []string, resourcetype TaggedResourceType, tags map[string]string) *CreateTagsParams
+	CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error)
+	NewDeleteTagsParams(resourceids []string, resourcetype TaggedResourceType) *DeleteTagsParams
+	DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	NewListTagsParams() *ListTagsParams
+	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+	StreamTags(ctx context.Context, p *ListTagsParams, fn func(*Tag) error) (int, error)
+}
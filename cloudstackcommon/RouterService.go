@@ -0,0 +1,426 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ConfigureVirtualRouterElementParams struct {
+	Enabled *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id      *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ConfigureVirtualRouterElementParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("enabled", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ConfigureVirtualRouterElementParams) Command() string {
+	return "configureVirtualRouterElement"
+}
+
+// Returns true if the API command starts an async job
+func (p *ConfigureVirtualRouterElementParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ConfigureVirtualRouterElementParams) ResponseType() interface{} {
+	return &ConfigureVirtualRouterElementResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ConfigureVirtualRouterElementParams) Validate() error {
+	v := &validator{api: "configureVirtualRouterElement"}
+	v.required("enabled", p.Enabled != nil)
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
+	p.Enabled = &v
+	return
+}
+
+func (p *ConfigureVirtualRouterElementParams) GetEnabled() bool {
+	if p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+func (p *ConfigureVirtualRouterElementParams) HasEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ConfigureVirtualRouterElementParams) ResetEnabled() {
+	p.Enabled = nil
+}
+
+// This param is required.
+func (p *ConfigureVirtualRouterElementParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ConfigureVirtualRouterElementParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ConfigureVirtualRouterElementParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ConfigureVirtualRouterElementParams) ResetId() {
+	p.Id = nil
+}
+
+type ConfigureVirtualRouterElementResponse struct {
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Enabled   Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid     string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVirtualRouterElementParams struct {
+	Nspid        *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Providertype *string `json:"providertype,omitempty" yaml:"providertype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVirtualRouterElementParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Nspid; v != nil {
+		u.Set("nspid", *v)
+	}
+	if v := p.Providertype; v != nil {
+		u.Set("providertype", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateVirtualRouterElementParams) Command() string {
+	return "createVirtualRouterElement"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVirtualRouterElementParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVirtualRouterElementParams) ResponseType() interface{} {
+	return &CreateVirtualRouterElementResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVirtualRouterElementParams) Validate() error {
+	v := &validator{api: "createVirtualRouterElement"}
+	v.required("nspid", p.Nspid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
+	p.Nspid = &v
+	return
+}
+
+func (p *CreateVirtualRouterElementParams) GetNspid() string {
+	if p.Nspid == nil {
+		return ""
+	}
+	return *p.Nspid
+}
+
+func (p *CreateVirtualRouterElementParams) HasNspid() bool {
+	return p.Nspid != nil
+}
+
+func (p *CreateVirtualRouterElementParams) ResetNspid() {
+	p.Nspid = nil
+}
+
+func (p *CreateVirtualRouterElementParams) SetProvidertype(v string) {
+	p.Providertype = &v
+	return
+}
+
+func (p *CreateVirtualRouterElementParams) GetProvidertype() string {
+	if p.Providertype == nil {
+		return ""
+	}
+	return *p.Providertype
+}
+
+func (p *CreateVirtualRouterElementParams) HasProvidertype() bool {
+	return p.Providertype != nil
+}
+
+func (p *CreateVirtualRouterElementParams) ResetProvidertype() {
+	p.Providertype = nil
+}
+
+type CreateVirtualRouterElementResponse struct {
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Enabled   Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid     string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListVirtualRouterElementsParams struct {
+	Enabled  *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Nspid    *string `json:"nspid,omitempty" yaml:"nspid,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVirtualRouterElementsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Enabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("enabled", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Nspid; v != nil {
+		u.Set("nspid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListVirtualRouterElementsParams) Command() string {
+	return "listVirtualRouterElements"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVirtualRouterElementsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVirtualRouterElementsParams) ResponseType() interface{} {
+	return &ListVirtualRouterElementsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVirtualRouterElementsParams) Validate() error {
+	v := &validator{api: "listVirtualRouterElements"}
+	return v.err()
+}
+
+func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
+	p.Enabled = &v
+	return
+}
+
+func (p *ListVirtualRouterElementsParams) GetEnabled() bool {
+	if p.Enabled == nil {
+		return false
+	}
+	return *p.Enabled
+}
+
+func (p *ListVirtualRouterElementsParams) HasEnabled() bool {
+	return p.Enabled != nil
+}
+
+func (p *ListVirtualRouterElementsParams) ResetEnabled() {
+	p.Enabled = nil
+}
+
+func (p *ListVirtualRouterElementsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListVirtualRouterElementsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListVirtualRouterElementsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListVirtualRouterElementsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVirtualRouterElementsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListVirtualRouterElementsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListVirtualRouterElementsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVirtualRouterElementsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListVirtualRouterElementsParams) SetNspid(v string) {
+	p.Nspid = &v
+	return
+}
+
+func (p *ListVirtualRouterElementsParams) GetNspid() string {
+	if p.Nspid == nil {
+		return ""
+	}
+	return *p.Nspid
+}
+
+func (p *ListVirtualRouterElementsParams) HasNspid() bool {
+	return p.Nspid != nil
+}
+
+func (p *ListVirtualRouterElementsParams) ResetNspid() {
+	p.Nspid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVirtualRouterElementsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListVirtualRouterElementsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListVirtualRouterElementsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVirtualRouterElementsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVirtualRouterElementsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVirtualRouterElementsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListVirtualRouterElementsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVirtualRouterElementsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListVirtualRouterElementsResponse struct {
+	Count                 int                        `json:"count" xml:"count"`
+	VirtualRouterElements []*VirtualRouterElement    `json:"virtualrouterelement" xml:"virtualrouterelement"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VirtualRouterElement struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Enabled   Bool                       `json:"enabled,omitempty" xml:"enabled,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Nspid     string                     `json:"nspid,omitempty" xml:"nspid,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the RouterService that is the same for all versions
+type RouterService interface {
+	NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams
+	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*ConfigureVirtualRouterElementResponse, error)
+	NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams
+	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams
+	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	StreamVirtualRouterElements(ctx context.Context, p *ListVirtualRouterElementsParams, fn func(*VirtualRouterElement) error) (int, error)
+}
@@ -0,0 +1,407 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddS3Params struct {
+	Accesskey         *string `json:"accesskey,omitempty" yaml:"accesskey,omitempty"`
+	Bucket            *string `json:"bucket,omitempty" yaml:"bucket,omitempty"`
+	Connectiontimeout *int    `json:"connectiontimeout,omitempty" yaml:"connectiontimeout,omitempty"`
+	Endpoint          *string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Maxerrorretry     *int    `json:"maxerrorretry,omitempty" yaml:"maxerrorretry,omitempty"`
+	Secretkey         *string `json:"secretkey,omitempty" yaml:"secretkey,omitempty"`
+	Sockettimeout     *int    `json:"sockettimeout,omitempty" yaml:"sockettimeout,omitempty"`
+	Usehttps          *bool   `json:"usehttps,omitempty" yaml:"usehttps,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddS3Params) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accesskey; v != nil {
+		u.Set("accesskey", *v)
+	}
+	if v := p.Bucket; v != nil {
+		u.Set("bucket", *v)
+	}
+	if v := p.Connectiontimeout; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("connectiontimeout", vv)
+	}
+	if v := p.Endpoint; v != nil {
+		u.Set("endpoint", *v)
+	}
+	if v := p.Maxerrorretry; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("maxerrorretry", vv)
+	}
+	if v := p.Secretkey; v != nil {
+		u.Set("secretkey", *v)
+	}
+	if v := p.Sockettimeout; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("sockettimeout", vv)
+	}
+	if v := p.Usehttps; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("usehttps", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddS3Params) Command() string {
+	return "addS3"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddS3Params) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddS3Params) ResponseType() interface{} {
+	return &AddS3Response{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddS3Params) Validate() error {
+	v := &validator{api: "addS3"}
+	v.required("accesskey", p.Accesskey != nil)
+	v.required("bucket", p.Bucket != nil)
+	v.required("secretkey", p.Secretkey != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddS3Params) SetAccesskey(v string) {
+	p.Accesskey = &v
+	return
+}
+
+func (p *AddS3Params) GetAccesskey() string {
+	if p.Accesskey == nil {
+		return ""
+	}
+	return *p.Accesskey
+}
+
+func (p *AddS3Params) HasAccesskey() bool {
+	return p.Accesskey != nil
+}
+
+func (p *AddS3Params) ResetAccesskey() {
+	p.Accesskey = nil
+}
+
+// This param is required.
+func (p *AddS3Params) SetBucket(v string) {
+	p.Bucket = &v
+	return
+}
+
+func (p *AddS3Params) GetBucket() string {
+	if p.Bucket == nil {
+		return ""
+	}
+	return *p.Bucket
+}
+
+func (p *AddS3Params) HasBucket() bool {
+	return p.Bucket != nil
+}
+
+func (p *AddS3Params) ResetBucket() {
+	p.Bucket = nil
+}
+
+func (p *AddS3Params) SetConnectiontimeout(v int) {
+	p.Connectiontimeout = &v
+	return
+}
+
+func (p *AddS3Params) GetConnectiontimeout() int {
+	if p.Connectiontimeout == nil {
+		return 0
+	}
+	return *p.Connectiontimeout
+}
+
+func (p *AddS3Params) HasConnectiontimeout() bool {
+	return p.Connectiontimeout != nil
+}
+
+func (p *AddS3Params) ResetConnectiontimeout() {
+	p.Connectiontimeout = nil
+}
+
+func (p *AddS3Params) SetEndpoint(v string) {
+	p.Endpoint = &v
+	return
+}
+
+func (p *AddS3Params) GetEndpoint() string {
+	if p.Endpoint == nil {
+		return ""
+	}
+	return *p.Endpoint
+}
+
+func (p *AddS3Params) HasEndpoint() bool {
+	return p.Endpoint != nil
+}
+
+func (p *AddS3Params) ResetEndpoint() {
+	p.Endpoint = nil
+}
+
+func (p *AddS3Params) SetMaxerrorretry(v int) {
+	p.Maxerrorretry = &v
+	return
+}
+
+func (p *AddS3Params) GetMaxerrorretry() int {
+	if p.Maxerrorretry == nil {
+		return 0
+	}
+	return *p.Maxerrorretry
+}
+
+func (p *AddS3Params) HasMaxerrorretry() bool {
+	return p.Maxerrorretry != nil
+}
+
+func (p *AddS3Params) ResetMaxerrorretry() {
+	p.Maxerrorretry = nil
+}
+
+// This param is required.
+func (p *AddS3Params) SetSecretkey(v string) {
+	p.Secretkey = &v
+	return
+}
+
+func (p *AddS3Params) GetSecretkey() string {
+	if p.Secretkey == nil {
+		return ""
+	}
+	return *p.Secretkey
+}
+
+func (p *AddS3Params) HasSecretkey() bool {
+	return p.Secretkey != nil
+}
+
+func (p *AddS3Params) ResetSecretkey() {
+	p.Secretkey = nil
+}
+
+func (p *AddS3Params) SetSockettimeout(v int) {
+	p.Sockettimeout = &v
+	return
+}
+
+func (p *AddS3Params) GetSockettimeout() int {
+	if p.Sockettimeout == nil {
+		return 0
+	}
+	return *p.Sockettimeout
+}
+
+func (p *AddS3Params) HasSockettimeout() bool {
+	return p.Sockettimeout != nil
+}
+
+func (p *AddS3Params) ResetSockettimeout() {
+	p.Sockettimeout = nil
+}
+
+func (p *AddS3Params) SetUsehttps(v bool) {
+	p.Usehttps = &v
+	return
+}
+
+func (p *AddS3Params) GetUsehttps() bool {
+	if p.Usehttps == nil {
+		return false
+	}
+	return *p.Usehttps
+}
+
+func (p *AddS3Params) HasUsehttps() bool {
+	return p.Usehttps != nil
+}
+
+func (p *AddS3Params) ResetUsehttps() {
+	p.Usehttps = nil
+}
+
+type AddS3Response struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListS3sParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListS3sParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListS3sParams) Command() string {
+	return "listS3s"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListS3sParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListS3sParams) ResponseType() interface{} {
+	return &ListS3sResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListS3sParams) Validate() error {
+	v := &validator{api: "listS3s"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListS3sParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListS3sParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListS3sParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListS3sParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListS3sParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListS3sParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListS3sParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListS3sParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListS3sParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListS3sParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListS3sParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListS3sParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListS3sResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	S3s   []*S3                      `json:"s3" xml:"s3"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type S3 struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the S3Service that is the same for all versions
+type S3Service interface {
+	NewAddS3Params(accesskey string, bucket string, secretkey string) *AddS3Params
+	AddS3(p *AddS3Params) (*AddS3Response, error)
+	NewListS3sParams() *ListS3sParams
+	GetS3ID(keyword string, opts ...OptionFunc) (string, error)
+	ListS3s(p *ListS3sParams) (*ListS3sResponse, error)
+	StreamS3s(ctx context.Context, p *ListS3sParams, fn func(*S3) error) (int, error)
+}
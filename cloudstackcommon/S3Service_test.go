@@ -0,0 +1,95 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddS3(t *testing.T) {
+	params := url.Values{
+		"accesskey":         {"test-accesskey"},
+		"bucket":            {"test-bucket"},
+		"connectiontimeout": {"3"},
+		"endpoint":          {"test-endpoint"},
+		"maxerrorretry":     {"5"},
+		"secretkey":         {"test-secretkey"},
+		"sockettimeout":     {"7"},
+		"usehttps":          {"true"},
+	}
+
+	p := &AddS3Params{}
+	p.SetAccesskey("test-accesskey")
+	p.SetBucket("test-bucket")
+	p.SetConnectiontimeout(3)
+	p.SetEndpoint("test-endpoint")
+	p.SetMaxerrorretry(5)
+	p.SetSecretkey("test-secretkey")
+	p.SetSockettimeout(7)
+	p.SetUsehttps(true)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addS3", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addS3" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddS3Response)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addS3", `{"adds3response":{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestListS3s(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+	}
+
+	p := &ListS3sParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listS3s", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listS3s" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListS3sResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listS3s", `{"lists3sresponse":{"count":1,"s3":[{"details":["test-details"],"id":"test-id","name":"test-name","protocol":"test-protocol","providername":"test-providername","scope":"test-scope","url":"test-url","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.S3s[0].Id != "test-id" {
+		t.Errorf("Expected r.S3s[0].Id to be %v, got %v", "test-id", r.S3s[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
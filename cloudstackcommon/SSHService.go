@@ -0,0 +1,771 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type CreateSSHKeyPairParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateSSHKeyPairParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateSSHKeyPairParams) Command() string {
+	return "createSSHKeyPair"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateSSHKeyPairParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateSSHKeyPairParams) ResponseType() interface{} {
+	return &CreateSSHKeyPairResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateSSHKeyPairParams) Validate() error {
+	v := &validator{api: "createSSHKeyPair"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateSSHKeyPairParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateSSHKeyPairParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateSSHKeyPairParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateSSHKeyPairParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateSSHKeyPairParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateSSHKeyPairParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateSSHKeyPairParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateSSHKeyPairParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateSSHKeyPairParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateSSHKeyPairParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateSSHKeyPairParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateSSHKeyPairParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateSSHKeyPairParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *CreateSSHKeyPairParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *CreateSSHKeyPairParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateSSHKeyPairParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type CreateSSHKeyPairResponse struct {
+	Privatekey string                     `json:"privatekey,omitempty" xml:"privatekey,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteSSHKeyPairParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSSHKeyPairParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteSSHKeyPairParams) Command() string {
+	return "deleteSSHKeyPair"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSSHKeyPairParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSSHKeyPairParams) ResponseType() interface{} {
+	return &DeleteSSHKeyPairResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSSHKeyPairParams) Validate() error {
+	v := &validator{api: "deleteSSHKeyPair"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DeleteSSHKeyPairParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DeleteSSHKeyPairParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DeleteSSHKeyPairParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *DeleteSSHKeyPairParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DeleteSSHKeyPairParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DeleteSSHKeyPairParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DeleteSSHKeyPairParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *DeleteSSHKeyPairParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *DeleteSSHKeyPairParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *DeleteSSHKeyPairParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *DeleteSSHKeyPairParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *DeleteSSHKeyPairParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *DeleteSSHKeyPairParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *DeleteSSHKeyPairParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *DeleteSSHKeyPairParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type DeleteSSHKeyPairResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListSSHKeyPairsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSSHKeyPairsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Fingerprint; v != nil {
+		u.Set("fingerprint", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSSHKeyPairsParams) Command() string {
+	return "listSSHKeyPairs"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSSHKeyPairsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSSHKeyPairsParams) ResponseType() interface{} {
+	return &ListSSHKeyPairsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSSHKeyPairsParams) Validate() error {
+	v := &validator{api: "listSSHKeyPairs"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListSSHKeyPairsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListSSHKeyPairsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListSSHKeyPairsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListSSHKeyPairsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListSSHKeyPairsParams) SetFingerprint(v string) {
+	p.Fingerprint = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetFingerprint() string {
+	if p.Fingerprint == nil {
+		return ""
+	}
+	return *p.Fingerprint
+}
+
+func (p *ListSSHKeyPairsParams) HasFingerprint() bool {
+	return p.Fingerprint != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetFingerprint() {
+	p.Fingerprint = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListSSHKeyPairsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListSSHKeyPairsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSSHKeyPairsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListSSHKeyPairsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListSSHKeyPairsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListSSHKeyPairsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListSSHKeyPairsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListSSHKeyPairsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSSHKeyPairsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListSSHKeyPairsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSSHKeyPairsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListSSHKeyPairsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListSSHKeyPairsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListSSHKeyPairsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListSSHKeyPairsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListSSHKeyPairsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type ListSSHKeyPairsResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	SSHKeyPairs []*SSHKeyPair              `json:"sshkeypair" xml:"sshkeypair"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SSHKeyPair struct {
+	Fingerprint string                     `json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RegisterSSHKeyPairParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Publickey *string `json:"publickey,omitempty" yaml:"publickey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RegisterSSHKeyPairParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Publickey; v != nil {
+		u.Set("publickey", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RegisterSSHKeyPairParams) Command() string {
+	return "registerSSHKeyPair"
+}
+
+// Returns true if the API command starts an async job
+func (p *RegisterSSHKeyPairParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RegisterSSHKeyPairParams) ResponseType() interface{} {
+	return &RegisterSSHKeyPairResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RegisterSSHKeyPairParams) Validate() error {
+	v := &validator{api: "registerSSHKeyPair"}
+	v.required("name", p.Name != nil)
+	v.required("publickey", p.Publickey != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *RegisterSSHKeyPairParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *RegisterSSHKeyPairParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *RegisterSSHKeyPairParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *RegisterSSHKeyPairParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *RegisterSSHKeyPairParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *RegisterSSHKeyPairParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *RegisterSSHKeyPairParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *RegisterSSHKeyPairParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *RegisterSSHKeyPairParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *RegisterSSHKeyPairParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *RegisterSSHKeyPairParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *RegisterSSHKeyPairParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *RegisterSSHKeyPairParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *RegisterSSHKeyPairParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *RegisterSSHKeyPairParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// This param is required.
+func (p *RegisterSSHKeyPairParams) SetPublickey(v string) {
+	p.Publickey = &v
+	return
+}
+
+func (p *RegisterSSHKeyPairParams) GetPublickey() string {
+	if p.Publickey == nil {
+		return ""
+	}
+	return *p.Publickey
+}
+
+func (p *RegisterSSHKeyPairParams) HasPublickey() bool {
+	return p.Publickey != nil
+}
+
+func (p *RegisterSSHKeyPairParams) ResetPublickey() {
+	p.Publickey = nil
+}
+
+type RegisterSSHKeyPairResponse struct {
+	Fingerprint string                     `json:"fingerprint,omitempty" xml:"fingerprint,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the SSHService that is the same for all versions
+type SSHService interface {
+	NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams
+	CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams
+	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	NewListSSHKeyPairsParams() *ListSSHKeyPairsParams
+	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	StreamSSHKeyPairs(ctx context.Context, p *ListSSHKeyPairsParams, fn func(*SSHKeyPair) error) (int, error)
+	NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams
+	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+}
@@ -0,0 +1,167 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateSSHKeyPair(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+	}
+
+	p := &CreateSSHKeyPairParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createSSHKeyPair", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createSSHKeyPair" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateSSHKeyPairResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createSSHKeyPair", `{"createsshkeypairresponse":{"createsshkeypair":{"privatekey":"test-privatekey"}}}`, true, r)
+	if r.Privatekey != "test-privatekey" {
+		t.Errorf("Expected r.Privatekey to be %v, got %v", "test-privatekey", r.Privatekey)
+	}
+}
+
+func TestDeleteSSHKeyPair(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+	}
+
+	p := &DeleteSSHKeyPairParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteSSHKeyPair", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSSHKeyPair" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteSSHKeyPairResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteSSHKeyPair", `{"deletesshkeypairresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListSSHKeyPairs(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"fingerprint": {"test-fingerprint"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"name":        {"test-name"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+	}
+
+	p := &ListSSHKeyPairsParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetFingerprint("test-fingerprint")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listSSHKeyPairs", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listSSHKeyPairs" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListSSHKeyPairsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listSSHKeyPairs", `{"listsshkeypairsresponse":{"count":1,"sshkeypair":[{"fingerprint":"test-fingerprint","name":"test-name"}]}}`, false, r)
+	if r.SSHKeyPairs[0].Fingerprint != "test-fingerprint" {
+		t.Errorf("Expected r.SSHKeyPairs[0].Fingerprint to be %v, got %v", "test-fingerprint", r.SSHKeyPairs[0].Fingerprint)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestRegisterSSHKeyPair(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"name":      {"test-name"},
+		"projectid": {"test-projectid"},
+		"publickey": {"test-publickey"},
+	}
+
+	p := &RegisterSSHKeyPairParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetName("test-name")
+	p.SetProjectid("test-projectid")
+	p.SetPublickey("test-publickey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "registerSSHKeyPair", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "registerSSHKeyPair" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RegisterSSHKeyPairResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "registerSSHKeyPair", `{"registersshkeypairresponse":{"registersshkeypair":{"fingerprint":"test-fingerprint","name":"test-name"}}}`, true, r)
+	if r.Fingerprint != "test-fingerprint" {
+		t.Errorf("Expected r.Fingerprint to be %v, got %v", "test-fingerprint", r.Fingerprint)
+	}
+}
@@ -0,0 +1,320 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type DeleteSecurityGroupParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSecurityGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteSecurityGroupParams) Command() string {
+	return "deleteSecurityGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSecurityGroupParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSecurityGroupParams) ResponseType() interface{} {
+	return &DeleteSecurityGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSecurityGroupParams) Validate() error {
+	v := &validator{api: "deleteSecurityGroup"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DeleteSecurityGroupParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DeleteSecurityGroupParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DeleteSecurityGroupParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DeleteSecurityGroupParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *DeleteSecurityGroupParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DeleteSecurityGroupParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DeleteSecurityGroupParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DeleteSecurityGroupParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *DeleteSecurityGroupParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteSecurityGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteSecurityGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteSecurityGroupParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *DeleteSecurityGroupParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *DeleteSecurityGroupParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *DeleteSecurityGroupParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *DeleteSecurityGroupParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *DeleteSecurityGroupParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *DeleteSecurityGroupParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *DeleteSecurityGroupParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *DeleteSecurityGroupParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type DeleteSecurityGroupResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RevokeSecurityGroupEgressParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RevokeSecurityGroupEgressParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RevokeSecurityGroupEgressParams) Command() string {
+	return "revokeSecurityGroupEgress"
+}
+
+// Returns true if the API command starts an async job
+func (p *RevokeSecurityGroupEgressParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RevokeSecurityGroupEgressParams) ResponseType() interface{} {
+	return &RevokeSecurityGroupEgressResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RevokeSecurityGroupEgressParams) Validate() error {
+	v := &validator{api: "revokeSecurityGroupEgress"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RevokeSecurityGroupEgressParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RevokeSecurityGroupEgressParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RevokeSecurityGroupEgressParams) ResetId() {
+	p.Id = nil
+}
+
+type RevokeSecurityGroupEgressResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RevokeSecurityGroupIngressParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RevokeSecurityGroupIngressParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RevokeSecurityGroupIngressParams) Command() string {
+	return "revokeSecurityGroupIngress"
+}
+
+// Returns true if the API command starts an async job
+func (p *RevokeSecurityGroupIngressParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RevokeSecurityGroupIngressParams) ResponseType() interface{} {
+	return &RevokeSecurityGroupIngressResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RevokeSecurityGroupIngressParams) Validate() error {
+	v := &validator{api: "revokeSecurityGroupIngress"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RevokeSecurityGroupIngressParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RevokeSecurityGroupIngressParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RevokeSecurityGroupIngressParams) ResetId() {
+	p.Id = nil
+}
+
+type RevokeSecurityGroupIngressResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the SecurityGroupService that is the same for all versions
+type SecurityGroupService interface {
+	NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams
+	DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
+	NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams
+	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams
+	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+}
@@ -0,0 +1,1524 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type CreateSnapshotParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Policyid  *string `json:"policyid,omitempty" yaml:"policyid,omitempty"`
+	Quiescevm *bool   `json:"quiescevm,omitempty" yaml:"quiescevm,omitempty"`
+	Volumeid  *string `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Policyid; v != nil {
+		u.Set("policyid", *v)
+	}
+	if v := p.Quiescevm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("quiescevm", vv)
+	}
+	if v := p.Volumeid; v != nil {
+		u.Set("volumeid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateSnapshotParams) Command() string {
+	return "createSnapshot"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateSnapshotParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateSnapshotParams) ResponseType() interface{} {
+	return &CreateSnapshotResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateSnapshotParams) Validate() error {
+	v := &validator{api: "createSnapshot"}
+	v.required("volumeid", p.Volumeid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateSnapshotParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateSnapshotParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateSnapshotParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateSnapshotParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateSnapshotParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateSnapshotParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateSnapshotParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateSnapshotParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreateSnapshotParams) SetPolicyid(v string) {
+	p.Policyid = &v
+	return
+}
+
+func (p *CreateSnapshotParams) GetPolicyid() string {
+	if p.Policyid == nil {
+		return ""
+	}
+	return *p.Policyid
+}
+
+func (p *CreateSnapshotParams) HasPolicyid() bool {
+	return p.Policyid != nil
+}
+
+func (p *CreateSnapshotParams) ResetPolicyid() {
+	p.Policyid = nil
+}
+
+func (p *CreateSnapshotParams) SetQuiescevm(v bool) {
+	p.Quiescevm = &v
+	return
+}
+
+func (p *CreateSnapshotParams) GetQuiescevm() bool {
+	if p.Quiescevm == nil {
+		return false
+	}
+	return *p.Quiescevm
+}
+
+func (p *CreateSnapshotParams) HasQuiescevm() bool {
+	return p.Quiescevm != nil
+}
+
+func (p *CreateSnapshotParams) ResetQuiescevm() {
+	p.Quiescevm = nil
+}
+
+// Sets the 'volumeid' param: the ID of the volume. This param is required.
+func (p *CreateSnapshotParams) SetVolumeid(v string) {
+	p.Volumeid = &v
+	return
+}
+
+func (p *CreateSnapshotParams) GetVolumeid() string {
+	if p.Volumeid == nil {
+		return ""
+	}
+	return *p.Volumeid
+}
+
+func (p *CreateSnapshotParams) HasVolumeid() bool {
+	return p.Volumeid != nil
+}
+
+func (p *CreateSnapshotParams) ResetVolumeid() {
+	p.Volumeid = nil
+}
+
+type CreateSnapshotResponse struct {
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created      Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Intervaltype SnapshotInterval           `json:"intervaltype,omitempty" xml:"intervaltype,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Revertable   Bool                       `json:"revertable,omitempty" xml:"revertable,omitempty"`
+	Snapshottype string                     `json:"snapshottype,omitempty" xml:"snapshottype,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags         []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Volumeid     string                     `json:"volumeid,omitempty" xml:"volumeid,omitempty"`
+	Volumename   string                     `json:"volumename,omitempty" xml:"volumename,omitempty"`
+	Volumetype   string                     `json:"volumetype,omitempty" xml:"volumetype,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteSnapshotParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteSnapshotParams) Command() string {
+	return "deleteSnapshot"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSnapshotParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSnapshotParams) ResponseType() interface{} {
+	return &DeleteSnapshotResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSnapshotParams) Validate() error {
+	v := &validator{api: "deleteSnapshot"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteSnapshotParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteSnapshotParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteSnapshotParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteSnapshotParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteSnapshotResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListSnapshotsParams struct {
+	Account      *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid     *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id           *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Intervaltype *SnapshotInterval `json:"intervaltype,omitempty" yaml:"intervaltype,omitempty"`
+	Isrecursive  *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword      *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall      *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name         *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page         *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid    *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Snapshottype *string           `json:"snapshottype,omitempty" yaml:"snapshottype,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Volumeid     *string           `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+	Zoneid       *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSnapshotsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Intervaltype; v != nil {
+		u.Set("intervaltype", string(*v))
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Snapshottype; v != nil {
+		u.Set("snapshottype", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Volumeid; v != nil {
+		u.Set("volumeid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSnapshotsParams) Command() string {
+	return "listSnapshots"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSnapshotsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSnapshotsParams) ResponseType() interface{} {
+	return &ListSnapshotsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSnapshotsParams) Validate() error {
+	v := &validator{api: "listSnapshots"}
+	if p.Intervaltype != nil {
+		v.enum("intervaltype", string(*p.Intervaltype), p.Intervaltype.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListSnapshotsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListSnapshotsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListSnapshotsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListSnapshotsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListSnapshotsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListSnapshotsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListSnapshotsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListSnapshotsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListSnapshotsParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListSnapshotsParams) SetIntervaltype(v SnapshotInterval) {
+	p.Intervaltype = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetIntervaltype() SnapshotInterval {
+	if p.Intervaltype == nil {
+		return ""
+	}
+	return *p.Intervaltype
+}
+
+func (p *ListSnapshotsParams) HasIntervaltype() bool {
+	return p.Intervaltype != nil
+}
+
+func (p *ListSnapshotsParams) ResetIntervaltype() {
+	p.Intervaltype = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListSnapshotsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListSnapshotsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListSnapshotsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSnapshotsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListSnapshotsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSnapshotsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListSnapshotsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListSnapshotsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListSnapshotsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListSnapshotsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListSnapshotsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListSnapshotsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSnapshotsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListSnapshotsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSnapshotsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSnapshotsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListSnapshotsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSnapshotsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListSnapshotsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListSnapshotsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListSnapshotsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListSnapshotsParams) SetSnapshottype(v string) {
+	p.Snapshottype = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetSnapshottype() string {
+	if p.Snapshottype == nil {
+		return ""
+	}
+	return *p.Snapshottype
+}
+
+func (p *ListSnapshotsParams) HasSnapshottype() bool {
+	return p.Snapshottype != nil
+}
+
+func (p *ListSnapshotsParams) ResetSnapshottype() {
+	p.Snapshottype = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListSnapshotsParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListSnapshotsParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListSnapshotsParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListSnapshotsParams) ResetTags() {
+	p.Tags = nil
+}
+
+// Sets the 'volumeid' param: the ID of the volume.
+func (p *ListSnapshotsParams) SetVolumeid(v string) {
+	p.Volumeid = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetVolumeid() string {
+	if p.Volumeid == nil {
+		return ""
+	}
+	return *p.Volumeid
+}
+
+func (p *ListSnapshotsParams) HasVolumeid() bool {
+	return p.Volumeid != nil
+}
+
+func (p *ListSnapshotsParams) ResetVolumeid() {
+	p.Volumeid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListSnapshotsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListSnapshotsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListSnapshotsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListSnapshotsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListSnapshotsResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	Snapshots []*Snapshot                `json:"snapshot" xml:"snapshot"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Snapshot struct {
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created      Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Intervaltype SnapshotInterval           `json:"intervaltype,omitempty" xml:"intervaltype,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Revertable   Bool                       `json:"revertable,omitempty" xml:"revertable,omitempty"`
+	Snapshottype string                     `json:"snapshottype,omitempty" xml:"snapshottype,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags         []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Volumeid     string                     `json:"volumeid,omitempty" xml:"volumeid,omitempty"`
+	Volumename   string                     `json:"volumename,omitempty" xml:"volumename,omitempty"`
+	Volumetype   string                     `json:"volumetype,omitempty" xml:"volumetype,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RevertSnapshotParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RevertSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RevertSnapshotParams) Command() string {
+	return "revertSnapshot"
+}
+
+// Returns true if the API command starts an async job
+func (p *RevertSnapshotParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RevertSnapshotParams) ResponseType() interface{} {
+	return &RevertSnapshotResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RevertSnapshotParams) Validate() error {
+	v := &validator{api: "revertSnapshot"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RevertSnapshotParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RevertSnapshotParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RevertSnapshotParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RevertSnapshotParams) ResetId() {
+	p.Id = nil
+}
+
+type RevertSnapshotResponse struct {
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account      string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created      Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain       string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid     string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Intervaltype SnapshotInterval           `json:"intervaltype,omitempty" xml:"intervaltype,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project      string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid    string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Revertable   Bool                       `json:"revertable,omitempty" xml:"revertable,omitempty"`
+	Snapshottype string                     `json:"snapshottype,omitempty" xml:"snapshottype,omitempty"`
+	State        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags         []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Volumeid     string                     `json:"volumeid,omitempty" xml:"volumeid,omitempty"`
+	Volumename   string                     `json:"volumename,omitempty" xml:"volumename,omitempty"`
+	Volumetype   string                     `json:"volumetype,omitempty" xml:"volumetype,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteSnapshotPoliciesParams struct {
+	Id  *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Ids []string `json:"ids,omitempty" yaml:"ids,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteSnapshotPoliciesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Ids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("ids", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteSnapshotPoliciesParams) Command() string {
+	return "deleteSnapshotPolicies"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteSnapshotPoliciesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteSnapshotPoliciesParams) ResponseType() interface{} {
+	return &DeleteSnapshotPoliciesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteSnapshotPoliciesParams) Validate() error {
+	v := &validator{api: "deleteSnapshotPolicies"}
+	return v.err()
+}
+
+func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteSnapshotPoliciesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteSnapshotPoliciesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteSnapshotPoliciesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'ids' param: the IDs of the resources.
+func (p *DeleteSnapshotPoliciesParams) SetIds(v []string) {
+	p.Ids = v
+	return
+}
+
+func (p *DeleteSnapshotPoliciesParams) GetIds() []string {
+	return p.Ids
+}
+
+func (p *DeleteSnapshotPoliciesParams) HasIds() bool {
+	return p.Ids != nil
+}
+
+func (p *DeleteSnapshotPoliciesParams) ResetIds() {
+	p.Ids = nil
+}
+
+type DeleteSnapshotPoliciesResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVMSnapshotParams struct {
+	Description      *string `json:"description,omitempty" yaml:"description,omitempty"`
+	Name             *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Quiescevm        *bool   `json:"quiescevm,omitempty" yaml:"quiescevm,omitempty"`
+	Snapshotmemory   *bool   `json:"snapshotmemory,omitempty" yaml:"snapshotmemory,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVMSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Description; v != nil {
+		u.Set("description", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Quiescevm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("quiescevm", vv)
+	}
+	if v := p.Snapshotmemory; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("snapshotmemory", vv)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateVMSnapshotParams) Command() string {
+	return "createVMSnapshot"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVMSnapshotParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVMSnapshotParams) ResponseType() interface{} {
+	return &CreateVMSnapshotResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVMSnapshotParams) Validate() error {
+	v := &validator{api: "createVMSnapshot"}
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+func (p *CreateVMSnapshotParams) SetDescription(v string) {
+	p.Description = &v
+	return
+}
+
+func (p *CreateVMSnapshotParams) GetDescription() string {
+	if p.Description == nil {
+		return ""
+	}
+	return *p.Description
+}
+
+func (p *CreateVMSnapshotParams) HasDescription() bool {
+	return p.Description != nil
+}
+
+func (p *CreateVMSnapshotParams) ResetDescription() {
+	p.Description = nil
+}
+
+func (p *CreateVMSnapshotParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateVMSnapshotParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateVMSnapshotParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateVMSnapshotParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *CreateVMSnapshotParams) SetQuiescevm(v bool) {
+	p.Quiescevm = &v
+	return
+}
+
+func (p *CreateVMSnapshotParams) GetQuiescevm() bool {
+	if p.Quiescevm == nil {
+		return false
+	}
+	return *p.Quiescevm
+}
+
+func (p *CreateVMSnapshotParams) HasQuiescevm() bool {
+	return p.Quiescevm != nil
+}
+
+func (p *CreateVMSnapshotParams) ResetQuiescevm() {
+	p.Quiescevm = nil
+}
+
+func (p *CreateVMSnapshotParams) SetSnapshotmemory(v bool) {
+	p.Snapshotmemory = &v
+	return
+}
+
+func (p *CreateVMSnapshotParams) GetSnapshotmemory() bool {
+	if p.Snapshotmemory == nil {
+		return false
+	}
+	return *p.Snapshotmemory
+}
+
+func (p *CreateVMSnapshotParams) HasSnapshotmemory() bool {
+	return p.Snapshotmemory != nil
+}
+
+func (p *CreateVMSnapshotParams) ResetSnapshotmemory() {
+	p.Snapshotmemory = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *CreateVMSnapshotParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *CreateVMSnapshotParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *CreateVMSnapshotParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *CreateVMSnapshotParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+type CreateVMSnapshotResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account          string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created          Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Current          Bool                       `json:"current,omitempty" xml:"current,omitempty"`
+	Description      string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Displayname      string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Domain           string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid         string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Parent           string                     `json:"parent,omitempty" xml:"parent,omitempty"`
+	ParentName       string                     `json:"parentName,omitempty" xml:"parentName,omitempty"`
+	Project          string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid        string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Type             string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVMSnapshotParams struct {
+	Vmsnapshotid *string `json:"vmsnapshotid,omitempty" yaml:"vmsnapshotid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVMSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Vmsnapshotid; v != nil {
+		u.Set("vmsnapshotid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVMSnapshotParams) Command() string {
+	return "deleteVMSnapshot"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVMSnapshotParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVMSnapshotParams) ResponseType() interface{} {
+	return &DeleteVMSnapshotResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVMSnapshotParams) Validate() error {
+	v := &validator{api: "deleteVMSnapshot"}
+	v.required("vmsnapshotid", p.Vmsnapshotid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
+	p.Vmsnapshotid = &v
+	return
+}
+
+func (p *DeleteVMSnapshotParams) GetVmsnapshotid() string {
+	if p.Vmsnapshotid == nil {
+		return ""
+	}
+	return *p.Vmsnapshotid
+}
+
+func (p *DeleteVMSnapshotParams) HasVmsnapshotid() bool {
+	return p.Vmsnapshotid != nil
+}
+
+func (p *DeleteVMSnapshotParams) ResetVmsnapshotid() {
+	p.Vmsnapshotid = nil
+}
+
+type DeleteVMSnapshotResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListVMSnapshotParams struct {
+	Account          *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid         *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Isrecursive      *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword          *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall          *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name             *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page             *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize         *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid        *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State            *string           `json:"state,omitempty" yaml:"state,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Virtualmachineid *string           `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Vmsnapshotid     *string           `json:"vmsnapshotid,omitempty" yaml:"vmsnapshotid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVMSnapshotParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	if v := p.Vmsnapshotid; v != nil {
+		u.Set("vmsnapshotid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListVMSnapshotParams) Command() string {
+	return "listVMSnapshot"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVMSnapshotParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVMSnapshotParams) ResponseType() interface{} {
+	return &ListVMSnapshotResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVMSnapshotParams) Validate() error {
+	v := &validator{api: "listVMSnapshot"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListVMSnapshotParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListVMSnapshotParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListVMSnapshotParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVMSnapshotParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListVMSnapshotParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVMSnapshotParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVMSnapshotParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListVMSnapshotParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListVMSnapshotParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVMSnapshotParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListVMSnapshotParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVMSnapshotParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVMSnapshotParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListVMSnapshotParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListVMSnapshotParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListVMSnapshotParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListVMSnapshotParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListVMSnapshotParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVMSnapshotParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListVMSnapshotParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVMSnapshotParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVMSnapshotParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListVMSnapshotParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVMSnapshotParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVMSnapshotParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListVMSnapshotParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVMSnapshotParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListVMSnapshotParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListVMSnapshotParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListVMSnapshotParams) ResetState() {
+	p.State = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListVMSnapshotParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListVMSnapshotParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListVMSnapshotParams) ResetTags() {
+	p.Tags = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *ListVMSnapshotParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *ListVMSnapshotParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *ListVMSnapshotParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+func (p *ListVMSnapshotParams) SetVmsnapshotid(v string) {
+	p.Vmsnapshotid = &v
+	return
+}
+
+func (p *ListVMSnapshotParams) GetVmsnapshotid() string {
+	if p.Vmsnapshotid == nil {
+		return ""
+	}
+	return *p.Vmsnapshotid
+}
+
+func (p *ListVMSnapshotParams) HasVmsnapshotid() bool {
+	return p.Vmsnapshotid != nil
+}
+
+func (p *ListVMSnapshotParams) ResetVmsnapshotid() {
+	p.Vmsnapshotid = nil
+}
+
+type ListVMSnapshotResponse struct {
+	Count      int                        `json:"count" xml:"count"`
+	VMSnapshot []*VMSnapshot              `json:"vmsnapshot" xml:"vmsnapshot"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VMSnapshot struct {
+	Account          string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created          Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Current          Bool                       `json:"current,omitempty" xml:"current,omitempty"`
+	Description      string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Displayname      string                     `json:"displayname,omitempty" xml:"displayname,omitempty"`
+	Domain           string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid         string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Parent           string                     `json:"parent,omitempty" xml:"parent,omitempty"`
+	ParentName       string                     `json:"parentName,omitempty" xml:"parentName,omitempty"`
+	Project          string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid        string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Type             string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Virtualmachineid string                     `json:"virtualmachineid,omitempty" xml:"virtualmachineid,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the SnapshotService that is the same for all versions
+type SnapshotService interface {
+	NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams
+	CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	NewDeleteSnapshotParams(id string) *DeleteSnapshotParams
+	DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	NewListSnapshotsParams() *ListSnapshotsParams
+	GetSnapshotID(name string, opts ...OptionFunc) (string, error)
+	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotsByTags(tags map[string]string, opts ...OptionFunc) ([]*Snapshot, error)
+	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	StreamSnapshots(ctx context.Context, p *ListSnapshotsParams, fn func(*Snapshot) error) (int, error)
+	NewRevertSnapshotParams(id string) *RevertSnapshotParams
+	RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams
+	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
+	NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams
+	CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams
+	DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	NewListVMSnapshotParams() *ListVMSnapshotParams
+	GetVMSnapshotID(name string, opts ...OptionFunc) (string, error)
+	GetVMSnapshotByTags(tags map[string]string, opts ...OptionFunc) ([]*VMSnapshot, error)
+	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	StreamVMSnapshot(ctx context.Context, p *ListVMSnapshotParams, fn func(*VMSnapshot) error) (int, error)
+}
@@ -0,0 +1,323 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreateSnapshot(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"policyid":  {"test-policyid"},
+		"quiescevm": {"true"},
+		"volumeid":  {"test-volumeid"},
+	}
+
+	p := &CreateSnapshotParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPolicyid("test-policyid")
+	p.SetQuiescevm(true)
+	p.SetVolumeid("test-volumeid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createSnapshot", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateSnapshotResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createSnapshot", `{"createsnapshotresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","intervaltype":"test-intervaltype","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","revertable":true,"snapshottype":"test-snapshottype","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"volumeid":"test-volumeid","volumename":"test-volumename","volumetype":"test-volumetype","zoneid":"test-zoneid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteSnapshot(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteSnapshotParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteSnapshot", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteSnapshotResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteSnapshot", `{"deletesnapshotresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"id":            {"test-id"},
+		"intervaltype":  {"DAILY"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"name":          {"test-name"},
+		"page":          {"9"},
+		"pagesize":      {"10"},
+		"projectid":     {"test-projectid"},
+		"snapshottype":  {"test-snapshottype"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+		"volumeid":      {"test-volumeid"},
+		"zoneid":        {"test-zoneid"},
+	}
+
+	p := &ListSnapshotsParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIntervaltype(SnapshotIntervalDaily)
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(9)
+	p.SetPagesize(10)
+	p.SetProjectid("test-projectid")
+	p.SetSnapshottype("test-snapshottype")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVolumeid("test-volumeid")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listSnapshots", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listSnapshots" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListSnapshotsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listSnapshots", `{"listsnapshotsresponse":{"count":1,"snapshot":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","intervaltype":"test-intervaltype","name":"test-name","project":"test-project","projectid":"test-projectid","revertable":true,"snapshottype":"test-snapshottype","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"volumeid":"test-volumeid","volumename":"test-volumename","volumetype":"test-volumetype","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.Snapshots[0].Account != "test-account" {
+		t.Errorf("Expected r.Snapshots[0].Account to be %v, got %v", "test-account", r.Snapshots[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestRevertSnapshot(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &RevertSnapshotParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "revertSnapshot", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "revertSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RevertSnapshotResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "revertSnapshot", `{"revertsnapshotresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","id":"test-id","intervaltype":"test-intervaltype","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","revertable":true,"snapshottype":"test-snapshottype","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"volumeid":"test-volumeid","volumename":"test-volumename","volumetype":"test-volumetype","zoneid":"test-zoneid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteSnapshotPolicies(t *testing.T) {
+	params := url.Values{
+		"id":  {"test-id"},
+		"ids": {"test-ids1, test-ids2"},
+	}
+
+	p := &DeleteSnapshotPoliciesParams{}
+	p.SetId("test-id")
+	p.SetIds([]string{"test-ids1", "test-ids2"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteSnapshotPolicies", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteSnapshotPolicies" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteSnapshotPoliciesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteSnapshotPolicies", `{"deletesnapshotpoliciesresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestCreateVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"description":      {"test-description"},
+		"name":             {"test-name"},
+		"quiescevm":        {"true"},
+		"snapshotmemory":   {"true"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+
+	p := &CreateVMSnapshotParams{}
+	p.SetDescription("test-description")
+	p.SetName("test-name")
+	p.SetQuiescevm(true)
+	p.SetSnapshotmemory(true)
+	p.SetVirtualmachineid("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createVMSnapshot", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createVMSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateVMSnapshotResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createVMSnapshot", `{"createvmsnapshotresponse":{"account":"test-account","created":"2014-01-02T15:04:05+0100","current":true,"description":"test-description","displayname":"test-displayname","domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","name":"test-name","parent":"test-parent","parentName":"test-parentName","project":"test-project","projectid":"test-projectid","state":"test-state","type":"test-type","virtualmachineid":"test-virtualmachineid","zoneid":"test-zoneid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"vmsnapshotid": {"test-vmsnapshotid"},
+	}
+
+	p := &DeleteVMSnapshotParams{}
+	p.SetVmsnapshotid("test-vmsnapshotid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVMSnapshot", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVMSnapshot" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVMSnapshotResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVMSnapshot", `{"deletevmsnapshotresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListVMSnapshot(t *testing.T) {
+	params := url.Values{
+		"account":          {"test-account"},
+		"domainid":         {"test-domainid"},
+		"isrecursive":      {"true"},
+		"keyword":          {"test-keyword"},
+		"listall":          {"true"},
+		"name":             {"test-name"},
+		"page":             {"7"},
+		"pagesize":         {"8"},
+		"projectid":        {"test-projectid"},
+		"state":            {"test-state"},
+		"tags[0].key":      {"test-tagskey"},
+		"tags[0].value":    {"test-tagsvalue"},
+		"virtualmachineid": {"test-virtualmachineid"},
+		"vmsnapshotid":     {"test-vmsnapshotid"},
+	}
+
+	p := &ListVMSnapshotParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetName("test-name")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVirtualmachineid("test-virtualmachineid")
+	p.SetVmsnapshotid("test-vmsnapshotid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listVMSnapshot", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listVMSnapshot" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListVMSnapshotResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listVMSnapshot", `{"listvmsnapshotresponse":{"count":1,"vmsnapshot":[{"account":"test-account","created":"2014-01-02T15:04:05+0100","current":true,"description":"test-description","displayname":"test-displayname","domain":"test-domain","domainid":"test-domainid","id":"test-id","name":"test-name","parent":"test-parent","parentName":"test-parentName","project":"test-project","projectid":"test-projectid","state":"test-state","type":"test-type","virtualmachineid":"test-virtualmachineid","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.VMSnapshot[0].Account != "test-account" {
+		t.Errorf("Expected r.VMSnapshot[0].Account to be %v, got %v", "test-account", r.VMSnapshot[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
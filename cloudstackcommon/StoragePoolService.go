@@ -0,0 +1,177 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListStorageProvidersParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Type     *string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListStorageProvidersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Type; v != nil {
+		u.Set("type", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListStorageProvidersParams) Command() string {
+	return "listStorageProviders"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListStorageProvidersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListStorageProvidersParams) ResponseType() interface{} {
+	return &ListStorageProvidersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListStorageProvidersParams) Validate() error {
+	v := &validator{api: "listStorageProviders"}
+	v.required("type", p.Type != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListStorageProvidersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListStorageProvidersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListStorageProvidersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListStorageProvidersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListStorageProvidersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListStorageProvidersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListStorageProvidersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListStorageProvidersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListStorageProvidersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListStorageProvidersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListStorageProvidersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListStorageProvidersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// This param is required.
+func (p *ListStorageProvidersParams) SetType(v string) {
+	p.Type = &v
+	return
+}
+
+func (p *ListStorageProvidersParams) GetType() string {
+	if p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+func (p *ListStorageProvidersParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListStorageProvidersParams) ResetType() {
+	p.Type = nil
+}
+
+type ListStorageProvidersResponse struct {
+	Count            int                        `json:"count" xml:"count"`
+	StorageProviders []*StorageProvider         `json:"storageprovider" xml:"storageprovider"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StorageProvider struct {
+	Name  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Type  string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the StoragePoolService that is the same for all versions
+type StoragePoolService interface {
+	NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams
+	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	StreamStorageProviders(ctx context.Context, p *ListStorageProvidersParams, fn func(*StorageProvider) error) (int, error)
+}
@@ -0,0 +1,219 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type AddStratosphereSspParams struct {
+	Name       *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Password   *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Tenantuuid *string `json:"tenantuuid,omitempty" yaml:"tenantuuid,omitempty"`
+	Url        *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username   *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddStratosphereSspParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Tenantuuid; v != nil {
+		u.Set("tenantuuid", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddStratosphereSspParams) Command() string {
+	return "addStratosphereSsp"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddStratosphereSspParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddStratosphereSspParams) ResponseType() interface{} {
+	return &AddStratosphereSspResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddStratosphereSspParams) Validate() error {
+	v := &validator{api: "addStratosphereSsp"}
+	v.required("name", p.Name != nil)
+	v.required("url", p.Url != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AddStratosphereSspParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *AddStratosphereSspParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *AddStratosphereSspParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *AddStratosphereSspParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'password' param: the password.
+func (p *AddStratosphereSspParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddStratosphereSspParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddStratosphereSspParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddStratosphereSspParams) ResetPassword() {
+	p.Password = nil
+}
+
+func (p *AddStratosphereSspParams) SetTenantuuid(v string) {
+	p.Tenantuuid = &v
+	return
+}
+
+func (p *AddStratosphereSspParams) GetTenantuuid() string {
+	if p.Tenantuuid == nil {
+		return ""
+	}
+	return *p.Tenantuuid
+}
+
+func (p *AddStratosphereSspParams) HasTenantuuid() bool {
+	return p.Tenantuuid != nil
+}
+
+func (p *AddStratosphereSspParams) ResetTenantuuid() {
+	p.Tenantuuid = nil
+}
+
+// This param is required.
+func (p *AddStratosphereSspParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddStratosphereSspParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddStratosphereSspParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddStratosphereSspParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user.
+func (p *AddStratosphereSspParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddStratosphereSspParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddStratosphereSspParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddStratosphereSspParams) ResetUsername() {
+	p.Username = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddStratosphereSspParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddStratosphereSspParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddStratosphereSspParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddStratosphereSspParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type AddStratosphereSspResponse struct {
+	Hostid string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Name   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Url    string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the StratosphereSSPService that is the same for all versions
+type StratosphereSSPService interface {
+	NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams
+	AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
+}
@@ -0,0 +1,58 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddStratosphereSsp(t *testing.T) {
+	params := url.Values{
+		"name":       {"test-name"},
+		"password":   {"test-password"},
+		"tenantuuid": {"test-tenantuuid"},
+		"url":        {"test-url"},
+		"username":   {"test-username"},
+		"zoneid":     {"test-zoneid"},
+	}
+
+	p := &AddStratosphereSspParams{}
+	p.SetName("test-name")
+	p.SetPassword("test-password")
+	p.SetTenantuuid("test-tenantuuid")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addStratosphereSsp", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addStratosphereSsp" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddStratosphereSspResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addStratosphereSsp", `{"addstratospheresspresponse":{"hostid":"test-hostid","name":"test-name","url":"test-url","zoneid":"test-zoneid"}}`, false, r)
+	if r.Hostid != "test-hostid" {
+		t.Errorf("Expected r.Hostid to be %v, got %v", "test-hostid", r.Hostid)
+	}
+}
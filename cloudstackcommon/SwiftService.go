@@ -0,0 +1,330 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddSwiftParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Key      *string `json:"key,omitempty" yaml:"key,omitempty"`
+	Url      *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddSwiftParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Key; v != nil {
+		u.Set("key", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddSwiftParams) Command() string {
+	return "addSwift"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddSwiftParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddSwiftParams) ResponseType() interface{} {
+	return &AddSwiftResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddSwiftParams) Validate() error {
+	v := &validator{api: "addSwift"}
+	v.required("url", p.Url != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *AddSwiftParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *AddSwiftParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *AddSwiftParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *AddSwiftParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *AddSwiftParams) SetKey(v string) {
+	p.Key = &v
+	return
+}
+
+func (p *AddSwiftParams) GetKey() string {
+	if p.Key == nil {
+		return ""
+	}
+	return *p.Key
+}
+
+func (p *AddSwiftParams) HasKey() bool {
+	return p.Key != nil
+}
+
+func (p *AddSwiftParams) ResetKey() {
+	p.Key = nil
+}
+
+// This param is required.
+func (p *AddSwiftParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddSwiftParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddSwiftParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddSwiftParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user.
+func (p *AddSwiftParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddSwiftParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddSwiftParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddSwiftParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddSwiftResponse struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListSwiftsParams struct {
+	Id       *int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSwiftsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("id", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSwiftsParams) Command() string {
+	return "listSwifts"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSwiftsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSwiftsParams) ResponseType() interface{} {
+	return &ListSwiftsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSwiftsParams) Validate() error {
+	v := &validator{api: "listSwifts"}
+	return v.err()
+}
+
+func (p *ListSwiftsParams) SetId(v int64) {
+	p.Id = &v
+	return
+}
+
+func (p *ListSwiftsParams) GetId() int64 {
+	if p.Id == nil {
+		return 0
+	}
+	return *p.Id
+}
+
+func (p *ListSwiftsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListSwiftsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSwiftsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListSwiftsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListSwiftsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSwiftsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSwiftsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListSwiftsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListSwiftsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSwiftsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSwiftsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListSwiftsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListSwiftsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSwiftsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListSwiftsResponse struct {
+	Count  int                        `json:"count" xml:"count"`
+	Swifts []*Swift                   `json:"swift" xml:"swift"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Swift struct {
+	Details      []string                   `json:"details,omitempty" xml:"details,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Protocol     string                     `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Providername string                     `json:"providername,omitempty" xml:"providername,omitempty"`
+	Scope        string                     `json:"scope,omitempty" xml:"scope,omitempty"`
+	Url          string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid       string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename     string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the SwiftService that is the same for all versions
+type SwiftService interface {
+	NewAddSwiftParams(url string) *AddSwiftParams
+	AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error)
+	NewListSwiftsParams() *ListSwiftsParams
+	GetSwiftID(keyword string, opts ...OptionFunc) (string, error)
+	ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	StreamSwifts(ctx context.Context, p *ListSwiftsParams, fn func(*Swift) error) (int, error)
+}
@@ -0,0 +1,308 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListCapacityParams struct {
+	Clusterid   *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Fetchlatest *bool   `json:"fetchlatest,omitempty" yaml:"fetchlatest,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid       *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Sortby      *string `json:"sortby,omitempty" yaml:"sortby,omitempty"`
+	Type        *int    `json:"type,omitempty" yaml:"type,omitempty"`
+	Zoneid      *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListCapacityParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Fetchlatest; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("fetchlatest", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Sortby; v != nil {
+		u.Set("sortby", *v)
+	}
+	if v := p.Type; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("type", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListCapacityParams) Command() string {
+	return "listCapacity"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListCapacityParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListCapacityParams) ResponseType() interface{} {
+	return &ListCapacityResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListCapacityParams) Validate() error {
+	v := &validator{api: "listCapacity"}
+	return v.err()
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *ListCapacityParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *ListCapacityParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *ListCapacityParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *ListCapacityParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+func (p *ListCapacityParams) SetFetchlatest(v bool) {
+	p.Fetchlatest = &v
+	return
+}
+
+func (p *ListCapacityParams) GetFetchlatest() bool {
+	if p.Fetchlatest == nil {
+		return false
+	}
+	return *p.Fetchlatest
+}
+
+func (p *ListCapacityParams) HasFetchlatest() bool {
+	return p.Fetchlatest != nil
+}
+
+func (p *ListCapacityParams) ResetFetchlatest() {
+	p.Fetchlatest = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListCapacityParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListCapacityParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListCapacityParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListCapacityParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListCapacityParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListCapacityParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListCapacityParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListCapacityParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListCapacityParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListCapacityParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListCapacityParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListCapacityParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListCapacityParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListCapacityParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListCapacityParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListCapacityParams) ResetPodid() {
+	p.Podid = nil
+}
+
+func (p *ListCapacityParams) SetSortby(v string) {
+	p.Sortby = &v
+	return
+}
+
+func (p *ListCapacityParams) GetSortby() string {
+	if p.Sortby == nil {
+		return ""
+	}
+	return *p.Sortby
+}
+
+func (p *ListCapacityParams) HasSortby() bool {
+	return p.Sortby != nil
+}
+
+func (p *ListCapacityParams) ResetSortby() {
+	p.Sortby = nil
+}
+
+func (p *ListCapacityParams) SetType(v int) {
+	p.Type = &v
+	return
+}
+
+func (p *ListCapacityParams) GetType() int {
+	if p.Type == nil {
+		return 0
+	}
+	return *p.Type
+}
+
+func (p *ListCapacityParams) HasType() bool {
+	return p.Type != nil
+}
+
+func (p *ListCapacityParams) ResetType() {
+	p.Type = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListCapacityParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListCapacityParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListCapacityParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListCapacityParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListCapacityResponse struct {
+	Count    int                        `json:"count" xml:"count"`
+	Capacity []*Capacity                `json:"capacity" xml:"capacity"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Capacity struct {
+	Capacitytotal int64                      `json:"capacitytotal,omitempty" xml:"capacitytotal,omitempty"`
+	Capacityused  int64                      `json:"capacityused,omitempty" xml:"capacityused,omitempty"`
+	Clusterid     string                     `json:"clusterid,omitempty" xml:"clusterid,omitempty"`
+	Clustername   string                     `json:"clustername,omitempty" xml:"clustername,omitempty"`
+	Percentused   Percentage                 `json:"percentused,omitempty" xml:"percentused,omitempty"`
+	Podid         string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname       string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Type          int                        `json:"type,omitempty" xml:"type,omitempty"`
+	Zoneid        string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename      string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra         map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the SystemCapacityService that is the same for all versions
+type SystemCapacityService interface {
+	NewListCapacityParams() *ListCapacityParams
+	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+	StreamCapacity(ctx context.Context, p *ListCapacityParams, fn func(*Capacity) error) (int, error)
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListCapacity(t *testing.T) {
+	params := url.Values{
+		"clusterid":   {"test-clusterid"},
+		"fetchlatest": {"true"},
+		"keyword":     {"test-keyword"},
+		"page":        {"4"},
+		"pagesize":    {"5"},
+		"podid":       {"test-podid"},
+		"sortby":      {"test-sortby"},
+		"type":        {"8"},
+		"zoneid":      {"test-zoneid"},
+	}
+
+	p := &ListCapacityParams{}
+	p.SetClusterid("test-clusterid")
+	p.SetFetchlatest(true)
+	p.SetKeyword("test-keyword")
+	p.SetPage(4)
+	p.SetPagesize(5)
+	p.SetPodid("test-podid")
+	p.SetSortby("test-sortby")
+	p.SetType(8)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listCapacity", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listCapacity" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListCapacityResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listCapacity", `{"listcapacityresponse":{"capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"count":1}}`, false, r)
+	if r.Capacity[0].Clusterid != "test-clusterid" {
+		t.Errorf("Expected r.Capacity[0].Clusterid to be %v, got %v", "test-clusterid", r.Capacity[0].Clusterid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
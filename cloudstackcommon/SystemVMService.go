@@ -0,0 +1,1155 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type ChangeServiceForSystemVmParams struct {
+	Details           map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ChangeServiceForSystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ChangeServiceForSystemVmParams) Command() string {
+	return "changeServiceForSystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *ChangeServiceForSystemVmParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ChangeServiceForSystemVmParams) ResponseType() interface{} {
+	return &ChangeServiceForSystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ChangeServiceForSystemVmParams) Validate() error {
+	v := &validator{api: "changeServiceForSystemVm"}
+	v.required("id", p.Id != nil)
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	return v.err()
+}
+
+func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *ChangeServiceForSystemVmParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *ChangeServiceForSystemVmParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *ChangeServiceForSystemVmParams) ResetDetails() {
+	p.Details = nil
+}
+
+// This param is required.
+func (p *ChangeServiceForSystemVmParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ChangeServiceForSystemVmParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ChangeServiceForSystemVmParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ChangeServiceForSystemVmParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *ChangeServiceForSystemVmParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
+
+func (p *ChangeServiceForSystemVmParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
+	}
+	return *p.Serviceofferingid
+}
+
+func (p *ChangeServiceForSystemVmParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *ChangeServiceForSystemVmParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+type ChangeServiceForSystemVmResponse struct {
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobid                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DestroySystemVmParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DestroySystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DestroySystemVmParams) Command() string {
+	return "destroySystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *DestroySystemVmParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DestroySystemVmParams) ResponseType() interface{} {
+	return &DestroySystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DestroySystemVmParams) Validate() error {
+	v := &validator{api: "destroySystemVm"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DestroySystemVmParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DestroySystemVmParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DestroySystemVmParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DestroySystemVmParams) ResetId() {
+	p.Id = nil
+}
+
+type DestroySystemVmResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListSystemVmsParams struct {
+	Hostid       *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name         *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Podid        *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	State        *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Storageid    *string `json:"storageid,omitempty" yaml:"storageid,omitempty"`
+	Systemvmtype *string `json:"systemvmtype,omitempty" yaml:"systemvmtype,omitempty"`
+	Zoneid       *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListSystemVmsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Storageid; v != nil {
+		u.Set("storageid", *v)
+	}
+	if v := p.Systemvmtype; v != nil {
+		u.Set("systemvmtype", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListSystemVmsParams) Command() string {
+	return "listSystemVms"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListSystemVmsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListSystemVmsParams) ResponseType() interface{} {
+	return &ListSystemVmsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListSystemVmsParams) Validate() error {
+	v := &validator{api: "listSystemVms"}
+	return v.err()
+}
+
+// Sets the 'hostid' param: the ID of the host.
+func (p *ListSystemVmsParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *ListSystemVmsParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *ListSystemVmsParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+func (p *ListSystemVmsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListSystemVmsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListSystemVmsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListSystemVmsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListSystemVmsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListSystemVmsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListSystemVmsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListSystemVmsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListSystemVmsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListSystemVmsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListSystemVmsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListSystemVmsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListSystemVmsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListSystemVmsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListSystemVmsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListSystemVmsParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListSystemVmsParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListSystemVmsParams) ResetPodid() {
+	p.Podid = nil
+}
+
+func (p *ListSystemVmsParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListSystemVmsParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListSystemVmsParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListSystemVmsParams) SetStorageid(v string) {
+	p.Storageid = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetStorageid() string {
+	if p.Storageid == nil {
+		return ""
+	}
+	return *p.Storageid
+}
+
+func (p *ListSystemVmsParams) HasStorageid() bool {
+	return p.Storageid != nil
+}
+
+func (p *ListSystemVmsParams) ResetStorageid() {
+	p.Storageid = nil
+}
+
+func (p *ListSystemVmsParams) SetSystemvmtype(v string) {
+	p.Systemvmtype = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetSystemvmtype() string {
+	if p.Systemvmtype == nil {
+		return ""
+	}
+	return *p.Systemvmtype
+}
+
+func (p *ListSystemVmsParams) HasSystemvmtype() bool {
+	return p.Systemvmtype != nil
+}
+
+func (p *ListSystemVmsParams) ResetSystemvmtype() {
+	p.Systemvmtype = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListSystemVmsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListSystemVmsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListSystemVmsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListSystemVmsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListSystemVmsResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	SystemVms []*SystemVm                `json:"systemvm" xml:"systemvm"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type SystemVm struct {
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobid                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type MigrateSystemVmParams struct {
+	Hostid           *string `json:"hostid,omitempty" yaml:"hostid,omitempty"`
+	Virtualmachineid *string `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *MigrateSystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hostid; v != nil {
+		u.Set("hostid", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *MigrateSystemVmParams) Command() string {
+	return "migrateSystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *MigrateSystemVmParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *MigrateSystemVmParams) ResponseType() interface{} {
+	return &MigrateSystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *MigrateSystemVmParams) Validate() error {
+	v := &validator{api: "migrateSystemVm"}
+	v.required("hostid", p.Hostid != nil)
+	v.required("virtualmachineid", p.Virtualmachineid != nil)
+	return v.err()
+}
+
+// Sets the 'hostid' param: the ID of the host. This param is required.
+func (p *MigrateSystemVmParams) SetHostid(v string) {
+	p.Hostid = &v
+	return
+}
+
+func (p *MigrateSystemVmParams) GetHostid() string {
+	if p.Hostid == nil {
+		return ""
+	}
+	return *p.Hostid
+}
+
+func (p *MigrateSystemVmParams) HasHostid() bool {
+	return p.Hostid != nil
+}
+
+func (p *MigrateSystemVmParams) ResetHostid() {
+	p.Hostid = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine. This param is required.
+func (p *MigrateSystemVmParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *MigrateSystemVmParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *MigrateSystemVmParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *MigrateSystemVmParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+type MigrateSystemVmResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RebootSystemVmParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RebootSystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RebootSystemVmParams) Command() string {
+	return "rebootSystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *RebootSystemVmParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RebootSystemVmParams) ResponseType() interface{} {
+	return &RebootSystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RebootSystemVmParams) Validate() error {
+	v := &validator{api: "rebootSystemVm"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RebootSystemVmParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RebootSystemVmParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RebootSystemVmParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RebootSystemVmParams) ResetId() {
+	p.Id = nil
+}
+
+type RebootSystemVmResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ScaleSystemVmParams struct {
+	Details           map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ScaleSystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ScaleSystemVmParams) Command() string {
+	return "scaleSystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *ScaleSystemVmParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ScaleSystemVmParams) ResponseType() interface{} {
+	return &ScaleSystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ScaleSystemVmParams) Validate() error {
+	v := &validator{api: "scaleSystemVm"}
+	v.required("id", p.Id != nil)
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	return v.err()
+}
+
+func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *ScaleSystemVmParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *ScaleSystemVmParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *ScaleSystemVmParams) ResetDetails() {
+	p.Details = nil
+}
+
+// This param is required.
+func (p *ScaleSystemVmParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ScaleSystemVmParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ScaleSystemVmParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ScaleSystemVmParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *ScaleSystemVmParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
+
+func (p *ScaleSystemVmParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
+	}
+	return *p.Serviceofferingid
+}
+
+func (p *ScaleSystemVmParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *ScaleSystemVmParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+type ScaleSystemVmResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StartSystemVmParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StartSystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *StartSystemVmParams) Command() string {
+	return "startSystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *StartSystemVmParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StartSystemVmParams) ResponseType() interface{} {
+	return &StartSystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StartSystemVmParams) Validate() error {
+	v := &validator{api: "startSystemVm"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *StartSystemVmParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *StartSystemVmParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *StartSystemVmParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *StartSystemVmParams) ResetId() {
+	p.Id = nil
+}
+
+type StartSystemVmResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StopSystemVmParams struct {
+	Forced *bool   `json:"forced,omitempty" yaml:"forced,omitempty"`
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *StopSystemVmParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Forced; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forced", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *StopSystemVmParams) Command() string {
+	return "stopSystemVm"
+}
+
+// Returns true if the API command starts an async job
+func (p *StopSystemVmParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *StopSystemVmParams) ResponseType() interface{} {
+	return &StopSystemVmResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *StopSystemVmParams) Validate() error {
+	v := &validator{api: "stopSystemVm"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'forced' param: force the operation, even if the resource is in use.
+func (p *StopSystemVmParams) SetForced(v bool) {
+	p.Forced = &v
+	return
+}
+
+func (p *StopSystemVmParams) GetForced() bool {
+	if p.Forced == nil {
+		return false
+	}
+	return *p.Forced
+}
+
+func (p *StopSystemVmParams) HasForced() bool {
+	return p.Forced != nil
+}
+
+func (p *StopSystemVmParams) ResetForced() {
+	p.Forced = nil
+}
+
+// This param is required.
+func (p *StopSystemVmParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *StopSystemVmParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *StopSystemVmParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *StopSystemVmParams) ResetId() {
+	p.Id = nil
+}
+
+type StopSystemVmResponse struct {
+	JobID                string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Activeviewersessions int                        `json:"activeviewersessions,omitempty" xml:"activeviewersessions,omitempty"`
+	Created              Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Dns1                 string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                 string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Gateway              string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Hostid               string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname             string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Id                   string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Jobstatus            int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Linklocalip          string                     `json:"linklocalip,omitempty" xml:"linklocalip,omitempty"`
+	Linklocalmacaddress  string                     `json:"linklocalmacaddress,omitempty" xml:"linklocalmacaddress,omitempty"`
+	Linklocalnetmask     string                     `json:"linklocalnetmask,omitempty" xml:"linklocalnetmask,omitempty"`
+	Name                 string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networkdomain        string                     `json:"networkdomain,omitempty" xml:"networkdomain,omitempty"`
+	Podid                string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Privateip            string                     `json:"privateip,omitempty" xml:"privateip,omitempty"`
+	Privatemacaddress    string                     `json:"privatemacaddress,omitempty" xml:"privatemacaddress,omitempty"`
+	Privatenetmask       string                     `json:"privatenetmask,omitempty" xml:"privatenetmask,omitempty"`
+	Publicip             string                     `json:"publicip,omitempty" xml:"publicip,omitempty"`
+	Publicmacaddress     string                     `json:"publicmacaddress,omitempty" xml:"publicmacaddress,omitempty"`
+	Publicnetmask        string                     `json:"publicnetmask,omitempty" xml:"publicnetmask,omitempty"`
+	State                string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Systemvmtype         string                     `json:"systemvmtype,omitempty" xml:"systemvmtype,omitempty"`
+	Templateid           string                     `json:"templateid,omitempty" xml:"templateid,omitempty"`
+	Zoneid               string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename             string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the SystemVMService that is the same for all versions
+type SystemVMService interface {
+	NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams
+	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	NewDestroySystemVmParams(id string) *DestroySystemVmParams
+	DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	NewListSystemVmsParams() *ListSystemVmsParams
+	GetSystemVmID(name string, opts ...OptionFunc) (string, error)
+	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	StreamSystemVms(ctx context.Context, p *ListSystemVmsParams, fn func(*SystemVm) error) (int, error)
+	NewMigrateSystemVmParams(hostid string, virtualmachineid string) *MigrateSystemVmParams
+	MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	NewRebootSystemVmParams(id string) *RebootSystemVmParams
+	RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams
+	ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	NewStartSystemVmParams(id string) *StartSystemVmParams
+	StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	NewStopSystemVmParams(id string) *StopSystemVmParams
+	StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error)
+}
@@ -0,0 +1,283 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestChangeServiceForSystemVm(t *testing.T) {
+	params := url.Values{
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"id":                         {"test-id"},
+		"serviceofferingid":          {"test-serviceofferingid"},
+	}
+
+	p := &ChangeServiceForSystemVmParams{}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetId("test-id")
+	p.SetServiceofferingid("test-serviceofferingid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "changeServiceForSystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "changeServiceForSystemVm" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ChangeServiceForSystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "changeServiceForSystemVm", `{"changeserviceforsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+}
+
+func TestDestroySystemVm(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DestroySystemVmParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "destroySystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "destroySystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DestroySystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "destroySystemVm", `{"destroysystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListSystemVms(t *testing.T) {
+	params := url.Values{
+		"hostid":       {"test-hostid"},
+		"id":           {"test-id"},
+		"keyword":      {"test-keyword"},
+		"name":         {"test-name"},
+		"page":         {"5"},
+		"pagesize":     {"6"},
+		"podid":        {"test-podid"},
+		"state":        {"test-state"},
+		"storageid":    {"test-storageid"},
+		"systemvmtype": {"test-systemvmtype"},
+		"zoneid":       {"test-zoneid"},
+	}
+
+	p := &ListSystemVmsParams{}
+	p.SetHostid("test-hostid")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetPodid("test-podid")
+	p.SetState("test-state")
+	p.SetStorageid("test-storageid")
+	p.SetSystemvmtype("test-systemvmtype")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listSystemVms", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listSystemVms" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListSystemVmsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listSystemVms", `{"listsystemvmsresponse":{"count":1,"systemvm":[{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.SystemVms[0].Dns1 != "test-dns1" {
+		t.Errorf("Expected r.SystemVms[0].Dns1 to be %v, got %v", "test-dns1", r.SystemVms[0].Dns1)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestMigrateSystemVm(t *testing.T) {
+	params := url.Values{
+		"hostid":           {"test-hostid"},
+		"virtualmachineid": {"test-virtualmachineid"},
+	}
+
+	p := &MigrateSystemVmParams{}
+	p.SetHostid("test-hostid")
+	p.SetVirtualmachineid("test-virtualmachineid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "migrateSystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "migrateSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*MigrateSystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "migrateSystemVm", `{"migratesystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestRebootSystemVm(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &RebootSystemVmParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "rebootSystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "rebootSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RebootSystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "rebootSystemVm", `{"rebootsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestScaleSystemVm(t *testing.T) {
+	params := url.Values{
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"id":                         {"test-id"},
+		"serviceofferingid":          {"test-serviceofferingid"},
+	}
+
+	p := &ScaleSystemVmParams{}
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetId("test-id")
+	p.SetServiceofferingid("test-serviceofferingid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "scaleSystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "scaleSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ScaleSystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "scaleSystemVm", `{"scalesystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestStartSystemVm(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &StartSystemVmParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "startSystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "startSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*StartSystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "startSystemVm", `{"startsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestStopSystemVm(t *testing.T) {
+	params := url.Values{
+		"forced": {"true"},
+		"id":     {"test-id"},
+	}
+
+	p := &StopSystemVmParams{}
+	p.SetForced(true)
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "stopSystemVm", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "stopSystemVm" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*StopSystemVmResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "stopSystemVm", `{"stopsystemvmresponse":{"activeviewersessions":1,"created":"2014-01-02T15:04:05+0100","dns1":"test-dns1","dns2":"test-dns2","gateway":"test-gateway","hostid":"test-hostid","hostname":"test-hostname","id":"test-id","jobid":"test-jobid","jobstatus":1,"linklocalip":"test-linklocalip","linklocalmacaddress":"test-linklocalmacaddress","linklocalnetmask":"test-linklocalnetmask","name":"test-name","networkdomain":"test-networkdomain","podid":"test-podid","privateip":"test-privateip","privatemacaddress":"test-privatemacaddress","privatenetmask":"test-privatenetmask","publicip":"test-publicip","publicmacaddress":"test-publicmacaddress","publicnetmask":"test-publicnetmask","state":"test-state","systemvmtype":"test-systemvmtype","templateid":"test-templateid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Dns1 != "test-dns1" {
+		t.Errorf("Expected r.Dns1 to be %v, got %v", "test-dns1", r.Dns1)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
@@ -0,0 +1,2570 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type UpgradeRouterTemplateParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Clusterid *string `json:"clusterid,omitempty" yaml:"clusterid,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id        *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Podid     *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Zoneid    *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpgradeRouterTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Clusterid; v != nil {
+		u.Set("clusterid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpgradeRouterTemplateParams) Command() string {
+	return "upgradeRouterTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpgradeRouterTemplateParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpgradeRouterTemplateParams) ResponseType() interface{} {
+	return &UpgradeRouterTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpgradeRouterTemplateParams) Validate() error {
+	v := &validator{api: "upgradeRouterTemplate"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpgradeRouterTemplateParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *UpgradeRouterTemplateParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpgradeRouterTemplateParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'clusterid' param: the ID of the cluster.
+func (p *UpgradeRouterTemplateParams) SetClusterid(v string) {
+	p.Clusterid = &v
+	return
+}
+
+func (p *UpgradeRouterTemplateParams) GetClusterid() string {
+	if p.Clusterid == nil {
+		return ""
+	}
+	return *p.Clusterid
+}
+
+func (p *UpgradeRouterTemplateParams) HasClusterid() bool {
+	return p.Clusterid != nil
+}
+
+func (p *UpgradeRouterTemplateParams) ResetClusterid() {
+	p.Clusterid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *UpgradeRouterTemplateParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *UpgradeRouterTemplateParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *UpgradeRouterTemplateParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *UpgradeRouterTemplateParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *UpgradeRouterTemplateParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpgradeRouterTemplateParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpgradeRouterTemplateParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpgradeRouterTemplateParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *UpgradeRouterTemplateParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *UpgradeRouterTemplateParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *UpgradeRouterTemplateParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *UpgradeRouterTemplateParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *UpgradeRouterTemplateParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *UpgradeRouterTemplateParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *UpgradeRouterTemplateParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *UpgradeRouterTemplateParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type UpgradeRouterTemplateResponse struct {
+	Jobid     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Jobstatus int                        `json:"jobstatus,omitempty" xml:"jobstatus,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CopyTemplateParams struct {
+	Destzoneid   *string `json:"destzoneid,omitempty" yaml:"destzoneid,omitempty"`
+	Id           *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Sourcezoneid *string `json:"sourcezoneid,omitempty" yaml:"sourcezoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CopyTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Destzoneid; v != nil {
+		u.Set("destzoneid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Sourcezoneid; v != nil {
+		u.Set("sourcezoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CopyTemplateParams) Command() string {
+	return "copyTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *CopyTemplateParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CopyTemplateParams) ResponseType() interface{} {
+	return &CopyTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CopyTemplateParams) Validate() error {
+	v := &validator{api: "copyTemplate"}
+	v.required("destzoneid", p.Destzoneid != nil)
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CopyTemplateParams) SetDestzoneid(v string) {
+	p.Destzoneid = &v
+	return
+}
+
+func (p *CopyTemplateParams) GetDestzoneid() string {
+	if p.Destzoneid == nil {
+		return ""
+	}
+	return *p.Destzoneid
+}
+
+func (p *CopyTemplateParams) HasDestzoneid() bool {
+	return p.Destzoneid != nil
+}
+
+func (p *CopyTemplateParams) ResetDestzoneid() {
+	p.Destzoneid = nil
+}
+
+// This param is required.
+func (p *CopyTemplateParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *CopyTemplateParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *CopyTemplateParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *CopyTemplateParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *CopyTemplateParams) SetSourcezoneid(v string) {
+	p.Sourcezoneid = &v
+	return
+}
+
+func (p *CopyTemplateParams) GetSourcezoneid() string {
+	if p.Sourcezoneid == nil {
+		return ""
+	}
+	return *p.Sourcezoneid
+}
+
+func (p *CopyTemplateParams) HasSourcezoneid() bool {
+	return p.Sourcezoneid != nil
+}
+
+func (p *CopyTemplateParams) ResetSourcezoneid() {
+	p.Sourcezoneid = nil
+}
+
+type CopyTemplateResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateTemplateParams struct {
+	Bits                  *int              `json:"bits,omitempty" yaml:"bits,omitempty"`
+	Details               map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Isdynamicallyscalable *bool             `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isfeatured            *bool             `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool             `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Name                  *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string           `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool             `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Requireshvm           *bool             `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Snapshotid            *string           `json:"snapshotid,omitempty" yaml:"snapshotid,omitempty"`
+	Templatetag           *string           `json:"templatetag,omitempty" yaml:"templatetag,omitempty"`
+	Url                   *string           `json:"url,omitempty" yaml:"url,omitempty"`
+	Virtualmachineid      *string           `json:"virtualmachineid,omitempty" yaml:"virtualmachineid,omitempty"`
+	Volumeid              *string           `json:"volumeid,omitempty" yaml:"volumeid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Bits; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("bits", vv)
+	}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Isdynamicallyscalable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isdynamicallyscalable", vv)
+	}
+	if v := p.Isfeatured; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isfeatured", vv)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
+	}
+	if v := p.Passwordenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("passwordenabled", vv)
+	}
+	if v := p.Requireshvm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("requireshvm", vv)
+	}
+	if v := p.Snapshotid; v != nil {
+		u.Set("snapshotid", *v)
+	}
+	if v := p.Templatetag; v != nil {
+		u.Set("templatetag", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Virtualmachineid; v != nil {
+		u.Set("virtualmachineid", *v)
+	}
+	if v := p.Volumeid; v != nil {
+		u.Set("volumeid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateTemplateParams) Command() string {
+	return "createTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateTemplateParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateTemplateParams) ResponseType() interface{} {
+	return &CreateTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateTemplateParams) Validate() error {
+	v := &validator{api: "createTemplate"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("name", p.Name != nil)
+	v.required("ostypeid", p.Ostypeid != nil)
+	return v.err()
+}
+
+func (p *CreateTemplateParams) SetBits(v int) {
+	p.Bits = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetBits() int {
+	if p.Bits == nil {
+		return 0
+	}
+	return *p.Bits
+}
+
+func (p *CreateTemplateParams) HasBits() bool {
+	return p.Bits != nil
+}
+
+func (p *CreateTemplateParams) ResetBits() {
+	p.Bits = nil
+}
+
+func (p *CreateTemplateParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *CreateTemplateParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *CreateTemplateParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *CreateTemplateParams) ResetDetails() {
+	p.Details = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *CreateTemplateParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *CreateTemplateParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *CreateTemplateParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+func (p *CreateTemplateParams) SetIsdynamicallyscalable(v bool) {
+	p.Isdynamicallyscalable = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetIsdynamicallyscalable() bool {
+	if p.Isdynamicallyscalable == nil {
+		return false
+	}
+	return *p.Isdynamicallyscalable
+}
+
+func (p *CreateTemplateParams) HasIsdynamicallyscalable() bool {
+	return p.Isdynamicallyscalable != nil
+}
+
+func (p *CreateTemplateParams) ResetIsdynamicallyscalable() {
+	p.Isdynamicallyscalable = nil
+}
+
+func (p *CreateTemplateParams) SetIsfeatured(v bool) {
+	p.Isfeatured = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetIsfeatured() bool {
+	if p.Isfeatured == nil {
+		return false
+	}
+	return *p.Isfeatured
+}
+
+func (p *CreateTemplateParams) HasIsfeatured() bool {
+	return p.Isfeatured != nil
+}
+
+func (p *CreateTemplateParams) ResetIsfeatured() {
+	p.Isfeatured = nil
+}
+
+func (p *CreateTemplateParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *CreateTemplateParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *CreateTemplateParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+// This param is required.
+func (p *CreateTemplateParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateTemplateParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateTemplateParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'ostypeid' param: the ID of the OS type. This param is required.
+func (p *CreateTemplateParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
+	}
+	return *p.Ostypeid
+}
+
+func (p *CreateTemplateParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
+
+func (p *CreateTemplateParams) ResetOstypeid() {
+	p.Ostypeid = nil
+}
+
+func (p *CreateTemplateParams) SetPasswordenabled(v bool) {
+	p.Passwordenabled = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetPasswordenabled() bool {
+	if p.Passwordenabled == nil {
+		return false
+	}
+	return *p.Passwordenabled
+}
+
+func (p *CreateTemplateParams) HasPasswordenabled() bool {
+	return p.Passwordenabled != nil
+}
+
+func (p *CreateTemplateParams) ResetPasswordenabled() {
+	p.Passwordenabled = nil
+}
+
+func (p *CreateTemplateParams) SetRequireshvm(v bool) {
+	p.Requireshvm = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetRequireshvm() bool {
+	if p.Requireshvm == nil {
+		return false
+	}
+	return *p.Requireshvm
+}
+
+func (p *CreateTemplateParams) HasRequireshvm() bool {
+	return p.Requireshvm != nil
+}
+
+func (p *CreateTemplateParams) ResetRequireshvm() {
+	p.Requireshvm = nil
+}
+
+func (p *CreateTemplateParams) SetSnapshotid(v string) {
+	p.Snapshotid = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetSnapshotid() string {
+	if p.Snapshotid == nil {
+		return ""
+	}
+	return *p.Snapshotid
+}
+
+func (p *CreateTemplateParams) HasSnapshotid() bool {
+	return p.Snapshotid != nil
+}
+
+func (p *CreateTemplateParams) ResetSnapshotid() {
+	p.Snapshotid = nil
+}
+
+func (p *CreateTemplateParams) SetTemplatetag(v string) {
+	p.Templatetag = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetTemplatetag() string {
+	if p.Templatetag == nil {
+		return ""
+	}
+	return *p.Templatetag
+}
+
+func (p *CreateTemplateParams) HasTemplatetag() bool {
+	return p.Templatetag != nil
+}
+
+func (p *CreateTemplateParams) ResetTemplatetag() {
+	p.Templatetag = nil
+}
+
+func (p *CreateTemplateParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *CreateTemplateParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *CreateTemplateParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'virtualmachineid' param: the ID of the virtual machine.
+func (p *CreateTemplateParams) SetVirtualmachineid(v string) {
+	p.Virtualmachineid = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetVirtualmachineid() string {
+	if p.Virtualmachineid == nil {
+		return ""
+	}
+	return *p.Virtualmachineid
+}
+
+func (p *CreateTemplateParams) HasVirtualmachineid() bool {
+	return p.Virtualmachineid != nil
+}
+
+func (p *CreateTemplateParams) ResetVirtualmachineid() {
+	p.Virtualmachineid = nil
+}
+
+// Sets the 'volumeid' param: the ID of the volume.
+func (p *CreateTemplateParams) SetVolumeid(v string) {
+	p.Volumeid = &v
+	return
+}
+
+func (p *CreateTemplateParams) GetVolumeid() string {
+	if p.Volumeid == nil {
+		return ""
+	}
+	return *p.Volumeid
+}
+
+func (p *CreateTemplateParams) HasVolumeid() bool {
+	return p.Volumeid != nil
+}
+
+func (p *CreateTemplateParams) ResetVolumeid() {
+	p.Volumeid = nil
+}
+
+type CreateTemplateResponse struct {
+	JobID                 string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteTemplateParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteTemplateParams) Command() string {
+	return "deleteTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteTemplateParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteTemplateParams) ResponseType() interface{} {
+	return &DeleteTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteTemplateParams) Validate() error {
+	v := &validator{api: "deleteTemplate"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteTemplateParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteTemplateParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteTemplateParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteTemplateParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *DeleteTemplateParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *DeleteTemplateParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *DeleteTemplateParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *DeleteTemplateParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type DeleteTemplateResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExtractTemplateParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Mode   *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ExtractTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Mode; v != nil {
+		u.Set("mode", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ExtractTemplateParams) Command() string {
+	return "extractTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *ExtractTemplateParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ExtractTemplateParams) ResponseType() interface{} {
+	return &ExtractTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ExtractTemplateParams) Validate() error {
+	v := &validator{api: "extractTemplate"}
+	v.required("id", p.Id != nil)
+	v.required("mode", p.Mode != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ExtractTemplateParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ExtractTemplateParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ExtractTemplateParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ExtractTemplateParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *ExtractTemplateParams) SetMode(v string) {
+	p.Mode = &v
+	return
+}
+
+func (p *ExtractTemplateParams) GetMode() string {
+	if p.Mode == nil {
+		return ""
+	}
+	return *p.Mode
+}
+
+func (p *ExtractTemplateParams) HasMode() bool {
+	return p.Mode != nil
+}
+
+func (p *ExtractTemplateParams) ResetMode() {
+	p.Mode = nil
+}
+
+func (p *ExtractTemplateParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *ExtractTemplateParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *ExtractTemplateParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *ExtractTemplateParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ExtractTemplateParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ExtractTemplateParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ExtractTemplateParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ExtractTemplateParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ExtractTemplateResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid        string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Created          Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	ExtractId        string                     `json:"extractId,omitempty" xml:"extractId,omitempty"`
+	ExtractMode      string                     `json:"extractMode,omitempty" xml:"extractMode,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Resultstring     string                     `json:"resultstring,omitempty" xml:"resultstring,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status           string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storagetype      string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Uploadpercentage int                        `json:"uploadpercentage,omitempty" xml:"uploadpercentage,omitempty"`
+	Url              string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename         string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTemplatesParams struct {
+	Account        *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid       *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Hypervisor     *HypervisorType   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Id             *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive    *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword        *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall        *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name           *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Page           *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid      *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Showremoved    *bool             `json:"showremoved,omitempty" yaml:"showremoved,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Templatefilter *string           `json:"templatefilter,omitempty" yaml:"templatefilter,omitempty"`
+	Zoneid         *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListTemplatesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Showremoved; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("showremoved", vv)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Templatefilter; v != nil {
+		u.Set("templatefilter", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListTemplatesParams) Command() string {
+	return "listTemplates"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListTemplatesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListTemplatesParams) ResponseType() interface{} {
+	return &ListTemplatesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListTemplatesParams) Validate() error {
+	v := &validator{api: "listTemplates"}
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	v.required("templatefilter", p.Templatefilter != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListTemplatesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListTemplatesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListTemplatesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListTemplatesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListTemplatesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListTemplatesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware.
+func (p *ListTemplatesParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *ListTemplatesParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *ListTemplatesParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *ListTemplatesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListTemplatesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListTemplatesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListTemplatesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListTemplatesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListTemplatesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListTemplatesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListTemplatesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListTemplatesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListTemplatesParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListTemplatesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListTemplatesParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListTemplatesParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListTemplatesParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListTemplatesParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListTemplatesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListTemplatesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListTemplatesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListTemplatesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListTemplatesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListTemplatesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListTemplatesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListTemplatesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListTemplatesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListTemplatesParams) SetShowremoved(v bool) {
+	p.Showremoved = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetShowremoved() bool {
+	if p.Showremoved == nil {
+		return false
+	}
+	return *p.Showremoved
+}
+
+func (p *ListTemplatesParams) HasShowremoved() bool {
+	return p.Showremoved != nil
+}
+
+func (p *ListTemplatesParams) ResetShowremoved() {
+	p.Showremoved = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListTemplatesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListTemplatesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListTemplatesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListTemplatesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// This param is required.
+func (p *ListTemplatesParams) SetTemplatefilter(v string) {
+	p.Templatefilter = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetTemplatefilter() string {
+	if p.Templatefilter == nil {
+		return ""
+	}
+	return *p.Templatefilter
+}
+
+func (p *ListTemplatesParams) HasTemplatefilter() bool {
+	return p.Templatefilter != nil
+}
+
+func (p *ListTemplatesParams) ResetTemplatefilter() {
+	p.Templatefilter = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListTemplatesParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListTemplatesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListTemplatesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListTemplatesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListTemplatesResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	Templates []*Template                `json:"template" xml:"template"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Template struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PrepareTemplateParams struct {
+	Templateid *string `json:"templateid,omitempty" yaml:"templateid,omitempty"`
+	Zoneid     *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *PrepareTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Templateid; v != nil {
+		u.Set("templateid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *PrepareTemplateParams) Command() string {
+	return "prepareTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *PrepareTemplateParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *PrepareTemplateParams) ResponseType() interface{} {
+	return &PrepareTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *PrepareTemplateParams) Validate() error {
+	v := &validator{api: "prepareTemplate"}
+	v.required("templateid", p.Templateid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'templateid' param: the ID of the template. This param is required.
+func (p *PrepareTemplateParams) SetTemplateid(v string) {
+	p.Templateid = &v
+	return
+}
+
+func (p *PrepareTemplateParams) GetTemplateid() string {
+	if p.Templateid == nil {
+		return ""
+	}
+	return *p.Templateid
+}
+
+func (p *PrepareTemplateParams) HasTemplateid() bool {
+	return p.Templateid != nil
+}
+
+func (p *PrepareTemplateParams) ResetTemplateid() {
+	p.Templateid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *PrepareTemplateParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *PrepareTemplateParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *PrepareTemplateParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *PrepareTemplateParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type PrepareTemplateResponse struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RegisterTemplateParams struct {
+	Account               *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Bits                  *int              `json:"bits,omitempty" yaml:"bits,omitempty"`
+	Checksum              *string           `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	Details               map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Displaytext           *string           `json:"displaytext,omitempty" yaml:"displaytext,omitempty"`
+	Domainid              *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Format                *string           `json:"format,omitempty" yaml:"format,omitempty"`
+	Hypervisor            *HypervisorType   `json:"hypervisor,omitempty" yaml:"hypervisor,omitempty"`
+	Isdynamicallyscalable *bool             `json:"isdynamicallyscalable,omitempty" yaml:"isdynamicallyscalable,omitempty"`
+	Isextractable         *bool             `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured            *bool             `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic              *bool             `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Isrouting             *bool             `json:"isrouting,omitempty" yaml:"isrouting,omitempty"`
+	Name                  *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Ostypeid              *string           `json:"ostypeid,omitempty" yaml:"ostypeid,omitempty"`
+	Passwordenabled       *bool             `json:"passwordenabled,omitempty" yaml:"passwordenabled,omitempty"`
+	Projectid             *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Requireshvm           *bool             `json:"requireshvm,omitempty" yaml:"requireshvm,omitempty"`
+	Sshkeyenabled         *bool             `json:"sshkeyenabled,omitempty" yaml:"sshkeyenabled,omitempty"`
+	Templatetag           *string           `json:"templatetag,omitempty" yaml:"templatetag,omitempty"`
+	Url                   *string           `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid                *string           `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RegisterTemplateParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Bits; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("bits", vv)
+	}
+	if v := p.Checksum; v != nil {
+		u.Set("checksum", *v)
+	}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Displaytext; v != nil {
+		u.Set("displaytext", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Format; v != nil {
+		u.Set("format", *v)
+	}
+	if v := p.Hypervisor; v != nil {
+		u.Set("hypervisor", string(*v))
+	}
+	if v := p.Isdynamicallyscalable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isdynamicallyscalable", vv)
+	}
+	if v := p.Isextractable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isextractable", vv)
+	}
+	if v := p.Isfeatured; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isfeatured", vv)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Isrouting; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrouting", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Ostypeid; v != nil {
+		u.Set("ostypeid", *v)
+	}
+	if v := p.Passwordenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("passwordenabled", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Requireshvm; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("requireshvm", vv)
+	}
+	if v := p.Sshkeyenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("sshkeyenabled", vv)
+	}
+	if v := p.Templatetag; v != nil {
+		u.Set("templatetag", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RegisterTemplateParams) Command() string {
+	return "registerTemplate"
+}
+
+// Returns true if the API command starts an async job
+func (p *RegisterTemplateParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RegisterTemplateParams) ResponseType() interface{} {
+	return &RegisterTemplateResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RegisterTemplateParams) Validate() error {
+	v := &validator{api: "registerTemplate"}
+	v.required("displaytext", p.Displaytext != nil)
+	v.required("format", p.Format != nil)
+	v.required("hypervisor", p.Hypervisor != nil)
+	if p.Hypervisor != nil {
+		v.enum("hypervisor", string(*p.Hypervisor), p.Hypervisor.Known(), false)
+	}
+	v.required("name", p.Name != nil)
+	v.required("ostypeid", p.Ostypeid != nil)
+	v.required("url", p.Url != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *RegisterTemplateParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *RegisterTemplateParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *RegisterTemplateParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *RegisterTemplateParams) SetBits(v int) {
+	p.Bits = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetBits() int {
+	if p.Bits == nil {
+		return 0
+	}
+	return *p.Bits
+}
+
+func (p *RegisterTemplateParams) HasBits() bool {
+	return p.Bits != nil
+}
+
+func (p *RegisterTemplateParams) ResetBits() {
+	p.Bits = nil
+}
+
+func (p *RegisterTemplateParams) SetChecksum(v string) {
+	p.Checksum = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetChecksum() string {
+	if p.Checksum == nil {
+		return ""
+	}
+	return *p.Checksum
+}
+
+func (p *RegisterTemplateParams) HasChecksum() bool {
+	return p.Checksum != nil
+}
+
+func (p *RegisterTemplateParams) ResetChecksum() {
+	p.Checksum = nil
+}
+
+func (p *RegisterTemplateParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *RegisterTemplateParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *RegisterTemplateParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *RegisterTemplateParams) ResetDetails() {
+	p.Details = nil
+}
+
+// Sets the 'displaytext' param: the display text. This param is required.
+func (p *RegisterTemplateParams) SetDisplaytext(v string) {
+	p.Displaytext = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetDisplaytext() string {
+	if p.Displaytext == nil {
+		return ""
+	}
+	return *p.Displaytext
+}
+
+func (p *RegisterTemplateParams) HasDisplaytext() bool {
+	return p.Displaytext != nil
+}
+
+func (p *RegisterTemplateParams) ResetDisplaytext() {
+	p.Displaytext = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *RegisterTemplateParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *RegisterTemplateParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *RegisterTemplateParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *RegisterTemplateParams) SetFormat(v string) {
+	p.Format = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetFormat() string {
+	if p.Format == nil {
+		return ""
+	}
+	return *p.Format
+}
+
+func (p *RegisterTemplateParams) HasFormat() bool {
+	return p.Format != nil
+}
+
+func (p *RegisterTemplateParams) ResetFormat() {
+	p.Format = nil
+}
+
+// Sets the 'hypervisor' param: the hypervisor, like KVM, XenServer or VMware. This param is required.
+func (p *RegisterTemplateParams) SetHypervisor(v HypervisorType) {
+	p.Hypervisor = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetHypervisor() HypervisorType {
+	if p.Hypervisor == nil {
+		return ""
+	}
+	return *p.Hypervisor
+}
+
+func (p *RegisterTemplateParams) HasHypervisor() bool {
+	return p.Hypervisor != nil
+}
+
+func (p *RegisterTemplateParams) ResetHypervisor() {
+	p.Hypervisor = nil
+}
+
+func (p *RegisterTemplateParams) SetIsdynamicallyscalable(v bool) {
+	p.Isdynamicallyscalable = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetIsdynamicallyscalable() bool {
+	if p.Isdynamicallyscalable == nil {
+		return false
+	}
+	return *p.Isdynamicallyscalable
+}
+
+func (p *RegisterTemplateParams) HasIsdynamicallyscalable() bool {
+	return p.Isdynamicallyscalable != nil
+}
+
+func (p *RegisterTemplateParams) ResetIsdynamicallyscalable() {
+	p.Isdynamicallyscalable = nil
+}
+
+func (p *RegisterTemplateParams) SetIsextractable(v bool) {
+	p.Isextractable = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetIsextractable() bool {
+	if p.Isextractable == nil {
+		return false
+	}
+	return *p.Isextractable
+}
+
+func (p *RegisterTemplateParams) HasIsextractable() bool {
+	return p.Isextractable != nil
+}
+
+func (p *RegisterTemplateParams) ResetIsextractable() {
+	p.Isextractable = nil
+}
+
+func (p *RegisterTemplateParams) SetIsfeatured(v bool) {
+	p.Isfeatured = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetIsfeatured() bool {
+	if p.Isfeatured == nil {
+		return false
+	}
+	return *p.Isfeatured
+}
+
+func (p *RegisterTemplateParams) HasIsfeatured() bool {
+	return p.Isfeatured != nil
+}
+
+func (p *RegisterTemplateParams) ResetIsfeatured() {
+	p.Isfeatured = nil
+}
+
+func (p *RegisterTemplateParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *RegisterTemplateParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *RegisterTemplateParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+func (p *RegisterTemplateParams) SetIsrouting(v bool) {
+	p.Isrouting = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetIsrouting() bool {
+	if p.Isrouting == nil {
+		return false
+	}
+	return *p.Isrouting
+}
+
+func (p *RegisterTemplateParams) HasIsrouting() bool {
+	return p.Isrouting != nil
+}
+
+func (p *RegisterTemplateParams) ResetIsrouting() {
+	p.Isrouting = nil
+}
+
+// This param is required.
+func (p *RegisterTemplateParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *RegisterTemplateParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *RegisterTemplateParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'ostypeid' param: the ID of the OS type. This param is required.
+func (p *RegisterTemplateParams) SetOstypeid(v string) {
+	p.Ostypeid = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetOstypeid() string {
+	if p.Ostypeid == nil {
+		return ""
+	}
+	return *p.Ostypeid
+}
+
+func (p *RegisterTemplateParams) HasOstypeid() bool {
+	return p.Ostypeid != nil
+}
+
+func (p *RegisterTemplateParams) ResetOstypeid() {
+	p.Ostypeid = nil
+}
+
+func (p *RegisterTemplateParams) SetPasswordenabled(v bool) {
+	p.Passwordenabled = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetPasswordenabled() bool {
+	if p.Passwordenabled == nil {
+		return false
+	}
+	return *p.Passwordenabled
+}
+
+func (p *RegisterTemplateParams) HasPasswordenabled() bool {
+	return p.Passwordenabled != nil
+}
+
+func (p *RegisterTemplateParams) ResetPasswordenabled() {
+	p.Passwordenabled = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *RegisterTemplateParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *RegisterTemplateParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *RegisterTemplateParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *RegisterTemplateParams) SetRequireshvm(v bool) {
+	p.Requireshvm = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetRequireshvm() bool {
+	if p.Requireshvm == nil {
+		return false
+	}
+	return *p.Requireshvm
+}
+
+func (p *RegisterTemplateParams) HasRequireshvm() bool {
+	return p.Requireshvm != nil
+}
+
+func (p *RegisterTemplateParams) ResetRequireshvm() {
+	p.Requireshvm = nil
+}
+
+func (p *RegisterTemplateParams) SetSshkeyenabled(v bool) {
+	p.Sshkeyenabled = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetSshkeyenabled() bool {
+	if p.Sshkeyenabled == nil {
+		return false
+	}
+	return *p.Sshkeyenabled
+}
+
+func (p *RegisterTemplateParams) HasSshkeyenabled() bool {
+	return p.Sshkeyenabled != nil
+}
+
+func (p *RegisterTemplateParams) ResetSshkeyenabled() {
+	p.Sshkeyenabled = nil
+}
+
+func (p *RegisterTemplateParams) SetTemplatetag(v string) {
+	p.Templatetag = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetTemplatetag() string {
+	if p.Templatetag == nil {
+		return ""
+	}
+	return *p.Templatetag
+}
+
+func (p *RegisterTemplateParams) HasTemplatetag() bool {
+	return p.Templatetag != nil
+}
+
+func (p *RegisterTemplateParams) ResetTemplatetag() {
+	p.Templatetag = nil
+}
+
+// This param is required.
+func (p *RegisterTemplateParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *RegisterTemplateParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *RegisterTemplateParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *RegisterTemplateParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *RegisterTemplateParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *RegisterTemplateParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *RegisterTemplateParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type RegisterTemplateResponse struct {
+	Count            int                        `json:"count" xml:"count"`
+	RegisterTemplate []*RegisterTemplate        `json:"template" xml:"template"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RegisterTemplate struct {
+	Account               string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid             string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Bootable              Bool                       `json:"bootable,omitempty" xml:"bootable,omitempty"`
+	Checksum              string                     `json:"checksum,omitempty" xml:"checksum,omitempty"`
+	Created               Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	CrossZones            Bool                       `json:"crossZones,omitempty" xml:"crossZones,omitempty"`
+	Details               map[string]string          `json:"details,omitempty" xml:"details,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Format                string                     `json:"format,omitempty" xml:"format,omitempty"`
+	Hostid                string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Hostname              string                     `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	Hypervisor            HypervisorType             `json:"hypervisor,omitempty" xml:"hypervisor,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Isdynamicallyscalable Bool                       `json:"isdynamicallyscalable,omitempty" xml:"isdynamicallyscalable,omitempty"`
+	Isextractable         Bool                       `json:"isextractable,omitempty" xml:"isextractable,omitempty"`
+	Isfeatured            Bool                       `json:"isfeatured,omitempty" xml:"isfeatured,omitempty"`
+	Ispublic              Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Isready               Bool                       `json:"isready,omitempty" xml:"isready,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Ostypeid              string                     `json:"ostypeid,omitempty" xml:"ostypeid,omitempty"`
+	Ostypename            string                     `json:"ostypename,omitempty" xml:"ostypename,omitempty"`
+	Passwordenabled       Bool                       `json:"passwordenabled,omitempty" xml:"passwordenabled,omitempty"`
+	Project               string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid             string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed               Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Size                  Size                       `json:"size,omitempty" xml:"size,omitempty"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid,omitempty" xml:"sourcetemplateid,omitempty"`
+	Sshkeyenabled         Bool                       `json:"sshkeyenabled,omitempty" xml:"sshkeyenabled,omitempty"`
+	Status                string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Templatetag           string                     `json:"templatetag,omitempty" xml:"templatetag,omitempty"`
+	Templatetype          string                     `json:"templatetype,omitempty" xml:"templatetype,omitempty"`
+	Zoneid                string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename              string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTemplatePermissionsParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListTemplatePermissionsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListTemplatePermissionsParams) Command() string {
+	return "listTemplatePermissions"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListTemplatePermissionsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListTemplatePermissionsParams) ResponseType() interface{} {
+	return &ListTemplatePermissionsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListTemplatePermissionsParams) Validate() error {
+	v := &validator{api: "listTemplatePermissions"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ListTemplatePermissionsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListTemplatePermissionsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListTemplatePermissionsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListTemplatePermissionsParams) ResetId() {
+	p.Id = nil
+}
+
+type ListTemplatePermissionsResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	TemplatePermissions []*TemplatePermission      `json:"templatepermission" xml:"templatepermission"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type TemplatePermission struct {
+	Account    []string                   `json:"account,omitempty" xml:"account,omitempty"`
+	Domainid   string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ispublic   Bool                       `json:"ispublic,omitempty" xml:"ispublic,omitempty"`
+	Projectids []string                   `json:"projectids,omitempty" xml:"projectids,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateTemplatePermissionsParams struct {
+	Accounts      []string `json:"accounts,omitempty" yaml:"accounts,omitempty"`
+	Id            *string  `json:"id,omitempty" yaml:"id,omitempty"`
+	Isextractable *bool    `json:"isextractable,omitempty" yaml:"isextractable,omitempty"`
+	Isfeatured    *bool    `json:"isfeatured,omitempty" yaml:"isfeatured,omitempty"`
+	Ispublic      *bool    `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Op            *string  `json:"op,omitempty" yaml:"op,omitempty"`
+	Projectids    []string `json:"projectids,omitempty" yaml:"projectids,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateTemplatePermissionsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Accounts; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("accounts", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isextractable; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isextractable", vv)
+	}
+	if v := p.Isfeatured; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isfeatured", vv)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Op; v != nil {
+		u.Set("op", *v)
+	}
+	if v := p.Projectids; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("projectids", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateTemplatePermissionsParams) Command() string {
+	return "updateTemplatePermissions"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateTemplatePermissionsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateTemplatePermissionsParams) ResponseType() interface{} {
+	return &UpdateTemplatePermissionsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateTemplatePermissionsParams) Validate() error {
+	v := &validator{api: "updateTemplatePermissions"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
+	p.Accounts = v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetAccounts() []string {
+	return p.Accounts
+}
+
+func (p *UpdateTemplatePermissionsParams) HasAccounts() bool {
+	return p.Accounts != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetAccounts() {
+	p.Accounts = nil
+}
+
+// This param is required.
+func (p *UpdateTemplatePermissionsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateTemplatePermissionsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateTemplatePermissionsParams) SetIsextractable(v bool) {
+	p.Isextractable = &v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetIsextractable() bool {
+	if p.Isextractable == nil {
+		return false
+	}
+	return *p.Isextractable
+}
+
+func (p *UpdateTemplatePermissionsParams) HasIsextractable() bool {
+	return p.Isextractable != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetIsextractable() {
+	p.Isextractable = nil
+}
+
+func (p *UpdateTemplatePermissionsParams) SetIsfeatured(v bool) {
+	p.Isfeatured = &v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetIsfeatured() bool {
+	if p.Isfeatured == nil {
+		return false
+	}
+	return *p.Isfeatured
+}
+
+func (p *UpdateTemplatePermissionsParams) HasIsfeatured() bool {
+	return p.Isfeatured != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetIsfeatured() {
+	p.Isfeatured = nil
+}
+
+func (p *UpdateTemplatePermissionsParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *UpdateTemplatePermissionsParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+func (p *UpdateTemplatePermissionsParams) SetOp(v string) {
+	p.Op = &v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetOp() string {
+	if p.Op == nil {
+		return ""
+	}
+	return *p.Op
+}
+
+func (p *UpdateTemplatePermissionsParams) HasOp() bool {
+	return p.Op != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetOp() {
+	p.Op = nil
+}
+
+func (p *UpdateTemplatePermissionsParams) SetProjectids(v []string) {
+	p.Projectids = v
+	return
+}
+
+func (p *UpdateTemplatePermissionsParams) GetProjectids() []string {
+	return p.Projectids
+}
+
+func (p *UpdateTemplatePermissionsParams) HasProjectids() bool {
+	return p.Projectids != nil
+}
+
+func (p *UpdateTemplatePermissionsParams) ResetProjectids() {
+	p.Projectids = nil
+}
+
+type UpdateTemplatePermissionsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the TemplateService that is the same for all versions
+type TemplateService interface {
+	NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams
+	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	NewCopyTemplateParams(destzoneid string, id string) *CopyTemplateParams
+	CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams
+	CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	NewDeleteTemplateParams(id string) *DeleteTemplateParams
+	DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams
+	ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	NewListTemplatesParams(templatefilter string) *ListTemplatesParams
+	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, error)
+	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplatesByTags(tags map[string]string, templatefilter string, opts ...OptionFunc) ([]*Template, error)
+	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	StreamTemplates(ctx context.Context, p *ListTemplatesParams, fn func(*Template) error) (int, error)
+	NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams
+	PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	NewRegisterTemplateParams(displaytext string, format string, hypervisor HypervisorType, name string, ostypeid string, url string, zoneid string) *RegisterTemplateParams
+	RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams
+	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	StreamTemplatePermissions(ctx context.Context, p *ListTemplatePermissionsParams, fn func(*TemplatePermission) error) (int, error)
+	NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams
+	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+}
@@ -0,0 +1,795 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListUcsBladesParams struct {
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUcsBladesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListUcsBladesParams) Command() string {
+	return "listUcsBlades"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUcsBladesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUcsBladesParams) ResponseType() interface{} {
+	return &ListUcsBladesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUcsBladesParams) Validate() error {
+	v := &validator{api: "listUcsBlades"}
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListUcsBladesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListUcsBladesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListUcsBladesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListUcsBladesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListUcsBladesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListUcsBladesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListUcsBladesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListUcsBladesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListUcsBladesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListUcsBladesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListUcsBladesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListUcsBladesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// This param is required.
+func (p *ListUcsBladesParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
+	return
+}
+
+func (p *ListUcsBladesParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
+	}
+	return *p.Ucsmanagerid
+}
+
+func (p *ListUcsBladesParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *ListUcsBladesParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+type ListUcsBladesResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	UcsBlades []*UcsBlade                `json:"ucsblade" xml:"ucsblade"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UcsBlade struct {
+	Bladedn      string                     `json:"bladedn,omitempty" xml:"bladedn,omitempty"`
+	Hostid       string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Profiledn    string                     `json:"profiledn,omitempty" xml:"profiledn,omitempty"`
+	Ucsmanagerid string                     `json:"ucsmanagerid,omitempty" xml:"ucsmanagerid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddUcsManagerParams struct {
+	Name     *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Password *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Url      *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Username *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddUcsManagerParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddUcsManagerParams) Command() string {
+	return "addUcsManager"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddUcsManagerParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddUcsManagerParams) ResponseType() interface{} {
+	return &AddUcsManagerResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddUcsManagerParams) Validate() error {
+	v := &validator{api: "addUcsManager"}
+	v.required("password", p.Password != nil)
+	v.required("url", p.Url != nil)
+	v.required("username", p.Username != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+func (p *AddUcsManagerParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *AddUcsManagerParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *AddUcsManagerParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *AddUcsManagerParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddUcsManagerParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddUcsManagerParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddUcsManagerParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddUcsManagerParams) ResetPassword() {
+	p.Password = nil
+}
+
+// This param is required.
+func (p *AddUcsManagerParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddUcsManagerParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddUcsManagerParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddUcsManagerParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddUcsManagerParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddUcsManagerParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddUcsManagerParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddUcsManagerParams) ResetUsername() {
+	p.Username = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddUcsManagerParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddUcsManagerParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddUcsManagerParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddUcsManagerParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type AddUcsManagerResponse struct {
+	Id     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Url    string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListUcsManagersParams struct {
+	Id       *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUcsManagersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListUcsManagersParams) Command() string {
+	return "listUcsManagers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUcsManagersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUcsManagersParams) ResponseType() interface{} {
+	return &ListUcsManagersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUcsManagersParams) Validate() error {
+	v := &validator{api: "listUcsManagers"}
+	return v.err()
+}
+
+func (p *ListUcsManagersParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListUcsManagersParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListUcsManagersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListUcsManagersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListUcsManagersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListUcsManagersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListUcsManagersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListUcsManagersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListUcsManagersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListUcsManagersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListUcsManagersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListUcsManagersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListUcsManagersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListUcsManagersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListUcsManagersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListUcsManagersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListUcsManagersParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListUcsManagersParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListUcsManagersParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListUcsManagersParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListUcsManagersResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	UcsManagers []*UcsManager              `json:"ucsmanager" xml:"ucsmanager"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UcsManager struct {
+	Id     string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name   string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Url    string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListUcsProfilesParams struct {
+	Keyword      *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page         *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize     *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUcsProfilesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListUcsProfilesParams) Command() string {
+	return "listUcsProfiles"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUcsProfilesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUcsProfilesParams) ResponseType() interface{} {
+	return &ListUcsProfilesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUcsProfilesParams) Validate() error {
+	v := &validator{api: "listUcsProfiles"}
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListUcsProfilesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListUcsProfilesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListUcsProfilesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListUcsProfilesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListUcsProfilesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListUcsProfilesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListUcsProfilesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListUcsProfilesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListUcsProfilesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListUcsProfilesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListUcsProfilesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListUcsProfilesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// This param is required.
+func (p *ListUcsProfilesParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
+	return
+}
+
+func (p *ListUcsProfilesParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
+	}
+	return *p.Ucsmanagerid
+}
+
+func (p *ListUcsProfilesParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *ListUcsProfilesParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+type ListUcsProfilesResponse struct {
+	Count       int                        `json:"count" xml:"count"`
+	UcsProfiles []*UcsProfile              `json:"ucsprofile" xml:"ucsprofile"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UcsProfile struct {
+	Ucsdn string                     `json:"ucsdn,omitempty" xml:"ucsdn,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AssociateUcsProfileToBladeParams struct {
+	Bladeid      *string `json:"bladeid,omitempty" yaml:"bladeid,omitempty"`
+	Profiledn    *string `json:"profiledn,omitempty" yaml:"profiledn,omitempty"`
+	Ucsmanagerid *string `json:"ucsmanagerid,omitempty" yaml:"ucsmanagerid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AssociateUcsProfileToBladeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Bladeid; v != nil {
+		u.Set("bladeid", *v)
+	}
+	if v := p.Profiledn; v != nil {
+		u.Set("profiledn", *v)
+	}
+	if v := p.Ucsmanagerid; v != nil {
+		u.Set("ucsmanagerid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AssociateUcsProfileToBladeParams) Command() string {
+	return "associateUcsProfileToBlade"
+}
+
+// Returns true if the API command starts an async job
+func (p *AssociateUcsProfileToBladeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AssociateUcsProfileToBladeParams) ResponseType() interface{} {
+	return &AssociateUcsProfileToBladeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AssociateUcsProfileToBladeParams) Validate() error {
+	v := &validator{api: "associateUcsProfileToBlade"}
+	v.required("bladeid", p.Bladeid != nil)
+	v.required("profiledn", p.Profiledn != nil)
+	v.required("ucsmanagerid", p.Ucsmanagerid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
+	p.Bladeid = &v
+	return
+}
+
+func (p *AssociateUcsProfileToBladeParams) GetBladeid() string {
+	if p.Bladeid == nil {
+		return ""
+	}
+	return *p.Bladeid
+}
+
+func (p *AssociateUcsProfileToBladeParams) HasBladeid() bool {
+	return p.Bladeid != nil
+}
+
+func (p *AssociateUcsProfileToBladeParams) ResetBladeid() {
+	p.Bladeid = nil
+}
+
+// This param is required.
+func (p *AssociateUcsProfileToBladeParams) SetProfiledn(v string) {
+	p.Profiledn = &v
+	return
+}
+
+func (p *AssociateUcsProfileToBladeParams) GetProfiledn() string {
+	if p.Profiledn == nil {
+		return ""
+	}
+	return *p.Profiledn
+}
+
+func (p *AssociateUcsProfileToBladeParams) HasProfiledn() bool {
+	return p.Profiledn != nil
+}
+
+func (p *AssociateUcsProfileToBladeParams) ResetProfiledn() {
+	p.Profiledn = nil
+}
+
+// This param is required.
+func (p *AssociateUcsProfileToBladeParams) SetUcsmanagerid(v string) {
+	p.Ucsmanagerid = &v
+	return
+}
+
+func (p *AssociateUcsProfileToBladeParams) GetUcsmanagerid() string {
+	if p.Ucsmanagerid == nil {
+		return ""
+	}
+	return *p.Ucsmanagerid
+}
+
+func (p *AssociateUcsProfileToBladeParams) HasUcsmanagerid() bool {
+	return p.Ucsmanagerid != nil
+}
+
+func (p *AssociateUcsProfileToBladeParams) ResetUcsmanagerid() {
+	p.Ucsmanagerid = nil
+}
+
+type AssociateUcsProfileToBladeResponse struct {
+	JobID        string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Bladedn      string                     `json:"bladedn,omitempty" xml:"bladedn,omitempty"`
+	Hostid       string                     `json:"hostid,omitempty" xml:"hostid,omitempty"`
+	Id           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Profiledn    string                     `json:"profiledn,omitempty" xml:"profiledn,omitempty"`
+	Ucsmanagerid string                     `json:"ucsmanagerid,omitempty" xml:"ucsmanagerid,omitempty"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the UCSService that is the same for all versions
+type UCSService interface {
+	NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams
+	ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	StreamUcsBlades(ctx context.Context, p *ListUcsBladesParams, fn func(*UcsBlade) error) (int, error)
+	NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams
+	AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
+	NewListUcsManagersParams() *ListUcsManagersParams
+	GetUcsManagerID(keyword string, opts ...OptionFunc) (string, error)
+	GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error)
+	ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	StreamUcsManagers(ctx context.Context, p *ListUcsManagersParams, fn func(*UcsManager) error) (int, error)
+	NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams
+	ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	StreamUcsProfiles(ctx context.Context, p *ListUcsProfilesParams, fn func(*UcsProfile) error) (int, error)
+	NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams
+	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+}
@@ -0,0 +1,196 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListUcsBlades(t *testing.T) {
+	params := url.Values{
+		"keyword":      {"test-keyword"},
+		"page":         {"2"},
+		"pagesize":     {"3"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+
+	p := &ListUcsBladesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listUcsBlades", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsBlades" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListUcsBladesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listUcsBlades", `{"listucsbladesresponse":{"count":1,"ucsblade":[{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}]}}`, false, r)
+	if r.UcsBlades[0].Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.UcsBlades[0].Bladedn to be %v, got %v", "test-bladedn", r.UcsBlades[0].Bladedn)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestAddUcsManager(t *testing.T) {
+	params := url.Values{
+		"name":     {"test-name"},
+		"password": {"test-password"},
+		"url":      {"test-url"},
+		"username": {"test-username"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &AddUcsManagerParams{}
+	p.SetName("test-name")
+	p.SetPassword("test-password")
+	p.SetUrl("test-url")
+	p.SetUsername("test-username")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addUcsManager", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addUcsManager" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddUcsManagerResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addUcsManager", `{"adducsmanagerresponse":{"id":"test-id","name":"test-name","url":"test-url","zoneid":"test-zoneid"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestListUcsManagers(t *testing.T) {
+	params := url.Values{
+		"id":       {"test-id"},
+		"keyword":  {"test-keyword"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &ListUcsManagersParams{}
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listUcsManagers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsManagers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListUcsManagersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listUcsManagers", `{"listucsmanagersresponse":{"count":1,"ucsmanager":[{"id":"test-id","name":"test-name","url":"test-url","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.UcsManagers[0].Id != "test-id" {
+		t.Errorf("Expected r.UcsManagers[0].Id to be %v, got %v", "test-id", r.UcsManagers[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestListUcsProfiles(t *testing.T) {
+	params := url.Values{
+		"keyword":      {"test-keyword"},
+		"page":         {"2"},
+		"pagesize":     {"3"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+
+	p := &ListUcsProfilesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listUcsProfiles", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listUcsProfiles" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListUcsProfilesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listUcsProfiles", `{"listucsprofilesresponse":{"count":1,"ucsprofile":[{"ucsdn":"test-ucsdn"}]}}`, false, r)
+	if r.UcsProfiles[0].Ucsdn != "test-ucsdn" {
+		t.Errorf("Expected r.UcsProfiles[0].Ucsdn to be %v, got %v", "test-ucsdn", r.UcsProfiles[0].Ucsdn)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestAssociateUcsProfileToBlade(t *testing.T) {
+	params := url.Values{
+		"bladeid":      {"test-bladeid"},
+		"profiledn":    {"test-profiledn"},
+		"ucsmanagerid": {"test-ucsmanagerid"},
+	}
+
+	p := &AssociateUcsProfileToBladeParams{}
+	p.SetBladeid("test-bladeid")
+	p.SetProfiledn("test-profiledn")
+	p.SetUcsmanagerid("test-ucsmanagerid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "associateUcsProfileToBlade", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "associateUcsProfileToBlade" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AssociateUcsProfileToBladeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "associateUcsProfileToBlade", `{"associateucsprofiletobladeresponse":{"bladedn":"test-bladedn","hostid":"test-hostid","id":"test-id","jobid":"test-jobid","profiledn":"test-profiledn","ucsmanagerid":"test-ucsmanagerid"}}`, false, r)
+	if r.Bladedn != "test-bladedn" {
+		t.Errorf("Expected r.Bladedn to be %v, got %v", "test-bladedn", r.Bladedn)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
@@ -0,0 +1,1331 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type AddTrafficMonitorParams struct {
+	Excludezones *string `json:"excludezones,omitempty" yaml:"excludezones,omitempty"`
+	Includezones *string `json:"includezones,omitempty" yaml:"includezones,omitempty"`
+	Url          *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid       *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddTrafficMonitorParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Excludezones; v != nil {
+		u.Set("excludezones", *v)
+	}
+	if v := p.Includezones; v != nil {
+		u.Set("includezones", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddTrafficMonitorParams) Command() string {
+	return "addTrafficMonitor"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddTrafficMonitorParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddTrafficMonitorParams) ResponseType() interface{} {
+	return &AddTrafficMonitorResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddTrafficMonitorParams) Validate() error {
+	v := &validator{api: "addTrafficMonitor"}
+	v.required("url", p.Url != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
+	p.Excludezones = &v
+	return
+}
+
+func (p *AddTrafficMonitorParams) GetExcludezones() string {
+	if p.Excludezones == nil {
+		return ""
+	}
+	return *p.Excludezones
+}
+
+func (p *AddTrafficMonitorParams) HasExcludezones() bool {
+	return p.Excludezones != nil
+}
+
+func (p *AddTrafficMonitorParams) ResetExcludezones() {
+	p.Excludezones = nil
+}
+
+func (p *AddTrafficMonitorParams) SetIncludezones(v string) {
+	p.Includezones = &v
+	return
+}
+
+func (p *AddTrafficMonitorParams) GetIncludezones() string {
+	if p.Includezones == nil {
+		return ""
+	}
+	return *p.Includezones
+}
+
+func (p *AddTrafficMonitorParams) HasIncludezones() bool {
+	return p.Includezones != nil
+}
+
+func (p *AddTrafficMonitorParams) ResetIncludezones() {
+	p.Includezones = nil
+}
+
+// This param is required.
+func (p *AddTrafficMonitorParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *AddTrafficMonitorParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *AddTrafficMonitorParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *AddTrafficMonitorParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *AddTrafficMonitorParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *AddTrafficMonitorParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *AddTrafficMonitorParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *AddTrafficMonitorParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type AddTrafficMonitorResponse struct {
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress  string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Timeout    string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Zoneid     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteTrafficMonitorParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteTrafficMonitorParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteTrafficMonitorParams) Command() string {
+	return "deleteTrafficMonitor"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteTrafficMonitorParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteTrafficMonitorParams) ResponseType() interface{} {
+	return &DeleteTrafficMonitorResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteTrafficMonitorParams) Validate() error {
+	v := &validator{api: "deleteTrafficMonitor"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteTrafficMonitorParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteTrafficMonitorParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteTrafficMonitorParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteTrafficMonitorParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteTrafficMonitorResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTrafficMonitorsParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListTrafficMonitorsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListTrafficMonitorsParams) Command() string {
+	return "listTrafficMonitors"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListTrafficMonitorsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListTrafficMonitorsParams) ResponseType() interface{} {
+	return &ListTrafficMonitorsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListTrafficMonitorsParams) Validate() error {
+	v := &validator{api: "listTrafficMonitors"}
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListTrafficMonitorsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListTrafficMonitorsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListTrafficMonitorsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListTrafficMonitorsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListTrafficMonitorsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListTrafficMonitorsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListTrafficMonitorsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListTrafficMonitorsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListTrafficMonitorsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListTrafficMonitorsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListTrafficMonitorsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *ListTrafficMonitorsParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListTrafficMonitorsParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListTrafficMonitorsParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListTrafficMonitorsParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListTrafficMonitorsResponse struct {
+	Count           int                        `json:"count" xml:"count"`
+	TrafficMonitors []*TrafficMonitor          `json:"trafficmonitor" xml:"trafficmonitor"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type TrafficMonitor struct {
+	Id         string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress  string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Numretries string                     `json:"numretries,omitempty" xml:"numretries,omitempty"`
+	Timeout    string                     `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	Zoneid     string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddTrafficTypeParams struct {
+	Hypervnetworklabel *string             `json:"hypervnetworklabel,omitempty" yaml:"hypervnetworklabel,omitempty"`
+	Isolationmethod    *string             `json:"isolationmethod,omitempty" yaml:"isolationmethod,omitempty"`
+	Kvmnetworklabel    *string             `json:"kvmnetworklabel,omitempty" yaml:"kvmnetworklabel,omitempty"`
+	Physicalnetworkid  *string             `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Traffictype        *NetworkTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+	Vlan               *string             `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vmwarenetworklabel *string             `json:"vmwarenetworklabel,omitempty" yaml:"vmwarenetworklabel,omitempty"`
+	Xennetworklabel    *string             `json:"xennetworklabel,omitempty" yaml:"xennetworklabel,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddTrafficTypeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hypervnetworklabel; v != nil {
+		u.Set("hypervnetworklabel", *v)
+	}
+	if v := p.Isolationmethod; v != nil {
+		u.Set("isolationmethod", *v)
+	}
+	if v := p.Kvmnetworklabel; v != nil {
+		u.Set("kvmnetworklabel", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	if v := p.Vmwarenetworklabel; v != nil {
+		u.Set("vmwarenetworklabel", *v)
+	}
+	if v := p.Xennetworklabel; v != nil {
+		u.Set("xennetworklabel", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddTrafficTypeParams) Command() string {
+	return "addTrafficType"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddTrafficTypeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddTrafficTypeParams) ResponseType() interface{} {
+	return &AddTrafficTypeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddTrafficTypeParams) Validate() error {
+	v := &validator{api: "addTrafficType"}
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("traffictype", p.Traffictype != nil)
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
+	}
+	return v.err()
+}
+
+func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
+	p.Hypervnetworklabel = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetHypervnetworklabel() string {
+	if p.Hypervnetworklabel == nil {
+		return ""
+	}
+	return *p.Hypervnetworklabel
+}
+
+func (p *AddTrafficTypeParams) HasHypervnetworklabel() bool {
+	return p.Hypervnetworklabel != nil
+}
+
+func (p *AddTrafficTypeParams) ResetHypervnetworklabel() {
+	p.Hypervnetworklabel = nil
+}
+
+func (p *AddTrafficTypeParams) SetIsolationmethod(v string) {
+	p.Isolationmethod = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetIsolationmethod() string {
+	if p.Isolationmethod == nil {
+		return ""
+	}
+	return *p.Isolationmethod
+}
+
+func (p *AddTrafficTypeParams) HasIsolationmethod() bool {
+	return p.Isolationmethod != nil
+}
+
+func (p *AddTrafficTypeParams) ResetIsolationmethod() {
+	p.Isolationmethod = nil
+}
+
+func (p *AddTrafficTypeParams) SetKvmnetworklabel(v string) {
+	p.Kvmnetworklabel = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetKvmnetworklabel() string {
+	if p.Kvmnetworklabel == nil {
+		return ""
+	}
+	return *p.Kvmnetworklabel
+}
+
+func (p *AddTrafficTypeParams) HasKvmnetworklabel() bool {
+	return p.Kvmnetworklabel != nil
+}
+
+func (p *AddTrafficTypeParams) ResetKvmnetworklabel() {
+	p.Kvmnetworklabel = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *AddTrafficTypeParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *AddTrafficTypeParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *AddTrafficTypeParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// This param is required.
+func (p *AddTrafficTypeParams) SetTraffictype(v NetworkTrafficType) {
+	p.Traffictype = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetTraffictype() NetworkTrafficType {
+	if p.Traffictype == nil {
+		return ""
+	}
+	return *p.Traffictype
+}
+
+func (p *AddTrafficTypeParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *AddTrafficTypeParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+func (p *AddTrafficTypeParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *AddTrafficTypeParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *AddTrafficTypeParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+func (p *AddTrafficTypeParams) SetVmwarenetworklabel(v string) {
+	p.Vmwarenetworklabel = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetVmwarenetworklabel() string {
+	if p.Vmwarenetworklabel == nil {
+		return ""
+	}
+	return *p.Vmwarenetworklabel
+}
+
+func (p *AddTrafficTypeParams) HasVmwarenetworklabel() bool {
+	return p.Vmwarenetworklabel != nil
+}
+
+func (p *AddTrafficTypeParams) ResetVmwarenetworklabel() {
+	p.Vmwarenetworklabel = nil
+}
+
+func (p *AddTrafficTypeParams) SetXennetworklabel(v string) {
+	p.Xennetworklabel = &v
+	return
+}
+
+func (p *AddTrafficTypeParams) GetXennetworklabel() string {
+	if p.Xennetworklabel == nil {
+		return ""
+	}
+	return *p.Xennetworklabel
+}
+
+func (p *AddTrafficTypeParams) HasXennetworklabel() bool {
+	return p.Xennetworklabel != nil
+}
+
+func (p *AddTrafficTypeParams) ResetXennetworklabel() {
+	p.Xennetworklabel = nil
+}
+
+type AddTrafficTypeResponse struct {
+	JobID              string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Hypervnetworklabel string                     `json:"hypervnetworklabel,omitempty" xml:"hypervnetworklabel,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Kvmnetworklabel    string                     `json:"kvmnetworklabel,omitempty" xml:"kvmnetworklabel,omitempty"`
+	Physicalnetworkid  string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Traffictype        NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Vmwarenetworklabel string                     `json:"vmwarenetworklabel,omitempty" xml:"vmwarenetworklabel,omitempty"`
+	Xennetworklabel    string                     `json:"xennetworklabel,omitempty" xml:"xennetworklabel,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteTrafficTypeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteTrafficTypeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteTrafficTypeParams) Command() string {
+	return "deleteTrafficType"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteTrafficTypeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteTrafficTypeParams) ResponseType() interface{} {
+	return &DeleteTrafficTypeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteTrafficTypeParams) Validate() error {
+	v := &validator{api: "deleteTrafficType"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteTrafficTypeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteTrafficTypeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteTrafficTypeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteTrafficTypeParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteTrafficTypeResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTrafficTypesParams struct {
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListTrafficTypesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListTrafficTypesParams) Command() string {
+	return "listTrafficTypes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListTrafficTypesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListTrafficTypesParams) ResponseType() interface{} {
+	return &ListTrafficTypesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListTrafficTypesParams) Validate() error {
+	v := &validator{api: "listTrafficTypes"}
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListTrafficTypesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListTrafficTypesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListTrafficTypesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListTrafficTypesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListTrafficTypesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListTrafficTypesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListTrafficTypesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListTrafficTypesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListTrafficTypesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListTrafficTypesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListTrafficTypesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListTrafficTypesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *ListTrafficTypesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListTrafficTypesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListTrafficTypesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListTrafficTypesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+type ListTrafficTypesResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	TrafficTypes []*TrafficType             `json:"traffictype" xml:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type TrafficType struct {
+	Canenableindividualservice   Bool                       `json:"canenableindividualservice,omitempty" xml:"canenableindividualservice,omitempty"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid,omitempty" xml:"destinationphysicalnetworkid,omitempty"`
+	Id                           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Servicelist                  []string                   `json:"servicelist,omitempty" xml:"servicelist,omitempty"`
+	State                        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateTrafficTypeParams struct {
+	Hypervnetworklabel *string `json:"hypervnetworklabel,omitempty" yaml:"hypervnetworklabel,omitempty"`
+	Id                 *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Kvmnetworklabel    *string `json:"kvmnetworklabel,omitempty" yaml:"kvmnetworklabel,omitempty"`
+	Vmwarenetworklabel *string `json:"vmwarenetworklabel,omitempty" yaml:"vmwarenetworklabel,omitempty"`
+	Xennetworklabel    *string `json:"xennetworklabel,omitempty" yaml:"xennetworklabel,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateTrafficTypeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Hypervnetworklabel; v != nil {
+		u.Set("hypervnetworklabel", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Kvmnetworklabel; v != nil {
+		u.Set("kvmnetworklabel", *v)
+	}
+	if v := p.Vmwarenetworklabel; v != nil {
+		u.Set("vmwarenetworklabel", *v)
+	}
+	if v := p.Xennetworklabel; v != nil {
+		u.Set("xennetworklabel", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateTrafficTypeParams) Command() string {
+	return "updateTrafficType"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateTrafficTypeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateTrafficTypeParams) ResponseType() interface{} {
+	return &UpdateTrafficTypeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateTrafficTypeParams) Validate() error {
+	v := &validator{api: "updateTrafficType"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
+	p.Hypervnetworklabel = &v
+	return
+}
+
+func (p *UpdateTrafficTypeParams) GetHypervnetworklabel() string {
+	if p.Hypervnetworklabel == nil {
+		return ""
+	}
+	return *p.Hypervnetworklabel
+}
+
+func (p *UpdateTrafficTypeParams) HasHypervnetworklabel() bool {
+	return p.Hypervnetworklabel != nil
+}
+
+func (p *UpdateTrafficTypeParams) ResetHypervnetworklabel() {
+	p.Hypervnetworklabel = nil
+}
+
+// This param is required.
+func (p *UpdateTrafficTypeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateTrafficTypeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateTrafficTypeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateTrafficTypeParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateTrafficTypeParams) SetKvmnetworklabel(v string) {
+	p.Kvmnetworklabel = &v
+	return
+}
+
+func (p *UpdateTrafficTypeParams) GetKvmnetworklabel() string {
+	if p.Kvmnetworklabel == nil {
+		return ""
+	}
+	return *p.Kvmnetworklabel
+}
+
+func (p *UpdateTrafficTypeParams) HasKvmnetworklabel() bool {
+	return p.Kvmnetworklabel != nil
+}
+
+func (p *UpdateTrafficTypeParams) ResetKvmnetworklabel() {
+	p.Kvmnetworklabel = nil
+}
+
+func (p *UpdateTrafficTypeParams) SetVmwarenetworklabel(v string) {
+	p.Vmwarenetworklabel = &v
+	return
+}
+
+func (p *UpdateTrafficTypeParams) GetVmwarenetworklabel() string {
+	if p.Vmwarenetworklabel == nil {
+		return ""
+	}
+	return *p.Vmwarenetworklabel
+}
+
+func (p *UpdateTrafficTypeParams) HasVmwarenetworklabel() bool {
+	return p.Vmwarenetworklabel != nil
+}
+
+func (p *UpdateTrafficTypeParams) ResetVmwarenetworklabel() {
+	p.Vmwarenetworklabel = nil
+}
+
+func (p *UpdateTrafficTypeParams) SetXennetworklabel(v string) {
+	p.Xennetworklabel = &v
+	return
+}
+
+func (p *UpdateTrafficTypeParams) GetXennetworklabel() string {
+	if p.Xennetworklabel == nil {
+		return ""
+	}
+	return *p.Xennetworklabel
+}
+
+func (p *UpdateTrafficTypeParams) HasXennetworklabel() bool {
+	return p.Xennetworklabel != nil
+}
+
+func (p *UpdateTrafficTypeParams) ResetXennetworklabel() {
+	p.Xennetworklabel = nil
+}
+
+type UpdateTrafficTypeResponse struct {
+	JobID              string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Hypervnetworklabel string                     `json:"hypervnetworklabel,omitempty" xml:"hypervnetworklabel,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Kvmnetworklabel    string                     `json:"kvmnetworklabel,omitempty" xml:"kvmnetworklabel,omitempty"`
+	Physicalnetworkid  string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Traffictype        NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Vmwarenetworklabel string                     `json:"vmwarenetworklabel,omitempty" xml:"vmwarenetworklabel,omitempty"`
+	Xennetworklabel    string                     `json:"xennetworklabel,omitempty" xml:"xennetworklabel,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListTrafficTypeImplementorsParams struct {
+	Keyword     *string             `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page        *int                `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int                `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Traffictype *NetworkTrafficType `json:"traffictype,omitempty" yaml:"traffictype,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListTrafficTypeImplementorsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Traffictype; v != nil {
+		u.Set("traffictype", string(*v))
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListTrafficTypeImplementorsParams) Command() string {
+	return "listTrafficTypeImplementors"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListTrafficTypeImplementorsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListTrafficTypeImplementorsParams) ResponseType() interface{} {
+	return &ListTrafficTypeImplementorsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListTrafficTypeImplementorsParams) Validate() error {
+	v := &validator{api: "listTrafficTypeImplementors"}
+	if p.Traffictype != nil {
+		v.enum("traffictype", string(*p.Traffictype), p.Traffictype.Known(), false)
+	}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListTrafficTypeImplementorsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListTrafficTypeImplementorsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListTrafficTypeImplementorsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListTrafficTypeImplementorsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListTrafficTypeImplementorsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListTrafficTypeImplementorsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListTrafficTypeImplementorsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListTrafficTypeImplementorsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListTrafficTypeImplementorsParams) SetTraffictype(v NetworkTrafficType) {
+	p.Traffictype = &v
+	return
+}
+
+func (p *ListTrafficTypeImplementorsParams) GetTraffictype() NetworkTrafficType {
+	if p.Traffictype == nil {
+		return ""
+	}
+	return *p.Traffictype
+}
+
+func (p *ListTrafficTypeImplementorsParams) HasTraffictype() bool {
+	return p.Traffictype != nil
+}
+
+func (p *ListTrafficTypeImplementorsParams) ResetTraffictype() {
+	p.Traffictype = nil
+}
+
+type ListTrafficTypeImplementorsResponse struct {
+	Count                   int                        `json:"count" xml:"count"`
+	TrafficTypeImplementors []*TrafficTypeImplementor  `json:"traffictypeimplementor" xml:"traffictypeimplementor"`
+	Extra                   map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type TrafficTypeImplementor struct {
+	Traffictype            NetworkTrafficType         `json:"traffictype,omitempty" xml:"traffictype,omitempty"`
+	Traffictypeimplementor string                     `json:"traffictypeimplementor,omitempty" xml:"traffictypeimplementor,omitempty"`
+	Extra                  map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type GenerateUsageRecordsParams struct {
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Enddate   *string `json:"enddate,omitempty" yaml:"enddate,omitempty"`
+	Startdate *string `json:"startdate,omitempty" yaml:"startdate,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *GenerateUsageRecordsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Enddate; v != nil {
+		u.Set("enddate", *v)
+	}
+	if v := p.Startdate; v != nil {
+		u.Set("startdate", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *GenerateUsageRecordsParams) Command() string {
+	return "generateUsageRecords"
+}
+
+// Returns true if the API command starts an async job
+func (p *GenerateUsageRecordsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *GenerateUsageRecordsParams) ResponseType() interface{} {
+	return &GenerateUsageRecordsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *GenerateUsageRecordsParams) Validate() error {
+	v := &validator{api: "generateUsageRecords"}
+	v.required("enddate", p.Enddate != nil)
+	v.required("startdate", p.Startdate != nil)
+	return v.err()
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *GenerateUsageRecordsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *GenerateUsageRecordsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *GenerateUsageRecordsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'enddate' param: the end date, in the format yyyy-MM-dd. This param is required.
+func (p *GenerateUsageRecordsParams) SetEnddate(v string) {
+	p.Enddate = &v
+	return
+}
+
+func (p *GenerateUsageRecordsParams) GetEnddate() string {
+	if p.Enddate == nil {
+		return ""
+	}
+	return *p.Enddate
+}
+
+func (p *GenerateUsageRecordsParams) HasEnddate() bool {
+	return p.Enddate != nil
+}
+
+func (p *GenerateUsageRecordsParams) ResetEnddate() {
+	p.Enddate = nil
+}
+
+// Sets the 'startdate' param: the start date, in the format yyyy-MM-dd. This param is required.
+func (p *GenerateUsageRecordsParams) SetStartdate(v string) {
+	p.Startdate = &v
+	return
+}
+
+func (p *GenerateUsageRecordsParams) GetStartdate() string {
+	if p.Startdate == nil {
+		return ""
+	}
+	return *p.Startdate
+}
+
+func (p *GenerateUsageRecordsParams) HasStartdate() bool {
+	return p.Startdate != nil
+}
+
+func (p *GenerateUsageRecordsParams) ResetStartdate() {
+	p.Startdate = nil
+}
+
+type GenerateUsageRecordsResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListUsageTypesParams struct {
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUsageTypesParams) URLValues() url.Values {
+	u := url.Values{}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListUsageTypesParams) Command() string {
+	return "listUsageTypes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUsageTypesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUsageTypesParams) ResponseType() interface{} {
+	return &ListUsageTypesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUsageTypesParams) Validate() error {
+	v := &validator{api: "listUsageTypes"}
+	return v.err()
+}
+
+type ListUsageTypesResponse struct {
+	Count      int                        `json:"count" xml:"count"`
+	UsageTypes []*UsageType               `json:"usagetype" xml:"usagetype"`
+	Extra      map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UsageType struct {
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Usagetypeid int                        `json:"usagetypeid,omitempty" xml:"usagetypeid,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the UsageService that is the same for all versions
+type UsageService interface {
+	NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams
+	AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
+	NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams
+	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
+	NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams
+	ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	StreamTrafficMonitors(ctx context.Context, p *ListTrafficMonitorsParams, fn func(*TrafficMonitor) error) (int, error)
+	NewAddTrafficTypeParams(physicalnetworkid string, traffictype NetworkTrafficType) *AddTrafficTypeParams
+	AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams
+	DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams
+	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, error)
+	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	StreamTrafficTypes(ctx context.Context, p *ListTrafficTypesParams, fn func(*TrafficType) error) (int, error)
+	NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams
+	UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+	NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams
+	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	StreamTrafficTypeImplementors(ctx context.Context, p *ListTrafficTypeImplementorsParams, fn func(*TrafficTypeImplementor) error) (int, error)
+	NewGenerateUsageRecordsParams(enddate string, startdate string) *GenerateUsageRecordsParams
+	GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
+	NewListUsageTypesParams() *ListUsageTypesParams
+	ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	StreamUsageTypes(ctx context.Context, p *ListUsageTypesParams, fn func(*UsageType) error) (int, error)
+}
@@ -0,0 +1,350 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestAddTrafficMonitor(t *testing.T) {
+	params := url.Values{
+		"excludezones": {"test-excludezones"},
+		"includezones": {"test-includezones"},
+		"url":          {"test-url"},
+		"zoneid":       {"test-zoneid"},
+	}
+
+	p := &AddTrafficMonitorParams{}
+	p.SetExcludezones("test-excludezones")
+	p.SetIncludezones("test-includezones")
+	p.SetUrl("test-url")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addTrafficMonitor", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addTrafficMonitor" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddTrafficMonitorResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addTrafficMonitor", `{"addtrafficmonitorresponse":{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","timeout":"test-timeout","zoneid":"test-zoneid"}}`, false, r)
+	if r.Id != "test-id" {
+		t.Errorf("Expected r.Id to be %v, got %v", "test-id", r.Id)
+	}
+}
+
+func TestDeleteTrafficMonitor(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteTrafficMonitorParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteTrafficMonitor", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteTrafficMonitor" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteTrafficMonitorResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteTrafficMonitor", `{"deletetrafficmonitorresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListTrafficMonitors(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"page":     {"2"},
+		"pagesize": {"3"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &ListTrafficMonitorsParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listTrafficMonitors", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listTrafficMonitors" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListTrafficMonitorsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listTrafficMonitors", `{"listtrafficmonitorsresponse":{"count":1,"trafficmonitor":[{"id":"test-id","ipaddress":"test-ipaddress","numretries":"test-numretries","timeout":"test-timeout","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.TrafficMonitors[0].Id != "test-id" {
+		t.Errorf("Expected r.TrafficMonitors[0].Id to be %v, got %v", "test-id", r.TrafficMonitors[0].Id)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestAddTrafficType(t *testing.T) {
+	params := url.Values{
+		"hypervnetworklabel": {"test-hypervnetworklabel"},
+		"isolationmethod":    {"test-isolationmethod"},
+		"kvmnetworklabel":    {"test-kvmnetworklabel"},
+		"physicalnetworkid":  {"test-physicalnetworkid"},
+		"traffictype":        {"Control"},
+		"vlan":               {"test-vlan"},
+		"vmwarenetworklabel": {"test-vmwarenetworklabel"},
+		"xennetworklabel":    {"test-xennetworklabel"},
+	}
+
+	p := &AddTrafficTypeParams{}
+	p.SetHypervnetworklabel("test-hypervnetworklabel")
+	p.SetIsolationmethod("test-isolationmethod")
+	p.SetKvmnetworklabel("test-kvmnetworklabel")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetTraffictype(TrafficTypeControl)
+	p.SetVlan("test-vlan")
+	p.SetVmwarenetworklabel("test-vmwarenetworklabel")
+	p.SetXennetworklabel("test-xennetworklabel")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addTrafficType", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addTrafficType" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddTrafficTypeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addTrafficType", `{"addtraffictyperesponse":{"hypervnetworklabel":"test-hypervnetworklabel","id":"test-id","jobid":"test-jobid","kvmnetworklabel":"test-kvmnetworklabel","physicalnetworkid":"test-physicalnetworkid","traffictype":"test-traffictype","vmwarenetworklabel":"test-vmwarenetworklabel","xennetworklabel":"test-xennetworklabel"}}`, false, r)
+	if r.Hypervnetworklabel != "test-hypervnetworklabel" {
+		t.Errorf("Expected r.Hypervnetworklabel to be %v, got %v", "test-hypervnetworklabel", r.Hypervnetworklabel)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteTrafficType(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteTrafficTypeParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteTrafficType", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteTrafficType" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteTrafficTypeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteTrafficType", `{"deletetraffictyperesponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListTrafficTypes(t *testing.T) {
+	params := url.Values{
+		"keyword":           {"test-keyword"},
+		"page":              {"2"},
+		"pagesize":          {"3"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+	}
+
+	p := &ListTrafficTypesParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listTrafficTypes", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listTrafficTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListTrafficTypesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listTrafficTypes", `{"listtraffictypesresponse":{"count":1,"traffictype":[{"canenableindividualservice":true,"destinationphysicalnetworkid":"test-destinationphysicalnetworkid","id":"test-id","name":"test-name","physicalnetworkid":"test-physicalnetworkid","servicelist":["test-servicelist"],"state":"test-state"}]}}`, false, r)
+	if r.TrafficTypes[0].Destinationphysicalnetworkid != "test-destinationphysicalnetworkid" {
+		t.Errorf("Expected r.TrafficTypes[0].Destinationphysicalnetworkid to be %v, got %v", "test-destinationphysicalnetworkid", r.TrafficTypes[0].Destinationphysicalnetworkid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateTrafficType(t *testing.T) {
+	params := url.Values{
+		"hypervnetworklabel": {"test-hypervnetworklabel"},
+		"id":                 {"test-id"},
+		"kvmnetworklabel":    {"test-kvmnetworklabel"},
+		"vmwarenetworklabel": {"test-vmwarenetworklabel"},
+		"xennetworklabel":    {"test-xennetworklabel"},
+	}
+
+	p := &UpdateTrafficTypeParams{}
+	p.SetHypervnetworklabel("test-hypervnetworklabel")
+	p.SetId("test-id")
+	p.SetKvmnetworklabel("test-kvmnetworklabel")
+	p.SetVmwarenetworklabel("test-vmwarenetworklabel")
+	p.SetXennetworklabel("test-xennetworklabel")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateTrafficType", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateTrafficType" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateTrafficTypeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateTrafficType", `{"updatetraffictyperesponse":{"hypervnetworklabel":"test-hypervnetworklabel","id":"test-id","jobid":"test-jobid","kvmnetworklabel":"test-kvmnetworklabel","physicalnetworkid":"test-physicalnetworkid","traffictype":"test-traffictype","vmwarenetworklabel":"test-vmwarenetworklabel","xennetworklabel":"test-xennetworklabel"}}`, false, r)
+	if r.Hypervnetworklabel != "test-hypervnetworklabel" {
+		t.Errorf("Expected r.Hypervnetworklabel to be %v, got %v", "test-hypervnetworklabel", r.Hypervnetworklabel)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListTrafficTypeImplementors(t *testing.T) {
+	params := url.Values{
+		"keyword":     {"test-keyword"},
+		"page":        {"2"},
+		"pagesize":    {"3"},
+		"traffictype": {"Control"},
+	}
+
+	p := &ListTrafficTypeImplementorsParams{}
+	p.SetKeyword("test-keyword")
+	p.SetPage(2)
+	p.SetPagesize(3)
+	p.SetTraffictype(TrafficTypeControl)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listTrafficTypeImplementors", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listTrafficTypeImplementors" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListTrafficTypeImplementorsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listTrafficTypeImplementors", `{"listtraffictypeimplementorsresponse":{"count":1,"traffictypeimplementor":[{"traffictype":"test-traffictype","traffictypeimplementor":"test-traffictypeimplementor"}]}}`, false, r)
+	if r.TrafficTypeImplementors[0].Traffictypeimplementor != "test-traffictypeimplementor" {
+		t.Errorf("Expected r.TrafficTypeImplementors[0].Traffictypeimplementor to be %v, got %v", "test-traffictypeimplementor", r.TrafficTypeImplementors[0].Traffictypeimplementor)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestGenerateUsageRecords(t *testing.T) {
+	params := url.Values{
+		"domainid":  {"test-domainid"},
+		"enddate":   {"test-enddate"},
+		"startdate": {"test-startdate"},
+	}
+
+	p := &GenerateUsageRecordsParams{}
+	p.SetDomainid("test-domainid")
+	p.SetEnddate("test-enddate")
+	p.SetStartdate("test-startdate")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "generateUsageRecords", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "generateUsageRecords" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*GenerateUsageRecordsResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "generateUsageRecords", `{"generateusagerecordsresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListUsageTypes(t *testing.T) {
+	params := url.Values{}
+
+	p := &ListUsageTypesParams{}
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listUsageTypes", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listUsageTypes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListUsageTypesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listUsageTypes", `{"listusagetypesresponse":{"count":1,"usagetype":[{"description":"test-description","usagetypeid":1}]}}`, false, r)
+	if r.UsageTypes[0].Description != "test-description" {
+		t.Errorf("Expected r.UsageTypes[0].Description to be %v, got %v", "test-description", r.UsageTypes[0].Description)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
@@ -0,0 +1,1799 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type ImportLdapUsersParams struct {
+	Account        *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Accountdetails map[string]string `json:"accountdetails,omitempty" yaml:"accountdetails,omitempty"`
+	Accounttype    *int              `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid       *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Group          *string           `json:"group,omitempty" yaml:"group,omitempty"`
+	Keyword        *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page           *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Timezone       *string           `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ImportLdapUsersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Accountdetails; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("accountdetails[0].%s", k), vv)
+		}
+	}
+	if v := p.Accounttype; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("accounttype", vv)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Group; v != nil {
+		u.Set("group", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Timezone; v != nil {
+		u.Set("timezone", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ImportLdapUsersParams) Command() string {
+	return "importLdapUsers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ImportLdapUsersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ImportLdapUsersParams) ResponseType() interface{} {
+	return &ImportLdapUsersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ImportLdapUsersParams) Validate() error {
+	v := &validator{api: "importLdapUsers"}
+	v.required("accounttype", p.Accounttype != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ImportLdapUsersParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ImportLdapUsersParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ImportLdapUsersParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ImportLdapUsersParams) SetAccountdetails(v map[string]string) {
+	p.Accountdetails = v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetAccountdetails() map[string]string {
+	return p.Accountdetails
+}
+
+func (p *ImportLdapUsersParams) HasAccountdetails() bool {
+	return p.Accountdetails != nil
+}
+
+func (p *ImportLdapUsersParams) ResetAccountdetails() {
+	p.Accountdetails = nil
+}
+
+// Sets the 'accounttype' param: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin. This param is required.
+func (p *ImportLdapUsersParams) SetAccounttype(v int) {
+	p.Accounttype = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetAccounttype() int {
+	if p.Accounttype == nil {
+		return 0
+	}
+	return *p.Accounttype
+}
+
+func (p *ImportLdapUsersParams) HasAccounttype() bool {
+	return p.Accounttype != nil
+}
+
+func (p *ImportLdapUsersParams) ResetAccounttype() {
+	p.Accounttype = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ImportLdapUsersParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ImportLdapUsersParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ImportLdapUsersParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ImportLdapUsersParams) SetGroup(v string) {
+	p.Group = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetGroup() string {
+	if p.Group == nil {
+		return ""
+	}
+	return *p.Group
+}
+
+func (p *ImportLdapUsersParams) HasGroup() bool {
+	return p.Group != nil
+}
+
+func (p *ImportLdapUsersParams) ResetGroup() {
+	p.Group = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ImportLdapUsersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ImportLdapUsersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ImportLdapUsersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ImportLdapUsersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ImportLdapUsersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ImportLdapUsersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ImportLdapUsersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ImportLdapUsersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ImportLdapUsersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'timezone' param: the time zone, like America/New_York.
+func (p *ImportLdapUsersParams) SetTimezone(v string) {
+	p.Timezone = &v
+	return
+}
+
+func (p *ImportLdapUsersParams) GetTimezone() string {
+	if p.Timezone == nil {
+		return ""
+	}
+	return *p.Timezone
+}
+
+func (p *ImportLdapUsersParams) HasTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *ImportLdapUsersParams) ResetTimezone() {
+	p.Timezone = nil
+}
+
+type ImportLdapUsersResponse struct {
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Email     string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Lastname  string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Principal string                     `json:"principal,omitempty" xml:"principal,omitempty"`
+	Username  string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListLdapUsersParams struct {
+	Keyword  *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listtype *string `json:"listtype,omitempty" yaml:"listtype,omitempty"`
+	Page     *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListLdapUsersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listtype; v != nil {
+		u.Set("listtype", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListLdapUsersParams) Command() string {
+	return "listLdapUsers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListLdapUsersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListLdapUsersParams) ResponseType() interface{} {
+	return &ListLdapUsersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListLdapUsersParams) Validate() error {
+	v := &validator{api: "listLdapUsers"}
+	return v.err()
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListLdapUsersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListLdapUsersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListLdapUsersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListLdapUsersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListLdapUsersParams) SetListtype(v string) {
+	p.Listtype = &v
+	return
+}
+
+func (p *ListLdapUsersParams) GetListtype() string {
+	if p.Listtype == nil {
+		return ""
+	}
+	return *p.Listtype
+}
+
+func (p *ListLdapUsersParams) HasListtype() bool {
+	return p.Listtype != nil
+}
+
+func (p *ListLdapUsersParams) ResetListtype() {
+	p.Listtype = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListLdapUsersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListLdapUsersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListLdapUsersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListLdapUsersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListLdapUsersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListLdapUsersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListLdapUsersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListLdapUsersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+type ListLdapUsersResponse struct {
+	Count     int                        `json:"count" xml:"count"`
+	LdapUsers []*LdapUser                `json:"ldapuser" xml:"ldapuser"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type LdapUser struct {
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Email     string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Lastname  string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Principal string                     `json:"principal,omitempty" xml:"principal,omitempty"`
+	Username  string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateUserParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Email     *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Firstname *string `json:"firstname,omitempty" yaml:"firstname,omitempty"`
+	Lastname  *string `json:"lastname,omitempty" yaml:"lastname,omitempty"`
+	Password  *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Timezone  *string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userid    *string `json:"userid,omitempty" yaml:"userid,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Email; v != nil {
+		u.Set("email", *v)
+	}
+	if v := p.Firstname; v != nil {
+		u.Set("firstname", *v)
+	}
+	if v := p.Lastname; v != nil {
+		u.Set("lastname", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Timezone; v != nil {
+		u.Set("timezone", *v)
+	}
+	if v := p.Userid; v != nil {
+		u.Set("userid", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateUserParams) Command() string {
+	return "createUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateUserParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateUserParams) ResponseType() interface{} {
+	return &CreateUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateUserParams) Validate() error {
+	v := &validator{api: "createUser"}
+	v.required("account", p.Account != nil)
+	v.required("email", p.Email != nil)
+	v.required("firstname", p.Firstname != nil)
+	v.required("lastname", p.Lastname != nil)
+	v.required("password", p.Password != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *CreateUserParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateUserParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateUserParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateUserParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateUserParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateUserParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateUserParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateUserParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateUserParams) SetEmail(v string) {
+	p.Email = &v
+	return
+}
+
+func (p *CreateUserParams) GetEmail() string {
+	if p.Email == nil {
+		return ""
+	}
+	return *p.Email
+}
+
+func (p *CreateUserParams) HasEmail() bool {
+	return p.Email != nil
+}
+
+func (p *CreateUserParams) ResetEmail() {
+	p.Email = nil
+}
+
+// This param is required.
+func (p *CreateUserParams) SetFirstname(v string) {
+	p.Firstname = &v
+	return
+}
+
+func (p *CreateUserParams) GetFirstname() string {
+	if p.Firstname == nil {
+		return ""
+	}
+	return *p.Firstname
+}
+
+func (p *CreateUserParams) HasFirstname() bool {
+	return p.Firstname != nil
+}
+
+func (p *CreateUserParams) ResetFirstname() {
+	p.Firstname = nil
+}
+
+// This param is required.
+func (p *CreateUserParams) SetLastname(v string) {
+	p.Lastname = &v
+	return
+}
+
+func (p *CreateUserParams) GetLastname() string {
+	if p.Lastname == nil {
+		return ""
+	}
+	return *p.Lastname
+}
+
+func (p *CreateUserParams) HasLastname() bool {
+	return p.Lastname != nil
+}
+
+func (p *CreateUserParams) ResetLastname() {
+	p.Lastname = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *CreateUserParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *CreateUserParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *CreateUserParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *CreateUserParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'timezone' param: the time zone, like America/New_York.
+func (p *CreateUserParams) SetTimezone(v string) {
+	p.Timezone = &v
+	return
+}
+
+func (p *CreateUserParams) GetTimezone() string {
+	if p.Timezone == nil {
+		return ""
+	}
+	return *p.Timezone
+}
+
+func (p *CreateUserParams) HasTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *CreateUserParams) ResetTimezone() {
+	p.Timezone = nil
+}
+
+func (p *CreateUserParams) SetUserid(v string) {
+	p.Userid = &v
+	return
+}
+
+func (p *CreateUserParams) GetUserid() string {
+	if p.Userid == nil {
+		return ""
+	}
+	return *p.Userid
+}
+
+func (p *CreateUserParams) HasUserid() bool {
+	return p.Userid != nil
+}
+
+func (p *CreateUserParams) ResetUserid() {
+	p.Userid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *CreateUserParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *CreateUserParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *CreateUserParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *CreateUserParams) ResetUsername() {
+	p.Username = nil
+}
+
+type CreateUserResponse struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteUserParams) Command() string {
+	return "deleteUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteUserParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteUserParams) ResponseType() interface{} {
+	return &DeleteUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteUserParams) Validate() error {
+	v := &validator{api: "deleteUser"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteUserParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteUserParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteUserParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteUserParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteUserResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DisableUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DisableUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DisableUserParams) Command() string {
+	return "disableUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *DisableUserParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DisableUserParams) ResponseType() interface{} {
+	return &DisableUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DisableUserParams) Validate() error {
+	v := &validator{api: "disableUser"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DisableUserParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DisableUserParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DisableUserParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DisableUserParams) ResetId() {
+	p.Id = nil
+}
+
+type DisableUserResponse struct {
+	JobID               string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type EnableUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *EnableUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *EnableUserParams) Command() string {
+	return "enableUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *EnableUserParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *EnableUserParams) ResponseType() interface{} {
+	return &EnableUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *EnableUserParams) Validate() error {
+	v := &validator{api: "enableUser"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *EnableUserParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *EnableUserParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *EnableUserParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *EnableUserParams) ResetId() {
+	p.Id = nil
+}
+
+type EnableUserResponse struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type GetUserParams struct {
+	Userapikey *string `json:"userapikey,omitempty" yaml:"userapikey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *GetUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Userapikey; v != nil {
+		u.Set("userapikey", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *GetUserParams) Command() string {
+	return "getUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *GetUserParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *GetUserParams) ResponseType() interface{} {
+	return &GetUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *GetUserParams) Validate() error {
+	v := &validator{api: "getUser"}
+	v.required("userapikey", p.Userapikey != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *GetUserParams) SetUserapikey(v string) {
+	p.Userapikey = &v
+	return
+}
+
+func (p *GetUserParams) GetUserapikey() string {
+	if p.Userapikey == nil {
+		return ""
+	}
+	return *p.Userapikey
+}
+
+func (p *GetUserParams) HasUserapikey() bool {
+	return p.Userapikey != nil
+}
+
+func (p *GetUserParams) ResetUserapikey() {
+	p.Userapikey = nil
+}
+
+type GetUserResponse struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListUsersParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Accounttype *int64  `json:"accounttype,omitempty" yaml:"accounttype,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Username    *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListUsersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Accounttype; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("accounttype", vv)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListUsersParams) Command() string {
+	return "listUsers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListUsersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListUsersParams) ResponseType() interface{} {
+	return &ListUsersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListUsersParams) Validate() error {
+	v := &validator{api: "listUsers"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListUsersParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListUsersParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListUsersParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListUsersParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'accounttype' param: the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin.
+func (p *ListUsersParams) SetAccounttype(v int64) {
+	p.Accounttype = &v
+	return
+}
+
+func (p *ListUsersParams) GetAccounttype() int64 {
+	if p.Accounttype == nil {
+		return 0
+	}
+	return *p.Accounttype
+}
+
+func (p *ListUsersParams) HasAccounttype() bool {
+	return p.Accounttype != nil
+}
+
+func (p *ListUsersParams) ResetAccounttype() {
+	p.Accounttype = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListUsersParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListUsersParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListUsersParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListUsersParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListUsersParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListUsersParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListUsersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListUsersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListUsersParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListUsersParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListUsersParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListUsersParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListUsersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListUsersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListUsersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListUsersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListUsersParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListUsersParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListUsersParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListUsersParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListUsersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListUsersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListUsersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListUsersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListUsersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListUsersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListUsersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListUsersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListUsersParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListUsersParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListUsersParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListUsersParams) ResetState() {
+	p.State = nil
+}
+
+// Sets the 'username' param: the name of the user.
+func (p *ListUsersParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *ListUsersParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *ListUsersParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *ListUsersParams) ResetUsername() {
+	p.Username = nil
+}
+
+type ListUsersResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Users []*User                    `json:"user" xml:"user"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type User struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type LockUserParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *LockUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *LockUserParams) Command() string {
+	return "lockUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *LockUserParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *LockUserParams) ResponseType() interface{} {
+	return &LockUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *LockUserParams) Validate() error {
+	v := &validator{api: "lockUser"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *LockUserParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *LockUserParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *LockUserParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *LockUserParams) ResetId() {
+	p.Id = nil
+}
+
+type LockUserResponse struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateUserParams struct {
+	Email         *string `json:"email,omitempty" yaml:"email,omitempty"`
+	Firstname     *string `json:"firstname,omitempty" yaml:"firstname,omitempty"`
+	Id            *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Lastname      *string `json:"lastname,omitempty" yaml:"lastname,omitempty"`
+	Password      *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Timezone      *string `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	Userapikey    *string `json:"userapikey,omitempty" yaml:"userapikey,omitempty"`
+	Username      *string `json:"username,omitempty" yaml:"username,omitempty"`
+	Usersecretkey *string `json:"usersecretkey,omitempty" yaml:"usersecretkey,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Email; v != nil {
+		u.Set("email", *v)
+	}
+	if v := p.Firstname; v != nil {
+		u.Set("firstname", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Lastname; v != nil {
+		u.Set("lastname", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Timezone; v != nil {
+		u.Set("timezone", *v)
+	}
+	if v := p.Userapikey; v != nil {
+		u.Set("userapikey", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	if v := p.Usersecretkey; v != nil {
+		u.Set("usersecretkey", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateUserParams) Command() string {
+	return "updateUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateUserParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateUserParams) ResponseType() interface{} {
+	return &UpdateUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateUserParams) Validate() error {
+	v := &validator{api: "updateUser"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+func (p *UpdateUserParams) SetEmail(v string) {
+	p.Email = &v
+	return
+}
+
+func (p *UpdateUserParams) GetEmail() string {
+	if p.Email == nil {
+		return ""
+	}
+	return *p.Email
+}
+
+func (p *UpdateUserParams) HasEmail() bool {
+	return p.Email != nil
+}
+
+func (p *UpdateUserParams) ResetEmail() {
+	p.Email = nil
+}
+
+func (p *UpdateUserParams) SetFirstname(v string) {
+	p.Firstname = &v
+	return
+}
+
+func (p *UpdateUserParams) GetFirstname() string {
+	if p.Firstname == nil {
+		return ""
+	}
+	return *p.Firstname
+}
+
+func (p *UpdateUserParams) HasFirstname() bool {
+	return p.Firstname != nil
+}
+
+func (p *UpdateUserParams) ResetFirstname() {
+	p.Firstname = nil
+}
+
+// This param is required.
+func (p *UpdateUserParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateUserParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateUserParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateUserParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateUserParams) SetLastname(v string) {
+	p.Lastname = &v
+	return
+}
+
+func (p *UpdateUserParams) GetLastname() string {
+	if p.Lastname == nil {
+		return ""
+	}
+	return *p.Lastname
+}
+
+func (p *UpdateUserParams) HasLastname() bool {
+	return p.Lastname != nil
+}
+
+func (p *UpdateUserParams) ResetLastname() {
+	p.Lastname = nil
+}
+
+// Sets the 'password' param: the password.
+func (p *UpdateUserParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *UpdateUserParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *UpdateUserParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *UpdateUserParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'timezone' param: the time zone, like America/New_York.
+func (p *UpdateUserParams) SetTimezone(v string) {
+	p.Timezone = &v
+	return
+}
+
+func (p *UpdateUserParams) GetTimezone() string {
+	if p.Timezone == nil {
+		return ""
+	}
+	return *p.Timezone
+}
+
+func (p *UpdateUserParams) HasTimezone() bool {
+	return p.Timezone != nil
+}
+
+func (p *UpdateUserParams) ResetTimezone() {
+	p.Timezone = nil
+}
+
+func (p *UpdateUserParams) SetUserapikey(v string) {
+	p.Userapikey = &v
+	return
+}
+
+func (p *UpdateUserParams) GetUserapikey() string {
+	if p.Userapikey == nil {
+		return ""
+	}
+	return *p.Userapikey
+}
+
+func (p *UpdateUserParams) HasUserapikey() bool {
+	return p.Userapikey != nil
+}
+
+func (p *UpdateUserParams) ResetUserapikey() {
+	p.Userapikey = nil
+}
+
+// Sets the 'username' param: the name of the user.
+func (p *UpdateUserParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *UpdateUserParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *UpdateUserParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *UpdateUserParams) ResetUsername() {
+	p.Username = nil
+}
+
+func (p *UpdateUserParams) SetUsersecretkey(v string) {
+	p.Usersecretkey = &v
+	return
+}
+
+func (p *UpdateUserParams) GetUsersecretkey() string {
+	if p.Usersecretkey == nil {
+		return ""
+	}
+	return *p.Usersecretkey
+}
+
+func (p *UpdateUserParams) HasUsersecretkey() bool {
+	return p.Usersecretkey != nil
+}
+
+func (p *UpdateUserParams) ResetUsersecretkey() {
+	p.Usersecretkey = nil
+}
+
+type UpdateUserResponse struct {
+	Account             string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Accountid           string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Accounttype         int                        `json:"accounttype,omitempty" xml:"accounttype,omitempty"`
+	Apikey              string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Created             Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain              string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid            string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Email               string                     `json:"email,omitempty" xml:"email,omitempty"`
+	Firstname           string                     `json:"firstname,omitempty" xml:"firstname,omitempty"`
+	Id                  string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Iscallerchilddomain Bool                       `json:"iscallerchilddomain,omitempty" xml:"iscallerchilddomain,omitempty"`
+	Isdefault           Bool                       `json:"isdefault,omitempty" xml:"isdefault,omitempty"`
+	Lastname            string                     `json:"lastname,omitempty" xml:"lastname,omitempty"`
+	Secretkey           string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	State               string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Timezone            string                     `json:"timezone,omitempty" xml:"timezone,omitempty"`
+	Username            string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RegisterUserKeysParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RegisterUserKeysParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RegisterUserKeysParams) Command() string {
+	return "registerUserKeys"
+}
+
+// Returns true if the API command starts an async job
+func (p *RegisterUserKeysParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RegisterUserKeysParams) ResponseType() interface{} {
+	return &RegisterUserKeysResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RegisterUserKeysParams) Validate() error {
+	v := &validator{api: "registerUserKeys"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *RegisterUserKeysParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *RegisterUserKeysParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *RegisterUserKeysParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *RegisterUserKeysParams) ResetId() {
+	p.Id = nil
+}
+
+type RegisterUserKeysResponse struct {
+	Apikey    string                     `json:"apikey,omitempty" xml:"apikey,omitempty"`
+	Secretkey string                     `json:"secretkey,omitempty" xml:"secretkey,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the UserService that is the same for all versions
+type UserService interface {
+	NewImportLdapUsersParams(accounttype int) *ImportLdapUsersParams
+	ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	NewListLdapUsersParams() *ListLdapUsersParams
+	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	StreamLdapUsers(ctx context.Context, p *ListLdapUsersParams, fn func(*LdapUser) error) (int, error)
+	NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams
+	CreateUser(p *CreateUserParams) (*CreateUserResponse, error)
+	NewDeleteUserParams(id string) *DeleteUserParams
+	DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error)
+	NewDisableUserParams(id string) *DisableUserParams
+	DisableUser(p *DisableUserParams) (*DisableUserResponse, error)
+	NewEnableUserParams(id string) *EnableUserParams
+	EnableUser(p *EnableUserParams) (*EnableUserResponse, error)
+	NewGetUserParams(userapikey string) *GetUserParams
+	GetUser(p *GetUserParams) (*GetUserResponse, error)
+	NewListUsersParams() *ListUsersParams
+	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
+	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	StreamUsers(ctx context.Context, p *ListUsersParams, fn func(*User) error) (int, error)
+	NewLockUserParams(id string) *LockUserParams
+	LockUser(p *LockUserParams) (*LockUserResponse, error)
+	NewUpdateUserParams(id string) *UpdateUserParams
+	UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error)
+	NewRegisterUserKeysParams(id string) *RegisterUserKeysParams
+	RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+}
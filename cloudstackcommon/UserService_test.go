@@ -0,0 +1,391 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestImportLdapUsers(t *testing.T) {
+	params := url.Values{
+		"account": {"test-account"},
+		"accountdetails[0].test-accountdetailskey": {"test-accountdetailsvalue"},
+		"accounttype": {"3"},
+		"domainid":    {"test-domainid"},
+		"group":       {"test-group"},
+		"keyword":     {"test-keyword"},
+		"page":        {"7"},
+		"pagesize":    {"8"},
+		"timezone":    {"test-timezone"},
+	}
+
+	p := &ImportLdapUsersParams{}
+	p.SetAccount("test-account")
+	p.SetAccountdetails(map[string]string{"test-accountdetailskey": "test-accountdetailsvalue"})
+	p.SetAccounttype(3)
+	p.SetDomainid("test-domainid")
+	p.SetGroup("test-group")
+	p.SetKeyword("test-keyword")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetTimezone("test-timezone")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "importLdapUsers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "importLdapUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ImportLdapUsersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "importLdapUsers", `{"importldapusersresponse":{"domain":"test-domain","email":"test-email","firstname":"test-firstname","lastname":"test-lastname","principal":"test-principal","username":"test-username"}}`, false, r)
+	if r.Domain != "test-domain" {
+		t.Errorf("Expected r.Domain to be %v, got %v", "test-domain", r.Domain)
+	}
+}
+
+func TestListLdapUsers(t *testing.T) {
+	params := url.Values{
+		"keyword":  {"test-keyword"},
+		"listtype": {"test-listtype"},
+		"page":     {"3"},
+		"pagesize": {"4"},
+	}
+
+	p := &ListLdapUsersParams{}
+	p.SetKeyword("test-keyword")
+	p.SetListtype("test-listtype")
+	p.SetPage(3)
+	p.SetPagesize(4)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listLdapUsers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listLdapUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListLdapUsersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listLdapUsers", `{"listldapusersresponse":{"count":1,"ldapuser":[{"domain":"test-domain","email":"test-email","firstname":"test-firstname","lastname":"test-lastname","principal":"test-principal","username":"test-username"}]}}`, false, r)
+	if r.LdapUsers[0].Domain != "test-domain" {
+		t.Errorf("Expected r.LdapUsers[0].Domain to be %v, got %v", "test-domain", r.LdapUsers[0].Domain)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"email":     {"test-email"},
+		"firstname": {"test-firstname"},
+		"lastname":  {"test-lastname"},
+		"password":  {"test-password"},
+		"timezone":  {"test-timezone"},
+		"userid":    {"test-userid"},
+		"username":  {"test-username"},
+	}
+
+	p := &CreateUserParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetEmail("test-email")
+	p.SetFirstname("test-firstname")
+	p.SetLastname("test-lastname")
+	p.SetPassword("test-password")
+	p.SetTimezone("test-timezone")
+	p.SetUserid("test-userid")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createUser", `{"createuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteUserParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteUser", `{"deleteuserresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestDisableUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DisableUserParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "disableUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "disableUser" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DisableUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "disableUser", `{"disableuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"jobid":"test-jobid","lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestEnableUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &EnableUserParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "enableUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "enableUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*EnableUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "enableUser", `{"enableuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	params := url.Values{
+		"userapikey": {"test-userapikey"},
+	}
+
+	p := &GetUserParams{}
+	p.SetUserapikey("test-userapikey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "getUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "getUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*GetUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "getUser", `{"getuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"accounttype": {"2"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"state":       {"test-state"},
+		"username":    {"test-username"},
+	}
+
+	p := &ListUsersParams{}
+	p.SetAccount("test-account")
+	p.SetAccounttype(2)
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetState("test-state")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listUsers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListUsersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listUsers", `{"listusersresponse":{"count":1,"user":[{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}]}}`, false, r)
+	if r.Users[0].Account != "test-account" {
+		t.Errorf("Expected r.Users[0].Account to be %v, got %v", "test-account", r.Users[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestLockUser(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &LockUserParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "lockUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "lockUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*LockUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "lockUser", `{"lockuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	params := url.Values{
+		"email":         {"test-email"},
+		"firstname":     {"test-firstname"},
+		"id":            {"test-id"},
+		"lastname":      {"test-lastname"},
+		"password":      {"test-password"},
+		"timezone":      {"test-timezone"},
+		"userapikey":    {"test-userapikey"},
+		"username":      {"test-username"},
+		"usersecretkey": {"test-usersecretkey"},
+	}
+
+	p := &UpdateUserParams{}
+	p.SetEmail("test-email")
+	p.SetFirstname("test-firstname")
+	p.SetId("test-id")
+	p.SetLastname("test-lastname")
+	p.SetPassword("test-password")
+	p.SetTimezone("test-timezone")
+	p.SetUserapikey("test-userapikey")
+	p.SetUsername("test-username")
+	p.SetUsersecretkey("test-usersecretkey")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateUser" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateUser", `{"updateuserresponse":{"account":"test-account","accountid":"test-accountid","accounttype":1,"apikey":"test-apikey","created":"2014-01-02T15:04:05+0100","domain":"test-domain","domainid":"test-domainid","email":"test-email","firstname":"test-firstname","id":"test-id","iscallerchilddomain":true,"isdefault":true,"lastname":"test-lastname","secretkey":"test-secretkey","state":"test-state","timezone":"test-timezone","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestRegisterUserKeys(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &RegisterUserKeysParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "registerUserKeys", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "registerUserKeys" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RegisterUserKeysResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "registerUserKeys", `{"registeruserkeysresponse":{"apikey":"test-apikey","secretkey":"test-secretkey"}}`, false, r)
+	if r.Apikey != "test-apikey" {
+		t.Errorf("Expected r.Apikey to be %v, got %v", "test-apikey", r.Apikey)
+	}
+}
@@ -0,0 +1,1508 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type ListDedicatedGuestVlanRangesParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Guestvlanrange    *string `json:"guestvlanrange,omitempty" yaml:"guestvlanrange,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDedicatedGuestVlanRangesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Guestvlanrange; v != nil {
+		u.Set("guestvlanrange", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDedicatedGuestVlanRangesParams) Command() string {
+	return "listDedicatedGuestVlanRanges"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDedicatedGuestVlanRangesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDedicatedGuestVlanRangesParams) ResponseType() interface{} {
+	return &ListDedicatedGuestVlanRangesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDedicatedGuestVlanRangesParams) Validate() error {
+	v := &validator{api: "listDedicatedGuestVlanRanges"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListDedicatedGuestVlanRangesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) SetGuestvlanrange(v string) {
+	p.Guestvlanrange = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetGuestvlanrange() string {
+	if p.Guestvlanrange == nil {
+		return ""
+	}
+	return *p.Guestvlanrange
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasGuestvlanrange() bool {
+	return p.Guestvlanrange != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetGuestvlanrange() {
+	p.Guestvlanrange = nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDedicatedGuestVlanRangesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDedicatedGuestVlanRangesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDedicatedGuestVlanRangesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListDedicatedGuestVlanRangesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListDedicatedGuestVlanRangesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListDedicatedGuestVlanRangesParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListDedicatedGuestVlanRangesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListDedicatedGuestVlanRangesResponse struct {
+	Count                    int                        `json:"count" xml:"count"`
+	DedicatedGuestVlanRanges []*DedicatedGuestVlanRange `json:"dedicatedguestvlanrange" xml:"dedicatedguestvlanrange"`
+	Extra                    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatedGuestVlanRange struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Guestvlanrange    string                     `json:"guestvlanrange,omitempty" xml:"guestvlanrange,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Physicalnetworkid int64                      `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Zoneid            int64                      `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleaseDedicatedGuestVlanRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleaseDedicatedGuestVlanRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleaseDedicatedGuestVlanRangeParams) Command() string {
+	return "releaseDedicatedGuestVlanRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleaseDedicatedGuestVlanRangeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleaseDedicatedGuestVlanRangeParams) ResponseType() interface{} {
+	return &ReleaseDedicatedGuestVlanRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleaseDedicatedGuestVlanRangeParams) Validate() error {
+	v := &validator{api: "releaseDedicatedGuestVlanRange"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ReleaseDedicatedGuestVlanRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ReleaseDedicatedGuestVlanRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ReleaseDedicatedGuestVlanRangeParams) ResetId() {
+	p.Id = nil
+}
+
+type ReleaseDedicatedGuestVlanRangeResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicateGuestVlanRangeParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vlanrange         *string `json:"vlanrange,omitempty" yaml:"vlanrange,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DedicateGuestVlanRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Vlanrange; v != nil {
+		u.Set("vlanrange", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DedicateGuestVlanRangeParams) Command() string {
+	return "dedicateGuestVlanRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *DedicateGuestVlanRangeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DedicateGuestVlanRangeParams) ResponseType() interface{} {
+	return &DedicateGuestVlanRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DedicateGuestVlanRangeParams) Validate() error {
+	v := &validator{api: "dedicateGuestVlanRange"}
+	v.required("account", p.Account != nil)
+	v.required("domainid", p.Domainid != nil)
+	v.required("physicalnetworkid", p.Physicalnetworkid != nil)
+	v.required("vlanrange", p.Vlanrange != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account. This param is required.
+func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DedicateGuestVlanRangeParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DedicateGuestVlanRangeParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DedicateGuestVlanRangeParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *DedicateGuestVlanRangeParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DedicateGuestVlanRangeParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DedicateGuestVlanRangeParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DedicateGuestVlanRangeParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network. This param is required.
+func (p *DedicateGuestVlanRangeParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *DedicateGuestVlanRangeParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *DedicateGuestVlanRangeParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *DedicateGuestVlanRangeParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *DedicateGuestVlanRangeParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *DedicateGuestVlanRangeParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *DedicateGuestVlanRangeParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *DedicateGuestVlanRangeParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// This param is required.
+func (p *DedicateGuestVlanRangeParams) SetVlanrange(v string) {
+	p.Vlanrange = &v
+	return
+}
+
+func (p *DedicateGuestVlanRangeParams) GetVlanrange() string {
+	if p.Vlanrange == nil {
+		return ""
+	}
+	return *p.Vlanrange
+}
+
+func (p *DedicateGuestVlanRangeParams) HasVlanrange() bool {
+	return p.Vlanrange != nil
+}
+
+func (p *DedicateGuestVlanRangeParams) ResetVlanrange() {
+	p.Vlanrange = nil
+}
+
+type DedicateGuestVlanRangeResponse struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Guestvlanrange    string                     `json:"guestvlanrange,omitempty" xml:"guestvlanrange,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Physicalnetworkid int64                      `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Zoneid            int64                      `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVlanIpRangeParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Endip             *string `json:"endip,omitempty" yaml:"endip,omitempty"`
+	Endipv6           *string `json:"endipv6,omitempty" yaml:"endipv6,omitempty"`
+	Forvirtualnetwork *bool   `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Gateway           *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ip6cidr           *string `json:"ip6cidr,omitempty" yaml:"ip6cidr,omitempty"`
+	Ip6gateway        *string `json:"ip6gateway,omitempty" yaml:"ip6gateway,omitempty"`
+	Netmask           *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Networkid         *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Startip           *string `json:"startip,omitempty" yaml:"startip,omitempty"`
+	Startipv6         *string `json:"startipv6,omitempty" yaml:"startipv6,omitempty"`
+	Vlan              *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVlanIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Endip; v != nil {
+		u.Set("endip", *v)
+	}
+	if v := p.Endipv6; v != nil {
+		u.Set("endipv6", *v)
+	}
+	if v := p.Forvirtualnetwork; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forvirtualnetwork", vv)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Ip6cidr; v != nil {
+		u.Set("ip6cidr", *v)
+	}
+	if v := p.Ip6gateway; v != nil {
+		u.Set("ip6gateway", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Startip; v != nil {
+		u.Set("startip", *v)
+	}
+	if v := p.Startipv6; v != nil {
+		u.Set("startipv6", *v)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateVlanIpRangeParams) Command() string {
+	return "createVlanIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVlanIpRangeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVlanIpRangeParams) ResponseType() interface{} {
+	return &CreateVlanIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVlanIpRangeParams) Validate() error {
+	v := &validator{api: "createVlanIpRange"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateVlanIpRangeParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateVlanIpRangeParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateVlanIpRangeParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateVlanIpRangeParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'endip' param: the last IP address of the range.
+func (p *CreateVlanIpRangeParams) SetEndip(v string) {
+	p.Endip = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetEndip() string {
+	if p.Endip == nil {
+		return ""
+	}
+	return *p.Endip
+}
+
+func (p *CreateVlanIpRangeParams) HasEndip() bool {
+	return p.Endip != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetEndip() {
+	p.Endip = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetEndipv6(v string) {
+	p.Endipv6 = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetEndipv6() string {
+	if p.Endipv6 == nil {
+		return ""
+	}
+	return *p.Endipv6
+}
+
+func (p *CreateVlanIpRangeParams) HasEndipv6() bool {
+	return p.Endipv6 != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetEndipv6() {
+	p.Endipv6 = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetForvirtualnetwork(v bool) {
+	p.Forvirtualnetwork = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetForvirtualnetwork() bool {
+	if p.Forvirtualnetwork == nil {
+		return false
+	}
+	return *p.Forvirtualnetwork
+}
+
+func (p *CreateVlanIpRangeParams) HasForvirtualnetwork() bool {
+	return p.Forvirtualnetwork != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetForvirtualnetwork() {
+	p.Forvirtualnetwork = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreateVlanIpRangeParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetIp6cidr(v string) {
+	p.Ip6cidr = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetIp6cidr() string {
+	if p.Ip6cidr == nil {
+		return ""
+	}
+	return *p.Ip6cidr
+}
+
+func (p *CreateVlanIpRangeParams) HasIp6cidr() bool {
+	return p.Ip6cidr != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetIp6cidr() {
+	p.Ip6cidr = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetIp6gateway(v string) {
+	p.Ip6gateway = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetIp6gateway() string {
+	if p.Ip6gateway == nil {
+		return ""
+	}
+	return *p.Ip6gateway
+}
+
+func (p *CreateVlanIpRangeParams) HasIp6gateway() bool {
+	return p.Ip6gateway != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetIp6gateway() {
+	p.Ip6gateway = nil
+}
+
+// Sets the 'netmask' param: the netmask.
+func (p *CreateVlanIpRangeParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *CreateVlanIpRangeParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *CreateVlanIpRangeParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *CreateVlanIpRangeParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *CreateVlanIpRangeParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *CreateVlanIpRangeParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *CreateVlanIpRangeParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *CreateVlanIpRangeParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateVlanIpRangeParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *CreateVlanIpRangeParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'startip' param: the first IP address of the range.
+func (p *CreateVlanIpRangeParams) SetStartip(v string) {
+	p.Startip = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetStartip() string {
+	if p.Startip == nil {
+		return ""
+	}
+	return *p.Startip
+}
+
+func (p *CreateVlanIpRangeParams) HasStartip() bool {
+	return p.Startip != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetStartip() {
+	p.Startip = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetStartipv6(v string) {
+	p.Startipv6 = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetStartipv6() string {
+	if p.Startipv6 == nil {
+		return ""
+	}
+	return *p.Startipv6
+}
+
+func (p *CreateVlanIpRangeParams) HasStartipv6() bool {
+	return p.Startipv6 != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetStartipv6() {
+	p.Startipv6 = nil
+}
+
+func (p *CreateVlanIpRangeParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *CreateVlanIpRangeParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *CreateVlanIpRangeParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *CreateVlanIpRangeParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *CreateVlanIpRangeParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *CreateVlanIpRangeParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type CreateVlanIpRangeResponse struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description       string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Endip             string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Endipv6           string                     `json:"endipv6,omitempty" xml:"endipv6,omitempty"`
+	Forvirtualnetwork Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Gateway           string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr           string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway        string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Netmask           string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid         string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Podid             string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname           string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Startip           string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Startipv6         string                     `json:"startipv6,omitempty" xml:"startipv6,omitempty"`
+	Vlan              string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVlanIpRangeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVlanIpRangeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVlanIpRangeParams) Command() string {
+	return "deleteVlanIpRange"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVlanIpRangeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVlanIpRangeParams) ResponseType() interface{} {
+	return &DeleteVlanIpRangeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVlanIpRangeParams) Validate() error {
+	v := &validator{api: "deleteVlanIpRange"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVlanIpRangeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVlanIpRangeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVlanIpRangeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVlanIpRangeParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVlanIpRangeResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListVlanIpRangesParams struct {
+	Account           *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid          *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Forvirtualnetwork *bool   `json:"forvirtualnetwork,omitempty" yaml:"forvirtualnetwork,omitempty"`
+	Id                *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword           *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Networkid         *string `json:"networkid,omitempty" yaml:"networkid,omitempty"`
+	Page              *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize          *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Physicalnetworkid *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Podid             *string `json:"podid,omitempty" yaml:"podid,omitempty"`
+	Projectid         *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Vlan              *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Zoneid            *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVlanIpRangesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Forvirtualnetwork; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("forvirtualnetwork", vv)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Networkid; v != nil {
+		u.Set("networkid", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Podid; v != nil {
+		u.Set("podid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListVlanIpRangesParams) Command() string {
+	return "listVlanIpRanges"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVlanIpRangesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVlanIpRangesParams) ResponseType() interface{} {
+	return &ListVlanIpRangesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVlanIpRangesParams) Validate() error {
+	v := &validator{api: "listVlanIpRanges"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListVlanIpRangesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListVlanIpRangesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVlanIpRangesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListVlanIpRangesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListVlanIpRangesParams) SetForvirtualnetwork(v bool) {
+	p.Forvirtualnetwork = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetForvirtualnetwork() bool {
+	if p.Forvirtualnetwork == nil {
+		return false
+	}
+	return *p.Forvirtualnetwork
+}
+
+func (p *ListVlanIpRangesParams) HasForvirtualnetwork() bool {
+	return p.Forvirtualnetwork != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetForvirtualnetwork() {
+	p.Forvirtualnetwork = nil
+}
+
+func (p *ListVlanIpRangesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListVlanIpRangesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVlanIpRangesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListVlanIpRangesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'networkid' param: the ID of the network.
+func (p *ListVlanIpRangesParams) SetNetworkid(v string) {
+	p.Networkid = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetNetworkid() string {
+	if p.Networkid == nil {
+		return ""
+	}
+	return *p.Networkid
+}
+
+func (p *ListVlanIpRangesParams) HasNetworkid() bool {
+	return p.Networkid != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetNetworkid() {
+	p.Networkid = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVlanIpRangesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListVlanIpRangesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVlanIpRangesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListVlanIpRangesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *ListVlanIpRangesParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *ListVlanIpRangesParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+// Sets the 'podid' param: the ID of the pod.
+func (p *ListVlanIpRangesParams) SetPodid(v string) {
+	p.Podid = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetPodid() string {
+	if p.Podid == nil {
+		return ""
+	}
+	return *p.Podid
+}
+
+func (p *ListVlanIpRangesParams) HasPodid() bool {
+	return p.Podid != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetPodid() {
+	p.Podid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVlanIpRangesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListVlanIpRangesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListVlanIpRangesParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *ListVlanIpRangesParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListVlanIpRangesParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListVlanIpRangesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListVlanIpRangesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListVlanIpRangesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListVlanIpRangesResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	VlanIpRanges []*VlanIpRange             `json:"vlaniprange" xml:"vlaniprange"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VlanIpRange struct {
+	Account           string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Description       string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Domain            string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Endip             string                     `json:"endip,omitempty" xml:"endip,omitempty"`
+	Endipv6           string                     `json:"endipv6,omitempty" xml:"endipv6,omitempty"`
+	Forvirtualnetwork Bool                       `json:"forvirtualnetwork,omitempty" xml:"forvirtualnetwork,omitempty"`
+	Gateway           string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ip6cidr           string                     `json:"ip6cidr,omitempty" xml:"ip6cidr,omitempty"`
+	Ip6gateway        string                     `json:"ip6gateway,omitempty" xml:"ip6gateway,omitempty"`
+	Netmask           string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Networkid         string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Podid             string                     `json:"podid,omitempty" xml:"podid,omitempty"`
+	Podname           string                     `json:"podname,omitempty" xml:"podname,omitempty"`
+	Project           string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid         string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Startip           string                     `json:"startip,omitempty" xml:"startip,omitempty"`
+	Startipv6         string                     `json:"startipv6,omitempty" xml:"startipv6,omitempty"`
+	Vlan              string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the VLANService that is the same for all versions
+type VLANService interface {
+	NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams
+	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	StreamDedicatedGuestVlanRanges(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, fn func(*DedicatedGuestVlanRange) error) (int, error)
+	NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams
+	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	NewDedicateGuestVlanRangeParams(account string, domainid string, physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams
+	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams
+	CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams
+	DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	NewListVlanIpRangesParams() *ListVlanIpRangesParams
+	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	StreamVlanIpRanges(ctx context.Context, p *ListVlanIpRangesParams, fn func(*VlanIpRange) error) (int, error)
+}
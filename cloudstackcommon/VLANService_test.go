@@ -0,0 +1,269 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListDedicatedGuestVlanRanges(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"guestvlanrange":    {"test-guestvlanrange"},
+		"id":                {"test-id"},
+		"keyword":           {"test-keyword"},
+		"page":              {"6"},
+		"pagesize":          {"7"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"projectid":         {"test-projectid"},
+		"zoneid":            {"test-zoneid"},
+	}
+
+	p := &ListDedicatedGuestVlanRangesParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetGuestvlanrange("test-guestvlanrange")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetPage(6)
+	p.SetPagesize(7)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDedicatedGuestVlanRanges", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedGuestVlanRanges" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDedicatedGuestVlanRangesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDedicatedGuestVlanRanges", `{"listdedicatedguestvlanrangesresponse":{"count":1,"dedicatedguestvlanrange":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","guestvlanrange":"test-guestvlanrange","id":"test-id","physicalnetworkid":1,"project":"test-project","projectid":"test-projectid","zoneid":1}]}}`, false, r)
+	if r.DedicatedGuestVlanRanges[0].Account != "test-account" {
+		t.Errorf("Expected r.DedicatedGuestVlanRanges[0].Account to be %v, got %v", "test-account", r.DedicatedGuestVlanRanges[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestReleaseDedicatedGuestVlanRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &ReleaseDedicatedGuestVlanRangeParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releaseDedicatedGuestVlanRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedGuestVlanRange" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleaseDedicatedGuestVlanRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releaseDedicatedGuestVlanRange", `{"releasededicatedguestvlanrangeresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDedicateGuestVlanRange(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"projectid":         {"test-projectid"},
+		"vlanrange":         {"test-vlanrange"},
+	}
+
+	p := &DedicateGuestVlanRangeParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetProjectid("test-projectid")
+	p.SetVlanrange("test-vlanrange")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "dedicateGuestVlanRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateGuestVlanRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DedicateGuestVlanRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "dedicateGuestVlanRange", `{"dedicateguestvlanrangeresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","guestvlanrange":"test-guestvlanrange","id":"test-id","physicalnetworkid":1,"project":"test-project","projectid":"test-projectid","zoneid":1}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestCreateVlanIpRange(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"endip":             {"test-endip"},
+		"endipv6":           {"test-endipv6"},
+		"forvirtualnetwork": {"true"},
+		"gateway":           {"test-gateway"},
+		"ip6cidr":           {"test-ip6cidr"},
+		"ip6gateway":        {"test-ip6gateway"},
+		"netmask":           {"test-netmask"},
+		"networkid":         {"test-networkid"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"podid":             {"test-podid"},
+		"projectid":         {"test-projectid"},
+		"startip":           {"test-startip"},
+		"startipv6":         {"test-startipv6"},
+		"vlan":              {"test-vlan"},
+		"zoneid":            {"test-zoneid"},
+	}
+
+	p := &CreateVlanIpRangeParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetEndip("test-endip")
+	p.SetEndipv6("test-endipv6")
+	p.SetForvirtualnetwork(true)
+	p.SetGateway("test-gateway")
+	p.SetIp6cidr("test-ip6cidr")
+	p.SetIp6gateway("test-ip6gateway")
+	p.SetNetmask("test-netmask")
+	p.SetNetworkid("test-networkid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetStartip("test-startip")
+	p.SetStartipv6("test-startipv6")
+	p.SetVlan("test-vlan")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createVlanIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createVlanIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateVlanIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createVlanIpRange", `{"createvlaniprangeresponse":{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","endip":"test-endip","endipv6":"test-endipv6","forvirtualnetwork":true,"gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","netmask":"test-netmask","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","podid":"test-podid","podname":"test-podname","project":"test-project","projectid":"test-projectid","startip":"test-startip","startipv6":"test-startipv6","vlan":"test-vlan","zoneid":"test-zoneid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+}
+
+func TestDeleteVlanIpRange(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteVlanIpRangeParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVlanIpRange", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVlanIpRange" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVlanIpRangeResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVlanIpRange", `{"deletevlaniprangeresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListVlanIpRanges(t *testing.T) {
+	params := url.Values{
+		"account":           {"test-account"},
+		"domainid":          {"test-domainid"},
+		"forvirtualnetwork": {"true"},
+		"id":                {"test-id"},
+		"keyword":           {"test-keyword"},
+		"networkid":         {"test-networkid"},
+		"page":              {"7"},
+		"pagesize":          {"8"},
+		"physicalnetworkid": {"test-physicalnetworkid"},
+		"podid":             {"test-podid"},
+		"projectid":         {"test-projectid"},
+		"vlan":              {"test-vlan"},
+		"zoneid":            {"test-zoneid"},
+	}
+
+	p := &ListVlanIpRangesParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetForvirtualnetwork(true)
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetNetworkid("test-networkid")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetPodid("test-podid")
+	p.SetProjectid("test-projectid")
+	p.SetVlan("test-vlan")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listVlanIpRanges", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listVlanIpRanges" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListVlanIpRangesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listVlanIpRanges", `{"listvlaniprangesresponse":{"count":1,"vlaniprange":[{"account":"test-account","description":"test-description","domain":"test-domain","domainid":"test-domainid","endip":"test-endip","endipv6":"test-endipv6","forvirtualnetwork":true,"gateway":"test-gateway","id":"test-id","ip6cidr":"test-ip6cidr","ip6gateway":"test-ip6gateway","netmask":"test-netmask","networkid":"test-networkid","physicalnetworkid":"test-physicalnetworkid","podid":"test-podid","podname":"test-podname","project":"test-project","projectid":"test-projectid","startip":"test-startip","startipv6":"test-startipv6","vlan":"test-vlan","zoneid":"test-zoneid"}]}}`, false, r)
+	if r.VlanIpRanges[0].Account != "test-account" {
+		t.Errorf("Expected r.VlanIpRanges[0].Account to be %v, got %v", "test-account", r.VlanIpRanges[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
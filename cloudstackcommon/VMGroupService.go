@@ -0,0 +1,641 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type CreateInstanceGroupParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Name      *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateInstanceGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateInstanceGroupParams) Command() string {
+	return "createInstanceGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateInstanceGroupParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateInstanceGroupParams) ResponseType() interface{} {
+	return &CreateInstanceGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateInstanceGroupParams) Validate() error {
+	v := &validator{api: "createInstanceGroup"}
+	v.required("name", p.Name != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateInstanceGroupParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateInstanceGroupParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateInstanceGroupParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateInstanceGroupParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateInstanceGroupParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateInstanceGroupParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateInstanceGroupParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateInstanceGroupParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// This param is required.
+func (p *CreateInstanceGroupParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateInstanceGroupParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateInstanceGroupParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateInstanceGroupParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *CreateInstanceGroupParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *CreateInstanceGroupParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *CreateInstanceGroupParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *CreateInstanceGroupParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type CreateInstanceGroupResponse struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created   Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteInstanceGroupParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteInstanceGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteInstanceGroupParams) Command() string {
+	return "deleteInstanceGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteInstanceGroupParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteInstanceGroupParams) ResponseType() interface{} {
+	return &DeleteInstanceGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteInstanceGroupParams) Validate() error {
+	v := &validator{api: "deleteInstanceGroup"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteInstanceGroupParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteInstanceGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteInstanceGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteInstanceGroupParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteInstanceGroupResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListInstanceGroupsParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListInstanceGroupsParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListInstanceGroupsParams) Command() string {
+	return "listInstanceGroups"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListInstanceGroupsParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListInstanceGroupsParams) ResponseType() interface{} {
+	return &ListInstanceGroupsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListInstanceGroupsParams) Validate() error {
+	v := &validator{api: "listInstanceGroups"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListInstanceGroupsParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListInstanceGroupsParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListInstanceGroupsParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListInstanceGroupsParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListInstanceGroupsParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListInstanceGroupsParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListInstanceGroupsParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListInstanceGroupsParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListInstanceGroupsParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListInstanceGroupsParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListInstanceGroupsParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListInstanceGroupsParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetListall() {
+	p.Listall = nil
+}
+
+func (p *ListInstanceGroupsParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListInstanceGroupsParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetName() {
+	p.Name = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListInstanceGroupsParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListInstanceGroupsParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListInstanceGroupsParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListInstanceGroupsParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListInstanceGroupsParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListInstanceGroupsParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListInstanceGroupsParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListInstanceGroupsParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type ListInstanceGroupsResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	InstanceGroups []*InstanceGroup           `json:"instancegroup" xml:"instancegroup"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type InstanceGroup struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created   Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateInstanceGroupParams struct {
+	Id   *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateInstanceGroupParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateInstanceGroupParams) Command() string {
+	return "updateInstanceGroup"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateInstanceGroupParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateInstanceGroupParams) ResponseType() interface{} {
+	return &UpdateInstanceGroupResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateInstanceGroupParams) Validate() error {
+	v := &validator{api: "updateInstanceGroup"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *UpdateInstanceGroupParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateInstanceGroupParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateInstanceGroupParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateInstanceGroupParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateInstanceGroupParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateInstanceGroupParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateInstanceGroupParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateInstanceGroupParams) ResetName() {
+	p.Name = nil
+}
+
+type UpdateInstanceGroupResponse struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Created   Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name      string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the VMGroupService that is the same for all versions
+type VMGroupService interface {
+	NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams
+	CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams
+	DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	NewListInstanceGroupsParams() *ListInstanceGroupsParams
+	GetInstanceGroupID(name string, opts ...OptionFunc) (string, error)
+	GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	StreamInstanceGroups(ctx context.Context, p *ListInstanceGroupsParams, fn func(*InstanceGroup) error) (int, error)
+	NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams
+	UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+}
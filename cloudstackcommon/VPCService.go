@@ -0,0 +1,1425 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type CreatePrivateGatewayParams struct {
+	Aclid              *string `json:"aclid,omitempty" yaml:"aclid,omitempty"`
+	Gateway            *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ipaddress          *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Netmask            *string `json:"netmask,omitempty" yaml:"netmask,omitempty"`
+	Networkofferingid  *string `json:"networkofferingid,omitempty" yaml:"networkofferingid,omitempty"`
+	Physicalnetworkid  *string `json:"physicalnetworkid,omitempty" yaml:"physicalnetworkid,omitempty"`
+	Sourcenatsupported *bool   `json:"sourcenatsupported,omitempty" yaml:"sourcenatsupported,omitempty"`
+	Vlan               *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid              *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreatePrivateGatewayParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Aclid; v != nil {
+		u.Set("aclid", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
+	}
+	if v := p.Netmask; v != nil {
+		u.Set("netmask", *v)
+	}
+	if v := p.Networkofferingid; v != nil {
+		u.Set("networkofferingid", *v)
+	}
+	if v := p.Physicalnetworkid; v != nil {
+		u.Set("physicalnetworkid", *v)
+	}
+	if v := p.Sourcenatsupported; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("sourcenatsupported", vv)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreatePrivateGatewayParams) Command() string {
+	return "createPrivateGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreatePrivateGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreatePrivateGatewayParams) ResponseType() interface{} {
+	return &CreatePrivateGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreatePrivateGatewayParams) Validate() error {
+	v := &validator{api: "createPrivateGateway"}
+	v.required("gateway", p.Gateway != nil)
+	v.required("ipaddress", p.Ipaddress != nil)
+	v.required("netmask", p.Netmask != nil)
+	v.required("vlan", p.Vlan != nil)
+	v.required("vpcid", p.Vpcid != nil)
+	return v.err()
+}
+
+func (p *CreatePrivateGatewayParams) SetAclid(v string) {
+	p.Aclid = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetAclid() string {
+	if p.Aclid == nil {
+		return ""
+	}
+	return *p.Aclid
+}
+
+func (p *CreatePrivateGatewayParams) HasAclid() bool {
+	return p.Aclid != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetAclid() {
+	p.Aclid = nil
+}
+
+// This param is required.
+func (p *CreatePrivateGatewayParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreatePrivateGatewayParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+// This param is required.
+func (p *CreatePrivateGatewayParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
+	}
+	return *p.Ipaddress
+}
+
+func (p *CreatePrivateGatewayParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// Sets the 'netmask' param: the netmask. This param is required.
+func (p *CreatePrivateGatewayParams) SetNetmask(v string) {
+	p.Netmask = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetNetmask() string {
+	if p.Netmask == nil {
+		return ""
+	}
+	return *p.Netmask
+}
+
+func (p *CreatePrivateGatewayParams) HasNetmask() bool {
+	return p.Netmask != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetNetmask() {
+	p.Netmask = nil
+}
+
+func (p *CreatePrivateGatewayParams) SetNetworkofferingid(v string) {
+	p.Networkofferingid = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetNetworkofferingid() string {
+	if p.Networkofferingid == nil {
+		return ""
+	}
+	return *p.Networkofferingid
+}
+
+func (p *CreatePrivateGatewayParams) HasNetworkofferingid() bool {
+	return p.Networkofferingid != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetNetworkofferingid() {
+	p.Networkofferingid = nil
+}
+
+// Sets the 'physicalnetworkid' param: the ID of the physical network.
+func (p *CreatePrivateGatewayParams) SetPhysicalnetworkid(v string) {
+	p.Physicalnetworkid = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetPhysicalnetworkid() string {
+	if p.Physicalnetworkid == nil {
+		return ""
+	}
+	return *p.Physicalnetworkid
+}
+
+func (p *CreatePrivateGatewayParams) HasPhysicalnetworkid() bool {
+	return p.Physicalnetworkid != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetPhysicalnetworkid() {
+	p.Physicalnetworkid = nil
+}
+
+func (p *CreatePrivateGatewayParams) SetSourcenatsupported(v bool) {
+	p.Sourcenatsupported = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetSourcenatsupported() bool {
+	if p.Sourcenatsupported == nil {
+		return false
+	}
+	return *p.Sourcenatsupported
+}
+
+func (p *CreatePrivateGatewayParams) HasSourcenatsupported() bool {
+	return p.Sourcenatsupported != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetSourcenatsupported() {
+	p.Sourcenatsupported = nil
+}
+
+// This param is required.
+func (p *CreatePrivateGatewayParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *CreatePrivateGatewayParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC. This param is required.
+func (p *CreatePrivateGatewayParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *CreatePrivateGatewayParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *CreatePrivateGatewayParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *CreatePrivateGatewayParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+type CreatePrivateGatewayResponse struct {
+	JobID              string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account            string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid              string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway            string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress          string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Netmask            string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Physicalnetworkid  string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project            string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid          string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Sourcenatsupported Bool                       `json:"sourcenatsupported,omitempty" xml:"sourcenatsupported,omitempty"`
+	State              string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vlan               string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid              string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid             string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename           string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeletePrivateGatewayParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeletePrivateGatewayParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeletePrivateGatewayParams) Command() string {
+	return "deletePrivateGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeletePrivateGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeletePrivateGatewayParams) ResponseType() interface{} {
+	return &DeletePrivateGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeletePrivateGatewayParams) Validate() error {
+	v := &validator{api: "deletePrivateGateway"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeletePrivateGatewayParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeletePrivateGatewayParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeletePrivateGatewayParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeletePrivateGatewayParams) ResetId() {
+	p.Id = nil
+}
+
+type DeletePrivateGatewayResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListPrivateGatewaysParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ipaddress   *string `json:"ipaddress,omitempty" yaml:"ipaddress,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	State       *string `json:"state,omitempty" yaml:"state,omitempty"`
+	Vlan        *string `json:"vlan,omitempty" yaml:"vlan,omitempty"`
+	Vpcid       *string `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListPrivateGatewaysParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Ipaddress; v != nil {
+		u.Set("ipaddress", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.State; v != nil {
+		u.Set("state", *v)
+	}
+	if v := p.Vlan; v != nil {
+		u.Set("vlan", *v)
+	}
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListPrivateGatewaysParams) Command() string {
+	return "listPrivateGateways"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListPrivateGatewaysParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListPrivateGatewaysParams) ResponseType() interface{} {
+	return &ListPrivateGatewaysResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListPrivateGatewaysParams) Validate() error {
+	v := &validator{api: "listPrivateGateways"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListPrivateGatewaysParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListPrivateGatewaysParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListPrivateGatewaysParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListPrivateGatewaysParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListPrivateGatewaysParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListPrivateGatewaysParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *ListPrivateGatewaysParams) SetIpaddress(v string) {
+	p.Ipaddress = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetIpaddress() string {
+	if p.Ipaddress == nil {
+		return ""
+	}
+	return *p.Ipaddress
+}
+
+func (p *ListPrivateGatewaysParams) HasIpaddress() bool {
+	return p.Ipaddress != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetIpaddress() {
+	p.Ipaddress = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListPrivateGatewaysParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListPrivateGatewaysParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListPrivateGatewaysParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListPrivateGatewaysParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListPrivateGatewaysParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListPrivateGatewaysParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListPrivateGatewaysParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListPrivateGatewaysParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListPrivateGatewaysParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListPrivateGatewaysParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListPrivateGatewaysParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListPrivateGatewaysParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+func (p *ListPrivateGatewaysParams) SetState(v string) {
+	p.State = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetState() string {
+	if p.State == nil {
+		return ""
+	}
+	return *p.State
+}
+
+func (p *ListPrivateGatewaysParams) HasState() bool {
+	return p.State != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetState() {
+	p.State = nil
+}
+
+func (p *ListPrivateGatewaysParams) SetVlan(v string) {
+	p.Vlan = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetVlan() string {
+	if p.Vlan == nil {
+		return ""
+	}
+	return *p.Vlan
+}
+
+func (p *ListPrivateGatewaysParams) HasVlan() bool {
+	return p.Vlan != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetVlan() {
+	p.Vlan = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListPrivateGatewaysParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *ListPrivateGatewaysParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *ListPrivateGatewaysParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListPrivateGatewaysParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+type ListPrivateGatewaysResponse struct {
+	Count           int                        `json:"count" xml:"count"`
+	PrivateGateways []*PrivateGateway          `json:"privategateway" xml:"privategateway"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PrivateGateway struct {
+	Account            string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Aclid              string                     `json:"aclid,omitempty" xml:"aclid,omitempty"`
+	Domain             string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid           string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gateway            string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id                 string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ipaddress          string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Netmask            string                     `json:"netmask,omitempty" xml:"netmask,omitempty"`
+	Physicalnetworkid  string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Project            string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid          string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Sourcenatsupported Bool                       `json:"sourcenatsupported,omitempty" xml:"sourcenatsupported,omitempty"`
+	State              string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vlan               string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Vpcid              string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid             string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename           string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra              map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateStaticRouteParams struct {
+	Cidr      *string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+	Gatewayid *string `json:"gatewayid,omitempty" yaml:"gatewayid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateStaticRouteParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Cidr; v != nil {
+		u.Set("cidr", *v)
+	}
+	if v := p.Gatewayid; v != nil {
+		u.Set("gatewayid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateStaticRouteParams) Command() string {
+	return "createStaticRoute"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateStaticRouteParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateStaticRouteParams) ResponseType() interface{} {
+	return &CreateStaticRouteResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateStaticRouteParams) Validate() error {
+	v := &validator{api: "createStaticRoute"}
+	v.required("cidr", p.Cidr != nil)
+	v.required("gatewayid", p.Gatewayid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *CreateStaticRouteParams) SetCidr(v string) {
+	p.Cidr = &v
+	return
+}
+
+func (p *CreateStaticRouteParams) GetCidr() string {
+	if p.Cidr == nil {
+		return ""
+	}
+	return *p.Cidr
+}
+
+func (p *CreateStaticRouteParams) HasCidr() bool {
+	return p.Cidr != nil
+}
+
+func (p *CreateStaticRouteParams) ResetCidr() {
+	p.Cidr = nil
+}
+
+// This param is required.
+func (p *CreateStaticRouteParams) SetGatewayid(v string) {
+	p.Gatewayid = &v
+	return
+}
+
+func (p *CreateStaticRouteParams) GetGatewayid() string {
+	if p.Gatewayid == nil {
+		return ""
+	}
+	return *p.Gatewayid
+}
+
+func (p *CreateStaticRouteParams) HasGatewayid() bool {
+	return p.Gatewayid != nil
+}
+
+func (p *CreateStaticRouteParams) ResetGatewayid() {
+	p.Gatewayid = nil
+}
+
+type CreateStaticRouteResponse struct {
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr      string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gatewayid string                     `json:"gatewayid,omitempty" xml:"gatewayid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vpcid     string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteStaticRouteParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteStaticRouteParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteStaticRouteParams) Command() string {
+	return "deleteStaticRoute"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteStaticRouteParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteStaticRouteParams) ResponseType() interface{} {
+	return &DeleteStaticRouteResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteStaticRouteParams) Validate() error {
+	v := &validator{api: "deleteStaticRoute"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteStaticRouteParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteStaticRouteParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteStaticRouteParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteStaticRouteParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteStaticRouteResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListStaticRoutesParams struct {
+	Account     *string           `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Gatewayid   *string           `json:"gatewayid,omitempty" yaml:"gatewayid,omitempty"`
+	Id          *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool             `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool             `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string           `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Vpcid       *string           `json:"vpcid,omitempty" yaml:"vpcid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListStaticRoutesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Gatewayid; v != nil {
+		u.Set("gatewayid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	if v := p.Vpcid; v != nil {
+		u.Set("vpcid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListStaticRoutesParams) Command() string {
+	return "listStaticRoutes"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListStaticRoutesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListStaticRoutesParams) ResponseType() interface{} {
+	return &ListStaticRoutesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListStaticRoutesParams) Validate() error {
+	v := &validator{api: "listStaticRoutes"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListStaticRoutesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListStaticRoutesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListStaticRoutesParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListStaticRoutesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListStaticRoutesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListStaticRoutesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListStaticRoutesParams) SetGatewayid(v string) {
+	p.Gatewayid = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetGatewayid() string {
+	if p.Gatewayid == nil {
+		return ""
+	}
+	return *p.Gatewayid
+}
+
+func (p *ListStaticRoutesParams) HasGatewayid() bool {
+	return p.Gatewayid != nil
+}
+
+func (p *ListStaticRoutesParams) ResetGatewayid() {
+	p.Gatewayid = nil
+}
+
+func (p *ListStaticRoutesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListStaticRoutesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListStaticRoutesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListStaticRoutesParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListStaticRoutesParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListStaticRoutesParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListStaticRoutesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListStaticRoutesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListStaticRoutesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListStaticRoutesParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListStaticRoutesParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListStaticRoutesParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListStaticRoutesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListStaticRoutesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListStaticRoutesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListStaticRoutesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListStaticRoutesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListStaticRoutesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListStaticRoutesParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListStaticRoutesParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListStaticRoutesParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListStaticRoutesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListStaticRoutesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListStaticRoutesParams) ResetTags() {
+	p.Tags = nil
+}
+
+// Sets the 'vpcid' param: the ID of the VPC.
+func (p *ListStaticRoutesParams) SetVpcid(v string) {
+	p.Vpcid = &v
+	return
+}
+
+func (p *ListStaticRoutesParams) GetVpcid() string {
+	if p.Vpcid == nil {
+		return ""
+	}
+	return *p.Vpcid
+}
+
+func (p *ListStaticRoutesParams) HasVpcid() bool {
+	return p.Vpcid != nil
+}
+
+func (p *ListStaticRoutesParams) ResetVpcid() {
+	p.Vpcid = nil
+}
+
+type ListStaticRoutesResponse struct {
+	Count        int                        `json:"count" xml:"count"`
+	StaticRoutes []*StaticRoute             `json:"staticroute" xml:"staticroute"`
+	Extra        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type StaticRoute struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidr      string                     `json:"cidr,omitempty" xml:"cidr,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Gatewayid string                     `json:"gatewayid,omitempty" xml:"gatewayid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Tags      []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vpcid     string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVPCParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVPCParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVPCParams) Command() string {
+	return "deleteVPC"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVPCParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVPCParams) ResponseType() interface{} {
+	return &DeleteVPCResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVPCParams) Validate() error {
+	v := &validator{api: "deleteVPC"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVPCParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVPCParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVPCParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVPCParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVPCResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVPCOfferingParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVPCOfferingParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVPCOfferingParams) Command() string {
+	return "deleteVPCOffering"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVPCOfferingParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVPCOfferingParams) ResponseType() interface{} {
+	return &DeleteVPCOfferingResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVPCOfferingParams) Validate() error {
+	v := &validator{api: "deleteVPCOffering"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVPCOfferingParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVPCOfferingParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVPCOfferingParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVPCOfferingParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVPCOfferingResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the VPCService that is the same for all versions
+type VPCService interface {
+	NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vlan string, vpcid string) *CreatePrivateGatewayParams
+	CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams
+	DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	NewListPrivateGatewaysParams() *ListPrivateGatewaysParams
+	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	StreamPrivateGateways(ctx context.Context, p *ListPrivateGatewaysParams, fn func(*PrivateGateway) error) (int, error)
+	NewCreateStaticRouteParams(cidr string, gatewayid string) *CreateStaticRouteParams
+	CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams
+	DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	NewListStaticRoutesParams() *ListStaticRoutesParams
+	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	GetStaticRoutesByTags(tags map[string]string, opts ...OptionFunc) ([]*StaticRoute, error)
+	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	StreamStaticRoutes(ctx context.Context, p *ListStaticRoutesParams, fn func(*StaticRoute) error) (int, error)
+	NewDeleteVPCParams(id string) *DeleteVPCParams
+	DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams
+	DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+}
@@ -0,0 +1,319 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestCreatePrivateGateway(t *testing.T) {
+	params := url.Values{
+		"aclid":              {"test-aclid"},
+		"gateway":            {"test-gateway"},
+		"ipaddress":          {"test-ipaddress"},
+		"netmask":            {"test-netmask"},
+		"networkofferingid":  {"test-networkofferingid"},
+		"physicalnetworkid":  {"test-physicalnetworkid"},
+		"sourcenatsupported": {"true"},
+		"vlan":               {"test-vlan"},
+		"vpcid":              {"test-vpcid"},
+	}
+
+	p := &CreatePrivateGatewayParams{}
+	p.SetAclid("test-aclid")
+	p.SetGateway("test-gateway")
+	p.SetIpaddress("test-ipaddress")
+	p.SetNetmask("test-netmask")
+	p.SetNetworkofferingid("test-networkofferingid")
+	p.SetPhysicalnetworkid("test-physicalnetworkid")
+	p.SetSourcenatsupported(true)
+	p.SetVlan("test-vlan")
+	p.SetVpcid("test-vpcid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createPrivateGateway", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createPrivateGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreatePrivateGatewayResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createPrivateGateway", `{"createprivategatewayresponse":{"account":"test-account","aclid":"test-aclid","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ipaddress":"test-ipaddress","jobid":"test-jobid","netmask":"test-netmask","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","sourcenatsupported":true,"state":"test-state","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeletePrivateGateway(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeletePrivateGatewayParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deletePrivateGateway", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deletePrivateGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeletePrivateGatewayResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deletePrivateGateway", `{"deleteprivategatewayresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListPrivateGateways(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"ipaddress":   {"test-ipaddress"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"8"},
+		"pagesize":    {"9"},
+		"projectid":   {"test-projectid"},
+		"state":       {"test-state"},
+		"vlan":        {"test-vlan"},
+		"vpcid":       {"test-vpcid"},
+	}
+
+	p := &ListPrivateGatewaysParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIpaddress("test-ipaddress")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetState("test-state")
+	p.SetVlan("test-vlan")
+	p.SetVpcid("test-vpcid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listPrivateGateways", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listPrivateGateways" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListPrivateGatewaysResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listPrivateGateways", `{"listprivategatewaysresponse":{"count":1,"privategateway":[{"account":"test-account","aclid":"test-aclid","domain":"test-domain","domainid":"test-domainid","gateway":"test-gateway","id":"test-id","ipaddress":"test-ipaddress","netmask":"test-netmask","physicalnetworkid":"test-physicalnetworkid","project":"test-project","projectid":"test-projectid","sourcenatsupported":true,"state":"test-state","vlan":"test-vlan","vpcid":"test-vpcid","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.PrivateGateways[0].Account != "test-account" {
+		t.Errorf("Expected r.PrivateGateways[0].Account to be %v, got %v", "test-account", r.PrivateGateways[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestCreateStaticRoute(t *testing.T) {
+	params := url.Values{
+		"cidr":      {"test-cidr"},
+		"gatewayid": {"test-gatewayid"},
+	}
+
+	p := &CreateStaticRouteParams{}
+	p.SetCidr("test-cidr")
+	p.SetGatewayid("test-gatewayid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createStaticRoute", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createStaticRoute" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateStaticRouteResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createStaticRoute", `{"createstaticrouteresponse":{"account":"test-account","cidr":"test-cidr","domain":"test-domain","domainid":"test-domainid","gatewayid":"test-gatewayid","id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcid":"test-vpcid"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteStaticRoute(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteStaticRouteParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteStaticRoute", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteStaticRoute" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteStaticRouteResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteStaticRoute", `{"deletestaticrouteresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListStaticRoutes(t *testing.T) {
+	params := url.Values{
+		"account":       {"test-account"},
+		"domainid":      {"test-domainid"},
+		"gatewayid":     {"test-gatewayid"},
+		"id":            {"test-id"},
+		"isrecursive":   {"true"},
+		"keyword":       {"test-keyword"},
+		"listall":       {"true"},
+		"page":          {"8"},
+		"pagesize":      {"9"},
+		"projectid":     {"test-projectid"},
+		"tags[0].key":   {"test-tagskey"},
+		"tags[0].value": {"test-tagsvalue"},
+		"vpcid":         {"test-vpcid"},
+	}
+
+	p := &ListStaticRoutesParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetGatewayid("test-gatewayid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(8)
+	p.SetPagesize(9)
+	p.SetProjectid("test-projectid")
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	p.SetVpcid("test-vpcid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listStaticRoutes", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listStaticRoutes" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListStaticRoutesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listStaticRoutes", `{"liststaticroutesresponse":{"count":1,"staticroute":[{"account":"test-account","cidr":"test-cidr","domain":"test-domain","domainid":"test-domainid","gatewayid":"test-gatewayid","id":"test-id","project":"test-project","projectid":"test-projectid","state":"test-state","tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vpcid":"test-vpcid"}]}}`, false, r)
+	if r.StaticRoutes[0].Account != "test-account" {
+		t.Errorf("Expected r.StaticRoutes[0].Account to be %v, got %v", "test-account", r.StaticRoutes[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestDeleteVPC(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteVPCParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVPC", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVPC" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVPCResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVPC", `{"deletevpcresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteVPCOffering(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteVPCOfferingParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVPCOffering", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVPCOffering" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVPCOfferingResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVPCOffering", `{"deletevpcofferingresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
@@ -0,0 +1,1898 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+)
+
+type DeleteRemoteAccessVpnParams struct {
+	Publicipid *string `json:"publicipid,omitempty" yaml:"publicipid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteRemoteAccessVpnParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Publicipid; v != nil {
+		u.Set("publicipid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteRemoteAccessVpnParams) Command() string {
+	return "deleteRemoteAccessVpn"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteRemoteAccessVpnParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteRemoteAccessVpnParams) ResponseType() interface{} {
+	return &DeleteRemoteAccessVpnResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteRemoteAccessVpnParams) Validate() error {
+	v := &validator{api: "deleteRemoteAccessVpn"}
+	v.required("publicipid", p.Publicipid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
+	p.Publicipid = &v
+	return
+}
+
+func (p *DeleteRemoteAccessVpnParams) GetPublicipid() string {
+	if p.Publicipid == nil {
+		return ""
+	}
+	return *p.Publicipid
+}
+
+func (p *DeleteRemoteAccessVpnParams) HasPublicipid() bool {
+	return p.Publicipid != nil
+}
+
+func (p *DeleteRemoteAccessVpnParams) ResetPublicipid() {
+	p.Publicipid = nil
+}
+
+type DeleteRemoteAccessVpnResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVpnConnectionParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVpnConnectionParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVpnConnectionParams) Command() string {
+	return "deleteVpnConnection"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVpnConnectionParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVpnConnectionParams) ResponseType() interface{} {
+	return &DeleteVpnConnectionResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVpnConnectionParams) Validate() error {
+	v := &validator{api: "deleteVpnConnection"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVpnConnectionParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVpnConnectionParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVpnConnectionParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVpnConnectionParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVpnConnectionResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateVpnCustomerGatewayParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist    *string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Dpd         *bool   `json:"dpd,omitempty" yaml:"dpd,omitempty"`
+	Esplifetime *int64  `json:"esplifetime,omitempty" yaml:"esplifetime,omitempty"`
+	Esppolicy   *string `json:"esppolicy,omitempty" yaml:"esppolicy,omitempty"`
+	Gateway     *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Ikelifetime *int64  `json:"ikelifetime,omitempty" yaml:"ikelifetime,omitempty"`
+	Ikepolicy   *string `json:"ikepolicy,omitempty" yaml:"ikepolicy,omitempty"`
+	Ipsecpsk    *string `json:"ipsecpsk,omitempty" yaml:"ipsecpsk,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateVpnCustomerGatewayParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Cidrlist; v != nil {
+		u.Set("cidrlist", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Dpd; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("dpd", vv)
+	}
+	if v := p.Esplifetime; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("esplifetime", vv)
+	}
+	if v := p.Esppolicy; v != nil {
+		u.Set("esppolicy", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Ikelifetime; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("ikelifetime", vv)
+	}
+	if v := p.Ikepolicy; v != nil {
+		u.Set("ikepolicy", *v)
+	}
+	if v := p.Ipsecpsk; v != nil {
+		u.Set("ipsecpsk", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateVpnCustomerGatewayParams) Command() string {
+	return "createVpnCustomerGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateVpnCustomerGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateVpnCustomerGatewayParams) ResponseType() interface{} {
+	return &CreateVpnCustomerGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateVpnCustomerGatewayParams) Validate() error {
+	v := &validator{api: "createVpnCustomerGateway"}
+	v.required("cidrlist", p.Cidrlist != nil)
+	v.required("esppolicy", p.Esppolicy != nil)
+	v.required("gateway", p.Gateway != nil)
+	v.required("ikepolicy", p.Ikepolicy != nil)
+	v.required("ipsecpsk", p.Ipsecpsk != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs. This param is required.
+func (p *CreateVpnCustomerGatewayParams) SetCidrlist(v string) {
+	p.Cidrlist = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetCidrlist() string {
+	if p.Cidrlist == nil {
+		return ""
+	}
+	return *p.Cidrlist
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateVpnCustomerGatewayParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) SetDpd(v bool) {
+	p.Dpd = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetDpd() bool {
+	if p.Dpd == nil {
+		return false
+	}
+	return *p.Dpd
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasDpd() bool {
+	return p.Dpd != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetDpd() {
+	p.Dpd = nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
+	p.Esplifetime = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetEsplifetime() int64 {
+	if p.Esplifetime == nil {
+		return 0
+	}
+	return *p.Esplifetime
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasEsplifetime() bool {
+	return p.Esplifetime != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetEsplifetime() {
+	p.Esplifetime = nil
+}
+
+// This param is required.
+func (p *CreateVpnCustomerGatewayParams) SetEsppolicy(v string) {
+	p.Esppolicy = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetEsppolicy() string {
+	if p.Esppolicy == nil {
+		return ""
+	}
+	return *p.Esppolicy
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasEsppolicy() bool {
+	return p.Esppolicy != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetEsppolicy() {
+	p.Esppolicy = nil
+}
+
+// This param is required.
+func (p *CreateVpnCustomerGatewayParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
+	p.Ikelifetime = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIkelifetime() int64 {
+	if p.Ikelifetime == nil {
+		return 0
+	}
+	return *p.Ikelifetime
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasIkelifetime() bool {
+	return p.Ikelifetime != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetIkelifetime() {
+	p.Ikelifetime = nil
+}
+
+// This param is required.
+func (p *CreateVpnCustomerGatewayParams) SetIkepolicy(v string) {
+	p.Ikepolicy = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIkepolicy() string {
+	if p.Ikepolicy == nil {
+		return ""
+	}
+	return *p.Ikepolicy
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasIkepolicy() bool {
+	return p.Ikepolicy != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetIkepolicy() {
+	p.Ikepolicy = nil
+}
+
+// This param is required.
+func (p *CreateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
+	p.Ipsecpsk = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetIpsecpsk() string {
+	if p.Ipsecpsk == nil {
+		return ""
+	}
+	return *p.Ipsecpsk
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasIpsecpsk() bool {
+	return p.Ipsecpsk != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetIpsecpsk() {
+	p.Ipsecpsk = nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateVpnCustomerGatewayParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateVpnCustomerGatewayParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateVpnCustomerGatewayParams) ResetName() {
+	p.Name = nil
+}
+
+type CreateVpnCustomerGatewayResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd         Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy   string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Gateway     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy   string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipsecpsk    string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed     Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVpnCustomerGatewayParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVpnCustomerGatewayParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVpnCustomerGatewayParams) Command() string {
+	return "deleteVpnCustomerGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVpnCustomerGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVpnCustomerGatewayParams) ResponseType() interface{} {
+	return &DeleteVpnCustomerGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVpnCustomerGatewayParams) Validate() error {
+	v := &validator{api: "deleteVpnCustomerGateway"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVpnCustomerGatewayParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVpnCustomerGatewayParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVpnCustomerGatewayParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVpnCustomerGatewayResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListVpnCustomerGatewaysParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVpnCustomerGatewaysParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListVpnCustomerGatewaysParams) Command() string {
+	return "listVpnCustomerGateways"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVpnCustomerGatewaysParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVpnCustomerGatewaysParams) ResponseType() interface{} {
+	return &ListVpnCustomerGatewaysResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVpnCustomerGatewaysParams) Validate() error {
+	v := &validator{api: "listVpnCustomerGateways"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVpnCustomerGatewaysParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVpnCustomerGatewaysParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVpnCustomerGatewaysParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVpnCustomerGatewaysParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVpnCustomerGatewaysParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVpnCustomerGatewaysParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVpnCustomerGatewaysParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListVpnCustomerGatewaysParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListVpnCustomerGatewaysParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVpnCustomerGatewaysParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+type ListVpnCustomerGatewaysResponse struct {
+	Count               int                        `json:"count" xml:"count"`
+	VpnCustomerGateways []*VpnCustomerGateway      `json:"vpncustomergateway" xml:"vpncustomergateway"`
+	Extra               map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VpnCustomerGateway struct {
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd         Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy   string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Gateway     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy   string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipsecpsk    string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed     Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateVpnCustomerGatewayParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Cidrlist    *string `json:"cidrlist,omitempty" yaml:"cidrlist,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Dpd         *bool   `json:"dpd,omitempty" yaml:"dpd,omitempty"`
+	Esplifetime *int64  `json:"esplifetime,omitempty" yaml:"esplifetime,omitempty"`
+	Esppolicy   *string `json:"esppolicy,omitempty" yaml:"esppolicy,omitempty"`
+	Gateway     *string `json:"gateway,omitempty" yaml:"gateway,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Ikelifetime *int64  `json:"ikelifetime,omitempty" yaml:"ikelifetime,omitempty"`
+	Ikepolicy   *string `json:"ikepolicy,omitempty" yaml:"ikepolicy,omitempty"`
+	Ipsecpsk    *string `json:"ipsecpsk,omitempty" yaml:"ipsecpsk,omitempty"`
+	Name        *string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateVpnCustomerGatewayParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Cidrlist; v != nil {
+		u.Set("cidrlist", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Dpd; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("dpd", vv)
+	}
+	if v := p.Esplifetime; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("esplifetime", vv)
+	}
+	if v := p.Esppolicy; v != nil {
+		u.Set("esppolicy", *v)
+	}
+	if v := p.Gateway; v != nil {
+		u.Set("gateway", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Ikelifetime; v != nil {
+		vv := strconv.FormatInt(*v, 10)
+		u.Set("ikelifetime", vv)
+	}
+	if v := p.Ikepolicy; v != nil {
+		u.Set("ikepolicy", *v)
+	}
+	if v := p.Ipsecpsk; v != nil {
+		u.Set("ipsecpsk", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateVpnCustomerGatewayParams) Command() string {
+	return "updateVpnCustomerGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateVpnCustomerGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateVpnCustomerGatewayParams) ResponseType() interface{} {
+	return &UpdateVpnCustomerGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateVpnCustomerGatewayParams) Validate() error {
+	v := &validator{api: "updateVpnCustomerGateway"}
+	v.required("cidrlist", p.Cidrlist != nil)
+	v.required("esppolicy", p.Esppolicy != nil)
+	v.required("gateway", p.Gateway != nil)
+	v.required("id", p.Id != nil)
+	v.required("ikepolicy", p.Ikepolicy != nil)
+	v.required("ipsecpsk", p.Ipsecpsk != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'cidrlist' param: the list of CIDRs. This param is required.
+func (p *UpdateVpnCustomerGatewayParams) SetCidrlist(v string) {
+	p.Cidrlist = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetCidrlist() string {
+	if p.Cidrlist == nil {
+		return ""
+	}
+	return *p.Cidrlist
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasCidrlist() bool {
+	return p.Cidrlist != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetCidrlist() {
+	p.Cidrlist = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *UpdateVpnCustomerGatewayParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) SetDpd(v bool) {
+	p.Dpd = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetDpd() bool {
+	if p.Dpd == nil {
+		return false
+	}
+	return *p.Dpd
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasDpd() bool {
+	return p.Dpd != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetDpd() {
+	p.Dpd = nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) SetEsplifetime(v int64) {
+	p.Esplifetime = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetEsplifetime() int64 {
+	if p.Esplifetime == nil {
+		return 0
+	}
+	return *p.Esplifetime
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasEsplifetime() bool {
+	return p.Esplifetime != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetEsplifetime() {
+	p.Esplifetime = nil
+}
+
+// This param is required.
+func (p *UpdateVpnCustomerGatewayParams) SetEsppolicy(v string) {
+	p.Esppolicy = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetEsppolicy() string {
+	if p.Esppolicy == nil {
+		return ""
+	}
+	return *p.Esppolicy
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasEsppolicy() bool {
+	return p.Esppolicy != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetEsppolicy() {
+	p.Esppolicy = nil
+}
+
+// This param is required.
+func (p *UpdateVpnCustomerGatewayParams) SetGateway(v string) {
+	p.Gateway = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetGateway() string {
+	if p.Gateway == nil {
+		return ""
+	}
+	return *p.Gateway
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasGateway() bool {
+	return p.Gateway != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetGateway() {
+	p.Gateway = nil
+}
+
+// This param is required.
+func (p *UpdateVpnCustomerGatewayParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) SetIkelifetime(v int64) {
+	p.Ikelifetime = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIkelifetime() int64 {
+	if p.Ikelifetime == nil {
+		return 0
+	}
+	return *p.Ikelifetime
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasIkelifetime() bool {
+	return p.Ikelifetime != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetIkelifetime() {
+	p.Ikelifetime = nil
+}
+
+// This param is required.
+func (p *UpdateVpnCustomerGatewayParams) SetIkepolicy(v string) {
+	p.Ikepolicy = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIkepolicy() string {
+	if p.Ikepolicy == nil {
+		return ""
+	}
+	return *p.Ikepolicy
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasIkepolicy() bool {
+	return p.Ikepolicy != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetIkepolicy() {
+	p.Ikepolicy = nil
+}
+
+// This param is required.
+func (p *UpdateVpnCustomerGatewayParams) SetIpsecpsk(v string) {
+	p.Ipsecpsk = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetIpsecpsk() string {
+	if p.Ipsecpsk == nil {
+		return ""
+	}
+	return *p.Ipsecpsk
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasIpsecpsk() bool {
+	return p.Ipsecpsk != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetIpsecpsk() {
+	p.Ipsecpsk = nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateVpnCustomerGatewayParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateVpnCustomerGatewayParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateVpnCustomerGatewayParams) ResetName() {
+	p.Name = nil
+}
+
+type UpdateVpnCustomerGatewayResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account     string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Cidrlist    string                     `json:"cidrlist,omitempty" xml:"cidrlist,omitempty"`
+	Domain      string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid    string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Dpd         Bool                       `json:"dpd,omitempty" xml:"dpd,omitempty"`
+	Esplifetime int64                      `json:"esplifetime,omitempty" xml:"esplifetime,omitempty"`
+	Esppolicy   string                     `json:"esppolicy,omitempty" xml:"esppolicy,omitempty"`
+	Gateway     string                     `json:"gateway,omitempty" xml:"gateway,omitempty"`
+	Id          string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Ikelifetime int64                      `json:"ikelifetime,omitempty" xml:"ikelifetime,omitempty"`
+	Ikepolicy   string                     `json:"ikepolicy,omitempty" xml:"ikepolicy,omitempty"`
+	Ipaddress   string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Ipsecpsk    string                     `json:"ipsecpsk,omitempty" xml:"ipsecpsk,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Project     string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid   string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	Removed     Date                       `json:"removed,omitempty" xml:"removed,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteVpnGatewayParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVpnGatewayParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVpnGatewayParams) Command() string {
+	return "deleteVpnGateway"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVpnGatewayParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVpnGatewayParams) ResponseType() interface{} {
+	return &DeleteVpnGatewayResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVpnGatewayParams) Validate() error {
+	v := &validator{api: "deleteVpnGateway"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVpnGatewayParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVpnGatewayParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVpnGatewayParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVpnGatewayParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVpnGatewayResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type AddVpnUserParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Password  *string `json:"password,omitempty" yaml:"password,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *AddVpnUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Password; v != nil {
+		u.Set("password", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *AddVpnUserParams) Command() string {
+	return "addVpnUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *AddVpnUserParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *AddVpnUserParams) ResponseType() interface{} {
+	return &AddVpnUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *AddVpnUserParams) Validate() error {
+	v := &validator{api: "addVpnUser"}
+	v.required("password", p.Password != nil)
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *AddVpnUserParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *AddVpnUserParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *AddVpnUserParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *AddVpnUserParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *AddVpnUserParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *AddVpnUserParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *AddVpnUserParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *AddVpnUserParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'password' param: the password. This param is required.
+func (p *AddVpnUserParams) SetPassword(v string) {
+	p.Password = &v
+	return
+}
+
+func (p *AddVpnUserParams) GetPassword() string {
+	if p.Password == nil {
+		return ""
+	}
+	return *p.Password
+}
+
+func (p *AddVpnUserParams) HasPassword() bool {
+	return p.Password != nil
+}
+
+func (p *AddVpnUserParams) ResetPassword() {
+	p.Password = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *AddVpnUserParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *AddVpnUserParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *AddVpnUserParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *AddVpnUserParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *AddVpnUserParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *AddVpnUserParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *AddVpnUserParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *AddVpnUserParams) ResetUsername() {
+	p.Username = nil
+}
+
+type AddVpnUserResponse struct {
+	JobID     string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Username  string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListVpnUsersParams struct {
+	Account     *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid    *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id          *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Isrecursive *bool   `json:"isrecursive,omitempty" yaml:"isrecursive,omitempty"`
+	Keyword     *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Listall     *bool   `json:"listall,omitempty" yaml:"listall,omitempty"`
+	Page        *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize    *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Projectid   *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Username    *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListVpnUsersParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Isrecursive; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("isrecursive", vv)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Listall; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("listall", vv)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListVpnUsersParams) Command() string {
+	return "listVpnUsers"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListVpnUsersParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListVpnUsersParams) ResponseType() interface{} {
+	return &ListVpnUsersResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListVpnUsersParams) Validate() error {
+	v := &validator{api: "listVpnUsers"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListVpnUsersParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListVpnUsersParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListVpnUsersParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListVpnUsersParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListVpnUsersParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListVpnUsersParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListVpnUsersParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListVpnUsersParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListVpnUsersParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'isrecursive' param: false by default; if true, the resources of the given domain and all its subdomains are listed.
+func (p *ListVpnUsersParams) SetIsrecursive(v bool) {
+	p.Isrecursive = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetIsrecursive() bool {
+	if p.Isrecursive == nil {
+		return false
+	}
+	return *p.Isrecursive
+}
+
+func (p *ListVpnUsersParams) HasIsrecursive() bool {
+	return p.Isrecursive != nil
+}
+
+func (p *ListVpnUsersParams) ResetIsrecursive() {
+	p.Isrecursive = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListVpnUsersParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListVpnUsersParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListVpnUsersParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'listall' param: false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed.
+func (p *ListVpnUsersParams) SetListall(v bool) {
+	p.Listall = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetListall() bool {
+	if p.Listall == nil {
+		return false
+	}
+	return *p.Listall
+}
+
+func (p *ListVpnUsersParams) HasListall() bool {
+	return p.Listall != nil
+}
+
+func (p *ListVpnUsersParams) ResetListall() {
+	p.Listall = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListVpnUsersParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListVpnUsersParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListVpnUsersParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListVpnUsersParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListVpnUsersParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListVpnUsersParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *ListVpnUsersParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *ListVpnUsersParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *ListVpnUsersParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'username' param: the name of the user.
+func (p *ListVpnUsersParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *ListVpnUsersParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *ListVpnUsersParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *ListVpnUsersParams) ResetUsername() {
+	p.Username = nil
+}
+
+type ListVpnUsersResponse struct {
+	Count    int                        `json:"count" xml:"count"`
+	VpnUsers []*VpnUser                 `json:"vpnuser" xml:"vpnuser"`
+	Extra    map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type VpnUser struct {
+	Account   string                     `json:"account,omitempty" xml:"account,omitempty"`
+	Domain    string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid  string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id        string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Project   string                     `json:"project,omitempty" xml:"project,omitempty"`
+	Projectid string                     `json:"projectid,omitempty" xml:"projectid,omitempty"`
+	State     string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Username  string                     `json:"username,omitempty" xml:"username,omitempty"`
+	Extra     map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type RemoveVpnUserParams struct {
+	Account   *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid  *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Projectid *string `json:"projectid,omitempty" yaml:"projectid,omitempty"`
+	Username  *string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *RemoveVpnUserParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Projectid; v != nil {
+		u.Set("projectid", *v)
+	}
+	if v := p.Username; v != nil {
+		u.Set("username", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *RemoveVpnUserParams) Command() string {
+	return "removeVpnUser"
+}
+
+// Returns true if the API command starts an async job
+func (p *RemoveVpnUserParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *RemoveVpnUserParams) ResponseType() interface{} {
+	return &RemoveVpnUserResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *RemoveVpnUserParams) Validate() error {
+	v := &validator{api: "removeVpnUser"}
+	v.required("username", p.Username != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *RemoveVpnUserParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *RemoveVpnUserParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *RemoveVpnUserParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *RemoveVpnUserParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *RemoveVpnUserParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *RemoveVpnUserParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *RemoveVpnUserParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *RemoveVpnUserParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'projectid' param: the ID of the project.
+func (p *RemoveVpnUserParams) SetProjectid(v string) {
+	p.Projectid = &v
+	return
+}
+
+func (p *RemoveVpnUserParams) GetProjectid() string {
+	if p.Projectid == nil {
+		return ""
+	}
+	return *p.Projectid
+}
+
+func (p *RemoveVpnUserParams) HasProjectid() bool {
+	return p.Projectid != nil
+}
+
+func (p *RemoveVpnUserParams) ResetProjectid() {
+	p.Projectid = nil
+}
+
+// Sets the 'username' param: the name of the user. This param is required.
+func (p *RemoveVpnUserParams) SetUsername(v string) {
+	p.Username = &v
+	return
+}
+
+func (p *RemoveVpnUserParams) GetUsername() string {
+	if p.Username == nil {
+		return ""
+	}
+	return *p.Username
+}
+
+func (p *RemoveVpnUserParams) HasUsername() bool {
+	return p.Username != nil
+}
+
+func (p *RemoveVpnUserParams) ResetUsername() {
+	p.Username = nil
+}
+
+type RemoveVpnUserResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the VPNService that is the same for all versions
+type VPNService interface {
+	NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams
+	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams
+	DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams
+	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams
+	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams
+	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, error)
+	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	StreamVpnCustomerGateways(ctx context.Context, p *ListVpnCustomerGatewaysParams, fn func(*VpnCustomerGateway) error) (int, error)
+	NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams
+	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams
+	DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	NewAddVpnUserParams(password string, username string) *AddVpnUserParams
+	AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	NewListVpnUsersParams() *ListVpnUsersParams
+	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	StreamVpnUsers(ctx context.Context, p *ListVpnUsersParams, fn func(*VpnUser) error) (int, error)
+	NewRemoveVpnUserParams(username string) *RemoveVpnUserParams
+	RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+}
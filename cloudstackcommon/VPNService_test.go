@@ -0,0 +1,402 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestDeleteRemoteAccessVpn(t *testing.T) {
+	params := url.Values{
+		"publicipid": {"test-publicipid"},
+	}
+
+	p := &DeleteRemoteAccessVpnParams{}
+	p.SetPublicipid("test-publicipid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteRemoteAccessVpn", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteRemoteAccessVpn" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteRemoteAccessVpnResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteRemoteAccessVpn", `{"deleteremoteaccessvpnresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteVpnConnection(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteVpnConnectionParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVpnConnection", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVpnConnection" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVpnConnectionResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVpnConnection", `{"deletevpnconnectionresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestCreateVpnCustomerGateway(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"cidrlist":    {"test-cidrlist"},
+		"domainid":    {"test-domainid"},
+		"dpd":         {"true"},
+		"esplifetime": {"5"},
+		"esppolicy":   {"test-esppolicy"},
+		"gateway":     {"test-gateway"},
+		"ikelifetime": {"8"},
+		"ikepolicy":   {"test-ikepolicy"},
+		"ipsecpsk":    {"test-ipsecpsk"},
+		"name":        {"test-name"},
+	}
+
+	p := &CreateVpnCustomerGatewayParams{}
+	p.SetAccount("test-account")
+	p.SetCidrlist("test-cidrlist")
+	p.SetDomainid("test-domainid")
+	p.SetDpd(true)
+	p.SetEsplifetime(5)
+	p.SetEsppolicy("test-esppolicy")
+	p.SetGateway("test-gateway")
+	p.SetIkelifetime(8)
+	p.SetIkepolicy("test-ikepolicy")
+	p.SetIpsecpsk("test-ipsecpsk")
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createVpnCustomerGateway", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createVpnCustomerGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateVpnCustomerGatewayResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createVpnCustomerGateway", `{"createvpncustomergatewayresponse":{"account":"test-account","cidrlist":"test-cidrlist","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipaddress":"test-ipaddress","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteVpnCustomerGateway(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteVpnCustomerGatewayParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVpnCustomerGateway", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVpnCustomerGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVpnCustomerGatewayResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVpnCustomerGateway", `{"deletevpncustomergatewayresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListVpnCustomerGateways(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"7"},
+		"pagesize":    {"8"},
+		"projectid":   {"test-projectid"},
+	}
+
+	p := &ListVpnCustomerGatewaysParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listVpnCustomerGateways", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listVpnCustomerGateways" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListVpnCustomerGatewaysResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listVpnCustomerGateways", `{"listvpncustomergatewaysresponse":{"count":1,"vpncustomergateway":[{"account":"test-account","cidrlist":"test-cidrlist","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipaddress":"test-ipaddress","ipsecpsk":"test-ipsecpsk","name":"test-name","project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100"}]}}`, false, r)
+	if r.VpnCustomerGateways[0].Account != "test-account" {
+		t.Errorf("Expected r.VpnCustomerGateways[0].Account to be %v, got %v", "test-account", r.VpnCustomerGateways[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateVpnCustomerGateway(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"cidrlist":    {"test-cidrlist"},
+		"domainid":    {"test-domainid"},
+		"dpd":         {"true"},
+		"esplifetime": {"5"},
+		"esppolicy":   {"test-esppolicy"},
+		"gateway":     {"test-gateway"},
+		"id":          {"test-id"},
+		"ikelifetime": {"9"},
+		"ikepolicy":   {"test-ikepolicy"},
+		"ipsecpsk":    {"test-ipsecpsk"},
+		"name":        {"test-name"},
+	}
+
+	p := &UpdateVpnCustomerGatewayParams{}
+	p.SetAccount("test-account")
+	p.SetCidrlist("test-cidrlist")
+	p.SetDomainid("test-domainid")
+	p.SetDpd(true)
+	p.SetEsplifetime(5)
+	p.SetEsppolicy("test-esppolicy")
+	p.SetGateway("test-gateway")
+	p.SetId("test-id")
+	p.SetIkelifetime(9)
+	p.SetIkepolicy("test-ikepolicy")
+	p.SetIpsecpsk("test-ipsecpsk")
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateVpnCustomerGateway", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateVpnCustomerGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateVpnCustomerGatewayResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateVpnCustomerGateway", `{"updatevpncustomergatewayresponse":{"account":"test-account","cidrlist":"test-cidrlist","domain":"test-domain","domainid":"test-domainid","dpd":true,"esplifetime":1,"esppolicy":"test-esppolicy","gateway":"test-gateway","id":"test-id","ikelifetime":1,"ikepolicy":"test-ikepolicy","ipaddress":"test-ipaddress","ipsecpsk":"test-ipsecpsk","jobid":"test-jobid","name":"test-name","project":"test-project","projectid":"test-projectid","removed":"2014-01-02T15:04:05+0100"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteVpnGateway(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteVpnGatewayParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteVpnGateway", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteVpnGateway" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteVpnGatewayResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteVpnGateway", `{"deletevpngatewayresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestAddVpnUser(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"password":  {"test-password"},
+		"projectid": {"test-projectid"},
+		"username":  {"test-username"},
+	}
+
+	p := &AddVpnUserParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetPassword("test-password")
+	p.SetProjectid("test-projectid")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "addVpnUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "addVpnUser" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*AddVpnUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "addVpnUser", `{"addvpnuserresponse":{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","project":"test-project","projectid":"test-projectid","state":"test-state","username":"test-username"}}`, false, r)
+	if r.Account != "test-account" {
+		t.Errorf("Expected r.Account to be %v, got %v", "test-account", r.Account)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestListVpnUsers(t *testing.T) {
+	params := url.Values{
+		"account":     {"test-account"},
+		"domainid":    {"test-domainid"},
+		"id":          {"test-id"},
+		"isrecursive": {"true"},
+		"keyword":     {"test-keyword"},
+		"listall":     {"true"},
+		"page":        {"7"},
+		"pagesize":    {"8"},
+		"projectid":   {"test-projectid"},
+		"username":    {"test-username"},
+	}
+
+	p := &ListVpnUsersParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetIsrecursive(true)
+	p.SetKeyword("test-keyword")
+	p.SetListall(true)
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetProjectid("test-projectid")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listVpnUsers", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listVpnUsers" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListVpnUsersResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listVpnUsers", `{"listvpnusersresponse":{"count":1,"vpnuser":[{"account":"test-account","domain":"test-domain","domainid":"test-domainid","id":"test-id","project":"test-project","projectid":"test-projectid","state":"test-state","username":"test-username"}]}}`, false, r)
+	if r.VpnUsers[0].Account != "test-account" {
+		t.Errorf("Expected r.VpnUsers[0].Account to be %v, got %v", "test-account", r.VpnUsers[0].Account)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestRemoveVpnUser(t *testing.T) {
+	params := url.Values{
+		"account":   {"test-account"},
+		"domainid":  {"test-domainid"},
+		"projectid": {"test-projectid"},
+		"username":  {"test-username"},
+	}
+
+	p := &RemoveVpnUserParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetProjectid("test-projectid")
+	p.SetUsername("test-username")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "removeVpnUser", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "removeVpnUser" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*RemoveVpnUserResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "removeVpnUser", `{"removevpnuserresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
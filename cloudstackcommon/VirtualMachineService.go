@@ -0,0 +1,312 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type GetVMPasswordParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *GetVMPasswordParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *GetVMPasswordParams) Command() string {
+	return "getVMPassword"
+}
+
+// Returns true if the API command starts an async job
+func (p *GetVMPasswordParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *GetVMPasswordParams) ResponseType() interface{} {
+	return &GetVMPasswordResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *GetVMPasswordParams) Validate() error {
+	v := &validator{api: "getVMPassword"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *GetVMPasswordParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *GetVMPasswordParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *GetVMPasswordParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *GetVMPasswordParams) ResetId() {
+	p.Id = nil
+}
+
+type GetVMPasswordResponse struct {
+	Encryptedpassword string                     `json:"encryptedpassword,omitempty" xml:"encryptedpassword,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CleanVMReservationsParams struct {
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CleanVMReservationsParams) URLValues() url.Values {
+	u := url.Values{}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CleanVMReservationsParams) Command() string {
+	return "cleanVMReservations"
+}
+
+// Returns true if the API command starts an async job
+func (p *CleanVMReservationsParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CleanVMReservationsParams) ResponseType() interface{} {
+	return &CleanVMReservationsResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CleanVMReservationsParams) Validate() error {
+	v := &validator{api: "cleanVMReservations"}
+	return v.err()
+}
+
+type CleanVMReservationsResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExpungeVirtualMachineParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ExpungeVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ExpungeVirtualMachineParams) Command() string {
+	return "expungeVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *ExpungeVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ExpungeVirtualMachineParams) ResponseType() interface{} {
+	return &ExpungeVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ExpungeVirtualMachineParams) Validate() error {
+	v := &validator{api: "expungeVirtualMachine"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ExpungeVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ExpungeVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ExpungeVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ExpungeVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+type ExpungeVirtualMachineResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ScaleVirtualMachineParams struct {
+	Details           map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Id                *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Serviceofferingid *string           `json:"serviceofferingid,omitempty" yaml:"serviceofferingid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ScaleVirtualMachineParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Serviceofferingid; v != nil {
+		u.Set("serviceofferingid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ScaleVirtualMachineParams) Command() string {
+	return "scaleVirtualMachine"
+}
+
+// Returns true if the API command starts an async job
+func (p *ScaleVirtualMachineParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ScaleVirtualMachineParams) ResponseType() interface{} {
+	return &ScaleVirtualMachineResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ScaleVirtualMachineParams) Validate() error {
+	v := &validator{api: "scaleVirtualMachine"}
+	v.required("id", p.Id != nil)
+	v.required("serviceofferingid", p.Serviceofferingid != nil)
+	return v.err()
+}
+
+func (p *ScaleVirtualMachineParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *ScaleVirtualMachineParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *ScaleVirtualMachineParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *ScaleVirtualMachineParams) ResetDetails() {
+	p.Details = nil
+}
+
+// This param is required.
+func (p *ScaleVirtualMachineParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ScaleVirtualMachineParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ScaleVirtualMachineParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ScaleVirtualMachineParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'serviceofferingid' param: the ID of the service offering. This param is required.
+func (p *ScaleVirtualMachineParams) SetServiceofferingid(v string) {
+	p.Serviceofferingid = &v
+	return
+}
+
+func (p *ScaleVirtualMachineParams) GetServiceofferingid() string {
+	if p.Serviceofferingid == nil {
+		return ""
+	}
+	return *p.Serviceofferingid
+}
+
+func (p *ScaleVirtualMachineParams) HasServiceofferingid() bool {
+	return p.Serviceofferingid != nil
+}
+
+func (p *ScaleVirtualMachineParams) ResetServiceofferingid() {
+	p.Serviceofferingid = nil
+}
+
+type ScaleVirtualMachineResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the VirtualMachineService that is the same for all versions
+type VirtualMachineService interface {
+	NewGetVMPasswordParams(id string) *GetVMPasswordParams
+	GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	NewCleanVMReservationsParams() *CleanVMReservationsParams
+	CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams
+	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams
+	ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+}
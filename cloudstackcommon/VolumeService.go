@@ -0,0 +1,245 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+type DeleteVolumeParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteVolumeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteVolumeParams) Command() string {
+	return "deleteVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteVolumeParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteVolumeParams) ResponseType() interface{} {
+	return &DeleteVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteVolumeParams) Validate() error {
+	v := &validator{api: "deleteVolume"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteVolumeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteVolumeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteVolumeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteVolumeParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteVolumeResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ExtractVolumeParams struct {
+	Id     *string `json:"id,omitempty" yaml:"id,omitempty"`
+	Mode   *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Url    *string `json:"url,omitempty" yaml:"url,omitempty"`
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ExtractVolumeParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Mode; v != nil {
+		u.Set("mode", *v)
+	}
+	if v := p.Url; v != nil {
+		u.Set("url", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ExtractVolumeParams) Command() string {
+	return "extractVolume"
+}
+
+// Returns true if the API command starts an async job
+func (p *ExtractVolumeParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ExtractVolumeParams) ResponseType() interface{} {
+	return &ExtractVolumeResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ExtractVolumeParams) Validate() error {
+	v := &validator{api: "extractVolume"}
+	v.required("id", p.Id != nil)
+	v.required("mode", p.Mode != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *ExtractVolumeParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ExtractVolumeParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ExtractVolumeParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ExtractVolumeParams) ResetId() {
+	p.Id = nil
+}
+
+// This param is required.
+func (p *ExtractVolumeParams) SetMode(v string) {
+	p.Mode = &v
+	return
+}
+
+func (p *ExtractVolumeParams) GetMode() string {
+	if p.Mode == nil {
+		return ""
+	}
+	return *p.Mode
+}
+
+func (p *ExtractVolumeParams) HasMode() bool {
+	return p.Mode != nil
+}
+
+func (p *ExtractVolumeParams) ResetMode() {
+	p.Mode = nil
+}
+
+func (p *ExtractVolumeParams) SetUrl(v string) {
+	p.Url = &v
+	return
+}
+
+func (p *ExtractVolumeParams) GetUrl() string {
+	if p.Url == nil {
+		return ""
+	}
+	return *p.Url
+}
+
+func (p *ExtractVolumeParams) HasUrl() bool {
+	return p.Url != nil
+}
+
+func (p *ExtractVolumeParams) ResetUrl() {
+	p.Url = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *ExtractVolumeParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ExtractVolumeParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ExtractVolumeParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ExtractVolumeParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ExtractVolumeResponse struct {
+	JobID            string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid        string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Created          Date                       `json:"created,omitempty" xml:"created,omitempty"`
+	ExtractId        string                     `json:"extractId,omitempty" xml:"extractId,omitempty"`
+	ExtractMode      string                     `json:"extractMode,omitempty" xml:"extractMode,omitempty"`
+	Id               string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name             string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Resultstring     string                     `json:"resultstring,omitempty" xml:"resultstring,omitempty"`
+	State            string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Status           string                     `json:"status,omitempty" xml:"status,omitempty"`
+	Storagetype      string                     `json:"storagetype,omitempty" xml:"storagetype,omitempty"`
+	Uploadpercentage int                        `json:"uploadpercentage,omitempty" xml:"uploadpercentage,omitempty"`
+	Url              string                     `json:"url,omitempty" xml:"url,omitempty"`
+	Zoneid           string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename         string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra            map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the VolumeService that is the same for all versions
+type VolumeService interface {
+	NewDeleteVolumeParams(id string) *DeleteVolumeParams
+	DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams
+	ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+}
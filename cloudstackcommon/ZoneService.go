@@ -0,0 +1,1687 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type ListDedicatedZonesParams struct {
+	Account         *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Affinitygroupid *string `json:"affinitygroupid,omitempty" yaml:"affinitygroupid,omitempty"`
+	Domainid        *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Keyword         *string `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Page            *int    `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize        *int    `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Zoneid          *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListDedicatedZonesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Affinitygroupid; v != nil {
+		u.Set("affinitygroupid", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListDedicatedZonesParams) Command() string {
+	return "listDedicatedZones"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListDedicatedZonesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListDedicatedZonesParams) ResponseType() interface{} {
+	return &ListDedicatedZonesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListDedicatedZonesParams) Validate() error {
+	v := &validator{api: "listDedicatedZones"}
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *ListDedicatedZonesParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *ListDedicatedZonesParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetAccount() {
+	p.Account = nil
+}
+
+func (p *ListDedicatedZonesParams) SetAffinitygroupid(v string) {
+	p.Affinitygroupid = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetAffinitygroupid() string {
+	if p.Affinitygroupid == nil {
+		return ""
+	}
+	return *p.Affinitygroupid
+}
+
+func (p *ListDedicatedZonesParams) HasAffinitygroupid() bool {
+	return p.Affinitygroupid != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetAffinitygroupid() {
+	p.Affinitygroupid = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListDedicatedZonesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListDedicatedZonesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListDedicatedZonesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListDedicatedZonesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListDedicatedZonesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListDedicatedZonesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListDedicatedZonesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListDedicatedZonesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone.
+func (p *ListDedicatedZonesParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ListDedicatedZonesParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ListDedicatedZonesParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ListDedicatedZonesParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ListDedicatedZonesResponse struct {
+	Count          int                        `json:"count" xml:"count"`
+	DedicatedZones []*DedicatedZone           `json:"dedicatedzone" xml:"dedicatedzone"`
+	Extra          map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicatedZone struct {
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Zoneid          string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename        string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ReleaseDedicatedZoneParams struct {
+	Zoneid *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ReleaseDedicatedZoneParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ReleaseDedicatedZoneParams) Command() string {
+	return "releaseDedicatedZone"
+}
+
+// Returns true if the API command starts an async job
+func (p *ReleaseDedicatedZoneParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ReleaseDedicatedZoneParams) ResponseType() interface{} {
+	return &ReleaseDedicatedZoneResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ReleaseDedicatedZoneParams) Validate() error {
+	v := &validator{api: "releaseDedicatedZone"}
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *ReleaseDedicatedZoneParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *ReleaseDedicatedZoneParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *ReleaseDedicatedZoneParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type ReleaseDedicatedZoneResponse struct {
+	JobID       string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type CreateZoneParams struct {
+	Allocationstate      *string `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Dns1                 *string `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2                 *string `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Domain               *string `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Domainid             *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Guestcidraddress     *string `json:"guestcidraddress,omitempty" yaml:"guestcidraddress,omitempty"`
+	Internaldns1         *string `json:"internaldns1,omitempty" yaml:"internaldns1,omitempty"`
+	Internaldns2         *string `json:"internaldns2,omitempty" yaml:"internaldns2,omitempty"`
+	Ip6dns1              *string `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2              *string `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Localstorageenabled  *bool   `json:"localstorageenabled,omitempty" yaml:"localstorageenabled,omitempty"`
+	Name                 *string `json:"name,omitempty" yaml:"name,omitempty"`
+	Networktype          *string `json:"networktype,omitempty" yaml:"networktype,omitempty"`
+	Securitygroupenabled *bool   `json:"securitygroupenabled,omitempty" yaml:"securitygroupenabled,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *CreateZoneParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Dns1; v != nil {
+		u.Set("dns1", *v)
+	}
+	if v := p.Dns2; v != nil {
+		u.Set("dns2", *v)
+	}
+	if v := p.Domain; v != nil {
+		u.Set("domain", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Guestcidraddress; v != nil {
+		u.Set("guestcidraddress", *v)
+	}
+	if v := p.Internaldns1; v != nil {
+		u.Set("internaldns1", *v)
+	}
+	if v := p.Internaldns2; v != nil {
+		u.Set("internaldns2", *v)
+	}
+	if v := p.Ip6dns1; v != nil {
+		u.Set("ip6dns1", *v)
+	}
+	if v := p.Ip6dns2; v != nil {
+		u.Set("ip6dns2", *v)
+	}
+	if v := p.Localstorageenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("localstorageenabled", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networktype; v != nil {
+		u.Set("networktype", *v)
+	}
+	if v := p.Securitygroupenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("securitygroupenabled", vv)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *CreateZoneParams) Command() string {
+	return "createZone"
+}
+
+// Returns true if the API command starts an async job
+func (p *CreateZoneParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *CreateZoneParams) ResponseType() interface{} {
+	return &CreateZoneResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *CreateZoneParams) Validate() error {
+	v := &validator{api: "createZone"}
+	v.required("dns1", p.Dns1 != nil)
+	v.required("internaldns1", p.Internaldns1 != nil)
+	v.required("name", p.Name != nil)
+	v.required("networktype", p.Networktype != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *CreateZoneParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *CreateZoneParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *CreateZoneParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *CreateZoneParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+// This param is required.
+func (p *CreateZoneParams) SetDns1(v string) {
+	p.Dns1 = &v
+	return
+}
+
+func (p *CreateZoneParams) GetDns1() string {
+	if p.Dns1 == nil {
+		return ""
+	}
+	return *p.Dns1
+}
+
+func (p *CreateZoneParams) HasDns1() bool {
+	return p.Dns1 != nil
+}
+
+func (p *CreateZoneParams) ResetDns1() {
+	p.Dns1 = nil
+}
+
+func (p *CreateZoneParams) SetDns2(v string) {
+	p.Dns2 = &v
+	return
+}
+
+func (p *CreateZoneParams) GetDns2() string {
+	if p.Dns2 == nil {
+		return ""
+	}
+	return *p.Dns2
+}
+
+func (p *CreateZoneParams) HasDns2() bool {
+	return p.Dns2 != nil
+}
+
+func (p *CreateZoneParams) ResetDns2() {
+	p.Dns2 = nil
+}
+
+func (p *CreateZoneParams) SetDomain(v string) {
+	p.Domain = &v
+	return
+}
+
+func (p *CreateZoneParams) GetDomain() string {
+	if p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+func (p *CreateZoneParams) HasDomain() bool {
+	return p.Domain != nil
+}
+
+func (p *CreateZoneParams) ResetDomain() {
+	p.Domain = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *CreateZoneParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *CreateZoneParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *CreateZoneParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *CreateZoneParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *CreateZoneParams) SetGuestcidraddress(v string) {
+	p.Guestcidraddress = &v
+	return
+}
+
+func (p *CreateZoneParams) GetGuestcidraddress() string {
+	if p.Guestcidraddress == nil {
+		return ""
+	}
+	return *p.Guestcidraddress
+}
+
+func (p *CreateZoneParams) HasGuestcidraddress() bool {
+	return p.Guestcidraddress != nil
+}
+
+func (p *CreateZoneParams) ResetGuestcidraddress() {
+	p.Guestcidraddress = nil
+}
+
+// This param is required.
+func (p *CreateZoneParams) SetInternaldns1(v string) {
+	p.Internaldns1 = &v
+	return
+}
+
+func (p *CreateZoneParams) GetInternaldns1() string {
+	if p.Internaldns1 == nil {
+		return ""
+	}
+	return *p.Internaldns1
+}
+
+func (p *CreateZoneParams) HasInternaldns1() bool {
+	return p.Internaldns1 != nil
+}
+
+func (p *CreateZoneParams) ResetInternaldns1() {
+	p.Internaldns1 = nil
+}
+
+func (p *CreateZoneParams) SetInternaldns2(v string) {
+	p.Internaldns2 = &v
+	return
+}
+
+func (p *CreateZoneParams) GetInternaldns2() string {
+	if p.Internaldns2 == nil {
+		return ""
+	}
+	return *p.Internaldns2
+}
+
+func (p *CreateZoneParams) HasInternaldns2() bool {
+	return p.Internaldns2 != nil
+}
+
+func (p *CreateZoneParams) ResetInternaldns2() {
+	p.Internaldns2 = nil
+}
+
+func (p *CreateZoneParams) SetIp6dns1(v string) {
+	p.Ip6dns1 = &v
+	return
+}
+
+func (p *CreateZoneParams) GetIp6dns1() string {
+	if p.Ip6dns1 == nil {
+		return ""
+	}
+	return *p.Ip6dns1
+}
+
+func (p *CreateZoneParams) HasIp6dns1() bool {
+	return p.Ip6dns1 != nil
+}
+
+func (p *CreateZoneParams) ResetIp6dns1() {
+	p.Ip6dns1 = nil
+}
+
+func (p *CreateZoneParams) SetIp6dns2(v string) {
+	p.Ip6dns2 = &v
+	return
+}
+
+func (p *CreateZoneParams) GetIp6dns2() string {
+	if p.Ip6dns2 == nil {
+		return ""
+	}
+	return *p.Ip6dns2
+}
+
+func (p *CreateZoneParams) HasIp6dns2() bool {
+	return p.Ip6dns2 != nil
+}
+
+func (p *CreateZoneParams) ResetIp6dns2() {
+	p.Ip6dns2 = nil
+}
+
+func (p *CreateZoneParams) SetLocalstorageenabled(v bool) {
+	p.Localstorageenabled = &v
+	return
+}
+
+func (p *CreateZoneParams) GetLocalstorageenabled() bool {
+	if p.Localstorageenabled == nil {
+		return false
+	}
+	return *p.Localstorageenabled
+}
+
+func (p *CreateZoneParams) HasLocalstorageenabled() bool {
+	return p.Localstorageenabled != nil
+}
+
+func (p *CreateZoneParams) ResetLocalstorageenabled() {
+	p.Localstorageenabled = nil
+}
+
+// This param is required.
+func (p *CreateZoneParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *CreateZoneParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *CreateZoneParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *CreateZoneParams) ResetName() {
+	p.Name = nil
+}
+
+// This param is required.
+func (p *CreateZoneParams) SetNetworktype(v string) {
+	p.Networktype = &v
+	return
+}
+
+func (p *CreateZoneParams) GetNetworktype() string {
+	if p.Networktype == nil {
+		return ""
+	}
+	return *p.Networktype
+}
+
+func (p *CreateZoneParams) HasNetworktype() bool {
+	return p.Networktype != nil
+}
+
+func (p *CreateZoneParams) ResetNetworktype() {
+	p.Networktype = nil
+}
+
+func (p *CreateZoneParams) SetSecuritygroupenabled(v bool) {
+	p.Securitygroupenabled = &v
+	return
+}
+
+func (p *CreateZoneParams) GetSecuritygroupenabled() bool {
+	if p.Securitygroupenabled == nil {
+		return false
+	}
+	return *p.Securitygroupenabled
+}
+
+func (p *CreateZoneParams) HasSecuritygroupenabled() bool {
+	return p.Securitygroupenabled != nil
+}
+
+func (p *CreateZoneParams) ResetSecuritygroupenabled() {
+	p.Securitygroupenabled = nil
+}
+
+type CreateZoneResponse struct {
+	Allocationstate       string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity              []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Description           string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Dhcpprovider          string                     `json:"dhcpprovider,omitempty" xml:"dhcpprovider,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                  string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                  string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Domainname            string                     `json:"domainname,omitempty" xml:"domainname,omitempty"`
+	Guestcidraddress      string                     `json:"guestcidraddress,omitempty" xml:"guestcidraddress,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Internaldns1          string                     `json:"internaldns1,omitempty" xml:"internaldns1,omitempty"`
+	Internaldns2          string                     `json:"internaldns2,omitempty" xml:"internaldns2,omitempty"`
+	Ip6dns1               string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2               string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Localstorageenabled   Bool                       `json:"localstorageenabled,omitempty" xml:"localstorageenabled,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networktype           string                     `json:"networktype,omitempty" xml:"networktype,omitempty"`
+	Resourcedetails       map[string]string          `json:"resourcedetails,omitempty" xml:"resourcedetails,omitempty"`
+	Securitygroupsenabled Bool                       `json:"securitygroupsenabled,omitempty" xml:"securitygroupsenabled,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vlan                  string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zonetoken             string                     `json:"zonetoken,omitempty" xml:"zonetoken,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DedicateZoneParams struct {
+	Account  *string `json:"account,omitempty" yaml:"account,omitempty"`
+	Domainid *string `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Zoneid   *string `json:"zoneid,omitempty" yaml:"zoneid,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DedicateZoneParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Account; v != nil {
+		u.Set("account", *v)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Zoneid; v != nil {
+		u.Set("zoneid", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DedicateZoneParams) Command() string {
+	return "dedicateZone"
+}
+
+// Returns true if the API command starts an async job
+func (p *DedicateZoneParams) IsAsync() bool {
+	return true
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DedicateZoneParams) ResponseType() interface{} {
+	return &DedicateZoneResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DedicateZoneParams) Validate() error {
+	v := &validator{api: "dedicateZone"}
+	v.required("domainid", p.Domainid != nil)
+	v.required("zoneid", p.Zoneid != nil)
+	return v.err()
+}
+
+// Sets the 'account' param: the name of the account.
+func (p *DedicateZoneParams) SetAccount(v string) {
+	p.Account = &v
+	return
+}
+
+func (p *DedicateZoneParams) GetAccount() string {
+	if p.Account == nil {
+		return ""
+	}
+	return *p.Account
+}
+
+func (p *DedicateZoneParams) HasAccount() bool {
+	return p.Account != nil
+}
+
+func (p *DedicateZoneParams) ResetAccount() {
+	p.Account = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain. This param is required.
+func (p *DedicateZoneParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *DedicateZoneParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *DedicateZoneParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *DedicateZoneParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+// Sets the 'zoneid' param: the ID of the zone. This param is required.
+func (p *DedicateZoneParams) SetZoneid(v string) {
+	p.Zoneid = &v
+	return
+}
+
+func (p *DedicateZoneParams) GetZoneid() string {
+	if p.Zoneid == nil {
+		return ""
+	}
+	return *p.Zoneid
+}
+
+func (p *DedicateZoneParams) HasZoneid() bool {
+	return p.Zoneid != nil
+}
+
+func (p *DedicateZoneParams) ResetZoneid() {
+	p.Zoneid = nil
+}
+
+type DedicateZoneResponse struct {
+	JobID           string                     `json:"jobid,omitempty" xml:"jobid,omitempty"`
+	Accountid       string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Affinitygroupid string                     `json:"affinitygroupid,omitempty" xml:"affinitygroupid,omitempty"`
+	Domainid        string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Id              string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Zoneid          string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Zonename        string                     `json:"zonename,omitempty" xml:"zonename,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type DeleteZoneParams struct {
+	Id *string `json:"id,omitempty" yaml:"id,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *DeleteZoneParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *DeleteZoneParams) Command() string {
+	return "deleteZone"
+}
+
+// Returns true if the API command starts an async job
+func (p *DeleteZoneParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *DeleteZoneParams) ResponseType() interface{} {
+	return &DeleteZoneResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *DeleteZoneParams) Validate() error {
+	v := &validator{api: "deleteZone"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// This param is required.
+func (p *DeleteZoneParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *DeleteZoneParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *DeleteZoneParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *DeleteZoneParams) ResetId() {
+	p.Id = nil
+}
+
+type DeleteZoneResponse struct {
+	Displaytext string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Success     Bool                       `json:"success,omitempty" xml:"success,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ListZonesParams struct {
+	Available      *bool             `json:"available,omitempty" yaml:"available,omitempty"`
+	Domainid       *string           `json:"domainid,omitempty" yaml:"domainid,omitempty"`
+	Id             *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Keyword        *string           `json:"keyword,omitempty" yaml:"keyword,omitempty"`
+	Name           *string           `json:"name,omitempty" yaml:"name,omitempty"`
+	Networktype    *string           `json:"networktype,omitempty" yaml:"networktype,omitempty"`
+	Page           *int              `json:"page,omitempty" yaml:"page,omitempty"`
+	Pagesize       *int              `json:"pagesize,omitempty" yaml:"pagesize,omitempty"`
+	Showcapacities *bool             `json:"showcapacities,omitempty" yaml:"showcapacities,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *ListZonesParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Available; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("available", vv)
+	}
+	if v := p.Domainid; v != nil {
+		u.Set("domainid", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Keyword; v != nil {
+		u.Set("keyword", *v)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	if v := p.Networktype; v != nil {
+		u.Set("networktype", *v)
+	}
+	if v := p.Page; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("page", vv)
+	}
+	if v := p.Pagesize; v != nil {
+		vv := strconv.Itoa(*v)
+		u.Set("pagesize", vv)
+	}
+	if v := p.Showcapacities; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("showcapacities", vv)
+	}
+	if v := p.Tags; v != nil {
+		i := 0
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("tags[%d].key", i), k)
+			u.Set(fmt.Sprintf("tags[%d].value", i), vv)
+			i++
+		}
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *ListZonesParams) Command() string {
+	return "listZones"
+}
+
+// Returns true if the API command starts an async job
+func (p *ListZonesParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *ListZonesParams) ResponseType() interface{} {
+	return &ListZonesResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *ListZonesParams) Validate() error {
+	v := &validator{api: "listZones"}
+	return v.err()
+}
+
+func (p *ListZonesParams) SetAvailable(v bool) {
+	p.Available = &v
+	return
+}
+
+func (p *ListZonesParams) GetAvailable() bool {
+	if p.Available == nil {
+		return false
+	}
+	return *p.Available
+}
+
+func (p *ListZonesParams) HasAvailable() bool {
+	return p.Available != nil
+}
+
+func (p *ListZonesParams) ResetAvailable() {
+	p.Available = nil
+}
+
+// Sets the 'domainid' param: the ID of the domain.
+func (p *ListZonesParams) SetDomainid(v string) {
+	p.Domainid = &v
+	return
+}
+
+func (p *ListZonesParams) GetDomainid() string {
+	if p.Domainid == nil {
+		return ""
+	}
+	return *p.Domainid
+}
+
+func (p *ListZonesParams) HasDomainid() bool {
+	return p.Domainid != nil
+}
+
+func (p *ListZonesParams) ResetDomainid() {
+	p.Domainid = nil
+}
+
+func (p *ListZonesParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *ListZonesParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *ListZonesParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *ListZonesParams) ResetId() {
+	p.Id = nil
+}
+
+// Sets the 'keyword' param: lists the resources matching the keyword.
+func (p *ListZonesParams) SetKeyword(v string) {
+	p.Keyword = &v
+	return
+}
+
+func (p *ListZonesParams) GetKeyword() string {
+	if p.Keyword == nil {
+		return ""
+	}
+	return *p.Keyword
+}
+
+func (p *ListZonesParams) HasKeyword() bool {
+	return p.Keyword != nil
+}
+
+func (p *ListZonesParams) ResetKeyword() {
+	p.Keyword = nil
+}
+
+func (p *ListZonesParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *ListZonesParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *ListZonesParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *ListZonesParams) ResetName() {
+	p.Name = nil
+}
+
+func (p *ListZonesParams) SetNetworktype(v string) {
+	p.Networktype = &v
+	return
+}
+
+func (p *ListZonesParams) GetNetworktype() string {
+	if p.Networktype == nil {
+		return ""
+	}
+	return *p.Networktype
+}
+
+func (p *ListZonesParams) HasNetworktype() bool {
+	return p.Networktype != nil
+}
+
+func (p *ListZonesParams) ResetNetworktype() {
+	p.Networktype = nil
+}
+
+// Sets the 'page' param: the page to list, starting at 1.
+func (p *ListZonesParams) SetPage(v int) {
+	p.Page = &v
+	return
+}
+
+func (p *ListZonesParams) GetPage() int {
+	if p.Page == nil {
+		return 0
+	}
+	return *p.Page
+}
+
+func (p *ListZonesParams) HasPage() bool {
+	return p.Page != nil
+}
+
+func (p *ListZonesParams) ResetPage() {
+	p.Page = nil
+}
+
+// Sets the 'pagesize' param: the number of resources per page.
+func (p *ListZonesParams) SetPagesize(v int) {
+	p.Pagesize = &v
+	return
+}
+
+func (p *ListZonesParams) GetPagesize() int {
+	if p.Pagesize == nil {
+		return 0
+	}
+	return *p.Pagesize
+}
+
+func (p *ListZonesParams) HasPagesize() bool {
+	return p.Pagesize != nil
+}
+
+func (p *ListZonesParams) ResetPagesize() {
+	p.Pagesize = nil
+}
+
+func (p *ListZonesParams) SetShowcapacities(v bool) {
+	p.Showcapacities = &v
+	return
+}
+
+func (p *ListZonesParams) GetShowcapacities() bool {
+	if p.Showcapacities == nil {
+		return false
+	}
+	return *p.Showcapacities
+}
+
+func (p *ListZonesParams) HasShowcapacities() bool {
+	return p.Showcapacities != nil
+}
+
+func (p *ListZonesParams) ResetShowcapacities() {
+	p.Showcapacities = nil
+}
+
+// Sets the 'tags' param: the tags, as key/value pairs.
+func (p *ListZonesParams) SetTags(v map[string]string) {
+	p.Tags = v
+	return
+}
+
+func (p *ListZonesParams) GetTags() map[string]string {
+	return p.Tags
+}
+
+func (p *ListZonesParams) HasTags() bool {
+	return p.Tags != nil
+}
+
+func (p *ListZonesParams) ResetTags() {
+	p.Tags = nil
+}
+
+type ListZonesResponse struct {
+	Count int                        `json:"count" xml:"count"`
+	Zones []*Zone                    `json:"zone" xml:"zone"`
+	Extra map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Zone struct {
+	Allocationstate       string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity              []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Description           string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Dhcpprovider          string                     `json:"dhcpprovider,omitempty" xml:"dhcpprovider,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                  string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                  string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Domainname            string                     `json:"domainname,omitempty" xml:"domainname,omitempty"`
+	Guestcidraddress      string                     `json:"guestcidraddress,omitempty" xml:"guestcidraddress,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Internaldns1          string                     `json:"internaldns1,omitempty" xml:"internaldns1,omitempty"`
+	Internaldns2          string                     `json:"internaldns2,omitempty" xml:"internaldns2,omitempty"`
+	Ip6dns1               string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2               string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Localstorageenabled   Bool                       `json:"localstorageenabled,omitempty" xml:"localstorageenabled,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networktype           string                     `json:"networktype,omitempty" xml:"networktype,omitempty"`
+	Resourcedetails       map[string]string          `json:"resourcedetails,omitempty" xml:"resourcedetails,omitempty"`
+	Securitygroupsenabled Bool                       `json:"securitygroupsenabled,omitempty" xml:"securitygroupsenabled,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vlan                  string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zonetoken             string                     `json:"zonetoken,omitempty" xml:"zonetoken,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type UpdateZoneParams struct {
+	Allocationstate     *string           `json:"allocationstate,omitempty" yaml:"allocationstate,omitempty"`
+	Details             map[string]string `json:"details,omitempty" yaml:"details,omitempty"`
+	Dhcpprovider        *string           `json:"dhcpprovider,omitempty" yaml:"dhcpprovider,omitempty"`
+	Dns1                *string           `json:"dns1,omitempty" yaml:"dns1,omitempty"`
+	Dns2                *string           `json:"dns2,omitempty" yaml:"dns2,omitempty"`
+	Dnssearchorder      []string          `json:"dnssearchorder,omitempty" yaml:"dnssearchorder,omitempty"`
+	Domain              *string           `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Guestcidraddress    *string           `json:"guestcidraddress,omitempty" yaml:"guestcidraddress,omitempty"`
+	Id                  *string           `json:"id,omitempty" yaml:"id,omitempty"`
+	Internaldns1        *string           `json:"internaldns1,omitempty" yaml:"internaldns1,omitempty"`
+	Internaldns2        *string           `json:"internaldns2,omitempty" yaml:"internaldns2,omitempty"`
+	Ip6dns1             *string           `json:"ip6dns1,omitempty" yaml:"ip6dns1,omitempty"`
+	Ip6dns2             *string           `json:"ip6dns2,omitempty" yaml:"ip6dns2,omitempty"`
+	Ispublic            *bool             `json:"ispublic,omitempty" yaml:"ispublic,omitempty"`
+	Localstorageenabled *bool             `json:"localstorageenabled,omitempty" yaml:"localstorageenabled,omitempty"`
+	Name                *string           `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+// Returns the params as URL values, as they are sent to CloudStack
+func (p *UpdateZoneParams) URLValues() url.Values {
+	u := url.Values{}
+	if v := p.Allocationstate; v != nil {
+		u.Set("allocationstate", *v)
+	}
+	if v := p.Details; v != nil {
+		for k, vv := range v {
+			u.Set(fmt.Sprintf("details[0].%s", k), vv)
+		}
+	}
+	if v := p.Dhcpprovider; v != nil {
+		u.Set("dhcpprovider", *v)
+	}
+	if v := p.Dns1; v != nil {
+		u.Set("dns1", *v)
+	}
+	if v := p.Dns2; v != nil {
+		u.Set("dns2", *v)
+	}
+	if v := p.Dnssearchorder; v != nil {
+		vv := strings.Join(v, ", ")
+		u.Set("dnssearchorder", vv)
+	}
+	if v := p.Domain; v != nil {
+		u.Set("domain", *v)
+	}
+	if v := p.Guestcidraddress; v != nil {
+		u.Set("guestcidraddress", *v)
+	}
+	if v := p.Id; v != nil {
+		u.Set("id", *v)
+	}
+	if v := p.Internaldns1; v != nil {
+		u.Set("internaldns1", *v)
+	}
+	if v := p.Internaldns2; v != nil {
+		u.Set("internaldns2", *v)
+	}
+	if v := p.Ip6dns1; v != nil {
+		u.Set("ip6dns1", *v)
+	}
+	if v := p.Ip6dns2; v != nil {
+		u.Set("ip6dns2", *v)
+	}
+	if v := p.Ispublic; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("ispublic", vv)
+	}
+	if v := p.Localstorageenabled; v != nil {
+		vv := strconv.FormatBool(*v)
+		u.Set("localstorageenabled", vv)
+	}
+	if v := p.Name; v != nil {
+		u.Set("name", *v)
+	}
+	return u
+}
+
+// Returns the name of the API command of these params
+func (p *UpdateZoneParams) Command() string {
+	return "updateZone"
+}
+
+// Returns true if the API command starts an async job
+func (p *UpdateZoneParams) IsAsync() bool {
+	return false
+}
+
+// Returns a new instance of the response type of the API command, to decode the response into
+func (p *UpdateZoneParams) ResponseType() interface{} {
+	return &UpdateZoneResponse{}
+}
+
+// Checks the params without calling the API. If any of the params is invalid, a *ValidationError
+// is returned containing all invalid params.
+func (p *UpdateZoneParams) Validate() error {
+	v := &validator{api: "updateZone"}
+	v.required("id", p.Id != nil)
+	return v.err()
+}
+
+// Sets the 'allocationstate' param: the allocation state, which is Enabled or Disabled.
+func (p *UpdateZoneParams) SetAllocationstate(v string) {
+	p.Allocationstate = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetAllocationstate() string {
+	if p.Allocationstate == nil {
+		return ""
+	}
+	return *p.Allocationstate
+}
+
+func (p *UpdateZoneParams) HasAllocationstate() bool {
+	return p.Allocationstate != nil
+}
+
+func (p *UpdateZoneParams) ResetAllocationstate() {
+	p.Allocationstate = nil
+}
+
+func (p *UpdateZoneParams) SetDetails(v map[string]string) {
+	p.Details = v
+	return
+}
+
+func (p *UpdateZoneParams) GetDetails() map[string]string {
+	return p.Details
+}
+
+func (p *UpdateZoneParams) HasDetails() bool {
+	return p.Details != nil
+}
+
+func (p *UpdateZoneParams) ResetDetails() {
+	p.Details = nil
+}
+
+func (p *UpdateZoneParams) SetDhcpprovider(v string) {
+	p.Dhcpprovider = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetDhcpprovider() string {
+	if p.Dhcpprovider == nil {
+		return ""
+	}
+	return *p.Dhcpprovider
+}
+
+func (p *UpdateZoneParams) HasDhcpprovider() bool {
+	return p.Dhcpprovider != nil
+}
+
+func (p *UpdateZoneParams) ResetDhcpprovider() {
+	p.Dhcpprovider = nil
+}
+
+func (p *UpdateZoneParams) SetDns1(v string) {
+	p.Dns1 = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetDns1() string {
+	if p.Dns1 == nil {
+		return ""
+	}
+	return *p.Dns1
+}
+
+func (p *UpdateZoneParams) HasDns1() bool {
+	return p.Dns1 != nil
+}
+
+func (p *UpdateZoneParams) ResetDns1() {
+	p.Dns1 = nil
+}
+
+func (p *UpdateZoneParams) SetDns2(v string) {
+	p.Dns2 = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetDns2() string {
+	if p.Dns2 == nil {
+		return ""
+	}
+	return *p.Dns2
+}
+
+func (p *UpdateZoneParams) HasDns2() bool {
+	return p.Dns2 != nil
+}
+
+func (p *UpdateZoneParams) ResetDns2() {
+	p.Dns2 = nil
+}
+
+func (p *UpdateZoneParams) SetDnssearchorder(v []string) {
+	p.Dnssearchorder = v
+	return
+}
+
+func (p *UpdateZoneParams) GetDnssearchorder() []string {
+	return p.Dnssearchorder
+}
+
+func (p *UpdateZoneParams) HasDnssearchorder() bool {
+	return p.Dnssearchorder != nil
+}
+
+func (p *UpdateZoneParams) ResetDnssearchorder() {
+	p.Dnssearchorder = nil
+}
+
+func (p *UpdateZoneParams) SetDomain(v string) {
+	p.Domain = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetDomain() string {
+	if p.Domain == nil {
+		return ""
+	}
+	return *p.Domain
+}
+
+func (p *UpdateZoneParams) HasDomain() bool {
+	return p.Domain != nil
+}
+
+func (p *UpdateZoneParams) ResetDomain() {
+	p.Domain = nil
+}
+
+func (p *UpdateZoneParams) SetGuestcidraddress(v string) {
+	p.Guestcidraddress = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetGuestcidraddress() string {
+	if p.Guestcidraddress == nil {
+		return ""
+	}
+	return *p.Guestcidraddress
+}
+
+func (p *UpdateZoneParams) HasGuestcidraddress() bool {
+	return p.Guestcidraddress != nil
+}
+
+func (p *UpdateZoneParams) ResetGuestcidraddress() {
+	p.Guestcidraddress = nil
+}
+
+// This param is required.
+func (p *UpdateZoneParams) SetId(v string) {
+	p.Id = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetId() string {
+	if p.Id == nil {
+		return ""
+	}
+	return *p.Id
+}
+
+func (p *UpdateZoneParams) HasId() bool {
+	return p.Id != nil
+}
+
+func (p *UpdateZoneParams) ResetId() {
+	p.Id = nil
+}
+
+func (p *UpdateZoneParams) SetInternaldns1(v string) {
+	p.Internaldns1 = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetInternaldns1() string {
+	if p.Internaldns1 == nil {
+		return ""
+	}
+	return *p.Internaldns1
+}
+
+func (p *UpdateZoneParams) HasInternaldns1() bool {
+	return p.Internaldns1 != nil
+}
+
+func (p *UpdateZoneParams) ResetInternaldns1() {
+	p.Internaldns1 = nil
+}
+
+func (p *UpdateZoneParams) SetInternaldns2(v string) {
+	p.Internaldns2 = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetInternaldns2() string {
+	if p.Internaldns2 == nil {
+		return ""
+	}
+	return *p.Internaldns2
+}
+
+func (p *UpdateZoneParams) HasInternaldns2() bool {
+	return p.Internaldns2 != nil
+}
+
+func (p *UpdateZoneParams) ResetInternaldns2() {
+	p.Internaldns2 = nil
+}
+
+func (p *UpdateZoneParams) SetIp6dns1(v string) {
+	p.Ip6dns1 = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetIp6dns1() string {
+	if p.Ip6dns1 == nil {
+		return ""
+	}
+	return *p.Ip6dns1
+}
+
+func (p *UpdateZoneParams) HasIp6dns1() bool {
+	return p.Ip6dns1 != nil
+}
+
+func (p *UpdateZoneParams) ResetIp6dns1() {
+	p.Ip6dns1 = nil
+}
+
+func (p *UpdateZoneParams) SetIp6dns2(v string) {
+	p.Ip6dns2 = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetIp6dns2() string {
+	if p.Ip6dns2 == nil {
+		return ""
+	}
+	return *p.Ip6dns2
+}
+
+func (p *UpdateZoneParams) HasIp6dns2() bool {
+	return p.Ip6dns2 != nil
+}
+
+func (p *UpdateZoneParams) ResetIp6dns2() {
+	p.Ip6dns2 = nil
+}
+
+func (p *UpdateZoneParams) SetIspublic(v bool) {
+	p.Ispublic = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetIspublic() bool {
+	if p.Ispublic == nil {
+		return false
+	}
+	return *p.Ispublic
+}
+
+func (p *UpdateZoneParams) HasIspublic() bool {
+	return p.Ispublic != nil
+}
+
+func (p *UpdateZoneParams) ResetIspublic() {
+	p.Ispublic = nil
+}
+
+func (p *UpdateZoneParams) SetLocalstorageenabled(v bool) {
+	p.Localstorageenabled = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetLocalstorageenabled() bool {
+	if p.Localstorageenabled == nil {
+		return false
+	}
+	return *p.Localstorageenabled
+}
+
+func (p *UpdateZoneParams) HasLocalstorageenabled() bool {
+	return p.Localstorageenabled != nil
+}
+
+func (p *UpdateZoneParams) ResetLocalstorageenabled() {
+	p.Localstorageenabled = nil
+}
+
+func (p *UpdateZoneParams) SetName(v string) {
+	p.Name = &v
+	return
+}
+
+func (p *UpdateZoneParams) GetName() string {
+	if p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+func (p *UpdateZoneParams) HasName() bool {
+	return p.Name != nil
+}
+
+func (p *UpdateZoneParams) ResetName() {
+	p.Name = nil
+}
+
+type UpdateZoneResponse struct {
+	Allocationstate       string                     `json:"allocationstate,omitempty" xml:"allocationstate,omitempty"`
+	Capacity              []Capacity                 `json:"capacity,omitempty" xml:"capacity,omitempty"`
+	Description           string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Dhcpprovider          string                     `json:"dhcpprovider,omitempty" xml:"dhcpprovider,omitempty"`
+	Displaytext           string                     `json:"displaytext,omitempty" xml:"displaytext,omitempty"`
+	Dns1                  string                     `json:"dns1,omitempty" xml:"dns1,omitempty"`
+	Dns2                  string                     `json:"dns2,omitempty" xml:"dns2,omitempty"`
+	Domain                string                     `json:"domain,omitempty" xml:"domain,omitempty"`
+	Domainid              string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Domainname            string                     `json:"domainname,omitempty" xml:"domainname,omitempty"`
+	Guestcidraddress      string                     `json:"guestcidraddress,omitempty" xml:"guestcidraddress,omitempty"`
+	Id                    string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Internaldns1          string                     `json:"internaldns1,omitempty" xml:"internaldns1,omitempty"`
+	Internaldns2          string                     `json:"internaldns2,omitempty" xml:"internaldns2,omitempty"`
+	Ip6dns1               string                     `json:"ip6dns1,omitempty" xml:"ip6dns1,omitempty"`
+	Ip6dns2               string                     `json:"ip6dns2,omitempty" xml:"ip6dns2,omitempty"`
+	Localstorageenabled   Bool                       `json:"localstorageenabled,omitempty" xml:"localstorageenabled,omitempty"`
+	Name                  string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Networktype           string                     `json:"networktype,omitempty" xml:"networktype,omitempty"`
+	Resourcedetails       map[string]string          `json:"resourcedetails,omitempty" xml:"resourcedetails,omitempty"`
+	Securitygroupsenabled Bool                       `json:"securitygroupsenabled,omitempty" xml:"securitygroupsenabled,omitempty"`
+	Tags                  []Tag                      `json:"tags,omitempty" xml:"tags,omitempty"`
+	Vlan                  string                     `json:"vlan,omitempty" xml:"vlan,omitempty"`
+	Zonetoken             string                     `json:"zonetoken,omitempty" xml:"zonetoken,omitempty"`
+	Extra                 map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+// The part of the ZoneService that is the same for all versions
+type ZoneService interface {
+	NewListDedicatedZonesParams() *ListDedicatedZonesParams
+	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	StreamDedicatedZones(ctx context.Context, p *ListDedicatedZonesParams, fn func(*DedicatedZone) error) (int, error)
+	NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams
+	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams
+	CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error)
+	NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams
+	DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	NewDeleteZoneParams(id string) *DeleteZoneParams
+	DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	NewListZonesParams() *ListZonesParams
+	GetZoneID(name string, opts ...OptionFunc) (string, error)
+	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
+	GetZonesByTags(tags map[string]string, opts ...OptionFunc) ([]*Zone, error)
+	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	StreamZones(ctx context.Context, p *ListZonesParams, fn func(*Zone) error) (int, error)
+	NewUpdateZoneParams(id string) *UpdateZoneParams
+	UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error)
+}
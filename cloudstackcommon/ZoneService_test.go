@@ -0,0 +1,307 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestListDedicatedZones(t *testing.T) {
+	params := url.Values{
+		"account":         {"test-account"},
+		"affinitygroupid": {"test-affinitygroupid"},
+		"domainid":        {"test-domainid"},
+		"keyword":         {"test-keyword"},
+		"page":            {"5"},
+		"pagesize":        {"6"},
+		"zoneid":          {"test-zoneid"},
+	}
+
+	p := &ListDedicatedZonesParams{}
+	p.SetAccount("test-account")
+	p.SetAffinitygroupid("test-affinitygroupid")
+	p.SetDomainid("test-domainid")
+	p.SetKeyword("test-keyword")
+	p.SetPage(5)
+	p.SetPagesize(6)
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listDedicatedZones", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listDedicatedZones" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListDedicatedZonesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listDedicatedZones", `{"listdedicatedzonesresponse":{"count":1,"dedicatedzone":[{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","zoneid":"test-zoneid","zonename":"test-zonename"}]}}`, false, r)
+	if r.DedicatedZones[0].Accountid != "test-accountid" {
+		t.Errorf("Expected r.DedicatedZones[0].Accountid to be %v, got %v", "test-accountid", r.DedicatedZones[0].Accountid)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestReleaseDedicatedZone(t *testing.T) {
+	params := url.Values{
+		"zoneid": {"test-zoneid"},
+	}
+
+	p := &ReleaseDedicatedZoneParams{}
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "releaseDedicatedZone", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "releaseDedicatedZone" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ReleaseDedicatedZoneResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "releaseDedicatedZone", `{"releasededicatedzoneresponse":{"displaytext":"test-displaytext","jobid":"test-jobid","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestCreateZone(t *testing.T) {
+	params := url.Values{
+		"allocationstate":      {"test-allocationstate"},
+		"dns1":                 {"test-dns1"},
+		"dns2":                 {"test-dns2"},
+		"domain":               {"test-domain"},
+		"domainid":             {"test-domainid"},
+		"guestcidraddress":     {"test-guestcidraddress"},
+		"internaldns1":         {"test-internaldns1"},
+		"internaldns2":         {"test-internaldns2"},
+		"ip6dns1":              {"test-ip6dns1"},
+		"ip6dns2":              {"test-ip6dns2"},
+		"localstorageenabled":  {"true"},
+		"name":                 {"test-name"},
+		"networktype":          {"test-networktype"},
+		"securitygroupenabled": {"true"},
+	}
+
+	p := &CreateZoneParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetDns1("test-dns1")
+	p.SetDns2("test-dns2")
+	p.SetDomain("test-domain")
+	p.SetDomainid("test-domainid")
+	p.SetGuestcidraddress("test-guestcidraddress")
+	p.SetInternaldns1("test-internaldns1")
+	p.SetInternaldns2("test-internaldns2")
+	p.SetIp6dns1("test-ip6dns1")
+	p.SetIp6dns2("test-ip6dns2")
+	p.SetLocalstorageenabled(true)
+	p.SetName("test-name")
+	p.SetNetworktype("test-networktype")
+	p.SetSecuritygroupenabled(true)
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "createZone", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "createZone" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*CreateZoneResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "createZone", `{"createzoneresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"description":"test-description","dhcpprovider":"test-dhcpprovider","displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","domainname":"test-domainname","guestcidraddress":"test-guestcidraddress","id":"test-id","internaldns1":"test-internaldns1","internaldns2":"test-internaldns2","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","localstorageenabled":true,"name":"test-name","networktype":"test-networktype","resourcedetails":{"key":"value"},"securitygroupsenabled":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vlan":"test-vlan","zonetoken":"test-zonetoken"}}`, false, r)
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+}
+
+func TestDedicateZone(t *testing.T) {
+	params := url.Values{
+		"account":  {"test-account"},
+		"domainid": {"test-domainid"},
+		"zoneid":   {"test-zoneid"},
+	}
+
+	p := &DedicateZoneParams{}
+	p.SetAccount("test-account")
+	p.SetDomainid("test-domainid")
+	p.SetZoneid("test-zoneid")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "dedicateZone", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "dedicateZone" || cmd.IsAsync() != true {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DedicateZoneResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "dedicateZone", `{"dedicatezoneresponse":{"accountid":"test-accountid","affinitygroupid":"test-affinitygroupid","domainid":"test-domainid","id":"test-id","jobid":"test-jobid","zoneid":"test-zoneid","zonename":"test-zonename"}}`, false, r)
+	if r.Accountid != "test-accountid" {
+		t.Errorf("Expected r.Accountid to be %v, got %v", "test-accountid", r.Accountid)
+	}
+	if r.JobID != "test-jobid" {
+		t.Errorf("Expected r.JobID to be %v, got %v", "test-jobid", r.JobID)
+	}
+}
+
+func TestDeleteZone(t *testing.T) {
+	params := url.Values{
+		"id": {"test-id"},
+	}
+
+	p := &DeleteZoneParams{}
+	p.SetId("test-id")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "deleteZone", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "deleteZone" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*DeleteZoneResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "deleteZone", `{"deletezoneresponse":{"displaytext":"test-displaytext","success":true}}`, false, r)
+	if r.Displaytext != "test-displaytext" {
+		t.Errorf("Expected r.Displaytext to be %v, got %v", "test-displaytext", r.Displaytext)
+	}
+}
+
+func TestListZones(t *testing.T) {
+	params := url.Values{
+		"available":      {"true"},
+		"domainid":       {"test-domainid"},
+		"id":             {"test-id"},
+		"keyword":        {"test-keyword"},
+		"name":           {"test-name"},
+		"networktype":    {"test-networktype"},
+		"page":           {"7"},
+		"pagesize":       {"8"},
+		"showcapacities": {"true"},
+		"tags[0].key":    {"test-tagskey"},
+		"tags[0].value":  {"test-tagsvalue"},
+	}
+
+	p := &ListZonesParams{}
+	p.SetAvailable(true)
+	p.SetDomainid("test-domainid")
+	p.SetId("test-id")
+	p.SetKeyword("test-keyword")
+	p.SetName("test-name")
+	p.SetNetworktype("test-networktype")
+	p.SetPage(7)
+	p.SetPagesize(8)
+	p.SetShowcapacities(true)
+	p.SetTags(map[string]string{"test-tagskey": "test-tagsvalue"})
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "listZones", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "listZones" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*ListZonesResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "listZones", `{"listzonesresponse":{"count":1,"zone":[{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"description":"test-description","dhcpprovider":"test-dhcpprovider","displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","domainname":"test-domainname","guestcidraddress":"test-guestcidraddress","id":"test-id","internaldns1":"test-internaldns1","internaldns2":"test-internaldns2","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","localstorageenabled":true,"name":"test-name","networktype":"test-networktype","resourcedetails":{"key":"value"},"securitygroupsenabled":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vlan":"test-vlan","zonetoken":"test-zonetoken"}]}}`, false, r)
+	if r.Zones[0].Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Zones[0].Allocationstate to be %v, got %v", "test-allocationstate", r.Zones[0].Allocationstate)
+	}
+	if r.Count != 1 {
+		t.Errorf("Expected r.Count to be %v, got %v", 1, r.Count)
+	}
+}
+
+func TestUpdateZone(t *testing.T) {
+	params := url.Values{
+		"allocationstate":            {"test-allocationstate"},
+		"details[0].test-detailskey": {"test-detailsvalue"},
+		"dhcpprovider":               {"test-dhcpprovider"},
+		"dns1":                       {"test-dns1"},
+		"dns2":                       {"test-dns2"},
+		"dnssearchorder":             {"test-dnssearchorder1, test-dnssearchorder2"},
+		"domain":                     {"test-domain"},
+		"guestcidraddress":           {"test-guestcidraddress"},
+		"id":                         {"test-id"},
+		"internaldns1":               {"test-internaldns1"},
+		"internaldns2":               {"test-internaldns2"},
+		"ip6dns1":                    {"test-ip6dns1"},
+		"ip6dns2":                    {"test-ip6dns2"},
+		"ispublic":                   {"true"},
+		"localstorageenabled":        {"true"},
+		"name":                       {"test-name"},
+	}
+
+	p := &UpdateZoneParams{}
+	p.SetAllocationstate("test-allocationstate")
+	p.SetDetails(map[string]string{"test-detailskey": "test-detailsvalue"})
+	p.SetDhcpprovider("test-dhcpprovider")
+	p.SetDns1("test-dns1")
+	p.SetDns2("test-dns2")
+	p.SetDnssearchorder([]string{"test-dnssearchorder1", "test-dnssearchorder2"})
+	p.SetDomain("test-domain")
+	p.SetGuestcidraddress("test-guestcidraddress")
+	p.SetId("test-id")
+	p.SetInternaldns1("test-internaldns1")
+	p.SetInternaldns2("test-internaldns2")
+	p.SetIp6dns1("test-ip6dns1")
+	p.SetIp6dns2("test-ip6dns2")
+	p.SetIspublic(true)
+	p.SetLocalstorageenabled(true)
+	p.SetName("test-name")
+	if err := p.Validate(); err != nil {
+		t.Errorf("The params with all params set are invalid: %v", err)
+	}
+	checkURLValues(t, "updateZone", p.URLValues(), params)
+
+	var cmd Command = p
+	if cmd.Command() != "updateZone" || cmd.IsAsync() != false {
+		t.Errorf("Unexpected command %s (async: %t)", cmd.Command(), cmd.IsAsync())
+	}
+	r, ok := cmd.ResponseType().(*UpdateZoneResponse)
+	if !ok {
+		t.Fatalf("Unexpected response type %T", cmd.ResponseType())
+	}
+	decodeSample(t, "updateZone", `{"updatezoneresponse":{"allocationstate":"test-allocationstate","capacity":[{"capacitytotal":1,"capacityused":1,"clusterid":"test-clusterid","clustername":"test-clustername","percentused":"12.5%","podid":"test-podid","podname":"test-podname","type":1,"zoneid":"test-zoneid","zonename":"test-zonename"}],"description":"test-description","dhcpprovider":"test-dhcpprovider","displaytext":"test-displaytext","dns1":"test-dns1","dns2":"test-dns2","domain":"test-domain","domainid":"test-domainid","domainname":"test-domainname","guestcidraddress":"test-guestcidraddress","id":"test-id","internaldns1":"test-internaldns1","internaldns2":"test-internaldns2","ip6dns1":"test-ip6dns1","ip6dns2":"test-ip6dns2","localstorageenabled":true,"name":"test-name","networktype":"test-networktype","resourcedetails":{"key":"value"},"securitygroupsenabled":true,"tags":[{"account":"test-account","customer":"test-customer","domain":"test-domain","domainid":"test-domainid","key":"test-key","project":"test-project","projectid":"test-projectid","resourceid":"test-resourceid","resourcetype":"test-resourcetype","value":"test-value"}],"vlan":"test-vlan","zonetoken":"test-zonetoken"}}`, false, r)
+	if r.Allocationstate != "test-allocationstate" {
+		t.Errorf("Expected r.Allocationstate to be %v, got %v", "test-allocationstate", r.Allocationstate)
+	}
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Implemented by the CloudStackClient of every version specific package
+type AsyncJobClient interface {
+	AsyncTimeout(timeoutInSeconds int64)
+	GetAsyncJobResult(jobid string, timeout int64) (b json.RawMessage, warn error, err error)
+	Execute(ctx context.Context, cmd Command, out interface{}) error
+}
+
+// An API command with its params, which can be run with the Execute method of a CloudStackClient. The params
+// types of all API commands implement this interface, so generic code (like a batch runner or a logger) can
+// work with any command.
+type Command interface {
+	Command() string           // The name of the API command, like 'listVirtualMachines'
+	URLValues() url.Values     // The params, as they are sent to CloudStack
+	IsAsync() bool             // True if the API command starts an async job
+	ResponseType() interface{} // A new instance of the response type, to decode the response into
+}
+
+type Client struct {
+	AsyncJobClient
+
+	APIDiscovery     APIDiscoveryService
+	Account          AccountService
+	Address          AddressService
+	AffinityGroup    AffinityGroupService
+	Alert            AlertService
+	Asyncjob         AsyncjobService
+	AutoScale        AutoScaleService
+	Baremetal        BaremetalService
+	BigSwitchVNS     BigSwitchVNSService
+	Certificate      CertificateService
+	CloudIdentifier  CloudIdentifierService
+	Cluster          ClusterService
+	Configuration    ConfigurationService
+	DiskOffering     DiskOfferingService
+	Domain           DomainService
+	Event            EventService
+	Firewall         FirewallService
+	GuestOS          GuestOSService
+	Host             HostService
+	Hypervisor       HypervisorService
+	ISO              ISOService
+	ImageStore       ImageStoreService
+	InternalLB       InternalLBService
+	Limit            LimitService
+	LoadBalancer     LoadBalancerService
+	NAT              NATService
+	NetworkACL       NetworkACLService
+	NetworkDevice    NetworkDeviceService
+	NetworkOffering  NetworkOfferingService
+	Network          NetworkService
+	Nic              NicService
+	NiciraNVP        NiciraNVPService
+	Pod              PodService
+	Pool             PoolService
+	PortableIP       PortableIPService
+	Project          ProjectService
+	Region           RegionService
+	Resourcemetadata ResourcemetadataService
+	Resourcetags     ResourcetagsService
+	Router           RouterService
+	S3               S3Service
+	SSH              SSHService
+	SecurityGroup    SecurityGroupService
+	ServiceOffering  ServiceOfferingService
+	Snapshot         SnapshotService
+	StoragePool      StoragePoolService
+	StratosphereSSP  StratosphereSSPService
+	Swift            SwiftService
+	SystemCapacity   SystemCapacityService
+	SystemVM         SystemVMService
+	Template         TemplateService
+	UCS              UCSService
+	Usage            UsageService
+	User             UserService
+	VLAN             VLANService
+	VMGroup          VMGroupService
+	VPC              VPCService
+	VPN              VPNService
+	VirtualMachine   VirtualMachineService
+	Volume           VolumeService
+	Zone             ZoneService
+}
@@ -0,0 +1,57 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// Decodes the sample response of an API into v, like the clients do: the object wrapping the response is
+// removed (twice if raw is set) and fields in the response that are not part of the response type are an error
+func decodeSample(t *testing.T, api string, response string, raw bool, v interface{}) {
+	unwrap := func(b []byte) []byte {
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(b, &m); err != nil || len(m) != 1 {
+			t.Fatalf("Unexpected sample response of %s: %s", api, b)
+		}
+		for _, v := range m {
+			return v
+		}
+		return nil
+	}
+	b := unwrap([]byte(response))
+	if raw {
+		b = unwrap(b)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		t.Fatalf("Failed to decode the sample response of %s: %v", api, err)
+	}
+}
+
+// Checks the URL values of the params of the given API
+func checkURLValues(t *testing.T, api string, got, want url.Values) {
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected URL values for %s:\n got: %v\nwant: %v", api, got, want)
+	}
+}
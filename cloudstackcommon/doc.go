@@ -0,0 +1,86 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package cloudstackcommon contains the types and interfaces of all APIs that are the same for all CloudStack versions.
+// The version specific packages use these types for those APIs, so code using only this package works with
+// any version. Use the Common() method of a version specific CloudStackClient to get a Client.
+//
+// # Services
+//
+// The API commands are grouped into the following services:
+//
+//   - APIDiscovery (APIDiscoveryService): ListApis
+//   - Account (AccountService): DeleteAccount, DeleteAccountFromProject, AddAccountToProject, ListProjectAccounts
+//   - Address (AddressService): DisassociateIpAddress
+//   - AffinityGroup (AffinityGroupService): CreateAffinityGroup, DeleteAffinityGroup, ListAffinityGroups, ListAffinityGroupTypes
+//   - Alert (AlertService): ArchiveAlerts, DeleteAlerts, GenerateAlert, ListAlerts
+//   - Asyncjob (AsyncjobService): ListAsyncJobs, QueryAsyncJobResult
+//   - AutoScale (AutoScaleService): CreateAutoScalePolicy, DeleteAutoScalePolicy, ListAutoScalePolicies, UpdateAutoScalePolicy, DeleteAutoScaleVmGroup, DeleteAutoScaleVmProfile, CreateCondition, DeleteCondition, ListConditions, CreateCounter, DeleteCounter, ListCounters
+//   - Baremetal (BaremetalService): AddBaremetalDhcp, ListBaremetalDhcp, AddBaremetalPxeKickStartServer, AddBaremetalPxePingServer, ListBaremetalPxeServers
+//   - BigSwitchVNS (BigSwitchVNSService): AddBigSwitchVnsDevice, DeleteBigSwitchVnsDevice, ListBigSwitchVnsDevices
+//   - Certificate (CertificateService): UploadCustomCertificate
+//   - CloudIdentifier (CloudIdentifierService): GetCloudIdentifier
+//   - Cluster (ClusterService): AddCluster, DedicateCluster, DeleteCluster, ListClusters, UpdateCluster, ListDedicatedClusters, ReleaseDedicatedCluster
+//   - Configuration (ConfigurationService): ListConfigurations, UpdateConfiguration, ListDeploymentPlanners, AddLdapConfiguration, DeleteLdapConfiguration, ListLdapConfigurations
+//   - DiskOffering (DiskOfferingService): DeleteDiskOffering
+//   - Domain (DomainService): CreateDomain, DeleteDomain, ListDomains, UpdateDomain, ListDomainChildren
+//   - Event (EventService): ArchiveEvents, DeleteEvents, ListEvents, ListEventTypes
+//   - Firewall (FirewallService): DeleteEgressFirewallRule, DeleteFirewallRule, AddPaloAltoFirewall, ConfigurePaloAltoFirewall, DeletePaloAltoFirewall, ListPaloAltoFirewalls, DeletePortForwardingRule
+//   - GuestOS (GuestOSService): ListOsCategories
+//   - Host (HostService): ListDedicatedHosts, ReleaseDedicatedHost, DedicateHost, DeleteHost, UpdateHostPassword, ReleaseHostReservation, FindHostsForMigration, AddSecondaryStorage
+//   - Hypervisor (HypervisorService): ListHypervisors, ListHypervisorCapabilities, UpdateHypervisorCapabilities
+//   - ISO (ISOService): CopyIso, DeleteIso, ExtractIso, ListIsos, RegisterIso, ListIsoPermissions, UpdateIsoPermissions
+//   - ImageStore (ImageStoreService): UpdateCloudToUseObjectStore, AddImageStore, DeleteImageStore, ListImageStores, CreateSecondaryStagingStore, DeleteSecondaryStagingStore, ListSecondaryStagingStores
+//   - InternalLB (InternalLBService): ConfigureInternalLoadBalancerElement, CreateInternalLoadBalancerElement, ListInternalLoadBalancerElements
+//   - Limit (LimitService): GetApiLimit, ResetApiLimit, UpdateResourceCount, ListResourceLimits, UpdateResourceLimit
+//   - LoadBalancer (LoadBalancerService): RemoveCertFromLoadBalancer, AssignCertToLoadBalancer, RemoveFromGlobalLoadBalancerRule, DeleteGlobalLoadBalancerRule, DeleteLBHealthCheckPolicy, DeleteLBStickinessPolicy, DeleteLoadBalancer, DeleteLoadBalancerRule, AddNetscalerLoadBalancer, ConfigureNetscalerLoadBalancer, DeleteNetscalerLoadBalancer, ListNetscalerLoadBalancers, DeleteSslCert, AssignToGlobalLoadBalancerRule
+//   - NAT (NATService): DeleteIpForwardingRule, DisableStaticNat, EnableStaticNat
+//   - NetworkACL (NetworkACLService): DeleteNetworkACL, DeleteNetworkACLList, ReplaceNetworkACLList
+//   - NetworkDevice (NetworkDeviceService): AddNetworkDevice, DeleteNetworkDevice, ListNetworkDevice
+//   - NetworkOffering (NetworkOfferingService): DeleteNetworkOffering
+//   - Network (NetworkService): DeleteNetwork, ListNetworkIsolationMethods, AddNetworkServiceProvider, DeleteNetworkServiceProvider, ListNetworkServiceProviders, UpdateNetworkServiceProvider, CreatePhysicalNetwork, DeletePhysicalNetwork, ListPhysicalNetworks, UpdatePhysicalNetwork, DedicatePublicIpRange, ReleasePublicIpRange, CreateStorageNetworkIpRange, DeleteStorageNetworkIpRange, ListStorageNetworkIpRange, UpdateStorageNetworkIpRange, ListSupportedNetworkServices
+//   - Nic (NicService): RemoveIpFromNic, AddIpToNic
+//   - NiciraNVP (NiciraNVPService): AddNiciraNvpDevice, DeleteNiciraNvpDevice, ListNiciraNvpDevices
+//   - Pod (PodService): ListDedicatedPods, ReleaseDedicatedPod, CreatePod, DedicatePod, DeletePod, ListPods, UpdatePod
+//   - Pool (PoolService): DeleteStoragePool
+//   - PortableIP (PortableIPService): CreatePortableIpRange, DeletePortableIpRange, ListPortableIpRanges
+//   - Project (ProjectService): ActivateProject, CreateProject, DeleteProject, ListProjects, SuspendProject, UpdateProject, DeleteProjectInvitation, ListProjectInvitations, UpdateProjectInvitation
+//   - Region (RegionService): AddRegion, ListRegions, RemoveRegion, UpdateRegion
+//   - Resourcemetadata (ResourcemetadataService): RemoveResourceDetail
+//   - Resourcetags (ResourcetagsService): CreateTags, DeleteTags, ListTags
+//   - Router (RouterService): ConfigureVirtualRouterElement, CreateVirtualRouterElement, ListVirtualRouterElements
+//   - S3 (S3Service): AddS3, ListS3s
+//   - SSH (SSHService): CreateSSHKeyPair, DeleteSSHKeyPair, ListSSHKeyPairs, RegisterSSHKeyPair
+//   - SecurityGroup (SecurityGroupService): DeleteSecurityGroup, RevokeSecurityGroupEgress, RevokeSecurityGroupIngress
+//   - ServiceOffering (ServiceOfferingService): DeleteServiceOffering
+//   - Snapshot (SnapshotService): CreateSnapshot, DeleteSnapshot, ListSnapshots, RevertSnapshot, DeleteSnapshotPolicies, CreateVMSnapshot, DeleteVMSnapshot, ListVMSnapshot
+//   - StoragePool (StoragePoolService): ListStorageProviders
+//   - StratosphereSSP (StratosphereSSPService): AddStratosphereSsp
+//   - Swift (SwiftService): AddSwift, ListSwifts
+//   - SystemCapacity (SystemCapacityService): ListCapacity
+//   - SystemVM (SystemVMService): ChangeServiceForSystemVm, DestroySystemVm, ListSystemVms, MigrateSystemVm, RebootSystemVm, ScaleSystemVm, StartSystemVm, StopSystemVm
+//   - Template (TemplateService): UpgradeRouterTemplate, CopyTemplate, CreateTemplate, DeleteTemplate, ExtractTemplate, ListTemplates, PrepareTemplate, RegisterTemplate, ListTemplatePermissions, UpdateTemplatePermissions
+//   - UCS (UCSService): ListUcsBlades, AddUcsManager, ListUcsManagers, ListUcsProfiles, AssociateUcsProfileToBlade
+//   - Usage (UsageService): AddTrafficMonitor, DeleteTrafficMonitor, ListTrafficMonitors, AddTrafficType, DeleteTrafficType, ListTrafficTypes, UpdateTrafficType, ListTrafficTypeImplementors, GenerateUsageRecords, ListUsageTypes
+//   - User (UserService): ImportLdapUsers, ListLdapUsers, CreateUser, DeleteUser, DisableUser, EnableUser, GetUser, ListUsers, LockUser, UpdateUser, RegisterUserKeys
+//   - VLAN (VLANService): ListDedicatedGuestVlanRanges, ReleaseDedicatedGuestVlanRange, DedicateGuestVlanRange, CreateVlanIpRange, DeleteVlanIpRange, ListVlanIpRanges
+//   - VMGroup (VMGroupService): CreateInstanceGroup, DeleteInstanceGroup, ListInstanceGroups, UpdateInstanceGroup
+//   - VPC (VPCService): CreatePrivateGateway, DeletePrivateGateway, ListPrivateGateways, CreateStaticRoute, DeleteStaticRoute, ListStaticRoutes, DeleteVPC, DeleteVPCOffering
+//   - VPN (VPNService): DeleteRemoteAccessVpn, DeleteVpnConnection, CreateVpnCustomerGateway, DeleteVpnCustomerGateway, ListVpnCustomerGateways, UpdateVpnCustomerGateway, DeleteVpnGateway, AddVpnUser, ListVpnUsers, RemoveVpnUser
+//   - VirtualMachine (VirtualMachineService): GetVMPassword, CleanVMReservations, ExpungeVirtualMachine, ScaleVirtualMachine
+//   - Volume (VolumeService): DeleteVolume, ExtractVolume
+//   - Zone (ZoneService): ListDedicatedZones, ReleaseDedicatedZone, CreateZone, DedicateZone, DeleteZone, ListZones, UpdateZone
+package cloudstackcommon
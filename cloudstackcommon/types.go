@@ -0,0 +1,600 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cloudstackcommon
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Returned when one or more params of an API call are invalid
+type ValidationError struct {
+	API    string
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return fmt.Sprintf("Invalid params for API %s: %s", e.API, strings.Join(msgs, "; "))
+}
+
+// A single param that is invalid
+type FieldError struct {
+	Param   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Param, e.Message)
+}
+
+// Collects the errors found while validating the params of an API call
+type validator struct {
+	api    string
+	errors []*FieldError
+}
+
+func (v *validator) fail(param string, format string, args ...interface{}) {
+	v.errors = append(v.errors, &FieldError{Param: param, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) required(param string, set bool) {
+	if !set {
+		v.fail(param, "is required")
+	}
+}
+
+func (v *validator) enum(param string, value string, known bool, numeric bool) {
+	if _, err := strconv.Atoi(value); known || numeric && err == nil {
+		return
+	}
+	v.fail(param, "is not a known value: %s", value)
+}
+
+func (v *validator) exclusive(param string, set bool, other string, otherSet bool) {
+	if set && otherSet {
+		v.fail(param, "can not be used together with %s", other)
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{API: v.api, Errors: v.errors}
+}
+
+// An option of the courtesy helper functions (like GetVirtualMachineID), which limits the scope in which
+// the object is searched by setting a param of the 'list' API used
+type OptionFunc func(p interface{}) error
+
+// Returned by an OptionFunc if the 'list' API used can not be limited to the scope of the option
+type ScopeError struct {
+	API   string
+	Scope string // One of 'project', 'domain', 'zone' or 'listall'
+}
+
+func (e *ScopeError) Error() string {
+	return fmt.Sprintf("API %s can not be scoped by %s", e.API, e.Scope)
+}
+
+func scopeOption(scope string, set func(p interface{}) bool) OptionFunc {
+	return func(p interface{}) error {
+		if !set(p) {
+			return &ScopeError{API: p.(Command).Command(), Scope: scope}
+		}
+		return nil
+	}
+}
+
+// Only searches the objects of the project with the given ID, or of all projects if the ID is '-1'
+func WithProject(id string) OptionFunc {
+	return scopeOption("project", func(p interface{}) bool {
+		ps, ok := p.(interface{ SetProjectid(string) })
+		if ok {
+			ps.SetProjectid(id)
+		}
+		return ok
+	})
+}
+
+// Only searches the objects of the domain with the given ID
+func WithDomain(id string) OptionFunc {
+	return scopeOption("domain", func(p interface{}) bool {
+		ps, ok := p.(interface{ SetDomainid(string) })
+		if ok {
+			ps.SetDomainid(id)
+		}
+		return ok
+	})
+}
+
+// Only searches the objects in the zone with the given ID
+func WithZone(id string) OptionFunc {
+	return scopeOption("zone", func(p interface{}) bool {
+		ps, ok := p.(interface{ SetZoneid(string) })
+		if ok {
+			ps.SetZoneid(id)
+		}
+		return ok
+	})
+}
+
+// Searches the objects of all accounts the caller has access to (like the objects of the accounts
+// of a domain for a domain admin), instead of only the objects of the caller
+func WithListAll(listall bool) OptionFunc {
+	return scopeOption("listall", func(p interface{}) bool {
+		ps, ok := p.(interface{ SetListall(bool) })
+		if ok {
+			ps.SetListall(listall)
+		}
+		return ok
+	})
+}
+
+// Returned by the courtesy helper functions if multiple objects have the name that is searched for
+type AmbiguousError struct {
+	Type       string // The type of the objects, like 'VirtualMachine'
+	Name       string
+	Candidates []*Candidate
+}
+
+func (e *AmbiguousError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, c := range e.Candidates {
+		candidates[i] = c.String()
+	}
+	return fmt.Sprintf("Found %d objects of type %s named %s: %s", len(e.Candidates), e.Type, e.Name, strings.Join(candidates, ", "))
+}
+
+// An object that matches the name that is searched for. Zone, Project and Domain are only set if
+// the objects have these fields.
+type Candidate struct {
+	ID      string
+	Name    string
+	Zone    string
+	Project string
+	Domain  string
+}
+
+func (c *Candidate) String() string {
+	scope := []string{}
+	if c.Zone != "" {
+		scope = append(scope, "zone: "+c.Zone)
+	}
+	if c.Project != "" {
+		scope = append(scope, "project: "+c.Project)
+	}
+	if c.Domain != "" {
+		scope = append(scope, "domain: "+c.Domain)
+	}
+	if len(scope) == 0 {
+		return c.ID
+	}
+	return fmt.Sprintf("%s (%s)", c.ID, strings.Join(scope, ", "))
+}
+
+// The direction of the traffic a network ACL item applies to. Values that are not listed here can be used by converting a string, like ACLTrafficType("value"),
+// but are rejected by the Validate function of the params.
+type ACLTrafficType string
+
+const (
+	ACLTrafficTypeIngress ACLTrafficType = "Ingress"
+	ACLTrafficTypeEgress  ACLTrafficType = "Egress"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v ACLTrafficType) Known() bool {
+	for _, k := range []ACLTrafficType{ACLTrafficTypeIngress, ACLTrafficTypeEgress} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// A hypervisor supported by CloudStack. Values that are not listed here can be used by converting a string, like HypervisorType("value"),
+// but are rejected by the Validate function of the params.
+type HypervisorType string
+
+const (
+	HypervisorBareMetal HypervisorType = "BareMetal"
+	HypervisorHyperV    HypervisorType = "Hyperv"
+	HypervisorKVM       HypervisorType = "KVM"
+	HypervisorLXC       HypervisorType = "LXC"
+	HypervisorOvm       HypervisorType = "Ovm"
+	HypervisorSimulator HypervisorType = "Simulator"
+	HypervisorVMware    HypervisorType = "VMware"
+	HypervisorXenServer HypervisorType = "XenServer"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v HypervisorType) Known() bool {
+	for _, k := range []HypervisorType{HypervisorBareMetal, HypervisorHyperV, HypervisorKVM, HypervisorLXC, HypervisorOvm, HypervisorSimulator, HypervisorVMware, HypervisorXenServer} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The algorithm used by a load balancer rule. Values that are not listed here can be used by converting a string, like LBAlgorithm("value"),
+// but are rejected by the Validate function of the params.
+type LBAlgorithm string
+
+const (
+	LBAlgorithmLeastConn  LBAlgorithm = "leastconn"
+	LBAlgorithmRoundRobin LBAlgorithm = "roundrobin"
+	LBAlgorithmSource     LBAlgorithm = "source"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v LBAlgorithm) Known() bool {
+	for _, k := range []LBAlgorithm{LBAlgorithmLeastConn, LBAlgorithmRoundRobin, LBAlgorithmSource} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The protocol of a firewall, port forwarding, load balancer or network ACL rule. Values that are not listed here can be used by converting a string, like Protocol("value"),
+// but are rejected by the Validate function of the params.
+type Protocol string
+
+const (
+	ProtocolAll      Protocol = "all"
+	ProtocolICMP     Protocol = "icmp"
+	ProtocolTCP      Protocol = "tcp"
+	ProtocolTCPProxy Protocol = "tcp-proxy"
+	ProtocolUDP      Protocol = "udp"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v Protocol) Known() bool {
+	for _, k := range []Protocol{ProtocolAll, ProtocolICMP, ProtocolTCP, ProtocolTCPProxy, ProtocolUDP} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The interval of a snapshot policy, or the policy that created a snapshot. Values that are not listed here can be used by converting a string, like SnapshotInterval("value"),
+// but are rejected by the Validate function of the params.
+type SnapshotInterval string
+
+const (
+	SnapshotIntervalDaily   SnapshotInterval = "DAILY"
+	SnapshotIntervalHourly  SnapshotInterval = "HOURLY"
+	SnapshotIntervalManual  SnapshotInterval = "MANUAL"
+	SnapshotIntervalMonthly SnapshotInterval = "MONTHLY"
+	SnapshotIntervalWeekly  SnapshotInterval = "WEEKLY"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v SnapshotInterval) Known() bool {
+	for _, k := range []SnapshotInterval{SnapshotIntervalDaily, SnapshotIntervalHourly, SnapshotIntervalManual, SnapshotIntervalMonthly, SnapshotIntervalWeekly} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The type of a resource that can have tags and details. Values that are not listed here can be used by converting a string, like TaggedResourceType("value"),
+// but are rejected by the Validate function of the params.
+type TaggedResourceType string
+
+const (
+	TaggedResourceTypeAutoScaleVmGroup   TaggedResourceType = "AutoScaleVmGroup"
+	TaggedResourceTypeAutoScaleVmProfile TaggedResourceType = "AutoScaleVmProfile"
+	TaggedResourceTypeCustomerGateway    TaggedResourceType = "CustomerGateway"
+	TaggedResourceTypeDiskOffering       TaggedResourceType = "DiskOffering"
+	TaggedResourceTypeFirewallRule       TaggedResourceType = "FirewallRule"
+	TaggedResourceTypeISO                TaggedResourceType = "ISO"
+	TaggedResourceTypeLoadBalancer       TaggedResourceType = "LoadBalancer"
+	TaggedResourceTypeNetwork            TaggedResourceType = "Network"
+	TaggedResourceTypeNetworkACL         TaggedResourceType = "NetworkACL"
+	TaggedResourceTypeNetworkACLList     TaggedResourceType = "NetworkACLList"
+	TaggedResourceTypeNic                TaggedResourceType = "Nic"
+	TaggedResourceTypePortForwardingRule TaggedResourceType = "PortForwardingRule"
+	TaggedResourceTypePrivateGateway     TaggedResourceType = "PrivateGateway"
+	TaggedResourceTypeProject            TaggedResourceType = "Project"
+	TaggedResourceTypePublicIpAddress    TaggedResourceType = "PublicIpAddress"
+	TaggedResourceTypeRemoteAccessVpn    TaggedResourceType = "RemoteAccessVpn"
+	TaggedResourceTypeSecurityGroup      TaggedResourceType = "SecurityGroup"
+	TaggedResourceTypeServiceOffering    TaggedResourceType = "ServiceOffering"
+	TaggedResourceTypeSnapshot           TaggedResourceType = "Snapshot"
+	TaggedResourceTypeStaticRoute        TaggedResourceType = "StaticRoute"
+	TaggedResourceTypeStorage            TaggedResourceType = "Storage"
+	TaggedResourceTypeTemplate           TaggedResourceType = "Template"
+	TaggedResourceTypeUser               TaggedResourceType = "User"
+	TaggedResourceTypeUserVm             TaggedResourceType = "UserVm"
+	TaggedResourceTypeVMSnapshot         TaggedResourceType = "VMSnapshot"
+	TaggedResourceTypeVolume             TaggedResourceType = "Volume"
+	TaggedResourceTypeVpc                TaggedResourceType = "Vpc"
+	TaggedResourceTypeVpnConnection      TaggedResourceType = "VpnConnection"
+	TaggedResourceTypeVpnGateway         TaggedResourceType = "VpnGateway"
+	TaggedResourceTypeZone               TaggedResourceType = "Zone"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v TaggedResourceType) Known() bool {
+	for _, k := range []TaggedResourceType{TaggedResourceTypeAutoScaleVmGroup, TaggedResourceTypeAutoScaleVmProfile, TaggedResourceTypeCustomerGateway, TaggedResourceTypeDiskOffering, TaggedResourceTypeFirewallRule, TaggedResourceTypeISO, TaggedResourceTypeLoadBalancer, TaggedResourceTypeNetwork, TaggedResourceTypeNetworkACL, TaggedResourceTypeNetworkACLList, TaggedResourceTypeNic, TaggedResourceTypePortForwardingRule, TaggedResourceTypePrivateGateway, TaggedResourceTypeProject, TaggedResourceTypePublicIpAddress, TaggedResourceTypeRemoteAccessVpn, TaggedResourceTypeSecurityGroup, TaggedResourceTypeServiceOffering, TaggedResourceTypeSnapshot, TaggedResourceTypeStaticRoute, TaggedResourceTypeStorage, TaggedResourceTypeTemplate, TaggedResourceTypeUser, TaggedResourceTypeUserVm, TaggedResourceTypeVMSnapshot, TaggedResourceTypeVolume, TaggedResourceTypeVpc, TaggedResourceTypeVpnConnection, TaggedResourceTypeVpnGateway, TaggedResourceTypeZone} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The type of traffic carried by a network. Values that are not listed here can be used by converting a string, like NetworkTrafficType("value"),
+// but are rejected by the Validate function of the params.
+type NetworkTrafficType string
+
+const (
+	TrafficTypeControl    NetworkTrafficType = "Control"
+	TrafficTypeGuest      NetworkTrafficType = "Guest"
+	TrafficTypeManagement NetworkTrafficType = "Management"
+	TrafficTypePublic     NetworkTrafficType = "Public"
+	TrafficTypeStorage    NetworkTrafficType = "Storage"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v NetworkTrafficType) Known() bool {
+	for _, k := range []NetworkTrafficType{TrafficTypeControl, TrafficTypeGuest, TrafficTypeManagement, TrafficTypePublic, TrafficTypeStorage} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The state of a virtual machine. Values that are not listed here can be used by converting a string, like VMState("value"),
+// but are rejected by the Validate function of the params.
+type VMState string
+
+const (
+	VMStateDestroyed  VMState = "Destroyed"
+	VMStateError      VMState = "Error"
+	VMStateExpunging  VMState = "Expunging"
+	VMStateMigrating  VMState = "Migrating"
+	VMStateRunning    VMState = "Running"
+	VMStateShutdowned VMState = "Shutdowned"
+	VMStateStarting   VMState = "Starting"
+	VMStateStopped    VMState = "Stopped"
+	VMStateStopping   VMState = "Stopping"
+	VMStateUnknown    VMState = "Unknown"
+)
+
+// Returns true if the value is one of the values listed above, ignoring the case like CloudStack does
+func (v VMState) Known() bool {
+	for _, k := range []VMState{VMStateDestroyed, VMStateError, VMStateExpunging, VMStateMigrating, VMStateRunning, VMStateShutdowned, VMStateStarting, VMStateStopped, VMStateStopping, VMStateUnknown} {
+		if strings.EqualFold(string(v), string(k)) {
+			return true
+		}
+	}
+	return false
+}
+
+// The layouts CloudStack uses for dates
+var dateLayouts = []string{
+	"2006-01-02T15:04:05-0700",
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006-01-02'T'15:04:05-07:00", // Used for usage records
+}
+
+// A date as returned by CloudStack. The date as returned is kept in Raw, so if it has an unexpected
+// format the response can still be decoded. In that case the embedded time is zero.
+type Date struct {
+	time.Time
+	Raw string
+}
+
+func (d *Date) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &d.Raw); err != nil {
+		d.Raw = string(b)
+	}
+	d.Time = time.Time{}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, d.Raw); err == nil {
+			d.Time = t
+			break
+		}
+	}
+	return nil
+}
+
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(dec, start, d)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.Raw == "" && !d.IsZero() {
+		return json.Marshal(d.Format(dateLayouts[0]))
+	}
+	return json.Marshal(d.Raw)
+}
+
+// A boolean that CloudStack returns either as a JSON boolean or as a string. Values that can't be
+// parsed are decoded as false.
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	v, err := strconv.ParseBool(strings.Trim(string(data), "\""))
+	*b = Bool(err == nil && v)
+	return nil
+}
+
+func (b *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, b)
+}
+
+// A percentage, which CloudStack returns as a string like '12.5%'
+type Percentage float64
+
+func (p *Percentage) UnmarshalJSON(b []byte) error {
+	*p = Percentage(parseNumber(b, "%"))
+	return nil
+}
+
+func (p *Percentage) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, p)
+}
+
+func (p Percentage) String() string {
+	return strconv.FormatFloat(float64(p), 'f', -1, 64) + "%"
+}
+
+// A number that CloudStack returns as a string
+type Float float64
+
+func (f *Float) UnmarshalJSON(b []byte) error {
+	*f = Float(parseNumber(b, ""))
+	return nil
+}
+
+func (f *Float) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, f)
+}
+
+// A size in bytes
+type Size int64
+
+func (s *Size) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseInt(strings.Trim(string(b), "\""), 10, 64)
+	if err != nil {
+		v = int64(parseNumber(b, ""))
+	}
+	*s = Size(v)
+	return nil
+}
+
+func (s *Size) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalXMLText(d, start, s)
+}
+
+func (s Size) KB() float64 {
+	return float64(s) / 1024
+}
+
+func (s Size) MB() float64 {
+	return float64(s) / (1024 * 1024)
+}
+
+func (s Size) GB() float64 {
+	return float64(s) / (1024 * 1024 * 1024)
+}
+
+// Returns the size in the largest unit that is at least 1, like '1.5 GB'
+func (s Size) String() string {
+	switch {
+	case s >= 1024*1024*1024:
+		return fmt.Sprintf("%.1f GB", s.GB())
+	case s >= 1024*1024:
+		return fmt.Sprintf("%.1f MB", s.MB())
+	case s >= 1024:
+		return fmt.Sprintf("%.1f KB", s.KB())
+	default:
+		return strconv.FormatInt(int64(s), 10) + " bytes"
+	}
+}
+
+// Decodes the text of an XML element like the same value would be decoded if it was returned as a JSON string
+func unmarshalXMLText(d *xml.Decoder, start xml.StartElement, u json.Unmarshaler) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return u.UnmarshalJSON(b)
+}
+
+// Parses a number that may be quoted and may have the given suffix. Values that can't be parsed
+// are returned as 0, so an unexpected format doesn't break decoding the rest of the response.
+func parseNumber(b []byte, suffix string) float64 {
+	s := strings.TrimSpace(strings.TrimSuffix(strings.Trim(string(b), "\""), suffix))
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+type ApiParam struct {
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Length      int                        `json:"length,omitempty" xml:"length,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Related     string                     `json:"related,omitempty" xml:"related,omitempty"`
+	Required    Bool                       `json:"required,omitempty" xml:"required,omitempty"`
+	Since       string                     `json:"since,omitempty" xml:"since,omitempty"`
+	Type        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ApiResponse struct {
+	Description string                     `json:"description,omitempty" xml:"description,omitempty"`
+	Name        string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Response    []string                   `json:"response,omitempty" xml:"response,omitempty"`
+	Type        string                     `json:"type,omitempty" xml:"type,omitempty"`
+	Extra       map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type PortableIpAddress struct {
+	Accountid         string                     `json:"accountid,omitempty" xml:"accountid,omitempty"`
+	Allocated         string                     `json:"allocated,omitempty" xml:"allocated,omitempty"`
+	Domainid          string                     `json:"domainid,omitempty" xml:"domainid,omitempty"`
+	Ipaddress         string                     `json:"ipaddress,omitempty" xml:"ipaddress,omitempty"`
+	Networkid         string                     `json:"networkid,omitempty" xml:"networkid,omitempty"`
+	Physicalnetworkid string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Regionid          int                        `json:"regionid,omitempty" xml:"regionid,omitempty"`
+	State             string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Vpcid             string                     `json:"vpcid,omitempty" xml:"vpcid,omitempty"`
+	Zoneid            string                     `json:"zoneid,omitempty" xml:"zoneid,omitempty"`
+	Extra             map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type Provider struct {
+	Canenableindividualservice   Bool                       `json:"canenableindividualservice,omitempty" xml:"canenableindividualservice,omitempty"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid,omitempty" xml:"destinationphysicalnetworkid,omitempty"`
+	Id                           string                     `json:"id,omitempty" xml:"id,omitempty"`
+	Name                         string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid,omitempty" xml:"physicalnetworkid,omitempty"`
+	Servicelist                  []string                   `json:"servicelist,omitempty" xml:"servicelist,omitempty"`
+	State                        string                     `json:"state,omitempty" xml:"state,omitempty"`
+	Extra                        map[string]json.RawMessage `json:"-" xml:"-"`
+}
+
+type ServiceCapabilityValue struct {
+	Canchooseservicecapability Bool                       `json:"canchooseservicecapability,omitempty" xml:"canchooseservicecapability,omitempty"`
+	Name                       string                     `json:"name,omitempty" xml:"name,omitempty"`
+	Value                      string                     `json:"value,omitempty" xml:"value,omitempty"`
+	Extra                      map[string]json.RawMessage `json:"-" xml:"-"`
+}
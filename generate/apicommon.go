@@ -0,0 +1,310 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+// The commands in this list have the exact same parameters and responses in all
+// supported API versions, so their types can be shared between the version
+// specific packages. If a command changes in a new API version, remove it here.
+var common = []string{
+	"activateProject",
+	"addAccountToProject",
+	"addBaremetalDhcp",
+	"addBaremetalPxeKickStartServer",
+	"addBaremetalPxePingServer",
+	"addBigSwitchVnsDevice",
+	"addCluster",
+	"addImageStore",
+	"addIpToNic",
+	"addLdapConfiguration",
+	"addNetscalerLoadBalancer",
+	"addNetworkDevice",
+	"addNetworkServiceProvider",
+	"addNiciraNvpDevice",
+	"addPaloAltoFirewall",
+	"addRegion",
+	"addS3",
+	"addSecondaryStorage",
+	"addStratosphereSsp",
+	"addSwift",
+	"addTrafficMonitor",
+	"addTrafficType",
+	"addUcsManager",
+	"addVpnUser",
+	"archiveAlerts",
+	"archiveEvents",
+	"assignCertToLoadBalancer",
+	"assignToGlobalLoadBalancerRule",
+	"associateUcsProfileToBlade",
+	"changeServiceForSystemVm",
+	"cleanVMReservations",
+	"configureInternalLoadBalancerElement",
+	"configureNetscalerLoadBalancer",
+	"configurePaloAltoFirewall",
+	"configureVirtualRouterElement",
+	"copyIso",
+	"copyTemplate",
+	"createAffinityGroup",
+	"createAutoScalePolicy",
+	"createCondition",
+	"createCounter",
+	"createDomain",
+	"createInstanceGroup",
+	"createInternalLoadBalancerElement",
+	"createPhysicalNetwork",
+	"createPod",
+	"createPortableIpRange",
+	"createPrivateGateway",
+	"createProject",
+	"createSSHKeyPair",
+	"createSecondaryStagingStore",
+	"createSnapshot",
+	"createStaticRoute",
+	"createStorageNetworkIpRange",
+	"createTags",
+	"createTemplate",
+	"createUser",
+	"createVMSnapshot",
+	"createVirtualRouterElement",
+	"createVlanIpRange",
+	"createVpnCustomerGateway",
+	"createZone",
+	"dedicateCluster",
+	"dedicateGuestVlanRange",
+	"dedicateHost",
+	"dedicatePod",
+	"dedicatePublicIpRange",
+	"dedicateZone",
+	"deleteAccount",
+	"deleteAccountFromProject",
+	"deleteAffinityGroup",
+	"deleteAlerts",
+	"deleteAutoScalePolicy",
+	"deleteAutoScaleVmGroup",
+	"deleteAutoScaleVmProfile",
+	"deleteBigSwitchVnsDevice",
+	"deleteCluster",
+	"deleteCondition",
+	"deleteCounter",
+	"deleteDiskOffering",
+	"deleteDomain",
+	"deleteEgressFirewallRule",
+	"deleteEvents",
+	"deleteFirewallRule",
+	"deleteGlobalLoadBalancerRule",
+	"deleteHost",
+	"deleteImageStore",
+	"deleteInstanceGroup",
+	"deleteIpForwardingRule",
+	"deleteIso",
+	"deleteLBHealthCheckPolicy",
+	"deleteLBStickinessPolicy",
+	"deleteLdapConfiguration",
+	"deleteLoadBalancer",
+	"deleteLoadBalancerRule",
+	"deleteNetscalerLoadBalancer",
+	"deleteNetwork",
+	"deleteNetworkACL",
+	"deleteNetworkACLList",
+	"deleteNetworkDevice",
+	"deleteNetworkOffering",
+	"deleteNetworkServiceProvider",
+	"deleteNiciraNvpDevice",
+	"deletePaloAltoFirewall",
+	"deletePhysicalNetwork",
+	"deletePod",
+	"deletePortForwardingRule",
+	"deletePortableIpRange",
+	"deletePrivateGateway",
+	"deleteProject",
+	"deleteProjectInvitation",
+	"deleteRemoteAccessVpn",
+	"deleteSSHKeyPair",
+	"deleteSecondaryStagingStore",
+	"deleteSecurityGroup",
+	"deleteServiceOffering",
+	"deleteSnapshot",
+	"deleteSnapshotPolicies",
+	"deleteSslCert",
+	"deleteStaticRoute",
+	"deleteStorageNetworkIpRange",
+	"deleteStoragePool",
+	"deleteTags",
+	"deleteTemplate",
+	"deleteTrafficMonitor",
+	"deleteTrafficType",
+	"deleteUser",
+	"deleteVMSnapshot",
+	"deleteVPC",
+	"deleteVPCOffering",
+	"deleteVlanIpRange",
+	"deleteVolume",
+	"deleteVpnConnection",
+	"deleteVpnCustomerGateway",
+	"deleteVpnGateway",
+	"deleteZone",
+	"destroySystemVm",
+	"disableStaticNat",
+	"disableUser",
+	"disassociateIpAddress",
+	"enableStaticNat",
+	"enableUser",
+	"expungeVirtualMachine",
+	"extractIso",
+	"extractTemplate",
+	"extractVolume",
+	"findHostsForMigration",
+	"generateAlert",
+	"generateUsageRecords",
+	"getApiLimit",
+	"getCloudIdentifier",
+	"getUser",
+	"getVMPassword",
+	"importLdapUsers",
+	"listAffinityGroupTypes",
+	"listAffinityGroups",
+	"listAlerts",
+	"listApis",
+	"listAsyncJobs",
+	"listAutoScalePolicies",
+	"listBaremetalDhcp",
+	"listBaremetalPxeServers",
+	"listBigSwitchVnsDevices",
+	"listCapacity",
+	"listClusters",
+	"listConditions",
+	"listConfigurations",
+	"listCounters",
+	"listDedicatedClusters",
+	"listDedicatedGuestVlanRanges",
+	"listDedicatedHosts",
+	"listDedicatedPods",
+	"listDedicatedZones",
+	"listDeploymentPlanners",
+	"listDomainChildren",
+	"listDomains",
+	"listEventTypes",
+	"listEvents",
+	"listHypervisorCapabilities",
+	"listHypervisors",
+	"listImageStores",
+	"listInstanceGroups",
+	"listInternalLoadBalancerElements",
+	"listIsoPermissions",
+	"listIsos",
+	"listLdapConfigurations",
+	"listLdapUsers",
+	"listNetscalerLoadBalancers",
+	"listNetworkDevice",
+	"listNetworkIsolationMethods",
+	"listNetworkServiceProviders",
+	"listNiciraNvpDevices",
+	"listOsCategories",
+	"listPaloAltoFirewalls",
+	"listPhysicalNetworks",
+	"listPods",
+	"listPortableIpRanges",
+	"listPrivateGateways",
+	"listProjectAccounts",
+	"listProjectInvitations",
+	"listProjects",
+	"listRegions",
+	"listResourceLimits",
+	"listS3s",
+	"listSSHKeyPairs",
+	"listSecondaryStagingStores",
+	"listSnapshots",
+	"listStaticRoutes",
+	"listStorageNetworkIpRange",
+	"listStorageProviders",
+	"listSupportedNetworkServices",
+	"listSwifts",
+	"listSystemVms",
+	"listTags",
+	"listTemplatePermissions",
+	"listTemplates",
+	"listTrafficMonitors",
+	"listTrafficTypeImplementors",
+	"listTrafficTypes",
+	"listUcsBlades",
+	"listUcsManagers",
+	"listUcsProfiles",
+	"listUsageTypes",
+	"listUsers",
+	"listVMSnapshot",
+	"listVirtualRouterElements",
+	"listVlanIpRanges",
+	"listVpnCustomerGateways",
+	"listVpnUsers",
+	"listZones",
+	"lockUser",
+	"migrateSystemVm",
+	"prepareTemplate",
+	"queryAsyncJobResult",
+	"rebootSystemVm",
+	"registerIso",
+	"registerSSHKeyPair",
+	"registerTemplate",
+	"registerUserKeys",
+	"releaseDedicatedCluster",
+	"releaseDedicatedGuestVlanRange",
+	"releaseDedicatedHost",
+	"releaseDedicatedPod",
+	"releaseDedicatedZone",
+	"releaseHostReservation",
+	"releasePublicIpRange",
+	"removeCertFromLoadBalancer",
+	"removeFromGlobalLoadBalancerRule",
+	"removeIpFromNic",
+	"removeRegion",
+	"removeResourceDetail",
+	"removeVpnUser",
+	"replaceNetworkACLList",
+	"resetApiLimit",
+	"revertSnapshot",
+	"revokeSecurityGroupEgress",
+	"revokeSecurityGroupIngress",
+	"scaleSystemVm",
+	"scaleVirtualMachine",
+	"startSystemVm",
+	"stopSystemVm",
+	"suspendProject",
+	"updateAutoScalePolicy",
+	"updateCloudToUseObjectStore",
+	"updateCluster",
+	"updateConfiguration",
+	"updateDomain",
+	"updateHostPassword",
+	"updateHypervisorCapabilities",
+	"updateInstanceGroup",
+	"updateIsoPermissions",
+	"updateNetworkServiceProvider",
+	"updatePhysicalNetwork",
+	"updatePod",
+	"updateProject",
+	"updateProjectInvitation",
+	"updateRegion",
+	"updateResourceCount",
+	"updateResourceLimit",
+	"updateStorageNetworkIpRange",
+	"updateTemplatePermissions",
+	"updateTrafficType",
+	"updateUser",
+	"updateVpnCustomerGateway",
+	"updateZone",
+	"upgradeRouterTemplate",
+	"uploadCustomCertificate",
+}
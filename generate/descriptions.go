@@ -0,0 +1,69 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+// Descriptions of params that are used by many APIs, by name. They are only used for the docs of params of
+// which the API details have no description, like the params of the saved stub specs. Params of which the
+// meaning differs per API, like 'id' and 'name', are not listed.
+var paramDescriptions = map[string]string{
+	"account":           "the name of the account",
+	"accountid":         "the ID of the account",
+	"accounttype":       "the type of the account: 0 for a user, 1 for a root admin and 2 for a domain admin",
+	"allocationstate":   "the allocation state, which is Enabled or Disabled",
+	"cidrlist":          "the list of CIDRs",
+	"clusterid":         "the ID of the cluster",
+	"customid":          "an optional field, in case you want to set a custom ID to the resource; allowed to root admins only",
+	"displaytext":       "the display text",
+	"domainid":          "the ID of the domain",
+	"enddate":           "the end date, in the format yyyy-MM-dd",
+	"endip":             "the last IP address of the range",
+	"endport":           "the end of the port range",
+	"fordisplay":        "whether the resource is displayed to the end user; only root admins can change this",
+	"forced":            "force the operation, even if the resource is in use",
+	"hostid":            "the ID of the host",
+	"hypervisor":        "the hypervisor, like KVM, XenServer or VMware",
+	"icmpcode":          "the ICMP code, for the icmp protocol",
+	"icmptype":          "the ICMP type, for the icmp protocol",
+	"ids":               "the IDs of the resources",
+	"ipaddressid":       "the ID of the public IP address",
+	"isrecursive":       "false by default; if true, the resources of the given domain and all its subdomains are listed",
+	"keyword":           "lists the resources matching the keyword",
+	"listall":           "false by default, which lists only the resources of the caller; if true, all resources the caller is authorized to see are listed",
+	"netmask":           "the netmask",
+	"networkdomain":     "the network domain",
+	"networkid":         "the ID of the network",
+	"ostypeid":          "the ID of the OS type",
+	"page":              "the page to list, starting at 1",
+	"pagesize":          "the number of resources per page",
+	"password":          "the password",
+	"physicalnetworkid": "the ID of the physical network",
+	"podid":             "the ID of the pod",
+	"projectid":         "the ID of the project",
+	"resourcetype":      "the type of the resource, like UserVm or Volume",
+	"serviceofferingid": "the ID of the service offering",
+	"startdate":         "the start date, in the format yyyy-MM-dd",
+	"startip":           "the first IP address of the range",
+	"startport":         "the start of the port range",
+	"tags":              "the tags, as key/value pairs",
+	"templateid":        "the ID of the template",
+	"timezone":          "the time zone, like America/New_York",
+	"username":          "the name of the user",
+	"virtualmachineid":  "the ID of the virtual machine",
+	"volumeid":          "the ID of the volume",
+	"vpcid":             "the ID of the VPC",
+	"zoneid":            "the ID of the zone",
+}
@@ -0,0 +1,378 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The differences between the APIs of two CloudStack versions
+type apiDiff struct {
+	Old             string         `json:"old"`
+	New             string         `json:"new"`
+	RemovedServices []string       `json:"removedServices"`
+	AddedServices   []string       `json:"addedServices"`
+	Removed         []*commandRef  `json:"removed"`
+	Added           []*commandRef  `json:"added"`
+	Changed         []*commandDiff `json:"changed"`
+}
+
+type commandRef struct {
+	Name    string `json:"name"`
+	Service string `json:"service"`
+}
+
+// The changes of a command that is part of both versions
+type commandDiff struct {
+	Name     string             `json:"name"`
+	Service  string             `json:"service"`
+	Changes  []*attributeChange `json:"changes,omitempty"`
+	Params   []*fieldChange     `json:"params,omitempty"`
+	Response []*fieldChange     `json:"response,omitempty"`
+}
+
+// A param or response field that is added, removed or changed. The fields of nested response
+// objects are named by their path, like 'nic.ipaddress'.
+type fieldChange struct {
+	Name    string             `json:"name"`
+	Change  string             `json:"change"`            // One of 'added', 'removed' or 'changed'
+	Type    string             `json:"type,omitempty"`    // The type of an added or removed field
+	Changes []*attributeChange `json:"changes,omitempty"` // The changed attributes of a changed field
+}
+
+type attributeChange struct {
+	Attribute string `json:"attribute"`
+	Old       string `json:"old"`
+	New       string `json:"new"`
+}
+
+// Runs the diff subcommand, which reports the differences between the APIs of two versions. The
+// versions are given as a version like 'v43', or as the file name of a saved listApis response.
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "Report the differences as JSON instead of text.")
+	overrides := fs.String("overrides", "overrides.json", "A JSON file mapping APIs to the service they belong to, for APIs that are not grouped correctly by their name.")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s diff [flags] <old version or spec> <new version or spec>\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	ov, err := getOverrides(*overrides)
+	if err != nil {
+		return err
+	}
+
+	oldAI, err := getSpecApiInfo(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	newAI, err := getSpecApiInfo(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	d := diffAPIs(oldAI, newAI, ov)
+	d.Old, d.New = fs.Arg(0), fs.Arg(1)
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(d)
+	}
+	d.writeText(os.Stdout)
+	return nil
+}
+
+// Returns the API details of a version, or of the given saved listApis response
+func getSpecApiInfo(v string) (map[string]*API, error) {
+	file := v
+	if !strings.HasSuffix(v, ".json") {
+		file = specFile(v)
+	}
+	resp, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return parseApiInfo(resp)
+}
+
+func diffAPIs(oldAI, newAI map[string]*API, ov map[string]string) *apiDiff {
+	oldServices := serviceOfAPIs(oldAI, ov)
+	newServices := serviceOfAPIs(newAI, ov)
+
+	d := &apiDiff{
+		RemovedServices: missingServices(oldServices, newServices),
+		AddedServices:   missingServices(newServices, oldServices),
+		Removed:         []*commandRef{},
+		Added:           []*commandRef{},
+		Changed:         []*commandDiff{},
+	}
+
+	for _, name := range sortedAPINames(oldAI) {
+		if _, found := newAI[name]; !found {
+			d.Removed = append(d.Removed, &commandRef{name, oldServices[name]})
+		}
+	}
+
+	for _, name := range sortedAPINames(newAI) {
+		o, n := oldAI[name], newAI[name]
+		if o == nil {
+			d.Added = append(d.Added, &commandRef{name, newServices[name]})
+			continue
+		}
+
+		cd := &commandDiff{Name: name, Service: newServices[name]}
+		cd.Changes = compareAttribute(cd.Changes, "service", oldServices[name], newServices[name])
+		cd.Changes = compareAttribute(cd.Changes, "async", strconv.FormatBool(o.Isasync), strconv.FormatBool(n.Isasync))
+		cd.Params = diffParams(o.Params, n.Params)
+		cd.Response = diffResponse(responseTypes(o.Response, ""), responseTypes(n.Response, ""))
+
+		if len(cd.Changes) > 0 || len(cd.Params) > 0 || len(cd.Response) > 0 {
+			d.Changed = append(d.Changed, cd)
+		}
+	}
+	return d
+}
+
+// Returns the service of every API, using the same grouping as used to generate the packages
+func serviceOfAPIs(ai map[string]*API, ov map[string]string) map[string]string {
+	groups, _ := groupAPIs(ai, ov)
+
+	services := make(map[string]string)
+	for sn, apis := range groups {
+		for _, api := range apis {
+			services[api] = sn
+		}
+	}
+	return services
+}
+
+// Returns the services that have APIs in a, but not in b
+func missingServices(a, b map[string]string) []string {
+	found := make(map[string]bool)
+	for _, sn := range b {
+		found[sn] = true
+	}
+
+	missing := make(map[string]bool)
+	for _, sn := range a {
+		if !found[sn] {
+			missing[sn] = true
+		}
+	}
+
+	names := []string{}
+	for sn := range missing {
+		names = append(names, sn)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedAPINames(ai map[string]*API) []string {
+	names := make([]string, 0, len(ai))
+	for name := range ai {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func compareAttribute(changes []*attributeChange, attribute, o, n string) []*attributeChange {
+	if o == n {
+		return changes
+	}
+	return append(changes, &attributeChange{attribute, o, n})
+}
+
+func diffParams(oldParams, newParams APIParams) []*fieldChange {
+	params := func(aps APIParams) map[string]*APIParam {
+		m := make(map[string]*APIParam)
+		for _, ap := range aps {
+			if _, found := m[ap.Name]; !found {
+				m[ap.Name] = ap
+			}
+		}
+		return m
+	}
+	op, np := params(oldParams), params(newParams)
+
+	names := []string{}
+	for name := range op {
+		names = append(names, name)
+	}
+	for name := range np {
+		if _, found := op[name]; !found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []*fieldChange{}
+	for _, name := range names {
+		o, n := op[name], np[name]
+		switch {
+		case n == nil:
+			changes = append(changes, &fieldChange{Name: name, Change: "removed", Type: o.Type})
+		case o == nil:
+			changes = append(changes, &fieldChange{Name: name, Change: "added", Type: n.Type})
+		default:
+			var ac []*attributeChange
+			ac = compareAttribute(ac, "type", o.Type, n.Type)
+			ac = compareAttribute(ac, "required", strconv.FormatBool(o.Required), strconv.FormatBool(n.Required))
+			ac = compareAttribute(ac, "length", strconv.Itoa(o.Length), strconv.Itoa(n.Length))
+			if len(ac) > 0 {
+				changes = append(changes, &fieldChange{Name: name, Change: "changed", Changes: ac})
+			}
+		}
+	}
+	return changes
+}
+
+// Returns the types of all response fields by their path. Nested objects have the type 'object'.
+func responseTypes(resp APIResponses, prefix string) map[string]string {
+	types := make(map[string]string)
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if r.Response != nil {
+			types[prefix+r.Name] = "object"
+			for name, typ := range responseTypes(r.Response, prefix+r.Name+".") {
+				types[name] = typ
+			}
+			continue
+		}
+		if _, found := types[prefix+r.Name]; !found {
+			types[prefix+r.Name] = r.Type
+		}
+	}
+	return types
+}
+
+// Returns the changes of the response fields. The fields of an added or removed object are not
+// reported separately.
+func diffResponse(oldTypes, newTypes map[string]string) []*fieldChange {
+	names := []string{}
+	for name := range oldTypes {
+		names = append(names, name)
+	}
+	for name := range newTypes {
+		if _, found := oldTypes[name]; !found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []*fieldChange{}
+	for _, name := range names {
+		o, inOld := oldTypes[name]
+		n, inNew := newTypes[name]
+		if i := strings.LastIndex(name, "."); i != -1 {
+			parent := name[:i]
+			if _, found := oldTypes[parent]; !found {
+				continue
+			}
+			if _, found := newTypes[parent]; !found {
+				continue
+			}
+		}
+		switch {
+		case !inNew:
+			changes = append(changes, &fieldChange{Name: name, Change: "removed", Type: o})
+		case !inOld:
+			changes = append(changes, &fieldChange{Name: name, Change: "added", Type: n})
+		case o != n:
+			changes = append(changes, &fieldChange{Name: name, Change: "changed", Changes: []*attributeChange{{"type", o, n}}})
+		}
+	}
+	return changes
+}
+
+func (d *apiDiff) writeText(w io.Writer) {
+	pn := func(format string, args ...interface{}) {
+		fmt.Fprintf(w, format+"\n", args...)
+	}
+
+	pn("API differences between %s and %s", d.Old, d.New)
+	pn("")
+	pn("Services only in %s: %s", d.Old, joinOrNone(d.RemovedServices))
+	pn("Services only in %s: %s", d.New, joinOrNone(d.AddedServices))
+	pn("")
+
+	pn("Removed commands (%d):", len(d.Removed))
+	for _, c := range d.Removed {
+		pn("  - %s (%s)", c.Name, c.Service)
+	}
+	pn("")
+
+	pn("Added commands (%d):", len(d.Added))
+	for _, c := range d.Added {
+		pn("  + %s (%s)", c.Name, c.Service)
+	}
+	pn("")
+
+	pn("Changed commands (%d):", len(d.Changed))
+	for _, c := range d.Changed {
+		pn("  %s (%s):", c.Name, c.Service)
+		for _, ac := range c.Changes {
+			pn("    ~ %s: %s -> %s", ac.Attribute, ac.Old, ac.New)
+		}
+		for _, fc := range c.Params {
+			pn("    %s", fc.text("param"))
+		}
+		for _, fc := range c.Response {
+			pn("    %s", fc.text("response"))
+		}
+	}
+}
+
+func (fc *fieldChange) text(kind string) string {
+	switch fc.Change {
+	case "added":
+		return fmt.Sprintf("+ %s %s (%s)", kind, fc.Name, fc.Type)
+	case "removed":
+		return fmt.Sprintf("- %s %s (%s)", kind, fc.Name, fc.Type)
+	}
+
+	changes := []string{}
+	for _, ac := range fc.Changes {
+		changes = append(changes, fmt.Sprintf("%s %s -> %s", ac.Attribute, ac.Old, ac.New))
+	}
+	return fmt.Sprintf("~ %s %s: %s", kind, fc.Name, strings.Join(changes, ", "))
+}
+
+func joinOrNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
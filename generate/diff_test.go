@@ -0,0 +1,162 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffAPIs(t *testing.T) {
+	oldAI := map[string]*API{
+		"listZones":  {Name: "listZones", Params: APIParams{{Name: "id", Type: "uuid"}}},
+		"deleteZone": {Name: "deleteZone", Isasync: false},
+		"listPods":   {Name: "listPods"},
+	}
+	newAI := map[string]*API{
+		"listZones":  {Name: "listZones", Params: APIParams{{Name: "id", Type: "uuid"}}},
+		"deleteZone": {Name: "deleteZone", Isasync: true},
+		"listHosts":  {Name: "listHosts"},
+	}
+
+	d := diffAPIs(oldAI, newAI, nil)
+
+	if want := []*commandRef{{"listPods", "PodService"}}; !reflect.DeepEqual(d.Removed, want) {
+		t.Errorf("Expected removed commands %+v, got %+v", want, d.Removed)
+	}
+	if want := []*commandRef{{"listHosts", "HostService"}}; !reflect.DeepEqual(d.Added, want) {
+		t.Errorf("Expected added commands %+v, got %+v", want, d.Added)
+	}
+	if want := []string{"PodService"}; !reflect.DeepEqual(d.RemovedServices, want) {
+		t.Errorf("Expected removed services %v, got %v", want, d.RemovedServices)
+	}
+	if want := []string{"HostService"}; !reflect.DeepEqual(d.AddedServices, want) {
+		t.Errorf("Expected added services %v, got %v", want, d.AddedServices)
+	}
+
+	// The unchanged listZones is not reported
+	want := []*commandDiff{{
+		Name:     "deleteZone",
+		Service:  "ZoneService",
+		Changes:  []*attributeChange{{"async", "false", "true"}},
+		Params:   []*fieldChange{},
+		Response: []*fieldChange{},
+	}}
+	if !reflect.DeepEqual(d.Changed, want) {
+		t.Errorf("Expected the changed command %+v, got %d changed commands", want[0], len(d.Changed))
+	}
+}
+
+func TestDiffParams(t *testing.T) {
+	tests := []struct {
+		name string
+		old  APIParams
+		new  APIParams
+		want []*fieldChange
+	}{
+		{"unchanged",
+			APIParams{{Name: "id", Type: "uuid", Required: true}},
+			APIParams{{Name: "id", Type: "uuid", Required: true}},
+			[]*fieldChange{}},
+		{"added",
+			APIParams{},
+			APIParams{{Name: "zoneid", Type: "uuid"}},
+			[]*fieldChange{{Name: "zoneid", Change: "added", Type: "uuid"}}},
+		{"removed",
+			APIParams{{Name: "zoneid", Type: "uuid"}},
+			APIParams{},
+			[]*fieldChange{{Name: "zoneid", Change: "removed", Type: "uuid"}}},
+		{"type changed",
+			APIParams{{Name: "size", Type: "integer"}},
+			APIParams{{Name: "size", Type: "long"}},
+			[]*fieldChange{{Name: "size", Change: "changed", Changes: []*attributeChange{{"type", "integer", "long"}}}}},
+		{"required changed",
+			APIParams{{Name: "name", Type: "string", Required: true}},
+			APIParams{{Name: "name", Type: "string"}},
+			[]*fieldChange{{Name: "name", Change: "changed", Changes: []*attributeChange{{"required", "true", "false"}}}}},
+		{"length changed",
+			APIParams{{Name: "name", Type: "string", Length: 255}},
+			APIParams{{Name: "name", Type: "string", Length: 1024}},
+			[]*fieldChange{{Name: "name", Change: "changed", Changes: []*attributeChange{{"length", "255", "1024"}}}}},
+		{"sorted by name",
+			APIParams{{Name: "zoneid", Type: "uuid"}, {Name: "account", Type: "string"}},
+			APIParams{},
+			[]*fieldChange{
+				{Name: "account", Change: "removed", Type: "string"},
+				{Name: "zoneid", Change: "removed", Type: "uuid"},
+			}},
+	}
+
+	for _, tt := range tests {
+		if got := diffParams(tt.old, tt.new); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestDiffResponse(t *testing.T) {
+	nic := func(fields ...string) *APIResponse {
+		r := &APIResponse{Name: "nic"}
+		for _, f := range fields {
+			r.Response = append(r.Response, &APIResponse{Name: f, Type: "string"})
+		}
+		return r
+	}
+
+	tests := []struct {
+		name string
+		old  APIResponses
+		new  APIResponses
+		want []*fieldChange
+	}{
+		{"unchanged",
+			APIResponses{{Name: "id", Type: "string"}},
+			APIResponses{{Name: "id", Type: "string"}},
+			[]*fieldChange{}},
+		{"added",
+			APIResponses{},
+			APIResponses{{Name: "created", Type: "date"}},
+			[]*fieldChange{{Name: "created", Change: "added", Type: "date"}}},
+		{"removed",
+			APIResponses{{Name: "created", Type: "date"}},
+			APIResponses{},
+			[]*fieldChange{{Name: "created", Change: "removed", Type: "date"}}},
+		{"type changed",
+			APIResponses{{Name: "size", Type: "integer"}},
+			APIResponses{{Name: "size", Type: "long"}},
+			[]*fieldChange{{Name: "size", Change: "changed", Changes: []*attributeChange{{"type", "integer", "long"}}}}},
+		{"nested field added",
+			APIResponses{nic("id")},
+			APIResponses{nic("id", "ipaddress")},
+			[]*fieldChange{{Name: "nic.ipaddress", Change: "added", Type: "string"}}},
+		{"fields of an added object are not reported",
+			APIResponses{},
+			APIResponses{nic("id", "ipaddress")},
+			[]*fieldChange{{Name: "nic", Change: "added", Type: "object"}}},
+		{"fields of a removed object are not reported",
+			APIResponses{nic("id")},
+			APIResponses{},
+			[]*fieldChange{{Name: "nic", Change: "removed", Type: "object"}}},
+	}
+
+	for _, tt := range tests {
+		got := diffResponse(responseTypes(tt.old, ""), responseTypes(tt.new, ""))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: expected %+v, got %+v", tt.name, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The names of all APIs in the package that is generated
+var packageAPIs = make(map[string]bool)
+
+// Returns the doc of a param setter, which is empty if there is nothing known about the param
+func paramDoc(a *API, ap *APIParam) []string {
+	doc := []string{}
+	if d := paramDescription(ap); d != "" {
+		doc = append(doc, fmt.Sprintf("Sets the '%s' param: %s.", ap.Name, strings.TrimSuffix(d, ".")))
+	}
+	if ap.Required {
+		doc = append(doc, "This param is required.")
+	}
+	if ap.Since != "" {
+		doc = append(doc, fmt.Sprintf("Available since CloudStack %s.", ap.Since))
+	}
+	if vs := paramVersions(a.Name, ap.Name); vs != nil {
+		doc = append(doc, versionsDoc(vs))
+	}
+	if len(doc) == 0 {
+		return nil
+	}
+	return []string{strings.Join(doc, " ")}
+}
+
+// Returns the description of a param, or the description of params with its name if the API details have none
+func paramDescription(ap *APIParam) string {
+	if ap.Description != "" {
+		return ap.Description
+	}
+	return paramDescriptions[ap.Name]
+}
+
+// Returns the required params of an API, with their description, for the doc of the New...Params functions
+func requiredParamsDoc(a *API) []string {
+	doc := []string{}
+	for _, ap := range a.Params {
+		if !ap.Required {
+			continue
+		}
+		if d := paramDescription(ap); d != "" {
+			doc = append(doc, fmt.Sprintf("  - %s: %s", ap.Name, d))
+		} else {
+			doc = append(doc, fmt.Sprintf("  - %s", ap.Name))
+		}
+	}
+	if len(doc) == 0 {
+		return nil
+	}
+	return append([]string{"", "The required params are:", ""}, doc...)
+}
+
+// Returns the doc of an API call, containing the description of the API followed by notes about
+// async behaviour, the versions supporting it and related APIs and helpers
+func (s *service) apiDoc(a *API) []string {
+	doc := []string{a.Description}
+	notes := []string{}
+
+	if a.Isasync {
+		notes = append(notes, "This is an async API. When using the async client, the call waits until the job is finished or the configured AsyncTimeout is reached.")
+	}
+	if a.Since != "" {
+		notes = append(notes, fmt.Sprintf("Available since CloudStack %s.", a.Since))
+	}
+	if vs := partialVersions(a.Name); vs != nil {
+		notes = append(notes, versionsDoc(vs))
+	}
+	if related := s.relatedFuncs(a); len(related) > 0 {
+		notes = append(notes, fmt.Sprintf("See also %s.", strings.Join(related, ", ")))
+	}
+
+	if len(notes) > 0 {
+		doc = append(doc, "")
+		doc = append(doc, notes...)
+	}
+	return doc
+}
+
+// Returns the names of the related APIs listed by CloudStack, together with the list API and helpers
+// for the object the API works on
+func (s *service) relatedFuncs(a *API) []string {
+	found := map[string]bool{a.Name: true}
+	related := []string{}
+
+	noun := parseSingular(apiNoun(a.Name))
+	for _, l := range s.apis {
+		if !strings.HasPrefix(l.Name, "list") || parseSingular(apiNoun(l.Name)) != noun {
+			continue
+		}
+		if !found[l.Name] {
+			related = append(related, capitalize(l.Name))
+			found[l.Name] = true
+		}
+		if _, ok := s.hasGetIDHelper(l); ok {
+			related = append(related, funcName(s.getIDSignature(l)))
+		}
+		if s.hasGetByNameHelper(l) {
+			related = append(related, funcName(s.getByNameSignature(l)))
+		}
+		if s.hasGetByIDHelper(l) {
+			related = append(related, funcName(s.getByIDSignature(l)))
+		}
+		if s.hasGetByTagsHelper(l) {
+			related = append(related, funcName(s.getByTagsSignature(l)))
+		}
+	}
+
+	for _, name := range strings.Split(a.Related, ",") {
+		name = strings.TrimSpace(name)
+		if packageAPIs[name] && !found[name] {
+			related = append(related, capitalize(name))
+			found[name] = true
+		}
+	}
+	return related
+}
+
+func funcName(signature string) string {
+	return signature[:strings.Index(signature, "(")]
+}
@@ -0,0 +1,46 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParamDoc(t *testing.T) {
+	a := &API{Name: "testAPI"}
+
+	tests := []struct {
+		name  string
+		param *APIParam
+		want  []string
+	}{
+		{"description of the API details", &APIParam{Name: "zoneid", Description: "the zone to list."},
+			[]string{"Sets the 'zoneid' param: the zone to list."}},
+		{"description of the param name", &APIParam{Name: "zoneid", Required: true},
+			[]string{"Sets the 'zoneid' param: the ID of the zone. This param is required."}},
+		{"no description", &APIParam{Name: "id", Since: "4.4"},
+			[]string{"Available since CloudStack 4.4."}},
+		{"nothing known", &APIParam{Name: "id"}, nil},
+	}
+
+	for _, tt := range tests {
+		if doc := paramDoc(a, tt.param); !reflect.DeepEqual(doc, tt.want) {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, doc)
+		}
+	}
+}
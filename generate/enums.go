@@ -0,0 +1,247 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+// An enumerated set of values of a param or response field
+type enum struct {
+	name   string
+	prefix string // The prefix of the constants, if different from the name
+	doc    string
+	values [][2]string // The name suffix and value of each constant
+}
+
+// If new enums are added, they also need to be added to enumFields to be used
+var enums = []*enum{
+	{
+		name: "ACLTrafficType",
+		doc:  "The direction of the traffic a network ACL item applies to",
+		values: [][2]string{
+			{"Ingress", "Ingress"},
+			{"Egress", "Egress"},
+		},
+	},
+	{
+		name:   "HypervisorType",
+		prefix: "Hypervisor",
+		doc:    "A hypervisor supported by CloudStack",
+		values: [][2]string{
+			{"BareMetal", "BareMetal"},
+			{"HyperV", "Hyperv"},
+			{"KVM", "KVM"},
+			{"LXC", "LXC"},
+			{"Ovm", "Ovm"},
+			{"Simulator", "Simulator"},
+			{"VMware", "VMware"},
+			{"XenServer", "XenServer"},
+		},
+	},
+	{
+		name: "LBAlgorithm",
+		doc:  "The algorithm used by a load balancer rule",
+		values: [][2]string{
+			{"LeastConn", "leastconn"},
+			{"RoundRobin", "roundrobin"},
+			{"Source", "source"},
+		},
+	},
+	{
+		name: "Protocol",
+		doc:  "The protocol of a firewall, port forwarding, load balancer or network ACL rule",
+		values: [][2]string{
+			{"All", "all"},
+			{"ICMP", "icmp"},
+			{"TCP", "tcp"},
+			{"TCPProxy", "tcp-proxy"},
+			{"UDP", "udp"},
+		},
+	},
+	{
+		name: "SnapshotInterval",
+		doc:  "The interval of a snapshot policy, or the policy that created a snapshot",
+		values: [][2]string{
+			{"Daily", "DAILY"},
+			{"Hourly", "HOURLY"},
+			{"Manual", "MANUAL"},
+			{"Monthly", "MONTHLY"},
+			{"Weekly", "WEEKLY"},
+		},
+	},
+	{
+		name: "TaggedResourceType",
+		doc:  "The type of a resource that can have tags and details",
+		values: [][2]string{
+			{"AutoScaleVmGroup", "AutoScaleVmGroup"},
+			{"AutoScaleVmProfile", "AutoScaleVmProfile"},
+			{"CustomerGateway", "CustomerGateway"},
+			{"DiskOffering", "DiskOffering"},
+			{"FirewallRule", "FirewallRule"},
+			{"ISO", "ISO"},
+			{"LoadBalancer", "LoadBalancer"},
+			{"Network", "Network"},
+			{"NetworkACL", "NetworkACL"},
+			{"NetworkACLList", "NetworkACLList"},
+			{"Nic", "Nic"},
+			{"PortForwardingRule", "PortForwardingRule"},
+			{"PrivateGateway", "PrivateGateway"},
+			{"Project", "Project"},
+			{"PublicIpAddress", "PublicIpAddress"},
+			{"RemoteAccessVpn", "RemoteAccessVpn"},
+			{"SecurityGroup", "SecurityGroup"},
+			{"ServiceOffering", "ServiceOffering"},
+			{"Snapshot", "Snapshot"},
+			{"StaticRoute", "StaticRoute"},
+			{"Storage", "Storage"},
+			{"Template", "Template"},
+			{"User", "User"},
+			{"UserVm", "UserVm"},
+			{"VMSnapshot", "VMSnapshot"},
+			{"Volume", "Volume"},
+			{"Vpc", "Vpc"},
+			{"VpnConnection", "VpnConnection"},
+			{"VpnGateway", "VpnGateway"},
+			{"Zone", "Zone"},
+		},
+	},
+	{
+		name:   "NetworkTrafficType",
+		prefix: "TrafficType",
+		doc:    "The type of traffic carried by a network",
+		values: [][2]string{
+			{"Control", "Control"},
+			{"Guest", "Guest"},
+			{"Management", "Management"},
+			{"Public", "Public"},
+			{"Storage", "Storage"},
+		},
+	},
+	{
+		name: "VMState",
+		doc:  "The state of a virtual machine",
+		values: [][2]string{
+			{"Destroyed", "Destroyed"},
+			{"Error", "Error"},
+			{"Expunging", "Expunging"},
+			{"Migrating", "Migrating"},
+			{"Running", "Running"},
+			{"Shutdowned", "Shutdowned"},
+			{"Starting", "Starting"},
+			{"Stopped", "Stopped"},
+			{"Stopping", "Stopping"},
+			{"Unknown", "Unknown"},
+		},
+	},
+}
+
+// The enum used by a param or response field for the listed APIs, or for all APIs if none are listed
+type enumField struct {
+	enum string
+	apis []string
+}
+
+// Params and response fields using an enum, by name. Only fields of type string are changed.
+var enumFields = map[string][]enumField{
+	"algorithm":  {{enum: "LBAlgorithm"}},
+	"hypervisor": {{enum: "HypervisorType"}},
+	"intervaltype": {{enum: "SnapshotInterval", apis: []string{
+		"createSnapshot", "createSnapshotPolicy", "listSnapshots", "revertSnapshot",
+	}}},
+	"protocol": {{enum: "Protocol", apis: []string{
+		"authorizeSecurityGroupEgress", "authorizeSecurityGroupIngress", "createEgressFirewallRule",
+		"createFirewallRule", "createIpForwardingRule", "createLoadBalancerRule", "createNetworkACL",
+		"createPortForwardingRule", "listEgressFirewallRules", "listFirewallRules", "listIpForwardingRules",
+		"listLoadBalancerRules", "listNetworkACLs", "listPortForwardingRules", "updateEgressFirewallRule",
+		"updateFirewallRule", "updateLoadBalancerRule", "updateNetworkACLItem", "updatePortForwardingRule",
+	}}},
+	"resourcetype": {{enum: "TaggedResourceType", apis: []string{
+		"addResourceDetail", "createTags", "deleteTags", "listResourceDetails", "listTags", "removeResourceDetail",
+	}}},
+	"state": {{enum: "VMState", apis: []string{
+		"addNicToVirtualMachine", "assignVirtualMachine", "attachIso", "changeServiceForVirtualMachine",
+		"deployVirtualMachine", "destroyVirtualMachine", "detachIso", "listLoadBalancerRuleInstances",
+		"listVirtualMachines", "migrateVirtualMachine", "migrateVirtualMachineWithVolume",
+		"rebootVirtualMachine", "recoverVirtualMachine", "removeNicFromVirtualMachine",
+		"resetPasswordForVirtualMachine", "resetSSHKeyForVirtualMachine", "restoreVirtualMachine",
+		"revertToVMSnapshot", "startVirtualMachine", "stopVirtualMachine", "updateDefaultNicForVirtualMachine",
+		"updateVMAffinityGroup", "updateVirtualMachine",
+	}}},
+	"traffictype": {
+		{enum: "ACLTrafficType", apis: []string{
+			"createNetworkACL", "listNetworkACLs", "updateNetworkACLItem",
+		}},
+		{enum: "NetworkTrafficType", apis: []string{
+			"addTrafficType", "createNetwork", "createNetworkOffering", "listNetscalerLoadBalancerNetworks",
+			"listNetworkOfferings", "listNetworks", "listNiciraNvpDeviceNetworks", "listNics",
+			"listPaloAltoFirewallNetworks", "listTrafficTypeImplementors", "updateNetwork",
+			"updateNetworkOffering", "updateTrafficType",
+		}},
+	},
+}
+
+// Enum params that also accept a number, like the protocol number of a network ACL item
+var numericEnumParams = map[string]map[string]bool{
+	"createNetworkACL":     {"protocol": true},
+	"updateNetworkACLItem": {"protocol": true},
+}
+
+// Returns the enum type of a param or response field of the given API, if it has one
+func getEnum(api, field, typ string) (string, bool) {
+	if mapType(typ) != "string" {
+		return "", false
+	}
+	for _, ef := range enumFields[field] {
+		if len(ef.apis) == 0 {
+			return ef.enum, true
+		}
+		for _, a := range ef.apis {
+			if a == api {
+				return ef.enum, true
+			}
+		}
+	}
+	return "", false
+}
+
+// An enum type, as used by the templates
+type enumData struct {
+	Name   string
+	Doc    string
+	Values []*enumValue
+}
+
+type enumValue struct {
+	Const string
+	Value string
+}
+
+// Returns the enum types and their constants. The enums are defined in the common package, so
+// the version specific packages only add aliases for them.
+func enumDefs() []*enumData {
+	data := make([]*enumData, 0, len(enums))
+	for _, e := range enums {
+		prefix := e.prefix
+		if prefix == "" {
+			prefix = e.name
+		}
+
+		ed := &enumData{Name: e.name, Doc: e.doc}
+		for _, v := range e.values {
+			ed.Values = append(ed.Values, &enumValue{Const: prefix + v[0], Value: v[1]})
+		}
+		data = append(data, ed)
+	}
+	return data
+}
@@ -21,12 +21,12 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
-	"go/format"
 	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path"
+	"reflect"
 	"sort"
 	"strings"
 	"unicode"
@@ -35,25 +35,40 @@ import (
 )
 
 var (
-	version = flag.String("version", "", "The CloudStack API version for which to generate the client package, like 'v43', 'v44' or 'latest'.")
-	apiurl  = flag.String("apiurl", "", "URL to the CloudStack API.")
-	apikey  = flag.String("apikey", "", "API key to authenticate to the CloudStack API.")
-	secret  = flag.String("secret", "", "API secret to authenticate to the CloudStack API.")
+	version   = flag.String("version", "", "The CloudStack API version for which to generate the client package, like 'v43', 'v44', 'latest' or 'common'.")
+	apiurl    = flag.String("apiurl", "", "URL to the CloudStack API.")
+	apikey    = flag.String("apikey", "", "API key to authenticate to the CloudStack API.")
+	secret    = flag.String("secret", "", "API secret to authenticate to the CloudStack API.")
+	spec      = flag.String("spec", "", "A saved listApis response to read the API details from, instead of calling the CloudStack API. Defaults to the saved spec of the selected version if no 'apiurl' is given.")
+	dump      = flag.String("dump", "", "Save the listApis response of the CloudStack API to this file, instead of generating a client package.")
+	overrides = flag.String("overrides", "overrides.json", "A JSON file mapping APIs to the service they belong to, for APIs that are not grouped correctly by their name.")
+	templates = flag.String("templates", "", "A directory with templates that replace or add to the embedded templates, to generate a customized client package.")
+	openapi   = flag.String("openapi", "", "Write an OpenAPI 3 document of the APIs of the selected version to this file, instead of generating a client package.")
+	merge     = flag.String("merge", "", "A comma separated list of versions (oldest first) of which the saved specs are merged into a single package. Defaults to 'v43,v44' for 'latest'.")
+	stubs     = flag.Bool("stubs", false, "Allow generating a package from a saved stub spec, which is not a real listApis response. Without it, stubs are rejected and the package has to be generated from a CloudStack API (apiurl) or a spec saved with 'dump'.")
 )
 
 type apiInfo map[string][]string
 
-var mapVersion = map[string]apiInfo{
-	// If new API versions are added, they need to be added here also!
-	"v43":    v43,
-	"v44":    v44,
-	"latest": v44,
+var mapSpec = map[string]string{
+	// Versions not listed here use the spec named after the version, like 'apiv45.json'
+	"v43":    "apiv43.json",
+	"v44":    "apiv44.json",
+	"latest": "apiv44.json",
+	"common": "apiv44.json",
 }
 
+// The import path of the package containing the types shared by all versions
+const commonPkgPath = "github.com/xanzy/go-cloudstack/cloudstackcommon"
+
 var pkg string
 
+// Contains the names of all APIs that are shared by all versions
+var commonAPIs = make(map[string]bool)
+
 type allServices struct {
 	services services
+	warnings []error
 }
 
 type apiInfoNotFoundError struct {
@@ -64,6 +79,14 @@ func (e *apiInfoNotFoundError) Error() string {
 	return fmt.Sprintf("Could not find API details for: %s", e.api)
 }
 
+type commonAPIChangedError struct {
+	api string
+}
+
+func (e *commonAPIChangedError) Error() string {
+	return fmt.Sprintf("API %s is different from the shared API, remove it from the list in apicommon.go", e.api)
+}
+
 type generateError struct {
 	service *service
 	error   error
@@ -84,9 +107,6 @@ func (e *goimportError) Error() string {
 type service struct {
 	name string
 	apis []*API
-
-	p  func(format string, args ...interface{}) // print raw
-	pn func(format string, args ...interface{}) // print with indent and newline
 }
 
 type services []*service
@@ -122,6 +142,8 @@ func (s APIParams) Swap(i, j int) {
 type API struct {
 	Name        string       `json:"name"`
 	Description string       `json:"description"`
+	Since       string       `json:"since"`
+	Related     string       `json:"related"`
 	Isasync     bool         `json:"isasync"`
 	Params      APIParams    `json:"params"`
 	Response    APIResponses `json:"response"`
@@ -133,6 +155,7 @@ type APIParam struct {
 	Type        string `json:"type"`
 	Length      int    `json:"length"`
 	Required    bool   `json:"required"`
+	Since       string `json:"since"`
 }
 
 type APIResponse struct {
@@ -158,24 +181,66 @@ func (s APIResponses) Swap(i, j int) {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	flag.Parse()
 
+	if *dump != "" {
+		if err := dumpApiInfo(*dump); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *spec == "" && *apiurl == "" && len(mergedVersions()) == 0 {
+		*spec = specFile(*version)
+	}
+
 	pkg = "cloudstack"
 	if *version != "latest" {
 		pkg += strings.TrimPrefix(*version, "v")
 	}
 
+	for _, api := range common {
+		commonAPIs[api] = true
+	}
+
 	as, errors, err := getAllServices()
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if err := as.WriteGeneralCode(); err != nil {
+	if len(as.warnings) > 0 {
+		log.Printf("%d API(s) need attention:", len(as.warnings))
+		for _, w := range as.warnings {
+			log.Print(w.Error())
+		}
+	}
+
+	if *openapi != "" {
+		if err := as.writeOpenAPI(*openapi); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	t, err := loadTemplates(*templates)
+	if err != nil {
 		log.Fatal(err)
 	}
 
-	for _, s := range as.services {
-		if err := s.WriteGeneratedCode(); err != nil {
+	pd := newPackageData(as)
+	if err := pd.writeGeneralCode(t); err != nil {
+		log.Fatal(err)
+	}
+
+	for i, s := range as.services {
+		if err := pd.Services[i].writeGeneratedCode(t); err != nil {
 			errors = append(errors, &generateError{s, err})
 		}
 	}
@@ -192,626 +257,143 @@ func main() {
 	if len(errors) > 0 {
 		log.Printf("%d API(s) failed to generate:", len(errors))
 		for _, ce := range errors {
-			log.Printf(ce.Error())
+			log.Print(ce.Error())
 		}
 		os.Exit(1)
 	}
 }
 
-func (as *allServices) WriteGeneralCode() error {
-	outdir, err := sourceDir()
-	if err != nil {
-		log.Fatalf("Failed to get source dir: %s", err)
-	}
-
-	code, err := as.GeneralCode()
-	if err != nil {
-		return err
-	}
-
-	file := path.Join(outdir, "cloudstack.go")
-	return ioutil.WriteFile(file, code, 0644)
-}
-
-func (as *allServices) GeneralCode() ([]byte, error) {
-	// Buffer the output in memory, for gofmt'ing later in the defer.
-	var buf bytes.Buffer
-	p := func(format string, args ...interface{}) {
-		_, err := fmt.Fprintf(&buf, format, args...)
-		if err != nil {
-			panic(err)
+// Returns true if any of the APIs of this service is shared by all versions
+func (s *service) hasCommonAPIs() bool {
+	for _, a := range s.apis {
+		if commonAPIs[a.Name] {
+			return true
 		}
 	}
-	pn := func(format string, args ...interface{}) {
-		p(format+"\n", args...)
-	}
-	pn("//")
-	pn("// Copyright 2014, Sander van Harmelen")
-	pn("//")
-	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
-	pn("// you may not use this file except in compliance with the License.")
-	pn("// You may obtain a copy of the License at")
-	pn("//")
-	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
-	pn("//")
-	pn("// Unless required by applicable law or agreed to in writing, software")
-	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
-	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
-	pn("// See the License for the specific language governing permissions and")
-	pn("// limitations under the License.")
-	pn("//")
-	pn("")
-	pn("package %s", pkg)
-	pn("")
-	pn("import (")
-	pn("  \t%q", "fmt")
-	pn(")")
-	pn("")
-	pn("type CSError struct {")
-	pn("  ErrorCode   int    `json:\"errorcode\"`")
-	pn("  CSErrorCode int    `json:\"cserrorcode\"`")
-	pn("  ErrorText   string `json:\"errortext\"`")
-	pn("}")
-	pn("")
-	pn("func (e *CSError) Error() error {")
-	pn("  return fmt.Errorf(\"CloudStack API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
-	pn("}")
-	pn("")
-	pn("type CloudStackClient struct {")
-	pn("  client  *http.Client // The http client for communicating")
-	pn("  baseURL string       // The base URL of the API")
-	pn("  apiKey  string       // Api key")
-	pn("  secret  string       // Secret key")
-	pn("  async   bool         // Wait for async calls to finish")
-	pn("  timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 60 seconds")
-	pn("")
-	for _, s := range as.services {
-		pn("  %s *%s", strings.TrimSuffix(s.name, "Service"), s.name)
-	}
-	pn("}")
-	pn("")
-	pn("// Creates a new client for communicating with CloudStack")
-	pn("func newClient(apiurl string, apikey string, secret string, async bool, verifyssl bool) *CloudStackClient {")
-	pn("  cs := &CloudStackClient{")
-	pn("    client: &http.Client{")
-	pn("      Transport: &http.Transport{")
-	pn("        Proxy:           http.ProxyFromEnvironment,")
-	pn("        TLSClientConfig: &tls.Config{InsecureSkipVerify: !verifyssl}, // If verifyssl is true, skipping the verify should be false and vice versa")
-	pn("      },")
-	pn("    },")
-	pn("    baseURL: apiurl,")
-	pn("    apiKey:  apikey,")
-	pn("    secret:  secret,")
-	pn("    async:   async,")
-	pn("    timeout: 60,")
-	pn("  }")
-	for _, s := range as.services {
-		pn("	cs.%s = New%s(cs)", strings.TrimSuffix(s.name, "Service"), s.name)
-	}
-	pn("  return cs")
-	pn("}")
-	pn("")
-	pn("// Default non-async client. So for async calls you need to implement and check the async job result yourself. When using")
-	pn("// HTTPS with a self-signed certificate to connect to your CloudStack API, you would probably want to set 'verifyssl' to")
-	pn("// false so the call ignores the SSL errors/warnings.")
-	pn("func NewClient(apiurl string, apikey string, secret string, verifyssl bool) *CloudStackClient {")
-	pn("  cs := newClient(apiurl, apikey, secret, false, verifyssl)")
-	pn("  return cs")
-	pn("}")
-	pn("")
-	pn("// For sync API calls this client behaves exactly the same as a standard client call, but for async API calls")
-	pn("// this client will wait until the async job is finished or until the configured AsyncTimeout is reached. When the async")
-	pn("// job finishes successfully it will return actual object received from the API and nil, but when the timout is")
-	pn("// reached it will return the initial object containing the async job ID for the running job and a warning.")
-	pn("func NewAsyncClient(apiurl string, apikey string, secret string, verifyssl bool) *CloudStackClient {")
-	pn("  cs := newClient(apiurl, apikey, secret, true, verifyssl)")
-	pn("  return cs")
-	pn("}")
-	pn("")
-	pn("// When using the async client an api call will wait for the async call to finish before returning. The default is to poll for 60")
-	pn("// seconds, to check if the async job is finished.")
-	pn("func (cs *CloudStackClient) AsyncTimeout(timeoutInSeconds int64) {")
-	pn("	cs.timeout = timeoutInSeconds")
-	pn("}")
-	pn("")
-	pn("// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured")
-	pn("// timeout, the async job return a warning saying the timer has expired.")
-	pn("func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (b json.RawMessage, warn error, err error) {")
-	pn("  currentTime := time.Now().Unix()")
-	pn("  for {")
-	pn("    p := cs.Asyncjob.NewQueryAsyncJobResultParams(jobid)")
-	pn("    r, err := cs.Asyncjob.QueryAsyncJobResult(p)")
-	pn("    if err != nil {")
-	pn("      return nil, nil, err")
-	pn("    }")
-	pn("")
-	pn("    // Status 1 means the job is finished successfully")
-	pn("    if r.Jobstatus == 1 {")
-	pn("      return r.Jobresult, nil, nil")
-	pn("    }")
-	pn("")
-	pn("    // When the status is 2, the job has failed")
-	pn("    if r.Jobstatus == 2 {")
-	pn("      if r.Jobresulttype == \"text\" {")
-	pn("        return nil, nil, fmt.Errorf(string(r.Jobresult))")
-	pn("      } else {")
-	pn("        return nil, nil, fmt.Errorf(\"Undefined error: %%s\", string(r.Jobresult))")
-	pn("      }")
-	pn("    }")
-	pn("")
-	pn("    if time.Now().Unix()-currentTime > timeout {")
-	pn("      return nil, fmt.Errorf(\"Timeout while waiting for async job to finish\"), nil")
-	pn("    }")
-	pn("    time.Sleep(3 * time.Second)")
-	pn("  }")
-	pn("}")
-	pn("")
-	pn("// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if")
-	pn("// no error occured. If the API returns an error the result will be nil and the HTTP error code and CS")
-	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("  params.Set(\"apikey\", cs.apiKey)")
-	pn("  params.Set(\"command\", api)")
-	pn("  params.Set(\"response\", \"json\")")
-	pn("")
-	pn("  // Generate signature for API call")
-	pn("  // * Serialize parameters and sort them by key, done by Encode")
-	pn("  // * Convert the entire argument string to lowercase")
-	pn("  // * Replace all instances of '+' to '%%20'")
-	pn("  // * Calculate HMAC SHA1 of argument string with CloudStack secret")
-	pn("  // * URL encode the string and convert to base64")
-	pn("  s := params.Encode()")
-	pn("  s2 := strings.ToLower(s)")
-	pn("  s3 := strings.Replace(s2, \"+\", \"%%20\", -1)")
-	pn("  mac := hmac.New(sha1.New, []byte(cs.secret))")
-	pn("  mac.Write([]byte(s3))")
-	pn("  signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))")
-	pn("")
-	pn("	var err error")
-	pn("	var resp *http.Response")
-	pn("	if api == \"deployVirtualMachine\" {")
-	pn("		// The deployVirtualMachine API should be called using a POST call")
-	pn("  	// so we don't have to worry about the userdata size")
-	pn("")
-	pn("		// Add the unescaped signature to the POST params")
-	pn("		params.Set(\"signature\", signature)")
-	pn("")
-	pn("		// Make a POST call")
-	pn("		resp, err = cs.client.PostForm(cs.baseURL, params)")
-	pn("	} else {")
-	pn("  	// Create the final URL before we issue the request")
-	pn("  	url := cs.baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
-	pn("")
-	pn("		// Make a GET call")
-	pn("  	resp, err = cs.client.Get(url)")
-	pn("	}")
-	pn("  if err != nil {")
-	pn("    return nil, err")
-	pn("  }")
-	pn("")
-	pn("  b, err := ioutil.ReadAll(resp.Body)")
-	pn("  resp.Body.Close()")
-	pn("  if err != nil {")
-	pn("    return nil, err")
-	pn("  }")
-	pn("")
-	pn("  // Need to get the raw value to make the result play nice")
-	pn("  b, err = getRawValue(b)")
-	pn("  if err != nil {")
-	pn("    return nil, err")
-	pn("  }")
-	pn("")
-	pn("  if resp.StatusCode != 200 {")
-	pn("    var e CSError")
-	pn("    if err := json.Unmarshal(b, &e); err != nil {")
-	pn("      return nil, err")
-	pn("    }")
-	pn("    return nil, e.Error()")
-	pn("  }")
-	pn("  return b, nil")
-	pn("}")
-	pn("")
-	pn("// Generic function to get the first raw value from a response as json.RawMessage")
-	pn("func getRawValue(b json.RawMessage) (json.RawMessage, error) {")
-	pn("  var m map[string]json.RawMessage")
-	pn("  if err := json.Unmarshal(b, &m); err != nil {")
-	pn("    return nil, err")
-	pn("  }")
-	pn("  for _, v := range m {")
-	pn("    return v, nil")
-	pn("  }")
-	pn("  return nil, fmt.Errorf(\"Unable to extract the raw value from:\\n\\n%%s\\n\\n\", string(b))")
-	pn("}")
-	pn("")
-	for _, s := range as.services {
-		pn("type %s struct {", s.name)
-		pn("  cs *CloudStackClient")
-		pn("}")
-		pn("")
-		pn("func New%s(cs *CloudStackClient) *%s {", s.name, s.name)
-		pn("	return &%s{cs: cs}", s.name)
-		pn("}")
-		pn("")
-	}
-
-	clean, err := format.Source(buf.Bytes())
-	if err != nil {
-		return buf.Bytes(), err
-	}
-	return clean, err
+	return false
 }
 
-func (s *service) WriteGeneratedCode() error {
-	outdir, err := sourceDir()
-	if err != nil {
-		log.Fatalf("Failed to get source dir: %s", err)
+// Params are stored as pointers, so an unset param can be told apart from a param set to its zero
+// value. Slices and maps can be nil already, so they are stored as is.
+func fieldType(a *API, ap *APIParam) string {
+	typ := paramType(a, ap)
+	if strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "map[") {
+		return typ
 	}
+	return "*" + typ
+}
 
-	code, err := s.GenerateCode()
-	if err != nil {
-		return err
+func (s *service) parseParamName(name string) string {
+	if name != "type" {
+		return name
 	}
-
-	file := path.Join(outdir, s.name+".go")
-	return ioutil.WriteFile(file, code, 0644)
+	return uncapitalize(strings.TrimSuffix(s.name, "Service")) + capitalize(name)
 }
 
-func (s *service) GenerateCode() ([]byte, error) {
-	// Buffer the output in memory, for gofmt'ing later in the defer.
-	var buf bytes.Buffer
-	s.p = func(format string, args ...interface{}) {
-		_, err := fmt.Fprintf(&buf, format, args...)
-		if err != nil {
-			panic(err)
+func (s *service) newParamTypeSignature(a *API) string {
+	tn := capitalize(a.Name + "Params")
+
+	args := []string{}
+	for _, ap := range a.Params {
+		if ap.Required {
+			args = append(args, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a, ap)))
 		}
 	}
-	s.pn = func(format string, args ...interface{}) {
-		s.p(format+"\n", args...)
-	}
-	pn := s.pn
-
-	pn("//")
-	pn("// Copyright 2014, Sander van Harmelen")
-	pn("//")
-	pn("// Licensed under the Apache License, Version 2.0 (the \"License\");")
-	pn("// you may not use this file except in compliance with the License.")
-	pn("// You may obtain a copy of the License at")
-	pn("//")
-	pn("//     http://www.apache.org/licenses/LICENSE-2.0")
-	pn("//")
-	pn("// Unless required by applicable law or agreed to in writing, software")
-	pn("// distributed under the License is distributed on an \"AS IS\" BASIS,")
-	pn("// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.")
-	pn("// See the License for the specific language governing permissions and")
-	pn("// limitations under the License.")
-	pn("//")
-	pn("")
-	pn("package %s", pkg)
-	pn("")
-	pn("import (")
-	pn("	\t%q", "fmt")
-	pn(")")
-	pn("")
+	return fmt.Sprintf("New%s(%s) *%s", tn, strings.Join(args, ", "), tn)
+}
 
-	for _, a := range s.apis {
-		s.generateParamType(a)
-		s.generateToURLValuesFunc(a)
-		s.generateParamSettersFunc(a)
-		s.generateNewParamTypeFunc(a)
-		s.generateHelperFuncs(a)
-		s.generateNewAPICallFunc(a)
-		s.generateResponseType(a)
+// Returns the name of the param used to search for the ID and if a GetXID helper is generated
+func (s *service) hasGetIDHelper(a *API) (string, bool) {
+	if !strings.HasPrefix(a.Name, "list") {
+		return "", false
 	}
 
-	clean, err := format.Source(buf.Bytes())
-	if err != nil {
-		buf.WriteTo(os.Stdout)
-		return buf.Bytes(), err
+	v, found := hasNameOrKeywordParamField(a.Params)
+	if !found || !hasIDAndNameResponseField(a.Response) {
+		return "", false
 	}
-	return clean, nil
-}
-
-func (s *service) generateParamType(a *API) {
-	pn := s.pn
-
-	pn("type %s struct {", capitalize(a.Name+"Params"))
-	pn("	p map[string]interface{}")
-	pn("}\n")
-	return
-}
-
-func (s *service) generateToURLValuesFunc(a *API) {
-	pn := s.pn
 
-	pn("func (p *%s) toURLValues() url.Values {", capitalize(a.Name+"Params"))
-	pn("	u := url.Values{}")
-	pn("	if p.p == nil {")
-	pn("		return u")
-	pn("	}")
+	// Check if ID is a required parameters and bail if so
 	for _, ap := range a.Params {
-		pn("	if v, found := p.p[\"%s\"]; found {", ap.Name)
-		s.generateConvertCode(s.parseParamName(ap.Name), mapType(ap.Type))
-		pn("	}")
-	}
-	pn("	return u")
-	pn("}")
-	pn("")
-	return
+		if ap.Required && s.parseParamName(ap.Name) == "id" {
+			return "", false
+		}
+	}
+	return v, true
 }
 
-func (s *service) generateConvertCode(name, typ string) {
-	pn := s.pn
-	n := s.unparseParamName(name)
-
-	switch typ {
-	case "string":
-		pn("u.Set(\"%s\", v.(string))", n)
-	case "int":
-		pn("vv := strconv.Itoa(v.(int))")
-		pn("u.Set(\"%s\", vv)", n)
-	case "int64":
-		pn("vv := strconv.FormatInt(v.(int64), 10)")
-		pn("u.Set(\"%s\", vv)", n)
-	case "bool":
-		pn("vv := strconv.FormatBool(v.(bool))")
-		pn("u.Set(\"%s\", vv)", n)
-	case "[]string":
-		pn("vv := strings.Join(v.([]string), \", \")")
-		pn("u.Set(\"%s\", vv)", n)
-	case "map[string]string":
-		pn("i := 0")
-		pn("for k, vv := range v.(map[string]string) {")
-		pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", n)
-		pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), vv)", n)
-		pn("	i++")
-		pn("}")
-	}
-	return
+func (s *service) hasGetByNameHelper(a *API) bool {
+	_, found := s.hasGetIDHelper(a)
+	return found && hasIDParamField(a.Params)
 }
 
-func (s *service) parseParamName(name string) string {
-	if name != "type" {
-		return name
-	}
-	return uncapitalize(strings.TrimSuffix(s.name, "Service")) + capitalize(name)
+func (s *service) hasGetByIDHelper(a *API) bool {
+	return strings.HasPrefix(a.Name, "list") && hasIDParamField(a.Params)
 }
 
-func (s *service) unparseParamName(name string) string {
-	if name == uncapitalize(strings.TrimSuffix(s.name, "Service"))+"Type" {
-		return "type"
-	}
-	return name
+func (s *service) hasGetByTagsHelper(a *API) bool {
+	return strings.HasPrefix(a.Name, "list") && hasTagsParamField(a)
 }
 
-func (s *service) generateParamSettersFunc(a *API) {
-	pn := s.pn
-	found := make(map[string]bool)
+func (s *service) getIDSignature(a *API) string {
+	ln := strings.TrimPrefix(a.Name, "list")
+	v, _ := hasNameOrKeywordParamField(a.Params)
 
+	args := []string{v + " string"}
 	for _, ap := range a.Params {
-		if !found[ap.Name] {
-			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(ap.Type))
-			pn("	if p.p == nil {")
-			pn("		p.p = make(map[string]interface{})")
-			pn("	}")
-			pn("	p.p[\"%s\"] = v", s.parseParamName(ap.Name))
-			pn("	return")
-			pn("}")
-			pn("")
-			found[ap.Name] = true
+		if ap.Required {
+			args = append(args, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a, ap)))
 		}
 	}
-	return
+	// Add an addition (needed) parameter for the GetTemplateId helper function
+	if parseSingular(ln) == "Template" {
+		args = append(args, "zoneid string")
+	}
+	args = append(args, "opts ...OptionFunc")
+	return fmt.Sprintf("Get%sID(%s) (string, error)", parseSingular(ln), strings.Join(args, ", "))
 }
 
-func (s *service) generateNewParamTypeFunc(a *API) {
-	p, pn := s.p, s.pn
-	tn := capitalize(a.Name + "Params")
-	rp := APIParams{}
+func (s *service) getByNameSignature(a *API) string {
+	ln := strings.TrimPrefix(a.Name, "list")
 
-	// Generate the function signature
-	pn("// You should always use this function to get a new %s instance,", tn)
-	pn("// as then you are sure you have configured all required params")
-	p("func (s *%s) New%s(", s.name, tn)
+	args := []string{"name string"}
 	for _, ap := range a.Params {
 		if ap.Required {
-			rp = append(rp, ap)
-			p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
+			args = append(args, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a, ap)))
 		}
 	}
-	pn(") *%s {", tn)
-
-	// Generate the function body
-	pn("	p := &%s{}", tn)
-	pn("	p.p = make(map[string]interface{})")
-	sort.Sort(rp)
-	for _, ap := range rp {
-		pn("	p.p[\"%s\"] = %s", s.parseParamName(ap.Name), s.parseParamName(ap.Name))
+	// Add an addition (needed) parameter for the GetTemplateId helper function
+	if parseSingular(ln) == "Template" {
+		args = append(args, "zoneid string")
 	}
-	pn("	return p")
-	pn("}")
-	pn("")
-	return
+	args = append(args, "opts ...OptionFunc")
+	return fmt.Sprintf("Get%sByName(%s) (*%s, int, error)", parseSingular(ln), strings.Join(args, ", "), parseSingular(ln))
 }
 
-func (s *service) generateHelperFuncs(a *API) {
-	p, pn := s.p, s.pn
+func (s *service) getByIDSignature(a *API) string {
+	ln := strings.TrimPrefix(a.Name, "list")
 
-	if strings.HasPrefix(a.Name, "list") {
-		v, found := hasNameOrKeywordParamField(a.Params)
-		if found && hasIDAndNameResponseField(a.Response) {
-			ln := strings.TrimPrefix(a.Name, "list")
-
-			// Check if ID is a required parameters and bail if so
-			for _, ap := range a.Params {
-				if ap.Required && s.parseParamName(ap.Name) == "id" {
-					return
-				}
-			}
-
-			// Generate the function signature
-			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-			p("func (s *%s) Get%sID(%s string, ", s.name, parseSingular(ln), v)
-			for _, ap := range a.Params {
-				if ap.Required {
-					p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
-				}
-			}
-			// Add an addition (needed) parameter for the GetTemplateId helper function
-			if parseSingular(ln) == "Template" {
-				p("zoneid string, ")
-			}
-			pn(") (string, error) {")
-
-			// Generate the function body
-			pn("	p := &List%sParams{}", ln)
-			pn("	p.p = make(map[string]interface{})")
-			pn("")
-			pn("	p.p[\"%s\"] = %s", v, v)
-			for _, ap := range a.Params {
-				if ap.Required {
-					pn("	p.p[\"%s\"] = %s", s.parseParamName(ap.Name), s.parseParamName(ap.Name))
-				}
-			}
-			// Assign the additional parameter for the GetTemplateId helper function
-			if parseSingular(ln) == "Template" {
-				pn("	p.p[\"zoneid\"] = zoneid")
-			}
-			pn("")
-			pn("	l, err := s.List%s(p)", ln)
-			pn("	if err != nil {")
-			pn("		return \"\", err")
-			pn("	}")
-			pn("")
-			pn("	if l.Count == 0 {")
-			pn("	  return \"\", fmt.Errorf(\"No match found for %%s: %%+v\", %s, l)", v)
-			pn("	}")
-			pn("")
-			pn("	if l.Count == 1 {")
-			pn("	  return l.%s[0].Id, nil", ln)
-			pn("	}")
-			pn("")
-			pn(" 	if l.Count > 1 {")
-			pn("    for _, v := range l.%s {", ln)
-			pn("      if v.Name == %s {", v)
-			pn("        return v.Id, nil")
-			pn("      }")
-			pn("    }")
-			pn("	}")
-			pn("  return \"\", fmt.Errorf(\"Could not find an exact match for %%s: %%+v\", %s, l)", v)
-			pn("}\n")
-			pn("")
-
-			if found := hasIDParamField(a.Params); found {
-				// Generate the function signature
-				pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-				p("func (s *%s) Get%sByName(name string, ", s.name, parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required {
-						p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
-					}
-				}
-				// Add an addition (needed) parameter for the GetTemplateId helper function
-				if parseSingular(ln) == "Template" {
-					p("zoneid string, ")
-				}
-				pn(") (*%s, int, error) {", parseSingular(ln))
-
-				// Generate the function body
-				p("  id, err := s.Get%sID(name, ", parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required {
-						p("%s, ", s.parseParamName(ap.Name))
-					}
-				}
-				// Assign the additional parameter for the GetTemplateId helper function
-				if parseSingular(ln) == "Template" {
-					p("zoneid")
-				}
-				pn(")")
-				pn("  if err != nil {")
-				pn("    return nil, -1, err")
-				pn("  }")
-				pn("")
-				p("  r, count, err := s.Get%sByID(id, ", parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required {
-						p("%s, ", s.parseParamName(ap.Name))
-					}
-				}
-				pn(")")
-				pn("  if err != nil {")
-				pn("    return nil, count, err")
-				pn("  }")
-				pn("	return r, count, nil")
-				pn("}")
-				pn("")
-			}
+	args := []string{"id string"}
+	for _, ap := range a.Params {
+		if ap.Required && s.parseParamName(ap.Name) != "id" {
+			args = append(args, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a, ap)))
 		}
+	}
+	args = append(args, "opts ...OptionFunc")
+	return fmt.Sprintf("Get%sByID(%s) (*%s, int, error)", parseSingular(ln), strings.Join(args, ", "), parseSingular(ln))
+}
 
-		if found := hasIDParamField(a.Params); found {
-			ln := strings.TrimPrefix(a.Name, "list")
+func (s *service) getByTagsSignature(a *API) string {
+	ln := strings.TrimPrefix(a.Name, "list")
 
-			// Generate the function signature
-			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-			p("func (s *%s) Get%sByID(id string, ", s.name, parseSingular(ln))
-			for _, ap := range a.Params {
-				if ap.Required && s.parseParamName(ap.Name) != "id" {
-					p("%s %s, ", s.parseParamName(ap.Name), mapType(ap.Type))
-				}
-			}
-			pn(") (*%s, int, error) {", parseSingular(ln))
-
-			// Generate the function body
-			pn("	p := &List%sParams{}", ln)
-			pn("	p.p = make(map[string]interface{})")
-			pn("")
-			pn("	p.p[\"id\"] = id")
-			for _, ap := range a.Params {
-				if ap.Required {
-					pn("	p.p[\"%s\"] = %s", s.parseParamName(ap.Name), s.parseParamName(ap.Name))
-				}
-			}
-			pn("")
-			pn("	l, err := s.List%s(p)", ln)
-			pn("	if err != nil {")
-			pn("		if strings.Contains(err.Error(), fmt.Sprintf(")
-			pn("			\"Invalid parameter id value=%%s due to incorrect long value format, \"+")
-			pn("				\"or entity does not exist\", id)) {")
-			pn("			return nil, 0, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
-			pn("		}")
-			pn("		return nil, -1, err")
-			pn("	}")
-			pn("")
-			if hasProjectParam := hasProjectidParamField(a.Params); hasProjectParam {
-
-				pn("    if l.Count == 0 {")
-				pn("		// look	inside projects ")
-				pn("		p.p[\"projectid\"] = \"-1\"")
-				pn("		l, err = s.List%s(p)", ln)
-				pn("		if err != nil {")
-				pn("			if strings.Contains(err.Error(), fmt.Sprintf(")
-				pn("				\"Invalid parameter id value=%%s due to incorrect long value format, \"+")
-				pn("				\"or entity does not exist\", id)) {")
-				pn("				return nil, 0, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
-				pn("			}")
-				pn("			return nil, -1, err")
-				pn("		}")
-				pn("	}")
-				pn("")
-			}
-			pn("	if l.Count == 0 {")
-			pn("	  return nil, l.Count, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
-			pn("	}")
-			pn("")
-			pn("	if l.Count == 1 {")
-			pn("	  return l.%s[0], l.Count, nil", ln)
-			pn("	}")
-			pn("  return nil, l.Count, fmt.Errorf(\"There is more then one result for %s UUID: %%s!\", id)", parseSingular(ln))
-			pn("}\n")
-			pn("")
+	args := []string{"tags map[string]string"}
+	for _, ap := range a.Params {
+		if ap.Required {
+			args = append(args, fmt.Sprintf("%s %s", s.parseParamName(ap.Name), paramType(a, ap)))
 		}
 	}
-	return
+	args = append(args, "opts ...OptionFunc")
+	return fmt.Sprintf("Get%sByTags(%s) ([]*%s, error)", ln, strings.Join(args, ", "), parseSingular(ln))
 }
 
 func hasNameOrKeywordParamField(params APIParams) (v string, found bool) {
@@ -846,6 +428,15 @@ func hasProjectidParamField(params APIParams) bool {
 	return false
 }
 
+func hasTagsParamField(a *API) bool {
+	for _, ap := range a.Params {
+		if ap.Name == "tags" && paramType(a, ap) == "map[string]string" {
+			return true
+		}
+	}
+	return false
+}
+
 func hasIDAndNameResponseField(resp APIResponses) bool {
 	id := false
 	name := false
@@ -861,59 +452,15 @@ func hasIDAndNameResponseField(resp APIResponses) bool {
 	return id && name
 }
 
-func (s *service) generateNewAPICallFunc(a *API) {
-	pn := s.pn
+func apiCallSignature(a *API) string {
 	n := capitalize(a.Name)
+	return fmt.Sprintf("%s(p *%s) (*%s, error)", n, n+"Params", n+"Response")
+}
 
-	// Generate the function signature
-	pn("// %s", a.Description)
-	pn("func (s *%s) %s(p *%s) (*%s, error) {", s.name, n, n+"Params", n+"Response")
-
-	// Generate the function body
-	pn("	resp, err := s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
-	pn("	if err != nil {")
-	pn("		return nil, err")
-	pn("	}")
-	pn("")
-	if n == "CreateNetwork" || n == "CreateSSHKeyPair" || n == "RegisterSSHKeyPair" {
-		pn("	if resp, err = getRawValue(resp); err != nil {")
-		pn("		return nil, err")
-		pn("	}")
-		pn("")
-	}
-	pn("	var r %s", n+"Response")
-	pn("	if err := json.Unmarshal(resp, &r); err != nil {")
-	pn("		return nil, err")
-	pn("	}")
-	if a.Isasync {
-		pn("")
-		pn("	// If we have a async client, we need to wait for the async result")
-		pn("	if s.cs.async {")
-		pn("		b, warn, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
-		pn("		if err != nil {")
-		pn("			return nil, err")
-		pn("		}")
-		pn("		// If 'warn' has a value it means the job is running longer than the configured")
-		pn("		// timeout, the resonse will contain the jobid of the running async job")
-		pn("		if warn != nil {")
-		pn("			return &r, warn")
-		pn("		}")
-		pn("")
-		if !isSuccessOnlyResponse(a.Response) {
-			pn("    b, err = getRawValue(b)")
-			pn("    if err != nil {")
-			pn("      return nil, err")
-			pn("    }")
-			pn("")
-		}
-		pn("		if err := json.Unmarshal(b, &r); err != nil {")
-		pn("			return nil, err")
-		pn("		}")
-		pn("	}")
-	}
-	pn("	return &r, nil")
-	pn("}")
-	pn("")
+// Returns the signature of the function streaming the objects listed by a 'list' API
+func apiStreamSignature(a *API) string {
+	ln := strings.TrimPrefix(a.Name, "list")
+	return fmt.Sprintf("Stream%s(ctx context.Context, p *%s, fn func(*%s) error) (int, error)", ln, capitalize(a.Name+"Params"), parseSingular(ln))
 }
 
 func isSuccessOnlyResponse(resp APIResponses) bool {
@@ -931,40 +478,13 @@ func isSuccessOnlyResponse(resp APIResponses) bool {
 	return displaytext && success
 }
 
-func (s *service) generateResponseType(a *API) {
-	pn := s.pn
+// Returns the names of all types generated for the response of the given API
+func responseTypeNames(a *API) []string {
 	tn := capitalize(a.Name + "Response")
-	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
-
-	// If this is a 'list' response, we need an seperate list struct. There seem to be other
-	// types of responses that also need a seperate list struct, so checking on exact matches
-	// for those once.
 	if strings.HasPrefix(a.Name, "list") || a.Name == "registerTemplate" {
-		pn("type %s struct {", tn)
-		pn("	Count int `json:\"count\"`")
-		// This nasty check is for some specific response that do not behave consistent
-		switch a.Name {
-		case "listEgressFirewallRules":
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "firewallrule")
-		case "registerTemplate":
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), "template")
-		default:
-			pn("	%s []*%s `json:\"%s\"`", ln, parseSingular(ln), strings.ToLower(parseSingular(ln)))
-		}
-		pn("}")
-		pn("")
-		tn = parseSingular(ln)
-	}
-
-	pn("type %s struct {", tn)
-	if a.Isasync {
-		pn("	JobID string `json:\"jobid,omitempty\"`")
+		return []string{tn, parseSingular(capitalize(strings.TrimPrefix(a.Name, "list")))}
 	}
-	sort.Sort(a.Response)
-	s.recusiveGenerateResponseType(a.Response, a.Isasync)
-	pn("}")
-	pn("")
-	return
+	return []string{tn}
 }
 
 func parseSingular(n string) string {
@@ -977,38 +497,6 @@ func parseSingular(n string) string {
 	return strings.TrimSuffix(n, "s")
 }
 
-func (s *service) recusiveGenerateResponseType(resp APIResponses, async bool) (output string) {
-	pn := s.pn
-	found := make(map[string]bool)
-
-	for _, r := range resp {
-		if r.Name == "" {
-			continue
-		}
-		if r.Response != nil {
-			pn("%s []struct {", capitalize(r.Name))
-			sort.Sort(r.Response)
-			s.recusiveGenerateResponseType(r.Response, async)
-			pn("} `json:\"%s,omitempty\"`", r.Name)
-		} else {
-			if !found[r.Name] {
-				// This code is needed because the response field is different for sync and async calls :(
-				if r.Name == "success" {
-					if async {
-						pn("%s bool `json:\"%s,omitempty\"`", capitalize(r.Name), r.Name)
-					} else {
-						pn("%s string `json:\"%s,omitempty\"`", capitalize(r.Name), r.Name)
-					}
-				} else {
-					pn("%s %s `json:\"%s,omitempty\"`", capitalize(r.Name), mapType(r.Type), r.Name)
-				}
-				found[r.Name] = true
-			}
-		}
-	}
-	return
-}
-
 func getAllServices() (*allServices, []error, error) {
 	// Get a map with all API info
 	ai, err := getApiInfo()
@@ -1016,18 +504,54 @@ func getAllServices() (*allServices, []error, error) {
 		return nil, nil, err
 	}
 
+	ov, err := getOverrides(*overrides)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// Generate a complete set of services with their methods (APIs)
 	as := &allServices{}
 	errors := []error{}
-	for sn, apis := range mapVersion[*version] {
-		s := &service{name: sn}
-		for _, api := range apis {
-			a, found := ai[api]
-			if !found {
+
+	groups, warnings := groupAPIs(ai, ov)
+	as.warnings = warnings
+
+	var commonNested map[string]*nestedType
+	if *version == "common" {
+		groups = commonGroups(groups)
+		for _, api := range common {
+			if _, found := ai[api]; !found {
 				errors = append(errors, &apiInfoNotFoundError{api})
-				continue
 			}
-			s.apis = append(s.apis, a)
+		}
+	} else {
+		cai, err := getCommonApiInfo()
+		if err != nil {
+			return nil, nil, err
+		}
+		errors = append(errors, checkCommonAPIs(ai, cai)...)
+
+		apis := []*API{}
+		for _, api := range common {
+			if a, found := cai[api]; found {
+				apis = append(apis, a)
+			}
+		}
+		commonNested, _ = findNestedTypes(apis, nil, groups)
+	}
+
+	if *version != "common" {
+		for _, sn := range deprecatedServices[*version] {
+			if _, found := groups[sn]; !found {
+				groups[sn] = nil
+			}
+		}
+	}
+
+	for sn, apis := range groups {
+		s := &service{name: sn}
+		for _, api := range apis {
+			s.apis = append(s.apis, ai[api])
 		}
 		for _, apis := range s.apis {
 			sort.Sort(apis.Params)
@@ -1035,20 +559,117 @@ func getAllServices() (*allServices, []error, error) {
 		as.services = append(as.services, s)
 	}
 	sort.Sort(as.services)
+
+	apis := []*API{}
+	for _, s := range as.services {
+		apis = append(apis, s.apis...)
+	}
+	for _, a := range apis {
+		packageAPIs[a.Name] = true
+	}
+	nt, warnings := findNestedTypes(apis, commonNested, groups)
+	nestedTypes = nt
+	as.warnings = append(as.warnings, warnings...)
+
 	return as, errors, nil
 }
 
+// Returns the saved spec of the given version
+func specFile(version string) string {
+	if file, found := mapSpec[version]; found {
+		return file
+	}
+	return "api" + version + ".json"
+}
+
+// Only keeps the APIs that are shared by all versions
+func commonGroups(groups apiInfo) apiInfo {
+	cg := make(apiInfo)
+	for sn, apis := range groups {
+		for _, api := range apis {
+			if commonAPIs[api] {
+				cg[sn] = append(cg[sn], api)
+			}
+		}
+	}
+	return cg
+}
+
+// Makes sure the shared APIs did not change in this version, as the shared types would not
+// match the API anymore in that case
+func checkCommonAPIs(ai map[string]*API, cai map[string]*API) []error {
+	errors := []error{}
+	for _, api := range common {
+		a, found := ai[api]
+		if !found {
+			errors = append(errors, &apiInfoNotFoundError{api})
+			continue
+		}
+		if ca, found := cai[api]; found && !reflect.DeepEqual(a, ca) {
+			errors = append(errors, &commonAPIChangedError{api})
+		}
+	}
+	return errors
+}
+
+// Returns the API details used to generate the common package
+func getCommonApiInfo() (map[string]*API, error) {
+	return readSpec(specFile("common"))
+}
+
 func getApiInfo() (map[string]*API, error) {
-	cs, err := gocs.NewClient(*apiurl, *apikey, *secret, false)
+	if vs := mergedVersions(); len(vs) > 0 && *spec == "" && *apiurl == "" {
+		return mergeApiInfo(vs)
+	}
+
+	if *spec != "" {
+		return readSpec(*spec)
+	}
+
+	resp, err := getRawApiInfo()
 	if err != nil {
 		return nil, err
 	}
-	resp, err := cs.RawRequest("listApis", "")
+	return parseApiInfo(resp)
+}
+
+type stubSpecError struct {
+	file string
+	stub string
+}
+
+func (e *stubSpecError) Error() string {
+	return fmt.Sprintf("%s is a stub spec: %s Generate the package using 'apiurl' instead, or pass 'stubs' to use the stub anyway", e.file, e.stub)
+}
+
+// Reads the API details of a saved spec to generate a package from. Stub specs are rejected unless
+// allowed with the 'stubs' flag, as the API of a server is the source of truth until real specs are saved.
+func readSpec(file string) (map[string]*API, error) {
+	resp, err := ioutil.ReadFile(file)
 	if err != nil {
 		return nil, err
 	}
 
+	var sp struct {
+		Stub string `json:"stub"`
+	}
+	if err := json.Unmarshal(resp, &sp); err != nil {
+		return nil, err
+	}
+	if sp.Stub != "" && !*stubs {
+		return nil, &stubSpecError{file, sp.Stub}
+	}
+	return parseApiInfo(resp)
+}
+
+// The stub specs that were reported, as a spec can be read more than once
+var reportedStubs = make(map[string]bool)
+
+func parseApiInfo(resp []byte) (map[string]*API, error) {
+
 	var ar struct {
+		// Set if the spec is not a real listApis response, explaining what is missing
+		Stub             string `json:"stub"`
 		ListAPIsResponse struct {
 			Count int    `json:"count"`
 			APIs  []*API `json:"api"`
@@ -1057,6 +678,10 @@ func getApiInfo() (map[string]*API, error) {
 	if err := json.Unmarshal(resp, &ar); err != nil {
 		return nil, err
 	}
+	if ar.Stub != "" && !reportedStubs[ar.Stub] {
+		log.Printf("Using a stub spec: %s", ar.Stub)
+		reportedStubs[ar.Stub] = true
+	}
 
 	// Make a map of all retrieved APIs
 	ai := make(map[string]*API)
@@ -1066,6 +691,31 @@ func getApiInfo() (map[string]*API, error) {
 	return ai, nil
 }
 
+func getRawApiInfo() ([]byte, error) {
+	cs, err := gocs.NewClient(*apiurl, *apikey, *secret, false)
+	if err != nil {
+		return nil, err
+	}
+	return cs.RawRequest("listApis", "")
+}
+
+// Saves the listApis response, so it can be used to generate the code without a CloudStack API
+func dumpApiInfo(file string) error {
+	resp, err := getRawApiInfo()
+	if err != nil {
+		return err
+	}
+
+	// Indent the response so changes between versions are easy to review
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, resp, "", "  "); err != nil {
+		return err
+	}
+	buf.WriteString("\n")
+
+	return ioutil.WriteFile(file, buf.Bytes(), 0644)
+}
+
 func sourceDir() (string, error) {
 	wd, err := os.Getwd()
 	if err != nil {
@@ -1098,6 +748,17 @@ func mapType(t string) string {
 	}
 }
 
+func zeroValue(typ string) string {
+	switch typ {
+	case "bool":
+		return "false"
+	case "int", "int64":
+		return "0"
+	default:
+		return "\"\""
+	}
+}
+
 func capitalize(s string) string {
 	r := []rune(s)
 	r[0] = unicode.ToUpper(r[0])
@@ -0,0 +1,39 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"testing"
+)
+
+// The saved specs are stubs, so they can only be used when explicitly allowed
+func TestReadSpecStub(t *testing.T) {
+	defer func(allow bool) { *stubs = allow }(*stubs)
+
+	*stubs = false
+	if _, err := readSpec(specFile("v44")); err == nil {
+		t.Errorf("Expected the stub spec to be rejected")
+	} else if _, ok := err.(*stubSpecError); !ok {
+		t.Errorf("Expected a *stubSpecError, got %v", err)
+	}
+
+	*stubs = true
+	ai, err := readSpec(specFile("v44"))
+	if err != nil || ai["listZones"] == nil {
+		t.Errorf("Expected the stub spec to be read, got error %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The keywords used to find the service an API belongs to. The name of the API (without the leading
+// verb) is matched against the keywords of all services, and the service with the longest matching
+// keyword wins. APIs that end up in the wrong service this way should be added to the overrides file.
+var serviceKeywords = map[string][]string{
+	"APIDiscoveryService":     {"APIDiscovery", "Apis"},
+	"AccountService":          {"Account"},
+	"AddressService":          {"Address", "IpAddress"},
+	"AffinityGroupService":    {"AffinityGroup"},
+	"AlertService":            {"Alert"},
+	"AsyncjobService":         {"Asyncjob"},
+	"AutoScaleService":        {"AutoScale", "Condition", "Counter"},
+	"BaremetalService":        {"Baremetal"},
+	"BigSwitchVNSService":     {"BigSwitchVNS"},
+	"CertificateService":      {"Certificate"},
+	"CloudIdentifierService":  {"CloudIdentifier"},
+	"ClusterService":          {"Cluster"},
+	"ConfigurationService":    {"Configuration", "Capabilities", "DeploymentPlanner"},
+	"DiskOfferingService":     {"DiskOffering"},
+	"DomainService":           {"Domain"},
+	"EventService":            {"Event"},
+	"ExtFirewallService":      {"ExtFirewall", "ExternalFirewall"},
+	"ExtLoadBalancerService":  {"ExtLoadBalancer", "ExternalLoadBalancer"},
+	"ExternalDeviceService":   {"ExternalDevice", "Cisco"},
+	"FirewallService":         {"Firewall", "PortForwardingRule"},
+	"GuestOSService":          {"GuestOS", "OsCategor", "OsType"},
+	"HostService":             {"Host", "SecondaryStorage"},
+	"HypervisorService":       {"Hypervisor", "HypervisorCapabilities"},
+	"ISOService":              {"ISO"},
+	"ImageStoreService":       {"ImageStore", "SecondaryStagingStore", "ObjectStore"},
+	"InternalLBService":       {"InternalLB", "InternalLoadBalancer"},
+	"LDAPService":             {"LDAP", "Ldap"},
+	"LimitService":            {"Limit", "ResourceCount"},
+	"LoadBalancerService":     {"LoadBalancer", "LB", "SslCert"},
+	"LoginService":            {"Login"},
+	"LogoutService":           {"Logout"},
+	"NATService":              {"NAT", "IpForwardingRule", "StaticNat"},
+	"NetworkACLService":       {"NetworkACL"},
+	"NetworkDeviceService":    {"NetworkDevice"},
+	"NetworkOfferingService":  {"NetworkOffering"},
+	"NetworkService":          {"Network", "PhysicalNetwork", "NetworkService", "PublicIpRange", "StorageNetworkIpRange", "LoadBalancerNetwork", "FirewallNetwork", "DeviceNetwork"},
+	"NicService":              {"Nic"},
+	"NiciraNVPService":        {"NiciraNVP"},
+	"OvsElementService":       {"OvsElement"},
+	"PodService":              {"Pod"},
+	"PoolService":             {"Pool"},
+	"PortableIPService":       {"PortableIP"},
+	"ProjectService":          {"Project"},
+	"RegionService":           {"Region"},
+	"ResourcemetadataService": {"Resourcemetadata", "ResourceDetail"},
+	"ResourcetagsService":     {"Resourcetags", "Tags"},
+	"RouterService":           {"Router"},
+	"S3Service":               {"S3"},
+	"SSHService":              {"SSH"},
+	"SecurityGroupService":    {"SecurityGroup"},
+	"ServiceOfferingService":  {"ServiceOffering"},
+	"SnapshotService":         {"Snapshot"},
+	"StoragePoolService":      {"StoragePool", "StorageMaintenance", "StorageProvider"},
+	"StorageService":          {"Storage", "Lun"},
+	"StratosphereSSPService":  {"StratosphereSSP"},
+	"SwiftService":            {"Swift"},
+	"SystemCapacityService":   {"SystemCapacity", "Capacity"},
+	"SystemVMService":         {"SystemVM", "SystemVm"},
+	"TemplateService":         {"Template"},
+	"UCSService":              {"UCS", "Ucs"},
+	"UsageService":            {"Usage", "Traffic"},
+	"UserService":             {"User"},
+	"VLANService":             {"VLAN", "Vlan"},
+	"VMGroupService":          {"VMGroup", "InstanceGroup"},
+	"VPCService":              {"VPC", "PrivateGateway", "StaticRoute"},
+	"VPNService":              {"VPN", "Vpn", "VpnUser"},
+	"VirtualMachineService":   {"VirtualMachine", "VMPassword", "VMReservations"},
+	"VolumeService":           {"Volume"},
+	"ZoneService":             {"Zone", "VmwareDc"},
+}
+
+// Services of earlier releases of which the APIs are not part of the saved specs (login and logout are
+// not returned by listApis). They are still generated, without any methods, so code using them keeps
+// compiling, but they are deprecated.
+var deprecatedServices = map[string][]string{
+	"v43":    {"LoginService", "LogoutService", "StorageService"},
+	"v44":    {"LoginService", "LogoutService"},
+	"latest": {"LoginService", "LogoutService"},
+}
+
+type apiNotAssignedError struct {
+	api string
+}
+
+func (e *apiNotAssignedError) Error() string {
+	return fmt.Sprintf("Could not find a service for: %s", e.api)
+}
+
+type unknownOverrideError struct {
+	api string
+}
+
+func (e *unknownOverrideError) Error() string {
+	return fmt.Sprintf("Override for %s is not used, as the API is not part of the API details", e.api)
+}
+
+// Reads the overrides file, which maps API names to the service they belong to
+func getOverrides(file string) (map[string]string, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]string)
+	if err := json.Unmarshal(b, &overrides); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// Groups all APIs into services, using the overrides first and the service keywords for all
+// other APIs. Returns the grouped APIs and a report of all APIs that could not be assigned and all
+// overrides of APIs that are not part of the API details.
+func groupAPIs(ai map[string]*API, overrides map[string]string) (apiInfo, []error) {
+	groups := make(apiInfo)
+	report := []error{}
+
+	for name := range ai {
+		sn, found := overrides[name]
+		if !found {
+			sn, found = findService(name)
+		}
+		if !found {
+			report = append(report, &apiNotAssignedError{name})
+			continue
+		}
+		groups[sn] = append(groups[sn], name)
+	}
+	for name := range overrides {
+		if _, found := ai[name]; !found {
+			report = append(report, &unknownOverrideError{name})
+		}
+	}
+
+	// Sort the APIs so all APIs working on the same object are listed together
+	for _, apis := range groups {
+		sort.Sort(byNoun(apis))
+	}
+	sort.Sort(byMessage(report))
+
+	return groups, report
+}
+
+// Returns the service with the longest keyword matching the API name. If multiple keywords with the
+// same length match, the one closest to the start of the name wins.
+func findService(api string) (string, bool) {
+	n := strings.ToLower(apiNoun(api))
+
+	var service string
+	length, pos := 0, 0
+	for _, sn := range sortedServiceNames() {
+		for _, kw := range serviceKeywords[sn] {
+			i := strings.Index(n, strings.ToLower(kw))
+			if i == -1 {
+				continue
+			}
+			if len(kw) > length || (len(kw) == length && i < pos) {
+				service, length, pos = sn, len(kw), i
+			}
+		}
+	}
+	return service, service != ""
+}
+
+func sortedServiceNames() []string {
+	names := make([]string, 0, len(serviceKeywords))
+	for sn := range serviceKeywords {
+		names = append(names, sn)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Returns the name of the API without the leading verb, so 'listVirtualMachines' becomes 'VirtualMachines'.
+// If the name is only a verb (like 'login') the name itself is returned.
+func apiNoun(api string) string {
+	i := strings.IndexFunc(api, unicode.IsUpper)
+	if i == -1 {
+		return api
+	}
+	return api[i:]
+}
+
+type byNoun []string
+
+// Add functions for the Sort interface
+func (s byNoun) Len() int {
+	return len(s)
+}
+
+func (s byNoun) Less(i, j int) bool {
+	ni, nj := parseSingular(apiNoun(s[i])), parseSingular(apiNoun(s[j]))
+	if ni != nj {
+		return ni < nj
+	}
+	return s[i] < s[j]
+}
+
+func (s byNoun) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+type byMessage []error
+
+// Add functions for the Sort interface
+func (s byMessage) Len() int {
+	return len(s)
+}
+
+func (s byMessage) Less(i, j int) bool {
+	return s[i].Error() < s[j].Error()
+}
+
+func (s byMessage) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
@@ -0,0 +1,52 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupAPIs(t *testing.T) {
+	ai := map[string]*API{
+		"listZones":         {Name: "listZones"},
+		"createStoragePool": {Name: "createStoragePool"},
+		"doSomethingOdd":    {Name: "doSomethingOdd"},
+	}
+	overrides := map[string]string{
+		"createStoragePool": "PoolService",
+		"deleteNothing":     "PoolService",
+	}
+
+	groups, report := groupAPIs(ai, overrides)
+
+	want := apiInfo{
+		"ZoneService": {"listZones"},
+		"PoolService": {"createStoragePool"},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Unexpected groups %v, want %v", groups, want)
+	}
+
+	wantReport := []error{
+		&apiNotAssignedError{"doSomethingOdd"},
+		&unknownOverrideError{"deleteNothing"},
+	}
+	if !reflect.DeepEqual(report, wantReport) {
+		t.Errorf("Unexpected report %v, want %v", report, wantReport)
+	}
+}
@@ -0,0 +1,131 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"sort"
+)
+
+// Describes how the entries of a map param are encoded, as the CloudStack API uses different
+// conventions for different params
+type mapParam struct {
+	Key       string   // The name used for the key of each entry, like 'tags[0].key=k'
+	Value     string   // The name used for the value of each entry, like 'tags[0].value=v'
+	ZeroIndex bool     // All entries are encoded under index 0, like 'details[0].k=v'
+	Type      string   // The type of the entries of a list param, like 'iptonetworklist[0].ip=v'
+	Fields    []string // The fields of the list entry type
+}
+
+var keyValueParam = &mapParam{Key: "key", Value: "value"}
+
+// Map params that are not encoded as key/value pairs
+var mapParams = map[string]*mapParam{
+	"accountdetails":             {ZeroIndex: true},
+	"counterparam":               {Key: "name", Value: "value"},
+	"details":                    {ZeroIndex: true},
+	"gslblbruleweightsmap":       {Key: "loadbalancerid", Value: "weight"},
+	"iptonetworklist":            {Type: "IPToNetwork", Fields: []string{"ip", "ipv6", "networkid"}},
+	"migrateto":                  {Key: "volume", Value: "pool"},
+	"networkdeviceparameterlist": {ZeroIndex: true},
+	"param":                      {Key: "name", Value: "value"},
+	"servicecapabilitylist":      {Type: "ServiceCapability", Fields: []string{"service", "capabilitytype", "capabilityvalue"}},
+	"serviceofferingdetails":     {ZeroIndex: true},
+	"serviceproviderlist":        {Type: "ServiceProvider", Fields: []string{"service", "provider"}},
+	"usersecuritygrouplist":      {Key: "account", Value: "group"},
+	"vmidipmap":                  {Type: "VMIPMapping", Fields: []string{"vmid", "vmip"}},
+}
+
+// Map params that are known to be encoded as key/value pairs, like 'tags[0].key=k&tags[0].value=v'
+var keyValueParams = map[string]bool{
+	"tags": true,
+}
+
+// APIs using another encoding for a map param than the one in mapParams
+var apiMapParams = map[string]map[string]*mapParam{
+	"addImageStore":               {"details": keyValueParam},
+	"addResourceDetail":           {"details": keyValueParam},
+	"createSecondaryStagingStore": {"details": keyValueParam},
+	"updateCloudToUseObjectStore": {"details": keyValueParam},
+}
+
+// Returns the encoding of the given map param of the given API. Map params that are not listed in
+// mapParams or keyValueParams are encoded as key/value pairs as well.
+func getMapParam(api, param string) *mapParam {
+	if mp, found := apiMapParams[api][param]; found {
+		return mp
+	}
+	if mp, found := mapParams[param]; found {
+		return mp
+	}
+	return keyValueParam
+}
+
+// Returns the Go type of a param, using the enum type for enumerated params and the element type
+// for list encoded map params
+func paramType(a *API, ap *APIParam) string {
+	if enum, ok := getEnum(a.Name, ap.Name, ap.Type); ok {
+		return enum
+	}
+	if ap.Type != "map" {
+		return mapType(ap.Type)
+	}
+	if mp := getMapParam(a.Name, ap.Name); mp.Type != "" {
+		return "[]" + mp.Type
+	}
+	return "map[string]string"
+}
+
+// The entry type of a list encoded map param, as used by the templates
+type mapParamTypeData struct {
+	Name   string
+	Alias  bool // The type is defined in the common package
+	Fields []string
+}
+
+// Returns the entry types of all list encoded map params used by the given services. The types
+// used by shared APIs are defined in the common package, so only aliases are needed for them.
+func mapParamTypes(ss services) []*mapParamTypeData {
+	types := make(map[string]*mapParam)
+	shared := make(map[string]bool)
+	for _, s := range ss {
+		for _, a := range s.apis {
+			for _, ap := range a.Params {
+				if ap.Type != "map" {
+					continue
+				}
+				if mp := getMapParam(a.Name, ap.Name); mp.Type != "" {
+					types[mp.Type] = mp
+					if commonAPIs[a.Name] && *version != "common" {
+						shared[mp.Type] = true
+					}
+				}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(types))
+	for tn := range types {
+		names = append(names, tn)
+	}
+	sort.Strings(names)
+
+	data := make([]*mapParamTypeData, 0, len(names))
+	for _, tn := range names {
+		data = append(data, &mapParamTypeData{Name: tn, Alias: shared[tn], Fields: types[tn].Fields})
+	}
+	return data
+}
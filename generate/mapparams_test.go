@@ -0,0 +1,50 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+// Every map param of the saved specs must have a known encoding, as a new map param silently
+// falling back to the key/value encoding is usually a bug
+func TestMapParamsKnown(t *testing.T) {
+	for _, v := range []string{"v43", "v44"} {
+		b, err := ioutil.ReadFile(specFile(v))
+		if err != nil {
+			t.Fatalf("Failed to read the spec of %s: %v", v, err)
+		}
+		ai, err := parseApiInfo(b)
+		if err != nil {
+			t.Fatalf("Failed to parse the spec of %s: %v", v, err)
+		}
+
+		for _, a := range ai {
+			for _, ap := range a.Params {
+				if ap.Type != "map" {
+					continue
+				}
+				_, mapped := mapParams[ap.Name]
+				_, apiMapped := apiMapParams[a.Name][ap.Name]
+				if !mapped && !apiMapped && !keyValueParams[ap.Name] {
+					t.Errorf("Map param %s of API %s (%s) is not listed in mapParams, apiMapParams or keyValueParams", ap.Name, a.Name, v)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,197 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The versions that are merged into a single package, oldest first, if no other versions are given
+// with the -merge flag
+var mergeSpecs = map[string][]string{
+	"latest": {"v43", "v44"},
+}
+
+// The CloudStack versions supporting an API and each of its params, like '4.3'
+type apiSupport struct {
+	versions []string
+	params   map[string][]string
+}
+
+// The versions supporting every API of the merged package that is generated; empty if the package
+// is generated from a single version
+var apiVersions = make(map[string]*apiSupport)
+
+// The versions merged into the package that is generated, like 'v43'
+func mergedVersions() []string {
+	if *merge != "" {
+		return strings.Split(*merge, ",")
+	}
+	return mergeSpecs[*version]
+}
+
+// Returns the CloudStack version of a version, like '4.4' for 'v44' or '4.10' for 'v410'
+func versionNumber(v string) string {
+	n := strings.TrimPrefix(v, "v")
+	if len(n) < 2 {
+		return n
+	}
+	return n[:1] + "." + n[1:]
+}
+
+// Reads the saved specs of the given versions and merges them into the API details of a single
+// package. If an API or param is part of multiple versions, the details of the newest version are
+// used. The response fields of all versions are combined, so the response types can decode the
+// responses of every version. The versions supporting every API and param are kept in apiVersions.
+func mergeApiInfo(versions []string) (map[string]*API, error) {
+	ai := make(map[string]*API)
+	for i := len(versions) - 1; i >= 0; i-- {
+		vai, err := readSpec(specFile(versions[i]))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read the spec of %s: %v", versions[i], err)
+		}
+
+		vn := versionNumber(versions[i])
+		for name, a := range vai {
+			support, found := apiVersions[name]
+			if !found {
+				support = &apiSupport{params: make(map[string][]string)}
+				apiVersions[name] = support
+			}
+			support.versions = append([]string{vn}, support.versions...)
+			for _, ap := range a.Params {
+				support.params[ap.Name] = appendVersion(support.params[ap.Name], vn)
+			}
+
+			if n, found := ai[name]; found {
+				ai[name] = mergeAPI(n, a)
+			} else {
+				ai[name] = a
+			}
+		}
+	}
+	return ai, nil
+}
+
+// Adds a version in front of the versions (which are the newer versions), unless already added
+// for a duplicate param
+func appendVersion(versions []string, v string) []string {
+	if len(versions) > 0 && versions[0] == v {
+		return versions
+	}
+	return append([]string{v}, versions...)
+}
+
+// Returns the newer API, extended with the params and response fields that are only part of the older API.
+// A param is only required if it is required by both versions, so the merged params can be used to call
+// either version.
+func mergeAPI(newer, older *API) *API {
+	m := *newer
+	m.Params = APIParams{}
+
+	required := make(map[string]bool)
+	for _, ap := range older.Params {
+		required[ap.Name] = required[ap.Name] || ap.Required
+	}
+
+	found := make(map[string]bool)
+	for _, ap := range newer.Params {
+		np := ap
+		if ap.Required && !required[ap.Name] {
+			// The param is optional or not part of the older version
+			cp := *ap
+			cp.Required = false
+			np = &cp
+		}
+		m.Params = append(m.Params, np)
+		found[ap.Name] = true
+	}
+	for _, ap := range older.Params {
+		if !found[ap.Name] {
+			// The param can't be required, as it is not part of the newer version
+			op := *ap
+			op.Required = false
+			m.Params = append(m.Params, &op)
+			found[ap.Name] = true
+		}
+	}
+
+	m.Response = mergeResponses(newer.Response, older.Response)
+	return &m
+}
+
+func mergeResponses(newer, older APIResponses) APIResponses {
+	m := append(APIResponses{}, newer...)
+
+	idx := make(map[string]int)
+	for i, r := range m {
+		if _, found := idx[r.Name]; !found {
+			idx[r.Name] = i
+		}
+	}
+	for _, r := range older {
+		i, found := idx[r.Name]
+		if !found {
+			m = append(m, r)
+			idx[r.Name] = len(m) - 1
+			continue
+		}
+		if m[i].Response != nil && r.Response != nil {
+			mr := *m[i]
+			mr.Response = mergeResponses(m[i].Response, r.Response)
+			m[i] = &mr
+		}
+	}
+	return m
+}
+
+// Returns the versions supporting the given API, or nil if the package is not merged
+func supportedVersions(api string) []string {
+	if s, found := apiVersions[api]; found {
+		return s.versions
+	}
+	return nil
+}
+
+// Returns the versions supporting the given param, if it is not supported by all versions that
+// support the API
+func paramVersions(api, param string) []string {
+	s, found := apiVersions[api]
+	if !found || len(s.params[param]) == len(s.versions) {
+		return nil
+	}
+	return s.params[param]
+}
+
+// Returns the versions supporting an API, if it is not supported by all merged versions
+func partialVersions(api string) []string {
+	vs := supportedVersions(api)
+	if len(vs) == len(mergedVersions()) {
+		return nil
+	}
+	return vs
+}
+
+// Returns a sentence listing the given versions, for the docs
+func versionsDoc(versions []string) string {
+	vs := versions
+	if len(vs) > 1 {
+		vs = append(append([]string{}, vs[:len(vs)-2]...), vs[len(vs)-2]+" and "+vs[len(vs)-1])
+	}
+	return fmt.Sprintf("Only supported by CloudStack %s.", strings.Join(vs, ", "))
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVersionNumber(t *testing.T) {
+	tests := map[string]string{
+		"v43":  "4.3",
+		"v44":  "4.4",
+		"v410": "4.10",
+		"v5":   "5",
+	}
+	for v, want := range tests {
+		if got := versionNumber(v); got != want {
+			t.Errorf("versionNumber(%q) = %q, want %q", v, got, want)
+		}
+	}
+}
+
+func TestMergeAPI(t *testing.T) {
+	newer := &API{Name: "updatePortForwardingRule", Params: APIParams{
+		{Name: "id", Required: true},
+		{Name: "name", Required: true},
+		{Name: "customid"},
+	}}
+	older := &API{Name: "updatePortForwardingRule", Params: APIParams{
+		{Name: "ipaddressid", Required: true},
+		{Name: "name", Required: true},
+		{Name: "customid", Required: true},
+	}}
+
+	// Only params required by both versions stay required
+	want := map[string]bool{
+		"id":          false,
+		"name":        true,
+		"customid":    false,
+		"ipaddressid": false,
+	}
+
+	m := mergeAPI(newer, older)
+	if len(m.Params) != len(want) {
+		t.Fatalf("Unexpected params %v", m.Params)
+	}
+	for _, ap := range m.Params {
+		if required, found := want[ap.Name]; !found || ap.Required != required {
+			t.Errorf("Param %s: required is %v, want %v", ap.Name, ap.Required, required)
+		}
+	}
+	if !newer.Params[0].Required || !older.Params[0].Required {
+		t.Errorf("The params of the merged versions must not be changed")
+	}
+}
+
+// Merging an API that did not change must return an equal API, as the shared APIs are checked that way
+func TestMergeAPIUnchanged(t *testing.T) {
+	a := &API{Name: "listEventTypes", Params: APIParams{}, Response: APIResponses{{Name: "name", Type: "string"}}}
+	if m := mergeAPI(a, a); !reflect.DeepEqual(m, a) {
+		t.Errorf("Merged API %+v is different from %+v", m, a)
+	}
+}
@@ -0,0 +1,64 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+// Response fields with a native type, by name. Only the fields of the listed types are changed.
+var nativeFields = map[string]struct {
+	typ   string
+	types []string
+}{
+	"cpuallocated":      {"Percentage", []string{"string"}},
+	"cpuused":           {"Percentage", []string{"string"}},
+	"created":           {"Date", []string{"string"}},
+	"disksizeallocated": {"Size", []string{"long"}},
+	"disksizetotal":     {"Size", []string{"long"}},
+	"disksizeused":      {"Size", []string{"long"}},
+	"enddate":           {"Date", []string{"string"}},
+	"lastupdated":       {"Date", []string{"string"}},
+	"percentused":       {"Percentage", []string{"string"}},
+	"physicalsize":      {"Size", []string{"long"}},
+	"rawusage":          {"Float", []string{"string"}},
+	"removed":           {"Date", []string{"string"}},
+	"size":              {"Size", []string{"long"}},
+	"startdate":         {"Date", []string{"string"}},
+	"virtualsize":       {"Size", []string{"long"}},
+}
+
+// The native types, which are defined in the common package
+var nativeTypes = []string{"Bool", "Date", "Float", "Percentage", "Size"}
+
+// Returns the type of a response field of the given API
+func responseFieldType(api string, r *APIResponse) string {
+	if enum, ok := getEnum(api, r.Name, r.Type); ok {
+		return enum
+	}
+	if nf, found := nativeFields[r.Name]; found {
+		for _, t := range nf.types {
+			if t == r.Type {
+				return nf.typ
+			}
+		}
+	}
+	switch {
+	case r.Type == "date":
+		return "Date"
+	case mapType(r.Type) == "bool":
+		return "Bool"
+	default:
+		return mapType(r.Type)
+	}
+}
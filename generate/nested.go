@@ -0,0 +1,233 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The names of the types used for nested response objects, if they can't be derived from the field name.
+// If a field has objects with different fields, the next name is used for each variant.
+var nestedTypeNames = map[string][]string{
+	"affinitygroup":        {"AffinityGroup"},
+	"capability":           {"ServiceCapabilityValue"},
+	"egressrule":           {"SecurityGroupRule"},
+	"gpugroup":             {"GPUGroup"},
+	"healthcheckpolicy":    {"HealthCheckPolicy"},
+	"ingressrule":          {"SecurityGroupRule"},
+	"loadbalancerinstance": {"LoadBalancerInstance"},
+	"loadbalancerrule":     {"LoadBalancerRule", "InternalLoadBalancerRule"},
+	"params":               {"ApiParam"},
+	"portableipaddress":    {"PortableIpAddress"},
+	"response":             {"ApiResponse"},
+	"securitygroup":        {"SecurityGroup"},
+	"stickinesspolicy":     {"StickinessPolicy"},
+	"vgpu":                 {"VGPU"},
+}
+
+type nestedKind int
+
+const (
+	nestedDefine   nestedKind = iota // Defined as a named type in this package
+	nestedAlias                      // Defined in the common package, so only an alias is needed
+	nestedTopLevel                   // The same as the list element type of an API, which is used instead
+)
+
+type nestedType struct {
+	name string
+	kind nestedKind
+	resp APIResponses
+}
+
+// All named nested response types of the package that is generated, by their signature
+var nestedTypes = make(map[string]*nestedType)
+
+type nestedTypeConflictError struct {
+	field string
+	name  string
+}
+
+func (e *nestedTypeConflictError) Error() string {
+	return fmt.Sprintf("Nested field %s uses an anonymous type, as type name %s is already used", e.field, e.name)
+}
+
+// Returns a string describing the fields of a response, so responses with the same fields can be shared
+func responseSignature(resp APIResponses) string {
+	fields := []string{}
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if r.Response != nil {
+			fields = append(fields, fmt.Sprintf("%s:{%s}", r.Name, responseSignature(r.Response)))
+		} else {
+			fields = append(fields, fmt.Sprintf("%s:%s", r.Name, r.Type))
+		}
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, ",")
+}
+
+// Finds all nested response objects of the given APIs and gives each distinct object a name. Objects
+// that are the same as the list element type of an API use that type, and objects that are also
+// found in the common package use the type defined there. If all names of an object are used by other
+// objects, the name is prefixed with the name of the service of the API (like 'LoadBalancerNic').
+func findNestedTypes(apis []*API, common map[string]*nestedType, groups apiInfo) (map[string]*nestedType, []error) {
+	nt := make(map[string]*nestedType)
+	conflicts := make(map[string]bool)
+	errors := []error{}
+
+	// Reserve the names of all other generated types
+	names := make(map[string]string)
+	for _, a := range apis {
+		names[capitalize(a.Name+"Params")] = ""
+		for _, tn := range responseTypeNames(a) {
+			names[tn] = ""
+		}
+		if strings.HasPrefix(a.Name, "list") {
+			names[parseSingular(capitalize(strings.TrimPrefix(a.Name, "list")))] = responseSignature(a.Response)
+		}
+	}
+	for _, e := range enums {
+		names[e.name] = ""
+	}
+	for _, mp := range mapParams {
+		if mp.Type != "" {
+			names[mp.Type] = ""
+		}
+	}
+	for sn := range serviceKeywords {
+		names[sn] = ""
+	}
+
+	serviceOf := make(map[string]string)
+	for sn, apis := range groups {
+		for _, api := range apis {
+			serviceOf[api] = strings.TrimSuffix(sn, "Service")
+		}
+	}
+
+	var walk func(prefix string, resp APIResponses)
+	walk = func(prefix string, resp APIResponses) {
+		for _, r := range resp {
+			if r.Response == nil {
+				continue
+			}
+			walk(prefix, r.Response)
+
+			sig := responseSignature(r.Response)
+			if _, found := nt[sig]; found {
+				continue
+			}
+			if ct, found := common[sig]; found {
+				kind := nestedAlias
+				if ct.kind == nestedTopLevel {
+					kind = nestedTopLevel
+				}
+				nt[sig] = &nestedType{name: ct.name, kind: kind, resp: r.Response}
+				continue
+			}
+
+			candidates, found := nestedTypeNames[r.Name]
+			if !found {
+				candidates = []string{capitalize(parseSingular(r.Name))}
+			}
+			if prefix != "" {
+				candidates = append(candidates[:len(candidates):len(candidates)], prefix+candidates[0])
+			}
+			for _, name := range candidates {
+				if s, found := names[name]; found {
+					if s == sig {
+						nt[sig] = &nestedType{name: name, kind: nestedTopLevel, resp: r.Response}
+						break
+					}
+					continue
+				}
+				names[name] = sig
+				nt[sig] = &nestedType{name: name, kind: nestedDefine, resp: r.Response}
+				break
+			}
+			if _, found := nt[sig]; !found && !conflicts[sig] {
+				errors = append(errors, &nestedTypeConflictError{r.Name, candidates[len(candidates)-1]})
+				conflicts[sig] = true
+			}
+		}
+	}
+
+	sort.Sort(byName(apis))
+	for _, a := range apis {
+		// The types of shared APIs are defined in the common package
+		if common != nil && commonAPIs[a.Name] {
+			continue
+		}
+		walk(serviceOf[a.Name], a.Response)
+	}
+	return nt, errors
+}
+
+// A named nested response type, as used by the templates
+type nestedTypeData struct {
+	Name   string
+	Alias  bool // The type is defined in the common package
+	Fields []*fieldData
+}
+
+// Returns the named nested response types that are not generated together with an API
+func nestedTypeDefs() []*nestedTypeData {
+	types := make(map[string]*nestedType)
+	for _, t := range nestedTypes {
+		if t.kind != nestedTopLevel {
+			types[t.name] = t
+		}
+	}
+
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := make([]*nestedTypeData, 0, len(names))
+	for _, name := range names {
+		t := types[name]
+		if t.kind == nestedAlias {
+			data = append(data, &nestedTypeData{Name: name, Alias: true})
+			continue
+		}
+		resp := append(APIResponses{}, t.resp...)
+		sort.Sort(resp)
+		data = append(data, &nestedTypeData{Name: name, Fields: responseFields("", resp)})
+	}
+	return data
+}
+
+type byName []*API
+
+// Add functions for the Sort interface
+func (s byName) Len() int {
+	return len(s)
+}
+
+func (s byName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+func (s byName) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
@@ -0,0 +1,390 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+)
+
+// An OpenAPI 3 document, containing only the parts needed to describe the CloudStack API
+type openAPIDocument struct {
+	OpenAPI    string                      `json:"openapi"`
+	Info       *openAPIInfo                `json:"info"`
+	Servers    []*openAPIServer            `json:"servers"`
+	Tags       []*openAPITag               `json:"tags"`
+	Paths      map[string]*openAPIPathItem `json:"paths"`
+	Components *openAPIComponents          `json:"components"`
+	Security   []map[string][]string       `json:"security"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+type openAPITag struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type openAPIPathItem struct {
+	Get *openAPIOperation `json:"get"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Tags        []string                    `json:"tags"`
+	Parameters  []*openAPIParameter         `json:"parameters"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+	Async       bool                        `json:"x-cloudstack-async"`
+	Since       string                      `json:"x-cloudstack-since,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name        string              `json:"name"`
+	In          string              `json:"in"`
+	Description string              `json:"description,omitempty"`
+	Required    bool                `json:"required,omitempty"`
+	Style       string              `json:"style,omitempty"`
+	Explode     *bool               `json:"explode,omitempty"`
+	Schema      *openAPISchema      `json:"schema"`
+	Since       string              `json:"x-cloudstack-since,omitempty"`
+	MapEncoding *openAPIMapEncoding `json:"x-cloudstack-map-encoding,omitempty"`
+}
+
+// Describes how the entries of a map param are encoded, as OpenAPI can't express the indexed params
+// used by CloudStack. Format shows the params of an entry, like 'tags[0].key=k&tags[0].value=v'.
+type openAPIMapEncoding struct {
+	Format    string   `json:"format"`
+	Key       string   `json:"key,omitempty"`
+	Value     string   `json:"value,omitempty"`
+	ZeroIndex bool     `json:"zeroIndex,omitempty"`
+	Fields    []string `json:"fields,omitempty"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	MaxLength            int                       `json:"maxLength,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+// Writes an OpenAPI 3 document describing all APIs of the package, grouped into the same
+// services, to the given file
+func (as *allServices) writeOpenAPI(file string) error {
+	b, err := json.MarshalIndent(as.openAPI(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, append(b, '\n'), 0644)
+}
+
+func (as *allServices) openAPI() *openAPIDocument {
+	doc := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: &openAPIInfo{
+			Title: "CloudStack API",
+			Description: "Every command is called with a GET request, using the 'command' param to select the command. " +
+				"The path of a command only gives every command its own operation, as CloudStack ignores it. " +
+				"All requests need to be signed with the 'signature' param, as described in the CloudStack documentation. " +
+				"Commands marked with 'x-cloudstack-async' start an async job, of which the result can be queried using queryAsyncJobResult. " +
+				"Map params are not encoded using the OpenAPI styles, but as indexed params as described by their 'x-cloudstack-map-encoding'.",
+			Version: *version,
+		},
+		Servers: []*openAPIServer{{
+			URL:         "http://localhost:8080/client/api",
+			Description: "The CloudStack API endpoint",
+		}},
+		Tags:  []*openAPITag{},
+		Paths: make(map[string]*openAPIPathItem),
+		Components: &openAPIComponents{
+			Schemas: make(map[string]*openAPISchema),
+			SecuritySchemes: map[string]*openAPISecurityScheme{
+				"apiKey": {
+					Type:        "apiKey",
+					Name:        "apikey",
+					In:          "query",
+					Description: "The API key of the user. The request also needs to be signed using the secret key.",
+				},
+			},
+		},
+		Security: []map[string][]string{{"apiKey": {}}},
+	}
+
+	for _, s := range as.services {
+		doc.Tags = append(doc.Tags, &openAPITag{
+			Name:        s.name,
+			Description: fmt.Sprintf("The commands of the %s", s.name),
+		})
+		for _, a := range s.apis {
+			doc.Paths["/"+a.Name] = &openAPIPathItem{Get: s.openAPIOperation(doc, a)}
+		}
+	}
+	return doc
+}
+
+func (s *service) openAPIOperation(doc *openAPIDocument, a *API) *openAPIOperation {
+	op := &openAPIOperation{
+		OperationID: a.Name,
+		Summary:     a.Description,
+		Tags:        []string{s.name},
+		Parameters: []*openAPIParameter{{
+			Name:     "command",
+			In:       "query",
+			Required: true,
+			Schema:   &openAPISchema{Type: "string", Enum: []string{a.Name}},
+		}, {
+			// CloudStack returns XML if no format is given
+			Name:        "response",
+			In:          "query",
+			Description: "The format of the response",
+			Required:    true,
+			Schema:      &openAPISchema{Type: "string", Enum: []string{"json"}},
+		}},
+		Async: a.Isasync,
+		Since: a.Since,
+	}
+
+	found := make(map[string]bool)
+	for _, ap := range a.Params {
+		if !found[ap.Name] {
+			op.Parameters = append(op.Parameters, openAPIParam(a, ap))
+			found[ap.Name] = true
+		}
+	}
+
+	ref := addSchema(doc, a, capitalize(a.Name+"Response"), openAPIResponseSchema(doc, a))
+
+	// CloudStack wraps the response in an object named after the command
+	op.Responses = map[string]*openAPIResponse{
+		"200": {
+			Description: "The response of the command",
+			Content: map[string]*openAPIMediaType{
+				"application/json": {Schema: &openAPISchema{
+					Type: "object",
+					Properties: map[string]*openAPISchema{
+						strings.ToLower(a.Name) + "response": ref,
+					},
+				}},
+			},
+		},
+		"default": {Description: "An error returned by CloudStack"},
+	}
+	return op
+}
+
+func openAPIParam(a *API, ap *APIParam) *openAPIParameter {
+	p := &openAPIParameter{
+		Name:        ap.Name,
+		In:          "query",
+		Description: paramDescription(ap),
+		Required:    ap.Required,
+		Schema:      openAPIType(ap.Type),
+		Since:       ap.Since,
+	}
+	if ap.Length > 0 && p.Schema.Type == "string" {
+		p.Schema.MaxLength = ap.Length
+	}
+
+	switch ap.Type {
+	case "list", "set":
+		// Lists are sent as a single comma separated value
+		explode := false
+		p.Style, p.Explode = "form", &explode
+	case "map":
+		mp := getMapParam(a.Name, ap.Name)
+		p.MapEncoding = &openAPIMapEncoding{
+			Format:    mapParamFormat(ap.Name, mp),
+			Key:       mp.Key,
+			Value:     mp.Value,
+			ZeroIndex: mp.ZeroIndex,
+			Fields:    mp.Fields,
+		}
+		if mp.Type != "" {
+			item := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+			for _, f := range mp.Fields {
+				item.Properties[f] = &openAPISchema{Type: "string"}
+			}
+			p.Schema = &openAPISchema{Type: "array", Items: item}
+		}
+		if p.Description != "" {
+			p.Description += ". "
+		}
+		p.Description += "Encoded as " + p.MapEncoding.Format
+	}
+	return p
+}
+
+// Returns the params an entry of a map param is encoded as, like 'tags[0].key=k&tags[0].value=v' for
+// the first entry of the tags param
+func mapParamFormat(name string, mp *mapParam) string {
+	switch {
+	case mp.ZeroIndex:
+		return fmt.Sprintf("%s[0].k=v (all entries use index 0)", name)
+	case mp.Type != "":
+		params := []string{}
+		for _, f := range mp.Fields {
+			params = append(params, fmt.Sprintf("%s[0].%s=%s", name, f, f))
+		}
+		return strings.Join(params, "&")
+	default:
+		return fmt.Sprintf("%s[0].%s=k&%s[0].%s=v", name, mp.Key, name, mp.Value)
+	}
+}
+
+// Returns the schema of the response of the given API. For 'list' APIs, the response contains
+// the count and a list of the listed objects.
+func openAPIResponseSchema(doc *openAPIDocument, a *API) *openAPISchema {
+	resp := a.Response
+	if a.Isasync {
+		resp = APIResponses{&APIResponse{Name: "jobid", Type: "string", Description: "The ID of the async job"}}
+		for _, r := range a.Response {
+			if r.Name != "jobid" {
+				resp = append(resp, r)
+			}
+		}
+	}
+	schema := openAPIObject(doc, a, resp)
+
+	if !strings.HasPrefix(a.Name, "list") && a.Name != "registerTemplate" {
+		return schema
+	}
+
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	tn := parseSingular(ln)
+	field := strings.ToLower(tn)
+	switch a.Name {
+	case "listEgressFirewallRules":
+		field = "firewallrule"
+	case "registerTemplate":
+		field = "template"
+	}
+	return &openAPISchema{
+		Type: "object",
+		Properties: map[string]*openAPISchema{
+			"count": {Type: "integer", Format: "int32"},
+			field:   {Type: "array", Items: addSchema(doc, a, tn, schema)},
+		},
+	}
+}
+
+// Returns an object schema with the given response fields. Nested objects with a named type in
+// the generated packages are added to the components, so they can be referenced.
+func openAPIObject(doc *openAPIDocument, a *API, resp APIResponses) *openAPISchema {
+	schema := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if _, found := schema.Properties[r.Name]; found {
+			continue
+		}
+		if r.Response == nil {
+			ps := openAPIType(r.Type)
+			ps.Description = r.Description
+			schema.Properties[r.Name] = ps
+			continue
+		}
+
+		item := openAPIObject(doc, a, r.Response)
+		if t, found := nestedTypes[responseSignature(r.Response)]; found {
+			item = addSchema(doc, a, t.name, item)
+		}
+		schema.Properties[r.Name] = &openAPISchema{Type: "array", Description: r.Description, Items: item}
+	}
+	return schema
+}
+
+// Adds the schema to the components and returns a reference to it. If a different schema with the
+// same name was already added (like the nested objects of different responses), the name is qualified
+// with the name of the API, like 'ListLoadBalancerRuleInstancesNic'.
+func addSchema(doc *openAPIDocument, a *API, name string, schema *openAPISchema) *openAPISchema {
+	qualified := name
+	for i := 1; ; i++ {
+		s, found := doc.Components.Schemas[qualified]
+		if !found || reflect.DeepEqual(s, schema) {
+			break
+		}
+		qualified = capitalize(a.Name) + name
+		if i > 1 {
+			qualified = fmt.Sprintf("%s%s%d", capitalize(a.Name), name, i)
+		}
+	}
+	doc.Components.Schemas[qualified] = schema
+	return &openAPISchema{Ref: "#/components/schemas/" + qualified}
+}
+
+// Returns the schema of a type used in the API details
+func openAPIType(t string) *openAPISchema {
+	switch t {
+	case "boolean":
+		return &openAPISchema{Type: "boolean"}
+	case "short", "int", "integer":
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case "long":
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case "float", "double":
+		return &openAPISchema{Type: "number", Format: t}
+	case "uuid":
+		return &openAPISchema{Type: "string", Format: "uuid"}
+	case "list", "set":
+		return &openAPISchema{Type: "array", Items: &openAPISchema{Type: "string"}}
+	case "map":
+		return &openAPISchema{Type: "object", AdditionalProperties: &openAPISchema{Type: "string"}}
+	case "responseobject":
+		return &openAPISchema{Type: "object"}
+	default:
+		return &openAPISchema{Type: "string"}
+	}
+}
@@ -0,0 +1,87 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"testing"
+)
+
+func TestOpenAPIMapParams(t *testing.T) {
+	tests := []struct {
+		api    string
+		param  string
+		format string
+	}{
+		{"createTags", "tags", "tags[0].key=k&tags[0].value=v"},
+		{"deployVirtualMachine", "details", "details[0].k=v (all entries use index 0)"},
+		{"addImageStore", "details", "details[0].key=k&details[0].value=v"},
+		{"deployVirtualMachine", "iptonetworklist", "iptonetworklist[0].ip=ip&iptonetworklist[0].ipv6=ipv6&iptonetworklist[0].networkid=networkid"},
+		{"migrateVirtualMachineWithVolume", "migrateto", "migrateto[0].volume=k&migrateto[0].pool=v"},
+	}
+
+	for _, tt := range tests {
+		p := openAPIParam(&API{Name: tt.api}, &APIParam{Name: tt.param, Type: "map"})
+		if p.Style != "" || p.Explode != nil {
+			t.Errorf("%s of %s: map params must not use an OpenAPI style, got %q", tt.param, tt.api, p.Style)
+		}
+		if p.MapEncoding == nil || p.MapEncoding.Format != tt.format {
+			t.Errorf("%s of %s: unexpected encoding %+v, want format %q", tt.param, tt.api, p.MapEncoding, tt.format)
+		}
+	}
+}
+
+func TestOpenAPIResponseParam(t *testing.T) {
+	doc := (&allServices{}).openAPI()
+	op := (&service{name: "ZoneService"}).openAPIOperation(doc, &API{Name: "listZones"})
+
+	for _, p := range op.Parameters {
+		if p.Name == "response" {
+			if !p.Required || len(p.Schema.Enum) != 1 || p.Schema.Enum[0] != "json" {
+				t.Errorf("The response param must require 'json', got %+v", p.Schema)
+			}
+			return
+		}
+	}
+	t.Errorf("The response param is not declared")
+}
+
+func TestOpenAPISchemaCollisions(t *testing.T) {
+	doc := (&allServices{}).openAPI()
+	nic := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{"id": {Type: "string"}}}
+	otherNic := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{"ipaddress": {Type: "string"}}}
+
+	tests := []struct {
+		api    string
+		schema *openAPISchema
+		ref    string
+	}{
+		{"listVirtualMachines", nic, "#/components/schemas/Nic"},
+		{"listRouters", nic, "#/components/schemas/Nic"},
+		{"listLoadBalancerRuleInstances", otherNic, "#/components/schemas/ListLoadBalancerRuleInstancesNic"},
+		{"listLoadBalancerRuleInstances", otherNic, "#/components/schemas/ListLoadBalancerRuleInstancesNic"},
+	}
+
+	for _, tt := range tests {
+		ref := addSchema(doc, &API{Name: tt.api}, "Nic", tt.schema)
+		if ref.Ref != tt.ref {
+			t.Errorf("Nic of %s: got reference %q, want %q", tt.api, ref.Ref, tt.ref)
+		}
+	}
+	if doc.Components.Schemas["Nic"] != nic {
+		t.Errorf("The first Nic schema was overwritten")
+	}
+}
@@ -0,0 +1,229 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The sample values used by the generated tests. Every param gets its own value, so a setter or an
+// encoding that uses the wrong param is detected.
+
+// Sets the sample value of the params of an API and the URL values they are expected to be encoded as
+func addParamSamples(ad *apiData) {
+	samples := make(map[string]string)
+	for i, p := range ad.Params {
+		p.Sample, p.Encoded = paramSample(p, i+1)
+		samples[p.Name] = p.Sample
+	}
+
+	args := []string{}
+	for _, p := range ad.Required {
+		args = append(args, samples[p.Name])
+	}
+	ad.SampleArgs = strings.Join(args, ", ")
+}
+
+// Returns the Go expression of the sample value of a param, and the URL values it is encoded as
+func paramSample(p *paramData, n int) (string, [][2]string) {
+	s := sampleString(p.Name)
+
+	switch {
+	case p.Map != nil:
+		return mapParamSample(p)
+	case p.Enum:
+		// The first value of the enum, as other values fail the validation
+		v := enumSample(p.Type)
+		return v.Const, [][2]string{{p.Name, v.Value}}
+	case p.BaseType == "int", p.BaseType == "int64":
+		return strconv.Itoa(n), [][2]string{{p.Name, strconv.Itoa(n)}}
+	case p.BaseType == "bool":
+		return "true", [][2]string{{p.Name, "true"}}
+	case p.BaseType == "[]string":
+		return fmt.Sprintf("[]string{%q, %q}", s+"1", s+"2"), [][2]string{{p.Name, s + "1, " + s + "2"}}
+	default:
+		return strconv.Quote(s), [][2]string{{p.Name, s}}
+	}
+}
+
+func mapParamSample(p *paramData) (string, [][2]string) {
+	mp := p.Map
+	if mp.Type != "" {
+		fields, encoded := []string{}, [][2]string{}
+		for _, f := range mp.Fields {
+			s := sampleString(f)
+			fields = append(fields, fmt.Sprintf("%s: %q", capitalize(f), s))
+			encoded = append(encoded, [2]string{fmt.Sprintf("%s[0].%s", p.Name, f), s})
+		}
+		return fmt.Sprintf("%s{{%s}}", p.Type, strings.Join(fields, ", ")), encoded
+	}
+
+	key, value := sampleString(p.Name+"key"), sampleString(p.Name+"value")
+	sample := fmt.Sprintf("map[string]string{%q: %q}", key, value)
+	if mp.ZeroIndex {
+		return sample, [][2]string{{fmt.Sprintf("%s[0].%s", p.Name, key), value}}
+	}
+	return sample, [][2]string{
+		{fmt.Sprintf("%s[0].%s", p.Name, mp.Key), key},
+		{fmt.Sprintf("%s[0].%s", p.Name, mp.Value), value},
+	}
+}
+
+// Returns a sample string for the given name
+func sampleString(name string) string {
+	return "test-" + name
+}
+
+// Returns the first value of the named enum
+func enumSample(name string) *enumValue {
+	for _, e := range enumDefs() {
+		if e.Name == name {
+			return e.Values[0]
+		}
+	}
+	// Can't happen, as only params with a type of one of the enums are enum params
+	panic("unknown enum " + name)
+}
+
+// Sets the sample response of an API, as returned by CloudStack, and the checks done on the decoded response
+func addResponseSample(ad *apiData) {
+	elem := responseSample(ad.ResponseFields)
+
+	r, prefix := "r.", "r."
+	if ad.ListResponse != nil {
+		prefix = fmt.Sprintf("r.%s[0].", ad.ListResponse.Field)
+	}
+	for _, f := range ad.ResponseFields {
+		if f.Type == "string" {
+			ad.SampleChecks = append(ad.SampleChecks, [2]string{prefix + f.Name, strconv.Quote(sampleString(f.JSON))})
+			break
+		}
+	}
+
+	var inner interface{} = elem
+	if ad.ListResponse != nil {
+		inner = map[string]interface{}{"count": 1, ad.ListResponse.JSON: []interface{}{elem}}
+		ad.SampleChecks = append(ad.SampleChecks, [2]string{r + "Count", "1"})
+	}
+	if ad.Async {
+		elem["jobid"] = sampleString("jobid")
+		ad.SampleChecks = append(ad.SampleChecks, [2]string{r + "JobID", strconv.Quote(sampleString("jobid"))})
+	}
+	if ad.RawValue {
+		inner = map[string]interface{}{strings.ToLower(ad.Singular): elem}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{strings.ToLower(ad.Name) + "response": inner})
+	if err != nil {
+		// Can't happen, as the sample only contains strings, numbers, bools, lists and maps
+		panic(err)
+	}
+	ad.SampleResponse = string(b)
+
+	var buf bytes.Buffer
+	xmlSample(&buf, strings.ToLower(ad.Name)+"response", inner)
+	ad.SampleXMLResponse = buf.String()
+
+	// The raw values of an XML response contain the XML of their element
+	for _, f := range ad.ResponseFields {
+		if f.Type == "json.RawMessage" {
+			ad.SampleRawFields = append(ad.SampleRawFields, [2]string{strings.TrimPrefix(prefix, r) + f.Name, "<" + f.JSON})
+		}
+	}
+}
+
+// Writes the sample as the XML CloudStack returns: objects as elements with a child element per field, and
+// lists as repeated elements
+func xmlSample(buf *bytes.Buffer, name string, v interface{}) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, e := range v {
+			xmlSample(buf, name, e)
+		}
+	case []string:
+		for _, e := range v {
+			xmlSample(buf, name, e)
+		}
+	case map[string]interface{}:
+		fmt.Fprintf(buf, "<%s>", name)
+		for _, k := range sortedKeys(v) {
+			xmlSample(buf, k, v[k])
+		}
+		fmt.Fprintf(buf, "</%s>", name)
+	case map[string]string:
+		fmt.Fprintf(buf, "<%s>", name)
+		for k, e := range v {
+			xmlSample(buf, k, e)
+		}
+		fmt.Fprintf(buf, "</%s>", name)
+	default:
+		fmt.Fprintf(buf, "<%s>", name)
+		xml.EscapeText(buf, []byte(fmt.Sprint(v)))
+		fmt.Fprintf(buf, "</%s>", name)
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns a sample object with all given fields
+func responseSample(fields []*fieldData) map[string]interface{} {
+	obj := make(map[string]interface{})
+	for _, f := range fields {
+		obj[f.JSON] = fieldSample(f)
+	}
+	return obj
+}
+
+func fieldSample(f *fieldData) interface{} {
+	if f.Fields != nil {
+		return []interface{}{responseSample(f.Fields)}
+	}
+	switch f.Type {
+	case "int", "int64", "Size":
+		return 1
+	case "Bool":
+		return true
+	case "Date":
+		return "2014-01-02T15:04:05+0100"
+	case "Float":
+		return "1.5"
+	case "Percentage":
+		return "12.5%"
+	case "[]string":
+		return []string{sampleString(f.JSON)}
+	case "map[string]string":
+		return map[string]string{"key": "value"}
+	case "json.RawMessage":
+		return map[string]string{}
+	default:
+		// Strings and enums
+		return sampleString(f.JSON)
+	}
+}
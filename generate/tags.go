@@ -0,0 +1,89 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"strings"
+)
+
+// The 'list' APIs used to get the objects of a resource type returned by listTags. A resource type
+// can be listed by more than one API (like ingress and egress firewall rules). The objects of other
+// resource types, or of which the API is not part of the package or needs other params than the tags
+// (like templates), are only returned by their ID.
+var taggedResources = [][2]string{
+	{"UserVm", "listVirtualMachines"},
+	{"Volume", "listVolumes"},
+	{"Snapshot", "listSnapshots"},
+	{"VMSnapshot", "listVMSnapshot"},
+	{"ISO", "listIsos"},
+	{"Network", "listNetworks"},
+	{"Vpc", "listVPCs"},
+	{"PublicIpAddress", "listPublicIpAddresses"},
+	{"FirewallRule", "listFirewallRules"},
+	{"FirewallRule", "listEgressFirewallRules"},
+	{"PortForwardingRule", "listPortForwardingRules"},
+	{"LoadBalancer", "listLoadBalancerRules"},
+	{"NetworkACL", "listNetworkACLs"},
+	{"StaticRoute", "listStaticRoutes"},
+	{"SecurityGroup", "listSecurityGroups"},
+	{"Project", "listProjects"},
+	{"Zone", "listZones"},
+}
+
+// A resource type that can be found by its tags, and the 'list' API used to get its objects
+type taggedResourceData struct {
+	ResourceType string // The resource type, like 'UserVm'
+	Service      string // The name of the field of the client, like 'VirtualMachine'
+	List         string // The name of the listed objects, like 'VirtualMachines'
+	Singular     string // The singular of List, like 'VirtualMachine'
+}
+
+func taggedResourceDefs(ss services) []*taggedResourceData {
+	apis := make(map[string]*API)
+	short := make(map[string]string)
+	for _, s := range ss {
+		for _, a := range s.apis {
+			apis[a.Name] = a
+			short[a.Name] = strings.TrimSuffix(s.name, "Service")
+		}
+	}
+
+	data := []*taggedResourceData{}
+	for _, tr := range taggedResources {
+		a, found := apis[tr[1]]
+		if !found || !hasTagsParamField(a) || hasRequiredParams(a) {
+			continue
+		}
+		ln := strings.TrimPrefix(a.Name, "list")
+		data = append(data, &taggedResourceData{
+			ResourceType: tr[0],
+			Service:      short[a.Name],
+			List:         ln,
+			Singular:     parseSingular(ln),
+		})
+	}
+	return data
+}
+
+func hasRequiredParams(a *API) bool {
+	for _, ap := range a.Params {
+		if ap.Required {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,540 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// The templates of the files generated for every package. Any other template in the templates directory
+// given with the -templates flag with a name ending in '.go.tmpl' is generated as an additional file.
+var packageTemplates = map[string]bool{
+	"cloudstack.go.tmpl": true,
+	"common.go.tmpl":     true,
+	"doc.go.tmpl":        true,
+	"service.go.tmpl":    true,
+	"tags.go.tmpl":       true,
+	"types.go.tmpl":      true,
+	"versions.go.tmpl":   true,
+	"xml.go.tmpl":        true,
+
+	"cloudstack_test.go.tmpl": true,
+	"service_test.go.tmpl":    true,
+}
+
+// The functions available in the templates
+var templateFuncs = template.FuncMap{
+	"capitalize":   capitalize,
+	"uncapitalize": uncapitalize,
+	"singular":     parseSingular,
+	"join":         strings.Join,
+	"lower":        strings.ToLower,
+}
+
+// Loads the embedded templates. If a directory is given, the templates in that directory are loaded
+// afterwards, so they replace the embedded templates (and the templates they define) with the same name.
+func loadTemplates(dir string) (*template.Template, error) {
+	t, err := template.New("").Funcs(templateFuncs).ParseFS(templateFS, "templates/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		return t, nil
+	}
+	return t.ParseGlob(filepath.Join(dir, "*.tmpl"))
+}
+
+// Executes the named template and writes the formatted result to the given file of the package
+func writeTemplate(t *template.Template, name, file string, data interface{}) error {
+	outdir, err := sourceDir()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+		return err
+	}
+
+	code, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("Failed to format %s: %v", file, err)
+	}
+	return ioutil.WriteFile(path.Join(outdir, file), code, 0644)
+}
+
+// The data the templates of a package are executed with
+type packageData struct {
+	Package       string   // The name of the package
+	Version       string   // The version given with the -version flag
+	VersionNumber string   // The CloudStack version, like '4.4'; empty for 'latest' and 'common'
+	Versions      []string // The CloudStack versions of a merged package, oldest first; empty if not merged
+	Common        bool     // True for the package with the APIs that are the same for all versions
+	CommonPkg     string   // The name of the common package
+	CommonPkgPath string   // The import path of the common package
+	Services      []*serviceData
+	Enums         []*enumData
+	NativeTypes   []string
+	MapParamTypes []*mapParamTypeData
+	NestedTypes   []*nestedTypeData
+	APIVersions   []*apiVersionsData    // The versions supporting every API of a merged package, sorted by name
+	Tagged        []*taggedResourceData // The resource types of which the objects can be found by their tags
+}
+
+// The data the template of a service is executed with
+type serviceData struct {
+	*packageData
+	Name          string // The name of the service type, like 'VirtualMachineService'
+	Short         string // The name of the field of the client, like 'VirtualMachine'
+	HasCommonAPIs bool   // The service has APIs that are the same for all versions
+	Deprecated    bool   // The service has no APIs, but is kept for compatibility
+	APIs          []*apiData
+}
+
+type apiData struct {
+	Service            *serviceData
+	Name               string // The name of the API, like 'listVirtualMachines'
+	Async              bool
+	Shared             bool   // The API is the same for all versions, so its types are defined in the common package
+	ParamsType         string // The name of the params type
+	ResponseType       string // The name of the type returned by the API call
+	ResponseTypes      []string
+	Params             []*paramData // The params of the API, without duplicates
+	Required           []*paramData // The required params, in the order of the API details
+	RequiredSorted     []*paramData // The required params, sorted by name
+	Exclusive          [][2]string  // The params that can not be used together
+	NewParamsSignature string
+	NewParamsDoc       []string
+	CallSignature      string
+	StreamSignature    string // Set for 'list' APIs, of which the listed objects can be streamed
+	Doc                []string
+	RawValue           bool // The response is wrapped in an object which needs to be removed
+	SuccessOnly        bool
+	List               string // The name of the listed objects, like 'VirtualMachines', for 'list' APIs
+	Singular           string // The singular of List, like 'VirtualMachine'
+	GetID              *helperData
+	GetByName          *helperData
+	GetByID            *helperData
+	GetByTags          *helperData
+	ListResponse       *listResponseData // Set if the response type wraps a list of objects
+	ElemType           string            // The name of the type with the fields of the response
+	ResponseFields     []*fieldData
+	SampleArgs         string      // The sample values of the required params, in the order of NewParamsSignature
+	SampleResponse     string      // A sample response, as returned by CloudStack
+	SampleChecks       [][2]string // Expressions of the decoded sample response and their expected values
+	SampleXMLResponse  string      // The sample response as XML
+	SampleRawFields    [][2]string // The raw fields of the decoded sample response and the prefix of their XML
+}
+
+type paramData struct {
+	Name      string
+	Field     string // The name of the field in the params type
+	Type      string // The type of the setter and getter
+	FieldType string // The type of the field in the params type
+	BaseType  string // The Go type of the type of the param in the API details
+	Zero      string
+	Arg       string // The name of the argument, when used as argument of a function
+	Pointer   bool
+	Required  bool
+	Enum      bool
+	Numeric   bool      // Set for enum params that also accept a number
+	Map       *mapParam // Set for map params
+	Doc       []string
+	Sample    string      // The Go expression of the sample value used in the tests
+	Encoded   [][2]string // The URL values the sample value is encoded as
+}
+
+// A helper function generated for a 'list' API
+type helperData struct {
+	Signature string
+	Param     string // The param used to search by name
+	Template  bool   // Templates are searched in a zone
+	IDArgs    string // The arguments used to get the ID of the object
+	Args      string // The arguments used to get the object by its ID
+	Projects  bool   // The object is searched in all projects if not found otherwise
+
+	// The fields of a Candidate of an AmbiguousError and the fields of the object they are set from
+	Candidates [][2]string
+}
+
+type listResponseData struct {
+	Field string
+	Elem  string
+	JSON  string
+}
+
+// The versions supporting an API, and the versions supporting each of its params that is not
+// supported by all of them
+type apiVersionsData struct {
+	Name     string
+	Versions []string
+	Params   []*paramVersionsData
+}
+
+type paramVersionsData struct {
+	Name     string
+	Versions []string
+}
+
+// A field of a response type. Nested objects without a named type are generated as an anonymous struct.
+type fieldData struct {
+	Name      string
+	JSON      string
+	Type      string
+	Anonymous bool
+	Fields    []*fieldData // The fields of a nested object
+}
+
+func newPackageData(as *allServices) *packageData {
+	pd := &packageData{
+		Package:       pkg,
+		Version:       *version,
+		Common:        *version == "common",
+		CommonPkg:     path.Base(commonPkgPath),
+		CommonPkgPath: commonPkgPath,
+		Enums:         enumDefs(),
+		NativeTypes:   nativeTypes,
+		MapParamTypes: mapParamTypes(as.services),
+		NestedTypes:   nestedTypeDefs(),
+		Tagged:        taggedResourceDefs(as.services),
+	}
+	if len(apiVersions) > 0 {
+		for _, v := range mergedVersions() {
+			pd.Versions = append(pd.Versions, versionNumber(v))
+		}
+		pd.APIVersions = apiVersionsDefs(as.services)
+	}
+	if *version != "latest" && *version != "common" {
+		pd.VersionNumber = versionNumber(*version)
+	}
+
+	for _, s := range as.services {
+		sd := &serviceData{
+			packageData:   pd,
+			Name:          s.name,
+			Short:         strings.TrimSuffix(s.name, "Service"),
+			HasCommonAPIs: s.hasCommonAPIs(),
+			Deprecated:    len(s.apis) == 0,
+		}
+		for _, a := range s.apis {
+			sd.APIs = append(sd.APIs, s.newAPIData(sd, a))
+		}
+		pd.Services = append(pd.Services, sd)
+	}
+	return pd
+}
+
+// Writes the files of the package that are not specific for a service
+func (pd *packageData) writeGeneralCode(t *template.Template) error {
+	files := map[string]string{
+		"cloudstack.go":      "cloudstack.go.tmpl",
+		"cloudstack_test.go": "cloudstack_test.go.tmpl",
+		"doc.go":             "doc.go.tmpl",
+		"types.go":           "types.go.tmpl",
+	}
+	if pd.Common {
+		files["cloudstack.go"] = "common.go.tmpl"
+	} else {
+		files["tags.go"] = "tags.go.tmpl"
+		files["xml.go"] = "xml.go.tmpl"
+	}
+	if len(pd.Versions) > 0 {
+		files["versions.go"] = "versions.go.tmpl"
+	}
+	for _, tmpl := range t.Templates() {
+		if name := tmpl.Name(); strings.HasSuffix(name, ".go.tmpl") && !packageTemplates[name] {
+			files[strings.TrimSuffix(name, ".tmpl")] = name
+		}
+	}
+
+	names := make([]string, 0, len(files))
+	for file := range files {
+		names = append(names, file)
+	}
+	sort.Strings(names)
+
+	for _, file := range names {
+		if err := writeTemplate(t, files[file], file, pd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Writes the code of the service and the tests of all its APIs
+func (sd *serviceData) writeGeneratedCode(t *template.Template) error {
+	if err := writeTemplate(t, "service.go.tmpl", sd.Name+".go", sd); err != nil {
+		return err
+	}
+	return writeTemplate(t, "service_test.go.tmpl", sd.Name+"_test.go", sd)
+}
+
+func (s *service) newAPIData(sd *serviceData, a *API) *apiData {
+	ln := strings.TrimPrefix(a.Name, "list")
+	ad := &apiData{
+		Service:            sd,
+		Name:               a.Name,
+		Async:              a.Isasync,
+		Shared:             commonAPIs[a.Name],
+		ParamsType:         capitalize(a.Name + "Params"),
+		ResponseType:       capitalize(a.Name + "Response"),
+		ResponseTypes:      responseTypeNames(a),
+		NewParamsSignature: s.newParamTypeSignature(a),
+		NewParamsDoc:       requiredParamsDoc(a),
+		CallSignature:      apiCallSignature(a),
+		Doc:                s.apiDoc(a),
+		SuccessOnly:        isSuccessOnlyResponse(a.Response),
+		List:               ln,
+		Singular:           parseSingular(ln),
+	}
+
+	if strings.HasPrefix(a.Name, "list") && !a.Isasync {
+		ad.StreamSignature = apiStreamSignature(a)
+	}
+
+	switch capitalize(a.Name) {
+	case "CreateNetwork", "CreateSSHKeyPair", "RegisterSSHKeyPair":
+		ad.RawValue = true
+	}
+
+	found := make(map[string]bool)
+	for _, ap := range a.Params {
+		p := s.newParamData(a, ap)
+		if !found[ap.Name] {
+			ad.Params = append(ad.Params, p)
+			found[ap.Name] = true
+		}
+		if ap.Required {
+			ad.Required = append(ad.Required, p)
+		}
+	}
+	ad.Exclusive = exclusive(ad.Params)
+
+	rp := APIParams{}
+	for _, ap := range a.Params {
+		if ap.Required {
+			rp = append(rp, ap)
+		}
+	}
+	sort.Sort(rp)
+	for _, ap := range rp {
+		ad.RequiredSorted = append(ad.RequiredSorted, s.newParamData(a, ap))
+	}
+
+	s.addHelpers(ad, a)
+	s.addResponse(ad, a)
+	addParamSamples(ad)
+	addResponseSample(ad)
+	return ad
+}
+
+func (s *service) newParamData(a *API, ap *APIParam) *paramData {
+	typ := paramType(a, ap)
+	_, enum := getEnum(a.Name, ap.Name, ap.Type)
+
+	p := &paramData{
+		Name:      ap.Name,
+		Field:     capitalize(ap.Name),
+		Type:      typ,
+		FieldType: fieldType(a, ap),
+		BaseType:  mapType(ap.Type),
+		Zero:      zeroValue(typ),
+		Arg:       s.parseParamName(ap.Name),
+		Pointer:   strings.HasPrefix(fieldType(a, ap), "*"),
+		Required:  ap.Required,
+		Enum:      enum,
+		Numeric:   enum && numericEnumParams[a.Name][ap.Name],
+		Doc:       paramDoc(a, ap),
+	}
+	if ap.Type == "map" {
+		p.Map = getMapParam(a.Name, ap.Name)
+	}
+	return p
+}
+
+func (s *service) addHelpers(ad *apiData, a *API) {
+	if v, found := s.hasGetIDHelper(a); found {
+		ad.GetID = &helperData{
+			Signature:  s.getIDSignature(a),
+			Param:      v,
+			Template:   ad.Singular == "Template",
+			Projects:   hasProjectidParamField(a.Params),
+			Candidates: candidateFields(a.Response),
+		}
+	}
+
+	if s.hasGetByNameHelper(a) {
+		args := []string{"name"}
+		for _, ap := range a.Params {
+			if ap.Required {
+				args = append(args, s.parseParamName(ap.Name))
+			}
+		}
+		// Add the additional parameter for the GetTemplateID helper function
+		if ad.Singular == "Template" {
+			args = append(args, "zoneid")
+		}
+		ad.GetByName = &helperData{
+			Signature: s.getByNameSignature(a),
+			IDArgs:    strings.Join(append(args, "opts..."), ", "),
+		}
+
+		args = []string{"id"}
+		for _, ap := range a.Params {
+			if ap.Required {
+				args = append(args, s.parseParamName(ap.Name))
+			}
+		}
+		ad.GetByName.Args = strings.Join(append(args, "opts..."), ", ")
+	}
+
+	if s.hasGetByIDHelper(a) {
+		ad.GetByID = &helperData{
+			Signature: s.getByIDSignature(a),
+			Projects:  hasProjectidParamField(a.Params),
+		}
+	}
+
+	if s.hasGetByTagsHelper(a) {
+		ad.GetByTags = &helperData{
+			Signature: s.getByTagsSignature(a),
+			Projects:  hasProjectidParamField(a.Params),
+		}
+	}
+}
+
+// Returns the fields of a Candidate that can be set from the response fields of a 'list' API
+func candidateFields(resp APIResponses) [][2]string {
+	fields := [][2]string{{"ID", "Id"}, {"Name", "Name"}}
+	for _, f := range [][2]string{{"Zone", "zonename"}, {"Project", "project"}, {"Domain", "domain"}} {
+		for _, r := range resp {
+			if r.Name == f[1] && r.Response == nil && mapType(r.Type) == "string" {
+				fields = append(fields, [2]string{f[0], capitalize(f[1])})
+				break
+			}
+		}
+	}
+	return fields
+}
+
+func (s *service) addResponse(ad *apiData, a *API) {
+	ad.ElemType = ad.ResponseType
+
+	// If this is a 'list' response, we need an seperate list struct. There seem to be other
+	// types of responses that also need a seperate list struct, so checking on exact matches
+	// for those once.
+	if strings.HasPrefix(a.Name, "list") || a.Name == "registerTemplate" {
+		ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+		lr := &listResponseData{Field: ln, Elem: parseSingular(ln), JSON: strings.ToLower(parseSingular(ln))}
+
+		// This nasty check is for some specific response that do not behave consistent
+		switch a.Name {
+		case "listEgressFirewallRules":
+			lr.JSON = "firewallrule"
+		case "registerTemplate":
+			lr.JSON = "template"
+		}
+		ad.ListResponse = lr
+		ad.ElemType = lr.Elem
+	}
+
+	resp := a.Response
+	if a.Isasync {
+		// Some async responses also contain a jobid field, which would clash with the JobID field
+		resp = APIResponses{}
+		for _, r := range a.Response {
+			if r.Name != "jobid" {
+				resp = append(resp, r)
+			}
+		}
+	}
+	sort.Sort(resp)
+	ad.ResponseFields = responseFields(a.Name, resp)
+}
+
+// Returns the fields of a response type. Enums are only used for the top level fields of the
+// response of the given API.
+func responseFields(api string, resp APIResponses) []*fieldData {
+	fields := []*fieldData{}
+	found := make(map[string]bool)
+
+	for _, r := range resp {
+		if r.Name == "" {
+			continue
+		}
+		if r.Response != nil {
+			sort.Sort(r.Response)
+			if t, found := nestedTypes[responseSignature(r.Response)]; found {
+				fields = append(fields, &fieldData{
+					Name:   capitalize(r.Name),
+					JSON:   r.Name,
+					Type:   "[]" + t.name,
+					Fields: responseFields("", r.Response),
+				})
+				continue
+			}
+			fields = append(fields, &fieldData{
+				Name:      capitalize(r.Name),
+				JSON:      r.Name,
+				Anonymous: true,
+				Fields:    responseFields("", r.Response),
+			})
+			continue
+		}
+		if !found[r.Name] {
+			// The success field is a string for sync calls and a bool for async calls :(
+			typ := responseFieldType(api, r)
+			if r.Name == "success" {
+				typ = "Bool"
+			}
+			fields = append(fields, &fieldData{Name: capitalize(r.Name), JSON: r.Name, Type: typ})
+			found[r.Name] = true
+		}
+	}
+	return fields
+}
+
+func apiVersionsDefs(ss services) []*apiVersionsData {
+	data := []*apiVersionsData{}
+	for _, s := range ss {
+		for _, a := range s.apis {
+			avd := &apiVersionsData{Name: a.Name, Versions: supportedVersions(a.Name)}
+			found := make(map[string]bool)
+			for _, ap := range a.Params {
+				if vs := paramVersions(a.Name, ap.Name); vs != nil && !found[ap.Name] {
+					avd.Params = append(avd.Params, &paramVersionsData{Name: ap.Name, Versions: vs})
+					found[ap.Name] = true
+				}
+			}
+			data = append(data, avd)
+		}
+	}
+	sort.Slice(data, func(i, j int) bool { return data[i].Name < data[j].Name })
+	return data
+}
@@ -0,0 +1,40 @@
+//
+// Copyright 2014, Sander van Harmelen
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+// Params that can not be used together, for every API that has both of them
+var exclusiveParams = [][2]string{
+	{"affinitygroupids", "affinitygroupnames"},
+	{"iptonetworklist", "networkids"},
+	{"securitygroupids", "securitygroupnames"},
+}
+
+// Returns the pairs of exclusive params that are both params of the given API
+func exclusive(params []*paramData) [][2]string {
+	found := make(map[string]bool)
+	for _, p := range params {
+		found[p.Name] = true
+	}
+
+	pairs := [][2]string{}
+	for _, ep := range exclusiveParams {
+		if found[ep[0]] && found[ep[1]] {
+			pairs = append(pairs, ep)
+		}
+	}
+	return pairs
+}